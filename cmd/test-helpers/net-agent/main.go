@@ -0,0 +1,247 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	protocolTCP  = "tcp"
+	protocolUDP  = "udp"
+	protocolHTTP = "http"
+)
+
+// verdict is the result of a client check, printed as JSON on the standard output.
+type verdict struct {
+	Protocol  string `json:"protocol"`
+	Address   string `json:"address"`
+	Received  string `json:"received,omitempty"`
+	Succeeded bool   `json:"succeeded"`
+	Error     string `json:"error,omitempty"`
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: test-net-agent client|server [flags]")
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "client":
+		os.Exit(runClient(os.Args[2:]))
+	case "server":
+		os.Exit(runServer(os.Args[2:]))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q, expected client or server\n", os.Args[1])
+		os.Exit(2)
+	}
+}
+
+// runClient connects to the server and succeeds if the first line it receives is the expected payload.
+func runClient(args []string) int {
+	flags := pflag.NewFlagSet("client", pflag.ExitOnError)
+	protocol := flags.String("protocol", protocolTCP, "protocol to reach the server with: tcp, udp or http")
+	host := flags.String("host", "", "DNS name or IP of the server")
+	port := flags.Int("port", 0, "port of the server")
+	expectedPayload := flags.String("expected-payload", "", "first line expected from the server")
+	ipFamily := flags.String("ip-family", "", "IP family to reach the server over, IPv4 or IPv6, both by default")
+	timeout := flags.Duration("timeout", 5*time.Second, "time to wait for the answer of the server in each attempt")
+	attempts := flags.Int("attempts", 3, "number of attempts before failing")
+	flags.Parse(args)
+
+	result := verdict{Protocol: *protocol, Address: net.JoinHostPort(*host, strconv.Itoa(*port))}
+	network, err := networkFor(*protocol, *ipFamily)
+	for attempt := 0; err == nil && attempt < *attempts; attempt++ {
+		result.Received, err = receive(*protocol, network, result.Address, *timeout)
+		if err == nil && result.Received != *expectedPayload {
+			err = fmt.Errorf("received %q, expected %q", result.Received, *expectedPayload)
+		}
+		if err != nil && attempt+1 < *attempts {
+			fmt.Fprintf(os.Stderr, "attempt %d failed: %v\n", attempt+1, err)
+			err = nil
+			continue
+		}
+		break
+	}
+
+	result.Succeeded = err == nil
+	if err != nil {
+		result.Error = err.Error()
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(result)
+	if !result.Succeeded {
+		return 1
+	}
+	return 0
+}
+
+func networkFor(protocol, ipFamily string) (string, error) {
+	network := protocol
+	if protocol == protocolHTTP {
+		network = protocolTCP
+	} else if protocol != protocolTCP && protocol != protocolUDP {
+		return "", fmt.Errorf("unknown protocol %q", protocol)
+	}
+
+	switch ipFamily {
+	case "":
+		return network, nil
+	case "IPv4":
+		return network + "4", nil
+	case "IPv6":
+		return network + "6", nil
+	default:
+		return "", fmt.Errorf("unknown IP family %q", ipFamily)
+	}
+}
+
+func receive(protocol, network, address string, timeout time.Duration) (string, error) {
+	if protocol == protocolHTTP {
+		return receiveHTTP(network, address, timeout)
+	}
+
+	conn, err := net.DialTimeout(network, address, timeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return "", err
+	}
+
+	if protocol == protocolUDP {
+		// The server does not see the connection unless something is sent over it.
+		if _, err := conn.Write([]byte("\n")); err != nil {
+			return "", err
+		}
+		datagram := make([]byte, 65535)
+		n, err := conn.Read(datagram)
+		if err != nil {
+			return "", err
+		}
+		return firstLine(string(datagram[:n])), nil
+	}
+	return readFirstLine(conn)
+}
+
+func receiveHTTP(network, address string, timeout time.Duration) (string, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, address string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, address)
+			},
+		},
+	}
+	resp, err := client.Get("http://" + address)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	return readFirstLine(resp.Body)
+}
+
+func readFirstLine(reader io.Reader) (string, error) {
+	line, err := bufio.NewReader(reader).ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+	return firstLine(line), nil
+}
+
+func firstLine(data string) string {
+	line, _, _ := strings.Cut(data, "\n")
+	return strings.TrimSuffix(line, "\r")
+}
+
+// runServer answers every connection, datagram or HTTP request with the payload, until it is killed.
+func runServer(args []string) int {
+	flags := pflag.NewFlagSet("server", pflag.ExitOnError)
+	protocol := flags.String("protocol", protocolTCP, "protocol to serve: tcp, udp or http")
+	port := flags.Int("port", 0, "port to listen on")
+	payload := flags.String("payload", "", "payload to answer with")
+	flags.Parse(args)
+
+	address := fmt.Sprintf(":%d", *port)
+	var err error
+	switch *protocol {
+	case protocolTCP:
+		err = serveTCP(address, *payload)
+	case protocolUDP:
+		err = serveUDP(address, *payload)
+	case protocolHTTP:
+		err = http.ListenAndServe(address, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintln(w, *payload)
+		}))
+	default:
+		err = fmt.Errorf("unknown protocol %q", *protocol)
+	}
+	fmt.Fprintln(os.Stderr, err)
+	return 1
+}
+
+func serveTCP(address, payload string) error {
+	listener, err := net.Listen(protocolTCP, address)
+	if err != nil {
+		return err
+	}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(conn, payload)
+		conn.Close()
+	}
+}
+
+func serveUDP(address, payload string) error {
+	conn, err := net.ListenPacket(protocolUDP, address)
+	if err != nil {
+		return err
+	}
+	buffer := make([]byte, 65535)
+	for {
+		_, source, err := conn.ReadFrom(buffer)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.WriteTo([]byte(payload+"\n"), source); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to answer %s: %v\n", source, err)
+		}
+	}
+}
@@ -23,11 +23,13 @@ import (
 	klog "kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	virt_api "kubevirt.io/kubevirt/pkg/virt-api"
 )
 
 func main() {
 	klog.InitializeLogging("virt-api")
+	tracecontext.InitExporter("virt-api")
 
 	app := virt_api.NewVirtApi()
 	service.Setup(app)
@@ -37,6 +37,7 @@ import (
 	"libvirt.org/go/libvirtxml"
 
 	netresources "kubevirt.io/kubevirt/pkg/network/resources"
+	handlerresources "kubevirt.io/kubevirt/pkg/virt-handler/handler-resources"
 	"kubevirt.io/kubevirt/pkg/virt-handler/ksm"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -50,7 +51,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/safepath"
 
+	"kubevirt.io/kubevirt/pkg/util/eventfilter"
 	"kubevirt.io/kubevirt/pkg/util/ratelimiter"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 
 	scraper "kubevirt.io/kubevirt/pkg/downwardmetrics/scraper"
 
@@ -81,6 +84,7 @@ import (
 	dmetricsmanager "kubevirt.io/kubevirt/pkg/virt-handler/dmetrics-manager"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	launcherwatchdog "kubevirt.io/kubevirt/pkg/virt-handler/launcher-watchdog"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	nodelabeller "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller"
 	"kubevirt.io/kubevirt/pkg/virt-handler/rest"
@@ -102,6 +106,8 @@ const (
 
 	podIpAddress = ""
 
+	podName = ""
+
 	// This value reflects in the max number of VMIs per node
 	maxDevices = 1000
 
@@ -137,6 +143,7 @@ type virtHandlerApp struct {
 	service.ServiceListen
 	HostOverride              string
 	PodIpAddress              string
+	PodName                   string
 	VirtShareDir              string
 	VirtPrivateDir            string
 	KubeletPodsDir            string
@@ -248,15 +255,25 @@ func (app *virtHandlerApp) Run() {
 		os.Exit(0)
 	}()
 
+	factory := controller.NewKubeInformerFactory(app.virtCli.RestClient(), app.virtCli, nil, app.namespace)
+
+	app.clusterConfig, err = virtconfig.NewClusterConfig(factory.CRD(), factory.KubeVirt(), app.namespace)
+	if err != nil {
+		panic(err)
+	}
+	// set log verbosity
+	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
+	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
+	app.clusterConfig.SetConfigModifiedCallback(app.shouldInstallKubevirtSeccompProfile)
+
 	// Create event recorder
 	broadcaster := record.NewBroadcaster()
 	broadcaster.StartRecordingToSink(&k8coresv1.EventSinkImpl{Interface: app.virtCli.CoreV1().Events(k8sv1.NamespaceAll)})
+	eventFilter := eventfilter.NewFilter(app.clusterConfig.GetEventConfiguration)
 	// Scheme is used to create an ObjectReference from an Object (e.g. VirtualMachineInstance) during Event creation
-	recorder := broadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "virt-handler", Host: app.HostOverride})
+	recorder := eventFilter.Wrap(broadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "virt-handler", Host: app.HostOverride}))
 
 	// Wire VirtualMachineInstance controller
-	factory := controller.NewKubeInformerFactory(app.virtCli.RestClient(), app.virtCli, nil, app.namespace)
-
 	vmiInformer := factory.VMI()
 	vmiSourceInformer := factory.VMISourceHost(app.HostOverride)
 	vmiTargetInformer := factory.VMITargetHost(app.HostOverride)
@@ -285,14 +302,6 @@ func (app *virtHandlerApp) Run() {
 	}
 
 	podIsolationDetector := isolation.NewSocketBasedIsolationDetector()
-	app.clusterConfig, err = virtconfig.NewClusterConfig(factory.CRD(), factory.KubeVirt(), app.namespace)
-	if err != nil {
-		panic(err)
-	}
-	// set log verbosity
-	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
-	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
-	app.clusterConfig.SetConfigModifiedCallback(app.shouldInstallKubevirtSeccompProfile)
 
 	if err := app.setupTLS(factory); err != nil {
 		logger.Criticalf("Error constructing migration tls config: %v", err)
@@ -326,6 +335,7 @@ func (app *virtHandlerApp) Run() {
 	nodeInformer := cache.NewSharedInformer(listWatch, &k8sv1.Node{}, controller.ResyncPeriod(12*time.Hour))
 
 	ksmHandler := ksm.NewHandler(app.HostOverride, app.virtCli.CoreV1(), nodeInformer.GetStore(), app.clusterConfig)
+	handlerResourcesHandler := handlerresources.NewHandler(app.HostOverride, app.namespace, app.PodName, app.virtCli, nodeInformer.GetStore(), app.clusterConfig)
 
 	var capabilities libvirtxml.Caps
 	var hostCpuModel string
@@ -341,7 +351,7 @@ func (app *virtHandlerApp) Run() {
 
 	machines := getMachines(capabilities)
 
-	nodeLabellerrecorder := broadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "node-labeller", Host: app.HostOverride})
+	nodeLabellerrecorder := eventFilter.Wrap(broadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "node-labeller", Host: app.HostOverride}))
 	nodeLabellerController, err := nodelabeller.NewNodeLabeller(app.clusterConfig,
 		app.virtCli.CoreV1().Nodes(),
 		nodeInformer.GetStore(),
@@ -349,6 +359,7 @@ func (app *virtHandlerApp) Run() {
 		nodeLabellerrecorder,
 		capabilities.Host.CPU.Counter,
 		machines,
+		&capabilities.Host,
 	)
 	if err != nil {
 		panic(err)
@@ -369,6 +380,7 @@ func (app *virtHandlerApp) Run() {
 	downwardMetricsManager := dmetricsmanager.NewDownwardMetricsManager(app.HostOverride)
 
 	launcherClientsManager := launcherclients.NewLauncherClientsManager(app.VirtShareDir, podIsolationDetector)
+	launcherWatchdog := launcherwatchdog.NewWatchdog(app.clusterConfig, app.virtCli, vmiSourceInformer.GetStore(), launcherClientsManager, podIsolationDetector, recorder)
 
 	netConf := netsetup.NewNetConf(app.clusterConfig)
 	netStat := netsetup.NewNetStat()
@@ -442,6 +454,7 @@ func (app *virtHandlerApp) Run() {
 		app.virtCli,
 		nodeInformer.GetStore(),
 		app.HostOverride,
+		app.namespace,
 		app.VirtPrivateDir,
 		app.KubeletPodsDir,
 		launcherClientsManager,
@@ -510,7 +523,7 @@ func (app *virtHandlerApp) Run() {
 		panic(fmt.Errorf("failed to detect the presence of selinux: %v", err))
 	}
 
-	if err := metrics.SetupMetrics(app.HostOverride, app.MaxRequestsInFlight, vmiSourceInformer, machines); err != nil {
+	if err := metrics.SetupMetrics(app.HostOverride, app.MaxRequestsInFlight, vmiSourceInformer, machines, app.clusterConfig); err != nil {
 		panic(err)
 	}
 
@@ -522,6 +535,8 @@ func (app *virtHandlerApp) Run() {
 	go migrationTargetController.Run(5, stop)
 	go vmController.Run(10, stop)
 	go ksmHandler.Run(stop)
+	go handlerResourcesHandler.Run(stop)
+	go launcherWatchdog.Run(stop)
 
 	doneCh := make(chan string)
 	defer close(doneCh)
@@ -649,13 +664,21 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/redefine-checkpoint").To(lifecycleHandler.RedefineCheckpointHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/pause").To(lifecycleHandler.PauseHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/unpause").To(lifecycleHandler.UnpauseHandler))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/suspend").To(lifecycleHandler.SuspendHandler))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/cloudinit/rotate").To(lifecycleHandler.CloudInitRotateHandler))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/cloudinit/eject").To(lifecycleHandler.CloudInitEjectHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/freeze").To(lifecycleHandler.FreezeHandler).Reads(v1.FreezeUnfreezeTimeout{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/unfreeze").To(lifecycleHandler.UnfreezeHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/softreboot").To(lifecycleHandler.SoftRebootHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/reset").To(lifecycleHandler.ResetHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo").To(lifecycleHandler.GetGuestInfo).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo/refresh").To(lifecycleHandler.RefreshGuestInfo))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/filesystemlist").To(lifecycleHandler.GetFilesystems).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/domainxml").To(lifecycleHandler.GetDomainXML).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceDomainXML{}))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestfile").To(lifecycleHandler.GuestFileReadHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestFile{}))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestfile").To(lifecycleHandler.GuestFileWriteHandler))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestexec").To(lifecycleHandler.GuestExecHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vsock").Param(restful.QueryParameter("port", "Target VSOCK port")).To(consoleHandler.VSOCKHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/fetchcertchain").To(lifecycleHandler.SEVFetchCertChainHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVPlatformInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/querylaunchmeasurement").To(lifecycleHandler.SEVQueryLaunchMeasurementHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVMeasurementInfo{}))
@@ -686,6 +709,9 @@ func (app *virtHandlerApp) AddFlags() {
 	flag.StringVar(&app.PodIpAddress, "pod-ip-address", podIpAddress,
 		"The pod ip address")
 
+	flag.StringVar(&app.PodName, "pod-name", podName,
+		"The pod name, used to resize virt-handler in place")
+
 	flag.StringVar(&app.VirtShareDir, "kubevirt-share-dir", util.VirtShareDir,
 		"Shared directory between virt-handler and virt-launcher")
 
@@ -783,5 +809,6 @@ func main() {
 	app := &virtHandlerApp{}
 	service.Setup(app)
 	log.InitializeLogging("virt-handler")
+	tracecontext.InitExporter("virt-handler")
 	app.Run()
 }
@@ -51,6 +51,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/storage/nbdclient"
 	putil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	virtlauncher "kubevirt.io/kubevirt/pkg/virt-launcher"
@@ -363,6 +364,7 @@ func main() {
 	hookSidecars := pflag.Uint("hook-sidecars", 0, "Number of requested hook sidecars, virt-launcher will wait for all of them to become available")
 	diskMemoryLimitBytes := pflag.Int64("disk-memory-limit", virtconfig.DefaultDiskVerificationMemoryLimitBytes, "Memory limit for disk verification")
 	ovmfPath := pflag.String("ovmf-path", virtconfig.DefaultARCHOVMFPath, "The directory that contains the EFI roms (like OVMF_CODE.fd)")
+	efiFirmwareImages := pflag.StringToString("efi-firmware-images", nil, "The file names of the EFI roms in the ovmf-path which replace the default ones, e.g. secureBootCode=OVMF_CODE_4M.ms.fd")
 	qemuAgentSysInterval := pflag.Duration("qemu-agent-sys-interval", 120*time.Second, "Interval between consecutive qemu agent calls for sys commands")
 	qemuAgentFileInterval := pflag.Duration("qemu-agent-file-interval", 300*time.Second, "Interval between consecutive qemu agent calls for file command")
 	qemuAgentUserInterval := pflag.Duration("qemu-agent-user-interval", 10*time.Second, "Interval between consecutive qemu agent calls for user command")
@@ -371,16 +373,21 @@ func main() {
 	simulateCrash := pflag.Bool("simulate-crash", false, "Causes virt-launcher to immediately crash. This is used by functional tests to simulate crash loop scenarios.")
 	libvirtLogFilters := pflag.String("libvirt-log-filters", "", "Set custom log filters for libvirt")
 	hypervisor := pflag.String("hypervisor", v1.KvmHypervisorName, "Hypervisor to be used by the VMI")
+	domainStatsInterval := pflag.Duration("domain-stats-interval", 0, "Interval between consecutive domain stats collections from libvirt, the default is used if zero")
+	disabledDomainStats := pflag.StringSlice("disabled-domain-stats", nil, "Domain stats collectors (block, vcpu, network) which are not collected from libvirt")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.Parse()
 
 	log.InitializeLogging("virt-launcher")
+	tracecontext.InitExporter("virt-launcher")
 
 	// check if virt-launcher verbosity should be changed
+	logVerbosity, _ := strconv.Atoi(goflag.CommandLine.Lookup("v").Value.String())
 	if verbosityStr, ok := os.LookupEnv("VIRT_LAUNCHER_LOG_VERBOSITY"); ok {
 		if verbosity, err := strconv.Atoi(verbosityStr); err == nil {
 			log.Log.SetVerbosityLevel(verbosity)
+			logVerbosity = verbosity
 			log.Log.V(2).Infof("set log verbosity to %d", verbosity)
 		} else {
 			log.Log.Warningf("failed to set log verbosity. The value of logVerbosity label should be an integer, got %s instead.", verbosityStr)
@@ -458,7 +465,17 @@ func main() {
 		stopChan,
 		hookFuncs...,
 	)
-	domainManager, err := virtwrap.NewLibvirtDomainManager(domainConn, *virtShareDir, *ephemeralDiskDir, &agentStore, *ovmfPath, ephemeralDiskCreator, metadataCache, signalStopChan, *diskMemoryLimitBytes, util.GetPodCPUSet, *imageVolumeEnabled, *libvirtHooksServerAndClientEnabled, preMigrationHookServer, *hypervisor, nbdclient.RegisterNBDServer, domainName, *vmStatsCollectorEnabled)
+
+	var disabledDomainStatsCollectors []v1.DomainStatsCollector
+	for _, collector := range *disabledDomainStats {
+		disabledDomainStatsCollectors = append(disabledDomainStatsCollectors, v1.DomainStatsCollector(collector))
+	}
+	domainStatsOptions := virtwrap.DomainStatsOptions{
+		CollectionInterval: *domainStatsInterval,
+		DisabledStats:      virtwrap.DomainStatsTypesForCollectors(disabledDomainStatsCollectors),
+	}
+
+	domainManager, err := virtwrap.NewLibvirtDomainManager(domainConn, *virtShareDir, *ephemeralDiskDir, &agentStore, *ovmfPath, *efiFirmwareImages, ephemeralDiskCreator, metadataCache, signalStopChan, *diskMemoryLimitBytes, util.GetPodCPUSet, *imageVolumeEnabled, *libvirtHooksServerAndClientEnabled, preMigrationHookServer, *hypervisor, nbdclient.RegisterNBDServer, domainName, *vmStatsCollectorEnabled, domainStatsOptions)
 	if err != nil {
 		panic(err)
 	}
@@ -473,7 +490,7 @@ func main() {
 	// Start the virt-launcher command service.
 	// Clients can use this service to tell virt-launcher
 	// to start/stop virtual machines
-	options := cmdserver.NewServerOptions(*allowEmulation).WithVMStatsCollector(*vmStatsCollectorEnabled).WithNotifier(notifier).WithVMI(vmi)
+	options := cmdserver.NewServerOptions(*allowEmulation).WithVMStatsCollector(*vmStatsCollectorEnabled).WithNotifier(notifier).WithVMI(vmi).WithLogVerbosity(logVerbosity)
 	cmdclient.SetBaseDir(*virtShareDir)
 	cmdServerDone := startCmdServer(cmdclient.UninitializedSocketOnGuest(), domainManager, stopChan, options)
 
@@ -39,6 +39,7 @@ func main() {
 	memProfile := pflag.String("memProfile", "", "Path to store a memory profile. Profiling is skipped if empty")
 	timeoutSeconds := pflag.Int32("timeoutSeconds", 1, "Duration in seconds the probe will wait for the guest command to return.")
 	guestAgentPing := pflag.Bool("guestAgentPing", false, "Flag to specify readiness probe based of guest-agent ping")
+	guestAgentFileExists := pflag.String("guestAgentFileExists", "", "Path of a file in the guest whose existence is checked through the guest-agent")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.Parse()
@@ -60,6 +61,15 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *guestAgentFileExists != "" {
+		err := client.GuestFileExists(*domainName, *guestAgentFileExists, *timeoutSeconds)
+		if err != nil {
+			log.Log.Reason(err).Criticalf("Failed to find %s in the guest", *guestAgentFileExists)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	exitCode, stdOut, err := client.Exec(*domainName, *command, pflag.Args(), *timeoutSeconds)
 	if len(stdOut) > 0 {
 		fmt.Println(stdOut)
@@ -21,6 +21,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	goflag "flag"
 	"fmt"
@@ -37,9 +38,58 @@ import (
 	"kubevirt.io/client-go/log"
 )
 
+const (
+	formatText = "text"
+	formatJSON = "json"
+
+	timestampFormat = "2006-01-02T15:04:05.000000Z"
+)
+
 type VirtTail struct {
 	ctx     context.Context
 	logFile string
+	format  string
+	vmi     vmiReference
+}
+
+type vmiReference struct {
+	namespace string
+	name      string
+	uid       string
+}
+
+// consoleLine is a line of the serial console in the JSON format, it
+// carries the same keys as the logs of the KubeVirt components
+type consoleLine struct {
+	Component string `json:"component"`
+	Level     string `json:"level"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+	Msg       string `json:"msg"`
+	Timestamp string `json:"timestamp"`
+}
+
+func (v *VirtTail) formatLine(text string, now time.Time) string {
+	if v.format != formatJSON {
+		return text
+	}
+	line, err := json.Marshal(consoleLine{
+		Component: "guest-console-log",
+		Level:     "info",
+		Kind:      "VirtualMachineInstance",
+		Namespace: v.vmi.namespace,
+		Name:      v.vmi.name,
+		UID:       v.vmi.uid,
+		Msg:       text,
+		Timestamp: now.UTC().Format(timestampFormat),
+	})
+	if err != nil {
+		log.Log.V(3).Infof("failed to format line: %v", err)
+		return text
+	}
+	return string(line)
 }
 
 func (v *VirtTail) tailLogsWrapper() error {
@@ -92,7 +142,7 @@ func (v *VirtTail) tailLogs(location tail.SeekInfo) (*tail.SeekInfo, error) {
 				if line.Err != nil {
 					log.Log.V(3).Infof("tail error: %v", line.Err)
 				} else {
-					fmt.Println(line.Text)
+					fmt.Println(v.formatLine(line.Text, line.Time))
 				}
 			}
 		case <-v.ctx.Done():
@@ -105,6 +155,10 @@ func main() {
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.CommandLine.ParseErrorsWhitelist = pflag.ParseErrorsWhitelist{UnknownFlags: true}
 	logFile := pflag.String("logfile", "", "path of the logfile to be streamed")
+	format := pflag.String("format", formatText, "format of the streamed lines, text or json")
+	namespace := pflag.String("namespace", "", "namespace of the VMI, added to the lines in the json format")
+	name := pflag.String("name", "", "name of the VMI, added to the lines in the json format")
+	uid := pflag.String("uid", "", "UID of the VMI, added to the lines in the json format")
 	pflag.Parse()
 
 	log.InitializeLogging("virt-tail")
@@ -114,6 +168,10 @@ func main() {
 		log.Log.V(3).Infof("logfile flags must be provided")
 		os.Exit(1)
 	}
+	if *format != formatText && *format != formatJSON {
+		log.Log.V(3).Infof("format must be %s or %s, got %s", formatText, formatJSON, *format)
+		os.Exit(1)
+	}
 
 	// Create context that listens for the interrupt signal from the container runtime.
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -124,6 +182,12 @@ func main() {
 	v := &VirtTail{
 		ctx:     gctx,
 		logFile: *logFile,
+		format:  *format,
+		vmi: vmiReference{
+			namespace: *namespace,
+			name:      *name,
+			uid:       *uid,
+		},
 	}
 
 	g.Go(v.tailLogsWrapper)
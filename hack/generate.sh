@@ -23,6 +23,9 @@ swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1alpha1/types
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1beta1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/backup/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/plugin/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/defaults/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/quota/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/maintenance/v1alpha1/types.go
 
 deepcopy-gen \
     --bounding-dirs kubevirt.io/api \
@@ -40,6 +43,9 @@ deepcopy-gen \
     kubevirt.io/api/clone/v1beta1 \
     kubevirt.io/api/backup/v1alpha1 \
     kubevirt.io/api/plugin/v1alpha1 \
+    kubevirt.io/api/defaults/v1alpha1 \
+    kubevirt.io/api/quota/v1alpha1 \
+    kubevirt.io/api/maintenance/v1alpha1 \
     kubevirt.io/api/core/v1
 
 defaulter-gen \
@@ -71,6 +77,9 @@ openapi-gen \
     kubevirt.io/api/snapshot/v1beta1 \
     kubevirt.io/api/backup/v1alpha1 \
     kubevirt.io/api/plugin/v1alpha1 \
+    kubevirt.io/api/defaults/v1alpha1 \
+    kubevirt.io/api/quota/v1alpha1 \
+    kubevirt.io/api/maintenance/v1alpha1 \
     kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1
 
 conversion-gen \
@@ -89,7 +98,8 @@ fi
 
 client-gen --clientset-name kubevirt \
     --input-base kubevirt.io/api \
-    --input core/v1,export/v1beta1,export/v1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1beta1,pool/v1alpha1,pool/v1beta1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1,backup/v1alpha1,plugin/v1alpha1 \
+    --input core/v1,export/v1beta1,export/v1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1beta1,pool/v1alpha1,pool/v1beta1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1,backup/v1alpha1,plugin/v1alpha1,defaults/v1alpha1,quota/v1alpha1,maintenance/v1alpha1 \
+    --plural-exceptions Endpoints:Endpoints,VirtualMachineDefaults:VirtualMachineDefaults \
     --output-dir ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go \
     --output-pkg ${CLIENT_GEN_BASE} \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt
@@ -165,6 +175,15 @@ deepcopy-gen \
     #include plugin
     GOFLAGS= controller-gen crd paths=../api/plugin/v1alpha1/
 
+    #include defaults
+    GOFLAGS= controller-gen crd paths=../api/defaults/v1alpha1/
+
+    #include quota
+    GOFLAGS= controller-gen crd paths=../api/quota/v1alpha1/
+
+    #include maintenance
+    GOFLAGS= controller-gen crd paths=../api/maintenance/v1alpha1/
+
     #remove some weird stuff from controller-gen
     cd config/crd
     for file in *; do
@@ -90,6 +90,7 @@ centos_extra="
 testimage_main="
   device-mapper
   e2fsprogs
+  iperf3
   iputils
   nmap-ncat
   procps-ng
@@ -32,12 +32,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/client-go/precond"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
 )
@@ -75,6 +77,10 @@ type CloudInitData struct {
 	NetworkData         string
 	DevicesData         *[]DeviceData
 	VolumeName          string
+	// GenerateNetworkData requests the network data to be generated from the
+	// interfaces of the VirtualMachineInstance and NetworkInterfaces.
+	GenerateNetworkData bool
+	NetworkInterfaces   *[]NetworkInterfaceData
 }
 
 type NoCloudMetadata struct {
@@ -82,6 +88,7 @@ type NoCloudMetadata struct {
 	InstanceID    string            `json:"instance-id"`
 	LocalHostname string            `json:"local-hostname,omitempty"`
 	PublicSSHKeys map[string]string `json:"public-keys,omitempty"`
+	KubeVirt      *IdentityMetadata `json:"kubevirt,omitempty"`
 }
 
 type ConfigDriveMetadata struct {
@@ -92,6 +99,15 @@ type ConfigDriveMetadata struct {
 	UUID          string            `json:"uuid,omitempty"`
 	Devices       *[]DeviceData     `json:"devices,omitempty"`
 	PublicSSHKeys map[string]string `json:"public_keys,omitempty"`
+	KubeVirt      *IdentityMetadata `json:"kubevirt,omitempty"`
+}
+
+// IdentityMetadata identifies the VirtualMachineInstance, and the VirtualMachine owning it if any, in the guest.
+// Unlike the hostname, it can not be customized, e.g. Cluster API derives the provider ID of the node from it.
+type IdentityMetadata struct {
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace"`
+	VirtualMachine string `json:"virtualMachine,omitempty"`
 }
 
 type DeviceData struct {
@@ -139,6 +155,7 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 
 			cloudInitData, err = readCloudInitNoCloudSource(volume.CloudInitNoCloud)
 			cloudInitData.NoCloudMetaData = readCloudInitNoCloudMetaData(hostname, cloudInitUUIDFromVMI(vmi), instancetype, keys)
+			cloudInitData.NoCloudMetaData.KubeVirt = identityMetadataFromVMI(vmi)
 			cloudInitData.VolumeName = volume.Name
 			return cloudInitData, err
 		}
@@ -150,8 +167,13 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 			}
 
 			uuid := cloudInitUUIDFromVMI(vmi)
-			cloudInitData, err = readCloudInitConfigDriveSource(volume.CloudInitConfigDrive)
+			if ignition.UsesConfigDrive(vmi) {
+				cloudInitData, err = readIgnitionConfigDriveSource(vmi, volume.CloudInitConfigDrive)
+			} else {
+				cloudInitData, err = readCloudInitConfigDriveSource(volume.CloudInitConfigDrive)
+			}
 			cloudInitData.ConfigDriveMetaData = readCloudInitConfigDriveMetaData(vmi.Name, uuid, hostname, vmi.Namespace, keys, instancetype)
+			cloudInitData.ConfigDriveMetaData.KubeVirt = identityMetadataFromVMI(vmi)
 			cloudInitData.VolumeName = volume.Name
 			return cloudInitData, err
 		}
@@ -372,6 +394,18 @@ func readCloudInitData(userData, userDataBase64, networkData, networkDataBase64
 }
 
 func readCloudInitNoCloudSource(source *v1.CloudInitNoCloudSource) (*CloudInitData, error) {
+	if source.GenerateNetworkData {
+		userData, err := readRawOrBase64Data(source.UserData, source.UserDataBase64)
+		if err != nil {
+			return &CloudInitData{}, err
+		}
+		return &CloudInitData{
+			DataSource:          DataSourceNoCloud,
+			UserData:            userData,
+			GenerateNetworkData: true,
+		}, nil
+	}
+
 	userData, networkData, err := readCloudInitData(source.UserData,
 		source.UserDataBase64, source.NetworkData, source.NetworkDataBase64)
 	if err != nil {
@@ -399,6 +433,37 @@ func readCloudInitConfigDriveSource(source *v1.CloudInitConfigDriveSource) (*Clo
 	}, nil
 }
 
+// readIgnitionConfigDriveSource reads the Ignition config of the VMI as the user data of the
+// config drive, guests booted on the openstack platform read it from there.
+func readIgnitionConfigDriveSource(vmi *v1.VirtualMachineInstance, source *v1.CloudInitConfigDriveSource) (*CloudInitData, error) {
+	userData, err := ignition.ReadConfig(vmi)
+	if err != nil {
+		return &CloudInitData{}, err
+	}
+
+	networkData, err := readRawOrBase64Data(source.NetworkData, source.NetworkDataBase64)
+	if err != nil {
+		return &CloudInitData{}, err
+	}
+
+	return &CloudInitData{
+		DataSource:  DataSourceConfigDrive,
+		UserData:    userData,
+		NetworkData: networkData,
+	}, nil
+}
+
+func identityMetadataFromVMI(vmi *v1.VirtualMachineInstance) *IdentityMetadata {
+	identity := &IdentityMetadata{
+		Name:      vmi.Name,
+		Namespace: vmi.Namespace,
+	}
+	if owner := metav1.GetControllerOf(vmi); owner != nil && owner.Kind == v1.VirtualMachineGroupVersionKind.Kind {
+		identity.VirtualMachine = owner.Name
+	}
+	return identity
+}
+
 func readCloudInitNoCloudMetaData(hostname, instanceId string, instanceType string, keys map[string]string) *NoCloudMetadata {
 	return &NoCloudMetadata{
 		InstanceType:  instanceType,
@@ -585,6 +650,7 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 			log.Log.V(2).Infof("No metadata found in cloud-init data. Create minimal metadata with instance-id.")
 			data.NoCloudMetaData = &NoCloudMetadata{
 				InstanceID: cloudInitUUIDFromVMI(vmi),
+				KubeVirt:   identityMetadataFromVMI(vmi),
 			}
 			data.NoCloudMetaData.InstanceType = instanceType
 		}
@@ -605,6 +671,7 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 			data.ConfigDriveMetaData = &ConfigDriveMetadata{
 				InstanceID: instanceId,
 				UUID:       cloudInitUUIDFromVMI(vmi),
+				KubeVirt:   identityMetadataFromVMI(vmi),
 			}
 			data.ConfigDriveMetaData.InstanceType = instanceType
 		}
@@ -624,6 +691,17 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		return err
 	}
 
+	if data.GenerateNetworkData && data.NetworkData == "" {
+		var interfacesData []NetworkInterfaceData
+		if data.NetworkInterfaces != nil {
+			interfacesData = *data.NetworkInterfaces
+		}
+		data.NetworkData, err = generateNetworkData(vmi, interfacesData)
+		if err != nil {
+			return fmt.Errorf("failed to generate cloud-init network data: %v", err)
+		}
+	}
+
 	if data.UserData == "" && data.NetworkData == "" {
 		return fmt.Errorf("UserData or NetworkData is required for cloud-init data source")
 	}
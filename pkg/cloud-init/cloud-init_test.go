@@ -191,6 +191,30 @@ var _ = Describe("CloudInit", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(buf)).To(Equal(exampleJSONParsed))
 			})
+			It("should match the generated nocloud metadata with identity", func() {
+				exampleJSONParsed := `{
+  "instance-id": "fake.fake-namespace",
+  "local-hostname": "fake",
+  "kubevirt": {
+    "name": "fake",
+    "namespace": "fake-namespace",
+    "virtualMachine": "fake-vm"
+  }
+}`
+
+				metadataStruct := NoCloudMetadata{
+					InstanceID:    "fake.fake-namespace",
+					LocalHostname: "fake",
+					KubeVirt: &IdentityMetadata{
+						Name:           "fake",
+						Namespace:      "fake-namespace",
+						VirtualMachine: "fake-vm",
+					},
+				}
+				buf, err := json.MarshalIndent(metadataStruct, "", "  ")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(buf)).To(Equal(exampleJSONParsed))
+			})
 			It("should match the generated nocloud metadata", func() {
 				exampleJSONParsed := `{
   "instance-type": "fake.fake-instancetype",
@@ -589,6 +613,82 @@ var _ = Describe("CloudInit", func() {
 		})
 	})
 
+	Describe("ReadCloudInitVolumeDataSource", func() {
+		newVMI := func(volumeSource v1.VolumeSource, ownerReferences ...metav1.OwnerReference) *v1.VirtualMachineInstance {
+			return &v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "fake-domain",
+					Namespace:       "fake-namespace",
+					OwnerReferences: ownerReferences,
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Hostname: "custom-hostname",
+					Volumes:  []v1.Volume{{Name: "cloudinit", VolumeSource: volumeSource}},
+				},
+			}
+		}
+		identityMetadata := func(cloudInitData *CloudInitData) *IdentityMetadata {
+			if cloudInitData.NoCloudMetaData != nil {
+				return cloudInitData.NoCloudMetaData.KubeVirt
+			}
+			return cloudInitData.ConfigDriveMetaData.KubeVirt
+		}
+		noCloud := v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake"}}
+		configDrive := v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: "fake"}}
+
+		DescribeTable("should identify the VMI in the metadata", func(volumeSource v1.VolumeSource) {
+			cloudInitData, err := ReadCloudInitVolumeDataSource(newVMI(volumeSource), tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identityMetadata(cloudInitData)).To(Equal(&IdentityMetadata{Name: "fake-domain", Namespace: "fake-namespace"}))
+		},
+			Entry("with NoCloud", noCloud),
+			Entry("with ConfigDrive", configDrive),
+		)
+
+		DescribeTable("should identify the VM owning the VMI in the metadata", func(volumeSource v1.VolumeSource) {
+			owner := metav1.NewControllerRef(&v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "fake-vm"}}, v1.VirtualMachineGroupVersionKind)
+			cloudInitData, err := ReadCloudInitVolumeDataSource(newVMI(volumeSource, *owner), tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identityMetadata(cloudInitData)).To(Equal(&IdentityMetadata{
+				Name:           "fake-domain",
+				Namespace:      "fake-namespace",
+				VirtualMachine: "fake-vm",
+			}))
+		},
+			Entry("with NoCloud", noCloud),
+			Entry("with ConfigDrive", configDrive),
+		)
+
+		Context("with the Ignition config propagated through the config drive", func() {
+			const ignitionData = `{"ignition":{"version":"3.4.0"}}`
+
+			newIgnitionVMI := func(source *v1.CloudInitConfigDriveSource) *v1.VirtualMachineInstance {
+				vmi := newVMI(v1.VolumeSource{CloudInitConfigDrive: source})
+				vmi.Spec.Ignition = &v1.Ignition{
+					Fragments:   []v1.IgnitionFragment{{Data: ignitionData}},
+					Propagation: v1.IgnitionPropagationConfigDrive,
+				}
+				return vmi
+			}
+
+			It("should use the Ignition config as user data", func() {
+				cloudInitData, err := ReadCloudInitVolumeDataSource(newIgnitionVMI(&v1.CloudInitConfigDriveSource{}), tmpDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cloudInitData.DataSource).To(Equal(DataSourceConfigDrive))
+				Expect(cloudInitData.UserData).To(Equal(ignitionData))
+				Expect(cloudInitData.NetworkData).To(BeEmpty())
+				Expect(cloudInitData.ConfigDriveMetaData).ToNot(BeNil())
+			})
+
+			It("should keep the network data of the volume", func() {
+				cloudInitData, err := ReadCloudInitVolumeDataSource(newIgnitionVMI(&v1.CloudInitConfigDriveSource{NetworkData: "fake"}), tmpDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cloudInitData.UserData).To(Equal(ignitionData))
+				Expect(cloudInitData.NetworkData).To(Equal("fake"))
+			})
+		})
+	})
+
 	Describe("GenerateLocalData", func() {
 		It("should cleanly run twice", func() {
 			instancetype := "fake-instancetype"
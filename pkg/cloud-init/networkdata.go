@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"net"
+
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// NetworkInterfaceData holds the hypervisor view of a guest network interface,
+// keyed by the name of the interface in the VirtualMachineInstance spec.
+type NetworkInterfaceData struct {
+	Name string
+	MAC  string
+	MTU  int
+}
+
+type networkConfigV2 struct {
+	Version   int                         `json:"version"`
+	Ethernets map[string]ethernetConfigV2 `json:"ethernets"`
+}
+
+type ethernetConfigV2 struct {
+	Match ethernetMatchV2 `json:"match"`
+	MTU   int             `json:"mtu,omitempty"`
+	DHCP4 bool            `json:"dhcp4"`
+	DHCP6 bool            `json:"dhcp6"`
+}
+
+type ethernetMatchV2 struct {
+	MACAddress string `json:"macaddress"`
+}
+
+// generateNetworkData renders a version 2 network config out of the interfaces of the VirtualMachineInstance.
+// Interfaces are matched by MAC address, preferring the one reported by the hypervisor over the one requested
+// in the spec, and interfaces without a known MAC address are skipped.
+// DHCPv4 is enabled on all interfaces, as their addresses are either served by the DHCP server of the binding
+// or by the network they are connected to. DHCPv6 is enabled when an IPv6 address is reported for an interface
+// not using the bridge binding, which has no DHCPv6 server.
+func generateNetworkData(vmi *v1.VirtualMachineInstance, interfacesData []NetworkInterfaceData) (string, error) {
+	config := networkConfigV2{
+		Version:   2,
+		Ethernets: map[string]ethernetConfigV2{},
+	}
+
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+
+		ethernet := ethernetConfigV2{
+			Match: ethernetMatchV2{MACAddress: iface.MacAddress},
+			DHCP4: true,
+			DHCP6: iface.Bridge == nil && hasIPv6Address(vmi.Status.Interfaces, iface.Name),
+		}
+		for _, data := range interfacesData {
+			if data.Name != iface.Name {
+				continue
+			}
+			if data.MAC != "" {
+				ethernet.Match.MACAddress = data.MAC
+			}
+			ethernet.MTU = data.MTU
+		}
+		if ethernet.Match.MACAddress == "" {
+			continue
+		}
+
+		config.Ethernets[iface.Name] = ethernet
+	}
+
+	networkData, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(networkData), nil
+}
+
+func hasIPv6Address(interfaceStatuses []v1.VirtualMachineInstanceNetworkInterface, name string) bool {
+	for _, status := range interfaceStatuses {
+		if status.Name != name {
+			continue
+		}
+		for _, ip := range status.IPs {
+			if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Network data generation", func() {
+	const (
+		specMAC       = "02:00:00:00:00:01"
+		hypervisorMAC = "02:00:00:00:00:02"
+	)
+
+	newVMI := func(ifaces ...v1.Interface) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.Interfaces = ifaces
+		return vmi
+	}
+
+	masqueradeIface := func(name, mac string) v1.Interface {
+		return v1.Interface{
+			Name:                   name,
+			MacAddress:             mac,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		}
+	}
+
+	bridgeIface := func(name, mac string) v1.Interface {
+		return v1.Interface{
+			Name:                   name,
+			MacAddress:             mac,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}
+	}
+
+	It("should match the interfaces by the MAC address and MTU reported by the hypervisor", func() {
+		vmi := newVMI(masqueradeIface("default", specMAC), bridgeIface("secondary", ""))
+
+		networkData, err := generateNetworkData(vmi, []NetworkInterfaceData{
+			{Name: "default", MAC: hypervisorMAC, MTU: 1400},
+			{Name: "secondary", MAC: specMAC, MTU: 9000},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(Equal(`ethernets:
+  default:
+    dhcp4: true
+    dhcp6: false
+    match:
+      macaddress: "02:00:00:00:00:02"
+    mtu: 1400
+  secondary:
+    dhcp4: true
+    dhcp6: false
+    match:
+      macaddress: "02:00:00:00:00:01"
+    mtu: 9000
+version: 2
+`))
+	})
+
+	It("should fall back to the MAC address of the spec and skip interfaces without one", func() {
+		vmi := newVMI(masqueradeIface("default", specMAC), bridgeIface("secondary", ""))
+
+		networkData, err := generateNetworkData(vmi, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(Equal(`ethernets:
+  default:
+    dhcp4: true
+    dhcp6: false
+    match:
+      macaddress: "02:00:00:00:00:01"
+version: 2
+`))
+	})
+
+	It("should skip absent interfaces", func() {
+		absentIface := bridgeIface("secondary", specMAC)
+		absentIface.State = v1.InterfaceStateAbsent
+		vmi := newVMI(absentIface)
+
+		networkData, err := generateNetworkData(vmi, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(Equal("ethernets: {}\nversion: 2\n"))
+	})
+
+	DescribeTable("should enable DHCPv6", func(iface v1.Interface, ips []string, expectedDHCP6 bool) {
+		vmi := newVMI(iface)
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: iface.Name, IPs: ips}}
+
+		networkData, err := generateNetworkData(vmi, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if expectedDHCP6 {
+			Expect(networkData).To(ContainSubstring("dhcp6: true"))
+		} else {
+			Expect(networkData).To(ContainSubstring("dhcp6: false"))
+		}
+	},
+		Entry("when an IPv6 address is reported", masqueradeIface("default", specMAC), []string{"10.0.2.2", "fd10:0:2::2"}, true),
+		Entry("not when only an IPv4 address is reported", masqueradeIface("default", specMAC), []string{"10.0.2.2"}, false),
+		Entry("not for the bridge binding", bridgeIface("default", specMAC), []string{"fd10:0:2::2"}, false),
+	)
+
+	It("should request the generation from a NoCloud source without other network data", func() {
+		cloudInitData, err := readCloudInitNoCloudSource(&v1.CloudInitNoCloudSource{GenerateNetworkData: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cloudInitData.GenerateNetworkData).To(BeTrue())
+		Expect(cloudInitData.NetworkData).To(BeEmpty())
+	})
+})
@@ -44,24 +44,24 @@ func GetSysprepDiskPath(volumeName string) string {
 }
 
 func sysprepVolumeHasContents(sysprepVolume *v1.SysprepSource) bool {
-	return sysprepVolume.ConfigMap != nil || sysprepVolume.Secret != nil
+	return sysprepVolume.ConfigMap != nil || sysprepVolume.Secret != nil || len(sysprepVolume.Sources) > 0
 }
 
 // Explained here: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview
-const autounattendFilename = "autounattend.xml"
-const unattendFilename = "unattend.xml"
+const AutounattendFilename = "autounattend.xml"
+const UnattendFilename = "unattend.xml"
 
 func validateUnattendPresence(dirPath string) error {
 	files, err := os.ReadDir(dirPath)
 	if err != nil {
-		return fmt.Errorf("Error validating that %s or %s have been provided: %w", autounattendFilename, unattendFilename, err)
+		return fmt.Errorf("Error validating that %s or %s have been provided: %w", AutounattendFilename, UnattendFilename, err)
 	}
 	for _, file := range files {
-		if f := strings.ToLower(file.Name()); f == autounattendFilename || f == unattendFilename {
+		if f := strings.ToLower(file.Name()); f == AutounattendFilename || f == UnattendFilename {
 			return nil
 		}
 	}
-	return fmt.Errorf("Sysprep drive should contain %s or %s but neither were found.", autounattendFilename, unattendFilename)
+	return fmt.Errorf("Sysprep drive should contain %s or %s but neither were found.", AutounattendFilename, UnattendFilename)
 }
 
 // CreateSysprepDisks creates Sysprep iso disks which are attached to vmis from either ConfigMap or Secret as a source
@@ -25,6 +25,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -67,6 +68,13 @@ var _ = Describe("SysprepConfigMap", func() {
 		libvmi.WithSysprepSecret("sysprep-volume", "secret-config"),
 	)
 
+	vmiSources := libvmi.New(
+		libvmi.WithSysprepSources("sysprep-volume",
+			v1.SysprepProjection{ConfigMap: &k8sv1.LocalObjectReference{Name: "test-config"}},
+			v1.SysprepProjection{Secret: &k8sv1.LocalObjectReference{Name: "secret-config"}},
+		),
+	)
+
 	DescribeTable("Assert successful sysprep ISO creation with CreateSysprepDisks",
 		func(vmi *v1.VirtualMachineInstance, filenames []string) {
 			createFiles(filenames)
@@ -81,6 +89,7 @@ var _ = Describe("SysprepConfigMap", func() {
 		Entry("Should pass when using a secret and finding valid filenames", vmiSecret, []string{"AutounattenD.xml", "UnattenD.xml"}),
 		Entry("Should pass when using a secret and finding only autoattend.xml", vmiSecret, []string{"Autounattend.xml"}),
 		Entry("Should pass when using a secret and finding only unattend.xml", vmiSecret, []string{"Unattend.xml"}),
+		Entry("Should pass when using several sources and finding unattend.xml and scripts", vmiSources, []string{"Unattend.xml", "setup.ps1", "cert.pem"}),
 	)
 
 	DescribeTable("Assert failures when creating sysprep ISO with CreateSysprepDisks",
@@ -93,5 +102,6 @@ var _ = Describe("SysprepConfigMap", func() {
 		Entry("Should fail when using a configMap and finding incorrect filenames", vmiConfigMap, []string{"wrongname.xml", "foobar.xml"}),
 		Entry("Should fail when using a secret and finding no filenames", vmiSecret, []string{}),
 		Entry("Should fail when using a secret and finding incorrect filenames", vmiSecret, []string{"wrongname.xml", "foobar.xml"}),
+		Entry("Should fail when using several sources and finding only scripts", vmiSources, []string{"setup.ps1", "cert.pem"}),
 	)
 })
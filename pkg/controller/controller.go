@@ -58,6 +58,9 @@ const (
 	// SuccessfulCreatePodReason is added in an event when a pod for a vmi controller
 	// is successfully created.
 	SuccessfulCreatePodReason = "SuccessfulCreate"
+	// ClaimedLauncherReservationReason is added in an event when a placeholder pod of a launcher reservation
+	// is deleted to make room for the pod of a vmi.
+	ClaimedLauncherReservationReason = "ClaimedLauncherReservation"
 	// FailedDeletePodReason is added in an event and in a vmi controller condition
 	// when a pod for a vmi controller failed to be deleted.
 	FailedDeletePodReason = "FailedDelete"
@@ -110,6 +113,9 @@ const (
 	// ImagePullBackOffReason is set when an error has occurred while pulling an image for a containerDisk VM volume,
 	// and that kubelet is backing off before retrying.
 	ImagePullBackOffReason = "ImagePullBackOff"
+	// CPUModelNotAvailableReason is set when the pod of a VMI can't be scheduled because the requested CPU model
+	// is obsolete or not allowed in the cluster.
+	CPUModelNotAvailableReason = "CPUModelNotAvailable"
 	// NoSuitableNodesForHostModelMigration is set when a VMI with host-model CPU mode tries to migrate but no node
 	// is suitable for migration (since CPU model / required features are not supported)
 	NoSuitableNodesForHostModelMigration = "NoSuitableNodesForHostModelMigration"
@@ -382,6 +388,38 @@ func VMIActivePodsCount(vmi *v1.VirtualMachineInstance, vmiPodIndexer cache.Inde
 	return running
 }
 
+// IsLauncherPod tells whether the pod is the virt-launcher pod of a VMI
+func IsLauncherPod(pod *k8sv1.Pod) bool {
+	return pod.Labels[v1.AppLabel] == "virt-launcher" && pod.Labels[v1.CreatedByLabel] != ""
+}
+
+// LauncherPodVMIName returns the name of the VMI which created the virt-launcher pod.
+// The name is kept in an annotation, as the owner reference may have been removed.
+func LauncherPodVMIName(pod *k8sv1.Pod) string {
+	if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && controllerRef.Kind == v1.VirtualMachineInstanceGroupVersionKind.Kind {
+		return controllerRef.Name
+	}
+	return pod.Annotations[v1.DomainAnnotation]
+}
+
+// IsOrphanedLauncherPod tells whether the VMI which created the virt-launcher pod is gone.
+// A VMI recreated with the same name does not adopt the pods of its predecessor.
+func IsOrphanedLauncherPod(pod *k8sv1.Pod, vmiStore cache.Store) (bool, error) {
+	if !IsLauncherPod(pod) {
+		return false, nil
+	}
+	name := LauncherPodVMIName(pod)
+	if name == "" {
+		return false, nil
+	}
+
+	obj, exists, err := vmiStore.GetByKey(NamespacedKey(pod.Namespace, name))
+	if err != nil {
+		return false, err
+	}
+	return !exists || obj.(*v1.VirtualMachineInstance).UID != types.UID(pod.Labels[v1.CreatedByLabel]), nil
+}
+
 func GeneratePatchBytes(ops []string) []byte {
 	return []byte(fmt.Sprintf("[%s]", strings.Join(ops, ", ")))
 }
@@ -464,7 +502,8 @@ func vmiHasCondition(vmi *v1.VirtualMachineInstance, conditionType v1.VirtualMac
 }
 
 func VMIHasHotplugCPU(vmi *v1.VirtualMachineInstance) bool {
-	return vmiHasCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
+	vmiConditionManager := NewVirtualMachineInstanceConditionManager()
+	return vmiConditionManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue)
 }
 
 func VMIHasHotplugMemory(vmi *v1.VirtualMachineInstance) bool {
@@ -57,14 +57,20 @@ import (
 	"kubevirt.io/api/core"
 	kubev1 "kubevirt.io/api/core/v1"
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/defaults"
+	defaultsv1alpha1 "kubevirt.io/api/defaults/v1alpha1"
 	exportv1 "kubevirt.io/api/export/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/api/maintenance"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
 	"kubevirt.io/api/migrations"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/api/plugin"
 	pluginv1alpha1 "kubevirt.io/api/plugin/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1beta1"
+	"kubevirt.io/api/quota"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	"kubevirt.io/api/snapshot"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
@@ -157,6 +163,15 @@ type KubeInformerFactory interface {
 	// Watches Plugin objects
 	Plugin() cache.SharedIndexInformer
 
+	// Watches VirtualMachineDefaults objects
+	VirtualMachineDefaults() cache.SharedIndexInformer
+
+	// Watches VirtualMachineQuota objects
+	VirtualMachineQuota() cache.SharedIndexInformer
+
+	// Watches NodeMaintenance objects
+	NodeMaintenance() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -621,6 +636,17 @@ func GetVirtualMachineInformerIndexers() cache.Indexers {
 			}
 			return pvcs, nil
 		},
+		"dependsOn": func(obj interface{}) ([]string, error) {
+			vm, ok := obj.(*kubev1.VirtualMachine)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			var dependencies []string
+			for _, dependency := range vm.Spec.DependsOn {
+				dependencies = append(dependencies, fmt.Sprintf("%s/%s", vm.Namespace, dependency.Name))
+			}
+			return dependencies, nil
+		},
 	}
 }
 
@@ -857,6 +883,27 @@ func (f *kubeInformerFactory) Plugin() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineDefaults() cache.SharedIndexInformer {
+	return f.getInformer("virtualMachineDefaultsInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().DefaultsV1alpha1().RESTClient(), defaults.ResourceVirtualMachineDefaultsPlural, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &defaultsv1alpha1.VirtualMachineDefaults{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineQuota() cache.SharedIndexInformer {
+	return f.getInformer("virtualMachineQuotaInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().QuotaV1alpha1().RESTClient(), quota.ResourceVirtualMachineQuotaPlural, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &quotav1alpha1.VirtualMachineQuota{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) NodeMaintenance() cache.SharedIndexInformer {
+	return f.getInformer("nodeMaintenanceInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MaintenanceV1alpha1().RESTClient(), maintenance.ResourceNodeMaintenancePlural, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &maintenancev1alpha1.NodeMaintenance{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
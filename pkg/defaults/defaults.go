@@ -33,6 +33,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -50,6 +51,7 @@ func setVMDefaultMachineType(vm *v1.VirtualMachine, clusterConfig *virtconfig.Cl
 	}
 
 	if machine := vm.Spec.Template.Spec.Domain.Machine; machine != nil && machine.Type != "" {
+		machine.Type = clusterConfig.ResolveMachineType(vm.Spec.Template.Spec.Architecture, machine.Type)
 		return
 	}
 
@@ -58,7 +60,8 @@ func setVMDefaultMachineType(vm *v1.VirtualMachine, clusterConfig *virtconfig.Cl
 	}
 
 	if vm.Spec.Template.Spec.Domain.Machine.Type == "" {
-		vm.Spec.Template.Spec.Domain.Machine.Type = clusterConfig.GetMachineType(vm.Spec.Template.Spec.Architecture)
+		arch := vm.Spec.Template.Spec.Architecture
+		vm.Spec.Template.Spec.Domain.Machine.Type = clusterConfig.ResolveMachineType(arch, clusterConfig.GetMachineType(arch))
 	}
 }
 
@@ -73,10 +76,7 @@ func SetDefaultVirtualMachineInstance(clusterConfig *virtconfig.ClusterConfig, v
 	setGuestMemoryStatus(vmi)
 	setCurrentCPUTopologyStatus(vmi)
 
-	// Hotplug needs to be enabled on ARM yet
-	if !IsARM64(&vmi.Spec) {
-		setupHotplug(clusterConfig, vmi)
-	}
+	setupHotplug(clusterConfig, vmi)
 
 	return nil
 }
@@ -90,6 +90,11 @@ func setupHotplug(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachin
 }
 
 func setupCPUHotplug(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) {
+	// vCPU hotplug into arm64 guests needs a GICv3 node, so it is only available when requested by maxSockets
+	if IsARM64(&vmi.Spec) {
+		return
+	}
+
 	if vmi.Spec.Domain.CPU.MaxSockets == 0 {
 		maxSockets := clusterConfig.GetMaximumCpuSockets()
 		if vmi.Spec.Domain.CPU.Sockets > maxSockets && maxSockets != 0 {
@@ -191,6 +196,7 @@ func setDefaultHypervFeatureDependencies(spec *v1.VirtualMachineInstanceSpec) {
 }
 
 func SetDefaultVirtualMachineInstanceSpec(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) error {
+	setRealtimeProfile(spec)
 	setDefaultArchitecture(clusterConfig, spec)
 	setDefaultMachineType(clusterConfig, spec)
 	setDefaultResourceRequests(clusterConfig, spec)
@@ -205,10 +211,52 @@ func SetDefaultVirtualMachineInstanceSpec(clusterConfig *virtconfig.ClusterConfi
 	return nil
 }
 
+// setRealtimeProfile expands the requested realtime profile into the individual tunings.
+// Settings explicitly configured on the spec are kept.
+func setRealtimeProfile(spec *v1.VirtualMachineInstanceSpec) {
+	cpu := spec.Domain.CPU
+	if cpu == nil || cpu.Realtime == nil || cpu.Realtime.Profile != v1.RealtimeProfileLowLatency {
+		return
+	}
+
+	// dedicatedCpuPlacement and isolateEmulatorThread are required by the profile, see validateCPURealtime
+	if cpu.NUMA == nil {
+		cpu.NUMA = &v1.NUMA{GuestMappingPassthrough: &v1.NUMAGuestMappingPassthrough{}}
+	}
+	if cpu.Model == "" {
+		cpu.Model = v1.CPUModeHostPassthrough
+	}
+
+	if spec.Domain.Devices.AutoattachMemBalloon == nil {
+		spec.Domain.Devices.AutoattachMemBalloon = pointer.P(false)
+	}
+
+	if spec.Domain.Clock == nil {
+		spec.Domain.Clock = &v1.Clock{}
+	}
+	if spec.Domain.Clock.Timer == nil {
+		spec.Domain.Clock.Timer = &v1.Timer{}
+	}
+	if spec.Domain.Clock.Timer.HPET == nil {
+		spec.Domain.Clock.Timer.HPET = &v1.HPETTimer{Enabled: pointer.P(false)}
+	}
+	if spec.Domain.Clock.Timer.PIT == nil {
+		spec.Domain.Clock.Timer.PIT = &v1.PITTimer{TickPolicy: v1.PITTickPolicyDelay}
+	}
+}
+
 func setDefaultEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
-	if spec.EvictionStrategy == nil {
-		spec.EvictionStrategy = clusterConfig.GetConfig().EvictionStrategy
+	if spec.EvictionStrategy != nil {
+		return
+	}
+	// Evicting VMIs backed by swap would kill them, migrate them instead
+	if spec.Domain.Memory != nil {
+		if class := clusterConfig.GetMemoryOvercommitClass(spec.Domain.Memory.OvercommitClass); class != nil && class.Swap {
+			spec.EvictionStrategy = pointer.P(v1.EvictionStrategyLiveMigrate)
+			return
+		}
 	}
+	spec.EvictionStrategy = clusterConfig.GetConfig().EvictionStrategy
 }
 
 func setDefaultMachineType(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
@@ -222,6 +270,8 @@ func setDefaultMachineType(clusterConfig *virtconfig.ClusterConfig, spec *v1.Vir
 		spec.Domain.Machine = &v1.Machine{Type: machineType}
 	}
 
+	// aliases are replaced with the machine type they stand for, as the VMI keeps it for its whole life
+	spec.Domain.Machine.Type = clusterConfig.ResolveMachineType(spec.Architecture, spec.Domain.Machine.Type)
 }
 
 func setDefaultPullPoliciesOnContainerDisks(spec *v1.VirtualMachineInstanceSpec) {
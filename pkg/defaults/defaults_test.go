@@ -260,4 +260,85 @@ var _ = Describe("Defaults", func() {
 			Entry("ppc64le", "ppc64le", nil, false),
 		)
 	})
+
+	Context("Memory overcommit class", func() {
+		var clusterConfig *virtconfig.ClusterConfig
+
+		BeforeEach(func() {
+			clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				EvictionStrategy: pointer.P(v1.EvictionStrategyNone),
+				MemoryOvercommitConfiguration: &v1.MemoryOvercommitConfiguration{
+					Classes: []v1.MemoryOvercommitClass{
+						{Name: "dense", MemoryOvercommit: 200},
+						{Name: "swap", MemoryOvercommit: 150, Swap: true},
+					},
+				},
+			})
+		})
+
+		DescribeTable("should default the eviction strategy", func(class string, evictionStrategy, expected *v1.EvictionStrategy) {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Memory = &v1.Memory{OvercommitClass: class}
+			vmi.Spec.EvictionStrategy = evictionStrategy
+
+			Expect(defaults.SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec)).To(Succeed())
+			Expect(vmi.Spec.EvictionStrategy).To(Equal(expected))
+		},
+			Entry("to LiveMigrate when the class allows swap", "swap", nil, pointer.P(v1.EvictionStrategyLiveMigrate)),
+			Entry("to the cluster one when the class does not allow swap", "dense", nil, pointer.P(v1.EvictionStrategyNone)),
+			Entry("keeping the one set on the VMI", "swap", pointer.P(v1.EvictionStrategyExternal), pointer.P(v1.EvictionStrategyExternal)),
+		)
+	})
+
+	Context("Realtime profile", func() {
+		var clusterConfig *virtconfig.ClusterConfig
+
+		BeforeEach(func() {
+			clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		})
+
+		It("should expand the lowLatency profile", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.CPU = &v1.CPU{Realtime: &v1.Realtime{Profile: v1.RealtimeProfileLowLatency}}
+
+			Expect(defaults.SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec)).To(Succeed())
+
+			cpu := vmi.Spec.Domain.CPU
+			Expect(cpu.DedicatedCPUPlacement).To(BeFalse())
+			Expect(cpu.IsolateEmulatorThread).To(BeFalse())
+			Expect(cpu.Model).To(Equal(v1.CPUModeHostPassthrough))
+			Expect(cpu.NUMA).ToNot(BeNil())
+			Expect(cpu.NUMA.GuestMappingPassthrough).ToNot(BeNil())
+			Expect(vmi.Spec.Domain.Devices.AutoattachMemBalloon).To(HaveValue(BeFalse()))
+			Expect(vmi.Spec.Domain.Clock.Timer.HPET.Enabled).To(HaveValue(BeFalse()))
+			Expect(vmi.Spec.Domain.Clock.Timer.PIT.TickPolicy).To(Equal(v1.PITTickPolicyDelay))
+		})
+
+		It("should keep explicitly configured settings", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Model:    "Skylake-Server",
+				Realtime: &v1.Realtime{Profile: v1.RealtimeProfileLowLatency},
+			}
+			vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointer.P(true)
+			vmi.Spec.Domain.Clock = &v1.Clock{Timer: &v1.Timer{HPET: &v1.HPETTimer{Enabled: pointer.P(true)}}}
+
+			Expect(defaults.SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec)).To(Succeed())
+
+			Expect(vmi.Spec.Domain.CPU.Model).To(Equal("Skylake-Server"))
+			Expect(vmi.Spec.Domain.Devices.AutoattachMemBalloon).To(HaveValue(BeTrue()))
+			Expect(vmi.Spec.Domain.Clock.Timer.HPET.Enabled).To(HaveValue(BeTrue()))
+			Expect(vmi.Spec.Domain.Clock.Timer.PIT.TickPolicy).To(Equal(v1.PITTickPolicyDelay))
+		})
+
+		It("should not change the spec without a profile", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.CPU = &v1.CPU{Realtime: &v1.Realtime{}}
+
+			Expect(defaults.SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec)).To(Succeed())
+
+			Expect(vmi.Spec.Domain.CPU.DedicatedCPUPlacement).To(BeFalse())
+			Expect(vmi.Spec.Domain.Devices.AutoattachMemBalloon).To(BeNil())
+		})
+	})
 })
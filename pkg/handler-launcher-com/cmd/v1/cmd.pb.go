@@ -64,6 +64,15 @@ It has these top-level messages:
 	AgentMemoryBlocksRequest
 	VMStatsRequest
 	VMStatsResponse
+	GuestFileExistsRequest
+	GuestFileExistsResponse
+	DomainXMLResponse
+	GuestFileRequest
+	GuestFileResponse
+	GuestExecStartRequest
+	GuestExecStartResponse
+	GuestExecStatusRequest
+	GuestExecStatusResponse
 */
 package v1
 
@@ -299,11 +308,17 @@ func (m *Topology) GetNumaCells() []*Cell {
 }
 
 type SMBios struct {
-	Manufacturer string `protobuf:"bytes,1,opt,name=manufacturer" json:"manufacturer,omitempty"`
-	Product      string `protobuf:"bytes,2,opt,name=product" json:"product,omitempty"`
-	Version      string `protobuf:"bytes,3,opt,name=version" json:"version,omitempty"`
-	Sku          string `protobuf:"bytes,4,opt,name=sku" json:"sku,omitempty"`
-	Family       string `protobuf:"bytes,5,opt,name=family" json:"family,omitempty"`
+	Manufacturer          string   `protobuf:"bytes,1,opt,name=manufacturer" json:"manufacturer,omitempty"`
+	Product               string   `protobuf:"bytes,2,opt,name=product" json:"product,omitempty"`
+	Version               string   `protobuf:"bytes,3,opt,name=version" json:"version,omitempty"`
+	Sku                   string   `protobuf:"bytes,4,opt,name=sku" json:"sku,omitempty"`
+	Family                string   `protobuf:"bytes,5,opt,name=family" json:"family,omitempty"`
+	BaseBoardManufacturer string   `protobuf:"bytes,6,opt,name=baseBoardManufacturer" json:"baseBoardManufacturer,omitempty"`
+	BaseBoardProduct      string   `protobuf:"bytes,7,opt,name=baseBoardProduct" json:"baseBoardProduct,omitempty"`
+	BaseBoardVersion      string   `protobuf:"bytes,8,opt,name=baseBoardVersion" json:"baseBoardVersion,omitempty"`
+	BaseBoardSerial       string   `protobuf:"bytes,9,opt,name=baseBoardSerial" json:"baseBoardSerial,omitempty"`
+	BaseBoardAsset        string   `protobuf:"bytes,10,opt,name=baseBoardAsset" json:"baseBoardAsset,omitempty"`
+	OemStrings            []string `protobuf:"bytes,11,rep,name=oemStrings" json:"oemStrings,omitempty"`
 }
 
 func (m *SMBios) Reset()                    { *m = SMBios{} }
@@ -346,6 +361,48 @@ func (m *SMBios) GetFamily() string {
 	return ""
 }
 
+func (m *SMBios) GetBaseBoardManufacturer() string {
+	if m != nil {
+		return m.BaseBoardManufacturer
+	}
+	return ""
+}
+
+func (m *SMBios) GetBaseBoardProduct() string {
+	if m != nil {
+		return m.BaseBoardProduct
+	}
+	return ""
+}
+
+func (m *SMBios) GetBaseBoardVersion() string {
+	if m != nil {
+		return m.BaseBoardVersion
+	}
+	return ""
+}
+
+func (m *SMBios) GetBaseBoardSerial() string {
+	if m != nil {
+		return m.BaseBoardSerial
+	}
+	return ""
+}
+
+func (m *SMBios) GetBaseBoardAsset() string {
+	if m != nil {
+		return m.BaseBoardAsset
+	}
+	return ""
+}
+
+func (m *SMBios) GetOemStrings() []string {
+	if m != nil {
+		return m.OemStrings
+	}
+	return nil
+}
+
 type DiskInfo struct {
 	Format      string `protobuf:"bytes,1,opt,name=format" json:"format,omitempty"`
 	BackingFile string `protobuf:"bytes,2,opt,name=backingFile" json:"backingFile,omitempty"`
@@ -388,12 +445,15 @@ func (m *DiskInfo) GetVirtualSize() uint64 {
 
 type ClusterConfig struct {
 	// Deprecated
-	ExpandDisksEnabled          bool `protobuf:"varint,1,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
-	FreePageReportingDisabled   bool `protobuf:"varint,2,opt,name=FreePageReportingDisabled" json:"FreePageReportingDisabled,omitempty"`
-	BochsDisplayForEFIGuests    bool `protobuf:"varint,3,opt,name=BochsDisplayForEFIGuests" json:"BochsDisplayForEFIGuests,omitempty"`
-	SerialConsoleLogDisabled    bool `protobuf:"varint,4,opt,name=SerialConsoleLogDisabled" json:"SerialConsoleLogDisabled,omitempty"`
-	PCINUMAAwareTopologyEnabled bool `protobuf:"varint,5,opt,name=PCINUMAAwareTopologyEnabled" json:"PCINUMAAwareTopologyEnabled,omitempty"`
-	VGPULiveMigrationEnabled    bool `protobuf:"varint,6,opt,name=VGPULiveMigrationEnabled" json:"VGPULiveMigrationEnabled,omitempty"`
+	ExpandDisksEnabled          bool          `protobuf:"varint,1,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
+	FreePageReportingDisabled   bool          `protobuf:"varint,2,opt,name=FreePageReportingDisabled" json:"FreePageReportingDisabled,omitempty"`
+	BochsDisplayForEFIGuests    bool          `protobuf:"varint,3,opt,name=BochsDisplayForEFIGuests" json:"BochsDisplayForEFIGuests,omitempty"`
+	SerialConsoleLogDisabled    bool          `protobuf:"varint,4,opt,name=SerialConsoleLogDisabled" json:"SerialConsoleLogDisabled,omitempty"`
+	PCINUMAAwareTopologyEnabled bool          `protobuf:"varint,5,opt,name=PCINUMAAwareTopologyEnabled" json:"PCINUMAAwareTopologyEnabled,omitempty"`
+	VGPULiveMigrationEnabled    bool          `protobuf:"varint,6,opt,name=VGPULiveMigrationEnabled" json:"VGPULiveMigrationEnabled,omitempty"`
+	GuestAgentAllowedCommands   []string      `protobuf:"bytes,7,rep,name=GuestAgentAllowedCommands" json:"GuestAgentAllowedCommands,omitempty"`
+	GuestAgentDeniedCommands    []string      `protobuf:"bytes,8,rep,name=GuestAgentDeniedCommands" json:"GuestAgentDeniedCommands,omitempty"`
+	LauncherLogVerbosity        *LogVerbosity `protobuf:"bytes,9,opt,name=LauncherLogVerbosity" json:"LauncherLogVerbosity,omitempty"`
 }
 
 func (m *ClusterConfig) Reset()                    { *m = ClusterConfig{} }
@@ -443,6 +503,27 @@ func (m *ClusterConfig) GetVGPULiveMigrationEnabled() bool {
 	return false
 }
 
+func (m *ClusterConfig) GetGuestAgentAllowedCommands() []string {
+	if m != nil {
+		return m.GuestAgentAllowedCommands
+	}
+	return nil
+}
+
+func (m *ClusterConfig) GetGuestAgentDeniedCommands() []string {
+	if m != nil {
+		return m.GuestAgentDeniedCommands
+	}
+	return nil
+}
+
+func (m *ClusterConfig) GetLauncherLogVerbosity() *LogVerbosity {
+	if m != nil {
+		return m.LauncherLogVerbosity
+	}
+	return nil
+}
+
 type InterfaceBindingMigration struct {
 	Method string `protobuf:"bytes,1,opt,name=Method" json:"Method,omitempty"`
 }
@@ -1524,6 +1605,294 @@ func (m *VMStatsResponse) GetGuestGetMemoryBlocks() *Response {
 	return nil
 }
 
+type LogVerbosity struct {
+	Verbosity uint32 `protobuf:"varint,1,opt,name=verbosity" json:"verbosity,omitempty"`
+}
+
+func (m *LogVerbosity) Reset()                    { *m = LogVerbosity{} }
+func (m *LogVerbosity) String() string            { return proto.CompactTextString(m) }
+func (*LogVerbosity) ProtoMessage()               {}
+func (*LogVerbosity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *LogVerbosity) GetVerbosity() uint32 {
+	if m != nil {
+		return m.Verbosity
+	}
+	return 0
+}
+
+type GuestFileExistsRequest struct {
+	DomainName     string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Path           string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,3,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+}
+
+func (m *GuestFileExistsRequest) Reset()                    { *m = GuestFileExistsRequest{} }
+func (m *GuestFileExistsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileExistsRequest) ProtoMessage()               {}
+func (*GuestFileExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *GuestFileExistsRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileExistsRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileExistsRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type GuestFileExistsResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+}
+
+func (m *GuestFileExistsResponse) Reset()                    { *m = GuestFileExistsResponse{} }
+func (m *GuestFileExistsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileExistsResponse) ProtoMessage()               {}
+func (*GuestFileExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *GuestFileExistsResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+type DomainXMLResponse struct {
+	Response              *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	DomainXML             string    `protobuf:"bytes,2,opt,name=domainXML" json:"domainXML,omitempty"`
+	DomainCapabilitiesXML string    `protobuf:"bytes,3,opt,name=domainCapabilitiesXML" json:"domainCapabilitiesXML,omitempty"`
+}
+
+func (m *DomainXMLResponse) Reset()                    { *m = DomainXMLResponse{} }
+func (m *DomainXMLResponse) String() string            { return proto.CompactTextString(m) }
+func (*DomainXMLResponse) ProtoMessage()               {}
+func (*DomainXMLResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *DomainXMLResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *DomainXMLResponse) GetDomainXML() string {
+	if m != nil {
+		return m.DomainXML
+	}
+	return ""
+}
+
+func (m *DomainXMLResponse) GetDomainCapabilitiesXML() string {
+	if m != nil {
+		return m.DomainCapabilitiesXML
+	}
+	return ""
+}
+
+type GuestFileRequest struct {
+	Vmi      *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Content  []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MaxBytes int64  `protobuf:"varint,4,opt,name=maxBytes" json:"maxBytes,omitempty"`
+}
+
+func (m *GuestFileRequest) Reset()                    { *m = GuestFileRequest{} }
+func (m *GuestFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileRequest) ProtoMessage()               {}
+func (*GuestFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *GuestFileRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *GuestFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *GuestFileRequest) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+type GuestFileResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Content  []byte    `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *GuestFileResponse) Reset()                    { *m = GuestFileResponse{} }
+func (m *GuestFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileResponse) ProtoMessage()               {}
+func (*GuestFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *GuestFileResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type GuestExecStartRequest struct {
+	Vmi     *VMI     `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Command string   `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Args    []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *GuestExecStartRequest) Reset()                    { *m = GuestExecStartRequest{} }
+func (m *GuestExecStartRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStartRequest) ProtoMessage()               {}
+func (*GuestExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *GuestExecStartRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *GuestExecStartRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *GuestExecStartRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type GuestExecStartResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Pid      int64     `protobuf:"varint,2,opt,name=pid" json:"pid,omitempty"`
+}
+
+func (m *GuestExecStartResponse) Reset()                    { *m = GuestExecStartResponse{} }
+func (m *GuestExecStartResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStartResponse) ProtoMessage()               {}
+func (*GuestExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *GuestExecStartResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestExecStartResponse) GetPid() int64 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+type GuestExecStatusRequest struct {
+	Vmi *VMI  `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Pid int64 `protobuf:"varint,2,opt,name=pid" json:"pid,omitempty"`
+}
+
+func (m *GuestExecStatusRequest) Reset()                    { *m = GuestExecStatusRequest{} }
+func (m *GuestExecStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStatusRequest) ProtoMessage()               {}
+func (*GuestExecStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *GuestExecStatusRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *GuestExecStatusRequest) GetPid() int64 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+type GuestExecStatusResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Exited   bool      `protobuf:"varint,2,opt,name=exited" json:"exited,omitempty"`
+	ExitCode int32     `protobuf:"varint,3,opt,name=exitCode" json:"exitCode,omitempty"`
+	Stdout   []byte    `protobuf:"bytes,4,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr   []byte    `protobuf:"bytes,5,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (m *GuestExecStatusResponse) Reset()                    { *m = GuestExecStatusResponse{} }
+func (m *GuestExecStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStatusResponse) ProtoMessage()               {}
+func (*GuestExecStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *GuestExecStatusResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestExecStatusResponse) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *GuestExecStatusResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *GuestExecStatusResponse) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
+}
+
+func (m *GuestExecStatusResponse) GetStderr() []byte {
+	if m != nil {
+		return m.Stderr
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -1579,6 +1948,16 @@ func init() {
 	proto.RegisterType((*AgentMemoryBlocksRequest)(nil), "kubevirt.cmd.v1.AgentMemoryBlocksRequest")
 	proto.RegisterType((*VMStatsRequest)(nil), "kubevirt.cmd.v1.VMStatsRequest")
 	proto.RegisterType((*VMStatsResponse)(nil), "kubevirt.cmd.v1.VMStatsResponse")
+	proto.RegisterType((*LogVerbosity)(nil), "kubevirt.cmd.v1.LogVerbosity")
+	proto.RegisterType((*GuestFileExistsRequest)(nil), "kubevirt.cmd.v1.GuestFileExistsRequest")
+	proto.RegisterType((*GuestFileExistsResponse)(nil), "kubevirt.cmd.v1.GuestFileExistsResponse")
+	proto.RegisterType((*DomainXMLResponse)(nil), "kubevirt.cmd.v1.DomainXMLResponse")
+	proto.RegisterType((*GuestFileRequest)(nil), "kubevirt.cmd.v1.GuestFileRequest")
+	proto.RegisterType((*GuestFileResponse)(nil), "kubevirt.cmd.v1.GuestFileResponse")
+	proto.RegisterType((*GuestExecStartRequest)(nil), "kubevirt.cmd.v1.GuestExecStartRequest")
+	proto.RegisterType((*GuestExecStartResponse)(nil), "kubevirt.cmd.v1.GuestExecStartResponse")
+	proto.RegisterType((*GuestExecStatusRequest)(nil), "kubevirt.cmd.v1.GuestExecStatusRequest")
+	proto.RegisterType((*GuestExecStatusResponse)(nil), "kubevirt.cmd.v1.GuestExecStatusResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1628,6 +2007,16 @@ type CmdClient interface {
 	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
 	RedefineCheckpoint(ctx context.Context, in *RedefineCheckpointRequest, opts ...grpc.CallOption) (*RedefineCheckpointResponse, error)
 	GetVMStats(ctx context.Context, in *VMStatsRequest, opts ...grpc.CallOption) (*VMStatsResponse, error)
+	GuestFileExists(ctx context.Context, in *GuestFileExistsRequest, opts ...grpc.CallOption) (*GuestFileExistsResponse, error)
+	RefreshGuestInfo(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	GetDomainXML(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*DomainXMLResponse, error)
+	SuspendVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	RotateCloudInit(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	EjectCloudInit(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	ReadGuestFile(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error)
+	WriteGuestFile(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*Response, error)
+	GuestExecStart(ctx context.Context, in *GuestExecStartRequest, opts ...grpc.CallOption) (*GuestExecStartResponse, error)
+	GuestExecStatus(ctx context.Context, in *GuestExecStatusRequest, opts ...grpc.CallOption) (*GuestExecStatusResponse, error)
 }
 
 type cmdClient struct {
@@ -1962,6 +2351,96 @@ func (c *cmdClient) GetVMStats(ctx context.Context, in *VMStatsRequest, opts ...
 	return out, nil
 }
 
+func (c *cmdClient) GuestFileExists(ctx context.Context, in *GuestFileExistsRequest, opts ...grpc.CallOption) (*GuestFileExistsResponse, error) {
+	out := new(GuestFileExistsResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileExists", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) RefreshGuestInfo(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/RefreshGuestInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GetDomainXML(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*DomainXMLResponse, error) {
+	out := new(DomainXMLResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetDomainXML", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) SuspendVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SuspendVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) RotateCloudInit(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/RotateCloudInit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) EjectCloudInit(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/EjectCloudInit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) ReadGuestFile(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	out := new(GuestFileResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/ReadGuestFile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) WriteGuestFile(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/WriteGuestFile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestExecStart(ctx context.Context, in *GuestExecStartRequest, opts ...grpc.CallOption) (*GuestExecStartResponse, error) {
+	out := new(GuestExecStartResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestExecStart", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestExecStatus(ctx context.Context, in *GuestExecStatusRequest, opts ...grpc.CallOption) (*GuestExecStatusResponse, error) {
+	out := new(GuestExecStatusResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestExecStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -2001,6 +2480,16 @@ type CmdServer interface {
 	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
 	RedefineCheckpoint(context.Context, *RedefineCheckpointRequest) (*RedefineCheckpointResponse, error)
 	GetVMStats(context.Context, *VMStatsRequest) (*VMStatsResponse, error)
+	GuestFileExists(context.Context, *GuestFileExistsRequest) (*GuestFileExistsResponse, error)
+	RefreshGuestInfo(context.Context, *VMIRequest) (*Response, error)
+	GetDomainXML(context.Context, *VMIRequest) (*DomainXMLResponse, error)
+	SuspendVirtualMachine(context.Context, *VMIRequest) (*Response, error)
+	RotateCloudInit(context.Context, *VMIRequest) (*Response, error)
+	EjectCloudInit(context.Context, *VMIRequest) (*Response, error)
+	ReadGuestFile(context.Context, *GuestFileRequest) (*GuestFileResponse, error)
+	WriteGuestFile(context.Context, *GuestFileRequest) (*Response, error)
+	GuestExecStart(context.Context, *GuestExecStartRequest) (*GuestExecStartResponse, error)
+	GuestExecStatus(context.Context, *GuestExecStatusRequest) (*GuestExecStatusResponse, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -2655,6 +3144,186 @@ func _Cmd_GetVMStats_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GuestFileExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileExists(ctx, req.(*GuestFileExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_RefreshGuestInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).RefreshGuestInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/RefreshGuestInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).RefreshGuestInfo(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GetDomainXML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetDomainXML(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetDomainXML",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetDomainXML(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_SuspendVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SuspendVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SuspendVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SuspendVirtualMachine(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_RotateCloudInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).RotateCloudInit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/RotateCloudInit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).RotateCloudInit(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_EjectCloudInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).EjectCloudInit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/EjectCloudInit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).EjectCloudInit(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_ReadGuestFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).ReadGuestFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/ReadGuestFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).ReadGuestFile(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_WriteGuestFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).WriteGuestFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/WriteGuestFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).WriteGuestFile(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestExecStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestExecStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestExecStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestExecStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestExecStart(ctx, req.(*GuestExecStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestExecStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestExecStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestExecStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestExecStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestExecStatus(ctx, req.(*GuestExecStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2803,6 +3472,46 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVMStats",
 			Handler:    _Cmd_GetVMStats_Handler,
 		},
+		{
+			MethodName: "GuestFileExists",
+			Handler:    _Cmd_GuestFileExists_Handler,
+		},
+		{
+			MethodName: "RefreshGuestInfo",
+			Handler:    _Cmd_RefreshGuestInfo_Handler,
+		},
+		{
+			MethodName: "GetDomainXML",
+			Handler:    _Cmd_GetDomainXML_Handler,
+		},
+		{
+			MethodName: "SuspendVirtualMachine",
+			Handler:    _Cmd_SuspendVirtualMachine_Handler,
+		},
+		{
+			MethodName: "RotateCloudInit",
+			Handler:    _Cmd_RotateCloudInit_Handler,
+		},
+		{
+			MethodName: "EjectCloudInit",
+			Handler:    _Cmd_EjectCloudInit_Handler,
+		},
+		{
+			MethodName: "ReadGuestFile",
+			Handler:    _Cmd_ReadGuestFile_Handler,
+		},
+		{
+			MethodName: "WriteGuestFile",
+			Handler:    _Cmd_WriteGuestFile_Handler,
+		},
+		{
+			MethodName: "GuestExecStart",
+			Handler:    _Cmd_GuestExecStart_Handler,
+		},
+		{
+			MethodName: "GuestExecStatus",
+			Handler:    _Cmd_GuestExecStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2811,171 +3520,204 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xdd, 0x72, 0x1b, 0xb7,
-	0xf5, 0x37, 0x25, 0x4a, 0x26, 0x8f, 0x3e, 0x2c, 0xc3, 0x92, 0xbc, 0x52, 0xfe, 0xb6, 0xf5, 0xdf,
-	0xb6, 0xae, 0xd3, 0x26, 0x72, 0xed, 0x38, 0x99, 0x4e, 0xa6, 0x89, 0x2d, 0x51, 0x94, 0xa2, 0x44,
-	0x94, 0x69, 0x50, 0x92, 0xa7, 0x69, 0x33, 0x99, 0xd5, 0x2e, 0x44, 0x6d, 0xb5, 0xbb, 0x60, 0x16,
-	0x58, 0xc6, 0xca, 0x55, 0x3a, 0xe9, 0xf4, 0xa2, 0x33, 0xbd, 0xef, 0x45, 0x1f, 0xa3, 0xef, 0xd2,
-	0x07, 0xe9, 0x0b, 0x74, 0x80, 0xc5, 0x2e, 0xf7, 0x93, 0xa4, 0x4a, 0x5e, 0x71, 0x71, 0x80, 0xf3,
-	0x3b, 0x07, 0xc0, 0xc1, 0x0f, 0x07, 0x00, 0xe1, 0xfd, 0xde, 0x55, 0xf7, 0xe9, 0xa5, 0xe1, 0x59,
-	0x0e, 0xf1, 0x3f, 0x74, 0x8c, 0xc0, 0x33, 0x2f, 0x89, 0xff, 0xa1, 0x49, 0xdd, 0xa7, 0xa6, 0x6b,
-	0x3d, 0xed, 0x3f, 0x13, 0x3f, 0xdb, 0x3d, 0x9f, 0x72, 0x8a, 0xee, 0x5c, 0x05, 0xe7, 0xa4, 0x6f,
-	0xfb, 0x7c, 0x5b, 0xc8, 0xfa, 0xcf, 0xf4, 0x0b, 0xb8, 0xf7, 0x86, 0xb8, 0xc1, 0x19, 0xf1, 0x99,
-	0x4d, 0x3d, 0x4c, 0x58, 0x8f, 0x7a, 0x8c, 0xa0, 0x8f, 0xa1, 0xe6, 0xab, 0x6f, 0xad, 0xb2, 0x55,
-	0x79, 0xb2, 0xf0, 0x7c, 0x63, 0x3b, 0xa3, 0xba, 0x1d, 0x35, 0xc6, 0x71, 0x53, 0xa4, 0xc1, 0xed,
-	0x7e, 0x88, 0xa4, 0xcd, 0x6c, 0x55, 0x9e, 0xd4, 0x71, 0x54, 0xd4, 0x1f, 0xc1, 0xec, 0x59, 0xeb,
-	0x50, 0x36, 0x70, 0xed, 0x2f, 0x19, 0xf5, 0x24, 0xec, 0x22, 0x8e, 0x8a, 0xfa, 0x33, 0x98, 0x6d,
-	0xb4, 0x4f, 0xd1, 0x32, 0xcc, 0xd8, 0x96, 0xac, 0x5b, 0xc2, 0x33, 0xb6, 0x85, 0x36, 0xa1, 0xc6,
-	0xec, 0x73, 0xc7, 0xf6, 0xba, 0x4c, 0x9b, 0xd9, 0x9a, 0x7d, 0xb2, 0x84, 0xe3, 0xb2, 0xfe, 0x14,
-	0x6e, 0x77, 0xc2, 0xef, 0x9c, 0xda, 0x2a, 0xcc, 0xf5, 0x0d, 0x27, 0x20, 0xd2, 0x8d, 0x2a, 0x0e,
-	0x0b, 0x7a, 0x13, 0xe6, 0xda, 0x46, 0x97, 0x30, 0x51, 0x6d, 0xd2, 0xc0, 0xe3, 0x52, 0xa3, 0x8a,
-	0xc3, 0x02, 0x42, 0x50, 0x0d, 0x3c, 0x9b, 0x2b, 0xd7, 0xe5, 0xb7, 0x90, 0x31, 0xfb, 0x07, 0xa2,
-	0xcd, 0x4a, 0x68, 0xf9, 0xad, 0xbf, 0x80, 0xf9, 0x16, 0x71, 0xa9, 0x7f, 0x8d, 0xd6, 0x61, 0xde,
-	0x70, 0x13, 0x40, 0xaa, 0x54, 0x84, 0xa4, 0xff, 0xbb, 0x02, 0xd5, 0x06, 0x71, 0x9c, 0x9c, 0xaf,
-	0x4f, 0x61, 0xde, 0x95, 0x70, 0xb2, 0xf9, 0xc2, 0xf3, 0xfb, 0xb9, 0x91, 0x0e, 0xad, 0x61, 0xd5,
-	0x0c, 0x7d, 0x00, 0x73, 0x3d, 0xd1, 0x0d, 0x6d, 0x76, 0x6b, 0xf6, 0xc9, 0xc2, 0xf3, 0xf5, 0x5c,
-	0x7b, 0xd9, 0x49, 0x1c, 0x36, 0x42, 0x9f, 0x40, 0xdd, 0xb2, 0x19, 0x37, 0x3c, 0x93, 0x30, 0xad,
-	0x2a, 0x35, 0xb4, 0x9c, 0x86, 0x1a, 0x47, 0x3c, 0x68, 0x8a, 0x9e, 0x40, 0xd5, 0xec, 0x05, 0x4c,
-	0x9b, 0x93, 0x2a, 0xab, 0x39, 0x95, 0x46, 0xfb, 0x14, 0xcb, 0x16, 0xfa, 0x2b, 0xa8, 0x9d, 0xd0,
-	0x1e, 0x75, 0x68, 0xf7, 0x1a, 0xbd, 0x00, 0xf0, 0x02, 0xd7, 0xf8, 0xd6, 0x24, 0x8e, 0xc3, 0xb4,
-	0x8a, 0xd4, 0x5d, 0xcb, 0xeb, 0x12, 0xc7, 0xc1, 0x75, 0xd1, 0x50, 0x7c, 0x31, 0xfd, 0x6f, 0x15,
-	0x98, 0xef, 0xb4, 0x76, 0x6d, 0xca, 0x90, 0x0e, 0x8b, 0xae, 0xe1, 0x05, 0x17, 0x86, 0xc9, 0x03,
-	0x9f, 0xf8, 0x72, 0x9c, 0xea, 0x38, 0x25, 0x13, 0x51, 0xd4, 0xf3, 0xa9, 0x15, 0x98, 0xd1, 0x08,
-	0x47, 0xc5, 0x64, 0x00, 0xce, 0xa6, 0x02, 0x10, 0xad, 0xc0, 0x2c, 0xbb, 0x0a, 0xb4, 0xaa, 0x94,
-	0x8a, 0x4f, 0x31, 0x79, 0x17, 0x86, 0x6b, 0x3b, 0xd7, 0xda, 0x9c, 0x14, 0xaa, 0x92, 0xfe, 0xd7,
-	0x0a, 0xd4, 0xf6, 0x6c, 0x76, 0x75, 0xe8, 0x5d, 0x50, 0xd9, 0x88, 0xfa, 0xae, 0xc1, 0x95, 0x23,
-	0xaa, 0x84, 0xb6, 0x60, 0xe1, 0xdc, 0x30, 0xaf, 0x6c, 0xaf, 0xbb, 0x6f, 0x3b, 0x44, 0xb9, 0x91,
-	0x14, 0xa1, 0x87, 0x00, 0xc2, 0x5f, 0xc3, 0xe9, 0x44, 0xf1, 0x53, 0xc5, 0x09, 0x89, 0x40, 0x10,
-	0x43, 0x12, 0x35, 0xa8, 0xca, 0x06, 0x49, 0x91, 0xfe, 0x9f, 0x19, 0x58, 0x6a, 0x38, 0x01, 0xe3,
-	0xc4, 0x6f, 0x50, 0xef, 0xc2, 0xee, 0xa2, 0x6d, 0x40, 0xcd, 0x77, 0x3d, 0xc3, 0xb3, 0x84, 0x7f,
-	0xac, 0xe9, 0x19, 0xe7, 0x0e, 0x09, 0x43, 0xa9, 0x86, 0x0b, 0x6a, 0xd0, 0xef, 0x60, 0x63, 0xdf,
-	0x27, 0x44, 0xc4, 0x03, 0x26, 0x3d, 0xea, 0x73, 0xdb, 0xeb, 0xee, 0xd9, 0x2c, 0x54, 0x9b, 0x91,
-	0x6a, 0xe5, 0x0d, 0xd0, 0xa7, 0xa0, 0xed, 0x52, 0xf3, 0x92, 0xed, 0xd9, 0xac, 0xe7, 0x18, 0xd7,
-	0xfb, 0xd4, 0x6f, 0xee, 0x1f, 0x1e, 0x04, 0x84, 0x71, 0x26, 0xfb, 0x53, 0xc3, 0xa5, 0xf5, 0x42,
-	0xb7, 0x43, 0x7c, 0xdb, 0x70, 0x1a, 0xd4, 0x63, 0xd4, 0x21, 0x47, 0x74, 0x60, 0xb8, 0x1a, 0xea,
-	0x96, 0xd5, 0xa3, 0x57, 0xf0, 0x5e, 0xbb, 0x71, 0x78, 0x7c, 0xda, 0xda, 0xd9, 0xf9, 0xde, 0xf0,
-	0x49, 0x14, 0x5b, 0x51, 0x77, 0xe7, 0xa4, 0xfa, 0xb0, 0x26, 0xc2, 0xfa, 0xd9, 0x41, 0xfb, 0xf4,
-	0xc8, 0xee, 0x93, 0x96, 0xdd, 0xf5, 0x0d, 0x6e, 0x53, 0x2f, 0x52, 0x9f, 0x0f, 0xad, 0x97, 0xd5,
-	0xeb, 0x1f, 0xc1, 0xc6, 0xa1, 0xc7, 0x89, 0x7f, 0x61, 0x98, 0x64, 0xd7, 0xf6, 0x2c, 0xdb, 0xeb,
-	0xc6, 0x6d, 0x44, 0x38, 0xb4, 0x08, 0xbf, 0xa4, 0x56, 0x14, 0x0e, 0x61, 0x49, 0xff, 0xb1, 0x06,
-	0x6b, 0x67, 0xe1, 0xd4, 0xb5, 0x0c, 0xf3, 0xd2, 0xf6, 0xc8, 0xeb, 0x9e, 0x50, 0x60, 0xe8, 0x2b,
-	0x58, 0x4d, 0x57, 0x84, 0x71, 0xae, 0x58, 0x35, 0xbf, 0xd6, 0xc3, 0x6a, 0x5c, 0xa8, 0x84, 0x5e,
-	0xc0, 0x5a, 0x8b, 0xb8, 0xbb, 0x86, 0xe3, 0x50, 0xea, 0x75, 0xb8, 0xc1, 0x59, 0x9b, 0xf8, 0x36,
-	0x0d, 0xe7, 0x72, 0x09, 0x17, 0x57, 0xa2, 0xdf, 0xc0, 0xbd, 0xb6, 0x4f, 0x84, 0xdc, 0x34, 0x38,
-	0xb1, 0xce, 0xa8, 0x13, 0xb8, 0x8a, 0x3d, 0xea, 0xb8, 0xa8, 0x4a, 0xd0, 0x3f, 0x57, 0x43, 0x2a,
-	0x67, 0xab, 0x88, 0xfe, 0xa3, 0x31, 0xc7, 0x71, 0x53, 0xd4, 0x81, 0xba, 0x0c, 0x3f, 0xb1, 0x72,
-	0x14, 0x6f, 0x7c, 0x9c, 0xd3, 0x2b, 0x1c, 0xa6, 0xed, 0x58, 0xaf, 0xe9, 0x71, 0xff, 0x1a, 0x0f,
-	0x70, 0x4a, 0x62, 0x7e, 0xbe, 0x34, 0xe6, 0xf7, 0x60, 0xc9, 0x4c, 0x2e, 0x1a, 0xed, 0xb6, 0xec,
-	0xc0, 0xc3, 0x3c, 0x09, 0x25, 0x5b, 0xe1, 0xb4, 0x12, 0xfa, 0xa9, 0x02, 0x1b, 0x76, 0x14, 0x06,
-	0x7b, 0xd4, 0x35, 0x6c, 0x6f, 0x87, 0x73, 0xc3, 0xbc, 0x74, 0x89, 0xc7, 0xb5, 0x9a, 0xec, 0x5b,
-	0x73, 0xcc, 0xbe, 0x1d, 0x96, 0xe1, 0x84, 0x7d, 0x2d, 0xb7, 0x83, 0x3c, 0x40, 0x71, 0x65, 0x1c,
-	0x84, 0x5a, 0x5d, 0x5a, 0xff, 0xfc, 0xa6, 0xd6, 0x13, 0x91, 0x2e, 0xcc, 0x16, 0x20, 0x0b, 0x4e,
-	0xea, 0x39, 0x41, 0xd7, 0xf6, 0x98, 0xdc, 0xa2, 0x41, 0x6e, 0xd1, 0x49, 0xd1, 0xe6, 0x5b, 0x58,
-	0x4e, 0x4f, 0x95, 0x20, 0xd6, 0x2b, 0x72, 0xad, 0xd6, 0x83, 0xf8, 0x44, 0x4f, 0x93, 0x9b, 0x6f,
-	0x51, 0xe8, 0x44, 0xec, 0xaa, 0xf6, 0xe5, 0x4f, 0x67, 0x7e, 0x5b, 0xd9, 0x3c, 0x82, 0x87, 0xc3,
-	0xc7, 0xa9, 0xc0, 0x50, 0x6a, 0x97, 0xaf, 0x27, 0xd1, 0xbe, 0x83, 0xfb, 0x25, 0xfd, 0x2e, 0x80,
-	0x79, 0x95, 0xf6, 0xf7, 0x57, 0x39, 0x7f, 0x4b, 0xf9, 0x20, 0x61, 0x52, 0xef, 0x03, 0x9c, 0xb5,
-	0x0e, 0x31, 0xf9, 0x4e, 0x10, 0x20, 0x7a, 0x0c, 0xb3, 0x7d, 0xd7, 0x56, 0xab, 0x3c, 0xbf, 0x79,
-	0x8a, 0x96, 0xa2, 0x01, 0x7a, 0x05, 0xb7, 0x69, 0x38, 0x51, 0xca, 0xfa, 0xe3, 0xf1, 0xa6, 0x15,
-	0x47, 0x6a, 0xfa, 0x09, 0xac, 0x0c, 0xfc, 0xb9, 0xa1, 0x75, 0x2d, 0x6d, 0x7d, 0x71, 0x80, 0xfa,
-	0x53, 0x05, 0x16, 0x9a, 0xef, 0x88, 0x19, 0x21, 0x3e, 0x04, 0xb0, 0xe4, 0xac, 0x1c, 0x1b, 0x2e,
-	0x51, 0x83, 0x97, 0x90, 0x08, 0xa4, 0x06, 0x75, 0x5d, 0xc3, 0xb3, 0xa2, 0x2d, 0x59, 0x15, 0x45,
-	0x2e, 0xb4, 0xe3, 0x77, 0x23, 0xba, 0x91, 0xdf, 0xe8, 0x31, 0x2c, 0x73, 0xdb, 0x25, 0x34, 0xe0,
-	0x1d, 0x62, 0x52, 0xcf, 0x62, 0x92, 0x65, 0xe6, 0x70, 0x46, 0xaa, 0x2f, 0xc3, 0x62, 0xd3, 0xed,
-	0xf1, 0x6b, 0xe5, 0x85, 0xfe, 0x39, 0xd4, 0x70, 0x22, 0xd7, 0x64, 0x81, 0x69, 0x12, 0xc6, 0xd4,
-	0x06, 0x18, 0x15, 0x45, 0x8d, 0x4b, 0x18, 0x33, 0xba, 0x51, 0x60, 0x44, 0x45, 0xfd, 0x5b, 0x58,
-	0x0e, 0x63, 0x6b, 0xd2, 0x44, 0x77, 0x1d, 0xe6, 0xc3, 0xce, 0x2b, 0x0b, 0xaa, 0xa4, 0x7b, 0x70,
-	0x2f, 0x34, 0x20, 0xf9, 0x77, 0x52, 0x2b, 0x5b, 0xb0, 0x60, 0x0d, 0xd0, 0xa2, 0x24, 0x23, 0x21,
-	0xd2, 0xdf, 0xc1, 0x5d, 0xb9, 0xe1, 0xca, 0xd5, 0x34, 0xa1, 0xb5, 0x0f, 0xe0, 0x6e, 0x37, 0x8b,
-	0xa5, 0x6c, 0xe6, 0x2b, 0xf4, 0xbf, 0x54, 0x60, 0x4d, 0x9a, 0x3e, 0x65, 0xc4, 0x3f, 0xb2, 0x19,
-	0x9f, 0xd4, 0xfc, 0x0b, 0x58, 0xeb, 0x16, 0xe1, 0x29, 0x17, 0x8a, 0x2b, 0xf5, 0xbf, 0x57, 0x40,
-	0x93, 0x6e, 0x88, 0x9c, 0x8b, 0x5d, 0x33, 0x4e, 0xdc, 0x89, 0x87, 0xfd, 0x53, 0xd0, 0xba, 0x25,
-	0x90, 0xca, 0x99, 0xd2, 0x7a, 0xfd, 0x1a, 0x16, 0xc3, 0x65, 0x33, 0x99, 0x0b, 0x9b, 0x50, 0x23,
-	0xef, 0x6c, 0xde, 0xa0, 0x56, 0x68, 0x72, 0x0e, 0xc7, 0x65, 0x11, 0x7b, 0x8c, 0x5b, 0xaf, 0x03,
-	0xae, 0x52, 0x5c, 0x55, 0xd2, 0xbf, 0x86, 0x15, 0x39, 0x12, 0x6d, 0x91, 0xc8, 0x8f, 0xb9, 0x6c,
-	0xf3, 0x0b, 0x71, 0xa6, 0x70, 0x21, 0x7e, 0xa9, 0xe2, 0x2c, 0xc4, 0x9e, 0xa8, 0x6f, 0x3a, 0x85,
-	0x25, 0x91, 0x73, 0xfe, 0x40, 0x6e, 0xca, 0x56, 0x9f, 0xc0, 0x7a, 0xe0, 0x5d, 0x48, 0xd5, 0x93,
-	0x22, 0xa7, 0x4b, 0x6a, 0xf5, 0xb7, 0x70, 0x37, 0x3c, 0x41, 0xed, 0x05, 0x6e, 0xef, 0xa6, 0x46,
-	0x37, 0xa1, 0x66, 0x05, 0x6e, 0xaf, 0x6d, 0xf0, 0x4b, 0x35, 0xf9, 0x71, 0x59, 0x3f, 0x87, 0x3b,
-	0x9d, 0xe6, 0xd9, 0x34, 0xd6, 0x9e, 0x20, 0x33, 0xd2, 0x97, 0x79, 0x93, 0x22, 0x62, 0x55, 0xd4,
-	0x7f, 0xac, 0xc0, 0xc6, 0x91, 0x3c, 0xd3, 0xb7, 0x88, 0xc1, 0x02, 0x9f, 0x88, 0x0d, 0x71, 0x0a,
-	0x4b, 0xdd, 0xc9, 0x62, 0x2a, 0xc3, 0xf9, 0x0a, 0xfd, 0x1b, 0x91, 0x11, 0xff, 0x89, 0x98, 0x3c,
-	0xf4, 0xa3, 0x43, 0x4c, 0x9f, 0xf0, 0xe9, 0x6d, 0x35, 0x0c, 0xd6, 0xf7, 0x6c, 0x9f, 0x5f, 0x63,
-	0x83, 0x93, 0xa9, 0xd0, 0xa6, 0x0e, 0x8b, 0x56, 0x04, 0xd8, 0x3a, 0x0f, 0xed, 0xcd, 0xe2, 0x94,
-	0x4c, 0x67, 0x80, 0x3a, 0xa6, 0x4f, 0x88, 0xc7, 0x2e, 0xe9, 0xc4, 0xc3, 0x89, 0xa0, 0xea, 0xda,
-	0x6e, 0x44, 0x0e, 0xf2, 0x5b, 0xc8, 0x2c, 0x83, 0x1b, 0x72, 0x8d, 0x2e, 0x62, 0xf9, 0xad, 0xbf,
-	0x81, 0xa5, 0x5d, 0xc3, 0xbc, 0x0a, 0x7a, 0xd3, 0x1b, 0x3c, 0x13, 0x36, 0x30, 0xb1, 0xc8, 0x85,
-	0xed, 0x91, 0xc6, 0x25, 0x31, 0xaf, 0x7a, 0xd4, 0xf6, 0x6e, 0x3c, 0x37, 0x0f, 0x01, 0xcc, 0x58,
-	0x59, 0x59, 0x48, 0x48, 0xf4, 0x3f, 0x57, 0x60, 0xb3, 0xc8, 0xca, 0xc4, 0x41, 0x38, 0xb0, 0x71,
-	0xe8, 0xf5, 0x0d, 0xc7, 0x8e, 0x0e, 0xa5, 0xf9, 0x0a, 0x7d, 0x15, 0x50, 0x6a, 0x67, 0x0d, 0x13,
-	0x02, 0x04, 0x2b, 0x71, 0xec, 0x24, 0x64, 0x3b, 0x5d, 0xe2, 0xf1, 0x23, 0x6a, 0x58, 0x91, 0x6c,
-	0x1d, 0x56, 0xa5, 0xac, 0xd1, 0x0b, 0x52, 0xfa, 0xf7, 0x61, 0x4d, 0xca, 0x45, 0x46, 0x9a, 0x05,
-	0x96, 0x15, 0x82, 0x4a, 0x22, 0xd9, 0x3d, 0xb8, 0x2b, 0x65, 0x67, 0x66, 0x2f, 0x88, 0x1b, 0x3e,
-	0x80, 0xf7, 0xa4, 0x30, 0x64, 0x98, 0x5d, 0x87, 0x9a, 0x61, 0x6a, 0x9b, 0xd1, 0x11, 0x1b, 0x57,
-	0xac, 0xb3, 0x0a, 0x48, 0x0a, 0x5f, 0xb3, 0xa2, 0xa6, 0x32, 0xbf, 0xce, 0x3a, 0xfe, 0x05, 0x65,
-	0x5c, 0x30, 0x76, 0x56, 0x2e, 0xfc, 0xfb, 0x81, 0x7a, 0xb1, 0x7c, 0x13, 0x34, 0x29, 0x3f, 0x26,
-	0xfc, 0x7b, 0xea, 0x5f, 0x61, 0x1a, 0x0c, 0x06, 0xe6, 0x11, 0x3c, 0x48, 0xd6, 0xc5, 0x59, 0x2d,
-	0xcb, 0x2a, 0x27, 0xfa, 0x12, 0xd7, 0xfd, 0x0b, 0x60, 0xf9, 0xac, 0x95, 0x1c, 0x23, 0xd4, 0x4c,
-	0xa7, 0x27, 0xe1, 0xd4, 0xff, 0x2c, 0x9f, 0xed, 0xe7, 0xa6, 0x2d, 0x95, 0xc3, 0xa0, 0x97, 0x50,
-	0x8f, 0x97, 0xa6, 0x4a, 0x82, 0xff, 0xbf, 0xe0, 0xc8, 0x90, 0x9e, 0x65, 0x3c, 0xd0, 0x41, 0x4d,
-	0x58, 0x94, 0xfb, 0xf1, 0x01, 0x91, 0x73, 0x2e, 0x97, 0x5c, 0x11, 0x46, 0x36, 0x2a, 0x70, 0x4a,
-	0x0d, 0xbd, 0x81, 0x95, 0xa8, 0x1c, 0x85, 0x89, 0x3a, 0xfc, 0xfe, 0xa2, 0x18, 0x2a, 0x13, 0x4c,
-	0x38, 0xa7, 0x8e, 0x4e, 0x54, 0x4a, 0x75, 0x40, 0x06, 0x11, 0x26, 0xef, 0x2f, 0x8a, 0xf2, 0xfc,
-	0xc2, 0x40, 0xc4, 0x79, 0x80, 0x64, 0x7f, 0xc5, 0xf4, 0xcb, 0xb3, 0x70, 0x69, 0x7f, 0x13, 0x01,
-	0x8c, 0x53, 0x6a, 0xe8, 0x0b, 0x58, 0x8a, 0xca, 0x32, 0xa2, 0xd5, 0x41, 0x59, 0x2f, 0xc6, 0x49,
-	0x06, 0x3d, 0x4e, 0x2b, 0xa2, 0x0b, 0xb8, 0x1f, 0x09, 0x32, 0xcb, 0x40, 0xab, 0x49, 0xcc, 0x0f,
-	0x8a, 0x31, 0x8b, 0xd7, 0x0c, 0x2e, 0x03, 0x4b, 0x7a, 0x2c, 0xd7, 0x93, 0x56, 0x1f, 0xe6, 0x71,
-	0x72, 0xc9, 0xe1, 0xb4, 0x22, 0xfa, 0x0a, 0x96, 0x23, 0x41, 0xb8, 0x08, 0xe5, 0x59, 0xb7, 0x28,
-	0x7a, 0xf3, 0x0b, 0x15, 0x67, 0x54, 0x93, 0x6e, 0xc9, 0xb5, 0xab, 0x2d, 0x0c, 0x73, 0x2b, 0xb9,
-	0xbc, 0x71, 0x5a, 0x31, 0x19, 0x82, 0xd1, 0x82, 0xd7, 0x16, 0x87, 0x85, 0x60, 0x86, 0x16, 0x70,
-	0x4e, 0x3d, 0x09, 0x19, 0x71, 0x85, 0xb6, 0x34, 0x0c, 0x32, 0xc3, 0x28, 0x38, 0xa7, 0x8e, 0xbe,
-	0x81, 0x55, 0x29, 0x53, 0x3c, 0x72, 0x40, 0xb8, 0xa4, 0x19, 0x6d, 0x59, 0xc2, 0xbe, 0x5f, 0x0c,
-	0x5b, 0x40, 0x48, 0xb8, 0x10, 0x06, 0x39, 0xb0, 0x91, 0x91, 0x0f, 0x98, 0x4a, 0xbb, 0x23, 0x6d,
-	0x6c, 0x0f, 0xb5, 0x91, 0x23, 0x36, 0x5c, 0x0e, 0x18, 0x77, 0x26, 0x1d, 0x6e, 0x4c, 0x5b, 0x19,
-	0xd6, 0x99, 0x02, 0x82, 0xc4, 0x85, 0x30, 0xfa, 0x3f, 0x00, 0xee, 0xc4, 0xb4, 0x39, 0xd9, 0x7e,
-	0xb9, 0x9f, 0x3f, 0x0d, 0x2e, 0x3c, 0xff, 0xf9, 0x70, 0xba, 0x55, 0x20, 0x29, 0xbe, 0x7d, 0x0d,
-	0xcb, 0x56, 0x2a, 0xdf, 0x52, 0x84, 0xf9, 0xcb, 0x72, 0xd2, 0x4d, 0xa3, 0x65, 0xd4, 0xd1, 0x81,
-	0x62, 0xb9, 0x90, 0x27, 0xd4, 0xf5, 0x7b, 0x75, 0x54, 0xc7, 0xf2, 0x3a, 0xe8, 0xb3, 0x0c, 0x91,
-	0xcf, 0x8d, 0xc2, 0x48, 0x13, 0x78, 0xb3, 0x80, 0xc0, 0xe7, 0x47, 0x41, 0xe4, 0x49, 0xfb, 0xa0,
-	0x88, 0xb4, 0x6f, 0x8f, 0xd7, 0x9d, 0x14, 0x4f, 0x7f, 0x96, 0xe1, 0xe9, 0xda, 0xd8, 0xdd, 0x91,
-	0xfc, 0xfc, 0x32, 0xcb, 0xcf, 0xf5, 0x51, 0xfa, 0x19, 0x5a, 0xee, 0x94, 0xd3, 0x32, 0x8c, 0x82,
-	0x2a, 0xe5, 0xe0, 0x97, 0x59, 0x0e, 0x5e, 0x18, 0xdb, 0xab, 0x90, 0x7a, 0x77, 0x72, 0xd4, 0xbb,
-	0x38, 0x0a, 0x21, 0x4b, 0xb8, 0x2f, 0xb3, 0x84, 0xbb, 0x34, 0xb6, 0x0f, 0x21, 0xcf, 0x36, 0x0b,
-	0x78, 0x76, 0x79, 0xec, 0x48, 0x89, 0xb9, 0xb5, 0x59, 0xc0, 0xad, 0x77, 0xc6, 0x86, 0x89, 0xf9,
-	0xb4, 0x55, 0xc2, 0xa7, 0x2b, 0xa3, 0xa0, 0x8a, 0xf9, 0xf3, 0xed, 0x30, 0xfe, 0xbc, 0x3b, 0x0a,
-	0x73, 0x08, 0x55, 0xb6, 0x4a, 0xa8, 0x12, 0x8d, 0xe7, 0x67, 0x46, 0xed, 0xf9, 0x3f, 0x35, 0x98,
-	0x6d, 0xb8, 0x16, 0x3a, 0x06, 0xd4, 0xb9, 0xf6, 0xcc, 0xf4, 0x35, 0x27, 0x7a, 0xaf, 0xf0, 0xb8,
-	0x12, 0x72, 0xed, 0x66, 0xb9, 0x2d, 0xfd, 0x16, 0x7a, 0x0d, 0xf7, 0xda, 0x46, 0xc0, 0xc8, 0xd4,
-	0x00, 0xdf, 0xc0, 0xda, 0xa9, 0xd7, 0x9b, 0x2a, 0x64, 0x07, 0x56, 0xc3, 0x3b, 0x90, 0x0c, 0x62,
-	0xfe, 0x95, 0x22, 0x75, 0x55, 0x32, 0x1c, 0x14, 0xc3, 0xfa, 0xa9, 0xba, 0x01, 0x99, 0xe6, 0x60,
-	0x62, 0xc2, 0x08, 0x9f, 0x1a, 0xe0, 0x09, 0x68, 0x1d, 0x7a, 0xc1, 0x31, 0x39, 0xa7, 0x74, 0x7a,
-	0xa8, 0x18, 0xd6, 0x3b, 0x97, 0x01, 0xb7, 0xe8, 0xf7, 0xde, 0xd4, 0x30, 0x8f, 0x01, 0x7d, 0x65,
-	0x3b, 0xce, 0xd4, 0xf0, 0xda, 0xb0, 0xba, 0x47, 0x1c, 0xc2, 0xa7, 0x37, 0x39, 0x6f, 0x61, 0x2d,
-	0xbc, 0xfa, 0xcf, 0x42, 0xe6, 0xcf, 0x02, 0xd9, 0x27, 0x82, 0x91, 0xb3, 0x2e, 0x96, 0x64, 0xac,
-	0x74, 0x62, 0xf8, 0x5d, 0xc2, 0x27, 0xf0, 0xf4, 0xf7, 0xf0, 0xa0, 0x61, 0x78, 0x26, 0xc9, 0x8c,
-	0xe6, 0xe0, 0xe5, 0x69, 0xb2, 0xa9, 0xb7, 0xbb, 0x9e, 0xe1, 0x84, 0x4e, 0xb6, 0xa9, 0xd5, 0x70,
-	0x88, 0xe1, 0x05, 0xbd, 0x09, 0x30, 0xff, 0x00, 0x8f, 0xf6, 0x6d, 0xcf, 0x70, 0xec, 0xec, 0x4a,
-	0x9a, 0x86, 0xc3, 0xc7, 0x80, 0xbe, 0xa0, 0xbc, 0xe7, 0x04, 0x5d, 0xb1, 0x91, 0xec, 0x91, 0xbe,
-	0x2d, 0xc8, 0xf5, 0x7f, 0xc7, 0x6b, 0x41, 0x5d, 0x6c, 0x6c, 0x32, 0xc3, 0x43, 0x0f, 0x72, 0x2d,
-	0x93, 0x0f, 0x28, 0x9b, 0x8f, 0x4a, 0xd2, 0xc5, 0x54, 0x50, 0x2d, 0xc7, 0x70, 0x61, 0x1e, 0x33,
-	0x02, 0x73, 0xac, 0x14, 0x54, 0x72, 0xde, 0xe2, 0x01, 0xe1, 0xf1, 0x73, 0xc5, 0x28, 0xd8, 0xfc,
-	0xf1, 0x29, 0xf7, 0xd2, 0x21, 0x41, 0x6b, 0x71, 0x66, 0x31, 0x02, 0xf0, 0x71, 0x31, 0x60, 0xee,
-	0x49, 0xe1, 0x16, 0xfa, 0xa3, 0x1c, 0x82, 0xc4, 0xf5, 0xfe, 0x28, 0xe8, 0xf7, 0x8b, 0xa1, 0x8b,
-	0x1e, 0x08, 0x6e, 0xa1, 0x5d, 0xa8, 0xb6, 0x6d, 0xaf, 0x3b, 0x0a, 0x73, 0xe8, 0x9c, 0x37, 0xa1,
-	0xda, 0x7c, 0x47, 0x4c, 0xf4, 0x7f, 0x79, 0x8c, 0xc1, 0xa3, 0xdd, 0xe6, 0x83, 0x92, 0xda, 0x04,
-	0x19, 0xd7, 0xe3, 0x6b, 0xfd, 0x02, 0xd2, 0xc8, 0x3e, 0x27, 0x94, 0xcd, 0x49, 0xf2, 0x55, 0x40,
-	0xae, 0x1e, 0x2d, 0xb3, 0x6a, 0xe2, 0xdb, 0x77, 0xa4, 0x97, 0xfc, 0xb9, 0x29, 0x71, 0x35, 0x3f,
-	0x8a, 0xf3, 0xc4, 0xdc, 0x24, 0xfe, 0xb3, 0x76, 0xf3, 0xf0, 0x2c, 0xf8, 0xc3, 0x9b, 0xe2, 0x91,
-	0x5c, 0x1a, 0xd2, 0x68, 0x9f, 0xb2, 0x09, 0x37, 0xbb, 0x1c, 0xa6, 0xfa, 0xef, 0xd8, 0x24, 0x7b,
-	0x32, 0x1c, 0x10, 0xae, 0x5e, 0x1e, 0x46, 0x75, 0x7f, 0x2b, 0xff, 0x0f, 0x93, 0xf4, 0x93, 0x85,
-	0x7e, 0x0b, 0x19, 0xb0, 0x2a, 0xce, 0x50, 0xd9, 0x8b, 0xff, 0xe1, 0x2e, 0xe6, 0x9f, 0xc9, 0x4b,
-	0x9f, 0x29, 0xf4, 0x5b, 0xe8, 0x1b, 0x40, 0xf9, 0x37, 0x04, 0x54, 0xf4, 0xd4, 0x5e, 0xf2, 0xd0,
-	0x30, 0x7c, 0x48, 0x4c, 0xb8, 0x1f, 0x93, 0x56, 0xfa, 0xd4, 0x3a, 0x6a, 0x7c, 0xc6, 0x3d, 0xf5,
-	0x4a, 0xae, 0x59, 0x12, 0xe3, 0x1e, 0x3f, 0x1b, 0x0c, 0x1f, 0x9f, 0xfc, 0x55, 0x52, 0xfe, 0xc1,
-	0x21, 0xcc, 0x04, 0xc3, 0x37, 0x81, 0x91, 0x99, 0x60, 0xea, 0xe9, 0x60, 0xf8, 0x70, 0x50, 0x40,
-	0xf9, 0xfb, 0xfa, 0x82, 0xd1, 0x2e, 0x7d, 0x3a, 0xd8, 0xfc, 0xf5, 0x58, 0x6d, 0x13, 0x29, 0xb2,
-	0x08, 0x49, 0x75, 0xd1, 0x81, 0x1e, 0x15, 0x8c, 0x4b, 0xf2, 0x52, 0xb3, 0x20, 0x28, 0x33, 0x77,
-	0x24, 0xfa, 0xad, 0xdd, 0xea, 0xd7, 0x33, 0xfd, 0x67, 0xe7, 0xf3, 0xf2, 0x7f, 0xab, 0x1f, 0xfd,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x0c, 0xfa, 0x94, 0xe4, 0x2a, 0x00, 0x00,
+	// 3171 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0xdd, 0x72, 0x1b, 0xb7,
+	0x15, 0x36, 0x45, 0x4a, 0x16, 0x8f, 0xfe, 0x61, 0x49, 0x5e, 0x33, 0xb1, 0xad, 0x6e, 0x5b, 0x47,
+	0x49, 0x1d, 0xbb, 0x56, 0x9c, 0x4c, 0x27, 0xd3, 0xc4, 0x96, 0x28, 0x5a, 0x56, 0x22, 0xca, 0x34,
+	0x68, 0xc9, 0x6d, 0xda, 0x4c, 0x66, 0xb5, 0x0b, 0x51, 0x5b, 0xed, 0x2e, 0x98, 0x05, 0x56, 0xb6,
+	0x7c, 0x95, 0x4e, 0x3a, 0xbd, 0xe8, 0x4c, 0xef, 0x7b, 0xd5, 0xa7, 0xc8, 0x03, 0xf4, 0x2d, 0xfa,
+	0x2e, 0xbd, 0xea, 0x00, 0x8b, 0x5d, 0xee, 0x2f, 0x29, 0x85, 0xba, 0xd2, 0xe2, 0x00, 0xe7, 0x3b,
+	0xf8, 0x39, 0xf8, 0x70, 0x70, 0x40, 0xc1, 0x87, 0xfd, 0xd3, 0xde, 0xc3, 0x13, 0xc3, 0xb3, 0x1c,
+	0xe2, 0x7f, 0xec, 0x18, 0x81, 0x67, 0x9e, 0x10, 0xff, 0x63, 0x93, 0xba, 0x0f, 0x4d, 0xd7, 0x7a,
+	0x78, 0xf6, 0x48, 0xfc, 0x79, 0xd0, 0xf7, 0x29, 0xa7, 0x68, 0xe1, 0x34, 0x38, 0x22, 0x67, 0xb6,
+	0xcf, 0x1f, 0x08, 0xd9, 0xd9, 0x23, 0xfd, 0x18, 0x6e, 0xbc, 0x24, 0x6e, 0x70, 0x48, 0x7c, 0x66,
+	0x53, 0x0f, 0x13, 0xd6, 0xa7, 0x1e, 0x23, 0xe8, 0x53, 0x98, 0xf6, 0xd5, 0xb7, 0x56, 0x59, 0xab,
+	0xac, 0xcf, 0x6c, 0xdc, 0x7a, 0x90, 0x51, 0x7d, 0x10, 0x35, 0xc6, 0x71, 0x53, 0xa4, 0xc1, 0xf5,
+	0xb3, 0x10, 0x49, 0x9b, 0x58, 0xab, 0xac, 0xd7, 0x71, 0x54, 0xd4, 0xef, 0x42, 0xf5, 0xb0, 0xbd,
+	0x2b, 0x1b, 0xb8, 0xf6, 0x57, 0x8c, 0x7a, 0x12, 0x76, 0x16, 0x47, 0x45, 0xfd, 0x11, 0x54, 0x9b,
+	0x9d, 0x03, 0x34, 0x0f, 0x13, 0xb6, 0x25, 0xeb, 0xe6, 0xf0, 0x84, 0x6d, 0xa1, 0x06, 0x4c, 0x33,
+	0xfb, 0xc8, 0xb1, 0xbd, 0x1e, 0xd3, 0x26, 0xd6, 0xaa, 0xeb, 0x73, 0x38, 0x2e, 0xeb, 0x0f, 0xe1,
+	0x7a, 0x37, 0xfc, 0xce, 0xa9, 0x2d, 0xc3, 0xe4, 0x99, 0xe1, 0x04, 0x44, 0x76, 0xa3, 0x86, 0xc3,
+	0x82, 0xde, 0x82, 0xc9, 0x8e, 0xd1, 0x23, 0x4c, 0x54, 0x9b, 0x34, 0xf0, 0xb8, 0xd4, 0xa8, 0xe1,
+	0xb0, 0x80, 0x10, 0xd4, 0x02, 0xcf, 0xe6, 0xaa, 0xeb, 0xf2, 0x5b, 0xc8, 0x98, 0xfd, 0x8e, 0x68,
+	0x55, 0x09, 0x2d, 0xbf, 0xf5, 0xc7, 0x30, 0xd5, 0x26, 0x2e, 0xf5, 0xcf, 0xd1, 0x2a, 0x4c, 0x19,
+	0x6e, 0x02, 0x48, 0x95, 0x8a, 0x90, 0xf4, 0xff, 0x56, 0xa0, 0xd6, 0x24, 0x8e, 0x93, 0xeb, 0xeb,
+	0x43, 0x98, 0x72, 0x25, 0x9c, 0x6c, 0x3e, 0xb3, 0x71, 0x33, 0x37, 0xd3, 0xa1, 0x35, 0xac, 0x9a,
+	0xa1, 0xfb, 0x30, 0xd9, 0x17, 0xc3, 0xd0, 0xaa, 0x6b, 0xd5, 0xf5, 0x99, 0x8d, 0xd5, 0x5c, 0x7b,
+	0x39, 0x48, 0x1c, 0x36, 0x42, 0x9f, 0x41, 0xdd, 0xb2, 0x19, 0x37, 0x3c, 0x93, 0x30, 0xad, 0x26,
+	0x35, 0xb4, 0x9c, 0x86, 0x9a, 0x47, 0x3c, 0x68, 0x8a, 0xd6, 0xa1, 0x66, 0xf6, 0x03, 0xa6, 0x4d,
+	0x4a, 0x95, 0xe5, 0x9c, 0x4a, 0xb3, 0x73, 0x80, 0x65, 0x0b, 0xfd, 0x29, 0x4c, 0xbf, 0xa2, 0x7d,
+	0xea, 0xd0, 0xde, 0x39, 0x7a, 0x0c, 0xe0, 0x05, 0xae, 0xf1, 0x9d, 0x49, 0x1c, 0x87, 0x69, 0x15,
+	0xa9, 0xbb, 0x92, 0xd7, 0x25, 0x8e, 0x83, 0xeb, 0xa2, 0xa1, 0xf8, 0x62, 0xfa, 0x3f, 0xaa, 0x30,
+	0xd5, 0x6d, 0x6f, 0xd9, 0x94, 0x21, 0x1d, 0x66, 0x5d, 0xc3, 0x0b, 0x8e, 0x0d, 0x93, 0x07, 0x3e,
+	0xf1, 0xe5, 0x3c, 0xd5, 0x71, 0x4a, 0x26, 0xbc, 0xa8, 0xef, 0x53, 0x2b, 0x30, 0xa3, 0x19, 0x8e,
+	0x8a, 0x49, 0x07, 0xac, 0xa6, 0x1c, 0x10, 0x2d, 0x42, 0x95, 0x9d, 0x06, 0x5a, 0x4d, 0x4a, 0xc5,
+	0xa7, 0x58, 0xbc, 0x63, 0xc3, 0xb5, 0x9d, 0x73, 0x6d, 0x52, 0x0a, 0x55, 0x09, 0x3d, 0x86, 0x95,
+	0x23, 0x83, 0x91, 0x2d, 0x6a, 0xf8, 0x56, 0x3b, 0xd9, 0x95, 0x29, 0xd9, 0xac, 0xb8, 0x12, 0x7d,
+	0x04, 0x8b, 0x71, 0x45, 0x47, 0x75, 0xee, 0xba, 0x54, 0xc8, 0xc9, 0x53, 0x6d, 0xd5, 0xce, 0xd3,
+	0xa6, 0x33, 0x6d, 0x95, 0x1c, 0xad, 0xc3, 0x42, 0x2c, 0xeb, 0x12, 0xdf, 0x36, 0x1c, 0xad, 0x2e,
+	0x9b, 0x66, 0xc5, 0xe8, 0x1e, 0xcc, 0xc7, 0xa2, 0x4d, 0xc6, 0x08, 0xd7, 0x40, 0x36, 0xcc, 0x48,
+	0xd1, 0x1d, 0x00, 0x4a, 0xdc, 0x2e, 0xf7, 0xe5, 0xa6, 0x9a, 0x59, 0xab, 0xae, 0xd7, 0x71, 0x42,
+	0xa2, 0xff, 0xbd, 0x02, 0xd3, 0xdb, 0x36, 0x3b, 0xdd, 0xf5, 0x8e, 0xa9, 0x9c, 0x24, 0xea, 0xbb,
+	0x06, 0x57, 0x0b, 0xa1, 0x4a, 0x68, 0x0d, 0x66, 0x8e, 0x0c, 0xf3, 0xd4, 0xf6, 0x7a, 0xcf, 0x6c,
+	0x87, 0xa8, 0x65, 0x48, 0x8a, 0x84, 0x19, 0x31, 0x37, 0x86, 0xd3, 0x8d, 0xf6, 0x4f, 0x0d, 0x27,
+	0x24, 0x02, 0x41, 0xb8, 0x44, 0xd4, 0xa0, 0x26, 0x1b, 0x24, 0x45, 0xfa, 0x7f, 0x6a, 0x30, 0xd7,
+	0x74, 0x02, 0xc6, 0x89, 0xdf, 0xa4, 0xde, 0xb1, 0xdd, 0x43, 0x0f, 0x00, 0xb5, 0xde, 0xf6, 0x0d,
+	0xcf, 0x12, 0xfd, 0x63, 0x2d, 0xcf, 0x38, 0x72, 0x48, 0xb8, 0x95, 0xa6, 0x71, 0x41, 0x0d, 0xfa,
+	0x3d, 0xdc, 0x7a, 0xe6, 0x13, 0x22, 0xf6, 0x03, 0x26, 0x7d, 0xea, 0x73, 0xdb, 0xeb, 0x6d, 0xdb,
+	0x2c, 0x54, 0x9b, 0x90, 0x6a, 0xe5, 0x0d, 0xd0, 0xe7, 0xa0, 0x6d, 0x51, 0xf3, 0x84, 0x6d, 0xdb,
+	0xac, 0xef, 0x18, 0xe7, 0xcf, 0xa8, 0xdf, 0x7a, 0xb6, 0xbb, 0x13, 0x10, 0xc6, 0x99, 0x1c, 0xcf,
+	0x34, 0x2e, 0xad, 0x17, 0xba, 0xe1, 0xb2, 0x34, 0xa9, 0xc7, 0xa8, 0x43, 0xf6, 0xe8, 0xc0, 0x70,
+	0x2d, 0xd4, 0x2d, 0xab, 0x47, 0x4f, 0xe1, 0xbd, 0x4e, 0x73, 0x77, 0xff, 0xa0, 0xbd, 0xb9, 0xf9,
+	0xc6, 0xf0, 0x49, 0xb4, 0xb7, 0xa2, 0xe1, 0x4e, 0x4a, 0xf5, 0x61, 0x4d, 0x84, 0xf5, 0xc3, 0x9d,
+	0xce, 0xc1, 0x9e, 0x7d, 0x46, 0xda, 0x76, 0xcf, 0x37, 0xb8, 0x4d, 0xbd, 0x48, 0x7d, 0x2a, 0xb4,
+	0x5e, 0x56, 0x8f, 0x5e, 0xc2, 0xf2, 0x9e, 0x3a, 0x43, 0xf6, 0x68, 0xef, 0x90, 0xf8, 0x47, 0x94,
+	0xd9, 0xfc, 0x5c, 0x7a, 0xdd, 0xcc, 0xc6, 0xed, 0xdc, 0x5e, 0x4e, 0x36, 0xc2, 0x85, 0xaa, 0x62,
+	0x19, 0xe4, 0xb4, 0x6c, 0xf6, 0x88, 0xc7, 0x37, 0x1d, 0x87, 0xbe, 0x21, 0x56, 0x93, 0xba, 0xae,
+	0xe1, 0x59, 0x4c, 0xbb, 0x2e, 0x1d, 0xb0, 0xbc, 0x81, 0x18, 0xcc, 0xa0, 0x72, 0x9b, 0x78, 0x76,
+	0x42, 0x79, 0x5a, 0x2a, 0x97, 0xd6, 0xeb, 0x9f, 0xc0, 0xad, 0x5d, 0x8f, 0x13, 0xff, 0xd8, 0x30,
+	0xc9, 0x96, 0xed, 0x59, 0xb6, 0xd7, 0x8b, 0x07, 0x2c, 0x7c, 0xbb, 0x4d, 0xf8, 0x09, 0xb5, 0x22,
+	0xdf, 0x0e, 0x4b, 0xfa, 0x0f, 0xd3, 0xb0, 0x72, 0x18, 0xfa, 0x61, 0xdb, 0x30, 0x4f, 0x6c, 0x8f,
+	0xbc, 0xe8, 0x0b, 0x05, 0x86, 0xbe, 0x86, 0xe5, 0x74, 0x45, 0x48, 0x5a, 0x5a, 0xa5, 0x84, 0xb8,
+	0xc3, 0x6a, 0x5c, 0xa8, 0x24, 0x78, 0xa6, 0x4d, 0xdc, 0x2d, 0xc3, 0x71, 0x28, 0xf5, 0xba, 0xdc,
+	0xe0, 0xac, 0x43, 0x7c, 0x9b, 0x86, 0x8e, 0x39, 0x87, 0x8b, 0x2b, 0xd1, 0x6f, 0xe1, 0x46, 0xc7,
+	0x27, 0x42, 0x6e, 0x1a, 0x9c, 0x58, 0x87, 0xd4, 0x09, 0x5c, 0x75, 0x14, 0xd4, 0x71, 0x51, 0x95,
+	0x38, 0xcb, 0xb9, 0xf2, 0x0f, 0xad, 0x56, 0x72, 0x96, 0x47, 0x0e, 0x84, 0xe3, 0xa6, 0xa8, 0x0b,
+	0x75, 0xb9, 0x97, 0x04, 0x0d, 0xa8, 0x43, 0xe0, 0xd3, 0x9c, 0x5e, 0xe1, 0x34, 0x3d, 0x88, 0xf5,
+	0x5a, 0x1e, 0xf7, 0xcf, 0xf1, 0x00, 0xa7, 0x64, 0x03, 0x4f, 0x95, 0x6e, 0xe0, 0x6d, 0x98, 0x33,
+	0x93, 0x0c, 0x20, 0x29, 0x75, 0x66, 0xe3, 0x4e, 0xfe, 0x44, 0x49, 0xb6, 0xc2, 0x69, 0x25, 0xf4,
+	0x63, 0x05, 0x6e, 0xd9, 0x91, 0x1b, 0x6c, 0x53, 0xd7, 0xb0, 0xbd, 0x4d, 0xce, 0x0d, 0xf3, 0xc4,
+	0x25, 0x1e, 0x97, 0x3e, 0x34, 0xb3, 0xd1, 0xba, 0xe0, 0xd8, 0x76, 0xcb, 0x70, 0xc2, 0xb1, 0x96,
+	0xdb, 0x41, 0x1e, 0xa0, 0xb8, 0x32, 0x76, 0x42, 0xad, 0x2e, 0xad, 0x7f, 0x79, 0x59, 0xeb, 0x89,
+	0x6d, 0x2b, 0xcc, 0x16, 0x20, 0x0b, 0x82, 0xed, 0x3b, 0x41, 0xcf, 0xf6, 0x98, 0x8c, 0xb7, 0x40,
+	0xc6, 0x5b, 0x49, 0x51, 0xe3, 0x35, 0xcc, 0xa7, 0x97, 0x4a, 0x9c, 0x92, 0xa7, 0xe4, 0x5c, 0xed,
+	0x07, 0xf1, 0x89, 0x1e, 0x26, 0x23, 0xa9, 0x22, 0xd7, 0x89, 0x8e, 0x0a, 0x15, 0x64, 0x7d, 0x3e,
+	0xf1, 0xbb, 0x4a, 0x63, 0x0f, 0xee, 0x0c, 0x9f, 0xa7, 0x02, 0x43, 0xa9, 0x90, 0xad, 0x9e, 0x44,
+	0xfb, 0x1e, 0x6e, 0x96, 0x8c, 0xbb, 0x00, 0xe6, 0x69, 0xba, 0xbf, 0x1f, 0xe5, 0xfa, 0x5b, 0xca,
+	0x07, 0x09, 0x93, 0xfa, 0x19, 0xc0, 0x61, 0x7b, 0x17, 0x93, 0xef, 0x03, 0xc2, 0x38, 0xba, 0x07,
+	0xd5, 0x33, 0xd7, 0x56, 0xbb, 0x3c, 0x1f, 0x09, 0x89, 0x96, 0xa2, 0x01, 0x7a, 0x0a, 0xd7, 0x69,
+	0xb8, 0x50, 0xca, 0xfa, 0xbd, 0x8b, 0x2d, 0x2b, 0x8e, 0xd4, 0xf4, 0x57, 0xb0, 0x38, 0xe8, 0xcf,
+	0x25, 0xad, 0x6b, 0x69, 0xeb, 0xb3, 0x03, 0xd4, 0x1f, 0x2b, 0x30, 0xd3, 0x7a, 0x4b, 0xcc, 0x08,
+	0xf1, 0x0e, 0x80, 0x25, 0x57, 0x65, 0xdf, 0x70, 0x89, 0x9a, 0xbc, 0x84, 0x44, 0x20, 0x29, 0x06,
+	0x8d, 0xe2, 0x2b, 0x55, 0x14, 0x81, 0xed, 0xa6, 0xdf, 0x8b, 0xe8, 0x46, 0x7e, 0x8b, 0xb8, 0x83,
+	0xdb, 0x2e, 0xa1, 0x01, 0xef, 0x12, 0x93, 0x0a, 0x56, 0x16, 0x2c, 0x33, 0x89, 0x33, 0x52, 0x7d,
+	0x1e, 0x66, 0x5b, 0x6e, 0x9f, 0x9f, 0xab, 0x5e, 0xe8, 0x5f, 0xc2, 0x34, 0x4e, 0x5c, 0x1c, 0x58,
+	0x60, 0x9a, 0x84, 0x31, 0x75, 0x9a, 0x47, 0x45, 0x51, 0xe3, 0x12, 0xc6, 0x8c, 0x5e, 0xe4, 0x18,
+	0x51, 0x51, 0xff, 0x0e, 0xe6, 0x43, 0xdf, 0x1a, 0xf7, 0xd6, 0xb2, 0x0a, 0x53, 0xe1, 0xe0, 0x95,
+	0x05, 0x55, 0xd2, 0x3d, 0xb8, 0x11, 0x1a, 0x90, 0xfc, 0x3b, 0xae, 0x95, 0x35, 0x98, 0xb1, 0x06,
+	0x68, 0x51, 0xc4, 0x94, 0x10, 0xe9, 0x6f, 0x61, 0x49, 0x1e, 0x64, 0x72, 0x37, 0x8d, 0x69, 0xed,
+	0x3e, 0x2c, 0xf5, 0xb2, 0x58, 0xca, 0x66, 0xbe, 0x42, 0xff, 0x5b, 0x05, 0x56, 0xa4, 0xe9, 0x03,
+	0x46, 0xfc, 0x3d, 0x9b, 0xf1, 0x71, 0xcd, 0x3f, 0x86, 0x95, 0x5e, 0x11, 0x9e, 0xea, 0x42, 0x71,
+	0xa5, 0xfe, 0xcf, 0x8a, 0x3a, 0xea, 0x45, 0x00, 0xc9, 0xce, 0x19, 0x27, 0xee, 0xd8, 0xd3, 0xfe,
+	0x39, 0x68, 0xbd, 0x12, 0x48, 0xd5, 0x99, 0xd2, 0x7a, 0xfd, 0x1c, 0x66, 0xc3, 0x6d, 0x33, 0x5e,
+	0x17, 0x1a, 0x30, 0x4d, 0xde, 0xda, 0xbc, 0x49, 0xad, 0xd0, 0xe4, 0x24, 0x8e, 0xcb, 0xc2, 0xf7,
+	0x18, 0xb7, 0x5e, 0x04, 0x5c, 0xdd, 0x57, 0x54, 0x49, 0xff, 0x06, 0x16, 0xe5, 0x4c, 0x74, 0xc4,
+	0xad, 0xec, 0x82, 0xdb, 0x36, 0xbf, 0x11, 0x27, 0x0a, 0x37, 0xe2, 0x57, 0xb0, 0x94, 0xc0, 0x1e,
+	0x6b, 0x6c, 0x3a, 0x85, 0x39, 0x11, 0x40, 0xbf, 0x23, 0x97, 0x65, 0xab, 0xcf, 0x60, 0x35, 0xf0,
+	0x8e, 0xa5, 0xea, 0xab, 0xa2, 0x4e, 0x97, 0xd4, 0xea, 0xaf, 0x61, 0x29, 0xbc, 0x0e, 0x6f, 0x07,
+	0x6e, 0xff, 0xb2, 0x46, 0x1b, 0x30, 0x6d, 0x05, 0x6e, 0xbf, 0x63, 0xf0, 0x13, 0xb5, 0xf8, 0x71,
+	0x59, 0x3f, 0x82, 0x85, 0x6e, 0xeb, 0xf0, 0x2a, 0xf6, 0x9e, 0x20, 0x33, 0x72, 0x26, 0xe3, 0x26,
+	0x45, 0xc4, 0xaa, 0xa8, 0xff, 0x50, 0x81, 0x5b, 0x61, 0x84, 0xdc, 0x26, 0x06, 0x0b, 0x7c, 0x22,
+	0x0e, 0xc4, 0x2b, 0xd8, 0xea, 0x4e, 0x16, 0x53, 0x19, 0xce, 0x57, 0xe8, 0xdf, 0x8a, 0x88, 0xf8,
+	0x2f, 0xc4, 0xe4, 0x61, 0x3f, 0xba, 0xc4, 0xf4, 0x09, 0xbf, 0xba, 0xa3, 0x86, 0xc1, 0xea, 0xb6,
+	0xed, 0xf3, 0x73, 0x6c, 0x70, 0x72, 0x25, 0xb4, 0xa9, 0xc3, 0xac, 0x15, 0x01, 0xb6, 0x8f, 0x42,
+	0x7b, 0x55, 0x9c, 0x92, 0xe9, 0x0c, 0x50, 0xd7, 0xf4, 0x09, 0xf1, 0xd8, 0x09, 0x1d, 0x7b, 0x3a,
+	0x11, 0xd4, 0x5c, 0xdb, 0x8d, 0xc8, 0x41, 0x7e, 0x0b, 0x99, 0x65, 0x70, 0x43, 0xee, 0xd1, 0x59,
+	0x2c, 0xbf, 0xf5, 0x97, 0x30, 0xb7, 0x65, 0x98, 0xa7, 0x41, 0xff, 0xea, 0x26, 0xcf, 0x84, 0x5b,
+	0x98, 0x58, 0xe4, 0xd8, 0xf6, 0x48, 0xf3, 0x84, 0x98, 0xa7, 0x7d, 0x6a, 0x7b, 0x97, 0x5e, 0x9b,
+	0x3b, 0x00, 0x66, 0xac, 0xac, 0x2c, 0x24, 0x24, 0xfa, 0x5f, 0x2b, 0xd0, 0x28, 0xb2, 0x32, 0xb6,
+	0x13, 0x0e, 0x6c, 0xec, 0x7a, 0x67, 0x86, 0x63, 0x47, 0x37, 0xec, 0x7c, 0x85, 0xbe, 0x0c, 0x28,
+	0x75, 0xb2, 0x86, 0x01, 0x01, 0x82, 0xc5, 0xd8, 0x77, 0x12, 0x32, 0x79, 0xaf, 0xdb, 0xa3, 0x86,
+	0x15, 0xc9, 0x56, 0x61, 0x59, 0xca, 0x9a, 0xfd, 0x20, 0xa5, 0x7f, 0x13, 0x56, 0xc2, 0x3b, 0xa0,
+	0xcd, 0x4e, 0xb3, 0xc0, 0xb2, 0x42, 0x50, 0x49, 0x24, 0xbb, 0x01, 0x4b, 0x52, 0x76, 0x28, 0x52,
+	0x58, 0x91, 0xf0, 0x36, 0xbc, 0x27, 0x85, 0x21, 0xc3, 0x6c, 0x39, 0xd4, 0x0c, 0x43, 0xdb, 0x8c,
+	0x8e, 0x38, 0xb8, 0x62, 0x9d, 0x65, 0x40, 0x52, 0xf8, 0x82, 0x15, 0x35, 0x15, 0x7d, 0x61, 0xd9,
+	0x8e, 0x3f, 0xa7, 0x8c, 0x0b, 0xc6, 0xce, 0xca, 0x45, 0xff, 0xde, 0x51, 0x2f, 0x96, 0x37, 0x40,
+	0x93, 0xf2, 0x7d, 0xc2, 0xdf, 0x50, 0xff, 0x14, 0xd3, 0x60, 0x30, 0x31, 0x77, 0xe1, 0x76, 0xb2,
+	0x2e, 0x8e, 0x6a, 0x59, 0x56, 0x39, 0x31, 0x96, 0xb8, 0xee, 0x27, 0x80, 0xf9, 0xc3, 0x76, 0x72,
+	0x8e, 0x50, 0x2b, 0x1d, 0x9e, 0x84, 0x4b, 0xff, 0xcb, 0x7c, 0xb4, 0x9f, 0x5b, 0xb6, 0x54, 0x0c,
+	0x83, 0x9e, 0x88, 0x6c, 0xa3, 0x5a, 0x43, 0x15, 0x04, 0xff, 0x22, 0x0f, 0x92, 0x59, 0x65, 0x3c,
+	0xd0, 0x41, 0x2d, 0x98, 0x95, 0xe7, 0xf1, 0x0e, 0x91, 0x6b, 0xae, 0x55, 0x4b, 0x30, 0xb2, 0x5e,
+	0x81, 0x53, 0x6a, 0xe8, 0x25, 0x2c, 0x46, 0xe5, 0xc8, 0x4d, 0xd4, 0xe5, 0xf7, 0xd7, 0xc5, 0x50,
+	0x19, 0x67, 0xc2, 0x39, 0x75, 0xf4, 0x4a, 0x85, 0x54, 0x3b, 0x64, 0xe0, 0x61, 0xda, 0x64, 0x49,
+	0x9c, 0x5f, 0xe8, 0x88, 0x38, 0x0f, 0x90, 0x1c, 0xaf, 0x58, 0x7e, 0x6d, 0x6a, 0xd8, 0x78, 0x13,
+	0x0e, 0x8c, 0x53, 0x6a, 0xe8, 0x39, 0xcc, 0x45, 0x65, 0xe9, 0xd1, 0xea, 0xa2, 0xac, 0x17, 0xe3,
+	0x24, 0x9d, 0x1e, 0xa7, 0x15, 0xd1, 0x31, 0xdc, 0x8c, 0x04, 0x99, 0x6d, 0x20, 0x73, 0x94, 0x33,
+	0x1b, 0xf7, 0x8b, 0x31, 0x8b, 0xf7, 0x0c, 0x2e, 0x03, 0x4b, 0xf6, 0x58, 0xee, 0x27, 0xad, 0x3e,
+	0xac, 0xc7, 0xc9, 0x2d, 0x87, 0xd3, 0x8a, 0xe8, 0x6b, 0x98, 0x8f, 0x04, 0xe1, 0x26, 0xd4, 0xa0,
+	0xc4, 0x7b, 0xf3, 0x1b, 0x15, 0x67, 0x54, 0x93, 0xdd, 0x92, 0x7b, 0x57, 0x9b, 0x19, 0xd6, 0xad,
+	0xe4, 0xf6, 0xc6, 0x69, 0xc5, 0xa4, 0x0b, 0x46, 0x1b, 0x5e, 0x9b, 0x1d, 0xe6, 0x82, 0x19, 0x5a,
+	0xc0, 0x39, 0xf5, 0x24, 0x64, 0xc4, 0x15, 0xda, 0xdc, 0x30, 0xc8, 0x0c, 0xa3, 0xe0, 0x9c, 0x3a,
+	0xfa, 0x16, 0x96, 0xa5, 0x4c, 0xf1, 0xc8, 0x0e, 0xe1, 0x92, 0x66, 0xb4, 0x79, 0x09, 0xfb, 0x61,
+	0x31, 0x6c, 0x01, 0x21, 0xe1, 0x42, 0x18, 0xe4, 0xc0, 0xad, 0x8c, 0x7c, 0xc0, 0x54, 0xda, 0x82,
+	0xb4, 0xf1, 0x60, 0xa8, 0x8d, 0x1c, 0xb1, 0xe1, 0x72, 0xc0, 0x78, 0x30, 0x69, 0x77, 0x63, 0xda,
+	0xe2, 0xb0, 0xc1, 0x14, 0x10, 0x24, 0x2e, 0x84, 0xd1, 0xff, 0x05, 0xb0, 0x10, 0xd3, 0xe6, 0x78,
+	0xe7, 0xe5, 0xb3, 0xfc, 0x6d, 0x70, 0x66, 0xe3, 0x57, 0xc3, 0xe9, 0x56, 0x81, 0xa4, 0xf8, 0xf6,
+	0x05, 0xcc, 0x5b, 0xa9, 0x78, 0x4b, 0x11, 0xe6, 0x07, 0xe5, 0xa4, 0x9b, 0x46, 0xcb, 0xa8, 0xa3,
+	0x1d, 0xc5, 0x72, 0x21, 0x4f, 0xa8, 0xc7, 0x89, 0xda, 0xa8, 0x81, 0xe5, 0x75, 0xd0, 0x17, 0x19,
+	0x22, 0x9f, 0x1c, 0x85, 0x91, 0x26, 0xf0, 0x56, 0x01, 0x81, 0x4f, 0x8d, 0x82, 0xc8, 0x93, 0xf6,
+	0x4e, 0x11, 0x69, 0x5f, 0xbf, 0xd8, 0x70, 0x52, 0x3c, 0xfd, 0x45, 0x86, 0xa7, 0xa7, 0x2f, 0x3c,
+	0x1c, 0xc9, 0xcf, 0x4f, 0xb2, 0xfc, 0x5c, 0x1f, 0xa5, 0x9f, 0xa1, 0xe5, 0x6e, 0x39, 0x2d, 0xc3,
+	0x28, 0xa8, 0x52, 0x0e, 0x7e, 0x92, 0xe5, 0xe0, 0x99, 0x0b, 0xf7, 0x2a, 0xa4, 0xde, 0xcd, 0x1c,
+	0xf5, 0xce, 0x8e, 0x42, 0xc8, 0x12, 0xee, 0x93, 0x2c, 0xe1, 0xce, 0x5d, 0xb8, 0x0f, 0x21, 0xcf,
+	0xb6, 0x0a, 0x78, 0x76, 0xfe, 0xc2, 0x9e, 0x12, 0x73, 0x6b, 0xab, 0x80, 0x5b, 0x17, 0x2e, 0x0c,
+	0x13, 0xf3, 0x69, 0xbb, 0x84, 0x4f, 0x17, 0x47, 0x41, 0x15, 0xf3, 0xe7, 0xeb, 0x61, 0xfc, 0xb9,
+	0x34, 0x0a, 0x73, 0x08, 0x55, 0xb6, 0x4b, 0xa8, 0x12, 0x5d, 0xac, 0x9f, 0x59, 0x6a, 0xbc, 0x0f,
+	0xb3, 0xa9, 0x27, 0x9f, 0xf7, 0xa1, 0x7e, 0x16, 0x15, 0xd4, 0x5b, 0xf7, 0x40, 0xa0, 0x73, 0x58,
+	0x8d, 0xf3, 0x3c, 0xad, 0xb7, 0x36, 0xe3, 0xec, 0xa2, 0x39, 0x0e, 0x04, 0xb5, 0xfe, 0xe0, 0xf6,
+	0x2e, 0xbf, 0x0b, 0xf2, 0x1e, 0xd5, 0xc2, 0xbc, 0x47, 0x07, 0x6e, 0xe6, 0xac, 0x8e, 0x97, 0xfd,
+	0xf8, 0x77, 0x05, 0x96, 0x42, 0x8a, 0xfe, 0x43, 0x7b, 0x6f, 0xdc, 0x23, 0xe1, 0x7d, 0xa8, 0x5b,
+	0x11, 0x96, 0x1a, 0xdf, 0x40, 0x20, 0x32, 0x6a, 0x61, 0xa1, 0x69, 0xf4, 0x8d, 0x23, 0xdb, 0xb1,
+	0xb9, 0x4d, 0x98, 0x68, 0x19, 0xe6, 0x8d, 0x8a, 0x2b, 0x45, 0x62, 0x6f, 0x31, 0x1e, 0xf3, 0x65,
+	0x6f, 0x92, 0x45, 0x73, 0xad, 0xc1, 0x75, 0x93, 0x7a, 0x9c, 0x78, 0x5c, 0x5d, 0x86, 0xa3, 0xa2,
+	0xc8, 0xad, 0xb8, 0xc6, 0xdb, 0xad, 0x73, 0x4e, 0xc2, 0x48, 0xbb, 0x8a, 0xe3, 0xb2, 0x6e, 0xc1,
+	0x52, 0xa2, 0x17, 0x63, 0x67, 0x57, 0xa2, 0x1e, 0x4c, 0xa4, 0x7a, 0xa0, 0xbb, 0x2a, 0x89, 0x29,
+	0x72, 0x76, 0x5d, 0x6e, 0xf8, 0x3f, 0x27, 0xad, 0x61, 0xa6, 0xf3, 0xde, 0xe6, 0x20, 0xef, 0x6d,
+	0x24, 0xf2, 0xde, 0xe2, 0x5b, 0x37, 0x60, 0x35, 0x6b, 0x6e, 0xbc, 0x91, 0x2d, 0x42, 0xb5, 0xaf,
+	0x6e, 0xcd, 0x55, 0x2c, 0x3e, 0x75, 0x9c, 0x36, 0xc1, 0x03, 0x76, 0xd9, 0x21, 0xe5, 0x31, 0x7f,
+	0xaa, 0xc0, 0xcd, 0x1c, 0xe8, 0xd8, 0x09, 0x74, 0x91, 0xd0, 0x8c, 0xdf, 0xd4, 0x55, 0x29, 0x95,
+	0xf8, 0xac, 0x16, 0x26, 0x3e, 0x69, 0xc0, 0xa5, 0xb3, 0xcc, 0x62, 0x55, 0x52, 0x72, 0xe2, 0xfb,
+	0xda, 0x64, 0x2c, 0x27, 0xbe, 0xbf, 0xf1, 0xbf, 0x3b, 0x50, 0x6d, 0xba, 0x16, 0xda, 0x07, 0xd4,
+	0x3d, 0xf7, 0xcc, 0xf4, 0x3b, 0x0a, 0x7a, 0xaf, 0x70, 0x06, 0xc2, 0xb9, 0x6a, 0x94, 0x8f, 0x41,
+	0xbf, 0x86, 0x5e, 0xc0, 0x8d, 0x8e, 0x11, 0x30, 0x72, 0x65, 0x80, 0x2f, 0x61, 0xe5, 0xc0, 0xeb,
+	0x5f, 0x29, 0x64, 0x17, 0x96, 0xc3, 0x24, 0x6b, 0x06, 0x31, 0xff, 0x0c, 0x9a, 0xca, 0xc5, 0x0e,
+	0x07, 0xc5, 0xb0, 0x7a, 0xe0, 0x1d, 0x17, 0xc1, 0x8e, 0x35, 0x99, 0x98, 0x30, 0xc2, 0xaf, 0x0c,
+	0xf0, 0x15, 0x68, 0x5d, 0x7a, 0xcc, 0x31, 0x39, 0xa2, 0xf4, 0xea, 0x50, 0x31, 0xac, 0x76, 0x4f,
+	0x02, 0x6e, 0xd1, 0x37, 0xde, 0x95, 0x61, 0xee, 0x03, 0xfa, 0xda, 0x76, 0x9c, 0x2b, 0xc3, 0xeb,
+	0xc0, 0xf2, 0x36, 0x71, 0x08, 0xbf, 0xba, 0xc5, 0x79, 0x0d, 0x2b, 0xe1, 0xdb, 0x62, 0x16, 0x32,
+	0x9f, 0x6c, 0xc8, 0xbe, 0x41, 0x8e, 0x5c, 0x75, 0xb1, 0x25, 0x63, 0xa5, 0x57, 0x86, 0xdf, 0x23,
+	0x7c, 0x8c, 0x9e, 0xfe, 0x11, 0x6e, 0x37, 0x0d, 0xcf, 0x24, 0x99, 0xd9, 0x8c, 0x0d, 0x8c, 0xb9,
+	0xf4, 0x76, 0xcf, 0x33, 0x9c, 0xb0, 0x93, 0x1d, 0x6a, 0x35, 0x1d, 0x62, 0x78, 0x41, 0x7f, 0x0c,
+	0xcc, 0x3f, 0xc1, 0xdd, 0x67, 0xb6, 0x67, 0x38, 0xf6, 0x3b, 0x72, 0xf5, 0x1d, 0xde, 0x07, 0xf4,
+	0x9c, 0x72, 0xf1, 0x6a, 0x2f, 0x22, 0xd5, 0x6d, 0x72, 0x66, 0x8b, 0xe8, 0xed, 0xe7, 0xe3, 0xb5,
+	0xa1, 0x2e, 0x22, 0x67, 0x19, 0x2d, 0xa0, 0xfc, 0xaf, 0x79, 0x92, 0x2f, 0xb4, 0x8d, 0xbb, 0x25,
+	0xf7, 0xd1, 0x94, 0x53, 0xcd, 0xc7, 0x70, 0xe1, 0x45, 0x69, 0x04, 0xe6, 0x85, 0xee, 0xb8, 0x92,
+	0xf3, 0x66, 0x77, 0x08, 0x8f, 0xdf, 0x43, 0x47, 0xc1, 0xe6, 0xf3, 0x33, 0xb9, 0xa7, 0x54, 0x09,
+	0x3a, 0x1d, 0x5f, 0x5d, 0x46, 0x00, 0xde, 0x2b, 0x06, 0xcc, 0xbd, 0x59, 0x5e, 0x43, 0x7f, 0x96,
+	0x53, 0x90, 0x78, 0x3f, 0x1c, 0x05, 0xfd, 0x61, 0x31, 0x74, 0xd1, 0x0b, 0xe4, 0x35, 0xb4, 0x05,
+	0x35, 0xf1, 0x4e, 0x37, 0x0a, 0x73, 0xe8, 0x9a, 0xb7, 0xa0, 0x26, 0x0e, 0x7b, 0xf4, 0x7e, 0x1e,
+	0x63, 0xf0, 0xab, 0x80, 0xc6, 0xed, 0x92, 0xda, 0x04, 0x19, 0xd7, 0xe3, 0x77, 0xc3, 0x02, 0xd2,
+	0xc8, 0xbe, 0x57, 0x36, 0xf4, 0x61, 0x4d, 0x12, 0xbb, 0x47, 0xcb, 0xec, 0x9a, 0xf8, 0x79, 0x0f,
+	0xe9, 0x25, 0x3f, 0x85, 0x4d, 0xbc, 0xfd, 0x8d, 0xe2, 0x3c, 0xb1, 0x36, 0x89, 0x5f, 0x38, 0x5f,
+	0xde, 0x3d, 0x0b, 0x7e, 0x1e, 0xad, 0x78, 0x24, 0x17, 0x86, 0x34, 0x3b, 0x07, 0x6c, 0xcc, 0xc3,
+	0x2e, 0x87, 0x19, 0x0e, 0x78, 0xac, 0x33, 0x19, 0x76, 0x08, 0x57, 0x4f, 0x9b, 0xa3, 0x86, 0xbf,
+	0x96, 0xab, 0xce, 0xbc, 0x89, 0xea, 0xd7, 0x90, 0x01, 0xcb, 0x3b, 0x44, 0x3d, 0x1f, 0x26, 0x5e,
+	0x16, 0x87, 0x77, 0x31, 0xff, 0x3b, 0x9c, 0xd2, 0x77, 0x50, 0xfd, 0x1a, 0xfa, 0x16, 0x50, 0xfe,
+	0x91, 0x12, 0x15, 0xfd, 0x96, 0xa7, 0xe4, 0x25, 0x73, 0xf8, 0x94, 0x98, 0x70, 0x33, 0x26, 0xad,
+	0x74, 0x5a, 0x6c, 0xd4, 0xfc, 0x5c, 0x34, 0xad, 0x26, 0xb9, 0x66, 0x4e, 0xcc, 0x7b, 0xfc, 0x2e,
+	0x39, 0x7c, 0x7e, 0xf2, 0xb9, 0xea, 0xfc, 0x8b, 0x66, 0x18, 0x09, 0x86, 0x8f, 0x8e, 0x23, 0x23,
+	0xc1, 0xd4, 0xdb, 0xe4, 0xf0, 0xe9, 0xa0, 0x80, 0xf2, 0x0f, 0x82, 0x05, 0xb3, 0x5d, 0xfa, 0x36,
+	0xd9, 0xf8, 0xcd, 0x85, 0xda, 0x26, 0x42, 0x64, 0xe1, 0x92, 0x2a, 0x93, 0x8a, 0xee, 0x16, 0xcc,
+	0x4b, 0xf2, 0xd5, 0xa4, 0xb1, 0x56, 0xde, 0x20, 0x86, 0x3c, 0x86, 0x85, 0xcc, 0xdd, 0x1e, 0x7d,
+	0x50, 0x4e, 0xb3, 0xa9, 0x9c, 0x43, 0x63, 0x7d, 0x74, 0xc3, 0xd8, 0xce, 0x1e, 0x2c, 0x62, 0x72,
+	0xec, 0x13, 0x76, 0x32, 0x38, 0x9a, 0xc6, 0xd9, 0x9b, 0xb3, 0xb1, 0x23, 0x8a, 0x4b, 0xfe, 0x50,
+	0x24, 0xbd, 0xe4, 0xe4, 0x4c, 0xa6, 0x1e, 0x5e, 0xc0, 0x4a, 0x37, 0x60, 0x7d, 0xe2, 0x59, 0x57,
+	0x13, 0x36, 0xa2, 0x5d, 0x58, 0xc0, 0x94, 0x1b, 0x9c, 0x34, 0x1d, 0x1a, 0x58, 0xbb, 0xe2, 0x5f,
+	0x22, 0x7e, 0x2e, 0xd4, 0x73, 0x98, 0x6f, 0x89, 0xed, 0x3a, 0x3e, 0xd2, 0x21, 0xcc, 0x61, 0x62,
+	0x58, 0xf1, 0x2a, 0x95, 0x1d, 0x46, 0x89, 0xa4, 0x47, 0x43, 0x1f, 0xd6, 0x44, 0xe1, 0xee, 0xc3,
+	0xfc, 0x6b, 0xdf, 0xe6, 0xe4, 0x52, 0xc0, 0x43, 0xfa, 0x69, 0xc0, 0x7c, 0x3a, 0x43, 0x80, 0x4a,
+	0xa2, 0x8a, 0x6c, 0xc6, 0xa2, 0xf1, 0xc1, 0xc8, 0x76, 0xca, 0x84, 0x05, 0x0b, 0xc9, 0x1a, 0x1e,
+	0x94, 0xfa, 0x7d, 0x2e, 0x87, 0xd0, 0x58, 0x1f, 0xdd, 0x30, 0xb4, 0xb2, 0x55, 0xfb, 0x66, 0xe2,
+	0xec, 0xd1, 0xd1, 0x94, 0xfc, 0x1f, 0xa2, 0x4f, 0xfe, 0x3f, 0x00, 0x9c, 0xe6, 0x64, 0xcc, 0x70,
+	0x34, 0x00, 0x00,
 }
@@ -101,6 +101,26 @@ func (mr *MockCmdClientMockRecorder) DeleteVirtualMachine(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).DeleteVirtualMachine), varargs...)
 }
 
+// EjectCloudInit mocks base method.
+func (m *MockCmdClient) EjectCloudInit(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EjectCloudInit", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EjectCloudInit indicates an expected call of EjectCloudInit.
+func (mr *MockCmdClientMockRecorder) EjectCloudInit(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EjectCloudInit", reflect.TypeOf((*MockCmdClient)(nil).EjectCloudInit), varargs...)
+}
+
 // Exec mocks base method.
 func (m *MockCmdClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +241,26 @@ func (mr *MockCmdClientMockRecorder) GetDomainStats(ctx, in any, opts ...any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainStats", reflect.TypeOf((*MockCmdClient)(nil).GetDomainStats), varargs...)
 }
 
+// GetDomainXML mocks base method.
+func (m *MockCmdClient) GetDomainXML(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*DomainXMLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainXML", varargs...)
+	ret0, _ := ret[0].(*DomainXMLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainXML indicates an expected call of GetDomainXML.
+func (mr *MockCmdClientMockRecorder) GetDomainXML(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainXML", reflect.TypeOf((*MockCmdClient)(nil).GetDomainXML), varargs...)
+}
+
 // GetFilesystems mocks base method.
 func (m *MockCmdClient) GetFilesystems(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestFilesystemsResponse, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +421,66 @@ func (mr *MockCmdClientMockRecorder) GetVMStats(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMStats", reflect.TypeOf((*MockCmdClient)(nil).GetVMStats), varargs...)
 }
 
+// GuestExecStart mocks base method.
+func (m *MockCmdClient) GuestExecStart(ctx context.Context, in *GuestExecStartRequest, opts ...grpc.CallOption) (*GuestExecStartResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestExecStart", varargs...)
+	ret0, _ := ret[0].(*GuestExecStartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStart indicates an expected call of GuestExecStart.
+func (mr *MockCmdClientMockRecorder) GuestExecStart(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStart", reflect.TypeOf((*MockCmdClient)(nil).GuestExecStart), varargs...)
+}
+
+// GuestExecStatus mocks base method.
+func (m *MockCmdClient) GuestExecStatus(ctx context.Context, in *GuestExecStatusRequest, opts ...grpc.CallOption) (*GuestExecStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestExecStatus", varargs...)
+	ret0, _ := ret[0].(*GuestExecStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStatus indicates an expected call of GuestExecStatus.
+func (mr *MockCmdClientMockRecorder) GuestExecStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStatus", reflect.TypeOf((*MockCmdClient)(nil).GuestExecStatus), varargs...)
+}
+
+// GuestFileExists mocks base method.
+func (m *MockCmdClient) GuestFileExists(ctx context.Context, in *GuestFileExistsRequest, opts ...grpc.CallOption) (*GuestFileExistsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileExists", varargs...)
+	ret0, _ := ret[0].(*GuestFileExistsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileExists indicates an expected call of GuestFileExists.
+func (mr *MockCmdClientMockRecorder) GuestFileExists(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileExists", reflect.TypeOf((*MockCmdClient)(nil).GuestFileExists), varargs...)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdClient) GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -521,6 +621,26 @@ func (mr *MockCmdClientMockRecorder) Ping(ctx, in any, opts ...any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCmdClient)(nil).Ping), varargs...)
 }
 
+// ReadGuestFile mocks base method.
+func (m *MockCmdClient) ReadGuestFile(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadGuestFile", varargs...)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGuestFile indicates an expected call of ReadGuestFile.
+func (mr *MockCmdClientMockRecorder) ReadGuestFile(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGuestFile", reflect.TypeOf((*MockCmdClient)(nil).ReadGuestFile), varargs...)
+}
+
 // RedefineCheckpoint mocks base method.
 func (m *MockCmdClient) RedefineCheckpoint(ctx context.Context, in *RedefineCheckpointRequest, opts ...grpc.CallOption) (*RedefineCheckpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -541,6 +661,26 @@ func (mr *MockCmdClientMockRecorder) RedefineCheckpoint(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedefineCheckpoint", reflect.TypeOf((*MockCmdClient)(nil).RedefineCheckpoint), varargs...)
 }
 
+// RefreshGuestInfo mocks base method.
+func (m *MockCmdClient) RefreshGuestInfo(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshGuestInfo", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshGuestInfo indicates an expected call of RefreshGuestInfo.
+func (mr *MockCmdClientMockRecorder) RefreshGuestInfo(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGuestInfo", reflect.TypeOf((*MockCmdClient)(nil).RefreshGuestInfo), varargs...)
+}
+
 // ResetVirtualMachine mocks base method.
 func (m *MockCmdClient) ResetVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -561,6 +701,26 @@ func (mr *MockCmdClientMockRecorder) ResetVirtualMachine(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).ResetVirtualMachine), varargs...)
 }
 
+// RotateCloudInit mocks base method.
+func (m *MockCmdClient) RotateCloudInit(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateCloudInit", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCloudInit indicates an expected call of RotateCloudInit.
+func (mr *MockCmdClientMockRecorder) RotateCloudInit(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCloudInit", reflect.TypeOf((*MockCmdClient)(nil).RotateCloudInit), varargs...)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockCmdClient) ShutdownVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -641,6 +801,26 @@ func (mr *MockCmdClientMockRecorder) SyncMigrationTarget(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncMigrationTarget", reflect.TypeOf((*MockCmdClient)(nil).SyncMigrationTarget), varargs...)
 }
 
+// SuspendVirtualMachine mocks base method.
+func (m *MockCmdClient) SuspendVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendVirtualMachine", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendVirtualMachine indicates an expected call of SuspendVirtualMachine.
+func (mr *MockCmdClientMockRecorder) SuspendVirtualMachine(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).SuspendVirtualMachine), varargs...)
+}
+
 // SyncVirtualMachine mocks base method.
 func (m *MockCmdClient) SyncVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -761,6 +941,26 @@ func (mr *MockCmdClientMockRecorder) VirtualMachineMemoryDump(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineMemoryDump", reflect.TypeOf((*MockCmdClient)(nil).VirtualMachineMemoryDump), varargs...)
 }
 
+// WriteGuestFile mocks base method.
+func (m *MockCmdClient) WriteGuestFile(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WriteGuestFile", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteGuestFile indicates an expected call of WriteGuestFile.
+func (mr *MockCmdClientMockRecorder) WriteGuestFile(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGuestFile", reflect.TypeOf((*MockCmdClient)(nil).WriteGuestFile), varargs...)
+}
+
 // MockCmdServer is a mock of CmdServer interface.
 type MockCmdServer struct {
 	ctrl     *gomock.Controller
@@ -830,6 +1030,21 @@ func (mr *MockCmdServerMockRecorder) DeleteVirtualMachine(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).DeleteVirtualMachine), arg0, arg1)
 }
 
+// EjectCloudInit mocks base method.
+func (m *MockCmdServer) EjectCloudInit(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EjectCloudInit", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EjectCloudInit indicates an expected call of EjectCloudInit.
+func (mr *MockCmdServerMockRecorder) EjectCloudInit(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EjectCloudInit", reflect.TypeOf((*MockCmdServer)(nil).EjectCloudInit), arg0, arg1)
+}
+
 // Exec mocks base method.
 func (m *MockCmdServer) Exec(arg0 context.Context, arg1 *ExecRequest) (*ExecResponse, error) {
 	m.ctrl.T.Helper()
@@ -920,6 +1135,21 @@ func (mr *MockCmdServerMockRecorder) GetDomainStats(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainStats", reflect.TypeOf((*MockCmdServer)(nil).GetDomainStats), arg0, arg1)
 }
 
+// GetDomainXML mocks base method.
+func (m *MockCmdServer) GetDomainXML(arg0 context.Context, arg1 *VMIRequest) (*DomainXMLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainXML", arg0, arg1)
+	ret0, _ := ret[0].(*DomainXMLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainXML indicates an expected call of GetDomainXML.
+func (mr *MockCmdServerMockRecorder) GetDomainXML(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainXML", reflect.TypeOf((*MockCmdServer)(nil).GetDomainXML), arg0, arg1)
+}
+
 // GetFilesystems mocks base method.
 func (m *MockCmdServer) GetFilesystems(arg0 context.Context, arg1 *EmptyRequest) (*GuestFilesystemsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1040,6 +1270,51 @@ func (mr *MockCmdServerMockRecorder) GetVMStats(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMStats", reflect.TypeOf((*MockCmdServer)(nil).GetVMStats), arg0, arg1)
 }
 
+// GuestExecStart mocks base method.
+func (m *MockCmdServer) GuestExecStart(arg0 context.Context, arg1 *GuestExecStartRequest) (*GuestExecStartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStart", arg0, arg1)
+	ret0, _ := ret[0].(*GuestExecStartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStart indicates an expected call of GuestExecStart.
+func (mr *MockCmdServerMockRecorder) GuestExecStart(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStart", reflect.TypeOf((*MockCmdServer)(nil).GuestExecStart), arg0, arg1)
+}
+
+// GuestExecStatus mocks base method.
+func (m *MockCmdServer) GuestExecStatus(arg0 context.Context, arg1 *GuestExecStatusRequest) (*GuestExecStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStatus", arg0, arg1)
+	ret0, _ := ret[0].(*GuestExecStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStatus indicates an expected call of GuestExecStatus.
+func (mr *MockCmdServerMockRecorder) GuestExecStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStatus", reflect.TypeOf((*MockCmdServer)(nil).GuestExecStatus), arg0, arg1)
+}
+
+// GuestFileExists mocks base method.
+func (m *MockCmdServer) GuestFileExists(arg0 context.Context, arg1 *GuestFileExistsRequest) (*GuestFileExistsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileExists", arg0, arg1)
+	ret0, _ := ret[0].(*GuestFileExistsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileExists indicates an expected call of GuestFileExists.
+func (mr *MockCmdServerMockRecorder) GuestFileExists(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileExists", reflect.TypeOf((*MockCmdServer)(nil).GuestFileExists), arg0, arg1)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdServer) GuestPing(arg0 context.Context, arg1 *GuestPingRequest) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -1145,6 +1420,21 @@ func (mr *MockCmdServerMockRecorder) Ping(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCmdServer)(nil).Ping), arg0, arg1)
 }
 
+// ReadGuestFile mocks base method.
+func (m *MockCmdServer) ReadGuestFile(arg0 context.Context, arg1 *GuestFileRequest) (*GuestFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGuestFile", arg0, arg1)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGuestFile indicates an expected call of ReadGuestFile.
+func (mr *MockCmdServerMockRecorder) ReadGuestFile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGuestFile", reflect.TypeOf((*MockCmdServer)(nil).ReadGuestFile), arg0, arg1)
+}
+
 // RedefineCheckpoint mocks base method.
 func (m *MockCmdServer) RedefineCheckpoint(arg0 context.Context, arg1 *RedefineCheckpointRequest) (*RedefineCheckpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -1160,6 +1450,21 @@ func (mr *MockCmdServerMockRecorder) RedefineCheckpoint(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedefineCheckpoint", reflect.TypeOf((*MockCmdServer)(nil).RedefineCheckpoint), arg0, arg1)
 }
 
+// RefreshGuestInfo mocks base method.
+func (m *MockCmdServer) RefreshGuestInfo(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshGuestInfo", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshGuestInfo indicates an expected call of RefreshGuestInfo.
+func (mr *MockCmdServerMockRecorder) RefreshGuestInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGuestInfo", reflect.TypeOf((*MockCmdServer)(nil).RefreshGuestInfo), arg0, arg1)
+}
+
 // ResetVirtualMachine mocks base method.
 func (m *MockCmdServer) ResetVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -1175,6 +1480,21 @@ func (mr *MockCmdServerMockRecorder) ResetVirtualMachine(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).ResetVirtualMachine), arg0, arg1)
 }
 
+// RotateCloudInit mocks base method.
+func (m *MockCmdServer) RotateCloudInit(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCloudInit", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCloudInit indicates an expected call of RotateCloudInit.
+func (mr *MockCmdServerMockRecorder) RotateCloudInit(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCloudInit", reflect.TypeOf((*MockCmdServer)(nil).RotateCloudInit), arg0, arg1)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockCmdServer) ShutdownVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -1235,6 +1555,21 @@ func (mr *MockCmdServerMockRecorder) SyncMigrationTarget(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncMigrationTarget", reflect.TypeOf((*MockCmdServer)(nil).SyncMigrationTarget), arg0, arg1)
 }
 
+// SuspendVirtualMachine mocks base method.
+func (m *MockCmdServer) SuspendVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendVirtualMachine", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendVirtualMachine indicates an expected call of SuspendVirtualMachine.
+func (mr *MockCmdServerMockRecorder) SuspendVirtualMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).SuspendVirtualMachine), arg0, arg1)
+}
+
 // SyncVirtualMachine mocks base method.
 func (m *MockCmdServer) SyncVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -1324,3 +1659,18 @@ func (mr *MockCmdServerMockRecorder) VirtualMachineMemoryDump(arg0, arg1 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineMemoryDump", reflect.TypeOf((*MockCmdServer)(nil).VirtualMachineMemoryDump), arg0, arg1)
 }
+
+// WriteGuestFile mocks base method.
+func (m *MockCmdServer) WriteGuestFile(arg0 context.Context, arg1 *GuestFileRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteGuestFile", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteGuestFile indicates an expected call of WriteGuestFile.
+func (mr *MockCmdServerMockRecorder) WriteGuestFile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGuestFile", reflect.TypeOf((*MockCmdServer)(nil).WriteGuestFile), arg0, arg1)
+}
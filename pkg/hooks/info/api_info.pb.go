@@ -87,6 +87,11 @@ type HookPoint struct {
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	// priority is used to sort hooks prior to their execution (second key is the name)
 	Priority int32 `protobuf:"varint,2,opt,name=priority" json:"priority,omitempty"`
+	// timeoutSeconds limits the duration of a call to the hook point, virt-launcher defaults it to 60 seconds
+	TimeoutSeconds int32 `protobuf:"varint,3,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+	// failurePolicy defines how a failed or timed out call to the hook point is handled,
+	// either Fail (default) or Ignore
+	FailurePolicy string `protobuf:"bytes,4,opt,name=failurePolicy" json:"failurePolicy,omitempty"`
 }
 
 func (m *HookPoint) Reset()                    { *m = HookPoint{} }
@@ -108,6 +113,20 @@ func (m *HookPoint) GetPriority() int32 {
 	return 0
 }
 
+func (m *HookPoint) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+func (m *HookPoint) GetFailurePolicy() string {
+	if m != nil {
+		return m.FailurePolicy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InfoParams)(nil), "kubevirt.hooks.info.InfoParams")
 	proto.RegisterType((*InfoResult)(nil), "kubevirt.hooks.info.InfoResult")
@@ -189,18 +208,21 @@ var _Info_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api_info.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4b, 0x2c, 0xc8, 0x8c,
-	0xcf, 0xcc, 0x4b, 0xcb, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xce, 0x2e, 0x4d, 0x4a,
-	0x2d, 0xcb, 0x2c, 0x2a, 0xd1, 0xcb, 0xc8, 0xcf, 0xcf, 0x2e, 0xd6, 0x03, 0x49, 0x29, 0xf1, 0x70,
-	0x71, 0x79, 0xe6, 0xa5, 0xe5, 0x07, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x2b, 0xd5, 0x40, 0x78, 0x41,
-	0xa9, 0xc5, 0xa5, 0x39, 0x25, 0x42, 0x42, 0x5c, 0x2c, 0x79, 0x89, 0xb9, 0xa9, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x60, 0xb6, 0x90, 0x1d, 0x17, 0x17, 0x48, 0x77, 0x40, 0x7e, 0x66, 0x5e,
-	0x49, 0xb1, 0x04, 0xb3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x9c, 0x1e, 0x16, 0x93, 0xf5, 0x3c, 0x60,
-	0xca, 0x82, 0x90, 0x74, 0x08, 0x49, 0x71, 0x71, 0x94, 0xa5, 0x16, 0x15, 0x67, 0xe6, 0xe7, 0x15,
-	0x4b, 0xb0, 0x28, 0x30, 0x6b, 0x70, 0x06, 0xc1, 0xf9, 0x4a, 0xd6, 0x5c, 0x9c, 0x70, 0x4d, 0x58,
-	0x2d, 0x97, 0xe2, 0xe2, 0x28, 0x28, 0xca, 0xcc, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x52, 0x60,
-	0xd4, 0x60, 0x0d, 0x82, 0xf3, 0x8d, 0x02, 0xb8, 0x58, 0x40, 0x4e, 0x17, 0xf2, 0x80, 0xd2, 0xf2,
-	0x58, 0x1d, 0x85, 0xf0, 0xab, 0x14, 0x6e, 0x05, 0x10, 0xef, 0x27, 0xb1, 0x81, 0x83, 0xcd, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x38, 0x98, 0xcf, 0x48, 0x01, 0x00, 0x00,
+	// 244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0x3d, 0x4f, 0xc3, 0x30,
+	0x14, 0x54, 0x48, 0x40, 0xcd, 0x03, 0x3a, 0x98, 0xc5, 0xca, 0x00, 0x51, 0x84, 0x50, 0x26, 0x0f,
+	0x65, 0x67, 0x2e, 0x5b, 0x64, 0x7e, 0x00, 0x72, 0x8b, 0x23, 0x9e, 0x9a, 0xf8, 0x45, 0xfe, 0xa8,
+	0x54, 0x89, 0x3f, 0xc0, 0xbf, 0x46, 0x4e, 0x21, 0x7c, 0x28, 0x4c, 0xf6, 0x9d, 0xef, 0x7c, 0xf6,
+	0x3d, 0x58, 0xaa, 0x01, 0x9f, 0xd1, 0xb4, 0x24, 0x06, 0x4b, 0x9e, 0xd8, 0xd5, 0x2e, 0x6c, 0xf4,
+	0x1e, 0xad, 0x17, 0xaf, 0x44, 0x3b, 0x27, 0xe2, 0x51, 0x75, 0x01, 0xf0, 0x68, 0x5a, 0x6a, 0x94,
+	0x55, 0xbd, 0xab, 0xde, 0x8e, 0x48, 0x6a, 0x17, 0x3a, 0xcf, 0x18, 0x64, 0x46, 0xf5, 0x9a, 0x27,
+	0x65, 0x52, 0xe7, 0x72, 0xdc, 0xb3, 0x07, 0x80, 0xe8, 0x6e, 0x08, 0x8d, 0x77, 0x3c, 0x2d, 0xd3,
+	0xfa, 0x7c, 0x75, 0x2d, 0x66, 0x6e, 0x16, 0xeb, 0x2f, 0x99, 0xfc, 0xe1, 0x60, 0x05, 0x2c, 0xf6,
+	0xda, 0x3a, 0x24, 0xe3, 0x78, 0x56, 0xa6, 0x75, 0x2e, 0x27, 0x5c, 0xbd, 0x27, 0x90, 0x4f, 0xae,
+	0xd9, 0xf4, 0x02, 0x16, 0x83, 0x45, 0xb2, 0xe8, 0x0f, 0xfc, 0xa4, 0x4c, 0xea, 0x53, 0x39, 0x61,
+	0x76, 0x07, 0x4b, 0x8f, 0xbd, 0xa6, 0xe0, 0x9f, 0xf4, 0x96, 0xcc, 0x4b, 0x7c, 0x5d, 0x54, 0xfc,
+	0x61, 0xd9, 0x2d, 0x5c, 0xb6, 0x0a, 0xbb, 0x60, 0x75, 0x43, 0x1d, 0x6e, 0x0f, 0x3c, 0x1b, 0x03,
+	0x7e, 0x93, 0xab, 0x06, 0xb2, 0xd8, 0x04, 0x5b, 0x7f, 0xae, 0x37, 0xb3, 0x7f, 0xfc, 0xae, 0xae,
+	0xf8, 0x5f, 0x70, 0x6c, 0x73, 0x73, 0x36, 0x4e, 0xe1, 0xfe, 0x63, 0x00, 0xb9, 0x10, 0x08, 0xaf,
+	0x97, 0x01, 0x00, 0x00,
 }
@@ -22,3 +22,9 @@ package info
 const OnDefineDomainHookPointName = "OnDefineDomain"
 const PreCloudInitIsoHookPointName = "PreCloudInitIso"
 const ShutdownHookPointName = "Shutdown"
+
+// FailurePolicyFail fails the hook point when a call to the hook fails or times out
+const FailurePolicyFail = "Fail"
+
+// FailurePolicyIgnore continues with the unmodified input when a call to the hook fails or times out
+const FailurePolicyIgnore = "Ignore"
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -31,14 +32,19 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/grpc"
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -47,12 +53,36 @@ import (
 
 const dialSockErr = "Failed to Dial hook socket: %s"
 
+const defaultHookTimeout = time.Minute
+
 type callBackClient struct {
+	Name                 string
 	SocketPath           string
 	Version              string
 	subscribedHookPoints []*hooksInfo.HookPoint
 }
 
+func (c *callBackClient) hookPoint(hookPointName string) *hooksInfo.HookPoint {
+	for _, hookPoint := range c.subscribedHookPoints {
+		if hookPoint.GetName() == hookPointName {
+			return hookPoint
+		}
+	}
+	return nil
+}
+
+// timeout returns the duration a call to the hook point may take, as requested by the hook
+func (c *callBackClient) timeout(hookPointName string) time.Duration {
+	if timeoutSeconds := c.hookPoint(hookPointName).GetTimeoutSeconds(); timeoutSeconds > 0 {
+		return time.Duration(timeoutSeconds) * time.Second
+	}
+	return defaultHookTimeout
+}
+
+func (c *callBackClient) ignoresFailures(hookPointName string) bool {
+	return c.hookPoint(hookPointName).GetFailurePolicy() == hooksInfo.FailurePolicyIgnore
+}
+
 var manager Manager
 var once sync.Once
 
@@ -192,6 +222,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 
 	// The order matters. We should match newer versions first.
 	supportedVersions := []string{
+		hooksV1alpha4.Version,
 		hooksV1alpha3.Version,
 		hooksV1alpha2.Version,
 		hooksV1alpha1.Version,
@@ -200,6 +231,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 	for _, version := range supportedVersions {
 		if _, found := versionsSet[version]; found {
 			return &callBackClient{
+				Name:                 info.GetName(),
 				SocketPath:           socketPath,
 				Version:              version,
 				subscribedHookPoints: info.GetHookPoints(),
@@ -212,17 +244,18 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 			info.GetVersions(), supportedVersions)
 }
 
+// sortCallbacksPerHookPoint orders the hooks of every hook point by the priority they requested for it,
+// the hooks with the same priority are ordered by their name
 func sortCallbacksPerHookPoint(callbacksPerHookPoint map[string][]*callBackClient) {
-	for _, callbacks := range callbacksPerHookPoint {
-		for _, callback := range callbacks {
-			sort.Slice(callback.subscribedHookPoints, func(i, j int) bool {
-				if callback.subscribedHookPoints[i].Priority == callback.subscribedHookPoints[j].Priority {
-					return strings.Compare(callback.subscribedHookPoints[i].Name, callback.subscribedHookPoints[j].Name) < 0
-				} else {
-					return callback.subscribedHookPoints[i].Priority > callback.subscribedHookPoints[j].Priority
-				}
-			})
-		}
+	for hookPointName, callbacks := range callbacksPerHookPoint {
+		sort.SliceStable(callbacks, func(i, j int) bool {
+			iPriority := callbacks[i].hookPoint(hookPointName).GetPriority()
+			jPriority := callbacks[j].hookPoint(hookPointName).GetPriority()
+			if iPriority == jPriority {
+				return strings.Compare(callbacks[i].Name, callbacks[j].Name) < 0
+			}
+			return iPriority > jPriority
+		})
 	}
 }
 
@@ -243,10 +276,15 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 	}
 
 	for _, callback := range callbacks {
-		domainSpecXML, err = m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON)
+		resultXML, err := m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON)
 		if err != nil {
+			if callback.ignoresFailures(hooksInfo.OnDefineDomainHookPointName) {
+				log.Log.Reason(err).Warningf("Ignoring the failed OnDefineDomain call of hook %s", callback.Name)
+				continue
+			}
 			return "", err
 		}
+		domainSpecXML = resultXML
 	}
 
 	return string(domainSpecXML), nil
@@ -260,7 +298,7 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 	}
 	defer conn.Close()
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), callback.timeout(hooksInfo.OnDefineDomainHookPointName))
 	defer cancel()
 
 	switch callback.Version {
@@ -297,6 +335,12 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 			return nil, err
 		}
 		domainSpecXML = result.GetDomainXML()
+	case hooksV1alpha4.Version:
+		domainSpecXML, err = onDefineDomainV1alpha4(ctx, conn, domainSpecXML, vmiJSON)
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
+			return nil, err
+		}
 	default:
 		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 	}
@@ -304,6 +348,83 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 	return domainSpecXML, nil
 }
 
+// onDefineDomainV1alpha4 collects the streamed results of the hook, the domain XML chunks replace the
+// domain specification and the patches are applied on top of it
+func onDefineDomainV1alpha4(ctx context.Context, conn *grpc.ClientConn, domainSpecXML, vmiJSON []byte) ([]byte, error) {
+	client := hooksV1alpha4.NewCallbacksClient(conn)
+	stream, err := client.OnDefineDomain(ctx, &hooksV1alpha4.OnDefineDomainParams{
+		DomainXML: domainSpecXML,
+		Vmi:       vmiJSON,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resultXML []byte
+	var patches []*hooksV1alpha4.DomainPatch
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if message := result.GetMessage(); message != "" {
+			log.Log.Infof("OnDefineDomain hook: %s", message)
+		}
+		resultXML = append(resultXML, result.GetDomainXML()...)
+		patches = append(patches, result.GetPatches()...)
+	}
+
+	if len(resultXML) == 0 {
+		resultXML = domainSpecXML
+	}
+	return applyDomainPatches(resultXML, patches)
+}
+
+func applyDomainPatches(domainSpecXML []byte, patches []*hooksV1alpha4.DomainPatch) ([]byte, error) {
+	if len(patches) == 0 {
+		return domainSpecXML, nil
+	}
+
+	domainSpec := &virtwrapApi.DomainSpec{}
+	if err := xml.Unmarshal(domainSpecXML, domainSpec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the domain spec: %v", err)
+	}
+	domainSpecJSON, err := json.Marshal(domainSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the domain spec: %v", err)
+	}
+
+	operations := make([]patch.PatchOperation, 0, len(patches))
+	for _, domainPatch := range patches {
+		operation := patch.PatchOperation{Op: domainPatch.GetOp(), Path: domainPatch.GetPath()}
+		if len(domainPatch.GetValue()) > 0 {
+			operation.Value = json.RawMessage(domainPatch.GetValue())
+		}
+		operations = append(operations, operation)
+	}
+	payload, err := patch.GeneratePatchPayload(operations...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain patch: %v", err)
+	}
+	jsonPatch, err := jsonpatch.DecodePatch(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain patch: %v", err)
+	}
+	patchedJSON, err := jsonPatch.Apply(domainSpecJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the domain patch: %v", err)
+	}
+
+	patchedSpec := &virtwrapApi.DomainSpec{}
+	if err := json.Unmarshal(patchedJSON, patchedSpec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the patched domain spec: %v", err)
+	}
+	return xml.MarshalIndent(patchedSpec, "", "\t")
+}
+
 func preCloudInitIsoDataToJSON(vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) ([]byte, []byte, []byte, error) {
 	vmiJSON, err := json.Marshal(vmi)
 	if err != nil {
@@ -368,50 +489,16 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 
 	for _, callback := range callbacks {
 		switch callback.Version {
-		case hooksV1alpha2.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
-			if err != nil {
-				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
-				return cloudInitData, err
-			}
-			defer conn.Close()
-
-			client := hooksV1alpha2.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
-
-			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha2.PreCloudInitIsoParams{
-				CloudInitData:          cloudInitDataJSON,
-				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
-				Vmi:                    vmiJSON,
-			})
-			if err != nil {
-				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
-				return cloudInitData, err
-			}
-			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
-		case hooksV1alpha3.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
-			if err != nil {
-				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
-				return cloudInitData, err
-			}
-			defer conn.Close()
-
-			client := hooksV1alpha3.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
-
-			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha3.PreCloudInitIsoParams{
-				CloudInitData:          cloudInitDataJSON,
-				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
-				Vmi:                    vmiJSON,
-			})
+		case hooksV1alpha2.Version, hooksV1alpha3.Version, hooksV1alpha4.Version:
+			result, err := preCloudInitIsoCallback(callback, cloudInitData.DataSource, cloudInitDataJSON, cloudInitNoCloudSourceJSON, vmiJSON)
 			if err != nil {
-				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+				if callback.ignoresFailures(hooksInfo.PreCloudInitIsoHookPointName) {
+					log.Log.Reason(err).Warningf("Ignoring the failed PreCloudInitIso call of hook %s", callback.Name)
+					continue
+				}
 				return cloudInitData, err
 			}
-			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
+			return result, nil
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
@@ -419,6 +506,66 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 	return cloudInitData, nil
 }
 
+func preCloudInitIsoCallback(callback *callBackClient, dataSource cloudinit.DataSourceType, cloudInitDataJSON, cloudInitNoCloudSourceJSON, vmiJSON []byte) (*cloudinit.CloudInitData, error) {
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), callback.timeout(hooksInfo.PreCloudInitIsoHookPointName))
+	defer cancel()
+
+	switch callback.Version {
+	case hooksV1alpha2.Version:
+		client := hooksV1alpha2.NewCallbacksClient(conn)
+		result, err := client.PreCloudInitIso(ctx, &hooksV1alpha2.PreCloudInitIsoParams{
+			CloudInitData:          cloudInitDataJSON,
+			CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
+			Vmi:                    vmiJSON,
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+			return nil, err
+		}
+		return preCloudInitIsoValidateResult(dataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
+	case hooksV1alpha3.Version:
+		client := hooksV1alpha3.NewCallbacksClient(conn)
+		result, err := client.PreCloudInitIso(ctx, &hooksV1alpha3.PreCloudInitIsoParams{
+			CloudInitData:          cloudInitDataJSON,
+			CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
+			Vmi:                    vmiJSON,
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+			return nil, err
+		}
+		return preCloudInitIsoValidateResult(dataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
+	case hooksV1alpha4.Version:
+		client := hooksV1alpha4.NewCallbacksClient(conn)
+		result, err := client.PreCloudInitIso(ctx, &hooksV1alpha4.PreCloudInitIsoParams{
+			CloudInitData: cloudInitDataJSON,
+			Vmi:           vmiJSON,
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+			return nil, err
+		}
+		var resultData *cloudinit.CloudInitData
+		if err := json.Unmarshal(result.GetCloudInitData(), &resultData); err != nil {
+			log.Log.Reason(err).Error("Failed to unmarshal CloudInitData result")
+			return nil, err
+		}
+		if !cloudinit.IsValidCloudInitData(resultData) {
+			return nil, fmt.Errorf("hook %s returned invalid cloud-init data", callback.Name)
+		}
+		return resultData, nil
+	}
+
+	return nil, fmt.Errorf("unsupported callback version: %s", callback.Version)
+}
+
 func (m *hookManager) Shutdown() error {
 	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.ShutdownHookPointName]
 	if !found {
@@ -426,19 +573,12 @@ func (m *hookManager) Shutdown() error {
 	}
 	for _, callback := range callbacks {
 		switch callback.Version {
-		case hooksV1alpha3.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
-			if err != nil {
-				log.Log.Reason(err).Error("Failed to run Shutdown")
-				return err
-			}
-			defer conn.Close()
-
-			client := hooksV1alpha3.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
-
-			if _, err := client.Shutdown(ctx, &hooksV1alpha3.ShutdownParams{}); err != nil {
+		case hooksV1alpha3.Version, hooksV1alpha4.Version:
+			if err := shutdownCallback(callback); err != nil {
+				if callback.ignoresFailures(hooksInfo.ShutdownHookPointName) {
+					log.Log.Reason(err).Warningf("Ignoring the failed Shutdown call of hook %s", callback.Name)
+					continue
+				}
 				log.Log.Reason(err).Error("Failed to run Shutdown")
 				return err
 			}
@@ -448,3 +588,21 @@ func (m *hookManager) Shutdown() error {
 	}
 	return nil
 }
+
+func shutdownCallback(callback *callBackClient) error {
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), callback.timeout(hooksInfo.ShutdownHookPointName))
+	defer cancel()
+
+	if callback.Version == hooksV1alpha4.Version {
+		_, err = hooksV1alpha4.NewCallbacksClient(conn).Shutdown(ctx, &hooksV1alpha4.ShutdownParams{})
+	} else {
+		_, err = hooksV1alpha3.NewCallbacksClient(conn).Shutdown(ctx, &hooksV1alpha3.ShutdownParams{})
+	}
+	return err
+}
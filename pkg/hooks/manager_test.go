@@ -42,6 +42,7 @@ import (
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -99,10 +100,51 @@ func (s *callbackServer) Shutdown(
 	return &hooksV1alpha3.ShutdownResult{}, nil
 }
 
+type callbackServerV1alpha4 struct {
+	results []*hooksV1alpha4.OnDefineDomainResult
+	err     error
+	delay   time.Duration
+}
+
+func (s *callbackServerV1alpha4) OnDefineDomain(
+	_ *hooksV1alpha4.OnDefineDomainParams,
+	stream hooksV1alpha4.Callbacks_OnDefineDomainServer,
+) error {
+	GinkgoWriter.Println("Hook's v1alpha4 OnDefineDomain method has been called")
+	time.Sleep(s.delay)
+	if s.err != nil {
+		return s.err
+	}
+	for _, result := range s.results {
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *callbackServerV1alpha4) PreCloudInitIso(
+	_ context.Context,
+	params *hooksV1alpha4.PreCloudInitIsoParams,
+) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	return &hooksV1alpha4.PreCloudInitIsoResult{
+		CloudInitData: params.GetCloudInitData(),
+	}, s.err
+}
+
+func (s *callbackServerV1alpha4) Shutdown(
+	_ context.Context,
+	_ *hooksV1alpha4.ShutdownParams,
+) (*hooksV1alpha4.ShutdownResult, error) {
+	return &hooksV1alpha4.ShutdownResult{}, s.err
+}
+
 type testCase struct {
 	socketPath string
 	info       infoServer
 	callback   callbackServer
+	// callbackV1alpha4 replaces the v1alpha3 callback server when set
+	callbackV1alpha4 *callbackServerV1alpha4
 
 	// error from the Run(), will be read on Stop()
 	errch  chan error
@@ -146,7 +188,11 @@ func (t *testCase) Run() {
 		defer socket.Close()
 
 		hooksInfo.RegisterInfoServer(server, &t.info)
-		hooksV1alpha3.RegisterCallbacksServer(server, &t.callback)
+		if t.callbackV1alpha4 != nil {
+			hooksV1alpha4.RegisterCallbacksServer(server, t.callbackV1alpha4)
+		} else {
+			hooksV1alpha3.RegisterCallbacksServer(server, &t.callback)
+		}
 
 		GinkgoWriter.Printf("Starting hook server exposing 'info' services on socket %s\n", t.socketPath)
 		grpcDone <- server.Serve(socket)
@@ -329,6 +375,156 @@ var _ = Describe("HooksManager", func() {
 			})
 		})
 
+		It("Should order the sidecars of a hook point by priority and name", func() {
+			hooks := []struct {
+				hookName string
+				priority int32
+			}{
+				{"hook-b", 0},
+				{"hook-c", 10},
+				{"hook-a", 0},
+			}
+			for _, hook := range hooks {
+				t := newTestCase(socketDir, hook.hookName)
+				t.info.HookPoints = append(t.info.HookPoints, &hooksInfo.HookPoint{
+					Name:     hooksInfo.OnDefineDomainHookPointName,
+					Priority: hook.priority,
+				})
+				t.Run()
+				DeferCleanup(func() { Expect(t.Stop()).ToNot(HaveOccurred()) })
+			}
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(uint(len(hooks)), collectTimeout)).To(Succeed())
+
+			var names []string
+			for _, callback := range manager.CallbacksPerHookPoint[hooksInfo.OnDefineDomainHookPointName] {
+				names = append(names, callback.Name)
+			}
+			Expect(names).To(Equal([]string{"hook-c", "hook-a", "hook-b"}))
+		})
+
+		Context("with a v1alpha4 sidecar", func() {
+			var (
+				t          *testCase
+				domainSpec *virtwrapApi.DomainSpec
+				vmi        *v1.VirtualMachineInstance
+			)
+
+			BeforeEach(func() {
+				t = newTestCase(socketDir, "hook1")
+				t.info.Versions = []string{hooksV1alpha4.Version, hooksV1alpha3.Version}
+				t.callbackV1alpha4 = &callbackServerV1alpha4{}
+
+				domainSpec = &virtwrapApi.DomainSpec{}
+				Expect(xml.Unmarshal(domainXML, domainSpec)).To(Succeed())
+				vmi = &v1.VirtualMachineInstance{}
+			})
+
+			AfterEach(func() {
+				Expect(t.Stop()).To(Succeed())
+			})
+
+			collect := func(hookPoint *hooksInfo.HookPoint) *hookManager {
+				t.info.HookPoints = []*hooksInfo.HookPoint{hookPoint}
+				t.Run()
+				manager := newManager(socketDir)
+				ExpectWithOffset(1, manager.Collect(1, collectTimeout)).To(Succeed())
+				ExpectWithOffset(1, manager.CallbacksPerHookPoint[hookPoint.Name][0].Version).To(Equal(hooksV1alpha4.Version))
+				return manager
+			}
+
+			It("should apply the streamed patches to the domain", func() {
+				t.callbackV1alpha4.results = []*hooksV1alpha4.OnDefineDomainResult{
+					{Message: "patching the domain name"},
+					{Patches: []*hooksV1alpha4.DomainPatch{
+						{Op: "test", Path: "/Name", Value: []byte(`"mynamespace_testvmi"`)},
+						{Op: "replace", Path: "/Name", Value: []byte(`"patched"`)},
+					}},
+				}
+				manager := collect(&hooksInfo.HookPoint{Name: hooksInfo.OnDefineDomainHookPointName})
+
+				resultXML, err := manager.OnDefineDomain(domainSpec, vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				resultSpec := &virtwrapApi.DomainSpec{}
+				Expect(xml.Unmarshal([]byte(resultXML), resultSpec)).To(Succeed())
+				Expect(resultSpec.Name).To(Equal("patched"))
+				resultSpec.Name = domainSpec.Name
+				Expect(resultSpec).To(Equal(domainSpec))
+			})
+
+			It("should concatenate the streamed domain chunks", func() {
+				modified := domainSpec.DeepCopy()
+				modified.Name = "streamed"
+				modifiedXML, err := xml.Marshal(modified)
+				Expect(err).ToNot(HaveOccurred())
+				middle := len(modifiedXML) / 2
+				t.callbackV1alpha4.results = []*hooksV1alpha4.OnDefineDomainResult{
+					{DomainXML: modifiedXML[:middle]},
+					{DomainXML: modifiedXML[middle:]},
+				}
+				manager := collect(&hooksInfo.HookPoint{Name: hooksInfo.OnDefineDomainHookPointName})
+
+				resultXML, err := manager.OnDefineDomain(domainSpec, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resultXML).To(Equal(string(modifiedXML)))
+			})
+
+			It("should fail on a failed call by default", func() {
+				t.callbackV1alpha4.err = fmt.Errorf("hook failure")
+				manager := collect(&hooksInfo.HookPoint{Name: hooksInfo.OnDefineDomainHookPointName})
+
+				_, err := manager.OnDefineDomain(domainSpec, vmi)
+				Expect(err).To(MatchError(ContainSubstring("hook failure")))
+			})
+
+			It("should fail on an invalid patch", func() {
+				t.callbackV1alpha4.results = []*hooksV1alpha4.OnDefineDomainResult{
+					{Patches: []*hooksV1alpha4.DomainPatch{{Op: "move", Path: "/Name"}}},
+				}
+				manager := collect(&hooksInfo.HookPoint{Name: hooksInfo.OnDefineDomainHookPointName})
+
+				_, err := manager.OnDefineDomain(domainSpec, vmi)
+				Expect(err).To(MatchError(ContainSubstring("invalid domain patch")))
+			})
+
+			It("should continue with the unmodified domain when failures are ignored", func() {
+				t.callbackV1alpha4.err = fmt.Errorf("hook failure")
+				manager := collect(&hooksInfo.HookPoint{
+					Name:          hooksInfo.OnDefineDomainHookPointName,
+					FailurePolicy: hooksInfo.FailurePolicyIgnore,
+				})
+
+				resultXML, err := manager.OnDefineDomain(domainSpec, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				expectedXML, err := xml.MarshalIndent(domainSpec, "", "\t")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resultXML).To(Equal(string(expectedXML)))
+			})
+
+			It("should time out according to the hook point", func() {
+				t.callbackV1alpha4.delay = 2 * time.Second
+				manager := collect(&hooksInfo.HookPoint{
+					Name:           hooksInfo.OnDefineDomainHookPointName,
+					TimeoutSeconds: 1,
+				})
+
+				_, err := manager.OnDefineDomain(domainSpec, vmi)
+				Expect(err).To(MatchError(ContainSubstring("DeadlineExceeded")))
+			})
+
+			It("should ignore failed Shutdown calls when requested", func() {
+				t.callbackV1alpha4.err = fmt.Errorf("hook failure")
+				manager := collect(&hooksInfo.HookPoint{
+					Name:          hooksInfo.ShutdownHookPointName,
+					FailurePolicy: hooksInfo.FailurePolicyIgnore,
+				})
+
+				Expect(manager.Shutdown()).To(Succeed())
+			})
+		})
+
 		AfterEach(func() {
 			os.RemoveAll(socketDir)
 		})
@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api_v1alpha4.proto
+
+/*
+Package v1alpha4 is a generated protocol buffer package.
+
+It is generated from these files:
+
+	api_v1alpha4.proto
+
+It has these top-level messages:
+
+	OnDefineDomainParams
+	OnDefineDomainResult
+	DomainPatch
+	PreCloudInitIsoParams
+	PreCloudInitIsoResult
+	ShutdownParams
+	ShutdownResult
+*/
+package v1alpha4
+
+import (
+	fmt "fmt"
+
+	proto "github.com/golang/protobuf/proto"
+
+	math "math"
+
+	context "golang.org/x/net/context"
+
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type OnDefineDomainParams struct {
+	// domainXML is original libvirt domain specification
+	DomainXML []byte `protobuf:"bytes,1,opt,name=domainXML,proto3" json:"domainXML,omitempty"`
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,2,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *OnDefineDomainParams) Reset()                    { *m = OnDefineDomainParams{} }
+func (m *OnDefineDomainParams) String() string            { return proto.CompactTextString(m) }
+func (*OnDefineDomainParams) ProtoMessage()               {}
+func (*OnDefineDomainParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *OnDefineDomainParams) GetDomainXML() []byte {
+	if m != nil {
+		return m.DomainXML
+	}
+	return nil
+}
+
+func (m *OnDefineDomainParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type OnDefineDomainResult struct {
+	// domainXML is a chunk of the processed libvirt domain specification, the chunks of all results are
+	// concatenated. When no chunk is sent, the patches are applied to the original domain specification
+	DomainXML []byte `protobuf:"bytes,1,opt,name=domainXML,proto3" json:"domainXML,omitempty"`
+	// patches are applied to the domain specification after all results have been received
+	Patches []*DomainPatch `protobuf:"bytes,2,rep,name=patches" json:"patches,omitempty"`
+	// message is a progress message logged by virt-launcher
+	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *OnDefineDomainResult) Reset()                    { *m = OnDefineDomainResult{} }
+func (m *OnDefineDomainResult) String() string            { return proto.CompactTextString(m) }
+func (*OnDefineDomainResult) ProtoMessage()               {}
+func (*OnDefineDomainResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *OnDefineDomainResult) GetDomainXML() []byte {
+	if m != nil {
+		return m.DomainXML
+	}
+	return nil
+}
+
+func (m *OnDefineDomainResult) GetPatches() []*DomainPatch {
+	if m != nil {
+		return m.Patches
+	}
+	return nil
+}
+
+func (m *OnDefineDomainResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// DomainPatch is a JSON patch (RFC 6902) operation on the JSON representation of the domain specification
+// used by virt-launcher (kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api.DomainSpec),
+// e.g. {op: "replace", path: "/Devices/Disks/0/Driver/Cache", value: "\"none\""}
+type DomainPatch struct {
+	// op is one of add, remove, replace or test
+	Op string `protobuf:"bytes,1,opt,name=op" json:"op,omitempty"`
+	// path is a JSON pointer to the patched element or attribute
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// value is the JSON encoded value of the element or attribute
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *DomainPatch) Reset()                    { *m = DomainPatch{} }
+func (m *DomainPatch) String() string            { return proto.CompactTextString(m) }
+func (*DomainPatch) ProtoMessage()               {}
+func (*DomainPatch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *DomainPatch) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *DomainPatch) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DomainPatch) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type PreCloudInitIsoParams struct {
+	// cloudInitData is an object of CloudInitData encoded as JSON
+	CloudInitData []byte `protobuf:"bytes,1,opt,name=cloudInitData,proto3" json:"cloudInitData,omitempty"`
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,2,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *PreCloudInitIsoParams) Reset()                    { *m = PreCloudInitIsoParams{} }
+func (m *PreCloudInitIsoParams) String() string            { return proto.CompactTextString(m) }
+func (*PreCloudInitIsoParams) ProtoMessage()               {}
+func (*PreCloudInitIsoParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *PreCloudInitIsoParams) GetCloudInitData() []byte {
+	if m != nil {
+		return m.CloudInitData
+	}
+	return nil
+}
+
+func (m *PreCloudInitIsoParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type PreCloudInitIsoResult struct {
+	// cloudInitData is an object of CloudInitData encoded as JSON
+	CloudInitData []byte `protobuf:"bytes,1,opt,name=cloudInitData,proto3" json:"cloudInitData,omitempty"`
+}
+
+func (m *PreCloudInitIsoResult) Reset()                    { *m = PreCloudInitIsoResult{} }
+func (m *PreCloudInitIsoResult) String() string            { return proto.CompactTextString(m) }
+func (*PreCloudInitIsoResult) ProtoMessage()               {}
+func (*PreCloudInitIsoResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *PreCloudInitIsoResult) GetCloudInitData() []byte {
+	if m != nil {
+		return m.CloudInitData
+	}
+	return nil
+}
+
+type ShutdownParams struct {
+}
+
+func (m *ShutdownParams) Reset()                    { *m = ShutdownParams{} }
+func (m *ShutdownParams) String() string            { return proto.CompactTextString(m) }
+func (*ShutdownParams) ProtoMessage()               {}
+func (*ShutdownParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+type ShutdownResult struct {
+}
+
+func (m *ShutdownResult) Reset()                    { *m = ShutdownResult{} }
+func (m *ShutdownResult) String() string            { return proto.CompactTextString(m) }
+func (*ShutdownResult) ProtoMessage()               {}
+func (*ShutdownResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func init() {
+	proto.RegisterType((*OnDefineDomainParams)(nil), "kubevirt.hooks.v1alpha4.OnDefineDomainParams")
+	proto.RegisterType((*OnDefineDomainResult)(nil), "kubevirt.hooks.v1alpha4.OnDefineDomainResult")
+	proto.RegisterType((*DomainPatch)(nil), "kubevirt.hooks.v1alpha4.DomainPatch")
+	proto.RegisterType((*PreCloudInitIsoParams)(nil), "kubevirt.hooks.v1alpha4.PreCloudInitIsoParams")
+	proto.RegisterType((*PreCloudInitIsoResult)(nil), "kubevirt.hooks.v1alpha4.PreCloudInitIsoResult")
+	proto.RegisterType((*ShutdownParams)(nil), "kubevirt.hooks.v1alpha4.ShutdownParams")
+	proto.RegisterType((*ShutdownResult)(nil), "kubevirt.hooks.v1alpha4.ShutdownResult")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Callbacks service
+
+type CallbacksClient interface {
+	// OnDefineDomain streams the changes of the hook to the domain, they are applied in the order they are sent
+	OnDefineDomain(ctx context.Context, in *OnDefineDomainParams, opts ...grpc.CallOption) (Callbacks_OnDefineDomainClient, error)
+	PreCloudInitIso(ctx context.Context, in *PreCloudInitIsoParams, opts ...grpc.CallOption) (*PreCloudInitIsoResult, error)
+	Shutdown(ctx context.Context, in *ShutdownParams, opts ...grpc.CallOption) (*ShutdownResult, error)
+}
+
+type callbacksClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCallbacksClient(cc *grpc.ClientConn) CallbacksClient {
+	return &callbacksClient{cc}
+}
+
+func (c *callbacksClient) OnDefineDomain(ctx context.Context, in *OnDefineDomainParams, opts ...grpc.CallOption) (Callbacks_OnDefineDomainClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Callbacks_serviceDesc.Streams[0], c.cc, "/kubevirt.hooks.v1alpha4.Callbacks/OnDefineDomain", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &callbacksOnDefineDomainClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Callbacks_OnDefineDomainClient interface {
+	Recv() (*OnDefineDomainResult, error)
+	grpc.ClientStream
+}
+
+type callbacksOnDefineDomainClient struct {
+	grpc.ClientStream
+}
+
+func (x *callbacksOnDefineDomainClient) Recv() (*OnDefineDomainResult, error) {
+	m := new(OnDefineDomainResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *callbacksClient) PreCloudInitIso(ctx context.Context, in *PreCloudInitIsoParams, opts ...grpc.CallOption) (*PreCloudInitIsoResult, error) {
+	out := new(PreCloudInitIsoResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/PreCloudInitIso", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) Shutdown(ctx context.Context, in *ShutdownParams, opts ...grpc.CallOption) (*ShutdownResult, error) {
+	out := new(ShutdownResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/Shutdown", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Callbacks service
+
+type CallbacksServer interface {
+	// OnDefineDomain streams the changes of the hook to the domain, they are applied in the order they are sent
+	OnDefineDomain(*OnDefineDomainParams, Callbacks_OnDefineDomainServer) error
+	PreCloudInitIso(context.Context, *PreCloudInitIsoParams) (*PreCloudInitIsoResult, error)
+	Shutdown(context.Context, *ShutdownParams) (*ShutdownResult, error)
+}
+
+func RegisterCallbacksServer(s *grpc.Server, srv CallbacksServer) {
+	s.RegisterService(&_Callbacks_serviceDesc, srv)
+}
+
+func _Callbacks_OnDefineDomain_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OnDefineDomainParams)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CallbacksServer).OnDefineDomain(m, &callbacksOnDefineDomainServer{stream})
+}
+
+type Callbacks_OnDefineDomainServer interface {
+	Send(*OnDefineDomainResult) error
+	grpc.ServerStream
+}
+
+type callbacksOnDefineDomainServer struct {
+	grpc.ServerStream
+}
+
+func (x *callbacksOnDefineDomainServer) Send(m *OnDefineDomainResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Callbacks_PreCloudInitIso_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreCloudInitIsoParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).PreCloudInitIso(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/PreCloudInitIso",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).PreCloudInitIso(ctx, req.(*PreCloudInitIsoParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).Shutdown(ctx, req.(*ShutdownParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Callbacks_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubevirt.hooks.v1alpha4.Callbacks",
+	HandlerType: (*CallbacksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreCloudInitIso",
+			Handler:    _Callbacks_PreCloudInitIso_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _Callbacks_Shutdown_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "OnDefineDomain",
+			Handler:       _Callbacks_OnDefineDomain_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api_v1alpha4.proto",
+}
+
+func init() { proto.RegisterFile("api_v1alpha4.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xdf, 0x4e, 0xea, 0x40,
+	0x10, 0xc6, 0xd3, 0x72, 0xce, 0xe1, 0x74, 0xe0, 0x70, 0xc8, 0x04, 0x63, 0x43, 0xbc, 0x20, 0x0d,
+	0x89, 0xdc, 0xd8, 0x28, 0x7a, 0xab, 0x37, 0x10, 0x0d, 0x89, 0x06, 0x52, 0x6f, 0xbc, 0x30, 0x31,
+	0x43, 0x59, 0x6d, 0x43, 0xdb, 0x5d, 0xbb, 0xdb, 0xfa, 0x16, 0x3e, 0x9a, 0xcf, 0x64, 0xec, 0x9f,
+	0x08, 0x48, 0x03, 0x77, 0x3b, 0x5f, 0x67, 0xbe, 0x9d, 0xf9, 0x4d, 0x17, 0x90, 0x84, 0xff, 0x94,
+	0x9e, 0x51, 0x20, 0x3c, 0xba, 0xb0, 0x45, 0xcc, 0x15, 0xc7, 0xc3, 0x65, 0x32, 0x67, 0xa9, 0x1f,
+	0x2b, 0xdb, 0xe3, 0x7c, 0x29, 0xed, 0xf2, 0xb3, 0x75, 0x0d, 0x9d, 0x69, 0x34, 0x66, 0xcf, 0x7e,
+	0xc4, 0xc6, 0x3c, 0x24, 0x3f, 0x9a, 0x51, 0x4c, 0xa1, 0xc4, 0x23, 0x30, 0x16, 0x59, 0xfc, 0x70,
+	0x77, 0x6b, 0x6a, 0x3d, 0x6d, 0xd0, 0x74, 0xbe, 0x05, 0x6c, 0x43, 0x2d, 0x0d, 0x7d, 0x53, 0xcf,
+	0xf4, 0xaf, 0xa3, 0xf5, 0xae, 0x6d, 0x1a, 0x39, 0x4c, 0x26, 0x81, 0xda, 0x61, 0x74, 0x05, 0x75,
+	0x41, 0xca, 0xf5, 0x98, 0x34, 0xf5, 0x5e, 0x6d, 0xd0, 0x18, 0xf6, 0xed, 0x8a, 0x4e, 0xed, 0xb2,
+	0x3d, 0xe5, 0x7a, 0x4e, 0x59, 0x84, 0x26, 0xd4, 0x43, 0x26, 0x25, 0xbd, 0x30, 0xb3, 0xd6, 0xd3,
+	0x06, 0x86, 0x53, 0x86, 0xd6, 0x0d, 0x34, 0x56, 0x2a, 0xb0, 0x05, 0x3a, 0x17, 0xd9, 0xfd, 0x86,
+	0xa3, 0x73, 0x81, 0x08, 0xbf, 0x04, 0x29, 0x2f, 0x1b, 0xc1, 0x70, 0xb2, 0x33, 0x76, 0xe0, 0x77,
+	0x4a, 0x41, 0x92, 0x5b, 0x35, 0x9d, 0x3c, 0xb0, 0xa6, 0x70, 0x30, 0x8b, 0xd9, 0x28, 0xe0, 0xc9,
+	0x62, 0x12, 0xf9, 0x6a, 0x22, 0x79, 0x81, 0xa8, 0x0f, 0xff, 0xdc, 0x52, 0x1d, 0x93, 0xa2, 0x62,
+	0xba, 0x75, 0x71, 0x0b, 0xaa, 0xcb, 0x1f, 0x86, 0x05, 0xaa, 0xbd, 0x0c, 0xad, 0x36, 0xb4, 0xee,
+	0xbd, 0x44, 0x2d, 0xf8, 0x5b, 0xb1, 0xab, 0x55, 0x25, 0x77, 0x1a, 0x7e, 0xe8, 0x60, 0x8c, 0x28,
+	0x08, 0xe6, 0xe4, 0x2e, 0x25, 0x0a, 0x68, 0xad, 0xaf, 0x06, 0x4f, 0x2a, 0x29, 0x6f, 0xfb, 0x19,
+	0xba, 0xfb, 0xa6, 0xe7, 0xb7, 0x9f, 0x6a, 0xf8, 0x0a, 0xff, 0x37, 0x46, 0x44, 0xbb, 0xd2, 0x63,
+	0x2b, 0xdd, 0xee, 0xde, 0xf9, 0x05, 0xbc, 0x47, 0xf8, 0x5b, 0x42, 0xc0, 0xe3, 0xca, 0xda, 0x75,
+	0x72, 0xdd, 0xdd, 0x89, 0xb9, 0xfb, 0xfc, 0x4f, 0xf6, 0x8c, 0xce, 0x3f, 0x07, 0x00, 0x18, 0xe7,
+	0xbd, 0xa2, 0x5c, 0x03, 0x00, 0x00,
+}
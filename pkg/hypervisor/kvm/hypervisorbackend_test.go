@@ -257,6 +257,29 @@ var _ = Describe("GetMemoryOverhead calculation", func() {
 		})
 	})
 
+	When("the vmi has virtiofs filesystems with DAX windows", func() {
+		BeforeEach(func() {
+			vmi.Spec.Domain.Devices = v1.Devices{
+				Filesystems: []v1.Filesystem{
+					{Name: "plain", Virtiofs: &v1.FilesystemVirtiofs{}},
+					{Name: "dax1", Virtiofs: &v1.FilesystemVirtiofs{DAXWindowSize: pointer.P(resource.MustParse("64Mi"))}},
+					{Name: "dax2", Virtiofs: &v1.FilesystemVirtiofs{DAXWindowSize: pointer.P(resource.MustParse("1Gi"))}},
+				},
+			}
+		})
+
+		It("should add the size of the DAX windows", func() {
+			expected := resource.NewScaledQuantity(0, resource.Kilo)
+			expected.Add(*baseOverhead)
+			expected.Add(*staticOverhead)
+			expected.Add(*videoRAMOverhead)
+			expected.Add(*coresOverhead)
+			expected.Add(resource.MustParse("1088Mi"))
+			overhead := kvm.NewKvmHypervisorBackend().GetMemoryOverhead(vmi, "amd64", nil)
+			Expect(overhead.Value()).To(BeEquivalentTo(expected.Value()))
+		})
+	})
+
 	When("the additionalOverheadRatio is provided", func() {
 		DescribeTable("should adjust the overhead using the given ratio", func(additionalOverheadRatio string, expectParseError bool) {
 			base := resource.NewScaledQuantity(0, resource.Kilo)
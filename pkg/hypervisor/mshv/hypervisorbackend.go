@@ -31,6 +31,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 const (
@@ -153,6 +154,9 @@ func (k *MshvHypervisorBackend) GetMemoryOverhead(vmi *v1.VirtualMachineInstance
 		overhead.Add(resource.MustParse("53Mi"))
 	}
 
+	// The DAX windows of the virtiofs filesystems are mapped by qemu and filled with the pages of the shared files
+	overhead.Add(virtiofs.DAXWindowsSize(vmi))
+
 	if vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed() {
 		overhead.Add(resource.MustParse("100Mi"))
 	}
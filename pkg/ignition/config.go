@@ -0,0 +1,157 @@
+/*
+ * This file is part of the kubevirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ignition
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+)
+
+// MergeVersion is the Ignition spec version of the config merging several fragments.
+// Ignition only merges configs of its own major version, so all fragments must be spec 3 configs.
+const MergeVersion = "3.0.0"
+
+type ignitionConfig struct {
+	Ignition ignitionSection `json:"ignition"`
+}
+
+type ignitionSection struct {
+	Version string          `json:"version"`
+	Config  *ignitionMerges `json:"config,omitempty"`
+}
+
+type ignitionMerges struct {
+	Merge []ignitionResource `json:"merge"`
+}
+
+type ignitionResource struct {
+	Source string `json:"source"`
+}
+
+// UsesFirmwareConfig returns true if the Ignition config of the VMI is passed to the guest through
+// the QEMU firmware configuration device.
+func UsesFirmwareConfig(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Ignition != nil {
+		return vmi.Spec.Ignition.Propagation != v1.IgnitionPropagationConfigDrive
+	}
+	return strings.Contains(vmi.Annotations[v1.IgnitionAnnotation], "ignition")
+}
+
+// UsesConfigDrive returns true if the Ignition config of the VMI is passed to the guest as the
+// user data of its cloudInitConfigDrive volume.
+func UsesConfigDrive(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Ignition != nil && vmi.Spec.Ignition.Propagation == v1.IgnitionPropagationConfigDrive
+}
+
+// ValidateConfig checks that data is an Ignition config. Configs which are merged must be spec 3 configs.
+func ValidateConfig(data string, merged bool) error {
+	cfg := ignitionConfig{}
+	if err := json.Unmarshal([]byte(data), &cfg); err != nil {
+		return fmt.Errorf("invalid Ignition config: %v", err)
+	}
+	if cfg.Ignition.Version == "" {
+		return fmt.Errorf("invalid Ignition config: ignition.version is not set")
+	}
+	if merged && !strings.HasPrefix(cfg.Ignition.Version, "3.") {
+		return fmt.Errorf("config version %s can not be merged, only Ignition spec 3 configs can", cfg.Ignition.Version)
+	}
+	return nil
+}
+
+// MergeConfigs returns a single config as is, and otherwise a config merging all of them in order.
+func MergeConfigs(configs []string) (string, error) {
+	if len(configs) == 1 {
+		return configs[0], nil
+	}
+
+	merges := &ignitionMerges{}
+	for i, data := range configs {
+		if err := ValidateConfig(data, true); err != nil {
+			return "", fmt.Errorf("fragment %d: %v", i, err)
+		}
+		merges.Merge = append(merges.Merge, ignitionResource{
+			Source: "data:;base64," + base64.StdEncoding.EncodeToString([]byte(data)),
+		})
+	}
+
+	merged, err := json.Marshal(ignitionConfig{
+		Ignition: ignitionSection{Version: MergeVersion, Config: merges},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+// ReadConfig returns the Ignition config of the VMI, merging the fragments read from the
+// Secret and ConfigMap volumes mounted in the virt-launcher pod with the inline ones.
+func ReadConfig(vmi *v1.VirtualMachineInstance) (string, error) {
+	if vmi.Spec.Ignition == nil {
+		return vmi.Annotations[v1.IgnitionAnnotation], nil
+	}
+
+	var configs []string
+	for i, fragment := range vmi.Spec.Ignition.Fragments {
+		data, err := readFragment(fragment, vmi.Spec.Volumes)
+		if err != nil {
+			return "", fmt.Errorf("fragment %d: %v", i, err)
+		}
+		configs = append(configs, data)
+	}
+	if len(configs) == 0 {
+		return "", fmt.Errorf("no Ignition fragment found")
+	}
+	return MergeConfigs(configs)
+}
+
+func readFragment(fragment v1.IgnitionFragment, volumes []v1.Volume) (string, error) {
+	if fragment.VolumeName == "" {
+		return fragment.Data, nil
+	}
+
+	for _, volume := range volumes {
+		if volume.Name != fragment.VolumeName {
+			continue
+		}
+		var sourcePath string
+		switch {
+		case volume.Secret != nil:
+			sourcePath = config.GetSecretSourcePath(volume.Name)
+		case volume.ConfigMap != nil:
+			sourcePath = config.GetConfigMapSourcePath(volume.Name)
+		default:
+			return "", fmt.Errorf("volume %s is neither a Secret nor a ConfigMap", volume.Name)
+		}
+		data, err := os.ReadFile(filepath.Join(sourcePath, v1.IgnitionConfigKey))
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return "", fmt.Errorf("volume %s not found", fragment.VolumeName)
+}
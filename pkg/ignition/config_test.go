@@ -0,0 +1,142 @@
+/*
+ * This file is part of the kubevirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ignition
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Ignition config", func() {
+	const (
+		usersFragment = `{"ignition":{"version":"3.4.0"},"passwd":{"users":[{"name":"core"}]}}`
+		filesFragment = `{"ignition":{"version":"3.2.0"},"storage":{"files":[{"path":"/etc/hostname"}]}}`
+		spec2Fragment = `{"ignition":{"version":"2.2.0"}}`
+	)
+
+	mergedSources := func(data string) []string {
+		cfg := ignitionConfig{}
+		Expect(json.Unmarshal([]byte(data), &cfg)).To(Succeed())
+		Expect(cfg.Ignition.Version).To(Equal(MergeVersion))
+		Expect(cfg.Ignition.Config).ToNot(BeNil())
+
+		var sources []string
+		for _, merge := range cfg.Ignition.Config.Merge {
+			encoded, found := strings.CutPrefix(merge.Source, "data:;base64,")
+			Expect(found).To(BeTrue())
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			Expect(err).ToNot(HaveOccurred())
+			sources = append(sources, string(decoded))
+		}
+		return sources
+	}
+
+	DescribeTable("should validate", func(data string, merged bool, matcher OmegaMatcher) {
+		Expect(ValidateConfig(data, merged)).To(matcher)
+	},
+		Entry("a spec 3 config", usersFragment, true, Succeed()),
+		Entry("a spec 2 config which is not merged", spec2Fragment, false, Succeed()),
+		Entry("a spec 2 config which is merged", spec2Fragment, true, MatchError(ContainSubstring("can not be merged"))),
+		Entry("a config without version", `{"passwd":{}}`, false, MatchError(ContainSubstring("ignition.version is not set"))),
+		Entry("a config which is no JSON", "#cloud-config", false, MatchError(ContainSubstring("invalid Ignition config"))),
+	)
+
+	Context("merging fragments", func() {
+		It("should pass a single fragment as is", func() {
+			Expect(MergeConfigs([]string{spec2Fragment})).To(Equal(spec2Fragment))
+		})
+
+		It("should merge several fragments in order", func() {
+			merged, err := MergeConfigs([]string{usersFragment, filesFragment})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mergedSources(merged)).To(Equal([]string{usersFragment, filesFragment}))
+		})
+
+		It("should reject a spec 2 fragment", func() {
+			_, err := MergeConfigs([]string{usersFragment, spec2Fragment})
+			Expect(err).To(MatchError(ContainSubstring("fragment 1")))
+		})
+	})
+
+	Context("reading the config of a VirtualMachineInstance", func() {
+		BeforeEach(func() {
+			secretSourceDir, configMapSourceDir := config.SecretSourceDir, config.ConfigMapSourceDir
+			DeferCleanup(func() {
+				config.SecretSourceDir, config.ConfigMapSourceDir = secretSourceDir, configMapSourceDir
+			})
+			config.SecretSourceDir = filepath.Join(GinkgoT().TempDir(), "secret")
+			config.ConfigMapSourceDir = filepath.Join(GinkgoT().TempDir(), "config-map")
+
+			writeFragment := func(dir, data string) {
+				Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(dir, v1.IgnitionConfigKey), []byte(data), 0644)).To(Succeed())
+			}
+			writeFragment(config.GetSecretSourcePath("users"), usersFragment)
+			writeFragment(config.GetConfigMapSourcePath("files"), filesFragment)
+		})
+
+		It("should read the annotation without fragments", func() {
+			vmi := libvmi.New(libvmi.WithAnnotation(v1.IgnitionAnnotation, spec2Fragment))
+			Expect(ReadConfig(vmi)).To(Equal(spec2Fragment))
+		})
+
+		It("should merge the Secret, ConfigMap and inline fragments", func() {
+			const inlineFragment = `{"ignition":{"version":"3.0.0"},"systemd":{}}`
+			vmi := libvmi.New(
+				libvmi.WithSecretDisk("users-secret", "users"),
+				libvmi.WithConfigMapDisk("files-configmap", "files"),
+				libvmi.WithIgnition("",
+					v1.IgnitionFragment{VolumeName: "users"},
+					v1.IgnitionFragment{VolumeName: "files"},
+					v1.IgnitionFragment{Data: inlineFragment},
+				),
+			)
+			merged, err := ReadConfig(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mergedSources(merged)).To(Equal([]string{usersFragment, filesFragment, inlineFragment}))
+		})
+
+		It("should fail if the volume of a fragment does not exist", func() {
+			vmi := libvmi.New(libvmi.WithIgnition("", v1.IgnitionFragment{VolumeName: "users"}))
+			_, err := ReadConfig(vmi)
+			Expect(err).To(MatchError(ContainSubstring("volume users not found")))
+		})
+
+		It("should fail if the volume of a fragment is neither a Secret nor a ConfigMap", func() {
+			vmi := libvmi.New(
+				libvmi.WithContainerDisk("users", "quay.io/containerdisks/fedora-coreos"),
+				libvmi.WithIgnition("", v1.IgnitionFragment{VolumeName: "users"}),
+			)
+			_, err := ReadConfig(vmi)
+			Expect(err).To(MatchError(ContainSubstring("neither a Secret nor a ConfigMap")))
+		})
+	})
+})
@@ -63,18 +63,21 @@ func GetDomainBasePath(domain string, namespace string) string {
 
 func GenerateIgnitionLocalData(vmi *v1.VirtualMachineInstance, namespace string) error {
 	precond.MustNotBeEmpty(vmi.Name)
-	precond.MustNotBeNil(vmi.Annotations[v1.IgnitionAnnotation])
+
+	ignitionData, err := ReadConfig(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to read the Ignition config: %v", err)
+	}
 
 	domainBasePath := GetDomainBasePath(vmi.Name, namespace)
-	err := util.MkdirAllWithNosec(domainBasePath)
+	err = util.MkdirAllWithNosec(domainBasePath)
 	if err != nil {
 		log.Log.Reason(err).Errorf("unable to create Ignition base path %s", domainBasePath)
 		return err
 	}
 
 	ignitionFile := fmt.Sprintf("%s/%s", domainBasePath, IgnitionFile)
-	ignitionData := []byte(vmi.Annotations[v1.IgnitionAnnotation])
-	err = util.WriteFileWithNosec(ignitionFile, ignitionData)
+	err = util.WriteFileWithNosec(ignitionFile, []byte(ignitionData))
 	if err != nil {
 		return err
 	}
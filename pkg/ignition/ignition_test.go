@@ -66,5 +66,20 @@ var _ = Describe("Ignition", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+
+		Context("with ignition fragments", func() {
+			It("should write the config of the fragments", func() {
+				data := `{"ignition":{"version":"3.4.0"}}`
+				vmi = libvmi.New(
+					libvmi.WithNamespace(namespace),
+					libvmi.WithName(vmName),
+					libvmi.WithIgnition("", v1.IgnitionFragment{Data: data}),
+				)
+				Expect(GenerateIgnitionLocalData(vmi, namespace)).To(Succeed())
+				content, err := os.ReadFile(fmt.Sprintf("%s/%s/%s/%s", tmpDir, namespace, vmName, IgnitionFile))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal(data))
+			})
+		})
 	})
 })
@@ -109,6 +109,7 @@ const (
 	storeControllerRevisionErrFmt   = "error encountered while storing instancetype.kubevirt.io controllerRevisions: %v"
 	upgradeControllerRevisionErrFmt = "error encountered while upgrading instancetype.kubevirt.io controllerRevisions: %v"
 	cleanControllerRevisionErrFmt   = "error encountered cleaning controllerRevision %s after successfully expanding VirtualMachine %s: %v"
+	virtioDriversNotAttachedErrFmt  = "virtio-win drivers disk not attached: %v"
 )
 
 func (c *controller) Sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
@@ -256,5 +257,13 @@ func (c *controller) ApplyToVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMa
 		return fmt.Errorf("VMI conflicts with instancetype spec in fields: [%s]", conflicts.String())
 	}
 
+	if err := preferenceapply.ApplyVirtioDriversDisk(
+		preferenceSpec,
+		&vmi.Spec,
+		c.clusterConfig.GetVirtioDriversConfiguration(),
+	); err != nil {
+		c.recorder.Eventf(vm, corev1.EventTypeWarning, common.VirtioDriversNotConfiguredReason, virtioDriversNotAttachedErrFmt, err)
+	}
+
 	return nil
 }
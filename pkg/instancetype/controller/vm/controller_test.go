@@ -46,6 +46,7 @@ import (
 	"kubevirt.io/client-go/kubevirt/fake"
 
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
+	preferenceapply "kubevirt.io/kubevirt/pkg/instancetype/preference/apply"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -668,6 +669,51 @@ var _ = Describe("Instance type and Preference VirtualMachine Controller", func(
 			Expect(vmi.Annotations).ToNot(HaveKey(virtv1.PreferenceAnnotation))
 		})
 
+		Context("with a Windows VirtualMachinePreference", func() {
+			const driversImage = "registry.example.com/virtio-win:latest"
+
+			BeforeEach(func() {
+				preference.Spec.PreferredGuestOS = pointer.P(v1beta1.GuestOSWindows)
+				Expect(preferenceInformerStore.Update(preference)).To(Succeed())
+
+				vm.Spec.Preference = &virtv1.PreferenceMatcher{
+					Name: preference.Name,
+					Kind: instancetypeapi.SingularPreferenceResourceName,
+				}
+				vmi.Spec.Domain.Devices.AutoattachVirtioDriversDisk = pointer.P(true)
+			})
+
+			It("should attach the configured virtio-win drivers disk to the VirtualMachineInstance", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &virtv1.KubeVirt{
+					Spec: virtv1.KubeVirtSpec{
+						Configuration: virtv1.KubeVirtConfiguration{
+							Instancetype: &virtv1.InstancetypeConfiguration{
+								VirtioDrivers: &virtv1.VirtioDriversConfiguration{Image: driversImage},
+							},
+						},
+					},
+				})
+
+				Expect(instancetypeController.ApplyToVMI(vm, vmi)).To(Succeed())
+
+				Expect(vmi.Spec.Volumes).To(ContainElement(virtv1.Volume{
+					Name: preferenceapply.VirtioDriversVolumeName,
+					VolumeSource: virtv1.VolumeSource{
+						ContainerDisk: &virtv1.ContainerDiskSource{Image: driversImage},
+					},
+				}))
+				Expect(vm.Spec.Template.Spec.Volumes).To(BeEmpty())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should record a warning event when no virtio-win drivers disk source is configured", func() {
+				Expect(instancetypeController.ApplyToVMI(vm, vmi)).To(Succeed())
+
+				Expect(vmi.Spec.Volumes).ToNot(ContainElement(HaveField("Name", preferenceapply.VirtioDriversVolumeName)))
+				testutils.ExpectEvent(recorder, common.VirtioDriversNotConfiguredReason)
+			})
+		})
+
 		DescribeTable("should fail to sync with FailedFindPreference reason",
 			func(matcher *virtv1.PreferenceMatcher) {
 				vm.Spec.Preference = matcher
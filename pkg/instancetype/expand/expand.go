@@ -28,6 +28,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/instancetype/apply"
 	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+	preferenceApply "kubevirt.io/kubevirt/pkg/instancetype/preference/apply"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	utils "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -106,6 +107,8 @@ func (e *expander) Expand(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, er
 		return nil, conflicts
 	}
 
+	preferenceApply.ApplyDataVolumeTemplatePreferences(preferenceSpec, expandedVM.Spec.DataVolumeTemplates)
+
 	// Apply defaults to VM.Spec.Template.Spec after applying instance types to ensure we don't conflict
 	if err := defaults.SetDefaultVirtualMachineInstanceSpec(e.clusterConfig, &expandedVM.Spec.Template.Spec); err != nil {
 		return nil, err
@@ -21,6 +21,8 @@ package infer_test
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -29,7 +31,9 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
 	apiinstancetype "kubevirt.io/api/instancetype"
@@ -1245,4 +1249,57 @@ var _ = Describe("InferFromVolume", func() {
 		Entry("it should clear guest memory when ignoring inference failures", v1.IgnoreInferFromVolumeFailure, true),
 		Entry("it should not clear guest memory when rejecting inference failures", v1.RejectInferFromVolumeFailure, false),
 	)
+
+	It("should look up a DataSource once for concurrent inferences", func() {
+		const concurrentInferences = 10
+
+		cdiClient := virtClient.CdiClient().(*cdifake.Clientset)
+		var gets atomic.Int32
+		started := make(chan struct{})
+		release := make(chan struct{})
+		cdiClient.PrependReactor("get", "datasources", func(k8stesting.Action) (bool, runtime.Object, error) {
+			if gets.Add(1) == 1 {
+				close(started)
+			}
+			<-release
+			return false, nil, nil
+		})
+
+		newVM := func() *v1.VirtualMachine {
+			poolVM := vm.DeepCopy()
+			poolVM.Spec.Instancetype = &v1.InstancetypeMatcher{InferFromVolume: inferVolumeName}
+			poolVM.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "dataVolume"},
+				Spec: cdiv1.DataVolumeSpec{
+					SourceRef: &cdiv1.DataVolumeSourceRef{Name: dsWithLabelsName, Kind: "DataSource"},
+				},
+			}}
+			poolVM.Spec.Template.Spec.Volumes = []v1.Volume{{
+				Name:         inferVolumeName,
+				VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dataVolume"}},
+			}}
+			return poolVM
+		}
+
+		var wg sync.WaitGroup
+		inferDefaults := func(poolVM *v1.VirtualMachine) {
+			defer GinkgoRecover()
+			defer wg.Done()
+			Expect(handler.Infer(poolVM)).To(Succeed())
+			Expect(poolVM.Spec.Instancetype).To(Equal(&v1.InstancetypeMatcher{
+				Name: defaultInferedNameFromDS,
+				Kind: defaultInferedKindFromDS,
+			}))
+		}
+		wg.Add(concurrentInferences)
+		go inferDefaults(newVM())
+		Eventually(started).Should(BeClosed())
+		for i := 1; i < concurrentInferences; i++ {
+			go inferDefaults(newVM())
+		}
+		Consistently(gets.Load).Should(Equal(int32(1)))
+		close(release)
+		wg.Wait()
+		Expect(gets.Load()).To(Equal(int32(1)))
+	})
 })
@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 
+	"golang.org/x/sync/singleflight"
+	k8sv1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -38,6 +40,21 @@ const (
 	unsupportedDataVolumeSourceRefFmt = "unable to infer defaults from DataVolumeSourceRef as Kind %s is not supported"
 )
 
+// lookups batches the concurrent lookups of the same object into a single request to the API server, e.g. when a
+// VirtualMachinePool scales out and all of its VirtualMachines infer their defaults from the same DataSource.
+var lookups singleflight.Group
+
+func lookup[T any](kind, namespace, name string, get func() (T, error)) (T, error) {
+	obj, err, _ := lookups.Do(kind+"/"+namespace+"/"+name, func() (interface{}, error) {
+		return get()
+	})
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return obj.(T), nil
+}
+
 /*
 Defaults will be inferred from the following combinations of DataVolumeSources, DataVolumeTemplates, DataSources and PVCs:
 
@@ -77,7 +94,9 @@ func fromLabels(labels map[string]string, defaultNameLabel, defaultKindLabel str
 }
 
 func (h *handler) fromPVC(pvcName, pvcNamespace, defaultNameLabel, defaultKindLabel string) (defaultName, defaultKind string, err error) {
-	pvc, err := h.virtClient.CoreV1().PersistentVolumeClaims(pvcNamespace).Get(context.Background(), pvcName, metav1.GetOptions{})
+	pvc, err := lookup("PersistentVolumeClaim", pvcNamespace, pvcName, func() (*k8sv1.PersistentVolumeClaim, error) {
+		return h.virtClient.CoreV1().PersistentVolumeClaims(pvcNamespace).Get(context.Background(), pvcName, metav1.GetOptions{})
+	})
 	if err != nil {
 		return "", "", err
 	}
@@ -96,7 +115,9 @@ func (h *handler) fromDataVolume(
 			return h.fromDataVolumeSpec(&dvtSpec, defaultNameLabel, defaultKindLabel, vm.Namespace)
 		}
 	}
-	dv, err := h.virtClient.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Get(context.Background(), dvName, metav1.GetOptions{})
+	dv, err := lookup("DataVolume", vm.Namespace, dvName, func() (*cdiv1beta1.DataVolume, error) {
+		return h.virtClient.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Get(context.Background(), dvName, metav1.GetOptions{})
+	})
 	if err != nil {
 		// Handle garbage collected DataVolumes by attempting to lookup the PVC using the name of the DataVolume in the VM namespace
 		if k8serrors.IsNotFound(err) {
@@ -127,8 +148,10 @@ func (h *handler) fromDataVolumeSpec(
 func (h *handler) fromDataSource(
 	dataSourceName, dataSourceNamespace, defaultNameLabel, defaultKindLabel string,
 ) (defaultName, defaultKind string, err error) {
-	ds, err := h.virtClient.CdiClient().CdiV1beta1().DataSources(dataSourceNamespace).Get(
-		context.Background(), dataSourceName, metav1.GetOptions{})
+	ds, err := lookup("DataSource", dataSourceNamespace, dataSourceName, func() (*cdiv1beta1.DataSource, error) {
+		return h.virtClient.CdiClient().CdiV1beta1().DataSources(dataSourceNamespace).Get(
+			context.Background(), dataSourceName, metav1.GetOptions{})
+	})
 	if err != nil {
 		return "", "", err
 	}
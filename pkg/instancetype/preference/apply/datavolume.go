@@ -0,0 +1,40 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package apply
+
+import (
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+)
+
+// ApplyDataVolumeTemplatePreferences sets the preferred storage class on the DataVolumeTemplates of a VM.
+// This can't wait for the VMI to be created as the DataVolumes are created from the VM.
+func ApplyDataVolumeTemplatePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, dataVolumeTemplates []virtv1.DataVolumeTemplateSpec) {
+	if preferenceSpec == nil || preferenceSpec.Volumes == nil || preferenceSpec.Volumes.PreferredStorageClassName == "" {
+		return
+	}
+	for _, dv := range dataVolumeTemplates {
+		if dv.Spec.PVC != nil && dv.Spec.PVC.StorageClassName == nil {
+			dv.Spec.PVC.StorageClassName = &preferenceSpec.Volumes.PreferredStorageClassName
+		}
+		if dv.Spec.Storage != nil && dv.Spec.Storage.StorageClassName == nil {
+			dv.Spec.Storage.StorageClassName = &preferenceSpec.Volumes.PreferredStorageClassName
+		}
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/preference/apply"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Preference.Volumes.PreferredStorageClassName", func() {
+	const preferredStorageClassName = "preferred-storage-class"
+
+	var (
+		dataVolumeTemplates []virtv1.DataVolumeTemplateSpec
+		preferenceSpec      *v1beta1.VirtualMachinePreferenceSpec
+	)
+
+	BeforeEach(func() {
+		dataVolumeTemplates = []virtv1.DataVolumeTemplateSpec{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pvc",
+			},
+			Spec: cdiv1beta1.DataVolumeSpec{
+				PVC: &k8sv1.PersistentVolumeClaimSpec{},
+			},
+		}, {
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "storage",
+			},
+			Spec: cdiv1beta1.DataVolumeSpec{
+				Storage: &cdiv1beta1.StorageSpec{},
+			},
+		}}
+		preferenceSpec = &v1beta1.VirtualMachinePreferenceSpec{
+			Volumes: &v1beta1.VolumePreferences{
+				PreferredStorageClassName: preferredStorageClassName,
+			},
+		}
+	})
+
+	It("should apply to DataVolumeTemplates", func() {
+		apply.ApplyDataVolumeTemplatePreferences(preferenceSpec, dataVolumeTemplates)
+		Expect(dataVolumeTemplates[0].Spec.PVC.StorageClassName).To(HaveValue(Equal(preferredStorageClassName)))
+		Expect(dataVolumeTemplates[1].Spec.Storage.StorageClassName).To(HaveValue(Equal(preferredStorageClassName)))
+	})
+
+	It("should not overwrite user defined value", func() {
+		const userDefinedValue = "user-storage-class"
+		dataVolumeTemplates[0].Spec.PVC.StorageClassName = pointer.P(userDefinedValue)
+		dataVolumeTemplates[1].Spec.Storage.StorageClassName = pointer.P(userDefinedValue)
+		apply.ApplyDataVolumeTemplatePreferences(preferenceSpec, dataVolumeTemplates)
+		Expect(dataVolumeTemplates[0].Spec.PVC.StorageClassName).To(HaveValue(Equal(userDefinedValue)))
+		Expect(dataVolumeTemplates[1].Spec.Storage.StorageClassName).To(HaveValue(Equal(userDefinedValue)))
+	})
+})
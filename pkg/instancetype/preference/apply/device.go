@@ -102,6 +102,7 @@ func ApplyDevicePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec
 	applyInterfacePreferences(preferenceSpec, vmiSpec)
 	applyInputPreferences(preferenceSpec, vmiSpec)
 	applyPanicDevicePreferences(preferenceSpec, vmiSpec)
+	applyWatchdogPreferences(preferenceSpec, vmiSpec)
 }
 
 func applyInputPreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
@@ -131,3 +132,18 @@ func applyPanicDevicePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenc
 		panicDevice.Model = preferenceSpec.Devices.PreferredPanicDeviceModel
 	}
 }
+
+func applyWatchdogPreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	watchdog := vmiSpec.Domain.Devices.Watchdog
+	if preferenceSpec.Devices.PreferredWatchdogAction == nil || watchdog == nil {
+		return
+	}
+
+	// Only apply the preferred action when the user has not provided an action for the watchdog device already
+	if watchdog.I6300ESB != nil && watchdog.I6300ESB.Action == "" {
+		watchdog.I6300ESB.Action = *preferenceSpec.Devices.PreferredWatchdogAction
+	}
+	if watchdog.Diag288 != nil && watchdog.Diag288.Action == "" {
+		watchdog.Diag288.Action = *preferenceSpec.Devices.PreferredWatchdogAction
+	}
+}
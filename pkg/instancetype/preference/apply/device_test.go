@@ -356,6 +356,42 @@ var _ = Describe("Preference.Devices", func() {
 		)
 	})
 
+	Context("PreferredWatchdogAction", func() {
+		DescribeTable("should",
+			func(preferredWatchdogAction *virtv1.WatchdogAction, vmiWatchdog, expectedWatchdog *virtv1.Watchdog) {
+				vmi.Spec.Domain.Devices.Watchdog = vmiWatchdog
+				preferenceSpec.Devices.PreferredWatchdogAction = preferredWatchdogAction
+				Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+				Expect(vmi.Spec.Domain.Devices.Watchdog).To(Equal(expectedWatchdog))
+			},
+			Entry("not apply when preferredWatchdogAction is nil",
+				nil,
+				newWatchdog(&virtv1.I6300ESBWatchdog{}, nil),
+				newWatchdog(&virtv1.I6300ESBWatchdog{}, nil),
+			),
+			Entry("not apply when watchdog is not provided in the VMI spec",
+				pointer.P(virtv1.WatchdogActionInjectNMI),
+				nil,
+				nil,
+			),
+			Entry("apply to i6300esb watchdog without an action",
+				pointer.P(virtv1.WatchdogActionInjectNMI),
+				newWatchdog(&virtv1.I6300ESBWatchdog{}, nil),
+				newWatchdog(&virtv1.I6300ESBWatchdog{Action: virtv1.WatchdogActionInjectNMI}, nil),
+			),
+			Entry("apply to diag288 watchdog without an action",
+				pointer.P(virtv1.WatchdogActionDump),
+				newWatchdog(nil, &virtv1.Diag288Watchdog{}),
+				newWatchdog(nil, &virtv1.Diag288Watchdog{Action: virtv1.WatchdogActionDump}),
+			),
+			Entry("not apply when watchdog action is already set within VMI spec",
+				pointer.P(virtv1.WatchdogActionInjectNMI),
+				newWatchdog(&virtv1.I6300ESBWatchdog{Action: virtv1.WatchdogActionPoweroff}, nil),
+				newWatchdog(&virtv1.I6300ESBWatchdog{Action: virtv1.WatchdogActionPoweroff}, nil),
+			),
+		)
+	})
+
 	DescribeTable("PreferredAutoAttach should", func(preferenceValue, vmiValue *bool, match types.GomegaMatcher) {
 		type autoAttachField struct {
 			preference **bool
@@ -399,3 +435,13 @@ var _ = Describe("Preference.Devices", func() {
 		Entry("not apply false when VMI value is true", pointer.P(false), pointer.P(true), HaveValue(BeTrue())),
 	)
 })
+
+func newWatchdog(i6300esb *virtv1.I6300ESBWatchdog, diag288 *virtv1.Diag288Watchdog) *virtv1.Watchdog {
+	return &virtv1.Watchdog{
+		Name: "watchdog",
+		WatchdogDevice: virtv1.WatchdogDevice{
+			I6300ESB: i6300esb,
+			Diag288:  diag288,
+		},
+	}
+}
@@ -22,8 +22,12 @@ package apply
 import (
 	virtv1 "kubevirt.io/api/core/v1"
 	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
+const windowsHypervSpinlocksRetries = 8191
+
 func applyFeaturePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
 	if preferenceSpec.Features == nil {
 		return
@@ -121,3 +125,69 @@ func applyHyperVFeaturePreferences(preferenceSpec *v1beta1.VirtualMachinePrefere
 		vmiSpec.Domain.Features.Hyperv.VendorID = preferenceSpec.Features.PreferredHyperv.VendorID.DeepCopy()
 	}
 }
+
+// applyWindowsHyperVDefaults enables the complete set of Hyper-V enlightenments recommended for Windows guests.
+// Enlightenments already configured on the VirtualMachineInstance are left untouched and enlightenments depending
+// on a disabled one are skipped, keeping the resulting set valid. Host support is enforced through the Hyper-V node
+// selectors rendered for the virt-launcher pod.
+func applyWindowsHyperVDefaults(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferenceSpec.PreferredGuestOS == nil || *preferenceSpec.PreferredGuestOS != v1beta1.GuestOSWindows {
+		return
+	}
+
+	if vmiSpec.Domain.Features == nil {
+		vmiSpec.Domain.Features = &virtv1.Features{}
+	}
+	if vmiSpec.Domain.Features.Hyperv == nil {
+		vmiSpec.Domain.Features.Hyperv = &virtv1.FeatureHyperv{}
+	}
+	hyperv := vmiSpec.Domain.Features.Hyperv
+
+	for _, state := range []**virtv1.FeatureState{
+		&hyperv.Relaxed,
+		&hyperv.VAPIC,
+		&hyperv.VPIndex,
+		&hyperv.Runtime,
+		&hyperv.Reset,
+		&hyperv.Frequencies,
+		&hyperv.Reenlightenment,
+	} {
+		if *state == nil {
+			*state = &virtv1.FeatureState{Enabled: pointer.P(true)}
+		}
+	}
+
+	if hyperv.Spinlocks == nil {
+		hyperv.Spinlocks = &virtv1.FeatureSpinlocks{
+			FeatureState: virtv1.FeatureState{Enabled: pointer.P(true)},
+			Retries:      pointer.P(uint32(windowsHypervSpinlocksRetries)),
+		}
+	}
+
+	if !isFeatureStateEnabled(hyperv.VPIndex) {
+		return
+	}
+
+	if hyperv.IPI == nil {
+		hyperv.IPI = &virtv1.FeatureState{Enabled: pointer.P(true)}
+	}
+
+	if hyperv.TLBFlush == nil {
+		hyperv.TLBFlush = &virtv1.TLBFlush{FeatureState: virtv1.FeatureState{Enabled: pointer.P(true)}}
+	}
+
+	if hyperv.SyNIC == nil {
+		hyperv.SyNIC = &virtv1.FeatureState{Enabled: pointer.P(true)}
+	}
+
+	if hyperv.SyNICTimer == nil && isFeatureStateEnabled(hyperv.SyNIC) {
+		hyperv.SyNICTimer = &virtv1.SyNICTimer{
+			FeatureState: virtv1.FeatureState{Enabled: pointer.P(true)},
+			Direct:       &virtv1.FeatureState{Enabled: pointer.P(true)},
+		}
+	}
+}
+
+func isFeatureStateEnabled(state *virtv1.FeatureState) bool {
+	return state != nil && (state.Enabled == nil || *state.Enabled)
+}
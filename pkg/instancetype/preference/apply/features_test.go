@@ -110,4 +110,73 @@ var _ = Describe("Preference.Features", func() {
 		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
 		Expect(vmi.Spec.Domain.Features.Hyperv.EVMCS.Enabled).To(HaveValue(BeFalse()))
 	})
+
+	Context("with the windows guest OS hint", func() {
+		BeforeEach(func() {
+			preferenceSpec = &v1beta1.VirtualMachinePreferenceSpec{
+				PreferredGuestOS: pointer.P(v1beta1.GuestOSWindows),
+			}
+		})
+
+		It("should apply the complete set of Hyper-V enlightenments", func() {
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+			enabled := &virtv1.FeatureState{Enabled: pointer.P(true)}
+			Expect(vmi.Spec.Domain.Features.Hyperv).To(HaveValue(Equal(virtv1.FeatureHyperv{
+				Relaxed: enabled,
+				VAPIC:   enabled,
+				Spinlocks: &virtv1.FeatureSpinlocks{
+					FeatureState: *enabled,
+					Retries:      pointer.P(uint32(8191)),
+				},
+				VPIndex: enabled,
+				Runtime: enabled,
+				SyNIC:   enabled,
+				SyNICTimer: &virtv1.SyNICTimer{
+					FeatureState: *enabled,
+					Direct:       enabled,
+				},
+				Reset:           enabled,
+				Frequencies:     enabled,
+				Reenlightenment: enabled,
+				TLBFlush:        &virtv1.TLBFlush{FeatureState: *enabled},
+				IPI:             enabled,
+			})))
+		})
+
+		It("should not override enlightenments preferred explicitly", func() {
+			preferenceSpec.Features = &v1beta1.FeaturePreferences{
+				PreferredHyperv: &virtv1.FeatureHyperv{
+					Reenlightenment: &virtv1.FeatureState{Enabled: pointer.P(false)},
+				},
+			}
+
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Features.Hyperv.Reenlightenment.Enabled).To(HaveValue(BeFalse()))
+			Expect(vmi.Spec.Domain.Features.Hyperv.Frequencies.Enabled).To(HaveValue(BeTrue()))
+		})
+
+		It("should skip enlightenments depending on an enlightenment disabled in the VMI", func() {
+			vmi.Spec.Domain.Features = &virtv1.Features{
+				Hyperv: &virtv1.FeatureHyperv{
+					VPIndex: &virtv1.FeatureState{Enabled: pointer.P(false)},
+				},
+			}
+
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Features.Hyperv.VPIndex.Enabled).To(HaveValue(BeFalse()))
+			Expect(vmi.Spec.Domain.Features.Hyperv.SyNIC).To(BeNil())
+			Expect(vmi.Spec.Domain.Features.Hyperv.SyNICTimer).To(BeNil())
+			Expect(vmi.Spec.Domain.Features.Hyperv.IPI).To(BeNil())
+			Expect(vmi.Spec.Domain.Features.Hyperv.TLBFlush).To(BeNil())
+			Expect(vmi.Spec.Domain.Features.Hyperv.Relaxed.Enabled).To(HaveValue(BeTrue()))
+		})
+
+		It("should not apply Hyper-V enlightenments for linux guests", func() {
+			preferenceSpec.PreferredGuestOS = pointer.P(v1beta1.GuestOSLinux)
+
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Features).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package apply
+
+import (
+	"errors"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+)
+
+const VirtioDriversVolumeName = "virtio-drivers"
+
+var ErrVirtioDriversNotConfigured = errors.New("no virtio-win drivers disk source is configured on the cluster")
+
+// ApplyVirtioDriversDisk attaches the virtio-win drivers disk as a SATA CD-ROM to Windows guests requesting it
+// through autoattachVirtioDriversDisk. The disk is only added to the VirtualMachineInstance, leaving the
+// VirtualMachine untouched, so it is detached on the next start once the VirtualMachine stops requesting it.
+func ApplyVirtioDriversDisk(
+	preferenceSpec *v1beta1.VirtualMachinePreferenceSpec,
+	vmiSpec *virtv1.VirtualMachineInstanceSpec,
+	virtioDrivers *virtv1.VirtioDriversConfiguration,
+) error {
+	if preferenceSpec == nil || preferenceSpec.PreferredGuestOS == nil || *preferenceSpec.PreferredGuestOS != v1beta1.GuestOSWindows {
+		return nil
+	}
+	autoattach := vmiSpec.Domain.Devices.AutoattachVirtioDriversDisk
+	if autoattach == nil || !*autoattach {
+		return nil
+	}
+
+	volumeSource, err := virtioDriversVolumeSource(virtioDrivers)
+	if err != nil {
+		return err
+	}
+
+	// Leave a user defined disk or volume of the same name alone
+	for _, disk := range vmiSpec.Domain.Devices.Disks {
+		if disk.Name == VirtioDriversVolumeName {
+			return nil
+		}
+	}
+	for _, volume := range vmiSpec.Volumes {
+		if volume.Name == VirtioDriversVolumeName {
+			return nil
+		}
+	}
+
+	vmiSpec.Domain.Devices.Disks = append(vmiSpec.Domain.Devices.Disks, virtv1.Disk{
+		Name: VirtioDriversVolumeName,
+		DiskDevice: virtv1.DiskDevice{
+			CDRom: &virtv1.CDRomTarget{
+				Bus: virtv1.DiskBusSATA,
+			},
+		},
+	})
+	vmiSpec.Volumes = append(vmiSpec.Volumes, virtv1.Volume{
+		Name:         VirtioDriversVolumeName,
+		VolumeSource: volumeSource,
+	})
+
+	return nil
+}
+
+func virtioDriversVolumeSource(virtioDrivers *virtv1.VirtioDriversConfiguration) (virtv1.VolumeSource, error) {
+	switch {
+	case virtioDrivers == nil:
+		return virtv1.VolumeSource{}, ErrVirtioDriversNotConfigured
+	case virtioDrivers.PersistentVolumeClaimName != "":
+		return virtv1.VolumeSource{
+			PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: virtioDrivers.PersistentVolumeClaimName,
+				},
+			},
+		}, nil
+	case virtioDrivers.Image != "":
+		return virtv1.VolumeSource{
+			ContainerDisk: &virtv1.ContainerDiskSource{
+				Image: virtioDrivers.Image,
+			},
+		}, nil
+	default:
+		return virtv1.VolumeSource{}, ErrVirtioDriversNotConfigured
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/preference/apply"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Preference.PreferredGuestOS virtio-win drivers disk", func() {
+	const (
+		driversImage = "registry.example.com/virtio-win:latest"
+		driversClaim = "virtio-win"
+	)
+
+	var (
+		vmi            *virtv1.VirtualMachineInstance
+		preferenceSpec *v1beta1.VirtualMachinePreferenceSpec
+	)
+
+	BeforeEach(func() {
+		vmi = libvmi.New(libvmi.WithAutoattachVirtioDriversDisk(true))
+		preferenceSpec = &v1beta1.VirtualMachinePreferenceSpec{
+			PreferredGuestOS: pointer.P(v1beta1.GuestOSWindows),
+		}
+	})
+
+	DescribeTable("should attach the drivers disk as a SATA CD-ROM", func(virtioDrivers *virtv1.VirtioDriversConfiguration, expectedSource virtv1.VolumeSource) {
+		Expect(apply.ApplyVirtioDriversDisk(preferenceSpec, &vmi.Spec, virtioDrivers)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.Disks).To(ConsistOf(virtv1.Disk{
+			Name: apply.VirtioDriversVolumeName,
+			DiskDevice: virtv1.DiskDevice{
+				CDRom: &virtv1.CDRomTarget{Bus: virtv1.DiskBusSATA},
+			},
+		}))
+		Expect(vmi.Spec.Volumes).To(ConsistOf(virtv1.Volume{
+			Name:         apply.VirtioDriversVolumeName,
+			VolumeSource: expectedSource,
+		}))
+	},
+		Entry("from a containerDisk",
+			&virtv1.VirtioDriversConfiguration{Image: driversImage},
+			virtv1.VolumeSource{ContainerDisk: &virtv1.ContainerDiskSource{Image: driversImage}},
+		),
+		Entry("from a PersistentVolumeClaim taking precedence over the image",
+			&virtv1.VirtioDriversConfiguration{Image: driversImage, PersistentVolumeClaimName: driversClaim},
+			virtv1.VolumeSource{PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: driversClaim},
+			}},
+		),
+	)
+
+	DescribeTable("should not attach the drivers disk", func(mutate func()) {
+		mutate()
+		Expect(apply.ApplyVirtioDriversDisk(preferenceSpec, &vmi.Spec, &virtv1.VirtioDriversConfiguration{Image: driversImage})).To(Succeed())
+		Expect(vmi.Spec.Volumes).ToNot(ContainElement(HaveField("VolumeSource.ContainerDisk", Not(BeNil()))))
+	},
+		Entry("when autoattachVirtioDriversDisk is not set", func() {
+			vmi.Spec.Domain.Devices.AutoattachVirtioDriversDisk = nil
+		}),
+		Entry("when autoattachVirtioDriversDisk is false", func() {
+			vmi.Spec.Domain.Devices.AutoattachVirtioDriversDisk = pointer.P(false)
+		}),
+		Entry("when the preference does not indicate a guest OS", func() {
+			preferenceSpec.PreferredGuestOS = nil
+		}),
+		Entry("when the preference indicates a Linux guest", func() {
+			preferenceSpec.PreferredGuestOS = pointer.P(v1beta1.GuestOSLinux)
+		}),
+		Entry("when a volume of the same name is already defined", func() {
+			libvmi.WithPersistentVolumeClaim(apply.VirtioDriversVolumeName, driversClaim)(vmi)
+		}),
+	)
+
+	DescribeTable("should fail when no drivers disk source is configured", func(virtioDrivers *virtv1.VirtioDriversConfiguration) {
+		Expect(apply.ApplyVirtioDriversDisk(preferenceSpec, &vmi.Spec, virtioDrivers)).To(MatchError(apply.ErrVirtioDriversNotConfigured))
+		Expect(vmi.Spec.Volumes).To(BeEmpty())
+	},
+		Entry("without a configuration", nil),
+		Entry("with an empty configuration", &virtv1.VirtioDriversConfiguration{}),
+	)
+})
@@ -43,6 +43,7 @@ func (a *vmiApplier) Apply(
 	applyCPUPreferences(preferenceSpec, vmiSpec)
 	ApplyDevicePreferences(preferenceSpec, vmiSpec)
 	applyFeaturePreferences(preferenceSpec, vmiSpec)
+	applyWindowsHyperVDefaults(preferenceSpec, vmiSpec)
 	applyFirmwarePreferences(preferenceSpec, vmiSpec)
 	applyMachinePreferences(preferenceSpec, vmiSpec)
 	applyClockPreferences(preferenceSpec, vmiSpec)
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	virtv1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypeapiv1beta1 "kubevirt.io/api/instancetype/v1beta1"
 
@@ -53,9 +54,58 @@ func ValidatePreferenceSpec(field *k8sfield.Path, spec *instancetypeapiv1beta1.V
 
 	causes = append(causes, validatePreferredCPUTopology(field, spec)...)
 	causes = append(causes, validateSpreadOptions(field, spec)...)
+	causes = append(causes, validatePreferredSoundModel(field, spec)...)
+	causes = append(causes, validatePreferredVideoType(field, spec)...)
+	causes = append(causes, validatePreferredWatchdogAction(field, spec)...)
+	causes = append(causes, validatePreferredGuestOS(field, spec)...)
 	return causes
 }
 
+const (
+	preferredSoundModelUnknownErrFmt = "unknown preferredSoundModel %s"
+	preferredVideoTypeUnknownErrFmt  = "unknown preferredVideoType %s"
+	preferredWatchdogActionErrFmt    = "unknown preferredWatchdogAction %s"
+	preferredGuestOSUnknownErrFmt    = "unknown preferredGuestOS %s"
+)
+
+func validatePreferredSoundModel(field *k8sfield.Path, spec *instancetypeapiv1beta1.VirtualMachinePreferenceSpec) []metav1.StatusCause {
+	if spec.Devices == nil || spec.Devices.PreferredSoundModel == "" {
+		return nil
+	}
+	model := spec.Devices.PreferredSoundModel
+	if model != virtv1.SoundModelICH9 && model != virtv1.SoundModelAC97 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(preferredSoundModelUnknownErrFmt, model),
+			Field:   field.Child("devices", "preferredSoundModel").String(),
+		}}
+	}
+	return nil
+}
+
+func validatePreferredVideoType(field *k8sfield.Path, spec *instancetypeapiv1beta1.VirtualMachinePreferenceSpec) []metav1.StatusCause {
+	if spec.Devices == nil || spec.Devices.PreferredVideoType == nil {
+		return nil
+	}
+	// Architecture specific support is validated once the preference has been applied to a VirtualMachineInstance
+	supportedVideoTypes := []string{
+		virtv1.VideoTypeVGA,
+		virtv1.VideoTypeCirrus,
+		virtv1.VideoTypeVirtio,
+		virtv1.VideoTypeRamfb,
+		virtv1.VideoTypeBochs,
+	}
+	videoType := *spec.Devices.PreferredVideoType
+	if !slices.Contains(supportedVideoTypes, videoType) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(preferredVideoTypeUnknownErrFmt, videoType),
+			Field:   field.Child("devices", "preferredVideoType").String(),
+		}}
+	}
+	return nil
+}
+
 const preferredCPUTopologyUnknownErrFmt = "unknown preferredCPUTopology %s"
 
 func validatePreferredCPUTopology(field *k8sfield.Path, spec *instancetypeapiv1beta1.VirtualMachinePreferenceSpec) []metav1.StatusCause {
@@ -73,6 +123,36 @@ func validatePreferredCPUTopology(field *k8sfield.Path, spec *instancetypeapiv1b
 	return nil
 }
 
+func validatePreferredWatchdogAction(field *k8sfield.Path, spec *instancetypeapiv1beta1.VirtualMachinePreferenceSpec) []metav1.StatusCause {
+	if spec.Devices == nil || spec.Devices.PreferredWatchdogAction == nil {
+		return nil
+	}
+	action := *spec.Devices.PreferredWatchdogAction
+	if !slices.Contains(virtv1.SupportedWatchdogActions(), action) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(preferredWatchdogActionErrFmt, action),
+			Field:   field.Child("devices", "preferredWatchdogAction").String(),
+		}}
+	}
+	return nil
+}
+
+func validatePreferredGuestOS(field *k8sfield.Path, spec *instancetypeapiv1beta1.VirtualMachinePreferenceSpec) []metav1.StatusCause {
+	if spec.PreferredGuestOS == nil {
+		return nil
+	}
+	guestOS := *spec.PreferredGuestOS
+	if guestOS != instancetypeapiv1beta1.GuestOSWindows && guestOS != instancetypeapiv1beta1.GuestOSLinux {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(preferredGuestOSUnknownErrFmt, guestOS),
+			Field:   field.Child("preferredGuestOS").String(),
+		}}
+	}
+	return nil
+}
+
 const (
 	spreadAcrossCoresThreadsRatioErr = "only a ratio of 2 (1 core 2 threads) is allowed when spreading vCPUs over cores and threads"
 	spreadAcrossUnsupportedErrFmt    = "across %s is not supported"
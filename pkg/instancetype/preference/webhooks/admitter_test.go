@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	v1 "kubevirt.io/api/core/v1"
 	apiinstancetype "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 
@@ -84,6 +85,103 @@ var _ = Describe("Validating Preference Admitter", func() {
 		Expect(response.Result.Details.Causes[0].Field).To(Equal(k8sfield.NewPath("spec", "cpu", "preferredCPUTopology").String()))
 	})
 
+	It("should reject unsupported PreferredSoundModel value", func() {
+		preferenceObj.Spec.Devices = &instancetypev1beta1.DevicePreferences{
+			PreferredSoundModel: "foo",
+		}
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeFalse(), "Expected preference to not be allowed")
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+		Expect(response.Result.Details.Causes[0].Message).To(Equal("unknown preferredSoundModel foo"))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(k8sfield.NewPath("spec", "devices", "preferredSoundModel").String()))
+	})
+
+	It("should reject unsupported PreferredVideoType value", func() {
+		preferenceObj.Spec.Devices = &instancetypev1beta1.DevicePreferences{
+			PreferredVideoType: pointer.P("foo"),
+		}
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeFalse(), "Expected preference to not be allowed")
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+		Expect(response.Result.Details.Causes[0].Message).To(Equal("unknown preferredVideoType foo"))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(k8sfield.NewPath("spec", "devices", "preferredVideoType").String()))
+	})
+
+	It("should reject unsupported PreferredWatchdogAction value", func() {
+		preferenceObj.Spec.Devices = &instancetypev1beta1.DevicePreferences{
+			PreferredWatchdogAction: pointer.P(v1.WatchdogAction("foo")),
+		}
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeFalse(), "Expected preference to not be allowed")
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+		Expect(response.Result.Details.Causes[0].Message).To(Equal("unknown preferredWatchdogAction foo"))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(k8sfield.NewPath("spec", "devices", "preferredWatchdogAction").String()))
+	})
+
+	DescribeTable("should accept supported PreferredWatchdogAction", func(action v1.WatchdogAction) {
+		preferenceObj.Spec.Devices = &instancetypev1beta1.DevicePreferences{
+			PreferredWatchdogAction: pointer.P(action),
+		}
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeTrue(), "Expected preference to be allowed.")
+	},
+		Entry("poweroff", v1.WatchdogActionPoweroff),
+		Entry("reset", v1.WatchdogActionReset),
+		Entry("shutdown", v1.WatchdogActionShutdown),
+		Entry("inject-nmi", v1.WatchdogActionInjectNMI),
+		Entry("dump", v1.WatchdogActionDump),
+	)
+
+	It("should reject unsupported PreferredGuestOS value", func() {
+		preferenceObj.Spec.PreferredGuestOS = pointer.P(instancetypev1beta1.PreferredGuestOS("foo"))
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeFalse(), "Expected preference to not be allowed")
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+		Expect(response.Result.Details.Causes[0].Message).To(Equal("unknown preferredGuestOS foo"))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(k8sfield.NewPath("spec", "preferredGuestOS").String()))
+	})
+
+	DescribeTable("should accept supported PreferredGuestOS", func(guestOS instancetypev1beta1.PreferredGuestOS) {
+		preferenceObj.Spec.PreferredGuestOS = pointer.P(guestOS)
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeTrue(), "Expected preference to be allowed.")
+	},
+		Entry("windows", instancetypev1beta1.GuestOSWindows),
+		Entry("linux", instancetypev1beta1.GuestOSLinux),
+	)
+
+	DescribeTable("should accept supported sound and video preferences", func(soundModel, videoType string) {
+		preferenceObj.Spec.Devices = &instancetypev1beta1.DevicePreferences{
+			PreferredSoundModel: soundModel,
+			PreferredVideoType:  pointer.P(videoType),
+		}
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeTrue(), "Expected preference to be allowed.")
+	},
+		Entry("ich9 and virtio", v1.SoundModelICH9, v1.VideoTypeVirtio),
+		Entry("ac97 and bochs", v1.SoundModelAC97, v1.VideoTypeBochs),
+		Entry("ich9 and ramfb", v1.SoundModelICH9, v1.VideoTypeRamfb),
+		Entry("ac97 and vga", v1.SoundModelAC97, v1.VideoTypeVGA),
+	)
+
 	DescribeTable("should reject unsupported SpreadOptions Across value", func(preferredCPUTopology instancetypev1beta1.PreferredCPUTopology) {
 		var unsupportedAcrossValue instancetypev1beta1.SpreadAcross = "foobar"
 		preferenceObj = &instancetypev1beta1.VirtualMachinePreference{
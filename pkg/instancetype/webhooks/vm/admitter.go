@@ -21,6 +21,7 @@ package vm
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -67,10 +68,15 @@ type admitter struct {
 	requirementsChecker
 }
 
-func NewAdmitter(virtClient kubecli.KubevirtClient) *admitter {
+// NewAdmitter returns an admitter looking up instancetypes and preferences in the given stores first. Nil stores
+// and objects missing from the stores are looked up with the client instead.
+func NewAdmitter(
+	instancetypeStore, clusterInstancetypeStore, preferenceStore, clusterPreferenceStore cache.Store,
+	virtClient kubecli.KubevirtClient,
+) *admitter {
 	return &admitter{
-		instancetypeFinder:  find.NewSpecFinder(nil, nil, nil, virtClient),
-		preferenceFinder:    preferenceFind.NewSpecFinder(nil, nil, nil, virtClient),
+		instancetypeFinder:  find.NewSpecFinder(instancetypeStore, clusterInstancetypeStore, nil, virtClient),
+		preferenceFinder:    preferenceFind.NewSpecFinder(preferenceStore, clusterPreferenceStore, nil, virtClient),
 		requirementsChecker: requirements.New(),
 		applyVMIHandler:     apply.NewVMIApplier(),
 	}
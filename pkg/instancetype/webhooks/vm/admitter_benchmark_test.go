@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	webhook "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+// BenchmarkApplyToVMFromStores measures the admission of VirtualMachines of a pool scaling out at once,
+// all referencing the same instancetype. Run with -cpu to check that the cost per VirtualMachine stays
+// constant with the number of concurrent admissions.
+func BenchmarkApplyToVMFromStores(b *testing.B) {
+	clusterInstancetypeStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	if err := clusterInstancetypeStore.Add(&v1beta1.VirtualMachineClusterInstancetype{
+		ObjectMeta: metav1.ObjectMeta{Name: "u1.small"},
+		Spec: v1beta1.VirtualMachineInstancetypeSpec{
+			CPU:    v1beta1.CPUInstancetype{Guest: uint32(1)},
+			Memory: v1beta1.MemoryInstancetype{Guest: resource.MustParse("2Gi")},
+		},
+	}); err != nil {
+		b.Fatal(err)
+	}
+	admitter := webhook.NewAdmitter(nil, clusterInstancetypeStore, nil, nil,
+		kubecli.NewMockKubevirtClient(gomock.NewController(b)))
+	vm := libvmi.NewVirtualMachine(
+		libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)),
+		libvmi.WithClusterInstancetype("u1.small"),
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, _, causes := admitter.ApplyToVM(vm.DeepCopy()); len(causes) > 0 {
+				b.Errorf("unexpected causes: %v", causes)
+			}
+		}
+	})
+}
@@ -30,6 +30,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/instancetype/v1beta1"
@@ -135,7 +136,7 @@ var _ = Describe("Instance type and Preference VirtualMachine Admitter", func()
 				libvmi.WithPreference(preferenceName),
 			)
 
-			admitter = webhook.NewAdmitter(virtClient)
+			admitter = webhook.NewAdmitter(nil, nil, nil, nil, virtClient)
 		})
 
 		It("should reject if instancetype fails to apply to VMI", func() {
@@ -567,4 +568,44 @@ var _ = Describe("Instance type and Preference VirtualMachine Admitter", func()
 			),
 		)
 	})
+
+	Context("Given stores", func() {
+		It("should look up the instancetype and preference in the stores", func() {
+			// No expectations are set on the client, any lookup through it fails the test
+			virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+
+			clusterInstancetypeStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			Expect(clusterInstancetypeStore.Add(&v1beta1.VirtualMachineClusterInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "u1.small"},
+				Spec: v1beta1.VirtualMachineInstancetypeSpec{
+					CPU:    v1beta1.CPUInstancetype{Guest: uint32(1)},
+					Memory: v1beta1.MemoryInstancetype{Guest: resource.MustParse("2Gi")},
+				},
+			})).To(Succeed())
+			clusterPreferenceStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			Expect(clusterPreferenceStore.Add(&v1beta1.VirtualMachineClusterPreference{
+				ObjectMeta: metav1.ObjectMeta{Name: "fedora"},
+				Spec: v1beta1.VirtualMachinePreferenceSpec{
+					CPU: &v1beta1.CPUPreferences{PreferredCPUTopology: pointer.P(v1beta1.Cores)},
+				},
+			})).To(Succeed())
+
+			admitter := webhook.NewAdmitter(
+				cache.NewStore(cache.MetaNamespaceKeyFunc), clusterInstancetypeStore,
+				cache.NewStore(cache.MetaNamespaceKeyFunc), clusterPreferenceStore,
+				virtClient,
+			)
+			vm := libvmi.NewVirtualMachine(
+				libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)),
+				libvmi.WithClusterInstancetype("u1.small"),
+				libvmi.WithClusterPreference("fedora"),
+			)
+
+			instancetypeSpec, preferenceSpec, causes := admitter.ApplyToVM(vm)
+			Expect(causes).To(BeEmpty())
+			Expect(instancetypeSpec.CPU.Guest).To(Equal(uint32(1)))
+			Expect(preferenceSpec.CPU.PreferredCPUTopology).To(HaveValue(Equal(v1beta1.Cores)))
+			Expect(vm.Spec.Template.Spec.Domain.CPU.Cores).To(Equal(uint32(1)))
+		})
+	})
 })
@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/instancetype/v1beta1"
@@ -53,11 +54,12 @@ type mutator struct {
 	findPreferenceSpecHandler
 }
 
-func NewMutator(virtClient kubecli.KubevirtClient) *mutator {
+// NewMutator returns a mutator looking up preferences in the given stores first. Nil stores and objects missing
+// from the stores are looked up with the client instead.
+func NewMutator(preferenceStore, clusterPreferenceStore cache.Store, virtClient kubecli.KubevirtClient) *mutator {
 	return &mutator{
-		inferHandler: infer.New(virtClient),
-		// TODO(lyarwood): Wire up informers for use here to speed up lookups
-		findPreferenceSpecHandler: preferenceFind.NewSpecFinder(nil, nil, nil, virtClient),
+		inferHandler:              infer.New(virtClient),
+		findPreferenceSpecHandler: preferenceFind.NewSpecFinder(preferenceStore, clusterPreferenceStore, nil, virtClient),
 	}
 }
 
@@ -107,16 +109,7 @@ func mutateMachineType(vm *virtv1.VirtualMachine, preferenceSpec *v1beta1.Virtua
 // DataVolumeTemplates within the VM as it's obviously too late to do this
 // during VMI creation with the rest of the preferred preference values
 func mutateDataVolumeTemplates(vm *virtv1.VirtualMachine, preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) {
-	if preferenceSpec != nil && preferenceSpec.Volumes != nil && preferenceSpec.Volumes.PreferredStorageClassName != "" {
-		for _, dv := range vm.Spec.DataVolumeTemplates {
-			if dv.Spec.PVC != nil && dv.Spec.PVC.StorageClassName == nil {
-				dv.Spec.PVC.StorageClassName = &preferenceSpec.Volumes.PreferredStorageClassName
-			}
-			if dv.Spec.Storage != nil && dv.Spec.Storage.StorageClassName == nil {
-				dv.Spec.Storage.StorageClassName = &preferenceSpec.Volumes.PreferredStorageClassName
-			}
-		}
-	}
+	apply.ApplyDataVolumeTemplatePreferences(preferenceSpec, vm.Spec.DataVolumeTemplates)
 }
 
 func (m *mutator) validateMatchers(vm, oldVM *virtv1.VirtualMachine, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -53,6 +53,16 @@ func WithCloudInitConfigDrive(opts ...cloudinit.ConfigDriveOption) Option {
 	}
 }
 
+// WithIgnition sets the Ignition config made of the given fragments.
+func WithIgnition(propagation v1.IgnitionPropagation, fragments ...v1.IgnitionFragment) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Ignition = &v1.Ignition{
+			Fragments:   fragments,
+			Propagation: propagation,
+		}
+	}
+}
+
 func addDiskVolumeWithCloudInitConfigDrive(vmi *v1.VirtualMachineInstance, diskName string, bus v1.DiskBus) {
 	addDisk(vmi, newDisk(diskName, bus))
 	v := newVolume(diskName)
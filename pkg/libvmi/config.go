@@ -61,6 +61,14 @@ func WithSysprepConfigMap(volumeName, configMapName string) Option {
 	}
 }
 
+func WithSysprepSources(volumeName string, sources ...v1.SysprepProjection) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, newSysprepVolume(volumeName, &v1.SysprepSource{
+			Sources: sources,
+		}))
+	}
+}
+
 func WithLabelledConfigMapDisk(configMapName, volumeName, label string) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Spec.Volumes = append(vmi.Spec.Volumes, newConfigMapVolume(configMapName, volumeName, label))
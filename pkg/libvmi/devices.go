@@ -50,6 +50,12 @@ func WithAutoattachMemBalloon(enable bool) Option {
 	}
 }
 
+func WithAutoattachVirtioDriversDisk(enable bool) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.AutoattachVirtioDriversDisk = &enable
+	}
+}
+
 // WithRng adds `rng` to the vmi devices.
 func WithRng() Option {
 	return func(vmi *v1.VirtualMachineInstance) {
@@ -105,6 +111,16 @@ func WithAutoattachSerialConsole(enable bool) Option {
 	}
 }
 
+// WithSerialDevice adds an additional named serial device
+func WithSerialDevice(name string, serialType v1.SerialDeviceType) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.Serials = append(vmi.Spec.Domain.Devices.Serials, v1.SerialDevice{
+			Name: name,
+			Type: serialType,
+		})
+	}
+}
+
 func WithTPM(persistent bool) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{
@@ -67,11 +67,9 @@ func ValidateLiveUpdateMemory(vmSpec *v1.VirtualMachineInstanceSpec, maxGuest *r
 		return fmt.Errorf("Memory hotplug is not compatible with encrypted VMs")
 	}
 
-	blockAlignment := HotplugBlockAlignmentBytes
-	if domain.Memory != nil &&
-		domain.Memory.Hugepages != nil &&
-		domain.Memory.Hugepages.PageSize == "1Gi" {
-		blockAlignment = Hotplug1GHugePagesBlockAlignmentBytes
+	blockAlignment, err := hotplugBlockAlignment(domain.Memory)
+	if err != nil {
+		return err
 	}
 
 	if domain.Memory == nil ||
@@ -95,8 +93,8 @@ func ValidateLiveUpdateMemory(vmSpec *v1.VirtualMachineInstanceSpec, maxGuest *r
 		return fmt.Errorf("MaxGuest must be %s aligned", alignment)
 	}
 
-	if vmSpec.Architecture != "amd64" {
-		return fmt.Errorf("Memory hotplug is only available for x86_64 VMs")
+	if vmSpec.Architecture != "amd64" && vmSpec.Architecture != "arm64" {
+		return fmt.Errorf("Memory hotplug is only available for x86_64 and arm64 VMs")
 	}
 
 	if domain.Memory.Guest.Value() < requiredMinGuestMemory {
@@ -123,15 +121,13 @@ func BuildMemoryDevice(vmi *v1.VirtualMachineInstance) (*api.MemoryDevice, error
 		return nil, err
 	}
 
-	blockAlignment := HotplugBlockAlignmentBytes
-	if domain.Memory != nil &&
-		domain.Memory.Hugepages != nil &&
-		domain.Memory.Hugepages.PageSize == "1Gi" {
-		blockAlignment = Hotplug1GHugePagesBlockAlignmentBytes
+	blockAlignment, err := hotplugBlockAlignment(domain.Memory)
+	if err != nil {
+		return nil, err
 	}
 
 	return &api.MemoryDevice{
-		Model: "virtio-mem",
+		Model: api.MemoryDeviceModelVirtioMem,
 		Target: &api.MemoryTarget{
 			Size:      pluggableMemorySize,
 			Node:      "0",
@@ -140,3 +136,21 @@ func BuildMemoryDevice(vmi *v1.VirtualMachineInstance) (*api.MemoryDevice, error
 		},
 	}, nil
 }
+
+// hotplugBlockAlignment returns the virtio-mem block size to use for the given
+// memory configuration. The block size must match the backing hugepage size,
+// so only hugepage sizes which are valid virtio-mem block sizes are accepted.
+func hotplugBlockAlignment(memory *v1.Memory) (int64, error) {
+	if memory == nil || memory.Hugepages == nil {
+		return HotplugBlockAlignmentBytes, nil
+	}
+
+	switch memory.Hugepages.PageSize {
+	case "2Mi":
+		return HotplugBlockAlignmentBytes, nil
+	case "1Gi":
+		return Hotplug1GHugePagesBlockAlignmentBytes, nil
+	default:
+		return 0, fmt.Errorf("Memory hotplug is only compatible with 2Mi or 1Gi hugepages")
+	}
+}
@@ -74,14 +74,31 @@ var _ = Describe("LiveUpdate Memory", func() {
 					libvmi.WithGuestMemory("2G"),
 					libvmi.WithHugepages("1Gi"),
 				),
-				Entry("architecture is not amd64", "4Gi",
-					libvmi.WithArchitecture("arm64"),
+				Entry("hugepages size is not supported by virtio-mem", "4Gi",
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithHugepages("64Ki"),
+				),
+				Entry("architecture is neither amd64 nor arm64", "4Gi",
+					libvmi.WithArchitecture("s390x"),
 					libvmi.WithGuestMemory("1Gi"),
 				),
 				Entry("guest memory is less than 1Gi", "4Gi",
 					libvmi.WithGuestMemory("1022Mi"),
 				),
 			)
+
+			DescribeTable("should accept VM creation on", func(arch string) {
+				vm := libvmi.NewVirtualMachine(libvmi.New(
+					libvmi.WithArchitecture(arch),
+					libvmi.WithGuestMemory("1Gi"),
+				))
+
+				maxGuest := resource.MustParse("4Gi")
+				Expect(memory.ValidateLiveUpdateMemory(&vm.Spec.Template.Spec, &maxGuest)).To(Succeed())
+			},
+				Entry("amd64", "amd64"),
+				Entry("arm64", "arm64"),
+			)
 		})
 
 		Context("virtio-mem device", func() {
@@ -127,7 +144,7 @@ var _ = Describe("LiveUpdate Memory", func() {
 
 				Expect(memoryDevice).ToNot(BeNil())
 				Expect(*memoryDevice).To(Equal(api.MemoryDevice{
-					Model: "virtio-mem",
+					Model: api.MemoryDeviceModelVirtioMem,
 					Target: &api.MemoryTarget{
 						Size:      size,
 						Node:      "0",
@@ -140,6 +157,27 @@ var _ = Describe("LiveUpdate Memory", func() {
 				Entry("when using a VM with 2Mi sized hugepages", libvmi.WithHugepages("2Mi")),
 				Entry("when using a VM with 1Gi sized hugepages", libvmi.WithHugepages("1Gi")),
 			)
+
+			It("should fail to be built when using unsupported hugepages", func() {
+				currentGuestMemory := resource.MustParse("64Mi")
+
+				vmi := libvmi.New(
+					libvmi.WithArchitecture("amd64"),
+					libvmi.WithGuestMemory("128Mi"),
+					libvmi.WithMaxGuest("256Mi"),
+					libvmi.WithHugepages("64Ki"),
+				)
+				vmi.Status = v1.VirtualMachineInstanceStatus{
+					Memory: &v1.MemoryStatus{
+						GuestCurrent:   &currentGuestMemory,
+						GuestRequested: &currentGuestMemory,
+						GuestAtBoot:    &currentGuestMemory,
+					},
+				}
+
+				_, err := memory.BuildMemoryDevice(vmi)
+				Expect(err).To(MatchError("Memory hotplug is only compatible with 2Mi or 1Gi hugepages"))
+			})
 		})
 
 	})
@@ -61,6 +61,8 @@ type Stores struct {
 	Preference            cache.Store
 	ClusterPreference     cache.Store
 	ControllerRevision    cache.Store
+	VMRestore             cache.Store
+	VMExport              cache.Store
 }
 
 var (
@@ -69,6 +71,7 @@ var (
 		migrationMetrics,
 		perfscaleMetrics,
 		vmSnapshotMetrics,
+		storageWorkflowMetrics,
 	}
 
 	indexers       *Indexers
@@ -131,6 +134,10 @@ func SetupMetrics(
 		migrationStatsCollector,
 		vmiStatsCollector,
 		vmStatsCollector,
+		namespaceStatsCollector,
+		vmMetadataInfoCollector,
+		storageWorkflowCollector,
+		orphanedPodsCollector,
 	)
 }
 
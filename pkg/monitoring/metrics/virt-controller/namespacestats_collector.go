@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+)
+
+var (
+	namespaceStatsCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			namespaceRunningVMIs,
+			namespaceVCPUs,
+			namespaceMemory,
+			namespaceDiskAllocatedSize,
+		},
+		CollectCallback: namespaceStatsCollectorCallback,
+	}
+
+	namespaceRunningVMIs = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_running_vmis",
+			Help: "The number of running VirtualMachineInstances in a namespace.",
+		},
+		[]string{"namespace"},
+	)
+
+	namespaceVCPUs = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_vcpus",
+			Help: "The total number of vCPUs of the running VirtualMachineInstances in a namespace.",
+		},
+		[]string{"namespace"},
+	)
+
+	namespaceMemory = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_memory_committed_bytes",
+			Help: "The total guest memory of the running VirtualMachineInstances in a namespace in bytes.",
+		},
+		[]string{"namespace"},
+	)
+
+	namespaceDiskAllocatedSize = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_disk_allocated_size_bytes",
+			Help: "The total allocated disk size of the Virtual Machines in a namespace in bytes, based on their " +
+				"PersistentVolumeClaims. A PersistentVolumeClaim used by several Virtual Machines is counted once.",
+		},
+		[]string{"namespace"},
+	)
+)
+
+type namespaceStats struct {
+	runningVMIs int64
+	vcpus       int64
+	memory      int64
+	disk        int64
+	pvcs        sets.Set[string]
+}
+
+func namespaceStatsCollectorCallback() []operatormetrics.CollectorResult {
+	var vmis []*k6tv1.VirtualMachineInstance
+	if stores.VMI != nil {
+		for _, obj := range stores.VMI.List() {
+			vmis = append(vmis, obj.(*k6tv1.VirtualMachineInstance))
+		}
+	}
+
+	var vms []*k6tv1.VirtualMachine
+	if stores.VM != nil {
+		for _, obj := range stores.VM.List() {
+			vms = append(vms, obj.(*k6tv1.VirtualMachine))
+		}
+	}
+
+	return reportNamespaceStats(vmis, vms)
+}
+
+func reportNamespaceStats(vmis []*k6tv1.VirtualMachineInstance, vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	stats := map[string]*namespaceStats{}
+	statsFor := func(namespace string) *namespaceStats {
+		if _, exists := stats[namespace]; !exists {
+			stats[namespace] = &namespaceStats{pvcs: sets.New[string]()}
+		}
+		return stats[namespace]
+	}
+
+	for _, vmi := range vmis {
+		if !vmi.IsRunning() {
+			continue
+		}
+		nsStats := statsFor(vmi.Namespace)
+		nsStats.runningVMIs++
+		nsStats.vcpus += int64(vcpu.CalculateRequestedVCPUs(vcpu.GetCPUTopology(vmi)))
+		if memory := vcpu.GetVirtualMemory(vmi); memory != nil {
+			nsStats.memory += memory.Value()
+		}
+	}
+
+	for _, vm := range vms {
+		if vm.Spec.Template == nil {
+			continue
+		}
+		nsStats := statsFor(vm.Namespace)
+		for _, vol := range vm.Spec.Template.Spec.Volumes {
+			pvcName, _, isDataVolume := getPVCAndDiskName(vol)
+			if pvcName == "" || nsStats.pvcs.Has(pvcName) {
+				continue
+			}
+			pvc := getPVC(vm.Namespace, pvcName)
+			if pvc == nil {
+				continue
+			}
+			nsStats.pvcs.Insert(pvcName)
+			nsStats.disk += getAllocatedPVCSize(vm, pvc, isDataVolume).Value()
+		}
+	}
+
+	var crs []operatormetrics.CollectorResult
+	for namespace, nsStats := range stats {
+		crs = append(crs,
+			operatormetrics.CollectorResult{Metric: namespaceRunningVMIs, Value: float64(nsStats.runningVMIs), Labels: []string{namespace}},
+			operatormetrics.CollectorResult{Metric: namespaceVCPUs, Value: float64(nsStats.vcpus), Labels: []string{namespace}},
+			operatormetrics.CollectorResult{Metric: namespaceMemory, Value: float64(nsStats.memory), Labels: []string{namespace}},
+			operatormetrics.CollectorResult{Metric: namespaceDiskAllocatedSize, Value: float64(nsStats.disk), Labels: []string{namespace}},
+		)
+	}
+
+	return crs
+}
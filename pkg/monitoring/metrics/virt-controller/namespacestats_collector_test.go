@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Namespace Stats Collector", func() {
+	BeforeEach(func() {
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		stores.PersistentVolumeClaim = pvcInformer.GetIndexer()
+	})
+
+	newVMI := func(namespace, name string, phase k6tv1.VirtualMachineInstancePhase, sockets uint32, memory string) *k6tv1.VirtualMachineInstance {
+		return &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: k6tv1.VirtualMachineInstanceSpec{
+				Domain: k6tv1.DomainSpec{
+					CPU:    &k6tv1.CPU{Sockets: sockets, Cores: 1, Threads: 1},
+					Memory: &k6tv1.Memory{Guest: pointer.P(resource.MustParse(memory))},
+				},
+			},
+			Status: k6tv1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+	}
+
+	newVM := func(namespace, name string, claimNames ...string) *k6tv1.VirtualMachine {
+		vm := &k6tv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       k6tv1.VirtualMachineSpec{Template: &k6tv1.VirtualMachineInstanceTemplateSpec{}},
+		}
+		for _, claimName := range claimNames {
+			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, k6tv1.Volume{
+				Name: claimName,
+				VolumeSource: k6tv1.VolumeSource{
+					PersistentVolumeClaim: &k6tv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+					},
+				},
+			})
+		}
+		return vm
+	}
+
+	addPVC := func(namespace, name, size string) {
+		Expect(stores.PersistentVolumeClaim.Add(&k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				Resources: k8sv1.VolumeResourceRequirements{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+		})).To(Succeed())
+	}
+
+	valueOf := func(crs []operatormetrics.CollectorResult, metric operatormetrics.Metric, namespace string) float64 {
+		for _, cr := range crs {
+			if cr.Metric.GetOpts().Name == metric.GetOpts().Name && cr.Labels[0] == namespace {
+				return cr.Value
+			}
+		}
+		Fail("no result for " + metric.GetOpts().Name + " in namespace " + namespace)
+		return 0
+	}
+
+	It("should aggregate the running VMIs per namespace", func() {
+		vmis := []*k6tv1.VirtualMachineInstance{
+			newVMI("ns1", "vmi1", k6tv1.Running, 2, "1Gi"),
+			newVMI("ns1", "vmi2", k6tv1.Running, 4, "2Gi"),
+			newVMI("ns1", "vmi3", k6tv1.Pending, 8, "4Gi"),
+			newVMI("ns2", "vmi1", k6tv1.Running, 1, "512Mi"),
+		}
+
+		crs := reportNamespaceStats(vmis, nil)
+
+		Expect(valueOf(crs, namespaceRunningVMIs, "ns1")).To(Equal(2.0))
+		Expect(valueOf(crs, namespaceVCPUs, "ns1")).To(Equal(6.0))
+		Expect(valueOf(crs, namespaceMemory, "ns1")).To(Equal(float64(3 * 1024 * 1024 * 1024)))
+
+		Expect(valueOf(crs, namespaceRunningVMIs, "ns2")).To(Equal(1.0))
+		Expect(valueOf(crs, namespaceVCPUs, "ns2")).To(Equal(1.0))
+		Expect(valueOf(crs, namespaceMemory, "ns2")).To(Equal(float64(512 * 1024 * 1024)))
+	})
+
+	It("should aggregate the allocated storage of all VMs per namespace", func() {
+		addPVC("ns1", "disk1", "10Gi")
+		addPVC("ns1", "disk2", "5Gi")
+		addPVC("ns1", "shared", "1Gi")
+		addPVC("ns2", "disk1", "20Gi")
+
+		vms := []*k6tv1.VirtualMachine{
+			newVM("ns1", "vm1", "disk1", "shared"),
+			newVM("ns1", "vm2", "disk2", "shared", "missing"),
+			newVM("ns2", "vm1", "disk1"),
+		}
+
+		crs := reportNamespaceStats(nil, vms)
+
+		Expect(valueOf(crs, namespaceDiskAllocatedSize, "ns1")).To(Equal(float64(16 * 1024 * 1024 * 1024)))
+		Expect(valueOf(crs, namespaceDiskAllocatedSize, "ns2")).To(Equal(float64(20 * 1024 * 1024 * 1024)))
+		By("reporting namespaces without running VMIs")
+		Expect(valueOf(crs, namespaceRunningVMIs, "ns1")).To(BeZero())
+	})
+})
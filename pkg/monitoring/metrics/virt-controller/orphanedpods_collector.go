@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+var (
+	orphanedPodsCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			orphanedLauncherPods,
+		},
+		CollectCallback: orphanedPodsCollectorCallback,
+	}
+
+	orphanedLauncherPods = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_orphaned_launcher_pods",
+			Help: "The number of virt-launcher pods whose VirtualMachineInstance is gone and which are not terminating yet.",
+		},
+		[]string{"namespace"},
+	)
+)
+
+func orphanedPodsCollectorCallback() []operatormetrics.CollectorResult {
+	if indexers == nil || indexers.KVPod == nil || stores == nil || stores.VMI == nil {
+		return nil
+	}
+
+	var pods []*k8sv1.Pod
+	for _, obj := range indexers.KVPod.List() {
+		pods = append(pods, obj.(*k8sv1.Pod))
+	}
+
+	return reportOrphanedLauncherPods(pods, stores.VMI)
+}
+
+func reportOrphanedLauncherPods(pods []*k8sv1.Pod, vmiStore cache.Store) []operatormetrics.CollectorResult {
+	orphansPerNamespace := map[string]int{}
+
+	for _, pod := range pods {
+		if !controller.IsLauncherPod(pod) || pod.DeletionTimestamp != nil {
+			continue
+		}
+		orphaned, err := controller.IsOrphanedLauncherPod(pod, vmiStore)
+		if err != nil {
+			log.Log.Object(pod).Reason(err).Warning("Failed to check whether the virt-launcher pod is orphaned")
+			continue
+		}
+		if orphaned {
+			orphansPerNamespace[pod.Namespace]++
+		}
+	}
+
+	var crs []operatormetrics.CollectorResult
+	for namespace, count := range orphansPerNamespace {
+		crs = append(crs, operatormetrics.CollectorResult{
+			Metric: orphanedLauncherPods,
+			Labels: []string{namespace},
+			Value:  float64(count),
+		})
+	}
+
+	return crs
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Orphaned pods collector", func() {
+	newVMI := func(namespace, name string, uid types.UID) *k6tv1.VirtualMachineInstance {
+		return &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: uid},
+		}
+	}
+
+	newLauncherPod := func(namespace, vmiName string, vmiUID types.UID) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-launcher-" + vmiName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					k6tv1.AppLabel:       "virt-launcher",
+					k6tv1.CreatedByLabel: string(vmiUID),
+				},
+				Annotations: map[string]string{
+					k6tv1.DomainAnnotation: vmiName,
+				},
+			},
+		}
+	}
+
+	It("should report the orphaned launcher pods per namespace", func() {
+		vmiStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		Expect(vmiStore.Add(newVMI("ns1", "running", "running-uid"))).To(Succeed())
+		Expect(vmiStore.Add(newVMI("ns1", "recreated", "new-uid"))).To(Succeed())
+
+		terminating := newLauncherPod("ns2", "terminating", "terminating-uid")
+		terminating.DeletionTimestamp = &metav1.Time{}
+		notLauncher := newLauncherPod("ns2", "other", "other-uid")
+		notLauncher.Labels[k6tv1.AppLabel] = "other"
+
+		pods := []*k8sv1.Pod{
+			newLauncherPod("ns1", "running", "running-uid"),
+			newLauncherPod("ns1", "recreated", "old-uid"),
+			newLauncherPod("ns1", "deleted", "deleted-uid"),
+			newLauncherPod("ns2", "deleted", "deleted-uid"),
+			terminating,
+			notLauncher,
+		}
+
+		crs := reportOrphanedLauncherPods(pods, vmiStore)
+		Expect(crs).To(HaveLen(2))
+		values := map[string]float64{}
+		for _, cr := range crs {
+			Expect(cr.Metric.GetOpts().Name).To(Equal("kubevirt_orphaned_launcher_pods"))
+			values[cr.Labels[0]] = cr.Value
+		}
+		Expect(values).To(Equal(map[string]float64{"ns1": 2, "ns2": 1}))
+	})
+})
@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clonev1 "kubevirt.io/api/clone/v1beta1"
+	exportv1 "kubevirt.io/api/export/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+)
+
+const storageWorkflowTimeErrFmt = "Error encountered during storage workflow duration histogram calculation: %v"
+
+var (
+	storageWorkflowMetrics = []operatormetrics.Metric{
+		vmSnapshotTimeToReady,
+		vmSnapshotFailures,
+		vmRestoreTimeToComplete,
+		vmRestoreFailures,
+		vmCloneTimeToSucceed,
+		vmCloneFailures,
+		vmExportTimeToReady,
+	}
+
+	vmSnapshotTimeToReady = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_time_to_ready_seconds",
+			Help: "Histogram of the duration from the creation of virtual machine snapshots until they succeed, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	vmSnapshotFailures = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_failures_total",
+			Help: "The total number of failed virtual machine snapshots.",
+		},
+		[]string{"reason"},
+	)
+
+	vmRestoreTimeToComplete = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmrestore_time_to_complete_seconds",
+			Help: "Histogram of the duration from the creation of virtual machine restores until they complete, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	vmRestoreFailures = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmrestore_failures_total",
+			Help: "The total number of failed virtual machine restores.",
+		},
+		[]string{"reason"},
+	)
+
+	vmCloneTimeToSucceed = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmclone_time_to_succeed_seconds",
+			Help: "Histogram of the duration from the creation of virtual machine clones until they succeed, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	vmCloneFailures = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmclone_failures_total",
+			Help: "The total number of failed virtual machine clones.",
+		},
+		[]string{"reason"},
+	)
+
+	vmExportTimeToReady = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmexport_time_to_ready_seconds",
+			Help: "Histogram of the duration from the creation of virtual machine exports until they are ready, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+)
+
+// The handlers below are meant to be called once the status transition has been persisted,
+// so that a workflow is not accounted twice when the status update is retried.
+
+func HandleVMSnapshotSucceeded(snapshot *snapshotv1.VirtualMachineSnapshot) {
+	observeTimeSinceCreation(vmSnapshotTimeToReady, snapshot.CreationTimestamp)
+}
+
+func HandleVMSnapshotFailed(reason string) {
+	vmSnapshotFailures.WithLabelValues(reason).Inc()
+}
+
+func HandleVMRestoreCompleted(restore *snapshotv1.VirtualMachineRestore) {
+	observeTimeSinceCreation(vmRestoreTimeToComplete, restore.CreationTimestamp)
+}
+
+func HandleVMRestoreFailed(reason string) {
+	vmRestoreFailures.WithLabelValues(reason).Inc()
+}
+
+func HandleVMCloneSucceeded(vmClone *clonev1.VirtualMachineClone) {
+	observeTimeSinceCreation(vmCloneTimeToSucceed, vmClone.CreationTimestamp)
+}
+
+func HandleVMCloneFailed(reason string) {
+	vmCloneFailures.WithLabelValues(reason).Inc()
+}
+
+func HandleVMExportReady(vmExport *exportv1.VirtualMachineExport) {
+	observeTimeSinceCreation(vmExportTimeToReady, vmExport.CreationTimestamp)
+}
+
+func observeTimeSinceCreation(histogram prometheus.Histogram, creationTimestamp metav1.Time) {
+	now := metav1.NewTime(time.Now())
+	diffSeconds, err := getTransitionTimeSeconds(&creationTimestamp, &now)
+	if err != nil {
+		log.Log.V(logVerbosityDebug).Infof(storageWorkflowTimeErrFmt, err)
+		return
+	}
+
+	histogram.Observe(diffSeconds)
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clonev1 "kubevirt.io/api/clone/v1beta1"
+	exportv1 "kubevirt.io/api/export/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+var _ = Describe("Storage workflow metrics", func() {
+	creationTimestamp := metav1.NewTime(time.Now().Add(-time.Minute))
+	objectMeta := metav1.ObjectMeta{Name: "test", Namespace: "test-ns", CreationTimestamp: creationTimestamp}
+
+	getHistogram := func(histogram prometheus.Histogram) *ioprometheusclient.Histogram {
+		dto := &ioprometheusclient.Metric{}
+		Expect(histogram.Write(dto)).To(Succeed())
+		return dto.Histogram
+	}
+
+	getCounterValue := func(counter *operatormetrics.CounterVec, reason string) float64 {
+		dto := &ioprometheusclient.Metric{}
+		Expect(counter.WithLabelValues(reason).Write(dto)).To(Succeed())
+		return dto.Counter.GetValue()
+	}
+
+	DescribeTable("should observe the duration since creation", func(histogram prometheus.Histogram, handle func()) {
+		countBefore := getHistogram(histogram).GetSampleCount()
+		sumBefore := getHistogram(histogram).GetSampleSum()
+
+		handle()
+
+		Expect(getHistogram(histogram).GetSampleCount()).To(Equal(countBefore + 1))
+		Expect(getHistogram(histogram).GetSampleSum() - sumBefore).To(BeNumerically(">=", time.Minute.Seconds()))
+	},
+		Entry("of succeeded snapshots", vmSnapshotTimeToReady, func() {
+			HandleVMSnapshotSucceeded(&snapshotv1.VirtualMachineSnapshot{ObjectMeta: objectMeta})
+		}),
+		Entry("of completed restores", vmRestoreTimeToComplete, func() {
+			HandleVMRestoreCompleted(&snapshotv1.VirtualMachineRestore{ObjectMeta: objectMeta})
+		}),
+		Entry("of succeeded clones", vmCloneTimeToSucceed, func() {
+			HandleVMCloneSucceeded(&clonev1.VirtualMachineClone{ObjectMeta: objectMeta})
+		}),
+		Entry("of ready exports", vmExportTimeToReady, func() {
+			HandleVMExportReady(&exportv1.VirtualMachineExport{ObjectMeta: objectMeta})
+		}),
+	)
+
+	DescribeTable("should count the failures by reason", func(counter *operatormetrics.CounterVec, handle func(string)) {
+		const reason = "TestReason"
+		valueBefore := getCounterValue(counter, reason)
+
+		handle(reason)
+
+		Expect(getCounterValue(counter, reason)).To(Equal(valueBefore + 1))
+	},
+		Entry("of snapshots", vmSnapshotFailures, HandleVMSnapshotFailed),
+		Entry("of restores", vmRestoreFailures, HandleVMRestoreFailed),
+		Entry("of clones", vmCloneFailures, HandleVMCloneFailed),
+	)
+})
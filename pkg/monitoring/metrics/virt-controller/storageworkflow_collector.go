@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"time"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	corev1 "k8s.io/api/core/v1"
+
+	exportv1 "kubevirt.io/api/export/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+var (
+	storageWorkflowCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			vmRestoreInProgressSeconds,
+			vmExportNotReadySeconds,
+		},
+		CollectCallback: storageWorkflowCollectorCallback,
+	}
+
+	vmRestoreInProgressSeconds = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmrestore_in_progress_seconds",
+			Help: "Time in seconds since the creation of the virtual machine restores which are neither complete nor failed.",
+		},
+		[]string{"name", "namespace"},
+	)
+
+	vmExportNotReadySeconds = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmexport_not_ready_seconds",
+			Help: "Time in seconds since the pending virtual machine exports are not ready, labeled with the reason of their Ready condition.",
+		},
+		[]string{"name", "namespace", "reason"},
+	)
+)
+
+func storageWorkflowCollectorCallback() []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	if stores.VMRestore != nil {
+		var restores []*snapshotv1.VirtualMachineRestore
+		for _, obj := range stores.VMRestore.List() {
+			restores = append(restores, obj.(*snapshotv1.VirtualMachineRestore))
+		}
+		crs = append(crs, reportVMRestoresInProgress(restores, time.Now())...)
+	}
+
+	if stores.VMExport != nil {
+		var exports []*exportv1.VirtualMachineExport
+		for _, obj := range stores.VMExport.List() {
+			exports = append(exports, obj.(*exportv1.VirtualMachineExport))
+		}
+		crs = append(crs, reportVMExportsNotReady(exports, time.Now())...)
+	}
+
+	return crs
+}
+
+func reportVMRestoresInProgress(restores []*snapshotv1.VirtualMachineRestore, now time.Time) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	for _, restore := range restores {
+		if vmRestoreDone(restore) {
+			continue
+		}
+
+		crs = append(crs, operatormetrics.CollectorResult{
+			Metric: vmRestoreInProgressSeconds,
+			Labels: []string{restore.Name, restore.Namespace},
+			Value:  now.Sub(restore.CreationTimestamp.Time).Seconds(),
+		})
+	}
+
+	return crs
+}
+
+func vmRestoreDone(restore *snapshotv1.VirtualMachineRestore) bool {
+	if restore.Status == nil {
+		return false
+	}
+	if restore.Status.Complete != nil && *restore.Status.Complete {
+		return true
+	}
+	for _, condition := range restore.Status.Conditions {
+		if condition.Type == snapshotv1.ConditionFailure && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func reportVMExportsNotReady(exports []*exportv1.VirtualMachineExport, now time.Time) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	for _, vmExport := range exports {
+		if vmExport.Status == nil || vmExport.Status.Phase != exportv1.Pending {
+			continue
+		}
+
+		// Exports waiting for their exporter pod are measured since they became not ready
+		since, reason := vmExport.CreationTimestamp.Time, ""
+		for _, condition := range vmExport.Status.Conditions {
+			if condition.Type == exportv1.ConditionReady {
+				reason = condition.Reason
+				if !condition.LastTransitionTime.IsZero() {
+					since = condition.LastTransitionTime.Time
+				}
+				break
+			}
+		}
+
+		crs = append(crs, operatormetrics.CollectorResult{
+			Metric: vmExportNotReadySeconds,
+			Labels: []string{vmExport.Name, vmExport.Namespace, reason},
+			Value:  now.Sub(since).Seconds(),
+		})
+	}
+
+	return crs
+}
@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	exportv1 "kubevirt.io/api/export/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Storage workflow collector", func() {
+	now := time.Now()
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "test-ns", CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))}
+	}
+
+	Context("VM restores", func() {
+		It("should report only the restores in progress", func() {
+			restores := []*snapshotv1.VirtualMachineRestore{
+				{ObjectMeta: objectMeta("in-progress")},
+				{
+					ObjectMeta: objectMeta("complete"),
+					Status:     &snapshotv1.VirtualMachineRestoreStatus{Complete: pointer.P(true)},
+				},
+				{
+					ObjectMeta: objectMeta("failed"),
+					Status: &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							{Type: snapshotv1.ConditionFailure, Status: corev1.ConditionTrue},
+						},
+					},
+				},
+			}
+
+			crs := reportVMRestoresInProgress(restores, now)
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmrestore_in_progress_seconds"))
+			Expect(crs[0].Labels).To(HaveExactElements("in-progress", "test-ns"))
+			Expect(crs[0].Value).To(Equal(time.Hour.Seconds()))
+		})
+	})
+
+	Context("VM exports", func() {
+		It("should report only the pending exports since they are not ready", func() {
+			exports := []*exportv1.VirtualMachineExport{
+				{
+					ObjectMeta: objectMeta("pending"),
+					Status: &exportv1.VirtualMachineExportStatus{
+						Phase: exportv1.Pending,
+						Conditions: []exportv1.Condition{{
+							Type:               exportv1.ConditionReady,
+							Status:             corev1.ConditionFalse,
+							Reason:             "PodPending",
+							LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+						}},
+					},
+				},
+				{
+					ObjectMeta: objectMeta("ready"),
+					Status:     &exportv1.VirtualMachineExportStatus{Phase: exportv1.Ready},
+				},
+				{ObjectMeta: objectMeta("no-status")},
+			}
+
+			crs := reportVMExportsNotReady(exports, now)
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmexport_not_ready_seconds"))
+			Expect(crs[0].Labels).To(HaveExactElements("pending", "test-ns", "PodPending"))
+			Expect(crs[0].Value).To(Equal(time.Minute.Seconds()))
+		})
+	})
+})
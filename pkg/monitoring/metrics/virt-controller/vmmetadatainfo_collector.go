@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	"strings"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+var (
+	vmMetadataInfoCollector = operatormetrics.Collector{
+		Metrics:         []operatormetrics.Metric{vmMetadataInfo},
+		CollectCallback: vmMetadataInfoCollectorCallback,
+	}
+
+	vmMetadataInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_metadata_info",
+			Help: "Fleet attributes of Virtual Machines, meant to be joined with other Virtual Machine metrics. " +
+				"Labels can be left empty to reduce the cardinality via the vmInfoMetrics KubeVirt configuration.",
+		},
+		[]string{
+			// Basic info
+			"name", "namespace",
+
+			// Instance type
+			"instance_type", "preference",
+
+			// Guest OS info
+			"guest_os_name", "guest_os_version_id",
+
+			// Run strategy
+			"run_strategy",
+
+			// Controller owner
+			"owner_kind", "owner_name",
+		},
+	)
+)
+
+func vmMetadataInfoCollectorCallback() []operatormetrics.CollectorResult {
+	if stores.VM == nil {
+		return []operatormetrics.CollectorResult{}
+	}
+
+	var vms []*k6tv1.VirtualMachine
+	for _, obj := range stores.VM.List() {
+		vms = append(vms, obj.(*k6tv1.VirtualMachine))
+	}
+
+	return CollectVMsMetadataInfo(vms)
+}
+
+func CollectVMsMetadataInfo(vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	var results []operatormetrics.CollectorResult
+
+	for _, vm := range vms {
+		instanceType, preference := none, none
+		if !isVMInfoMetricsLabelDisabled(k6tv1.VMInfoMetricsLabelInstancetype) {
+			instanceType = getVMInstancetype(vm)
+		}
+		if !isVMInfoMetricsLabelDisabled(k6tv1.VMInfoMetricsLabelPreference) {
+			preference = getVMPreference(vm)
+		}
+
+		guestOSName, guestOSVersionID := none, none
+		if !isVMInfoMetricsLabelDisabled(k6tv1.VMInfoMetricsLabelGuestOS) {
+			guestOSName, guestOSVersionID = getVMGuestOS(vm)
+		}
+
+		runStrategy := none
+		if !isVMInfoMetricsLabelDisabled(k6tv1.VMInfoMetricsLabelRunStrategy) {
+			if strategy, err := vm.RunStrategy(); err == nil {
+				runStrategy = strings.ToLower(string(strategy))
+			}
+		}
+
+		ownerKind, ownerName := none, none
+		if !isVMInfoMetricsLabelDisabled(k6tv1.VMInfoMetricsLabelOwner) {
+			if owner := metav1.GetControllerOf(vm); owner != nil {
+				ownerKind, ownerName = owner.Kind, owner.Name
+			}
+		}
+
+		results = append(results, operatormetrics.CollectorResult{
+			Metric: vmMetadataInfo,
+			Labels: []string{
+				vm.Name, vm.Namespace,
+				instanceType, preference,
+				guestOSName, guestOSVersionID,
+				runStrategy,
+				ownerKind, ownerName,
+			},
+			Value: 1.0,
+		})
+	}
+
+	return results
+}
+
+func isVMInfoMetricsLabelDisabled(label k6tv1.VMInfoMetricsLabel) bool {
+	return clusterConfig != nil && clusterConfig.IsVMInfoMetricsLabelDisabled(label)
+}
+
+// getVMGuestOS returns the guest OS reported by the guest agent of the running VMI of the VM.
+func getVMGuestOS(vm *k6tv1.VirtualMachine) (name, versionID string) {
+	if stores.VMI == nil {
+		return none, none
+	}
+
+	obj, exists, err := stores.VMI.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return none, none
+	}
+
+	guestOSInfo := obj.(*k6tv1.VirtualMachineInstance).Status.GuestOSInfo
+	return guestOSInfo.Name, guestOSInfo.VersionID
+}
@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtcontroller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("VM metadata info collector", func() {
+	var vm *k6tv1.VirtualMachine
+
+	BeforeEach(func() {
+		originalClusterConfig := clusterConfig
+		DeferCleanup(func() { clusterConfig = originalClusterConfig })
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&k6tv1.KubeVirtConfiguration{})
+
+		vmiInformer, _ := testutils.NewFakeInformerFor(&k6tv1.VirtualMachineInstance{})
+		stores.VMI = vmiInformer.GetStore()
+		Expect(stores.VMI.Add(&k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-vm"},
+			Status: k6tv1.VirtualMachineInstanceStatus{
+				GuestOSInfo: k6tv1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux", VersionID: "41"},
+			},
+		})).To(Succeed())
+
+		vm = &k6tv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-ns",
+				Name:      "test-vm",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "pool.kubevirt.io/v1beta1",
+					Kind:       "VirtualMachinePool",
+					Name:       "test-pool",
+					Controller: pointer.P(true),
+				}},
+			},
+			Spec: k6tv1.VirtualMachineSpec{
+				RunStrategy: pointer.P(k6tv1.RunStrategyAlways),
+			},
+		}
+	})
+
+	It("should report the fleet attributes of a VM", func() {
+		crs := CollectVMsMetadataInfo([]*k6tv1.VirtualMachine{vm})
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vm_metadata_info"))
+		Expect(crs[0].Labels).To(HaveExactElements(
+			"test-vm", "test-ns",
+			"", "",
+			"Fedora Linux", "41",
+			"always",
+			"VirtualMachinePool", "test-pool",
+		))
+	})
+
+	It("should report empty guest OS labels without a VMI", func() {
+		vm.Name = "stopped-vm"
+		crs := CollectVMsMetadataInfo([]*k6tv1.VirtualMachine{vm})
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Labels[4:6]).To(HaveExactElements("", ""))
+	})
+
+	It("should leave the disabled labels empty", func() {
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&k6tv1.KubeVirtConfiguration{
+			VMInfoMetrics: &k6tv1.VMInfoMetricsConfiguration{
+				DisabledLabels: []k6tv1.VMInfoMetricsLabel{k6tv1.VMInfoMetricsLabelGuestOS, k6tv1.VMInfoMetricsLabelOwner},
+			},
+		})
+
+		crs := CollectVMsMetadataInfo([]*k6tv1.VirtualMachine{vm})
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Labels).To(HaveExactElements(
+			"test-vm", "test-ns",
+			"", "",
+			"", "",
+			"always",
+			"", "",
+		))
+	})
+})
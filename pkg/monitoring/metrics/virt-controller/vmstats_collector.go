@@ -43,6 +43,7 @@ var (
 		Metrics: append(timestampMetrics,
 			vmResourceRequests, vmResourceLimits, vmInfo,
 			vmDiskAllocatedSize, vmCreationTimestamp, vmVnicInfo, vmLabels,
+			vmConfigurationDrifted,
 		),
 		CollectCallback: vmStatsCollectorCallback,
 	}
@@ -207,6 +208,15 @@ var (
 		},
 		labels,
 	)
+
+	vmConfigurationDrifted = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_configuration_drifted",
+			Help: "Indicates that the running VMI of the Virtual Machine diverged from the VM spec. " +
+				"Only reported for drifted VMs, requires the VirtualMachineDriftDetection feature gate.",
+		},
+		labels,
+	)
 )
 
 func vmStatsCollectorCallback() []operatormetrics.CollectorResult {
@@ -228,6 +238,7 @@ func vmStatsCollectorCallback() []operatormetrics.CollectorResult {
 	results = append(results, reportVmsStats(vms)...)
 	results = append(results, collectVMCreationTimestamp(vms)...)
 	results = append(results, CollectVmsVnicInfo(vms)...)
+	results = append(results, collectVMConfigurationDrift(vms)...)
 	return results
 }
 
@@ -658,21 +669,8 @@ func collectDiskMetricsFromPVC(vm *k6tv1.VirtualMachine) []operatormetrics.Colle
 			continue
 		}
 
-		key := controller.NamespacedKey(vm.Namespace, pvcName)
-		obj, exists, err := stores.PersistentVolumeClaim.GetByKey(key)
-		if err != nil {
-			log.Log.Errorf("Error retrieving PVC %s in namespace %s: %v", pvcName, vm.Namespace, err)
-			continue
-		}
-
-		if !exists {
-			log.Log.Warningf("PVC %s in namespace %s does not exist", pvcName, vm.Namespace)
-			continue
-		}
-
-		pvc, ok := obj.(*k8sv1.PersistentVolumeClaim)
-		if !ok {
-			log.Log.Warningf("Object for PVC %s in namespace %s is not of expected type", pvcName, vm.Namespace)
+		pvc := getPVC(vm.Namespace, pvcName)
+		if pvc == nil {
 			continue
 		}
 
@@ -682,6 +680,28 @@ func collectDiskMetricsFromPVC(vm *k6tv1.VirtualMachine) []operatormetrics.Colle
 	return cr
 }
 
+func getPVC(namespace, name string) *k8sv1.PersistentVolumeClaim {
+	key := controller.NamespacedKey(namespace, name)
+	obj, exists, err := stores.PersistentVolumeClaim.GetByKey(key)
+	if err != nil {
+		log.Log.Errorf("Error retrieving PVC %s in namespace %s: %v", name, namespace, err)
+		return nil
+	}
+
+	if !exists {
+		log.Log.Warningf("PVC %s in namespace %s does not exist", name, namespace)
+		return nil
+	}
+
+	pvc, ok := obj.(*k8sv1.PersistentVolumeClaim)
+	if !ok {
+		log.Log.Warningf("Object for PVC %s in namespace %s is not of expected type", name, namespace)
+		return nil
+	}
+
+	return pvc
+}
+
 func getPVCAndDiskName(vol k6tv1.Volume) (pvcName, diskName string, isDataVolume bool) {
 	if vol.PersistentVolumeClaim != nil {
 		return vol.PersistentVolumeClaim.ClaimName, vol.Name, false
@@ -698,15 +718,7 @@ func getDiskSizeValues(
 	vm *k6tv1.VirtualMachine, pvc *k8sv1.PersistentVolumeClaim,
 	diskName string, isDataVolume bool,
 ) operatormetrics.CollectorResult {
-	var pvcSize *resource.Quantity
-
-	if isDataVolume {
-		pvcSize = getSizeFromDataVolumeTemplates(vm, pvc.Name)
-	}
-
-	if pvcSize == nil {
-		pvcSize = pvc.Spec.Resources.Requests.Storage()
-	}
+	pvcSize := getAllocatedPVCSize(vm, pvc, isDataVolume)
 
 	volumeMode := ""
 	if pvc.Spec.VolumeMode != nil {
@@ -720,6 +732,16 @@ func getDiskSizeValues(
 	}
 }
 
+func getAllocatedPVCSize(vm *k6tv1.VirtualMachine, pvc *k8sv1.PersistentVolumeClaim, isDataVolume bool) *resource.Quantity {
+	if isDataVolume {
+		if size := getSizeFromDataVolumeTemplates(vm, pvc.Name); size != nil {
+			return size
+		}
+	}
+
+	return pvc.Spec.Resources.Requests.Storage()
+}
+
 func getSizeFromDataVolumeTemplates(vm *k6tv1.VirtualMachine, dataVolumeName string) *resource.Quantity {
 	for _, dvTemplate := range vm.Spec.DataVolumeTemplates {
 		if dvTemplate.Name == dataVolumeName {
@@ -749,6 +771,22 @@ func collectVMCreationTimestamp(vms []*k6tv1.VirtualMachine) []operatormetrics.C
 	return cr
 }
 
+func collectVMConfigurationDrift(vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	var cr []operatormetrics.CollectorResult
+
+	for _, vm := range vms {
+		if controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, k6tv1.VirtualMachineConfigurationDrifted, k8sv1.ConditionTrue) {
+			cr = append(cr, operatormetrics.CollectorResult{
+				Metric: vmConfigurationDrifted,
+				Labels: []string{vm.Name, vm.Namespace},
+				Value:  1,
+			})
+		}
+	}
+
+	return cr
+}
+
 func CollectVmsVnicInfo(vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
 	var results []operatormetrics.CollectorResult
 
@@ -841,6 +841,29 @@ var _ = Describe("VM Stats Collector", func() {
 		})
 	})
 
+	Context("VM configuration drift metric collection", func() {
+		It("should report only the drifted VMs", func() {
+			newVM := func(name string, conditions ...k6tv1.VirtualMachineCondition) *k6tv1.VirtualMachine {
+				return &k6tv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: name},
+					Status:     k6tv1.VirtualMachineStatus{Conditions: conditions},
+				}
+			}
+			vms := []*k6tv1.VirtualMachine{
+				newVM("drifted", k6tv1.VirtualMachineCondition{Type: k6tv1.VirtualMachineConfigurationDrifted, Status: k8sv1.ConditionTrue}),
+				newVM("restart-required", k6tv1.VirtualMachineCondition{Type: k6tv1.VirtualMachineRestartRequired, Status: k8sv1.ConditionTrue}),
+				newVM("in-sync"),
+			}
+
+			results := collectVMConfigurationDrift(vms)
+
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Metric.GetOpts().Name).To(Equal("kubevirt_vm_configuration_drifted"))
+			Expect(results[0].Labels).To(Equal([]string{"drifted", "test-ns"}))
+			Expect(results[0].Value).To(Equal(1.0))
+		})
+	})
+
 	Context("VM vNIC info", func() {
 		It("should collect metrics for vNICs with various binding types, including PluginBinding", func() {
 			ifaces, nets := newVNICTestInterfaces()
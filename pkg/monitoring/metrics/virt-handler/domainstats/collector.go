@@ -25,6 +25,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/collector"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
@@ -42,6 +43,7 @@ var (
 		networkMetrics{},
 		cpuAffinityMetrics{},
 		filesystemMetrics{},
+		pressureMetrics{},
 	}
 
 	Collector = operatormetrics.Collector{
@@ -60,12 +62,14 @@ type resourceMetrics interface {
 type collectorSettings struct {
 	maxRequestsInFlight int
 	vmiInformer         cache.SharedIndexInformer
+	clusterConfig       *virtconfig.ClusterConfig
 }
 
-func SetupDomainStatsCollector(maxRequestsInFlight int, vmiInformer cache.SharedIndexInformer) {
+func SetupDomainStatsCollector(maxRequestsInFlight int, vmiInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig) {
 	settings = &collectorSettings{
 		maxRequestsInFlight: maxRequestsInFlight,
 		vmiInformer:         vmiInformer,
+		clusterConfig:       clusterConfig,
 	}
 }
 
@@ -93,17 +97,45 @@ func domainStatsCollectorCallback() []operatormetrics.CollectorResult {
 	}
 
 	concCollector := collector.NewConcurrentCollector(settings.maxRequestsInFlight)
-	return execDomainStatsCollector(concCollector, vmis)
+	return execDomainStatsCollector(concCollector, vmis, enabledResourceMetrics(settings.clusterConfig))
 }
 
-func execDomainStatsCollector(concCollector collector.Collector, vmis []*k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+// enabledResourceMetrics drops the resource metrics of the domain stats
+// collectors disabled in the KubeVirt CR.
+func enabledResourceMetrics(clusterConfig *virtconfig.ClusterConfig) []resourceMetrics {
+	if clusterConfig == nil {
+		return domainStatsResourceMetrics
+	}
+
+	var rms []resourceMetrics
+	for _, rm := range domainStatsResourceMetrics {
+		switch rm.(type) {
+		case blockMetrics:
+			if clusterConfig.IsDomainStatsCollectorDisabled(k6tv1.DomainStatsCollectorBlock) {
+				continue
+			}
+		case vcpuMetrics:
+			if clusterConfig.IsDomainStatsCollectorDisabled(k6tv1.DomainStatsCollectorVCPU) {
+				continue
+			}
+		case networkMetrics:
+			if clusterConfig.IsDomainStatsCollectorDisabled(k6tv1.DomainStatsCollectorNetwork) {
+				continue
+			}
+		}
+		rms = append(rms, rm)
+	}
+	return rms
+}
+
+func execDomainStatsCollector(concCollector collector.Collector, vmis []*k6tv1.VirtualMachineInstance, rms []resourceMetrics) []operatormetrics.CollectorResult {
 	scraper := NewDomainstatsScraper(len(vmis))
 	go concCollector.Collect(vmis, scraper, PrometheusCollectionTimeout)
 
 	var crs []operatormetrics.CollectorResult
 
 	for vmiReport := range scraper.ch {
-		for _, rm := range domainStatsResourceMetrics {
+		for _, rm := range rms {
 			crs = append(crs, rm.Collect(vmiReport)...)
 		}
 	}
@@ -31,6 +31,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/collector"
+	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
@@ -75,12 +76,32 @@ var _ = Describe("domain stats collector", func() {
 				vmis:     vmis,
 				vmiStats: vmiStats,
 			}
-			crs := execDomainStatsCollector(concCollector, vmis)
+			crs := execDomainStatsCollector(concCollector, vmis, domainStatsResourceMetrics)
 			Expect(crs).To(HaveLen(2))
 			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(memoryResident, kibibytesToBytes(1))))
 			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(memoryResident, kibibytesToBytes(2))))
 		})
 	})
+
+	Context("enabledResourceMetrics", func() {
+		It("should return all resource metrics without a cluster config", func() {
+			Expect(enabledResourceMetrics(nil)).To(Equal(domainStatsResourceMetrics))
+		})
+
+		It("should drop the resource metrics of disabled collectors", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&k6tv1.KubeVirtConfiguration{
+				DomainStatsConfiguration: &k6tv1.DomainStatsConfiguration{
+					DisabledCollectors: []k6tv1.DomainStatsCollector{k6tv1.DomainStatsCollectorBlock, k6tv1.DomainStatsCollectorVCPU},
+				},
+			})
+
+			rms := enabledResourceMetrics(clusterConfig)
+			Expect(rms).To(HaveLen(len(domainStatsResourceMetrics) - 2))
+			Expect(rms).ToNot(ContainElement(blockMetrics{}))
+			Expect(rms).ToNot(ContainElement(vcpuMetrics{}))
+			Expect(rms).To(ContainElement(networkMetrics{}))
+		})
+	})
 })
 
 type fakeCollector struct {
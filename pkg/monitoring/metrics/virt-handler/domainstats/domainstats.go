@@ -25,6 +25,7 @@ import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	k6tv1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
@@ -43,8 +44,9 @@ type VirtualMachineInstanceReport struct {
 }
 
 type VirtualMachineInstanceStats struct {
-	DomainStats *stats.DomainStats
-	FsStats     k6tv1.VirtualMachineInstanceFileSystemList
+	DomainStats   *stats.DomainStats
+	FsStats       k6tv1.VirtualMachineInstanceFileSystemList
+	PressureStats *cgroup.PressureStats
 }
 
 func newVirtualMachineInstanceReport(
@@ -19,7 +19,11 @@
 
 package domainstats
 
-import "github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+)
 
 var (
 	filesystemCapacityBytes = operatormetrics.NewGauge(
@@ -54,6 +58,7 @@ func (filesystemMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []oper
 			"disk_name":        fsStat.DiskName,
 			"mount_point":      fsStat.MountPoint,
 			"file_system_type": fsStat.FileSystemType,
+			"drive":            filesystemDrive(fsStat),
 		}
 
 		crs = append(crs,
@@ -64,3 +69,14 @@ func (filesystemMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []oper
 
 	return crs
 }
+
+// filesystemDrive returns the VMI volume backing the filesystem, so that it
+// can be joined with the block device metrics of the same drive.
+func filesystemDrive(fsStat k6tv1.VirtualMachineInstanceFileSystem) string {
+	for _, disk := range fsStat.Disk {
+		if disk.VolumeName != "" {
+			return disk.VolumeName
+		}
+	}
+	return ""
+}
@@ -48,6 +48,9 @@ var _ = Describe("filesystem metrics", func() {
 						FileSystemType: "ext4",
 						TotalBytes:     1,
 						UsedBytes:      2,
+						Disk: []k6tv1.VirtualMachineInstanceFileSystemDisk{
+							{BusType: "virtio", VolumeName: "rootdisk"},
+						},
 					},
 				},
 			},
@@ -63,6 +66,14 @@ var _ = Describe("filesystem metrics", func() {
 			Entry("kubevirt_vmi_filesystem_used_bytes", filesystemUsedBytes, 2.0),
 		)
 
+		It("should label the metrics with the drive backing the filesystem", func() {
+			crs := filesystemMetrics{}.Collect(vmiReport)
+			Expect(crs).To(HaveLen(2))
+			for _, cr := range crs {
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("drive", "rootdisk"))
+			}
+		})
+
 		It("result should be empty if stat not populated or set is false", func() {
 			vmiStats.FsStats.Items = []k6tv1.VirtualMachineInstanceFileSystem{}
 			crs := filesystemMetrics{}.Collect(vmiReport)
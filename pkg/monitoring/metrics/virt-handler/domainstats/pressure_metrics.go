@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	cgroups "github.com/opencontainers/cgroups"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	pressureCPUWaitingSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_pressure_cpu_waiting_seconds_total",
+			Help: "Total time in seconds during which at least one task of the VMI was stalled waiting for CPU.",
+		},
+	)
+
+	pressureCPUStalledSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_pressure_cpu_stalled_seconds_total",
+			Help: "Total time in seconds during which all the non-idle tasks of the VMI were stalled waiting for CPU.",
+		},
+	)
+
+	pressureMemoryWaitingSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_pressure_memory_waiting_seconds_total",
+			Help: "Total time in seconds during which at least one task of the VMI was stalled waiting for memory.",
+		},
+	)
+
+	pressureMemoryStalledSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_pressure_memory_stalled_seconds_total",
+			Help: "Total time in seconds during which all the non-idle tasks of the VMI were stalled waiting for memory.",
+		},
+	)
+
+	pressureIOWaitingSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_pressure_io_waiting_seconds_total",
+			Help: "Total time in seconds during which at least one task of the VMI was stalled waiting for I/O.",
+		},
+	)
+
+	pressureIOStalledSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_pressure_io_stalled_seconds_total",
+			Help: "Total time in seconds during which all the non-idle tasks of the VMI were stalled waiting for I/O.",
+		},
+	)
+)
+
+type pressureMetrics struct{}
+
+func (pressureMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{
+		pressureCPUWaitingSeconds,
+		pressureCPUStalledSeconds,
+		pressureMemoryWaitingSeconds,
+		pressureMemoryStalledSeconds,
+		pressureIOWaitingSeconds,
+		pressureIOStalledSeconds,
+	}
+}
+
+func (pressureMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	pressureStats := vmiReport.vmiStats.PressureStats
+	if pressureStats == nil {
+		return nil
+	}
+
+	var crs []operatormetrics.CollectorResult
+	crs = append(crs, collectPSIStats(vmiReport, pressureStats.CPU, pressureCPUWaitingSeconds, pressureCPUStalledSeconds)...)
+	crs = append(crs, collectPSIStats(vmiReport, pressureStats.Memory, pressureMemoryWaitingSeconds, pressureMemoryStalledSeconds)...)
+	crs = append(crs, collectPSIStats(vmiReport, pressureStats.IO, pressureIOWaitingSeconds, pressureIOStalledSeconds)...)
+
+	return crs
+}
+
+func collectPSIStats(
+	vmiReport *VirtualMachineInstanceReport, psiStats *cgroups.PSIStats, waiting, stalled operatormetrics.Metric,
+) []operatormetrics.CollectorResult {
+	if psiStats == nil {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{
+		vmiReport.newCollectorResult(waiting, microsecondsToSeconds(psiStats.Some.Total)),
+		vmiReport.newCollectorResult(stalled, microsecondsToSeconds(psiStats.Full.Total)),
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cgroups "github.com/opencontainers/cgroups"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+var _ = Describe("pressure metrics", func() {
+	Context("on Collect", func() {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi-1",
+				Namespace: "test-ns-1",
+			},
+		}
+
+		vmiStats := &VirtualMachineInstanceStats{
+			PressureStats: &cgroup.PressureStats{
+				CPU: &cgroups.PSIStats{
+					Some: cgroups.PSIData{Total: 1_000_000},
+					Full: cgroups.PSIData{Total: 2_000_000},
+				},
+				Memory: &cgroups.PSIStats{
+					Some: cgroups.PSIData{Total: 3_000_000},
+					Full: cgroups.PSIData{Total: 4_000_000},
+				},
+				IO: &cgroups.PSIStats{
+					Some: cgroups.PSIData{Total: 5_000_000},
+					Full: cgroups.PSIData{Total: 6_000_000},
+				},
+			},
+		}
+
+		vmiReport := newVirtualMachineInstanceReport(vmi, vmiStats)
+
+		DescribeTable("should collect metrics values", func(metric operatormetrics.Metric, expectedValue float64) {
+			crs := pressureMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(metric, expectedValue)))
+		},
+			Entry("kubevirt_vmi_pressure_cpu_waiting_seconds_total", pressureCPUWaitingSeconds, 1.0),
+			Entry("kubevirt_vmi_pressure_cpu_stalled_seconds_total", pressureCPUStalledSeconds, 2.0),
+			Entry("kubevirt_vmi_pressure_memory_waiting_seconds_total", pressureMemoryWaitingSeconds, 3.0),
+			Entry("kubevirt_vmi_pressure_memory_stalled_seconds_total", pressureMemoryStalledSeconds, 4.0),
+			Entry("kubevirt_vmi_pressure_io_waiting_seconds_total", pressureIOWaitingSeconds, 5.0),
+			Entry("kubevirt_vmi_pressure_io_stalled_seconds_total", pressureIOStalledSeconds, 6.0),
+		)
+
+		It("should skip the resources without pressure stats", func() {
+			report := newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{
+				PressureStats: &cgroup.PressureStats{
+					CPU: &cgroups.PSIStats{Some: cgroups.PSIData{Total: 1_000_000}},
+				},
+			})
+			crs := pressureMetrics{}.Collect(report)
+			Expect(crs).To(HaveLen(2))
+		})
+
+		It("result should be empty if pressure stats are not populated", func() {
+			crs := pressureMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{}))
+			Expect(crs).To(BeEmpty())
+		})
+	})
+})
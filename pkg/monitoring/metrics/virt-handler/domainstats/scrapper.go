@@ -27,7 +27,9 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/collector"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 )
 
 const logVerbosityWarning = 2
@@ -105,5 +107,25 @@ func (d DomainstatsScraper) gatherMetrics(socketFile string) (bool, *VirtualMach
 		return false, nil, fmt.Errorf("failed to update filesystem stats from socket %s: %w", socketFile, err)
 	}
 
+	vmStats.PressureStats = gatherPressureStats(socketFile)
+
 	return exists, vmStats, nil
 }
+
+// gatherPressureStats reads the pressure stall information of the cgroup of the VMI.
+// PSI is optional, failures are only logged to not drop the rest of the stats.
+func gatherPressureStats(socketFile string) *cgroup.PressureStats {
+	res, err := isolation.NewSocketBasedIsolationDetector().DetectForSocket(socketFile)
+	if err != nil {
+		log.Log.V(logVerbosityWarning).Reason(err).Infof("failed to detect the process of %s", socketFile)
+		return nil
+	}
+
+	pressureStats, err := cgroup.GetPressureStatsFromPid(res.Pid())
+	if err != nil {
+		log.Log.V(logVerbosityWarning).Reason(err).Infof("failed to read the pressure stats of %s", socketFile)
+		return nil
+	}
+
+	return pressureStats
+}
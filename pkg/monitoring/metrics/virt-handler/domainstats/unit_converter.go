@@ -20,14 +20,19 @@
 package domainstats
 
 const (
-	nanosecondsPerSecond float64 = 1_000_000_000
-	bytesPerKibibyte     float64 = 1024
+	nanosecondsPerSecond  float64 = 1_000_000_000
+	microsecondsPerSecond float64 = 1_000_000
+	bytesPerKibibyte      float64 = 1024
 )
 
 func nanosecondsToSeconds(ns uint64) float64 {
 	return float64(ns) / nanosecondsPerSecond
 }
 
+func microsecondsToSeconds(us uint64) float64 {
+	return float64(us) / microsecondsPerSecond
+}
+
 func kibibytesToBytes(kibibytes uint64) float64 {
 	return float64(kibibytes) * bytesPerKibibyte
 }
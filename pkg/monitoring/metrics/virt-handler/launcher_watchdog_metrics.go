@@ -0,0 +1,48 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"kubevirt.io/client-go/log"
+)
+
+var (
+	launcherWatchdogMetrics = []operatormetrics.Metric{
+		launcherUnresponsiveTotal,
+	}
+
+	launcherUnresponsiveTotal = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_unresponsive_total",
+			Help: "Total number of hung virt-launchers detected by the launcher watchdog, partitioned by VMI and recovery action.",
+		},
+		[]string{"namespace", "name", "action"},
+	)
+)
+
+func IncLauncherUnresponsive(namespace, name, action string) {
+	counter, err := launcherUnresponsiveTotal.GetMetricWithLabelValues(namespace, name, action)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to get launcher unresponsive counter for vmi %s/%s", namespace, name)
+		return
+	}
+	counter.Inc()
+}
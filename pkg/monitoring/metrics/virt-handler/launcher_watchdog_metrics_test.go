@@ -0,0 +1,50 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	io_prometheus_client "github.com/prometheus/client_model/go"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Launcher watchdog metrics", func() {
+	BeforeEach(func() {
+		launcherUnresponsiveTotal.Reset()
+	})
+
+	It("should count the hung virt-launchers per VMI and action", func() {
+		IncLauncherUnresponsive("test-ns", "test-vmi", "Alert")
+		IncLauncherUnresponsive("test-ns", "test-vmi", "Alert")
+		IncLauncherUnresponsive("test-ns", "other-vmi", "Fail")
+
+		dto := &io_prometheus_client.Metric{}
+		counter, err := launcherUnresponsiveTotal.GetMetricWithLabelValues("test-ns", "test-vmi", "Alert")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(counter.Write(dto)).To(Succeed())
+		Expect(*dto.Counter.Value).To(Equal(2.0))
+
+		counter, err = launcherUnresponsiveTotal.GetMetricWithLabelValues("test-ns", "other-vmi", "Fail")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(counter.Write(dto)).To(Succeed())
+		Expect(*dto.Counter.Value).To(Equal(1.0))
+	})
+})
@@ -0,0 +1,50 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	memoryOvercommitMetrics = []operatormetrics.Metric{
+		memoryOvercommitPressure,
+	}
+
+	memoryOvercommitPressure = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_overcommit_pressure",
+			Help: "Whether the memory in use on the node, swap included, crossed the memory overcommit pressure threshold (1) or not (0). Only reported when memory overcommit is configured.",
+		},
+		[]string{"node"},
+	)
+)
+
+func SetMemoryOvercommitPressure(nodeName string, underPressure bool) {
+	value := 0.0
+	if underPressure {
+		value = 1.0
+	}
+	memoryOvercommitPressure.WithLabelValues(nodeName).Set(value)
+}
+
+func DeleteMemoryOvercommitPressure(nodeName string) {
+	memoryOvercommitPressure.DeleteLabelValues(nodeName)
+}
@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	io_prometheus_client "github.com/prometheus/client_model/go"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Memory overcommit metrics", func() {
+	BeforeEach(func() {
+		memoryOvercommitPressure.Reset()
+	})
+
+	It("should report the overcommit pressure of the node", func() {
+		SetMemoryOvercommitPressure("node01", true)
+
+		dto := &io_prometheus_client.Metric{}
+		gauge, err := memoryOvercommitPressure.GetMetricWithLabelValues("node01")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gauge.Write(dto)).To(Succeed())
+		Expect(*dto.Gauge.Value).To(Equal(1.0))
+
+		SetMemoryOvercommitPressure("node01", false)
+		Expect(gauge.Write(dto)).To(Succeed())
+		Expect(*dto.Gauge.Value).To(Equal(0.0))
+	})
+
+	It("should stop reporting the overcommit pressure of the node", func() {
+		SetMemoryOvercommitPressure("node01", true)
+		DeleteMemoryOvercommitPressure("node01")
+
+		Expect(memoryOvercommitPressure.DeleteLabelValues("node01")).To(BeFalse())
+	})
+})
@@ -28,11 +28,13 @@ import (
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/workqueue"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/domainstats"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/migrationdomainstats"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 func SetupMetrics(
 	nodeName string, maxRequestsInFlight int,
 	vmiInformer cache.SharedIndexInformer, machines []libvirtxml.CapsGuestMachine,
+	clusterConfig *virtconfig.ClusterConfig,
 ) error {
 	if err := workqueue.SetupMetrics(); err != nil {
 		return err
@@ -46,13 +48,13 @@ func SetupMetrics(
 		return err
 	}
 
-	if err := operatormetrics.RegisterMetrics(componentMetrics, versionMetrics, machineTypeMetrics, guestPanicMetrics); err != nil {
+	if err := operatormetrics.RegisterMetrics(componentMetrics, versionMetrics, machineTypeMetrics, guestPanicMetrics, memoryOvercommitMetrics, launcherWatchdogMetrics); err != nil {
 		return err
 	}
 	SetVersionInfo()
 	ReportDeprecatedMachineTypes(machines, nodeName)
 
-	domainstats.SetupDomainStatsCollector(maxRequestsInFlight, vmiInformer)
+	domainstats.SetupDomainStatsCollector(maxRequestsInFlight, vmiInformer, clusterConfig)
 
 	if err := migrationdomainstats.SetupMigrationStatsCollector(vmiInformer); err != nil {
 		return err
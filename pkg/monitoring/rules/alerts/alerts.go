@@ -55,6 +55,16 @@ func Register(registry *operatorrules.Registry, namespace string) error {
 		vmsAlerts,
 	}
 
+	return registerAlerts(registry, alerts...)
+}
+
+// RegisterWorkflowAlerts registers the optional alerts covering migration and storage workflow failures.
+// They are deployed in their own PrometheusRule, so that they can be toggled in the KubeVirt CR.
+func RegisterWorkflowAlerts(registry *operatorrules.Registry) error {
+	return registerAlerts(registry, workflowAlerts)
+}
+
+func registerAlerts(registry *operatorrules.Registry, alerts ...[]promv1.Rule) error {
 	runbookURLTemplate := getRunbookURLTemplate()
 	for _, alertGroup := range alerts {
 		for _, alert := range alertGroup {
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package alerts
+
+import (
+	"fmt"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// migrationErrorBudget is the ratio of failed migrations allowed by a 99% success objective
+	migrationErrorBudget = 0.01
+
+	// burn rates and windows of the multi-window, multi-burn-rate alerts:
+	// the fast burn consumes 2% of a 30 days budget in an hour, the slow burn 5% in 6 hours
+	fastBurnRate = 14.4
+	slowBurnRate = 6
+)
+
+var workflowAlerts = []promv1.Rule{
+	{
+		Alert: "VMIMigrationErrorBudgetFastBurn",
+		Expr:  intstr.FromString(getMigrationFailureBurnRate(fastBurnRate, "1h", "5m")),
+		Annotations: map[string]string{
+			summaryAnnotationKey:     "VMI migrations are failing fast enough to exhaust the monthly error budget within 2 days.",
+			descriptionAnnotationKey: "More than 14.4% of the VMI migrations failed over the last hour and the last 5 minutes.",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:        "critical",
+			operatorHealthImpactLabelKey: "none",
+		},
+	},
+	{
+		Alert: "VMIMigrationErrorBudgetSlowBurn",
+		Expr:  intstr.FromString(getMigrationFailureBurnRate(slowBurnRate, "6h", "30m")),
+		Annotations: map[string]string{
+			summaryAnnotationKey:     "VMI migrations are failing fast enough to exhaust the monthly error budget within 5 days.",
+			descriptionAnnotationKey: "More than 6% of the VMI migrations failed over the last 6 hours and the last 30 minutes.",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:        "warning",
+			operatorHealthImpactLabelKey: "none",
+		},
+	},
+	{
+		Alert: "VMRestoreStuck",
+		Expr:  intstr.FromString("max by (namespace, name) (kubevirt_vmrestore_in_progress_seconds) > 3600"),
+		For:   ptr.To(promv1.Duration("5m")),
+		Annotations: map[string]string{
+			summaryAnnotationKey: "VirtualMachineRestore {{ $labels.namespace }}/{{ $labels.name }} has been in progress for more than 1 hour.",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:        "warning",
+			operatorHealthImpactLabelKey: "none",
+		},
+	},
+	{
+		Alert: "VMExportNotReady",
+		Expr:  intstr.FromString("max by (namespace, name, reason) (kubevirt_vmexport_not_ready_seconds) > 1800"),
+		For:   ptr.To(promv1.Duration("5m")),
+		Annotations: map[string]string{
+			summaryAnnotationKey: "VirtualMachineExport {{ $labels.namespace }}/{{ $labels.name }} has not been ready for more than 30 minutes " +
+				"(reason: {{ $labels.reason }}).",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:        "warning",
+			operatorHealthImpactLabelKey: "none",
+		},
+	},
+}
+
+func getMigrationFailureRatio(window string) string {
+	const migrationFailureRatioQuery = "sum(rate(kubevirt_vmi_migration_phase_transition_time_from_creation_seconds_count{phase='Failed'}[%s])) / " +
+		"sum(rate(kubevirt_vmi_migration_phase_transition_time_from_creation_seconds_count{phase=~'Succeeded|Failed'}[%s]))"
+	return fmt.Sprintf(migrationFailureRatioQuery, window, window)
+}
+
+func getMigrationFailureBurnRate(burnRate float64, longWindow, shortWindow string) string {
+	threshold := fmt.Sprintf("(%g * %g)", burnRate, migrationErrorBudget)
+	return fmt.Sprintf("(%s > %s) and (%s > %s)",
+		getMigrationFailureRatio(longWindow), threshold,
+		getMigrationFailureRatio(shortWindow), threshold,
+	)
+}
@@ -28,6 +28,7 @@ func Register(registry *operatorrules.Registry, namespace string) error {
 		virtRecordingRules(namespace),
 		vmRecordingRules,
 		vmiRecordingRules,
+		vmPoolRecordingRules,
 		vmsnapshotRecordingRules,
 		deprecatedRecordingRules,
 	)
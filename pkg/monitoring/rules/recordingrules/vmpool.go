@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package recordingrules
+
+import (
+	"fmt"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatorrules"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// vmPoolLabel is the metric label of the kubevirt.io/vm-pool label of the pool VMIs
+const vmPoolLabel = "kubernetes_vmi_label_kubevirt_io_vm_pool"
+
+var vmPoolRecordingRules = []operatorrules.RecordingRule{
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "vmpool:kubevirt_vmi_cpu_usage_seconds:rate5m",
+			Help: "CPU usage rate over 5 minutes of the VMIs of a VirtualMachinePool (aggregated by vmpool, namespace).",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr:       intstr.FromString(sumByVMPool("rate(kubevirt_vmi_cpu_usage_seconds_total{%s!=''}[5m])")),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "vmpool:kubevirt_vmi_memory_used_bytes:sum",
+			Help: "Amount of `used` memory as seen by the domains of the VMIs of a VirtualMachinePool (aggregated by vmpool, namespace).",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(sumByVMPool(
+			"kubevirt_vmi_memory_available_bytes{%[1]s!=''} - kubevirt_vmi_memory_usable_bytes{%[1]s!=''}",
+		)),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "vmpool:kubevirt_vmi_guest_queue_length:sum",
+			Help: "Guest queue length of the VMIs of a VirtualMachinePool (aggregated by vmpool, namespace).",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr: intstr.FromString(sumByVMPool(
+			"clamp_min(kubevirt_vmi_guest_load_1m{%s!=''} - on(name, namespace) group_left vmi:kubevirt_vmi_vcpu:count, 0)",
+		)),
+	},
+}
+
+// sumByVMPool sums the series of the VMIs of a VirtualMachinePool by the name of the pool,
+// exposed as the vmpool label. The %s verbs of expr are replaced with the pool label.
+func sumByVMPool(expr string) string {
+	return fmt.Sprintf(
+		"sum by (vmpool, namespace) (label_replace(%s, 'vmpool', '$1', '%s', '(.*)'))",
+		fmt.Sprintf(expr, vmPoolLabel), vmPoolLabel,
+	)
+}
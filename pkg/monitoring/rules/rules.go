@@ -27,7 +27,8 @@ import (
 )
 
 const (
-	kubevirtPrometheusRuleName = "prometheus-kubevirt-rules"
+	kubevirtPrometheusRuleName         = "prometheus-kubevirt-rules"
+	kubevirtWorkflowPrometheusRuleName = "prometheus-kubevirt-workflow-rules"
 
 	prometheusLabelKey   = "prometheus.kubevirt.io"
 	prometheusLabelValue = "true"
@@ -36,7 +37,12 @@ const (
 	kubevirtLabelValue = "kubevirt"
 )
 
-var registry = operatorrules.NewRegistry()
+var (
+	registry = operatorrules.NewRegistry()
+
+	// workflowRegistry holds the optional rules, deployed only when enabled in the KubeVirt CR
+	workflowRegistry = operatorrules.NewRegistry()
+)
 
 func SetupRules(namespace string) error {
 	err := recordingrules.Register(registry, namespace)
@@ -49,7 +55,7 @@ func SetupRules(namespace string) error {
 		return err
 	}
 
-	return nil
+	return alerts.RegisterWorkflowAlerts(workflowRegistry)
 }
 
 func BuildPrometheusRule(namespace string) (*promv1.PrometheusRule, error) {
@@ -68,10 +74,21 @@ func BuildPrometheusRule(namespace string) (*promv1.PrometheusRule, error) {
 	return rules, nil
 }
 
+func BuildWorkflowPrometheusRule(namespace string) (*promv1.PrometheusRule, error) {
+	return workflowRegistry.BuildPrometheusRule(
+		kubevirtWorkflowPrometheusRuleName,
+		namespace,
+		map[string]string{
+			prometheusLabelKey: prometheusLabelValue,
+			k8sAppLabelKey:     kubevirtLabelValue,
+		},
+	)
+}
+
 func ListRecordingRules() []operatorrules.RecordingRule {
 	return registry.ListRecordingRules()
 }
 
 func ListAlerts() []promv1.Rule {
-	return registry.ListAlerts()
+	return append(registry.ListAlerts(), workflowRegistry.ListAlerts()...)
 }
@@ -46,6 +46,22 @@ var _ = Describe("Rules Validation", func() {
 		Expect(problems).To(BeEmpty())
 	})
 
+	It("Should build the workflow alerts in their own PrometheusRule", func() {
+		promRule, err := rules.BuildPrometheusRule("test-ns")
+		Expect(err).ToNot(HaveOccurred())
+		workflowPromRule, err := rules.BuildWorkflowPrometheusRule("test-ns")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(workflowPromRule.Name).ToNot(Equal(promRule.Name))
+		Expect(workflowPromRule.Spec.Groups).ToNot(BeEmpty())
+		for _, group := range workflowPromRule.Spec.Groups {
+			for _, rule := range group.Rules {
+				Expect(rule.Alert).To(BeElementOf("VMIMigrationErrorBudgetFastBurn", "VMIMigrationErrorBudgetSlowBurn",
+					"VMRestoreStuck", "VMExportNotReady"))
+			}
+		}
+	})
+
 	It("Should validate recording rules", func() {
 		problems := linter.LintRecordingRules(rules.ListRecordingRules())
 		Expect(problems).To(BeEmpty())
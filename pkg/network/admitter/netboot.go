@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateNetworkBoot(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	netBootIfaceFound := false
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.NetworkBoot == nil {
+			continue
+		}
+		netBootField := field.Child("domain", "devices", "interfaces").Index(idx).Child("networkBoot")
+
+		if netBootIfaceFound {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "only one interface can have networkBoot",
+				Field:   netBootField.String(),
+			})
+		}
+		netBootIfaceFound = true
+
+		if iface.BootOrder != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "networkBoot can not be combined with bootOrder",
+				Field:   netBootField.String(),
+			})
+		}
+		if iface.SRIOV != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "networkBoot is not supported with SR-IOV interfaces",
+				Field:   netBootField.String(),
+			})
+		}
+
+		causes = append(causes, validateIPXEScriptRef(netBootField.Child("ipxeScriptRef"), iface, spec.Volumes)...)
+	}
+	return causes
+}
+
+func validateIPXEScriptRef(field *k8sfield.Path, iface v1.Interface, volumes []v1.Volume) []metav1.StatusCause {
+	scriptRef := iface.NetworkBoot.IPXEScriptRef
+	if scriptRef == "" {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if iface.Masquerade == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "ipxeScriptRef is only supported with the masquerade binding",
+			Field:   field.String(),
+		})
+	}
+	if iface.DHCPOptions != nil && iface.DHCPOptions.BootFileName != "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "ipxeScriptRef can not be combined with dhcpOptions.bootFileName",
+			Field:   field.String(),
+		})
+	}
+	if !hasConfigMapVolume(volumes, scriptRef) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: "ipxeScriptRef must reference a configMap volume",
+			Field:   field.String(),
+		})
+	}
+	return causes
+}
+
+func hasConfigMapVolume(volumes []v1.Volume, name string) bool {
+	for _, volume := range volumes {
+		if volume.Name == name {
+			return volume.ConfigMap != nil
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating network boot", func() {
+	const scriptVolumeName = "boot-script"
+
+	newSpec := func(ifaces ...v1.Interface) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = ifaces
+		for _, iface := range ifaces {
+			spec.Networks = append(spec.Networks, v1.Network{
+				Name:          iface.Name,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: iface.Name}},
+			})
+		}
+		spec.Networks[0].NetworkSource = v1.NetworkSource{Pod: &v1.PodNetwork{}}
+		spec.Volumes = []v1.Volume{{
+			Name: scriptVolumeName,
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "ipxe"}},
+			},
+		}}
+		return spec
+	}
+
+	newMasqueradeIface := func(name string, networkBoot *v1.InterfaceNetworkBoot) v1.Interface {
+		return v1.Interface{
+			Name:                   name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			NetworkBoot:            networkBoot,
+		}
+	}
+
+	newBridgeIface := func(name string, networkBoot *v1.InterfaceNetworkBoot) v1.Interface {
+		return v1.Interface{
+			Name:                   name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			NetworkBoot:            networkBoot,
+		}
+	}
+
+	validate := func(spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+		return admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{}).Validate()
+	}
+
+	DescribeTable("should accept", func(spec *v1.VirtualMachineInstanceSpec) {
+		Expect(validate(spec)).To(BeEmpty())
+	},
+		Entry("a network boot interface", newSpec(
+			newMasqueradeIface("default", &v1.InterfaceNetworkBoot{}),
+			newBridgeIface("red", nil),
+		)),
+		Entry("a network boot interface with an iPXE script", newSpec(
+			newMasqueradeIface("default", &v1.InterfaceNetworkBoot{IPXEScriptRef: scriptVolumeName}),
+		)),
+	)
+
+	It("should reject more than one network boot interface", func() {
+		spec := newSpec(
+			newMasqueradeIface("default", &v1.InterfaceNetworkBoot{}),
+			newBridgeIface("red", &v1.InterfaceNetworkBoot{}),
+		)
+
+		Expect(validate(spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "only one interface can have networkBoot",
+			Field:   "fake.domain.devices.interfaces[1].networkBoot",
+		}))
+	})
+
+	It("should reject a network boot interface with a boot order", func() {
+		iface := newMasqueradeIface("default", &v1.InterfaceNetworkBoot{})
+		bootOrder := uint(1)
+		iface.BootOrder = &bootOrder
+
+		Expect(validate(newSpec(iface))).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "networkBoot can not be combined with bootOrder",
+			Field:   "fake.domain.devices.interfaces[0].networkBoot",
+		}))
+	})
+
+	It("should reject a network boot SR-IOV interface", func() {
+		spec := newSpec(
+			newMasqueradeIface("default", nil),
+			v1.Interface{
+				Name:                   "sriov",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+				NetworkBoot:            &v1.InterfaceNetworkBoot{},
+			},
+		)
+
+		Expect(validate(spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "networkBoot is not supported with SR-IOV interfaces",
+			Field:   "fake.domain.devices.interfaces[1].networkBoot",
+		}))
+	})
+
+	It("should reject an iPXE script on an interface without masquerade binding", func() {
+		spec := newSpec(newBridgeIface("default", &v1.InterfaceNetworkBoot{IPXEScriptRef: scriptVolumeName}))
+
+		Expect(validate(spec)).To(ContainElement(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "ipxeScriptRef is only supported with the masquerade binding",
+			Field:   "fake.domain.devices.interfaces[0].networkBoot.ipxeScriptRef",
+		}))
+	})
+
+	It("should reject an iPXE script combined with a DHCP boot file name", func() {
+		iface := newMasqueradeIface("default", &v1.InterfaceNetworkBoot{IPXEScriptRef: scriptVolumeName})
+		iface.DHCPOptions = &v1.DHCPOptions{BootFileName: "pxelinux.0"}
+
+		Expect(validate(newSpec(iface))).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "ipxeScriptRef can not be combined with dhcpOptions.bootFileName",
+			Field:   "fake.domain.devices.interfaces[0].networkBoot.ipxeScriptRef",
+		}))
+	})
+
+	DescribeTable("should reject an iPXE script which does not reference a configMap volume", func(volumes []v1.Volume) {
+		spec := newSpec(newMasqueradeIface("default", &v1.InterfaceNetworkBoot{IPXEScriptRef: scriptVolumeName}))
+		spec.Volumes = volumes
+
+		Expect(validate(spec)).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: "ipxeScriptRef must reference a configMap volume",
+			Field:   "fake.domain.devices.interfaces[0].networkBoot.ipxeScriptRef",
+		}))
+	},
+		Entry("without the volume", nil),
+		Entry("with a volume of another type", []v1.Volume{{
+			Name:         scriptVolumeName,
+			VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}},
+		}}),
+	)
+})
@@ -24,13 +24,17 @@ package dhcp
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"path/filepath"
 
 	"kubevirt.io/client-go/log"
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/config"
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/dhcp/server"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 )
 
@@ -47,6 +51,8 @@ type configurator struct {
 	handler              netdriver.NetworkHandler
 	dhcpStartedDirectory string
 	podInterfaceName     string
+	ipxeScriptPath       string
+	ipxeScriptServer     func(serverIP net.IP, scriptPath string) error
 }
 
 type ConfigGenerator interface {
@@ -80,9 +86,18 @@ func NewMasqueradeConfigurator(advertisingIfaceName string, handler netdriver.Ne
 			subdomain: subdomain, podInterfaceName: podInterfaceName},
 		handler:              handler,
 		dhcpStartedDirectory: defaultDHCPStartedDirectory,
+		ipxeScriptPath:       ipxeScriptPath(vmiSpecIface),
+		ipxeScriptServer:     server.IPXEScriptServer,
 	}
 }
 
+func ipxeScriptPath(vmiSpecIface *v1.Interface) string {
+	if vmiSpecIface == nil || vmiSpecIface.NetworkBoot == nil || vmiSpecIface.NetworkBoot.IPXEScriptRef == "" {
+		return ""
+	}
+	return filepath.Join(config.GetConfigMapSourcePath(vmiSpecIface.NetworkBoot.IPXEScriptRef), v1.IPXEScriptKey)
+}
+
 func (d *configurator) EnsureDHCPServerStarted(podInterfaceName string, dhcpConfig cache.DHCPConfig, dhcpOptions *v1.DHCPOptions) error {
 	if dhcpConfig.IPAMDisabled {
 		return nil
@@ -90,6 +105,9 @@ func (d *configurator) EnsureDHCPServerStarted(podInterfaceName string, dhcpConf
 	dhcpStartedFile := d.getDHCPStartedFilePath(podInterfaceName)
 	_, err := os.Stat(dhcpStartedFile)
 	if errors.Is(err, os.ErrNotExist) {
+		if d.ipxeScriptPath != "" && dhcpConfig.AdvertisingIPAddr != nil {
+			dhcpOptions = d.startIPXEScriptServer(dhcpConfig.AdvertisingIPAddr, dhcpOptions)
+		}
 		if err := d.handler.StartDHCP(&dhcpConfig, d.advertisingIfaceName, dhcpOptions); err != nil {
 			return fmt.Errorf("failed to start DHCP server for interface %s", podInterfaceName)
 		}
@@ -106,6 +124,24 @@ func (d *configurator) EnsureDHCPServerStarted(podInterfaceName string, dhcpConf
 	return nil
 }
 
+// startIPXEScriptServer serves the iPXE script of the interface and returns the
+// DHCP options offering it as the boot file
+func (d *configurator) startIPXEScriptServer(serverIP net.IP, dhcpOptions *v1.DHCPOptions) *v1.DHCPOptions {
+	go func() {
+		if err := d.ipxeScriptServer(serverIP, d.ipxeScriptPath); err != nil {
+			log.Log.Reason(err).Errorf("iPXE script server for %s stopped", d.ipxeScriptPath)
+		}
+	}()
+
+	if dhcpOptions == nil {
+		dhcpOptions = &v1.DHCPOptions{}
+	} else {
+		dhcpOptions = dhcpOptions.DeepCopy()
+	}
+	dhcpOptions.BootFileName = server.IPXEScriptURL(serverIP)
+	return dhcpOptions
+}
+
 func (d *configurator) getDHCPStartedFilePath(podInterfaceName string) string {
 	return fmt.Sprintf("%s/dhcp_started-%s", d.dhcpStartedDirectory, podInterfaceName)
 }
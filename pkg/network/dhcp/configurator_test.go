@@ -20,6 +20,7 @@ package dhcp
 
 import (
 	"fmt"
+	"net"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -119,6 +120,22 @@ var _ = Describe("DHCP configurator", func() {
 			Entry("with masquerade configurator", newMasqueradeConfigurator),
 		)
 
+		It("should serve the iPXE script of a network boot interface and offer it as boot file", func() {
+			cfg := NewMasqueradeConfigurator(bridgeName, netdriver.NewMockNetworkHandler(gomock.NewController(GinkgoT())),
+				&v1.Interface{Name: ifaceName, NetworkBoot: &v1.InterfaceNetworkBoot{IPXEScriptRef: "boot-script"}}, nil, "", "")
+			cfg.dhcpStartedDirectory = fakeDhcpStartedDir
+			servedScripts := make(chan string, 1)
+			cfg.ipxeScriptServer = func(serverIP net.IP, scriptPath string) error {
+				servedScripts <- fmt.Sprintf("%s %s", serverIP, scriptPath)
+				return nil
+			}
+			expectedOptions := &v1.DHCPOptions{BootFileName: "http://10.10.10.0:8069/script.ipxe"}
+			cfg.handler.(*netdriver.MockNetworkHandler).EXPECT().StartDHCP(&dhcpConfig, bridgeName, expectedOptions).Return(nil)
+
+			Expect(cfg.EnsureDHCPServerStarted(ifaceName, dhcpConfig, nil)).To(Succeed())
+			Eventually(servedScripts).Should(Receive(Equal("10.10.10.0 /var/run/kubevirt-private/config-map/boot-script/script.ipxe")))
+		})
+
 		When("IPAM is disabled on the DHCPConfig", func() {
 			BeforeEach(func() {
 				dhcpConfig = cache.DHCPConfig{
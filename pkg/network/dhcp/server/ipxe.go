@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// IPXEScriptPort is the port the iPXE script is served on, on the advertising address of the DHCP server
+const IPXEScriptPort = 8069
+
+// IPXEScriptURL returns the URL the guest fetches the iPXE script from
+func IPXEScriptURL(serverIP net.IP) string {
+	return fmt.Sprintf("http://%s/%s", net.JoinHostPort(serverIP.String(), strconv.Itoa(IPXEScriptPort)), v1.IPXEScriptKey)
+}
+
+// IPXEScriptServer serves the iPXE script file over HTTP, it returns only if the server fails
+func IPXEScriptServer(serverIP net.IP, scriptPath string) error {
+	server := &http.Server{
+		Addr:              net.JoinHostPort(serverIP.String(), strconv.Itoa(IPXEScriptPort)),
+		Handler:           newIPXEScriptHandler(scriptPath),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+func newIPXEScriptHandler(scriptPath string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+v1.IPXEScriptKey, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		http.ServeFile(w, r, scriptPath)
+	})
+	return mux
+}
@@ -0,0 +1,65 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package server
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("iPXE script server", func() {
+	const script = "#!ipxe\nchain http://boot.example.com/boot.ipxe\n"
+
+	var handler http.Handler
+
+	BeforeEach(func() {
+		scriptPath := filepath.Join(GinkgoT().TempDir(), "script.ipxe")
+		Expect(os.WriteFile(scriptPath, []byte(script), 0o600)).To(Succeed())
+		handler = newIPXEScriptHandler(scriptPath)
+	})
+
+	It("should serve the script", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/script.ipxe", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(Equal(script))
+	})
+
+	DescribeTable("should not serve", func(method, path string, expectedCode int) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+
+		Expect(recorder.Code).To(Equal(expectedCode))
+	},
+		Entry("other paths", http.MethodGet, "/other", http.StatusNotFound),
+		Entry("other methods", http.MethodPost, "/script.ipxe", http.StatusMethodNotAllowed),
+	)
+
+	It("should build the script URL from the server address", func() {
+		Expect(IPXEScriptURL(net.ParseIP("10.0.2.1"))).To(Equal("http://10.0.2.1:8069/script.ipxe"))
+	})
+})
@@ -128,6 +128,17 @@ func LookupInterfaceByName(ifaces []v1.Interface, name string) *v1.Interface {
 	return nil
 }
 
+// LookupNetworkBootInterface returns the interface the vmi boots from the network with,
+// or nil if the vmi does not boot from the network.
+func LookupNetworkBootInterface(ifaces []v1.Interface) *v1.Interface {
+	for idx := range ifaces {
+		if ifaces[idx].NetworkBoot != nil && ifaces[idx].State != v1.InterfaceStateAbsent {
+			return &ifaces[idx]
+		}
+	}
+	return nil
+}
+
 func IndexInterfaceStatusByName(
 	interfaces []v1.VirtualMachineInstanceNetworkInterface,
 	p func(ifaceStatus v1.VirtualMachineInstanceNetworkInterface) bool,
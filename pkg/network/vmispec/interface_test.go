@@ -173,6 +173,24 @@ var _ = Describe("VMI network spec", func() {
 			Expect(netvmispec.BindingPluginNetworkWithDeviceInfoExist(ifaces, bindingPlugins)).To(BeTrue())
 		})
 	})
+	Context("network boot interface", func() {
+		It("returns nil when no interface boots from the network", func() {
+			ifaces := []v1.Interface{libvmi.InterfaceDeviceWithMasqueradeBinding()}
+			Expect(netvmispec.LookupNetworkBootInterface(ifaces)).To(BeNil())
+		})
+		It("returns the interface booting from the network", func() {
+			netBootIface := libvmi.InterfaceDeviceWithBridgeBinding("net1")
+			netBootIface.NetworkBoot = &v1.InterfaceNetworkBoot{}
+			ifaces := []v1.Interface{libvmi.InterfaceDeviceWithMasqueradeBinding(), netBootIface}
+			Expect(netvmispec.LookupNetworkBootInterface(ifaces)).To(Equal(&netBootIface))
+		})
+		It("ignores an absent interface", func() {
+			netBootIface := libvmi.InterfaceDeviceWithBridgeBinding("net1")
+			netBootIface.NetworkBoot = &v1.InterfaceNetworkBoot{}
+			netBootIface.State = v1.InterfaceStateAbsent
+			Expect(netvmispec.LookupNetworkBootInterface([]v1.Interface{netBootIface})).To(BeNil())
+		})
+	})
 })
 
 func interfaceWithBindingPlugin(name, pluginName string) v1.Interface {
@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package revisionhistory stores the revisions of VirtualMachine specs in
+// ControllerRevisions, which the VM can be rolled back to.
+package revisionhistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+// DefaultLimit is the number of old revisions kept when the VM does not set a limit
+const DefaultLimit int32 = 10
+
+type revisionData struct {
+	Spec virtv1.VirtualMachineSpec `json:"spec"`
+}
+
+// Data returns the content of the revision of a VM spec. The fields controlling
+// the power state and the history itself are not part of the revision, so
+// starting or stopping the VM does not record a new one.
+func Data(spec *virtv1.VirtualMachineSpec) ([]byte, error) {
+	specCopy := spec.DeepCopy()
+	specCopy.Running = nil
+	specCopy.RunStrategy = nil
+	specCopy.RevisionHistoryLimit = nil
+	return json.Marshal(revisionData{Spec: *specCopy})
+}
+
+// Spec decodes the VM spec stored in a revision
+func Spec(cr *appsv1.ControllerRevision) (*virtv1.VirtualMachineSpec, error) {
+	data := revisionData{}
+	if err := json.Unmarshal(cr.Data.Raw, &data); err != nil {
+		return nil, err
+	}
+	return &data.Spec, nil
+}
+
+// Name returns the name of the revision of a VM with the given content
+func Name(vmUID types.UID, data []byte) string {
+	hasher := fnv.New32a()
+	hasher.Write(data)
+	return fmt.Sprintf("revision-history-vm-%s-%s", vmUID, rand.SafeEncodeString(fmt.Sprint(hasher.Sum32())))
+}
+
+// Limit returns the number of old revisions to keep for a VM
+func Limit(vm *virtv1.VirtualMachine) int {
+	if vm.Spec.RevisionHistoryLimit == nil {
+		return int(DefaultLimit)
+	}
+	return int(*vm.Spec.RevisionHistoryLimit)
+}
+
+// IsRevisionOf tells whether the ControllerRevision is a revision in the history of the VM
+func IsRevisionOf(cr *appsv1.ControllerRevision, vm *virtv1.VirtualMachine) bool {
+	if cr.Labels[virtv1.VirtualMachineRevisionHistoryLabel] != vm.Name {
+		return false
+	}
+	for _, ref := range cr.OwnerReferences {
+		if ref.UID == vm.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// SortByRevision sorts revisions from the oldest to the newest
+func SortByRevision(revisions []*appsv1.ControllerRevision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+}
@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package revisionhistory_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/revisionhistory"
+)
+
+var _ = Describe("Revision history", func() {
+	var vm *virtv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvm", Namespace: "default", UID: "vm-uid"},
+			Spec: virtv1.VirtualMachineSpec{
+				RunStrategy: pointer.P(virtv1.RunStrategyAlways),
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{Hostname: "first"},
+				},
+			},
+		}
+	})
+
+	It("should not record the power state and the history limit", func() {
+		data, err := revisionhistory.Data(&vm.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyHalted)
+		vm.Spec.RevisionHistoryLimit = pointer.P(int32(3))
+		otherData, err := revisionhistory.Data(&vm.Spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(otherData).To(Equal(data))
+		Expect(revisionhistory.Name(vm.UID, otherData)).To(Equal(revisionhistory.Name(vm.UID, data)))
+
+		spec, err := revisionhistory.Spec(&appsv1.ControllerRevision{Data: runtime.RawExtension{Raw: data}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.RunStrategy).To(BeNil())
+		Expect(spec.Template.Spec.Hostname).To(Equal("first"))
+	})
+
+	It("should name revisions with different specs differently", func() {
+		data, err := revisionhistory.Data(&vm.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		vm.Spec.Template.Spec.Hostname = "second"
+		otherData, err := revisionhistory.Data(&vm.Spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revisionhistory.Name(vm.UID, otherData)).ToNot(Equal(revisionhistory.Name(vm.UID, data)))
+	})
+
+	It("should default the history limit", func() {
+		Expect(revisionhistory.Limit(vm)).To(Equal(int(revisionhistory.DefaultLimit)))
+		vm.Spec.RevisionHistoryLimit = pointer.P(int32(2))
+		Expect(revisionhistory.Limit(vm)).To(Equal(2))
+	})
+
+	It("should only consider revisions owned by the VM", func() {
+		cr := &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:          map[string]string{virtv1.VirtualMachineRevisionHistoryLabel: vm.Name},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)},
+			},
+		}
+		Expect(revisionhistory.IsRevisionOf(cr, vm)).To(BeTrue())
+
+		cr.OwnerReferences[0].UID = "other-uid"
+		Expect(revisionhistory.IsRevisionOf(cr, vm)).To(BeFalse())
+
+		cr.OwnerReferences[0].UID = vm.UID
+		cr.Labels = nil
+		Expect(revisionhistory.IsRevisionOf(cr, vm)).To(BeFalse())
+	})
+})
@@ -21,7 +21,6 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -72,7 +71,7 @@ func (admitter *VMBackupAdmitter) Admit(ctx context.Context, ar *admissionv1.Adm
 	}
 
 	vmBackup := &backupv1.VirtualMachineBackup{}
-	if err := json.Unmarshal(ar.Request.Object.Raw, vmBackup); err != nil {
+	if err := webhookutils.DecodeObject(ar.Request.Object.Raw, vmBackup); err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
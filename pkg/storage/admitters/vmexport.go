@@ -21,7 +21,6 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -65,8 +64,7 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 	}
 
 	vmExport := &exportv1.VirtualMachineExport{}
-	// TODO ideally use UniversalDeserializer here
-	err := json.Unmarshal(ar.Request.Object.Raw, vmExport)
+	err := webhookutils.DecodeObject(ar.Request.Object.Raw, vmExport)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
@@ -102,7 +100,7 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 
 	case admissionv1.Update:
 		prevObj := &exportv1.VirtualMachineExport{}
-		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		err = webhookutils.DecodeObject(ar.Request.OldObject.Raw, prevObj)
 		if err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
 		}
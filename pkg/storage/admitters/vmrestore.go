@@ -21,14 +21,15 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 
@@ -39,6 +40,7 @@ import (
 
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -47,14 +49,23 @@ type VMRestoreAdmitter struct {
 	Config            *virtconfig.ClusterConfig
 	Client            kubecli.KubevirtClient
 	VMRestoreInformer cache.SharedIndexInformer
+
+	// Optional informers used to look up the snapshot and its content without a live
+	// GET. The API server is still queried when an object is missing from the cache.
+	// The target VM is always read live, see validateTargetVM.
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
 }
 
 // NewVMRestoreAdmitter creates a VMRestoreAdmitter
-func NewVMRestoreAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient, vmRestoreInformer cache.SharedIndexInformer) *VMRestoreAdmitter {
+func NewVMRestoreAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient, vmRestoreInformer,
+	vmSnapshotInformer, vmSnapshotContentInformer cache.SharedIndexInformer) *VMRestoreAdmitter {
 	return &VMRestoreAdmitter{
-		Config:            config,
-		Client:            client,
-		VMRestoreInformer: vmRestoreInformer,
+		Config:                    config,
+		Client:                    client,
+		VMRestoreInformer:         vmRestoreInformer,
+		VMSnapshotInformer:        vmSnapshotInformer,
+		VMSnapshotContentInformer: vmSnapshotContentInformer,
 	}
 }
 
@@ -70,67 +81,38 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 	}
 
 	vmRestore := &snapshotv1.VirtualMachineRestore{}
-	// TODO ideally use UniversalDeserializer here
-	err := json.Unmarshal(ar.Request.Object.Raw, vmRestore)
+	err := webhookutils.DecodeObject(ar.Request.Object.Raw, vmRestore)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
 	var causes []metav1.StatusCause
 
+	// The target reference, the required fields and the immutability of the spec are
+	// validated by the ValidatingAdmissionPolicy deployed by virt-operator.
 	switch ar.Request.Operation {
 	case admissionv1.Create:
 		targetField := k8sfield.NewPath("spec", "target")
 
-		if vmRestore.Spec.Target.APIGroup == nil {
-			causes = []metav1.StatusCause{
-				{
-					Type:    metav1.CauseTypeFieldValueNotFound,
-					Message: "missing apiGroup",
-					Field:   targetField.Child("apiGroup").String(),
-				},
+		if isVirtualMachineTarget(vmRestore.Spec.Target) {
+			causes, err = admitter.validateTargetVM(ctx, k8sfield.NewPath("spec"), vmRestore)
+			if err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
 			}
-		} else {
-			switch *vmRestore.Spec.Target.APIGroup {
-			case core.GroupName:
-				switch vmRestore.Spec.Target.Kind {
-				case "VirtualMachine":
-					causes, err = admitter.validateTargetVM(ctx, k8sfield.NewPath("spec"), vmRestore)
-					if err != nil {
-						return webhookutils.ToAdmissionResponseError(err)
-					}
-
-					newCauses := admitter.validateVolumeOverrides(ctx, vmRestore)
-					if newCauses != nil {
-						causes = append(causes, newCauses...)
-					}
-
-					newCauses = admitter.validateVolumeRestorePolicy(ctx, vmRestore)
-					if newCauses != nil {
-						causes = append(causes, newCauses...)
-					}
-
-					newCauses = admitter.validateVolumeOwnershipPolicy(ctx, vmRestore)
-					if newCauses != nil {
-						causes = append(causes, newCauses...)
-					}
-				default:
-					causes = []metav1.StatusCause{
-						{
-							Type:    metav1.CauseTypeFieldValueInvalid,
-							Message: "invalid kind",
-							Field:   targetField.Child("kind").String(),
-						},
-					}
-				}
-			default:
-				causes = []metav1.StatusCause{
-					{
-						Type:    metav1.CauseTypeFieldValueInvalid,
-						Message: "invalid apiGroup",
-						Field:   targetField.Child("apiGroup").String(),
-					},
-				}
+
+			newCauses := admitter.validateVolumeOverrides(ctx, vmRestore)
+			if newCauses != nil {
+				causes = append(causes, newCauses...)
+			}
+
+			newCauses = admitter.validateVolumeRestorePolicy(ctx, vmRestore)
+			if newCauses != nil {
+				causes = append(causes, newCauses...)
+			}
+
+			newCauses = admitter.validateVolumeOwnershipPolicy(ctx, vmRestore)
+			if newCauses != nil {
+				causes = append(causes, newCauses...)
 			}
 		}
 
@@ -153,21 +135,6 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 		}
 
 	case admissionv1.Update:
-		prevObj := &snapshotv1.VirtualMachineRestore{}
-		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
-		if err != nil {
-			return webhookutils.ToAdmissionResponseError(err)
-		}
-
-		if !equality.Semantic.DeepEqual(prevObj.Spec, vmRestore.Spec) {
-			causes = []metav1.StatusCause{
-				{
-					Type:    metav1.CauseTypeFieldValueInvalid,
-					Message: "spec in immutable after creation",
-					Field:   k8sfield.NewPath("spec").String(),
-				},
-			}
-		}
 	default:
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
 	}
@@ -176,10 +143,33 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
-	reviewResponse := admissionv1.AdmissionResponse{
-		Allowed: true,
+	var warnings []string
+	if ar.Request.Operation == admissionv1.Create {
+		warnings = warnVMRestore(vmRestore)
+	}
+
+	return validating_webhooks.NewPassingAdmissionResponse(warnings...)
+}
+
+func isVirtualMachineTarget(target corev1.TypedLocalObjectReference) bool {
+	return target.APIGroup != nil && *target.APIGroup == core.GroupName && target.Kind == "VirtualMachine"
+}
+
+func warnVMRestore(vmRestore *snapshotv1.VirtualMachineRestore) []string {
+	var warnings []string
+	specField := k8sfield.NewPath("spec")
+
+	if vmRestore.Spec.VolumeRestorePolicy != nil && *vmRestore.Spec.VolumeRestorePolicy == snapshotv1.VolumeRestorePolicyInPlace {
+		warnings = append(warnings, fmt.Sprintf("%s is set to %s, the existing PVCs of the target are deleted and replaced by the restored volumes",
+			specField.Child("volumeRestorePolicy").String(), snapshotv1.VolumeRestorePolicyInPlace))
+	}
+
+	if vmRestore.Spec.TargetReadinessPolicy != nil && *vmRestore.Spec.TargetReadinessPolicy == snapshotv1.VirtualMachineRestoreWaitEventually {
+		warnings = append(warnings, fmt.Sprintf("%s is set to %s, the restore waits for the target to be ready without any timeout",
+			specField.Child("targetReadinessPolicy").String(), snapshotv1.VirtualMachineRestoreWaitEventually))
 	}
-	return &reviewResponse
+
+	return warnings
 }
 
 func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause, err error) {
@@ -188,27 +178,47 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
 
-	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
+	vmSnapshot, err := getCachedOrLive(admitter.VMSnapshotInformer, namespace, vmRestore.Spec.VirtualMachineSnapshotName,
+		func() (*snapshotv1.VirtualMachineSnapshot, error) {
+			return admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
+		})
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	if vmSnapshot.Status == nil || vmSnapshot.Status.SourceUID == nil || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		// The cached snapshot may predate its source UID and the creation of its content
+		vmSnapshot, err = admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
 
+	// The target is read live, as its UID decides whether it is the source of the snapshot.
+	// A cached target which was deleted and recreated under the same name would still
+	// carry the UID of the source and skip the checks of a restore to a different VM.
 	target, err := admitter.Client.VirtualMachine(namespace).Get(ctx, targetName, metav1.GetOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, err
 	}
 
-	sourceTargetVmsAreDifferent := errors.IsNotFound(err) || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
+	sourceTargetVmsAreDifferent := errors.IsNotFound(err) ||
+		(vmSnapshot.Status != nil && vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
 	if sourceTargetVmsAreDifferent {
-		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
-		if contentName == nil {
+		if vmSnapshot.Status == nil || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
 			return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
 		}
+		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
 
-		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
+		vmSnapshotContent, err := getCachedOrLive(admitter.VMSnapshotContentInformer, namespace, *contentName,
+			func() (*snapshotv1.VirtualMachineSnapshotContent, error) {
+				return admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
+			})
 		if err != nil {
 			return nil, err
 		}
@@ -230,6 +240,20 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 	return causes, nil
 }
 
+// getCachedOrLive returns the object from the informer cache and falls back to a live GET when
+// the informer is not set or does not know the object yet, e.g. right after its creation.
+func getCachedOrLive[T runtime.Object](informer cache.SharedIndexInformer, namespace, name string, get func() (T, error)) (T, error) {
+	if informer != nil {
+		obj, exists, err := informer.GetStore().GetByKey(cache.ObjectName{Namespace: namespace, Name: name}.String())
+		if err == nil && exists {
+			if cached, ok := obj.(T); ok {
+				return cached, nil
+			}
+		}
+	}
+	return get()
+}
+
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
 	// Validate patches are either on labels/annotations or on elements under "/spec/" path only
 	for _, patch := range patches {
@@ -150,28 +150,6 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
 		})
 
-		It("should reject missing apigroup", func() {
-			restore := &snapshotv1.VirtualMachineRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "restore",
-					Namespace: "default",
-				},
-				Spec: snapshotv1.VirtualMachineRestoreSpec{
-					Target: corev1.TypedLocalObjectReference{
-						Kind: "VirtualMachine",
-						Name: vmName,
-					},
-					VirtualMachineSnapshotName: vmSnapshotName,
-				},
-			}
-
-			ar := createRestoreAdmissionReview(restore)
-			resp := createTestVMRestoreAdmitter(config, snapshot).Admit(context.Background(), ar)
-			Expect(resp.Allowed).To(BeFalse())
-			Expect(resp.Result.Details.Causes).To(HaveLen(1))
-			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.apiGroup"))
-		})
-
 		It("should accept when snapshot does not exist", func() {
 			restore := &snapshotv1.VirtualMachineRestore{
 				ObjectMeta: metav1.ObjectMeta{
@@ -193,44 +171,6 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
-		It("should reject spec update", func() {
-			restore := &snapshotv1.VirtualMachineRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "restore",
-					Namespace: "default",
-				},
-				Spec: snapshotv1.VirtualMachineRestoreSpec{
-					Target: corev1.TypedLocalObjectReference{
-						APIGroup: &apiGroup,
-						Kind:     "VirtualMachine",
-						Name:     vmName,
-					},
-					VirtualMachineSnapshotName: vmSnapshotName,
-				},
-			}
-
-			oldRestore := &snapshotv1.VirtualMachineRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "restore",
-					Namespace: "default",
-				},
-				Spec: snapshotv1.VirtualMachineRestoreSpec{
-					Target: corev1.TypedLocalObjectReference{
-						APIGroup: &apiGroup,
-						Kind:     "VirtualMachine",
-						Name:     "baz",
-					},
-					VirtualMachineSnapshotName: vmSnapshotName,
-				},
-			}
-
-			ar := createRestoreUpdateAdmissionReview(oldRestore, restore)
-			resp := createTestVMRestoreAdmitter(config).Admit(context.Background(), ar)
-			Expect(resp.Allowed).To(BeFalse())
-			Expect(resp.Result.Details.Causes).To(HaveLen(1))
-			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
-		})
-
 		It("should allow metadata update", func() {
 			oldRestore := &snapshotv1.VirtualMachineRestore{
 				ObjectMeta: metav1.ObjectMeta{
@@ -324,57 +264,6 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Expect(resp.Allowed).To(BeTrue())
 			})
 
-			It("should reject invalid kind", func() {
-				restore := &snapshotv1.VirtualMachineRestore{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "restore",
-						Namespace: "default",
-					},
-					Spec: snapshotv1.VirtualMachineRestoreSpec{
-						Target: corev1.TypedLocalObjectReference{
-							APIGroup: &apiGroup,
-							Kind:     "VirtualMachineInstance",
-							Name:     vmName,
-						},
-						VirtualMachineSnapshotName: vmSnapshotName,
-					},
-				}
-
-				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
-
-				ar := createRestoreAdmissionReview(restore)
-				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
-				Expect(resp.Allowed).To(BeFalse())
-				Expect(resp.Result.Details.Causes).To(HaveLen(1))
-				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.kind"))
-			})
-
-			It("should reject invalid apiGroup", func() {
-				g := "foo.bar"
-				restore := &snapshotv1.VirtualMachineRestore{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "restore",
-						Namespace: "default",
-					},
-					Spec: snapshotv1.VirtualMachineRestoreSpec{
-						Target: corev1.TypedLocalObjectReference{
-							APIGroup: &g,
-							Kind:     "VirtualMachine",
-							Name:     vmName,
-						},
-						VirtualMachineSnapshotName: vmSnapshotName,
-					},
-				}
-
-				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
-
-				ar := createRestoreAdmissionReview(restore)
-				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
-				Expect(resp.Allowed).To(BeFalse())
-				Expect(resp.Result.Details.Causes).To(HaveLen(1))
-				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.apiGroup"))
-			})
-
 			It("should reject if restore in progress", func() {
 				restore := &snapshotv1.VirtualMachineRestore{
 					ObjectMeta: metav1.ObjectMeta{
@@ -487,6 +376,7 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				ar := createRestoreAdmissionReview(restore)
 				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
 				Expect(resp.Allowed).To(BeTrue())
+				Expect(resp.Warnings).To(ConsistOf(HavePrefix("spec.volumeRestorePolicy is set to InPlace")))
 			})
 
 			It("should accept PrefixTargetName volume restore policy", func() {
@@ -509,6 +399,30 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				ar := createRestoreAdmissionReview(restore)
 				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
 				Expect(resp.Allowed).To(BeTrue())
+				Expect(resp.Warnings).To(BeEmpty())
+			})
+
+			It("should warn when waiting for the target without timeout", func() {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						TargetReadinessPolicy:      pointer.P(snapshotv1.VirtualMachineRestoreWaitEventually),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+				Expect(resp.Warnings).To(ConsistOf(HavePrefix("spec.targetReadinessPolicy is set to WaitEventually")))
 			})
 
 			It("should reject invalid volume restore policy", func() {
@@ -652,6 +566,128 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Entry("target exists", true),
 			)
 
+			Context("with informers", func() {
+				var (
+					targetVM          *v1.VirtualMachine
+					vmSnapshotContent *snapshotv1.VirtualMachineSnapshotContent
+					restore           *snapshotv1.VirtualMachineRestore
+				)
+
+				BeforeEach(func() {
+					vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: v1.VirtualMachineInstanceSpec{
+							Domain: v1.DomainSpec{
+								Devices: v1.Devices{
+									TPM: &v1.TPMDevice{
+										Persistent: pointer.P(true),
+									},
+								},
+							},
+						},
+					}
+
+					targetVM = vm.DeepCopy()
+					targetVM.Name = "new-test-vm"
+					targetVM.Namespace = "default"
+					targetVM.UID = "new-uid"
+
+					vmSnapshotContent = &snapshotv1.VirtualMachineSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "snapshot-content",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{
+									ObjectMeta: vm.ObjectMeta,
+									Spec:       vm.Spec,
+								},
+							},
+						},
+					}
+
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     targetVM.Name,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+						},
+					}
+				})
+
+				It("should look up the snapshot and its content without API calls", func() {
+					cachedSnapshot := snapshot.DeepCopy()
+					cachedSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+
+					admitter := createTestVMRestoreAdmitterWithInformers(config, []runtime.Object{targetVM}, cachedSnapshot, vmSnapshotContent)
+					resp := admitter.Admit(context.Background(), createRestoreAdmissionReview(restore))
+
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("Restore to a different VM is not supported"))
+				})
+
+				It("should fall back to the API when the snapshot is not cached yet", func() {
+					liveSnapshot := snapshot.DeepCopy()
+					liveSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+
+					admitter := createTestVMRestoreAdmitterWithInformers(config, []runtime.Object{liveSnapshot, targetVM}, vmSnapshotContent)
+					resp := admitter.Admit(context.Background(), createRestoreAdmissionReview(restore))
+
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("Restore to a different VM is not supported"))
+				})
+
+				It("should refresh a cached snapshot which does not reference its content yet", func() {
+					cachedSnapshot := snapshot.DeepCopy()
+					cachedSnapshot.Status.VirtualMachineSnapshotContentName = nil
+					liveSnapshot := snapshot.DeepCopy()
+					liveSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+
+					admitter := createTestVMRestoreAdmitterWithInformers(config, []runtime.Object{liveSnapshot, targetVM}, cachedSnapshot, vmSnapshotContent)
+					resp := admitter.Admit(context.Background(), createRestoreAdmissionReview(restore))
+
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("Restore to a different VM is not supported"))
+				})
+
+				It("should refresh a cached snapshot which does not know its source UID yet", func() {
+					cachedSnapshot := snapshot.DeepCopy()
+					cachedSnapshot.Status.SourceUID = nil
+					cachedSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+					liveSnapshot := snapshot.DeepCopy()
+					liveSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+
+					admitter := createTestVMRestoreAdmitterWithInformers(config, []runtime.Object{liveSnapshot, targetVM}, cachedSnapshot, vmSnapshotContent)
+					resp := admitter.Admit(context.Background(), createRestoreAdmissionReview(restore))
+
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("Restore to a different VM is not supported"))
+				})
+
+				It("should compare the UID of the live target with the source of the snapshot", func() {
+					cachedSnapshot := snapshot.DeepCopy()
+					cachedSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+					sourceVM := targetVM.DeepCopy()
+					sourceVM.UID = *snapshot.Status.SourceUID
+
+					admitter := createTestVMRestoreAdmitterWithInformers(config, []runtime.Object{sourceVM}, cachedSnapshot, vmSnapshotContent)
+					resp := admitter.Admit(context.Background(), createRestoreAdmissionReview(restore))
+
+					Expect(resp.Allowed).To(BeTrue())
+				})
+			})
+
 			Context("when using Patches", func() {
 
 				var restore *snapshotv1.VirtualMachineRestore
@@ -768,6 +804,40 @@ func createRestoreUpdateAdmissionReview(old, current *snapshotv1.VirtualMachineR
 	return ar
 }
 
+// createTestVMRestoreAdmitterWithInformers serves the cached objects from informers and
+// only the live objects from the API, any other API call fails the test.
+func createTestVMRestoreAdmitterWithInformers(
+	config *virtconfig.ClusterConfig,
+	liveObjs []runtime.Object,
+	cachedObjs ...runtime.Object,
+) *VMRestoreAdmitter {
+	ctrl := gomock.NewController(GinkgoT())
+	virtClient := kubecli.NewMockKubevirtClient(ctrl)
+	kubevirtClient := kubevirtfake.NewSimpleClientset(liveObjs...)
+	virtClient.EXPECT().VirtualMachine("default").
+		Return(kubevirtClient.KubevirtV1().VirtualMachines("default")).AnyTimes()
+	for _, obj := range liveObjs {
+		if _, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+			virtClient.EXPECT().VirtualMachineSnapshot("default").
+				Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots("default")).AnyTimes()
+		}
+	}
+
+	restoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+	snapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+	contentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+	for _, obj := range cachedObjs {
+		switch obj.(type) {
+		case *snapshotv1.VirtualMachineSnapshot:
+			Expect(snapshotInformer.GetStore().Add(obj)).To(Succeed())
+		case *snapshotv1.VirtualMachineSnapshotContent:
+			Expect(contentInformer.GetStore().Add(obj)).To(Succeed())
+		}
+	}
+
+	return NewVMRestoreAdmitter(config, virtClient, restoreInformer, snapshotInformer, contentInformer)
+}
+
 func createTestVMRestoreAdmitter(
 	config *virtconfig.ClusterConfig,
 	objs ...runtime.Object,
@@ -21,20 +21,16 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
-	"k8s.io/apimachinery/pkg/api/equality"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
-	"kubevirt.io/api/core"
-
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -64,76 +60,32 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 	}
 
 	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{}
-	// TODO ideally use UniversalDeserializer here
-	err := json.Unmarshal(ar.Request.Object.Raw, vmSnapshot)
+	err := webhookutils.DecodeObject(ar.Request.Object.Raw, vmSnapshot)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
-	var causes []metav1.StatusCause
-
+	// The source reference and the immutability of the spec are validated by the
+	// ValidatingAdmissionPolicy deployed by virt-operator.
 	switch ar.Request.Operation {
-	case admissionv1.Create:
-		sourceField := k8sfield.NewPath("spec", "source")
-
-		if vmSnapshot.Spec.Source.APIGroup == nil {
-			causes = []metav1.StatusCause{
-				{
-					Type:    metav1.CauseTypeFieldValueNotFound,
-					Message: "missing apiGroup",
-					Field:   sourceField.Child("apiGroup").String(),
-				},
-			}
-			break
-		}
-
-		switch *vmSnapshot.Spec.Source.APIGroup {
-		case core.GroupName:
-			if vmSnapshot.Spec.Source.Kind != "VirtualMachine" {
-				causes = []metav1.StatusCause{
-					{
-						Type:    metav1.CauseTypeFieldValueInvalid,
-						Message: "invalid kind",
-						Field:   sourceField.Child("kind").String(),
-					},
-				}
-			}
-		default:
-			causes = []metav1.StatusCause{
-				{
-					Type:    metav1.CauseTypeFieldValueInvalid,
-					Message: "invalid apiGroup",
-					Field:   sourceField.Child("apiGroup").String(),
-				},
-			}
-		}
-
-	case admissionv1.Update:
-		prevObj := &snapshotv1.VirtualMachineSnapshot{}
-		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
-		if err != nil {
-			return webhookutils.ToAdmissionResponseError(err)
-		}
-
-		if !equality.Semantic.DeepEqual(prevObj.Spec, vmSnapshot.Spec) {
-			causes = []metav1.StatusCause{
-				{
-					Type:    metav1.CauseTypeFieldValueInvalid,
-					Message: "spec in immutable after creation",
-					Field:   k8sfield.NewPath("spec").String(),
-				},
-			}
-		}
+	case admissionv1.Create, admissionv1.Update:
 	default:
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
 	}
 
-	if len(causes) > 0 {
-		return webhookutils.ToAdmissionResponse(causes)
+	var warnings []string
+	if ar.Request.Operation == admissionv1.Create {
+		warnings = warnVMSnapshot(vmSnapshot)
 	}
 
-	reviewResponse := admissionv1.AdmissionResponse{
-		Allowed: true,
+	return validating_webhooks.NewPassingAdmissionResponse(warnings...)
+}
+
+func warnVMSnapshot(vmSnapshot *snapshotv1.VirtualMachineSnapshot) []string {
+	var warnings []string
+	if vmSnapshot.Spec.FailureDeadline != nil && vmSnapshot.Spec.FailureDeadline.Duration == 0 {
+		warnings = append(warnings, fmt.Sprintf("%s is set to 0, the snapshot never times out and the guest filesystems stay frozen until it completes",
+			k8sfield.NewPath("spec", "failureDeadline").String()))
 	}
-	return &reviewResponse
+	return warnings
 }
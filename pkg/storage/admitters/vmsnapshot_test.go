@@ -22,9 +22,11 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -119,18 +121,6 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
 		})
 
-		It("should reject missing apigroup", func() {
-			snapshot := &snapshotv1.VirtualMachineSnapshot{
-				Spec: snapshotv1.VirtualMachineSnapshotSpec{},
-			}
-
-			ar := createSnapshotAdmissionReview(snapshot)
-			resp := createTestVMSnapshotAdmitter(config, nil).Admit(context.Background(), ar)
-			Expect(resp.Allowed).To(BeFalse())
-			Expect(resp.Result.Details.Causes).To(HaveLen(1))
-			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.apiGroup"))
-		})
-
 		It("should allow when VM does not exist", func() {
 			snapshot := &snapshotv1.VirtualMachineSnapshot{
 				Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -147,34 +137,6 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
-		It("should reject spec update", func() {
-			snapshot := &snapshotv1.VirtualMachineSnapshot{
-				Spec: snapshotv1.VirtualMachineSnapshotSpec{
-					Source: corev1.TypedLocalObjectReference{
-						APIGroup: &apiGroup,
-						Kind:     "VirtualMachine",
-						Name:     vmName,
-					},
-				},
-			}
-
-			oldSnapshot := &snapshotv1.VirtualMachineSnapshot{
-				Spec: snapshotv1.VirtualMachineSnapshotSpec{
-					Source: corev1.TypedLocalObjectReference{
-						APIGroup: &apiGroup,
-						Kind:     "VirtualMachine",
-						Name:     "baz",
-					},
-				},
-			}
-
-			ar := createSnapshotUpdateAdmissionReview(oldSnapshot, snapshot)
-			resp := createTestVMSnapshotAdmitter(config, nil).Admit(context.Background(), ar)
-			Expect(resp.Allowed).To(BeFalse())
-			Expect(resp.Result.Details.Causes).To(HaveLen(1))
-			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
-		})
-
 		It("should allow metadata update", func() {
 			oldSnapshot := &snapshotv1.VirtualMachineSnapshot{
 				Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -233,47 +195,6 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 				Expect(resp.Allowed).To(BeTrue())
 			})
 
-			It("should reject invalid kind", func() {
-				snapshot := &snapshotv1.VirtualMachineSnapshot{
-					Spec: snapshotv1.VirtualMachineSnapshotSpec{
-						Source: corev1.TypedLocalObjectReference{
-							APIGroup: &apiGroup,
-							Kind:     "VirtualMachineInstance",
-							Name:     vmName,
-						},
-					},
-				}
-
-				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
-
-				ar := createSnapshotAdmissionReview(snapshot)
-				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
-				Expect(resp.Allowed).To(BeFalse())
-				Expect(resp.Result.Details.Causes).To(HaveLen(1))
-				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.kind"))
-			})
-
-			It("should reject invalid apiGroup", func() {
-				g := "foo.bar"
-				snapshot := &snapshotv1.VirtualMachineSnapshot{
-					Spec: snapshotv1.VirtualMachineSnapshotSpec{
-						Source: corev1.TypedLocalObjectReference{
-							APIGroup: &g,
-							Kind:     "VirtualMachine",
-							Name:     vmName,
-						},
-					},
-				}
-
-				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
-
-				ar := createSnapshotAdmissionReview(snapshot)
-				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
-				Expect(resp.Allowed).To(BeFalse())
-				Expect(resp.Result.Details.Causes).To(HaveLen(1))
-				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.apiGroup"))
-			})
-
 			DescribeTable("should accept persistent storage with both offline and online snapshot", func(runStrategy v1.VirtualMachineRunStrategy) {
 				vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
 					Spec: v1.VirtualMachineInstanceSpec{
@@ -322,6 +243,28 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
 				Expect(resp.Allowed).To(BeTrue())
 			})
+
+			DescribeTable("should warn only when the failure deadline is disabled", func(failureDeadline *metav1.Duration, expectedWarnings types.GomegaMatcher) {
+				snapshot := &snapshotv1.VirtualMachineSnapshot{
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						FailureDeadline: failureDeadline,
+					},
+				}
+
+				ar := createSnapshotAdmissionReview(snapshot)
+				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+				Expect(resp.Warnings).To(expectedWarnings)
+			},
+				Entry("with the default deadline", nil, BeEmpty()),
+				Entry("with a custom deadline", &metav1.Duration{Duration: time.Minute}, BeEmpty()),
+				Entry("with a disabled deadline", &metav1.Duration{}, ConsistOf(HavePrefix("spec.failureDeadline is set to 0"))),
+			)
 		})
 	})
 })
@@ -286,6 +286,33 @@ func HasPersistentEFI(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
 		*vmiSpec.Domain.Firmware.Bootloader.EFI.Persistent
 }
 
+// HasSuspendToDisk returns true if the guest memory is saved to the backend storage on suspend
+func HasSuspendToDisk(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
+	return vmiSpec.Domain.Memory != nil &&
+		vmiSpec.Domain.Memory.SuspendToDisk != nil &&
+		*vmiSpec.Domain.Memory.SuspendToDisk
+}
+
+// PVCSizeForVMI returns the size of the backend storage PVC, which also holds the guest memory
+// when the VMI can be suspended to disk
+func PVCSizeForVMI(vmi *corev1.VirtualMachineInstance) resource.Quantity {
+	size := resource.MustParse(PVCSize)
+	if !HasSuspendToDisk(&vmi.Spec) {
+		return size
+	}
+
+	memory := vmi.Spec.Domain.Memory
+	switch {
+	case memory.MaxGuest != nil:
+		size.Add(*memory.MaxGuest)
+	case memory.Guest != nil:
+		size.Add(*memory.Guest)
+	default:
+		size.Add(*vmi.Spec.Domain.Resources.Requests.Memory())
+	}
+	return size
+}
+
 func IsBackendStorageNeeded(obj interface{}) bool {
 	switch obj := obj.(type) {
 	case *corev1.VirtualMachine:
@@ -294,6 +321,7 @@ func IsBackendStorageNeeded(obj interface{}) bool {
 		}
 		return tpm.HasPersistentDevice(&obj.Spec.Template.Spec) ||
 			HasPersistentEFI(&obj.Spec.Template.Spec) ||
+			HasSuspendToDisk(&obj.Spec.Template.Spec) ||
 			cbt.HasCBTStateEnabled(obj.Status.ChangedBlockTracking)
 	case *snapshotv1.VirtualMachine:
 		if obj.Spec.Template == nil {
@@ -305,6 +333,7 @@ func IsBackendStorageNeeded(obj interface{}) bool {
 	case *corev1.VirtualMachineInstance:
 		return tpm.HasPersistentDevice(&obj.Spec) ||
 			HasPersistentEFI(&obj.Spec) ||
+			HasSuspendToDisk(&obj.Spec) ||
 			cbt.HasCBTStateEnabled(obj.Status.ChangedBlockTracking)
 	default:
 		log.Log.Errorf("unsupported object type: %T", obj)
@@ -545,7 +574,7 @@ func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels m
 		Spec: v1.PersistentVolumeClaimSpec{
 			AccessModes: []v1.PersistentVolumeAccessMode{accessMode},
 			Resources: v1.VolumeResourceRequirements{
-				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(PVCSize)},
+				Requests: v1.ResourceList{v1.ResourceStorage: PVCSizeForVMI(vmi)},
 			},
 			StorageClassName: &storageClass,
 			VolumeMode:       &mode,
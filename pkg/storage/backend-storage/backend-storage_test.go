@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 
@@ -293,6 +294,29 @@ var _ = Describe("Backend Storage", func() {
 			Expect(pvc).NotTo(BeNil())
 			Expect(pvc.Labels).To(HaveKeyWithValue(storagetypes.LabelApplyStorageProfile, "true"))
 		})
+
+		It("Should size the PVC to also hold the guest memory when suspend to disk is enabled", func() {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(nsName),
+				libvmi.WithName(vmiName),
+				libvmi.WithGuestMemory("1Gi"),
+			)
+			vmi.Spec.Domain.Memory.SuspendToDisk = pointer.P(true)
+
+			sc := storagev1.StorageClass{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:        "sc",
+					Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+				},
+			}
+			Expect(storageClassStore.Add(&sc)).To(Succeed())
+
+			pvc, err := backendStorage.createPVC(vmi, map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+			expectedSize := resource.MustParse(PVCSize)
+			expectedSize.Add(resource.MustParse("1Gi"))
+			Expect(pvc.Spec.Resources.Requests.Storage().Cmp(expectedSize)).To(BeZero())
+		})
 	})
 
 	Context("Legacy PVCs", func() {
@@ -372,6 +396,13 @@ var _ = Describe("Backend Storage", func() {
 			}),
 		)
 
+		It("should be true for a VM and VMI with suspend to disk", func() {
+			vmi.Spec.Domain.Memory = &virtv1.Memory{SuspendToDisk: pointer.P(true)}
+			vm.Spec.Template.Spec.Domain.Memory = &virtv1.Memory{SuspendToDisk: pointer.P(true)}
+			Expect(IsBackendStorageNeeded(vm)).To(BeTrue())
+			Expect(IsBackendStorageNeeded(vmi)).To(BeTrue())
+		})
+
 		DescribeTable("should with VM and VMI", func(expected bool, alter func(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance)) {
 			alter(vm, vmi)
 			Expect(IsBackendStorageNeeded(vm)).To(Equal(expected))
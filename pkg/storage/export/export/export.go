@@ -57,6 +57,7 @@ import (
 	instancetypeexpand "kubevirt.io/kubevirt/pkg/instancetype/expand"
 	instancetypefind "kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/storage/types"
@@ -1466,10 +1467,17 @@ func (ctrl *VMExportController) updateVMExportStatus(vmExport, vmExportCopy *exp
 		if _, err := ctrl.Client.VirtualMachineExport(vmExportCopy.Namespace).UpdateStatus(context.Background(), vmExportCopy, metav1.UpdateOptions{}); err != nil {
 			return err
 		}
+		if !isExportReady(vmExport) && isExportReady(vmExportCopy) {
+			metrics.HandleVMExportReady(vmExportCopy)
+		}
 	}
 	return nil
 }
 
+func isExportReady(vmExport *exportv1.VirtualMachineExport) bool {
+	return vmExport.Status != nil && vmExport.Status.Phase == exportv1.Ready
+}
+
 func (ctrl *VMExportController) getCertParams() (*CertParams, error) {
 	kv := ctrl.clusterConfig.GetConfigFromKubeVirtCR()
 	if kv == nil {
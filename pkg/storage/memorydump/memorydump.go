@@ -113,6 +113,35 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 	return nil
 }
 
+// RequestOnGuestPanic requests the memory dump configured by the guest panic capture
+// once the guest kernel of the running VMI panicked.
+func RequestOnGuestPanic(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
+	if vmi == nil || vmi.IsFinal() || vmi.Spec.Domain.Devices.GuestPanicCapture == nil {
+		return
+	}
+	claimName := vmi.Spec.Domain.Devices.GuestPanicCapture.MemoryDumpClaimName
+	if claimName == "" || vm.Status.MemoryDumpRequest != nil && vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpCompleted {
+		return
+	}
+	if !hasGuestPanicked(vmi) {
+		return
+	}
+	log.Log.Object(vm).Infof("Requesting memory dump to %s after guest panic", claimName)
+	vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+		ClaimName: claimName,
+		Phase:     v1.MemoryDumpAssociating,
+	}
+}
+
+func hasGuestPanicked(vmi *v1.VirtualMachineInstance) bool {
+	for _, cond := range vmi.Status.Conditions {
+		if cond.Type == v1.VirtualMachineInstanceGuestPanicked && cond.Status == k8score.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
 func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
 	if vm.Status.MemoryDumpRequest == nil {
 		return
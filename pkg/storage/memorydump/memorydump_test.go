@@ -165,6 +165,55 @@ var _ = Describe("MemoryDump", func() {
 		})
 	})
 
+	Context("RequestOnGuestPanic", func() {
+		newPanickedVMI := func() *v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMI(vmName)
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{MemoryDumpClaimName: testPVCName}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceGuestPanicked, Status: k8score.ConditionTrue},
+			}
+			return vmi
+		}
+
+		It("should request the memory dump once the guest panicked", func() {
+			vm := &v1.VirtualMachine{}
+
+			RequestOnGuestPanic(vm, newPanickedVMI())
+			Expect(vm.Status.MemoryDumpRequest).To(Equal(&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: testPVCName,
+				Phase:     v1.MemoryDumpAssociating,
+			}))
+		})
+
+		It("should not request the memory dump before the guest panicked", func() {
+			vm := &v1.VirtualMachine{}
+			vmi := newPanickedVMI()
+			vmi.Status.Conditions = nil
+
+			RequestOnGuestPanic(vm, vmi)
+			Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+		})
+
+		It("should not request the memory dump of a failed VMI", func() {
+			vm := &v1.VirtualMachine{}
+			vmi := newPanickedVMI()
+			vmi.Status.Phase = v1.Failed
+
+			RequestOnGuestPanic(vm, vmi)
+			Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+		})
+
+		It("should not interfere with a memory dump in progress", func() {
+			vm, _ := createVirtualMachineWithMemoryDump(v1.MemoryDumpInProgress)
+			vm.Status.MemoryDumpRequest.ClaimName = "other"
+
+			RequestOnGuestPanic(vm, newPanickedVMI())
+			Expect(vm.Status.MemoryDumpRequest.ClaimName).To(Equal("other"))
+			Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpInProgress))
+		})
+	})
+
 	DescribeTable("should remove memory dump volume from vmi volumes and update pvc annotation", func(phase v1.MemoryDumpPhase, expectedAnnotation string) {
 		vm, vmi := createVirtualMachineWithMemoryDump(phase)
 
@@ -48,6 +48,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	typesutil "kubevirt.io/kubevirt/pkg/storage/types"
@@ -90,6 +91,9 @@ const (
 	vmiExistsEventMessage        = "Restore target VMI still exists, please stop the restore target to proceed with restore"
 	targetNotReadyFailureMessage = "Restore target VMI must be powered off before restore operation"
 
+	targetNotReadyFailureReason      = "TargetNotReady"
+	restoreGracePeriodExceededReason = "GracePeriodExceeded"
+
 	restoreFailedEvent           = "Operation failed"
 	errorRestoreToExistingTarget = "restore source and restore target are different but restore target already exists"
 )
@@ -322,14 +326,16 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 }
 
 func (ctrl *VMRestoreController) doUpdateError(restore *snapshotv1.VirtualMachineRestore, err error) error {
-	if updateErr := ctrl.doUpdateErrorWithFailure(restore, err.Error(), false); updateErr != nil {
+	if updateErr := ctrl.doUpdateErrorWithFailure(restore, err.Error(), ""); updateErr != nil {
 		return updateErr
 	}
 
 	return err
 }
 
-func (ctrl *VMRestoreController) doUpdateErrorWithFailure(restore *snapshotv1.VirtualMachineRestore, errMsg string, fail bool) error {
+// doUpdateErrorWithFailure records the error in the status of the restore, the restore is marked as failed
+// when a failureReason is provided.
+func (ctrl *VMRestoreController) doUpdateErrorWithFailure(restore *snapshotv1.VirtualMachineRestore, errMsg, failureReason string) error {
 	updated := restore.DeepCopy()
 
 	eventReason := restoreErrorEvent
@@ -337,6 +343,7 @@ func (ctrl *VMRestoreController) doUpdateErrorWithFailure(restore *snapshotv1.Vi
 
 	updateRestoreCondition(updated, newProgressingCondition(corev1.ConditionFalse, errMsg))
 	updateRestoreCondition(updated, newReadyCondition(corev1.ConditionFalse, errMsg))
+	fail := failureReason != ""
 	if fail {
 		eventReason = restoreFailedEvent
 		eventMsg = fmt.Sprintf("VirtualMachineRestore failed %s", errMsg)
@@ -350,7 +357,15 @@ func (ctrl *VMRestoreController) doUpdateErrorWithFailure(restore *snapshotv1.Vi
 		eventMsg,
 	)
 
-	return ctrl.doUpdateStatus(restore, updated)
+	if err := ctrl.doUpdateStatus(restore, updated); err != nil {
+		return err
+	}
+
+	if fail && !vmRestoreFailed(restore) {
+		metrics.HandleVMRestoreFailed(failureReason)
+	}
+
+	return nil
 }
 
 func (ctrl *VMRestoreController) doUpdateStatus(original, updated *snapshotv1.VirtualMachineRestore) error {
@@ -358,6 +373,9 @@ func (ctrl *VMRestoreController) doUpdateStatus(original, updated *snapshotv1.Vi
 		if _, err := ctrl.Client.VirtualMachineRestore(updated.Namespace).UpdateStatus(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
 			return err
 		}
+		if !vmRestoreCompleted(original) && vmRestoreCompleted(updated) {
+			metrics.HandleVMRestoreCompleted(updated)
+		}
 	}
 
 	return nil
@@ -415,13 +433,13 @@ func (ctrl *VMRestoreController) handleVMRestoreTargetNotReady(vmRestore *snapsh
 		return ctrl.stopTarget(vmRestore, target)
 	case snapshotv1.VirtualMachineRestoreWaitGracePeriodAndFail:
 		if vmRestoreTargetReadyGracePeriodExceeded(vmRestore) {
-			return ctrl.doUpdateErrorWithFailure(vmRestore, restoreGracePeriodExceededError, true)
+			return ctrl.doUpdateErrorWithFailure(vmRestore, restoreGracePeriodExceededError, restoreGracePeriodExceededReason)
 		}
 
 		reason = waitGracePeriodMessage
 		eventMsg = vmiExistsEventMessage
 	case snapshotv1.VirtualMachineRestoreFailImmediate:
-		return ctrl.doUpdateErrorWithFailure(vmRestore, targetNotReadyFailureMessage, true)
+		return ctrl.doUpdateErrorWithFailure(vmRestore, targetNotReadyFailureMessage, targetNotReadyFailureReason)
 	default:
 		return fmt.Errorf("unknown targetReadinessPolicy: %v", targetReadinessPolicy)
 	}
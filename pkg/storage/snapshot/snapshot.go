@@ -63,7 +63,8 @@ const (
 
 	volumeSnapshotMissingEvent = "VolumeSnapshotMissing"
 
-	vmSnapshotDeadlineExceededError = "snapshot deadline exceeded"
+	vmSnapshotDeadlineExceededError  = "snapshot deadline exceeded"
+	vmSnapshotDeadlineExceededReason = "DeadlineExceeded"
 
 	snapshotRetryInterval = 5 * time.Second
 
@@ -847,12 +848,26 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		if _, err := ctrl.Client.VirtualMachineSnapshot(vmSnapshotCpy.Namespace).UpdateStatus(context.Background(), vmSnapshotCpy, metav1.UpdateOptions{}); err != nil {
 			return nil, err
 		}
+		handleVMSnapshotPhaseTransition(vmSnapshot, vmSnapshotCpy)
 		return vmSnapshotCpy, nil
 	}
 
 	return vmSnapshot, nil
 }
 
+func handleVMSnapshotPhaseTransition(oldSnapshot, newSnapshot *snapshotv1.VirtualMachineSnapshot) {
+	if oldSnapshot.Status != nil && oldSnapshot.Status.Phase == newSnapshot.Status.Phase {
+		return
+	}
+
+	switch newSnapshot.Status.Phase {
+	case snapshotv1.Succeeded:
+		metrics.HandleVMSnapshotSucceeded(newSnapshot)
+	case snapshotv1.Failed:
+		metrics.HandleVMSnapshotFailed(vmSnapshotDeadlineExceededReason)
+	}
+}
+
 // IndicationMessage returns a human-readable message for each indication
 func IndicationMessage(indication snapshotv1.Indication) string {
 	if message, ok := snapshotIndicationMessages[indication]; ok {
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cron_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/util/cron"
+)
+
+var _ = Describe("Cron", func() {
+	// Wednesday
+	from := time.Date(2026, time.January, 14, 10, 30, 15, 0, time.UTC)
+
+	DescribeTable("should compute the next time of", func(expr string, expected time.Time) {
+		schedule, err := cron.Parse(expr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(from)).To(Equal(expected))
+	},
+		Entry("every minute", "* * * * *", time.Date(2026, time.January, 14, 10, 31, 0, 0, time.UTC)),
+		Entry("a fixed time later in the day", "0 18 * * *", time.Date(2026, time.January, 14, 18, 0, 0, 0, time.UTC)),
+		Entry("a fixed time earlier in the day", "0 8 * * *", time.Date(2026, time.January, 15, 8, 0, 0, 0, time.UTC)),
+		Entry("week days", "0 8 * * mon-fri", time.Date(2026, time.January, 15, 8, 0, 0, 0, time.UTC)),
+		Entry("weekends", "0 8 * * sat,sun", time.Date(2026, time.January, 17, 8, 0, 0, 0, time.UTC)),
+		Entry("Sunday as 7", "0 8 * * 7", time.Date(2026, time.January, 18, 8, 0, 0, 0, time.UTC)),
+		Entry("steps", "*/20 * * * *", time.Date(2026, time.January, 14, 10, 40, 0, 0, time.UTC)),
+		Entry("steps from a value", "5/20 * * * *", time.Date(2026, time.January, 14, 10, 45, 0, 0, time.UTC)),
+		Entry("the next month", "0 0 1 * *", time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("the next year", "0 0 1 jan *", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("a macro", "@daily", time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC)),
+		Entry("a day of month or a day of week", "0 0 20 * fri", time.Date(2026, time.January, 16, 0, 0, 0, 0, time.UTC)),
+		Entry("a leap day", "0 0 29 feb *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("an impossible day", "0 0 30 feb *", time.Time{}),
+	)
+
+	It("should compute the next time in the location of the given time", func() {
+		loc, err := time.LoadLocation("Europe/Berlin")
+		Expect(err).ToNot(HaveOccurred())
+		schedule, err := cron.Parse("0 8 * * *")
+		Expect(err).ToNot(HaveOccurred())
+
+		next := schedule.Next(from.In(loc))
+		Expect(next).To(Equal(time.Date(2026, time.January, 15, 8, 0, 0, 0, loc)))
+		Expect(next.UTC().Hour()).To(Equal(7))
+	})
+
+	It("should skip times missing due to daylight saving", func() {
+		loc, err := time.LoadLocation("Europe/Berlin")
+		Expect(err).ToNot(HaveOccurred())
+		schedule, err := cron.Parse("30 2 * * *")
+		Expect(err).ToNot(HaveOccurred())
+
+		next := schedule.Next(time.Date(2026, time.March, 28, 12, 0, 0, 0, loc))
+		Expect(next).To(Equal(time.Date(2026, time.March, 30, 2, 30, 0, 0, loc)))
+	})
+
+	DescribeTable("should reject", func(expr string) {
+		_, err := cron.Parse(expr)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("too few fields", "* * * *"),
+		Entry("too many fields", "* * * * * *"),
+		Entry("out of range values", "60 * * * *"),
+		Entry("day of month 0", "0 0 0 * *"),
+		Entry("reversed ranges", "0 10-8 * * *"),
+		Entry("zero steps", "*/0 * * * *"),
+		Entry("unknown names", "0 0 * * someday"),
+		Entry("empty values", "0, * * * *"),
+	)
+})
@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventfilter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/clock"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// maxTrackedEvents bounds the deduplication cache. Expired entries are
+// pruned once it is exceeded.
+const maxTrackedEvents = 4096
+
+type ConfigGetter func() *v1.EventConfiguration
+
+type eventKey struct {
+	uid       types.UID
+	eventtype string
+	reason    string
+	message   string
+}
+
+// Filter decides which events are emitted according to the EventConfiguration
+// returned by getConfig. The configuration is read on every event, so changes
+// to the KubeVirt CR apply without a restart. All recorders wrapped by the same
+// Filter share its rate limiter and deduplication cache.
+type Filter struct {
+	getConfig ConfigGetter
+	clock     clock.Clock
+
+	lock          sync.Mutex
+	limiter       flowcontrol.RateLimiter
+	limiterConfig v1.TokenBucketRateLimiter
+	lastEmitted   map[eventKey]time.Time
+}
+
+func NewFilter(getConfig ConfigGetter) *Filter {
+	return NewFilterWithClock(getConfig, clock.RealClock{})
+}
+
+func NewFilterWithClock(getConfig ConfigGetter, clock clock.Clock) *Filter {
+	return &Filter{
+		getConfig:   getConfig,
+		clock:       clock,
+		lastEmitted: map[eventKey]time.Time{},
+	}
+}
+
+// Wrap returns an EventRecorder which only passes the events accepted by the
+// filter to recorder.
+func (f *Filter) Wrap(recorder record.EventRecorder) record.EventRecorder {
+	return &filteringRecorder{recorder: recorder, filter: f}
+}
+
+type filteringRecorder struct {
+	recorder record.EventRecorder
+	filter   *Filter
+}
+
+func (r *filteringRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.filter.shouldEmit(object, eventtype, reason, message) {
+		r.recorder.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *filteringRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.filter.shouldEmit(object, eventtype, reason, message) {
+		r.recorder.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *filteringRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.filter.shouldEmit(object, eventtype, reason, message) {
+		r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+func (f *Filter) shouldEmit(object runtime.Object, eventtype, reason, message string) bool {
+	config := f.getConfig()
+	if config == nil {
+		return true
+	}
+
+	for _, suppressed := range config.SuppressedReasons {
+		if reason == suppressed {
+			return false
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if config.DeduplicationWindow != nil && config.DeduplicationWindow.Duration > 0 {
+		if accessor, err := meta.Accessor(object); err == nil && accessor.GetUID() != "" {
+			key := eventKey{uid: accessor.GetUID(), eventtype: eventtype, reason: reason, message: message}
+			now := f.clock.Now()
+			if last, exists := f.lastEmitted[key]; exists && now.Sub(last) < config.DeduplicationWindow.Duration {
+				return false
+			}
+			if !f.tryAccept(config.RateLimiter) {
+				return false
+			}
+			f.lastEmitted[key] = now
+			f.pruneExpired(now, config.DeduplicationWindow.Duration)
+			return true
+		}
+	}
+
+	return f.tryAccept(config.RateLimiter)
+}
+
+func (f *Filter) tryAccept(limiterConfig *v1.TokenBucketRateLimiter) bool {
+	if limiterConfig == nil || limiterConfig.QPS <= 0 || limiterConfig.Burst <= 0 {
+		f.limiter = nil
+		return true
+	}
+
+	if f.limiter == nil || f.limiterConfig != *limiterConfig {
+		log.Log.V(2).Infof("setting event rate limiter to %v QPS and %v Burst", limiterConfig.QPS, limiterConfig.Burst)
+		f.limiter = flowcontrol.NewTokenBucketRateLimiterWithClock(limiterConfig.QPS, limiterConfig.Burst, f.clock)
+		f.limiterConfig = *limiterConfig
+	}
+	return f.limiter.TryAccept()
+}
+
+func (f *Filter) pruneExpired(now time.Time, window time.Duration) {
+	if len(f.lastEmitted) <= maxTrackedEvents {
+		return
+	}
+	for key, last := range f.lastEmitted {
+		if now.Sub(last) >= window {
+			delete(f.lastEmitted, key)
+		}
+	}
+}
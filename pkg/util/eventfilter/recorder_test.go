@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventfilter_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/eventfilter"
+)
+
+var _ = Describe("Event filtering recorder", func() {
+	var (
+		fakeRecorder *record.FakeRecorder
+		fakeClock    *clocktesting.FakeClock
+		config       *v1.EventConfiguration
+		recorder     record.EventRecorder
+		vmi          *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		fakeRecorder = record.NewFakeRecorder(100)
+		fakeClock = clocktesting.NewFakeClock(time.Now())
+		config = nil
+		recorder = eventfilter.NewFilterWithClock(func() *v1.EventConfiguration { return config }, fakeClock).Wrap(fakeRecorder)
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default", UID: "1234"}}
+	})
+
+	It("should pass all events through when no configuration is set", func() {
+		for i := 0; i < 3; i++ {
+			recorder.Eventf(vmi, k8sv1.EventTypeWarning, "FailedScheduling", "0/%d nodes are available", 3)
+		}
+		Expect(fakeRecorder.Events).To(HaveLen(3))
+	})
+
+	It("should drop events with a suppressed reason", func() {
+		config = &v1.EventConfiguration{SuppressedReasons: []string{"FailedScheduling"}}
+
+		recorder.Event(vmi, k8sv1.EventTypeWarning, "FailedScheduling", "0/3 nodes are available")
+		recorder.Event(vmi, k8sv1.EventTypeNormal, "Started", "VirtualMachineInstance started")
+
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+		Expect(<-fakeRecorder.Events).To(ContainSubstring("Started"))
+	})
+
+	It("should drop duplicate events within the deduplication window", func() {
+		config = &v1.EventConfiguration{DeduplicationWindow: &metav1.Duration{Duration: time.Minute}}
+
+		recorder.Event(vmi, k8sv1.EventTypeWarning, "SyncFailed", "failed")
+		recorder.Event(vmi, k8sv1.EventTypeWarning, "SyncFailed", "failed")
+		By("emitting a different message for the same reason")
+		recorder.Event(vmi, k8sv1.EventTypeWarning, "SyncFailed", "failed differently")
+		By("emitting the same event for another object")
+		otherVMI := vmi.DeepCopy()
+		otherVMI.UID = "5678"
+		recorder.Event(otherVMI, k8sv1.EventTypeWarning, "SyncFailed", "failed")
+		Expect(fakeRecorder.Events).To(HaveLen(3))
+
+		fakeClock.Step(time.Minute)
+		recorder.Event(vmi, k8sv1.EventTypeWarning, "SyncFailed", "failed")
+		Expect(fakeRecorder.Events).To(HaveLen(4))
+	})
+
+	It("should rate limit events", func() {
+		config = &v1.EventConfiguration{RateLimiter: &v1.TokenBucketRateLimiter{QPS: 1, Burst: 2}}
+
+		for i := 0; i < 5; i++ {
+			recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Created", "event %d", i)
+		}
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+
+		fakeClock.Step(time.Second)
+		recorder.Event(vmi, k8sv1.EventTypeNormal, "Created", "event")
+		Expect(fakeRecorder.Events).To(HaveLen(3))
+	})
+
+	It("should share the rate limit between wrapped recorders", func() {
+		config = &v1.EventConfiguration{RateLimiter: &v1.TokenBucketRateLimiter{QPS: 1, Burst: 1}}
+		filter := eventfilter.NewFilterWithClock(func() *v1.EventConfiguration { return config }, fakeClock)
+
+		filter.Wrap(fakeRecorder).Event(vmi, k8sv1.EventTypeNormal, "Created", "event")
+		filter.Wrap(fakeRecorder).Event(vmi, k8sv1.EventTypeNormal, "Created", "event")
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+	})
+
+	It("should pick up configuration changes", func() {
+		config = &v1.EventConfiguration{SuppressedReasons: []string{"Created"}}
+		recorder.Event(vmi, k8sv1.EventTypeNormal, "Created", "event")
+		Expect(fakeRecorder.Events).To(BeEmpty())
+
+		config = nil
+		recorder.Event(vmi, k8sv1.EventTypeNormal, "Created", "event")
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+	})
+})
@@ -2,7 +2,9 @@ package migrations
 
 import (
 	"fmt"
+	"time"
 
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	v1 "kubevirt.io/api/core/v1"
@@ -130,12 +132,52 @@ func VMIMigratableOnEviction(clusterConfig *virtconfig.ClusterConfig, vmi *v1.Vi
 	switch *strategy {
 	case v1.EvictionStrategyLiveMigrate:
 		return true
-	case v1.EvictionStrategyLiveMigrateIfPossible:
+	case v1.EvictionStrategyLiveMigrateIfPossible, v1.EvictionStrategyLiveMigrateOrShutdown:
 		return vmi.IsMigratable()
 	}
 	return false
 }
 
+// EvictionMigrationTimeout returns how long a VMI with the LiveMigrateOrShutdown eviction strategy is
+// tried to be migrated on eviction, before it is shut down
+func EvictionMigrationTimeout(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) time.Duration {
+	if vmi.Spec.EvictionMigrationTimeoutSeconds != nil {
+		return time.Duration(*vmi.Spec.EvictionMigrationTimeoutSeconds) * time.Second
+	}
+	return time.Duration(*clusterConfig.GetMigrationConfiguration().EvictionMigrationTimeout) * time.Second
+}
+
+// EvictionShutdownFallbackIn returns the time left until a VMI with the LiveMigrateOrShutdown eviction
+// strategy, which is marked for eviction, is shut down instead of being migrated. The time counts from
+// the EvictionRequested condition of the VMI. It returns false for all other VMIs.
+func EvictionShutdownFallbackIn(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance, now time.Time) (time.Duration, bool) {
+	strategy := VMIEvictionStrategy(clusterConfig, vmi)
+	if strategy == nil || *strategy != v1.EvictionStrategyLiveMigrateOrShutdown || !vmi.IsMarkedForEviction() {
+		return 0, false
+	}
+	timeout := EvictionMigrationTimeout(clusterConfig, vmi)
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceEvictionRequested)
+	if condition == nil || condition.Status != k8sv1.ConditionTrue {
+		// The eviction was just requested
+		return timeout, true
+	}
+	return condition.LastTransitionTime.Add(timeout).Sub(now), true
+}
+
+// ShutdownOnEvictionByPriority returns true when a VMI with the given priority falls below the
+// configured eviction shutdown priority threshold. A VMI without priority is treated as priority 0.
+func ShutdownOnEvictionByPriority(clusterConfig *virtconfig.ClusterConfig, priority *int32) bool {
+	threshold := clusterConfig.GetMigrationConfiguration().EvictionShutdownPriorityThreshold
+	if threshold == nil {
+		return false
+	}
+	vmiPriority := int32(0)
+	if priority != nil {
+		vmiPriority = *priority
+	}
+	return vmiPriority < *threshold
+}
+
 func ActiveMigrationExistsForVMI(migrationIndexer cache.Indexer, vmi *v1.VirtualMachineInstance) (bool, error) {
 	objs, err := migrationIndexer.ByIndex(controller.ByVMINameIndex, fmt.Sprintf("%s/%s", vmi.Namespace, vmi.Name))
 	if err != nil {
@@ -0,0 +1,129 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package tracecontext
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// TracesEndpointEnv is the OTLP/HTTP endpoint the spans are sent to
+	TracesEndpointEnv = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	// EndpointEnv is the base URL of an OTLP/HTTP endpoint, the spans are sent to its /v1/traces path
+	EndpointEnv = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+	tracesPath = "/v1/traces"
+
+	instrumentationScope = "kubevirt.io/kubevirt"
+	shutdownTimeout      = 10 * time.Second
+)
+
+var tracerProvider atomic.Pointer[sdktrace.TracerProvider]
+
+// InitExporter exports the spans of the component to the OpenTelemetry collector configured
+// by the OTEL_EXPORTER_OTLP_TRACES_ENDPOINT or OTEL_EXPORTER_OTLP_ENDPOINT environment variables.
+// Spans are not recorded if none of them is set. The other OTEL_EXPORTER_OTLP_* variables, e.g.
+// the headers or the certificate of the collector, are honoured as well.
+func InitExporter(serviceName string) {
+	endpoint := TracesEndpoint()
+	if endpoint == "" {
+		return
+	}
+	exporter, err := otlptracehttp.New(context.Background())
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to create the span exporter for %s", endpoint)
+		return
+	}
+	tracerProvider.Store(newTracerProvider(serviceName, sdktrace.WithBatcher(exporter)))
+	log.Log.Infof("Exporting spans to %s", endpoint)
+}
+
+func newTracerProvider(serviceName string, processor sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	// the service name is the one of the component, whatever OTEL_SERVICE_NAME is set to
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		res = resource.NewSchemaless(attribute.String("service.name", serviceName))
+	}
+	return sdktrace.NewTracerProvider(processor, sdktrace.WithResource(res))
+}
+
+// Shutdown exports the spans which are still queued and stops the export. Short lived processes,
+// e.g. virtctl, call it before they exit.
+func Shutdown() {
+	provider := tracerProvider.Swap(nil)
+	if provider == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := provider.Shutdown(ctx); err != nil {
+		log.Log.Reason(err).Warning("Failed to export the remaining spans")
+	}
+}
+
+// Enabled reports whether the spans of the component are exported
+func Enabled() bool {
+	return tracerProvider.Load() != nil
+}
+
+// TracesEndpoint returns the OTLP/HTTP endpoint configured by the environment, if any
+func TracesEndpoint() string {
+	if endpoint := os.Getenv(TracesEndpointEnv); endpoint != "" {
+		return endpoint
+	}
+	if endpoint := os.Getenv(EndpointEnv); endpoint != "" {
+		return strings.TrimSuffix(endpoint, "/") + tracesPath
+	}
+	return ""
+}
+
+// WrapTransport sends the trace context of the span started in the context of a request,
+// e.g. by StartRootSpan, along with the request in the traceparent header.
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &traceContextRoundTripper{next: rt}
+}
+
+type traceContextRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (t *traceContextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !trace.SpanContextFromContext(req.Context()).IsValid() {
+		return t.next.RoundTrip(req)
+	}
+	// a round tripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	propagation.TraceContext{}.Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package tracecontext
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var _ = Describe("Span exporter", func() {
+	const (
+		traceParent  = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
+
+	It("should pass on the trace context when no exporter is configured", func() {
+		span := StartSpan(traceParent, "CreateVMI")
+		Expect(span.TraceParent()).To(Equal(traceParent))
+		span.End(nil)
+	})
+
+	It("should not start a span without a valid trace context", func() {
+		span := StartSpan("invalid", "CreateVMI")
+		Expect(span).To(BeNil())
+		Expect(span.TraceParent()).To(BeEmpty())
+		span.SetAttribute("vmi", "testvmi")
+		span.End(nil)
+	})
+
+	It("should not start a root span when no exporter is configured", func() {
+		ctx, span := StartRootSpan(context.Background(), "virtctl start")
+		Expect(span).To(BeNil())
+		Expect(ctx).To(Equal(context.Background()))
+	})
+
+	Context("with an exporter", func() {
+		var exporter *tracetest.InMemoryExporter
+
+		BeforeEach(func() {
+			exporter = tracetest.NewInMemoryExporter()
+			tracerProvider.Store(newTracerProvider("virt-controller", sdktrace.WithSyncer(exporter)))
+			DeferCleanup(Shutdown)
+		})
+
+		It("should export the spans of sampled traces", func() {
+			span := StartSpan(traceParent, "CreateVMI")
+			span.SetAttribute("vmi", "default/testvmi")
+			child := span.TraceParent()
+			Expect(TraceID(child)).To(Equal(traceID))
+			Expect(child).ToNot(Equal(traceParent))
+			span.End(errors.New("admission denied"))
+
+			spans := exporter.GetSpans()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name).To(Equal("CreateVMI"))
+			Expect(spans[0].SpanContext.TraceID().String()).To(Equal(traceID))
+			Expect(spans[0].Parent.SpanID().String()).To(Equal(parentSpanID))
+			Expect(spans[0].Parent.IsRemote()).To(BeTrue())
+			Expect(child).To(Equal("00-" + traceID + "-" + spans[0].SpanContext.SpanID().String() + "-01"))
+			Expect(spans[0].Attributes).To(ConsistOf(attribute.String("vmi", "default/testvmi")))
+			Expect(spans[0].Status).To(Equal(sdktrace.Status{Code: codes.Error, Description: "admission denied"}))
+			Expect(spans[0].Resource.Attributes()).To(ContainElement(attribute.String("service.name", "virt-controller")))
+		})
+
+		It("should not record the spans of traces which are not sampled", func() {
+			const notSampled = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"
+			span := StartSpan(notSampled, "CreateVMI")
+			Expect(span.TraceParent()).To(Equal(notSampled))
+			span.End(nil)
+			Expect(exporter.GetSpans()).To(BeEmpty())
+		})
+
+		It("should pass on the trace context of a root span along with the requests", func() {
+			received := make(chan string, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received <- r.Header.Get(TraceParentHeader)
+			}))
+			DeferCleanup(server.Close)
+			client := &http.Client{Transport: WrapTransport(http.DefaultTransport)}
+
+			ctx, span := StartRootSpan(context.Background(), "virtctl start")
+			Expect(span).ToNot(BeNil())
+			request, err := http.NewRequestWithContext(ctx, http.MethodPut, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := client.Do(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(request.Header).ToNot(HaveKey(http.CanonicalHeaderKey(TraceParentHeader)))
+			Expect(received).To(Receive(Equal(span.TraceParent())))
+			span.End(nil)
+
+			spans := exporter.GetSpans()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name).To(Equal("virtctl start"))
+			Expect(spans[0].Parent.IsValid()).To(BeFalse())
+			Expect(spans[0].Status.Code).To(Equal(codes.Ok))
+		})
+
+		It("should not add a trace context to requests without a span", func() {
+			received := make(chan http.Header, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received <- r.Header
+			}))
+			DeferCleanup(server.Close)
+			client := &http.Client{Transport: WrapTransport(http.DefaultTransport)}
+
+			resp, err := client.Get(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(received).To(Receive(Not(HaveKey(http.CanonicalHeaderKey(TraceParentHeader)))))
+		})
+	})
+})
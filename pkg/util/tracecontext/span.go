@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package tracecontext
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span is an operation of a trace, e.g. the creation of a VMI for a start request.
+// A nil Span is valid and records nothing.
+type Span struct {
+	span   trace.Span
+	parent string
+}
+
+// StartSpan starts a span as child of traceParent. It returns nil if traceParent is not valid.
+// The span is only recorded when an exporter is configured and traceParent is sampled.
+func StartSpan(traceParent, name string) *Span {
+	if !IsValid(traceParent) {
+		return nil
+	}
+	span := &Span{parent: traceParent}
+	if provider := tracerProvider.Load(); provider != nil {
+		ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{TraceParentHeader: traceParent})
+		_, span.span = provider.Tracer(instrumentationScope).Start(ctx, name)
+	}
+	return span
+}
+
+// StartRootSpan starts a new trace, e.g. for a virtctl command. The requests sent with the
+// returned context through WrapTransport carry the trace context of the span.
+// It returns a nil span if no exporter is configured.
+func StartRootSpan(ctx context.Context, name string) (context.Context, *Span) {
+	provider := tracerProvider.Load()
+	if provider == nil {
+		return ctx, nil
+	}
+	ctx, span := provider.Tracer(instrumentationScope).Start(ctx, name, trace.WithNewRoot())
+	return ctx, &Span{span: span}
+}
+
+// SetAttribute adds an attribute, e.g. the name of the VMI, to the span
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil || s.span == nil {
+		return
+	}
+	s.span.SetAttributes(attribute.String(key, value))
+}
+
+// TraceParent returns the trace context to pass on to the operations started by the span.
+// A span which is not recorded passes on the trace context it was started with, so that
+// the trace does not refer to spans which were never exported.
+func (s *Span) TraceParent() string {
+	if s == nil {
+		return ""
+	}
+	if s.span == nil || !s.span.IsRecording() {
+		return s.parent
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(trace.ContextWithSpan(context.Background(), s.span), carrier)
+	return carrier.Get(TraceParentHeader)
+}
+
+// End ends the span and queues it for export. The span failed if err is not nil.
+func (s *Span) End(err error) {
+	if s == nil || s.span == nil {
+		return
+	}
+	if err != nil {
+		s.span.SetStatus(codes.Error, err.Error())
+	} else {
+		s.span.SetStatus(codes.Ok, "")
+	}
+	s.span.End()
+}
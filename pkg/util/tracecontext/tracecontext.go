@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package tracecontext propagates W3C trace context (https://www.w3.org/TR/trace-context/)
+// between the KubeVirt components. The trace context of a start, stop or migrate
+// request is carried on the VMI and migration objects, so that the logs every
+// component writes for the same operation can be correlated by trace ID.
+package tracecontext
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const TraceParentHeader = "traceparent"
+
+var traceParentRegex = regexp.MustCompile(`^([0-9a-f]{2})-([0-9a-f]{32})-([0-9a-f]{16})-([0-9a-f]{2})$`)
+
+const (
+	invalidVersion = "ff"
+	invalidTraceID = "00000000000000000000000000000000"
+	invalidSpanID  = "0000000000000000"
+)
+
+// IsValid reports whether traceParent is a well-formed W3C traceparent value
+func IsValid(traceParent string) bool {
+	matches := traceParentRegex.FindStringSubmatch(traceParent)
+	if matches == nil {
+		return false
+	}
+	return matches[1] != invalidVersion && matches[2] != invalidTraceID && matches[3] != invalidSpanID
+}
+
+// TraceID returns the trace ID of traceParent, or an empty string if it is not valid
+func TraceID(traceParent string) string {
+	if !IsValid(traceParent) {
+		return ""
+	}
+	return strings.Split(traceParent, "-")[1]
+}
+
+// FromHeader returns the trace context a client sent along with its request
+func FromHeader(header http.Header) string {
+	traceParent := strings.TrimSpace(header.Get(TraceParentHeader))
+	if !IsValid(traceParent) {
+		return ""
+	}
+	return traceParent
+}
+
+// FromObject returns the trace context carried by the object
+func FromObject(obj metav1.Object) string {
+	traceParent := obj.GetAnnotations()[v1.TraceParentAnnotation]
+	if !IsValid(traceParent) {
+		return ""
+	}
+	return traceParent
+}
+
+// SetOnObject annotates the object with the trace context, if it is valid
+func SetOnObject(obj metav1.Object, traceParent string) {
+	if !IsValid(traceParent) {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1.TraceParentAnnotation] = traceParent
+	obj.SetAnnotations(annotations)
+}
+
+// Log returns a logger for the object which includes the trace ID of its trace context
+func Log(obj log.LoggableObject) *log.FilteredLogger {
+	return WithTraceParent(log.Log.Object(obj), FromObject(obj.GetObjectMeta()))
+}
+
+// WithTraceParent adds the trace ID of traceParent to the logger, if it is valid
+func WithTraceParent(logger *log.FilteredLogger, traceParent string) *log.FilteredLogger {
+	if traceID := TraceID(traceParent); traceID != "" {
+		return logger.With("traceID", traceID)
+	}
+	return logger
+}
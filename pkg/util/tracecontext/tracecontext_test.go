@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package tracecontext_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
+)
+
+const validTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+var _ = Describe("Trace context", func() {
+	DescribeTable("should validate traceparent", func(traceParent string, expected bool) {
+		Expect(tracecontext.IsValid(traceParent)).To(Equal(expected))
+	},
+		Entry("valid", validTraceParent, true),
+		Entry("empty", "", false),
+		Entry("uppercase", "00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01", false),
+		Entry("invalid version", "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", false),
+		Entry("zero trace ID", "00-00000000000000000000000000000000-00f067aa0ba902b7-01", false),
+		Entry("zero span ID", "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", false),
+		Entry("truncated", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7", false),
+	)
+
+	It("should return the trace ID", func() {
+		Expect(tracecontext.TraceID(validTraceParent)).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(tracecontext.TraceID("invalid")).To(BeEmpty())
+	})
+
+	It("should read the trace context from the request header", func() {
+		header := http.Header{}
+		Expect(tracecontext.FromHeader(header)).To(BeEmpty())
+		header.Set(tracecontext.TraceParentHeader, validTraceParent)
+		Expect(tracecontext.FromHeader(header)).To(Equal(validTraceParent))
+		header.Set(tracecontext.TraceParentHeader, "invalid")
+		Expect(tracecontext.FromHeader(header)).To(BeEmpty())
+	})
+
+	It("should carry the trace context on objects", func() {
+		vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}
+		Expect(tracecontext.FromObject(vmi)).To(BeEmpty())
+
+		tracecontext.SetOnObject(vmi, "invalid")
+		Expect(vmi.Annotations).To(BeEmpty())
+
+		tracecontext.SetOnObject(vmi, validTraceParent)
+		Expect(vmi.Annotations).To(HaveKeyWithValue(v1.TraceParentAnnotation, validTraceParent))
+		Expect(tracecontext.FromObject(vmi)).To(Equal(validTraceParent))
+	})
+})
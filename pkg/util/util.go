@@ -23,6 +23,7 @@ const (
 	VirtShareDir                              = "/var/run/kubevirt"
 	VirtImageVolumeDir                        = "/var/run/kubevirt-image-volume"
 	VirtKernelBootVolumeDir                   = "/var/run/kubevirt-kernel-boot"
+	VirtEFIFirmwareDir                        = "/var/run/kubevirt-efi-firmware"
 	VirtPrivateDir                            = "/var/run/kubevirt-private"
 	KubeletRoot                               = "/var/lib/kubelet"
 	KubeletPodsDir                            = KubeletRoot + "/pods"
@@ -39,8 +40,17 @@ const (
 	ENV_VAR_LIBVIRT_DEBUG_LOGS          = "LIBVIRT_DEBUG_LOGS"
 	ENV_VAR_VIRTIOFSD_DEBUG_LOGS        = "VIRTIOFSD_DEBUG_LOGS"
 	ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY = "VIRT_LAUNCHER_LOG_VERBOSITY"
+	ENV_VAR_SERIAL_LOG_MAX_SIZE         = "SERIAL_LOG_MAX_SIZE"
+	ENV_VAR_SERIAL_LOG_MAX_BACKUPS      = "SERIAL_LOG_MAX_BACKUPS"
+
+	virtHandlerLeasePrefix = "virt-handler-heartbeat-"
 )
 
+// VirtHandlerLeaseName returns the name of the Lease virt-handler renews to signal it is alive on a node
+func VirtHandlerLeaseName(nodeName string) string {
+	return virtHandlerLeasePrefix + nodeName
+}
+
 // Check if a VMI spec requests GPU
 func IsGPUVMI(vmi *v1.VirtualMachineInstance) bool {
 	if vmi.Spec.Domain.Devices.GPUs != nil && len(vmi.Spec.Domain.Devices.GPUs) != 0 {
@@ -229,6 +239,15 @@ func PathForSwtpmLocalca(vmi *v1.VirtualMachineInstance) string {
 	return localCaPath
 }
 
+func PathForSavedState(vmi *v1.VirtualMachineInstance) string {
+	savedStatePath := "/var/lib/libvirt/qemu/saved-state"
+	if vmitrait.IsNonRoot(vmi) {
+		savedStatePath = filepath.Join(VirtPrivateDir, "libvirt", "qemu", "saved-state")
+	}
+
+	return savedStatePath
+}
+
 func PathForNVram(vmi *v1.VirtualMachineInstance) string {
 	nvramPath := "/var/lib/libvirt/qemu/nvram"
 	if vmitrait.IsNonRoot(vmi) {
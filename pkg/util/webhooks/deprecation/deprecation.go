@@ -0,0 +1,69 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package deprecation keeps track of the deprecated API fields in a single
+// place, so that admitters can consistently warn about them and tell users
+// where to migrate to and when the field is going away.
+package deprecation
+
+import (
+	"fmt"
+	"strings"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Field describes a deprecated field of an object of type T.
+type Field[T any] struct {
+	// Name is the JSON name of the deprecated field.
+	Name string
+	// Replacement is the dot separated path of the field superseding the
+	// deprecated one, relative to the parent of the deprecated field.
+	// Empty if the field is going away without a replacement.
+	Replacement string
+	// RemovalRelease is the release in which the field is going to be removed.
+	RemovalRelease string
+	// SetIn returns the paths of the parents, below path, in which the
+	// deprecated field is set.
+	SetIn func(path *k8sfield.Path, obj T) []*k8sfield.Path
+}
+
+// Warning returns the warning about the deprecated field being set below parent.
+func (f Field[T]) Warning(parent *k8sfield.Path) string {
+	warning := fmt.Sprintf("%s is deprecated", parent.Child(f.Name).String())
+	if f.RemovalRelease != "" {
+		warning += fmt.Sprintf(" and will be removed in %s", f.RemovalRelease)
+	}
+	if f.Replacement != "" {
+		elements := strings.Split(f.Replacement, ".")
+		warning += fmt.Sprintf(", please use %s instead", parent.Child(elements[0], elements[1:]...).String())
+	}
+	return warning + "."
+}
+
+// Warnings returns a warning for every deprecated field in fields set in obj.
+func Warnings[T any](fields []Field[T], path *k8sfield.Path, obj T) []string {
+	var warnings []string
+	for _, f := range fields {
+		for _, parent := range f.SetIn(path, obj) {
+			warnings = append(warnings, f.Warning(parent))
+		}
+	}
+	return warnings
+}
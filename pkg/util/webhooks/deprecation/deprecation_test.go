@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package deprecation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/webhooks/deprecation"
+)
+
+var _ = Describe("Deprecation", func() {
+	Context("Field warning", func() {
+		parent := k8sfield.NewPath("spec", "config")
+
+		DescribeTable("should describe the migration", func(f deprecation.Field[any], expected string) {
+			Expect(f.Warning(parent)).To(Equal(expected))
+		},
+			Entry("with replacement and removal release",
+				deprecation.Field[any]{Name: "old", Replacement: "new.value", RemovalRelease: "v2"},
+				"spec.config.old is deprecated and will be removed in v2, please use spec.config.new.value instead.",
+			),
+			Entry("without replacement",
+				deprecation.Field[any]{Name: "old", RemovalRelease: "v2"},
+				"spec.config.old is deprecated and will be removed in v2.",
+			),
+			Entry("without removal release",
+				deprecation.Field[any]{Name: "old", Replacement: "new"},
+				"spec.config.old is deprecated, please use spec.config.new instead.",
+			),
+		)
+	})
+
+	Context("VirtualMachine spec", func() {
+		It("should warn about spec.running", func() {
+			spec := &v1.VirtualMachineSpec{Running: pointer.P(true)}
+			Expect(deprecation.Warnings(deprecation.VirtualMachineSpecFields, k8sfield.NewPath("spec"), spec)).To(ConsistOf(
+				"spec.running is deprecated and will be removed in kubevirt.io/v2, please use spec.runStrategy instead.",
+			))
+		})
+
+		It("should not warn when no deprecated field is set", func() {
+			spec := &v1.VirtualMachineSpec{RunStrategy: pointer.P(v1.RunStrategyAlways)}
+			Expect(deprecation.Warnings(deprecation.VirtualMachineSpecFields, k8sfield.NewPath("spec"), spec)).To(BeEmpty())
+		})
+	})
+
+	Context("KubeVirt spec", func() {
+		warnings := func(spec *v1.KubeVirtSpec) []string {
+			return deprecation.Warnings(deprecation.KubeVirtSpecFields, k8sfield.NewPath("spec"), spec)
+		}
+
+		It("should not warn about an empty spec", func() {
+			Expect(warnings(&v1.KubeVirtSpec{})).To(BeEmpty())
+		})
+
+		DescribeTable("should warn about", func(spec *v1.KubeVirtSpec, expected ...string) {
+			Expect(warnings(spec)).To(ConsistOf(expected))
+		},
+			Entry("the architecture specific configuration knobs",
+				&v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+					EmulatedMachines: []string{"q35*"},
+					MachineType:      "q35",
+					OVMFPath:         "/usr/share/OVMF",
+				}},
+				"spec.configuration.emulatedMachines is deprecated and will be removed in kubevirt.io/v2, please use spec.configuration.architectureConfiguration instead.",
+				"spec.configuration.machineType is deprecated and will be removed in kubevirt.io/v2, please use spec.configuration.architectureConfiguration instead.",
+				"spec.configuration.ovmfPath is deprecated and will be removed in kubevirt.io/v2, please use spec.configuration.architectureConfiguration instead.",
+			),
+			Entry("minCPUModel",
+				&v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{DeprecatedMinCPUModel: "Penryn"}},
+				"spec.configuration.minCPUModel is deprecated and will be removed in kubevirt.io/v2.",
+			),
+			Entry("the ppc64le architecture",
+				&v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+					ArchitectureConfiguration: &v1.ArchConfiguration{Ppc64le: &v1.ArchSpecificConfiguration{}},
+				}},
+				"spec.configuration.architectureConfiguration.ppc64le is deprecated and will be removed in kubevirt.io/v2.",
+			),
+			Entry("every mediatedDevicesTypes",
+				&v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+					MediatedDevicesConfiguration: &v1.MediatedDevicesConfiguration{
+						MediatedDevicesTypes: []string{"nvidia-222"},
+						NodeMediatedDeviceTypes: []v1.NodeMediatedDeviceTypesConfig{
+							{MediatedDeviceTypes: []string{"nvidia-223"}},
+							{MediatedDevicesTypes: []string{"nvidia-224"}},
+						},
+					},
+				}},
+				"spec.configuration.mediatedDevicesConfiguration.mediatedDevicesTypes is deprecated and will be removed in kubevirt.io/v2, please use spec.configuration.mediatedDevicesConfiguration.mediatedDeviceTypes instead.",
+				"spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[1].mediatedDevicesTypes is deprecated and will be removed in kubevirt.io/v2, please use spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[1].mediatedDeviceTypes instead.",
+			),
+			Entry("the self signed rotation intervals",
+				&v1.KubeVirtSpec{CertificateRotationStrategy: v1.KubeVirtCertificateRotateStrategy{
+					SelfSigned: &v1.KubeVirtSelfSignConfiguration{
+						CARotateInterval:   &metav1.Duration{},
+						CertRotateInterval: &metav1.Duration{},
+						CAOverlapInterval:  &metav1.Duration{},
+					},
+				}},
+				"spec.certificateRotateStrategy.selfSigned.caRotateInterval is deprecated and will be removed in kubevirt.io/v2, please use spec.certificateRotateStrategy.selfSigned.ca.duration instead.",
+				"spec.certificateRotateStrategy.selfSigned.certRotateInterval is deprecated and will be removed in kubevirt.io/v2, please use spec.certificateRotateStrategy.selfSigned.server.duration instead.",
+				"spec.certificateRotateStrategy.selfSigned.caOverlapInterval is deprecated and will be removed in kubevirt.io/v2, please use spec.certificateRotateStrategy.selfSigned.ca.renewBefore instead.",
+			),
+		)
+	})
+})
@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package deprecation
+
+import (
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// nextAPIVersion is the API version in which the deprecated kubevirt.io/v1 fields are dropped.
+const nextAPIVersion = "kubevirt.io/v2"
+
+// VirtualMachineSpecFields are the deprecated fields of the VirtualMachine spec.
+var VirtualMachineSpecFields = []Field[*v1.VirtualMachineSpec]{
+	{
+		Name:           "running",
+		Replacement:    "runStrategy",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.VirtualMachineSpec) []*k8sfield.Path {
+			return setIf(path, spec.Running != nil)
+		},
+	},
+}
+
+// KubeVirtSpecFields are the deprecated fields of the KubeVirt spec.
+var KubeVirtSpecFields = []Field[*v1.KubeVirtSpec]{
+	{
+		Name:           "emulatedMachines",
+		Replacement:    "architectureConfiguration",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			return setIf(path.Child("configuration"), len(spec.Configuration.EmulatedMachines) > 0)
+		},
+	},
+	{
+		Name:           "machineType",
+		Replacement:    "architectureConfiguration",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			return setIf(path.Child("configuration"), spec.Configuration.MachineType != "")
+		},
+	},
+	{
+		Name:           "ovmfPath",
+		Replacement:    "architectureConfiguration",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			return setIf(path.Child("configuration"), spec.Configuration.OVMFPath != "")
+		},
+	},
+	{
+		Name:           "minCPUModel",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			return setIf(path.Child("configuration"), spec.Configuration.DeprecatedMinCPUModel != "")
+		},
+	},
+	{
+		Name:           "ppc64le",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			archConfig := spec.Configuration.ArchitectureConfiguration
+			return setIf(path.Child("configuration", "architectureConfiguration"), archConfig != nil && archConfig.Ppc64le != nil)
+		},
+	},
+	{
+		Name:           "mediatedDevicesTypes",
+		Replacement:    "mediatedDeviceTypes",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			mdev := spec.Configuration.MediatedDevicesConfiguration
+			if mdev == nil {
+				return nil
+			}
+			path = path.Child("configuration", "mediatedDevicesConfiguration")
+			paths := setIf(path, mdev.MediatedDevicesTypes != nil)
+			for i, mdevType := range mdev.NodeMediatedDeviceTypes {
+				paths = append(paths, setIf(path.Child("nodeMediatedDeviceTypes").Index(i), mdevType.MediatedDevicesTypes != nil)...)
+			}
+			return paths
+		},
+	},
+	{
+		Name:           "caRotateInterval",
+		Replacement:    "ca.duration",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			selfSigned := spec.CertificateRotationStrategy.SelfSigned
+			return setIf(path.Child("certificateRotateStrategy", "selfSigned"), selfSigned != nil && selfSigned.CARotateInterval != nil)
+		},
+	},
+	{
+		Name:           "certRotateInterval",
+		Replacement:    "server.duration",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			selfSigned := spec.CertificateRotationStrategy.SelfSigned
+			return setIf(path.Child("certificateRotateStrategy", "selfSigned"), selfSigned != nil && selfSigned.CertRotateInterval != nil)
+		},
+	},
+	{
+		Name:           "caOverlapInterval",
+		Replacement:    "ca.renewBefore",
+		RemovalRelease: nextAPIVersion,
+		SetIn: func(path *k8sfield.Path, spec *v1.KubeVirtSpec) []*k8sfield.Path {
+			selfSigned := spec.CertificateRotationStrategy.SelfSigned
+			return setIf(path.Child("certificateRotateStrategy", "selfSigned"), selfSigned != nil && selfSigned.CAOverlapInterval != nil)
+		},
+	},
+}
+
+func setIf(path *k8sfield.Path, set bool) []*k8sfield.Path {
+	if !set {
+		return nil
+	}
+	return []*k8sfield.Path{path}
+}
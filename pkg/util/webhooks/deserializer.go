@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package webhooks
+
+import (
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+
+	generatedscheme "kubevirt.io/client-go/kubevirt/scheme"
+)
+
+// admissionDeserializer decodes the objects of admission requests with the KubeVirt scheme.
+// It runs in strict mode to detect the unknown and duplicated fields of the objects.
+var admissionDeserializer = k8sjson.NewSerializerWithOptions(
+	k8sjson.DefaultMetaFactory,
+	generatedscheme.Scheme,
+	generatedscheme.Scheme,
+	k8sjson.SerializerOptions{Strict: true},
+)
+
+// DecodeObject decodes the raw object of an admission request into obj.
+// The apiVersion and kind of the raw object default to the ones of obj, and other versions of the same
+// kind are converted. Unknown and duplicated fields are dropped, see GetUnknownFieldWarnings to report them.
+// Types which are not registered in the KubeVirt scheme are decoded as plain JSON.
+func DecodeObject(raw []byte, obj runtime.Object) error {
+	_, err := decode(raw, obj)
+	return err
+}
+
+// GetUnknownFieldWarnings returns a warning for each unknown or duplicated field of the object of an
+// admission request. Nothing is returned when the kind of the request is not registered in the KubeVirt scheme.
+func GetUnknownFieldWarnings(request *admissionv1.AdmissionRequest) []string {
+	if request == nil || len(request.Object.Raw) == 0 {
+		return nil
+	}
+
+	obj, err := generatedscheme.Scheme.New(schema.GroupVersionKind(request.Kind))
+	if err != nil {
+		return nil
+	}
+
+	warnings, err := decode(request.Object.Raw, obj)
+	if err != nil {
+		return nil
+	}
+	return warnings
+}
+
+func decode(raw []byte, obj runtime.Object) (warnings []string, err error) {
+	decoded, gvk, err := admissionDeserializer.Decode(raw, nil, obj)
+	if err != nil {
+		strictErr, isStrictErr := runtime.AsStrictDecodingError(err)
+		if !isStrictErr {
+			return nil, err
+		}
+		for _, e := range strictErr.Errors() {
+			warnings = append(warnings, e.Error())
+		}
+	}
+
+	if decoded != obj {
+		if err := generatedscheme.Scheme.Convert(decoded, obj, nil); err != nil {
+			return nil, fmt.Errorf("cannot decode %s into %T: %v", gvk, obj, err)
+		}
+	}
+
+	return warnings, nil
+}
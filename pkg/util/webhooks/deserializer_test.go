@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package webhooks_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+var _ = Describe("Admission object deserialization", func() {
+	const vmSnapshotJSON = `{"apiVersion":"snapshot.kubevirt.io/v1beta1","kind":"VirtualMachineSnapshot",` +
+		`"metadata":{"name":"snap","namespace":"default"},` +
+		`"spec":{"source":{"apiGroup":"kubevirt.io","kind":"VirtualMachine","name":"vm"},"unknownField":true}}`
+
+	Context("DecodeObject", func() {
+		It("should decode a KubeVirt object", func() {
+			vmSnapshot := &snapshotv1.VirtualMachineSnapshot{}
+			Expect(webhooks.DecodeObject([]byte(vmSnapshotJSON), vmSnapshot)).To(Succeed())
+			Expect(vmSnapshot.Name).To(Equal("snap"))
+			Expect(vmSnapshot.Spec.Source.Name).To(Equal("vm"))
+		})
+
+		It("should default the apiVersion and kind to the ones of the object", func() {
+			vm := &v1.VirtualMachine{}
+			Expect(webhooks.DecodeObject([]byte(`{"metadata":{"name":"vm"}}`), vm)).To(Succeed())
+			Expect(vm.Name).To(Equal("vm"))
+		})
+
+		It("should fail to decode an object of another kind", func() {
+			vm := &v1.VirtualMachine{}
+			Expect(webhooks.DecodeObject([]byte(vmSnapshotJSON), vm)).ToNot(Succeed())
+		})
+
+		It("should fail on malformed objects", func() {
+			vm := &v1.VirtualMachine{}
+			Expect(webhooks.DecodeObject([]byte(`{"spec":`), vm)).ToNot(Succeed())
+		})
+
+		It("should decode objects which are not part of the KubeVirt API", func() {
+			pod := &k8sv1.Pod{}
+			Expect(webhooks.DecodeObject([]byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"pod"}}`), pod)).To(Succeed())
+			Expect(pod.Name).To(Equal("pod"))
+		})
+	})
+
+	Context("GetUnknownFieldWarnings", func() {
+		newRequest := func(kind metav1.GroupVersionKind, raw string) *admissionv1.AdmissionRequest {
+			return &admissionv1.AdmissionRequest{
+				Kind:   kind,
+				Object: runtime.RawExtension{Raw: []byte(raw)},
+			}
+		}
+
+		It("should warn about unknown fields", func() {
+			request := newRequest(metav1.GroupVersionKind(snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshot")), vmSnapshotJSON)
+			Expect(webhooks.GetUnknownFieldWarnings(request)).To(ConsistOf(ContainSubstring(`unknown field "spec.unknownField"`)))
+		})
+
+		It("should warn about duplicated fields", func() {
+			request := newRequest(metav1.GroupVersionKind(v1.VirtualMachineGroupVersionKind), `{"metadata":{"name":"a","name":"b"}}`)
+			Expect(webhooks.GetUnknownFieldWarnings(request)).To(ConsistOf(ContainSubstring(`duplicate field "metadata.name"`)))
+		})
+
+		It("should not warn about known fields", func() {
+			request := newRequest(metav1.GroupVersionKind(v1.VirtualMachineGroupVersionKind), `{"metadata":{"name":"vm"},"spec":{}}`)
+			Expect(webhooks.GetUnknownFieldWarnings(request)).To(BeEmpty())
+		})
+
+		It("should ignore kinds which are not part of the KubeVirt API", func() {
+			request := newRequest(metav1.GroupVersionKind{Version: "v1", Kind: "Pod"}, `{"metadata":{"name":"pod"},"unknownField":true}`)
+			Expect(webhooks.GetUnknownFieldWarnings(request)).To(BeEmpty())
+		})
+	})
+})
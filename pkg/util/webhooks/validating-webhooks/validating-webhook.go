@@ -19,8 +19,11 @@ type admitter interface {
 	Admit(context.Context, *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse
 }
 
-func NewPassingAdmissionResponse() *admissionv1.AdmissionResponse {
-	return &admissionv1.AdmissionResponse{Allowed: true}
+// NewPassingAdmissionResponse returns an admission response allowing the request.
+// Warnings are non-fatal and are returned to the client, e.g. about deprecated fields,
+// configurations which will become invalid or which are known to hurt the performance.
+func NewPassingAdmissionResponse(warnings ...string) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: true, Warnings: warnings}
 }
 
 func NewAdmissionResponse(causes []v1.StatusCause) *admissionv1.AdmissionResponse {
@@ -69,6 +72,7 @@ func Serve(resp http.ResponseWriter, req *http.Request, admitter admitter) {
 	if reviewResponse != nil {
 		response.Response = reviewResponse
 		response.Response.UID = review.Request.UID
+		response.Response.Warnings = append(response.Response.Warnings, webhooks.GetUnknownFieldWarnings(review.Request)...)
 	}
 	// reset the Object and OldObject, they are not needed in admitter response.
 	review.Request.Object = runtime.RawExtension{}
@@ -143,6 +143,13 @@ func ValidateStatus(data []byte) *admissionv1.AdmissionResponse {
 	return nil
 }
 
+// IsDryRun returns true if the admission request is a server-side dry-run.
+// The KubeVirt webhooks are declared without side effects, admitters must not write
+// anything or record any metric on such requests.
+func IsDryRun(request *admissionv1.AdmissionRequest) bool {
+	return request.DryRun != nil && *request.DryRun
+}
+
 func GetVMIFromAdmissionReview(ar *admissionv1.AdmissionReview) (new *v12.VirtualMachineInstance, old *v12.VirtualMachineInstance, err error) {
 
 	if !ValidateRequestResource(ar.Request.Resource, webhooks.VirtualMachineInstanceGroupVersionResource.Group, webhooks.VirtualMachineInstanceGroupVersionResource.Resource) {
@@ -152,7 +159,7 @@ func GetVMIFromAdmissionReview(ar *admissionv1.AdmissionReview) (new *v12.Virtua
 	raw := ar.Request.Object.Raw
 	newVMI := v12.VirtualMachineInstance{}
 
-	err = json.Unmarshal(raw, &newVMI)
+	err = DecodeObject(raw, &newVMI)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -161,7 +168,7 @@ func GetVMIFromAdmissionReview(ar *admissionv1.AdmissionReview) (new *v12.Virtua
 		raw := ar.Request.OldObject.Raw
 		oldVMI := v12.VirtualMachineInstance{}
 
-		err = json.Unmarshal(raw, &oldVMI)
+		err = DecodeObject(raw, &oldVMI)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -180,7 +187,7 @@ func GetVMFromAdmissionReview(ar *admissionv1.AdmissionReview) (new *v12.Virtual
 	raw := ar.Request.Object.Raw
 	newVM := v12.VirtualMachine{}
 
-	err = json.Unmarshal(raw, &newVM)
+	err = DecodeObject(raw, &newVM)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +196,7 @@ func GetVMFromAdmissionReview(ar *admissionv1.AdmissionReview) (new *v12.Virtual
 		raw := ar.Request.OldObject.Raw
 		oldVM := v12.VirtualMachine{}
 
-		err = json.Unmarshal(raw, &oldVM)
+		err = DecodeObject(raw, &oldVM)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -204,7 +211,7 @@ func GetInstanceTypeSpecFromAdmissionRequest(request *admissionv1.AdmissionReque
 	raw := request.Object.Raw
 	instancetypeObj := instancetypev1beta1.VirtualMachineInstancetype{}
 
-	err = json.Unmarshal(raw, &instancetypeObj)
+	err = DecodeObject(raw, &instancetypeObj)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -213,7 +220,7 @@ func GetInstanceTypeSpecFromAdmissionRequest(request *admissionv1.AdmissionReque
 		raw := request.OldObject.Raw
 		oldInstancetypeObj := instancetypev1beta1.VirtualMachineInstancetype{}
 
-		err = json.Unmarshal(raw, &oldInstancetypeObj)
+		err = DecodeObject(raw, &oldInstancetypeObj)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -227,7 +234,7 @@ func GetPreferenceSpecFromAdmissionRequest(request *admissionv1.AdmissionRequest
 	raw := request.Object.Raw
 	preferenceObj := instancetypev1beta1.VirtualMachinePreference{}
 
-	err = json.Unmarshal(raw, &preferenceObj)
+	err = DecodeObject(raw, &preferenceObj)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -236,7 +243,7 @@ func GetPreferenceSpecFromAdmissionRequest(request *admissionv1.AdmissionRequest
 		raw := request.OldObject.Raw
 		oldPreferenceObj := instancetypev1beta1.VirtualMachinePreference{}
 
-		err = json.Unmarshal(raw, &oldPreferenceObj)
+		err = DecodeObject(raw, &oldPreferenceObj)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -250,7 +257,7 @@ func GetVMIMFromAdmissionRequest(ar *admissionv1.AdmissionReview) (new *v12.Virt
 	raw := ar.Request.Object.Raw
 	migrationObj := v12.VirtualMachineInstanceMigration{}
 
-	err = json.Unmarshal(raw, &migrationObj)
+	err = DecodeObject(raw, &migrationObj)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -259,7 +266,7 @@ func GetVMIMFromAdmissionRequest(ar *admissionv1.AdmissionReview) (new *v12.Virt
 		raw := ar.Request.OldObject.Raw
 		oldMigrationObj := v12.VirtualMachineInstanceMigration{}
 
-		err = json.Unmarshal(raw, &oldMigrationObj)
+		err = DecodeObject(raw, &oldMigrationObj)
 		if err != nil {
 			return nil, nil, err
 		}
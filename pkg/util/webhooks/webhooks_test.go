@@ -0,0 +1,40 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package webhooks_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+var _ = Describe("Webhooks", func() {
+	DescribeTable("should detect dry-run requests", func(dryRun *bool, expected bool) {
+		Expect(webhooks.IsDryRun(&admissionv1.AdmissionRequest{DryRun: dryRun})).To(Equal(expected))
+	},
+		Entry("when dry-run is not set", nil, false),
+		Entry("when dry-run is disabled", pointer.P(false), false),
+		Entry("when dry-run is enabled", pointer.P(true), true),
+	)
+})
@@ -246,6 +246,19 @@ func (app *virtAPIApp) composeSubresources() {
 		restartRouteBuilder.ParameterNamed("body").Required(false)
 		subws.Route(restartRouteBuilder)
 
+		rollbackRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("rollback")).
+			To(subresourceApp.RollbackVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RollbackOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Rollback").
+			Doc("Roll back a VirtualMachine to a previous revision of its spec.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+		rollbackRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(rollbackRouteBuilder)
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("migrate")).
 			To(subresourceApp.MigrateVMRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -281,6 +294,32 @@ func (app *virtAPIApp) composeSubresources() {
 		stopRouteBuilder.ParameterNamed("body").Required(false)
 		subws.Route(stopRouteBuilder)
 
+		suspendRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("suspend")).
+			To(subresourceApp.SuspendVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.SuspendOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Suspend").
+			Doc("Suspend a VirtualMachine to disk.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+		suspendRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(suspendRouteBuilder)
+
+		resumeRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("resume")).
+			To(subresourceApp.ResumeVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ResumeOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Resume").
+			Doc("Resume a VirtualMachine suspended to disk.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+		resumeRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(resumeRouteBuilder)
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("expand-spec")).
 			To(subresourceApp.ExpandSpecVMRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -325,6 +364,26 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("cloudinit/rotate")).
+			To(subresourceApp.RotateCloudInitVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"RotateCloudInit").
+			Doc("Regenerate the cloud-init disk of a VirtualMachineInstance object and re-run cloud-init in the guest.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("cloudinit/eject")).
+			To(subresourceApp.EjectCloudInitVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"EjectCloudInit").
+			Doc("Eject the cloud-init disk of a VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("pause")).
 			To(subresourceApp.PauseVMIRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -350,6 +409,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("console")).
 			To(subresourceApp.ConsoleRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.SerialParam(subws)).
 			Operation(version.Version + "Console").
 			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance."))
 
@@ -394,6 +454,13 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version + "VSOCK").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port via VSOCK."))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestExecCommandParam(subws)).Param(definitions.GuestExecTimeoutParam(subws)).
+			Operation(version.Version + "GuestExec").
+			Doc("Open a websocket connection streaming the output and the exit code of a command run in the guest of a VirtualMachineInstance via guest agent."))
+
 		// VM endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
@@ -460,6 +527,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceGuestAgentInfo{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestosinfo/refresh")).
+			To(subresourceApp.GuestOSInfoRefresh).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Consumes(mime.MIME_ANY).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"GuestosinfoRefresh").
+			Doc("Poll the guest agent right away and get its os information").
+			Writes(v1.VirtualMachineInstanceGuestAgentInfo{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("userlist")).
 			To(subresourceApp.UserList).
 			Consumes(restful.MIME_JSON).
@@ -480,6 +557,43 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("domainxml")).
+			To(subresourceApp.DomainXML).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"DomainXML").
+			Doc("Get the live libvirt domain XML and domain capabilities of a VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceDomainXML{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceDomainXML{}))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileReadRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestFilePathParam(subws)).
+			Operation(version.Version+"ReadGuestFile").
+			Doc("Read a file of at most 1MiB inside the guest of a VirtualMachineInstance via guest agent").
+			Writes(v1.VirtualMachineInstanceGuestFile{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestFile{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileWriteRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Reads(v1.VirtualMachineInstanceGuestFile{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"WriteGuestFile").
+			Doc("Create or truncate a file inside the guest of a VirtualMachineInstance and write at most 1MiB to it via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusRequestEntityTooLarge, "Request Entity Too Large", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -542,6 +656,26 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("insertmedia")).
+			To(subresourceApp.InsertMediaVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.InsertMediaOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-insertmedia").
+			Doc("Insert or change the media of a CD-ROM drive of a Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("ejectmedia")).
+			To(subresourceApp.EjectMediaVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.EjectMediaOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-ejectmedia").
+			Doc("Eject the media of a CD-ROM drive of a Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("memorydump")).
 			To(subresourceApp.MemoryDumpVMRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -713,6 +847,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/softreboot",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/cloudinit/rotate",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/cloudinit/eject",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/start",
 						Namespaced: true,
@@ -741,10 +883,34 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/evacuate/cancel",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/rollback",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/suspend",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/resume",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/insertmedia",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/ejectmedia",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestosinfo/refresh",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/userlist",
 						Namespaced: true,
@@ -753,6 +919,18 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/domainxml",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestfile",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -893,9 +1071,41 @@ func (app *virtAPIApp) composeSubresources() {
 	restful.Add(ws)
 }
 
+// composeExternalMetrics serves the external metrics of the VirtualMachinePools for the HorizontalPodAutoscaler
+func (app *virtAPIApp) composeExternalMetrics() {
+	poolMetricsApp := rest.NewPoolMetricsAPIApp(app.clusterConfig)
+
+	ws := new(restful.WebService)
+	ws.Doc("External metrics API of the VirtualMachinePools.")
+	ws.Path(definitions.GroupVersionBasePath(rest.ExternalMetricsGroupVersion))
+
+	ws.Route(ws.GET(fmt.Sprintf("/namespaces/{%s}/{%s}", definitions.NamespaceParamName, rest.MetricParamName)).
+		To(poolMetricsApp.ExternalMetricHandler).
+		Produces(restful.MIME_JSON).
+		Param(definitions.NamespaceParam(ws)).
+		Param(ws.PathParameter(rest.MetricParamName, "Name of the external metric, e.g. vmpool_cpu_usage").Required(true)).
+		Param(ws.QueryParameter("labelSelector", "Selector of the series of the metric, e.g. vmpool=my-pool")).
+		Operation("getPoolExternalMetric").
+		Doc("Get the value of an external metric of the VirtualMachinePools.").
+		Returns(http.StatusOK, "OK", "").
+		Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+		Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+	ws.Route(ws.GET("/").
+		Produces(restful.MIME_JSON).Writes(metav1.APIResourceList{}).
+		To(poolMetricsApp.APIResourceListHandler).
+		Operation("getPoolExternalMetricsAPIResources").
+		Doc("Get the external metrics of the VirtualMachinePools").
+		Returns(http.StatusOK, "OK", metav1.APIResourceList{}).
+		Returns(http.StatusNotFound, httpStatusNotFoundMessage, ""))
+
+	restful.Add(ws)
+}
+
 func (app *virtAPIApp) Compose() {
 
 	app.composeSubresources()
+	app.composeExternalMetrics()
 
 	restful.Filter(filter.RequestLoggingFilter())
 	restful.Filter(restful.OPTIONSFilter())
@@ -978,7 +1188,7 @@ func (app *virtAPIApp) prepareCertManager() {
 
 func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers) {
 	http.HandleFunc(components.VMICreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts,
+		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.virtCli, informers, app.kubeVirtServiceAccounts,
 			func(field *field.Path, vmiSpec *v1.VirtualMachineInstanceSpec, clusterCfg *virtconfig.ClusterConfig) []metav1.StatusCause {
 				return netadmitter.Validate(field, vmiSpec, clusterCfg)
 			},
@@ -1052,7 +1262,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 func (app *virtAPIApp) registerMutatingWebhook(informers *webhooks.Informers) {
 
 	http.HandleFunc(components.VMMutatePath, func(w http.ResponseWriter, r *http.Request) {
-		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli)
+		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli, informers)
 	})
 	http.HandleFunc(components.VMIMutatePath, func(w http.ResponseWriter, r *http.Request) {
 		mutating_webhook.ServeVMIs(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts)
@@ -1202,7 +1412,17 @@ func (app *virtAPIApp) Run() {
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	vmBackupInformer := kubeInformerFactory.VirtualMachineBackup()
+	vmInformer := kubeInformerFactory.VirtualMachine()
+	vmSnapshotInformer := kubeInformerFactory.VirtualMachineSnapshot()
+	vmSnapshotContentInformer := kubeInformerFactory.VirtualMachineSnapshotContent()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	vmDefaultsInformer := kubeInformerFactory.VirtualMachineDefaults()
+	vmiInformer := kubeInformerFactory.VMI()
+	vmQuotaInformer := kubeInformerFactory.VirtualMachineQuota()
+	instancetypeInformer := kubeInformerFactory.VirtualMachineInstancetype()
+	clusterInstancetypeInformer := kubeInformerFactory.VirtualMachineClusterInstancetype()
+	preferenceInformer := kubeInformerFactory.VirtualMachinePreference()
+	clusterPreferenceInformer := kubeInformerFactory.VirtualMachineClusterPreference()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1237,11 +1457,21 @@ func (app *virtAPIApp) Run() {
 	kubeInformerFactory.WaitForCacheSync(stopChan)
 
 	webhookInformers := &webhooks.Informers{
-		VMIPresetInformer:  vmiPresetInformer,
-		VMRestoreInformer:  vmRestoreInformer,
-		VMBackupInformer:   vmBackupInformer,
-		DataSourceInformer: dataSourceInformer,
-		NamespaceInformer:  namespaceInformer,
+		VMIPresetInformer:           vmiPresetInformer,
+		VMRestoreInformer:           vmRestoreInformer,
+		VMBackupInformer:            vmBackupInformer,
+		VMInformer:                  vmInformer,
+		VMSnapshotInformer:          vmSnapshotInformer,
+		VMSnapshotContentInformer:   vmSnapshotContentInformer,
+		DataSourceInformer:          dataSourceInformer,
+		NamespaceInformer:           namespaceInformer,
+		VMDefaultsInformer:          vmDefaultsInformer,
+		VMIInformer:                 vmiInformer,
+		VMQuotaInformer:             vmQuotaInformer,
+		InstancetypeInformer:        instancetypeInformer,
+		ClusterInstancetypeInformer: clusterInstancetypeInformer,
+		PreferenceInformer:          preferenceInformer,
+		ClusterPreferenceInformer:   clusterPreferenceInformer,
 	}
 
 	// Build webhook subresources
@@ -591,6 +591,11 @@ const (
 	NameParamName            = "name"
 	MoveCursorParamName      = "moveCursor"
 	PreserveSessionParamName = "preserveSession"
+	SerialParamName          = "serial"
+	GuestFilePathParamName   = "path"
+
+	GuestExecCommandParamName = "command"
+	GuestExecTimeoutParamName = "timeoutSeconds"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -605,6 +610,22 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func SerialParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(SerialParamName, "Name of the additional serial device to connect to, the default serial console if empty.").DataType("string")
+}
+
+func GuestFilePathParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestFilePathParamName, "Absolute path of the file inside the guest.").DataType("string").Required(true)
+}
+
+func GuestExecCommandParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestExecCommandParamName, "Path of the executable in the guest followed by its arguments, one per parameter.").DataType("string").AllowMultiple(true).Required(true)
+}
+
+func GuestExecTimeoutParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestExecTimeoutParamName, "Seconds to wait for the command to exit, 60 by default.").DataType("integer")
+}
+
 func PreserveSessionParam(ws *restful.WebService) *restful.Parameter {
 	return ws.
 		QueryParameter(PreserveSessionParamName, "Connect only if ongoing session is not disturbed.").
@@ -64,6 +64,7 @@ var noAuthEndpoints = map[string]struct{}{
 	"/apis/subresources.kubevirt.io/v1alpha3/version": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/guestfs": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/healthz": {},
+	"/apis/external.metrics.k8s.io/v1beta1":           {},
 	// the profiler endpoints are blocked by a feature gate
 	// to restrict the usage to development environments
 	"/start-profiler": {},
@@ -252,14 +253,15 @@ func addNamespacedResourceAttributes(pathSplit []string, requestMethod string, r
 }
 
 func addNamespacedResourceBaseAttributes(pathSplit []string, requestMethod string, r *authv1.SubjectAccessReview) error {
-	// URL example
+	// URL examples
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/expand-vm-spec
+	// /apis/external.metrics.k8s.io/v1beta1/namespaces/default/vmpool_cpu_usage
 	group := pathSplit[2]
 	version := pathSplit[3]
 	namespace := pathSplit[5]
 	resource := pathSplit[6]
 
-	if resource != "expand-vm-spec" {
+	if resource != "expand-vm-spec" && group != ExternalMetricsGroupVersion.Group {
 		return fmt.Errorf("unknown resource type %s", resource)
 	}
 
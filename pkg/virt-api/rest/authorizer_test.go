@@ -225,8 +225,29 @@ var _ = Describe("Authorizer", func() {
 				Entry("subresource v1alpha3 start profiler", "/apis/subresources.kubevirt.io/v1alpha3/start-cluster-profiler"),
 				Entry("subresource v1alpha3 stop profiler", "/apis/subresources.kubevirt.io/v1alpha3/stop-cluster-profiler"),
 				Entry("subresource v1alpha3 dump profiler", "/apis/subresources.kubevirt.io/v1alpha3/dump-cluster-profiler"),
+				// External metrics
+				Entry("external metrics groupversion", "/apis/external.metrics.k8s.io/v1beta1"),
 			)
 
+			It("should review the list of an external metric", func() {
+				allowedFn = func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+					Expect(sar.Spec.ResourceAttributes).ToNot(BeNil())
+					Expect(sar.Spec.ResourceAttributes.Namespace).To(Equal("default"))
+					Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("list"))
+					Expect(sar.Spec.ResourceAttributes.Group).To(Equal("external.metrics.k8s.io"))
+					Expect(sar.Spec.ResourceAttributes.Version).To(Equal("v1beta1"))
+					Expect(sar.Spec.ResourceAttributes.Resource).To(Equal("vmpool_cpu_usage"))
+					sar.Status.Allowed = true
+					return sar, nil
+				}
+
+				req.Request.Method = http.MethodGet
+				req.Request.URL.Path = "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/vmpool_cpu_usage"
+				result, _, err := app.Authorize(req)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(BeTrue())
+			})
+
 			DescribeTable("should use correct subresource in SAR", func(pathSuffix, expectedSubresource string) {
 				allowedFn = func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
 					Expect(sar.Spec.ResourceAttributes).ToNot(BeNil())
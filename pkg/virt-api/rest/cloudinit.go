@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful/v3"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// RotateCloudInitVMIRequestHandler regenerates the cloud-init disk of a running VMI from the current
+// content of its cloud-init volume and secrets, and re-runs the cloud-init modules handling credentials
+// in the guest through the guest agent.
+func (app *SubresourceAPIApp) RotateCloudInitVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if statusErr := validateCloudInitMediaChange(vmi); statusErr != nil {
+			return statusErr
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not have guest agent connected"))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.CloudInitRotateURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
+// EjectCloudInitVMIRequestHandler removes the media of the cloud-init disk of a running VMI,
+// so that the cloud-init data is not readable from the guest anymore.
+func (app *SubresourceAPIApp) EjectCloudInitVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.CloudInitEjectURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validateCloudInitMediaChange, getURL, false)
+}
+
+func validateCloudInitMediaChange(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstancePaused, k8sv1.ConditionTrue) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
+	}
+
+	var volumeName string
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.CloudInitNoCloud != nil || volume.CloudInitConfigDrive != nil {
+			volumeName = volume.Name
+			break
+		}
+	}
+	if volumeName == "" {
+		return errors.NewBadRequest("VMI does not have a cloud-init volume")
+	}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == volumeName {
+			if disk.CDRom == nil {
+				return errors.NewBadRequest(fmt.Sprintf("cloud-init volume %s must be attached as a cdrom to be rotated or ejected", volumeName))
+			}
+			return nil
+		}
+	}
+	return errors.NewBadRequest(fmt.Sprintf("cloud-init volume %s is not attached to the VMI", volumeName))
+}
@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/libvmi/cloudinit"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Cloud-init Subresource API", func() {
+	var (
+		request   *restful.Request
+		response  *restful.Response
+		recorder  *httptest.ResponseRecorder
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+	)
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	newRunningVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		opts = append([]libvmi.Option{libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault)}, opts...)
+		vmi := libvmi.New(opts...)
+		vmi.Status.Phase = v1.Running
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceAgentConnected,
+			Status: k8sv1.ConditionTrue,
+		}}
+		return vmi
+	}
+
+	newCloudInitVMI := func(asCDRom bool) *v1.VirtualMachineInstance {
+		vmi := newRunningVMI(libvmi.WithCloudInitNoCloud(cloudinit.WithNoCloudUserData("#cloud-config")))
+		if asCDRom {
+			vmi.Spec.Domain.Devices.Disks[0].DiskDevice = v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}}
+		}
+		return vmi
+	}
+
+	handlers := map[string]func(*SubresourceAPIApp, *restful.Request, *restful.Response){
+		"rotate": (*SubresourceAPIApp).RotateCloudInitVMIRequestHandler,
+		"eject":  (*SubresourceAPIApp).EjectCloudInitVMIRequestHandler,
+	}
+
+	DescribeTable("should reject", func(action string, vmi *v1.VirtualMachineInstance, expectedCode int, expectedMessage string) {
+		vmiClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vmi, nil)
+
+		handlers[action](app, request, response)
+
+		statusErr := ExpectStatusErrorWithCode(recorder, expectedCode)
+		Expect(statusErr.ErrStatus.Message).To(ContainSubstring(expectedMessage))
+	},
+		Entry("rotating a VMI which is not running", "rotate",
+			func() *v1.VirtualMachineInstance {
+				vmi := newCloudInitVMI(true)
+				vmi.Status.Phase = v1.Scheduled
+				return vmi
+			}(), http.StatusConflict, vmNotRunning),
+		Entry("rotating a VMI without cloud-init volume", "rotate",
+			newRunningVMI(), http.StatusBadRequest, "VMI does not have a cloud-init volume"),
+		Entry("rotating a VMI with cloud-init attached as a disk", "rotate",
+			newCloudInitVMI(false), http.StatusBadRequest, "must be attached as a cdrom"),
+		Entry("rotating a VMI without guest agent", "rotate",
+			func() *v1.VirtualMachineInstance {
+				vmi := newCloudInitVMI(true)
+				vmi.Status.Conditions = nil
+				return vmi
+			}(), http.StatusConflict, "VMI does not have guest agent connected"),
+		Entry("ejecting a paused VMI", "eject",
+			func() *v1.VirtualMachineInstance {
+				vmi := newCloudInitVMI(true)
+				vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstancePaused,
+					Status: k8sv1.ConditionTrue,
+				})
+				return vmi
+			}(), http.StatusConflict, "VMI is paused"),
+		Entry("ejecting a VMI with cloud-init attached as a disk", "eject",
+			newCloudInitVMI(false), http.StatusBadRequest, "must be attached as a cdrom"),
+	)
+})
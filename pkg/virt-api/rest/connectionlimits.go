@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/lru"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// connectionLimitRetryAfterSeconds is returned to clients rejected by a concurrency cap,
+	// as it is unknown when one of the open connections will be closed.
+	connectionLimitRetryAfterSeconds = 1
+	// userRateLimitersCacheSize bounds the number of per-user rate limiters kept in memory.
+	userRateLimitersCacheSize = 1024
+)
+
+type userRateLimiter struct {
+	config  v1.TokenBucketRateLimiter
+	limiter *rate.Limiter
+}
+
+// connectionLimiter enforces the SubresourceConnectionLimits of the cluster configuration
+// on the streaming connections virt-api proxies to virt-handler.
+type connectionLimiter struct {
+	lock             sync.Mutex
+	clusterConfig    *virtconfig.ClusterConfig
+	userConnections  map[string]uint32
+	vmiConnections   map[string]uint32
+	userRateLimiters *lru.Cache
+}
+
+func newConnectionLimiter(clusterConfig *virtconfig.ClusterConfig) *connectionLimiter {
+	return &connectionLimiter{
+		clusterConfig:    clusterConfig,
+		userConnections:  map[string]uint32{},
+		vmiConnections:   map[string]uint32{},
+		userRateLimiters: lru.New(userRateLimitersCacheSize),
+	}
+}
+
+// acquire reserves a connection to the VMI for the user.
+// The returned release function has to be called once the connection is closed.
+func (l *connectionLimiter) acquire(user, namespace, name string) (release func(), statusErr *errors.StatusError) {
+	limits := l.clusterConfig.GetSubresourceConnectionLimits()
+	if limits == nil {
+		return func() {}, nil
+	}
+	vmiKey := fmt.Sprintf("%s/%s", namespace, name)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if limits.MaxConnectionsPerUser != nil && l.userConnections[user] >= *limits.MaxConnectionsPerUser {
+		return nil, errors.NewTooManyRequests(
+			fmt.Sprintf("user %s exceeded the limit of %d concurrent connections", user, *limits.MaxConnectionsPerUser),
+			connectionLimitRetryAfterSeconds,
+		)
+	}
+	if limits.MaxConnectionsPerVMI != nil && l.vmiConnections[vmiKey] >= *limits.MaxConnectionsPerVMI {
+		return nil, errors.NewTooManyRequests(
+			fmt.Sprintf("VMI %s exceeded the limit of %d concurrent connections", vmiKey, *limits.MaxConnectionsPerVMI),
+			connectionLimitRetryAfterSeconds,
+		)
+	}
+	if limits.ConnectionRateLimiter != nil {
+		if delay := l.reserve(user, *limits.ConnectionRateLimiter); delay > 0 {
+			return nil, errors.NewTooManyRequests(
+				fmt.Sprintf("user %s exceeded the connection rate limit", user),
+				int(math.Ceil(delay.Seconds())),
+			)
+		}
+	}
+
+	l.userConnections[user]++
+	l.vmiConnections[vmiKey]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.release(user, vmiKey) })
+	}, nil
+}
+
+func (l *connectionLimiter) release(user, vmiKey string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	decrement(l.userConnections, user)
+	decrement(l.vmiConnections, vmiKey)
+}
+
+func decrement(connections map[string]uint32, key string) {
+	if connections[key] <= 1 {
+		delete(connections, key)
+		return
+	}
+	connections[key]--
+}
+
+// reserve takes a token from the rate limiter of the user and returns how long
+// the user has to wait for the next one if none is available.
+func (l *connectionLimiter) reserve(user string, config v1.TokenBucketRateLimiter) time.Duration {
+	if config.QPS <= 0 {
+		return 0
+	}
+	var limiter *userRateLimiter
+	if cached, exists := l.userRateLimiters.Get(user); exists {
+		limiter = cached.(*userRateLimiter)
+	}
+	if limiter == nil || limiter.config != config {
+		limiter = &userRateLimiter{
+			config:  config,
+			limiter: rate.NewLimiter(rate.Limit(config.QPS), max(config.Burst, 1)),
+		}
+		l.userRateLimiters.Add(user, limiter)
+	}
+
+	reservation := limiter.limiter.Reserve()
+	delay := reservation.Delay()
+	if delay > 0 {
+		reservation.Cancel()
+	}
+	return delay
+}
+
+// acquireConnection reserves a connection for the user and VMI of the request.
+// If a limit is exceeded a 429 response is written and false is returned.
+func (app *SubresourceAPIApp) acquireConnection(request *restful.Request, response *restful.Response) (release func(), acquired bool) {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	user := request.HeaderParameter(userHeader)
+
+	release, statusErr := app.connectionLimiter.acquire(user, namespace, name)
+	if statusErr != nil {
+		log.Log.V(3).Infof("Rejecting connection to %s/%s: %s", namespace, name, statusErr.Error())
+		writeError(statusErr, response)
+		return nil, false
+	}
+	return release, true
+}
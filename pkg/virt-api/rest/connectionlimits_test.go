@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Subresource connection limits", func() {
+	const (
+		user      = "user"
+		otherUser = "other-user"
+		vmiName   = "vmi"
+		otherVMI  = "other-vmi"
+	)
+
+	newLimiter := func(limits *v1.SubresourceConnectionLimits) *connectionLimiter {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			SubresourceConnectionLimits: limits,
+		})
+		return newConnectionLimiter(config)
+	}
+
+	It("should not limit connections if no limits are configured", func() {
+		limiter := newLimiter(nil)
+		for range 10 {
+			_, statusErr := limiter.acquire(user, metav1.NamespaceDefault, vmiName)
+			Expect(statusErr).To(BeNil())
+		}
+	})
+
+	It("should limit concurrent connections per user", func() {
+		limiter := newLimiter(&v1.SubresourceConnectionLimits{
+			MaxConnectionsPerUser: pointer.P(uint32(1)),
+		})
+
+		release, statusErr := limiter.acquire(user, metav1.NamespaceDefault, vmiName)
+		Expect(statusErr).To(BeNil())
+
+		_, statusErr = limiter.acquire(user, metav1.NamespaceDefault, otherVMI)
+		Expect(statusErr).ToNot(BeNil())
+		Expect(statusErr.Status().Code).To(Equal(int32(http.StatusTooManyRequests)))
+		Expect(statusErr.Status().Details.RetryAfterSeconds).To(Equal(int32(connectionLimitRetryAfterSeconds)))
+
+		_, statusErr = limiter.acquire(otherUser, metav1.NamespaceDefault, otherVMI)
+		Expect(statusErr).To(BeNil())
+
+		release()
+		_, statusErr = limiter.acquire(user, metav1.NamespaceDefault, otherVMI)
+		Expect(statusErr).To(BeNil())
+	})
+
+	It("should limit concurrent connections per VMI", func() {
+		limiter := newLimiter(&v1.SubresourceConnectionLimits{
+			MaxConnectionsPerVMI: pointer.P(uint32(1)),
+		})
+
+		release, statusErr := limiter.acquire(user, metav1.NamespaceDefault, vmiName)
+		Expect(statusErr).To(BeNil())
+
+		_, statusErr = limiter.acquire(otherUser, metav1.NamespaceDefault, vmiName)
+		Expect(statusErr).ToNot(BeNil())
+		Expect(statusErr.Status().Code).To(Equal(int32(http.StatusTooManyRequests)))
+
+		_, statusErr = limiter.acquire(otherUser, "other-namespace", vmiName)
+		Expect(statusErr).To(BeNil())
+
+		release()
+		release()
+		_, statusErr = limiter.acquire(otherUser, metav1.NamespaceDefault, vmiName)
+		Expect(statusErr).To(BeNil())
+		Expect(limiter.vmiConnections).To(HaveKeyWithValue(metav1.NamespaceDefault+"/"+vmiName, uint32(1)))
+	})
+
+	It("should limit the connection rate per user", func() {
+		limiter := newLimiter(&v1.SubresourceConnectionLimits{
+			ConnectionRateLimiter: &v1.TokenBucketRateLimiter{
+				QPS:   0.1,
+				Burst: 2,
+			},
+		})
+
+		for range 2 {
+			release, statusErr := limiter.acquire(user, metav1.NamespaceDefault, vmiName)
+			Expect(statusErr).To(BeNil())
+			release()
+		}
+
+		_, statusErr := limiter.acquire(user, metav1.NamespaceDefault, vmiName)
+		Expect(statusErr).ToNot(BeNil())
+		Expect(statusErr.Status().Code).To(Equal(int32(http.StatusTooManyRequests)))
+		Expect(statusErr.Status().Details.RetryAfterSeconds).To(BeNumerically(">", 1))
+
+		_, statusErr = limiter.acquire(otherUser, metav1.NamespaceDefault, vmiName)
+		Expect(statusErr).To(BeNil())
+	})
+
+	DescribeTable("should reject connections with 429 and Retry-After", func(handler func(app *SubresourceAPIApp) restful.RouteFunction) {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			SubresourceConnectionLimits: &v1.SubresourceConnectionLimits{
+				MaxConnectionsPerUser: pointer.P(uint32(0)),
+			},
+		})
+		app := NewSubresourceAPIApp(nil, 0, nil, config)
+
+		request := restful.NewRequest(&http.Request{Header: http.Header{userHeader: []string{user}}})
+		request.PathParameters()["name"] = vmiName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder := httptest.NewRecorder()
+		response := restful.NewResponse(recorder)
+
+		handler(app)(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusTooManyRequests)
+		Expect(recorder.Header().Get("Retry-After")).To(Equal("1"))
+	},
+		Entry("console", func(app *SubresourceAPIApp) restful.RouteFunction { return app.ConsoleRequestHandler }),
+		Entry("VNC", func(app *SubresourceAPIApp) restful.RouteFunction { return app.VNCRequestHandler }),
+		Entry("port-forward", func(app *SubresourceAPIApp) restful.RouteFunction {
+			return app.PortForwardRequestHandler(app.FetchVirtualMachineInstance)
+		}),
+	)
+})
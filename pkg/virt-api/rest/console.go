@@ -21,6 +21,7 @@ package rest
 
 import (
 	"fmt"
+	"time"
 
 	restful "github.com/emicklei/go-restful/v3"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -30,27 +31,53 @@ import (
 	"kubevirt.io/client-go/log"
 
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
+// consoleReconnectTimeout covers the restart of virt-handler during its upgrade
+const consoleReconnectTimeout = 5 * time.Minute
+
 func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, response *restful.Response) {
+	release, acquired := app.acquireConnection(request, response)
+	if !acquired {
+		return
+	}
+	defer release()
+
 	activeConnectionMetric := apimetrics.NewActiveConsoleConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
 
 	defer apimetrics.SetVMILastConnectionTimestamp(request.PathParameter("namespace"), request.PathParameter("name"))
 
+	// An empty serial selects the default serial console
+	serial := ""
+	if request.Request != nil && request.Request.URL != nil {
+		serial = request.QueryParameter(definitions.SerialParamName)
+	}
+
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
-		validateVMIForConsole,
+		func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+			return validateVMIForConsole(vmi, serial)
+		},
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
-			return conn.ConsoleURI(vmi)
+			return conn.ConsoleURI(vmi, serial)
 		}),
 	)
+	// the serial console resumes on a new connection, it survives virt-handler restarts
+	streamer.serverReconnectTimeout = consoleReconnectTimeout
 
 	streamer.Handle(request, response)
 }
 
-func validateVMIForConsole(vmi *v1.VirtualMachineInstance) *errors.StatusError {
-	if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && !*vmi.Spec.Domain.Devices.AutoattachSerialConsole {
+func validateVMIForConsole(vmi *v1.VirtualMachineInstance, serial string) *errors.StatusError {
+	if serial != "" {
+		if !hasSerialDevice(vmi, serial) {
+			err := fmt.Errorf("No serial device named %s is present.", serial)
+			log.Log.Object(vmi).Reason(err).Error("Can't establish a serial console connection.")
+			return errors.NewBadRequest(err.Error())
+		}
+	} else if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && !*vmi.Spec.Domain.Devices.AutoattachSerialConsole {
 		err := fmt.Errorf("No serial consoles are present.")
 		log.Log.Object(vmi).Reason(err).Error("Can't establish a serial console connection.")
 		return errors.NewBadRequest(err.Error())
@@ -63,3 +90,12 @@ func validateVMIForConsole(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 	}
 	return nil
 }
+
+func hasSerialDevice(vmi *v1.VirtualMachineInstance, name string) bool {
+	for _, serial := range vmi.Spec.Domain.Devices.Serials {
+		if serial.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -24,6 +24,7 @@ import (
 	"crypto/tls"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -109,6 +110,25 @@ var _ = Describe("Console Subresource api", func() {
 		Entry("should fail if vmi is not running", true, v1.Scheduling),
 	)
 
+	It("should fail if the requested serial device is not present", func() {
+		request = restful.NewRequest(&http.Request{URL: &url.URL{RawQuery: "serial=data"}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		vmi.Spec.Domain.Devices.Serials = []v1.SerialDevice{{Name: "mgmt"}}
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		app.ConsoleRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(recorder.Body.String()).To(ContainSubstring("No serial device named data is present."))
+	})
+
 	It("should fail to connect to the serial console if the VMI is Failed", func() {
 		request.PathParameters()["name"] = testVMIName
 		request.PathParameters()["namespace"] = metav1.NamespaceDefault
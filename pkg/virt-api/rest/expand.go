@@ -99,6 +99,13 @@ func (app *SubresourceAPIApp) ExpandSpecVMRequestHandler(request *restful.Reques
 }
 
 func (app *SubresourceAPIApp) expandSpecResponse(vm *v1.VirtualMachine, errorFunc func(error) *errors.StatusError, response *restful.Response) {
+	// Resolve matchers inferred from volumes as the VM mutating webhook would, so partial VMs
+	// can be rendered before they are created
+	if err := app.instancetypeInferrer.Infer(vm); err != nil {
+		writeError(errorFunc(err), response)
+		return
+	}
+
 	expandedVM, err := app.instancetypeExpander.Expand(vm)
 	if err != nil {
 		writeError(errorFunc(err), response)
@@ -33,11 +33,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 
 	kubevirtcore "kubevirt.io/api/core"
 	v1 "kubevirt.io/api/core/v1"
@@ -45,6 +47,7 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/kubevirt/fake"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -61,6 +64,7 @@ var _ = Describe("Instancetype expansion subresources", func() {
 	var (
 		vmClient   *kubecli.MockVirtualMachineInterface
 		virtClient *kubecli.MockKubevirtClient
+		k8sClient  *k8sfake.Clientset
 		app        *SubresourceAPIApp
 
 		request  *restful.Request
@@ -77,6 +81,9 @@ var _ = Describe("Instancetype expansion subresources", func() {
 		virtClient.EXPECT().GeneratedKubeVirtClient().Return(fake.NewSimpleClientset()).AnyTimes()
 		virtClient.EXPECT().VirtualMachine(vmNamespace).Return(vmClient).AnyTimes()
 
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
 		fakeInstancetypeClients := fake.NewSimpleClientset().InstancetypeV1beta1()
 		virtClient.EXPECT().VirtualMachineClusterInstancetype().Return(fakeInstancetypeClients.VirtualMachineClusterInstancetypes()).AnyTimes()
 		virtClient.EXPECT().VirtualMachineClusterPreference().Return(fakeInstancetypeClients.VirtualMachineClusterPreferences()).AnyTimes()
@@ -420,6 +427,117 @@ var _ = Describe("Instancetype expansion subresources", func() {
 			Entry("singular kind", instancetypeapi.SingularResourceName),
 			Entry("plural kind", instancetypeapi.PluralResourceName),
 		)
+
+		Context("with matchers inferred from volumes", func() {
+			const pvcName = "test-pvc"
+
+			var (
+				clusterInstancetype *instancetypev1beta1.VirtualMachineClusterInstancetype
+				clusterPreference   *instancetypev1beta1.VirtualMachineClusterPreference
+			)
+
+			BeforeEach(func() {
+				clusterInstancetype = &instancetypev1beta1.VirtualMachineClusterInstancetype{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster-instancetype",
+					},
+					Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+						CPU: instancetypev1beta1.CPUInstancetype{
+							Guest: uint32(2),
+						},
+						Memory: instancetypev1beta1.MemoryInstancetype{
+							Guest: resource.MustParse("128Mi"),
+						},
+					},
+				}
+				_, err := virtClient.VirtualMachineClusterInstancetype().Create(context.Background(), clusterInstancetype, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				clusterPreference = &instancetypev1beta1.VirtualMachineClusterPreference{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster-preference",
+					},
+					Spec: instancetypev1beta1.VirtualMachinePreferenceSpec{
+						Devices: &instancetypev1beta1.DevicePreferences{
+							PreferredDiskBus: v1.DiskBusVirtio,
+						},
+						Volumes: &instancetypev1beta1.VolumePreferences{
+							PreferredStorageClassName: "preferred-storage-class",
+						},
+					},
+				}
+				_, err = virtClient.VirtualMachineClusterPreference().Create(context.Background(), clusterPreference, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				pvc := &k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pvcName,
+						Namespace: vmNamespace,
+						Labels: map[string]string{
+							instancetypeapi.DefaultInstancetypeLabel: clusterInstancetype.Name,
+							instancetypeapi.DefaultPreferenceLabel:   clusterPreference.Name,
+						},
+					},
+				}
+				_, err = k8sClient.CoreV1().PersistentVolumeClaims(vmNamespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vm.Spec.Template.Spec.Volumes[0].VolumeSource = v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvcName,
+						},
+					},
+				}
+				vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+					InferFromVolume: volumeName,
+				}
+				vm.Spec.Preference = &v1.PreferenceMatcher{
+					InferFromVolume: volumeName,
+				}
+			})
+
+			It("should infer and expand instancetype and preference within VM", func() {
+				recorder := callExpandSpecApi(vm)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				responseVm := &v1.VirtualMachine{}
+				Expect(json.NewDecoder(recorder.Body).Decode(responseVm)).To(Succeed())
+
+				Expect(responseVm.Spec.Instancetype).To(BeNil())
+				Expect(responseVm.Spec.Preference).To(BeNil())
+				Expect(responseVm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(clusterInstancetype.Spec.CPU.Guest))
+				Expect(responseVm.Spec.Template.Spec.Domain.Memory.Guest.Value()).To(Equal(clusterInstancetype.Spec.Memory.Guest.Value()))
+				Expect(responseVm.Spec.Template.Spec.Domain.Devices.Disks).To(HaveLen(1))
+				Expect(responseVm.Spec.Template.Spec.Domain.Devices.Disks[0].DiskDevice.Disk.Bus).To(Equal(v1.DiskBusVirtio))
+			})
+
+			It("should apply preferred storage class to DataVolumeTemplates", func() {
+				vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-dv",
+					},
+					Spec: cdiv1beta1.DataVolumeSpec{
+						Storage: &cdiv1beta1.StorageSpec{},
+					},
+				}}
+
+				recorder := callExpandSpecApi(vm)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				responseVm := &v1.VirtualMachine{}
+				Expect(json.NewDecoder(recorder.Body).Decode(responseVm)).To(Succeed())
+
+				Expect(responseVm.Spec.DataVolumeTemplates).To(HaveLen(1))
+				Expect(responseVm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(HaveValue(Equal(clusterPreference.Spec.Volumes.PreferredStorageClassName)))
+			})
+
+			It("should fail if the volume to infer from does not exist", func() {
+				vm.Spec.Instancetype.InferFromVolume = "nonexistent-volume"
+
+				recorder := callExpandSpecApi(vm)
+				statusErr := ExpectStatusErrorWithCode(recorder, expectedStatusError)
+				Expect(statusErr.Status().Message).To(ContainSubstring("unable to find volume nonexistent-volume"))
+			})
+		})
 	}
 
 	Context("VirtualMachine expand-spec endpoint", func() {
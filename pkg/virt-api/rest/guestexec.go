@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const guestExecGateDisabled = "GuestExec feature gate not enabled: Unable to run commands in the guest."
+
+// GuestExecRequestHandler runs a command in the guest of a running VMI through the guest agent. The output of the
+// command is streamed back as VirtualMachineInstanceGuestExecOutput JSON objects. Commands may only be run in the
+// namespaces allowed by the guestExec configuration, virt-handler records them in events of the VMI.
+func (app *SubresourceAPIApp) GuestExecRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestExecEnabled() {
+		writeError(errors.NewBadRequest(guestExecGateDisabled), response)
+		return
+	}
+
+	command := request.Request.URL.Query()[definitions.GuestExecCommandParamName]
+	if len(command) == 0 || command[0] == "" {
+		writeError(errors.NewBadRequest("a command is required"), response)
+		return
+	}
+	query := url.Values{
+		definitions.GuestExecCommandParamName: command,
+		guestUserParam:                        []string{request.HeaderParameter(userHeader)},
+	}
+	if timeoutSeconds := request.QueryParameter(definitions.GuestExecTimeoutParamName); timeoutSeconds != "" {
+		if seconds, err := strconv.Atoi(timeoutSeconds); err != nil || seconds <= 0 {
+			writeError(errors.NewBadRequest(fmt.Sprintf("%s must be a positive number of seconds, got %q", definitions.GuestExecTimeoutParamName, timeoutSeconds)), response)
+			return
+		}
+		query.Set(definitions.GuestExecTimeoutParamName, timeoutSeconds)
+	}
+
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	if statusErr := app.validateGuestExecNamespace(namespace, "guestexec"); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		validateVMIGuestAgentConnected,
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			uri, err := conn.GuestExecURI(vmi)
+			if err != nil {
+				return "", err
+			}
+			return uri + "?" + query.Encode(), nil
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+// validateGuestExecNamespace denies running commands, or writing files, in the guests of a namespace which does
+// not match the namespace selector of the guestExec configuration. They are denied in all namespaces if it is not set.
+func (app *SubresourceAPIApp) validateGuestExecNamespace(namespace, subresource string) *errors.StatusError {
+	forbidden := func(reason error) *errors.StatusError {
+		return errors.NewForbidden(v1.Resource("virtualmachineinstances/"+subresource), "", reason)
+	}
+
+	config := app.clusterConfig.GetGuestExecConfiguration()
+	if config == nil || config.NamespaceSelector == nil {
+		return forbidden(fmt.Errorf("no namespace is allowed by the guestExec configuration"))
+	}
+	selector, err := metav1.LabelSelectorAsSelector(config.NamespaceSelector)
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("invalid guestExec namespace selector: %v", err))
+	}
+
+	ns, err := app.virtCli.CoreV1().Namespaces().Get(context.Background(), namespace, metav1.GetOptions{})
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("unable to retrieve namespace %s: %v", namespace, err))
+	}
+	if !selector.Matches(labels.Set(ns.Labels)) {
+		return forbidden(fmt.Errorf("namespace %s is not allowed by the guestExec configuration", namespace))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Guest exec Subresource API", func() {
+	const allowedLabel = "guestexec.example.com/allowed"
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		virtClient *kubecli.MockKubevirtClient
+		vmiClient  *kubecli.MockVirtualMachineInstanceInterface
+		namespace  *k8sv1.Namespace
+	)
+
+	newApp := func(featureGates []string, guestExec *v1.GuestExecConfiguration) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			GuestExec:              guestExec,
+		})
+		return NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	allowLabeledNamespaces := &v1.GuestExecConfiguration{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{allowedLabel: "true"}},
+	}
+
+	setQuery := func(query string) {
+		httpRequest, err := http.NewRequest(http.MethodGet, "/guestexec?"+query, nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request = httpRequest
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		setQuery("command=/usr/bin/uptime")
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		namespace = &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}}
+		virtClient.EXPECT().CoreV1().DoAndReturn(func() interface{} {
+			return k8sfake.NewSimpleClientset(namespace).CoreV1()
+		}).AnyTimes()
+	})
+
+	It("should reject the request when the feature gate is disabled", func() {
+		newApp(nil, allowLabeledNamespaces).GuestExecRequestHandler(request, response)
+
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(statusErr.ErrStatus.Message).To(ContainSubstring("GuestExec feature gate not enabled"))
+	})
+
+	DescribeTable("should reject an invalid query", func(query string) {
+		setQuery(query)
+
+		newApp([]string{featuregate.GuestExecGate}, allowLabeledNamespaces).GuestExecRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	},
+		Entry("without command", ""),
+		Entry("with an empty command", "command="),
+		Entry("with an invalid timeout", "command=/usr/bin/uptime&timeoutSeconds=soon"),
+		Entry("with a negative timeout", "command=/usr/bin/uptime&timeoutSeconds=-1"),
+	)
+
+	DescribeTable("should forbid running commands", func(guestExec *v1.GuestExecConfiguration, namespaceLabels map[string]string) {
+		namespace.Labels = namespaceLabels
+
+		newApp([]string{featuregate.GuestExecGate}, guestExec).GuestExecRequestHandler(request, response)
+
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusForbidden)
+		Expect(statusErr.ErrStatus.Message).To(ContainSubstring("guestExec configuration"))
+	},
+		Entry("without guestExec configuration", nil, map[string]string{allowedLabel: "true"}),
+		Entry("without namespace selector", &v1.GuestExecConfiguration{}, map[string]string{allowedLabel: "true"}),
+		Entry("in a namespace not matching the selector", allowLabeledNamespaces, nil),
+	)
+
+	DescribeTable("should reject in an allowed namespace", func(vmi *v1.VirtualMachineInstance, expectedMessage string) {
+		namespace.Labels = map[string]string{allowedLabel: "true"}
+		vmiClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vmi, nil)
+
+		newApp([]string{featuregate.GuestExecGate}, allowLabeledNamespaces).GuestExecRequestHandler(request, response)
+
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		Expect(statusErr.ErrStatus.Message).To(ContainSubstring(expectedMessage))
+	},
+		Entry("a VMI which is not running", libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+		), vmiNotRunning),
+		Entry("a VMI without guest agent", func() *v1.VirtualMachineInstance {
+			vmi := libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))
+			vmi.Status.Phase = v1.Running
+			return vmi
+		}(), vmiGuestAgentErr),
+	)
+})
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const (
+	guestUserParam = "user"
+
+	// guestFileMaxBodySize leaves room for the base64 encoding of the content and the path
+	guestFileMaxBodySize = v1.GuestFileMaxSize/3*4 + 64*1024
+
+	guestFileWriteGateDisabled = "GuestExec feature gate not enabled: Unable to write files in the guest."
+)
+
+// GuestFileReadRequestHandler reads a small file in the guest of a running VMI through the guest agent.
+// virt-handler records the access in an event of the VMI, with the requesting user.
+func (app *SubresourceAPIApp) GuestFileReadRequestHandler(request *restful.Request, response *restful.Response) {
+	path := request.QueryParameter(definitions.GuestFilePathParamName)
+	if statusErr := validateGuestFilePath(path); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return guestFileURI(vmi, conn, url.Values{
+			definitions.GuestFilePathParamName: []string{path},
+			guestUserParam:                     []string{request.HeaderParameter(userHeader)},
+		})
+	}
+	_, uri, conn, statusErr := app.prepareConnection(request, validateVMIGuestAgentConnected, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	resp, err := conn.Get(uri, restful.MIME_JSON)
+	if err != nil {
+		log.Log.Errorf(getRequestErrFmt, err.Error())
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	file := &v1.VirtualMachineInstanceGuestFile{}
+	if err := json.Unmarshal([]byte(resp), file); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	response.WriteEntity(file)
+}
+
+// GuestFileWriteRequestHandler creates or truncates a small file in the guest of a running VMI and writes
+// the provided content to it through the guest agent. As writing a file is as powerful as running a command
+// in the guest, it is subject to the GuestExec feature gate and to the namespaces allowed by the guestExec
+// configuration. virt-handler records the access in an event of the VMI, with the requesting user.
+func (app *SubresourceAPIApp) GuestFileWriteRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestExecEnabled() {
+		writeError(errors.NewBadRequest(guestFileWriteGateDisabled), response)
+		return
+	}
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	if statusErr := app.validateGuestExecNamespace(namespace, "guestfile"); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a guest file is required"), response)
+		return
+	}
+	defer request.Request.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(request.Request.Body, guestFileMaxBodySize+1))
+	if err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if len(body) > guestFileMaxBodySize {
+		writeError(errors.NewRequestEntityTooLargeError(fmt.Sprintf("guest file content is limited to %d bytes", v1.GuestFileMaxSize)), response)
+		return
+	}
+
+	file := &v1.VirtualMachineInstanceGuestFile{}
+	if err := json.Unmarshal(body, file); err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if statusErr := validateGuestFilePath(file.Path); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if len(file.Content) > v1.GuestFileMaxSize {
+		writeError(errors.NewRequestEntityTooLargeError(fmt.Sprintf("guest file content is limited to %d bytes", v1.GuestFileMaxSize)), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return guestFileURI(vmi, conn, url.Values{
+			guestUserParam: []string{request.HeaderParameter(userHeader)},
+		})
+	}
+	_, uri, conn, statusErr := app.prepareConnection(request, validateVMIGuestAgentConnected, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if err := conn.Put(uri, io.NopCloser(bytes.NewReader(body))); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+}
+
+func guestFileURI(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn, query url.Values) (string, error) {
+	uri, err := conn.GuestFileURI(vmi)
+	if err != nil {
+		return "", err
+	}
+	return uri + "?" + query.Encode(), nil
+}
+
+func validateGuestFilePath(path string) *errors.StatusError {
+	if path == "" {
+		return errors.NewBadRequest("the path of the guest file is required")
+	}
+	// the path is interpreted by the guest, only reject what is not absolute on any guest OS
+	if !filepath.IsAbs(path) && !isWindowsAbsPath(path) {
+		return errors.NewBadRequest(fmt.Sprintf("the path of the guest file must be absolute, got %q", path))
+	}
+	return nil
+}
+
+func isWindowsAbsPath(path string) bool {
+	return len(path) > 2 && path[1] == ':' && (path[2] == '\\' || path[2] == '/')
+}
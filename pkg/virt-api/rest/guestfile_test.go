@@ -0,0 +1,216 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Guest file Subresource API", func() {
+	const allowedLabel = "guestexec.example.com/allowed"
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		virtClient *kubecli.MockKubevirtClient
+		vmiClient  *kubecli.MockVirtualMachineInstanceInterface
+		namespace  *k8sv1.Namespace
+		app        *SubresourceAPIApp
+	)
+
+	newApp := func(featureGates []string, guestExec *v1.GuestExecConfiguration) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			GuestExec:              guestExec,
+		})
+		return NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	allowLabeledNamespaces := &v1.GuestExecConfiguration{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{allowedLabel: "true"}},
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		namespace = &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   metav1.NamespaceDefault,
+			Labels: map[string]string{allowedLabel: "true"},
+		}}
+		virtClient.EXPECT().CoreV1().DoAndReturn(func() interface{} {
+			return k8sfake.NewSimpleClientset(namespace).CoreV1()
+		}).AnyTimes()
+
+		app = newApp([]string{featuregate.GuestExecGate}, allowLabeledNamespaces)
+	})
+
+	newRunningVMI := func() *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi.Status.Phase = v1.Running
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceAgentConnected,
+			Status: k8sv1.ConditionTrue,
+		}}
+		return vmi
+	}
+
+	setReadPath := func(path string) {
+		httpRequest, err := http.NewRequest(http.MethodGet, "/guestfile?"+definitions.GuestFilePathParamName+"="+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request = httpRequest
+	}
+
+	setWriteBody := func(file *v1.VirtualMachineInstanceGuestFile) {
+		body, err := json.Marshal(file)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	Context("reading a file", func() {
+		DescribeTable("should reject an invalid path", func(path string) {
+			setReadPath(path)
+
+			app.GuestFileReadRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("when empty", ""),
+			Entry("when relative", "etc/hostname"),
+		)
+
+		DescribeTable("should reject", func(vmi *v1.VirtualMachineInstance, expectedMessage string) {
+			setReadPath("/etc/hostname")
+			vmiClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vmi, nil)
+
+			app.GuestFileReadRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring(expectedMessage))
+		},
+			Entry("a VMI which is not running", func() *v1.VirtualMachineInstance {
+				vmi := newRunningVMI()
+				vmi.Status.Phase = v1.Scheduled
+				return vmi
+			}(), vmiNotRunning),
+			Entry("a VMI without guest agent", func() *v1.VirtualMachineInstance {
+				vmi := newRunningVMI()
+				vmi.Status.Conditions = nil
+				return vmi
+			}(), vmiGuestAgentErr),
+		)
+	})
+
+	Context("writing a file", func() {
+		It("should reject the request when the GuestExec feature gate is disabled", func() {
+			setWriteBody(&v1.VirtualMachineInstanceGuestFile{Path: "/etc/motd", Content: []byte("hello")})
+
+			newApp(nil, allowLabeledNamespaces).GuestFileWriteRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring("GuestExec feature gate not enabled"))
+		})
+
+		DescribeTable("should forbid writing files", func(guestExec *v1.GuestExecConfiguration, namespaceLabels map[string]string) {
+			setWriteBody(&v1.VirtualMachineInstanceGuestFile{Path: "/etc/motd", Content: []byte("hello")})
+			namespace.Labels = namespaceLabels
+
+			newApp([]string{featuregate.GuestExecGate}, guestExec).GuestFileWriteRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusForbidden)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring("guestExec configuration"))
+		},
+			Entry("without guestExec configuration", nil, map[string]string{allowedLabel: "true"}),
+			Entry("in a namespace not matching the selector", allowLabeledNamespaces, nil),
+		)
+
+		It("should reject a request without body", func() {
+			app.GuestFileWriteRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should reject a relative path", func() {
+			setWriteBody(&v1.VirtualMachineInstanceGuestFile{Path: "motd", Content: []byte("hello")})
+
+			app.GuestFileWriteRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring("must be absolute"))
+		})
+
+		It("should reject a content larger than the limit", func() {
+			setWriteBody(&v1.VirtualMachineInstanceGuestFile{Path: "/etc/motd", Content: make([]byte, v1.GuestFileMaxSize+1)})
+
+			app.GuestFileWriteRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusRequestEntityTooLarge)
+		})
+
+		It("should reject a VMI without guest agent", func() {
+			setWriteBody(&v1.VirtualMachineInstanceGuestFile{Path: "/etc/motd", Content: []byte("hello")})
+			vmi := newRunningVMI()
+			vmi.Status.Conditions = nil
+			vmiClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vmi, nil)
+
+			app.GuestFileWriteRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring(vmiGuestAgentErr))
+		})
+	})
+
+	DescribeTable("should accept the absolute path", func(path string) {
+		Expect(validateGuestFilePath(path)).To(BeNil())
+	},
+		Entry("of a linux guest", "/etc/motd"),
+		Entry("of a windows guest", `C:\Windows\Temp\config.ini`),
+	)
+})
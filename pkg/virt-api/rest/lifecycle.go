@@ -38,11 +38,14 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 )
 
 func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
+	span := startRequestSpan(request, "StartVM")
+	defer endRequestSpan(span, response)
 
 	vm, statusErr := app.fetchVirtualMachine(name, namespace)
 	if statusErr != nil {
@@ -78,6 +81,9 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 	if startPaused {
 		startChangeRequestData[v1.StartRequestDataPausedKey] = v1.StartRequestDataPausedTrue
 	}
+	if traceParent := span.TraceParent(); traceParent != "" {
+		startChangeRequestData[v1.StateChangeRequestDataTraceParentKey] = traceParent
+	}
 
 	var patchErr error
 
@@ -168,6 +174,8 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
+	span := startRequestSpan(request, "StopVM")
+	defer endRequestSpan(span, response)
 
 	bodyStruct := &v1.StopOptions{}
 	if request.Request.Body != nil {
@@ -220,7 +228,7 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 			return
 		}
 		// same behavior as RunStrategyManual
-		patchErr, err = app.patchVMStatusStopped(vmi, vm, response, bodyStruct)
+		patchErr, err = app.patchVMStatusStopped(vmi, vm, response, bodyStruct, span.TraceParent())
 		if err != nil {
 			return
 		}
@@ -231,7 +239,7 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 		}
 		// pass the buck and ask virt-controller to stop the VM. this way the
 		// VM will retain RunStrategy = manual
-		patchErr, err = app.patchVMStatusStopped(vmi, vm, response, bodyStruct)
+		patchErr, err = app.patchVMStatusStopped(vmi, vm, response, bodyStruct, span.TraceParent())
 		if err != nil {
 			return
 		}
@@ -523,6 +531,8 @@ func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Reque
 func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
+	span := startRequestSpan(request, "MigrateVM")
+	defer endRequestSpan(span, response)
 
 	bodyStruct := &v1.MigrateOptions{}
 	if request.Request.Body != nil {
@@ -549,7 +559,7 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 	}
 
 	createMigrationJob := func() *errors.StatusError {
-		_, err := app.virtCli.VirtualMachineInstanceMigration(namespace).Create(context.Background(), &v1.VirtualMachineInstanceMigration{
+		migration := &v1.VirtualMachineInstanceMigration{
 			ObjectMeta: metav1.ObjectMeta{
 				GenerateName: "kubevirt-migrate-vm-",
 			},
@@ -557,7 +567,9 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 				VMIName:           name,
 				AddedNodeSelector: bodyStruct.AddedNodeSelector,
 			},
-		}, metav1.CreateOptions{DryRun: bodyStruct.DryRun})
+		}
+		tracecontext.SetOnObject(migration, span.TraceParent())
+		_, err := app.virtCli.VirtualMachineInstanceMigration(namespace).Create(context.Background(), migration, metav1.CreateOptions{DryRun: bodyStruct.DryRun})
 		if err != nil {
 			return errors.NewInternalError(err)
 		}
@@ -572,6 +584,23 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 	response.WriteHeader(http.StatusAccepted)
 }
 
+// startRequestSpan starts the span of a start, stop or migrate request, as child of the trace context sent by the client
+func startRequestSpan(request *restful.Request, name string) *tracecontext.Span {
+	span := tracecontext.StartSpan(tracecontext.FromHeader(request.Request.Header), name)
+	span.SetAttribute("namespace", request.PathParameter("namespace"))
+	span.SetAttribute("name", request.PathParameter("name"))
+	return span
+}
+
+// endRequestSpan ends the span of a request, which failed if it was not accepted
+func endRequestSpan(span *tracecontext.Span, response *restful.Response) {
+	var err error
+	if response.StatusCode() != http.StatusAccepted {
+		err = fmt.Errorf("request failed with status %d", response.StatusCode())
+	}
+	span.End(err)
+}
+
 func (app *SubresourceAPIApp) patchVMITerminationGracePeriod(vmi *v1.VirtualMachineInstance, namespace string, gracePeriod int64, dryRun []string) (int64, error) {
 	var oldGracePeriod int64
 	patchSet := patch.New()
@@ -591,9 +620,12 @@ func (app *SubresourceAPIApp) patchVMITerminationGracePeriod(vmi *v1.VirtualMach
 	return oldGracePeriod, err
 }
 
-func (app *SubresourceAPIApp) patchVMStatusStopped(vmi *v1.VirtualMachineInstance, vm *v1.VirtualMachine, response *restful.Response, bodyStruct *v1.StopOptions) (error, error) {
-	patchBytes, err := getChangeRequestJson(vm,
-		v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest, UID: &vmi.UID})
+func (app *SubresourceAPIApp) patchVMStatusStopped(vmi *v1.VirtualMachineInstance, vm *v1.VirtualMachine, response *restful.Response, bodyStruct *v1.StopOptions, traceParent string) (error, error) {
+	stopRequest := v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest, UID: &vmi.UID}
+	if traceParent != "" {
+		stopRequest.Data = map[string]string{v1.StateChangeRequestDataTraceParentKey: traceParent}
+	}
+	patchBytes, err := getChangeRequestJson(vm, stopRequest)
 	if err != nil {
 		writeError(errors.NewInternalError(err), response)
 		return nil, err
@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	changeMediaNotEnabledError = "Enable DeclarativeHotplugVolumes feature gate and disable HotplugVolumes feature gate to use this API."
+)
+
+// InsertMediaVMRequestHandler handles the subresource for inserting or changing the media of a CD-ROM drive.
+func (app *SubresourceAPIApp) InsertMediaVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.changeMediaEnabled() {
+		writeError(errors.NewBadRequest(changeMediaNotEnabledError), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, InsertMediaOptions are expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.InsertMediaOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("InsertMediaOptions requires name to be set"), response)
+		return
+	}
+	if opts.VolumeSource == nil || (opts.VolumeSource.PersistentVolumeClaim == nil) == (opts.VolumeSource.DataVolume == nil) {
+		writeError(errors.NewBadRequest("InsertMediaOptions requires exactly one of persistentVolumeClaim or dataVolume as volumeSource"), response)
+		return
+	}
+
+	media := v1.Volume{Name: opts.Name}
+	if opts.VolumeSource.PersistentVolumeClaim != nil {
+		media.PersistentVolumeClaim = opts.VolumeSource.PersistentVolumeClaim.DeepCopy()
+		media.PersistentVolumeClaim.Hotpluggable = true
+	} else {
+		media.DataVolume = opts.VolumeSource.DataVolume.DeepCopy()
+		media.DataVolume.Hotpluggable = true
+	}
+
+	if err := app.vmMediaPatch(name, namespace, opts.Name, &media, opts.DryRun); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// EjectMediaVMRequestHandler handles the subresource for ejecting the media of a CD-ROM drive.
+func (app *SubresourceAPIApp) EjectMediaVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.changeMediaEnabled() {
+		writeError(errors.NewBadRequest(changeMediaNotEnabledError), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, EjectMediaOptions are expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.EjectMediaOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("EjectMediaOptions requires name to be set"), response)
+		return
+	}
+
+	if err := app.vmMediaPatch(name, namespace, opts.Name, nil, opts.DryRun); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// changeMediaEnabled returns true if the VM controller applies the volume
+// changes of the VM spec on the running VMI, which changes the media
+func (app *SubresourceAPIApp) changeMediaEnabled() bool {
+	return app.clusterConfig.DeclarativeHotplugVolumesEnabled() && !app.clusterConfig.HotplugVolumesEnabled()
+}
+
+// vmMediaPatch replaces the volume of the CD-ROM disk with the given media
+// volume, or removes it if the media is nil.
+func (app *SubresourceAPIApp) vmMediaPatch(name, namespace, diskName string, media *v1.Volume, dryRun []string) *errors.StatusError {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return statErr
+	}
+	if vm.Spec.Template == nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM has no template"))
+	}
+
+	volumes, err := changeMedia(&vm.Spec.Template.Spec, diskName, media)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	const volumesPath = "/spec/template/spec/volumes"
+	patchSet := patch.New(patch.WithTest(volumesPath, vm.Spec.Template.Spec.Volumes))
+	if len(vm.Spec.Template.Spec.Volumes) > 0 {
+		patchSet.AddOption(patch.WithReplace(volumesPath, volumes))
+	} else {
+		patchSet.AddOption(patch.WithAdd(volumesPath, volumes))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	if _, err := app.virtCli.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRun}); err != nil {
+		log.Log.Object(vm).Errorf("unable to patch vm: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vm: %v", err))
+	}
+	return nil
+}
+
+func changeMedia(spec *v1.VirtualMachineInstanceSpec, diskName string, media *v1.Volume) ([]v1.Volume, error) {
+	disk, exists := storagetypes.GetDisksByName(spec)[diskName]
+	if !exists {
+		return nil, fmt.Errorf("Unable to change the media of disk [%s] because it does not exist", diskName)
+	}
+	if disk.CDRom == nil {
+		return nil, fmt.Errorf("Unable to change the media of disk [%s] because it is not a CD-ROM", diskName)
+	}
+
+	volumes := []v1.Volume{}
+	found := false
+	for _, volume := range spec.Volumes {
+		if volume.Name == diskName {
+			if !storagetypes.IsDeclarativeHotplugVolume(&volume) {
+				return nil, fmt.Errorf("Unable to change the media of disk [%s] because its volume is not hotpluggable", diskName)
+			}
+			found = true
+			// the media is changed in place
+			if media != nil {
+				volumes = append(volumes, *media)
+			}
+			continue
+		}
+		if media != nil && volumeSourceExists(volume, volumeSourceName(&v1.HotplugVolumeSource{
+			PersistentVolumeClaim: media.PersistentVolumeClaim,
+			DataVolume:            media.DataVolume,
+		})) {
+			return nil, fmt.Errorf("Unable to insert the media into disk [%s] because it is already used by volume [%s]", diskName, volume.Name)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	if media == nil && !found {
+		return nil, fmt.Errorf("Unable to eject the media of disk [%s] because it is empty", diskName)
+	}
+	if media != nil && !found {
+		volumes = append(volumes, *media)
+	}
+	return volumes, nil
+}
@@ -0,0 +1,262 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Insert/Eject Media Subresource api", func() {
+	const (
+		cdromName = "cdrom"
+		isoPVC    = "installer-iso"
+	)
+
+	var (
+		request  *restful.Request
+		response *restful.Response
+		recorder *httptest.ResponseRecorder
+		vmClient *kubecli.MockVirtualMachineInterface
+		app      *SubresourceAPIApp
+		vm       *v1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+
+		vm = libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithContainerDisk("disk0", "image"),
+			libvmi.WithEmptyCDRom(v1.DiskBusSATA, cdromName),
+		))
+		vmClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vm, nil).AnyTimes()
+	})
+
+	withMedia := func(claimName string) {
+		vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: cdromName,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+					Hotpluggable:                      true,
+				},
+			},
+		})
+	}
+
+	setBody := func(opts interface{}) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+	}
+
+	expectPatchedVolumes := func(dryRun []string, matcher gomegatypes.GomegaMatcher) {
+		vmClient.EXPECT().Patch(gomock.Any(), testVMName, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{DryRun: dryRun}).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				var ops []patch.PatchOperation
+				Expect(json.Unmarshal(data, &ops)).To(Succeed())
+				Expect(ops).To(HaveLen(2))
+				Expect(ops[0].Op).To(Equal(patch.PatchTestOp))
+				Expect(ops[1].Path).To(Equal("/spec/template/spec/volumes"))
+				var volumes []v1.Volume
+				volumesBytes, err := json.Marshal(ops[1].Value)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(json.Unmarshal(volumesBytes, &volumes)).To(Succeed())
+				Expect(volumes).To(matcher)
+				return vm, nil
+			})
+	}
+
+	claimNameOf := func(volume v1.Volume) string {
+		if volume.PersistentVolumeClaim == nil || !volume.PersistentVolumeClaim.Hotpluggable {
+			return ""
+		}
+		return volume.PersistentVolumeClaim.ClaimName
+	}
+
+	Context("insert media", func() {
+		newInsertMediaOptions := func(claimName string) *v1.InsertMediaOptions {
+			return &v1.InsertMediaOptions{
+				Name: cdromName,
+				VolumeSource: &v1.HotplugVolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+					},
+				},
+			}
+		}
+
+		It("should insert the media into an empty CD-ROM", func() {
+			setBody(newInsertMediaOptions(isoPVC))
+			expectPatchedVolumes(nil, ContainElement(WithTransform(claimNameOf, Equal(isoPVC))))
+
+			app.InsertMediaVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should change the media of a CD-ROM in place", func() {
+			withMedia("old-iso")
+			opts := newInsertMediaOptions(isoPVC)
+			opts.DryRun = []string{metav1.DryRunAll}
+			setBody(opts)
+			expectPatchedVolumes(opts.DryRun, SatisfyAll(
+				HaveLen(2),
+				HaveEach(WithTransform(func(volume v1.Volume) string { return volume.Name }, Not(BeEmpty()))),
+				WithTransform(func(volumes []v1.Volume) string { return claimNameOf(volumes[1]) }, Equal(isoPVC)),
+			))
+
+			app.InsertMediaVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		DescribeTable("should reject", func(opts *v1.InsertMediaOptions, expectedCode int) {
+			setBody(opts)
+
+			app.InsertMediaVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, expectedCode)
+		},
+			Entry("without a name", &v1.InsertMediaOptions{VolumeSource: &v1.HotplugVolumeSource{}}, http.StatusBadRequest),
+			Entry("without a volume source", &v1.InsertMediaOptions{Name: cdromName}, http.StatusBadRequest),
+			Entry("a disk which is not a CD-ROM", &v1.InsertMediaOptions{
+				Name: "disk0",
+				VolumeSource: &v1.HotplugVolumeSource{
+					DataVolume: &v1.DataVolumeSource{Name: isoPVC},
+				},
+			}, http.StatusConflict),
+			Entry("an unknown disk", &v1.InsertMediaOptions{
+				Name: "unknown",
+				VolumeSource: &v1.HotplugVolumeSource{
+					DataVolume: &v1.DataVolumeSource{Name: isoPVC},
+				},
+			}, http.StatusConflict),
+		)
+
+		It("should reject a media used by another volume", func() {
+			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+				Name: "data",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: isoPVC},
+					},
+				},
+			})
+			setBody(newInsertMediaOptions(isoPVC))
+
+			app.InsertMediaVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should reject changing the media of a CD-ROM with a volume which is not hotpluggable", func() {
+			withMedia("old-iso")
+			vm.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.Hotpluggable = false
+			setBody(newInsertMediaOptions(isoPVC))
+
+			app.InsertMediaVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
+	Context("eject media", func() {
+		It("should eject the media of a CD-ROM", func() {
+			withMedia(isoPVC)
+			setBody(&v1.EjectMediaOptions{Name: cdromName})
+			expectPatchedVolumes(nil, SatisfyAll(
+				HaveLen(1),
+				Not(ContainElement(WithTransform(claimNameOf, Equal(isoPVC)))),
+			))
+
+			app.EjectMediaVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should reject ejecting the media of an empty CD-ROM", func() {
+			setBody(&v1.EjectMediaOptions{Name: cdromName})
+
+			app.EjectMediaVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should reject a request without a name", func() {
+			setBody(&v1.EjectMediaOptions{})
+
+			app.EjectMediaVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+	})
+
+	It("should fail if the media can not be changed declaratively", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.HotplugVolumesGate},
+			},
+		})
+		app.clusterConfig = config
+		setBody(&v1.EjectMediaOptions{Name: cdromName})
+
+		app.EjectMediaVMRequestHandler(request, response)
+
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(statusErr.ErrStatus.Message).To(Equal(changeMediaNotEnabledError))
+	})
+})
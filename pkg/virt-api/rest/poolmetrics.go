@@ -0,0 +1,233 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	MetricParamName = "metric"
+
+	poolMetricsQueryTimeout = 10 * time.Second
+)
+
+// ExternalMetricsGroupVersion is the API served for the HorizontalPodAutoscaler by the pool metrics adapter
+var ExternalMetricsGroupVersion = schema.GroupVersion{Group: "external.metrics.k8s.io", Version: "v1beta1"}
+
+// poolMetrics maps the external metrics of the VirtualMachinePools to the recording rules they are read from
+var poolMetrics = map[string]string{
+	"vmpool_cpu_usage":          "vmpool:kubevirt_vmi_cpu_usage_seconds:rate5m",
+	"vmpool_memory_used_bytes":  "vmpool:kubevirt_vmi_memory_used_bytes:sum",
+	"vmpool_guest_queue_length": "vmpool:kubevirt_vmi_guest_queue_length:sum",
+}
+
+// externalMetricValueList and externalMetricValue are the wire format of the external metrics API,
+// see k8s.io/metrics/pkg/apis/external_metrics/v1beta1
+type externalMetricValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []externalMetricValue `json:"items"`
+}
+
+type externalMetricValue struct {
+	MetricName   string            `json:"metricName"`
+	MetricLabels map[string]string `json:"metricLabels"`
+	Timestamp    metav1.Time       `json:"timestamp"`
+	Value        resource.Quantity `json:"value"`
+}
+
+// PoolMetricsAPIApp serves the guest metrics of the VirtualMachinePools, as aggregated by the vmpool
+// recording rules in Prometheus, as external metrics.
+type PoolMetricsAPIApp struct {
+	clusterConfig *virtconfig.ClusterConfig
+}
+
+func NewPoolMetricsAPIApp(clusterConfig *virtconfig.ClusterConfig) *PoolMetricsAPIApp {
+	return &PoolMetricsAPIApp{clusterConfig: clusterConfig}
+}
+
+// APIResourceListHandler lists the external metrics, so that the API can be discovered through the aggregator
+func (app *PoolMetricsAPIApp) APIResourceListHandler(_ *restful.Request, response *restful.Response) {
+	list := &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: ExternalMetricsGroupVersion.String(),
+	}
+	for _, name := range sortedPoolMetrics() {
+		list.APIResources = append(list.APIResources, metav1.APIResource{
+			Name:       name,
+			Namespaced: true,
+			Kind:       "ExternalMetricValueList",
+			Verbs:      metav1.Verbs{"get"},
+		})
+	}
+	if err := response.WriteAsJson(list); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+// ExternalMetricHandler returns the value of an external metric for the VirtualMachinePools of a namespace
+// matching the label selector of the request, e.g. vmpool=my-pool.
+func (app *PoolMetricsAPIApp) ExternalMetricHandler(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	metricName := request.PathParameter(MetricParamName)
+
+	adapterConfig := app.clusterConfig.GetPoolMetricsAdapterConfiguration()
+	if adapterConfig == nil || adapterConfig.PrometheusURL == "" {
+		writeError(errors.NewServiceUnavailable("the pool metrics adapter is not configured"), response)
+		return
+	}
+
+	recordingRule, exists := poolMetrics[metricName]
+	if !exists {
+		writeError(errors.NewNotFound(ExternalMetricsGroupVersion.WithResource(metricName).GroupResource(), ""), response)
+		return
+	}
+
+	selector, err := labels.Parse(request.QueryParameter("labelSelector"))
+	if err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf("invalid label selector: %v", err)), response)
+		return
+	}
+	query, err := poolMetricQuery(recordingRule, namespace, selector)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(request.Request.Context(), poolMetricsQueryTimeout)
+	defer cancel()
+	samples, err := queryPrometheus(ctx, adapterConfig.PrometheusURL, query)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to query %s for the external metric %s", adapterConfig.PrometheusURL, metricName)
+		writeError(errors.NewInternalError(fmt.Errorf("failed to query the metric %s: %v", metricName, err)), response)
+		return
+	}
+
+	list := &externalMetricValueList{
+		TypeMeta: metav1.TypeMeta{Kind: "ExternalMetricValueList", APIVersion: ExternalMetricsGroupVersion.String()},
+		Items:    []externalMetricValue{},
+	}
+	for _, sample := range samples {
+		metricLabels := map[string]string{}
+		for name, value := range sample.Metric {
+			if name != model.MetricNameLabel {
+				metricLabels[string(name)] = string(value)
+			}
+		}
+		list.Items = append(list.Items, externalMetricValue{
+			MetricName:   metricName,
+			MetricLabels: metricLabels,
+			Timestamp:    metav1.NewTime(sample.Timestamp.Time()),
+			Value:        *resource.NewMilliQuantity(int64(float64(sample.Value)*1000), resource.DecimalSI),
+		})
+	}
+	if err := response.WriteHeaderAndJson(http.StatusOK, list, restful.MIME_JSON); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+// poolMetricQuery selects the series of the recording rule of the namespace matching the selector
+func poolMetricQuery(recordingRule, namespace string, selector labels.Selector) (string, error) {
+	matchers := []string{fmt.Sprintf("namespace=%q", namespace)}
+	requirements, _ := selector.Requirements()
+	for _, requirement := range requirements {
+		key := requirement.Key()
+		if !model.LabelName(key).IsValidLegacy() {
+			return "", fmt.Errorf("label %q is not a valid metric label", key)
+		}
+		values := requirement.Values().List()
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals:
+			matchers = append(matchers, fmt.Sprintf("%s=%q", key, values[0]))
+		case selection.NotEquals:
+			matchers = append(matchers, fmt.Sprintf("%s!=%q", key, values[0]))
+		case selection.In:
+			matchers = append(matchers, fmt.Sprintf("%s=~%q", key, valuesRegexp(values)))
+		case selection.NotIn:
+			matchers = append(matchers, fmt.Sprintf("%s!~%q", key, valuesRegexp(values)))
+		case selection.Exists:
+			matchers = append(matchers, fmt.Sprintf("%s!=\"\"", key))
+		case selection.DoesNotExist:
+			matchers = append(matchers, fmt.Sprintf("%s=\"\"", key))
+		default:
+			return "", fmt.Errorf("operator %s of label %q is not supported", requirement.Operator(), key)
+		}
+	}
+	return fmt.Sprintf("%s{%s}", recordingRule, strings.Join(matchers, ",")), nil
+}
+
+func valuesRegexp(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, regexp.QuoteMeta(value))
+	}
+	return strings.Join(quoted, "|")
+}
+
+func queryPrometheus(ctx context.Context, prometheusURL, query string) (model.Vector, error) {
+	client, err := promapi.NewClient(promapi.Config{Address: prometheusURL})
+	if err != nil {
+		return nil, err
+	}
+	result, warnings, err := promv1.NewAPI(client).Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(warnings) > 0 {
+		log.Log.V(4).Infof("Query %s returned warnings: %v", query, warnings)
+	}
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type %s", result.Type())
+	}
+	return vector, nil
+}
+
+func sortedPoolMetrics() []string {
+	names := make([]string, 0, len(poolMetrics))
+	for name := range poolMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,158 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Pool metrics adapter", func() {
+	var (
+		request      *restful.Request
+		response     *restful.Response
+		recorder     *httptest.ResponseRecorder
+		prometheus   *httptest.Server
+		queries      []string
+		queryResults string
+	)
+
+	newApp := func(adapterConfig *v1.PoolMetricsAdapterConfiguration) *PoolMetricsAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			PoolMetricsAdapter: adapterConfig,
+		})
+		return NewPoolMetricsAPIApp(config)
+	}
+
+	setRequest := func(metric, labelSelector string) {
+		httpRequest, err := http.NewRequest(http.MethodGet, "/?labelSelector="+labelSelector, nil)
+		Expect(err).ToNot(HaveOccurred())
+		request = restful.NewRequest(httpRequest)
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		request.PathParameters()[MetricParamName] = metric
+	}
+
+	BeforeEach(func() {
+		queries = nil
+		queryResults = `[{"metric":{"__name__":"vmpool:kubevirt_vmi_cpu_usage_seconds:rate5m","namespace":"default","vmpool":"my-pool"},"value":[1700000000,"1.5"]}]`
+		prometheus = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/v1/query"))
+			Expect(r.ParseForm()).To(Succeed())
+			queries = append(queries, r.Form.Get("query"))
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":` + queryResults + `}}`))
+			Expect(err).ToNot(HaveOccurred())
+		}))
+		DeferCleanup(prometheus.Close)
+
+		setRequest("vmpool_cpu_usage", "vmpool%3Dmy-pool")
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+	})
+
+	It("should return the metric of the pool", func() {
+		newApp(&v1.PoolMetricsAdapterConfiguration{PrometheusURL: prometheus.URL}).ExternalMetricHandler(request, response)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(queries).To(ConsistOf(`vmpool:kubevirt_vmi_cpu_usage_seconds:rate5m{namespace="default",vmpool="my-pool"}`))
+
+		list := &externalMetricValueList{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), list)).To(Succeed())
+		Expect(list.Kind).To(Equal("ExternalMetricValueList"))
+		Expect(list.APIVersion).To(Equal("external.metrics.k8s.io/v1beta1"))
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].MetricName).To(Equal("vmpool_cpu_usage"))
+		Expect(list.Items[0].MetricLabels).To(Equal(map[string]string{"namespace": "default", "vmpool": "my-pool"}))
+		Expect(list.Items[0].Value.Cmp(resource.MustParse("1500m"))).To(BeZero())
+	})
+
+	It("should return an empty list when no pool matches", func() {
+		queryResults = `[]`
+
+		newApp(&v1.PoolMetricsAdapterConfiguration{PrometheusURL: prometheus.URL}).ExternalMetricHandler(request, response)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		list := &externalMetricValueList{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), list)).To(Succeed())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("should be unavailable when the adapter is not configured", func() {
+		newApp(nil).ExternalMetricHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusServiceUnavailable)
+		Expect(queries).To(BeEmpty())
+	})
+
+	It("should not find an unknown metric", func() {
+		setRequest("kubevirt_vmi_cpu_usage_seconds_total", "vmpool%3Dmy-pool")
+
+		newApp(&v1.PoolMetricsAdapterConfiguration{PrometheusURL: prometheus.URL}).ExternalMetricHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+		Expect(queries).To(BeEmpty())
+	})
+
+	It("should reject a label which is not a metric label", func() {
+		setRequest("vmpool_cpu_usage", "kubevirt.io%2Fvm-pool%3Dmy-pool")
+
+		newApp(&v1.PoolMetricsAdapterConfiguration{PrometheusURL: prometheus.URL}).ExternalMetricHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(queries).To(BeEmpty())
+	})
+
+	It("should list the metrics for discovery", func() {
+		newApp(nil).APIResourceListHandler(request, response)
+
+		list := &metav1.APIResourceList{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), list)).To(Succeed())
+		Expect(list.GroupVersion).To(Equal("external.metrics.k8s.io/v1beta1"))
+		Expect(list.APIResources).To(HaveLen(3))
+		Expect(list.APIResources[0].Name).To(Equal("vmpool_cpu_usage"))
+	})
+
+	DescribeTable("should translate the label selector to matchers", func(selector, expectedQuery string) {
+		parsed, err := labels.Parse(selector)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(poolMetricQuery("rule", "default", parsed)).To(Equal(expectedQuery))
+	},
+		Entry("without selector", "", `rule{namespace="default"}`),
+		Entry("with equality", "vmpool=a", `rule{namespace="default",vmpool="a"}`),
+		Entry("with inequality", "vmpool!=a", `rule{namespace="default",vmpool!="a"}`),
+		Entry("with set", "vmpool in (b,a.c)", `rule{namespace="default",vmpool=~"a\\.c|b"}`),
+		Entry("with excluded set", "vmpool notin (a)", `rule{namespace="default",vmpool!~"a"}`),
+		Entry("with existence", "vmpool", `rule{namespace="default",vmpool!=""}`),
+		Entry("with absence", "!vmpool", `rule{namespace="default",vmpool=""}`),
+	)
+})
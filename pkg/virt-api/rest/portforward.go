@@ -33,6 +33,12 @@ import (
 
 func (app *SubresourceAPIApp) PortForwardRequestHandler(fetcher vmiFetcher) restful.RouteFunction {
 	return func(request *restful.Request, response *restful.Response) {
+		release, acquired := app.acquireConnection(request, response)
+		if !acquired {
+			return
+		}
+		defer release()
+
 		activeTunnelMetric := apimetrics.NewActivePortForwardTunnel(request.PathParameter("namespace"), request.PathParameter("name"))
 		defer activeTunnelMetric.Dec()
 
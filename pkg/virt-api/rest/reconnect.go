@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"kubevirt.io/client-go/log"
+)
+
+const reconnectInterval = 2 * time.Second
+
+// redialFunc dials the server again. retry is false when dialing can't succeed anymore,
+// e.g. when the VMI stopped.
+type redialFunc func() (conn net.Conn, retry bool, err error)
+
+// reconnectingConn is a server connection which survives virt-handler restarts: when the
+// connection breaks, the server is dialed again until the timeout expires and the stream
+// resumes on the new connection. The data in flight when the connection broke is lost.
+type reconnectingConn struct {
+	lock       sync.Mutex
+	conn       net.Conn
+	generation int
+
+	reconnectLock sync.Mutex
+	redial        redialFunc
+	timeout       time.Duration
+	interval      time.Duration
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newReconnectingConn(conn net.Conn, redial redialFunc, timeout time.Duration) *reconnectingConn {
+	return &reconnectingConn{
+		conn:     conn,
+		redial:   redial,
+		timeout:  timeout,
+		interval: reconnectInterval,
+		done:     make(chan struct{}),
+	}
+}
+
+func (c *reconnectingConn) current() (net.Conn, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.conn, c.generation
+}
+
+func (c *reconnectingConn) Read(p []byte) (int, error) {
+	for {
+		conn, generation := c.current()
+		n, err := conn.Read(p)
+		if err == nil || n > 0 {
+			return n, nil
+		}
+		if reconnectErr := c.reconnect(generation); reconnectErr != nil {
+			return n, err
+		}
+	}
+}
+
+func (c *reconnectingConn) Write(p []byte) (int, error) {
+	written := 0
+	for {
+		conn, generation := c.current()
+		n, err := conn.Write(p[written:])
+		written += n
+		if err == nil {
+			return written, nil
+		}
+		if reconnectErr := c.reconnect(generation); reconnectErr != nil {
+			return written, err
+		}
+	}
+}
+
+// reconnect replaces the connection of the given generation. Both stream directions notice
+// the broken connection, the second one finds it already replaced.
+func (c *reconnectingConn) reconnect(generation int) error {
+	c.reconnectLock.Lock()
+	defer c.reconnectLock.Unlock()
+
+	if _, currentGeneration := c.current(); currentGeneration != generation {
+		return nil
+	}
+
+	timeout := time.NewTimer(c.timeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return net.ErrClosed
+		default:
+		}
+
+		conn, retry, err := c.redial()
+		if err == nil {
+			c.lock.Lock()
+			c.conn.Close()
+			c.conn = conn
+			c.generation++
+			c.lock.Unlock()
+			log.Log.Info("reconnected the stream to virt-handler")
+
+			select {
+			case <-c.done:
+				conn.Close()
+				return net.ErrClosed
+			default:
+			}
+			return nil
+		}
+		if !retry {
+			return err
+		}
+		log.Log.Reason(err).V(3).Info("failed to reconnect the stream to virt-handler, retrying")
+
+		select {
+		case <-c.done:
+			return net.ErrClosed
+		case <-timeout.C:
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *reconnectingConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.conn.Close()
+}
+
+func (c *reconnectingConn) LocalAddr() net.Addr {
+	conn, _ := c.current()
+	return conn.LocalAddr()
+}
+
+func (c *reconnectingConn) RemoteAddr() net.Addr {
+	conn, _ := c.current()
+	return conn.RemoteAddr()
+}
+
+func (c *reconnectingConn) SetDeadline(t time.Time) error {
+	conn, _ := c.current()
+	return conn.SetDeadline(t)
+}
+
+func (c *reconnectingConn) SetReadDeadline(t time.Time) error {
+	conn, _ := c.current()
+	return conn.SetReadDeadline(t)
+}
+
+func (c *reconnectingConn) SetWriteDeadline(t time.Time) error {
+	conn, _ := c.current()
+	return conn.SetWriteDeadline(t)
+}
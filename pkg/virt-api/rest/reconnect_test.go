@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	goerrors "errors"
+	"io"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("reconnectingConn", func() {
+	const testTimeout = 5 * time.Second
+
+	var (
+		conn, serverPipe net.Conn
+		redials          chan net.Conn
+	)
+
+	BeforeEach(func() {
+		conn, serverPipe = net.Pipe()
+		redials = make(chan net.Conn, 1)
+	})
+
+	newConn := func(redial redialFunc) *reconnectingConn {
+		c := newReconnectingConn(conn, redial, testTimeout)
+		c.interval = 10 * time.Millisecond
+		DeferCleanup(c.Close)
+		return c
+	}
+
+	redialPipe := func() (net.Conn, bool, error) {
+		newConn, newServerPipe := net.Pipe()
+		redials <- newServerPipe
+		return newConn, false, nil
+	}
+
+	It("resumes reading on a new connection when the server connection breaks", func() {
+		c := newConn(redialPipe)
+		Expect(serverPipe.Close()).To(Succeed())
+
+		go func() {
+			defer GinkgoRecover()
+			var newServerPipe net.Conn
+			Eventually(redials).Should(Receive(&newServerPipe))
+			_, err := newServerPipe.Write([]byte("login:"))
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		buf := make([]byte, 6)
+		_, err := io.ReadFull(c, buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(buf)).To(Equal("login:"))
+	})
+
+	It("resumes writing on a new connection when the server connection breaks", func() {
+		c := newConn(redialPipe)
+		Expect(serverPipe.Close()).To(Succeed())
+
+		received := make(chan string, 1)
+		go func() {
+			defer GinkgoRecover()
+			var newServerPipe net.Conn
+			Eventually(redials).Should(Receive(&newServerPipe))
+			buf := make([]byte, 4)
+			_, err := io.ReadFull(newServerPipe, buf)
+			Expect(err).ToNot(HaveOccurred())
+			received <- string(buf)
+		}()
+
+		n, err := c.Write([]byte("root"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(4))
+		Eventually(received).Should(Receive(Equal("root")))
+	})
+
+	It("retries dialing until the server is back", func() {
+		attempts := 0
+		c := newConn(func() (net.Conn, bool, error) {
+			attempts++
+			if attempts < 3 {
+				return nil, true, goerrors.New("virt-handler is restarting")
+			}
+			return redialPipe()
+		})
+		Expect(serverPipe.Close()).To(Succeed())
+
+		Expect(c.reconnect(0)).To(Succeed())
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("returns the original error when dialing can't succeed anymore", func() {
+		c := newConn(func() (net.Conn, bool, error) {
+			return nil, false, goerrors.New("VMI is not running")
+		})
+		Expect(serverPipe.Close()).To(Succeed())
+
+		_, err := c.Read(make([]byte, 1))
+		Expect(err).To(MatchError(io.EOF))
+	})
+
+	It("stops reconnecting when it is closed", func() {
+		c := newConn(func() (net.Conn, bool, error) {
+			return nil, true, goerrors.New("virt-handler is restarting")
+		})
+		Expect(serverPipe.Close()).To(Succeed())
+
+		result := make(chan error, 1)
+		go func() {
+			_, err := c.Read(make([]byte, 1))
+			result <- err
+		}()
+		Expect(c.Close()).To(Succeed())
+		Eventually(result, testTimeout).Should(Receive(HaveOccurred()))
+	})
+
+	It("reconnects once when both stream directions notice the broken connection", func() {
+		c := newConn(redialPipe)
+		Expect(serverPipe.Close()).To(Succeed())
+
+		Expect(c.reconnect(0)).To(Succeed())
+		Expect(c.reconnect(0)).To(Succeed())
+		Expect(redials).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/revisionhistory"
+)
+
+func (app *SubresourceAPIApp) RollbackVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.VirtualMachineRevisionHistoryEnabled() {
+		writeError(errors.NewBadRequest("VirtualMachineRevisionHistory feature gate not enabled: Unable to roll back the VM."), response)
+		return
+	}
+
+	opts := &v1.RollbackOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		if err := decodeBody(request, opts); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+	if opts.Revision < 0 {
+		writeError(errors.NewBadRequest("revision must not be negative"), response)
+		return
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	ctx := request.Request.Context()
+	crs, err := app.virtCli.AppsV1().ControllerRevisions(namespace).List(ctx, k8smetav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.VirtualMachineRevisionHistoryLabel, vm.Name),
+	})
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	var revisions []*appsv1.ControllerRevision
+	for i := range crs.Items {
+		if revisionhistory.IsRevisionOf(&crs.Items[i], vm) {
+			revisions = append(revisions, &crs.Items[i])
+		}
+	}
+	revisionhistory.SortByRevision(revisions)
+
+	target, statusErr := findRollbackRevision(revisions, opts.Revision)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	spec, err := revisionhistory.Spec(target)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	// the power state and the history limit are not part of the revisions
+	spec.Running = vm.Spec.Running
+	spec.RunStrategy = vm.Spec.RunStrategy
+	spec.RevisionHistoryLimit = vm.Spec.RevisionHistoryLimit
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/metadata/generation", vm.Generation),
+		patch.WithReplace("/spec", spec),
+	).GeneratePayload()
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	_, err = app.virtCli.VirtualMachine(namespace).Patch(ctx, vm.Name, types.JSONPatchType, patchBytes, k8smetav1.PatchOptions{DryRun: opts.DryRun})
+	if err != nil {
+		log.Log.Object(vm).V(2).Reason(err).Info("Failed to roll back VM")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// findRollbackRevision returns the revision to roll back to, or the revision
+// preceding the current one when no revision is given
+func findRollbackRevision(revisions []*appsv1.ControllerRevision, revision int64) (*appsv1.ControllerRevision, *errors.StatusError) {
+	if revision == 0 {
+		if len(revisions) < 2 {
+			return nil, errors.NewBadRequest("the VirtualMachine has no previous revision")
+		}
+		return revisions[len(revisions)-2], nil
+	}
+	for _, cr := range revisions {
+		if cr.Revision == revision {
+			return cr, nil
+		}
+	}
+	return nil, errors.NewNotFound(appsv1.Resource("controllerrevision"), fmt.Sprintf("revision %d", revision))
+}
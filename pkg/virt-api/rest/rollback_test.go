@@ -0,0 +1,206 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/revisionhistory"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Rollback Subresource API", func() {
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		kubeClient *k8sfake.Clientset
+		vmClient   *kubecli.MockVirtualMachineInterface
+		app        *SubresourceAPIApp
+		vm         *v1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = k8sfake.NewSimpleClientset()
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().AppsV1().Return(kubeClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.VirtualMachineRevisionHistoryGate},
+			},
+		})
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+
+		vm = newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+		vm.UID = "vm-uid"
+		vm.Generation = 4
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
+			Spec: v1.VirtualMachineInstanceSpec{Hostname: "third"},
+		}
+		vmClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vm, nil).AnyTimes()
+	})
+
+	addRevision := func(hostname string, revision int64) {
+		spec := vm.Spec.DeepCopy()
+		spec.Template.Spec.Hostname = hostname
+		data, err := revisionhistory.Data(spec)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            revisionhistory.Name(vm.UID, data),
+				Namespace:       vm.Namespace,
+				Labels:          map[string]string{v1.VirtualMachineRevisionHistoryLabel: vm.Name},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)},
+			},
+			Data:     runtime.RawExtension{Raw: data},
+			Revision: revision,
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newRollbackBody := func(opts *v1.RollbackOptions) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+	}
+
+	expectPatchedHostname := func(hostname string, dryRun []string) {
+		vmClient.EXPECT().Patch(gomock.Any(), testVMName, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{DryRun: dryRun}).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				var ops []patch.PatchOperation
+				Expect(json.Unmarshal(data, &ops)).To(Succeed())
+				Expect(ops).To(HaveLen(2))
+				Expect(ops[0].Path).To(Equal("/metadata/generation"))
+				Expect(ops[0].Value).To(BeEquivalentTo(vm.Generation))
+				Expect(ops[1].Path).To(Equal("/spec"))
+				spec := &v1.VirtualMachineSpec{}
+				specBytes, err := json.Marshal(ops[1].Value)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(json.Unmarshal(specBytes, spec)).To(Succeed())
+				Expect(spec.Template.Spec.Hostname).To(Equal(hostname))
+				Expect(spec.RunStrategy).To(Equal(vm.Spec.RunStrategy))
+				return vm, nil
+			})
+	}
+
+	It("should roll back to the previous revision by default", func() {
+		addRevision("first", 1)
+		addRevision("second", 2)
+		addRevision("third", 3)
+		expectPatchedHostname("second", nil)
+
+		app.RollbackVMRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should roll back to the given revision", func() {
+		addRevision("first", 1)
+		addRevision("second", 2)
+		addRevision("third", 3)
+		newRollbackBody(&v1.RollbackOptions{Revision: 1, DryRun: []string{metav1.DryRunAll}})
+		expectPatchedHostname("first", []string{metav1.DryRunAll})
+
+		app.RollbackVMRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should fail without a previous revision", func() {
+		addRevision("third", 1)
+
+		app.RollbackVMRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	It("should fail with an unknown revision", func() {
+		addRevision("second", 1)
+		addRevision("third", 2)
+		newRollbackBody(&v1.RollbackOptions{Revision: 5})
+
+		app.RollbackVMRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+	})
+
+	It("should ignore the revisions of other VMs", func() {
+		addRevision("third", 2)
+		vm.UID = "other-uid"
+		addRevision("first", 1)
+		vm.UID = "vm-uid"
+
+		app.RollbackVMRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	It("should fail if the feature gate is disabled", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		app.clusterConfig = config
+
+		app.RollbackVMRequestHandler(request, response)
+
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(statusErr.ErrStatus.Message).To(ContainSubstring("VirtualMachineRevisionHistory feature gate not enabled"))
+	})
+
+	It("should keep the power state of the VM", func() {
+		vm.Spec.RunStrategy = pointer.P(v1.RunStrategyHalted)
+		addRevision("second", 1)
+		addRevision("third", 2)
+		expectPatchedHostname("second", nil)
+
+		app.RollbackVMRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+})
@@ -52,6 +52,10 @@ type Streamer struct {
 
 	streamToClient streamFunc
 	streamToServer streamFunc
+
+	// serverReconnectTimeout enables reconnecting to the server when the server connection
+	// breaks, e.g. on virt-handler restarts, for streams which can resume on a new connection
+	serverReconnectTimeout time.Duration
 }
 
 type DirectDialer struct {
@@ -99,6 +103,12 @@ func (s *Streamer) Handle(request *restful.Request, response *restful.Response)
 		return statusErr
 	}
 
+	if s.serverReconnectTimeout > 0 {
+		serverConn = newReconnectingConn(serverConn, func() (net.Conn, bool, error) {
+			return s.dialer.redialUnderlying(namespace, name)
+		}, s.serverReconnectTimeout)
+	}
+
 	clientConn, err := clientConnectionUpgrade(request, response)
 	if err != nil {
 		writeError(errors.NewBadRequest(err.Error()), response)
@@ -199,6 +209,21 @@ func (d *DirectDialer) DialUnderlying(namespace, name string) (net.Conn, *errors
 	return d.dial.DialUnderlying(vmi)
 }
 
+// redialUnderlying dials the VMI again. Dialing is not retried once the VMI can't be fetched or
+// is no longer valid, e.g. when it stopped.
+func (d *DirectDialer) redialUnderlying(namespace, name string) (net.Conn, bool, error) {
+	vmi, statusErr := d.fetchAndValidateVMI(namespace, name)
+	if statusErr != nil {
+		return nil, false, statusErr
+	}
+
+	conn, statusErr := d.dial.DialUnderlying(vmi)
+	if statusErr != nil {
+		return nil, true, statusErr
+	}
+	return conn, false, nil
+}
+
 func (d *DirectDialer) fetchAndValidateVMI(namespace, name string) (*v1.VirtualMachineInstance, *errors.StatusError) {
 	vmi, err := d.fetchVMI(namespace, name)
 	if err != nil {
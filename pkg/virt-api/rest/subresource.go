@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/emicklei/go-restful/v3"
@@ -40,6 +41,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/expand"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
+	"kubevirt.io/kubevirt/pkg/instancetype/infer"
 	preferenceFind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -65,6 +67,10 @@ type instancetypeVMExpander interface {
 	Expand(vm *v1.VirtualMachine) (*v1.VirtualMachine, error)
 }
 
+type instancetypeVMInferrer interface {
+	Infer(vm *v1.VirtualMachine) error
+}
+
 type SubresourceAPIApp struct {
 	virtCli                 kubecli.KubevirtClient
 	consoleServerPort       int
@@ -72,19 +78,23 @@ type SubresourceAPIApp struct {
 	handlerTLSConfiguration *tls.Config
 	clusterConfig           *virtconfig.ClusterConfig
 	instancetypeExpander    instancetypeVMExpander
+	instancetypeInferrer    instancetypeVMInferrer
 	handlerHttpClient       *http.Client
+	connectionLimiter       *connectionLimiter
 }
 
 func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig) *SubresourceAPIApp {
 	// When this method is called from tools/openapispec.go when running 'make generate',
 	// the virtCli is nil, and accessing GeneratedKubeVirtClient() would cause nil dereference.
 	var instancetypeExpander instancetypeVMExpander
+	var instancetypeInferrer instancetypeVMInferrer
 	if virtCli != nil {
 		instancetypeExpander = expand.New(
 			clusterConfig,
 			find.NewSpecFinder(nil, nil, nil, virtCli),
 			preferenceFind.NewSpecFinder(nil, nil, nil, virtCli),
 		)
+		instancetypeInferrer = infer.New(virtCli)
 	}
 
 	httpClient := &http.Client{
@@ -101,7 +111,9 @@ func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int,
 		handlerTLSConfiguration: tlsConfiguration,
 		clusterConfig:           clusterConfig,
 		instancetypeExpander:    instancetypeExpander,
+		instancetypeInferrer:    instancetypeInferrer,
 		handlerHttpClient:       httpClient,
+		connectionLimiter:       newConnectionLimiter(clusterConfig),
 	}
 }
 
@@ -289,6 +301,9 @@ func writeError(error *errors.StatusError, response *restful.Response) {
 	errStatus := error.ErrStatus.DeepCopy()
 	errStatus.Kind = "Status"
 	errStatus.APIVersion = "v1"
+	if errStatus.Details != nil && errStatus.Details.RetryAfterSeconds > 0 {
+		response.AddHeader("Retry-After", strconv.Itoa(int(errStatus.Details.RetryAfterSeconds)))
+	}
 	err := response.WriteHeaderAndJson(int(error.Status().Code), errStatus, restful.MIME_JSON)
 	if err != nil {
 		log.Log.Reason(err).Error("Failed to write http response.")
@@ -297,59 +312,97 @@ func writeError(error *errors.StatusError, response *restful.Response) {
 
 // GuestOSInfo handles the subresource for providing VM guest agent information
 func (app *SubresourceAPIApp) GuestOSInfo(request *restful.Request, response *restful.Response) {
-	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
-		if vmi == nil || vmi.Status.Phase != v1.Running {
-			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
-		}
-		condManager := controller.NewVirtualMachineInstanceConditionManager()
-		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
-			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
-		}
-		return nil
-	}
 	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 		return conn.GuestInfoURI(vmi)
 	}
 
-	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceGuestAgentInfo{})
+	app.httpGetRequestHandler(request, response, validateVMIGuestAgentConnected, getURL, v1.VirtualMachineInstanceGuestAgentInfo{})
+}
+
+// GuestOSInfoRefresh handles the subresource for polling the VM guest agent right away and providing its information
+func (app *SubresourceAPIApp) GuestOSInfoRefresh(request *restful.Request, response *restful.Response) {
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestInfoRefreshURI(vmi)
+	}
+
+	vmi, url, conn, statusErr := app.prepareConnection(request, validateVMIGuestAgentConnected, getURL)
+	if statusErr != nil {
+		log.Log.Errorf(prepConnectionErrFmt, statusErr.Error())
+		response.WriteError(http.StatusInternalServerError, statusErr)
+		return
+	}
+
+	if err := conn.Put(url, nil); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to refresh guest info")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	guestInfoURL, err := conn.GuestInfoURI(vmi)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	resp, err := conn.Get(guestInfoURL, restful.MIME_JSON)
+	if err != nil {
+		log.Log.Errorf(getRequestErrFmt, err.Error())
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	guestInfo := v1.VirtualMachineInstanceGuestAgentInfo{}
+	if err := json.Unmarshal([]byte(resp), &guestInfo); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling response")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(guestInfo)
 }
 
 // UserList handles the subresource for providing VM guest user list
 func (app *SubresourceAPIApp) UserList(request *restful.Request, response *restful.Response) {
-	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
-		if vmi == nil || vmi.Status.Phase != v1.Running {
-			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
-		}
-		condManager := controller.NewVirtualMachineInstanceConditionManager()
-		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
-			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
-		}
-		return nil
-	}
 	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 		return conn.UserListURI(vmi)
 	}
 
-	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceGuestOSUserList{})
+	app.httpGetRequestHandler(request, response, validateVMIGuestAgentConnected, getURL, v1.VirtualMachineInstanceGuestOSUserList{})
 }
 
 // FilesystemList handles the subresource for providing guest filesystem list
 func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response *restful.Response) {
-	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
-		if vmi == nil || vmi.Status.Phase != v1.Running {
-			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
-		}
-		condManager := controller.NewVirtualMachineInstanceConditionManager()
-		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
-			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
-		}
-		return nil
-	}
 	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 		return conn.FilesystemListURI(vmi)
 	}
 
-	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
+	app.httpGetRequestHandler(request, response, validateVMIGuestAgentConnected, getURL, v1.VirtualMachineInstanceFileSystemList{})
+}
+
+// DomainXML handles the subresource for providing the libvirt domain XML and domain capabilities of a VMI
+func (app *SubresourceAPIApp) DomainXML(request *restful.Request, response *restful.Response) {
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.DomainXMLURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validateVMIRunning, getURL, v1.VirtualMachineInstanceDomainXML{})
+}
+
+func validateVMIRunning(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	return nil
+}
+
+func validateVMIGuestAgentConnected(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi == nil || vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+	}
+	return nil
 }
 
 func decodeBody(request *restful.Request, bodyStruct interface{}) *errors.StatusError {
@@ -58,6 +58,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 )
 
 const (
@@ -67,6 +68,8 @@ const (
 	UnPaused    = false
 	testVMName  = "testvm"
 	testVMIName = "testvmi"
+
+	testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
 )
 
 type readCloserWrapper struct {
@@ -935,6 +938,30 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("with default", &v1.MigrateOptions{}),
 			Entry("with dry-run option", &v1.MigrateOptions{DryRun: withDryRun()}),
 		)
+
+		It("should propagate the trace context of the request to the migration", func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			request.Request.Header = http.Header{}
+			request.Request.Header.Set(tracecontext.TraceParentHeader, testTraceParent)
+
+			vmi := v1.VirtualMachineInstance{
+				Status: v1.VirtualMachineInstanceStatus{
+					Phase: v1.Running,
+				},
+			}
+
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(&v1.VirtualMachine{}, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(&vmi, nil)
+			migrateClient.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, migration *v1.VirtualMachineInstanceMigration, opts k8smetav1.CreateOptions) (*v1.VirtualMachineInstanceMigration, error) {
+					Expect(migration.Annotations).To(HaveKeyWithValue(v1.TraceParentAnnotation, testTraceParent))
+					return migration, nil
+				})
+			app.MigrateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
 	})
 
 	Context("Subresource api - Guest OS Info", func() {
@@ -953,6 +980,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 
 		},
 			Entry("for GuestOSInfo", app.GuestOSInfo),
+			Entry("for GuestOSInfoRefresh", app.GuestOSInfoRefresh),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
 		)
@@ -972,6 +1000,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Expect(response.Error().Error()).To(ContainSubstring("VMI is not running"))
 		},
 			Entry("for GuestOSInfo", app.GuestOSInfo),
+			Entry("for GuestOSInfoRefresh", app.GuestOSInfoRefresh),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
 		)
@@ -996,11 +1025,38 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Expect(response.Error().Error()).To(ContainSubstring("VMI does not have guest agent connected"))
 		},
 			Entry("for GuestOSInfo", app.GuestOSInfo),
+			Entry("for GuestOSInfoRefresh", app.GuestOSInfoRefresh),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
 		)
 	})
 
+	Context("Subresource api - Domain XML", func() {
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		})
+
+		It("should fail when the VMI does not exist", func() {
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), testVMName))
+
+			app.DomainXML(request, response)
+
+			Expect(response.Error()).To(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should fail when the VMI is not running", func() {
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(&v1.VirtualMachineInstance{}, nil)
+
+			app.DomainXML(request, response)
+
+			Expect(response.Error()).To(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+			Expect(response.Error().Error()).To(ContainSubstring("VMI is not running"))
+		})
+	})
+
 	Context("StateChange JSON", func() {
 		It("should create a stop request if status exists", func() {
 			uid := uuid.NewUUID()
@@ -1519,6 +1575,30 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("Manual RunStrategy", v1.RunStrategyManual),
 			Entry("RerunOnFailure RunStrategy", v1.RunStrategyRerunOnFailure),
 		)
+
+		It("should propagate the trace context of the request to the start request", func() {
+			request.Request.Header = http.Header{}
+			request.Request.Header.Set(tracecontext.TraceParentHeader, testTraceParent)
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyManual)
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vm.Name))
+			vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), k8smetav1.PatchOptions{}).DoAndReturn(
+				func(ctx context.Context, name string, patchType types.PatchType, body []byte, opts k8smetav1.PatchOptions) (*v1.VirtualMachine, error) {
+					startRequest := v1.VirtualMachineStateChangeRequest{
+						Action: v1.StartRequest,
+						Data:   map[string]string{v1.StateChangeRequestDataTraceParentKey: testTraceParent},
+					}
+					expected, err := getChangeRequestJson(vm, startRequest)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(body).To(Equal(expected))
+					return vm, nil
+				})
+
+			app.StartVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
 	})
 
 	AfterEach(func() {
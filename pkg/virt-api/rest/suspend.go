@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+)
+
+const suspendToDiskGateDisabledFmt = "SuspendToDisk feature gate not enabled: Unable to %s the VM."
+
+// SuspendVMRequestHandler saves the guest memory of a running VM to its backend storage and stops it.
+// The VM stays stopped until it gets resumed.
+func (app *SubresourceAPIApp) SuspendVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.SuspendToDiskEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(suspendToDiskGateDisabledFmt, "suspend")), response)
+		return
+	}
+
+	opts := &v1.SuspendOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		if err := decodeBody(request, opts); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Status.Suspend != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is already %s", vm.Status.Suspend.Phase)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning))
+		}
+		if !backendstorage.HasSuspendToDisk(&vmi.Spec) {
+			return errors.NewBadRequest("suspend to disk is not enabled for the VirtualMachineInstance")
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.SuspendURI(vmi)
+	}
+	vmi, url, conn, statusErr := app.prepareConnection(request, validate, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	suspend := &v1.VirtualMachineSuspendStatus{
+		Phase:  v1.VirtualMachineSuspending,
+		VMIUID: vmi.UID,
+	}
+	if err := app.patchVMSuspendStatus(vm, patch.WithAdd("/status/suspend", suspend), opts.DryRun); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if len(opts.DryRun) > 0 {
+		response.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if err := conn.Put(url, nil); err != nil {
+		if revertErr := app.patchVMSuspendStatus(vm, patch.WithRemove("/status/suspend"), nil); revertErr != nil {
+			log.Log.Object(vm).Reason(revertErr).Error("Failed to revert the suspend status of the VM")
+		}
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// ResumeVMRequestHandler starts a VM suspended to disk from its saved state.
+// A VM which is still being suspended can't be resumed, the save of the guest memory can't be cancelled.
+func (app *SubresourceAPIApp) ResumeVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.SuspendToDiskEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(suspendToDiskGateDisabledFmt, "resume")), response)
+		return
+	}
+
+	opts := &v1.ResumeOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		if err := decodeBody(request, opts); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	switch {
+	case vm.Status.Suspend == nil:
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is not suspended")), response)
+		return
+	case vm.Status.Suspend.Phase == v1.VirtualMachineSuspending:
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is still being suspended, retry once it is Suspended")), response)
+		return
+	case vm.Status.Suspend.Phase != v1.VirtualMachineSuspended:
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is already %s", vm.Status.Suspend.Phase)), response)
+		return
+	}
+
+	if err := app.patchVMSuspendStatus(vm, patch.WithReplace("/status/suspend/phase", v1.VirtualMachineResuming), opts.DryRun); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) patchVMSuspendStatus(vm *v1.VirtualMachine, patchOpt patch.PatchOption, dryRun []string) error {
+	patchSet := patch.New(patchOpt)
+	if vm.Status.Suspend != nil {
+		patchSet = patch.New(patch.WithTest("/status/suspend/phase", vm.Status.Suspend.Phase), patchOpt)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMStatusFmt, string(patchBytes))
+	_, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, k8smetav1.PatchOptions{DryRun: dryRun})
+	return err
+}
@@ -0,0 +1,186 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Suspend Subresource API", func() {
+	var (
+		request   *restful.Request
+		response  *restful.Response
+		recorder  *httptest.ResponseRecorder
+		vmClient  *kubecli.MockVirtualMachineInterface
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+		vm        *v1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.SuspendToDiskGate},
+			},
+		})
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+
+		vm = newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+		vmClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vm, nil).AnyTimes()
+	})
+
+	expectSuspendStatusPatch := func(expectedOps ...patch.PatchOperation) {
+		vmClient.EXPECT().PatchStatus(gomock.Any(), testVMName, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions) (*v1.VirtualMachine, error) {
+				var ops []patch.PatchOperation
+				Expect(json.Unmarshal(data, &ops)).To(Succeed())
+				Expect(ops).To(HaveLen(len(expectedOps)))
+				for i := range expectedOps {
+					Expect(ops[i].Op).To(Equal(expectedOps[i].Op))
+					Expect(ops[i].Path).To(Equal(expectedOps[i].Path))
+					if expectedOps[i].Value != nil {
+						Expect(ops[i].Value).To(BeEquivalentTo(expectedOps[i].Value))
+					}
+				}
+				return vm, nil
+			})
+	}
+
+	Context("suspend", func() {
+		It("should fail if the feature gate is disabled", func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			app.clusterConfig = config
+
+			app.SuspendVMRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring("SuspendToDisk feature gate not enabled"))
+		})
+
+		It("should fail if the VM is already suspended", func() {
+			vm.Status.Suspend = &v1.VirtualMachineSuspendStatus{Phase: v1.VirtualMachineSuspended}
+
+			app.SuspendVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should fail if the VMI is not running", func() {
+			vmi := libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))
+			vmi.Spec.Domain.Memory = &v1.Memory{SuspendToDisk: pointer.P(true)}
+			vmi.Status.Phase = v1.Scheduled
+			vmiClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vmi, nil)
+
+			app.SuspendVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should fail if the VMI does not have suspend to disk enabled", func() {
+			vmi := libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))
+			vmi.Status.Phase = v1.Running
+			vmiClient.EXPECT().Get(gomock.Any(), testVMName, gomock.Any()).Return(vmi, nil)
+
+			app.SuspendVMRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring("suspend to disk is not enabled"))
+		})
+	})
+
+	Context("resume", func() {
+		It("should fail if the feature gate is disabled", func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			app.clusterConfig = config
+
+			app.ResumeVMRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.ErrStatus.Message).To(ContainSubstring("SuspendToDisk feature gate not enabled"))
+		})
+
+		It("should fail if the VM is not suspended", func() {
+			app.ResumeVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should fail if the VM is already resuming", func() {
+			vm.Status.Suspend = &v1.VirtualMachineSuspendStatus{Phase: v1.VirtualMachineResuming}
+
+			app.ResumeVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should resume a suspended VM", func() {
+			vm.Status.Suspend = &v1.VirtualMachineSuspendStatus{Phase: v1.VirtualMachineSuspended}
+			expectSuspendStatusPatch(
+				patch.PatchOperation{Op: patch.PatchTestOp, Path: "/status/suspend/phase", Value: v1.VirtualMachineSuspended},
+				patch.PatchOperation{Op: patch.PatchReplaceOp, Path: "/status/suspend/phase", Value: v1.VirtualMachineResuming},
+			)
+
+			app.ResumeVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should fail if the VM is still being suspended", func() {
+			vm.Status.Suspend = &v1.VirtualMachineSuspendStatus{Phase: v1.VirtualMachineSuspending}
+
+			app.ResumeVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+})
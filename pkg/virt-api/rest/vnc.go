@@ -35,6 +35,12 @@ import (
 )
 
 func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, response *restful.Response) {
+	release, acquired := app.acquireConnection(request, response)
+	if !acquired {
+		return
+	}
+	defer release()
+
 	activeConnectionMetric := apimetrics.NewActiveVNCConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
 
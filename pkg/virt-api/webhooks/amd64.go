@@ -47,7 +47,7 @@ func validateVideoTypeAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 
 	videoType := spec.Domain.Devices.Video.Type
 
-	validTypes := []string{"vga", "cirrus", "virtio", "ramfb", "bochs"}
+	validTypes := []string{v1.VideoTypeVGA, v1.VideoTypeCirrus, v1.VideoTypeVirtio, v1.VideoTypeRamfb, v1.VideoTypeBochs}
 	if !slices.Contains(validTypes, videoType) {
 		*statusCauses = append(*statusCauses, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
@@ -52,7 +52,7 @@ func validateVideoTypeArm64(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 
 	videoType := spec.Domain.Devices.Video.Type
 
-	validTypes := []string{"virtio", "ramfb"}
+	validTypes := []string{v1.VideoTypeVirtio, v1.VideoTypeRamfb}
 	if !slices.Contains(validTypes, videoType) {
 		*statusCauses = append(*statusCauses, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
@@ -20,7 +20,6 @@
 package mutators
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"kubevirt.io/client-go/log"
@@ -64,7 +63,7 @@ func (mutator *CloneCreateMutator) Mutate(ar *admissionv1.AdmissionReview) *admi
 
 	vmCloneOrig := &clone.VirtualMachineClone{}
 
-	if err := json.Unmarshal(ar.Request.Object.Raw, &vmCloneOrig); err != nil {
+	if err := webhookutils.DecodeObject(ar.Request.Object.Raw, vmCloneOrig); err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
@@ -20,7 +20,6 @@
 package mutators
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
@@ -49,7 +48,7 @@ func (mutator *MigrationCreateMutator) Mutate(ar *admissionv1.AdmissionReview) *
 	raw := ar.Request.Object.Raw
 	migration := v1.VirtualMachineInstanceMigration{}
 
-	err := json.Unmarshal(raw, &migration)
+	err := webhookutils.DecodeObject(raw, &migration)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
@@ -194,15 +194,18 @@ func (m *VirtLauncherPodMutator) createVirtiofsContainer(
 	internalMountPath := fmt.Sprintf("/virtiofs-data/%s", volume.Name)
 
 	socketPath := virtiofs.VirtioFSSocketPath(volume.Name)
+	fs := virtiofs.GetFilesystemVirtiofs(vmi, volume.Name)
 
 	args := []string{
 		fmt.Sprintf("--socket-path=%s", socketPath),
 		fmt.Sprintf("--shared-dir=%s", internalMountPath),
 		"--sandbox=none",
-		"--cache=auto",
+	}
+	args = append(args, virtiofs.TuningArgs(fs)...)
+	args = append(args,
 		"--migration-on-error=guest-error",
 		"--migration-mode=find-paths",
-	}
+	)
 
 	if translateUID {
 		args = append(args, fmt.Sprintf("--translate-uid=host:%d:0:1", util.NonRootUID))
@@ -228,7 +231,7 @@ func (m *VirtLauncherPodMutator) createVirtiofsContainer(
 	// Get resources based on VMI QOS settings
 	dedicatedCPUs := vmi.IsCPUDedicated()
 	guaranteedQOS := dedicatedCPUs || vmi.WantsToHaveQOSGuaranteed()
-	resources := virtiofs.ResourcesForVirtioFSContainer(dedicatedCPUs, guaranteedQOS, m.ClusterConfig)
+	resources := virtiofs.ResourcesForVirtioFSContainer(dedicatedCPUs, guaranteedQOS, fs, m.ClusterConfig)
 
 	return k8sv1.Container{
 		Name:            virtiofs.ContainerPathVirtiofsContainerName(volume.Name),
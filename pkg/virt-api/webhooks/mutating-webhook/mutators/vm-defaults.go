@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package mutators
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	defaultsv1alpha1 "kubevirt.io/api/defaults/v1alpha1"
+)
+
+// listVirtualMachineDefaults returns the VirtualMachineDefaults of a namespace sorted by name
+func listVirtualMachineDefaults(informer cache.SharedIndexInformer, namespace string) ([]*defaultsv1alpha1.VirtualMachineDefaults, error) {
+	var result []*defaultsv1alpha1.VirtualMachineDefaults
+	err := cache.ListAllByNamespace(informer.GetIndexer(), namespace, labels.Everything(), func(obj interface{}) {
+		result = append(result, obj.(*defaultsv1alpha1.VirtualMachineDefaults))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// applyVirtualMachineDefaults fills the fields of the VM left unset by the user. The given
+// VirtualMachineDefaults are applied in order, so the first one setting a field wins.
+// Network binding and disk bus are left to the preference when the VM references one.
+func applyVirtualMachineDefaults(vm *v1.VirtualMachine, vmDefaults []*defaultsv1alpha1.VirtualMachineDefaults) {
+	for _, d := range vmDefaults {
+		spec := &d.Spec
+		if spec.RunStrategy != nil && vm.Spec.RunStrategy == nil && vm.Spec.Running == nil {
+			runStrategy := *spec.RunStrategy
+			vm.Spec.RunStrategy = &runStrategy
+		}
+		if spec.EvictionStrategy != nil && vm.Spec.Template.Spec.EvictionStrategy == nil {
+			evictionStrategy := *spec.EvictionStrategy
+			vm.Spec.Template.Spec.EvictionStrategy = &evictionStrategy
+		}
+		if vm.Spec.Preference != nil {
+			continue
+		}
+		if spec.NetworkBinding != nil {
+			applyNetworkBindingDefault(vm.Spec.Template.Spec.Domain.Devices.Interfaces, spec.NetworkBinding)
+		}
+		if spec.DiskBus != nil {
+			applyDiskBusDefault(vm.Spec.Template.Spec.Domain.Devices.Disks, *spec.DiskBus)
+		}
+	}
+}
+
+func applyNetworkBindingDefault(interfaces []v1.Interface, binding *defaultsv1alpha1.NetworkBinding) {
+	for i := range interfaces {
+		iface := &interfaces[i]
+		if iface.InterfaceBindingMethod != (v1.InterfaceBindingMethod{}) || iface.Binding != nil {
+			continue
+		}
+		switch {
+		case binding.Method == defaultsv1alpha1.NetworkBindingMethodBridge:
+			iface.Bridge = &v1.InterfaceBridge{}
+		case binding.Method == defaultsv1alpha1.NetworkBindingMethodMasquerade:
+			iface.Masquerade = &v1.InterfaceMasquerade{}
+		case binding.Plugin != "":
+			iface.Binding = &v1.PluginBinding{Name: binding.Plugin}
+		}
+	}
+}
+
+func applyDiskBusDefault(disks []v1.Disk, bus v1.DiskBus) {
+	for i := range disks {
+		if disks[i].Disk != nil && disks[i].Disk.Bus == "" {
+			disks[i].Disk.Bus = bus
+		}
+	}
+}
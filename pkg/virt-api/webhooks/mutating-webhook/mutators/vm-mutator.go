@@ -26,6 +26,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -47,13 +48,18 @@ type VMsMutator struct {
 	ClusterConfig       *virtconfig.ClusterConfig
 	instancetypeMutator instancetypeVMsMutator
 	virtClient          kubecli.KubevirtClient
+	VMDefaultsInformer  cache.SharedIndexInformer
 }
 
-func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) *VMsMutator {
+func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) *VMsMutator {
+	instancetypeMutator := instancetypeVMWebhooks.NewMutator(
+		informers.PreferenceInformer.GetStore(), informers.ClusterPreferenceInformer.GetStore(), virtCli,
+	)
 	return &VMsMutator{
 		ClusterConfig:       clusterConfig,
-		instancetypeMutator: instancetypeVMWebhooks.NewMutator(virtCli),
+		instancetypeMutator: instancetypeMutator,
 		virtClient:          virtCli,
+		VMDefaultsInformer:  informers.VMDefaultsInformer,
 	}
 }
 
@@ -90,6 +96,15 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 		setFirmwareDefaultsIfEmpty(vm)
 	}
 
+	// Namespace defaults only fill fields left unset when the VM is created
+	if ar.Request.Operation == admissionv1.Create && mutator.ClusterConfig.VirtualMachineDefaultsEnabled() {
+		vmDefaults, err := listVirtualMachineDefaults(mutator.VMDefaultsInformer, vm.Namespace)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		applyVirtualMachineDefaults(vm, vmDefaults)
+	}
+
 	// Set VM defaults
 	log.Log.Object(vm).V(4).Info("Apply defaults")
 
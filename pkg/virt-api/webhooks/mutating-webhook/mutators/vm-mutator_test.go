@@ -37,6 +37,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	defaultsv1alpha1 "kubevirt.io/api/defaults/v1alpha1"
 	apiinstancetype "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	"kubevirt.io/client-go/kubecli"
@@ -48,7 +49,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	instancetypeVMWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("VirtualMachine Mutator", func() {
@@ -164,7 +167,8 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		cdiClient = cdifake.NewSimpleClientset()
 		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 
-		mutator.instancetypeMutator = instancetypeVMWebhooks.NewMutator(virtClient)
+		mutator.instancetypeMutator = instancetypeVMWebhooks.NewMutator(nil, nil, virtClient)
+		mutator.VMDefaultsInformer, _ = testutils.NewFakeInformerFor(&defaultsv1alpha1.VirtualMachineDefaults{})
 	})
 
 	It("should allow VM being deleted without applying mutations", func() {
@@ -242,6 +246,31 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		Entry("when override is for s390x architecture", "s390x", "", "", machineTypeFromConfig, machineTypeFromConfig),
 	)
 
+	DescribeTable("should replace machine type aliases on VM create", func(machineType, defaultMachineType, result string) {
+		aliases := map[string]string{"q35-stable": "pc-q35-rhel9.6.0"}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					ArchitectureConfiguration: &v1.ArchConfiguration{
+						Amd64: &v1.ArchSpecificConfiguration{MachineType: defaultMachineType, MachineTypeAliases: aliases},
+						Arm64: &v1.ArchSpecificConfiguration{},
+						S390x: &v1.ArchSpecificConfiguration{},
+					},
+				},
+			},
+		})
+		if machineType != "" {
+			vm.Spec.Template.Spec.Domain.Machine = &v1.Machine{Type: machineType}
+		}
+
+		vmSpec, _ := getVMSpecMetaFromResponseCreateWithArch("amd64")
+		Expect(vmSpec.Template.Spec.Domain.Machine.Type).To(Equal(result))
+	},
+		Entry("when the machine type is an alias", "q35-stable", "", "pc-q35-rhel9.6.0"),
+		Entry("when the default machine type is an alias", "", "q35-stable", "pc-q35-rhel9.6.0"),
+		Entry("but keep a machine type which is not an alias", "pc-q35-rhel9.4.0", "q35-stable", "pc-q35-rhel9.4.0"),
+	)
+
 	It("should not override default architecture with defaults on VM create", func() {
 		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
 			Status: v1.KubeVirtStatus{
@@ -785,6 +814,111 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		})
 	})
 
+	Context("with VirtualMachineDefaults", func() {
+		addVMDefaults := func(name, namespace string, spec defaultsv1alpha1.VirtualMachineDefaultsSpec) {
+			Expect(mutator.VMDefaultsInformer.GetStore().Add(&defaultsv1alpha1.VirtualMachineDefaults{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec:       spec,
+			})).To(Succeed())
+		}
+
+		enableVMDefaults := func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{featuregate.VirtualMachineDefaultsGate},
+						},
+					},
+				},
+			})
+		}
+
+		BeforeEach(func() {
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name:       "disk",
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+			}}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}}
+			vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			addVMDefaults("defaults", vm.Namespace, defaultsv1alpha1.VirtualMachineDefaultsSpec{
+				RunStrategy:      pointer.P(v1.RunStrategyHalted),
+				EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+				NetworkBinding:   &defaultsv1alpha1.NetworkBinding{Method: defaultsv1alpha1.NetworkBindingMethodBridge},
+				DiskBus:          pointer.P(v1.DiskBusSATA),
+			})
+		})
+
+		It("should not apply them when the feature gate is disabled", func() {
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.RunStrategy).To(BeNil())
+			Expect(vmSpec.Template.Spec.EvictionStrategy).To(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Disks[0].Disk.Bus).To(BeEmpty())
+		})
+
+		It("should fill unset fields on create", func() {
+			enableVMDefaults()
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+			Expect(vmSpec.Template.Spec.EvictionStrategy).To(HaveValue(Equal(v1.EvictionStrategyLiveMigrate)))
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].Bridge).ToNot(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].Masquerade).To(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Disks[0].Disk.Bus).To(Equal(v1.DiskBusSATA))
+		})
+
+		It("should not override fields set on the VM", func() {
+			enableVMDefaults()
+			vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+			vm.Spec.Template.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyNone)
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Masquerade = &v1.InterfaceMasquerade{}
+			vm.Spec.Template.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusSCSI
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+			Expect(vmSpec.Template.Spec.EvictionStrategy).To(HaveValue(Equal(v1.EvictionStrategyNone)))
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].Bridge).To(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Disks[0].Disk.Bus).To(Equal(v1.DiskBusSCSI))
+		})
+
+		It("should let the first object by name set a field", func() {
+			enableVMDefaults()
+			addVMDefaults("a-defaults", vm.Namespace, defaultsv1alpha1.VirtualMachineDefaultsSpec{
+				NetworkBinding: &defaultsv1alpha1.NetworkBinding{Plugin: "passt"},
+			})
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].Binding).To(Equal(&v1.PluginBinding{Name: "passt"}))
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].Bridge).To(BeNil())
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+		})
+
+		It("should ignore objects from other namespaces", func() {
+			enableVMDefaults()
+			vm.Namespace = "other"
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.RunStrategy).To(BeNil())
+		})
+
+		It("should leave network binding and disk bus to the preference", func() {
+			enableVMDefaults()
+			vm.Spec.Preference = &v1.PreferenceMatcher{Name: "preference"}
+			_, err := fakePreferenceClient.Create(context.Background(), &instancetypev1beta1.VirtualMachinePreference{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "preference", Namespace: vm.Namespace},
+			}, k8smetav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].Bridge).To(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Disks[0].Disk.Bus).To(BeEmpty())
+		})
+
+		It("should not apply them on update", func() {
+			enableVMDefaults()
+			resp := getResponseFromVMUpdate(vm.DeepCopy(), vm)
+			Expect(resp.Allowed).To(BeTrue())
+			vmSpec, _ := getVMSpecMetaFromResponse(resp)
+			Expect(vmSpec.RunStrategy).To(BeNil())
+		})
+	})
+
 	It("should default architecture to compiled architecture when not provided", func() {
 		// provide empty string for architecture so that default will apply
 		vmSpec, _ := getVMSpecMetaFromResponseCreate()
@@ -264,6 +264,33 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Entry("on arm64", "arm64", v1.CPUModeHostPassthrough),
 	)
 
+	DescribeTable("should replace machine type aliases on VMI create", func(machineType, defaultMachineType, result string) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					ArchitectureConfiguration: &v1.ArchConfiguration{
+						Amd64: &v1.ArchSpecificConfiguration{
+							MachineType:        defaultMachineType,
+							MachineTypeAliases: map[string]string{"q35-stable": "pc-q35-rhel9.6.0"},
+						},
+						Arm64: &v1.ArchSpecificConfiguration{},
+						S390x: &v1.ArchSpecificConfiguration{},
+					},
+				},
+			},
+		})
+		if machineType != "" {
+			vmi.Spec.Domain.Machine = &v1.Machine{Type: machineType}
+		}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmitWithArch("amd64")
+		Expect(vmiSpec.Domain.Machine.Type).To(Equal(result))
+	},
+		Entry("when the machine type is an alias", "q35-stable", "", "pc-q35-rhel9.6.0"),
+		Entry("when the default machine type is an alias", "", "q35-stable", "pc-q35-rhel9.6.0"),
+		Entry("but keep a machine type which is not an alias", "pc-q35-rhel9.4.0", "q35-stable", "pc-q35-rhel9.4.0"),
+	)
+
 	DescribeTable("it should", func(given []v1.Volume, expected []v1.Volume) {
 		vmi.Spec.Volumes = given
 		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
@@ -1359,6 +1386,27 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 			Entry("s390x", "s390x"),
 		)
 
+		Context("configure CPU hotplug on arm64", func() {
+			It("should leave MaxSockets unset", func() {
+				kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kvCR.Spec.Configuration.LiveUpdateConfiguration = &v1.LiveUpdateConfiguration{
+					MaxCpuSockets: pointer.P(uint32(10)),
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+				_, spec, _ := getMetaSpecStatusFromAdmitWithArch("arm64")
+				Expect(spec.Domain.CPU.MaxSockets).To(Equal(uint32(0)))
+			})
+
+			It("should keep MaxSockets when provided", func() {
+				vmi.Spec.Domain.CPU = &v1.CPU{
+					Sockets:    2,
+					MaxSockets: 16,
+				}
+				_, spec, _ := getMetaSpecStatusFromAdmitWithArch("arm64")
+				Expect(spec.Domain.CPU.MaxSockets).To(Equal(uint32(16)))
+			})
+		})
+
 		DescribeTableSubtree("configure Memory hotplug on supported arch", func(arch string) {
 			It("to keep VMI values of max guest when provided", func() {
 				guest := resource.MustParse("2Gi")
@@ -1477,17 +1525,6 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 			)
 		},
 			Entry("amd64", "amd64"),
-		)
-
-		DescribeTable("should leave MaxSockets unset on unsupported arch", func(arch string) {
-			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
-			kvCR.Spec.Configuration.LiveUpdateConfiguration = &v1.LiveUpdateConfiguration{
-				MaxCpuSockets: pointer.P(uint32(10)),
-			}
-			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
-			_, spec, _ := getMetaSpecStatusFromAdmitWithArch(arch)
-			Expect(spec.Domain.CPU.MaxSockets).To(Equal(uint32(0)))
-		},
 			Entry("arm64", "arm64"),
 		)
 
@@ -1500,7 +1537,6 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 			_, spec, _ := getMetaSpecStatusFromAdmitWithArch(arch)
 			Expect(spec.Domain.Memory.MaxGuest).To(BeNil())
 		},
-			Entry("arm64", "arm64"),
 			Entry("s390x", "s390x"),
 		)
 	})
@@ -47,7 +47,7 @@ func validateVideoTypeS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 
 	videoType := spec.Domain.Devices.Video.Type
 
-	validTypes := []string{"virtio"}
+	validTypes := []string{v1.VideoTypeVirtio}
 	if !slices.Contains(validTypes, videoType) {
 		*statusCauses = append(*statusCauses, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
@@ -70,6 +70,15 @@ func validateWatchdogS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 			Field:   field.Child("domain", "devices", "watchdog").String(),
 		})
 	}
+
+	// s390x has no non-maskable interrupt the watchdog could inject
+	if watchdog.Diag288 != nil && watchdog.Diag288.Action == v1.WatchdogActionInjectNMI {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("watchdog action '%s' is not supported on s390x architecture", watchdog.Diag288.Action),
+			Field:   field.Child("domain", "devices", "watchdog", "diag288", "action").String(),
+		})
+	}
 }
 
 func isOnlyDiag288Watchdog(watchdog *v1.Watchdog) bool {
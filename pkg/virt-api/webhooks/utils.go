@@ -64,9 +64,19 @@ var MigrationGroupVersionResource = metav1.GroupVersionResource{
 }
 
 type Informers struct {
-	VMIPresetInformer  cache.SharedIndexInformer
-	VMRestoreInformer  cache.SharedIndexInformer
-	VMBackupInformer   cache.SharedIndexInformer
-	DataSourceInformer cache.SharedIndexInformer
-	NamespaceInformer  cache.SharedIndexInformer
+	VMIPresetInformer           cache.SharedIndexInformer
+	VMRestoreInformer           cache.SharedIndexInformer
+	VMBackupInformer            cache.SharedIndexInformer
+	VMInformer                  cache.SharedIndexInformer
+	VMSnapshotInformer          cache.SharedIndexInformer
+	VMSnapshotContentInformer   cache.SharedIndexInformer
+	DataSourceInformer          cache.SharedIndexInformer
+	NamespaceInformer           cache.SharedIndexInformer
+	VMDefaultsInformer          cache.SharedIndexInformer
+	VMIInformer                 cache.SharedIndexInformer
+	VMQuotaInformer             cache.SharedIndexInformer
+	InstancetypeInformer        cache.SharedIndexInformer
+	ClusterInstancetypeInformer cache.SharedIndexInformer
+	PreferenceInformer          cache.SharedIndexInformer
+	ClusterPreferenceInformer   cache.SharedIndexInformer
 }
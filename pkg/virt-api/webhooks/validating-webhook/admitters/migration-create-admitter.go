@@ -21,7 +21,6 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -189,7 +188,7 @@ func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.Virtu
 	raw := ar.Request.Object.Raw
 	newMigration := v1.VirtualMachineInstanceMigration{}
 
-	err = json.Unmarshal(raw, &newMigration)
+	err = webhookutils.DecodeObject(raw, &newMigration)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -197,7 +196,7 @@ func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.Virtu
 	if ar.Request.Operation == admissionv1.Update {
 		raw := ar.Request.OldObject.Raw
 		oldMigration := v1.VirtualMachineInstanceMigration{}
-		err = json.Unmarshal(raw, &oldMigration)
+		err = webhookutils.DecodeObject(raw, &oldMigration)
 		if err != nil {
 			return nil, nil, err
 		}
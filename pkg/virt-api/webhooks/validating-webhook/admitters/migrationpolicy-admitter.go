@@ -21,7 +21,6 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -53,7 +52,7 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 	}
 
 	policy := &migrationsv1.MigrationPolicy{}
-	err := json.Unmarshal(ar.Request.Object.Raw, policy)
+	err := webhookutils.DecodeObject(ar.Request.Object.Raw, policy)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
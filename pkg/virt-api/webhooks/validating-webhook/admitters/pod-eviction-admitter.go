@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	k8scorev1 "k8s.io/api/core/v1"
@@ -38,6 +39,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -66,7 +68,7 @@ func NewPodEvictionAdmitter(clusterConfig *virtconfig.ClusterConfig, kubeClient
 }
 
 func isDryRun(ar *admissionv1.AdmissionReview, evictionObject *policyv1.Eviction) bool {
-	dryRun := ar.Request.DryRun != nil && *ar.Request.DryRun == true
+	dryRun := webhookutils.IsDryRun(ar.Request)
 
 	if !dryRun {
 		if evictionObject.DeleteOptions != nil && len(evictionObject.DeleteOptions.DryRun) > 0 {
@@ -150,6 +152,15 @@ func (admitter *PodEvictionAdmitter) admitLauncherPod(ctx context.Context, ar *a
 		}
 		markForEviction = true
 	case virtv1.EvictionStrategyLiveMigrateIfPossible:
+		// low priority VMIs are shut down to leave the migration capacity to the others
+		if vmi.IsMigratable() && !migrations.ShutdownOnEvictionByPriority(admitter.clusterConfig, pod.Spec.Priority) {
+			markForEviction = true
+		}
+	case virtv1.EvictionStrategyLiveMigrateOrShutdown:
+		// once the migration timed out, the eviction shuts the VMI down
+		if timeLeft, marked := migrations.EvictionShutdownFallbackIn(admitter.clusterConfig, vmi, time.Now()); marked && timeLeft <= 0 {
+			break
+		}
 		if vmi.IsMigratable() {
 			markForEviction = true
 		}
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -177,6 +178,11 @@ var _ = Describe("Pod eviction admitter", func() {
 			libvmi.WithEvictionStrategy(virtv1.EvictionStrategyLiveMigrateIfPossible),
 			withLiveMigratableCondition(),
 		),
+		Entry("When cluster-wide eviction strategy is missing, VMI eviction strategy is LiveMigrateOrShutdown and VMI is migratable",
+			nil,
+			libvmi.WithEvictionStrategy(virtv1.EvictionStrategyLiveMigrateOrShutdown),
+			withLiveMigratableCondition(),
+		),
 		Entry("When cluster-wide eviction strategy is missing, VMI eviction strategy is External and VMI is not migratable",
 			nil,
 			libvmi.WithEvictionStrategy(virtv1.EvictionStrategyExternal),
@@ -247,6 +253,10 @@ var _ = Describe("Pod eviction admitter", func() {
 			nil,
 			libvmi.WithEvictionStrategy(virtv1.EvictionStrategyLiveMigrateIfPossible),
 		),
+		Entry("When cluster-wide eviction strategy is missing, VMI eviction strategy is LiveMigrateOrShutdown and VMI is not migratable",
+			nil,
+			libvmi.WithEvictionStrategy(virtv1.EvictionStrategyLiveMigrateOrShutdown),
+		),
 		Entry("When cluster-wide eviction strategy is None, VMI eviction strategy is missing and VMI is not migratable",
 			pointer.P(virtv1.EvictionStrategyNone),
 		),
@@ -440,6 +450,45 @@ var _ = Describe("Pod eviction admitter", func() {
 		Expect(virtClient.Fake.Actions()).To(HaveLen(1))
 	})
 
+	DescribeTable("with the LiveMigrateOrShutdown eviction strategy and a VMI marked for evacuation", func(evictionRequested time.Duration, expectAllowed bool) {
+		vmiOptions := append(defaultVMIOptions,
+			libvmi.WithEvictionStrategy(virtv1.EvictionStrategyLiveMigrateOrShutdown),
+			withLiveMigratableCondition(),
+			withEvacuationNodeName(testNodeName),
+		)
+		vmi := libvmi.New(vmiOptions...)
+		vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+			Type:               virtv1.VirtualMachineInstanceEvictionRequested,
+			Status:             k8sv1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-evictionRequested)),
+		})
+		virtClient := kubevirtfake.NewSimpleClientset(vmi)
+
+		evictedVirtLauncherPod := newVirtLauncherPod(vmi.Namespace, vmi.Name, vmi.Status.NodeName)
+		kubeClient := fake.NewSimpleClientset(evictedVirtLauncherPod)
+
+		admitter := admitters.NewPodEvictionAdmitter(
+			newClusterConfig(nil),
+			kubeClient,
+			virtClient,
+		)
+
+		actualAdmissionResponse := admitter.Admit(
+			context.Background(),
+			newAdmissionReview(evictedVirtLauncherPod.Namespace, evictedVirtLauncherPod.Name, &requestOptions{}),
+		)
+
+		if expectAllowed {
+			Expect(actualAdmissionResponse).To(Equal(allowedAdmissionResponse()))
+		} else {
+			Expect(actualAdmissionResponse).To(Equal(newDeniedAdmissionResponse(fmt.Sprintf(`Evacuation in progress: Eviction triggered evacuation of VMI "%s/%s"`, vmi.Namespace, vmi.Name))))
+		}
+		Expect(virtClient.Fake.Actions()).To(HaveLen(1))
+	},
+		Entry("should deny the request within the eviction migration timeout", time.Minute, false),
+		Entry("should allow the request after the eviction migration timeout", 11*time.Minute, true),
+	)
+
 	DescribeTable("should deny the request and perform a dryRun patch on the VMI when", func(dryRunOpts *requestOptions) {
 		vmiOptions := append(defaultVMIOptions,
 			libvmi.WithEvictionStrategy(virtv1.EvictionStrategyLiveMigrate),
@@ -479,6 +528,51 @@ var _ = Describe("Pod eviction admitter", func() {
 		Entry("dry run is set in the object", &requestOptions{dryRunInObject: []string{metav1.DryRunAll}}),
 	)
 
+	DescribeTable("with an eviction shutdown priority threshold", func(evictionStrategy virtv1.EvictionStrategy, priority *int32, expectEvacuation bool) {
+		vmiOptions := append(defaultVMIOptions,
+			libvmi.WithEvictionStrategy(evictionStrategy),
+			withLiveMigratableCondition(),
+		)
+		vmi := libvmi.New(vmiOptions...)
+		virtClient := kubevirtfake.NewSimpleClientset(vmi)
+
+		evictedVirtLauncherPod := newVirtLauncherPod(vmi.Namespace, vmi.Name, vmi.Status.NodeName)
+		evictedVirtLauncherPod.Spec.Priority = priority
+		kubeClient := fake.NewSimpleClientset(evictedVirtLauncherPod)
+
+		kv := kubecli.NewMinimalKubeVirt("kubevirt")
+		kv.Namespace = "kubevirt"
+		kv.Spec.Configuration.MigrationConfiguration = &virtv1.MigrationConfiguration{
+			EvictionShutdownPriorityThreshold: pointer.P(int32(1000)),
+		}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+
+		admitter := admitters.NewPodEvictionAdmitter(clusterConfig, kubeClient, virtClient)
+
+		actualAdmissionResponse := admitter.Admit(
+			context.Background(),
+			newAdmissionReview(evictedVirtLauncherPod.Namespace, evictedVirtLauncherPod.Name, &requestOptions{}),
+		)
+
+		if expectEvacuation {
+			Expect(actualAdmissionResponse).To(Equal(newDeniedAdmissionResponse(
+				fmt.Sprintf("Eviction triggered evacuation of VMI \"%s/%s\"", vmi.Namespace, vmi.Name),
+			)))
+		} else {
+			Expect(actualAdmissionResponse).To(Equal(allowedAdmissionResponse()))
+			Expect(virtClient.Fake.Actions()).To(HaveLen(1))
+		}
+	},
+		Entry("should shut down a LiveMigrateIfPossible VMI without priority",
+			virtv1.EvictionStrategyLiveMigrateIfPossible, nil, false),
+		Entry("should shut down a LiveMigrateIfPossible VMI with a priority below the threshold",
+			virtv1.EvictionStrategyLiveMigrateIfPossible, pointer.P(int32(999)), false),
+		Entry("should migrate a LiveMigrateIfPossible VMI with a priority matching the threshold",
+			virtv1.EvictionStrategyLiveMigrateIfPossible, pointer.P(int32(1000)), true),
+		Entry("should migrate a LiveMigrate VMI with a priority below the threshold",
+			virtv1.EvictionStrategyLiveMigrate, pointer.P(int32(999)), true),
+	)
+
 	Context("hotplug pod eviction", func() {
 		It("should deny the request for a hotplug pod of a VMI", func() {
 			vmiOptions := append(defaultVMIOptions,
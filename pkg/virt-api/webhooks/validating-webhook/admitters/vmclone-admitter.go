@@ -72,7 +72,7 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 	}
 
 	vmClone := &clone.VirtualMachineClone{}
-	err := json.Unmarshal(ar.Request.Object.Raw, vmClone)
+	err := webhookutils.DecodeObject(ar.Request.Object.Raw, vmClone)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
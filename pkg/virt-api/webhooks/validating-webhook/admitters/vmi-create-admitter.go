@@ -24,9 +24,11 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
+	"unicode"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
@@ -34,12 +36,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
+	k6tconfig "kubevirt.io/kubevirt/pkg/config"
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	draadmitter "kubevirt.io/kubevirt/pkg/dra/admitter"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/ignition"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
@@ -48,9 +54,11 @@ import (
 
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 const requiredFieldFmt = "%s is a required field"
@@ -63,10 +71,17 @@ const (
 	cloudInitUserMaxLen    = 2048
 	cloudInitNetworkMaxLen = 2048
 
+	// ignitionMaxLen limits the inline Ignition configs for the same reason, larger
+	// configs should be read from Secret or ConfigMap fragments
+	ignitionMaxLen = 32768
+
 	// Copied from kubernetes/pkg/apis/core/validation/validation.go
 	maxDNSNameservers     = 3
 	maxDNSSearchPaths     = 6
 	maxDNSSearchListChars = 256
+
+	maxSMBIOSOEMStrings   = 32
+	maxSMBIOSStringLength = 255
 )
 
 var validIOThreadsPolicies = []v1.IOThreadsPolicy{v1.IOThreadsPolicyShared, v1.IOThreadsPolicyAuto, v1.IOThreadsPolicySupplementalPool}
@@ -96,9 +111,12 @@ type VMICreateAdmitter struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	SpecValidators          []SpecValidator
 	KubeVirtServiceAccounts map[string]struct{}
+	VirtClient              kubecli.KubevirtClient
+	VMIInformer             cache.SharedIndexInformer
+	VMQuotaInformer         cache.SharedIndexInformer
 }
 
-func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+func (admitter *VMICreateAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	if resp := webhookutils.ValidateSchema(v1.VirtualMachineInstanceGroupVersionKind, ar.Request.Object.Raw); resp != nil {
 		return resp
 	}
@@ -131,10 +149,39 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
-	return &admissionv1.AdmissionResponse{
-		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig),
+	// quotas are charged last, once nothing else can reject the VMI
+	if admitter.ClusterConfig.VirtualMachineQuotasEnabled() {
+		causes, err = admitter.chargeQuotas(ctx, ar.Request, vmi)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		} else if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
+	return validating_webhooks.NewPassingAdmissionResponse(warnVirtualMachineInstanceSpec(k8sfield.NewPath("spec"), &vmi.Spec, admitter.ClusterConfig)...)
+}
+
+// warnVirtualMachineInstanceSpec returns the non-fatal warnings of a VMI spec, about deprecated APIs
+// and about emulated devices which perform worse than their paravirtualized counterparts.
+func warnVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	warnings := warnDeprecatedAPIs(spec, config)
+
+	for i, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk != nil && disk.Disk.Bus == v1.DiskBusSATA {
+			warnings = append(warnings, fmt.Sprintf("%s is set to the emulated %s bus, consider the %s bus for better performance",
+				field.Child("domain", "devices", "disks").Index(i).Child("disk", "bus").String(), v1.DiskBusSATA, v1.DiskBusVirtio))
+		}
+	}
+
+	for i, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Model != "" && iface.Model != v1.VirtIO {
+			warnings = append(warnings, fmt.Sprintf("%s is set to the emulated %s model, consider the %s model for better performance",
+				field.Child("domain", "devices", "interfaces").Index(i).Child("model").String(), iface.Model, v1.VirtIO))
+		}
 	}
+
+	return warnings
 }
 
 func warnDeprecatedAPIs(spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
@@ -188,10 +235,13 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCpuRequestDoesNotExceedLimit(field, spec)...)
 	causes = append(causes, validateCpuPinning(field, spec, config)...)
 	causes = append(causes, validateNUMA(field, spec, config)...)
+	causes = append(causes, validateNestedVirtualization(field, spec, config)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
+	causes = append(causes, validatePreShutdownHook(field, spec)...)
+	causes = append(causes, validateIgnition(field, spec, config)...)
 	causes = append(causes, validateRealtime(field, spec)...)
 	causes = append(causes, validateSpecAffinity(field, spec)...)
 	causes = append(causes, validateSpecTopologySpreadConstraints(field, spec)...)
@@ -229,15 +279,26 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMDEVRamFB(field, spec)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validateSerialDevices(field, spec)...)
+	causes = append(causes, validateClock(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
+	causes = append(causes, validateFilesystemsVirtiofsOptions(field, spec)...)
 	causes = append(causes, validateVideoConfig(field, spec)...)
+	causes = append(causes, validateWatchdogAction(field, spec)...)
 	causes = append(causes, validatePanicDevices(field, spec, config)...)
+	causes = append(causes, validateGuestPanicCapture(field, spec, config)...)
 	causes = append(causes, validateRebootPolicy(field, spec, config)...)
+	causes = append(causes, validateQEMUCommandLine(field, spec, config)...)
 	causes = append(causes, validateReservedOverheadMemlock(field, spec, config)...)
+	causes = append(causes, validateMemoryKSM(field, spec, config)...)
+	causes = append(causes, validateMemoryFreePageReporting(field, spec, config)...)
+	causes = append(causes, validateMemoryOvercommitClass(field, spec, config)...)
+	causes = append(causes, validateMemoryBalloon(field, spec, config)...)
+	causes = append(causes, validateMemorySuspendToDisk(field, spec, config)...)
 
 	return causes
 }
@@ -267,6 +328,49 @@ func validateFilesystemsWithVirtIOFSEnabled(field *k8sfield.Path, spec *v1.Virtu
 	return causes
 }
 
+func validateFilesystemsVirtiofsOptions(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	names := make(map[string]struct{})
+	for idx, fs := range spec.Domain.Devices.Filesystems {
+		fsField := field.Child("domain", "devices", "filesystems").Index(idx)
+
+		if _, exists := names[fs.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("filesystem %s is defined more than once", fs.Name),
+				Field:   fsField.Child("name").String(),
+			})
+		}
+		names[fs.Name] = struct{}{}
+
+		if fs.Virtiofs == nil {
+			continue
+		}
+
+		switch fs.Virtiofs.Cache {
+		case "", v1.VirtiofsCacheAuto, v1.VirtiofsCacheAlways, v1.VirtiofsCacheNever:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not a supported virtiofs cache mode, supported modes: %s, %s, %s",
+					fs.Virtiofs.Cache, v1.VirtiofsCacheAuto, v1.VirtiofsCacheAlways, v1.VirtiofsCacheNever),
+				Field: fsField.Child("virtiofs", "cache").String(),
+			})
+		}
+
+		if size := fs.Virtiofs.DAXWindowSize; size != nil {
+			if size.Sign() <= 0 || size.Value()%virtiofs.DAXWindowAlignment.Value() != 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("the DAX window size must be a positive multiple of %s", virtiofs.DAXWindowAlignment.String()),
+					Field:   fsField.Child("virtiofs", "daxWindowSize").String(),
+				})
+			}
+		}
+	}
+
+	return causes
+}
+
 func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -448,6 +552,16 @@ func validateProbe(field *k8sfield.Path, probe *v1.Probe) []metav1.StatusCause {
 	if probe.GuestAgentPing != nil {
 		numHandlers++
 	}
+	if probe.GuestAgentFileExists != nil {
+		numHandlers++
+		if probe.GuestAgentFileExists.Path == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must be set", field.Child("guestAgentFileExists", "path")),
+				Field:   field.Child("guestAgentFileExists", "path").String(),
+			})
+		}
+	}
 
 	if numHandlers > 1 {
 		causes = append(causes, metav1.StatusCause{
@@ -500,6 +614,7 @@ func isValidEvictionStrategy(evictionStrategy *v1.EvictionStrategy) bool {
 	return evictionStrategy == nil ||
 		*evictionStrategy == v1.EvictionStrategyLiveMigrate ||
 		*evictionStrategy == v1.EvictionStrategyLiveMigrateIfPossible ||
+		*evictionStrategy == v1.EvictionStrategyLiveMigrateOrShutdown ||
 		*evictionStrategy == v1.EvictionStrategyNone ||
 		*evictionStrategy == v1.EvictionStrategyExternal
 }
@@ -518,6 +633,13 @@ func validateLiveMigration(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 			Field:   field.Child("evictionStrategy").String(),
 		})
 	}
+	if spec.EvictionMigrationTimeoutSeconds != nil && *spec.EvictionMigrationTimeoutSeconds <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("evictionMigrationTimeoutSeconds").String()),
+			Field:   field.Child("evictionMigrationTimeoutSeconds").String(),
+		})
+	}
 	return causes
 }
 
@@ -565,7 +687,7 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 		return causes
 	}
 	model := spec.Domain.Devices.Sound.Model
-	if model != "" && model != "ich9" && model != "ac97" {
+	if model != "" && model != v1.SoundModelICH9 && model != v1.SoundModelAC97 {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: "Sound device type is not supported. Options: 'ich9' or 'ac97'",
@@ -583,6 +705,96 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+// maxSerialPorts is the number of serial ports which can be added next to the default serial console
+const maxSerialPorts = 3
+
+func validateSerialDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	names := make(map[string]struct{})
+	serialPorts := 0
+	for idx, serial := range spec.Domain.Devices.Serials {
+		serialField := field.Child("domain", "devices", "serials").Index(idx)
+
+		// the name is part of the path of the serial device socket
+		if errors := validation.IsDNS1123Label(serial.Name); len(errors) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s does not conform to the kubernetes DNS_LABEL rules : %s", serialField.Child("name").String(), strings.Join(errors, ", ")),
+				Field:   serialField.Child("name").String(),
+			})
+		}
+		if _, exists := names[serial.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("serial device %s is defined more than once", serial.Name),
+				Field:   serialField.Child("name").String(),
+			})
+		}
+		names[serial.Name] = struct{}{}
+
+		switch serial.Type {
+		case "", v1.SerialDeviceTypeSerial:
+			serialPorts++
+		case v1.SerialDeviceTypeVirtio:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not a supported serial device type, supported types: %s, %s", serial.Type, v1.SerialDeviceTypeSerial, v1.SerialDeviceTypeVirtio),
+				Field:   serialField.Child("type").String(),
+			})
+		}
+	}
+
+	if serialPorts > maxSerialPorts {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("at most %d serial devices of type %s are supported", maxSerialPorts, v1.SerialDeviceTypeSerial),
+			Field:   field.Child("domain", "devices", "serials").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateClock(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	clock := spec.Domain.Clock
+	if clock == nil {
+		return causes
+	}
+	clockField := field.Child("domain", "clock")
+
+	if variable := clock.Variable; variable != nil {
+		if clock.UTC != nil || clock.Timezone != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can not be combined with utc or timezone", clockField.Child("variable").String()),
+				Field:   clockField.Child("variable").String(),
+			})
+		}
+		switch variable.Basis {
+		case "", v1.ClockOffsetBasisUTC, v1.ClockOffsetBasisLocaltime:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not a supported clock offset basis, supported bases: %s, %s", variable.Basis, v1.ClockOffsetBasisUTC, v1.ClockOffsetBasisLocaltime),
+				Field:   clockField.Child("variable", "basis").String(),
+			})
+		}
+	}
+
+	if clock.GuestTimeSync != nil && clock.GuestTimeSync.TimeoutSeconds != nil && *clock.GuestTimeSync.TimeoutSeconds <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", clockField.Child("guestTimeSync", "timeoutSeconds").String()),
+			Field:   clockField.Child("guestTimeSync", "timeoutSeconds").String(),
+		})
+	}
+
+	return causes
+}
+
 func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	launchSecurity := spec.Domain.LaunchSecurity
@@ -760,6 +972,152 @@ func validateNUMA(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, con
 			})
 		}
 	}
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NUMA != nil {
+		causes = append(causes, validateGuestNUMATopology(field, spec, config)...)
+	}
+	return causes
+}
+
+func validateGuestNUMATopology(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	numa := spec.Domain.CPU.NUMA
+	numaField := field.Child("domain", "cpu", "numa")
+
+	if numa.EvenSplit == nil && len(numa.Cells) == 0 {
+		return causes
+	}
+	if numa.GuestMappingPassthrough != nil || (numa.EvenSplit != nil && len(numa.Cells) > 0) {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("only one NUMA topology strategy can be set in %s", numaField.String()),
+			Field:   numaField.String(),
+		})
+	}
+	if !config.NUMAEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("NUMA feature gate is not enabled in kubevirt-config, invalid entry %s", numaField.String()),
+			Field:   numaField.String(),
+		})
+	}
+
+	// hotpluggable vCPUs have to be part of the guest NUMA topology as well
+	cpu := spec.Domain.CPU.DeepCopy()
+	if cpu.MaxSockets > cpu.Sockets {
+		cpu.Sockets = cpu.MaxSockets
+	}
+	vcpus := hwutil.GetNumberOfVCPUs(cpu)
+	if vcpus == 0 {
+		return append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("the CPU topology must be set in %s when a NUMA topology strategy is set in %s",
+				field.Child("domain", "cpu").String(), numaField.String()),
+			Field: field.Child("domain", "cpu").String(),
+		})
+	}
+	threads := int64(max(cpu.Threads, 1))
+
+	var pageSize int64
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Hugepages != nil {
+		if quantity, err := resource.ParseQuantity(spec.Domain.Memory.Hugepages.PageSize); err == nil {
+			pageSize = quantity.Value()
+		}
+	}
+
+	guestMemory := spec.Domain.Resources.Requests.Memory()
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		guestMemory = spec.Domain.Memory.Guest
+	}
+
+	if numa.EvenSplit != nil {
+		evenSplitField := numaField.Child("evenSplit", "nodes")
+		nodes := int64(numa.EvenSplit.Nodes)
+		switch {
+		case nodes == 0:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", evenSplitField.String()),
+				Field:   evenSplitField.String(),
+			})
+		case vcpus%nodes != 0:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%d vCPUs cannot be split evenly between %d numa nodes", vcpus, nodes),
+				Field:   evenSplitField.String(),
+			})
+		case spec.Domain.CPU.DedicatedCPUPlacement && (vcpus/nodes)%threads != 0:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("numa nodes must not split the threads of a core when %s is set", field.Child("domain", "cpu", "dedicatedCpuPlacement").String()),
+				Field:   evenSplitField.String(),
+			})
+		case guestMemory.Value()%nodes != 0 || (pageSize > 0 && (guestMemory.Value()/nodes)%pageSize != 0):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("guest memory %s cannot be split evenly between %d numa nodes", guestMemory.String(), nodes),
+				Field:   evenSplitField.String(),
+			})
+		}
+		return causes
+	}
+
+	assigned := map[int]bool{}
+	memorySum := resource.NewQuantity(0, resource.BinarySI)
+	for i, cell := range numa.Cells {
+		cellField := numaField.Child("cells").Index(i)
+		cellCPUs, err := hwutil.ParseCPUSetLine(cell.CPUs, hwutil.MAX_CPU_LIMIT)
+		if err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid CPU set: %v", cellField.Child("cpus").String(), err),
+				Field:   cellField.Child("cpus").String(),
+			})
+			continue
+		}
+		for _, cellCPU := range cellCPUs {
+			if int64(cellCPU) >= vcpus || assigned[cellCPU] {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("vCPU %d in %s does not exist or is already assigned to another numa node", cellCPU, cellField.Child("cpus").String()),
+					Field:   cellField.Child("cpus").String(),
+				})
+				continue
+			}
+			assigned[cellCPU] = true
+		}
+		if spec.Domain.CPU.DedicatedCPUPlacement && int64(len(cellCPUs))%threads != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("numa nodes must not split the threads of a core when %s is set", field.Child("domain", "cpu", "dedicatedCpuPlacement").String()),
+				Field:   cellField.Child("cpus").String(),
+			})
+		}
+
+		if cell.Memory.Sign() <= 0 || (pageSize > 0 && cell.Memory.Value()%pageSize != 0) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a positive amount of memory aligned to the page size", cellField.Child("memory").String()),
+				Field:   cellField.Child("memory").String(),
+			})
+		}
+		memorySum.Add(cell.Memory)
+	}
+
+	if int64(len(assigned)) != vcpus {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("all %d vCPUs must be assigned to a numa node in %s", vcpus, numaField.Child("cells").String()),
+			Field:   numaField.Child("cells").String(),
+		})
+	}
+	if memorySum.Cmp(*guestMemory) != 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("the memory of all numa nodes in %s must add up to the guest memory %s", numaField.Child("cells").String(), guestMemory.String()),
+			Field:   numaField.Child("cells").String(),
+		})
+	}
+
 	return causes
 }
 
@@ -785,6 +1143,38 @@ func validateThreadCountOnArchitecture(field *k8sfield.Path, spec *v1.VirtualMac
 	return causes
 }
 
+func validateNestedVirtualization(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.CPU == nil {
+		return causes
+	}
+
+	nestedField := field.Child("domain", "cpu", "nestedVirtualization")
+	switch spec.Domain.CPU.NestedVirtualization {
+	case "", v1.NestedVirtualizationForbid:
+	case v1.NestedVirtualizationRequire:
+		arch := spec.Architecture
+		if arch == "" {
+			arch = config.GetDefaultArchitecture()
+		}
+		// VMX and SVM are only exposed to guests on amd64
+		if arch != "amd64" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("nested virtualization is not supported on %s architecture", arch),
+				Field:   nestedField.String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("nested virtualization policy %s is not supported, must be %s or %s", spec.Domain.CPU.NestedVirtualization, v1.NestedVirtualizationRequire, v1.NestedVirtualizationForbid),
+			Field:   nestedField.String(),
+		})
+	}
+	return causes
+}
+
 func validateThreadCountOnDedicatedCPUPlacement(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.Threads > 2 {
@@ -873,69 +1263,258 @@ func validateStartStrategy(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 	return causes
 }
 
-func validateMemoryRequestsAndLimits(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+func validatePreShutdownHook(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
-	if spec.Domain.Resources.Requests.Memory().Value() > 0 && spec.Domain.Resources.Limits.Memory().Value() > 0 && spec.Domain.Resources.Requests.Memory().Value() != spec.Domain.Resources.Limits.Memory().Value() {
+	hook := spec.PreShutdownHook
+	if hook == nil {
+		return causes
+	}
+
+	hookField := field.Child("preShutdownHook")
+	if hook.Command == "" {
 		causes = append(causes, metav1.StatusCause{
-			Type: metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("%s must be equal to %s",
-				field.Child("domain", "resources", "requests", "memory").String(),
-				field.Child("domain", "resources", "limits", "memory").String(),
-			),
-			Field: field.Child("domain", "resources", "requests", "memory").String(),
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be set", hookField.Child("command").String()),
+			Field:   hookField.Child("command").String(),
 		})
 	}
-	return causes
-}
 
-func validateCPULimitIsInteger(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
-	var causes []metav1.StatusCause
-	if spec.Domain.Resources.Limits.Cpu().Value() > 0 && spec.Domain.Resources.Limits.Cpu().Value()*1000 != spec.Domain.Resources.Limits.Cpu().MilliValue() {
+	timeout := v1.DefaultPreShutdownHookTimeoutSeconds
+	if hook.TimeoutSeconds != nil {
+		timeout = *hook.TimeoutSeconds
+	}
+	gracePeriod := v1.DefaultGracePeriodSeconds
+	if spec.TerminationGracePeriodSeconds != nil {
+		gracePeriod = *spec.TerminationGracePeriodSeconds
+	}
+	if timeout < 1 {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "provided resources CPU limits must be an interger",
-			Field:   field.Child("domain", "resources", "limits", "cpu").String(),
+			Message: fmt.Sprintf("%s must be greater than 0", hookField.Child("timeoutSeconds").String()),
+			Field:   hookField.Child("timeoutSeconds").String(),
+		})
+	} else if timeout >= gracePeriod {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be shorter than the termination grace period of %d seconds, to leave time to the ACPI shutdown",
+				hookField.Child("timeoutSeconds").String(), gracePeriod),
+			Field: hookField.Child("timeoutSeconds").String(),
 		})
 	}
+
 	return causes
 }
 
-func validateCPURequestIsInteger(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+func validateIgnition(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
-	if spec.Domain.Resources.Requests.Cpu().Value() > 0 && spec.Domain.Resources.Requests.Cpu().Value()*1000 != spec.Domain.Resources.Requests.Cpu().MilliValue() {
+	causes = append(causes, validateCloudInitConfigDriveIgnition(field, spec)...)
+	if spec.Ignition == nil {
+		return causes
+	}
+
+	ignitionField := field.Child("ignition")
+	if !config.IgnitionEnabled() {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "provided resources CPU requests must be an interger",
-			Field:   field.Child("domain", "resources", "requests", "cpu").String(),
+			Message: fmt.Sprintf("ExperimentalIgnitionSupport feature gate is not enabled in kubevirt-config, invalid entry %s", ignitionField.String()),
+			Field:   ignitionField.String(),
 		})
 	}
-	return causes
-}
-
-func validateMemoryIsProvided(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
-	generateErrorCause := func() []metav1.StatusCause {
-		return []metav1.StatusCause{{
-			Type: metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("%s, %s, %s or %s should be provided",
-				field.Child("domain", "resources", "requests", "memory").String(),
-				field.Child("domain", "resources", "limits", "memory").String(),
-				field.Child("domain", "memory", "hugepages").String(),
-				field.Child("domain", "memory", "guest").String(),
-			),
-			Field: field.Child("domain", "resources", "limits", "memory").String(),
-		}}
-	}
 
-	if !spec.Domain.Resources.Limits.Memory().IsZero() {
-		return nil
+	fragments := spec.Ignition.Fragments
+	if len(fragments) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must have at least one fragment", ignitionField.Child("fragments").String()),
+			Field:   ignitionField.Child("fragments").String(),
+		})
 	}
 
-	if !spec.Domain.Resources.Requests.Memory().IsZero() {
-		return nil
+	dataLen := 0
+	for idx, fragment := range fragments {
+		fragmentField := ignitionField.Child("fragments").Index(idx)
+		switch {
+		case fragment.Data != "" && fragment.VolumeName != "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must have only one of data and volumeName set", fragmentField.String()),
+				Field:   fragmentField.String(),
+			})
+		case fragment.Data != "":
+			dataLen += len(fragment.Data)
+			if err := ignition.ValidateConfig(fragment.Data, len(fragments) > 1); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s: %v", fragmentField.Child("data").String(), err),
+					Field:   fragmentField.Child("data").String(),
+				})
+			}
+		case fragment.VolumeName != "":
+			causes = append(causes, validateIgnitionFragmentVolume(fragmentField.Child("volumeName"), fragment.VolumeName, spec.Volumes)...)
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must have one of data and volumeName set", fragmentField.String()),
+				Field:   fragmentField.String(),
+			})
+		}
 	}
 
-	if spec.Domain.Memory == nil {
-		return generateErrorCause()
+	if dataLen > ignitionMaxLen {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s inline data exceeds %d byte limit. Should use Secret or ConfigMap fragments for larger data.",
+				ignitionField.Child("fragments").String(), ignitionMaxLen),
+			Field: ignitionField.Child("fragments").String(),
+		})
+	}
+
+	switch spec.Ignition.Propagation {
+	case "", v1.IgnitionPropagationFirmwareConfig, v1.IgnitionPropagationConfigDrive:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s and %s", ignitionField.Child("propagation").String(),
+				v1.IgnitionPropagationFirmwareConfig, v1.IgnitionPropagationConfigDrive),
+			Field: ignitionField.Child("propagation").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateIgnitionFragmentVolume(field *k8sfield.Path, volumeName string, volumes []v1.Volume) []metav1.StatusCause {
+	for _, volume := range volumes {
+		if volume.Name != volumeName {
+			continue
+		}
+		if volume.Secret != nil || volume.ConfigMap != nil {
+			return nil
+		}
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must refer to a Secret or ConfigMap volume", field.String()),
+			Field:   field.String(),
+		}}
+	}
+
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s does not have a matching Volume", field.String()),
+		Field:   field.String(),
+	}}
+}
+
+// validateCloudInitConfigDriveIgnition checks that the cloudInitConfigDrive volume has user data
+// unless the Ignition config is propagated through it, in which case it must not have any.
+func validateCloudInitConfigDriveIgnition(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	usesConfigDrive := spec.Ignition != nil && spec.Ignition.Propagation == v1.IgnitionPropagationConfigDrive
+
+	foundConfigDrive := false
+	for idx, volume := range spec.Volumes {
+		source := volume.CloudInitConfigDrive
+		if source == nil {
+			continue
+		}
+		foundConfigDrive = true
+
+		sourceField := field.Child("volumes").Index(idx).Child("cloudInitConfigDrive")
+		hasUserData := source.UserData != "" || source.UserDataBase64 != "" ||
+			(source.UserDataSecretRef != nil && source.UserDataSecretRef.Name != "")
+		hasNetworkData := source.NetworkData != "" || source.NetworkDataBase64 != "" ||
+			(source.NetworkDataSecretRef != nil && source.NetworkDataSecretRef.Name != "")
+
+		if usesConfigDrive && hasUserData {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not have a userdatasource set, the Ignition config is propagated as its userdata",
+					sourceField.String()),
+				Field: sourceField.String(),
+			})
+		} else if !usesConfigDrive && !hasUserData && !hasNetworkData {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must have at least one userdatasource or one networkdatasource set.", sourceField.String()),
+				Field:   sourceField.String(),
+			})
+		}
+	}
+
+	if usesConfigDrive && !foundConfigDrive {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires a cloudInitConfigDrive volume", field.Child("ignition", "propagation").String()),
+			Field:   field.Child("ignition", "propagation").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateMemoryRequestsAndLimits(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Resources.Requests.Memory().Value() > 0 && spec.Domain.Resources.Limits.Memory().Value() > 0 && spec.Domain.Resources.Requests.Memory().Value() != spec.Domain.Resources.Limits.Memory().Value() {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be equal to %s",
+				field.Child("domain", "resources", "requests", "memory").String(),
+				field.Child("domain", "resources", "limits", "memory").String(),
+			),
+			Field: field.Child("domain", "resources", "requests", "memory").String(),
+		})
+	}
+	return causes
+}
+
+func validateCPULimitIsInteger(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Resources.Limits.Cpu().Value() > 0 && spec.Domain.Resources.Limits.Cpu().Value()*1000 != spec.Domain.Resources.Limits.Cpu().MilliValue() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "provided resources CPU limits must be an interger",
+			Field:   field.Child("domain", "resources", "limits", "cpu").String(),
+		})
+	}
+	return causes
+}
+
+func validateCPURequestIsInteger(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Resources.Requests.Cpu().Value() > 0 && spec.Domain.Resources.Requests.Cpu().Value()*1000 != spec.Domain.Resources.Requests.Cpu().MilliValue() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "provided resources CPU requests must be an interger",
+			Field:   field.Child("domain", "resources", "requests", "cpu").String(),
+		})
+	}
+	return causes
+}
+
+func validateMemoryIsProvided(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	generateErrorCause := func() []metav1.StatusCause {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s, %s, %s or %s should be provided",
+				field.Child("domain", "resources", "requests", "memory").String(),
+				field.Child("domain", "resources", "limits", "memory").String(),
+				field.Child("domain", "memory", "hugepages").String(),
+				field.Child("domain", "memory", "guest").String(),
+			),
+			Field: field.Child("domain", "resources", "limits", "memory").String(),
+		}}
+	}
+
+	if !spec.Domain.Resources.Limits.Memory().IsZero() {
+		return nil
+	}
+
+	if !spec.Domain.Resources.Requests.Memory().IsZero() {
+		return nil
+	}
+
+	if spec.Domain.Memory == nil {
+		return generateErrorCause()
 	}
 
 	if spec.Domain.Memory.Hugepages != nil {
@@ -1180,6 +1759,14 @@ func validateRealtime(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec)
 
 func validateCPURealtime(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
+	if profile := spec.Domain.CPU.Realtime.Profile; profile != "" && profile != v1.RealtimeProfileLowLatency {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not a supported realtime profile, supported profiles: %s",
+				profile, v1.RealtimeProfileLowLatency),
+			Field: field.Child("domain", "cpu", "realtime", "profile").String(),
+		})
+	}
 	if !spec.Domain.CPU.DedicatedCPUPlacement {
 		causes = append(causes, metav1.StatusCause{
 			Type: metav1.CauseTypeFieldValueRequired,
@@ -1190,6 +1777,16 @@ func validateCPURealtime(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSp
 			Field: field.Child("domain", "cpu", "dedicatedCpuPlacement").String(),
 		})
 	}
+	if spec.Domain.CPU.Realtime.Profile == v1.RealtimeProfileLowLatency && !spec.Domain.CPU.IsolateEmulatorThread {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be set to true when the %s realtime profile is used",
+				field.Child("domain", "cpu", "isolateEmulatorThread").String(),
+				v1.RealtimeProfileLowLatency,
+			),
+			Field: field.Child("domain", "cpu", "isolateEmulatorThread").String(),
+		})
+	}
 	return causes
 }
 
@@ -1266,6 +1863,15 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 		})
 	}
 
+	if len(annotations[v1.IgnitionAnnotation]) > ignitionMaxLen {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s exceeds %d byte limit. Should use Secret or ConfigMap fragments in spec.ignition for larger data.",
+				field.Child("annotations").Child(v1.IgnitionAnnotation).String(), ignitionMaxLen),
+			Field: field.Child("annotations").String(),
+		})
+	}
+
 	// Validate sidecar feature gate if set when the corresponding annotation is found
 	if annotations[hooks.HookSidecarListAnnotationName] != "" && !config.SidecarEnabled() {
 		causes = append(causes, metav1.StatusCause{
@@ -1447,6 +2053,7 @@ func validateFirmware(field *k8sfield.Path, firmware *v1.Firmware) []metav1.Stat
 	if firmware != nil {
 		causes = append(causes, validateBootloader(field.Child("bootloader"), firmware.Bootloader)...)
 		causes = append(causes, validateKernelBoot(field.Child("kernelBoot"), firmware.KernelBoot)...)
+		causes = append(causes, validateSMBIOS(field.Child("smbios"), firmware.SMBIOS)...)
 	}
 
 	return causes
@@ -1611,6 +2218,78 @@ func validateAccessCredentials(field *k8sfield.Path, accessCredentials []v1.Acce
 	return causes
 }
 
+// validateSysprepSources checks that the sources of a Sysprep volume do not overwrite each other's
+// files, and that one of them selects the answer file, which is required to create the disk.
+func validateSysprepSources(field *k8sfield.Path, source *v1.SysprepSource) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(source.Sources) == 0 {
+		return causes
+	}
+
+	if source.Secret != nil || source.ConfigMap != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with secret or configMap", field.Child("sources").String()),
+			Field:   field.Child("sources").String(),
+		})
+	}
+
+	hasAnswerFile := false
+	paths := map[string]string{}
+	for idx, projection := range source.Sources {
+		projectionField := field.Child("sources").Index(idx)
+		hasSecret := projection.Secret != nil && projection.Secret.Name != ""
+		hasConfigMap := projection.ConfigMap != nil && projection.ConfigMap.Name != ""
+		if hasSecret == hasConfigMap {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must have exactly one of secret and configMap set", projectionField.String()),
+				Field:   projectionField.String(),
+			})
+		}
+
+		// All keys are added when no items are set, the answer file is then only checked by virt-launcher
+		if len(projection.Items) == 0 {
+			hasAnswerFile = true
+		}
+		for itemIdx, item := range projection.Items {
+			pathField := projectionField.Child("items").Index(itemIdx).Child("path")
+			cleanPath := path.Clean(item.Path)
+			switch {
+			case item.Path == "" || path.IsAbs(item.Path) || slices.Contains(strings.Split(item.Path, "/"), ".."):
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be a relative path without '..'", pathField.String()),
+					Field:   pathField.String(),
+				})
+			case paths[cleanPath] != "":
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueDuplicate,
+					Message: fmt.Sprintf("%s %s is already added by %s", pathField.String(), item.Path, paths[cleanPath]),
+					Field:   pathField.String(),
+				})
+			default:
+				paths[cleanPath] = pathField.String()
+			}
+
+			if name := strings.ToLower(cleanPath); name == k6tconfig.AutounattendFilename || name == k6tconfig.UnattendFilename {
+				hasAnswerFile = true
+			}
+		}
+	}
+
+	if !hasAnswerFile {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must add %s or %s to the root of the disk, through the items of one source",
+				field.Child("sources").String(), k6tconfig.AutounattendFilename, k6tconfig.UnattendFilename),
+			Field: field.Child("sources").String(),
+		})
+	}
+
+	return causes
+}
+
 func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	nameMap := make(map[string]int)
@@ -1784,6 +2463,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				networkDataSourceCount++
 				networkDataLen = len(networkData)
 			}
+			if volume.CloudInitNoCloud != nil && volume.CloudInitNoCloud.GenerateNetworkData {
+				networkDataSourceCount++
+			}
 
 			if networkDataSourceCount > 1 {
 				causes = append(causes, metav1.StatusCause{
@@ -1801,7 +2483,8 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				})
 			}
 
-			if userDataSourceCount == 0 && networkDataSourceCount == 0 {
+			// An empty cloudInitConfigDrive volume may receive the Ignition config, see validateCloudInitConfigDriveIgnition
+			if userDataSourceCount == 0 && networkDataSourceCount == 0 && volume.CloudInitNoCloud != nil {
 				causes = append(causes, metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
 					Message: fmt.Sprintf("%s must have at least one userdatasource or one networkdatasource set.", field.Index(idx).Child(dataSourceType).String()),
@@ -1810,6 +2493,10 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			}
 		}
 
+		if volume.Sysprep != nil {
+			causes = append(causes, validateSysprepSources(field.Index(idx).Child("sysprep"), volume.Sysprep)...)
+		}
+
 		if volume.DownwardMetrics != nil && !config.DownwardMetricsEnabled() {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -1957,6 +2644,75 @@ func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav
 	return causes
 }
 
+func validateSMBIOS(field *k8sfield.Path, smbios *v1.SMBIOS) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if smbios == nil {
+		return causes
+	}
+
+	if system := smbios.System; system != nil {
+		systemField := field.Child("system")
+		causes = append(causes, validateSMBIOSString(systemField.Child("manufacturer"), system.Manufacturer)...)
+		causes = append(causes, validateSMBIOSString(systemField.Child("product"), system.Product)...)
+		causes = append(causes, validateSMBIOSString(systemField.Child("version"), system.Version)...)
+		causes = append(causes, validateSMBIOSString(systemField.Child("sku"), system.Sku)...)
+		causes = append(causes, validateSMBIOSString(systemField.Child("family"), system.Family)...)
+	}
+
+	if baseBoard := smbios.BaseBoard; baseBoard != nil {
+		baseBoardField := field.Child("baseBoard")
+		causes = append(causes, validateSMBIOSString(baseBoardField.Child("manufacturer"), baseBoard.Manufacturer)...)
+		causes = append(causes, validateSMBIOSString(baseBoardField.Child("product"), baseBoard.Product)...)
+		causes = append(causes, validateSMBIOSString(baseBoardField.Child("version"), baseBoard.Version)...)
+		causes = append(causes, validateSMBIOSString(baseBoardField.Child("serial"), baseBoard.Serial)...)
+		causes = append(causes, validateSMBIOSString(baseBoardField.Child("asset"), baseBoard.Asset)...)
+	}
+
+	oemStringsField := field.Child("oemStrings")
+	if len(smbios.OEMStrings) > maxSMBIOSOEMStrings {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not contain more than %d OEM strings", oemStringsField, maxSMBIOSOEMStrings),
+			Field:   oemStringsField.String(),
+		})
+	}
+	for i, oemString := range smbios.OEMStrings {
+		if oemString == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must not be empty", oemStringsField.Index(i)),
+				Field:   oemStringsField.Index(i).String(),
+			})
+			continue
+		}
+		causes = append(causes, validateSMBIOSString(oemStringsField.Index(i), oemString)...)
+	}
+
+	return causes
+}
+
+// validateSMBIOSString ensures the value fits into an SMBIOS string and
+// is readable by the guest
+func validateSMBIOSString(field *k8sfield.Path, value string) []metav1.StatusCause {
+	if len(value) > maxSMBIOSStringLength {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be longer than %d bytes", field, maxSMBIOSStringLength),
+			Field:   field.String(),
+		}}
+	}
+
+	if strings.IndexFunc(value, func(r rune) bool { return !unicode.IsPrint(r) }) != -1 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must only contain printable characters", field),
+			Field:   field.String(),
+		}}
+	}
+
+	return nil
+}
+
 // validateSpecAffinity is function that validate spec.affinity
 // instead of bring in the whole kubernetes lib we simply copy it from kubernetes/pkg/apis/core/validation/validation.go
 func validateSpecAffinity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
@@ -2035,15 +2791,27 @@ func validatePersistentReservation(field *k8sfield.Path, spec *v1.VirtualMachine
 	return causes
 }
 
+// arm64MaxHotplugVCPUs is the number of vCPUs the QEMU virt machine type
+// can address with a GICv3, which arm64 guests need for vCPU hotplug.
+const arm64MaxHotplugVCPUs = 512
+
 func validateCPUHotplug(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.MaxSockets != 0 {
-		if spec.Domain.CPU.Sockets > spec.Domain.CPU.MaxSockets {
+		cpu := spec.Domain.CPU
+		if cpu.Sockets > cpu.MaxSockets {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: fmt.Sprintf("Number of sockets in CPU topology is greater than the maximum sockets allowed"),
 				Field:   field.Child("domain", "cpu", "sockets").String(),
 			})
+		} else if virtconfig.IsARM64(spec.Architecture) && cpu.MaxSockets > cpu.Sockets &&
+			cpu.MaxSockets*max(cpu.Cores, 1)*max(cpu.Threads, 1) > arm64MaxHotplugVCPUs {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("CPU hotplug on arm64 supports at most %d vCPUs", arm64MaxHotplugVCPUs),
+				Field:   field.Child("domain", "cpu", "maxSockets").String(),
+			})
 		}
 	}
 	return causes
@@ -2067,6 +2835,32 @@ func validateVideoConfig(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSp
 	return causes
 }
 
+func validateWatchdogAction(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	watchdog := spec.Domain.Devices.Watchdog
+	if watchdog == nil {
+		return causes
+	}
+
+	watchdogField := field.Child("domain", "devices", "watchdog")
+	if watchdog.I6300ESB != nil && watchdog.I6300ESB.Action != "" && !slices.Contains(v1.SupportedWatchdogActions(), watchdog.I6300ESB.Action) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("watchdog action '%s' is not supported", watchdog.I6300ESB.Action),
+			Field:   watchdogField.Child("i6300esb", "action").String(),
+		})
+	}
+	if watchdog.Diag288 != nil && watchdog.Diag288.Action != "" && !slices.Contains(v1.SupportedWatchdogActions(), watchdog.Diag288.Action) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("watchdog action '%s' is not supported", watchdog.Diag288.Action),
+			Field:   watchdogField.Child("diag288", "action").String(),
+		})
+	}
+
+	return causes
+}
+
 func validatePanicDeviceModel(field *k8sfield.Path, model *v1.PanicDeviceModel) *metav1.StatusCause {
 	if model == nil {
 		return nil
@@ -2109,6 +2903,58 @@ func validatePanicDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+const maxGuestPanicConsoleLogLines = 100
+
+func validateGuestPanicCapture(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	capture := spec.Domain.Devices.GuestPanicCapture
+	if capture == nil {
+		return causes
+	}
+	captureField := field.Child("domain", "devices", "guestPanicCapture")
+
+	arch := spec.Architecture
+	if arch == "" {
+		arch = config.GetDefaultArchitecture()
+	}
+	if arch == "s390x" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("guest panic capture is not supported on %s architecture", arch),
+			Field:   captureField.String(),
+		})
+	}
+
+	if capture.ConsoleLogLines != nil {
+		if *capture.ConsoleLogLines > maxGuestPanicConsoleLogLines {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not exceed %d", captureField.Child("consoleLogLines").String(), maxGuestPanicConsoleLogLines),
+				Field:   captureField.Child("consoleLogLines").String(),
+			})
+		}
+		if spec.Domain.Devices.LogSerialConsole != nil && !*spec.Domain.Devices.LogSerialConsole {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s requires the serial console log", captureField.Child("consoleLogLines").String()),
+				Field:   captureField.Child("consoleLogLines").String(),
+			})
+		}
+	}
+
+	if capture.MemoryDumpClaimName != "" {
+		if errors := validation.IsDNS1123Subdomain(capture.MemoryDumpClaimName); len(errors) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s does not conform to the kubernetes DNS_SUBDOMAIN rules : %s", captureField.Child("memoryDumpClaimName").String(), strings.Join(errors, ", ")),
+				Field:   captureField.Child("memoryDumpClaimName").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func validateRebootPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -2128,6 +2974,74 @@ func validateRebootPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validateQEMUCommandLine(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Domain.QEMUCommandLine == nil {
+		return causes
+	}
+
+	if !config.QEMUArgsPassthroughEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("QEMUCommandLine is specified but the %s feature gate is not enabled", featuregate.QEMUArgsPassthrough),
+			Field:   field.Child("domain", "qemuCommandLine").String(),
+		})
+		return causes
+	}
+
+	allowedArgs := map[string]v1.AllowedQEMUArg{}
+	if passthrough := config.GetConfig().QEMUArgsPassthrough; passthrough != nil {
+		for _, arg := range passthrough.AllowedArgs {
+			allowedArgs[arg.Name] = arg
+		}
+	}
+
+	for i, arg := range spec.Domain.QEMUCommandLine.Args {
+		argField := field.Child("domain", "qemuCommandLine", "args").Index(i)
+		if !strings.HasPrefix(arg.Name, "-") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must start with a dash", argField.Child("name").String()),
+				Field:   argField.Child("name").String(),
+			})
+			continue
+		}
+
+		allowed, ok := allowedArgs[arg.Name]
+		if !ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("QEMU argument %s is not allowed by the qemuArgsPassthrough configuration", arg.Name),
+				Field:   argField.Child("name").String(),
+			})
+			continue
+		}
+
+		if !isQEMUArgValueAllowed(arg.Value, allowed.ValuePrefixes) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("value %q of QEMU argument %s is not allowed by the qemuArgsPassthrough configuration", arg.Value, arg.Name),
+				Field:   argField.Child("value").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func isQEMUArgValueAllowed(value string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func validateReservedOverheadMemlock(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -2146,3 +3060,181 @@ func validateReservedOverheadMemlock(field *k8sfield.Path, spec *v1.VirtualMachi
 
 	return causes
 }
+
+func validateMemoryKSM(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Domain.Memory == nil || spec.Domain.Memory.KSM == nil ||
+		spec.Domain.Memory.KSM.Enabled == nil || !*spec.Domain.Memory.KSM.Enabled {
+		return causes
+	}
+
+	if config.GetKSMConfiguration() == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "KSM cannot be enabled for the VM because KSM is not configured in kubevirt-config",
+			Field:   field.Child("domain", "memory", "ksm", "enabled").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateMemoryFreePageReporting(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Domain.Memory == nil || spec.Domain.Memory.FreePageReporting == nil ||
+		spec.Domain.Memory.FreePageReporting.Enabled == nil || !*spec.Domain.Memory.FreePageReporting.Enabled {
+		return causes
+	}
+
+	fieldPath := field.Child("domain", "memory", "freePageReporting", "enabled").String()
+	switch {
+	case config.IsFreePageReportingDisabled():
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Free page reporting cannot be enabled for the VM because it is disabled in kubevirt-config",
+			Field:   fieldPath,
+		})
+	case spec.Domain.Devices.AutoattachMemBalloon != nil && !*spec.Domain.Devices.AutoattachMemBalloon:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Free page reporting requires the memory balloon device to be attached",
+			Field:   fieldPath,
+		})
+	case (&v1.VirtualMachineInstance{Spec: *spec}).IsHighPerformanceVMI():
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Free page reporting is not supported for VMs with dedicated CPUs, realtime or hugepages",
+			Field:   fieldPath,
+		})
+	}
+
+	return causes
+}
+
+func validateMemoryOvercommitClass(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Domain.Memory == nil || spec.Domain.Memory.OvercommitClass == "" {
+		return causes
+	}
+
+	fieldPath := field.Child("domain", "memory", "overcommitClass").String()
+	class := config.GetMemoryOvercommitClass(spec.Domain.Memory.OvercommitClass)
+	switch {
+	case class == nil:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Memory overcommit class %s is not defined in kubevirt-config", spec.Domain.Memory.OvercommitClass),
+			Field:   fieldPath,
+		})
+	case class.Swap && (&v1.VirtualMachineInstance{Spec: *spec}).IsHighPerformanceVMI():
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Memory overcommit class %s allows swap, which is not supported for VMs with dedicated CPUs, realtime or hugepages", class.Name),
+			Field:   fieldPath,
+		})
+	}
+
+	return causes
+}
+
+func validateMemoryBalloon(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Balloon == nil {
+		return causes
+	}
+
+	balloon := spec.Domain.Memory.Balloon
+	fieldPath := field.Child("domain", "memory", "balloon")
+	guestMemory := balloonGuestMemory(spec)
+	switch {
+	case !config.AutoMemoryBalloonEnabled():
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.AutoMemoryBalloon),
+			Field:   fieldPath.String(),
+		})
+	case spec.Domain.Devices.AutoattachMemBalloon != nil && !*spec.Domain.Devices.AutoattachMemBalloon:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Memory balloon bounds require the memory balloon device to be attached",
+			Field:   fieldPath.String(),
+		})
+	case balloon.Min.Sign() <= 0:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Memory balloon min must be greater than zero",
+			Field:   fieldPath.Child("min").String(),
+		})
+	case balloon.Max != nil && balloon.Max.Cmp(balloon.Min) < 0:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Memory balloon max %s must not be less than min %s", balloon.Max.String(), balloon.Min.String()),
+			Field:   fieldPath.Child("max").String(),
+		})
+	case guestMemory != nil && balloon.Max != nil && balloon.Max.Cmp(*guestMemory) > 0:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Memory balloon max %s must not exceed the guest memory %s", balloon.Max.String(), guestMemory.String()),
+			Field:   fieldPath.Child("max").String(),
+		})
+	case guestMemory != nil && balloon.Min.Cmp(*guestMemory) > 0:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Memory balloon min %s must not exceed the guest memory %s", balloon.Min.String(), guestMemory.String()),
+			Field:   fieldPath.Child("min").String(),
+		})
+	case (&v1.VirtualMachineInstance{Spec: *spec}).IsHighPerformanceVMI():
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Memory balloon bounds are not supported for VMs with dedicated CPUs, realtime or hugepages",
+			Field:   fieldPath.String(),
+		})
+	}
+
+	return causes
+}
+
+// balloonGuestMemory returns the memory the guest boots with, which the balloon can not grow beyond.
+// The guest memory defaults to the memory request or limit, it is nil if neither is known yet.
+func balloonGuestMemory(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory.Guest != nil {
+		return spec.Domain.Memory.Guest
+	}
+	if memory, exists := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; exists {
+		return &memory
+	}
+	if memory, exists := spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; exists {
+		return &memory
+	}
+	return nil
+}
+
+func validateMemorySuspendToDisk(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Domain.Memory == nil || spec.Domain.Memory.SuspendToDisk == nil || !*spec.Domain.Memory.SuspendToDisk {
+		return causes
+	}
+
+	fieldPath := field.Child("domain", "memory", "suspendToDisk")
+	switch {
+	case !config.SuspendToDiskEnabled():
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.SuspendToDiskGate),
+			Field:   fieldPath.String(),
+		})
+	case len(spec.Domain.Devices.HostDevices) > 0 || len(spec.Domain.Devices.GPUs) > 0:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Suspend to disk is not supported for VMs with host devices or GPUs",
+			Field:   fieldPath.String(),
+		})
+	}
+
+	return causes
+}
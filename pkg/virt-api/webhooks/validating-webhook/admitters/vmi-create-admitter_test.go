@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"runtime"
+	"slices"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -236,6 +237,9 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("eviction strategy to be set to LiveMigrateIfPossible",
 				newBaseVmi(libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrateIfPossible)),
 			),
+			Entry("eviction strategy to be set to LiveMigrateOrShutdown",
+				newBaseVmi(libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrateOrShutdown)),
+			),
 			Entry("eviction strategy to be set to nil (unspecified)",
 				newBaseVmi(),
 			),
@@ -252,6 +256,19 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(resp.Allowed).To(BeFalse())
 			Expect(resp.Result.Message).To(Equal("spec.evictionStrategy is set with an unrecognized option: fantasy"))
 		})
+
+		It("should not allow an eviction migration timeout of zero", func() {
+			vmi := newBaseVmi(libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrateOrShutdown))
+			vmi.Spec.EvictionMigrationTimeoutSeconds = pointer.P(int64(0))
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(Equal("spec.evictionMigrationTimeoutSeconds must be greater than zero"))
+		})
 	})
 
 	Context("with probes given", func() {
@@ -300,6 +317,41 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(resp.Allowed).To(BeFalse())
 			Expect(resp.Result.Message).To(Equal(`spec.readinessProbe must have exactly one probe type set, spec.livenessProbe must have exactly one probe type set`))
 		})
+		It("should reject a guest agent file exists probe without a path", func() {
+			vmi := newBaseVmi(
+				withReadinessProbe(&v1.Probe{
+					InitialDelaySeconds: 2,
+					Handler: v1.Handler{
+						GuestAgentFileExists: &v1.GuestAgentFileExists{},
+					},
+				}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.readinessProbe.guestAgentFileExists.path"))
+		})
+		It("should accept guest agent file exists probes without a pod network", func() {
+			vmi := newBaseVmi(
+				libvmi.WithAutoAttachPodInterface(false),
+				withReadinessProbe(&v1.Probe{
+					InitialDelaySeconds: 2,
+					Handler: v1.Handler{
+						GuestAgentFileExists: &v1.GuestAgentFileExists{Path: "/tmp/ready"},
+					},
+				}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
 		It("should accept properly configured readiness and liveness probes", func() {
 			vmi := newBaseVmi(
 				libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
@@ -490,6 +542,16 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				featuregate.SidecarGate,
 			),
 		)
+
+		It("should reject an Ignition annotation exceeding the size limit", func() {
+			enableFeatureGates(featuregate.IgnitionGate)
+			vmi := newBaseVmi()
+			vmi.Annotations = map[string]string{v1.IgnitionAnnotation: strings.Repeat("a", 32769)}
+
+			causes := ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, config, false)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("exceeds 32768 byte limit"))
+		})
 	})
 
 	Context("with VirtualMachineInstance spec", func() {
@@ -1122,6 +1184,23 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(resp.Warnings).To(HaveLen(1))
 		})
 
+		It("should raise a warning when emulated devices are used", func() {
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "virtio", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				{Name: "sata", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}}},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "default", Model: "e1000"},
+				{Name: "secondary"},
+			}
+
+			warnings := warnVirtualMachineInstanceSpec(k8sfield.NewPath("spec"), &vmi.Spec, config)
+			Expect(warnings).To(ConsistOf(
+				HavePrefix("spec.domain.devices.disks[1].disk.bus is set to the emulated sata bus"),
+				HavePrefix("spec.domain.devices.interfaces[0].model is set to the emulated e1000 model"),
+			))
+		})
+
 		It("should allow BlockMultiQueue with CPU settings", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.BlockMultiQueue = pointer.P(true)
@@ -1515,6 +1594,119 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.livenessProbe should be provided.Pausing VMI with LivenessProbe is not supported"))
 		})
 
+		DescribeTable("should accept a valid pre-shutdown hook", func(hook *v1.PreShutdownHook, gracePeriod *int64) {
+			vmi.Spec.PreShutdownHook = hook
+			vmi.Spec.TerminationGracePeriodSeconds = gracePeriod
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("with the default timeout", &v1.PreShutdownHook{Command: "/usr/bin/stop-db"}, nil),
+			Entry("with a timeout shorter than the grace period", &v1.PreShutdownHook{Command: "/usr/bin/stop-db", TimeoutSeconds: pointer.P(int64(60))}, pointer.P(int64(180))),
+		)
+
+		DescribeTable("should reject an invalid pre-shutdown hook", func(hook *v1.PreShutdownHook, gracePeriod *int64, expectedField, expectedMessage string) {
+			vmi.Spec.PreShutdownHook = hook
+			vmi.Spec.TerminationGracePeriodSeconds = gracePeriod
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("without a command", &v1.PreShutdownHook{}, nil,
+				"fake.preShutdownHook.command", "fake.preShutdownHook.command must be set"),
+			Entry("with a timeout of 0", &v1.PreShutdownHook{Command: "/usr/bin/stop-db", TimeoutSeconds: pointer.P(int64(0))}, nil,
+				"fake.preShutdownHook.timeoutSeconds", "fake.preShutdownHook.timeoutSeconds must be greater than 0"),
+			Entry("with a timeout exceeding the default grace period", &v1.PreShutdownHook{Command: "/usr/bin/stop-db", TimeoutSeconds: pointer.P(int64(30))}, nil,
+				"fake.preShutdownHook.timeoutSeconds", "fake.preShutdownHook.timeoutSeconds must be shorter than the termination grace period of 30 seconds, to leave time to the ACPI shutdown"),
+			Entry("with the default timeout exceeding the grace period", &v1.PreShutdownHook{Command: "/usr/bin/stop-db"}, pointer.P(int64(5)),
+				"fake.preShutdownHook.timeoutSeconds", "fake.preShutdownHook.timeoutSeconds must be shorter than the termination grace period of 5 seconds, to leave time to the ACPI shutdown"),
+		)
+
+		Context("with an Ignition config", func() {
+			const spec3Config = `{"ignition":{"version":"3.4.0"}}`
+
+			withIgnitionVolumes := func(vmi *v1.VirtualMachineInstance) {
+				libvmi.WithSecretDisk("ignition-secret", "ignition-secret")(vmi)
+				libvmi.WithConfigMapDisk("ignition-configmap", "ignition-configmap")(vmi)
+				libvmi.WithContainerDisk("rootdisk", "quay.io/containerdisks/fedora-coreos")(vmi)
+			}
+
+			DescribeTable("should accept a valid config", func(options ...libvmi.Option) {
+				enableFeatureGates(featuregate.IgnitionGate)
+				vmi := libvmi.New(append([]libvmi.Option{withIgnitionVolumes}, options...)...)
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			},
+				Entry("with a single spec 2 fragment", libvmi.WithIgnition("", v1.IgnitionFragment{Data: `{"ignition":{"version":"2.2.0"}}`})),
+				Entry("with Secret, ConfigMap and inline fragments", libvmi.WithIgnition(v1.IgnitionPropagationFirmwareConfig,
+					v1.IgnitionFragment{VolumeName: "ignition-secret"},
+					v1.IgnitionFragment{VolumeName: "ignition-configmap"},
+					v1.IgnitionFragment{Data: spec3Config},
+				)),
+				Entry("with the config drive propagation",
+					libvmi.WithIgnition(v1.IgnitionPropagationConfigDrive, v1.IgnitionFragment{Data: spec3Config}),
+					libvmi.WithCloudInitConfigDrive(),
+				),
+				Entry("with the config drive propagation and network data",
+					libvmi.WithIgnition(v1.IgnitionPropagationConfigDrive, v1.IgnitionFragment{Data: spec3Config}),
+					libvmi.WithCloudInitConfigDrive(libvmici.WithConfigDriveNetworkData(" ")),
+				),
+			)
+
+			DescribeTable("should reject an invalid config", func(expectedField, expectedMessage string, options ...libvmi.Option) {
+				enableFeatureGates(featuregate.IgnitionGate)
+				vmi := libvmi.New(append([]libvmi.Option{withIgnitionVolumes}, options...)...)
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+			},
+				Entry("without fragments", "fake.ignition.fragments", "must have at least one fragment",
+					libvmi.WithIgnition("")),
+				Entry("with an empty fragment", "fake.ignition.fragments[0]", "must have one of data and volumeName set",
+					libvmi.WithIgnition("", v1.IgnitionFragment{})),
+				Entry("with a fragment having both sources", "fake.ignition.fragments[0]", "must have only one of data and volumeName set",
+					libvmi.WithIgnition("", v1.IgnitionFragment{Data: spec3Config, VolumeName: "ignition-secret"})),
+				Entry("with a fragment which is no Ignition config", "fake.ignition.fragments[0].data", "invalid Ignition config",
+					libvmi.WithIgnition("", v1.IgnitionFragment{Data: "#cloud-config"})),
+				Entry("with a spec 2 fragment to merge", "fake.ignition.fragments[1].data", "can not be merged",
+					libvmi.WithIgnition("", v1.IgnitionFragment{Data: spec3Config}, v1.IgnitionFragment{Data: `{"ignition":{"version":"2.2.0"}}`})),
+				Entry("with a fragment of a missing volume", "fake.ignition.fragments[0].volumeName", "does not have a matching Volume",
+					libvmi.WithIgnition("", v1.IgnitionFragment{VolumeName: "missing"})),
+				Entry("with a fragment of a containerDisk volume", "fake.ignition.fragments[0].volumeName", "must refer to a Secret or ConfigMap volume",
+					libvmi.WithIgnition("", v1.IgnitionFragment{VolumeName: "rootdisk"})),
+				Entry("with inline fragments exceeding the size limit", "fake.ignition.fragments", "exceeds 32768 byte limit",
+					libvmi.WithIgnition("", v1.IgnitionFragment{
+						Data: fmt.Sprintf(`{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/motd","contents":{"source":"data:,%s"}}]}}`, strings.Repeat("a", 32768)),
+					})),
+				Entry("with an unknown propagation", "fake.ignition.propagation", "must be one of FirmwareConfig and ConfigDrive",
+					libvmi.WithIgnition("Guestinfo", v1.IgnitionFragment{Data: spec3Config})),
+				Entry("with the config drive propagation without config drive", "fake.ignition.propagation", "requires a cloudInitConfigDrive volume",
+					libvmi.WithIgnition(v1.IgnitionPropagationConfigDrive, v1.IgnitionFragment{Data: spec3Config})),
+				Entry("with the config drive propagation and user data", "fake.volumes[3].cloudInitConfigDrive", "must not have a userdatasource set",
+					libvmi.WithIgnition(v1.IgnitionPropagationConfigDrive, v1.IgnitionFragment{Data: spec3Config}),
+					libvmi.WithCloudInitConfigDrive(libvmici.WithConfigDriveUserData(" ")),
+				),
+				Entry("with an empty config drive without the config drive propagation", "fake.volumes[3].cloudInitConfigDrive",
+					"must have at least one userdatasource or one networkdatasource set",
+					libvmi.WithIgnition("", v1.IgnitionFragment{Data: spec3Config}),
+					libvmi.WithCloudInitConfigDrive(),
+				),
+			)
+
+			It("should reject a config without the ExperimentalIgnitionSupport feature gate", func() {
+				vmi := libvmi.New(libvmi.WithIgnition("", v1.IgnitionFragment{Data: spec3Config}))
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(ContainSubstring("ExperimentalIgnitionSupport feature gate is not enabled"))
+			})
+		})
+
 		Context("with panic devices defined", func() {
 			It("should allow valid panic device model", func() {
 				vmi := api.NewMinimalVMI("testvm")
@@ -1544,6 +1736,52 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			})
 		})
 
+		Context("with guest panic capture defined", func() {
+			It("should allow a valid guest panic capture", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{
+					ConsoleLogLines:     pointer.P(uint32(50)),
+					MemoryDumpClaimName: "dump-pvc",
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject too many console log lines", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{ConsoleLogLines: pointer.P(uint32(101))}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.guestPanicCapture.consoleLogLines"))
+			})
+
+			It("should reject console log lines without the serial console log", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.LogSerialConsole = pointer.P(false)
+				vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{ConsoleLogLines: pointer.P(uint32(10))}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("fake.domain.devices.guestPanicCapture.consoleLogLines requires the serial console log"))
+			})
+
+			It("should reject an invalid memory dump claim name", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{MemoryDumpClaimName: "Invalid_Name"}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.guestPanicCapture.memoryDumpClaimName"))
+			})
+
+			It("should reject guest panic capture on s390x architecture", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Architecture = "s390x"
+				vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("guest panic capture is not supported on s390x architecture"))
+			})
+		})
+
 		Context("with kernel boot defined", func() {
 
 			createKernelBoot := func(kernelArgs, initrdPath, kernelPath, image string) *v1.KernelBoot {
@@ -1644,6 +1882,234 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("Reserved overhead memlock feature gate is not enabled in kubevirt-config"))
 		})
 
+		Context("with per VM memory features", func() {
+			It("should reject enabling KSM when KSM is not configured on the cluster", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{KSM: &v1.FeatureState{Enabled: pointer.P(true)}}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.ksm.enabled"))
+			})
+
+			It("should accept enabling KSM when KSM is configured on the cluster", func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.KSMConfiguration = &v1.KSMConfiguration{NodeLabelSelector: &metav1.LabelSelector{}}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{KSM: &v1.FeatureState{Enabled: pointer.P(true)}}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should accept disabling KSM", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{KSM: &v1.FeatureState{Enabled: pointer.P(false)}}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject enabling free page reporting when it is disabled on the cluster", func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.VirtualMachineOptions = &v1.VirtualMachineOptions{
+					DisableFreePageReporting: &v1.DisableFreePageReporting{},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{FreePageReporting: &v1.FeatureState{Enabled: pointer.P(true)}}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.freePageReporting.enabled"))
+				Expect(causes[0].Message).To(Equal("Free page reporting cannot be enabled for the VM because it is disabled in kubevirt-config"))
+			})
+
+			It("should reject enabling free page reporting without a memory balloon", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointer.P(false)
+				vmi.Spec.Domain.Memory = &v1.Memory{FreePageReporting: &v1.FeatureState{Enabled: pointer.P(true)}}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("Free page reporting requires the memory balloon device to be attached"))
+			})
+
+			It("should reject enabling free page reporting for high performance VMs", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{
+					FreePageReporting: &v1.FeatureState{Enabled: pointer.P(true)},
+					Hugepages:         &v1.Hugepages{PageSize: "2Mi"},
+				}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Message", "Free page reporting is not supported for VMs with dedicated CPUs, realtime or hugepages")))
+			})
+
+			It("should accept enabling free page reporting", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{FreePageReporting: &v1.FeatureState{Enabled: pointer.P(true)}}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+		})
+
+		Context("with memory overcommit classes", func() {
+			BeforeEach(func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.MemoryOvercommitConfiguration = &v1.MemoryOvercommitConfiguration{
+					Classes: []v1.MemoryOvercommitClass{
+						{Name: "dense", MemoryOvercommit: 200},
+						{Name: "swap", MemoryOvercommit: 150, Swap: true},
+					},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			DescribeTable("should accept a defined class", func(class string) {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{OvercommitClass: class}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			},
+				Entry("without swap", "dense"),
+				Entry("with swap", "swap"),
+			)
+
+			It("should reject a class which is not defined", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{OvercommitClass: "sparse"}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.overcommitClass"))
+				Expect(causes[0].Message).To(Equal("Memory overcommit class sparse is not defined in kubevirt-config"))
+			})
+
+			It("should reject a class allowing swap for high performance VMs", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{
+					OvercommitClass: "swap",
+					Hugepages:       &v1.Hugepages{PageSize: "2Mi"},
+				}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Message", "Memory overcommit class swap allows swap, which is not supported for VMs with dedicated CPUs, realtime or hugepages")))
+			})
+		})
+
+		Context("with memory balloon bounds", func() {
+			newBalloonVMI := func(min string, max *resource.Quantity) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{
+					Guest:   pointer.P(resource.MustParse("4Gi")),
+					Balloon: &v1.MemoryBalloon{Min: resource.MustParse(min), Max: max},
+				}
+				return vmi
+			}
+
+			It("should reject balloon bounds when the feature gate is disabled", func() {
+				vmi := newBalloonVMI("1Gi", nil)
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.balloon"))
+				Expect(causes[0].Message).To(Equal("AutoMemoryBalloon feature gate is not enabled in kubevirt-config"))
+			})
+
+			Context("with the feature gate enabled", func() {
+				BeforeEach(func() {
+					enableFeatureGates(featuregate.AutoMemoryBalloon)
+				})
+
+				It("should accept valid balloon bounds", func() {
+					vmi := newBalloonVMI("1Gi", pointer.P(resource.MustParse("2Gi")))
+
+					causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+					Expect(causes).To(BeEmpty())
+				})
+
+				It("should reject balloon bounds when the balloon device is not attached", func() {
+					vmi := newBalloonVMI("1Gi", nil)
+					vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointer.P(false)
+
+					causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Message).To(Equal("Memory balloon bounds require the memory balloon device to be attached"))
+				})
+
+				DescribeTable("should reject invalid balloon bounds", func(min string, max *resource.Quantity, expectedField string) {
+					vmi := newBalloonVMI(min, max)
+
+					causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Field).To(Equal(expectedField))
+				},
+					Entry("with a zero min", "0", nil, "fake.domain.memory.balloon.min"),
+					Entry("with max less than min", "2Gi", pointer.P(resource.MustParse("1Gi")), "fake.domain.memory.balloon.max"),
+					Entry("with max above the guest memory", "1Gi", pointer.P(resource.MustParse("5Gi")), "fake.domain.memory.balloon.max"),
+					Entry("with min above the guest memory", "5Gi", nil, "fake.domain.memory.balloon.min"),
+				)
+
+				It("should compare the balloon bounds to the memory request without guest memory", func() {
+					vmi := newBalloonVMI("1Gi", pointer.P(resource.MustParse("2Gi")))
+					vmi.Spec.Domain.Memory.Guest = nil
+					vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1536Mi")}
+
+					causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Message).To(Equal("Memory balloon max 2Gi must not exceed the guest memory 1536Mi"))
+				})
+
+				It("should reject balloon bounds for high performance VMs", func() {
+					vmi := newBalloonVMI("1Gi", nil)
+					vmi.Spec.Domain.Memory.Hugepages = &v1.Hugepages{PageSize: "2Mi"}
+
+					causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+					Expect(causes).To(ContainElement(HaveField("Message", "Memory balloon bounds are not supported for VMs with dedicated CPUs, realtime or hugepages")))
+				})
+			})
+		})
+
+		Context("with suspend to disk", func() {
+			newSuspendToDiskVMI := func() *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{SuspendToDisk: pointer.P(true)}
+				return vmi
+			}
+
+			It("should reject suspend to disk when the feature gate is disabled", func() {
+				vmi := newSuspendToDiskVMI()
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.suspendToDisk"))
+				Expect(causes[0].Message).To(Equal("SuspendToDisk feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should accept suspend to disk when the feature gate is enabled", func() {
+				enableFeatureGates(featuregate.SuspendToDiskGate)
+				vmi := newSuspendToDiskVMI()
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject suspend to disk with host devices", func() {
+				enableFeatureGates(featuregate.SuspendToDiskGate)
+				vmi := newSuspendToDiskVMI()
+				vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "hostdev", DeviceName: "vendor.com/device"}}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Message", "Suspend to disk is not supported for VMs with host devices or GPUs")))
+			})
+		})
+
 	})
 
 	Context("with cpu pinning", func() {
@@ -1689,6 +2155,83 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		Context("with guest NUMA topology strategies", func() {
+			BeforeEach(func() {
+				vmi.Spec.Domain.CPU.Cores = 4
+				vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
+					k8sv1.ResourceCPU: resource.MustParse("4"),
+				}
+				vmi.Spec.Domain.Memory = &v1.Memory{
+					Guest:     pointer.P(resource.MustParse("512Mi")),
+					Hugepages: &v1.Hugepages{PageSize: "2Mi"},
+				}
+				vmi.Spec.Domain.CPU.NUMA = &v1.NUMA{}
+			})
+
+			It("should accept an even split", func() {
+				vmi.Spec.Domain.CPU.NUMA.EvenSplit = &v1.NUMAEvenSplit{Nodes: 2}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should accept explicitly declared cells", func() {
+				vmi.Spec.Domain.CPU.NUMA.Cells = []v1.NUMACell{
+					{CPUs: "0", Memory: resource.MustParse("128Mi")},
+					{CPUs: "1-3", Memory: resource.MustParse("384Mi")},
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject more than one NUMA strategy", func() {
+				vmi.Spec.Domain.CPU.NUMA.GuestMappingPassthrough = &v1.NUMAGuestMappingPassthrough{}
+				vmi.Spec.Domain.CPU.NUMA.EvenSplit = &v1.NUMAEvenSplit{Nodes: 2}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Message", "only one NUMA topology strategy can be set in fake.domain.cpu.numa")))
+			})
+
+			DescribeTable("should reject an invalid even split", func(nodes uint32, threads uint32, pageSize string, expectedMessage string) {
+				vmi.Spec.Domain.CPU.Threads = threads
+				vmi.Spec.Domain.Memory.Hugepages.PageSize = pageSize
+				vmi.Spec.Domain.CPU.NUMA.EvenSplit = &v1.NUMAEvenSplit{Nodes: nodes}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Message", expectedMessage)))
+			},
+				Entry("with zero nodes", uint32(0), uint32(0), "2Mi", "fake.domain.cpu.numa.evenSplit.nodes must be greater than 0"),
+				Entry("with vCPUs not divisible by the nodes", uint32(3), uint32(0), "2Mi", "4 vCPUs cannot be split evenly between 3 numa nodes"),
+				Entry("with threads of a core split between nodes", uint32(8), uint32(2), "2Mi", "numa nodes must not split the threads of a core when fake.domain.cpu.dedicatedCpuPlacement is set"),
+				Entry("with memory not aligned to hugepages", uint32(2), uint32(0), "1Gi", "guest memory 512Mi cannot be split evenly between 2 numa nodes"),
+			)
+
+			DescribeTable("should reject invalid cells", func(cells []v1.NUMACell, expectedField string) {
+				vmi.Spec.Domain.CPU.NUMA.Cells = cells
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Field", expectedField)))
+			},
+				Entry("with an invalid CPU set", []v1.NUMACell{
+					{CPUs: "0-a", Memory: resource.MustParse("512Mi")},
+				}, "fake.domain.cpu.numa.cells[0].cpus"),
+				Entry("with a vCPU assigned twice", []v1.NUMACell{
+					{CPUs: "0-2", Memory: resource.MustParse("256Mi")},
+					{CPUs: "2-3", Memory: resource.MustParse("256Mi")},
+				}, "fake.domain.cpu.numa.cells[1].cpus"),
+				Entry("with a not existing vCPU", []v1.NUMACell{
+					{CPUs: "0-4", Memory: resource.MustParse("512Mi")},
+				}, "fake.domain.cpu.numa.cells[0].cpus"),
+				Entry("with unassigned vCPUs", []v1.NUMACell{
+					{CPUs: "0-2", Memory: resource.MustParse("512Mi")},
+				}, "fake.domain.cpu.numa.cells"),
+				Entry("with memory not adding up to the guest memory", []v1.NUMACell{
+					{CPUs: "0-1", Memory: resource.MustParse("256Mi")},
+					{CPUs: "2-3", Memory: resource.MustParse("128Mi")},
+				}, "fake.domain.cpu.numa.cells"),
+				Entry("with memory not aligned to hugepages", []v1.NUMACell{
+					{CPUs: "0-1", Memory: resource.MustParse("255Mi")},
+					{CPUs: "2-3", Memory: resource.MustParse("257Mi")},
+				}, "fake.domain.cpu.numa.cells[0].memory"),
+			)
+		})
+
 		It("should reject vmi with threads > 1 for arm64 arch", func() {
 			vmi.Spec.Domain.CPU.Threads = 2
 			vmi.Spec.Architecture = "arm64"
@@ -1727,6 +2270,27 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("threads must not be greater than 1 at fake.domain.cpu.threads (got 2) when fake.architecture is arm64"))
 		})
 
+		DescribeTable("should validate the nested virtualization policy", func(arch string, policy v1.NestedVirtualizationPolicy, expectedCauses []metav1.StatusCause) {
+			vmi.Spec.Architecture = arch
+			vmi.Spec.Domain.CPU.NestedVirtualization = policy
+			causes := validateNestedVirtualization(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(Equal(expectedCauses))
+		},
+			Entry("accept Require on amd64", "amd64", v1.NestedVirtualizationRequire, nil),
+			Entry("accept Forbid on amd64", "amd64", v1.NestedVirtualizationForbid, nil),
+			Entry("accept Forbid on arm64", "arm64", v1.NestedVirtualizationForbid, nil),
+			Entry("reject Require on arm64", "arm64", v1.NestedVirtualizationRequire, []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   "fake.domain.cpu.nestedVirtualization",
+				Message: "nested virtualization is not supported on arm64 architecture",
+			}}),
+			Entry("reject an unknown policy", "amd64", v1.NestedVirtualizationPolicy("Prefer"), []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   "fake.domain.cpu.nestedVirtualization",
+				Message: "nested virtualization policy Prefer is not supported, must be Require or Forbid",
+			}}),
+		)
+
 		It("should reject specs with more than two threads", func() {
 			vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
 			vmi.Spec.Domain.CPU.Cores = 4
@@ -2237,6 +2801,80 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate the sources of sysprep volumes", func(sysprep *v1.SysprepSource, expectedFields ...string) {
+			volumes := []v1.Volume{{
+				Name:         "sysprep",
+				VolumeSource: v1.VolumeSource{Sysprep: sysprep},
+			}}
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), volumes, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, expectedField := range expectedFields {
+				Expect(causes[i].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("accept a ConfigMap and a Secret", &v1.SysprepSource{Sources: []v1.SysprepProjection{
+				{
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "answers"},
+					Items:     []k8sv1.KeyToPath{{Key: "unattend", Path: "Autounattend.xml"}},
+				},
+				{
+					Secret: &k8sv1.LocalObjectReference{Name: "scripts"},
+					Items:  []k8sv1.KeyToPath{{Key: "setup", Path: "scripts/setup.ps1"}},
+				},
+			}}),
+			Entry("accept a source adding all keys without an answer file item", &v1.SysprepSource{Sources: []v1.SysprepProjection{
+				{ConfigMap: &k8sv1.LocalObjectReference{Name: "answers"}},
+				{
+					Secret: &k8sv1.LocalObjectReference{Name: "scripts"},
+					Items:  []k8sv1.KeyToPath{{Key: "setup", Path: "setup.ps1"}},
+				},
+			}}),
+			Entry("reject sources combined with a ConfigMap", &v1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "answers"},
+				Sources: []v1.SysprepProjection{{
+					Secret: &k8sv1.LocalObjectReference{Name: "answers"},
+					Items:  []k8sv1.KeyToPath{{Key: "unattend", Path: "unattend.xml"}},
+				}},
+			}, "fake[0].sysprep.sources"),
+			Entry("reject a source with both a ConfigMap and a Secret", &v1.SysprepSource{Sources: []v1.SysprepProjection{{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "answers"},
+				Secret:    &k8sv1.LocalObjectReference{Name: "answers"},
+				Items:     []k8sv1.KeyToPath{{Key: "unattend", Path: "unattend.xml"}},
+			}}}, "fake[0].sysprep.sources[0]"),
+			Entry("reject a source without a ConfigMap or a Secret", &v1.SysprepSource{Sources: []v1.SysprepProjection{{
+				Items: []k8sv1.KeyToPath{{Key: "unattend", Path: "unattend.xml"}},
+			}}}, "fake[0].sysprep.sources[0]"),
+			Entry("reject a path leaving the disk", &v1.SysprepSource{Sources: []v1.SysprepProjection{{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "answers"},
+				Items: []k8sv1.KeyToPath{
+					{Key: "unattend", Path: "unattend.xml"},
+					{Key: "setup", Path: "scripts/../../setup.ps1"},
+				},
+			}}}, "fake[0].sysprep.sources[0].items[1].path"),
+			Entry("reject an absolute path", &v1.SysprepSource{Sources: []v1.SysprepProjection{{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "answers"},
+				Items: []k8sv1.KeyToPath{
+					{Key: "unattend", Path: "unattend.xml"},
+					{Key: "setup", Path: "/setup.ps1"},
+				},
+			}}}, "fake[0].sysprep.sources[0].items[1].path"),
+			Entry("reject a path added by two sources", &v1.SysprepSource{Sources: []v1.SysprepProjection{
+				{
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "answers"},
+					Items:     []k8sv1.KeyToPath{{Key: "unattend", Path: "unattend.xml"}},
+				},
+				{
+					Secret: &k8sv1.LocalObjectReference{Name: "answers"},
+					Items:  []k8sv1.KeyToPath{{Key: "unattend", Path: "./unattend.xml"}},
+				},
+			}}, "fake[0].sysprep.sources[1].items[0].path"),
+			Entry("reject sources without an answer file", &v1.SysprepSource{Sources: []v1.SysprepProjection{{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "scripts"},
+				Items:     []k8sv1.KeyToPath{{Key: "setup", Path: "scripts/unattend.xml"}},
+			}}}, "fake[0].sysprep.sources"),
+		)
+
 		It("should reject CloudInitNoCloud volume if either userData or networkData is missing", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk",
@@ -2297,6 +2935,27 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate CloudInitNoCloud volume generating its networkData", func(source *v1.CloudInitNoCloudSource, expectedCauses int) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk",
+			})
+
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testdisk",
+				VolumeSource: v1.VolumeSource{
+					CloudInitNoCloud: source,
+				},
+			})
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+		},
+			Entry("should accept it without another source", &v1.CloudInitNoCloudSource{GenerateNetworkData: true}, 0),
+			Entry("should accept it with a userData source", &v1.CloudInitNoCloudSource{UserData: " ", GenerateNetworkData: true}, 0),
+			Entry("should reject it with a networkData source", &v1.CloudInitNoCloudSource{NetworkData: " ", GenerateNetworkData: true}, 1),
+			Entry("should reject it with a networkDataSecretRef source",
+				&v1.CloudInitNoCloudSource{NetworkDataSecretRef: &k8sv1.LocalObjectReference{Name: "secret"}, GenerateNetworkData: true}, 1),
+		)
+
 		It("should accept a single memoryDump volume without a matching disk", func() {
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
 				Name: "testMemoryDump",
@@ -2669,6 +3328,32 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 			Expect(causes).To(ContainElement(metav1.StatusCause{Type: metav1.CauseTypeFieldValueRequired, Field: "fake.domain.cpu.numa.guestMappingPassthrough", Message: "fake.domain.cpu.numa.guestMappingPassthrough must be defined when fake.domain.cpu.realtime is used"}))
 		})
+
+		It("should reject an unsupported realtime profile", func() {
+			vmi.Spec.Domain.CPU.DedicatedCPUPlacement = true
+			vmi.Spec.Domain.CPU.NUMA = &v1.NUMA{GuestMappingPassthrough: &v1.NUMAGuestMappingPassthrough{}}
+			vmi.Spec.Domain.CPU.Realtime.Profile = "fast"
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ContainElement(metav1.StatusCause{Type: metav1.CauseTypeFieldValueNotSupported, Field: "fake.domain.cpu.realtime.profile", Message: "fast is not a supported realtime profile, supported profiles: lowLatency"}))
+		})
+
+		It("should reject the lowLatency realtime profile without an isolated emulator thread", func() {
+			vmi.Spec.Domain.CPU.DedicatedCPUPlacement = true
+			vmi.Spec.Domain.CPU.NUMA = &v1.NUMA{GuestMappingPassthrough: &v1.NUMAGuestMappingPassthrough{}}
+			vmi.Spec.Domain.CPU.Realtime.Profile = v1.RealtimeProfileLowLatency
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ContainElement(metav1.StatusCause{Type: metav1.CauseTypeFieldValueRequired, Field: "fake.domain.cpu.isolateEmulatorThread", Message: "fake.domain.cpu.isolateEmulatorThread must be set to true when the lowLatency realtime profile is used"}))
+		})
+
+		It("should accept the lowLatency realtime profile", func() {
+			vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+			vmi.Spec.Domain.CPU.DedicatedCPUPlacement = true
+			vmi.Spec.Domain.CPU.IsolateEmulatorThread = true
+			vmi.Spec.Domain.CPU.NUMA = &v1.NUMA{GuestMappingPassthrough: &v1.NUMAGuestMappingPassthrough{}}
+			vmi.Spec.Domain.CPU.Realtime.Profile = v1.RealtimeProfileLowLatency
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).ToNot(ContainElement(HaveField("Field", "fake.domain.cpu.realtime.profile")))
+		})
 	})
 
 	Context("with AMD SEV LaunchSecurity", func() {
@@ -3689,6 +4374,21 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 
 			})
 		})
+
+		DescribeTable("on arm64", func(cpu *v1.CPU, expectedCauses int) {
+			vmi.Spec.Architecture = "arm64"
+			vmi.Spec.Domain.CPU = cpu
+
+			causes := validateCPUHotplug(k8sfield.NewPath("spec"), &vmi.Spec)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("spec.domain.cpu.maxSockets"))
+			}
+		},
+			Entry("should accept up to 512 vCPUs", &v1.CPU{Sockets: 2, MaxSockets: 128, Cores: 4, Threads: 1}, 0),
+			Entry("should reject more than 512 vCPUs", &v1.CPU{Sockets: 2, MaxSockets: 129, Cores: 4, Threads: 1}, 1),
+			Entry("should accept more than 512 vCPUs without hotplug", &v1.CPU{Sockets: 129, MaxSockets: 129, Cores: 4, Threads: 1}, 0),
+		)
 	})
 
 	Context("hyperV passthrough", func() {
@@ -3799,6 +4499,31 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("no watchdog configured", nil, "", false),
 		)
 
+		It("should reject the inject-nmi watchdog action on s390x", func() {
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+				Name: "w12",
+				WatchdogDevice: v1.WatchdogDevice{
+					Diag288: &v1.Diag288Watchdog{Action: v1.WatchdogActionInjectNMI},
+				},
+			}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "watchdog action 'inject-nmi' is not supported on s390x architecture",
+				Field:   "fake.domain.devices.watchdog.diag288.action",
+			}))
+		})
+
+		It("should accept the inject-nmi watchdog action on amd64", func() {
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+				Name: "w13",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionInjectNMI},
+				},
+			}
+			Expect(webhooks.ValidateVirtualMachineInstanceAmd64Setting(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+		})
+
 		DescribeTable("validate for arm64",
 			func(watchdog *v1.Watchdog, expectedMessage string, shouldReject bool) {
 				vmi.Spec.Domain.Devices.Watchdog = watchdog
@@ -3828,6 +4553,58 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 
 			Entry("no watchdog configured", nil, "", false),
 		)
+
+		DescribeTable("validate action",
+			func(watchdog *v1.Watchdog, expectedField, expectedMessage string) {
+				vmi.Spec.Domain.Devices.Watchdog = watchdog
+				causes := validateWatchdogAction(k8sfield.NewPath("fake"), &vmi.Spec)
+
+				if expectedMessage != "" {
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+					Expect(causes[0].Field).To(Equal(expectedField))
+					Expect(causes[0].Message).To(Equal(expectedMessage))
+				} else {
+					Expect(causes).To(BeEmpty())
+				}
+			},
+			Entry("inject-nmi is accepted", &v1.Watchdog{
+				Name: "w7",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionInjectNMI},
+				},
+			}, "", ""),
+
+			Entry("dump is accepted", &v1.Watchdog{
+				Name: "w8",
+				WatchdogDevice: v1.WatchdogDevice{
+					Diag288: &v1.Diag288Watchdog{Action: v1.WatchdogActionDump},
+				},
+			}, "", ""),
+
+			Entry("empty action is accepted", &v1.Watchdog{
+				Name: "w9",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{},
+				},
+			}, "", ""),
+
+			Entry("unknown i6300esb action is rejected", &v1.Watchdog{
+				Name: "w10",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{Action: "pause"},
+				},
+			}, "fake.domain.devices.watchdog.i6300esb.action", "watchdog action 'pause' is not supported"),
+
+			Entry("unknown diag288 action is rejected", &v1.Watchdog{
+				Name: "w11",
+				WatchdogDevice: v1.WatchdogDevice{
+					Diag288: &v1.Diag288Watchdog{Action: "pause"},
+				},
+			}, "fake.domain.devices.watchdog.diag288.action", "watchdog action 'pause' is not supported"),
+
+			Entry("no watchdog configured", nil, "", ""),
+		)
 	})
 
 	Context("with VideoConfig", func() {
@@ -3937,6 +4714,290 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with QEMUCommandLine", func() {
+		newVMIWithQEMUArgs := func(args ...v1.QEMUArg) *v1.VirtualMachineInstance {
+			vmi := libvmi.New(
+				libvmi.WithArchitecture(runtime.GOARCH),
+				libvmi.WithResourceMemory("128M"),
+			)
+			vmi.Spec.Domain.QEMUCommandLine = &v1.QEMUCommandLine{Args: args}
+			return vmi
+		}
+
+		enableQEMUArgsPassthrough := func(allowedArgs ...v1.AllowedQEMUArg) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.QEMUArgsPassthrough}
+			kvConfig.Spec.Configuration.QEMUArgsPassthrough = &v1.QEMUArgsPassthroughConfiguration{AllowedArgs: allowedArgs}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		}
+
+		It("should reject qemuCommandLine when feature gate is disabled", func() {
+			disableFeatureGates()
+			vmi := newVMIWithQEMUArgs(v1.QEMUArg{Name: "-device", Value: "pvpanic"})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[0].Message).To(Equal(fmt.Sprintf("QEMUCommandLine is specified but the %s feature gate is not enabled", featuregate.QEMUArgsPassthrough)))
+			Expect(causes[0].Field).To(Equal("fake.domain.qemuCommandLine"))
+		})
+
+		DescribeTable("should accept allowed arguments", func(args ...v1.QEMUArg) {
+			enableQEMUArgsPassthrough(
+				v1.AllowedQEMUArg{Name: "-device", ValuePrefixes: []string{"pvpanic", "pci-testdev,"}},
+				v1.AllowedQEMUArg{Name: "-no-hpet"},
+			)
+			vmi := newVMIWithQEMUArgs(args...)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("with a value matching a prefix", v1.QEMUArg{Name: "-device", Value: "pci-testdev,bus=pcie.0"}),
+			Entry("without a value", v1.QEMUArg{Name: "-no-hpet"}),
+			Entry("with several arguments", v1.QEMUArg{Name: "-no-hpet"}, v1.QEMUArg{Name: "-device", Value: "pvpanic"}),
+		)
+
+		DescribeTable("should reject arguments not allowed", func(arg v1.QEMUArg, expectedType metav1.CauseType, expectedField string) {
+			enableQEMUArgsPassthrough(
+				v1.AllowedQEMUArg{Name: "-device", ValuePrefixes: []string{"pvpanic"}},
+			)
+			vmi := newVMIWithQEMUArgs(arg)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(expectedType))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("without a leading dash", v1.QEMUArg{Name: "device", Value: "pvpanic"}, metav1.CauseTypeFieldValueInvalid, "fake.domain.qemuCommandLine.args[0].name"),
+			Entry("missing from the allowlist", v1.QEMUArg{Name: "-object", Value: "memory-backend-file"}, metav1.CauseTypeFieldValueNotSupported, "fake.domain.qemuCommandLine.args[0].name"),
+			Entry("with a value not matching any prefix", v1.QEMUArg{Name: "-device", Value: "vfio-pci,host=01:00.0"}, metav1.CauseTypeFieldValueNotSupported, "fake.domain.qemuCommandLine.args[0].value"),
+		)
+
+		It("should reject all arguments when no allowlist is configured", func() {
+			enableFeatureGates(featuregate.QEMUArgsPassthrough)
+			vmi := newVMIWithQEMUArgs(v1.QEMUArg{Name: "-no-hpet"})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.qemuCommandLine.args[0].name"))
+		})
+	})
+
+	Context("with serial devices", func() {
+		newVMIWithSerials := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+			return libvmi.New(append([]libvmi.Option{
+				libvmi.WithArchitecture(runtime.GOARCH),
+				libvmi.WithResourceMemory("128M"),
+			}, opts...)...)
+		}
+
+		It("should accept named serial ports and virtio consoles", func() {
+			vmi := newVMIWithSerials(
+				libvmi.WithSerialDevice("mgmt", ""),
+				libvmi.WithSerialDevice("data", v1.SerialDeviceTypeSerial),
+				libvmi.WithSerialDevice("agent", v1.SerialDeviceTypeVirtio),
+			)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid serial device", func(serial v1.SerialDevice, expectedType metav1.CauseType, expectedField string) {
+			vmi := newVMIWithSerials(libvmi.WithSerialDevice(serial.Name, serial.Type))
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(expectedType))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("without a name", v1.SerialDevice{}, metav1.CauseTypeFieldValueInvalid, "fake.domain.devices.serials[0].name"),
+			Entry("with a name escaping the socket directory", v1.SerialDevice{Name: "../mgmt"}, metav1.CauseTypeFieldValueInvalid, "fake.domain.devices.serials[0].name"),
+			Entry("with an unsupported type", v1.SerialDevice{Name: "mgmt", Type: "parallel"}, metav1.CauseTypeFieldValueNotSupported, "fake.domain.devices.serials[0].type"),
+		)
+
+		It("should reject duplicate serial devices", func() {
+			vmi := newVMIWithSerials(
+				libvmi.WithSerialDevice("mgmt", v1.SerialDeviceTypeSerial),
+				libvmi.WithSerialDevice("mgmt", v1.SerialDeviceTypeVirtio),
+			)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueDuplicate))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.serials[1].name"))
+		})
+
+		It("should reject more serial ports than the guest supports", func() {
+			vmi := newVMIWithSerials(
+				libvmi.WithSerialDevice("serial1", ""),
+				libvmi.WithSerialDevice("serial2", ""),
+				libvmi.WithSerialDevice("serial3", ""),
+				libvmi.WithSerialDevice("serial4", ""),
+				libvmi.WithSerialDevice("console1", v1.SerialDeviceTypeVirtio),
+			)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal("at most 3 serial devices of type serial are supported"))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.serials"))
+		})
+	})
+
+	Context("with clock", func() {
+		newVMIWithClock := func(clock v1.Clock) *v1.VirtualMachineInstance {
+			return libvmi.New(
+				libvmi.WithArchitecture(runtime.GOARCH),
+				libvmi.WithResourceMemory("128M"),
+				libvmi.WithClock(clock),
+			)
+		}
+
+		It("should accept a variable offset and a guest time sync policy", func() {
+			vmi := newVMIWithClock(v1.Clock{
+				ClockOffset: v1.ClockOffset{
+					Variable: &v1.ClockOffsetVariable{Basis: v1.ClockOffsetBasisLocaltime, OffsetSeconds: pointer.P(-3600)},
+				},
+				GuestTimeSync: &v1.GuestTimeSync{AfterMigration: pointer.P(false), TimeoutSeconds: pointer.P(int32(10))},
+			})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid clock", func(clock v1.Clock, expectedType metav1.CauseType, expectedField string) {
+			vmi := newVMIWithClock(clock)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(expectedType))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with a variable offset combined with utc",
+				v1.Clock{ClockOffset: v1.ClockOffset{UTC: &v1.ClockOffsetUTC{}, Variable: &v1.ClockOffsetVariable{}}},
+				metav1.CauseTypeFieldValueInvalid, "fake.domain.clock.variable",
+			),
+			Entry("with an unsupported variable offset basis",
+				v1.Clock{ClockOffset: v1.ClockOffset{Variable: &v1.ClockOffsetVariable{Basis: "timezone"}}},
+				metav1.CauseTypeFieldValueNotSupported, "fake.domain.clock.variable.basis",
+			),
+			Entry("with a non positive guest time sync timeout",
+				v1.Clock{GuestTimeSync: &v1.GuestTimeSync{TimeoutSeconds: pointer.P(int32(0))}},
+				metav1.CauseTypeFieldValueInvalid, "fake.domain.clock.guestTimeSync.timeoutSeconds",
+			),
+		)
+	})
+
+	Context("with SMBIOS", func() {
+		newVMIWithSMBIOS := func(smbios *v1.SMBIOS) *v1.VirtualMachineInstance {
+			vmi := libvmi.New(
+				libvmi.WithArchitecture(runtime.GOARCH),
+				libvmi.WithResourceMemory("128M"),
+			)
+			vmi.Spec.Domain.Firmware = &v1.Firmware{SMBIOS: smbios}
+			return vmi
+		}
+
+		It("should accept system and baseboard information and OEM strings", func() {
+			vmi := newVMIWithSMBIOS(&v1.SMBIOS{
+				System:     &v1.SMBIOSSystem{Manufacturer: "Acme", Product: "Appliance", Sku: "A-1"},
+				BaseBoard:  &v1.SMBIOSBaseBoard{Serial: "0123-4567", Asset: "rack 42"},
+				OEMStrings: []string{"license-server=10.0.0.1", "site=emea"},
+			})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid SMBIOS information", func(smbios *v1.SMBIOS, expectedType metav1.CauseType, expectedField string) {
+			vmi := newVMIWithSMBIOS(smbios)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(expectedType))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with a too long system value",
+				&v1.SMBIOS{System: &v1.SMBIOSSystem{Product: strings.Repeat("a", 256)}},
+				metav1.CauseTypeFieldValueInvalid, "fake.domain.firmware.smbios.system.product",
+			),
+			Entry("with a non printable baseboard value",
+				&v1.SMBIOS{BaseBoard: &v1.SMBIOSBaseBoard{Serial: "0123\n4567"}},
+				metav1.CauseTypeFieldValueInvalid, "fake.domain.firmware.smbios.baseBoard.serial",
+			),
+			Entry("with an empty OEM string",
+				&v1.SMBIOS{OEMStrings: []string{"site=emea", ""}},
+				metav1.CauseTypeFieldValueRequired, "fake.domain.firmware.smbios.oemStrings[1]",
+			),
+			Entry("with too many OEM strings",
+				&v1.SMBIOS{OEMStrings: slices.Repeat([]string{"site=emea"}, 33)},
+				metav1.CauseTypeFieldValueInvalid, "fake.domain.firmware.smbios.oemStrings",
+			),
+		)
+	})
+
+	Context("with virtiofs filesystems", func() {
+		newVMIWithFilesystems := func(names ...string) *v1.VirtualMachineInstance {
+			opts := []libvmi.Option{
+				libvmi.WithArchitecture(runtime.GOARCH),
+				libvmi.WithResourceMemory("128M"),
+			}
+			for _, name := range names {
+				opts = append(opts, libvmi.WithFilesystemPVC(name))
+			}
+			return libvmi.New(opts...)
+		}
+
+		BeforeEach(func() {
+			enableFeatureGates(featuregate.VirtIOFSStorageVolumeGate)
+		})
+
+		It("should accept several filesystems with their own options", func() {
+			vmi := newVMIWithFilesystems("fs1", "fs2", "fs3")
+			vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs.Cache = v1.VirtiofsCacheAlways
+			vmi.Spec.Domain.Devices.Filesystems[1].Virtiofs.DAXWindowSize = pointer.P(resource.MustParse("1Gi"))
+			vmi.Spec.Domain.Devices.Filesystems[2].Virtiofs.ThreadPoolSize = pointer.P(uint32(8))
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject duplicate filesystems", func() {
+			vmi := newVMIWithFilesystems("fs1")
+			vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, vmi.Spec.Domain.Devices.Filesystems[0])
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ContainElement(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: "filesystem fs1 is defined more than once",
+				Field:   "fake.domain.devices.filesystems[1].name",
+			}))
+		})
+
+		It("should reject an unsupported cache mode", func() {
+			vmi := newVMIWithFilesystems("fs1")
+			vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs.Cache = "metadata"
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.filesystems[0].virtiofs.cache"))
+		})
+
+		DescribeTable("should reject an invalid DAX window size", func(size string) {
+			vmi := newVMIWithFilesystems("fs1")
+			vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs.DAXWindowSize = pointer.P(resource.MustParse(size))
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.filesystems[0].virtiofs.daxWindowSize"))
+		},
+			Entry("zero", "0"),
+			Entry("negative", "-2Mi"),
+			Entry("not aligned", "3Mi"),
+		)
+	})
+
 	Context("with DRA GPUs", func() {
 		It("Should require deviceName without DRA", func() {
 			vmi := libvmi.New(
@@ -21,7 +21,6 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -51,7 +50,7 @@ func (admitter *VMIPresetAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 	raw := ar.Request.Object.Raw
 	vmipreset := v1.VirtualMachineInstancePreset{}
 
-	err := json.Unmarshal(raw, &vmipreset)
+	err := webhookutils.DecodeObject(raw, &vmipreset)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	v1 "kubevirt.io/api/core/v1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/vmquota"
+)
+
+// chargeQuotas charges the VirtualMachineInstance on the VirtualMachineQuotas of its namespace and rejects it
+// when it would exceed one of them. This covers the VirtualMachineInstances of VirtualMachines however they are
+// started as well as the standalone ones.
+// The charge is a reservation added to the status of the quotas with optimistic concurrency, so that concurrent
+// admissions, in the same or in different virt-api replicas, can not both take the last share of a quota.
+// virt-controller is the only writer of the usage: it accounts for the VirtualMachineInstances it observes and
+// drops their reservations, as well as the ones of the VirtualMachineInstances which were not created.
+func (admitter *VMICreateAdmitter) chargeQuotas(ctx context.Context, request *admissionv1.AdmissionRequest, vmi *v1.VirtualMachineInstance) ([]metav1.StatusCause, error) {
+	quotas, err := vmquota.List(admitter.VMQuotaInformer.GetIndexer(), vmi.Namespace)
+	if err != nil || len(quotas) == 0 {
+		return nil, err
+	}
+
+	requested := vmquota.Usage{}
+	requested.Add(&vmi.Spec)
+	reservation := quotav1alpha1.VirtualMachineQuotaReservation{
+		Name: vmi.Name,
+		UID:  vmi.UID,
+		Used: *requested.Limits(),
+		Time: metav1.Now(),
+	}
+	for _, quota := range quotas {
+		causes, err := admitter.chargeQuota(ctx, quota, &requested, reservation, webhookutils.IsDryRun(request))
+		if err != nil || len(causes) > 0 {
+			return causes, err
+		}
+	}
+	return nil, nil
+}
+
+// chargeQuota adds the reservation to the quota, unless the requested usage would exceed the quota or the request
+// is a dry-run. The cached quota is tried first and is fetched again on conflicts.
+func (admitter *VMICreateAdmitter) chargeQuota(ctx context.Context, quota *quotav1alpha1.VirtualMachineQuota, requested *vmquota.Usage, reservation quotav1alpha1.VirtualMachineQuotaReservation, dryRun bool) ([]metav1.StatusCause, error) {
+	client := admitter.VirtClient.GeneratedKubeVirtClient().QuotaV1alpha1().VirtualMachineQuotas(quota.Namespace)
+
+	var causes []metav1.StatusCause
+	current := quota
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if current == nil {
+			var err error
+			if current, err = client.Get(ctx, quota.Name, metav1.GetOptions{}); err != nil {
+				return err
+			}
+		}
+
+		charged := current.DeepCopy()
+		removeReservation(charged, reservation.Name)
+		used, err := admitter.quotaUsage(charged)
+		if err != nil {
+			return err
+		}
+		if causes = vmquota.Check(charged, &used, requested); len(causes) > 0 || dryRun {
+			return nil
+		}
+
+		charged.Status.Reservations = append(charged.Status.Reservations, reservation)
+		current = nil
+		_, err = client.UpdateStatus(ctx, charged, metav1.UpdateOptions{})
+		return err
+	})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return causes, err
+}
+
+// removeReservation drops a reservation left by a previous admission of a VirtualMachineInstance with the same
+// name which was not created
+func removeReservation(quota *quotav1alpha1.VirtualMachineQuota, name string) {
+	reservations := quota.Status.Reservations[:0]
+	for _, reservation := range quota.Status.Reservations {
+		if reservation.Name != name {
+			reservations = append(reservations, reservation)
+		}
+	}
+	quota.Status.Reservations = reservations
+}
+
+// quotaUsage returns the usage reported by the quota, or computes it from the VirtualMachineInstances of the
+// namespace when virt-controller did not report it yet, and adds the reservations of the quota.
+func (admitter *VMICreateAdmitter) quotaUsage(quota *quotav1alpha1.VirtualMachineQuota) (vmquota.Usage, error) {
+	used := vmquota.Usage{}
+	if quota.Status.Used != nil {
+		used = vmquota.UsageFromLimits(quota.Status.Used)
+	} else {
+		var err error
+		if used, err = vmquota.NamespaceUsage(admitter.VMIInformer.GetIndexer(), quota.Namespace, ""); err != nil {
+			return used, err
+		}
+	}
+	reserved := vmquota.Reserved(quota)
+	used.AddUsage(&reserved)
+	return used, nil
+}
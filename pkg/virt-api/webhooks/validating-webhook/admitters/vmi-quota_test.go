@@ -0,0 +1,221 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("VirtualMachineQuota charging", func() {
+	const namespace = "ns1"
+
+	var (
+		admitter       *VMICreateAdmitter
+		fakeVirtClient *kubevirtfake.Clientset
+	)
+
+	newVMI := func(name, guestMemory string, sockets uint32) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(namespace),
+			libvmi.WithUID(types.UID(name+"-uid")),
+			libvmi.WithGuestMemory(guestMemory),
+			libvmi.WithCPUCount(1, 1, sockets),
+			libvmi.WithArchitecture("amd64"),
+		)
+	}
+
+	addQuota := func(name string, hard quotav1alpha1.VirtualMachineQuotaLimits, used *quotav1alpha1.VirtualMachineQuotaLimits) {
+		quota := &quotav1alpha1.VirtualMachineQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       quotav1alpha1.VirtualMachineQuotaSpec{Hard: hard},
+			Status:     quotav1alpha1.VirtualMachineQuotaStatus{Used: used},
+		}
+		Expect(admitter.VMQuotaInformer.GetStore().Add(quota)).To(Succeed())
+		_, err := fakeVirtClient.QuotaV1alpha1().VirtualMachineQuotas(namespace).Create(context.Background(), quota, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	statusOf := func(name string) quotav1alpha1.VirtualMachineQuotaStatus {
+		quota, err := fakeVirtClient.QuotaV1alpha1().VirtualMachineQuotas(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return quota.Status
+	}
+
+	syncQuotaCache := func(name string) {
+		quota, err := fakeVirtClient.QuotaV1alpha1().VirtualMachineQuotas(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(admitter.VMQuotaInformer.GetStore().Update(quota)).To(Succeed())
+	}
+
+	admit := func(vmi *v1.VirtualMachineInstance, dryRun bool) *admissionv1.AdmissionResponse {
+		ar, err := newAdmissionReviewForVMICreation(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		ar.Request.DryRun = pointer.P(dryRun)
+		return admitter.Admit(context.Background(), ar)
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(fakeVirtClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.VirtualMachineQuotasGate},
+			},
+		})
+		admitter = &VMICreateAdmitter{ClusterConfig: config, VirtClient: virtClient}
+		admitter.VMIInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		admitter.VMQuotaInformer, _ = testutils.NewFakeInformerFor(&quotav1alpha1.VirtualMachineQuota{})
+	})
+
+	It("should admit a VMI when the namespace has no quota", func() {
+		Expect(admit(newVMI("vmi", "64Gi", 16), false).Allowed).To(BeTrue())
+		Expect(fakeVirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should reserve the usage of the VMI on every quota", func() {
+		used := &quotav1alpha1.VirtualMachineQuotaLimits{
+			RunningVirtualMachines: pointer.P(int64(1)),
+			GuestMemory:            pointer.P(resource.MustParse("1Gi")),
+			Sockets:                pointer.P(int64(1)),
+		}
+		addQuota("a", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(2))}, used)
+		addQuota("b", quotav1alpha1.VirtualMachineQuotaLimits{Sockets: pointer.P(int64(4))}, nil)
+		Expect(admitter.VMIInformer.GetStore().Add(newVMI("running", "1Gi", 1))).To(Succeed())
+
+		Expect(admit(newVMI("vmi", "2Gi", 2), false).Allowed).To(BeTrue())
+		for _, name := range []string{"a", "b"} {
+			status := statusOf(name)
+			Expect(status.Reservations).To(HaveLen(1))
+			reservation := status.Reservations[0]
+			Expect(reservation.Name).To(Equal("vmi"))
+			Expect(reservation.UID).To(Equal(types.UID("vmi-uid")))
+			Expect(*reservation.Used.RunningVirtualMachines).To(Equal(int64(1)))
+			Expect(reservation.Used.GuestMemory.Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+			Expect(*reservation.Used.Sockets).To(Equal(int64(2)))
+		}
+		// the usage is only written by virt-controller
+		Expect(statusOf("a").Used).To(Equal(used))
+		Expect(statusOf("b").Used).To(BeNil())
+	})
+
+	It("should reject a VMI exceeding the reservations of a quota", func() {
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(2))}, &quotav1alpha1.VirtualMachineQuotaLimits{
+			RunningVirtualMachines: pointer.P(int64(1)),
+		})
+		Expect(admit(newVMI("first", "1Gi", 1), false).Allowed).To(BeTrue())
+		syncQuotaCache("quota")
+
+		response := admit(newVMI("second", "1Gi", 1), false)
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Details.Causes[0].Message).To(Equal(
+			"exceeded VirtualMachineQuota quota: requested running VirtualMachines 1, used 2, limited to 2"))
+		Expect(statusOf("quota").Reservations).To(ConsistOf(HaveField("Name", "first")))
+	})
+
+	It("should replace the reservation of a VMI with the same name", func() {
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))}, nil)
+		Expect(admit(newVMI("vmi", "1Gi", 1), false).Allowed).To(BeTrue())
+		syncQuotaCache("quota")
+		Expect(admit(newVMI("vmi", "2Gi", 1), false).Allowed).To(BeTrue())
+
+		reservations := statusOf("quota").Reservations
+		Expect(reservations).To(HaveLen(1))
+		Expect(reservations[0].Used.GuestMemory.Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+	})
+
+	It("should reject a VMI exceeding the usage reported by a quota", func() {
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))}, &quotav1alpha1.VirtualMachineQuotaLimits{
+			RunningVirtualMachines: pointer.P(int64(1)),
+		})
+
+		response := admit(newVMI("vmi", "1Gi", 1), false)
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Message).To(Equal(
+			"exceeded VirtualMachineQuota quota: requested running VirtualMachines 1, used 1, limited to 1"))
+		Expect(statusOf("quota").Reservations).To(BeEmpty())
+	})
+
+	It("should not charge the quotas on dry-run", func() {
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))}, nil)
+		Expect(admit(newVMI("vmi", "1Gi", 1), true).Allowed).To(BeTrue())
+		Expect(statusOf("quota").Reservations).To(BeEmpty())
+	})
+
+	It("should not let concurrent VMIs both take the last share of a quota", func() {
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))}, &quotav1alpha1.VirtualMachineQuotaLimits{
+			RunningVirtualMachines: pointer.P(int64(0)),
+		})
+		// another admission reserved the last share of the quota, the cached one is outdated
+		concurrentCharge := true
+		fakeVirtClient.PrependReactor("update", "virtualmachinequotas", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "status" || !concurrentCharge {
+				return false, nil, nil
+			}
+			concurrentCharge = false
+			charged := action.(k8stesting.UpdateAction).GetObject().(*quotav1alpha1.VirtualMachineQuota).DeepCopy()
+			charged.Status.Reservations = []quotav1alpha1.VirtualMachineQuotaReservation{{
+				Name: "other",
+				Used: quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))},
+			}}
+			Expect(fakeVirtClient.Tracker().Update(action.GetResource(), charged, namespace)).To(Succeed())
+			return true, nil, errors.NewConflict(action.GetResource().GroupResource(), "quota", nil)
+		})
+
+		response := admit(newVMI("vmi", "1Gi", 1), false)
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Details.Causes[0].Message).To(Equal(
+			"exceeded VirtualMachineQuota quota: requested running VirtualMachines 1, used 1, limited to 1"))
+	})
+
+	It("should not charge the quotas when the feature gate is disabled", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		admitter.ClusterConfig = config
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(0))}, nil)
+		Expect(admit(newVMI("vmi", "1Gi", 1), false).Allowed).To(BeTrue())
+		Expect(statusOf("quota").Reservations).To(BeEmpty())
+	})
+})
@@ -31,6 +31,7 @@ import (
 
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
@@ -123,10 +124,7 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		}
 	}
 
-	return &admissionv1.AdmissionResponse{
-		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&newVMI.Spec, admitter.clusterConfig),
-	}
+	return validating_webhooks.NewPassingAdmissionResponse(warnDeprecatedAPIs(&newVMI.Spec, admitter.clusterConfig)...)
 }
 
 func admitVMILabelsUpdate(
@@ -21,7 +21,6 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -54,7 +53,7 @@ func (admitter *VMIRSAdmitter) Admit(_ context.Context, ar *admissionv1.Admissio
 	raw := ar.Request.Object.Raw
 	vmirs := v1.VirtualMachineInstanceReplicaSet{}
 
-	err := json.Unmarshal(raw, &vmirs)
+	err := webhookutils.DecodeObject(raw, &vmirs)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
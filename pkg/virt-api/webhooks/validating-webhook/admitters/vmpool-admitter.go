@@ -21,22 +21,25 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1beta1"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/util/webhooks/deprecation"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -76,7 +79,7 @@ func (admitter *VMPoolAdmitter) Admit(_ context.Context, ar *admissionv1.Admissi
 	raw := ar.Request.Object.Raw
 	pool := poolv1.VirtualMachinePool{}
 
-	err := json.Unmarshal(raw, &pool)
+	err := webhookutils.DecodeObject(raw, &pool)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
@@ -98,9 +101,12 @@ func (admitter *VMPoolAdmitter) Admit(_ context.Context, ar *admissionv1.Admissi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	warnings := warnDeprecatedAPIs(&pool.Spec.VirtualMachineTemplate.Spec.Template.Spec, admitter.ClusterConfig)
+	warnings = append(warnings, deprecation.Warnings(deprecation.VirtualMachineSpecFields, k8sfield.NewPath("spec", "virtualMachineTemplate", "spec"), &pool.Spec.VirtualMachineTemplate.Spec)...)
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&pool.Spec.VirtualMachineTemplate.Spec.Template.Spec, admitter.ClusterConfig),
+		Warnings: warnings,
 	}
 }
 
@@ -169,6 +175,10 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		}
 	}
 
+	if spec.MaxSurge != nil {
+		causes = append(causes, validateMaxSurge(field.Child("maxSurge"), spec)...)
+	}
+
 	if spec.UpdateStrategy != nil {
 		causes = append(causes, validateUpdateStrategyMutualExclusivity(field, spec.UpdateStrategy)...)
 	}
@@ -177,9 +187,12 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		causes = append(causes, validateScaleInStrategyMutualExclusivity(field, spec.ScaleInStrategy)...)
 	}
 
+	causes = append(causes, validateTopologyRules(field, pool)...)
+	causes = append(causes, validateArchitectureVariants(field.Child("architectureVariants"), spec)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
-		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
+		if err := webhookutils.DecodeObject(ar.Request.OldObject.Raw, oldPool); err != nil {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeUnexpectedServerResponse,
 				Message: "Could not fetch old vmpool",
@@ -197,6 +210,189 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	return causes
 }
 
+func validateMaxSurge(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	if spec.MaxSurge.Type == intstr.String {
+		percentage, found := strings.CutSuffix(spec.MaxSurge.StrVal, "%")
+		if !found {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxSurge percentage must end with %",
+				Field:   field.String(),
+			}}
+		}
+		if val, err := strconv.Atoi(percentage); err != nil || val < 0 {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("maxSurge percentage value %q must be a non-negative integer", percentage),
+				Field:   field.String(),
+			}}
+		}
+	} else if spec.MaxSurge.IntVal < 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxSurge must not be negative",
+			Field:   field.String(),
+		}}
+	}
+
+	strategy := spec.UpdateStrategy
+	if strategy == nil || strategy.Proactive == nil || strategy.Proactive.Method == nil ||
+		*strategy.Proactive.Method != poolv1.VirtualMachinePoolProactiveUpdateMethodReplace {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("maxSurge is only supported by the %s proactive update method", poolv1.VirtualMachinePoolProactiveUpdateMethodReplace),
+			Field:   field.String(),
+		}}
+	}
+
+	return nil
+}
+
+func validateTopologyRules(field *k8sfield.Path, pool *poolv1.VirtualMachinePool) []metav1.StatusCause {
+	spec := &pool.Spec
+	if len(spec.TopologySpread) == 0 && len(spec.AntiAffinity) == 0 {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if len(validation.IsValidLabelValue(pool.Name)) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "topologySpread and antiAffinity require the pool name to be a valid label value",
+			Field:   k8sfield.NewPath("metadata", "name").String(),
+		})
+	}
+
+	var spreadKeys []string
+	for i, spread := range spec.TopologySpread {
+		spreadField := field.Child("topologySpread").Index(i)
+		causes = append(causes, validateTopologyKey(spreadField.Child("topologyKey"), spread.TopologyKey, spreadKeys)...)
+		spreadKeys = append(spreadKeys, spread.TopologyKey)
+
+		if spread.MaxSkew != nil && *spread.MaxSkew < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxSkew must be greater than 0",
+				Field:   spreadField.Child("maxSkew").String(),
+			})
+		}
+
+		switch spread.WhenUnsatisfiable {
+		case "", k8sv1.DoNotSchedule, k8sv1.ScheduleAnyway:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("whenUnsatisfiable must be %s or %s", k8sv1.DoNotSchedule, k8sv1.ScheduleAnyway),
+				Field:   spreadField.Child("whenUnsatisfiable").String(),
+			})
+		}
+	}
+
+	var antiAffinityKeys []string
+	for i, antiAffinity := range spec.AntiAffinity {
+		keyField := field.Child("antiAffinity").Index(i).Child("topologyKey")
+		causes = append(causes, validateTopologyKey(keyField, antiAffinity.TopologyKey, antiAffinityKeys)...)
+		antiAffinityKeys = append(antiAffinityKeys, antiAffinity.TopologyKey)
+	}
+
+	return causes
+}
+
+func validateArchitectureVariants(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	if len(spec.ArchitectureVariants) == 0 {
+		return nil
+	}
+
+	var volumeNames, dataVolumeNames []string
+	vmSpec := &spec.VirtualMachineTemplate.Spec
+	if vmSpec.Template != nil {
+		for _, volume := range vmSpec.Template.Spec.Volumes {
+			volumeNames = append(volumeNames, volume.Name)
+		}
+	}
+	for _, dataVolume := range vmSpec.DataVolumeTemplates {
+		dataVolumeNames = append(dataVolumeNames, dataVolume.Name)
+	}
+
+	var causes []metav1.StatusCause
+	var architectures []string
+	for i, variant := range spec.ArchitectureVariants {
+		variantField := field.Index(i)
+
+		switch variant.Architecture {
+		case "amd64", "arm64", "s390x":
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("unsupported architecture: %s", variant.Architecture),
+				Field:   variantField.Child("architecture").String(),
+			})
+		}
+		if slices.Contains(architectures, variant.Architecture) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("architecture %q already has a variant", variant.Architecture),
+				Field:   variantField.Child("architecture").String(),
+			})
+		}
+		architectures = append(architectures, variant.Architecture)
+
+		if variant.Weight != nil && *variant.Weight < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "weight must not be negative",
+				Field:   variantField.Child("weight").String(),
+			})
+		}
+
+		for j, volume := range variant.Volumes {
+			if !slices.Contains(volumeNames, volume.Name) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("volume %q is not a volume of the VM template", volume.Name),
+					Field:   variantField.Child("volumes").Index(j).Child("name").String(),
+				})
+			}
+		}
+		for j, dataVolume := range variant.DataVolumeTemplates {
+			if !slices.Contains(dataVolumeNames, dataVolume.Name) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("dataVolumeTemplate %q is not a dataVolumeTemplate of the VM template", dataVolume.Name),
+					Field:   variantField.Child("dataVolumeTemplates").Index(j).Child("name").String(),
+				})
+			}
+		}
+	}
+
+	return causes
+}
+
+func validateTopologyKey(field *k8sfield.Path, key string, previousKeys []string) []metav1.StatusCause {
+	if key == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "topologyKey is required",
+			Field:   field.String(),
+		}}
+	}
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("topologyKey %q is invalid: %s", key, strings.Join(errs, ", ")),
+			Field:   field.String(),
+		}}
+	}
+	if slices.Contains(previousKeys, key) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: fmt.Sprintf("topologyKey %q is already used", key),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
+
 func validateUpdateStrategyMutualExclusivity(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolUpdateStrategy) []metav1.StatusCause {
 	mutualExclusivity := map[string]bool{
 		"unmanaged":     strategy.Unmanaged != nil,
@@ -22,10 +22,12 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -184,6 +186,48 @@ var _ = Describe("Validating Pool Admitter", func() {
 		}(), []string{
 			"spec.maxUnavailable",
 		}),
+		Entry("with invalid maxSurge percentage", func() *poolv1.VirtualMachinePool {
+			pool := newValidVMPool()
+			pool.Spec.MaxSurge = &intstr.IntOrString{
+				Type:   intstr.String,
+				StrVal: "invalid",
+			}
+			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+				Proactive: &poolv1.VirtualMachinePoolProactiveUpdateStrategy{
+					Method: pointer.P(poolv1.VirtualMachinePoolProactiveUpdateMethodReplace),
+				},
+			}
+			return pool
+		}(), []string{
+			"spec.maxSurge",
+		}),
+		Entry("with invalid maxSurge integer", func() *poolv1.VirtualMachinePool {
+			pool := newValidVMPool()
+			pool.Spec.MaxSurge = &intstr.IntOrString{
+				Type:   intstr.Int,
+				IntVal: -1,
+			}
+			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+				Proactive: &poolv1.VirtualMachinePoolProactiveUpdateStrategy{
+					Method: pointer.P(poolv1.VirtualMachinePoolProactiveUpdateMethodReplace),
+				},
+			}
+			return pool
+		}(), []string{
+			"spec.maxSurge",
+		}),
+		Entry("with maxSurge and without the Replace update method", func() *poolv1.VirtualMachinePool {
+			pool := newValidVMPool()
+			pool.Spec.MaxSurge = pointer.P(intstr.FromInt32(1))
+			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+				Proactive: &poolv1.VirtualMachinePoolProactiveUpdateStrategy{
+					Method: pointer.P(poolv1.VirtualMachinePoolProactiveUpdateMethodLiveUpdate),
+				},
+			}
+			return pool
+		}(), []string{
+			"spec.maxSurge",
+		}),
 		Entry("with invalid unmanaged and proactive update strategy", func() *poolv1.VirtualMachinePool {
 			pool := newValidVMPool()
 			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
@@ -285,6 +329,56 @@ var _ = Describe("Validating Pool Admitter", func() {
 		}(), []string{
 			"spec.scaleInStrategy",
 		}),
+		Entry("with invalid topology spread", func() *poolv1.VirtualMachinePool {
+			pool := newValidVMPool()
+			pool.Spec.TopologySpread = []poolv1.VirtualMachinePoolTopologySpread{
+				{TopologyKey: ""},
+				{TopologyKey: k8sv1.LabelTopologyZone, MaxSkew: pointer.P(int32(0))},
+				{TopologyKey: k8sv1.LabelHostname, WhenUnsatisfiable: "Invalid"},
+				{TopologyKey: "invalid key"},
+			}
+			return pool
+		}(), []string{
+			"spec.topologySpread[0].topologyKey",
+			"spec.topologySpread[1].maxSkew",
+			"spec.topologySpread[2].whenUnsatisfiable",
+			"spec.topologySpread[3].topologyKey",
+		}),
+		Entry("with duplicate anti-affinity topology keys", func() *poolv1.VirtualMachinePool {
+			pool := newValidVMPool()
+			pool.Spec.AntiAffinity = []poolv1.VirtualMachinePoolAntiAffinity{
+				{TopologyKey: k8sv1.LabelHostname},
+				{TopologyKey: k8sv1.LabelHostname, Preferred: true},
+			}
+			return pool
+		}(), []string{
+			"spec.antiAffinity[1].topologyKey",
+		}),
+		Entry("with anti-affinity and a pool name which is not a valid label value", func() *poolv1.VirtualMachinePool {
+			pool := newValidVMPool()
+			pool.Name = strings.Repeat("a", 64)
+			pool.Spec.AntiAffinity = []poolv1.VirtualMachinePoolAntiAffinity{{TopologyKey: k8sv1.LabelHostname}}
+			return pool
+		}(), []string{
+			"metadata.name",
+		}),
+		Entry("with invalid architecture variants", func() *poolv1.VirtualMachinePool {
+			pool := newValidVMPool()
+			pool.Spec.ArchitectureVariants = []poolv1.VirtualMachinePoolArchitectureVariant{
+				{Architecture: "riscv64"},
+				{Architecture: "arm64", Weight: pointer.P(int32(-1))},
+				{Architecture: "arm64"},
+				{Architecture: "s390x", Volumes: []v1.Volume{{Name: "unknown"}}},
+				{Architecture: "amd64", DataVolumeTemplates: []v1.DataVolumeTemplateSpec{{ObjectMeta: metav1.ObjectMeta{Name: "unknown"}}}},
+			}
+			return pool
+		}(), []string{
+			"spec.architectureVariants[0].architecture",
+			"spec.architectureVariants[1].weight",
+			"spec.architectureVariants[2].architecture",
+			"spec.architectureVariants[3].volumes[0].name",
+			"spec.architectureVariants[4].dataVolumeTemplates[0].name",
+		}),
 	)
 	It("should accept valid vm spec", func() {
 		pool := newValidVMPool()
@@ -293,8 +387,10 @@ var _ = Describe("Validating Pool Admitter", func() {
 				SelectionPolicy: &poolv1.VirtualMachinePoolSelectionPolicy{
 					SortPolicy: pointer.P(poolv1.VirtualMachinePoolSortPolicyNewest),
 				},
+				Method: pointer.P(poolv1.VirtualMachinePoolProactiveUpdateMethodReplace),
 			},
 		}
+		pool.Spec.MaxSurge = pointer.P(intstr.FromString("25%"))
 		pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{
 			Proactive: &poolv1.VirtualMachinePoolProactiveScaleInStrategy{
 				SelectionPolicy: &poolv1.VirtualMachinePoolSelectionPolicy{
@@ -303,6 +399,22 @@ var _ = Describe("Validating Pool Admitter", func() {
 				StatePreservation: pointer.P(poolv1.StatePreservationOnline),
 			},
 		}
+		pool.Spec.TopologySpread = []poolv1.VirtualMachinePoolTopologySpread{
+			{TopologyKey: k8sv1.LabelTopologyZone, MaxSkew: pointer.P(int32(2)), WhenUnsatisfiable: k8sv1.ScheduleAnyway},
+		}
+		pool.Spec.AntiAffinity = []poolv1.VirtualMachinePoolAntiAffinity{
+			{TopologyKey: k8sv1.LabelHostname},
+			{TopologyKey: k8sv1.LabelTopologyZone, Preferred: true},
+		}
+		pool.Spec.ArchitectureVariants = []poolv1.VirtualMachinePoolArchitectureVariant{
+			{Architecture: "amd64"},
+			{Architecture: "arm64", Weight: pointer.P(int32(2)), Volumes: []v1.Volume{{
+				Name: "testdisk",
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: testutils.NewFakeContainerDiskSource(),
+				},
+			}}},
+		}
 		poolBytes, _ := json.Marshal(&pool)
 
 		ar := &admissionv1.AdmissionReview{
@@ -21,8 +21,8 @@ package admitters
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -43,8 +43,11 @@ import (
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+	"kubevirt.io/kubevirt/pkg/util/cron"
 	migrationutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/util/webhooks/deprecation"
+	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -68,17 +71,28 @@ type VMsAdmitter struct {
 	VirtClient              kubecli.KubevirtClient
 	DataSourceInformer      cache.SharedIndexInformer
 	NamespaceInformer       cache.SharedIndexInformer
+	VMInformer              cache.SharedIndexInformer
+	VMIInformer             cache.SharedIndexInformer
+	VMQuotaInformer         cache.SharedIndexInformer
 	InstancetypeAdmitter    instancetypeVMsAdmitter
 	ClusterConfig           *virtconfig.ClusterConfig
 	KubeVirtServiceAccounts map[string]struct{}
 }
 
 func NewVMsAdmitter(clusterConfig *virtconfig.ClusterConfig, client kubecli.KubevirtClient, informers *webhooks.Informers, kubeVirtServiceAccounts map[string]struct{}) *VMsAdmitter {
+	instancetypeAdmitter := instancetypeWebhooks.NewAdmitter(
+		informers.InstancetypeInformer.GetStore(), informers.ClusterInstancetypeInformer.GetStore(),
+		informers.PreferenceInformer.GetStore(), informers.ClusterPreferenceInformer.GetStore(),
+		client,
+	)
 	return &VMsAdmitter{
 		VirtClient:              client,
 		DataSourceInformer:      informers.DataSourceInformer,
 		NamespaceInformer:       informers.NamespaceInformer,
-		InstancetypeAdmitter:    instancetypeWebhooks.NewAdmitter(client),
+		VMInformer:              informers.VMInformer,
+		VMIInformer:             informers.VMIInformer,
+		VMQuotaInformer:         informers.VMQuotaInformer,
+		InstancetypeAdmitter:    instancetypeAdmitter,
 		ClusterConfig:           clusterConfig,
 		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
 	}
@@ -97,7 +111,7 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	raw := ar.Request.Object.Raw
 	vm := v1.VirtualMachine{}
 
-	err := json.Unmarshal(raw, &vm)
+	err := webhookutils.DecodeObject(raw, &vm)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
@@ -152,6 +166,17 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if causes = validateDependsOnItself(k8sfield.NewPath("spec", "dependsOn"), &vm); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	causes, err = admitter.validateDependencyCycles(k8sfield.NewPath("spec", "dependsOn"), &vm)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	} else if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	causes, err = storageadmitters.Admit(admitter.VirtClient, ctx, ar.Request, &vm, admitter.ClusterConfig)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
@@ -167,20 +192,23 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
-	isDryRun := ar.Request.DryRun != nil && *ar.Request.DryRun
-	if !isDryRun && ar.Request.Operation == admissionv1.Create {
-		metrics.NewVMCreated(&vm)
+	if admitter.ClusterConfig.VirtualMachineQuotasEnabled() {
+		causes, err = admitter.validateQuotas(ar.Request, &vm, &vmCopy.Spec.Template.Spec)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		} else if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
 	}
 
-	warnings := warnDeprecatedAPIs(&vm.Spec.Template.Spec, admitter.ClusterConfig)
-	if vm.Spec.Running != nil {
-		warnings = append(warnings, "spec.running is deprecated, please use spec.runStrategy instead.")
+	if !webhookutils.IsDryRun(ar.Request) && ar.Request.Operation == admissionv1.Create {
+		metrics.NewVMCreated(&vm)
 	}
 
-	return &admissionv1.AdmissionResponse{
-		Allowed:  true,
-		Warnings: warnings,
-	}
+	warnings := warnVirtualMachineInstanceSpec(k8sfield.NewPath("spec", "template", "spec"), &vm.Spec.Template.Spec, admitter.ClusterConfig)
+	warnings = append(warnings, deprecation.Warnings(deprecation.VirtualMachineSpecFields, k8sfield.NewPath("spec"), &vm.Spec)...)
+
+	return validating_webhooks.NewPassingAdmissionResponse(warnings...)
 }
 
 func (admitter *VMsAdmitter) AdmitStatus(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -223,10 +251,185 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	causes = append(causes, storageadmitters.ValidateDataVolumeTemplate(field, spec)...)
 	causes = append(causes, validateRunStrategy(field, spec, config)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
+	causes = append(causes, validateSchedule(field.Child("schedule"), spec.Schedule, config)...)
+	causes = append(causes, validateDependsOn(field.Child("dependsOn"), spec.DependsOn, config)...)
+	causes = append(causes, validateCrashLoopBackoff(field.Child("crashLoopBackoff"), spec.CrashLoopBackoff)...)
+	causes = append(causes, validateRevisionHistoryLimit(field.Child("revisionHistoryLimit"), spec.RevisionHistoryLimit, config)...)
+
+	return causes
+}
+
+func validateSchedule(field *k8sfield.Path, schedule *v1.VirtualMachineSchedule, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if schedule == nil {
+		return nil
+	}
+	if !config.VirtualMachineScheduleEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt resource", featuregate.VirtualMachineScheduleGate),
+			Field:   field.String(),
+		}}
+	}
+	if schedule.Start == "" && schedule.Stop == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "schedule requires a start or a stop cron expression",
+			Field:   field.String(),
+		}}
+	}
+
+	for _, expr := range []struct{ name, value string }{{"start", schedule.Start}, {"stop", schedule.Stop}} {
+		if expr.value == "" {
+			continue
+		}
+		if _, err := cron.Parse(expr.value); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid cron expression: %v", err),
+				Field:   field.Child(expr.name).String(),
+			})
+		}
+	}
+
+	if schedule.TimeZone != nil {
+		// Local would depend on the time zone of virt-controller
+		if _, err := time.LoadLocation(*schedule.TimeZone); err != nil || *schedule.TimeZone == "Local" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("unknown time zone %q", *schedule.TimeZone),
+				Field:   field.Child("timeZone").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDependsOn(field *k8sfield.Path, dependencies []v1.VirtualMachineDependency, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if len(dependencies) == 0 {
+		return nil
+	}
+	if !config.VirtualMachineDependenciesEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt resource", featuregate.VirtualMachineDependenciesGate),
+			Field:   field.String(),
+		}}
+	}
+
+	names := map[string]struct{}{}
+	for i, dependency := range dependencies {
+		if dependency.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "dependency name must not be empty",
+				Field:   field.Index(i).Child("name").String(),
+			})
+			continue
+		}
+		if _, exists := names[dependency.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("duplicate dependency on VirtualMachine %s", dependency.Name),
+				Field:   field.Index(i).Child("name").String(),
+			})
+		}
+		names[dependency.Name] = struct{}{}
+	}
+	return causes
+}
 
+func validateDependsOnItself(field *k8sfield.Path, vm *v1.VirtualMachine) []metav1.StatusCause {
+	for i, dependency := range vm.Spec.DependsOn {
+		if dependency.Name == vm.Name {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "a VirtualMachine cannot depend on itself",
+				Field:   field.Index(i).Child("name").String(),
+			}}
+		}
+	}
+	return nil
+}
+
+// validateDependencyCycles rejects dependencies on VMs which already depend on the VM,
+// directly or through other VMs, as none of the VMs of a cycle would ever start.
+// The VMs depending on the VM are looked up with the dependsOn index of the VM informer.
+func (admitter *VMsAdmitter) validateDependencyCycles(field *k8sfield.Path, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
+	if len(vm.Spec.DependsOn) == 0 || admitter.VMInformer == nil {
+		return nil, nil
+	}
+
+	dependents := map[string]struct{}{}
+	pending := []string{vm.Name}
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		objs, err := admitter.VMInformer.GetIndexer().ByIndex("dependsOn", controller.NamespacedKey(vm.Namespace, name))
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			dependent := obj.(*v1.VirtualMachine)
+			if _, exists := dependents[dependent.Name]; !exists {
+				dependents[dependent.Name] = struct{}{}
+				pending = append(pending, dependent.Name)
+			}
+		}
+	}
+
+	var causes []metav1.StatusCause
+	for i, dependency := range vm.Spec.DependsOn {
+		if _, exists := dependents[dependency.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachine %s already depends on %s, the dependency would create a cycle", dependency.Name, vm.Name),
+				Field:   field.Index(i).Child("name").String(),
+			})
+		}
+	}
+	return causes, nil
+}
+
+func validateCrashLoopBackoff(field *k8sfield.Path, backoff *v1.CrashLoopBackoff) (causes []metav1.StatusCause) {
+	if backoff == nil {
+		return nil
+	}
+	for _, delay := range []struct {
+		name  string
+		value *metav1.Duration
+	}{{"baseDelay", backoff.BaseDelay}, {"maxDelay", backoff.MaxDelay}, {"resetWindow", backoff.ResetWindow}} {
+		if delay.value != nil && delay.value.Duration < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", field.Child(delay.name).String()),
+				Field:   field.Child(delay.name).String(),
+			})
+		}
+	}
 	return causes
 }
 
+func validateRevisionHistoryLimit(field *k8sfield.Path, limit *int32, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if limit == nil {
+		return nil
+	}
+	if !config.VirtualMachineRevisionHistoryEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt resource", featuregate.VirtualMachineRevisionHistoryGate),
+			Field:   field.String(),
+		}}
+	}
+	if *limit < 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
+
 func validateRunStrategy(field *k8sfield.Path, spec *v1.VirtualMachineSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	if spec.Running != nil && spec.RunStrategy != nil {
 		causes = append(causes, metav1.StatusCause{
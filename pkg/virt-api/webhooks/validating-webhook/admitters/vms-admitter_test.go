@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -45,8 +46,10 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/controller"
 	instancetypeWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
@@ -62,6 +65,9 @@ var _ = Describe("Validating VM Admitter", func() {
 		vmsAdmitter        *VMsAdmitter
 		dataSourceInformer cache.SharedIndexInformer
 		namespaceInformer  cache.SharedIndexInformer
+		vmInformer         cache.SharedIndexInformer
+		vmiInformer        cache.SharedIndexInformer
+		vmQuotaInformer    cache.SharedIndexInformer
 		mockVMIClient      *kubecli.MockVirtualMachineInstanceInterface
 		virtClient         *kubecli.MockKubevirtClient
 		k8sClient          *k8sfake.Clientset
@@ -95,6 +101,9 @@ var _ = Describe("Validating VM Admitter", func() {
 	BeforeEach(func() {
 		dataSourceInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataSource{})
 		namespaceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, controller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		vmQuotaInformer, _ = testutils.NewFakeInformerFor(&quotav1alpha1.VirtualMachineQuota{})
 		ns1 := &k8sv1.Namespace{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "ns1",
@@ -124,6 +133,9 @@ var _ = Describe("Validating VM Admitter", func() {
 			VirtClient:              virtClient,
 			DataSourceInformer:      dataSourceInformer,
 			NamespaceInformer:       namespaceInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			VMQuotaInformer:         vmQuotaInformer,
 			ClusterConfig:           config,
 			InstancetypeAdmitter:    instancetypeWebhooks.NewAdmitterStub(),
 			KubeVirtServiceAccounts: webhooks.KubeVirtServiceAccounts(kubeVirtNamespace),
@@ -250,6 +262,37 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	Context("with a VirtualMachineQuota", func() {
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			Expect(vmQuotaInformer.GetStore().Add(&quotav1alpha1.VirtualMachineQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "ns1"},
+				Spec: quotav1alpha1.VirtualMachineQuotaSpec{
+					Hard: quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))},
+				},
+			})).To(Succeed())
+			Expect(vmiInformer.GetStore().Add(libvmi.New(libvmi.WithName("running"), libvmi.WithNamespace("ns1")))).To(Succeed())
+			vm = libvmi.NewVirtualMachine(
+				libvmi.New(libvmi.WithName("vm"), libvmi.WithNamespace("ns1"), libvmi.WithMemoryRequest("64Mi")),
+				libvmi.WithRunStrategy(v1.RunStrategyAlways),
+			)
+		})
+
+		It("should accept exceeding VMs when the feature gate is disabled", func() {
+			Expect(admitVm(vmsAdmitter, vm).Allowed).To(BeTrue())
+		})
+
+		It("should reject exceeding VMs when the feature gate is enabled", func() {
+			enableFeatureGate(featuregate.VirtualMachineQuotasGate)
+			DeferCleanup(disableFeatureGates)
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeForbidden))
+		})
+	})
+
 	It("should accept VM requesting hugepages but missing spec.template.spec.domain.resources.requests.memory - bug #9102", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.Resources = v1.ResourceRequirements{}
@@ -1323,7 +1366,7 @@ var _ = Describe("Validating VM Admitter", func() {
 			virtClient.EXPECT().VirtualMachineClusterInstancetype().Return(
 				fakeclientset.NewSimpleClientset().InstancetypeV1beta1().VirtualMachineClusterInstancetypes()).AnyTimes()
 
-			vmsAdmitter.InstancetypeAdmitter = instancetypeWebhooks.NewAdmitter(virtClient)
+			vmsAdmitter.InstancetypeAdmitter = instancetypeWebhooks.NewAdmitter(nil, nil, nil, nil, virtClient)
 		})
 		It("should not apply instancetype to the VMISpec of the original VM", func() {
 			const clusterInstancetypeName = "clusterInstancetype"
@@ -1401,6 +1444,7 @@ var _ = Describe("Validating VM Admitter", func() {
 			})
 		},
 			Entry("amd64", "amd64"),
+			Entry("arm64", "arm64"),
 			Entry("s390x", "s390x"),
 		)
 
@@ -1509,12 +1553,19 @@ var _ = Describe("Validating VM Admitter", func() {
 					Field:   "spec.template.spec.domain.memory.guest",
 					Message: fmt.Sprintf("Guest memory must be %s aligned", resource.NewQuantity(memory.Hotplug1GHugePagesBlockAlignmentBytes, resource.BinarySI)),
 				}),
-				Entry("architecture is not amd64", func(vm *v1.VirtualMachine) {
-					vm.Spec.Template.Spec.Architecture = "arm64"
+				Entry("hugepages size is not supported by virtio-mem", func(vm *v1.VirtualMachine) {
+					vm.Spec.Template.Spec.Domain.Memory.Hugepages = &v1.Hugepages{PageSize: "64Ki"}
 				}, metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
 					Field:   "spec.template.spec.domain.memory.guest",
-					Message: "Memory hotplug is only available for x86_64 VMs",
+					Message: "Memory hotplug is only compatible with 2Mi or 1Gi hugepages",
+				}),
+				Entry("architecture is neither amd64 nor arm64", func(vm *v1.VirtualMachine) {
+					vm.Spec.Template.Spec.Architecture = "s390x"
+				}, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   "spec.template.spec.domain.memory.guest",
+					Message: "Memory hotplug is only available for x86_64 and arm64 VMs",
 				}),
 				Entry("guest memory is less than 1Gi", func(vm *v1.VirtualMachine) {
 					vm.Spec.Template.Spec.Domain.Memory.Guest = pointer.P(resource.MustParse("512Mi"))
@@ -1526,6 +1577,7 @@ var _ = Describe("Validating VM Admitter", func() {
 			)
 		},
 			Entry("amd64", "amd64"),
+			Entry("arm64", "arm64"),
 		)
 
 	})
@@ -1555,7 +1607,7 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Warnings).To(HaveLen(2))
 		Expect(resp.Warnings).To(ConsistOf(
 			HavePrefix("feature gate test-deprecated is deprecated"),
-			HavePrefix("spec.running is deprecated, please use spec.runStrategy instead.")))
+			Equal("spec.running is deprecated and will be removed in kubevirt.io/v2, please use spec.runStrategy instead.")))
 	})
 
 	It("should reject request when Discontinued feature is used", func() {
@@ -1618,6 +1670,172 @@ var _ = Describe("Validating VM Admitter", func() {
 			Entry("reject invalid runstrategy", v1.VirtualMachineRunStrategy("invalid"), "", false),
 		)
 	})
+
+	Context("schedule", func() {
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		DescribeTable("validate should", func(schedule *v1.VirtualMachineSchedule, featureGate string, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyAlways),
+					Schedule:    schedule,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+			enableFeatureGate(featureGate)
+			resp := admitVm(vmsAdmitter, vm)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("allow a start and a stop time",
+				&v1.VirtualMachineSchedule{Start: "0 8 * * mon-fri", Stop: "0 20 * * *", TimeZone: pointer.P("Europe/Berlin")},
+				featuregate.VirtualMachineScheduleGate, ""),
+			Entry("allow a stop time only",
+				&v1.VirtualMachineSchedule{Stop: "@daily"}, featuregate.VirtualMachineScheduleGate, ""),
+			Entry("reject a schedule if feature gate not enabled",
+				&v1.VirtualMachineSchedule{Stop: "@daily"}, "", "spec.schedule"),
+			Entry("reject a schedule without start and stop time",
+				&v1.VirtualMachineSchedule{}, featuregate.VirtualMachineScheduleGate, "spec.schedule"),
+			Entry("reject an invalid start time",
+				&v1.VirtualMachineSchedule{Start: "0 25 * * *"}, featuregate.VirtualMachineScheduleGate, "spec.schedule.start"),
+			Entry("reject an invalid stop time",
+				&v1.VirtualMachineSchedule{Stop: "daily"}, featuregate.VirtualMachineScheduleGate, "spec.schedule.stop"),
+			Entry("reject an unknown time zone",
+				&v1.VirtualMachineSchedule{Stop: "@daily", TimeZone: pointer.P("Mars/Olympus")}, featuregate.VirtualMachineScheduleGate, "spec.schedule.timeZone"),
+			Entry("reject the local time zone",
+				&v1.VirtualMachineSchedule{Stop: "@daily", TimeZone: pointer.P("Local")}, featuregate.VirtualMachineScheduleGate, "spec.schedule.timeZone"),
+		)
+	})
+
+	Context("dependsOn", func() {
+		BeforeEach(func() {
+			// worker -> backend -> frontend
+			for name, dependency := range map[string]string{"backend": "frontend", "worker": "backend"} {
+				Expect(vmInformer.GetStore().Add(&v1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: v1.VirtualMachineSpec{
+						DependsOn: []v1.VirtualMachineDependency{{Name: dependency}},
+						Template:  &v1.VirtualMachineInstanceTemplateSpec{},
+					},
+				})).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		DescribeTable("validate should", func(dependsOn []v1.VirtualMachineDependency, featureGate string, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "frontend"},
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyAlways),
+					DependsOn:   dependsOn,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+			enableFeatureGate(featureGate)
+			resp := admitVm(vmsAdmitter, vm)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("allow dependencies",
+				[]v1.VirtualMachineDependency{{Name: "database"}, {Name: "cache"}}, featuregate.VirtualMachineDependenciesGate, ""),
+			Entry("reject dependencies if feature gate not enabled",
+				[]v1.VirtualMachineDependency{{Name: "database"}}, "", "spec.dependsOn"),
+			Entry("reject a dependency without name",
+				[]v1.VirtualMachineDependency{{Name: "database"}, {}}, featuregate.VirtualMachineDependenciesGate, "spec.dependsOn[1].name"),
+			Entry("reject a duplicate dependency",
+				[]v1.VirtualMachineDependency{{Name: "database"}, {Name: "database"}}, featuregate.VirtualMachineDependenciesGate, "spec.dependsOn[1].name"),
+			Entry("reject a dependency on itself",
+				[]v1.VirtualMachineDependency{{Name: "database"}, {Name: "frontend"}}, featuregate.VirtualMachineDependenciesGate, "spec.dependsOn[1].name"),
+			Entry("reject a dependency on a VM depending on it",
+				[]v1.VirtualMachineDependency{{Name: "cache"}, {Name: "backend"}}, featuregate.VirtualMachineDependenciesGate, "spec.dependsOn[1].name"),
+			Entry("reject a dependency on a VM depending on it through other VMs",
+				[]v1.VirtualMachineDependency{{Name: "cache"}, {Name: "worker"}}, featuregate.VirtualMachineDependenciesGate, "spec.dependsOn[1].name"),
+		)
+	})
+
+	DescribeTable("crashLoopBackoff should", func(backoff *v1.CrashLoopBackoff, expectedField string) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vm := &v1.VirtualMachine{
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy:      pointer.P(v1.RunStrategyAlways),
+				CrashLoopBackoff: backoff,
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: vmi.Spec,
+				},
+			},
+		}
+		resp := admitVm(vmsAdmitter, vm)
+		if expectedField == "" {
+			Expect(resp.Allowed).To(BeTrue())
+			return
+		}
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("allow a backoff configuration",
+			&v1.CrashLoopBackoff{BaseDelay: &metav1.Duration{Duration: time.Second}, MaxDelay: &metav1.Duration{}, ResetWindow: &metav1.Duration{Duration: time.Minute}}, ""),
+		Entry("reject a negative base delay",
+			&v1.CrashLoopBackoff{BaseDelay: &metav1.Duration{Duration: -time.Second}}, "spec.crashLoopBackoff.baseDelay"),
+		Entry("reject a negative max delay",
+			&v1.CrashLoopBackoff{MaxDelay: &metav1.Duration{Duration: -time.Second}}, "spec.crashLoopBackoff.maxDelay"),
+		Entry("reject a negative reset window",
+			&v1.CrashLoopBackoff{ResetWindow: &metav1.Duration{Duration: -time.Second}}, "spec.crashLoopBackoff.resetWindow"),
+	)
+
+	Context("revisionHistoryLimit", func() {
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		DescribeTable("validate should", func(limit int32, featureGate string, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy:          pointer.P(v1.RunStrategyAlways),
+					RevisionHistoryLimit: pointer.P(limit),
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+			enableFeatureGate(featureGate)
+			resp := admitVm(vmsAdmitter, vm)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("allow a limit", int32(3), featuregate.VirtualMachineRevisionHistoryGate, ""),
+			Entry("allow no old revision", int32(0), featuregate.VirtualMachineRevisionHistoryGate, ""),
+			Entry("reject a limit if feature gate not enabled", int32(3), "", "spec.revisionHistoryLimit"),
+			Entry("reject a negative limit", int32(-1), featuregate.VirtualMachineRevisionHistoryGate, "spec.revisionHistoryLimit"),
+		)
+	})
 })
 
 func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/vmquota"
+)
+
+// vmRuns reports whether the run strategy of the VirtualMachine asks for it to be running
+func vmRuns(vm *v1.VirtualMachine) bool {
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return false
+	}
+	switch runStrategy {
+	case v1.RunStrategyAlways, v1.RunStrategyRerunOnFailure, v1.RunStrategyOnce:
+		return true
+	}
+	return false
+}
+
+// needsQuotaCheck reports whether the request starts the VirtualMachine or changes the template of a running one.
+// Other updates are let through so that lowering a quota does not block unrelated changes of running VirtualMachines.
+func needsQuotaCheck(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) (bool, error) {
+	if !vmRuns(vm) {
+		return false, nil
+	}
+	if ar.Operation != admissionv1.Update {
+		return true, nil
+	}
+	oldVM := &v1.VirtualMachine{}
+	if err := webhookutils.DecodeObject(ar.OldObject.Raw, oldVM); err != nil {
+		return false, err
+	}
+	return !vmRuns(oldVM) ||
+		!equality.Semantic.DeepEqual(oldVM.Spec.Instancetype, vm.Spec.Instancetype) ||
+		!equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.Domain, vm.Spec.Template.Spec.Domain), nil
+}
+
+// validateQuotas checks the VirtualMachine against the VirtualMachineQuotas of its namespace when the request
+// starts it or changes its template. The usage is computed from the active VirtualMachineInstances of the
+// namespace, the one of the VirtualMachine itself excluded. The given spec is expected to have the
+// instancetype and the defaults of the VirtualMachine applied.
+// This only gives an early feedback, the quotas are enforced when the VirtualMachineInstance is created.
+func (admitter *VMsAdmitter) validateQuotas(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine, spec *v1.VirtualMachineInstanceSpec) ([]metav1.StatusCause, error) {
+	if check, err := needsQuotaCheck(ar, vm); err != nil || !check {
+		return nil, err
+	}
+
+	quotas, err := vmquota.List(admitter.VMQuotaInformer.GetIndexer(), vm.Namespace)
+	if err != nil || len(quotas) == 0 {
+		return nil, err
+	}
+
+	used, err := vmquota.NamespaceUsage(admitter.VMIInformer.GetIndexer(), vm.Namespace, vm.Name)
+	if err != nil {
+		return nil, err
+	}
+	requested := vmquota.Usage{}
+	requested.Add(spec)
+
+	var causes []metav1.StatusCause
+	for _, quota := range quotas {
+		causes = append(causes, vmquota.Check(quota, &used, &requested)...)
+	}
+	return causes, nil
+}
@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("VirtualMachineQuota validation", func() {
+	const namespace = "ns1"
+
+	var admitter *VMsAdmitter
+
+	newVMI := func(name, guestMemory string, sockets uint32) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(namespace),
+			libvmi.WithGuestMemory(guestMemory),
+			libvmi.WithCPUCount(1, 1, sockets),
+		)
+	}
+
+	addQuota := func(name string, hard quotav1alpha1.VirtualMachineQuotaLimits) {
+		Expect(admitter.VMQuotaInformer.GetStore().Add(&quotav1alpha1.VirtualMachineQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       quotav1alpha1.VirtualMachineQuotaSpec{Hard: hard},
+		})).To(Succeed())
+	}
+
+	newRequest := func(op admissionv1.Operation, oldVM *v1.VirtualMachine) *admissionv1.AdmissionRequest {
+		request := &admissionv1.AdmissionRequest{Operation: op}
+		if oldVM != nil {
+			raw, err := json.Marshal(oldVM)
+			Expect(err).ToNot(HaveOccurred())
+			request.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return request
+	}
+
+	validate := func(request *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
+		causes, err := admitter.validateQuotas(request, vm, &vm.Spec.Template.Spec)
+		Expect(err).ToNot(HaveOccurred())
+		return causes
+	}
+
+	BeforeEach(func() {
+		admitter = &VMsAdmitter{}
+		admitter.VMIInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		admitter.VMQuotaInformer, _ = testutils.NewFakeInformerFor(&quotav1alpha1.VirtualMachineQuota{})
+		Expect(admitter.VMIInformer.GetStore().Add(newVMI("running", "2Gi", 2))).To(Succeed())
+	})
+
+	It("should accept a VM when the namespace has no quota", func() {
+		vm := libvmi.NewVirtualMachine(newVMI("vm", "64Gi", 16), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		Expect(validate(newRequest(admissionv1.Create, nil), vm)).To(BeEmpty())
+	})
+
+	DescribeTable("should reject a VM exceeding", func(hard quotav1alpha1.VirtualMachineQuotaLimits, message string) {
+		addQuota("quota", hard)
+		vm := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		causes := validate(newRequest(admissionv1.Create, nil), vm)
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Type).To(Equal(metav1.CauseTypeForbidden))
+		Expect(causes[0].Message).To(Equal(message))
+	},
+		Entry("the running VMs", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))},
+			"exceeded VirtualMachineQuota quota: requested running VirtualMachines 1, used 1, limited to 1"),
+		Entry("the guest memory", quotav1alpha1.VirtualMachineQuotaLimits{GuestMemory: pointer.P(resource.MustParse("3Gi"))},
+			"exceeded VirtualMachineQuota quota: requested guest memory 2Gi, used 2Gi, limited to 3Gi"),
+		Entry("the sockets", quotav1alpha1.VirtualMachineQuotaLimits{Sockets: pointer.P(int64(3))},
+			"exceeded VirtualMachineQuota quota: requested sockets 2, used 2, limited to 3"),
+	)
+
+	It("should accept a VM fitting every quota", func() {
+		addQuota("a", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(2))})
+		addQuota("b", quotav1alpha1.VirtualMachineQuotaLimits{GuestMemory: pointer.P(resource.MustParse("4Gi")), Sockets: pointer.P(int64(4))})
+		vm := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		Expect(validate(newRequest(admissionv1.Create, nil), vm)).To(BeEmpty())
+	})
+
+	It("should report every exceeded quota", func() {
+		addQuota("a", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))})
+		addQuota("b", quotav1alpha1.VirtualMachineQuotaLimits{Sockets: pointer.P(int64(1))})
+		vm := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		Expect(validate(newRequest(admissionv1.Create, nil), vm)).To(HaveLen(2))
+	})
+
+	It("should not count the VMI of the VM itself nor final VMIs", func() {
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(2))})
+		Expect(admitter.VMIInformer.GetStore().Add(newVMI("vm", "2Gi", 2))).To(Succeed())
+		stopped := newVMI("stopped", "2Gi", 2)
+		stopped.Status.Phase = v1.Succeeded
+		Expect(admitter.VMIInformer.GetStore().Add(stopped)).To(Succeed())
+		vm := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		Expect(validate(newRequest(admissionv1.Create, nil), vm)).To(BeEmpty())
+	})
+
+	It("should not count VMIs of other namespaces", func() {
+		addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(2))})
+		other := newVMI("other", "2Gi", 2)
+		other.Namespace = "ns2"
+		Expect(admitter.VMIInformer.GetStore().Add(other)).To(Succeed())
+		vm := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		Expect(validate(newRequest(admissionv1.Create, nil), vm)).To(BeEmpty())
+	})
+
+	Context("with an exhausted quota", func() {
+		BeforeEach(func() {
+			addQuota("quota", quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))})
+		})
+
+		DescribeTable("should not check a VM which does not run", func(runStrategy v1.VirtualMachineRunStrategy) {
+			vm := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(runStrategy))
+			Expect(validate(newRequest(admissionv1.Create, nil), vm)).To(BeEmpty())
+		},
+			Entry("with the Halted run strategy", v1.RunStrategyHalted),
+			Entry("with the Manual run strategy", v1.RunStrategyManual),
+		)
+
+		It("should check a VM being started", func() {
+			oldVM := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyHalted))
+			vm := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+			Expect(validate(newRequest(admissionv1.Update, oldVM), vm)).To(HaveLen(1))
+		})
+
+		It("should check a running VM whose template changes", func() {
+			oldVM := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+			vm := libvmi.NewVirtualMachine(newVMI("vm", "4Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+			Expect(validate(newRequest(admissionv1.Update, oldVM), vm)).To(HaveLen(1))
+		})
+
+		It("should not check unrelated updates of a running VM", func() {
+			oldVM := libvmi.NewVirtualMachine(newVMI("vm", "2Gi", 2), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+			vm := oldVM.DeepCopy()
+			vm.Labels = map[string]string{"updated": "true"}
+			Expect(validate(newRequest(admissionv1.Update, oldVM), vm)).To(BeEmpty())
+		})
+	})
+})
@@ -37,6 +37,8 @@ func ServeVMICreate(
 	resp http.ResponseWriter,
 	req *http.Request,
 	clusterConfig *virtconfig.ClusterConfig,
+	virtCli kubecli.KubevirtClient,
+	informers *webhooks.Informers,
 	kubeVirtServiceAccounts map[string]struct{},
 	specValidators ...admitters.SpecValidator,
 ) {
@@ -44,6 +46,9 @@ func ServeVMICreate(
 		ClusterConfig:           clusterConfig,
 		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
 		SpecValidators:          specValidators,
+		VirtClient:              virtCli,
+		VMIInformer:             informers.VMIInformer,
+		VMQuotaInformer:         informers.VMQuotaInformer,
 	})
 }
 
@@ -87,7 +92,8 @@ func ServeVMSnapshots(resp http.ResponseWriter, req *http.Request, clusterConfig
 }
 
 func ServeVMRestores(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) {
-	validating_webhooks.Serve(resp, req, storageadmitters.NewVMRestoreAdmitter(clusterConfig, virtCli, informers.VMRestoreInformer))
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMRestoreAdmitter(clusterConfig, virtCli, informers.VMRestoreInformer,
+		informers.VMSnapshotInformer, informers.VMSnapshotContentInformer))
 }
 
 func ServeVMBackups(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) {
@@ -169,6 +169,7 @@ func defaultClusterConfig(cpuArch string) *v1.KubeVirtConfiguration {
 	progressTimeout := MigrationProgressTimeout
 	completionTimeoutPerGiB := MigrationCompletionTimeoutPerGiB
 	utilityVolumesTimeout := MigrationUtilityVolumesTimeoutSeconds
+	evictionMigrationTimeout := MigrationEvictionTimeout
 	cpuRequestDefault := resource.MustParse(DefaultCPURequest)
 	nodeSelectorsDefault, _ := parseNodeSelectors(DefaultNodeSelectors)
 	defaultNetworkInterface := DefaultNetworkInterface
@@ -211,6 +212,7 @@ func defaultClusterConfig(cpuArch string) *v1.KubeVirtConfiguration {
 			ProgressTimeout:                   &progressTimeout,
 			CompletionTimeoutPerGiB:           &completionTimeoutPerGiB,
 			UtilityVolumesTimeout:             &utilityVolumesTimeout,
+			EvictionMigrationTimeout:          &evictionMigrationTimeout,
 			UnsafeMigrationOverride:           &defaultUnsafeMigrationOverride,
 			AllowAutoConverge:                 &allowAutoConverge,
 			AllowPostCopy:                     &allowPostCopy,
@@ -320,6 +322,13 @@ func setConfigFromKubeVirt(config *v1.KubeVirtConfiguration, kv *v1.KubeVirt) er
 	return validateConfig(config)
 }
 
+// ValidateKubeVirtConfiguration checks the configuration of the KubeVirt CR the
+// same way the components do when loading it. Components ignore configurations
+// failing this check and keep using the last valid one.
+func ValidateKubeVirtConfiguration(kv *v1.KubeVirt) error {
+	return setConfigFromKubeVirt(defaultClusterConfig(runtime.GOARCH), kv)
+}
+
 // getConfig returns the latest valid parsed config map result, or updates it
 // if a newer version is available.
 // XXX Rework this, to happen mostly in informer callbacks.
@@ -467,6 +476,17 @@ func validateConfig(config *v1.KubeVirtConfiguration) error {
 		return fmt.Errorf("invalid memoryOvercommit in ConfigMap: %d", config.DeveloperConfiguration.MemoryOvercommit)
 	}
 
+	if overcommitConfig := config.MemoryOvercommitConfiguration; overcommitConfig != nil {
+		for _, class := range overcommitConfig.Classes {
+			if class.MemoryOvercommit <= 0 {
+				return fmt.Errorf("invalid memoryOvercommit of overcommit class %s in ConfigMap: %d", class.Name, class.MemoryOvercommit)
+			}
+		}
+		if threshold := overcommitConfig.PressureThreshold; threshold != nil && (*threshold <= 0 || *threshold > 100) {
+			return fmt.Errorf("invalid memory overcommit pressureThreshold in ConfigMap: %d", *threshold)
+		}
+	}
+
 	if config.DeveloperConfiguration.CPUAllocationRatio <= 0 {
 		return fmt.Errorf("invalid cpu allocation ratio in ConfigMap: %d", config.DeveloperConfiguration.CPUAllocationRatio)
 	}
@@ -153,6 +153,33 @@ var _ = Describe("test configuration", func() {
 		Entry("when s390x unset, GetMachineType should return the default with s390x", "s390x", "", "", "", virtconfig.DefaultS390XMachineType),
 	)
 
+	DescribeTable("when machineTypeAliases", func(cpuArch, machineType, result string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVWithCPUArch(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					ArchitectureConfiguration: &v1.ArchConfiguration{
+						Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"q35-stable": "pc-q35-rhel9.6.0"}},
+						Arm64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"virt-stable": "virt-rhel9.6.0"}},
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: "Deployed",
+			},
+		}, cpuArch)
+		Expect(clusterConfig.ResolveMachineType(cpuArch, machineType)).To(Equal(result))
+	},
+		Entry("are set, ResolveMachineType should return the machine type of an alias", "amd64", "q35-stable", "pc-q35-rhel9.6.0"),
+		Entry("are set, ResolveMachineType should return the machine type of an alias of another architecture", "arm64", "virt-stable", "virt-rhel9.6.0"),
+		Entry("are set, ResolveMachineType should return a machine type which is not an alias", "amd64", "pc-q35-rhel9.4.0", "pc-q35-rhel9.4.0"),
+		Entry("are set, ResolveMachineType should not resolve the alias of another architecture", "amd64", "virt-stable", "virt-stable"),
+		Entry("are unset, ResolveMachineType should return the machine type", "s390x", "q35-stable", "q35-stable"),
+	)
+
 	It("architectureConfiguration fields should not have higher priority when deprecated options are set", func() {
 		const machineType = "quantum-qc35"
 		const ovmfPath = "/usr/share/something"
@@ -219,6 +246,39 @@ var _ = Describe("test configuration", func() {
 		Entry("when negative, GetMemoryOvercommit should return the default", -150, virtconfig.DefaultMemoryOvercommit),
 	)
 
+	DescribeTable(" when memoryOvercommitConfiguration", func(overcommitConfig *v1.MemoryOvercommitConfiguration, class string, overcommit, threshold int) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				MemoryOvercommit: 150,
+			},
+			MemoryOvercommitConfiguration: overcommitConfig,
+		})
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Memory = &v1.Memory{OvercommitClass: class}
+		Expect(clusterConfig.GetVMIMemoryOvercommit(vmi)).To(Equal(overcommit))
+		Expect(clusterConfig.GetMemoryOvercommitPressureThreshold()).To(Equal(threshold))
+	},
+		Entry("is unset, the cluster wide overcommit and default threshold should be used",
+			nil, "dense", 150, virtconfig.DefaultMemoryOvercommitPressureThreshold),
+		Entry("has the class selected by the VMI, its overcommit should be used",
+			&v1.MemoryOvercommitConfiguration{
+				Classes:           []v1.MemoryOvercommitClass{{Name: "dense", MemoryOvercommit: 200}},
+				PressureThreshold: pointer.P(80),
+			}, "dense", 200, 80),
+		Entry("does not have the class selected by the VMI, the cluster wide overcommit should be used",
+			&v1.MemoryOvercommitConfiguration{
+				Classes: []v1.MemoryOvercommitClass{{Name: "sparse", MemoryOvercommit: 110}},
+			}, "dense", 150, virtconfig.DefaultMemoryOvercommitPressureThreshold),
+		Entry("has an invalid class overcommit, the default configuration should be used",
+			&v1.MemoryOvercommitConfiguration{
+				Classes: []v1.MemoryOvercommitClass{{Name: "dense", MemoryOvercommit: -1}},
+			}, "dense", virtconfig.DefaultMemoryOvercommit, virtconfig.DefaultMemoryOvercommitPressureThreshold),
+		Entry("has an invalid pressure threshold, the default configuration should be used",
+			&v1.MemoryOvercommitConfiguration{
+				PressureThreshold: pointer.P(120),
+			}, "dense", virtconfig.DefaultMemoryOvercommit, virtconfig.DefaultMemoryOvercommitPressureThreshold),
+	)
+
 	DescribeTable(" when CPUAllocationRatio", func(value int, result int) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 			DeveloperConfiguration: &v1.DeveloperConfiguration{
@@ -518,6 +578,44 @@ var _ = Describe("test configuration", func() {
 		Entry("when unset, GetOVMFPath should return the default with s390x", "s390x", "", "", "", virtconfig.DefaultS390xOVMFPath),
 	)
 
+	DescribeTable("when EFI firmware images are configured", func(cpuArch string, result *v1.EFIFirmwareConfiguration) {
+		kv := &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					ArchitectureConfiguration: &v1.ArchConfiguration{
+						Amd64: &v1.ArchSpecificConfiguration{Firmware: &v1.EFIFirmwareConfiguration{
+							SecureBoot: &v1.EFIFirmwareImages{Code: "OVMF_CODE_4M.ms.fd", Vars: "OVMF_VARS_4M.ms.fd"},
+						}},
+						Arm64: &v1.ArchSpecificConfiguration{Firmware: &v1.EFIFirmwareConfiguration{
+							Image:   "registry.example.com/firmware/aavmf:latest",
+							Default: &v1.EFIFirmwareImages{Code: "QEMU_EFI.fd", Vars: "QEMU_VARS.fd"},
+						}},
+						S390x: &v1.ArchSpecificConfiguration{},
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: "Deployed",
+			},
+		}
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVWithCPUArch(kv, cpuArch)
+		Expect(clusterConfig.GetEFIFirmware(cpuArch)).To(Equal(result))
+	},
+		Entry("should return the amd64 images", "amd64", &v1.EFIFirmwareConfiguration{
+			SecureBoot: &v1.EFIFirmwareImages{Code: "OVMF_CODE_4M.ms.fd", Vars: "OVMF_VARS_4M.ms.fd"},
+		}),
+		Entry("should return the arm64 images", "arm64", &v1.EFIFirmwareConfiguration{
+			Image:   "registry.example.com/firmware/aavmf:latest",
+			Default: &v1.EFIFirmwareImages{Code: "QEMU_EFI.fd", Vars: "QEMU_VARS.fd"},
+		}),
+		Entry("should return nil when unset", "s390x", nil),
+	)
+
 	It("verifies that SetConfigModifiedCallback works as expected ", func() {
 		lock := &sync.Mutex{}
 		var callbackSet1, callbackSet2 bool
@@ -823,6 +921,65 @@ var _ = Describe("test configuration", func() {
 		Entry("expand InstancetypeConfiguration.ReferencePolicy is expand", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Expand)}, v1.Expand),
 	)
 
+	DescribeTable("GetObsoleteCPUModels should return", func(obsoleteCPUModels map[string]bool, cpuModels *v1.CPUModelsConfiguration, expected map[string]bool) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			ObsoleteCPUModels: obsoleteCPUModels,
+			CPUModels:         cpuModels,
+		})
+		Expect(clusterConfig.GetObsoleteCPUModels()).To(Equal(expected))
+	},
+		Entry("nil when nothing is set", nil, nil, nil),
+		Entry("obsoleteCPUModels when cpuModels is not set",
+			map[string]bool{"486": true}, nil, map[string]bool{"486": true}),
+		Entry("the obsolete cpuModels",
+			nil, &v1.CPUModelsConfiguration{Obsolete: []string{"Penryn"}}, map[string]bool{"Penryn": true}),
+		Entry("the union of obsoleteCPUModels and the obsolete cpuModels",
+			map[string]bool{"486": true}, &v1.CPUModelsConfiguration{Obsolete: []string{"Penryn"}}, map[string]bool{"486": true, "Penryn": true}),
+	)
+
+	It("GetAllowedCPUModels should return the allowed cpuModels", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		Expect(clusterConfig.GetAllowedCPUModels()).To(BeNil())
+
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			CPUModels: &v1.CPUModelsConfiguration{Allowed: []string{"Skylake-Client-IBRS", "EPYC"}},
+		})
+		Expect(clusterConfig.GetAllowedCPUModels()).To(ConsistOf("Skylake-Client-IBRS", "EPYC"))
+	})
+
+	It("GetMinCPUModels should return the minimum CPU model per vendor", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			CPUModels: &v1.CPUModelsConfiguration{
+				MinModels: []v1.VendorCPUModel{
+					{Vendor: "Intel", Model: "Haswell"},
+					{Vendor: "AMD", Model: "EPYC"},
+				},
+			},
+		})
+		Expect(clusterConfig.GetMinCPUModels()).To(Equal(map[string]string{"Intel": "Haswell", "AMD": "EPYC"}))
+	})
+
+	DescribeTable("GetNodeConfigurationOverride should return the first override selecting the node", func(nodeLabels map[string]string, expectedVMIs *int) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			NodeOverrides: []v1.NodeConfigurationOverride{
+				{NodeSelector: map[string]string{"pool": "dense"}, VirtualMachineInstancesPerNode: pointer.P(500)},
+				{NodeSelector: map[string]string{"gpu": "true"}, VirtualMachineInstancesPerNode: pointer.P(20)},
+			},
+		})
+		node := &kubev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: nodeLabels}}
+		override := clusterConfig.GetNodeConfigurationOverride(node)
+		if expectedVMIs == nil {
+			Expect(override).To(BeNil())
+			return
+		}
+		Expect(override).ToNot(BeNil())
+		Expect(override.VirtualMachineInstancesPerNode).To(Equal(expectedVMIs))
+	},
+		Entry("with a single matching override", map[string]string{"gpu": "true"}, pointer.P(20)),
+		Entry("with several matching overrides", map[string]string{"pool": "dense", "gpu": "true"}, pointer.P(500)),
+		Entry("without matching override", map[string]string{"pool": "sparse"}, nil),
+	)
+
 	DescribeTable("MediatedDevicesHandlingDisabled", func(kubevirtConfig *v1.KubeVirtConfiguration, expectedHandling bool) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kubevirtConfig)
 		Expect(clusterConfig.MediatedDevicesHandlingDisabled()).To(Equal(expectedHandling))
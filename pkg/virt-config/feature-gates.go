@@ -79,6 +79,42 @@ func (config *ClusterConfig) PluginsEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.PluginsGate)
 }
 
+func (config *ClusterConfig) VirtualMachineDefaultsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineDefaultsGate)
+}
+
+func (config *ClusterConfig) VirtualMachineQuotasEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineQuotasGate)
+}
+
+func (config *ClusterConfig) VirtualMachineScheduleEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineScheduleGate)
+}
+
+func (config *ClusterConfig) VirtualMachineDependenciesEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineDependenciesGate)
+}
+
+func (config *ClusterConfig) VirtualMachineRevisionHistoryEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineRevisionHistoryGate)
+}
+
+func (config *ClusterConfig) NodeGracefulShutdownEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.NodeGracefulShutdownGate)
+}
+
+func (config *ClusterConfig) VirtualMachineDriftDetectionEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineDriftDetectionGate)
+}
+
+func (config *ClusterConfig) NodeMaintenanceEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.NodeMaintenanceGate)
+}
+
+func (config *ClusterConfig) QEMUArgsPassthroughEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.QEMUArgsPassthrough)
+}
+
 func (config *ClusterConfig) HotplugVolumesEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.HotplugVolumesGate)
 }
@@ -236,3 +272,15 @@ func (config *ClusterConfig) VMStatsCollectorEnabled() bool {
 func (config *ClusterConfig) OCIExportEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.OCIExport)
 }
+
+func (config *ClusterConfig) AutoMemoryBalloonEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.AutoMemoryBalloon)
+}
+
+func (config *ClusterConfig) SuspendToDiskEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SuspendToDiskGate)
+}
+
+func (config *ClusterConfig) GuestExecEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestExecGate)
+}
@@ -241,6 +241,90 @@ const (
 	// Plugins enables the Plugin CRD for declarative VM extension
 	// via domain hooks, node hooks, and admission references (VEP-190).
 	PluginsGate = "Plugins"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// AutoMemoryBalloon enables virt-handler to resize the memory balloon of VMIs declaring
+	// balloon bounds, based on the guest memory usage and the node memory pressure.
+	AutoMemoryBalloon = "AutoMemoryBalloon"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// VirtualMachineDefaults lets the VM mutating webhook fill unset VM fields from the
+	// VirtualMachineDefaults objects found in the namespace of the VM.
+	VirtualMachineDefaultsGate = "VirtualMachineDefaults"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// VirtualMachineQuotas rejects the VMIs, started by VMs or standalone, which would exceed the
+	// VirtualMachineQuota objects of their namespace.
+	VirtualMachineQuotasGate = "VirtualMachineQuotas"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// VirtualMachineSchedule lets virt-controller start and stop VMs according to the cron
+	// expressions of their schedule.
+	VirtualMachineScheduleGate = "VirtualMachineSchedule"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// VirtualMachineDependencies lets VMs list VMs of their namespace which must be ready before
+	// virt-controller starts them.
+	VirtualMachineDependenciesGate = "VirtualMachineDependencies"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// VirtualMachineRevisionHistory lets virt-controller record the revisions of the VM specs
+	// and enables the rollback subresource returning a VM to one of them.
+	VirtualMachineRevisionHistoryGate = "VirtualMachineRevisionHistory"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// NodeGracefulShutdown lets virt-handler live migrate or stop the VMIs of a node
+	// during the graceful shutdown of the node by the kubelet.
+	NodeGracefulShutdownGate = "NodeGracefulShutdown"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// VirtualMachineDriftDetection lets virt-controller flag the VMs whose running VMI diverged
+	// from the VM spec, e.g. after hotplugging volumes or changing labels on the VMI directly.
+	VirtualMachineDriftDetectionGate = "VirtualMachineDriftDetection"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// NodeMaintenance lets virt-controller drain the VMIs of a node declared by a NodeMaintenance
+	// object, by live migrating, shutting down or ignoring them according to their eviction strategy.
+	NodeMaintenanceGate = "NodeMaintenance"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// QEMUArgsPassthrough allows VMIs to append the QEMU command line arguments allowed
+	// by the qemuArgsPassthrough configuration of the KubeVirt CR.
+	QEMUArgsPassthrough = "QEMUArgsPassthrough"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// SuspendToDisk allows suspending VirtualMachines to disk. The guest memory is saved to the
+	// backend storage and the VirtualMachineInstance is stopped until the VirtualMachine is resumed.
+	SuspendToDiskGate = "SuspendToDisk"
+
+	// Owner: sig-compute
+	// Alpha: v1.9.0
+	//
+	// GuestExec enables the guestexec subresource, which runs commands in the guests through the guest agent
+	// in the namespaces allowed by the guestExec configuration of the KubeVirt CR.
+	GuestExecGate = "GuestExec"
 )
 
 func init() {
@@ -287,4 +371,16 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: VMStatsCollector, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: OCIExport, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: PluginsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: AutoMemoryBalloon, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineDefaultsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineQuotasGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineScheduleGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineDependenciesGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineRevisionHistoryGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: NodeGracefulShutdownGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineDriftDetectionGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: NodeMaintenanceGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: QEMUArgsPassthrough, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SuspendToDiskGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestExecGate, State: Alpha})
 }
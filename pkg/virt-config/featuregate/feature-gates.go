@@ -86,16 +86,43 @@ func GetRegisteredFeatureGates() map[string]FeatureGate {
 	return maps.Clone(featureGates)
 }
 
+// EnabledByDefault tells whether the feature is enabled when its gate is neither enabled nor disabled explicitly.
+func (fg FeatureGate) EnabledByDefault() bool {
+	return fg.State == Beta || fg.State == GA
+}
+
+// LockedToDefault tells whether the gate can no longer be enabled or disabled explicitly,
+// since the feature graduated to GA or was discontinued.
+func (fg FeatureGate) LockedToDefault() bool {
+	return fg.State == GA || fg.State == Discontinued
+}
+
+// Stage returns the lifecycle stage of the feature gate as reported in the KubeVirt CR status.
+func (fg FeatureGate) Stage() v1.FeatureGateStage {
+	switch fg.State {
+	case Alpha:
+		return v1.FeatureGateStageAlpha
+	case Beta:
+		return v1.FeatureGateStageBeta
+	case GA:
+		return v1.FeatureGateStageGA
+	case Deprecated:
+		return v1.FeatureGateStageDeprecated
+	default:
+		return v1.FeatureGateStageDiscontinued
+	}
+}
+
 // IsEnabled evaluates whether a feature gate is active.
-// Precedence: GA (always on) > explicit enable > explicit disable > Beta (on by default) > off.
+// Precedence: locked (GA always on, Discontinued always off) > explicit enable > explicit disable > Beta (on by default) > off.
 func IsEnabled(gate string, devConfig *v1.DeveloperConfiguration) bool {
 	fg := FeatureGateInfo(gate)
 	if fg == nil {
 		return false
 	}
 
-	if fg.State == GA {
-		return true
+	if fg.LockedToDefault() {
+		return fg.EnabledByDefault()
 	}
 
 	if devConfig != nil {
@@ -110,3 +137,20 @@ func IsEnabled(gate string, devConfig *v1.DeveloperConfiguration) bool {
 
 	return fg.State == Beta
 }
+
+// Status reports the lifecycle of all registered feature gates, sorted by name.
+func Status(devConfig *v1.DeveloperConfiguration) []v1.FeatureGateStatus {
+	names := slices.Sorted(maps.Keys(featureGates))
+	status := make([]v1.FeatureGateStatus, 0, len(names))
+	for _, name := range names {
+		fg := featureGates[name]
+		status = append(status, v1.FeatureGateStatus{
+			Name:            fg.Name,
+			Stage:           fg.Stage(),
+			Default:         fg.EnabledByDefault(),
+			LockedToDefault: fg.LockedToDefault(),
+			Enabled:         IsEnabled(fg.Name, devConfig),
+		})
+	}
+	return status
+}
@@ -77,6 +77,7 @@ var _ = Describe("Feature Gate", func() {
 			testBetaGate       = "test-beta-gate"
 			testAlphaGate      = "test-alpha-gate"
 			testDeprecatedGate = "test-deprecated-gate"
+			testRemovedGate    = "test-discontinued-gate"
 			testUnknownGate    = "test-unknown-unregistered-gate"
 		)
 
@@ -85,6 +86,7 @@ var _ = Describe("Feature Gate", func() {
 			featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: testBetaGate, State: featuregate.Beta})
 			featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: testAlphaGate, State: featuregate.Alpha})
 			featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: testDeprecatedGate, State: featuregate.Deprecated})
+			featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: testRemovedGate, State: featuregate.Discontinued})
 		})
 
 		AfterEach(func() {
@@ -92,6 +94,7 @@ var _ = Describe("Feature Gate", func() {
 			featuregate.UnregisterFeatureGate(testBetaGate)
 			featuregate.UnregisterFeatureGate(testAlphaGate)
 			featuregate.UnregisterFeatureGate(testDeprecatedGate)
+			featuregate.UnregisterFeatureGate(testRemovedGate)
 		})
 
 		DescribeTable("should return the expected result",
@@ -112,12 +115,41 @@ var _ = Describe("Feature Gate", func() {
 			Entry("Alpha gate explicitly disabled",
 				testAlphaGate, &v1.DeveloperConfiguration{DisabledFeatureGates: []string{testAlphaGate}}, false),
 			Entry("Deprecated gate with nil config defaults to off", testDeprecatedGate, nil, false),
+			Entry("Discontinued gate explicitly enabled is still disabled",
+				testRemovedGate, &v1.DeveloperConfiguration{FeatureGates: []string{testRemovedGate}}, false),
 			Entry("Unregistered gate with nil config defaults to off", testUnknownGate, nil, false),
 			Entry("Unregistered gate explicitly disabled",
 				testUnknownGate, &v1.DeveloperConfiguration{FeatureGates: []string{testUnknownGate}}, false),
 		)
 	})
 
+	It("should report the lifecycle of the registered FGs sorted by name", func() {
+		registered := featuregate.GetRegisteredFeatureGates()
+		for name := range registered {
+			featuregate.UnregisterFeatureGate(name)
+		}
+		DeferCleanup(func() {
+			for _, fg := range registered {
+				featuregate.RegisterFeatureGate(fg)
+			}
+		})
+		featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: "my-ga-fg", State: featuregate.GA})
+		featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: "my-alpha-fg", State: featuregate.Alpha})
+		featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: "my-discontinued-fg", State: featuregate.Discontinued})
+		featuregate.RegisterFeatureGate(featuregate.FeatureGate{Name: "my-beta-fg", State: featuregate.Beta})
+
+		devConfig := &v1.DeveloperConfiguration{
+			FeatureGates:         []string{"my-alpha-fg", "my-discontinued-fg"},
+			DisabledFeatureGates: []string{"my-beta-fg"},
+		}
+		Expect(featuregate.Status(devConfig)).To(Equal([]v1.FeatureGateStatus{
+			{Name: "my-alpha-fg", Stage: v1.FeatureGateStageAlpha, Enabled: true},
+			{Name: "my-beta-fg", Stage: v1.FeatureGateStageBeta, Default: true},
+			{Name: "my-discontinued-fg", Stage: v1.FeatureGateStageDiscontinued, LockedToDefault: true},
+			{Name: "my-ga-fg", Stage: v1.FeatureGateStageGA, Default: true, LockedToDefault: true, Enabled: true},
+		}))
+	})
+
 	It("register FG that overrides an existing one", func() {
 		fg1 := featuregate.FeatureGate{Name: "my-fg1", State: featuregate.GA, Message: "my-message"}
 		fg2 := featuregate.FeatureGate{Name: "my-fg2", State: featuregate.GA, Message: "my-message"}
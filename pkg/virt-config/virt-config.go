@@ -45,11 +45,13 @@ const (
 	MigrationProgressTimeout                 int64  = 150
 	MigrationCompletionTimeoutPerGiB         int64  = 150
 	MigrationUtilityVolumesTimeoutSeconds    int64  = 150
+	MigrationEvictionTimeout                 int64  = 600
 	DefaultAMD64MachineType                         = "q35"
 	DefaultAARCH64MachineType                       = "virt"
 	DefaultS390XMachineType                         = "s390-ccw-virtio"
 	DefaultCPURequest                               = "100m"
 	DefaultMemoryOvercommit                         = 100
+	DefaultMemoryOvercommitPressureThreshold        = 90
 	DefaultAMD64EmulatedMachines                    = "q35*,pc-q35*"
 	DefaultAARCH64EmulatedMachines                  = "virt*"
 	DefaultS390XEmulatedMachines                    = "s390-ccw-virtio*"
@@ -164,6 +166,28 @@ func (c *ClusterConfig) GetMachineType(arch string) string {
 	}
 }
 
+// GetMachineTypeAliases returns the machine type aliases of the architecture, mapping each alias to the
+// machine type it stands for
+func (c *ClusterConfig) GetMachineTypeAliases(arch string) map[string]string {
+	switch arch {
+	case "arm64":
+		return c.GetConfig().ArchitectureConfiguration.Arm64.MachineTypeAliases
+	case "s390x":
+		return c.GetConfig().ArchitectureConfiguration.S390x.MachineTypeAliases
+	default:
+		return c.GetConfig().ArchitectureConfiguration.Amd64.MachineTypeAliases
+	}
+}
+
+// ResolveMachineType returns the machine type the alias stands for on the architecture, or the machine
+// type itself if it is not an alias
+func (c *ClusterConfig) ResolveMachineType(arch, machineType string) string {
+	if resolved, isAlias := c.GetMachineTypeAliases(arch)[machineType]; isAlias {
+		return resolved
+	}
+	return machineType
+}
+
 func (c *ClusterConfig) GetCPUModel() string {
 	return c.GetConfig().CPUModel
 }
@@ -180,6 +204,39 @@ func (c *ClusterConfig) GetMemoryOvercommit() int {
 	return c.GetConfig().DeveloperConfiguration.MemoryOvercommit
 }
 
+// GetMemoryOvercommitClass returns the memory overcommit class with the given name, or nil if it is not defined
+func (c *ClusterConfig) GetMemoryOvercommitClass(name string) *v1.MemoryOvercommitClass {
+	overcommitConfig := c.GetConfig().MemoryOvercommitConfiguration
+	if overcommitConfig == nil || name == "" {
+		return nil
+	}
+	for i := range overcommitConfig.Classes {
+		if overcommitConfig.Classes[i].Name == name {
+			return &overcommitConfig.Classes[i]
+		}
+	}
+	return nil
+}
+
+// GetVMIMemoryOvercommit returns the memory overcommit of the class selected by the VMI,
+// falling back to the cluster wide memory overcommit
+func (c *ClusterConfig) GetVMIMemoryOvercommit(vmi *v1.VirtualMachineInstance) int {
+	if vmi.Spec.Domain.Memory != nil {
+		if class := c.GetMemoryOvercommitClass(vmi.Spec.Domain.Memory.OvercommitClass); class != nil {
+			return class.MemoryOvercommit
+		}
+	}
+	return c.GetMemoryOvercommit()
+}
+
+func (c *ClusterConfig) GetMemoryOvercommitPressureThreshold() int {
+	overcommitConfig := c.GetConfig().MemoryOvercommitConfiguration
+	if overcommitConfig == nil || overcommitConfig.PressureThreshold == nil {
+		return DefaultMemoryOvercommitPressureThreshold
+	}
+	return *overcommitConfig.PressureThreshold
+}
+
 func (c *ClusterConfig) GetEmulatedMachines(arch string) []string {
 	oldEmulatedMachines := c.GetConfig().EmulatedMachines
 	if oldEmulatedMachines != nil {
@@ -256,6 +313,18 @@ func (c *ClusterConfig) GetOVMFPath(arch string) string {
 	}
 }
 
+// GetEFIFirmware returns the EFI firmware images configured for the architecture, nil if the default images are used.
+func (c *ClusterConfig) GetEFIFirmware(arch string) *v1.EFIFirmwareConfiguration {
+	switch arch {
+	case "arm64":
+		return c.GetConfig().ArchitectureConfiguration.Arm64.Firmware
+	case "s390x":
+		return c.GetConfig().ArchitectureConfiguration.S390x.Firmware
+	default:
+		return c.GetConfig().ArchitectureConfiguration.Amd64.Firmware
+	}
+}
+
 func (c *ClusterConfig) GetCPUAllocationRatio() int {
 	return c.GetConfig().DeveloperConfiguration.CPUAllocationRatio
 }
@@ -302,6 +371,17 @@ func canSelectNode(nodeSelector map[string]string, node *k8sv1.Node) bool {
 	return true
 }
 
+// GetNodeConfigurationOverride returns the first override selecting the node, or nil if there is none
+func (c *ClusterConfig) GetNodeConfigurationOverride(node *k8sv1.Node) *v1.NodeConfigurationOverride {
+	overrides := c.GetConfig().NodeOverrides
+	for i := range overrides {
+		if canSelectNode(overrides[i].NodeSelector, node) {
+			return &overrides[i]
+		}
+	}
+	return nil
+}
+
 func (c *ClusterConfig) GetDesiredMDEVTypes(node *k8sv1.Node) []string {
 	mdevTypesConf := c.GetConfig().MediatedDevicesConfiguration
 	if mdevTypesConf == nil {
@@ -403,7 +483,42 @@ func (c *ClusterConfig) GetVirtSynchronizationControllerVerbosity() uint {
 
 // GetObsoleteCPUModels return slice of obsolete cpus which are used in node-labeller
 func (c *ClusterConfig) GetObsoleteCPUModels() map[string]bool {
-	return c.GetConfig().ObsoleteCPUModels
+	config := c.GetConfig()
+	if config.CPUModels == nil || len(config.CPUModels.Obsolete) == 0 {
+		return config.ObsoleteCPUModels
+	}
+
+	obsolete := make(map[string]bool, len(config.ObsoleteCPUModels)+len(config.CPUModels.Obsolete))
+	for model, isObsolete := range config.ObsoleteCPUModels {
+		obsolete[model] = isObsolete
+	}
+	for _, model := range config.CPUModels.Obsolete {
+		obsolete[model] = true
+	}
+	return obsolete
+}
+
+// GetAllowedCPUModels returns the CPU models the nodes are restricted to, or nil if all the models are allowed
+func (c *ClusterConfig) GetAllowedCPUModels() []string {
+	config := c.GetConfig()
+	if config.CPUModels == nil || len(config.CPUModels.Allowed) == 0 {
+		return nil
+	}
+	return config.CPUModels.Allowed
+}
+
+// GetMinCPUModels returns the minimum CPU model of each CPU vendor
+func (c *ClusterConfig) GetMinCPUModels() map[string]string {
+	config := c.GetConfig()
+	if config.CPUModels == nil {
+		return nil
+	}
+
+	minModels := make(map[string]string, len(config.CPUModels.MinModels))
+	for _, minModel := range config.CPUModels.MinModels {
+		minModels[minModel.Vendor] = minModel.Model
+	}
+	return minModels
 }
 
 // GetClusterCPUArch return the CPU architecture in ClusterConfig
@@ -434,6 +549,65 @@ func (c *ClusterConfig) IsSerialConsoleLogDisabled() bool {
 	return c.GetConfig().VirtualMachineOptions != nil && c.GetConfig().VirtualMachineOptions.DisableSerialConsoleLog != nil
 }
 
+func (c *ClusterConfig) GetSerialConsoleLogRotation() *v1.SerialConsoleLogRotation {
+	if c.GetConfig().VirtualMachineOptions == nil {
+		return nil
+	}
+	return c.GetConfig().VirtualMachineOptions.SerialConsoleLogRotation
+}
+
+func (c *ClusterConfig) GetSerialConsoleLogFormat() v1.SerialConsoleLogFormat {
+	if c.GetConfig().VirtualMachineOptions == nil || c.GetConfig().VirtualMachineOptions.SerialConsoleLogFormat == "" {
+		return v1.SerialConsoleLogFormatText
+	}
+	return c.GetConfig().VirtualMachineOptions.SerialConsoleLogFormat
+}
+
+func (c *ClusterConfig) GetCrashLoopBackoff() *v1.CrashLoopBackoff {
+	if c.GetConfig().VirtualMachineOptions == nil {
+		return nil
+	}
+	return c.GetConfig().VirtualMachineOptions.CrashLoopBackoff
+}
+
+func (c *ClusterConfig) GetEventConfiguration() *v1.EventConfiguration {
+	return c.GetConfig().EventConfiguration
+}
+
+func (c *ClusterConfig) GetDomainStatsConfiguration() *v1.DomainStatsConfiguration {
+	return c.GetConfig().DomainStatsConfiguration
+}
+
+func (c *ClusterConfig) IsDomainStatsCollectorDisabled(collector v1.DomainStatsCollector) bool {
+	config := c.GetDomainStatsConfiguration()
+	return config != nil && slices.Contains(config.DisabledCollectors, collector)
+}
+
+func (c *ClusterConfig) IsVMInfoMetricsLabelDisabled(label v1.VMInfoMetricsLabel) bool {
+	config := c.GetConfig().VMInfoMetrics
+	return config != nil && slices.Contains(config.DisabledLabels, label)
+}
+
+func (c *ClusterConfig) GetSubresourceConnectionLimits() *v1.SubresourceConnectionLimits {
+	return c.GetConfig().SubresourceConnectionLimits
+}
+
+func (c *ClusterConfig) GetPoolMetricsAdapterConfiguration() *v1.PoolMetricsAdapterConfiguration {
+	return c.GetConfig().PoolMetricsAdapter
+}
+
+func (c *ClusterConfig) GetLauncherWatchdogConfiguration() *v1.LauncherWatchdogConfiguration {
+	return c.GetConfig().LauncherWatchdog
+}
+
+func (c *ClusterConfig) GetLauncherReservations() []v1.LauncherReservation {
+	return c.GetConfig().LauncherReservations
+}
+
+func (c *ClusterConfig) GetGuestExecConfiguration() *v1.GuestExecConfiguration {
+	return c.GetConfig().GuestExec
+}
+
 func (c *ClusterConfig) GetKSMConfiguration() *v1.KSMConfiguration {
 	return c.GetConfig().KSMConfiguration
 }
@@ -495,6 +669,14 @@ func (c *ClusterConfig) GetInstancetypeReferencePolicy() v1.InstancetypeReferenc
 	return v1.Reference
 }
 
+func (c *ClusterConfig) GetVirtioDriversConfiguration() *v1.VirtioDriversConfiguration {
+	instancetypeConfig := c.GetConfig().Instancetype
+	if instancetypeConfig == nil {
+		return nil
+	}
+	return instancetypeConfig.VirtioDrivers
+}
+
 func (c *ClusterConfig) ClusterProfilerEnabled() bool {
 	return c.GetConfig().DeveloperConfiguration.ClusterProfiler
 }
@@ -21,11 +21,15 @@ type NodeSelectorRenderer struct {
 	tscFrequency           *int64
 	vmiFeatures            *v1.Features
 	realtimeEnabled        bool
+	realtimeKernel         bool
+	swapEnabled            bool
 	sevEnabled             bool
 	sevESEnabled           bool
 	SecureExecutionEnabled bool
 	sevSNPEnabled          bool
 	tdxEnabled             bool
+	cpuHotplugEnabled      bool
+	nestedVirtualization   bool
 }
 
 type NodeSelectorRendererOption func(renderer *NodeSelectorRenderer)
@@ -76,6 +80,12 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 	if nsr.realtimeEnabled {
 		nsr.enableSelectorLabel(v1.RealtimeLabel)
 	}
+	if nsr.realtimeKernel {
+		nsr.enableSelectorLabel(v1.RealtimeKernelLabel)
+	}
+	if nsr.swapEnabled {
+		nsr.enableSelectorLabel(v1.SwapEnabledLabel)
+	}
 	if nsr.sevEnabled {
 		nsr.enableSelectorLabel(v1.SEVLabel)
 	}
@@ -91,6 +101,12 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 	if nsr.tdxEnabled {
 		nsr.enableSelectorLabel(v1.TDXLabel)
 	}
+	if nsr.cpuHotplugEnabled {
+		nsr.enableSelectorLabel(v1.CPUHotplugLabel)
+	}
+	if nsr.nestedVirtualization {
+		nsr.enableSelectorLabel(v1.NestedVirtualizationLabel)
+	}
 
 	return nsr.podNodeSelectors
 }
@@ -108,6 +124,19 @@ func WithRealtime() NodeSelectorRendererOption {
 		renderer.realtimeEnabled = true
 	}
 }
+
+func WithRealtimeKernel() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.realtimeKernel = true
+	}
+}
+
+func WithSwap() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.swapEnabled = true
+	}
+}
+
 func WithSEVSelector() NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.sevEnabled = true
@@ -137,6 +166,18 @@ func WithTDXSelector() NodeSelectorRendererOption {
 	}
 }
 
+func WithCPUHotplugSelector() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.cpuHotplugEnabled = true
+	}
+}
+
+func WithNestedVirtualizationSelector() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.nestedVirtualization = true
+	}
+}
+
 func WithDedicatedCPU() NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.hasDedicatedCPU = true
@@ -229,6 +270,7 @@ func makeHVFeatureLabelTable(vmiFeatures *v1.Features) []hvFeatureLabel {
 	// VPIndex, SyNIC: depend on both MSR and capability
 	// IPI, TLBFlush: depend on KVM Capabilities
 	// Runtime, Reset, SyNICTimer, Frequencies, Reenlightenment: depend on KVM MSRs availability
+	// SyNICTimer direct mode: depends on the KVM version
 	// EVMCS: depends on KVM capability, but the only way to know that is enable it, QEMU doesn't do
 	// any check before that, so we leave it out
 	//
@@ -238,8 +280,10 @@ func makeHVFeatureLabelTable(vmiFeatures *v1.Features) []hvFeatureLabel {
 	hyperv := vmiFeatures.Hyperv // shortcut
 
 	syNICTimer := &v1.FeatureState{}
+	var syNICTimerDirect *v1.FeatureState
 	if hyperv.SyNICTimer != nil {
 		syNICTimer.Enabled = hyperv.SyNICTimer.Enabled
+		syNICTimerDirect = hyperv.SyNICTimer.Direct
 	}
 
 	var tlbFlushFeatureState *v1.FeatureState
@@ -272,6 +316,10 @@ func makeHVFeatureLabelTable(vmiFeatures *v1.Features) []hvFeatureLabel {
 			Feature: syNICTimer,
 			Label:   "synictimer",
 		},
+		{
+			Feature: syNICTimerDirect,
+			Label:   "synictimer-direct",
+		},
 		{
 			Feature: hyperv.Frequencies,
 			Label:   "frequencies",
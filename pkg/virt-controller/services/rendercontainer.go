@@ -244,6 +244,11 @@ func updateReadinessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Pr
 		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 		return
 	}
+	if vmi.Spec.ReadinessProbe.GuestAgentFileExists != nil {
+		wrapGuestAgentFileExistsWithVirtProbe(vmi, computeProbe, vmi.Spec.ReadinessProbe.GuestAgentFileExists.Path)
+		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
+		return
+	}
 	wrapExecProbeWithVirtProbe(vmi, computeProbe)
 	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 }
@@ -254,6 +259,11 @@ func updateLivenessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Pro
 		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 		return
 	}
+	if vmi.Spec.LivenessProbe.GuestAgentFileExists != nil {
+		wrapGuestAgentFileExistsWithVirtProbe(vmi, computeProbe, vmi.Spec.LivenessProbe.GuestAgentFileExists.Path)
+		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
+		return
+	}
 	wrapExecProbeWithVirtProbe(vmi, computeProbe)
 	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 }
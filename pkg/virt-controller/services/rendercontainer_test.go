@@ -228,6 +228,24 @@ var _ = Describe("Container spec renderer", func() {
 			})
 		})
 
+		Context("readiness guest agent file exists probe", func() {
+			It("should check the file existence with virt-probe", func() {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					GuestAgentFileExists: &v1.GuestAgentFileExists{Path: "/tmp/ready"},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithReadinessProbe(
+					vmiWithReadinessProbe(probe)))
+				readinessProbe := specRenderer.Render(exampleCommand).ReadinessProbe
+				Expect(readinessProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--guestAgentFileExists", "/tmp/ready"))
+				Expect(readinessProbe.TimeoutSeconds).To(Equal(dummyProbe().TimeoutSeconds + 1))
+			})
+		})
+
 		Context("pre-wrapped liveness exec probe", func() {
 			It("should avoid wrapping the liveness exec probe a second time", func() {
 				var expectedExecCmd = []string{"virt-probe", "--", "dummy-cli"}
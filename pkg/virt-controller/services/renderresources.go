@@ -490,6 +490,9 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 		for _, dev := range hostDevs.PciHostDevices {
 			supportedHostDevicesMap[dev.ResourceName] = true
 		}
+		for _, rule := range hostDevs.PciDiscoveryRules {
+			supportedHostDevicesMap[rule.ResourceName] = true
+		}
 		for _, dev := range hostDevs.MediatedDevices {
 			supportedHostDevicesMap[dev.ResourceName] = true
 		}
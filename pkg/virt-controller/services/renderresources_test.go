@@ -760,6 +760,12 @@ var _ = Describe("validatePermittedHostDevices", func() {
 								ResourceName:      "intel.com/gpu",
 							},
 						},
+						PciDiscoveryRules: []v1.PciDiscoveryRule{
+							{
+								PCIVendorSelector: "10de:*",
+								ResourceName:      "nvidia.com/gpu",
+							},
+						},
 					},
 				},
 			},
@@ -790,6 +796,17 @@ var _ = Describe("validatePermittedHostDevices", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should pass validation when HostDevice is exposed by a discovery rule", func() {
+			vmiSpec.Domain.Devices.HostDevices = []v1.HostDevice{
+				{
+					Name:       "hostdev1",
+					DeviceName: "nvidia.com/gpu",
+				},
+			}
+			err := validatePermittedHostDevices(vmiSpec, config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should fail validation when HostDevice is not in permitted list", func() {
 			vmiSpec.Domain.Devices.HostDevices = []v1.HostDevice{
 				{
@@ -451,6 +451,15 @@ func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName st
 			})
 		}
 
+		if backendstorage.HasSuspendToDisk(&vmi.Spec) {
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      volumeName,
+				ReadOnly:  false,
+				MountPath: util.PathForSavedState(vmi),
+				SubPath:   "saved-state",
+			})
+		}
+
 		if cbt.HasCBTStateEnabled(vmi.Status.ChangedBlockTracking) {
 			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
 				Name:      volumeName,
@@ -769,6 +778,26 @@ func (vr *VolumeRenderer) addKernelBootVolumeMount() {
 	})
 }
 
+func withEFIFirmwareImage(image string) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: efiFirmwareVol,
+			VolumeSource: k8sv1.VolumeSource{
+				Image: &k8sv1.ImageVolumeSource{
+					Reference:  image,
+					PullPolicy: renderer.imagePullPolicyGetter.GetImagePullPolicy(),
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      efiFirmwareVol,
+			MountPath: util.VirtEFIFirmwareDir,
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
 func (vr *VolumeRenderer) addLauncherBinaryVolume() {
 	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
 		Name: containerdisk.LauncherVolume,
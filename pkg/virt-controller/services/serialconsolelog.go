@@ -25,7 +25,7 @@ func generateSerialConsoleLogContainer(vmi *v1.VirtualMachineInstance, image str
 			Image:           image,
 			ImagePullPolicy: config.GetImagePullPolicy(),
 			Command:         []string{"/usr/bin/virt-tail"},
-			Args:            []string{"--logfile", logFile},
+			Args:            serialConsoleLogArgs(vmi, logFile, config),
 			VolumeMounts: []k8sv1.VolumeMount{
 				{
 					Name:      "private",
@@ -53,6 +53,36 @@ func generateSerialConsoleLogContainer(vmi *v1.VirtualMachineInstance, image str
 	return nil
 }
 
+func serialConsoleLogArgs(vmi *v1.VirtualMachineInstance, logFile string, config *virtconfig.ClusterConfig) []string {
+	args := []string{"--logfile", logFile}
+	if config.GetSerialConsoleLogFormat() == v1.SerialConsoleLogFormatJSON {
+		args = append(args,
+			"--format", string(v1.SerialConsoleLogFormatJSON),
+			"--namespace", vmi.Namespace,
+			"--name", vmi.Name,
+			"--uid", string(vmi.UID),
+		)
+	}
+	return args
+}
+
+// serialConsoleLogRotationEnv passes the rotation limits of the serial console log to virtlogd in the compute container
+func serialConsoleLogRotationEnv(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) []k8sv1.EnvVar {
+	rotation := config.GetSerialConsoleLogRotation()
+	if rotation == nil || !isSerialConsoleLogEnabled(vmi, config) {
+		return nil
+	}
+
+	var env []k8sv1.EnvVar
+	if rotation.MaxSize != nil {
+		env = append(env, k8sv1.EnvVar{Name: util.ENV_VAR_SERIAL_LOG_MAX_SIZE, Value: fmt.Sprint(rotation.MaxSize.Value())})
+	}
+	if rotation.MaxBackups != nil {
+		env = append(env, k8sv1.EnvVar{Name: util.ENV_VAR_SERIAL_LOG_MAX_BACKUPS, Value: fmt.Sprint(*rotation.MaxBackups)})
+	}
+	return env
+}
+
 func isSerialConsoleLogEnabled(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) bool {
 	if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && *vmi.Spec.Domain.Devices.AutoattachSerialConsole == false {
 		return false
@@ -61,10 +61,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 	operatorutil "kubevirt.io/kubevirt/pkg/virt-operator/util"
 	"kubevirt.io/kubevirt/pkg/vmitrait"
 )
@@ -77,6 +79,7 @@ const (
 	virtBinDir       = "virt-bin-share-dir"
 	hotplugDisk      = "hotplug-disk"
 	virtExporter     = "virt-exporter"
+	efiFirmwareVol   = "efi-firmware"
 )
 
 const K8sDevicePrefix = "devices.kubevirt.io"
@@ -90,7 +93,6 @@ const SevDevice = K8sDevicePrefix + "/" + SevDeviceName
 const TdxDevice = K8sDevicePrefix + "/" + TdxDeviceName
 
 const debugLogs = "debugLogs"
-const logVerbosity = "logVerbosity"
 const virtiofsDebugLogs = "virtiofsdDebugLogs"
 
 const qemuTimeoutJitterRange = 120
@@ -251,7 +253,9 @@ func modifyNodeAffintyToRejectLabel(origAffinity *k8sv1.Affinity, labelToReject
 
 func sysprepVolumeSource(sysprepVolume v1.SysprepSource) (k8sv1.VolumeSource, error) {
 	logger := log.DefaultLogger()
-	if sysprepVolume.Secret != nil {
+	if len(sysprepVolume.Sources) > 0 {
+		return sysprepProjectedVolumeSource(sysprepVolume.Sources), nil
+	} else if sysprepVolume.Secret != nil {
 		return k8sv1.VolumeSource{
 			Secret: &k8sv1.SecretVolumeSource{
 				SecretName: sysprepVolume.Secret.Name,
@@ -271,6 +275,76 @@ func sysprepVolumeSource(sysprepVolume v1.SysprepSource) (k8sv1.VolumeSource, er
 	return k8sv1.VolumeSource{}, fmt.Errorf("%s", errorStr)
 }
 
+// sysprepProjectedVolumeSource assembles the keys of all sources in a single directory, from which
+// the Sysprep disk is created.
+func sysprepProjectedVolumeSource(sources []v1.SysprepProjection) k8sv1.VolumeSource {
+	projected := &k8sv1.ProjectedVolumeSource{}
+	for _, source := range sources {
+		if source.Secret != nil {
+			projected.Sources = append(projected.Sources, k8sv1.VolumeProjection{
+				Secret: &k8sv1.SecretProjection{
+					LocalObjectReference: *source.Secret,
+					Items:                source.Items,
+				},
+			})
+		} else if source.ConfigMap != nil {
+			projected.Sources = append(projected.Sources, k8sv1.VolumeProjection{
+				ConfigMap: &k8sv1.ConfigMapProjection{
+					LocalObjectReference: *source.ConfigMap,
+					Items:                source.Items,
+				},
+			})
+		}
+	}
+	return k8sv1.VolumeSource{Projected: projected}
+}
+
+// efiFirmwareImage returns the container image holding the EFI firmware booted by the VMI, if it is
+// configured and can be mounted into virt-launcher.
+func (t *TemplateService) efiFirmwareImage(vmi *v1.VirtualMachineInstance) string {
+	efiFirmware := t.clusterConfig.GetEFIFirmware(vmi.Spec.Architecture)
+	if efiFirmware == nil || !vmi.IsBootloaderEFI() || !t.clusterConfig.ImageVolumeEnabled() {
+		return ""
+	}
+	return efiFirmware.Image
+}
+
+// efiFirmwareImagesArg formats the configured EFI firmware images as expected by the --efi-firmware-images
+// flag of virt-launcher.
+func efiFirmwareImagesArg(efiFirmware *v1.EFIFirmwareConfiguration) string {
+	if efiFirmware == nil {
+		return ""
+	}
+
+	var images []string
+	addImage := func(key, name string) {
+		if name != "" {
+			images = append(images, key+"="+name)
+		}
+	}
+	for _, variant := range []struct {
+		images           *v1.EFIFirmwareImages
+		codeKey, varsKey string
+	}{
+		{efiFirmware.Default, efi.ImageCode, efi.ImageVars},
+		{efiFirmware.SecureBoot, efi.ImageSecureBootCode, efi.ImageSecureBootVars},
+		{efiFirmware.SEV, efi.ImageSEVCode, efi.ImageSEVVars},
+		// SNP and TDX firmwares are stateless
+		{efiFirmware.SNP, efi.ImageSNPCode, ""},
+		{efiFirmware.TDX, efi.ImageTDXCode, ""},
+		{efiFirmware.TDXSecureBoot, efi.ImageTDXSecureBootCode, ""},
+	} {
+		if variant.images == nil {
+			continue
+		}
+		addImage(variant.codeKey, variant.images.Code)
+		if variant.varsKey != "" {
+			addImage(variant.varsKey, variant.images.Vars)
+		}
+	}
+	return strings.Join(images, ",")
+}
+
 func (t *TemplateService) GetLauncherImage() string {
 	return t.launcherImage
 }
@@ -406,6 +480,9 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	resources := resourceRenderer.ResourceRequirements()
 
 	ovmfPath := t.clusterConfig.GetOVMFPath(vmi.Spec.Architecture)
+	if t.efiFirmwareImage(vmi) != "" {
+		ovmfPath = util.VirtEFIFirmwareDir
+	}
 
 	var requestedHookSidecarList hooks.HookSidecarList
 	for _, sidecarCreator := range t.sidecarCreators {
@@ -438,6 +515,9 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			"--disk-memory-limit", strconv.Itoa(int(t.clusterConfig.GetDiskVerification().MemoryLimit.Value())),
 			"--hypervisor", t.clusterConfig.GetHypervisor().Name,
 		}
+		if images := efiFirmwareImagesArg(t.clusterConfig.GetEFIFirmware(vmi.Spec.Architecture)); images != "" {
+			command = append(command, "--efi-firmware-images", images)
+		}
 		if nonRoot {
 			command = append(command, "--run-as-nonroot")
 		}
@@ -456,6 +536,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		if t.clusterConfig.VMStatsCollectorEnabled() {
 			command = append(command, "--vm-stats-collector")
 		}
+		command = append(command, domainStatsArgs(t.clusterConfig.GetDomainStatsConfiguration())...)
 		if customDebugFilters, exists := vmi.Annotations[v1.CustomLibvirtLogFiltersAnnotation]; exists {
 			log.Log.Object(vmi).Infof("Applying custom debug filters for vmi %s: %s", vmi.Name, customDebugFilters)
 			command = append(command, "--libvirt-log-filters", customDebugFilters)
@@ -484,7 +565,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 
 	virtLauncherLogVerbosity := t.clusterConfig.GetVirtLauncherVerbosity()
 
-	if verbosity, isSet := vmi.Labels[logVerbosity]; isSet || virtLauncherLogVerbosity != virtconfig.DefaultVirtLauncherLogVerbosity {
+	if verbosity, isSet := vmi.Labels[v1.LogVerbosityLabel]; isSet || virtLauncherLogVerbosity != virtconfig.DefaultVirtLauncherLogVerbosity {
 		// Override the cluster wide verbosity level if a specific value has been provided for this VMI
 		verbosityStr := fmt.Sprint(virtLauncherLogVerbosity)
 		if isSet {
@@ -506,6 +587,10 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	if labelValue, ok := vmi.Labels[virtiofsDebugLogs]; (ok && strings.EqualFold(labelValue, "true")) || virtLauncherLogVerbosity > util.EXT_LOG_VERBOSITY_THRESHOLD {
 		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: util.ENV_VAR_VIRTIOFSD_DEBUG_LOGS, Value: "1"})
 	}
+	compute.Env = append(compute.Env, serialConsoleLogRotationEnv(vmi, t.clusterConfig)...)
+	if endpoint := tracecontext.TracesEndpoint(); endpoint != "" {
+		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: tracecontext.TracesEndpointEnv, Value: endpoint})
+	}
 
 	compute.Env = append(compute.Env, k8sv1.EnvVar{
 		Name: ENV_VAR_POD_NAME,
@@ -778,6 +863,16 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 	if vmi.IsRealtimeEnabled() {
 		log.Log.V(4).Info("Add realtime node label selector")
 		opts = append(opts, WithRealtime())
+		if vmi.Spec.Domain.CPU.Realtime.Profile == v1.RealtimeProfileLowLatency {
+			log.Log.V(4).Info("Add realtime kernel node label selector")
+			opts = append(opts, WithRealtimeKernel())
+		}
+	}
+	if vmi.Spec.Domain.Memory != nil {
+		if class := t.clusterConfig.GetMemoryOvercommitClass(vmi.Spec.Domain.Memory.OvercommitClass); class != nil && class.Swap {
+			log.Log.V(4).Info("Add swap node label selector")
+			opts = append(opts, WithSwap())
+		}
 	}
 	if util.IsSEVVMI(vmi) {
 		log.Log.V(4).Info("Add SEV node label selector")
@@ -803,6 +898,17 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		opts = append(opts, WithTDXSelector())
 	}
 
+	// vCPU hotplug into arm64 guests requires a GICv3
+	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.MaxSockets > cpu.Sockets && virtconfig.IsARM64(vmi.Spec.Architecture) {
+		log.Log.V(4).Info("Add CPU hotplug node label selector")
+		opts = append(opts, WithCPUHotplugSelector())
+	}
+
+	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.NestedVirtualization == v1.NestedVirtualizationRequire {
+		log.Log.V(4).Info("Add nested virtualization node label selector")
+		opts = append(opts, WithNestedVirtualizationSelector())
+	}
+
 	return NewNodeSelectorRenderer(
 		vmi.Spec.NodeSelector,
 		t.clusterConfig.GetNodeSelectors(),
@@ -910,6 +1016,9 @@ func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imag
 	if imageVolumeFeatureGateEnabled {
 		volumeOpts = append(volumeOpts, withImageVolumes(vmi))
 	}
+	if image := t.efiFirmwareImage(vmi); image != "" {
+		volumeOpts = append(volumeOpts, withEFIFirmwareImage(image))
+	}
 	if len(requestedHookSidecarList) != 0 {
 		volumeOpts = append(volumeOpts, withSidecarVolumes(requestedHookSidecarList))
 	}
@@ -1402,6 +1511,18 @@ func wrapGuestAgentPingWithVirtProbe(vmi *v1.VirtualMachineInstance, probe *k8sv
 	return
 }
 
+func wrapGuestAgentFileExistsWithVirtProbe(vmi *v1.VirtualMachineInstance, probe *k8sv1.Probe, path string) {
+	fileExistsCommand := []string{
+		"virt-probe",
+		"--domainName", api.VMINamespaceKeyFunc(vmi),
+		"--timeoutSeconds", strconv.FormatInt(int64(probe.TimeoutSeconds), 10),
+		"--guestAgentFileExists", path,
+	}
+	probe.ProbeHandler.Exec = &k8sv1.ExecAction{Command: fileExistsCommand}
+	// we add 1s to the pod probe to compensate for the additional steps in probing
+	probe.TimeoutSeconds += 1
+}
+
 func alignPodMultiCategorySecurity(pod *k8sv1.Pod, selinuxType string, dockerSELinuxMCSWorkaround bool) {
 	if selinuxType == "" && !dockerSELinuxMCSWorkaround {
 		// No SELinux type and no docker workaround, nothing to do
@@ -1506,6 +1627,25 @@ func filterVMIAnnotationsForPod(vmiAnnotations map[string]string) map[string]str
 	return annotationsList
 }
 
+func domainStatsArgs(config *v1.DomainStatsConfiguration) []string {
+	if config == nil {
+		return nil
+	}
+
+	var args []string
+	if config.CollectionInterval != nil && config.CollectionInterval.Duration > 0 {
+		args = append(args, "--domain-stats-interval", config.CollectionInterval.Duration.String())
+	}
+	if len(config.DisabledCollectors) > 0 {
+		collectors := make([]string, 0, len(config.DisabledCollectors))
+		for _, collector := range config.DisabledCollectors {
+			collectors = append(collectors, string(collector))
+		}
+		args = append(args, "--disabled-domain-stats", strings.Join(collectors, ","))
+	}
+	return args
+}
+
 func checkForKeepLauncherAfterFailure(vmi *v1.VirtualMachineInstance) bool {
 	keepLauncherAfterFailure := false
 	for k, v := range vmi.Annotations {
@@ -1575,7 +1715,7 @@ func (t *TemplateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 		vmi: vmi,
 		resourceRules: []VMIResourceRule{
 			// Run overcommit first to avoid overcommitting overhead memory
-			NewVMIResourceRule(emptyMemoryRequest, WithMemoryRequests(vmi.Spec.Domain.Memory, t.clusterConfig.GetMemoryOvercommit())),
+			NewVMIResourceRule(emptyMemoryRequest, WithMemoryRequests(vmi.Spec.Domain.Memory, t.clusterConfig.GetVMIMemoryOvercommit(vmi))),
 			NewVMIResourceRule(doesVMIRequireDedicatedCPU, WithCPUPinning(vmi, vmi.Annotations, additionalCPUs)),
 			NewVMIResourceRule(not(doesVMIRequireDedicatedCPU), WithoutDedicatedCPU(vmi, t.clusterConfig.GetCPUAllocationRatio(), withCPULimits)),
 			NewVMIResourceRule(hasHugePages, WithHugePages(vmi.Spec.Domain.Memory, memoryOverhead)),
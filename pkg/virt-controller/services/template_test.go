@@ -26,6 +26,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -1287,6 +1288,23 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(v1.RealtimeKernelLabel)))
+			})
+
+			It("should add realtime kernel node label selector with the lowLatency realtime profile", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{
+						CPU: &v1.CPU{Realtime: &v1.Realtime{Profile: v1.RealtimeProfileLowLatency}},
+					}},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.RealtimeKernelLabel, "true"))
 			})
 
 			It("should not add realtime node label selector when no realtime workload", func() {
@@ -1431,6 +1449,59 @@ var _ = Describe("Template", func() {
 				})
 			})
 
+			DescribeTable("should add the nested virtualization node label selector", func(policy v1.NestedVirtualizationPolicy, expected bool) {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.CPU = &v1.CPU{NestedVirtualization: policy}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				if expected {
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.NestedVirtualizationLabel, "true"))
+				} else {
+					Expect(pod.Spec.NodeSelector).To(Not(HaveKey(v1.NestedVirtualizationLabel)))
+				}
+			},
+				Entry("when nested virtualization is required", v1.NestedVirtualizationRequire, true),
+				Entry("not when nested virtualization is forbidden", v1.NestedVirtualizationForbid, false),
+				Entry("not by default", v1.NestedVirtualizationPolicy(""), false),
+			)
+
+			Context("When scheduling arm64 workloads", func() {
+				var vmi *v1.VirtualMachineInstance
+
+				BeforeEach(func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					vmi = api.NewMinimalVMI("testvmi")
+					vmi.Spec.Architecture = "arm64"
+				})
+
+				It("should add CPU hotplug node label selector when vCPUs can be hotplugged", func() {
+					vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 2, MaxSockets: 8}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUHotplugLabel, "true"))
+				})
+
+				It("should not add CPU hotplug node label selector when maxSockets equals sockets", func() {
+					vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 2, MaxSockets: 2}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(Not(HaveKey(v1.CPUHotplugLabel)))
+				})
+
+				It("should not add CPU hotplug node label selector on amd64", func() {
+					vmi.Spec.Architecture = "amd64"
+					vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 2, MaxSockets: 8}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(Not(HaveKey(v1.CPUHotplugLabel)))
+				})
+			})
+
 			It("should not add node selector for hyperv nodes if VMI does not request hyperv features", func() {
 				config, kvStore, svc = configFactory(defaultArch)
 				enableFeatureGate(featuregate.HypervStrictCheckGate)
@@ -1519,6 +1590,7 @@ var _ = Describe("Template", func() {
 									},
 									SyNICTimer: &v1.SyNICTimer{
 										FeatureState: v1.FeatureState{Enabled: pointer.P(true)},
+										Direct:       &v1.FeatureState{Enabled: pointer.P(true)},
 									},
 									Frequencies: &v1.FeatureState{
 										Enabled: pointer.P(true),
@@ -1540,6 +1612,7 @@ var _ = Describe("Template", func() {
 
 				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"synic", "true"))
 				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"synictimer", "true"))
+				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"synictimer-direct", "true"))
 				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"frequencies", "true"))
 				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"ipi", "true"))
 				if EVMCSEnabled {
@@ -2527,6 +2600,53 @@ var _ = Describe("Template", func() {
 					// All four memory setters
 					Entry("all memory setters - not expect overcommit", notExpectOvercommit, setMemoryRequests, setMemoryLimits, setGuestMemory, setHugePagesMemory),
 				)
+
+				Context("with overcommit classes", func() {
+					newVMIWithClass := func(class string) *v1.VirtualMachineInstance {
+						return &v1.VirtualMachineInstance{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "testvmi",
+								Namespace: "default",
+								UID:       "1234",
+							},
+							Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{
+								Memory: &v1.Memory{Guest: pointer.P(resource.MustParse("1Gi")), OvercommitClass: class},
+							}},
+						}
+					}
+
+					BeforeEach(func() {
+						config, kvStore, svc = configFactory(defaultArch)
+						kvConfig := kv.DeepCopy()
+						kvConfig.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit = 110
+						kvConfig.Spec.Configuration.MemoryOvercommitConfiguration = &v1.MemoryOvercommitConfiguration{
+							Classes: []v1.MemoryOvercommitClass{
+								{Name: "dense", MemoryOvercommit: 200},
+								{Name: "swap", MemoryOvercommit: 150, Swap: true},
+							},
+						}
+						testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+					})
+
+					It("should use the ratio of the selected class instead of the cluster wide one", func() {
+						vmi := newVMIWithClass("dense")
+						overhead := hypervisor.NewLauncherHypervisorResources(config.GetHypervisor().Name).GetMemoryOverhead(vmi, svc.clusterConfig.GetClusterCPUArch(), svc.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio)
+
+						pod, err := svc.RenderLaunchManifest(vmi)
+						Expect(err).ToNot(HaveOccurred())
+
+						request := pod.Spec.Containers[0].Resources.Requests.Memory()
+						request.Sub(overhead)
+						Expect(request.Cmp(resource.MustParse("512Mi"))).To(BeZero())
+						Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.SwapEnabledLabel))
+					})
+
+					It("should add the swap node label selector when the class allows swap", func() {
+						pod, err := svc.RenderLaunchManifest(newVMIWithClass("swap"))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.SwapEnabledLabel, "true"))
+					})
+				})
 			})
 		})
 
@@ -3470,6 +3590,52 @@ var _ = Describe("Template", func() {
 					}))
 				})
 			})
+			Context("with several sources", func() {
+				It("Should project the Sysprep sources into a single volume", func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					disableFeatureGate(featuregate.ImageVolume)
+					items := []k8sv1.KeyToPath{{Key: "unattend.xml", Path: "unattend.xml"}}
+					volumes := []v1.Volume{
+						{
+							Name: "sysprep-volume",
+							VolumeSource: v1.VolumeSource{
+								Sysprep: &v1.SysprepSource{
+									Sources: []v1.SysprepProjection{
+										{ConfigMap: &k8sv1.LocalObjectReference{Name: "test-sysprep-configmap"}, Items: items},
+										{Secret: &k8sv1.LocalObjectReference{Name: "test-sysprep-certificates"}},
+									},
+								},
+							},
+						},
+					}
+					vmi := v1.VirtualMachineInstance{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "testvmi", Namespace: "default", UID: "1234",
+						},
+						Spec: v1.VirtualMachineInstanceSpec{Volumes: volumes, Domain: v1.DomainSpec{}},
+					}
+
+					pod, err := svc.RenderLaunchManifest(&vmi)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+						Name: "sysprep-volume",
+						VolumeSource: k8sv1.VolumeSource{
+							Projected: &k8sv1.ProjectedVolumeSource{
+								Sources: []k8sv1.VolumeProjection{
+									{ConfigMap: &k8sv1.ConfigMapProjection{
+										LocalObjectReference: k8sv1.LocalObjectReference{Name: "test-sysprep-configmap"},
+										Items:                items,
+									}},
+									{Secret: &k8sv1.SecretProjection{
+										LocalObjectReference: k8sv1.LocalObjectReference{Name: "test-sysprep-certificates"},
+									}},
+								},
+							},
+						},
+					}))
+				})
+			})
 		})
 
 		Context("with a secret volume source", func() {
@@ -3834,7 +4000,7 @@ var _ = Describe("Template", func() {
 						DedicatedCPUPlacement: true,
 					}
 				}
-				res := virtiofs.ResourcesForVirtioFSContainer(dedicatedCpu, quaranteedQos, clusterConfig)
+				res := virtiofs.ResourcesForVirtioFSContainer(dedicatedCpu, quaranteedQos, nil, clusterConfig)
 				Expect(res.Requests).To(BeEquivalentTo(expectedReq))
 				Expect(res.Limits).To(BeEquivalentTo(expectedLim))
 			},
@@ -4486,6 +4652,117 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with DomainStatsConfiguration", func() {
+			computeCommand := func() []string {
+				pod, err := svc.RenderLaunchManifest(libvmi.New(libvmi.WithNamespace("default")))
+				Expect(err).ToNot(HaveOccurred())
+				for _, container := range pod.Spec.Containers {
+					if container.Name == "compute" {
+						return container.Command
+					}
+				}
+				Fail("compute container not found")
+				return nil
+			}
+
+			It("should pass the domain stats settings to virt-launcher", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DomainStatsConfiguration: &v1.DomainStatsConfiguration{
+								CollectionInterval: &metav1.Duration{Duration: 30 * time.Second},
+								DisabledCollectors: []v1.DomainStatsCollector{v1.DomainStatsCollectorBlock, v1.DomainStatsCollectorVCPU},
+							},
+						},
+					},
+				})
+
+				command := computeCommand()
+				Expect(command).To(ContainElements("--domain-stats-interval", "30s"))
+				Expect(command).To(ContainElements("--disabled-domain-stats", "block,vcpu"))
+			})
+
+			It("should not pass domain stats settings by default", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+
+				command := computeCommand()
+				Expect(command).ToNot(ContainElement("--domain-stats-interval"))
+				Expect(command).ToNot(ContainElement("--disabled-domain-stats"))
+			})
+		})
+
+		Context("with EFI firmware images", func() {
+			const firmwareImage = "registry.example.com/firmware/ovmf:latest"
+
+			configureFirmware := func(featureGates ...string) {
+				config, kvStore, svc = configFactory(defaultArch)
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								DisabledFeatureGates: featureGates,
+							},
+							ArchitectureConfiguration: &v1.ArchConfiguration{
+								Amd64: &v1.ArchSpecificConfiguration{
+									OVMFPath: virtconfig.DefaultARCHOVMFPath,
+									Firmware: &v1.EFIFirmwareConfiguration{
+										Image:      firmwareImage,
+										SecureBoot: &v1.EFIFirmwareImages{Code: "OVMF_CODE_4M.ms.fd", Vars: "OVMF_VARS_4M.ms.fd"},
+										SNP:        &v1.EFIFirmwareImages{Code: "OVMF.amdsev-custom.fd", Vars: "ignored.fd"},
+									},
+								},
+							},
+						},
+					},
+				})
+			}
+
+			It("should pass the images to virt-launcher", func() {
+				configureFirmware()
+
+				pod, err := svc.RenderLaunchManifest(libvmi.New(libvmi.WithNamespace("default"), libvmi.WithUefi(true)))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements(
+					"--efi-firmware-images", "secureBootCode=OVMF_CODE_4M.ms.fd,secureBootVars=OVMF_VARS_4M.ms.fd,snpCode=OVMF.amdsev-custom.fd",
+				))
+			})
+
+			It("should mount the firmware image and look the images up in it", func() {
+				configureFirmware()
+
+				pod, err := svc.RenderLaunchManifest(libvmi.New(libvmi.WithNamespace("default"), libvmi.WithUefi(true)))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements("--ovmf-path", util.VirtEFIFirmwareDir))
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: "efi-firmware",
+					VolumeSource: k8sv1.VolumeSource{
+						Image: &k8sv1.ImageVolumeSource{Reference: firmwareImage, PullPolicy: k8sv1.PullIfNotPresent},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      "efi-firmware",
+					MountPath: util.VirtEFIFirmwareDir,
+					ReadOnly:  true,
+				}))
+			})
+
+			DescribeTable("should not mount the firmware image", func(vmi *v1.VirtualMachineInstance, featureGates ...string) {
+				configureFirmware(featureGates...)
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements("--ovmf-path", virtconfig.DefaultARCHOVMFPath))
+				for _, volume := range pod.Spec.Volumes {
+					Expect(volume.Name).ToNot(Equal("efi-firmware"))
+				}
+			},
+				Entry("with BIOS", libvmi.New(libvmi.WithNamespace("default"))),
+				Entry("without the ImageVolume feature gate",
+					libvmi.New(libvmi.WithNamespace("default"), libvmi.WithUefi(true)), featuregate.ImageVolume),
+			)
+		})
+
 		Context("Using defaultRuntimeClass", func() {
 			It("Should set a runtimeClassName on launcher pod, if configured", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -5787,6 +6064,55 @@ var _ = Describe("Template", func() {
 			Entry("without AutoattachSerialConsole but with LogSerialConsole", false, true, false),
 			Entry("without AutoattachSerialConsole and without LogSerialConsole", false, false, false),
 		)
+
+		DescribeTable("should pass the serial console log rotation limits to the compute container", func(logSerialConsole bool, matcher OmegaMatcher) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.VirtualMachineOptions.SerialConsoleLogRotation = &v1.SerialConsoleLogRotation{
+				MaxSize:    pointer.P(resource.MustParse("8Mi")),
+				MaxBackups: pointer.P(uint32(5)),
+			}
+			_, kvStore, svc = configFactory(defaultArch)
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Domain.Devices.LogSerialConsole = &logSerialConsole
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Spec.Containers[0].Env).To(matcher)
+		},
+			Entry("with LogSerialConsole", true, ContainElements(
+				k8sv1.EnvVar{Name: util.ENV_VAR_SERIAL_LOG_MAX_SIZE, Value: "8388608"},
+				k8sv1.EnvVar{Name: util.ENV_VAR_SERIAL_LOG_MAX_BACKUPS, Value: "5"},
+			)),
+			Entry("without LogSerialConsole", false, Not(ContainElement(
+				gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{"Name": Equal(util.ENV_VAR_SERIAL_LOG_MAX_SIZE)}),
+			))),
+		)
+
+		DescribeTable("should pass the serial console log format to the guest-console-log container", func(format v1.SerialConsoleLogFormat, expectedArgs []string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.VirtualMachineOptions.SerialConsoleLogFormat = format
+			_, kvStore, svc = configFactory(defaultArch)
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.UID = "fake-uid"
+			vmi.Spec.Domain.Devices.LogSerialConsole = pointer.P(true)
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Spec.InitContainers).To(ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Name": Equal("guest-console-log"),
+				"Args": Equal(append([]string{"--logfile", "/var/run/kubevirt-private/fake-uid/virt-serial0-log"}, expectedArgs...)),
+			})))
+		},
+			Entry("by default", v1.SerialConsoleLogFormat(""), []string{}),
+			Entry("with the text format", v1.SerialConsoleLogFormatText, []string{}),
+			Entry("with the JSON format", v1.SerialConsoleLogFormatJSON, []string{
+				"--format", "json", "--namespace", "default", "--name", "fake-vmi", "--uid", "fake-uid",
+			}),
+		)
 	})
 
 	Context("network-info", func() {
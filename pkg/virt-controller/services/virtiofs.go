@@ -31,8 +31,9 @@ func generateVirtioFSContainers(vmi *v1.VirtualMachineInstance, image string, co
 			if volume.ContainerPath != nil {
 				continue
 			}
-			resources := virtiofs.ResourcesForVirtioFSContainer(vmi.IsCPUDedicated(), vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), config)
-			container := generateContainerFromVolume(&volume, image, resources)
+			fs := virtiofs.GetFilesystemVirtiofs(vmi, volume.Name)
+			resources := virtiofs.ResourcesForVirtioFSContainer(vmi.IsCPUDedicated(), vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), fs, config)
+			container := generateContainerFromVolume(&volume, fs, image, resources)
 			containers = append(containers, container)
 
 		}
@@ -62,12 +63,13 @@ func virtioFSMountPoint(volume *v1.Volume) string {
 	return volumeMountPoint
 }
 
-func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
+func generateContainerFromVolume(volume *v1.Volume, fs *v1.FilesystemVirtiofs, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
 
 	socketPathArg := fmt.Sprintf("--socket-path=%s", virtiofs.VirtioFSSocketPath(volume.Name))
 	sourceArg := fmt.Sprintf("--shared-dir=%s", virtioFSMountPoint(volume))
 
-	args := []string{socketPathArg, sourceArg, "--sandbox=none", "--cache=auto"}
+	args := []string{socketPathArg, sourceArg, "--sandbox=none"}
+	args = append(args, virtiofs.TuningArgs(fs)...)
 
 	// If some files cannot be migrated, let's allow the migration to finish.
 	// Mark these files as invalid, the guest will not be able to access any such files,
@@ -6,10 +6,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -155,4 +158,42 @@ var _ = Describe("virtiofs container", func() {
 		mapUidToGuestRoot := fmt.Sprintf("--translate-uid=host:%d:0:1", util.NonRootUID)
 		Expect(container[0].Args).Should(ContainElement(mapUidToGuestRoot))
 	})
+
+	It("should tune every virtiofsd process with the options of its filesystem", func() {
+		vmi := api.NewMinimalVMI("testvm")
+
+		for _, name := range []string{"default-volume", "tuned-volume"} {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: name,
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+				},
+			})
+		}
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems,
+			v1.Filesystem{
+				Name:     "default-volume",
+				Virtiofs: &v1.FilesystemVirtiofs{},
+			},
+			v1.Filesystem{
+				Name: "tuned-volume",
+				Virtiofs: &v1.FilesystemVirtiofs{
+					Cache:          v1.VirtiofsCacheNever,
+					ThreadPoolSize: pointer.P(uint32(16)),
+				},
+			},
+		)
+
+		containers := generateVirtioFSContainers(vmi, "virtiofs-container", config)
+		Expect(containers).To(HaveLen(2))
+
+		Expect(containers[0].Args).To(ContainElement("--cache=auto"))
+		Expect(containers[0].Args).ToNot(ContainElement(HavePrefix("--thread-pool-size")))
+		Expect(containers[1].Args).To(ContainElements("--cache=never", "--thread-pool-size=16"))
+
+		defaultMemory := containers[0].Resources.Limits[k8sv1.ResourceMemory]
+		tunedMemory := containers[1].Resources.Limits[k8sv1.ResourceMemory]
+		defaultMemory.Add(resource.MustParse("32Mi"))
+		Expect(tunedMemory.Cmp(defaultMemory)).To(Equal(0))
+	})
 })
@@ -38,8 +38,10 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/launcherreservation"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/orphan"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
@@ -62,7 +64,9 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/flowcontrol"
 
+	"kubevirt.io/kubevirt/pkg/util/eventfilter"
 	"kubevirt.io/kubevirt/pkg/util/ratelimiter"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 
@@ -95,6 +99,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/nodemaintenance"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/machinetype"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/quota"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmschedule"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
@@ -152,8 +160,13 @@ type VirtControllerApp struct {
 	kvPodInformer   cache.SharedIndexInformer
 
 	nodeInformer   cache.SharedIndexInformer
+	leaseInformer  cache.SharedIndexInformer
 	nodeController *node.Controller
 
+	orphanController *orphan.Controller
+
+	reservationController *launcherreservation.Controller
+
 	vmiCache      cache.Store
 	vmiController *vmi.Controller
 	vmiInformer   cache.SharedIndexInformer
@@ -165,6 +178,7 @@ type VirtControllerApp struct {
 	kubeVirtInformer cache.SharedIndexInformer
 
 	clusterConfig *virtconfig.ClusterConfig
+	eventFilter   *eventfilter.Filter
 
 	pdbInformer cache.SharedIndexInformer
 
@@ -215,6 +229,12 @@ type VirtControllerApp struct {
 
 	migrationPolicyInformer cache.SharedIndexInformer
 
+	nodeMaintenanceInformer   cache.SharedIndexInformer
+	nodeMaintenanceController *nodemaintenance.Controller
+
+	vmQuotaInformer   cache.SharedIndexInformer
+	vmQuotaController *quota.Controller
+
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clonecontroller.VMCloneController
 
@@ -241,25 +261,30 @@ type VirtControllerApp struct {
 	kubevirtNamespace          string
 	host                       string
 	evacuationController       *evacuation.EvacuationController
+	vmScheduleController       *vmschedule.Controller
+	machineTypeController      *machinetype.Controller
 	disruptionBudgetController *disruptionbudget.DisruptionBudgetController
 
 	ctx context.Context
 
 	// indicates if controllers were started with or without CDI/DataVolume support
 	hasCDI bool
-	// indicates if controllers were started with or without DRA support
-	isDRAEnabled bool
 	// the channel used to trigger re-initialization.
 	reInitChan chan string
 
 	// number of threads for each controller
 	nodeControllerThreads             int
+	orphanControllerThreads           int
+	reservationControllerThreads      int
 	vmiControllerThreads              int
 	rsControllerThreads               int
 	poolControllerThreads             int
 	vmControllerThreads               int
 	migrationControllerThreads        int
 	evacuationControllerThreads       int
+	nodeMaintenanceControllerThreads  int
+	vmScheduleControllerThreads       int
+	machineTypeControllerThreads      int
 	disruptionBudgetControllerThreads int
 	launcherSubGid                    int64
 	exportControllerThreads           int
@@ -270,6 +295,7 @@ type VirtControllerApp struct {
 	additionalLauncherAnnotationsSync []string
 	additionalLauncherLabelsSync      []string
 	backupControllerThreads           int
+	vmQuotaControllerThreads          int
 
 	promCertFilePath string
 	promKeyFilePath  string
@@ -304,6 +330,7 @@ func Execute() {
 	app.readyChan = make(chan bool, 1)
 
 	log.InitializeLogging("virt-controller")
+	tracecontext.InitExporter("virt-controller")
 
 	app.reloadableRateLimiter = ratelimiter.NewReloadableRateLimiter(flowcontrol.NewTokenBucketRateLimiter(virtconfig.DefaultVirtControllerQPS, virtconfig.DefaultVirtControllerBurst))
 	clientmetrics.RegisterRestConfigHooks()
@@ -356,10 +383,10 @@ func Execute() {
 
 	app.reInitChan = make(chan string, 10)
 	app.hasCDI = app.clusterConfig.HasDataVolumeAPI()
-	app.isDRAEnabled = app.clusterConfig.AnyDeviceDRAGateEnabled()
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
+	app.eventFilter = eventfilter.NewFilter(app.clusterConfig.GetEventConfiguration)
 
 	webService := new(restful.WebService)
 	webService.Path("/").Consumes(restful.MIME_JSON).Produces(restful.MIME_JSON)
@@ -376,6 +403,7 @@ func Execute() {
 	app.vmiInformer = app.informerFactory.VMI()
 	app.kvPodInformer = app.informerFactory.KubeVirtPod()
 	app.nodeInformer = app.informerFactory.KubeVirtNode()
+	app.leaseInformer = app.informerFactory.Leases()
 	app.namespaceStore = app.informerFactory.Namespace().GetStore()
 	app.namespaceInformer = app.informerFactory.Namespace()
 	app.vmiCache = app.vmiInformer.GetStore()
@@ -442,6 +470,8 @@ func Execute() {
 	}
 	app.ingressCache = app.informerFactory.Ingress().GetStore()
 	app.migrationPolicyInformer = app.informerFactory.MigrationPolicy()
+	app.nodeMaintenanceInformer = app.informerFactory.NodeMaintenance()
+	app.vmQuotaInformer = app.informerFactory.VirtualMachineQuota()
 
 	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
 
@@ -466,6 +496,8 @@ func Execute() {
 		Preference:            app.preferenceInformer.GetStore(),
 		ClusterPreference:     app.clusterPreferenceInformer.GetStore(),
 		ControllerRevision:    app.controllerRevisionInformer.GetStore(),
+		VMRestore:             app.vmRestoreInformer.GetStore(),
+		VMExport:              app.vmExportInformer.GetStore(),
 	}
 
 	if err := metrics.SetupMetrics(
@@ -483,12 +515,16 @@ func Execute() {
 	app.initVirtualMachines()
 	app.initDisruptionBudgetController()
 	app.initEvacuationController()
+	app.initNodeMaintenanceController()
+	app.initVMScheduleController()
+	app.initMachineTypeController()
 	app.initSnapshotController()
 	app.initRestoreController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
 	app.initBackupController()
+	app.initVMQuotaController()
 	go app.Run()
 
 	<-app.reInitChan
@@ -508,16 +544,6 @@ func (vca *VirtControllerApp) configModificationCallback() {
 		vca.reInitChan <- "reinit"
 		return
 	}
-	newIsDRAEnabled := vca.clusterConfig.AnyDeviceDRAGateEnabled()
-	if newIsDRAEnabled != vca.isDRAEnabled {
-		if newIsDRAEnabled {
-			log.Log.Infof("Reinitialize virt-controller, DRA integration has been introduced")
-		} else {
-			log.Log.Infof("Reinitialize virt-controller, DRA integration has been removed")
-		}
-		vca.reInitChan <- "reinit"
-		return
-	}
 }
 
 // Update virt-controller rate limiter
@@ -603,8 +629,13 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		}
 
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
+		go vca.nodeMaintenanceController.Run(vca.nodeMaintenanceControllerThreads, stop)
+		go vca.vmScheduleController.Run(vca.vmScheduleControllerThreads, stop)
+		go vca.machineTypeController.Run(vca.machineTypeControllerThreads, stop)
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
+		go vca.orphanController.Run(vca.orphanControllerThreads, stop)
+		go vca.reservationController.Run(vca.reservationControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
@@ -637,6 +668,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the backup controller: %v", err)
 			}
 		}()
+		go vca.vmQuotaController.Run(vca.vmQuotaControllerThreads, stop)
 
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
 		close(vca.readyChan)
@@ -647,7 +679,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 func (vca *VirtControllerApp) newRecorder(namespace string, componentName string) record.EventRecorder {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(&k8coresv1.EventSinkImpl{Interface: vca.clientSet.CoreV1().Events(namespace)})
-	return eventBroadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: componentName})
+	return vca.eventFilter.Wrap(eventBroadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: componentName}))
 }
 
 func (vca *VirtControllerApp) initCommon() {
@@ -714,13 +746,25 @@ func (vca *VirtControllerApp) initCommon() {
 		netmigration.NewEvaluator(),
 		vca.additionalLauncherAnnotationsSync,
 		vca.additionalLauncherLabelsSync,
+		launcherreservation.NewClaimer(vca.clientSet, vca.kvPodInformer, vca.nodeInformer, vca.clusterConfig, vca.kubevirtNamespace),
 	)
 	if err != nil {
 		panic(err)
 	}
 
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "node-controller")
-	vca.nodeController, err = node.NewController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, recorder)
+	vca.nodeController, err = node.NewController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, vca.leaseInformer, recorder, vca.kubevirtNamespace)
+	if err != nil {
+		panic(err)
+	}
+	recorder = vca.newRecorder(k8sv1.NamespaceAll, "orphan-controller")
+	vca.orphanController, err = orphan.NewController(vca.clientSet, vca.kvPodInformer, vca.vmiInformer, recorder)
+	if err != nil {
+		panic(err)
+	}
+	recorder = vca.newRecorder(k8sv1.NamespaceAll, "launcher-reservation-controller")
+	vca.reservationController, err = launcherreservation.NewController(vca.clientSet, vca.kvPodInformer, vca.nodeInformer, vca.clusterConfig, recorder,
+		vca.kubevirtNamespace, vca.launcherImage, vca.imagePullSecret)
 	if err != nil {
 		panic(err)
 	}
@@ -772,6 +816,7 @@ func (vca *VirtControllerApp) initPool() {
 		vca.persistentVolumeClaimInformer,
 		vca.dataVolumeInformer,
 		vca.controllerRevisionInformer,
+		vca.nodeInformer,
 		recorder,
 		controller.BurstReplicas)
 	if err != nil {
@@ -842,6 +887,7 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 		vca.kvPodInformer,
 		vca.migrationInformer,
 		vca.kubeVirtInformer,
+		vca.namespaceInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig)
@@ -867,6 +913,53 @@ func (vca *VirtControllerApp) initEvacuationController() {
 	}
 }
 
+func (vca *VirtControllerApp) initNodeMaintenanceController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "node-maintenance-controller")
+	vca.nodeMaintenanceController, err = nodemaintenance.NewController(
+		vca.clientSet,
+		vca.nodeMaintenanceInformer,
+		vca.vmiInformer,
+		vca.migrationInformer,
+		vca.nodeInformer,
+		recorder,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initVMScheduleController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "vm-schedule-controller")
+	vca.vmScheduleController, err = vmschedule.NewController(
+		vca.vmInformer,
+		vca.vmiInformer,
+		recorder,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initMachineTypeController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "machine-type-controller")
+	vca.machineTypeController, err = machinetype.NewController(
+		vca.vmInformer,
+		vca.nodeInformer,
+		recorder,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initSnapshotController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-controller")
 	vca.snapshotController = &snapshot.VMSnapshotController{
@@ -979,6 +1072,19 @@ func (vca *VirtControllerApp) initBackupController() {
 	}
 }
 
+func (vca *VirtControllerApp) initVMQuotaController() {
+	var err error
+	vca.vmQuotaController, err = quota.NewController(
+		vca.vmQuotaInformer,
+		vca.vmiInformer,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -1037,6 +1143,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.nodeControllerThreads, "node-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for node controller")
 
+	flag.IntVar(&vca.orphanControllerThreads, "orphan-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for orphaned virt-launcher pod controller")
+
+	flag.IntVar(&vca.reservationControllerThreads, "launcher-reservation-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for launcher reservation controller")
+
 	flag.IntVar(&vca.vmiControllerThreads, "vmi-controller-threads", defaultVMIControllerThreads,
 		"Number of goroutines to run for vmi controller")
 
@@ -1055,6 +1167,15 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.evacuationControllerThreads, "evacuation-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for evacuation controller")
 
+	flag.IntVar(&vca.nodeMaintenanceControllerThreads, "node-maintenance-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for node maintenance controller")
+
+	flag.IntVar(&vca.vmScheduleControllerThreads, "vm-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for vm schedule controller")
+
+	flag.IntVar(&vca.machineTypeControllerThreads, "machine-type-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for machine type controller")
+
 	flag.IntVar(&vca.disruptionBudgetControllerThreads, "disruption-budget-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for disruption budget controller")
 
@@ -1092,6 +1213,9 @@ func (vca *VirtControllerApp) AddFlags() {
 		"Comma separated list of labels keys which if present on the VM template and so VMI, will be sync to the virt-launcher pod. Supports prefix wildcards via the '*' suffix (for example 'vendor.io/*'). Note, it is unidirectional from VM.spec.template.metadata -> VMI and VMI -> virt-launcher pod")
 	flag.IntVar(&vca.backupControllerThreads, "backup-controller-threads", defaultBackupControllerThreads,
 		"Number of goroutines to run for backup controller")
+
+	flag.IntVar(&vca.vmQuotaControllerThreads, "vm-quota-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for vm quota controller")
 }
 
 func (vca *VirtControllerApp) setupLeaderElector() (err error) {
@@ -33,6 +33,7 @@ import (
 
 	"github.com/emicklei/go-restful/v3"
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -46,7 +47,9 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -64,12 +67,18 @@ import (
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/nodemaintenance"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/launcherreservation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/machinetype"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/orphan"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/quota"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmschedule"
 )
 
 func newValidGetRequest() *http.Request {
@@ -96,6 +105,7 @@ var _ = Describe("Application", func() {
 		vmSnapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		migrationInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		leaseInformer, _ := testutils.NewFakeInformerFor(&coordinationv1.Lease{})
 		recorder := record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
@@ -131,6 +141,8 @@ var _ = Describe("Application", func() {
 		preferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
 		clusterPreferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
 		controllerRevisionInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+		nodeMaintenanceInformer, _ := testutils.NewFakeInformerFor(&maintenancev1alpha1.NodeMaintenance{})
+		vmQuotaInformer, _ := testutils.NewFakeInformerFor(&quotav1alpha1.VirtualMachineQuota{})
 
 		var qemuGid int64 = 107
 
@@ -138,8 +150,13 @@ var _ = Describe("Application", func() {
 		app.nodeTopologyUpdater = topologyUpdater
 		app.informerFactory = controller.NewKubeInformerFactory(nil, nil, nil, "test")
 		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		app.nodeMaintenanceController, _ = nodemaintenance.NewController(virtClient, nodeMaintenanceInformer, vmiInformer, migrationInformer, nodeInformer, recorder, config)
+		app.vmScheduleController, _ = vmschedule.NewController(vmInformer, vmiInformer, recorder, virtClient, config)
+		app.machineTypeController, _ = machinetype.NewController(vmInformer, nodeInformer, recorder, virtClient, config)
 		app.disruptionBudgetController, _ = disruptionbudget.NewDisruptionBudgetController(vmiInformer, pdbInformer, podInformer, migrationInformer, recorder, virtClient)
-		app.nodeController, _ = node.NewController(virtClient, nodeInformer, vmiInformer, recorder)
+		app.nodeController, _ = node.NewController(virtClient, nodeInformer, vmiInformer, leaseInformer, recorder, metav1.NamespaceDefault)
+		app.orphanController, _ = orphan.NewController(virtClient, podInformer, vmiInformer, recorder)
+		app.reservationController, _ = launcherreservation.NewController(virtClient, podInformer, nodeInformer, config, recorder, metav1.NamespaceDefault, "g", "")
 		app.vmiController, _ = vmi.NewController(services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
 			vmiInformer,
 			vmInformer,
@@ -165,6 +182,7 @@ var _ = Describe("Application", func() {
 			stubMigrationEvaluator{},
 			[]string{},
 			[]string{},
+			nil,
 		)
 		app.rsController, _ = replicaset.NewController(vmiInformer, rsInformer, recorder, virtClient, uint(10))
 		app.vmController, _ = vm.NewController(vmiInformer,
@@ -281,6 +299,7 @@ var _ = Describe("Application", func() {
 			recorder,
 			"",
 		)
+		app.vmQuotaController, _ = quota.NewController(vmQuotaInformer, vmiInformer, virtClient, config)
 
 		app.readyChan = make(chan bool)
 
@@ -297,6 +316,7 @@ var _ = Describe("Application", func() {
 		// for sync
 		go pvcInformer.Run(ctx.Done())
 		go nodeInformer.Run(ctx.Done())
+		go leaseInformer.Run(ctx.Done())
 		go resourceQuotaInformer.Run(ctx.Done())
 		go namespaceInformer.Run(ctx.Done())
 		time.Sleep(time.Second)
@@ -35,6 +35,7 @@ import (
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
 
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
@@ -365,11 +366,23 @@ func (ctrl *VMCloneController) updateStatus(origClone *clone.VirtualMachineClone
 		if err != nil {
 			return err
 		}
+		if phaseChanged && origClone.Status.Phase != vmClone.Status.Phase {
+			handleClonePhaseTransition(vmClone, syncInfo)
+		}
 	}
 
 	return nil
 }
 
+func handleClonePhaseTransition(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) {
+	switch vmClone.Status.Phase {
+	case clone.Succeeded:
+		metrics.HandleVMCloneSucceeded(vmClone)
+	case clone.Failed:
+		metrics.HandleVMCloneFailed(string(syncInfo.event))
+	}
+}
+
 func validateVolumeSnapshotStatus(vm *k6tv1.VirtualMachine) error {
 	var vssErr error
 
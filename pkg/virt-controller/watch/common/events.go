@@ -29,4 +29,6 @@ const (
 	SuccessfulDeleteVirtualMachineReason = "SuccessfulDelete"
 	// FailedUpdateVirtualMachineReason when a virtual machine is failed to be updated.
 	FailedUpdateVirtualMachineReason = "FailedUpdate"
+	// VirtioDriversNotConfiguredReason when the virtio-win drivers disk is requested without a source configured on the cluster.
+	VirtioDriversNotConfiguredReason = "VirtioDriversNotConfigured"
 )
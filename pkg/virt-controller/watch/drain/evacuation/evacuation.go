@@ -2,12 +2,15 @@ package evacuation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -35,6 +38,10 @@ const (
 	FailedCreateVirtualMachineInstanceMigrationReason = "FailedCreate"
 	// SuccessfulCreateVirtualMachineInstanceMigrationReason is added in an event if creating a VirtualMachineInstanceMigration succeeded.
 	SuccessfulCreateVirtualMachineInstanceMigrationReason = "SuccessfulCreate"
+	// FailedShutdownVirtualMachineInstanceReason is added in an event if shutting down a VirtualMachineInstance after its eviction migration timeout failed.
+	FailedShutdownVirtualMachineInstanceReason = "FailedShutdown"
+	// SuccessfulShutdownVirtualMachineInstanceReason is added in an event if a VirtualMachineInstance was shut down after its eviction migration timeout.
+	SuccessfulShutdownVirtualMachineInstanceReason = "SuccessfulShutdown"
 )
 
 type EvacuationController struct {
@@ -319,11 +326,55 @@ func (c *EvacuationController) execute(key string) error {
 		return fmt.Errorf("failed to list VMIs on node: %v", err)
 	}
 
+	vmis, err = c.shutdownTimedOutEvictions(node, vmis)
+	if err != nil {
+		return err
+	}
+
 	migrations := migrationutils.ListUnfinishedMigrations(c.migrationIndexer)
 
 	return c.sync(node, vmis, migrations)
 }
 
+// shutdownTimedOutEvictions shuts down the VMIs with the LiveMigrateOrShutdown eviction strategy, which
+// were not migrated within their eviction migration timeout, and returns the remaining VMIs. The node
+// is enqueued again for the next timeout.
+func (c *EvacuationController) shutdownTimedOutEvictions(node *k8sv1.Node, vmis []*virtv1.VirtualMachineInstance) ([]*virtv1.VirtualMachineInstance, error) {
+	var (
+		remaining   []*virtv1.VirtualMachineInstance
+		nextTimeout time.Duration
+		errs        []error
+	)
+	now := time.Now()
+	for _, vmi := range vmis {
+		if vmi.IsFinal() || vmi.DeletionTimestamp != nil || hasMigratedOnEviction(vmi) {
+			remaining = append(remaining, vmi)
+			continue
+		}
+		timeLeft, marked := migrationutils.EvictionShutdownFallbackIn(c.clusterConfig, vmi, now)
+		if !marked || timeLeft > 0 {
+			if marked && (nextTimeout == 0 || timeLeft < nextTimeout) {
+				nextTimeout = timeLeft
+			}
+			remaining = append(remaining, vmi)
+			continue
+		}
+
+		err := c.clientset.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, v1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedShutdownVirtualMachineInstanceReason, "Error shutting down the VirtualMachineInstance after its eviction migration timeout: %v", err)
+			errs = append(errs, err)
+			continue
+		}
+		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulShutdownVirtualMachineInstanceReason, "Shut down the VirtualMachineInstance, since it was not migrated within its eviction migration timeout")
+	}
+
+	if nextTimeout > 0 {
+		c.Queue.AddAfter(node.Name, nextTimeout)
+	}
+	return remaining, errors.Join(errs...)
+}
+
 func getMarkedForEvictionVMIs(vmis []*virtv1.VirtualMachineInstance) []*virtv1.VirtualMachineInstance {
 	var evictionCandidates []*virtv1.VirtualMachineInstance
 	for _, vmi := range vmis {
@@ -375,6 +426,7 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 	if len(migrationCandidates) == 0 && len(nonMigrateable) == 0 {
 		return nil
 	}
+	c.sortByPriority(migrationCandidates)
 
 	selectedCandidates := migrationCandidates
 	if !c.clusterConfig.MigrationPriorityQueueEnabled() {
@@ -413,7 +465,7 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 			return nil
 		}
 
-		// TODO: should the order be randomized?
+		// the VMIs with the highest priority are migrated first
 		selectedCandidates = migrationCandidates[0:diff]
 	}
 
@@ -518,6 +570,23 @@ func (c *EvacuationController) filterRunningNonMigratingVMIs(vmis []*virtv1.Virt
 	return migrateable, nonMigrateable
 }
 
+// sortByPriority orders the VMIs by the descending priority of their virt-launcher pods,
+// as resolved from the PriorityClass of the VMIs. VMIs without priority come last.
+func (c *EvacuationController) sortByPriority(vmis []*virtv1.VirtualMachineInstance) {
+	priorities := make(map[*virtv1.VirtualMachineInstance]int32, len(vmis))
+	for _, vmi := range vmis {
+		pod, err := controller.CurrentVMIPod(vmi, c.vmiPodIndexer)
+		if err != nil || pod == nil || pod.Spec.Priority == nil {
+			priorities[vmi] = math.MinInt32
+			continue
+		}
+		priorities[vmi] = *pod.Spec.Priority
+	}
+	sort.SliceStable(vmis, func(i, j int) bool {
+		return priorities[vmis[i]] > priorities[vmis[j]]
+	})
+}
+
 // deprecated
 // This node evacuation method is deprecated. Use node drain to trigger evictions instead.
 func nodeHasTaint(taint *k8sv1.Taint, node *k8sv1.Node) bool {
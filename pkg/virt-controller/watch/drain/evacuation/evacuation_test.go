@@ -3,6 +3,7 @@ package evacuation
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,8 +12,10 @@ import (
 	"go.uber.org/mock/gomock"
 
 	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -33,9 +36,10 @@ import (
 
 var _ = Describe("Evacuation", func() {
 	var (
-		virtClient *kubecli.MockKubevirtClient
-		recorder   *record.FakeRecorder
-		controller *EvacuationController
+		virtClient     *kubecli.MockKubevirtClient
+		fakeVirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		controller     *EvacuationController
 	)
 
 	addNode := func(node *k8sv1.Node) {
@@ -55,7 +59,7 @@ var _ = Describe("Evacuation", func() {
 	BeforeEach(func() {
 		ctrl := gomock.NewController(GinkgoT())
 		virtClient = kubecli.NewMockKubevirtClient(ctrl)
-		fakeVirtClient := kubevirtfake.NewSimpleClientset()
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
 
 		vmiInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, cache.Indexers{
 			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
@@ -501,6 +505,40 @@ var _ = Describe("Evacuation", func() {
 			expectMigrationCreation()
 		})
 
+		It("should migrate the VMIs with the highest priority first", func() {
+			updateKV(func(kv *v1.KubeVirt) {
+				kv.Spec.Configuration.DeveloperConfiguration = &v1.DeveloperConfiguration{
+					DisabledFeatureGates: []string{featuregate.MigrationPriorityQueue},
+				}
+				kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{
+					ParallelOutboundMigrationsPerNode: pointer.P(uint32(1)),
+				}
+			})
+
+			nodeName := "node01"
+			node := newNode(nodeName)
+			addNode(node)
+			enqueue(node)
+
+			for i, priority := range []*int32{pointer.P(int32(10)), nil, pointer.P(int32(1000))} {
+				vmi := newVirtualMachineMarkedForEviction(fmt.Sprintf("testvmi%d", i), nodeName)
+				vmi.UID = k8stypes.UID(vmi.Name)
+				pod := newPod(vmi, fmt.Sprintf("pod%d", i), k8sv1.PodRunning, true)
+				pod.Spec.NodeName = nodeName
+				pod.Spec.Priority = priority
+				controller.vmiIndexer.Add(vmi)
+				controller.vmiPodIndexer.Add(pod)
+			}
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migrationList, err := virtClient.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrationList.Items).To(HaveLen(1))
+			Expect(migrationList.Items[0].Spec.VMIName).To(Equal("testvmi2"))
+		})
+
 		It("should treat pending migrations as non-running migrations", func() {
 			const maxParallelMigrationsPerCluster uint32 = 10
 			const maxParallelMigrationsPerSourceNode uint32 = 10
@@ -537,6 +575,69 @@ var _ = Describe("Evacuation", func() {
 		})
 	})
 
+	Context("VMIs with the LiveMigrateOrShutdown eviction strategy", func() {
+		var node *k8sv1.Node
+
+		BeforeEach(func() {
+			node = newNode("testnode")
+			addNode(node)
+			enqueue(node)
+			virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
+		})
+
+		newVirtualMachineEvictedSince := func(evictionRequested time.Duration) *v1.VirtualMachineInstance {
+			vmi := newVirtualMachineMarkedForEviction("testvm", node.Name)
+			vmi.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyLiveMigrateOrShutdown)
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:               v1.VirtualMachineInstanceEvictionRequested,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-evictionRequested)),
+			})
+			_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			controller.vmiIndexer.Add(vmi)
+			return vmi
+		}
+
+		expectVMIShutdown := func(vmi *v1.VirtualMachineInstance) {
+			_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			ExpectWithOffset(1, k8serrors.IsNotFound(err)).To(BeTrue())
+			migrationList, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, migrationList.Items).To(BeEmpty())
+		}
+
+		It("should migrate the VMI within its eviction migration timeout", func() {
+			newVirtualMachineEvictedSince(time.Minute)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+		})
+
+		It("should shut the VMI down after the cluster wide eviction migration timeout", func() {
+			updateKV(func(kv *v1.KubeVirt) {
+				kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{
+					EvictionMigrationTimeout: pointer.P(int64(300)),
+				}
+			})
+			vmi := newVirtualMachineEvictedSince(6 * time.Minute)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulShutdownVirtualMachineInstanceReason)
+			expectVMIShutdown(vmi)
+		})
+
+		It("should shut the VMI down after its own eviction migration timeout", func() {
+			vmi := newVirtualMachineEvictedSince(time.Minute)
+			vmi.Spec.EvictionMigrationTimeoutSeconds = pointer.P(int64(30))
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulShutdownVirtualMachineInstanceReason)
+			expectVMIShutdown(vmi)
+		})
+	})
+
 	AfterEach(func() {
 		// Ensure that we add checks for expected events to every test
 		Expect(recorder.Events).To(BeEmpty())
@@ -0,0 +1,606 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodemaintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/nodes"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// NodeMaintenanceLabel is set on the migrations created for a NodeMaintenance, its value is the name of the NodeMaintenance.
+const NodeMaintenanceLabel = "maintenance.kubevirt.io/node-maintenance"
+
+const (
+	// SuccessfulCreateVirtualMachineInstanceMigrationReason is added in an event if creating a VirtualMachineInstanceMigration succeeded.
+	SuccessfulCreateVirtualMachineInstanceMigrationReason = "SuccessfulCreate"
+	// FailedCreateVirtualMachineInstanceMigrationReason is added in an event if creating a VirtualMachineInstanceMigration failed.
+	FailedCreateVirtualMachineInstanceMigrationReason = "FailedCreate"
+	// SuccessfulShutdownVirtualMachineInstanceReason is added in an event if a VirtualMachineInstance was shut down.
+	SuccessfulShutdownVirtualMachineInstanceReason = "SuccessfulShutdown"
+	// FailedShutdownVirtualMachineInstanceReason is added in an event if shutting down a VirtualMachineInstance failed.
+	FailedShutdownVirtualMachineInstanceReason = "FailedShutdown"
+	// NodeCordonedReason is added in an event when the node under maintenance was cordoned.
+	NodeCordonedReason = "NodeCordoned"
+	// NodeUncordonedReason is added in an event when the node under maintenance was uncordoned.
+	NodeUncordonedReason = "NodeUncordoned"
+	// NodeNotFoundReason is added in an event when the node under maintenance does not exist.
+	NodeNotFoundReason = "NodeNotFound"
+)
+
+const notMigratableMessage = "the VirtualMachineInstance is not migratable"
+
+const (
+	// maxFailedMigrations is the number of failed migrations after which a VMI is not migrated anymore.
+	// It stays below the number of finished migrations the migration controller keeps per VMI.
+	maxFailedMigrations = 3
+	// migrationBackoff is the time to wait before migrating a VMI again after a failed migration,
+	// it doubles with each further failed migration.
+	migrationBackoff = 20 * time.Second
+)
+
+// Controller drains the VMIs of the nodes declared by NodeMaintenance objects. The node is
+// cordoned first, then each VMI on the node is live migrated, shut down or ignored according to
+// the policies of the NodeMaintenance. The node is uncordoned when the NodeMaintenance is deleted.
+type Controller struct {
+	clientset             kubecli.KubevirtClient
+	Queue                 workqueue.TypedRateLimitingInterface[string]
+	maintenanceStore      cache.Store
+	vmiIndexer            cache.Indexer
+	migrationIndexer      cache.Indexer
+	nodeStore             cache.Store
+	recorder              record.EventRecorder
+	migrationExpectations *controller.UIDTrackingControllerExpectations
+	clusterConfig         *virtconfig.ClusterConfig
+	hasSynced             func() bool
+}
+
+func NewController(
+	clientset kubecli.KubevirtClient,
+	maintenanceInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-node-maintenance"},
+		),
+		maintenanceStore:      maintenanceInformer.GetStore(),
+		vmiIndexer:            vmiInformer.GetIndexer(),
+		migrationIndexer:      migrationInformer.GetIndexer(),
+		nodeStore:             nodeInformer.GetStore(),
+		recorder:              recorder,
+		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		clusterConfig:         clusterConfig,
+	}
+
+	c.hasSynced = func() bool {
+		return maintenanceInformer.HasSynced() && vmiInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced()
+	}
+
+	_, err := maintenanceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNodeMaintenance,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueNodeMaintenance(curr) },
+		DeleteFunc: c.enqueueNodeMaintenance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMI,
+		UpdateFunc: c.updateVMI,
+		DeleteFunc: c.enqueueVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = migrationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.addMigration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNode,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueNode(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueNodeMaintenance(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from NodeMaintenance.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *Controller) enqueueVMI(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vmi %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+	c.enqueueNodeMaintenancesOf(vmi.Status.NodeName)
+}
+
+func (c *Controller) updateVMI(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	c.enqueueNodeMaintenancesOf(oldVMI.Status.NodeName)
+	c.enqueueVMI(curr)
+}
+
+func (c *Controller) addMigration(obj interface{}) {
+	migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+	// only observe the migration expectation if our controller created it
+	if key, ok := migration.Labels[NodeMaintenanceLabel]; ok {
+		c.migrationExpectations.CreationObserved(key)
+		c.Queue.Add(key)
+	}
+}
+
+func (c *Controller) enqueueNode(obj interface{}) {
+	c.enqueueNodeMaintenancesOf(obj.(*k8sv1.Node).Name)
+}
+
+func (c *Controller) enqueueNodeMaintenancesOf(nodeName string) {
+	if nodeName == "" {
+		return
+	}
+	for _, nm := range c.listNodeMaintenancesOf(nodeName) {
+		c.Queue.Add(nm.Name)
+	}
+}
+
+func (c *Controller) listNodeMaintenancesOf(nodeName string) []*maintenancev1alpha1.NodeMaintenance {
+	var maintenances []*maintenancev1alpha1.NodeMaintenance
+	for _, obj := range c.maintenanceStore.List() {
+		nm := obj.(*maintenancev1alpha1.NodeMaintenance)
+		if nm.Spec.NodeName == nodeName {
+			maintenances = append(maintenances, nm)
+		}
+	}
+	return maintenances
+}
+
+// Run runs the passed in Controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting node maintenance controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping node maintenance controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing NodeMaintenance %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed NodeMaintenance %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.maintenanceStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		c.migrationExpectations.DeleteExpectations(key)
+		return nil
+	}
+	nm := obj.(*maintenancev1alpha1.NodeMaintenance).DeepCopy()
+
+	// The node is released even when the feature gate was disabled in the meantime,
+	// otherwise the NodeMaintenance could not be deleted.
+	if nm.DeletionTimestamp != nil {
+		return c.finalize(nm)
+	}
+
+	if !c.clusterConfig.NodeMaintenanceEnabled() {
+		return nil
+	}
+
+	if !controller.HasFinalizer(nm, maintenancev1alpha1.NodeMaintenanceFinalizer) {
+		controller.AddFinalizer(nm, maintenancev1alpha1.NodeMaintenanceFinalizer)
+		_, err = c.clientset.GeneratedKubeVirtClient().MaintenanceV1alpha1().NodeMaintenances().Update(context.Background(), nm, metav1.UpdateOptions{})
+		return err
+	}
+
+	if !c.migrationExpectations.SatisfiedExpectations(key) {
+		return nil
+	}
+
+	return c.sync(nm)
+}
+
+func (c *Controller) sync(nm *maintenancev1alpha1.NodeMaintenance) error {
+	status := nm.Status.DeepCopy()
+
+	obj, exists, err := c.nodeStore.GetByKey(nm.Spec.NodeName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if status.Phase != maintenancev1alpha1.NodeMaintenanceFailed {
+			c.recorder.Eventf(nm, k8sv1.EventTypeWarning, NodeNotFoundReason, "Node %s does not exist", nm.Spec.NodeName)
+		}
+		status.Phase = maintenancev1alpha1.NodeMaintenanceFailed
+		status.LastError = fmt.Sprintf("node %s does not exist", nm.Spec.NodeName)
+		return c.updateStatus(nm, status)
+	}
+	node := obj.(*k8sv1.Node)
+
+	if !node.Spec.Unschedulable {
+		// Record that the node is cordoned by the maintenance before cordoning it,
+		// so that it is uncordoned when the maintenance is deleted.
+		if !status.NodeCordoned {
+			status.NodeCordoned = true
+			return c.updateStatus(nm, status)
+		}
+		if err := c.setUnschedulable(node, true); err != nil {
+			return err
+		}
+		c.recorder.Eventf(nm, k8sv1.EventTypeNormal, NodeCordonedReason, "Cordoned node %s", node.Name)
+	}
+
+	objs, err := c.vmiIndexer.ByIndex("node", node.Name)
+	if err != nil {
+		return err
+	}
+
+	unfinishedMigrations := map[string]bool{}
+	for _, migration := range migrationutils.ListUnfinishedMigrations(c.migrationIndexer) {
+		unfinishedMigrations[controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)] = true
+	}
+
+	var (
+		pending    []maintenancev1alpha1.NodeMaintenancePendingVMI
+		ignored    int32
+		toMigrate  []*virtv1.VirtualMachineInstance
+		toShutdown []*virtv1.VirtualMachineInstance
+		gaveUp     []string
+		retryIn    time.Duration
+	)
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsFinal() {
+			continue
+		}
+
+		action := c.actionFor(nm, vmi)
+		pendingVMI := maintenancev1alpha1.NodeMaintenancePendingVMI{
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+			Action:    action,
+		}
+		switch action {
+		case maintenancev1alpha1.NodeMaintenanceActionIgnore:
+			ignored++
+			continue
+		case maintenancev1alpha1.NodeMaintenanceActionShutdown:
+			if vmi.DeletionTimestamp == nil {
+				toShutdown = append(toShutdown, vmi)
+			}
+		case maintenancev1alpha1.NodeMaintenanceActionMigrate:
+			failed := c.listFailedMigrations(nm, vmi)
+			pendingVMI.FailedMigrations = int32(len(failed))
+			switch {
+			case !vmi.IsMigratable():
+				pendingVMI.Message = notMigratableMessage
+			case vmi.DeletionTimestamp != nil || unfinishedMigrations[controller.NamespacedKey(vmi.Namespace, vmi.Name)]:
+				// Wait for the VMI to go away or for its migration to finish
+			case len(failed) >= maxFailedMigrations:
+				pendingVMI.Message = fmt.Sprintf("not migrated anymore after %d failed migrations", len(failed))
+				gaveUp = append(gaveUp, controller.NamespacedKey(vmi.Namespace, vmi.Name))
+			case len(failed) > 0 && time.Now().Before(nextMigrationAttempt(failed)):
+				retryAt := nextMigrationAttempt(failed)
+				pendingVMI.Message = fmt.Sprintf("migration failed %d times, retrying at %s", len(failed), retryAt.UTC().Format(time.RFC3339))
+				if until := time.Until(retryAt); retryIn == 0 || until < retryIn {
+					retryIn = until
+				}
+			default:
+				toMigrate = append(toMigrate, vmi)
+			}
+		}
+		pending = append(pending, pendingVMI)
+	}
+
+	errs := append(c.migrate(nm, toMigrate), c.shutdown(nm, toShutdown)...)
+	if len(errs) > 0 {
+		status.LastError = errs[len(errs)-1].Error()
+	}
+
+	status.PendingVMIs = pending
+	status.IgnoredVMIs = ignored
+	status.TotalVMIs = max(status.TotalVMIs, int32(len(pending)))
+	status.DrainProgress = 100
+	if status.TotalVMIs > 0 {
+		status.DrainProgress = (status.TotalVMIs - int32(len(pending))) * 100 / status.TotalVMIs
+	}
+	status.Phase = maintenancev1alpha1.NodeMaintenanceRunning
+	if len(pending) == 0 {
+		status.Phase = maintenancev1alpha1.NodeMaintenanceSucceeded
+	}
+	if len(gaveUp) > 0 {
+		// The other VMIs are still drained, but the maintenance can't complete anymore
+		status.Phase = maintenancev1alpha1.NodeMaintenanceFailed
+		status.LastError = fmt.Sprintf("VirtualMachineInstances failed to migrate %d times: %s", maxFailedMigrations, strings.Join(gaveUp, ", "))
+	}
+
+	if err := c.updateStatus(nm, status); err != nil {
+		return err
+	}
+	if retryIn > 0 {
+		c.Queue.AddAfter(nm.Name, retryIn)
+	}
+	return errors.Join(errs...)
+}
+
+// listFailedMigrations returns the failed migrations the maintenance created for the VMI, newest first.
+// Migrations of a former maintenance with the same name are not taken into account.
+func (c *Controller) listFailedMigrations(nm *maintenancev1alpha1.NodeMaintenance, vmi *virtv1.VirtualMachineInstance) []*virtv1.VirtualMachineInstanceMigration {
+	objs, err := c.migrationIndexer.ByIndex(controller.ByVMINameIndex, controller.NamespacedKey(vmi.Namespace, vmi.Name))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to list the migrations of the VirtualMachineInstance")
+		return nil
+	}
+
+	var failed []*virtv1.VirtualMachineInstanceMigration
+	for _, obj := range objs {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if migration.Labels[NodeMaintenanceLabel] != nm.Name ||
+			migration.Status.Phase != virtv1.MigrationFailed ||
+			migration.CreationTimestamp.Before(&nm.CreationTimestamp) {
+			continue
+		}
+		failed = append(failed, migration)
+	}
+	sort.Slice(failed, func(i, j int) bool {
+		return migrationFailedAt(failed[j]).Before(migrationFailedAt(failed[i]))
+	})
+	return failed
+}
+
+// nextMigrationAttempt returns when the VMI may be migrated again after the passed failed migrations, newest first.
+func nextMigrationAttempt(failed []*virtv1.VirtualMachineInstanceMigration) time.Time {
+	backoff := migrationBackoff << (len(failed) - 1)
+	return migrationFailedAt(failed[0]).Add(backoff)
+}
+
+func migrationFailedAt(migration *virtv1.VirtualMachineInstanceMigration) time.Time {
+	for _, ts := range migration.Status.PhaseTransitionTimestamps {
+		if ts.Phase == virtv1.MigrationFailed {
+			return ts.PhaseTransitionTimestamp.Time
+		}
+	}
+	return migration.CreationTimestamp.Time
+}
+
+// actionFor returns the action taken on a VMI, as set by the policy matching its eviction strategy
+// or as derived from the eviction strategy when no policy matches.
+func (c *Controller) actionFor(nm *maintenancev1alpha1.NodeMaintenance, vmi *virtv1.VirtualMachineInstance) maintenancev1alpha1.NodeMaintenanceAction {
+	evictionStrategy := virtv1.EvictionStrategyNone
+	if strategy := migrationutils.VMIEvictionStrategy(c.clusterConfig, vmi); strategy != nil {
+		evictionStrategy = *strategy
+	}
+
+	for _, policy := range nm.Spec.Policies {
+		if policy.EvictionStrategy == evictionStrategy {
+			return policy.Action
+		}
+	}
+
+	switch evictionStrategy {
+	case virtv1.EvictionStrategyLiveMigrate:
+		return maintenancev1alpha1.NodeMaintenanceActionMigrate
+	case virtv1.EvictionStrategyLiveMigrateIfPossible, virtv1.EvictionStrategyLiveMigrateOrShutdown:
+		if vmi.IsMigratable() {
+			return maintenancev1alpha1.NodeMaintenanceActionMigrate
+		}
+		return maintenancev1alpha1.NodeMaintenanceActionShutdown
+	case virtv1.EvictionStrategyExternal:
+		return maintenancev1alpha1.NodeMaintenanceActionIgnore
+	default:
+		return maintenancev1alpha1.NodeMaintenanceActionShutdown
+	}
+}
+
+// migrate creates a migration for each of the VMIs. The migration controller enforces the
+// limits of parallel migrations, the migrations exceeding them stay pending.
+func (c *Controller) migrate(nm *maintenancev1alpha1.NodeMaintenance, vmis []*virtv1.VirtualMachineInstance) []error {
+	var errs []error
+	c.migrationExpectations.ExpectCreations(nm.Name, len(vmis))
+	for _, vmi := range vmis {
+		migration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), c.newMigration(nm, vmi), metav1.CreateOptions{})
+		if err != nil {
+			c.migrationExpectations.CreationObserved(nm.Name)
+			c.recorder.Eventf(nm, k8sv1.EventTypeWarning, FailedCreateVirtualMachineInstanceMigrationReason, "Error creating a migration for VirtualMachineInstance %s/%s: %v", vmi.Namespace, vmi.Name, err)
+			errs = append(errs, fmt.Errorf("failed to migrate VirtualMachineInstance %s/%s: %v", vmi.Namespace, vmi.Name, err))
+			continue
+		}
+		c.recorder.Eventf(nm, k8sv1.EventTypeNormal, SuccessfulCreateVirtualMachineInstanceMigrationReason, "Created migration %s for VirtualMachineInstance %s/%s", migration.Name, vmi.Namespace, vmi.Name)
+	}
+	return errs
+}
+
+func (c *Controller) newMigration(nm *maintenancev1alpha1.NodeMaintenance, vmi *virtv1.VirtualMachineInstance) *virtv1.VirtualMachineInstanceMigration {
+	migration := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "kubevirt-maintenance-",
+			Labels: map[string]string{
+				NodeMaintenanceLabel: nm.Name,
+			},
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}
+	if c.clusterConfig.MigrationPriorityQueueEnabled() {
+		migration.Spec.Priority = pointer.P(virtv1.PrioritySystemMaintenance)
+	}
+	return migration
+}
+
+// shutdown deletes the VMIs. Their VMs start them again on another node depending on their run strategy.
+func (c *Controller) shutdown(nm *maintenancev1alpha1.NodeMaintenance, vmis []*virtv1.VirtualMachineInstance) []error {
+	var errs []error
+	for _, vmi := range vmis {
+		err := c.clientset.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			c.recorder.Eventf(nm, k8sv1.EventTypeWarning, FailedShutdownVirtualMachineInstanceReason, "Error shutting down VirtualMachineInstance %s/%s: %v", vmi.Namespace, vmi.Name, err)
+			errs = append(errs, fmt.Errorf("failed to shut down VirtualMachineInstance %s/%s: %v", vmi.Namespace, vmi.Name, err))
+			continue
+		}
+		c.recorder.Eventf(nm, k8sv1.EventTypeNormal, SuccessfulShutdownVirtualMachineInstanceReason, "Shut down VirtualMachineInstance %s/%s", vmi.Namespace, vmi.Name)
+	}
+	return errs
+}
+
+// finalize uncordons the node when it was cordoned by the maintenance and removes the finalizer.
+// When other maintenances of the node remain, the node stays cordoned and the last one uncordons it.
+func (c *Controller) finalize(nm *maintenancev1alpha1.NodeMaintenance) error {
+	if !controller.HasFinalizer(nm, maintenancev1alpha1.NodeMaintenanceFinalizer) {
+		return nil
+	}
+
+	if nm.Status.NodeCordoned {
+		if err := c.releaseNode(nm); err != nil {
+			return err
+		}
+	}
+
+	controller.RemoveFinalizer(nm, maintenancev1alpha1.NodeMaintenanceFinalizer)
+	_, err := c.clientset.GeneratedKubeVirtClient().MaintenanceV1alpha1().NodeMaintenances().Update(context.Background(), nm, metav1.UpdateOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *Controller) releaseNode(nm *maintenancev1alpha1.NodeMaintenance) error {
+	for _, other := range c.listNodeMaintenancesOf(nm.Spec.NodeName) {
+		if other.Name == nm.Name || other.DeletionTimestamp != nil {
+			continue
+		}
+		// Hand over the uncordoning to the remaining maintenance
+		if other.Status.NodeCordoned {
+			return nil
+		}
+		other = other.DeepCopy()
+		status := other.Status.DeepCopy()
+		status.NodeCordoned = true
+		return c.updateStatus(other, status)
+	}
+
+	obj, exists, err := c.nodeStore.GetByKey(nm.Spec.NodeName)
+	if err != nil || !exists {
+		return err
+	}
+	node := obj.(*k8sv1.Node)
+	if !node.Spec.Unschedulable {
+		return nil
+	}
+	if err := c.setUnschedulable(node, false); err != nil {
+		return err
+	}
+	c.recorder.Eventf(nm, k8sv1.EventTypeNormal, NodeUncordonedReason, "Uncordoned node %s", node.Name)
+	return nil
+}
+
+func (c *Controller) setUnschedulable(node *k8sv1.Node, unschedulable bool) error {
+	modified := node.DeepCopy()
+	modified.Spec.Unschedulable = unschedulable
+	return nodes.PatchNode(c.clientset, node, modified)
+}
+
+func (c *Controller) updateStatus(nm *maintenancev1alpha1.NodeMaintenance, status *maintenancev1alpha1.NodeMaintenanceStatus) error {
+	if equality.Semantic.DeepEqual(&nm.Status, status) {
+		return nil
+	}
+	nm.Status = *status
+	_, err := c.clientset.GeneratedKubeVirtClient().MaintenanceV1alpha1().NodeMaintenances().UpdateStatus(context.Background(), nm, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,485 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodemaintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const nodeName = "node01"
+
+var _ = Describe("NodeMaintenance controller", func() {
+	var (
+		kubeClient     *fake.Clientset
+		fakeVirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		controller     *Controller
+	)
+
+	newNodeMaintenance := func(policies ...maintenancev1alpha1.NodeMaintenancePolicy) *maintenancev1alpha1.NodeMaintenance {
+		return &maintenancev1alpha1.NodeMaintenance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "maintenance",
+				Finalizers: []string{maintenancev1alpha1.NodeMaintenanceFinalizer},
+			},
+			Spec: maintenancev1alpha1.NodeMaintenanceSpec{
+				NodeName: nodeName,
+				Policies: policies,
+			},
+		}
+	}
+
+	newVMI := func(name string, evictionStrategy v1.EvictionStrategy, migratable bool) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithNamespace(k8sv1.NamespaceDefault),
+			libvmi.WithEvictionStrategy(evictionStrategy),
+		)
+		vmi.Name = name
+		vmi.Status.NodeName = nodeName
+		vmi.Status.Phase = v1.Running
+		if migratable {
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceIsMigratable,
+				Status: k8sv1.ConditionTrue,
+			})
+		}
+		return vmi
+	}
+
+	addNode := func(unschedulable bool) {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: nodeName},
+			Spec:       k8sv1.NodeSpec{Unschedulable: unschedulable},
+		}
+		Expect(controller.nodeStore.Add(node)).To(Succeed())
+		_, err := kubeClient.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addNodeMaintenance := func(nm *maintenancev1alpha1.NodeMaintenance) {
+		Expect(controller.maintenanceStore.Add(nm)).To(Succeed())
+		_, err := fakeVirtClient.MaintenanceV1alpha1().NodeMaintenances().Create(context.Background(), nm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance) {
+		Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getNodeMaintenance := func(name string) *maintenancev1alpha1.NodeMaintenance {
+		nm, err := fakeVirtClient.MaintenanceV1alpha1().NodeMaintenances().Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return nm
+	}
+
+	isNodeUnschedulable := func() bool {
+		node, err := kubeClient.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node.Spec.Unschedulable
+	}
+
+	listMigrations := func() []v1.VirtualMachineInstanceMigration {
+		migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return migrations.Items
+	}
+
+	vmiExists := func(vmi *v1.VirtualMachineInstance) bool {
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+		if err != nil {
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			return false
+		}
+		return true
+	}
+
+	newController := func(featureGates ...string) {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(fakeVirtClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(
+			fakeVirtClient.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(
+			fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+
+		maintenanceInformer, _ := testutils.NewFakeInformerFor(&maintenancev1alpha1.NodeMaintenance{})
+		vmiInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, virtcontroller.GetVMIInformerIndexers())
+		migrationInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstanceMigration{}, virtcontroller.GetVirtualMachineInstanceMigrationInformerIndexers())
+		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		recorder = record.NewFakeRecorder(100)
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+
+		var err error
+		controller, err = NewController(virtClient, maintenanceInformer, vmiInformer, migrationInformer, nodeInformer, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		newController(featuregate.NodeMaintenanceGate)
+	})
+
+	It("should do nothing when the feature gate is disabled", func() {
+		newController()
+		addNode(false)
+		nm := newNodeMaintenance()
+		addNodeMaintenance(nm)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+		Expect(isNodeUnschedulable()).To(BeFalse())
+		Expect(getNodeMaintenance(nm.Name).Status).To(Equal(maintenancev1alpha1.NodeMaintenanceStatus{}))
+	})
+
+	It("should add the finalizer", func() {
+		addNode(false)
+		nm := newNodeMaintenance()
+		nm.Finalizers = nil
+		addNodeMaintenance(nm)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+		Expect(getNodeMaintenance(nm.Name).Finalizers).To(ConsistOf(maintenancev1alpha1.NodeMaintenanceFinalizer))
+	})
+
+	It("should fail when the node does not exist", func() {
+		nm := newNodeMaintenance()
+		addNodeMaintenance(nm)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+		status := getNodeMaintenance(nm.Name).Status
+		Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceFailed))
+		Expect(status.LastError).To(Equal("node node01 does not exist"))
+		testutils.ExpectEvent(recorder, NodeNotFoundReason)
+	})
+
+	It("should record that it cordons the node before cordoning it", func() {
+		addNode(false)
+		nm := newNodeMaintenance()
+		addNodeMaintenance(nm)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+		nm = getNodeMaintenance(nm.Name)
+		Expect(nm.Status.NodeCordoned).To(BeTrue())
+		Expect(isNodeUnschedulable()).To(BeFalse())
+
+		Expect(controller.maintenanceStore.Update(nm)).To(Succeed())
+		Expect(controller.execute(nm.Name)).To(Succeed())
+		Expect(isNodeUnschedulable()).To(BeTrue())
+		testutils.ExpectEvent(recorder, NodeCordonedReason)
+	})
+
+	It("should not claim a node which is already cordoned", func() {
+		addNode(true)
+		nm := newNodeMaintenance()
+		addNodeMaintenance(nm)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+		status := getNodeMaintenance(nm.Name).Status
+		Expect(status.NodeCordoned).To(BeFalse())
+		Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceSucceeded))
+		Expect(status.DrainProgress).To(BeEquivalentTo(100))
+	})
+
+	It("should drain the VMIs according to their eviction strategy", func() {
+		addNode(true)
+		nm := newNodeMaintenance()
+		addNodeMaintenance(nm)
+		migrated := newVMI("migrated", v1.EvictionStrategyLiveMigrate, true)
+		shutDown := newVMI("shutdown", v1.EvictionStrategyNone, true)
+		notMigratable := newVMI("not-migratable", v1.EvictionStrategyLiveMigrateIfPossible, false)
+		ignored := newVMI("ignored", v1.EvictionStrategyExternal, true)
+		for _, vmi := range []*v1.VirtualMachineInstance{migrated, shutDown, notMigratable, ignored} {
+			addVMI(vmi)
+		}
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+
+		migrations := listMigrations()
+		Expect(migrations).To(HaveLen(1))
+		Expect(migrations[0].Spec.VMIName).To(Equal(migrated.Name))
+		Expect(migrations[0].Labels).To(HaveKeyWithValue(NodeMaintenanceLabel, nm.Name))
+		Expect(vmiExists(shutDown)).To(BeFalse())
+		Expect(vmiExists(notMigratable)).To(BeFalse())
+		Expect(vmiExists(ignored)).To(BeTrue())
+
+		status := getNodeMaintenance(nm.Name).Status
+		Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceRunning))
+		Expect(status.TotalVMIs).To(BeEquivalentTo(3))
+		Expect(status.IgnoredVMIs).To(BeEquivalentTo(1))
+		Expect(status.DrainProgress).To(BeEquivalentTo(0))
+		Expect(status.PendingVMIs).To(ConsistOf(
+			maintenancev1alpha1.NodeMaintenancePendingVMI{Namespace: k8sv1.NamespaceDefault, Name: migrated.Name, Action: maintenancev1alpha1.NodeMaintenanceActionMigrate},
+			maintenancev1alpha1.NodeMaintenancePendingVMI{Namespace: k8sv1.NamespaceDefault, Name: shutDown.Name, Action: maintenancev1alpha1.NodeMaintenanceActionShutdown},
+			maintenancev1alpha1.NodeMaintenancePendingVMI{Namespace: k8sv1.NamespaceDefault, Name: notMigratable.Name, Action: maintenancev1alpha1.NodeMaintenanceActionShutdown},
+		))
+		testutils.ExpectEvents(recorder,
+			SuccessfulCreateVirtualMachineInstanceMigrationReason,
+			SuccessfulShutdownVirtualMachineInstanceReason,
+			SuccessfulShutdownVirtualMachineInstanceReason,
+		)
+	})
+
+	It("should apply the policy matching the eviction strategy of the VMIs", func() {
+		addNode(true)
+		nm := newNodeMaintenance(
+			maintenancev1alpha1.NodeMaintenancePolicy{EvictionStrategy: v1.EvictionStrategyLiveMigrate, Action: maintenancev1alpha1.NodeMaintenanceActionIgnore},
+			maintenancev1alpha1.NodeMaintenancePolicy{EvictionStrategy: v1.EvictionStrategyNone, Action: maintenancev1alpha1.NodeMaintenanceActionMigrate},
+		)
+		addNodeMaintenance(nm)
+		ignored := newVMI("ignored", v1.EvictionStrategyLiveMigrate, true)
+		migrated := newVMI("migrated", v1.EvictionStrategyNone, true)
+		addVMI(ignored)
+		addVMI(migrated)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+
+		migrations := listMigrations()
+		Expect(migrations).To(HaveLen(1))
+		Expect(migrations[0].Spec.VMIName).To(Equal(migrated.Name))
+		Expect(getNodeMaintenance(nm.Name).Status.IgnoredVMIs).To(BeEquivalentTo(1))
+	})
+
+	It("should report the VMIs to migrate which are not migratable", func() {
+		addNode(true)
+		nm := newNodeMaintenance()
+		addNodeMaintenance(nm)
+		vmi := newVMI("testvmi", v1.EvictionStrategyLiveMigrate, false)
+		addVMI(vmi)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+
+		Expect(listMigrations()).To(BeEmpty())
+		Expect(getNodeMaintenance(nm.Name).Status.PendingVMIs).To(ConsistOf(maintenancev1alpha1.NodeMaintenancePendingVMI{
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+			Action:    maintenancev1alpha1.NodeMaintenanceActionMigrate,
+			Message:   notMigratableMessage,
+		}))
+	})
+
+	It("should not migrate a VMI which is already migrating", func() {
+		addNode(true)
+		nm := newNodeMaintenance()
+		addNodeMaintenance(nm)
+		vmi := newVMI("testvmi", v1.EvictionStrategyLiveMigrate, true)
+		addVMI(vmi)
+		Expect(controller.migrationIndexer.Add(&v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: vmi.Namespace},
+			Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vmi.Name},
+			Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationRunning},
+		})).To(Succeed())
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+		Expect(listMigrations()).To(BeEmpty())
+	})
+
+	Context("when migrations failed", func() {
+		var (
+			nm  *maintenancev1alpha1.NodeMaintenance
+			vmi *v1.VirtualMachineInstance
+		)
+
+		addFailedMigration := func(name, maintenance string, failedAt time.Time) {
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: vmi.Namespace},
+				Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vmi.Name},
+				Status: v1.VirtualMachineInstanceMigrationStatus{
+					Phase: v1.MigrationFailed,
+					PhaseTransitionTimestamps: []v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp{
+						{Phase: v1.MigrationFailed, PhaseTransitionTimestamp: metav1.NewTime(failedAt)},
+					},
+				},
+			}
+			if maintenance != "" {
+				migration.Labels = map[string]string{NodeMaintenanceLabel: maintenance}
+			}
+			Expect(controller.migrationIndexer.Add(migration)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			addNode(true)
+			nm = newNodeMaintenance()
+			addNodeMaintenance(nm)
+			vmi = newVMI("testvmi", v1.EvictionStrategyLiveMigrate, true)
+			addVMI(vmi)
+		})
+
+		It("should back off before migrating the VMI again", func() {
+			addFailedMigration("failed", nm.Name, time.Now())
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+
+			Expect(listMigrations()).To(BeEmpty())
+			status := getNodeMaintenance(nm.Name).Status
+			Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceRunning))
+			Expect(status.PendingVMIs).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":             Equal(vmi.Name),
+				"FailedMigrations": BeEquivalentTo(1),
+				"Message":          ContainSubstring("migration failed 1 times, retrying at"),
+			})))
+		})
+
+		It("should migrate the VMI again once the backoff expired", func() {
+			addFailedMigration("failed", nm.Name, time.Now().Add(-migrationBackoff))
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+
+			Expect(listMigrations()).To(HaveLen(1))
+			Expect(getNodeMaintenance(nm.Name).Status.PendingVMIs).To(ConsistOf(maintenancev1alpha1.NodeMaintenancePendingVMI{
+				Namespace:        vmi.Namespace,
+				Name:             vmi.Name,
+				Action:           maintenancev1alpha1.NodeMaintenanceActionMigrate,
+				FailedMigrations: 1,
+			}))
+		})
+
+		It("should double the backoff with each failed migration", func() {
+			addFailedMigration("failed-1", nm.Name, time.Now().Add(-time.Hour))
+			addFailedMigration("failed-2", nm.Name, time.Now().Add(-migrationBackoff))
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+			Expect(listMigrations()).To(BeEmpty())
+		})
+
+		It("should only count the failed migrations of the maintenance", func() {
+			addFailedMigration("other-maintenance", "other", time.Now())
+			addFailedMigration("user", "", time.Now())
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+			Expect(listMigrations()).To(HaveLen(1))
+		})
+
+		It("should fail after too many failed migrations", func() {
+			for i := range maxFailedMigrations {
+				addFailedMigration(fmt.Sprintf("failed-%d", i), nm.Name, time.Now().Add(-time.Hour))
+			}
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+
+			Expect(listMigrations()).To(BeEmpty())
+			status := getNodeMaintenance(nm.Name).Status
+			Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceFailed))
+			Expect(status.LastError).To(ContainSubstring("default/testvmi"))
+			Expect(status.PendingVMIs).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":             Equal(vmi.Name),
+				"FailedMigrations": BeEquivalentTo(maxFailedMigrations),
+				"Message":          Equal("not migrated anymore after 3 failed migrations"),
+			})))
+		})
+	})
+
+	It("should succeed once the VMIs are drained", func() {
+		addNode(true)
+		nm := newNodeMaintenance()
+		nm.Status = maintenancev1alpha1.NodeMaintenanceStatus{
+			Phase:     maintenancev1alpha1.NodeMaintenanceRunning,
+			TotalVMIs: 2,
+			PendingVMIs: []maintenancev1alpha1.NodeMaintenancePendingVMI{
+				{Namespace: k8sv1.NamespaceDefault, Name: "testvmi", Action: maintenancev1alpha1.NodeMaintenanceActionMigrate},
+			},
+		}
+		addNodeMaintenance(nm)
+
+		Expect(controller.execute(nm.Name)).To(Succeed())
+
+		status := getNodeMaintenance(nm.Name).Status
+		Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceSucceeded))
+		Expect(status.TotalVMIs).To(BeEquivalentTo(2))
+		Expect(status.DrainProgress).To(BeEquivalentTo(100))
+		Expect(status.PendingVMIs).To(BeEmpty())
+	})
+
+	Context("when the NodeMaintenance is deleted", func() {
+		newDeletedNodeMaintenance := func(name string, nodeCordoned bool) *maintenancev1alpha1.NodeMaintenance {
+			nm := newNodeMaintenance()
+			nm.Name = name
+			nm.DeletionTimestamp = pointer.P(metav1.Now())
+			nm.Status.NodeCordoned = nodeCordoned
+			return nm
+		}
+
+		It("should uncordon the node it cordoned and remove the finalizer", func() {
+			addNode(true)
+			nm := newDeletedNodeMaintenance("maintenance", true)
+			addNodeMaintenance(nm)
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+			Expect(isNodeUnschedulable()).To(BeFalse())
+			Expect(getNodeMaintenance(nm.Name).Finalizers).To(BeEmpty())
+			testutils.ExpectEvent(recorder, NodeUncordonedReason)
+		})
+
+		It("should keep a node cordoned it did not cordon", func() {
+			addNode(true)
+			nm := newDeletedNodeMaintenance("maintenance", false)
+			addNodeMaintenance(nm)
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+			Expect(isNodeUnschedulable()).To(BeTrue())
+			Expect(getNodeMaintenance(nm.Name).Finalizers).To(BeEmpty())
+		})
+
+		It("should hand over the uncordoning to another maintenance of the node", func() {
+			addNode(true)
+			nm := newDeletedNodeMaintenance("maintenance", true)
+			addNodeMaintenance(nm)
+			other := newNodeMaintenance()
+			other.Name = "other"
+			addNodeMaintenance(other)
+
+			Expect(controller.execute(nm.Name)).To(Succeed())
+			Expect(isNodeUnschedulable()).To(BeTrue())
+			Expect(getNodeMaintenance(other.Name).Status.NodeCordoned).To(BeTrue())
+			Expect(getNodeMaintenance(nm.Name).Finalizers).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launcherreservation
+
+import (
+	"context"
+	"slices"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// claimedNodeAffinityWeight makes the node of the claimed pod win over the other preferences of the VMI
+const claimedNodeAffinityWeight = 100
+
+// Claimer lets the virt-launcher pods of starting VMIs claim placeholder pods.
+// Claiming deletes the placeholder pod, releasing its resources on a node which already pulled the
+// virt-launcher image, and makes the virt-launcher pod prefer that node.
+type Claimer struct {
+	clientset     kubecli.KubevirtClient
+	podIndexer    cache.Indexer
+	nodeStore     cache.Store
+	clusterConfig *virtconfig.ClusterConfig
+	namespace     string
+}
+
+func NewClaimer(clientset kubecli.KubevirtClient, podInformer, nodeInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig, namespace string) *Claimer {
+	return &Claimer{
+		clientset:     clientset,
+		podIndexer:    podInformer.GetIndexer(),
+		nodeStore:     nodeInformer.GetStore(),
+		clusterConfig: clusterConfig,
+		namespace:     namespace,
+	}
+}
+
+// Claim claims a placeholder pod for the virt-launcher pod of the VMI and returns it, or nil if no
+// placeholder pod is available.
+func (c *Claimer) Claim(vmi *v1.VirtualMachineInstance, launcherPod *k8sv1.Pod) (*k8sv1.Pod, error) {
+	reservations := c.clusterConfig.GetLauncherReservations()
+	if len(reservations) == 0 {
+		return nil, nil
+	}
+
+	candidates := listReservationPods(c.podIndexer, c.namespace, "")
+	// Claim the oldest pods first, they are the most likely to be ready
+	sortOldestFirst(candidates)
+
+	for _, pod := range candidates {
+		reservation := lookupReservation(reservations, pod.Labels[v1.LauncherReservationLabel])
+		if reservation == nil || !reservationAcceptsVMI(reservation, vmi) || !isClaimable(pod) || !c.nodeFits(pod.Labels[v1.NodeNameLabel], launcherPod) {
+			continue
+		}
+		err := c.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &pod.UID},
+		})
+		if k8serrors.IsNotFound(err) || k8serrors.IsConflict(err) {
+			// Claimed by another VMI in the meantime
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		return pod, nil
+	}
+	return nil, nil
+}
+
+// ApplyClaim makes the virt-launcher pod prefer the node of the claimed placeholder pod
+func ApplyClaim(launcherPod, reservationPod *k8sv1.Pod) {
+	if launcherPod.Annotations == nil {
+		launcherPod.Annotations = map[string]string{}
+	}
+	launcherPod.Annotations[v1.ClaimedLauncherReservationAnnotation] = controller.NamespacedKey(reservationPod.Namespace, reservationPod.Name)
+
+	if launcherPod.Spec.Affinity == nil {
+		launcherPod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if launcherPod.Spec.Affinity.NodeAffinity == nil {
+		launcherPod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	nodeAffinity := launcherPod.Spec.Affinity.NodeAffinity
+	nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+		k8sv1.PreferredSchedulingTerm{
+			Weight:     claimedNodeAffinityWeight,
+			Preference: nodeNameSelectorTerm(reservationPod.Labels[v1.NodeNameLabel]),
+		},
+	)
+}
+
+func reservationAcceptsVMI(reservation *v1.LauncherReservation, vmi *v1.VirtualMachineInstance) bool {
+	if len(reservation.Instancetypes) == 0 {
+		return true
+	}
+	instancetype, exists := vmi.Annotations[v1.ClusterInstancetypeAnnotation]
+	return exists && slices.Contains(reservation.Instancetypes, instancetype)
+}
+
+func isClaimable(pod *k8sv1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != k8sv1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == k8sv1.PodReady {
+			return condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// nodeFits checks the node of a placeholder pod against the node selector of the virt-launcher pod,
+// the scheduler remains in charge of the remaining constraints
+func (c *Claimer) nodeFits(nodeName string, launcherPod *k8sv1.Pod) bool {
+	obj, exists, err := c.nodeStore.GetByKey(nodeName)
+	if err != nil || !exists {
+		return false
+	}
+	node := obj.(*k8sv1.Node)
+	return labels.SelectorFromSet(launcherPod.Spec.NodeSelector).Matches(labels.Set(node.Labels))
+}
@@ -0,0 +1,393 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launcherreservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// AppLabelValue is the value of the kubevirt.io label of the placeholder pods
+	AppLabelValue = "virt-launcher-reservation"
+
+	// SuccessfulCreateReservationPodReason is added in an event when a placeholder pod was created.
+	SuccessfulCreateReservationPodReason = "SuccessfulCreateReservationPod"
+	// FailedCreateReservationPodReason is added in an event when a placeholder pod could not be created.
+	FailedCreateReservationPodReason = "FailedCreateReservationPod"
+
+	reservationPodContainerName = "reservation"
+)
+
+// Controller keeps the placeholder pods of the launcher reservations on the selected nodes. The
+// placeholder pods run the virt-launcher image without doing anything, so that the image is pulled
+// and their resources are held for the VMIs. Placeholder pods are claimed by starting VMIs, see
+// Claimer, and replaced by the controller. Pods of reservations removed from the configuration and
+// pods on nodes no longer selected are deleted.
+type Controller struct {
+	clientset       kubecli.KubevirtClient
+	Queue           workqueue.TypedRateLimitingInterface[string]
+	podIndexer      cache.Indexer
+	nodeStore       cache.Store
+	clusterConfig   *virtconfig.ClusterConfig
+	recorder        record.EventRecorder
+	expectations    *controller.ControllerExpectations
+	namespace       string
+	launcherImage   string
+	imagePullSecret string
+	hasSynced       func() bool
+}
+
+func NewController(
+	clientset kubecli.KubevirtClient,
+	podInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	clusterConfig *virtconfig.ClusterConfig,
+	recorder record.EventRecorder,
+	namespace string,
+	launcherImage string,
+	imagePullSecret string,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-launcher-reservation"},
+		),
+		podIndexer:      podInformer.GetIndexer(),
+		nodeStore:       nodeInformer.GetStore(),
+		clusterConfig:   clusterConfig,
+		recorder:        recorder,
+		expectations:    controller.NewControllerExpectations(),
+		namespace:       namespace,
+		launcherImage:   launcherImage,
+		imagePullSecret: imagePullSecret,
+	}
+
+	c.hasSynced = func() bool {
+		return podInformer.HasSynced() && nodeInformer.HasSynced()
+	}
+
+	_, err := podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addPod,
+		UpdateFunc: func(_, curr interface{}) { c.enqueuePod(curr) },
+		DeleteFunc: c.enqueuePod,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { c.enqueueAllReservations() },
+		UpdateFunc: c.updateNode,
+		DeleteFunc: func(interface{}) { c.enqueueAllReservations() },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusterConfig.SetConfigModifiedCallback(c.enqueueAllReservations)
+
+	return c, nil
+}
+
+func isReservationPod(pod *k8sv1.Pod) bool {
+	return pod.Labels[v1.AppLabel] == AppLabelValue && pod.Labels[v1.LauncherReservationLabel] != ""
+}
+
+func (c *Controller) addPod(obj interface{}) {
+	pod := obj.(*k8sv1.Pod)
+	if !isReservationPod(pod) {
+		return
+	}
+	c.expectations.CreationObserved(pod.Labels[v1.LauncherReservationLabel])
+	c.Queue.Add(pod.Labels[v1.LauncherReservationLabel])
+}
+
+func (c *Controller) enqueuePod(obj interface{}) {
+	pod, ok := obj.(*k8sv1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		pod, ok = tombstone.Obj.(*k8sv1.Pod)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a pod %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+	if isReservationPod(pod) {
+		c.Queue.Add(pod.Labels[v1.LauncherReservationLabel])
+	}
+}
+
+func (c *Controller) updateNode(old, curr interface{}) {
+	oldNode := old.(*k8sv1.Node)
+	currNode := curr.(*k8sv1.Node)
+	if oldNode.Spec.Unschedulable != currNode.Spec.Unschedulable || !labels.Equals(oldNode.Labels, currNode.Labels) {
+		c.enqueueAllReservations()
+	}
+}
+
+// enqueueAllReservations enqueues the configured reservations and the reservations which still have pods
+func (c *Controller) enqueueAllReservations() {
+	for _, reservation := range c.clusterConfig.GetLauncherReservations() {
+		c.Queue.Add(reservation.Name)
+	}
+	for _, pod := range c.listReservationPods("") {
+		c.Queue.Add(pod.Labels[v1.LauncherReservationLabel])
+	}
+}
+
+// Run runs the passed in Controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting launcher reservation controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+	c.enqueueAllReservations()
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping launcher reservation controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing launcher reservation %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed launcher reservation %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(reservationName string) error {
+	if !c.expectations.SatisfiedExpectations(reservationName) {
+		return nil
+	}
+
+	var staleReservationPods []*k8sv1.Pod
+	reservationPodsByNode := map[string][]*k8sv1.Pod{}
+	for _, pod := range c.listReservationPods(reservationName) {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Status.Phase == k8sv1.PodFailed || pod.Status.Phase == k8sv1.PodSucceeded {
+			staleReservationPods = append(staleReservationPods, pod)
+			continue
+		}
+		nodeName := pod.Labels[v1.NodeNameLabel]
+		reservationPodsByNode[nodeName] = append(reservationPodsByNode[nodeName], pod)
+	}
+
+	reservation := lookupReservation(c.clusterConfig.GetLauncherReservations(), reservationName)
+	var missingReservationPods []*k8sv1.Node
+	if reservation != nil {
+		for _, node := range c.selectedNodes(reservation) {
+			reservationPods := reservationPodsByNode[node.Name]
+			delete(reservationPodsByNode, node.Name)
+			// Keep the oldest pods, they are the most likely to be ready
+			sortOldestFirst(reservationPods)
+			for i := len(reservationPods); i < int(reservation.PodsPerNode); i++ {
+				missingReservationPods = append(missingReservationPods, node)
+			}
+			if len(reservationPods) > int(reservation.PodsPerNode) {
+				staleReservationPods = append(staleReservationPods, reservationPods[reservation.PodsPerNode:]...)
+			}
+		}
+	}
+	// Pods of removed reservations and of nodes which are not selected anymore
+	for _, reservationPods := range reservationPodsByNode {
+		staleReservationPods = append(staleReservationPods, reservationPods...)
+	}
+
+	var errs []error
+	for _, pod := range staleReservationPods {
+		err := c.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &pod.UID},
+		})
+		if err != nil && !k8serrors.IsNotFound(err) && !k8serrors.IsConflict(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	c.expectations.ExpectCreations(reservationName, len(missingReservationPods))
+	for _, node := range missingReservationPods {
+		pod, err := c.clientset.CoreV1().Pods(c.namespace).Create(context.Background(), c.newReservationPod(reservation, node.Name), metav1.CreateOptions{})
+		if err != nil {
+			c.expectations.CreationObserved(reservationName)
+			c.recorder.Eventf(node, k8sv1.EventTypeWarning, FailedCreateReservationPodReason, "Error creating placeholder pod of launcher reservation %s: %v", reservationName, err)
+			errs = append(errs, err)
+			continue
+		}
+		c.recorder.Eventf(node, k8sv1.EventTypeNormal, SuccessfulCreateReservationPodReason, "Created placeholder pod %s of launcher reservation %s", pod.Name, reservationName)
+	}
+	return errors.Join(errs...)
+}
+
+// listReservationPods lists the placeholder pods of a reservation, or of all reservations if reservationName is empty
+func (c *Controller) listReservationPods(reservationName string) []*k8sv1.Pod {
+	return listReservationPods(c.podIndexer, c.namespace, reservationName)
+}
+
+func listReservationPods(podIndexer cache.Indexer, namespace, reservationName string) []*k8sv1.Pod {
+	objs, err := podIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to list the placeholder pods.")
+		return nil
+	}
+	var pods []*k8sv1.Pod
+	for _, obj := range objs {
+		pod := obj.(*k8sv1.Pod)
+		if isReservationPod(pod) && (reservationName == "" || pod.Labels[v1.LauncherReservationLabel] == reservationName) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+func sortOldestFirst(pods []*k8sv1.Pod) {
+	slices.SortFunc(pods, func(a, b *k8sv1.Pod) int {
+		if cmp := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+func lookupReservation(reservations []v1.LauncherReservation, name string) *v1.LauncherReservation {
+	for i := range reservations {
+		if reservations[i].Name == name {
+			return &reservations[i]
+		}
+	}
+	return nil
+}
+
+// selectedNodes returns the schedulable KubeVirt nodes matching the node selector of the reservation
+func (c *Controller) selectedNodes(reservation *v1.LauncherReservation) []*k8sv1.Node {
+	selector := labels.SelectorFromSet(reservation.NodeSelector)
+	var nodes []*k8sv1.Node
+	for _, obj := range c.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		if node.Spec.Unschedulable || node.Labels[v1.NodeSchedulable] != "true" || !selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func (c *Controller) newReservationPod(reservation *v1.LauncherReservation, nodeName string) *k8sv1.Pod {
+	pod := &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("virt-launcher-reservation-%s-", reservation.Name),
+			Namespace:    c.namespace,
+			Labels: map[string]string{
+				v1.AppLabel:                 AppLabelValue,
+				v1.LauncherReservationLabel: reservation.Name,
+				v1.NodeNameLabel:            nodeName,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			Affinity: &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{nodeNameSelectorTerm(nodeName)},
+					},
+				},
+			},
+			AutomountServiceAccountToken:  pointer.P(false),
+			TerminationGracePeriodSeconds: pointer.P(int64(0)),
+			SecurityContext: &k8sv1.PodSecurityContext{
+				RunAsNonRoot:   pointer.P(true),
+				RunAsUser:      pointer.P(int64(util.NonRootUID)),
+				SeccompProfile: &k8sv1.SeccompProfile{Type: k8sv1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []k8sv1.Container{{
+				Name:    reservationPodContainerName,
+				Image:   c.launcherImage,
+				Command: []string{"/bin/sh", "-c", "sleep infinity"},
+				Resources: k8sv1.ResourceRequirements{
+					Requests: reservation.Resources.DeepCopy(),
+				},
+				SecurityContext: &k8sv1.SecurityContext{
+					AllowPrivilegeEscalation: pointer.P(false),
+					Capabilities:             &k8sv1.Capabilities{Drop: []k8sv1.Capability{"ALL"}},
+				},
+			}},
+		},
+	}
+	if c.imagePullSecret != "" {
+		pod.Spec.ImagePullSecrets = []k8sv1.LocalObjectReference{{Name: c.imagePullSecret}}
+	}
+	return pod
+}
+
+func nodeNameSelectorTerm(nodeName string) k8sv1.NodeSelectorTerm {
+	return k8sv1.NodeSelectorTerm{
+		MatchFields: []k8sv1.NodeSelectorRequirement{{
+			Key:      "metadata.name",
+			Operator: k8sv1.NodeSelectorOpIn,
+			Values:   []string{nodeName},
+		}},
+	}
+}
@@ -0,0 +1,341 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launcherreservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	testNamespace     = "kubevirt"
+	testLauncherImage = "virt-launcher:devel"
+)
+
+var _ = Describe("Launcher reservation", func() {
+	var (
+		kubeClient    *fake.Clientset
+		virtClient    *kubecli.MockKubevirtClient
+		podInformer   cache.SharedIndexInformer
+		nodeInformer  cache.SharedIndexInformer
+		kvStore       cache.Store
+		clusterConfig *virtconfig.ClusterConfig
+	)
+
+	newNode := func(name string, nodeLabels map[string]string) *k8sv1.Node {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1.NodeSchedulable: "true"},
+			},
+		}
+		for key, value := range nodeLabels {
+			node.Labels[key] = value
+		}
+		return node
+	}
+
+	newReservationPod := func(name, reservationName, nodeName string, created time.Time) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				UID:               types.UID(name + "-uid"),
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1.AppLabel:                 AppLabelValue,
+					v1.LauncherReservationLabel: reservationName,
+					v1.NodeNameLabel:            nodeName,
+				},
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				Conditions: []k8sv1.PodCondition{{
+					Type:   k8sv1.PodReady,
+					Status: k8sv1.ConditionTrue,
+				}},
+			},
+		}
+	}
+
+	addNode := func(node *k8sv1.Node) {
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+	}
+
+	addPod := func(pod *k8sv1.Pod) {
+		Expect(podInformer.GetIndexer().Add(pod)).To(Succeed())
+		_, err := kubeClient.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	setPools := func(reservations ...v1.LauncherReservation) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{LauncherReservations: reservations},
+			},
+		})
+	}
+
+	listPods := func() []k8sv1.Pod {
+		pods, err := kubeClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pods.Items
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		// The fake clientset does not generate names
+		generated := 0
+		kubeClient.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			pod := action.(k8stesting.CreateAction).GetObject().(*k8sv1.Pod)
+			if pod.Name == "" && pod.GenerateName != "" {
+				generated++
+				pod.Name = fmt.Sprintf("%s%d", pod.GenerateName, generated)
+			}
+			return false, nil, nil
+		})
+
+		podInformer, _ = testutils.NewFakeInformerWithIndexersFor(&k8sv1.Pod{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		clusterConfig, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+	})
+
+	Context("controller", func() {
+		var (
+			recorder   *record.FakeRecorder
+			controller *Controller
+		)
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(10)
+
+			var err error
+			controller, err = NewController(virtClient, podInformer, nodeInformer, clusterConfig, recorder, testNamespace, testLauncherImage, "pull-secret")
+			Expect(err).ToNot(HaveOccurred())
+			controller.Queue = testutils.NewMockWorkQueue(controller.Queue)
+		})
+
+		It("should create the placeholder pods on the selected nodes", func() {
+			addNode(newNode("node01", map[string]string{"reservation": "reserved"}))
+			addNode(newNode("node02", nil))
+			unschedulable := newNode("node03", map[string]string{"reservation": "reserved"})
+			unschedulable.Spec.Unschedulable = true
+			addNode(unschedulable)
+			setPools(v1.LauncherReservation{
+				Name:         "small",
+				PodsPerNode:  2,
+				NodeSelector: map[string]string{"reservation": "reserved"},
+				Resources: k8sv1.ResourceList{
+					k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			})
+
+			Expect(controller.execute("small")).To(Succeed())
+
+			pods := listPods()
+			Expect(pods).To(HaveLen(2))
+			for _, pod := range pods {
+				Expect(pod.Labels).To(HaveKeyWithValue(v1.AppLabel, AppLabelValue))
+				Expect(pod.Labels).To(HaveKeyWithValue(v1.LauncherReservationLabel, "small"))
+				Expect(pod.Labels).To(HaveKeyWithValue(v1.NodeNameLabel, "node01"))
+				Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(
+					ConsistOf(nodeNameSelectorTerm("node01")))
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+				Expect(pod.Spec.Containers[0].Image).To(Equal(testLauncherImage))
+				Expect(pod.Spec.Containers[0].Resources.Requests.Memory().String()).To(Equal("1Gi"))
+				Expect(pod.Spec.ImagePullSecrets).To(ConsistOf(k8sv1.LocalObjectReference{Name: "pull-secret"}))
+			}
+			testutils.ExpectEvents(recorder, SuccessfulCreateReservationPodReason, SuccessfulCreateReservationPodReason)
+		})
+
+		It("should wait for the expected pods before creating more", func() {
+			addNode(newNode("node01", nil))
+			setPools(v1.LauncherReservation{Name: "small", PodsPerNode: 1})
+
+			Expect(controller.execute("small")).To(Succeed())
+			Expect(controller.execute("small")).To(Succeed())
+
+			Expect(listPods()).To(HaveLen(1))
+		})
+
+		It("should delete surplus, terminated and unselected placeholder pods", func() {
+			addNode(newNode("node01", nil))
+			addNode(newNode("node02", map[string]string{"reservation": "cold"}))
+			setPools(v1.LauncherReservation{
+				Name:         "small",
+				PodsPerNode:  1,
+				NodeSelector: map[string]string{"reservation": "cold"},
+			})
+			now := time.Now()
+			unselected := newReservationPod("unselected", "small", "node01", now)
+			kept := newReservationPod("kept", "small", "node02", now.Add(-time.Hour))
+			surplus := newReservationPod("surplus", "small", "node02", now)
+			failed := newReservationPod("failed", "small", "node02", now)
+			failed.Status.Phase = k8sv1.PodFailed
+			for _, pod := range []*k8sv1.Pod{unselected, kept, surplus, failed} {
+				addPod(pod)
+			}
+
+			Expect(controller.execute("small")).To(Succeed())
+
+			pods := listPods()
+			Expect(pods).To(HaveLen(1))
+			Expect(pods[0].Name).To(Equal(kept.Name))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should delete the placeholder pods of removed reservations", func() {
+			addNode(newNode("node01", nil))
+			setPools(v1.LauncherReservation{Name: "small", PodsPerNode: 1})
+			addPod(newReservationPod("removed", "large", "node01", time.Now()))
+
+			Expect(controller.execute("large")).To(Succeed())
+
+			Expect(listPods()).To(BeEmpty())
+		})
+
+		It("should enqueue all reservations when the configuration changes", func() {
+			addPod(newReservationPod("removed", "large", "node01", time.Now()))
+			mockQueue := controller.Queue.(*testutils.MockWorkQueue[string])
+
+			mockQueue.ExpectAdds(2)
+			setPools(v1.LauncherReservation{Name: "small", PodsPerNode: 1})
+			mockQueue.Wait()
+
+			Expect(mockQueue.Len()).To(Equal(2))
+		})
+	})
+
+	Context("claimer", func() {
+		var claimer *Claimer
+
+		newLauncherPod := func(nodeSelector map[string]string) *k8sv1.Pod {
+			return &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "virt-launcher-testvmi-abcde",
+					Namespace: k8sv1.NamespaceDefault,
+				},
+				Spec: k8sv1.PodSpec{NodeSelector: nodeSelector},
+			}
+		}
+
+		BeforeEach(func() {
+			claimer = NewClaimer(virtClient, podInformer, nodeInformer, clusterConfig, testNamespace)
+			addNode(newNode("node01", nil))
+			addNode(newNode("node02", map[string]string{"gpu": "true"}))
+		})
+
+		It("should not claim without reservations", func() {
+			addPod(newReservationPod("idle", "small", "node01", time.Now()))
+
+			reservationPod, err := claimer.Claim(libvmi.New(), newLauncherPod(nil))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reservationPod).To(BeNil())
+			Expect(listPods()).To(HaveLen(1))
+		})
+
+		It("should claim the oldest ready placeholder pod on a fitting node", func() {
+			setPools(v1.LauncherReservation{Name: "small", PodsPerNode: 2})
+			now := time.Now()
+			notReady := newReservationPod("not-ready", "small", "node02", now.Add(-3*time.Hour))
+			notReady.Status.Conditions[0].Status = k8sv1.ConditionFalse
+			addPod(notReady)
+			addPod(newReservationPod("not-fitting", "small", "node01", now.Add(-2*time.Hour)))
+			addPod(newReservationPod("oldest", "small", "node02", now.Add(-time.Hour)))
+			addPod(newReservationPod("newest", "small", "node02", now))
+
+			reservationPod, err := claimer.Claim(libvmi.New(), newLauncherPod(map[string]string{"gpu": "true"}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reservationPod).ToNot(BeNil())
+			Expect(reservationPod.Name).To(Equal("oldest"))
+
+			var names []string
+			for _, pod := range listPods() {
+				names = append(names, pod.Name)
+			}
+			Expect(names).To(ConsistOf("not-ready", "not-fitting", "newest"))
+		})
+
+		It("should try the next placeholder pod when one was claimed in the meantime", func() {
+			setPools(v1.LauncherReservation{Name: "small", PodsPerNode: 2})
+			now := time.Now()
+			gone := newReservationPod("gone", "small", "node01", now.Add(-time.Hour))
+			Expect(podInformer.GetIndexer().Add(gone)).To(Succeed())
+			addPod(newReservationPod("idle", "small", "node01", now))
+
+			reservationPod, err := claimer.Claim(libvmi.New(), newLauncherPod(nil))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reservationPod).ToNot(BeNil())
+			Expect(reservationPod.Name).To(Equal("idle"))
+		})
+
+		DescribeTable("should respect the instancetypes of the reservation", func(vmi *v1.VirtualMachineInstance, claimed bool) {
+			setPools(v1.LauncherReservation{Name: "small", PodsPerNode: 1, Instancetypes: []string{"u1.small"}})
+			addPod(newReservationPod("idle", "small", "node01", time.Now()))
+
+			reservationPod, err := claimer.Claim(vmi, newLauncherPod(nil))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reservationPod != nil).To(Equal(claimed))
+		},
+			Entry("with a listed instancetype", libvmi.New(libvmi.WithAnnotation(v1.ClusterInstancetypeAnnotation, "u1.small")), true),
+			Entry("with another instancetype", libvmi.New(libvmi.WithAnnotation(v1.ClusterInstancetypeAnnotation, "u1.large")), false),
+			Entry("without instancetype", libvmi.New(), false),
+		)
+
+		It("should make the virt-launcher pod prefer the node of the claimed pod", func() {
+			launcherPod := newLauncherPod(nil)
+
+			ApplyClaim(launcherPod, newReservationPod("idle", "small", "node01", time.Now()))
+
+			Expect(launcherPod.Annotations).To(HaveKeyWithValue(v1.ClaimedLauncherReservationAnnotation, testNamespace+"/idle"))
+			Expect(launcherPod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(
+				k8sv1.PreferredSchedulingTerm{Weight: claimedNodeAffinityWeight, Preference: nodeNameSelectorTerm("node01")},
+			))
+		})
+	})
+})
@@ -0,0 +1,267 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package machinetype
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// UnsupportedMachineTypeReason is added in the condition and in an event of a VM when no node
+	// supports its machine type.
+	UnsupportedMachineTypeReason = "UnsupportedMachineType"
+)
+
+// Controller flags the VirtualMachines whose machine type is not supported by any node, e.g. because
+// it was removed by a newer QEMU version, with the MachineTypeUnsupported condition. The machine types
+// supported by the nodes are reported by virt-handler in the machine-type.node.kubevirt.io labels.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	Queue         workqueue.TypedRateLimitingInterface[string]
+	vmIndexer     cache.Indexer
+	nodeStore     cache.Store
+	recorder      record.EventRecorder
+	clusterConfig *virtconfig.ClusterConfig
+	hasSynced     func() bool
+}
+
+func NewController(
+	vmInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*Controller, error) {
+	c := &Controller{
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-machine-type"},
+		),
+		vmIndexer:     vmInformer.GetIndexer(),
+		nodeStore:     nodeInformer.GetStore(),
+		recorder:      recorder,
+		clientset:     clientset,
+		clusterConfig: clusterConfig,
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && nodeInformer.HasSynced()
+	}
+
+	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVM,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueVM(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueueAllVMs() },
+		DeleteFunc: func(_ interface{}) { c.enqueueAllVMs() },
+		UpdateFunc: c.updateNode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueueVM(obj interface{}) {
+	vm := obj.(*virtv1.VirtualMachine)
+	key, err := controller.KeyFunc(vm)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to extract key from VirtualMachine.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *Controller) enqueueAllVMs() {
+	for _, key := range c.vmIndexer.ListKeys() {
+		c.Queue.Add(key)
+	}
+}
+
+// updateNode re-evaluates the VMs only when the machine types supported by the node changed, and not on
+// each heartbeat of the node
+func (c *Controller) updateNode(old, curr interface{}) {
+	oldNode := old.(*k8sv1.Node)
+	currNode := curr.(*k8sv1.Node)
+	if oldNode.Labels[k8sv1.LabelArchStable] != currNode.Labels[k8sv1.LabelArchStable] ||
+		!maps.Equal(machineTypeLabels(oldNode), machineTypeLabels(currNode)) {
+		c.enqueueAllVMs()
+	}
+}
+
+func machineTypeLabels(node *k8sv1.Node) map[string]string {
+	labels := map[string]string{}
+	for key, value := range node.Labels {
+		if strings.HasPrefix(key, virtv1.SupportedMachineTypeLabel) {
+			labels[key] = value
+		}
+	}
+	return labels
+}
+
+// Run runs the passed in Controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting machine type controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping machine type controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachine %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachine %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.vmIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	if vm.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	conditionManager := controller.NewVirtualMachineConditionManager()
+	machineType, arch := c.machineTypeAndArch(vm)
+	if machineType == "" || c.isMachineTypeSupported(machineType, arch) {
+		conditionManager.RemoveCondition(vmCopy, virtv1.VirtualMachineMachineTypeUnsupported)
+	} else {
+		message := fmt.Sprintf("No %s node supports the machine type %s, it may have been removed by a newer QEMU version", arch, machineType)
+		if condition := conditionManager.GetCondition(vm, virtv1.VirtualMachineMachineTypeUnsupported); condition == nil || condition.Message != message {
+			c.recorder.Event(vm, k8sv1.EventTypeWarning, UnsupportedMachineTypeReason, message)
+			conditionManager.RemoveCondition(vmCopy, virtv1.VirtualMachineMachineTypeUnsupported)
+		}
+		conditionManager.UpdateCondition(vmCopy, &virtv1.VirtualMachineCondition{
+			Type:               virtv1.VirtualMachineMachineTypeUnsupported,
+			Status:             k8sv1.ConditionTrue,
+			Reason:             UnsupportedMachineTypeReason,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+
+	return c.updateConditions(vm, vmCopy)
+}
+
+func (c *Controller) machineTypeAndArch(vm *virtv1.VirtualMachine) (machineType, arch string) {
+	if vm.Spec.Template == nil {
+		return "", ""
+	}
+	arch = vm.Spec.Template.Spec.Architecture
+	if arch == "" {
+		arch = c.clusterConfig.GetDefaultArchitecture()
+	}
+	if machine := vm.Spec.Template.Spec.Domain.Machine; machine != nil {
+		machineType = c.clusterConfig.ResolveMachineType(arch, machine.Type)
+	}
+	return machineType, arch
+}
+
+// isMachineTypeSupported returns whether a node of the architecture supports the machine type. Machine types
+// are considered supported as long as no node of the architecture reports its supported machine types.
+func (c *Controller) isMachineTypeSupported(machineType, arch string) bool {
+	reported := false
+	for _, obj := range c.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		if node.Labels[k8sv1.LabelArchStable] != arch {
+			continue
+		}
+		for key, value := range node.Labels {
+			if !strings.HasPrefix(key, virtv1.SupportedMachineTypeLabel) {
+				continue
+			}
+			reported = true
+			if key == virtv1.SupportedMachineTypeLabel+machineType && value == "true" {
+				return true
+			}
+		}
+	}
+	return !reported
+}
+
+func (c *Controller) updateConditions(vm, vmCopy *virtv1.VirtualMachine) error {
+	if equality.Semantic.DeepEqual(vm.Status.Conditions, vmCopy.Status.Conditions) {
+		return nil
+	}
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/conditions", vm.Status.Conditions),
+		patch.WithAdd("/status/conditions", vmCopy.Status.Conditions),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
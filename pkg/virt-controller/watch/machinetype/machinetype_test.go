@@ -0,0 +1,200 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package machinetype
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Machine type controller", func() {
+	var (
+		vmInterface *kubecli.MockVirtualMachineInterface
+		recorder    *record.FakeRecorder
+		controller  *Controller
+		patchedVM   []byte
+		nodeCount   int
+	)
+
+	newVM := func(machineType string) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)))
+		vm.Name = "testvm"
+		vm.Spec.Template.Spec.Architecture = "amd64"
+		vm.Spec.Template.Spec.Domain.Machine = &v1.Machine{Type: machineType}
+		return vm
+	}
+
+	addNode := func(arch string, machineTypes ...string) {
+		nodeCount++
+		node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   fmt.Sprintf("node%d", nodeCount),
+			Labels: map[string]string{k8sv1.LabelArchStable: arch},
+		}}
+		for _, machineType := range machineTypes {
+			node.Labels[v1.SupportedMachineTypeLabel+machineType] = "true"
+		}
+		Expect(controller.nodeStore.Add(node)).To(Succeed())
+	}
+
+	sync := func(vm *v1.VirtualMachine) {
+		Expect(controller.vmIndexer.Add(vm)).To(Succeed())
+		key, err := cache.MetaNamespaceKeyFunc(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.execute(key)).To(Succeed())
+	}
+
+	patchedConditions := func() []v1.VirtualMachineCondition {
+		Expect(patchedVM).ToNot(BeEmpty())
+		var ops []patch.PatchOperation
+		Expect(json.Unmarshal(patchedVM, &ops)).To(Succeed())
+		Expect(ops).To(HaveLen(2))
+		Expect(ops[1].Path).To(Equal("/status/conditions"))
+		conditionsBytes, err := json.Marshal(ops[1].Value)
+		Expect(err).ToNot(HaveOccurred())
+		var conditions []v1.VirtualMachineCondition
+		Expect(json.Unmarshal(conditionsBytes, &conditions)).To(Succeed())
+		return conditions
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).Return(vmInterface).AnyTimes()
+
+		patchedVM = nil
+		nodeCount = 0
+		vmInterface.EXPECT().PatchStatus(gomock.Any(), "testvm", types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions) (*v1.VirtualMachine, error) {
+				patchedVM = data
+				return nil, nil
+			}).AnyTimes()
+
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		recorder = record.NewFakeRecorder(10)
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			ArchitectureConfiguration: &v1.ArchConfiguration{
+				Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"q35-stable": "pc-q35-rhel9.6.0"}},
+				Arm64: &v1.ArchSpecificConfiguration{},
+				S390x: &v1.ArchSpecificConfiguration{},
+			},
+		})
+
+		var err error
+		controller, err = NewController(vmInformer, nodeInformer, recorder, virtClient, config)
+		Expect(err).ToNot(HaveOccurred())
+		controller.Queue = testutils.NewMockWorkQueue(controller.Queue)
+	})
+
+	It("should flag a VM whose machine type no node supports", func() {
+		addNode("amd64", "q35", "pc-q35-rhel9.6.0")
+		addNode("arm64", "pc-q35-rhel8.6.0")
+
+		sync(newVM("pc-q35-rhel8.6.0"))
+
+		conditions := patchedConditions()
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Type).To(Equal(v1.VirtualMachineMachineTypeUnsupported))
+		Expect(conditions[0].Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(conditions[0].Reason).To(Equal(UnsupportedMachineTypeReason))
+		Expect(conditions[0].Message).To(ContainSubstring("pc-q35-rhel8.6.0"))
+		testutils.ExpectEvent(recorder, UnsupportedMachineTypeReason)
+	})
+
+	DescribeTable("should not flag a VM", func(machineType string, nodeArch string, nodeMachineTypes ...string) {
+		addNode(nodeArch, nodeMachineTypes...)
+
+		sync(newVM(machineType))
+
+		Expect(patchedVM).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	},
+		Entry("whose machine type a node supports", "pc-q35-rhel9.6.0", "amd64", "q35", "pc-q35-rhel9.6.0"),
+		Entry("whose machine type alias a node supports", "q35-stable", "amd64", "pc-q35-rhel9.6.0"),
+		Entry("when no node of its architecture reports machine types", "pc-q35-rhel8.6.0", "arm64", "virt"),
+		Entry("when the nodes of its architecture do not report machine types", "pc-q35-rhel8.6.0", "amd64"),
+	)
+
+	It("should remove the condition once a node supports the machine type", func() {
+		addNode("amd64", "pc-q35-rhel8.6.0")
+		vm := newVM("pc-q35-rhel8.6.0")
+		vm.Status.Conditions = []v1.VirtualMachineCondition{
+			{Type: v1.VirtualMachineReady, Status: k8sv1.ConditionFalse},
+			{Type: v1.VirtualMachineMachineTypeUnsupported, Status: k8sv1.ConditionTrue, Reason: UnsupportedMachineTypeReason},
+		}
+
+		sync(vm)
+
+		conditions := patchedConditions()
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Type).To(Equal(v1.VirtualMachineReady))
+	})
+
+	It("should not update a VM which is already flagged", func() {
+		addNode("amd64", "pc-q35-rhel9.6.0")
+		vm := newVM("pc-q35-rhel8.6.0")
+		vm.Status.Conditions = []v1.VirtualMachineCondition{{
+			Type:    v1.VirtualMachineMachineTypeUnsupported,
+			Status:  k8sv1.ConditionTrue,
+			Reason:  UnsupportedMachineTypeReason,
+			Message: "No amd64 node supports the machine type pc-q35-rhel8.6.0, it may have been removed by a newer QEMU version",
+		}}
+
+		sync(vm)
+
+		Expect(patchedVM).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should re-evaluate all VMs when the machine types of a node change", func() {
+		Expect(controller.vmIndexer.Add(newVM("pc-q35-rhel8.6.0"))).To(Succeed())
+		node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node0", Labels: map[string]string{
+			v1.SupportedMachineTypeLabel + "pc-q35-rhel8.6.0": "true",
+		}}}
+		updated := node.DeepCopy()
+		updated.Labels = map[string]string{v1.SupportedMachineTypeLabel + "pc-q35-rhel9.6.0": "true"}
+		heartbeat := node.DeepCopy()
+		heartbeat.Annotations = map[string]string{v1.VirtHandlerHeartbeat: "now"}
+
+		controller.updateNode(node, heartbeat)
+		Expect(controller.Queue.Len()).To(BeZero())
+
+		controller.updateNode(node, updated)
+		Expect(controller.Queue.Len()).To(Equal(1))
+	})
+})
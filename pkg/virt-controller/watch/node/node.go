@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	coordinationv1 "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
@@ -22,6 +24,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/lookup"
 )
 
@@ -37,6 +40,8 @@ type Controller struct {
 	Queue            workqueue.TypedRateLimitingInterface[string]
 	nodeStore        cache.Store
 	vmiStore         cache.Store
+	leaseStore       cache.Store
+	namespace        string
 	recorder         record.EventRecorder
 	heartBeatTimeout time.Duration
 	recheckInterval  time.Duration
@@ -44,7 +49,7 @@ type Controller struct {
 }
 
 // NewController creates a new instance of the NodeController struct.
-func NewController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*Controller, error) {
+func NewController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, leaseInformer cache.SharedIndexInformer, recorder record.EventRecorder, namespace string) (*Controller, error) {
 	c := &Controller{
 		clientset: clientset,
 		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
@@ -53,13 +58,15 @@ func NewController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIn
 		),
 		nodeStore:        nodeInformer.GetStore(),
 		vmiStore:         vmiInformer.GetStore(),
+		leaseStore:       leaseInformer.GetStore(),
+		namespace:        namespace,
 		recorder:         recorder,
 		heartBeatTimeout: 5 * time.Minute,
 		recheckInterval:  1 * time.Minute,
 	}
 
 	c.hasSynced = func() bool {
-		return nodeInformer.HasSynced() && vmiInformer.HasSynced()
+		return nodeInformer.HasSynced() && vmiInformer.HasSynced() && leaseInformer.HasSynced()
 	}
 
 	_, err := nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -191,7 +198,18 @@ func (c *Controller) execute(key string) error {
 
 	}
 
-	unresponsive, err := isNodeUnresponsive(node, c.heartBeatTimeout)
+	lease, err := c.heartBeatLease(key)
+	if err != nil {
+		return err
+	}
+
+	if !nodeExists && lease != nil {
+		if err := c.deleteHeartBeatLease(lease); err != nil {
+			return err
+		}
+	}
+
+	unresponsive, err := isNodeUnresponsive(node, lease, c.heartBeatTimeout)
 	if err != nil {
 		logger.Reason(err).Error("Failed to determine if node is responsive, will not reenqueue")
 		return nil
@@ -215,6 +233,23 @@ func (c *Controller) execute(key string) error {
 	return nil
 }
 
+// heartBeatLease returns the lease virt-handler renews on the node, if any
+func (c *Controller) heartBeatLease(nodeName string) (*coordinationv1.Lease, error) {
+	obj, exists, err := c.leaseStore.GetByKey(controller.NamespacedKey(c.namespace, util.VirtHandlerLeaseName(nodeName)))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*coordinationv1.Lease), nil
+}
+
+func (c *Controller) deleteHeartBeatLease(lease *coordinationv1.Lease) error {
+	err := c.clientset.CoordinationV1().Leases(lease.Namespace).Delete(context.Background(), lease.Name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the heartbeat lease %s of a removed node: %v", lease.Name, err)
+	}
+	return nil
+}
+
 func nodeIsSchedulable(node *v1.Node) bool {
 	if node == nil {
 		return false
@@ -448,19 +483,30 @@ func isControlledByVMI(controllerRef *metav1.OwnerReference) bool {
 	return controllerRef != nil && controllerRef.Kind == virtv1.VirtualMachineInstanceGroupVersionKind.Kind
 }
 
-func isNodeUnresponsive(node *v1.Node, timeout time.Duration) (bool, error) {
+// isNodeUnresponsive checks the last heartbeat of virt-handler, which is the latest of the lease
+// renewal and of the node annotation. virt-handler only updates the annotation from time to time
+// once it renews the lease.
+func isNodeUnresponsive(node *v1.Node, lease *coordinationv1.Lease, timeout time.Duration) (bool, error) {
 	if node == nil {
 		return true, nil
 	}
-	if lastHeartBeat, exists := node.Annotations[virtv1.VirtHandlerHeartbeat]; exists {
 
+	var lastHeartBeat time.Time
+	if lease != nil && lease.Spec.RenewTime != nil {
+		lastHeartBeat = lease.Spec.RenewTime.Time
+	}
+	if annotation, exists := node.Annotations[virtv1.VirtHandlerHeartbeat]; exists {
 		timestamp := metav1.Time{}
-		if err := json.Unmarshal([]byte(`"`+lastHeartBeat+`"`), &timestamp); err != nil {
+		if err := json.Unmarshal([]byte(`"`+annotation+`"`), &timestamp); err != nil {
 			return false, err
 		}
-		if timestamp.Time.Before(metav1.Now().Add(-timeout)) {
-			return true, nil
+		if timestamp.Time.After(lastHeartBeat) {
+			lastHeartBeat = timestamp.Time
 		}
 	}
-	return false, nil
+
+	if lastHeartBeat.IsZero() {
+		return false, nil
+	}
+	return lastHeartBeat.Before(metav1.Now().Add(-timeout)), nil
 }
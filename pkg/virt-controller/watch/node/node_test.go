@@ -12,6 +12,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	appv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,6 +30,7 @@ import (
 
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
 )
 
@@ -40,6 +42,7 @@ var _ = Describe("Node controller with", func() {
 	var nodeInformer cache.SharedIndexInformer
 	var vmiSource *framework.FakeControllerSource
 	var vmiInformer cache.SharedIndexInformer
+	var leaseInformer cache.SharedIndexInformer
 	var stop chan struct{}
 	var controller *Controller
 	var recorder *record.FakeRecorder
@@ -51,7 +54,8 @@ var _ = Describe("Node controller with", func() {
 	syncCaches := func(stop chan struct{}) {
 		go nodeInformer.Run(stop)
 		go vmiInformer.Run(stop)
-		Expect(cache.WaitForCacheSync(stop, nodeInformer.HasSynced, vmiInformer.HasSynced)).To(BeTrue())
+		go leaseInformer.Run(stop)
+		Expect(cache.WaitForCacheSync(stop, nodeInformer.HasSynced, vmiInformer.HasSynced, leaseInformer.HasSynced)).To(BeTrue())
 	}
 
 	BeforeEach(func() {
@@ -62,10 +66,11 @@ var _ = Describe("Node controller with", func() {
 
 		nodeInformer, nodeSource = testutils.NewFakeInformerFor(&k8sv1.Node{})
 		vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		leaseInformer, _ = testutils.NewFakeInformerFor(&coordinationv1.Lease{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 
-		controller, _ = NewController(virtClient, nodeInformer, vmiInformer, recorder)
+		controller, _ = NewController(virtClient, nodeInformer, vmiInformer, leaseInformer, recorder, metav1.NamespaceDefault)
 		// Wrap our workqueue to have a way to detect when we are done processing updates
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
@@ -77,6 +82,7 @@ var _ = Describe("Node controller with", func() {
 		kubeClient = fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 		virtClient.EXPECT().AppsV1().Return(kubeClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().CoordinationV1().Return(kubeClient.CoordinationV1()).AnyTimes()
 
 		// Make sure that all unexpected calls to kubeClient will fail
 		kubeClient.Fake.PrependReactor("*", "*", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
@@ -158,6 +164,15 @@ var _ = Describe("Node controller with", func() {
 
 			sanityExecute()
 		})
+
+		It("should do nothing when the lease is renewed while the annotation is outdated", func() {
+			node := NewUnhealthyNode("testnode")
+			Expect(leaseInformer.GetStore().Add(NewHeartBeatLease(node.Name, 0))).To(Succeed())
+
+			addNode(node)
+
+			sanityExecute()
+		})
 	})
 
 	Context("unresponsive virt-handler given", func() {
@@ -177,6 +192,37 @@ var _ = Describe("Node controller with", func() {
 			sanityExecute()
 			testutils.ExpectEvent(recorder, NodeUnresponsiveReason)
 		})
+		It("should set the node to unschedulable when neither the lease nor the annotation are renewed", func() {
+			node := NewHealthyNode("testnode")
+			node.Annotations[v1.VirtHandlerHeartbeat] = nowAsJSONWithOffset(-10 * time.Minute)
+			Expect(leaseInformer.GetStore().Add(NewHeartBeatLease(node.Name, -10*time.Minute))).To(Succeed())
+
+			addNode(node)
+
+			kubeClient.Fake.PrependReactor("patch", "nodes", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, nil
+			})
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, NodeUnresponsiveReason)
+		})
+		It("should delete the lease of a removed node", func() {
+			node := NewUnhealthyNode("testnode")
+			Expect(leaseInformer.GetStore().Add(NewHeartBeatLease(node.Name, 0))).To(Succeed())
+
+			Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+			deleteNode(node.DeepCopy())
+			kubeClient.Fake.PrependReactor("list", "pods", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, &k8sv1.PodList{}, nil
+			})
+			kubeClient.Fake.PrependReactor("delete", "leases", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action.(k8stesting.DeleteAction).GetName()).To(Equal(util.VirtHandlerLeaseName(node.Name)))
+				return true, nil, nil
+			})
+
+			sanityExecute()
+			Expect(testing.FilterActions(&kubeClient.Fake, "delete", "leases")).To(HaveLen(1))
+		})
 		DescribeTable("should set a vmi without a pod to failed state if the vmi is in ", func(phase v1.VirtualMachineInstancePhase) {
 			node := NewUnhealthyNode("testnode")
 			vmi := watchtesting.NewRunningVirtualMachine("vmi1", node)
@@ -459,6 +505,19 @@ func NewUnhealthyNode(nodeName string) *k8sv1.Node {
 	return node
 }
 
+func NewHeartBeatLease(nodeName string, offset time.Duration) *coordinationv1.Lease {
+	renewTime := metav1.NewMicroTime(time.Now().Add(offset))
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      util.VirtHandlerLeaseName(nodeName),
+		},
+		Spec: coordinationv1.LeaseSpec{
+			RenewTime: &renewTime,
+		},
+	}
+}
+
 func nowAsJSONWithOffset(offset time.Duration) string {
 	now := metav1.Now()
 	now = metav1.NewTime(now.Add(offset))
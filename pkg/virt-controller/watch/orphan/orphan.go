@@ -0,0 +1,221 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package orphan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// SuccessfulDeleteOrphanedPodReason is added in an event when an orphaned virt-launcher pod was deleted.
+	SuccessfulDeleteOrphanedPodReason = "SuccessfulDeleteOrphanedPod"
+	// FailedDeleteOrphanedPodReason is added in an event when an orphaned virt-launcher pod could not be deleted.
+	FailedDeleteOrphanedPodReason = "FailedDeleteOrphanedPod"
+)
+
+// Controller deletes the virt-launcher pods whose VMI is gone. The VMI finalizer keeps
+// a VMI until its pods are deleted, but pods are left behind when the finalizer is
+// removed by hand or when the VMI is deleted without deleting its dependents.
+// Instead of waiting for a resync, pods are checked when they are observed and when
+// the VMI which created them is deleted.
+type Controller struct {
+	clientset  kubecli.KubevirtClient
+	Queue      workqueue.TypedRateLimitingInterface[string]
+	podIndexer cache.Indexer
+	vmiStore   cache.Store
+	recorder   record.EventRecorder
+	hasSynced  func() bool
+}
+
+func NewController(
+	clientset kubecli.KubevirtClient,
+	podInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-orphan"},
+		),
+		podIndexer: podInformer.GetIndexer(),
+		vmiStore:   vmiInformer.GetStore(),
+		recorder:   recorder,
+	}
+
+	c.hasSynced = func() bool {
+		return podInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueuePod,
+		UpdateFunc: func(_, curr interface{}) { c.enqueuePod(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: c.deleteVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueuePod(obj interface{}) {
+	pod := obj.(*k8sv1.Pod)
+	if !controller.IsLauncherPod(pod) || pod.DeletionTimestamp != nil {
+		return
+	}
+	key, err := controller.KeyFunc(pod)
+	if err != nil {
+		log.Log.Object(pod).Reason(err).Error("Failed to extract key from pod.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+// deleteVMI enqueues the virt-launcher pods created by a deleted VMI
+func (c *Controller) deleteVMI(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vmi %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+
+	objs, err := c.podIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to list the pods of the deleted VMI.")
+		return
+	}
+	for _, obj := range objs {
+		pod := obj.(*k8sv1.Pod)
+		if pod.Labels[virtv1.CreatedByLabel] == string(vmi.UID) {
+			c.enqueuePod(pod)
+		}
+	}
+}
+
+// Run runs the passed in Controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting orphan controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping orphan controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing pod %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed pod %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.podIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	pod := obj.(*k8sv1.Pod)
+	if pod.DeletionTimestamp != nil {
+		return nil
+	}
+
+	orphaned, err := controller.IsOrphanedLauncherPod(pod, c.vmiStore)
+	if err != nil || !orphaned {
+		return err
+	}
+
+	// The VMI may not be observed yet, only an uncached read tells for sure that it is gone
+	vmiName := controller.LauncherPodVMIName(pod)
+	vmi, err := c.clientset.VirtualMachineInstance(pod.Namespace).Get(context.Background(), vmiName, metav1.GetOptions{})
+	if err == nil && vmi.UID == types.UID(pod.Labels[virtv1.CreatedByLabel]) {
+		return nil
+	} else if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	err = c.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &pod.UID},
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		c.recorder.Eventf(pod, k8sv1.EventTypeWarning, FailedDeleteOrphanedPodReason, "Error deleting orphaned virt-launcher pod: %v", err)
+		return err
+	}
+
+	log.Log.Object(pod).Infof("Deleted virt-launcher pod orphaned by VMI %s/%s", pod.Namespace, vmiName)
+	c.recorder.Eventf(pod, k8sv1.EventTypeNormal, SuccessfulDeleteOrphanedPodReason, "Deleted virt-launcher pod whose VirtualMachineInstance %s is gone", vmiName)
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package orphan
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Orphan controller", func() {
+	var (
+		kubeClient     *fake.Clientset
+		fakeVirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		controller     *Controller
+	)
+
+	newVMI := func(uid types.UID) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+		vmi.Name = "testvmi"
+		vmi.UID = uid
+		return vmi
+	}
+
+	newLauncherPod := func(vmi *v1.VirtualMachineInstance) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-launcher-testvmi-abcde",
+				Namespace: vmi.Namespace,
+				UID:       "pod-uid",
+				Labels: map[string]string{
+					v1.AppLabel:       "virt-launcher",
+					v1.CreatedByLabel: string(vmi.UID),
+				},
+				Annotations: map[string]string{
+					v1.DomainAnnotation: vmi.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind),
+				},
+			},
+		}
+	}
+
+	addPod := func(pod *k8sv1.Pod) {
+		Expect(controller.podIndexer.Add(pod)).To(Succeed())
+		_, err := kubeClient.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance, cached bool) {
+		if cached {
+			Expect(controller.vmiStore.Add(vmi)).To(Succeed())
+		}
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	sync := func(pod *k8sv1.Pod) {
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.execute(key)).To(Succeed())
+	}
+
+	expectPodExists := func(pod *k8sv1.Pod, exists bool) {
+		_, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+		if exists {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(
+			fakeVirtClient.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
+
+		podInformer, _ := testutils.NewFakeInformerWithIndexersFor(&k8sv1.Pod{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(10)
+
+		var err error
+		controller, err = NewController(virtClient, podInformer, vmiInformer, recorder)
+		Expect(err).ToNot(HaveOccurred())
+		controller.Queue = testutils.NewMockWorkQueue(controller.Queue)
+	})
+
+	It("should delete a launcher pod whose VMI is gone", func() {
+		pod := newLauncherPod(newVMI("vmi-uid"))
+		addPod(pod)
+
+		sync(pod)
+
+		expectPodExists(pod, false)
+		testutils.ExpectEvent(recorder, SuccessfulDeleteOrphanedPodReason)
+	})
+
+	It("should delete a launcher pod whose VMI was recreated", func() {
+		pod := newLauncherPod(newVMI("old-uid"))
+		addPod(pod)
+		addVMI(newVMI("new-uid"), true)
+
+		sync(pod)
+
+		expectPodExists(pod, false)
+		testutils.ExpectEvent(recorder, SuccessfulDeleteOrphanedPodReason)
+	})
+
+	It("should keep a launcher pod whose VMI exists", func() {
+		vmi := newVMI("vmi-uid")
+		pod := newLauncherPod(vmi)
+		addPod(pod)
+		addVMI(vmi, true)
+
+		sync(pod)
+
+		expectPodExists(pod, true)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should keep a launcher pod whose VMI is not in the cache yet", func() {
+		vmi := newVMI("vmi-uid")
+		pod := newLauncherPod(vmi)
+		addPod(pod)
+		addVMI(vmi, false)
+
+		sync(pod)
+
+		expectPodExists(pod, true)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should ignore pods which are not launcher pods", func() {
+		pod := newLauncherPod(newVMI("vmi-uid"))
+		delete(pod.Labels, v1.CreatedByLabel)
+		addPod(pod)
+
+		sync(pod)
+
+		expectPodExists(pod, true)
+	})
+
+	It("should enqueue the launcher pods of a deleted VMI", func() {
+		vmi := newVMI("vmi-uid")
+		pod := newLauncherPod(vmi)
+		other := newLauncherPod(newVMI("other-uid"))
+		other.Name = "virt-launcher-other-abcde"
+		Expect(controller.podIndexer.Add(pod)).To(Succeed())
+		Expect(controller.podIndexer.Add(other)).To(Succeed())
+
+		controller.deleteVMI(cache.DeletedFinalStateUnknown{Key: "default/testvmi", Obj: vmi})
+
+		Expect(controller.Queue.Len()).To(Equal(1))
+		key, _ := controller.Queue.Get()
+		Expect(key).To(Equal("default/virt-launcher-testvmi-abcde"))
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/trace"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -44,6 +45,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 )
 
 // Controller is the main Controller struct.
@@ -56,6 +58,7 @@ type Controller struct {
 	dvStore         cache.Store
 	poolIndexer     cache.Indexer
 	revisionIndexer cache.Indexer
+	nodeStore       cache.Store
 	recorder        record.EventRecorder
 	expectations    *controller.UIDTrackingControllerExpectations
 	burstReplicas   uint
@@ -70,6 +73,9 @@ const (
 	defaultRetryDelay              = 3 * time.Second
 	defaultStartUpFailureThreshold = 3
 	minFailingToStartDuration      = 5 * time.Minute
+
+	// slowStartInitialBatchSize is the size of the first batch of VMs created on scale out
+	slowStartInitialBatchSize = 1
 )
 
 const (
@@ -77,9 +83,15 @@ const (
 	FailedScaleInReason         = "FailedScaleIn"
 	FailedUpdateReason          = "FailedUpdate"
 	FailedRevisionPruningReason = "FailedRevisionPruning"
+	FailedFailoverReason        = "FailedFailover"
+
+	SuccessfulFailoverReason = "SuccessfulFailover"
 
 	SuccessfulPausedPoolReason = "SuccessfulPaused"
 	SuccessfulResumePoolReason = "SuccessfulResume"
+
+	SuccessfulPausedUpdateReason = "SuccessfulPausedUpdate"
+	SuccessfulResumeUpdateReason = "SuccessfulResumeUpdate"
 )
 
 var virtControllerPoolWorkQueueTracer = &traceUtils.Tracer{Threshold: time.Second}
@@ -92,6 +104,7 @@ func NewController(clientset kubecli.KubevirtClient,
 	pvcInformer cache.SharedIndexInformer,
 	dvInformer cache.SharedIndexInformer,
 	revisionInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	burstReplicas uint) (*Controller, error) {
 	c := &Controller{
@@ -106,13 +119,14 @@ func NewController(clientset kubecli.KubevirtClient,
 		pvcStore:        pvcInformer.GetStore(),
 		dvStore:         dvInformer.GetStore(),
 		revisionIndexer: revisionInformer.GetIndexer(),
+		nodeStore:       nodeInformer.GetStore(),
 		recorder:        recorder,
 		expectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		burstReplicas:   burstReplicas,
 	}
 
 	c.hasSynced = func() bool {
-		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced() && pvcInformer.HasSynced() && dvInformer.HasSynced()
+		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced() && pvcInformer.HasSynced() && dvInformer.HasSynced() && nodeInformer.HasSynced()
 	}
 
 	_, err := poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -456,6 +470,41 @@ func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.Vir
 	return len(vms) - int(wantedReplicas)
 }
 
+// calcSurge returns the number of VMs which can be created above the desired
+// replicas, to replace the outdated VMs of a pool using the Replace update method.
+func (c *Controller) calcSurge(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	if pool.Spec.MaxSurge == nil || isUpdatePaused(pool) || isOpportunisticUpdate(pool) ||
+		resolveProactiveUpdateMethod(pool) != poolv1.VirtualMachinePoolProactiveUpdateMethodReplace {
+		return 0, nil
+	}
+
+	wantedReplicas := int32(1)
+	if pool.Spec.Replicas != nil {
+		wantedReplicas = *pool.Spec.Replicas
+	}
+
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(pool.Spec.MaxSurge, int(wantedReplicas), true)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maxSurge: %v", err)
+	}
+
+	outdatedCount := 0
+	for _, vm := range vms {
+		if vm.DeletionTimestamp != nil {
+			continue
+		}
+		outdated, err := c.isOutdatedVM(pool, vm)
+		if err != nil {
+			return 0, err
+		}
+		if outdated {
+			outdatedCount++
+		}
+	}
+
+	return min(maxSurge, outdatedCount), nil
+}
+
 func filterRunningVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 	filtered := []*virtv1.VirtualMachine{}
 	for _, vm := range vms {
@@ -844,6 +893,266 @@ func injectPoolRevisionLabelsIntoVM(vm *virtv1.VirtualMachine, revisionName stri
 	return vm
 }
 
+// injectPoolNameLabelsIntoVM labels the VM and its VMI with the name of the pool,
+// unless it is too long to be a label value.
+func injectPoolNameLabelsIntoVM(vm *virtv1.VirtualMachine, poolName string) *virtv1.VirtualMachine {
+	if len(validation.IsValidLabelValue(poolName)) > 0 {
+		return vm
+	}
+
+	if vm.Labels == nil {
+		vm.Labels = map[string]string{}
+	}
+	if vm.Spec.Template.ObjectMeta.Labels == nil {
+		vm.Spec.Template.ObjectMeta.Labels = map[string]string{}
+	}
+
+	vm.Labels[virtv1.VirtualMachinePoolNameLabel] = poolName
+	vm.Spec.Template.ObjectMeta.Labels[virtv1.VirtualMachinePoolNameLabel] = poolName
+
+	return vm
+}
+
+// injectPoolTopologyIntoVM adds the topology spread and anti-affinity rules of the pool
+// to the VMI template of the VM. The rules select the VMIs of the pool by the pool name
+// label, so they are only added when the pool name is a valid label value.
+func injectPoolTopologyIntoVM(vm *virtv1.VirtualMachine, pool *poolv1.VirtualMachinePool) *virtv1.VirtualMachine {
+	if len(validation.IsValidLabelValue(pool.Name)) > 0 {
+		return vm
+	}
+
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{virtv1.VirtualMachinePoolNameLabel: pool.Name},
+	}
+	vmiSpec := &vm.Spec.Template.Spec
+
+	for _, spread := range pool.Spec.TopologySpread {
+		constraint := k8score.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       spread.TopologyKey,
+			WhenUnsatisfiable: k8score.DoNotSchedule,
+			LabelSelector:     selector.DeepCopy(),
+		}
+		if spread.MaxSkew != nil {
+			constraint.MaxSkew = *spread.MaxSkew
+		}
+		if spread.WhenUnsatisfiable != "" {
+			constraint.WhenUnsatisfiable = spread.WhenUnsatisfiable
+		}
+		vmiSpec.TopologySpreadConstraints = append(vmiSpec.TopologySpreadConstraints, constraint)
+	}
+
+	for _, antiAffinity := range pool.Spec.AntiAffinity {
+		if vmiSpec.Affinity == nil {
+			vmiSpec.Affinity = &k8score.Affinity{}
+		}
+		if vmiSpec.Affinity.PodAntiAffinity == nil {
+			vmiSpec.Affinity.PodAntiAffinity = &k8score.PodAntiAffinity{}
+		}
+		term := k8score.PodAffinityTerm{
+			LabelSelector: selector.DeepCopy(),
+			TopologyKey:   antiAffinity.TopologyKey,
+		}
+		podAntiAffinity := vmiSpec.Affinity.PodAntiAffinity
+		if antiAffinity.Preferred {
+			podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+				k8score.WeightedPodAffinityTerm{Weight: 100, PodAffinityTerm: term})
+		} else {
+			podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+		}
+	}
+
+	return vm
+}
+
+// findArchitectureVariant returns the variant of the pool for the architecture, or nil
+// when the VMs of the architecture use the VM template as is
+func findArchitectureVariant(poolSpec *poolv1.VirtualMachinePoolSpec, architecture string) *poolv1.VirtualMachinePoolArchitectureVariant {
+	if architecture == "" {
+		return nil
+	}
+	for i := range poolSpec.ArchitectureVariants {
+		if poolSpec.ArchitectureVariants[i].Architecture == architecture {
+			return &poolSpec.ArchitectureVariants[i]
+		}
+	}
+	return nil
+}
+
+// applyArchitectureVariant returns a copy of the pool spec whose VM template is adapted
+// to the variant of the architecture. Volumes and data volume templates of the variant
+// replace the ones of the template with the same name.
+func applyArchitectureVariant(poolSpec *poolv1.VirtualMachinePoolSpec, architecture string) *poolv1.VirtualMachinePoolSpec {
+	variant := findArchitectureVariant(poolSpec, architecture)
+	if variant == nil {
+		return poolSpec
+	}
+
+	spec := poolSpec.DeepCopy()
+	vmSpec := &spec.VirtualMachineTemplate.Spec
+	if vmSpec.Template == nil {
+		vmSpec.Template = &virtv1.VirtualMachineInstanceTemplateSpec{}
+	}
+	vmSpec.Template.Spec.Architecture = variant.Architecture
+
+	for _, volume := range variant.Volumes {
+		for i := range vmSpec.Template.Spec.Volumes {
+			if vmSpec.Template.Spec.Volumes[i].Name == volume.Name {
+				vmSpec.Template.Spec.Volumes[i] = *volume.DeepCopy()
+			}
+		}
+	}
+	for _, dataVolume := range variant.DataVolumeTemplates {
+		for i := range vmSpec.DataVolumeTemplates {
+			if vmSpec.DataVolumeTemplates[i].Name == dataVolume.Name {
+				vmSpec.DataVolumeTemplates[i] = *dataVolume.DeepCopy()
+			}
+		}
+	}
+	if variant.Instancetype != nil {
+		vmSpec.Instancetype = variant.Instancetype.DeepCopy()
+	}
+	if variant.Preference != nil {
+		vmSpec.Preference = variant.Preference.DeepCopy()
+	}
+
+	return spec
+}
+
+// nextArchitectures picks the architectures of the next count VMs of the pool, so that the
+// VMs of each architecture variant follow the weights of the variants
+func nextArchitectures(poolSpec *poolv1.VirtualMachinePoolSpec, vms []*virtv1.VirtualMachine, count int) []string {
+	architectures := make([]string, count)
+	if len(poolSpec.ArchitectureVariants) == 0 {
+		return architectures
+	}
+
+	vmCounts := map[string]int{}
+	for _, vm := range vms {
+		vmCounts[vmArchitecture(vm)]++
+	}
+
+	for n := range architectures {
+		var next *poolv1.VirtualMachinePoolArchitectureVariant
+		for i := range poolSpec.ArchitectureVariants {
+			variant := &poolSpec.ArchitectureVariants[i]
+			if variantWeight(variant) == 0 {
+				continue
+			}
+			// pick the variant with the fewest VMs relative to its weight
+			if next == nil || vmCounts[variant.Architecture]*variantWeight(next) < vmCounts[next.Architecture]*variantWeight(variant) {
+				next = variant
+			}
+		}
+		if next == nil {
+			break
+		}
+		architectures[n] = next.Architecture
+		vmCounts[next.Architecture]++
+	}
+
+	return architectures
+}
+
+// vmArchitecture returns the architecture of the VM, which selects its architecture variant
+func vmArchitecture(vm *virtv1.VirtualMachine) string {
+	if vm.Spec.Template == nil {
+		return ""
+	}
+	return vm.Spec.Template.Spec.Architecture
+}
+
+func variantWeight(variant *poolv1.VirtualMachinePoolArchitectureVariant) int {
+	if variant.Weight == nil {
+		return 1
+	}
+	return int(*variant.Weight)
+}
+
+// isStandbyVM tells whether the VM is a standby VM of its pool
+func isStandbyVM(vm *virtv1.VirtualMachine) bool {
+	_, exists := vm.Labels[virtv1.VirtualMachinePoolStandbyLabel]
+	return exists
+}
+
+// splitStandbyVMs separates the VMs serving the replicas of the pool from its standby VMs
+func splitStandbyVMs(vms []*virtv1.VirtualMachine) (active, standby []*virtv1.VirtualMachine) {
+	for _, vm := range vms {
+		if isStandbyVM(vm) {
+			standby = append(standby, vm)
+		} else {
+			active = append(active, vm)
+		}
+	}
+	return active, standby
+}
+
+// standbyAntiAffinityTerm keeps the standby VMIs of the pool off the nodes running
+// the VMIs serving its replicas, so that a node failure never takes down both.
+func standbyAntiAffinityTerm(pool *poolv1.VirtualMachinePool) k8score.PodAffinityTerm {
+	return k8score.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{virtv1.VirtualMachinePoolNameLabel: pool.Name},
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      virtv1.VirtualMachinePoolStandbyLabel,
+				Operator: metav1.LabelSelectorOpDoesNotExist,
+			}},
+		},
+		TopologyKey: k8score.LabelHostname,
+	}
+}
+
+// injectPoolStandbyIntoVM turns the VM into a standby VM of the pool. Its VMI starts
+// paused, and on another node than the VMIs serving the replicas of the pool when the
+// pool name is a valid label value.
+func injectPoolStandbyIntoVM(vm *virtv1.VirtualMachine, pool *poolv1.VirtualMachinePool) *virtv1.VirtualMachine {
+	if vm.Labels == nil {
+		vm.Labels = map[string]string{}
+	}
+	if vm.Spec.Template.ObjectMeta.Labels == nil {
+		vm.Spec.Template.ObjectMeta.Labels = map[string]string{}
+	}
+
+	vm.Labels[virtv1.VirtualMachinePoolStandbyLabel] = "true"
+	vm.Spec.Template.ObjectMeta.Labels[virtv1.VirtualMachinePoolStandbyLabel] = "true"
+
+	vmiSpec := &vm.Spec.Template.Spec
+	vmiSpec.StartStrategy = pointer.P(virtv1.StartStrategyPaused)
+
+	if len(validation.IsValidLabelValue(pool.Name)) > 0 {
+		return vm
+	}
+
+	if vmiSpec.Affinity == nil {
+		vmiSpec.Affinity = &k8score.Affinity{}
+	}
+	if vmiSpec.Affinity.PodAntiAffinity == nil {
+		vmiSpec.Affinity.PodAntiAffinity = &k8score.PodAntiAffinity{}
+	}
+	vmiSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+		vmiSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, standbyAntiAffinityTerm(pool))
+
+	return vm
+}
+
+// promoteStandbyVM returns a copy of the standby VM serving a replica of the pool.
+// The revision labels are kept, so the promoted VM is not considered outdated.
+func promoteStandbyVM(vm *virtv1.VirtualMachine, pool *poolv1.VirtualMachinePool) *virtv1.VirtualMachine {
+	vmCopy := vm.DeepCopy()
+
+	delete(vmCopy.Labels, virtv1.VirtualMachinePoolStandbyLabel)
+	delete(vmCopy.Spec.Template.ObjectMeta.Labels, virtv1.VirtualMachinePoolStandbyLabel)
+
+	vmiSpec := &vmCopy.Spec.Template.Spec
+	vmiSpec.StartStrategy = nil
+	if pool.Spec.VirtualMachineTemplate.Spec.Template != nil {
+		vmiSpec.StartStrategy = pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.StartStrategy
+	}
+	watchutil.RemovePoolStandbyAntiAffinity(vmiSpec)
+
+	return vmCopy
+}
+
 func getRevisionName(pool *poolv1.VirtualMachinePool) string {
 	return fmt.Sprintf("%s-%d", pool.Name, pool.Generation)
 }
@@ -912,18 +1221,20 @@ func (c *Controller) getControllerRevision(namespace, name string) (*poolv1.Virt
 
 }
 
-func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error {
-
-	var wg sync.WaitGroup
-
+func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int, standby bool) error {
 	newNames := calculateNewVMNames(count, pool.Name, pool.Namespace, c.vmIndexer)
+	architectures := nextArchitectures(&pool.Spec, filterRunningVMs(vms), len(newNames))
 
 	revisionName, err := c.ensureControllerRevision(pool)
 	if err != nil {
 		return err
 	}
 
-	log.Log.Object(pool).Infof("Adding %d VMs to pool", len(newNames))
+	if standby {
+		log.Log.Object(pool).Infof("Adding %d standby VMs to pool", len(newNames))
+	} else {
+		log.Log.Object(pool).Infof("Adding %d VMs to pool", len(newNames))
+	}
 	poolKey, err := controller.KeyFunc(pool)
 	if err != nil {
 		return err
@@ -931,56 +1242,90 @@ func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error
 
 	// We have to create VMs
 	c.expectations.RaiseExpectations(poolKey, len(newNames), 0)
-	wg.Add(len(newNames))
-	errChan := make(chan error, len(newNames))
-
-	for _, name := range newNames {
-		go func(name string) {
-			defer wg.Done()
-
-			index, err := indexFromName(name)
-			if err != nil {
-				errChan <- err
-				return
-			}
-
-			vm := virtv1.NewVMReferenceFromNameWithNS(pool.Namespace, name)
+	created, err := slowStartBatch(len(newNames), slowStartInitialBatchSize, func(i int) error {
+		return c.createVM(pool, newNames[i], architectures[i], revisionName, standby)
+	})
+	// The VMs which failed or were not attempted won't be observed
+	if skipped := len(newNames) - created; skipped > 0 {
+		c.expectations.LowerExpectations(poolKey, skipped, 0)
+	}
+	if err != nil {
+		c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedCreateVirtualMachineReason, "Error creating VM: %v", err)
+		return err
+	}
 
-			vm.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
-			vm.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vm.Spec = *indexVMSpec(&pool.Spec, index)
-			vm = injectPoolRevisionLabelsIntoVM(vm, revisionName)
-			controller.AddFinalizer(vm, poolv1.VirtualMachinePoolControllerFinalizer)
+	return nil
+}
 
-			vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+func (c *Controller) createVM(pool *poolv1.VirtualMachinePool, name, architecture, revisionName string, standby bool) error {
+	index, err := indexFromName(name)
+	if err != nil {
+		return err
+	}
 
-			vm, err = c.clientset.VirtualMachine(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+	vm := virtv1.NewVMReferenceFromNameWithNS(pool.Namespace, name)
 
-			if err != nil {
-				c.expectations.CreationObserved(poolKey)
-				log.Log.Object(pool).Reason(err).Errorf("Failed to add vm %s/%s to pool", pool.Namespace, name)
-				errChan <- err
-				return
-			}
-			c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulCreateVirtualMachineReason, "Created VM %s/%s", vm.Namespace, vm.ObjectMeta.Name)
-			log.Log.Object(pool).Infof("Adding vm %s/%s to pool", pool.Namespace, name)
-		}(name)
+	vm.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
+	vm.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
+	vm.Spec = *indexVMSpec(applyArchitectureVariant(&pool.Spec, architecture), index)
+	vm = injectPoolRevisionLabelsIntoVM(vm, revisionName)
+	vm = injectPoolNameLabelsIntoVM(vm, pool.Name)
+	vm = injectPoolTopologyIntoVM(vm, pool)
+	if standby {
+		vm = injectPoolStandbyIntoVM(vm, pool)
 	}
-	wg.Wait()
+	controller.AddFinalizer(vm, poolv1.VirtualMachinePoolControllerFinalizer)
 
-	select {
-	case err := <-errChan:
-		// Only return the first error which occurred. We log the rest
-		c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedCreateVirtualMachineReason, "Error creating VM: %v", err)
+	vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+
+	vm, err = c.clientset.VirtualMachine(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+	if err != nil {
+		log.Log.Object(pool).Reason(err).Errorf("Failed to add vm %s/%s to pool", pool.Namespace, name)
 		return err
-	default:
 	}
-
+	c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulCreateVirtualMachineReason, "Created VM %s/%s", vm.Namespace, vm.ObjectMeta.Name)
+	log.Log.Object(pool).Infof("Adding vm %s/%s to pool", pool.Namespace, name)
 	return nil
 }
 
+// slowStartBatch calls fn count times with the indexes 0 to count-1, in batches doubling in size from
+// initialBatchSize. The calls of a batch run in parallel. It stops after the first batch with a failing
+// call, so that a burst of creations rejected for the same reason, e.g. timeouts of an overloaded
+// webhook, does not hit the API server all at once. It returns the number of successful calls and the
+// first error.
+func slowStartBatch(count, initialBatchSize int, fn func(index int) error) (int, error) {
+	successes := 0
+	for index, batchSize := 0, min(count, initialBatchSize); batchSize > 0; batchSize = min(2*batchSize, count-index) {
+		errChan := make(chan error, batchSize)
+		var wg sync.WaitGroup
+		wg.Add(batchSize)
+		for i := index; i < index+batchSize; i++ {
+			go func(i int) {
+				defer wg.Done()
+				if err := fn(i); err != nil {
+					errChan <- err
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		successes += batchSize - len(errChan)
+		if len(errChan) > 0 {
+			// Only return the first error which occurred. The rest are logged by fn
+			return successes, <-errChan
+		}
+		index += batchSize
+	}
+	return successes, nil
+}
+
 func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (common.SyncError, bool) {
-	diff := c.calcDiff(pool, vms)
+	surge, err := c.calcSurge(pool, vms)
+	if err != nil {
+		return common.NewSyncError(fmt.Errorf("error while calculating the surge: %v", err), FailedScaleOutReason), false
+	}
+
+	diff := c.calcDiff(pool, vms) - surge
 	if diff == 0 {
 		// if diff is 0, that means the pool is already at the desired state or someone has manually deleted the vm
 		if err := c.opportunisticScaleIn(pool, vms, isStatePreservationEnabled(resolveOpportunisticScaleInStatePreservation(pool))); err != nil {
@@ -992,7 +1337,7 @@ func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtua
 
 	maxDiff := int(math.Min(math.Abs(float64(diff)), float64(c.burstReplicas)))
 	if diff < 0 {
-		err := c.scaleOut(pool, maxDiff)
+		err := c.scaleOut(pool, vms, maxDiff, false)
 		if err != nil {
 			return common.NewSyncError(fmt.Errorf("error during scale out: %v", err), FailedScaleOutReason), false
 		}
@@ -1006,6 +1351,190 @@ func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtua
 	return nil, false
 }
 
+// scaleStandby creates and deletes standby VMs to keep the standby replicas of the pool.
+// Outdated standby VMs are deleted, to be recreated from the current VM template.
+func (c *Controller) scaleStandby(pool *poolv1.VirtualMachinePool, standbyVMs []*virtv1.VirtualMachine) (common.SyncError, bool) {
+	wantedReplicas := 0
+	if pool.Spec.Standby != nil {
+		wantedReplicas = int(pool.Spec.Standby.Replicas)
+	}
+
+	runningVMs := filterRunningVMs(standbyVMs)
+	diff := len(runningVMs) - wantedReplicas
+	if diff < 0 {
+		maxDiff := min(-diff, int(c.burstReplicas))
+		if err := c.scaleOut(pool, runningVMs, maxDiff, true); err != nil {
+			return common.NewSyncError(fmt.Errorf("error during standby scale out: %v", err), FailedScaleOutReason), false
+		}
+		return nil, false
+	}
+
+	var vmsToDelete []*virtv1.VirtualMachine
+	if pool.Spec.UpdateStrategy == nil || (pool.Spec.UpdateStrategy.Unmanaged == nil && !pool.Spec.UpdateStrategy.Paused) {
+		for _, vm := range runningVMs {
+			outdated, err := c.isOutdatedVM(pool, vm)
+			if err != nil {
+				return common.NewSyncError(fmt.Errorf("error while detecting outdated standby VMs: %v", err), FailedUpdateReason), false
+			}
+			if outdated {
+				vmsToDelete = append(vmsToDelete, vm)
+			}
+		}
+	}
+	for _, vm := range runningVMs {
+		if len(vmsToDelete) >= diff {
+			break
+		}
+		if !slices.Contains(vmsToDelete, vm) {
+			vmsToDelete = append(vmsToDelete, vm)
+		}
+	}
+	if len(vmsToDelete) == 0 {
+		return nil, true
+	}
+
+	if err := c.deleteStandbyVMs(pool, vmsToDelete[:min(len(vmsToDelete), int(c.burstReplicas))]); err != nil {
+		return common.NewSyncError(fmt.Errorf("error during standby scale in: %v", err), FailedScaleInReason), false
+	}
+	return nil, false
+}
+
+func (c *Controller) deleteStandbyVMs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) error {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+
+	for _, vm := range vms {
+		vmKey := controller.VirtualMachineKey(vm)
+		c.expectations.ExpectDeletions(poolKey, []string{vmKey})
+		if err := c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{}); err != nil {
+			c.expectations.DeletionObserved(poolKey, vmKey)
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedDeleteVirtualMachineReason, "Error deleting standby VM %s/%s: %v", vm.Namespace, vm.Name, err)
+			return err
+		}
+		if err := c.statePreservationCleanupforVM(pool, vm, false); err != nil {
+			return err
+		}
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Deleted standby VM %s/%s", vm.Namespace, vm.Name)
+	}
+
+	return nil
+}
+
+// isFailedVM tells whether the VM serves a replica of the pool which a standby VM should take over
+func (c *Controller) isFailedVM(vm *virtv1.VirtualMachine) (bool, error) {
+	if vm.DeletionTimestamp != nil {
+		return false, nil
+	}
+	if vm.Status.PrintableStatus == virtv1.VirtualMachineStatusCrashLoopBackOff {
+		return true, nil
+	}
+
+	obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return false, err
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	return vmi.DeletionTimestamp == nil && vmi.Status.Phase == virtv1.Failed, nil
+}
+
+// getStandbyVMI returns the running VMI of a standby VM, and whether it is still paused
+func (c *Controller) getStandbyVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachineInstance, bool, error) {
+	if vm.DeletionTimestamp != nil {
+		return nil, false, nil
+	}
+
+	obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return nil, false, err
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.DeletionTimestamp != nil || vmi.Status.Phase != virtv1.Running {
+		return nil, false, nil
+	}
+	paused := controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstancePaused, k8score.ConditionTrue)
+	return vmi, paused, nil
+}
+
+// failover replaces the failed VMs of the pool with its running standby VMs. A standby VM
+// which is already resumed, because a previous failover was interrupted, is promoted first.
+// It returns false when VMs were replaced, the pool has to be observed again before scaling.
+func (c *Controller) failover(pool *poolv1.VirtualMachinePool, vms, standbyVMs []*virtv1.VirtualMachine) (common.SyncError, bool) {
+	var resumedVMs, pausedVMs []*virtv1.VirtualMachine
+	for _, vm := range standbyVMs {
+		vmi, paused, err := c.getStandbyVMI(vm)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("error while detecting ready standby VMs: %v", err), FailedFailoverReason), false
+		}
+		if vmi == nil {
+			continue
+		}
+		if paused {
+			pausedVMs = append(pausedVMs, vm)
+		} else {
+			resumedVMs = append(resumedVMs, vm)
+		}
+	}
+	readyVMs := append(resumedVMs, pausedVMs...)
+	if len(readyVMs) == 0 {
+		return nil, true
+	}
+
+	var failedVMs []*virtv1.VirtualMachine
+	for _, vm := range vms {
+		failed, err := c.isFailedVM(vm)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("error while detecting failed VMs: %v", err), FailedFailoverReason), false
+		}
+		if failed {
+			failedVMs = append(failedVMs, vm)
+		}
+	}
+
+	count := min(len(failedVMs), len(readyVMs))
+	for i := 0; i < count; i++ {
+		if err := c.replaceFailedVM(pool, failedVMs[i], readyVMs[i], !slices.Contains(resumedVMs, readyVMs[i])); err != nil {
+			return common.NewSyncError(fmt.Errorf("error during failover: %v", err), FailedFailoverReason), false
+		}
+	}
+
+	return nil, count == 0
+}
+
+func (c *Controller) replaceFailedVM(pool *poolv1.VirtualMachinePool, failedVM, standbyVM *virtv1.VirtualMachine, paused bool) error {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+
+	if paused {
+		if err := c.clientset.VirtualMachineInstance(standbyVM.Namespace).Unpause(context.Background(), standbyVM.Name, &virtv1.UnpauseOptions{}); err != nil {
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedFailoverReason, "Error resuming standby VM %s/%s: %v", standbyVM.Namespace, standbyVM.Name, err)
+			return err
+		}
+	}
+
+	if _, err := c.clientset.VirtualMachine(standbyVM.Namespace).Update(context.Background(), promoteStandbyVM(standbyVM, pool), metav1.UpdateOptions{}); err != nil {
+		c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedFailoverReason, "Error promoting standby VM %s/%s: %v", standbyVM.Namespace, standbyVM.Name, err)
+		return err
+	}
+
+	vmKey := controller.VirtualMachineKey(failedVM)
+	c.expectations.ExpectDeletions(poolKey, []string{vmKey})
+	if err := c.clientset.VirtualMachine(failedVM.Namespace).Delete(context.Background(), failedVM.Name, metav1.DeleteOptions{}); err != nil {
+		c.expectations.DeletionObserved(poolKey, vmKey)
+		c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedDeleteVirtualMachineReason, "Error deleting failed VM %s/%s: %v", failedVM.Namespace, failedVM.Name, err)
+		return err
+	}
+	if err := c.statePreservationCleanupforVM(pool, failedVM, isStatePreservationEnabled(resolveProactiveScaleInStatePreservation(pool))); err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulFailoverReason, "Replaced failed VM %s/%s with standby VM %s/%s", failedVM.Namespace, failedVM.Name, standbyVM.Namespace, standbyVM.Name)
+	return nil
+}
+
 func isVMIReady(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi.DeletionTimestamp != nil || vmi.Status.Phase != virtv1.Running {
 		return false
@@ -1085,8 +1614,10 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 
 			vmCopy.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vmCopy.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vmCopy.Spec = *indexVMSpec(&pool.Spec, index)
+			vmCopy.Spec = *indexVMSpec(applyArchitectureVariant(&pool.Spec, vmArchitecture(vm)), index)
 			vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, revisionName)
+			vmCopy = injectPoolNameLabelsIntoVM(vmCopy, pool.Name)
+			vmCopy = injectPoolTopologyIntoVM(vmCopy, pool)
 
 			// Preserve VM identity/specific fields that were set during VM creation
 			preserveVMIdentityFields(vm, vmCopy)
@@ -1191,6 +1722,7 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 			return err
 		}
 
+		updateType = resolveProactiveUpdateTypeForMethod(pool, vm, updateType)
 		if updateType == proactiveUpdateTypeNone {
 			continue
 		}
@@ -1203,6 +1735,76 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 	return nil
 }
 
+// resolveProactiveUpdateTypeForMethod adjusts the restart of an outdated VMI to the
+// proactive update method of the pool.
+func resolveProactiveUpdateTypeForMethod(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, updateType proactiveUpdateType) proactiveUpdateType {
+	if updateType != proactiveUpdateTypeRestart {
+		return updateType
+	}
+
+	switch resolveProactiveUpdateMethod(pool) {
+	case poolv1.VirtualMachinePoolProactiveUpdateMethodLiveUpdate:
+		// The VM controller live updates the VMI, only restart it when this is not possible
+		if !controller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
+			return proactiveUpdateTypeNone
+		}
+	case poolv1.VirtualMachinePoolProactiveUpdateMethodReplace:
+		return proactiveUpdateTypeVMDelete
+	}
+
+	return updateType
+}
+
+// replaceOutdatedVMs deletes the outdated VMs of a pool using the Replace update method,
+// so that they get recreated from the current template. The number of deleted VMs is
+// bound by maxUnavailable, VMs created above the desired replicas by maxSurge are
+// accounted as available capacity.
+func (c *Controller) replaceOutdatedVMs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, vmOutdatedList []*virtv1.VirtualMachine) (int, error) {
+	maxUnavailableInt, err := calculateMaxUnavailableInt(pool)
+	if err != nil {
+		return 0, err
+	}
+	unavailableCount, err := c.getUnavailableVMICount(vms)
+	if err != nil {
+		return 0, err
+	}
+
+	wantedReplicas := int32(1)
+	if pool.Spec.Replicas != nil {
+		wantedReplicas = *pool.Spec.Replicas
+	}
+	surplus := max(len(vms)-int(wantedReplicas), 0)
+
+	if pool.Spec.UpdateStrategy != nil && pool.Spec.UpdateStrategy.Proactive != nil {
+		sortPolicy := resolveSortPolicyForUpdate(pool.Spec.UpdateStrategy.Proactive)
+		sortVMsBasedOnSortPolicy(vmOutdatedList, sortPolicy)
+	}
+
+	replaced := 0
+	maxReplaceable := maxUnavailableInt - unavailableCount + surplus
+	for _, vm := range vmOutdatedList {
+		if vm.DeletionTimestamp != nil {
+			continue
+		}
+		if maxReplaceable <= 0 {
+			log.Log.V(4).Infof("Delaying replacement of outdated VMs for pool %s/%s - max unavailable (%d) reached", pool.Namespace, pool.Name, maxUnavailableInt)
+			key, err := controller.KeyFunc(pool)
+			if err != nil {
+				return replaced, err
+			}
+			c.queue.AddAfter(key, defaultRetryDelay)
+			return replaced, nil
+		}
+
+		if err := c.handleResourceUpdate(pool, vm, nil, proactiveUpdateTypeVMDelete); err != nil {
+			return replaced, err
+		}
+		replaced++
+		maxReplaceable--
+	}
+	return replaced, nil
+}
+
 type proactiveUpdateType string
 
 const (
@@ -1268,6 +1870,7 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 		// get created in a future reconcile loop and we'll be able to process the VMI.
 		return proactiveUpdateTypeNone, nil
 	}
+	poolSpecRevisionForVM = applyArchitectureVariant(poolSpecRevisionForVM, vmArchitecture(vm))
 	expectedVMITemplate := poolSpecRevisionForVM.VirtualMachineTemplate.Spec.Template
 	expectedDataVolumeTemplates := poolSpecRevisionForVM.VirtualMachineTemplate.Spec.DataVolumeTemplates
 
@@ -1281,6 +1884,7 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 		log.Log.Infof("Marking vmi %s/%s for update due to missing revision", vm.Namespace, vm.Name)
 		return proactiveUpdateTypeRestart, nil
 	}
+	poolSpecRevisionForVMI = applyArchitectureVariant(poolSpecRevisionForVMI, vmi.Spec.Architecture)
 	currentVMITemplate := poolSpecRevisionForVMI.VirtualMachineTemplate.Spec.Template
 	currentDataVolumeTemplates := poolSpecRevisionForVMI.VirtualMachineTemplate.Spec.DataVolumeTemplates
 
@@ -1329,6 +1933,18 @@ func (c *Controller) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.Vi
 		return true, nil
 	}
 
+	if !equality.Semantic.DeepEqual(oldPoolSpec.TopologySpread, pool.Spec.TopologySpread) ||
+		!equality.Semantic.DeepEqual(oldPoolSpec.AntiAffinity, pool.Spec.AntiAffinity) {
+		log.Log.Object(pool).Infof("Marking vm %s/%s for update due out of date topology rules", vm.Namespace, vm.Name)
+		return true, nil
+	}
+
+	architecture := vmArchitecture(vm)
+	if !equality.Semantic.DeepEqual(findArchitectureVariant(oldPoolSpec, architecture), findArchitectureVariant(&pool.Spec, architecture)) {
+		log.Log.Object(pool).Infof("Marking vm %s/%s for update due out of date architecture variant", vm.Namespace, vm.Name)
+		return true, nil
+	}
+
 	return false, nil
 
 }
@@ -1388,6 +2004,11 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		return nil, true
 	}
 
+	if isUpdatePaused(pool) {
+		log.Log.V(4).Infof("update of pool %s/%s is paused, skipping update", pool.Namespace, pool.Name)
+		return nil, true
+	}
+
 	var err error
 	filteredVms := slices.Clone(vms)
 
@@ -1415,6 +2036,23 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		}
 	}
 
+	vmUpdateStable := len(vmOutdatedList) == 0
+
+	// Replace outdated VMs instead of updating them in place
+	if !isOpportunisticUpdate(pool) && resolveProactiveUpdateMethod(pool) == poolv1.VirtualMachinePoolProactiveUpdateMethodReplace {
+		replaced, err := c.replaceOutdatedVMs(pool, vms, vmOutdatedList)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("error during VM replacement: %v", err), FailedUpdateReason), false
+		}
+		// Only restart outdated VMIs once the replaced VMs are accounted by the next sync
+		if replaced == 0 {
+			if err = c.proactiveUpdate(pool, vmUpdatedList); err != nil {
+				return common.NewSyncError(fmt.Errorf("error during VMI update: %v", err), FailedUpdateReason), false
+			}
+		}
+		return nil, vmUpdateStable
+	}
+
 	// Always perform opportunistic updates
 	if err = c.opportunisticUpdate(pool, vmOutdatedList); err != nil {
 		return common.NewSyncError(fmt.Errorf("error during VM update: %v", err), FailedUpdateReason), false
@@ -1427,11 +2065,6 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		}
 	}
 
-	vmUpdateStable := false
-	if len(vmOutdatedList) == 0 {
-		vmUpdateStable = true
-	}
-
 	return nil, vmUpdateStable
 }
 
@@ -1460,7 +2093,7 @@ func (c *Controller) Execute() bool {
 	return true
 }
 
-func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, syncErr common.SyncError) error {
+func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms, standbyVMs []*virtv1.VirtualMachine, syncErr common.SyncError) error {
 
 	key, err := controller.KeyFunc(origPool)
 	if err != nil {
@@ -1509,8 +2142,39 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulResumePoolReason, "Pool is unpaused")
 	}
 
+	if isUpdatePaused(pool) && !cm.HasCondition(pool, poolv1.VirtualMachinePoolUpdatePaused) {
+		cm.UpdateCondition(pool,
+			&poolv1.VirtualMachinePoolCondition{
+				Type:               poolv1.VirtualMachinePoolUpdatePaused,
+				Reason:             SuccessfulPausedUpdateReason,
+				Message:            "Pool update is paused",
+				LastTransitionTime: metav1.Now(),
+				Status:             k8score.ConditionTrue,
+			})
+
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulPausedUpdateReason, "Pool update is paused")
+	} else if !isUpdatePaused(pool) && cm.HasCondition(pool, poolv1.VirtualMachinePoolUpdatePaused) {
+		cm.RemoveCondition(pool, poolv1.VirtualMachinePoolUpdatePaused)
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulResumeUpdateReason, "Pool update is resumed")
+	}
+
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	pool.Status.TopologySpread, err = c.calcTopologySpread(pool, vms)
+	if err != nil {
+		return err
+	}
+	pool.Status.StandbyReplicas = int32(len(standbyVMs))
+	pool.Status.ReadyStandbyReplicas = 0
+	for _, vm := range standbyVMs {
+		vmi, _, err := c.getStandbyVMI(vm)
+		if err != nil {
+			return err
+		}
+		if vmi != nil {
+			pool.Status.ReadyStandbyReplicas++
+		}
+	}
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		_, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
@@ -1522,6 +2186,97 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 	return nil
 }
 
+// topologyKeys returns the distinct topology keys of the spread and anti-affinity rules of the pool
+func topologyKeys(pool *poolv1.VirtualMachinePool) []string {
+	var keys []string
+	for _, spread := range pool.Spec.TopologySpread {
+		if !slices.Contains(keys, spread.TopologyKey) {
+			keys = append(keys, spread.TopologyKey)
+		}
+	}
+	for _, antiAffinity := range pool.Spec.AntiAffinity {
+		if !slices.Contains(keys, antiAffinity.TopologyKey) {
+			keys = append(keys, antiAffinity.TopologyKey)
+		}
+	}
+	return keys
+}
+
+// calcTopologySpread counts the running VMIs of the pool in each domain of the topology keys
+// of the pool. The skew of a topology also accounts for the domains of nodes without VMIs
+// of the pool, as the scheduler does.
+func (c *Controller) calcTopologySpread(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) ([]poolv1.VirtualMachinePoolTopologySpreadStatus, error) {
+	keys := topologyKeys(pool)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	replicas := map[string]map[string]int32{}
+	for _, key := range keys {
+		replicas[key] = map[string]int32{}
+	}
+
+	for _, node := range c.nodeStore.List() {
+		node := node.(*k8score.Node)
+		for _, key := range keys {
+			if domain, exists := node.Labels[key]; exists {
+				if _, counted := replicas[key][domain]; !counted {
+					replicas[key][domain] = 0
+				}
+			}
+		}
+	}
+
+	for _, vm := range vms {
+		obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.Status.Phase != virtv1.Running || vmi.Status.NodeName == "" {
+			continue
+		}
+
+		obj, exists, err = c.nodeStore.GetByKey(vmi.Status.NodeName)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		node := obj.(*k8score.Node)
+		for _, key := range keys {
+			if domain, exists := node.Labels[key]; exists {
+				replicas[key][domain]++
+			}
+		}
+	}
+
+	spread := make([]poolv1.VirtualMachinePoolTopologySpreadStatus, 0, len(keys))
+	for _, key := range keys {
+		status := poolv1.VirtualMachinePoolTopologySpreadStatus{TopologyKey: key}
+		if len(replicas[key]) > 0 {
+			minReplicas, maxReplicas := int32(math.MaxInt32), int32(0)
+			for _, count := range replicas[key] {
+				minReplicas = min(minReplicas, count)
+				maxReplicas = max(maxReplicas, count)
+			}
+			status.Skew = maxReplicas - minReplicas
+		}
+		for _, domain := range slices.Sorted(maps.Keys(replicas[key])) {
+			if replicas[key][domain] > 0 {
+				status.Domains = append(status.Domains, poolv1.VirtualMachinePoolTopologyDomain{Name: domain, Replicas: replicas[key][domain]})
+			}
+		}
+		spread = append(spread, status)
+	}
+
+	return spread, nil
+}
+
 func (c *Controller) execute(key string) error {
 	logger := log.DefaultLogger()
 
@@ -1582,6 +2337,7 @@ func (c *Controller) execute(key string) error {
 	if err != nil {
 		return err
 	}
+	vms, standbyVMs := splitStandbyVMs(vms)
 
 	if pool.DeletionTimestamp == nil {
 		if err := c.addPoolFinalizer(pool); err != nil {
@@ -1594,9 +2350,24 @@ func (c *Controller) execute(key string) error {
 		scaleIsStable := false
 		updateIsStable := false
 
-		syncErr, scaleIsStable = c.scale(pool, vms)
+		syncErr, scaleIsStable = c.failover(pool, vms, standbyVMs)
 		if syncErr != nil {
-			logger.Reason(err).Error("Scaling the pool failed.")
+			logger.Reason(err).Error("Failing over to standby VMs failed.")
+		}
+
+		if syncErr == nil && scaleIsStable {
+			syncErr, scaleIsStable = c.scale(pool, vms)
+			if syncErr != nil {
+				logger.Reason(err).Error("Scaling the pool failed.")
+			}
+		}
+
+		needsSync = c.expectations.SatisfiedExpectations(key)
+		if needsSync && scaleIsStable && syncErr == nil {
+			syncErr, scaleIsStable = c.scaleStandby(pool, standbyVMs)
+			if syncErr != nil {
+				logger.Reason(err).Error("Scaling the standby VMs of the pool failed.")
+			}
 		}
 
 		needsSync = c.expectations.SatisfiedExpectations(key)
@@ -1608,7 +2379,7 @@ func (c *Controller) execute(key string) error {
 		needsSync = c.expectations.SatisfiedExpectations(key)
 		if needsSync && syncErr == nil && scaleIsStable && updateIsStable {
 			// handle pruning revisions after scale and update operations are satisfied
-			syncErr = c.pruneUnusedRevisions(pool, vms)
+			syncErr = c.pruneUnusedRevisions(pool, append(vms, standbyVMs...))
 		}
 		virtControllerPoolWorkQueueTracer.StepTrace(key, "sync", trace.Field{Key: "VMPool Name", Value: pool.Name})
 	} else if pool.DeletionTimestamp != nil {
@@ -1616,10 +2387,10 @@ func (c *Controller) execute(key string) error {
 			return err
 		}
 
-		syncErr = c.pruneUnusedRevisions(pool, vms)
+		syncErr = c.pruneUnusedRevisions(pool, append(vms, standbyVMs...))
 	}
 
-	err = c.updateStatus(pool, vms, syncErr)
+	err = c.updateStatus(pool, vms, standbyVMs, syncErr)
 	if err != nil {
 		return err
 	}
@@ -1636,7 +2407,7 @@ func (c *Controller) handleResourceUpdate(pool *poolv1.VirtualMachinePool, vm *v
 		log.Log.Object(pool).Infof("Proactive update of VM %s/%s by deleting outdated VMI", vm.Namespace, vm.Name)
 	case proactiveUpdateTypeVMDelete:
 		err = c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{PropagationPolicy: pointer.P(metav1.DeletePropagationForeground)})
-		log.Log.Object(pool).Infof("Proactive update of VM %s/%s by deleting VM due to data volume changes", vm.Namespace, vm.Name)
+		log.Log.Object(pool).Infof("Proactive update of VM %s/%s by deleting VM", vm.Namespace, vm.Name)
 	case proactiveUpdateTypePatchRevisionLabel:
 		patchSet := patch.New()
 		vmiLabels := maps.Clone(vmi.Labels)
@@ -1974,6 +2745,17 @@ func isOpportunisticUpdate(pool *poolv1.VirtualMachinePool) bool {
 	return pool.Spec.UpdateStrategy != nil && pool.Spec.UpdateStrategy.Opportunistic != nil
 }
 
+func isUpdatePaused(pool *poolv1.VirtualMachinePool) bool {
+	return pool.Spec.UpdateStrategy != nil && pool.Spec.UpdateStrategy.Paused
+}
+
+func resolveProactiveUpdateMethod(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolProactiveUpdateMethod {
+	if pool.Spec.UpdateStrategy == nil || pool.Spec.UpdateStrategy.Proactive == nil || pool.Spec.UpdateStrategy.Proactive.Method == nil {
+		return poolv1.VirtualMachinePoolProactiveUpdateMethodRestart
+	}
+	return *pool.Spec.UpdateStrategy.Proactive.Method
+}
+
 func isAutohealingEnabled(pool *poolv1.VirtualMachinePool) bool {
 	return pool.Spec.Autohealing != nil
 }
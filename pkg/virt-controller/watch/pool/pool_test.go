@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -141,6 +142,7 @@ var _ = Describe("Pool", func() {
 			poolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
 			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 			dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
 
@@ -163,6 +165,7 @@ var _ = Describe("Pool", func() {
 				pvcInformer,
 				dvInformer,
 				crInformer,
+				nodeInformer,
 				recorder,
 				uint(10))
 			// Wrap our workqueue to have a way to detect when we are done processing updates
@@ -378,6 +381,359 @@ var _ = Describe("Pool", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should label the created VMs and their VMIs with the pool name", func() {
+			pool, _ := DefaultPool(1)
+
+			addPool(pool)
+
+			sanityExecute()
+
+			vms, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vms.Items).To(HaveLen(1))
+			Expect(vms.Items[0].Labels).To(HaveKeyWithValue(v1.VirtualMachinePoolNameLabel, pool.Name))
+			Expect(vms.Items[0].Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue(v1.VirtualMachinePoolNameLabel, pool.Name))
+		})
+
+		It("should add the topology spread and anti-affinity rules of the pool to the created VMs", func() {
+			pool, _ := DefaultPool(1)
+			pool.Spec.TopologySpread = []poolv1.VirtualMachinePoolTopologySpread{
+				{TopologyKey: k8sv1.LabelTopologyZone},
+				{TopologyKey: "rack", MaxSkew: pointer.P(int32(2)), WhenUnsatisfiable: k8sv1.ScheduleAnyway},
+			}
+			pool.Spec.AntiAffinity = []poolv1.VirtualMachinePoolAntiAffinity{
+				{TopologyKey: k8sv1.LabelHostname},
+				{TopologyKey: k8sv1.LabelTopologyZone, Preferred: true},
+			}
+
+			addPool(pool)
+
+			sanityExecute()
+
+			vms, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vms.Items).To(HaveLen(1))
+
+			selector := &metav1.LabelSelector{MatchLabels: map[string]string{v1.VirtualMachinePoolNameLabel: pool.Name}}
+			vmiSpec := vms.Items[0].Spec.Template.Spec
+			Expect(vmiSpec.TopologySpreadConstraints).To(Equal([]k8sv1.TopologySpreadConstraint{
+				{MaxSkew: 1, TopologyKey: k8sv1.LabelTopologyZone, WhenUnsatisfiable: k8sv1.DoNotSchedule, LabelSelector: selector},
+				{MaxSkew: 2, TopologyKey: "rack", WhenUnsatisfiable: k8sv1.ScheduleAnyway, LabelSelector: selector},
+			}))
+			Expect(vmiSpec.Affinity).ToNot(BeNil())
+			Expect(vmiSpec.Affinity.PodAntiAffinity).To(Equal(&k8sv1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []k8sv1.PodAffinityTerm{
+					{LabelSelector: selector, TopologyKey: k8sv1.LabelHostname},
+				},
+				PreferredDuringSchedulingIgnoredDuringExecution: []k8sv1.WeightedPodAffinityTerm{
+					{Weight: 100, PodAffinityTerm: k8sv1.PodAffinityTerm{LabelSelector: selector, TopologyKey: k8sv1.LabelTopologyZone}},
+				},
+			}))
+		})
+
+		It("should update the VMs when the topology rules of the pool change", func() {
+			pool, vm := DefaultPool(1)
+			pool.Status.Replicas = 1
+			pool.Status.ReadyReplicas = 1
+			poolRevision := createPoolRevision(pool)
+
+			pool.Generation = 123
+			pool.Spec.AntiAffinity = []poolv1.VirtualMachinePoolAntiAffinity{{TopologyKey: k8sv1.LabelHostname}}
+			addPool(pool)
+
+			createVMsWithOrdinal(pool, 1, poolRevision, poolRevision, vm)
+			addCR(poolRevision)
+
+			sanityExecute()
+
+			vm, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).Get(context.TODO(), fmt.Sprintf("%s-0", pool.Name), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Labels).To(HaveKeyWithValue(v1.VirtualMachinePoolRevisionName, getRevisionName(pool)))
+			Expect(vm.Spec.Template.Spec.Affinity).ToNot(BeNil())
+			Expect(vm.Spec.Template.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(HaveLen(1))
+		})
+
+		It("should report the spread of the running VMs across the topology domains", func() {
+			pool, vm := DefaultPool(3)
+			pool.Spec.TopologySpread = []poolv1.VirtualMachinePoolTopologySpread{{TopologyKey: k8sv1.LabelTopologyZone}}
+			pool.Spec.AntiAffinity = []poolv1.VirtualMachinePoolAntiAffinity{
+				{TopologyKey: k8sv1.LabelHostname},
+				{TopologyKey: k8sv1.LabelTopologyZone},
+			}
+			pool.Status.Replicas = 3
+			pool.Status.ReadyReplicas = 3
+			poolRevision := createPoolRevision(pool)
+			addPool(pool)
+			addCR(poolRevision)
+			createVMsWithOrdinal(pool, 3, poolRevision, poolRevision, vm)
+
+			for i, zone := range []string{"zone-a", "zone-a", "zone-b", "zone-c"} {
+				Expect(controller.nodeStore.Add(&k8sv1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: fmt.Sprintf("node%d", i),
+						Labels: map[string]string{
+							k8sv1.LabelHostname:     fmt.Sprintf("node%d", i),
+							k8sv1.LabelTopologyZone: zone,
+						},
+					},
+				})).To(Succeed())
+			}
+			for i := range 3 {
+				obj, exists, err := controller.vmiStore.GetByKey(fmt.Sprintf("%s/%s-%d", pool.Namespace, pool.Name, i))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				vmi := obj.(*v1.VirtualMachineInstance)
+				vmi.Status.NodeName = fmt.Sprintf("node%d", i)
+				Expect(controller.vmiStore.Update(vmi)).To(Succeed())
+			}
+
+			sanityExecute()
+
+			vmpool, err := fakeVirtClient.PoolV1beta1().VirtualMachinePools(pool.Namespace).Get(context.TODO(), pool.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmpool.Status.TopologySpread).To(Equal([]poolv1.VirtualMachinePoolTopologySpreadStatus{
+				{
+					TopologyKey: k8sv1.LabelTopologyZone,
+					Domains: []poolv1.VirtualMachinePoolTopologyDomain{
+						{Name: "zone-a", Replicas: 2},
+						{Name: "zone-b", Replicas: 1},
+					},
+					Skew: 2,
+				},
+				{
+					TopologyKey: k8sv1.LabelHostname,
+					Domains: []poolv1.VirtualMachinePoolTopologyDomain{
+						{Name: "node0", Replicas: 1},
+						{Name: "node1", Replicas: 1},
+						{Name: "node2", Replicas: 1},
+					},
+					Skew: 1,
+				},
+			}))
+		})
+
+		Context("with standby VMs", func() {
+			makeStandby := func(pool *poolv1.VirtualMachinePool, name string, paused bool) {
+				obj, exists, err := controller.vmIndexer.GetByKey(fmt.Sprintf("%s/%s", pool.Namespace, name))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				vm := injectPoolStandbyIntoVM(obj.(*v1.VirtualMachine).DeepCopy(), pool)
+				_, err = fakeVirtClient.KubevirtV1().VirtualMachines(vm.Namespace).Update(context.TODO(), vm, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.vmIndexer.Update(vm)).To(Succeed())
+
+				obj, exists, err = controller.vmiStore.GetByKey(fmt.Sprintf("%s/%s", pool.Namespace, name))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				vmi := obj.(*v1.VirtualMachineInstance)
+				if paused {
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstancePaused,
+						Status: k8sv1.ConditionTrue,
+					})
+				}
+				Expect(controller.vmiStore.Update(vmi)).To(Succeed())
+			}
+
+			It("should create the missing standby VMs", func() {
+				pool, vm := DefaultPool(1)
+				pool.Spec.Standby = &poolv1.VirtualMachinePoolStandby{Replicas: 2}
+				pool.Status.Replicas = 1
+				pool.Status.ReadyReplicas = 1
+				poolRevision := createPoolRevision(pool)
+				addPool(pool)
+				addCR(poolRevision)
+				createVMsWithOrdinal(pool, 1, poolRevision, poolRevision, vm)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				vms, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).List(context.TODO(), metav1.ListOptions{
+					LabelSelector: v1.VirtualMachinePoolStandbyLabel,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vms.Items).To(HaveLen(2))
+				for _, standbyVM := range vms.Items {
+					Expect(standbyVM.Spec.Template.ObjectMeta.Labels).To(HaveKey(v1.VirtualMachinePoolStandbyLabel))
+					Expect(standbyVM.Spec.Template.Spec.StartStrategy).To(HaveValue(Equal(v1.StartStrategyPaused)))
+					Expect(standbyVM.Spec.Template.Spec.Affinity).ToNot(BeNil())
+					Expect(standbyVM.Spec.Template.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(
+						ConsistOf(standbyAntiAffinityTerm(pool)))
+				}
+			})
+
+			It("should report the standby VMs", func() {
+				pool, vm := DefaultPool(1)
+				pool.Spec.Standby = &poolv1.VirtualMachinePoolStandby{Replicas: 2}
+				poolRevision := createPoolRevision(pool)
+				addPool(pool)
+				addCR(poolRevision)
+				createVMsWithOrdinal(pool, 3, poolRevision, poolRevision, vm)
+				makeStandby(pool, fmt.Sprintf("%s-1", pool.Name), true)
+				makeStandby(pool, fmt.Sprintf("%s-2", pool.Name), true)
+
+				obj, _, err := controller.vmiStore.GetByKey(fmt.Sprintf("%s/%s-2", pool.Namespace, pool.Name))
+				Expect(err).ToNot(HaveOccurred())
+				vmi := obj.(*v1.VirtualMachineInstance)
+				vmi.Status.Phase = v1.Scheduling
+				Expect(controller.vmiStore.Update(vmi)).To(Succeed())
+
+				sanityExecute()
+
+				vmpool, err := fakeVirtClient.PoolV1beta1().VirtualMachinePools(pool.Namespace).Get(context.TODO(), pool.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmpool.Status.Replicas).To(Equal(int32(1)))
+				Expect(vmpool.Status.StandbyReplicas).To(Equal(int32(2)))
+				Expect(vmpool.Status.ReadyStandbyReplicas).To(Equal(int32(1)))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(3))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+			})
+
+			It("should delete the standby VMs above the standby replicas", func() {
+				pool, vm := DefaultPool(1)
+				poolRevision := createPoolRevision(pool)
+				addPool(pool)
+				addCR(poolRevision)
+				createVMsWithOrdinal(pool, 2, poolRevision, poolRevision, vm)
+				makeStandby(pool, fmt.Sprintf("%s-1", pool.Name), true)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				_, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).Get(context.TODO(), fmt.Sprintf("%s-1", pool.Name), metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound"))
+				_, err = fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).Get(context.TODO(), fmt.Sprintf("%s-0", pool.Name), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should replace a failed VM with a paused standby VM", func() {
+				pool, vm := DefaultPool(1)
+				pool.Spec.Standby = &poolv1.VirtualMachinePoolStandby{Replicas: 1}
+				poolRevision := createPoolRevision(pool)
+				addPool(pool)
+				addCR(poolRevision)
+				createVMsWithOrdinal(pool, 2, poolRevision, poolRevision, vm)
+				makeStandby(pool, fmt.Sprintf("%s-1", pool.Name), true)
+
+				obj, _, err := controller.vmiStore.GetByKey(fmt.Sprintf("%s/%s-0", pool.Namespace, pool.Name))
+				Expect(err).ToNot(HaveOccurred())
+				vmi := obj.(*v1.VirtualMachineInstance)
+				vmi.Status.Phase = v1.Failed
+				Expect(controller.vmiStore.Update(vmi)).To(Succeed())
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulFailoverReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "put", "virtualmachineinstances")).To(HaveLen(1))
+				_, err = fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).Get(context.TODO(), fmt.Sprintf("%s-0", pool.Name), metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound"))
+
+				promotedVM, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).Get(context.TODO(), fmt.Sprintf("%s-1", pool.Name), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(promotedVM.Labels).ToNot(HaveKey(v1.VirtualMachinePoolStandbyLabel))
+				Expect(promotedVM.Labels).To(HaveKeyWithValue(v1.VirtualMachinePoolRevisionName, poolRevision.Name))
+				Expect(promotedVM.Spec.Template.ObjectMeta.Labels).ToNot(HaveKey(v1.VirtualMachinePoolStandbyLabel))
+				Expect(promotedVM.Spec.Template.Spec.StartStrategy).To(BeNil())
+				Expect(promotedVM.Spec.Template.Spec.Affinity).To(BeNil())
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(2))
+			})
+
+			It("should not replace a failed VM without a running standby VM", func() {
+				pool, vm := DefaultPool(1)
+				pool.Spec.Standby = &poolv1.VirtualMachinePoolStandby{Replicas: 1}
+				poolRevision := createPoolRevision(pool)
+				addPool(pool)
+				addCR(poolRevision)
+				createVMsWithOrdinal(pool, 2, poolRevision, poolRevision, vm)
+				makeStandby(pool, fmt.Sprintf("%s-1", pool.Name), false)
+
+				for i := range 2 {
+					obj, _, err := controller.vmiStore.GetByKey(fmt.Sprintf("%s/%s-%d", pool.Namespace, pool.Name, i))
+					Expect(err).ToNot(HaveOccurred())
+					vmi := obj.(*v1.VirtualMachineInstance)
+					vmi.Status.Phase = v1.Failed
+					Expect(controller.vmiStore.Update(vmi)).To(Succeed())
+				}
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "put", "virtualmachineinstances")).To(BeEmpty())
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+			})
+		})
+
+		Context("with architecture variants", func() {
+			amdDisk := v1.Volume{
+				Name: "disk0",
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{Image: "registry:5000/kubevirt/cirros-container-disk-demo:amd64"},
+				},
+			}
+			armDisk := v1.Volume{
+				Name: "disk0",
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{Image: "registry:5000/kubevirt/cirros-container-disk-demo:arm64"},
+				},
+			}
+
+			It("should create the VMs of each architecture following the variant weights", func() {
+				pool, _ := DefaultPool(3)
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes = []v1.Volume{amdDisk}
+				pool.Spec.ArchitectureVariants = []poolv1.VirtualMachinePoolArchitectureVariant{
+					{Architecture: "amd64"},
+					{Architecture: "arm64", Weight: pointer.P(int32(2)), Volumes: []v1.Volume{armDisk}},
+				}
+				addPool(pool)
+				createPoolRevision(pool)
+
+				sanityExecute()
+
+				for range 3 {
+					testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				}
+				vms, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).List(context.TODO(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vms.Items).To(HaveLen(3))
+
+				vmsPerArchitecture := map[string]int{}
+				for _, vm := range vms.Items {
+					vmsPerArchitecture[vm.Spec.Template.Spec.Architecture]++
+					if vm.Spec.Template.Spec.Architecture == "arm64" {
+						Expect(vm.Spec.Template.Spec.Volumes).To(ConsistOf(armDisk))
+					} else {
+						Expect(vm.Spec.Template.Spec.Volumes).To(ConsistOf(amdDisk))
+					}
+				}
+				Expect(vmsPerArchitecture).To(Equal(map[string]int{"amd64": 1, "arm64": 2}))
+			})
+
+			It("should mark the VMs outdated when their architecture variant changes", func() {
+				pool, vm := DefaultPool(2)
+				pool.Spec.ArchitectureVariants = []poolv1.VirtualMachinePoolArchitectureVariant{
+					{Architecture: "amd64"},
+					{Architecture: "arm64"},
+				}
+				poolRevision := createPoolRevision(pool)
+				addCR(poolRevision)
+
+				amdVM := vm.DeepCopy()
+				amdVM.Labels = map[string]string{v1.VirtualMachinePoolRevisionName: poolRevision.Name}
+				amdVM.Spec.Template.Spec.Architecture = "amd64"
+				armVM := amdVM.DeepCopy()
+				armVM.Spec.Template.Spec.Architecture = "arm64"
+
+				pool.Spec.ArchitectureVariants[1].Volumes = []v1.Volume{armDisk}
+
+				outdated, err := controller.isOutdatedVM(pool, amdVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outdated).To(BeFalse())
+				outdated, err = controller.isOutdatedVM(pool, armVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outdated).To(BeTrue())
+			})
+		})
+
 		It("should not create missing VMs when it is paused and add paused condition", func() {
 			pool, _ := DefaultPool(3)
 			pool.Spec.Paused = true
@@ -417,7 +773,31 @@ var _ = Describe("Pool", func() {
 
 			testutils.ExpectEvent(recorder, common.FailedCreateVirtualMachineReason)
 			testutils.ExpectEvent(recorder, FailedScaleOutReason)
-			Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(3))
+			// The first batch failed, the remaining VMs are not attempted
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(1))
+		})
+
+		It("should create VMs in batches of growing size and stop after a failing batch", func() {
+			pool, _ := DefaultPool(10)
+
+			addPool(pool)
+
+			var creations atomic.Int32
+			fakeVirtClient.Fake.PrependReactor("create", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				// Fail one VM of the third batch
+				if creations.Add(1) == 5 {
+					return true, &v1.VirtualMachine{}, fmt.Errorf("webhook timeout")
+				}
+				return false, nil, nil
+			})
+
+			sanityExecute()
+
+			// Batches of 1, 2 and 4 VMs
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(7))
+			vms, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vms.Items).To(HaveLen(6))
 		})
 
 		It("should remove failed condition when no reconcile error occurs", func() {
@@ -1394,6 +1774,143 @@ var _ = Describe("Pool", func() {
 			testutils.ExpectEvent(recorder, common.FailedUpdateVirtualMachineReason)
 		})
 
+		It("should not update VMs and VMIs when the update is paused and add update paused condition", func() {
+			pool, vm := DefaultPool(2)
+			pool.Status.Replicas = 2
+			pool.Status.ReadyReplicas = 2
+			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{Paused: true}
+
+			oldPoolRevision := createPoolRevision(pool)
+
+			pool.Generation = 123
+			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+			newPoolRevision := createPoolRevision(pool)
+
+			addPool(pool)
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			createVMsWithOrdinal(pool, 2, oldPoolRevision, oldPoolRevision, vm)
+
+			sanityExecute()
+
+			vmpool, err := fakeVirtClient.PoolV1beta1().VirtualMachinePools(pool.Namespace).Get(context.TODO(), pool.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmpool.Status.Conditions).To(HaveLen(1))
+			Expect(vmpool.Status.Conditions[0].Type).To(Equal(poolv1.VirtualMachinePoolUpdatePaused))
+			Expect(vmpool.Status.Conditions[0].Status).To(Equal(k8sv1.ConditionTrue))
+
+			testutils.ExpectEvent(recorder, SuccessfulPausedUpdateReason)
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "update", "virtualmachines")).To(BeEmpty())
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+		})
+
+		DescribeTable("should update outdated VMIs according to the proactive update method", func(method poolv1.VirtualMachinePoolProactiveUpdateMethod, restartRequired bool, expectedVMIDeletes, expectedVMDeletes int) {
+			pool, vm := DefaultPool(4)
+			pool.Status.Replicas = 4
+			pool.Status.ReadyReplicas = 4
+			maxUnavailable := intstr.FromInt32(1)
+			pool.Spec.MaxUnavailable = &maxUnavailable
+			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+				Proactive: &poolv1.VirtualMachinePoolProactiveUpdateStrategy{Method: &method},
+			}
+
+			oldPoolRevision := createPoolRevision(pool)
+
+			pool.Generation = 123
+			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+			newPoolRevision := createPoolRevision(pool)
+
+			addPool(pool)
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			createVMsWithOrdinal(pool, 4, newPoolRevision, oldPoolRevision, vm)
+
+			if restartRequired {
+				obj, exists, err := controller.vmIndexer.GetByKey(fmt.Sprintf("%s/%s-0", pool.Namespace, pool.Name))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				vm0 := obj.(*v1.VirtualMachine).DeepCopy()
+				vm0.Status.Conditions = append(vm0.Status.Conditions, v1.VirtualMachineCondition{
+					Type:   v1.VirtualMachineRestartRequired,
+					Status: k8sv1.ConditionTrue,
+				})
+				controller.vmIndexer.Update(vm0)
+			}
+
+			sanityExecute()
+
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(expectedVMIDeletes))
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(HaveLen(expectedVMDeletes))
+		},
+			Entry("Restart should restart the VMIs", poolv1.VirtualMachinePoolProactiveUpdateMethodRestart, false, 1, 0),
+			Entry("LiveUpdate should not restart the live updated VMIs", poolv1.VirtualMachinePoolProactiveUpdateMethodLiveUpdate, false, 0, 0),
+			Entry("LiveUpdate should restart the VMIs requiring it", poolv1.VirtualMachinePoolProactiveUpdateMethodLiveUpdate, true, 1, 0),
+			Entry("Replace should delete the VMs", poolv1.VirtualMachinePoolProactiveUpdateMethodReplace, false, 0, 1),
+		)
+
+		It("should replace outdated VMs instead of updating them with the Replace method", func() {
+			pool, vm := DefaultPool(4)
+			pool.Status.Replicas = 4
+			pool.Status.ReadyReplicas = 4
+			maxUnavailable := intstr.FromInt32(2)
+			pool.Spec.MaxUnavailable = &maxUnavailable
+			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+				Proactive: &poolv1.VirtualMachinePoolProactiveUpdateStrategy{
+					Method: pointer.P(poolv1.VirtualMachinePoolProactiveUpdateMethodReplace),
+				},
+			}
+
+			oldPoolRevision := createPoolRevision(pool)
+
+			pool.Generation = 123
+			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+			newPoolRevision := createPoolRevision(pool)
+
+			addPool(pool)
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			createVMsWithOrdinal(pool, 4, oldPoolRevision, oldPoolRevision, vm)
+
+			sanityExecute()
+
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "update", "virtualmachines")).To(BeEmpty())
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(HaveLen(2))
+		})
+
+		It("should surge new VMs before replacing outdated VMs", func() {
+			pool, vm := DefaultPool(4)
+			pool.Status.Replicas = 4
+			pool.Status.ReadyReplicas = 4
+			maxSurge := intstr.FromString("50%")
+			pool.Spec.MaxSurge = &maxSurge
+			pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+				Proactive: &poolv1.VirtualMachinePoolProactiveUpdateStrategy{
+					Method: pointer.P(poolv1.VirtualMachinePoolProactiveUpdateMethodReplace),
+				},
+			}
+
+			oldPoolRevision := createPoolRevision(pool)
+
+			pool.Generation = 123
+			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+			newPoolRevision := createPoolRevision(pool)
+
+			addPool(pool)
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			createVMsWithOrdinal(pool, 4, oldPoolRevision, oldPoolRevision, vm)
+
+			sanityExecute()
+
+			// 4 existing VMs and 2 surged VMs
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(6))
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+		})
+
 		It("should create VMs with finalizers", func() {
 			pool, _ := DefaultPool(2)
 			pool.Status.Replicas = 2
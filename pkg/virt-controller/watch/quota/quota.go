@@ -0,0 +1,276 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/vmquota"
+)
+
+const (
+	// resyncPeriod is how often the usage of all the quotas is recomputed
+	resyncPeriod = 5 * time.Minute
+	// reservationTimeout is how long the reservation of a VirtualMachineInstance is kept until it is observed,
+	// the VirtualMachineInstance was not created in the end when it is exceeded.
+	reservationTimeout = time.Minute
+)
+
+// Controller is the only writer of the usage reported by the VirtualMachineQuotas, it computes it from the
+// VirtualMachineInstances of their namespace. virt-api only adds reservations to the quotas when it admits
+// VirtualMachineInstances, the controller drops them once the VirtualMachineInstances are observed and
+// accounted for in the usage, in the same update, or when they time out.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	Queue         workqueue.TypedRateLimitingInterface[string]
+	quotaIndexer  cache.Indexer
+	vmiIndexer    cache.Indexer
+	clusterConfig *virtconfig.ClusterConfig
+	hasSynced     func() bool
+}
+
+func NewController(
+	quotaInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*Controller, error) {
+	c := &Controller{
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vm-quota"},
+		),
+		quotaIndexer:  quotaInformer.GetIndexer(),
+		vmiIndexer:    vmiInformer.GetIndexer(),
+		clientset:     clientset,
+		clusterConfig: clusterConfig,
+	}
+
+	c.hasSynced = func() bool {
+		return quotaInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := quotaInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueQuota,
+		UpdateFunc: c.updateQuota,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVMI,
+		UpdateFunc: c.updateVMI,
+		DeleteFunc: c.deleteVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueueQuota(obj interface{}) {
+	quota := obj.(*quotav1alpha1.VirtualMachineQuota)
+	key, err := controller.KeyFunc(quota)
+	if err != nil {
+		log.Log.Object(quota).Reason(err).Error("Failed to extract key from VirtualMachineQuota.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+// updateQuota recomputes the usage when the limits change or virt-api adds a reservation, not on the updates of
+// the usage by the controller itself
+func (c *Controller) updateQuota(old, curr interface{}) {
+	oldQuota := old.(*quotav1alpha1.VirtualMachineQuota)
+	currQuota := curr.(*quotav1alpha1.VirtualMachineQuota)
+	if oldQuota.Generation != currQuota.Generation ||
+		!equality.Semantic.DeepEqual(oldQuota.Status.Reservations, currQuota.Status.Reservations) {
+		c.enqueueQuota(curr)
+	}
+}
+
+func (c *Controller) enqueueQuotasOf(namespace string) {
+	quotas, err := vmquota.List(c.quotaIndexer, namespace)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the VirtualMachineQuotas of namespace %s.", namespace)
+		return
+	}
+	for _, quota := range quotas {
+		c.enqueueQuota(quota)
+	}
+}
+
+func (c *Controller) enqueueAll() {
+	for _, obj := range c.quotaIndexer.List() {
+		c.enqueueQuota(obj)
+	}
+}
+
+func (c *Controller) addVMI(obj interface{}) {
+	c.enqueueQuotasOf(obj.(*virtv1.VirtualMachineInstance).Namespace)
+}
+
+func (c *Controller) updateVMI(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if vmquota.Consumes(oldVMI) == vmquota.Consumes(currVMI) && equality.Semantic.DeepEqual(usageOf(oldVMI), usageOf(currVMI)) {
+		return
+	}
+	c.enqueueQuotasOf(currVMI.Namespace)
+}
+
+func (c *Controller) deleteVMI(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vmi %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+	c.enqueueQuotasOf(vmi.Namespace)
+}
+
+func usageOf(vmi *virtv1.VirtualMachineInstance) *quotav1alpha1.VirtualMachineQuotaLimits {
+	used := vmquota.Usage{}
+	used.Add(&vmi.Spec)
+	return used.Limits()
+}
+
+// Run runs the passed in Controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting vm quota controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+	go wait.Until(c.enqueueAll, resyncPeriod, stopCh)
+
+	<-stopCh
+	log.Log.Info("Stopping vm quota controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineQuota %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineQuota %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.quotaIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists || !c.clusterConfig.VirtualMachineQuotasEnabled() {
+		return nil
+	}
+	quota := obj.(*quotav1alpha1.VirtualMachineQuota)
+
+	used, err := vmquota.NamespaceUsage(c.vmiIndexer, quota.Namespace, "")
+	if err != nil {
+		return err
+	}
+	reservations, nextExpiry := c.pendingReservations(quota, time.Now())
+	if nextExpiry > 0 {
+		c.Queue.AddAfter(key, nextExpiry)
+	}
+	if quota.Status.Used != nil && equality.Semantic.DeepEqual(quota.Status.Used, used.Limits()) &&
+		len(reservations) == len(quota.Status.Reservations) {
+		return nil
+	}
+
+	quotaCopy := quota.DeepCopy()
+	quotaCopy.Status.Used = used.Limits()
+	quotaCopy.Status.Reservations = reservations
+	// a conflict means virt-api added a reservation in between, the usage is computed again on retry
+	_, err = c.clientset.GeneratedKubeVirtClient().QuotaV1alpha1().VirtualMachineQuotas(quota.Namespace).UpdateStatus(context.Background(), quotaCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// pendingReservations returns the reservations of the quota whose VirtualMachineInstance is not observed yet and
+// which did not time out, and the time until the first of them times out.
+func (c *Controller) pendingReservations(quota *quotav1alpha1.VirtualMachineQuota, now time.Time) ([]quotav1alpha1.VirtualMachineQuotaReservation, time.Duration) {
+	var (
+		pending    []quotav1alpha1.VirtualMachineQuotaReservation
+		nextExpiry time.Duration
+	)
+	for _, reservation := range quota.Status.Reservations {
+		if c.isObserved(quota.Namespace, &reservation) {
+			continue
+		}
+		expiry := reservation.Time.Add(reservationTimeout).Sub(now)
+		if expiry <= 0 {
+			continue
+		}
+		pending = append(pending, reservation)
+		if nextExpiry == 0 || expiry < nextExpiry {
+			nextExpiry = expiry
+		}
+	}
+	return pending, nextExpiry
+}
+
+func (c *Controller) isObserved(namespace string, reservation *quotav1alpha1.VirtualMachineQuotaReservation) bool {
+	obj, exists, err := c.vmiIndexer.GetByKey(controller.NamespacedKey(namespace, reservation.Name))
+	if err != nil || !exists {
+		return false
+	}
+	return reservation.UID == "" || obj.(*virtv1.VirtualMachineInstance).UID == reservation.UID
+}
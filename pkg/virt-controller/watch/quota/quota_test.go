@@ -0,0 +1,239 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package quota
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("VM quota controller", func() {
+	const namespace = "ns1"
+
+	var (
+		fakeVirtClient *kubevirtfake.Clientset
+		controller     *Controller
+	)
+
+	newVMI := func(name, guestMemory string, sockets uint32, phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(namespace),
+			libvmi.WithUID(types.UID(name+"-uid")),
+			libvmi.WithGuestMemory(guestMemory),
+			libvmi.WithCPUCount(1, 1, sockets),
+		)
+		vmi.Status.Phase = phase
+		return vmi
+	}
+
+	addQuota := func(used *quotav1alpha1.VirtualMachineQuotaLimits, reservations ...quotav1alpha1.VirtualMachineQuotaReservation) *quotav1alpha1.VirtualMachineQuota {
+		quota := &quotav1alpha1.VirtualMachineQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: namespace},
+			Spec: quotav1alpha1.VirtualMachineQuotaSpec{
+				Hard: quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(10))},
+			},
+			Status: quotav1alpha1.VirtualMachineQuotaStatus{Used: used, Reservations: reservations},
+		}
+		Expect(controller.quotaIndexer.Add(quota)).To(Succeed())
+		_, err := fakeVirtClient.QuotaV1alpha1().VirtualMachineQuotas(namespace).Create(context.Background(), quota, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return quota
+	}
+
+	newReservation := func(name string, age time.Duration) quotav1alpha1.VirtualMachineQuotaReservation {
+		return quotav1alpha1.VirtualMachineQuotaReservation{
+			Name: name,
+			UID:  types.UID(name + "-uid"),
+			Used: quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))},
+			Time: metav1.NewTime(time.Now().Add(-age)),
+		}
+	}
+
+	sync := func(quota *quotav1alpha1.VirtualMachineQuota) *quotav1alpha1.VirtualMachineQuota {
+		key, err := cache.MetaNamespaceKeyFunc(quota)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.execute(key)).To(Succeed())
+		updated, err := fakeVirtClient.QuotaV1alpha1().VirtualMachineQuotas(namespace).Get(context.Background(), quota.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updated
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(fakeVirtClient).AnyTimes()
+
+		quotaInformer, _ := testutils.NewFakeInformerFor(&quotav1alpha1.VirtualMachineQuota{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.VirtualMachineQuotasGate},
+			},
+		})
+
+		var err error
+		controller, err = NewController(quotaInformer, vmiInformer, virtClient, config)
+		Expect(err).ToNot(HaveOccurred())
+		controller.Queue = testutils.NewMockWorkQueue(controller.Queue)
+	})
+
+	It("should report the usage of the active VMIs of the namespace", func() {
+		Expect(controller.vmiIndexer.Add(newVMI("running", "1Gi", 2, v1.Running))).To(Succeed())
+		Expect(controller.vmiIndexer.Add(newVMI("scheduling", "2Gi", 1, v1.Scheduling))).To(Succeed())
+		Expect(controller.vmiIndexer.Add(newVMI("succeeded", "4Gi", 4, v1.Succeeded))).To(Succeed())
+		other := newVMI("other", "8Gi", 8, v1.Running)
+		other.Namespace = "ns2"
+		Expect(controller.vmiIndexer.Add(other)).To(Succeed())
+
+		quota := sync(addQuota(nil))
+		Expect(quota.Status.Used).ToNot(BeNil())
+		Expect(*quota.Status.Used.RunningVirtualMachines).To(Equal(int64(2)))
+		Expect(quota.Status.Used.GuestMemory.Cmp(resource.MustParse("3Gi"))).To(Equal(0))
+		Expect(*quota.Status.Used.Sockets).To(Equal(int64(3)))
+	})
+
+	It("should release the usage of the VMIs which stopped", func() {
+		Expect(controller.vmiIndexer.Add(newVMI("failed", "1Gi", 1, v1.Failed))).To(Succeed())
+		quota := sync(addQuota(&quotav1alpha1.VirtualMachineQuotaLimits{
+			RunningVirtualMachines: pointer.P(int64(1)),
+			GuestMemory:            pointer.P(resource.MustParse("1Gi")),
+			Sockets:                pointer.P(int64(1)),
+		}))
+		Expect(*quota.Status.Used.RunningVirtualMachines).To(BeZero())
+		Expect(quota.Status.Used.GuestMemory.IsZero()).To(BeTrue())
+		Expect(*quota.Status.Used.Sockets).To(BeZero())
+	})
+
+	It("should not update a quota reporting the current usage", func() {
+		Expect(controller.vmiIndexer.Add(newVMI("running", "1Gi", 1, v1.Running))).To(Succeed())
+		addQuota(&quotav1alpha1.VirtualMachineQuotaLimits{
+			RunningVirtualMachines: pointer.P(int64(1)),
+			GuestMemory:            pointer.P(resource.MustParse("1024Mi")),
+			Sockets:                pointer.P(int64(1)),
+		})
+		fakeVirtClient.ClearActions()
+		sync(&quotav1alpha1.VirtualMachineQuota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: namespace}})
+		Expect(fakeVirtClient.Actions()).To(HaveLen(1))
+		Expect(fakeVirtClient.Actions()[0].GetVerb()).To(Equal("get"))
+	})
+
+	It("should drop the reservations of the VMIs which are accounted for in the usage", func() {
+		Expect(controller.vmiIndexer.Add(newVMI("running", "1Gi", 1, v1.Running))).To(Succeed())
+		quota := sync(addQuota(nil, newReservation("running", time.Second), newReservation("pending", time.Second)))
+		Expect(*quota.Status.Used.RunningVirtualMachines).To(Equal(int64(1)))
+		Expect(quota.Status.Reservations).To(ConsistOf(HaveField("Name", "pending")))
+		Expect(controller.Queue.(*testutils.MockWorkQueue[string]).GetAddAfterEnqueueCount()).To(Equal(1))
+	})
+
+	It("should keep the reservation of a VMI replacing an observed one with the same name", func() {
+		previous := newVMI("vmi", "1Gi", 1, v1.Succeeded)
+		previous.UID = "previous-uid"
+		Expect(controller.vmiIndexer.Add(previous)).To(Succeed())
+		quota := sync(addQuota(nil, newReservation("vmi", time.Second)))
+		Expect(quota.Status.Reservations).To(ConsistOf(HaveField("Name", "vmi")))
+	})
+
+	It("should drop the reservations of the VMIs which were not created", func() {
+		quota := sync(addQuota(nil, newReservation("rejected", 2*reservationTimeout)))
+		Expect(quota.Status.Reservations).To(BeEmpty())
+		Expect(controller.Queue.(*testutils.MockWorkQueue[string]).GetAddAfterEnqueueCount()).To(BeZero())
+	})
+
+	It("should not update the quotas when the feature gate is disabled", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		controller.clusterConfig = config
+		Expect(controller.vmiIndexer.Add(newVMI("running", "1Gi", 1, v1.Running))).To(Succeed())
+		quota := sync(addQuota(nil))
+		Expect(quota.Status.Used).To(BeNil())
+	})
+
+	Context("should enqueue the quotas of the namespace", func() {
+		BeforeEach(func() {
+			Expect(controller.quotaIndexer.Add(&quotav1alpha1.VirtualMachineQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: namespace},
+			})).To(Succeed())
+		})
+
+		It("when a VMI stops", func() {
+			controller.updateVMI(newVMI("vmi", "1Gi", 1, v1.Running), newVMI("vmi", "1Gi", 1, v1.Failed))
+			Expect(controller.Queue.Len()).To(Equal(1))
+		})
+
+		It("when the resources of a VMI change", func() {
+			controller.updateVMI(newVMI("vmi", "1Gi", 1, v1.Running), newVMI("vmi", "1Gi", 2, v1.Running))
+			Expect(controller.Queue.Len()).To(Equal(1))
+		})
+
+		It("when a VMI is created", func() {
+			controller.addVMI(newVMI("vmi", "1Gi", 1, v1.Pending))
+			Expect(controller.Queue.Len()).To(Equal(1))
+		})
+
+		It("when virt-api reserves a quota", func() {
+			quota := &quotav1alpha1.VirtualMachineQuota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: namespace, Generation: 1}}
+			reserved := quota.DeepCopy()
+			reserved.Status.Reservations = []quotav1alpha1.VirtualMachineQuotaReservation{newReservation("vmi", 0)}
+			controller.updateQuota(quota, reserved)
+			Expect(controller.Queue.Len()).To(Equal(1))
+		})
+
+		It("when a VMI is deleted", func() {
+			controller.deleteVMI(newVMI("vmi", "1Gi", 1, v1.Running))
+			Expect(controller.Queue.Len()).To(Equal(1))
+		})
+
+		It("not when the status of a running VMI changes", func() {
+			vmi := newVMI("vmi", "1Gi", 1, v1.Running)
+			updated := vmi.DeepCopy()
+			updated.Status.NodeName = "node01"
+			controller.updateVMI(vmi, updated)
+			Expect(controller.Queue.Len()).To(BeZero())
+		})
+
+		It("not when the usage of a quota is updated", func() {
+			quota := &quotav1alpha1.VirtualMachineQuota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: namespace, Generation: 1}}
+			updated := quota.DeepCopy()
+			updated.Status.Used = &quotav1alpha1.VirtualMachineQuotaLimits{RunningVirtualMachines: pointer.P(int64(1))}
+			controller.updateQuota(quota, updated)
+			Expect(controller.Queue.Len()).To(BeZero())
+		})
+	})
+})
@@ -21,9 +21,11 @@ package util
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -116,3 +118,32 @@ func CreateDataVolumeManifest(clientset kubecli.KubevirtClient, dataVolumeTempla
 
 	return newDataVolume, nil
 }
+
+// RemovePoolStandbyAntiAffinity removes the pod anti-affinity a VirtualMachinePool adds to its
+// standby VMIs, which keeps them off the nodes running the VMIs serving the replicas of the pool.
+func RemovePoolStandbyAntiAffinity(vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if vmiSpec.Affinity == nil || vmiSpec.Affinity.PodAntiAffinity == nil {
+		return
+	}
+	podAntiAffinity := vmiSpec.Affinity.PodAntiAffinity
+	podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = slices.DeleteFunc(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		isPoolStandbyAntiAffinityTerm)
+	if len(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution) == 0 {
+		podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if equality.Semantic.DeepEqual(*podAntiAffinity, corev1.PodAntiAffinity{}) {
+		vmiSpec.Affinity.PodAntiAffinity = nil
+	}
+	if equality.Semantic.DeepEqual(*vmiSpec.Affinity, corev1.Affinity{}) {
+		vmiSpec.Affinity = nil
+	}
+}
+
+func isPoolStandbyAntiAffinityTerm(term corev1.PodAffinityTerm) bool {
+	if term.LabelSelector == nil {
+		return false
+	}
+	return slices.ContainsFunc(term.LabelSelector.MatchExpressions, func(requirement v1.LabelSelectorRequirement) bool {
+		return requirement.Key == virtv1.VirtualMachinePoolStandbyLabel && requirement.Operator == v1.LabelSelectorOpDoesNotExist
+	})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const configurationDriftedReason = "VMIDiverged"
+
+// syncConfigurationDrift adds or removes the ConfigurationDrifted condition from the VM based on whether
+// the VMI diverged from the VM spec it was created or live updated from.
+func (c *Controller) syncConfigurationDrift(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	vmConditionManager := controller.NewVirtualMachineConditionManager()
+
+	// Pending changes of the VM spec are reported by the RestartRequired condition, the VMI can
+	// only be compared to the generation of the VM it was created or live updated from.
+	if !c.clusterConfig.VirtualMachineDriftDetectionEnabled() ||
+		vm.Spec.Template == nil || vmi == nil || vmi.IsFinal() || vmi.DeletionTimestamp != nil ||
+		vm.Status.ObservedGeneration != vm.Generation {
+		vmConditionManager.RemoveCondition(vm, virtv1.VirtualMachineConfigurationDrifted)
+		return
+	}
+
+	drift := configurationDrift(vm, vmi)
+	if len(drift) == 0 {
+		vmConditionManager.RemoveCondition(vm, virtv1.VirtualMachineConfigurationDrifted)
+		return
+	}
+
+	message := fmt.Sprintf("the VMI diverged from generation %d of the VM: %s", vm.Status.ObservedGeneration, strings.Join(drift, ", "))
+	condition := vmConditionManager.GetCondition(vm, virtv1.VirtualMachineConfigurationDrifted)
+	if condition != nil && condition.Message == message {
+		return
+	}
+	vmConditionManager.RemoveCondition(vm, virtv1.VirtualMachineConfigurationDrifted)
+	vmConditionManager.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineConfigurationDrifted,
+		LastTransitionTime: metav1.Now(),
+		Status:             k8score.ConditionTrue,
+		Reason:             configurationDriftedReason,
+		Message:            message,
+	})
+}
+
+// configurationDrift lists the differences between the VMI and the template of its VM,
+// limited to what can be changed on a running VMI without going through the VM.
+func configurationDrift(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) []string {
+	var drift []string
+
+	vmVolumes := map[string]struct{}{}
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		vmVolumes[volume.Name] = struct{}{}
+	}
+	vmiVolumes := map[string]struct{}{}
+	for _, volume := range vmi.Spec.Volumes {
+		vmiVolumes[volume.Name] = struct{}{}
+		if _, exists := vmVolumes[volume.Name]; !exists {
+			drift = append(drift, fmt.Sprintf("volume %s is not declared", volume.Name))
+		}
+	}
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if _, exists := vmiVolumes[volume.Name]; !exists {
+			drift = append(drift, fmt.Sprintf("volume %s is missing", volume.Name))
+		}
+	}
+
+	drift = append(drift, metadataDrift("label", vm.Spec.Template.ObjectMeta.Labels, vmi.Labels)...)
+	drift = append(drift, metadataDrift("annotation", vm.Spec.Template.ObjectMeta.Annotations, vmi.Annotations)...)
+
+	return drift
+}
+
+// metadataDrift lists the labels or annotations of the VM template which are missing or changed on the VMI.
+// Labels and annotations added to the VMI are ignored, various components of KubeVirt add their own.
+func metadataDrift(kind string, declared, actual map[string]string) []string {
+	var drift []string
+	for key, value := range declared {
+		actualValue, exists := actual[key]
+		if !exists {
+			drift = append(drift, fmt.Sprintf("%s %s is missing", kind, key))
+		} else if actualValue != value {
+			drift = append(drift, fmt.Sprintf("%s %s was changed", kind, key))
+		}
+	}
+	slices.Sort(drift)
+	return drift
+}
@@ -0,0 +1,142 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8score "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("VM configuration drift", func() {
+	var c *Controller
+
+	newController := func(featureGates ...string) *Controller {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		return &Controller{clusterConfig: config}
+	}
+
+	newVMAndVMI := func() (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithLabel("app", "web"),
+			libvmi.WithAnnotation("owner", "team-a"),
+			libvmi.WithContainerDisk("rootdisk", "quay.io/containerdisks/fedora"),
+		))
+		vm.Generation = 2
+		vm.Status.ObservedGeneration = 2
+
+		vmi := libvmi.New(
+			libvmi.WithLabel("app", "web"),
+			libvmi.WithAnnotation("owner", "team-a"),
+			libvmi.WithContainerDisk("rootdisk", "quay.io/containerdisks/fedora"),
+		)
+		vmi.Status.Phase = v1.Running
+		return vm, vmi
+	}
+
+	driftCondition := func(vm *v1.VirtualMachine) *v1.VirtualMachineCondition {
+		return controller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineConfigurationDrifted)
+	}
+
+	BeforeEach(func() {
+		c = newController(featuregate.VirtualMachineDriftDetectionGate)
+	})
+
+	It("should not flag a VMI matching its VM", func() {
+		vm, vmi := newVMAndVMI()
+		c.syncConfigurationDrift(vm, vmi)
+		Expect(driftCondition(vm)).To(BeNil())
+	})
+
+	It("should flag a VMI with a volume hotplugged without its VM", func() {
+		vm, vmi := newVMAndVMI()
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{Name: "hotplugged"})
+
+		c.syncConfigurationDrift(vm, vmi)
+
+		condition := driftCondition(vm)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(k8score.ConditionTrue))
+		Expect(condition.Reason).To(Equal(configurationDriftedReason))
+		Expect(condition.Message).To(Equal("the VMI diverged from generation 2 of the VM: volume hotplugged is not declared"))
+	})
+
+	It("should flag a VMI whose labels and annotations were changed", func() {
+		vm, vmi := newVMAndVMI()
+		vmi.Labels["app"] = "db"
+		delete(vmi.Annotations, "owner")
+
+		c.syncConfigurationDrift(vm, vmi)
+
+		condition := driftCondition(vm)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Message).To(HaveSuffix("label app was changed, annotation owner is missing"))
+	})
+
+	It("should update the message when the drift changes", func() {
+		vm, vmi := newVMAndVMI()
+		vmi.Labels["app"] = "db"
+		c.syncConfigurationDrift(vm, vmi)
+
+		vmi.Spec.Volumes = vmi.Spec.Volumes[:0]
+		c.syncConfigurationDrift(vm, vmi)
+
+		Expect(vm.Status.Conditions).To(HaveLen(1))
+		Expect(driftCondition(vm).Message).To(HaveSuffix("volume rootdisk is missing, label app was changed"))
+	})
+
+	DescribeTable("should remove the condition", func(modify func(*Controller, *v1.VirtualMachine, *v1.VirtualMachineInstance) *v1.VirtualMachineInstance) {
+		vm, vmi := newVMAndVMI()
+		vmi.Labels["app"] = "db"
+		c.syncConfigurationDrift(vm, vmi)
+		Expect(driftCondition(vm)).ToNot(BeNil())
+
+		vmi = modify(c, vm, vmi)
+		c.syncConfigurationDrift(vm, vmi)
+		Expect(driftCondition(vm)).To(BeNil())
+	},
+		Entry("when the drift is reverted", func(_ *Controller, _ *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+			vmi.Labels["app"] = "web"
+			return vmi
+		}),
+		Entry("when the VM has changes which are not applied to the VMI", func(_ *Controller, vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+			vm.Generation = 3
+			return vmi
+		}),
+		Entry("when the VMI stopped", func(_ *Controller, _ *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+			vmi.Status.Phase = v1.Succeeded
+			return vmi
+		}),
+		Entry("when the VMI is gone", func(_ *Controller, _ *v1.VirtualMachine, _ *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+			return nil
+		}),
+		Entry("when the feature gate is disabled", func(c *Controller, _ *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+			*c = *newController()
+			return vmi
+		}),
+	)
+})
@@ -35,6 +35,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/revisionhistory"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -76,6 +77,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
@@ -126,6 +128,7 @@ const (
 	volumesUpdateErrorReason           = "VolumesUpdateError"
 	tolerationsChangeErrorReason       = "TolerationsChangeError"
 	annotationsLabelsChangeErrorReason = "AnnotationsLabelsChangeError"
+	revisionHistoryErrorReason         = "RevisionHistoryError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -581,6 +584,9 @@ func (c *Controller) VMICPUsPatch(vm *virtv1.VirtualMachine, vmi *virtv1.Virtual
 	resourcesDelta := resource.NewMilliQuantity(vcpusDelta*int64(1000/c.clusterConfig.GetCPUAllocationRatio()), resource.DecimalSI)
 
 	logMsg := fmt.Sprintf("hotplugging cpu to %v sockets", vm.Spec.Template.Spec.Domain.CPU.Sockets)
+	if vm.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets {
+		logMsg = fmt.Sprintf("unplugging cpu to %v sockets", vm.Spec.Template.Spec.Domain.CPU.Sockets)
+	}
 
 	if !vm.Spec.Template.Spec.Domain.Resources.Requests.Cpu().IsZero() {
 		newCpuReq := vmi.Spec.Domain.Resources.Requests.Cpu().DeepCopy()
@@ -632,11 +638,24 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8score.ConditionFalse) {
+		// The failed change was reverted in the template, bring the VMI spec back to the running CPU topology
+		if vmi.Status.CurrentCPUTopology != nil &&
+			vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets == vmi.Status.CurrentCPUTopology.Sockets {
+			if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets == vmi.Spec.Domain.CPU.Sockets {
+				return nil
+			}
+			return c.VMICPUsPatch(vmCopyWithInstancetype, vmi)
+		}
+		setRestartRequired(vm, "CPU sockets updated in template spec. CPU hotplug failed and is not available for this VM configuration")
+		return nil
+	}
+
 	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets == vmi.Spec.Domain.CPU.Sockets {
 		return nil
 	}
 
-	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8score.ConditionTrue) {
 		return fmt.Errorf("another CPU hotplug is in progress")
 	}
@@ -653,13 +672,10 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
-	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets {
-		setRestartRequired(vm, "Reduction of CPU socket count requires a restart")
-		return nil
-	}
-
-	if virtconfig.IsARM64(vm.Spec.Template.Spec.Architecture) {
-		setRestartRequired(vm, "ARM doesn't support CPU hotplug")
+	// vCPUs can only be unplugged when the guest agent is able to offline them inside of the guest first
+	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets &&
+		!vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceAgentConnected, k8score.ConditionTrue) {
+		setRestartRequired(vm, "Reduction of CPU socket count requires a restart when the guest agent is not connected")
 		return nil
 	}
 
@@ -1137,7 +1153,13 @@ func (c *Controller) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Virt
 		}
 		return vm, nil
 	case virtv1.RunStrategyOnce:
+		// For this RunStrategy, the VMI is started a single time. Once it reached a final phase
+		// the VM is completed and the VMI is neither restarted nor re-created.
 		if vmi == nil {
+			if vm.Status.Completion != nil {
+				log.Log.Object(vm).V(4).Infof("Not starting VM, it already completed in phase %s with runStrategy: %s", vm.Status.Completion.Phase, runStrategy)
+				return vm, nil
+			}
 			log.Log.Object(vm).Infof("%s due to start request and runStrategy: %s", startingVmMsg, runStrategy)
 			vm, err = c.startVMI(vm)
 			if err != nil {
@@ -1176,6 +1198,39 @@ func (c *Controller) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Virt
 	}
 }
 
+// syncSuspend takes over the run strategy of a VM suspended to disk. The VMI which got suspended
+// is removed to free the node resources, and a new VMI restoring the saved state is started
+// once the VM is resumed.
+func (c *Controller) syncSuspend(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, common.SyncError) {
+	var err error
+	suspend := vm.Status.Suspend
+
+	switch suspend.Phase {
+	case virtv1.VirtualMachineSuspending:
+		// virt-launcher saves the guest memory, the VMI reaches the Succeeded phase once it is done
+		return vm, nil
+	case virtv1.VirtualMachineSuspended, virtv1.VirtualMachineResuming:
+		if vmi != nil && vmi.UID == suspend.VMIUID {
+			log.Log.Object(vm).Infof("%s with VMI in phase %s as it is suspended to disk", stoppingVmMsg, vmi.Status.Phase)
+			vm, err = c.stopVMI(vm, vmi)
+			if err != nil {
+				return vm, common.NewSyncError(fmt.Errorf(failureDeletingVmiErrFormat, err), vmiFailedDeleteReason)
+			}
+			return vm, nil
+		}
+
+		if vmi == nil && suspend.Phase == virtv1.VirtualMachineResuming {
+			log.Log.Object(vm).Infof("%s from the state saved on suspend", startingVmMsg)
+			vm, err = c.startVMI(vm)
+			if err != nil {
+				return vm, common.NewSyncError(fmt.Errorf(startingVMIFailureFmt, err), failedCreateReason)
+			}
+		}
+	}
+
+	return vm, nil
+}
+
 // isVMIStartExpected determines whether a VMI is expected to be started for this VM.
 func (c *Controller) isVMIStartExpected(vm *virtv1.VirtualMachine) bool {
 	vmKey, err := controller.KeyFunc(vm)
@@ -1235,7 +1290,7 @@ func isSetToStart(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance)
 		return true
 	case virtv1.RunStrategyOnce:
 		if vmi == nil {
-			return true
+			return vm.Status.Completion == nil
 		}
 		return false
 	default:
@@ -1253,7 +1308,7 @@ func (c *Controller) cleanupRestartRequired(vm *virtv1.VirtualMachine) *virtv1.V
 	return vm
 }
 
-func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (_ *virtv1.VirtualMachine, err error) {
 	ready, err := c.handleDataVolumes(vm)
 	if err != nil {
 		return vm, err
@@ -1269,6 +1324,11 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 		return vm, nil
 	}
 
+	if dependency := c.unreadyDependency(vm); dependency != "" {
+		log.Log.Object(vm).V(4).Infof("Waiting for VirtualMachine %s to be ready, delaying start", dependency)
+		return vm, nil
+	}
+
 	// TODO add check for existence
 	vmKey, err := controller.KeyFunc(vm)
 	if err != nil {
@@ -1279,6 +1339,11 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 	vm = c.cleanupRestartRequired(vm)
 
 	// start it
+	span := tracecontext.StartSpan(stateChangeRequestTraceParent(vm, virtv1.StartRequest), "CreateVMI")
+	span.SetAttribute("namespace", vm.Namespace)
+	span.SetAttribute("name", vm.Name)
+	defer func() { span.End(err) }()
+
 	vmi := SetupVMIFromVM(vm)
 	vmRevisionName, err := c.createVMRevision(vm)
 	if err != nil {
@@ -1288,12 +1353,17 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 	vmi.Status.VirtualMachineRevisionName = vmRevisionName
 
 	setGenerationAnnotationOnVmi(vm.Generation, vmi)
+	tracecontext.SetOnObject(vmi, span.TraceParent())
 
 	if vm.Spec.RunStrategy != nil && *vm.Spec.RunStrategy == virtv1.RunStrategyWaitAsReceiver {
 		log.Log.Infof("Setting up receiver VMI %s/%s", vmi.Namespace, vmi.Name)
 		vmi.Annotations[virtv1.CreateMigrationTarget] = "true"
 	}
 
+	if vm.Status.Suspend != nil && vm.Status.Suspend.Phase == virtv1.VirtualMachineResuming {
+		vmi.Annotations[virtv1.RestoreSavedStateAnnotation] = "true"
+	}
+
 	// add a finalizer to ensure the VM controller has a chance to see
 	// the VMI before it is deleted
 	vmi.Finalizers = append(vmi.Finalizers, virtv1.VirtualMachineControllerFinalizer)
@@ -1337,12 +1407,31 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 		c.recorder.Eventf(vm, k8score.EventTypeWarning, common.FailedCreateVirtualMachineReason, "Error creating virtual machine instance: %v", err)
 		return vm, err
 	}
-	log.Log.Object(vm).Infof("Started VM by creating the new virtual machine instance %s", vmi.Name)
+	tracecontext.WithTraceParent(log.Log.Object(vm), tracecontext.FromObject(vmi)).Infof("Started VM by creating the new virtual machine instance %s", vmi.Name)
 	c.recorder.Eventf(vm, k8score.EventTypeNormal, common.SuccessfulCreateVirtualMachineReason, "Started the virtual machine by creating the new virtual machine instance %v", vmi.ObjectMeta.Name)
 
 	return vm, nil
 }
 
+// unreadyDependency returns the name of the first VirtualMachine the VM depends on which is not
+// ready, or an empty string if all of them are ready.
+func (c *Controller) unreadyDependency(vm *virtv1.VirtualMachine) string {
+	if !c.clusterConfig.VirtualMachineDependenciesEnabled() {
+		return ""
+	}
+	for _, dependency := range vm.Spec.DependsOn {
+		obj, exists, err := c.vmiIndexer.GetByKey(controller.NamespacedKey(vm.Namespace, dependency.Name))
+		if err != nil || !exists {
+			return dependency.Name
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil || !isReady(vmi) {
+			return dependency.Name
+		}
+	}
+	return ""
+}
+
 func setGenerationAnnotation(generation int64, annotations map[string]string) {
 	annotations[virtv1.VirtualMachineGenerationAnnotation] = strconv.FormatInt(generation, 10)
 }
@@ -1473,9 +1562,8 @@ func (c *Controller) conditionallyBumpGenerationAnnotationOnVmi(vm *virtv1.Virtu
 }
 
 // Returns in seconds how long to wait before trying to start the VM again.
-func calculateStartBackoffTime(failCount int, maxDelay int) int {
-	// The algorithm is designed to work well with a dynamic maxDelay
-	// if we decide to expose this as a tuning in the future.
+// A baseDelay lower or equal to zero is derived from maxDelay.
+func calculateStartBackoffTime(failCount int, baseDelay int, maxDelay int) int {
 	minInterval := 10
 	delaySeconds := 0
 
@@ -1484,10 +1572,12 @@ func calculateStartBackoffTime(failCount int, maxDelay int) int {
 	}
 
 	multiplier := int(math.Pow(float64(failCount), float64(2)))
-	interval := maxDelay / 30
-
-	if interval < minInterval {
-		interval = minInterval
+	interval := baseDelay
+	if interval <= 0 {
+		interval = maxDelay / 30
+		if interval < minInterval {
+			interval = minInterval
+		}
 	}
 
 	delaySeconds = interval * multiplier
@@ -1502,6 +1592,27 @@ func calculateStartBackoffTime(failCount int, maxDelay int) int {
 	return delaySeconds
 }
 
+// crashLoopBackoff returns the base delay, max delay and reset window used for the VM,
+// taking the fields set on the VM over the cluster wide ones.
+func (c *Controller) crashLoopBackoff(vm *virtv1.VirtualMachine) (baseDelay, maxDelay, resetWindow time.Duration) {
+	maxDelay = defaultMaxCrashLoopBackoffDelaySeconds * time.Second
+	for _, backoff := range []*virtv1.CrashLoopBackoff{c.clusterConfig.GetCrashLoopBackoff(), vm.Spec.CrashLoopBackoff} {
+		if backoff == nil {
+			continue
+		}
+		if backoff.BaseDelay != nil {
+			baseDelay = backoff.BaseDelay.Duration
+		}
+		if backoff.MaxDelay != nil {
+			maxDelay = backoff.MaxDelay.Duration
+		}
+		if backoff.ResetWindow != nil {
+			resetWindow = backoff.ResetWindow.Duration
+		}
+	}
+	return baseDelay, maxDelay, resetWindow
+}
+
 // Reports if vmi has ever hit a running state
 func wasVMIInRunningPhase(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
@@ -1517,13 +1628,48 @@ func wasVMIInRunningPhase(vmi *virtv1.VirtualMachineInstance) bool {
 	return false
 }
 
-// Reports if vmi failed before ever hitting a running state
-func vmiFailedEarly(vmi *virtv1.VirtualMachineInstance) bool {
+// runningTimeLeft returns how long the vmi still has to run for its start to count as
+// successful. It returns zero once the vmi ran for resetWindow, and resetWindow if it
+// never hit a running state.
+func runningTimeLeft(vmi *virtv1.VirtualMachineInstance, resetWindow time.Duration) time.Duration {
+	var runningSince *metav1.Time
+	for i, ts := range vmi.Status.PhaseTransitionTimestamps {
+		if ts.Phase == virtv1.Running {
+			runningSince = &vmi.Status.PhaseTransitionTimestamps[i].PhaseTransitionTimestamp
+			break
+		}
+	}
+	if runningSince == nil {
+		return resetWindow
+	}
+
+	end := time.Now()
+	if vmi.IsFinal() {
+		for _, ts := range vmi.Status.PhaseTransitionTimestamps {
+			if ts.Phase == vmi.Status.Phase {
+				end = ts.PhaseTransitionTimestamp.Time
+			}
+		}
+	}
+
+	if left := resetWindow - end.Sub(runningSince.Time); left > 0 {
+		return left
+	}
+	return 0
+}
+
+// Reports if vmi has hit a running state and kept running for resetWindow
+func wasVMIRunningFor(vmi *virtv1.VirtualMachineInstance, resetWindow time.Duration) bool {
+	return wasVMIInRunningPhase(vmi) && runningTimeLeft(vmi, resetWindow) == 0
+}
+
+// Reports if vmi failed before running for resetWindow
+func vmiFailedEarly(vmi *virtv1.VirtualMachineInstance, resetWindow time.Duration) bool {
 	if vmi == nil || !vmi.IsFinal() {
 		return false
 	}
 
-	if wasVMIInRunningPhase(vmi) {
+	if wasVMIRunningFor(vmi, resetWindow) {
 		return false
 	}
 
@@ -1531,11 +1677,11 @@ func vmiFailedEarly(vmi *virtv1.VirtualMachineInstance) bool {
 }
 
 // clear start failure tracking if...
-// 1. VMI exists and ever hit running phase
+// 1. VMI exists and ran for the reset window
 // 2. run strategy is not set to automatically restart failed VMIs
-func shouldClearStartFailure(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+func shouldClearStartFailure(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, resetWindow time.Duration) bool {
 
-	if wasVMIInRunningPhase(vmi) {
+	if wasVMIRunningFor(vmi, resetWindow) {
 		return true
 	}
 
@@ -1571,13 +1717,15 @@ func startFailureBackoffTimeLeft(vm *virtv1.VirtualMachine) int64 {
 	return 0
 }
 
-func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
-	if shouldClearStartFailure(vm, vmi) {
-		// if a vmi associated with the vm hits a running phase, then reset the start failure counter
+func (c *Controller) syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	baseDelay, maxDelay, resetWindow := c.crashLoopBackoff(vm)
+
+	if shouldClearStartFailure(vm, vmi, resetWindow) {
+		// if a vmi associated with the vm ran for the reset window, then reset the start failure counter
 		vm.Status.StartFailure = nil
 
-	} else if vmi != nil && vmiFailedEarly(vmi) {
-		// if the VMI failed without ever hitting running successfully,
+	} else if vmi != nil && vmiFailedEarly(vmi, resetWindow) {
+		// if the VMI failed without running for the reset window,
 		// record this as a start failure so we can back off retrying
 		if vm.Status.StartFailure != nil && vm.Status.StartFailure.LastFailedVMIUID == vmi.UID {
 			// already counted this failure
@@ -1590,7 +1738,7 @@ func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 		}
 
 		now := metav1.NewTime(time.Now())
-		delaySeconds := calculateStartBackoffTime(count, defaultMaxCrashLoopBackoffDelaySeconds)
+		delaySeconds := calculateStartBackoffTime(count, int(baseDelay.Seconds()), int(maxDelay.Seconds()))
 		retryAfter := metav1.NewTime(now.Time.Add(time.Duration(int64(delaySeconds)) * time.Second))
 
 		vm.Status.StartFailure = &virtv1.VirtualMachineStartFailure{
@@ -1598,9 +1746,95 @@ func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 			RetryAfterTimestamp:  &retryAfter,
 			ConsecutiveFailCount: count,
 		}
+	} else if vm.Status.StartFailure != nil && wasVMIInRunningPhase(vmi) && !vmi.IsFinal() {
+		// check again once the VMI ran for the reset window to clear the start failure
+		c.Queue.AddAfter(controller.VirtualMachineKey(vm), runningTimeLeft(vmi, resetWindow))
 	}
 }
 
+// syncCompletionStatus records the final phase of the VMI of a VM with the Once run strategy.
+// The completion is kept until the run strategy changes, so that the VM is not started again
+// once its VMI got deleted.
+func syncCompletionStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, runStrategy virtv1.VirtualMachineRunStrategy) {
+	if runStrategy != virtv1.RunStrategyOnce {
+		vm.Status.Completion = nil
+		return
+	}
+
+	// a VMI suspended to disk did not complete
+	if vm.Status.Completion != nil || vm.Status.Suspend != nil || vmi == nil || !vmi.IsFinal() {
+		return
+	}
+
+	now := metav1.Now()
+	vm.Status.Completion = &virtv1.VirtualMachineCompletion{
+		Phase:          vmi.Status.Phase,
+		CompletionTime: &now,
+	}
+}
+
+// syncSuspendStatus moves the suspension of a VM to disk forward based on its VMI.
+// Stopping a suspended VM discards its saved state.
+func syncSuspendStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, runStrategy virtv1.VirtualMachineRunStrategy) {
+	suspend := vm.Status.Suspend
+	if suspend == nil {
+		return
+	}
+
+	if runStrategy == virtv1.RunStrategyHalted {
+		vm.Status.Suspend = nil
+		return
+	}
+
+	switch suspend.Phase {
+	case virtv1.VirtualMachineSuspending:
+		switch {
+		case vmi == nil || vmi.UID != suspend.VMIUID:
+			vm.Status.Suspend = nil
+		case vmi.Status.Phase == virtv1.Succeeded:
+			now := metav1.Now()
+			suspend.Phase = virtv1.VirtualMachineSuspended
+			suspend.SuspendTime = &now
+			controller.NewVirtualMachineConditionManager().RemoveCondition(vm, virtv1.VirtualMachineSuspendFailed)
+		case vmi.IsFinal():
+			// the guest memory could not be saved
+			vm.Status.Suspend = nil
+		default:
+			syncSuspendFailure(vm, vmi)
+		}
+	case virtv1.VirtualMachineResuming:
+		if vmi != nil && vmi.UID != suspend.VMIUID && (vmi.IsRunning() || vmi.IsFinal()) {
+			vm.Status.Suspend = nil
+		}
+	}
+}
+
+// syncSuspendFailure ends the suspension of a VM when virt-launcher failed to save the guest memory,
+// the VMI keeps running. The SuspendFailed condition of the VM records the last failure, a failure
+// reported by the VMI before it is a leftover of a previous suspension.
+func syncSuspendFailure(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	vmiCondition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceSuspendFailed)
+	if vmiCondition == nil || vmiCondition.Status != k8score.ConditionTrue {
+		return
+	}
+
+	vmCondManager := controller.NewVirtualMachineConditionManager()
+	vmCondition := vmCondManager.GetCondition(vm, virtv1.VirtualMachineSuspendFailed)
+	if vmCondition != nil && !vmCondition.LastTransitionTime.Before(&vmiCondition.LastTransitionTime) {
+		return
+	}
+
+	vm.Status.Suspend = nil
+	vmCondManager.RemoveCondition(vm, virtv1.VirtualMachineSuspendFailed)
+	vm.Status.Conditions = append(vm.Status.Conditions, virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineSuspendFailed,
+		Status:             k8score.ConditionTrue,
+		LastTransitionTime: vmiCondition.LastTransitionTime,
+		Reason:             vmiCondition.Reason,
+		Message:            vmiCondition.Message,
+	})
+}
+
 func syncVolumeMigration(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
 	if vm.Status.VolumeUpdateState == nil || vm.Status.VolumeUpdateState.VolumeMigrationState == nil {
 		return
@@ -1681,7 +1915,7 @@ func (c *Controller) stopVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachi
 	vm = c.cleanupRestartRequired(vm)
 
 	c.recorder.Eventf(vm, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Stopped the virtual machine by deleting the virtual machine instance %v", vmi.ObjectMeta.UID)
-	log.Log.Object(vm).Infof("Dispatching delete event for vmi %s with phase %s", controller.NamespacedKey(vmi.Namespace, vmi.Name), vmi.Status.Phase)
+	tracecontext.WithTraceParent(log.Log.Object(vm), stateChangeRequestTraceParent(vm, virtv1.StopRequest)).Infof("Dispatching delete event for vmi %s with phase %s", controller.NamespacedKey(vmi.Namespace, vmi.Name), vmi.Status.Phase)
 
 	return vm, nil
 }
@@ -1863,6 +2097,83 @@ func (c *Controller) createVMRevision(vm *virtv1.VirtualMachine) (string, error)
 	return cr.Name, nil
 }
 
+// syncRevisionHistory records the current spec of the VM in its revision history
+// and prunes the revisions exceeding the history limit. A spec matching an older
+// revision, e.g. after a rollback, moves that revision to the top of the history.
+func (c *Controller) syncRevisionHistory(vm *virtv1.VirtualMachine) error {
+	objs, err := c.crIndexer.ByIndex("vm", string(vm.UID))
+	if err != nil {
+		return err
+	}
+	var revisions []*appsv1.ControllerRevision
+	for _, obj := range objs {
+		cr, ok := obj.(*appsv1.ControllerRevision)
+		if ok && revisionhistory.IsRevisionOf(cr, vm) {
+			revisions = append(revisions, cr)
+		}
+	}
+	revisionhistory.SortByRevision(revisions)
+
+	data, err := revisionhistory.Data(&vm.Spec)
+	if err != nil {
+		return err
+	}
+	name := revisionhistory.Name(vm.UID, data)
+
+	var nextRevision int64 = 1
+	if len(revisions) > 0 {
+		nextRevision = revisions[len(revisions)-1].Revision + 1
+	}
+
+	current := -1
+	for i, cr := range revisions {
+		if cr.Name == name {
+			current = i
+			break
+		}
+	}
+
+	switch {
+	case current == -1:
+		cr := &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       vm.Namespace,
+				Labels:          map[string]string{virtv1.VirtualMachineRevisionHistoryLabel: vm.Name},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)},
+			},
+			Data:     runtime.RawExtension{Raw: data},
+			Revision: nextRevision,
+		}
+		cr, err = c.clientset.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), cr, metav1.CreateOptions{})
+		if err != nil && !apiErrors.IsAlreadyExists(err) {
+			return err
+		}
+		if err == nil {
+			revisions = append(revisions, cr)
+		}
+	case current != len(revisions)-1:
+		cr := revisions[current].DeepCopy()
+		cr.Revision = nextRevision
+		cr, err = c.clientset.AppsV1().ControllerRevisions(vm.Namespace).Update(context.Background(), cr, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+		revisions = append(append(revisions[:current], revisions[current+1:]...), cr)
+	}
+
+	// the current revision is kept on top of the limit
+	for len(revisions) > revisionhistory.Limit(vm)+1 {
+		err = c.clientset.AppsV1().ControllerRevisions(vm.Namespace).Delete(context.Background(), revisions[0].Name, metav1.DeleteOptions{})
+		if err != nil && !apiErrors.IsNotFound(err) {
+			return err
+		}
+		revisions = revisions[1:]
+	}
+
+	return nil
+}
+
 // SetupVMIfromVM creates a VirtualMachineInstance object from one VirtualMachine object.
 func SetupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
 	vmi := libvmi.New()
@@ -1916,6 +2227,14 @@ func hasStartRequest(vm *virtv1.VirtualMachine) bool {
 	return stateChange.Action == virtv1.StartRequest
 }
 
+// stateChangeRequestTraceParent returns the trace context of the pending state change request, if it is of the given action
+func stateChangeRequestTraceParent(vm *virtv1.VirtualMachine, action virtv1.StateChangeRequestAction) string {
+	if len(vm.Status.StateChangeRequests) == 0 || vm.Status.StateChangeRequests[0].Action != action {
+		return ""
+	}
+	return vm.Status.StateChangeRequests[0].Data[virtv1.StateChangeRequestDataTraceParentKey]
+}
+
 func hasStopRequestForVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	if len(vm.Status.StateChangeRequests) == 0 {
 		return false
@@ -2054,6 +2373,10 @@ func (c *Controller) updateVirtualMachineInstance(old, cur interface{}) {
 		return
 	}
 
+	if !isReady(oldVMI) && isReady(curVMI) {
+		c.enqueueDependentVMs(curVMI)
+	}
+
 	curControllerRef := metav1.GetControllerOf(curVMI)
 	oldControllerRef := metav1.GetControllerOf(oldVMI)
 	controllerRefChanged := !equality.Semantic.DeepEqual(curControllerRef, oldControllerRef)
@@ -2132,6 +2455,22 @@ func (c *Controller) deleteVirtualMachineInstance(obj interface{}) {
 	c.enqueueVm(vm)
 }
 
+// enqueueDependentVMs enqueues the VirtualMachines which depend on the VM of the vmi
+func (c *Controller) enqueueDependentVMs(vmi *virtv1.VirtualMachineInstance) {
+	objs, err := c.vmIndexer.ByIndex("dependsOn", controller.NamespacedKey(vmi.Namespace, vmi.Name))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to list the VirtualMachines depending on the VirtualMachineInstance.")
+		return
+	}
+	for _, obj := range objs {
+		c.enqueueVm(obj)
+	}
+}
+
+func isReady(vmi *virtv1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, k8score.ConditionTrue)
+}
+
 func (c *Controller) listVMsMatchingPVC(namespace, pvcName string) ([]*virtv1.VirtualMachine, error) {
 	vms := []*virtv1.VirtualMachine{}
 	for _, indexName := range []string{"dv", "pvc"} {
@@ -2479,17 +2818,21 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 	}
 
 	c.trimDoneVolumeRequests(vm)
+	memorydump.RequestOnGuestPanic(vm, vmi)
 	memorydump.UpdateRequest(vm, vmi)
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
 		popStateChangeRequest(vm)
 	}
 
-	syncStartFailureStatus(vm, vmi)
+	c.syncStartFailureStatus(vm, vmi)
+	syncSuspendStatus(vm, vmi, runStrategy)
+	syncCompletionStatus(vm, vmi, runStrategy)
 	// On a successful migration, the volume change condition is removed and we need to detect the removal before the synchronization of the VMI
 	// condition to the VM
 	syncVolumeMigration(vm, vmi)
 	syncConditions(vm, vmi, syncErr)
+	c.syncConfigurationDrift(vm, vmi)
 	c.setPrintableStatus(vm, vmi)
 	cbt.SyncVMChangedBlockTrackingState(vm, vmi, c.clusterConfig, c.namespaceStore)
 
@@ -2527,6 +2870,8 @@ func (c *Controller) setPrintableStatus(vm *virtv1.VirtualMachine, vmi *virtv1.V
 		statusFunc func(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool
 	}{
 		{virtv1.VirtualMachineStatusTerminating, c.isVirtualMachineStatusTerminating},
+		{virtv1.VirtualMachineStatusSuspending, c.isVirtualMachineStatusSuspending},
+		{virtv1.VirtualMachineStatusSuspended, c.isVirtualMachineStatusSuspended},
 		{virtv1.VirtualMachineStatusStopping, c.isVirtualMachineStatusStopping},
 		{virtv1.VirtualMachineStatusMigrating, c.isVirtualMachineStatusMigrating},
 		{virtv1.VirtualMachineStatusPaused, c.isVirtualMachineStatusPaused},
@@ -2536,9 +2881,12 @@ func (c *Controller) setPrintableStatus(vm *virtv1.VirtualMachine, vmi *virtv1.V
 		{virtv1.VirtualMachineStatusUnschedulable, c.isVirtualMachineStatusUnschedulable},
 		{virtv1.VirtualMachineStatusProvisioning, c.isVirtualMachineStatusProvisioning},
 		{virtv1.VirtualMachineStatusWaitingForVolumeBinding, c.isVirtualMachineStatusWaitingForVolumeBinding},
+		{virtv1.VirtualMachineStatusWaitingForDependencies, c.isVirtualMachineStatusWaitingForDependencies},
 		{virtv1.VirtualMachineStatusErrImagePull, c.isVirtualMachineStatusErrImagePull},
 		{virtv1.VirtualMachineStatusImagePullBackOff, c.isVirtualMachineStatusImagePullBackOff},
 		{virtv1.VirtualMachineStatusStarting, c.isVirtualMachineStatusStarting},
+		{virtv1.VirtualMachineStatusSucceeded, c.isVirtualMachineStatusSucceeded},
+		{virtv1.VirtualMachineStatusFailed, c.isVirtualMachineStatusFailed},
 		{virtv1.VirtualMachineStatusCrashLoopBackOff, c.isVirtualMachineStatusCrashLoopBackOff},
 		{virtv1.VirtualMachineStatusStopped, c.isVirtualMachineStatusStopped},
 		{virtv1.VirtualMachineStatusWaitingForReceiver, c.isVirtualMachineWaitingReceiver},
@@ -2577,6 +2925,33 @@ func (c *Controller) isVirtualMachineStatusCrashLoopBackOff(vm *virtv1.VirtualMa
 	return false
 }
 
+// isVirtualMachineStatusSuspending determines whether the VM status field should be set to "Suspending".
+func (c *Controller) isVirtualMachineStatusSuspending(vm *virtv1.VirtualMachine, _ *virtv1.VirtualMachineInstance) bool {
+	return vm.Status.Suspend != nil && vm.Status.Suspend.Phase == virtv1.VirtualMachineSuspending
+}
+
+// isVirtualMachineStatusSuspended determines whether the VM status field should be set to "Suspended".
+func (c *Controller) isVirtualMachineStatusSuspended(vm *virtv1.VirtualMachine, _ *virtv1.VirtualMachineInstance) bool {
+	return vm.Status.Suspend != nil && vm.Status.Suspend.Phase == virtv1.VirtualMachineSuspended
+}
+
+// isVirtualMachineStatusSucceeded determines whether the VM status field should be set to "Succeeded".
+func (c *Controller) isVirtualMachineStatusSucceeded(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	return isVirtualMachineCompletedIn(vm, vmi, virtv1.Succeeded)
+}
+
+// isVirtualMachineStatusFailed determines whether the VM status field should be set to "Failed".
+func (c *Controller) isVirtualMachineStatusFailed(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	return isVirtualMachineCompletedIn(vm, vmi, virtv1.Failed)
+}
+
+func isVirtualMachineCompletedIn(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, phase virtv1.VirtualMachineInstancePhase) bool {
+	if vm.Status.Completion == nil || vm.Status.Completion.Phase != phase {
+		return false
+	}
+	return vmi == nil || vmi.IsFinal()
+}
+
 // isVirtualMachineStatusStopped determines whether the VM status field should be set to "Stopped".
 func (c *Controller) isVirtualMachineStatusStopped(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi != nil {
@@ -2600,6 +2975,11 @@ func (c *Controller) isVirtualMachineStatusWaitingForVolumeBinding(vm *virtv1.Vi
 	return storagetypes.HasUnboundPVC(vm.Namespace, vm.Spec.Template.Spec.Volumes, c.pvcStore)
 }
 
+// isVirtualMachineStatusWaitingForDependencies determines whether the VM status field should be set to "WaitingForDependencies".
+func (c *Controller) isVirtualMachineStatusWaitingForDependencies(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi == nil && isSetToStart(vm, vmi) && c.unreadyDependency(vm) != ""
+}
+
 // isVirtualMachineStatusStarting determines whether the VM status field should be set to "Starting".
 func (c *Controller) isVirtualMachineStatusStarting(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
@@ -2749,9 +3129,14 @@ func syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstanc
 
 	// sync VMI conditions, ignore list represents conditions that are not synced generically
 	syncIgnoreMap := map[string]interface{}{
-		string(virtv1.VirtualMachineReady):           nil,
-		string(virtv1.VirtualMachineFailure):         nil,
-		string(virtv1.VirtualMachineRestartRequired): nil,
+		string(virtv1.VirtualMachineReady):                nil,
+		string(virtv1.VirtualMachineFailure):              nil,
+		string(virtv1.VirtualMachineRestartRequired):      nil,
+		string(virtv1.VirtualMachineConfigurationDrifted): nil,
+		// maintained by the machine type controller
+		string(virtv1.VirtualMachineMachineTypeUnsupported): nil,
+		// maintained by the suspension to disk
+		string(virtv1.VirtualMachineSuspendFailed): nil,
 	}
 	vmiCondMap := make(map[string]interface{})
 
@@ -3036,6 +3421,13 @@ func (c *Controller) syncRestartRequired(lastSeenVMSpec *virtv1.VirtualMachineSp
 		lastSeenVM.Spec.Template.Spec.Networks = currentVM.Spec.Template.Spec.Networks
 	}
 
+	// A standby VM of a pool is promoted by removing its standby label, start strategy and anti-affinity
+	// once its VMI is resumed. They only matter when the VMI starts, so the promotion does not require a restart.
+	if isPromotedPoolStandbyVM(lastSeenVM, currentVM) {
+		lastSeenVM.Spec.Template.Spec.StartStrategy = currentVM.Spec.Template.Spec.StartStrategy
+		watchutil.RemovePoolStandbyAntiAffinity(&lastSeenVM.Spec.Template.Spec)
+	}
+
 	// Neutralize firmware UUID changes if the VMI's UUID matches the VM's UUID.
 	// This happens when the firmware synchronizer persists the UUID to a VM that didn't have one.
 	if vmi != nil && vmi.Spec.Domain.Firmware != nil && currentVM.Spec.Template.Spec.Domain.Firmware != nil &&
@@ -3062,6 +3454,12 @@ func (c *Controller) syncRestartRequired(lastSeenVMSpec *virtv1.VirtualMachineSp
 	return false
 }
 
+func isPromotedPoolStandbyVM(lastSeenVM, currentVM *virtv1.VirtualMachine) bool {
+	_, wasStandby := lastSeenVM.Spec.Template.ObjectMeta.Labels[virtv1.VirtualMachinePoolStandbyLabel]
+	_, isStandby := currentVM.Spec.Template.ObjectMeta.Labels[virtv1.VirtualMachinePoolStandbyLabel]
+	return wasStandby && !isStandby
+}
+
 // These "dynamic" annotations/labels are VMI annotations/labels which may diverge from the VM over time that we want to keep in sync.
 func (c *Controller) syncDynamicAnnotationsAndLabelsToVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachineInstance, error) {
 	if vm == nil || vm.Spec.Template == nil || vmi == nil || vmi.DeletionTimestamp != nil {
@@ -3143,7 +3541,7 @@ func (c *Controller) syncDynamicAnnotationsAndLabelsToVMI(vm *virtv1.VirtualMach
 		}
 	}
 
-	dynamicLabels := []string{}
+	dynamicLabels := []string{virtv1.VirtualMachinePoolStandbyLabel}
 	dynamicLabels = append(dynamicLabels, c.additionalLauncherLabelsSync...)
 	dynamicAnnotations := []string{descheduler.EvictPodAnnotationKeyAlpha, descheduler.EvictPodAnnotationKeyAlphaPreferNoEviction}
 	dynamicAnnotations = append(dynamicAnnotations, c.additionalLauncherAnnotationsSync...)
@@ -3279,6 +3677,12 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	vm.ObjectMeta = syncedVM.ObjectMeta
 	vm.Spec = syncedVM.Spec
 
+	if c.clusterConfig.VirtualMachineRevisionHistoryEnabled() {
+		if err := c.syncRevisionHistory(vm); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("failed to sync the revision history: %v", err), revisionHistoryErrorReason), nil
+		}
+	}
+
 	// eventually, would like the condition to be `== "true"`, but for now we need to support legacy behavior by default
 	if vm.Annotations[virtv1.ImmediateDataVolumeCreation] != "false" {
 		dataVolumesReady, err := c.handleDataVolumes(vm)
@@ -3294,7 +3698,11 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	}
 
 	origRunStrategy := vm.Spec.RunStrategy
-	vm, syncErr = c.syncRunStrategy(vm, vmi, runStrategy)
+	if vm.Status.Suspend != nil && runStrategy != virtv1.RunStrategyHalted {
+		vm, syncErr = c.syncSuspend(vm, vmi)
+	} else {
+		vm, syncErr = c.syncRunStrategy(vm, vmi, runStrategy)
+	}
 	if syncErr != nil {
 		return vm, vmi, syncErr, nil
 	}
@@ -45,6 +45,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/libdv"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/revisionhistory"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -1578,6 +1579,126 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("should count a VMI which did not run for the reset window as a start failure", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.CrashLoopBackoff = &v1.CrashLoopBackoff{
+					ResetWindow: &metav1.Duration{Duration: 10 * time.Minute},
+				}
+				vmi.UID = "456"
+				vmi.Status.Phase = v1.Failed
+				vmi.Status.PhaseTransitionTimestamps = []v1.VirtualMachineInstancePhaseTransitionTimestamp{
+					{
+						Phase:                    v1.Running,
+						PhaseTransitionTimestamp: metav1.NewTime(time.Now().Add(-time.Minute)),
+					},
+					{
+						Phase:                    v1.Failed,
+						PhaseTransitionTimestamp: metav1.Now(),
+					},
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				shouldExpectVMIFinalizerRemoval()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+
+				Expect(vm.Status.StartFailure).ToNot(BeNil())
+				Expect(vm.Status.StartFailure.LastFailedVMIUID).To(Equal(vmi.UID))
+				Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(1))
+			})
+
+			It("should keep start failures until the VMI ran for the reset window", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.CrashLoopBackoff = &v1.CrashLoopBackoff{
+					ResetWindow: &metav1.Duration{Duration: 10 * time.Minute},
+				}
+				vmi.UID = "456"
+				vmi.Status.Phase = v1.Running
+				vmi.Status.PhaseTransitionTimestamps = []v1.VirtualMachineInstancePhaseTransitionTimestamp{
+					{
+						Phase:                    v1.Running,
+						PhaseTransitionTimestamp: metav1.Now(),
+					},
+				}
+				vm.Status.StartFailure = &v1.VirtualMachineStartFailure{
+					LastFailedVMIUID:     "123",
+					ConsecutiveFailCount: 1,
+					RetryAfterTimestamp: &metav1.Time{
+						Time: time.Now().Add(-300 * time.Second),
+					},
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.StartFailure).ToNot(BeNil())
+				Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(1))
+			})
+
+			It("should cap the retry delay with the configured max delay", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							VirtualMachineOptions: &v1.VirtualMachineOptions{
+								CrashLoopBackoff: &v1.CrashLoopBackoff{
+									MaxDelay: &metav1.Duration{Duration: 30 * time.Second},
+								},
+							},
+						},
+					},
+				})
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vmi.UID = "456"
+				vmi.Status.Phase = v1.Failed
+				vm.Status.StartFailure = &v1.VirtualMachineStartFailure{
+					LastFailedVMIUID:     "123",
+					ConsecutiveFailCount: 5,
+					RetryAfterTimestamp: &metav1.Time{
+						Time: time.Now().Add(-300 * time.Second),
+					},
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				shouldExpectVMIFinalizerRemoval()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+
+				Expect(vm.Status.StartFailure).ToNot(BeNil())
+				Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(6))
+				Expect(vm.Status.StartFailure.RetryAfterTimestamp.Time).To(BeTemporally("~", time.Now().Add(30*time.Second), 2*time.Second))
+			})
+
 			DescribeTable("should clear existing start failures when runStrategy is halted or manual", func(runStrategy v1.VirtualMachineRunStrategy) {
 				vm, vmi := watchtesting.DefaultVirtualMachine(true)
 				vmi.UID = "456"
@@ -1636,7 +1757,7 @@ var _ = Describe("VirtualMachine", func() {
 			DescribeTable("should calculated expected backoff delay", func(failCount, minExpectedDelay int, maxExpectedDelay int) {
 
 				for i := 0; i < 1000; i++ {
-					delay := calculateStartBackoffTime(failCount, defaultMaxCrashLoopBackoffDelaySeconds)
+					delay := calculateStartBackoffTime(failCount, 0, defaultMaxCrashLoopBackoffDelaySeconds)
 
 					// check that minExpectedDelay <= delay <= maxExpectedDelay
 					Expect(delay).To(And(BeNumerically(">=", minExpectedDelay), BeNumerically("<=", maxExpectedDelay)))
@@ -1652,6 +1773,17 @@ var _ = Describe("VirtualMachine", func() {
 				Entry("failCount 6", 6, 300, 300),
 			)
 
+			DescribeTable("should calculate the backoff delay from the base delay", func(failCount, minExpectedDelay int, maxExpectedDelay int) {
+				for i := 0; i < 1000; i++ {
+					delay := calculateStartBackoffTime(failCount, 2, 60)
+					Expect(delay).To(And(BeNumerically(">=", minExpectedDelay), BeNumerically("<=", maxExpectedDelay)))
+				}
+			},
+				Entry("failCount 1", 1, 2, 3),
+				Entry("failCount 3", 3, 18, 27),
+				Entry("failCount 6", 6, 60, 60),
+			)
+
 			DescribeTable("has start failure backoff expired", func(vmFunc func() *v1.VirtualMachine, expected int64) {
 				vm := vmFunc()
 				seconds := startFailureBackoffTimeLeft(vm)
@@ -2535,6 +2667,257 @@ var _ = Describe("VirtualMachine", func() {
 			Entry("with run strategy RerunOnFailure", v1.RunStrategyRerunOnFailure),
 		)
 
+		Context("with run strategy Once", func() {
+			DescribeTable("should record the completion of a final VirtualMachineInstance", func(phase v1.VirtualMachineInstancePhase, expectedStatus v1.VirtualMachinePrintableStatus) {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyOnce)
+				vmi.Status.Phase = phase
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.Completion).ToNot(BeNil())
+				Expect(vm.Status.Completion.Phase).To(Equal(phase))
+				Expect(vm.Status.Completion.CompletionTime).ToNot(BeNil())
+				Expect(vm.Status.PrintableStatus).To(Equal(expectedStatus))
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			},
+				Entry("when it succeeded", v1.Succeeded, v1.VirtualMachineStatusSucceeded),
+				Entry("when it failed", v1.Failed, v1.VirtualMachineStatusFailed),
+			)
+
+			It("should not re-create the VirtualMachineInstance once completed", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyOnce)
+				vm.Status.RunStrategy = v1.RunStrategyOnce
+				vm.Status.Completion = &v1.VirtualMachineCompletion{
+					Phase:          v1.Succeeded,
+					CompletionTime: pointer.P(metav1.Now()),
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusSucceeded))
+			})
+
+			It("should clear the completion when the run strategy changes", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyHalted)
+				vm.Status.Completion = &v1.VirtualMachineCompletion{
+					Phase:          v1.Failed,
+					CompletionTime: pointer.P(metav1.Now()),
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.Completion).To(BeNil())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusStopped))
+			})
+		})
+
+		Context("suspended to disk", func() {
+			const suspendedVMIUID = types.UID("suspended-vmi-uid")
+
+			newSuspendedVirtualMachine := func(phase v1.VirtualMachineSuspendPhase, runStrategy v1.VirtualMachineRunStrategy) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(runStrategy)
+				vm.Status.Suspend = &v1.VirtualMachineSuspendStatus{
+					Phase:  phase,
+					VMIUID: suspendedVMIUID,
+				}
+				vmi.UID = suspendedVMIUID
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+				return vm, vmi
+			}
+
+			addVMI := func(vmi *v1.VirtualMachineInstance) {
+				vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+			}
+
+			It("should mark the VirtualMachine suspended once its memory got saved", func() {
+				vm, vmi := newSuspendedVirtualMachine(v1.VirtualMachineSuspending, v1.RunStrategyAlways)
+				vmi.Status.Phase = v1.Succeeded
+				addVMI(vmi)
+
+				sanityExecute(vm)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.Suspend).ToNot(BeNil())
+				Expect(vm.Status.Suspend.Phase).To(Equal(v1.VirtualMachineSuspended))
+				Expect(vm.Status.Suspend.SuspendTime).ToNot(BeNil())
+				Expect(vm.Status.Completion).To(BeNil())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusSuspended))
+			})
+
+			It("should keep the VirtualMachineInstance running while it is being suspended", func() {
+				vm, vmi := newSuspendedVirtualMachine(v1.VirtualMachineSuspending, v1.RunStrategyAlways)
+				vmi.Status.Phase = v1.Running
+				addVMI(vmi)
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusSuspending))
+			})
+
+			It("should abort the suspension if the VirtualMachineInstance failed", func() {
+				vm, vmi := newSuspendedVirtualMachine(v1.VirtualMachineSuspending, v1.RunStrategyAlways)
+				vmi.Status.Phase = v1.Failed
+				addVMI(vmi)
+
+				sanityExecute(vm)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.Suspend).To(BeNil())
+			})
+
+			DescribeTable("when virt-launcher failed to save the memory", func(vmConditions []v1.VirtualMachineCondition, expectAborted bool) {
+				vm, vmi := newSuspendedVirtualMachine(v1.VirtualMachineSuspending, v1.RunStrategyAlways)
+				vm.Status.Conditions = vmConditions
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).UpdateStatus(context.TODO(), vm, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmIndexer.Update(vm)
+				vmi.Status.Phase = v1.Running
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:               v1.VirtualMachineInstanceSuspendFailed,
+					Status:             k8sv1.ConditionTrue,
+					Reason:             v1.VirtualMachineInstanceReasonSaveFailed,
+					Message:            "no space left on device",
+					LastTransitionTime: metav1.Unix(2000, 0),
+				}}
+				addVMI(vmi)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				if !expectAborted {
+					Expect(vm.Status.Suspend).ToNot(BeNil())
+					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusSuspending))
+					return
+				}
+				Expect(vm.Status.Suspend).To(BeNil())
+				Expect(vm.Status.Conditions).To(ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"Type":               Equal(v1.VirtualMachineSuspendFailed),
+					"Status":             Equal(k8sv1.ConditionTrue),
+					"Reason":             Equal(v1.VirtualMachineInstanceReasonSaveFailed),
+					"Message":            Equal("no space left on device"),
+					"LastTransitionTime": Equal(metav1.Unix(2000, 0)),
+				})))
+			},
+				Entry("should abort the suspension and report the failure", nil, true),
+				Entry("should abort the suspension on a new failure",
+					[]v1.VirtualMachineCondition{{Type: v1.VirtualMachineSuspendFailed, Status: k8sv1.ConditionTrue, LastTransitionTime: metav1.Unix(1000, 0)}},
+					true,
+				),
+				Entry("should ignore the failure of a previous suspension",
+					[]v1.VirtualMachineCondition{{Type: v1.VirtualMachineSuspendFailed, Status: k8sv1.ConditionTrue, LastTransitionTime: metav1.Unix(2000, 0)}},
+					false,
+				),
+			)
+
+			It("should delete the suspended VirtualMachineInstance", func() {
+				vm, vmi := newSuspendedVirtualMachine(v1.VirtualMachineSuspended, v1.RunStrategyAlways)
+				vmi.Status.Phase = v1.Succeeded
+				addVMI(vmi)
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should not start a suspended VirtualMachine", func() {
+				vm, _ := newSuspendedVirtualMachine(v1.VirtualMachineSuspended, v1.RunStrategyAlways)
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusSuspended))
+			})
+
+			It("should start a VirtualMachineInstance restoring the saved state when resuming", func() {
+				vm, _ := newSuspendedVirtualMachine(v1.VirtualMachineResuming, v1.RunStrategyAlways)
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmi.Annotations).To(HaveKeyWithValue(v1.RestoreSavedStateAnnotation, "true"))
+			})
+
+			It("should clear the suspension once the resumed VirtualMachineInstance is running", func() {
+				vm, vmi := newSuspendedVirtualMachine(v1.VirtualMachineResuming, v1.RunStrategyAlways)
+				vmi.UID = "resumed-vmi-uid"
+				vmi.Status.Phase = v1.Running
+				addVMI(vmi)
+
+				sanityExecute(vm)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.Suspend).To(BeNil())
+			})
+
+			It("should discard the saved state when the VirtualMachine gets stopped", func() {
+				vm, _ := newSuspendedVirtualMachine(v1.VirtualMachineSuspended, v1.RunStrategyHalted)
+
+				sanityExecute(vm)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.Suspend).To(BeNil())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusStopped))
+			})
+		})
+
 		It("should ignore the name of a VirtualMachineInstance templates", func() {
 			vm, _ := watchtesting.DefaultVirtualMachineWithNames(true, "vmname", "vminame")
 
@@ -3453,6 +3836,190 @@ var _ = Describe("VirtualMachine", func() {
 
 		})
 
+		Context("dependsOn", func() {
+			enableDependencies := func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{featuregate.VirtualMachineDependenciesGate},
+							},
+						},
+					},
+				})
+			}
+
+			newDependencyVMI := func(ready k8sv1.ConditionStatus) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("database")
+				vmi.Status.Phase = v1.Running
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:   v1.VirtualMachineInstanceReady,
+					Status: ready,
+				}}
+				return vmi
+			}
+
+			createDependentVM := func() *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.DependsOn = []v1.VirtualMachineDependency{{Name: "database"}}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+				return vm
+			}
+
+			DescribeTable("should delay the start until the dependencies are ready", func(dependencyVMI *v1.VirtualMachineInstance) {
+				enableDependencies()
+				if dependencyVMI != nil {
+					Expect(controller.vmiIndexer.Add(dependencyVMI)).To(Succeed())
+				}
+				vm := createDependentVM()
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound"))
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusWaitingForDependencies))
+			},
+				Entry("when the dependency is not running", nil),
+				Entry("when the dependency is not ready", newDependencyVMI(k8sv1.ConditionFalse)),
+			)
+
+			It("should start the VM when the dependencies are ready", func() {
+				enableDependencies()
+				Expect(controller.vmiIndexer.Add(newDependencyVMI(k8sv1.ConditionTrue))).To(Succeed())
+				vm := createDependentVM()
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should ignore the dependencies if the feature gate is disabled", func() {
+				vm := createDependentVM()
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should enqueue the dependent VMs when a dependency becomes ready", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.DependsOn = []v1.VirtualMachineDependency{{Name: "database"}}
+				Expect(controller.vmIndexer.Add(vm)).To(Succeed())
+
+				oldVMI := newDependencyVMI(k8sv1.ConditionFalse)
+				oldVMI.ResourceVersion = "1"
+				newVMI := newDependencyVMI(k8sv1.ConditionTrue)
+				newVMI.ResourceVersion = "2"
+				controller.updateVirtualMachineInstance(oldVMI, newVMI)
+
+				Expect(mockQueue.Len()).To(Equal(1))
+				key, _ := controller.Queue.Get()
+				Expect(key).To(Equal(virtcontroller.NamespacedKey(vm.Namespace, vm.Name)))
+			})
+		})
+
+		Context("revision history", func() {
+			enableRevisionHistory := func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{featuregate.VirtualMachineRevisionHistoryGate},
+							},
+						},
+					},
+				})
+			}
+
+			newHistoryRevision := func(vm *v1.VirtualMachine, hostname string, revision int64) *appsv1.ControllerRevision {
+				spec := vm.Spec.DeepCopy()
+				spec.Template.Spec.Hostname = hostname
+				data, err := revisionhistory.Data(spec)
+				Expect(err).ToNot(HaveOccurred())
+				cr := &appsv1.ControllerRevision{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            revisionhistory.Name(vm.UID, data),
+						Namespace:       vm.Namespace,
+						Labels:          map[string]string{v1.VirtualMachineRevisionHistoryLabel: vm.Name},
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)},
+					},
+					Data:     runtime.RawExtension{Raw: data},
+					Revision: revision,
+				}
+				_, err = k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.TODO(), cr, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.crIndexer.Add(cr)).To(Succeed())
+				return cr
+			}
+
+			listHistoryRevisions := func(vm *v1.VirtualMachine) map[string]int64 {
+				crs, err := k8sClient.AppsV1().ControllerRevisions(vm.Namespace).List(context.TODO(), metav1.ListOptions{
+					LabelSelector: v1.VirtualMachineRevisionHistoryLabel + "=" + vm.Name,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				revisions := map[string]int64{}
+				for _, cr := range crs.Items {
+					revisions[cr.Name] = cr.Revision
+				}
+				return revisions
+			}
+
+			It("should record the spec of the VM", func() {
+				enableRevisionHistory()
+				vm, _ := watchtesting.DefaultVirtualMachine(false)
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				data, err := revisionhistory.Data(&vm.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(listHistoryRevisions(vm)).To(Equal(map[string]int64{revisionhistory.Name(vm.UID, data): 1}))
+			})
+
+			It("should not record the spec of the VM if the feature gate is disabled", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(false)
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				Expect(listHistoryRevisions(vm)).To(BeEmpty())
+			})
+
+			It("should move the revision the VM was rolled back to on top of the history", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(false)
+				first := newHistoryRevision(vm, "first", 1)
+				second := newHistoryRevision(vm, "second", 2)
+				vm.Spec.Template.Spec.Hostname = "first"
+
+				Expect(controller.syncRevisionHistory(vm)).To(Succeed())
+
+				Expect(listHistoryRevisions(vm)).To(Equal(map[string]int64{first.Name: 3, second.Name: 2}))
+			})
+
+			It("should prune the revisions exceeding the history limit", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(false)
+				vm.Spec.RevisionHistoryLimit = pointer.P(int32(1))
+				newHistoryRevision(vm, "first", 1)
+				newHistoryRevision(vm, "second", 2)
+				third := newHistoryRevision(vm, "third", 3)
+				vm.Spec.Template.Spec.Hostname = "fourth"
+
+				Expect(controller.syncRevisionHistory(vm)).To(Succeed())
+
+				data, err := revisionhistory.Data(&vm.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(listHistoryRevisions(vm)).To(Equal(map[string]int64{third.Name: 3, revisionhistory.Name(vm.UID, data): 4}))
+			})
+		})
+
 		Context("Changed Block Tracking", func() {
 			createKubeVirtConfigWithCBT := func(enableFeatureGate bool) *v1.KubeVirt {
 				labelSelector := &metav1.LabelSelector{
@@ -5505,7 +6072,7 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(vmi.Spec.Domain.Resources.Limits.Cpu().String()).To(Equal(expectedCpuLim.String()))
 				})
 
-				It("should raise RestartRequired condition for ARM64 VM", func() {
+				It("should patch VMI when CPU hotplug is requested on an ARM64 VM", func() {
 					vm, _ := watchtesting.DefaultVirtualMachine(true)
 					vm.Spec.Template.Spec.Architecture = "arm64"
 					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
@@ -5529,7 +6096,125 @@ var _ = Describe("VirtualMachine", func() {
 
 					vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
 					Expect(err).NotTo(HaveOccurred())
+					Expect(vm).To(matcher.HaveConditionMissingOrFalse(v1.VirtualMachineRestartRequired))
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(vmi.Spec.Domain.CPU.Sockets).To(Equal(uint32(3)))
+				})
+
+				It("should patch VMI when CPU unplug is requested and the guest agent is connected", func() {
+					resources := v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU: resource.MustParse("300m"),
+						},
+					}
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Resources = resources
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    3,
+						MaxSockets: 4,
+					}
+					vmi.Spec.Domain.Resources = resources
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: k8sv1.ConditionTrue,
+					}}
+
+					vcpusDelta := int64(vm.Spec.Template.Spec.Domain.CPU.Sockets) - int64(vmi.Spec.Domain.CPU.Sockets)
+					resourcesDelta := resource.NewMilliQuantity(vcpusDelta*int64(1000*(1.0/float32(config.GetCPUAllocationRatio()))), resource.DecimalSI)
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+					Expect(vm).ToNot(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(vm.Spec.Template.Spec.Domain.CPU.Sockets))
+
+					expectedCpuReq := vmi.Spec.Domain.Resources.Requests.Cpu().DeepCopy()
+					expectedCpuReq.Add(*resourcesDelta)
+					Expect(updatedVMI.Spec.Domain.Resources.Requests.Cpu().String()).To(Equal(expectedCpuReq.String()))
+				})
+
+				It("should raise RestartRequired condition when CPU unplug is requested and the guest agent is not connected", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    2,
+						MaxSockets: 4,
+					}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
 					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+				})
+
+				It("should raise RestartRequired condition when a previous CPU hotplug failed", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    1,
+						MaxSockets: 4,
+					}
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+						Type:   v1.VirtualMachineInstanceVCPUChange,
+						Status: k8sv1.ConditionFalse,
+					}}
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+				})
+
+				It("should restore the running CPU topology of the VMI when a failed CPU hotplug is reverted", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    2,
+						MaxSockets: 4,
+					}
+					vmi.Status.CurrentCPUTopology = &v1.CPUTopology{
+						Sockets: 1,
+					}
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+						Type:   v1.VirtualMachineInstanceVCPUChange,
+						Status: k8sv1.ConditionFalse,
+					}}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+					Expect(vm).ToNot(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(uint32(1)))
 				})
 			})
 
@@ -6397,6 +7082,77 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(vm.Status.Conditions).To(restartRequiredMatcher(k8sv1.ConditionTrue), "restart required")
 			})
 
+			It("should appear when changing the start strategy", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+
+				By("Creating a VMI started paused")
+				vm.Spec.Template.Spec.StartStrategy = pointer.P(v1.StartStrategyPaused)
+				vmi = SetupVMIFromVM(vm)
+				controller.vmiIndexer.Add(vmi)
+				controller.crIndexer.Add(createVMRevision(vm))
+
+				By("Removing the start strategy")
+				vm.Spec.Template.Spec.StartStrategy = nil
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				By("Executing the controller expecting the RestartRequired condition to appear")
+				sanityExecute(vm)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.Conditions).To(restartRequiredMatcher(k8sv1.ConditionTrue))
+			})
+
+			DescribeTable("should not appear when promoting a standby VM of a pool", func(rolloutStrategy v1.VMRolloutStrategy) {
+				kv.Spec.Configuration.VMRolloutStrategy = &rolloutStrategy
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+
+				userTerm := k8sv1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					TopologyKey:   k8sv1.LabelHostname,
+				}
+				standbyTerm := k8sv1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{v1.VirtualMachinePoolNameLabel: "pool"},
+						MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      v1.VirtualMachinePoolStandbyLabel,
+							Operator: metav1.LabelSelectorOpDoesNotExist,
+						}},
+					},
+					TopologyKey: k8sv1.LabelHostname,
+				}
+
+				By("Creating a VMI of a standby VM")
+				vm.Spec.Template.ObjectMeta.Labels = map[string]string{v1.VirtualMachinePoolStandbyLabel: "true"}
+				vm.Spec.Template.Spec.StartStrategy = pointer.P(v1.StartStrategyPaused)
+				vm.Spec.Template.Spec.Affinity = &k8sv1.Affinity{PodAntiAffinity: &k8sv1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []k8sv1.PodAffinityTerm{userTerm, standbyTerm},
+				}}
+				vmi = SetupVMIFromVM(vm)
+				controller.vmiIndexer.Add(vmi)
+				controller.crIndexer.Add(createVMRevision(vm))
+
+				By("Promoting the standby VM")
+				delete(vm.Spec.Template.ObjectMeta.Labels, v1.VirtualMachinePoolStandbyLabel)
+				vm.Spec.Template.Spec.StartStrategy = nil
+				vm.Spec.Template.Spec.Affinity = &k8sv1.Affinity{PodAntiAffinity: &k8sv1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []k8sv1.PodAffinityTerm{userTerm},
+				}}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				By("Executing the controller expecting no RestartRequired condition")
+				sanityExecute(vm)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.Conditions).ToNot(restartRequiredMatcher(k8sv1.ConditionTrue))
+			},
+				Entry("with Stage rollout strategy", v1.VMRolloutStrategyStage),
+				Entry("with LiveUpdate rollout strategy", v1.VMRolloutStrategyLiveUpdate),
+			)
+
 			It("should appear when VM doesn't specify maxSockets and sockets go above cluster-wide maxSockets", func() {
 				var maxSockets uint32 = 8
 
@@ -6863,6 +7619,27 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(vmi).ToNot(BeNil())
 			})
 
+			It("when starting a VM with Manual the trace context of the start request should be propagated to the VMI", func() {
+				const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyManual)
+				vm.Status.StateChangeRequests = []v1.VirtualMachineStateChangeRequest{{
+					Action: v1.StartRequest,
+					Data:   map[string]string{v1.StateChangeRequestDataTraceParentKey: traceParent},
+				}}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmi.Annotations).To(HaveKeyWithValue(v1.TraceParentAnnotation, traceParent))
+			})
+
 			It("when starting a VM with Manual and DVs are not ready the start request should not get trimmed", func() {
 				vm, _ := watchtesting.DefaultVirtualMachine(true)
 				vm.Spec.Running = nil
@@ -59,6 +59,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/launcherreservation"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
@@ -170,6 +171,10 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return common.NewSyncError(fmt.Errorf("failed create validation: %v", validateErr), "FailedCreateValidation"), pod
 		}
 
+		if !isWaitForFirstConsumer {
+			c.claimLauncherReservation(vmi, templatePod)
+		}
+
 		vmiKey := controller.VirtualMachineInstanceKey(vmi)
 		pod, err := c.createPod(vmiKey, vmi.Namespace, templatePod)
 		if k8serrors.IsForbidden(err) && strings.Contains(err.Error(), "violates PodSecurity") {
@@ -399,6 +404,9 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 			// Add PodScheduled False condition to the VM
 			if podConditionManager.HasConditionWithStatus(pod, k8sv1.PodScheduled, k8sv1.ConditionFalse) {
 				conditionManager.AddPodCondition(vmiCopy, podConditionManager.GetCondition(pod, k8sv1.PodScheduled))
+				if cpuModelErr := c.checkForUnavailableCPUModel(vmi); cpuModelErr != nil {
+					syncErr = cpuModelErr
+				}
 			} else if conditionManager.HasCondition(vmiCopy, virtv1.VirtualMachineInstanceConditionType(k8sv1.PodScheduled)) {
 				// Remove PodScheduling condition from the VM
 				conditionManager.RemoveCondition(vmiCopy, virtv1.VirtualMachineInstanceConditionType(k8sv1.PodScheduled))
@@ -517,6 +525,9 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 
 		if c.requireCPUHotplug(vmiCopy) {
 			syncHotplugCondition(vmiCopy, virtv1.VirtualMachineInstanceVCPUChange)
+		} else if conditionManager.HasConditionWithStatus(vmiCopy, virtv1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionFalse) {
+			// A failed vCPU change no longer applies once the spec is back at the running CPU topology
+			conditionManager.RemoveCondition(vmiCopy, virtv1.VirtualMachineInstanceVCPUChange)
 		}
 
 		if c.requireMemoryHotplug(vmiCopy) {
@@ -531,6 +542,8 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 
 		c.checkEphemeralHotplugVolumes(vmiCopy)
 
+		c.syncNodeShutdownStarted(vmiCopy, pod)
+
 	case vmi.IsScheduled():
 		if !vmiPodExists || (vmiCopy.IsMigrationTarget() && controller.PodIsDown(pod)) || migrationTargetFailed {
 			if vmiCopy.IsMigrationTarget() {
@@ -569,6 +582,8 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 		return fmt.Errorf("unknown vmi phase %v", vmi.Status.Phase)
 	}
 
+	c.syncNodeShutdownCondition(vmiCopy)
+
 	if vmiCopy.IsMarkedForEviction() {
 		if !conditionManager.HasConditionWithStatus(vmiCopy, virtv1.VirtualMachineInstanceEvictionRequested, k8sv1.ConditionTrue) {
 			now := v1.Now()
@@ -630,6 +645,86 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 	return nil
 }
 
+// syncNodeShutdownStarted reports that the kubelet terminates the virt-launcher pod of a VMI
+// during the graceful shutdown of its node, virt-handler then decides how the VMI is handled
+func (c *Controller) syncNodeShutdownStarted(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if !c.clusterConfig.NodeGracefulShutdownEnabled() || !isPodTerminatedByNodeShutdown(pod) {
+		return
+	}
+
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	cond := conditionManager.GetCondition(vmi, virtv1.VirtualMachineInstanceNodeShutdown)
+	if cond != nil && cond.Reason != virtv1.VirtualMachineInstanceReasonNodeShutdownMigrated {
+		return
+	}
+
+	now := v1.Now()
+	conditionManager.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstanceNodeShutdown,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             virtv1.VirtualMachineInstanceReasonNodeShutdownStarted,
+		Message:            fmt.Sprintf("The kubelet terminates the virt-launcher pod as node %s shuts down", pod.Spec.NodeName),
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
+// isPodTerminatedByNodeShutdown tells whether the kubelet terminates the pod, as it does during the graceful
+// shutdown of the node. The pod keeps its phase and reason until its containers stopped, only its
+// DisruptionTarget condition is reported right away.
+func isPodTerminatedByNodeShutdown(pod *k8sv1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == k8sv1.DisruptionTarget {
+			return cond.Status == k8sv1.ConditionTrue && cond.Reason == k8sv1.PodReasonTerminationByKubelet
+		}
+	}
+	return false
+}
+
+// syncNodeShutdownCondition records the outcome of the handling of a VMI by
+// virt-handler during the graceful shutdown of its node
+func (c *Controller) syncNodeShutdownCondition(vmi *virtv1.VirtualMachineInstance) {
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	cond := conditionManager.GetCondition(vmi, virtv1.VirtualMachineInstanceNodeShutdown)
+	if cond == nil {
+		return
+	}
+
+	var reason, message string
+	switch {
+	case vmi.IsFinal() && (cond.Reason == virtv1.VirtualMachineInstanceReasonNodeShutdownMigrating ||
+		cond.Reason == virtv1.VirtualMachineInstanceReasonNodeShutdownStopping):
+		reason = virtv1.VirtualMachineInstanceReasonNodeShutdownStopped
+		message = "The VMI was stopped as its node shut down"
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, reason, message)
+	case cond.Reason == virtv1.VirtualMachineInstanceReasonNodeShutdownMigrating && hasMigratedSince(vmi, cond):
+		reason = virtv1.VirtualMachineInstanceReasonNodeShutdownMigrated
+		message = fmt.Sprintf("The VMI was live migrated away from its shutting down node %s", vmi.Status.MigrationState.SourceNode)
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, reason, message)
+	default:
+		return
+	}
+
+	now := v1.Now()
+	conditionManager.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstanceNodeShutdown,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
+// hasMigratedSince tells whether a migration of the VMI to another node completed after the condition was set
+func hasMigratedSince(vmi *virtv1.VirtualMachineInstance, cond *virtv1.VirtualMachineInstanceCondition) bool {
+	state := vmi.Status.MigrationState
+	if state == nil || !state.Completed || state.Failed || state.EndTimestamp == nil {
+		return false
+	}
+	return state.SourceNode != vmi.Status.NodeName && !state.EndTimestamp.Before(&cond.LastTransitionTime)
+}
+
 func (c *Controller) addTopologyHints(vmi *virtv1.VirtualMachineInstance, vmiCopy *virtv1.VirtualMachineInstance) error {
 	if vmi.Status.TopologyHints == nil {
 		if topologyHints, tscRequirement, err := c.topologyHinter.TopologyHintsForVMI(vmi); err != nil && tscRequirement == topology.RequiredForBoot {
@@ -827,7 +922,7 @@ func (c *Controller) syncDynamicAnnotationsAndLabelsToPod(vmi *virtv1.VirtualMac
 		}
 	}
 
-	dynamicLabels := []string{virtv1.NodeNameLabel, virtv1.OutdatedLauncherImageLabel}
+	dynamicLabels := []string{virtv1.NodeNameLabel, virtv1.OutdatedLauncherImageLabel, virtv1.VirtualMachinePoolStandbyLabel}
 	dynamicLabels = append(dynamicLabels, c.additionalLauncherLabelsSync...)
 
 	generatedAnnotations, err := c.storageAnnotationsGenerator.Generate(vmi)
@@ -1020,6 +1115,26 @@ func checkForContainerImageError(pod *k8sv1.Pod) common.SyncError {
 	return nil
 }
 
+// checkForUnavailableCPUModel explains why the pod of a VMI requesting a CPU model which is
+// obsolete or not allowed in the cluster can't be scheduled.
+func (c *Controller) checkForUnavailableCPUModel(vmi *virtv1.VirtualMachineInstance) common.SyncError {
+	if vmi.Spec.Domain.CPU == nil {
+		return nil
+	}
+	model := vmi.Spec.Domain.CPU.Model
+	if model == "" || model == virtv1.CPUModeHostModel || model == virtv1.CPUModeHostPassthrough {
+		return nil
+	}
+
+	if _, obsolete := c.clusterConfig.GetObsoleteCPUModels()[model]; obsolete {
+		return &informalSyncError{fmt.Errorf("CPU model %s is obsolete in the cluster", model), controller.CPUModelNotAvailableReason}
+	}
+	if allowed := c.clusterConfig.GetAllowedCPUModels(); len(allowed) > 0 && !slices.Contains(allowed, model) {
+		return &informalSyncError{fmt.Errorf("CPU model %s is not allowed in the cluster", model), controller.CPUModelNotAvailableReason}
+	}
+	return nil
+}
+
 func (c *Controller) deleteAllMatchingPods(vmi *virtv1.VirtualMachineInstance) error {
 	pods, err := c.listPodsFromNamespace(vmi.Namespace)
 	if err != nil {
@@ -1102,6 +1217,25 @@ func (c *Controller) deletePod(vmiKey string, pod *k8sv1.Pod, options v1.DeleteO
 	return err
 }
 
+// claimLauncherReservation deletes a placeholder pod of a launcher reservation and makes the virt-launcher pod
+// prefer its node. Starting the VMI does not depend on it, the pod is scheduled as usual if no placeholder pod
+// is available.
+func (c *Controller) claimLauncherReservation(vmi *virtv1.VirtualMachineInstance, templatePod *k8sv1.Pod) {
+	if c.reservationClaimer == nil {
+		return
+	}
+	reservationPod, err := c.reservationClaimer.Claim(vmi, templatePod)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("Failed to claim a launcher reservation")
+		return
+	}
+	if reservationPod == nil {
+		return
+	}
+	launcherreservation.ApplyClaim(templatePod, reservationPod)
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.ClaimedLauncherReservationReason, "Claimed launcher reservation pod %s on node %s", reservationPod.Name, reservationPod.Labels[virtv1.NodeNameLabel])
+}
+
 func (c *Controller) createPod(key, namespace string, pod *k8sv1.Pod) (*k8sv1.Pod, error) {
 	c.podExpectations.ExpectCreations(key, 1)
 	pod, err := c.clientset.CoreV1().Pods(namespace).Create(context.Background(), pod, v1.CreateOptions{})
@@ -76,6 +76,7 @@ func NewController(templateService templateService,
 	netMigrationEvaluator migrationEvaluator,
 	additionalLauncherAnnotationsSync []string,
 	additionalLauncherLabelsSync []string,
+	reservationClaimer launcherReservationClaimer,
 ) (*Controller, error) {
 
 	c := &Controller{
@@ -108,6 +109,7 @@ func NewController(templateService templateService,
 		netMigrationEvaluator:             netMigrationEvaluator,
 		additionalLauncherAnnotationsSync: additionalLauncherAnnotationsSync,
 		additionalLauncherLabelsSync:      additionalLauncherLabelsSync,
+		reservationClaimer:                reservationClaimer,
 	}
 
 	c.hasSynced = func() bool {
@@ -196,6 +198,10 @@ type templateService interface {
 	GetLauncherImage() string
 }
 
+type launcherReservationClaimer interface {
+	Claim(vmi *virtv1.VirtualMachineInstance, launcherPod *k8sv1.Pod) (*k8sv1.Pod, error)
+}
+
 type annotationsGenerator interface {
 	GenerateFromActivePod(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) map[string]string
 }
@@ -247,6 +253,7 @@ type Controller struct {
 	netMigrationEvaluator             migrationEvaluator
 	additionalLauncherAnnotationsSync []string
 	additionalLauncherLabelsSync      []string
+	reservationClaimer                launcherReservationClaimer
 }
 
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
@@ -265,6 +272,8 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	}
 	c.cidsMap.Sync(vmis)
 
+	c.clusterConfig.SetConfigModifiedCallback(c.enqueueSchedulingVirtualMachineInstances)
+
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
@@ -531,6 +540,16 @@ func (c *Controller) lowerVMIExpectation(curr interface{}) {
 	c.vmiExpectations.SetExpectations(key, 0, 0)
 }
 
+// enqueueSchedulingVirtualMachineInstances re-evaluates the VMIs whose pod is not scheduled yet,
+// since their scheduling constraints may depend on the cluster configuration, e.g. on the CPU models.
+func (c *Controller) enqueueSchedulingVirtualMachineInstances() {
+	for _, obj := range c.vmiIndexer.List() {
+		if vmi := obj.(*virtv1.VirtualMachineInstance); vmi.IsScheduling() {
+			c.enqueueVirtualMachine(vmi)
+		}
+	}
+}
+
 func (c *Controller) enqueueVirtualMachine(obj interface{}) {
 	logger := log.Log
 	vmi := obj.(*virtv1.VirtualMachineInstance)
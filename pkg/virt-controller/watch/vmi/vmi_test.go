@@ -68,6 +68,7 @@ import (
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
@@ -254,6 +255,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			stubMigrationEvaluator{result: k8sv1.ConditionUnknown},
 			[]string{},
 			[]string{},
+			nil,
 		)
 		// Wrap our workqueue to have a way to detect when we are done processing updates
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
@@ -1015,6 +1017,47 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			))
 		})
 
+		It("should make the Pod prefer the node of a claimed launcher reservation pod", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			reservationPod := &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "virt-launcher-reservation-small-abcde",
+					Namespace: "kubevirt",
+					Labels:    map[string]string{virtv1.NodeNameLabel: "node01"},
+				},
+			}
+			controller.reservationClaimer = stubLauncherReservationClaimer{pod: reservationPod}
+
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, kvcontroller.ClaimedLauncherReservationReason, kvcontroller.SuccessfulCreatePodReason)
+			expectMatchingPodCreation(vmi, WithTransform(
+				func(pod *k8sv1.Pod) map[string]string {
+					return pod.Annotations
+				},
+				HaveKeyWithValue(virtv1.ClaimedLauncherReservationAnnotation, "kubevirt/virt-launcher-reservation-small-abcde"),
+			))
+		})
+
+		It("should create the Pod when claiming a launcher reservation fails", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			controller.reservationClaimer = stubLauncherReservationClaimer{err: fmt.Errorf("conflict")}
+
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			expectMatchingPodCreation(vmi, WithTransform(
+				func(pod *k8sv1.Pod) map[string]string {
+					return pod.Annotations
+				},
+				Not(HaveKey(virtv1.ClaimedLauncherReservationAnnotation)),
+			))
+		})
+
 		It("should add request-evict-only annotation to the virt-launcher pod if annotation does not exist", func() {
 			vmi := newPendingVirtualMachine("testvmi")
 			setReadyCondition(vmi, k8sv1.ConditionTrue, "")
@@ -2063,6 +2106,48 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			Entry("ImagePullBackOff in compute container", false, kvcontroller.ImagePullBackOffReason),
 		)
 
+		DescribeTable("when the pod can't be scheduled", func(cpuModel string, cpuModels *virtv1.CPUModelsConfiguration, expectSynchronized bool) {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.CPUModels = cpuModels
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Spec.Domain.CPU = &virtv1.CPU{Model: cpuModel}
+			vmi.Status.Phase = virtv1.Scheduling
+			pod := newPodForVirtualMachine(vmi, k8sv1.PodPending)
+			pod.Status.Conditions = append(pod.Status.Conditions, k8sv1.PodCondition{
+				Reason: "Unschedulable",
+				Status: k8sv1.ConditionFalse,
+				Type:   k8sv1.PodScheduled,
+			})
+
+			addVirtualMachine(vmi)
+			addPod(pod)
+
+			sanityExecute()
+			synchronizedFalse := ContainElement(MatchFields(IgnoreExtras,
+				Fields{
+					"Type":   Equal(virtv1.VirtualMachineInstanceSynchronized),
+					"Status": Equal(k8sv1.ConditionFalse),
+					"Reason": Equal(kvcontroller.CPUModelNotAvailableReason),
+				}))
+			if expectSynchronized {
+				expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, Not(synchronizedFalse))
+			} else {
+				expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, synchronizedFalse)
+			}
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(BeZero())
+		},
+			Entry("should set a Synchronized=False condition if the CPU model is obsolete",
+				"Penryn", &virtv1.CPUModelsConfiguration{Obsolete: []string{"Penryn"}}, false),
+			Entry("should set a Synchronized=False condition if the CPU model is not allowed",
+				"Penryn", &virtv1.CPUModelsConfiguration{Allowed: []string{"Haswell"}}, false),
+			Entry("should not set a Synchronized=False condition if the CPU model is allowed",
+				"Haswell", &virtv1.CPUModelsConfiguration{Allowed: []string{"Haswell"}}, true),
+			Entry("should not set a Synchronized=False condition for host-model",
+				virtv1.CPUModeHostModel, &virtv1.CPUModelsConfiguration{Allowed: []string{"Haswell"}}, true),
+		)
+
 		DescribeTable("should override Synchronized=False condition reason when it's already set", func(prevReason, newReason string) {
 			vmi := newPendingVirtualMachine("testvmi")
 			vmi.Status.Phase = virtv1.Scheduling
@@ -2780,6 +2865,112 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			})
 		})
 
+		Context("Node shutdown condition", func() {
+			setNodeShutdownCondition := func(vmi *virtv1.VirtualMachineInstance, reason string, transitionTime metav1.Time) {
+				kvcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
+					Type:               virtv1.VirtualMachineInstanceNodeShutdown,
+					Status:             k8sv1.ConditionTrue,
+					Reason:             reason,
+					LastTransitionTime: transitionTime,
+				})
+			}
+
+			DescribeTable("should mark a VMI stopped by the shutdown of its node", func(reason string) {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = virtv1.Failed
+				vmi.Finalizers = []string{}
+				setNodeShutdownCondition(vmi, reason, metav1.Now())
+				addVirtualMachine(vmi)
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, virtv1.VirtualMachineInstanceReasonNodeShutdownStopped)
+
+				updatedVmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVmi).To(matcher.HaveConditionTrueWithReason(virtv1.VirtualMachineInstanceNodeShutdown, virtv1.VirtualMachineInstanceReasonNodeShutdownStopped))
+			},
+				Entry("while stopping it", virtv1.VirtualMachineInstanceReasonNodeShutdownStopping),
+				Entry("while migrating it", virtv1.VirtualMachineInstanceReasonNodeShutdownMigrating),
+			)
+
+			DescribeTable("should mark a VMI migrated away from its shutting down node", func(failed bool, expectedReason string) {
+				conditionTime := metav1.NewTime(time.Now().Add(-time.Minute))
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = virtv1.Running
+				vmi.Status.NodeName = "target"
+				vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+					SourceNode:   "source",
+					TargetNode:   "target",
+					Completed:    true,
+					Failed:       failed,
+					EndTimestamp: pointer.P(metav1.Now()),
+				}
+				setNodeShutdownCondition(vmi, virtv1.VirtualMachineInstanceReasonNodeShutdownMigrating, conditionTime)
+
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Spec.NodeName = "target"
+				addActivePods(vmi, pod.UID, "")
+				addVirtualMachine(vmi)
+				addPod(pod)
+
+				sanityExecute()
+				if !failed {
+					testutils.ExpectEvent(recorder, virtv1.VirtualMachineInstanceReasonNodeShutdownMigrated)
+				}
+
+				updatedVmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVmi).To(matcher.HaveConditionTrueWithReason(virtv1.VirtualMachineInstanceNodeShutdown, expectedReason))
+			},
+				Entry("when the migration completed", false, virtv1.VirtualMachineInstanceReasonNodeShutdownMigrated),
+				Entry("unless the migration failed", true, virtv1.VirtualMachineInstanceReasonNodeShutdownMigrating),
+			)
+
+			DescribeTable("should report the shutdown of the node of a running VMI", func(featureGates []string, disruption *k8sv1.PodCondition, expectStarted bool) {
+				kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kvCR.Spec.Configuration.DeveloperConfiguration = &virtv1.DeveloperConfiguration{FeatureGates: featureGates}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = virtv1.Running
+
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				if disruption != nil {
+					pod.Status.Conditions = append(pod.Status.Conditions, *disruption)
+				}
+				addActivePods(vmi, pod.UID, "")
+				addVirtualMachine(vmi)
+				addPod(pod)
+
+				sanityExecute()
+
+				updatedVmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				if expectStarted {
+					Expect(updatedVmi).To(matcher.HaveConditionTrueWithReason(virtv1.VirtualMachineInstanceNodeShutdown, virtv1.VirtualMachineInstanceReasonNodeShutdownStarted))
+				} else {
+					Expect(updatedVmi).ToNot(matcher.HaveConditionTrue(virtv1.VirtualMachineInstanceNodeShutdown))
+				}
+			},
+				Entry("when the kubelet terminates its pod",
+					[]string{featuregate.NodeGracefulShutdownGate},
+					&k8sv1.PodCondition{Type: k8sv1.DisruptionTarget, Status: k8sv1.ConditionTrue, Reason: k8sv1.PodReasonTerminationByKubelet},
+					true,
+				),
+				Entry("unless its pod is disrupted by another component",
+					[]string{featuregate.NodeGracefulShutdownGate},
+					&k8sv1.PodCondition{Type: k8sv1.DisruptionTarget, Status: k8sv1.ConditionTrue, Reason: k8sv1.PodReasonPreemptionByScheduler},
+					false,
+				),
+				Entry("unless its pod is not disrupted", []string{featuregate.NodeGracefulShutdownGate}, nil, false),
+				Entry("unless the feature gate is disabled",
+					nil,
+					&k8sv1.PodCondition{Type: k8sv1.DisruptionTarget, Status: k8sv1.ConditionTrue, Reason: k8sv1.PodReasonTerminationByKubelet},
+					false,
+				),
+			)
+		})
+
 		DescribeTable("should set VirtualMachineUnpaused=False pod condition when VMI is paused", func(currUnpausedStatus k8sv1.ConditionStatus) {
 			vmi := newPendingVirtualMachine("testvmi")
 			vmi.Status.Phase = virtv1.Running
@@ -2833,6 +3024,35 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			Entry("when VirtualMachineUnpaused condition is unset", k8sv1.ConditionUnknown),
 		)
 
+		It("should remove a failed VCPUChange condition once the spec matches the running CPU topology", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = virtv1.Running
+			vmi.Spec.Domain.CPU = &virtv1.CPU{
+				Sockets:    1,
+				MaxSockets: 4,
+			}
+			vmi.Status.CurrentCPUTopology = &virtv1.CPUTopology{
+				Sockets: 1,
+			}
+			vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+				Type:   virtv1.VirtualMachineInstanceVCPUChange,
+				Status: k8sv1.ConditionFalse,
+			})
+
+			pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			addActivePods(vmi, pod.UID, "")
+
+			addVirtualMachine(vmi)
+			addPod(pod)
+
+			sanityExecute()
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, Not(ContainElement(MatchFields(IgnoreExtras,
+				Fields{
+					"Type": BeEquivalentTo(virtv1.VirtualMachineInstanceVCPUChange),
+				}))),
+			)
+		})
+
 		Context("with memory hotplug enabled", func() {
 			It("should add MemoryChange condition when guest memory changes", func() {
 				currentGuestMemory := resource.MustParse("128Mi")
@@ -5460,3 +5680,12 @@ type stubMigrationEvaluator struct {
 func (e stubMigrationEvaluator) Evaluate(_ *virtv1.VirtualMachineInstance, _ *k8sv1.Pod) k8sv1.ConditionStatus {
 	return e.result
 }
+
+type stubLauncherReservationClaimer struct {
+	pod *k8sv1.Pod
+	err error
+}
+
+func (s stubLauncherReservationClaimer) Claim(_ *virtv1.VirtualMachineInstance, _ *k8sv1.Pod) (*k8sv1.Pod, error) {
+	return s.pod, s.err
+}
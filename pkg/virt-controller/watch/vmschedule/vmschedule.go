@@ -0,0 +1,359 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/cron"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// SuccessfulScheduledStartReason is added in an event when a VM was started by its schedule.
+	SuccessfulScheduledStartReason = "SuccessfulScheduledStart"
+	// SuccessfulScheduledStopReason is added in an event when a VM was stopped by its schedule.
+	SuccessfulScheduledStopReason = "SuccessfulScheduledStop"
+	// SkippedScheduledStopReason is added in an event when the scheduled stop of a VM in use was skipped.
+	SkippedScheduledStopReason = "SkippedScheduledStop"
+	// FailedScheduledActionReason is added in an event when a VM could not be started or stopped by its schedule.
+	FailedScheduledActionReason = "FailedScheduledAction"
+)
+
+type scheduledAction int
+
+const (
+	noAction scheduledAction = iota
+	startAction
+	stopAction
+)
+
+// Controller starts and stops VirtualMachines according to their schedule
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	Queue         workqueue.TypedRateLimitingInterface[string]
+	vmIndexer     cache.Indexer
+	vmiIndexer    cache.Indexer
+	recorder      record.EventRecorder
+	clusterConfig *virtconfig.ClusterConfig
+	clock         clock.Clock
+	hasSynced     func() bool
+}
+
+func NewController(
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*Controller, error) {
+	c := &Controller{
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vm-schedule"},
+		),
+		vmIndexer:     vmInformer.GetIndexer(),
+		vmiIndexer:    vmiInformer.GetIndexer(),
+		recorder:      recorder,
+		clientset:     clientset,
+		clusterConfig: clusterConfig,
+		clock:         clock.RealClock{},
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVM,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueVM(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueueVM(obj interface{}) {
+	vm := obj.(*virtv1.VirtualMachine)
+	if vm.Spec.Schedule == nil {
+		return
+	}
+	key, err := controller.KeyFunc(vm)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to extract key from VirtualMachine.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+// Run runs the passed in Controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting vm schedule controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping vm schedule controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachine %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachine %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.vmIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	if vm.Spec.Schedule == nil || vm.DeletionTimestamp != nil || !c.clusterConfig.VirtualMachineScheduleEnabled() {
+		return nil
+	}
+
+	start, stop, loc, err := parseSchedule(vm.Spec.Schedule)
+	if err != nil {
+		// the schedule is validated on admission, nothing to retry
+		log.Log.Object(vm).Reason(err).Error("Invalid VirtualMachine schedule.")
+		return nil
+	}
+
+	now := c.clock.Now().Truncate(time.Second).In(loc)
+	// the times before the first evaluation of a schedule are not acted upon
+	lastScheduleTime := now
+	if vm.Status.Schedule != nil && vm.Status.Schedule.LastScheduleTime != nil {
+		lastScheduleTime = vm.Status.Schedule.LastScheduleTime.Time.In(loc)
+	}
+
+	action, actionTime := dueAction(start, stop, lastScheduleTime, now)
+	switch action {
+	case startAction:
+		if err := c.startVM(vm); err != nil {
+			return err
+		}
+	case stopAction:
+		if err := c.stopVM(vm); err != nil {
+			return err
+		}
+	}
+	if action != noAction {
+		lastScheduleTime = actionTime
+	}
+
+	status := &virtv1.VirtualMachineScheduleStatus{
+		LastScheduleTime: &metav1.Time{Time: lastScheduleTime},
+	}
+	var next time.Time
+	if start != nil {
+		if nextStart := start.Next(now); !nextStart.IsZero() {
+			status.NextStartTime = &metav1.Time{Time: nextStart}
+			next = nextStart
+		}
+	}
+	if stop != nil {
+		if nextStop := stop.Next(now); !nextStop.IsZero() {
+			status.NextStopTime = &metav1.Time{Time: nextStop}
+			if next.IsZero() || nextStop.Before(next) {
+				next = nextStop
+			}
+		}
+	}
+
+	if err := c.updateStatus(vm, status); err != nil {
+		return err
+	}
+	if !next.IsZero() {
+		c.Queue.AddAfter(key, next.Sub(now))
+	}
+	return nil
+}
+
+func parseSchedule(schedule *virtv1.VirtualMachineSchedule) (start, stop *cron.Schedule, loc *time.Location, err error) {
+	loc = time.UTC
+	if schedule.TimeZone != nil {
+		if loc, err = time.LoadLocation(*schedule.TimeZone); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if schedule.Start != "" {
+		if start, err = cron.Parse(schedule.Start); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if schedule.Stop != "" {
+		if stop, err = cron.Parse(schedule.Stop); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return start, stop, loc, nil
+}
+
+// dueAction returns the action of the latest start or stop time in (last, now], and that time.
+// Times missed while virt-controller was not running are caught up with, but only the latest
+// action is applied.
+func dueAction(start, stop *cron.Schedule, last, now time.Time) (scheduledAction, time.Time) {
+	latestStart := latestTime(start, last, now)
+	latestStop := latestTime(stop, last, now)
+	switch {
+	case latestStart.IsZero() && latestStop.IsZero():
+		return noAction, time.Time{}
+	case latestStart.After(latestStop):
+		return startAction, latestStart
+	default:
+		return stopAction, latestStop
+	}
+}
+
+func latestTime(schedule *cron.Schedule, last, now time.Time) time.Time {
+	var latest time.Time
+	if schedule == nil {
+		return latest
+	}
+	for t := schedule.Next(last); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		latest = t
+	}
+	return latest
+}
+
+func (c *Controller) startVM(vm *virtv1.VirtualMachine) error {
+	err := c.clientset.VirtualMachine(vm.Namespace).Start(context.Background(), vm.Name, &virtv1.StartOptions{})
+	// a conflict means the VM is already running
+	if err != nil && !k8serrors.IsConflict(err) {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedScheduledActionReason, "Error starting the VirtualMachine: %v", err)
+		return err
+	}
+	if err == nil {
+		c.recorder.Event(vm, k8sv1.EventTypeNormal, SuccessfulScheduledStartReason, "Started the VirtualMachine as scheduled")
+	}
+	return nil
+}
+
+func (c *Controller) stopVM(vm *virtv1.VirtualMachine) error {
+	if vm.Spec.Schedule.SkipStopIfInUse != nil && *vm.Spec.Schedule.SkipStopIfInUse {
+		inUse, err := c.inUse(vm)
+		if err != nil {
+			return err
+		}
+		if inUse != "" {
+			c.recorder.Eventf(vm, k8sv1.EventTypeNormal, SkippedScheduledStopReason, "Skipped the scheduled stop, the VirtualMachine is in use: %s", inUse)
+			return nil
+		}
+	}
+
+	err := c.clientset.VirtualMachine(vm.Namespace).Stop(context.Background(), vm.Name, &virtv1.StopOptions{})
+	// a conflict means the VM is not running
+	if err != nil && !k8serrors.IsConflict(err) {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedScheduledActionReason, "Error stopping the VirtualMachine: %v", err)
+		return err
+	}
+	if err == nil {
+		c.recorder.Event(vm, k8sv1.EventTypeNormal, SuccessfulScheduledStopReason, "Stopped the VirtualMachine as scheduled")
+	}
+	return nil
+}
+
+// inUse returns why the VM is in use, or an empty string if it is not
+func (c *Controller) inUse(vm *virtv1.VirtualMachine) (string, error) {
+	obj, exists, err := c.vmiIndexer.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+
+	switch {
+	case migrationutils.IsMigrating(vmi):
+		return "migration in progress", nil
+	case migrationutils.IsBackupInProgress(vmi):
+		return "backup in progress", nil
+	case !controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue):
+		return "", nil
+	}
+
+	users, err := c.clientset.VirtualMachineInstance(vmi.Namespace).UserList(context.Background(), vmi.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the guest users: %v", err)
+	}
+	if len(users.Items) > 0 {
+		return fmt.Sprintf("%d guest users logged in", len(users.Items)), nil
+	}
+	return "", nil
+}
+
+func (c *Controller) updateStatus(vm *virtv1.VirtualMachine, status *virtv1.VirtualMachineScheduleStatus) error {
+	if equality.Semantic.DeepEqual(vm.Status.Schedule, status) {
+		return nil
+	}
+	patchBytes, err := patch.New(patch.WithAdd("/status/schedule", status)).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
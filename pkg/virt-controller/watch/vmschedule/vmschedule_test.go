@@ -0,0 +1,251 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmschedule
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("VM schedule controller", func() {
+	var (
+		vmInterface  *kubecli.MockVirtualMachineInterface
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		recorder     *record.FakeRecorder
+		controller   *Controller
+		patchedVM    []byte
+	)
+
+	// a Wednesday
+	now := time.Date(2026, time.January, 14, 8, 0, 30, 0, time.UTC)
+
+	newVM := func(schedule *v1.VirtualMachineSchedule, lastScheduleTime *time.Time) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		vm.Name = "testvm"
+		vm.Spec.Schedule = schedule
+		if lastScheduleTime != nil {
+			vm.Status.Schedule = &v1.VirtualMachineScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: *lastScheduleTime},
+			}
+		}
+		return vm
+	}
+
+	sync := func(vm *v1.VirtualMachine) {
+		Expect(controller.vmIndexer.Add(vm)).To(Succeed())
+		key, err := cache.MetaNamespaceKeyFunc(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.execute(key)).To(Succeed())
+	}
+
+	patchedStatus := func() *v1.VirtualMachineScheduleStatus {
+		Expect(patchedVM).ToNot(BeEmpty())
+		var ops []patch.PatchOperation
+		Expect(json.Unmarshal(patchedVM, &ops)).To(Succeed())
+		Expect(ops).To(HaveLen(1))
+		Expect(ops[0].Path).To(Equal("/status/schedule"))
+		statusBytes, err := json.Marshal(ops[0].Value)
+		Expect(err).ToNot(HaveOccurred())
+		status := &v1.VirtualMachineScheduleStatus{}
+		Expect(json.Unmarshal(statusBytes, status)).To(Succeed())
+		return status
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+
+		patchedVM = nil
+		vmInterface.EXPECT().PatchStatus(gomock.Any(), "testvm", types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions) (*v1.VirtualMachine, error) {
+				patchedVM = data
+				return nil, nil
+			}).AnyTimes()
+
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(10)
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.VirtualMachineScheduleGate},
+			},
+		})
+
+		var err error
+		controller, err = NewController(vmInformer, vmiInformer, recorder, virtClient, config)
+		Expect(err).ToNot(HaveOccurred())
+		controller.Queue = testutils.NewMockWorkQueue(controller.Queue)
+		controller.clock = clocktesting.NewFakeClock(now)
+	})
+
+	AfterEach(func() {
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should only record the first evaluation of a schedule", func() {
+		sync(newVM(&v1.VirtualMachineSchedule{Start: "0 8 * * *", Stop: "0 20 * * *"}, nil))
+
+		status := patchedStatus()
+		Expect(status.LastScheduleTime.Time).To(BeTemporally("==", now))
+		Expect(status.NextStartTime.Time).To(BeTemporally("==", time.Date(2026, time.January, 15, 8, 0, 0, 0, time.UTC)))
+		Expect(status.NextStopTime.Time).To(BeTemporally("==", time.Date(2026, time.January, 14, 20, 0, 0, 0, time.UTC)))
+	})
+
+	It("should start the VM at its start time", func() {
+		vmInterface.EXPECT().Start(gomock.Any(), "testvm", gomock.Any()).Return(nil)
+
+		sync(newVM(&v1.VirtualMachineSchedule{Start: "0 8 * * *", Stop: "0 20 * * *"}, pointer.P(now.Add(-time.Hour))))
+
+		testutils.ExpectEvent(recorder, SuccessfulScheduledStartReason)
+		Expect(patchedStatus().LastScheduleTime.Time).To(BeTemporally("==", time.Date(2026, time.January, 14, 8, 0, 0, 0, time.UTC)))
+	})
+
+	It("should stop the VM at its stop time in its time zone", func() {
+		vmInterface.EXPECT().Stop(gomock.Any(), "testvm", gomock.Any()).Return(nil)
+
+		sync(newVM(&v1.VirtualMachineSchedule{Stop: "0 9 * * *", TimeZone: pointer.P("Europe/Berlin")}, pointer.P(now.Add(-time.Hour))))
+
+		testutils.ExpectEvent(recorder, SuccessfulScheduledStopReason)
+	})
+
+	It("should do nothing between the start and stop times", func() {
+		lastScheduleTime := time.Date(2026, time.January, 14, 8, 0, 0, 0, time.UTC)
+		vm := newVM(&v1.VirtualMachineSchedule{Start: "0 8 * * *", Stop: "0 20 * * *"}, &lastScheduleTime)
+		vm.Status.Schedule.NextStartTime = &metav1.Time{Time: time.Date(2026, time.January, 15, 8, 0, 0, 0, time.UTC)}
+		vm.Status.Schedule.NextStopTime = &metav1.Time{Time: time.Date(2026, time.January, 14, 20, 0, 0, 0, time.UTC)}
+
+		sync(vm)
+
+		Expect(patchedVM).To(BeEmpty())
+	})
+
+	It("should only apply the latest of the missed actions", func() {
+		vmInterface.EXPECT().Stop(gomock.Any(), "testvm", gomock.Any()).Return(nil)
+
+		sync(newVM(&v1.VirtualMachineSchedule{Start: "0 6 * * *", Stop: "0 7 * * *"}, pointer.P(now.Add(-36*time.Hour))))
+
+		testutils.ExpectEvent(recorder, SuccessfulScheduledStopReason)
+		Expect(patchedStatus().LastScheduleTime.Time).To(BeTemporally("==", time.Date(2026, time.January, 14, 7, 0, 0, 0, time.UTC)))
+	})
+
+	It("should ignore the start of a running VM", func() {
+		vmInterface.EXPECT().Start(gomock.Any(), "testvm", gomock.Any()).Return(
+			k8serrors.NewConflict(v1.Resource("virtualmachine"), "testvm", nil))
+
+		sync(newVM(&v1.VirtualMachineSchedule{Start: "0 8 * * *"}, pointer.P(now.Add(-time.Hour))))
+
+		Expect(patchedStatus().LastScheduleTime.Time).To(BeTemporally("==", time.Date(2026, time.January, 14, 8, 0, 0, 0, time.UTC)))
+	})
+
+	It("should retry failed actions", func() {
+		vmInterface.EXPECT().Start(gomock.Any(), "testvm", gomock.Any()).Return(k8serrors.NewServiceUnavailable("unavailable"))
+
+		vm := newVM(&v1.VirtualMachineSchedule{Start: "0 8 * * *"}, pointer.P(now.Add(-time.Hour)))
+		Expect(controller.vmIndexer.Add(vm)).To(Succeed())
+		Expect(controller.execute("default/testvm")).To(HaveOccurred())
+
+		testutils.ExpectEvent(recorder, FailedScheduledActionReason)
+		Expect(patchedVM).To(BeEmpty())
+	})
+
+	Context("with skipStopIfInUse", func() {
+		schedule := &v1.VirtualMachineSchedule{Stop: "0 8 * * *", SkipStopIfInUse: pointer.P(true)}
+
+		addVMI := func(options ...libvmi.Option) {
+			vmi := libvmi.New(append(options, libvmi.WithNamespace(k8sv1.NamespaceDefault))...)
+			vmi.Name = "testvm"
+			Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+		}
+
+		agentConnected := func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceAgentConnected,
+				Status: k8sv1.ConditionTrue,
+			})
+		}
+
+		It("should skip the stop while guest users are logged in", func() {
+			addVMI(agentConnected)
+			vmiInterface.EXPECT().UserList(gomock.Any(), "testvm").Return(v1.VirtualMachineInstanceGuestOSUserList{
+				Items: []v1.VirtualMachineInstanceGuestOSUser{{UserName: "user"}},
+			}, nil)
+
+			sync(newVM(schedule, pointer.P(now.Add(-time.Hour))))
+
+			testutils.ExpectEvent(recorder, SkippedScheduledStopReason)
+			Expect(patchedStatus().LastScheduleTime.Time).To(BeTemporally("==", time.Date(2026, time.January, 14, 8, 0, 0, 0, time.UTC)))
+		})
+
+		It("should skip the stop while the VM is migrating", func() {
+			addVMI(func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					StartTimestamp: &metav1.Time{Time: now.Add(-time.Minute)},
+				}
+			})
+
+			sync(newVM(schedule, pointer.P(now.Add(-time.Hour))))
+
+			testutils.ExpectEvent(recorder, SkippedScheduledStopReason)
+		})
+
+		It("should stop the VM when no guest user is logged in", func() {
+			addVMI(agentConnected)
+			vmiInterface.EXPECT().UserList(gomock.Any(), "testvm").Return(v1.VirtualMachineInstanceGuestOSUserList{}, nil)
+			vmInterface.EXPECT().Stop(gomock.Any(), "testvm", gomock.Any()).Return(nil)
+
+			sync(newVM(schedule, pointer.P(now.Add(-time.Hour))))
+
+			testutils.ExpectEvent(recorder, SuccessfulScheduledStopReason)
+		})
+	})
+
+	It("should ignore schedules without the feature gate", func() {
+		controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+		sync(newVM(&v1.VirtualMachineSchedule{Start: "0 8 * * *"}, pointer.P(now.Add(-time.Hour))))
+
+		Expect(patchedVM).To(BeEmpty())
+	})
+})
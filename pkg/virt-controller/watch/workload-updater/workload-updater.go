@@ -3,7 +3,6 @@ package workloadupdater
 import (
 	"context"
 	"fmt"
-	"math"
 	"math/rand"
 	"sync"
 	"time"
@@ -14,12 +13,14 @@ import (
 	policy "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
@@ -29,6 +30,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/util/cron"
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
@@ -69,8 +71,10 @@ type WorkloadUpdateController struct {
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	kubeVirtStore         cache.Store
+	namespaceStore        cache.Store
 	clusterConfig         *virtconfig.ClusterConfig
 	launcherImage         string
+	clock                 clock.Clock
 
 	lastDeletionBatch time.Time
 
@@ -84,6 +88,8 @@ type updateData struct {
 	abortChangeVMIs        []*virtv1.VirtualMachineInstance
 
 	numActiveMigrations int
+	// number of workload update migrations in flight per namespace
+	updatesPerNamespace map[string]int
 }
 
 func NewWorkloadUpdateController(
@@ -92,6 +98,7 @@ func NewWorkloadUpdateController(
 	podInformer cache.SharedIndexInformer,
 	migrationInformer cache.SharedIndexInformer,
 	kubeVirtInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -111,13 +118,16 @@ func NewWorkloadUpdateController(
 		podIndexer:            podInformer.GetIndexer(),
 		migrationIndexer:      migrationInformer.GetIndexer(),
 		kubeVirtStore:         kubeVirtInformer.GetStore(),
+		namespaceStore:        namespaceInformer.GetStore(),
 		recorder:              recorder,
 		clientset:             clientset,
 		launcherImage:         launcherImage,
 		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		clusterConfig:         clusterConfig,
+		clock:                 clock.RealClock{},
 		hasSynced: func() bool {
-			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() && kubeVirtInformer.HasSynced()
+			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() &&
+				kubeVirtInformer.HasSynced() && namespaceInformer.HasSynced()
 		},
 	}
 
@@ -319,7 +329,7 @@ func (c *WorkloadUpdateController) isOutdated(vmi *virtv1.VirtualMachineInstance
 
 func isHotplugInProgress(vmi *virtv1.VirtualMachineInstance) bool {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
-	return condManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) ||
+	return condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue) ||
 		condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMemoryChange, k8sv1.ConditionTrue) ||
 		condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMigrationRequired, k8sv1.ConditionTrue)
 }
@@ -363,8 +373,67 @@ func (c *WorkloadUpdateController) shouldAbortMigration(vmi *virtv1.VirtualMachi
 	return numMig > 0
 }
 
-func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateData {
-	data := &updateData{}
+// isNamespaceSelected returns whether the VMIs of the namespace are updated
+// automatically. All namespaces are selected when no selector is set.
+func (c *WorkloadUpdateController) isNamespaceSelected(selector labels.Selector, namespace string) bool {
+	if selector == nil {
+		return true
+	}
+	obj, exists, err := c.namespaceStore.GetByKey(namespace)
+	if err != nil || !exists {
+		return false
+	}
+	return selector.Matches(labels.Set(obj.(*k8sv1.Namespace).Labels))
+}
+
+// isUpdateWindowOpen returns whether automated workload updates may be started
+// now. Updates are always allowed when no window is configured.
+func (c *WorkloadUpdateController) isUpdateWindowOpen(windows []virtv1.WorkloadUpdateWindow) (bool, error) {
+	if len(windows) == 0 {
+		return true, nil
+	}
+	now := c.clock.Now()
+	for _, window := range windows {
+		schedule, err := cron.Parse(window.Start)
+		if err != nil {
+			return false, fmt.Errorf("invalid workload update window start %q: %v", window.Start, err)
+		}
+		loc := time.UTC
+		if window.TimeZone != nil {
+			if loc, err = time.LoadLocation(*window.TimeZone); err != nil {
+				return false, fmt.Errorf("invalid workload update window time zone %q: %v", *window.TimeZone, err)
+			}
+		}
+		// the window is open if it started within the last duration
+		start := schedule.Next(now.In(loc).Add(-window.Duration.Duration))
+		if !start.IsZero() && !start.After(now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// selectCandidates picks up to count VMIs while keeping the number of updates
+// per namespace within maxPerNamespace. The picked VMIs are added to updatesPerNamespace.
+func selectCandidates(vmis []*virtv1.VirtualMachineInstance, count int, updatesPerNamespace map[string]int, maxPerNamespace *int) []*virtv1.VirtualMachineInstance {
+	var candidates []*virtv1.VirtualMachineInstance
+	for _, vmi := range vmis {
+		if len(candidates) >= count {
+			break
+		}
+		if maxPerNamespace != nil && updatesPerNamespace[vmi.Namespace] >= *maxPerNamespace {
+			continue
+		}
+		updatesPerNamespace[vmi.Namespace]++
+		candidates = append(candidates, vmi)
+	}
+	return candidates
+}
+
+func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) (*updateData, error) {
+	data := &updateData{
+		updatesPerNamespace: map[string]int{},
+	}
 
 	lookup := make(map[string]bool)
 
@@ -372,6 +441,18 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 
 	for _, migration := range migrations {
 		lookup[migration.Namespace+"/"+migration.Spec.VMIName] = true
+		if metav1.HasAnnotation(migration.ObjectMeta, virtv1.WorkloadUpdateMigrationAnnotation) {
+			data.updatesPerNamespace[migration.Namespace]++
+		}
+	}
+
+	var namespaceSelector labels.Selector
+	if kv.Spec.WorkloadUpdateStrategy.NamespaceSelector != nil {
+		var err error
+		namespaceSelector, err = metav1.LabelSelectorAsSelector(kv.Spec.WorkloadUpdateStrategy.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workload update namespace selector: %v", err)
+		}
 	}
 
 	automatedMigrationAllowed := false
@@ -412,6 +493,9 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 			continue
 		} else if exists := lookup[vmi.Namespace+"/"+vmi.Name]; exists {
 			continue
+		} else if !c.isNamespaceSelected(namespaceSelector, vmi.Namespace) {
+			// VMIs of namespaces which opted out are only reported as outdated
+			continue
 		}
 		volMig := false
 		errValid := volumemig.ValidateVolumesUpdateMigration(vmi, nil, vmi.Status.MigratedVolumes)
@@ -425,7 +509,7 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 		}
 	}
 
-	return data
+	return data, nil
 }
 
 func (c *WorkloadUpdateController) execute(key string) error {
@@ -459,7 +543,10 @@ func (c *WorkloadUpdateController) execute(key string) error {
 
 func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
-	data := c.getUpdateData(kv)
+	data, err := c.getUpdateData(kv)
+	if err != nil {
+		return err
+	}
 
 	key, err := controller.KeyFunc(kv)
 	if err != nil {
@@ -516,13 +603,15 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		batchDeletionInterval = kv.Spec.WorkloadUpdateStrategy.BatchEvictionInterval.Duration
 	}
 
-	now := time.Now()
+	updateWindowOpen, err := c.isUpdateWindowOpen(kv.Spec.WorkloadUpdateStrategy.Windows)
+	if err != nil {
+		return err
+	}
+
+	now := c.clock.Now()
 
 	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
-	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
-		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
-		c.lastDeletionBatch = now
-	} else {
+	if !updateWindowOpen || !now.After(nextBatch) {
 		batchDeletionCount = 0
 	}
 
@@ -534,19 +623,15 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	maxParallelMigrations := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
 
 	maxNewMigrations := maxParallelMigrations - data.numActiveMigrations
-	if maxNewMigrations < 0 {
+	if maxNewMigrations < 0 || !updateWindowOpen {
 		maxNewMigrations = 0
 	}
 
-	migrateCount := int(math.Min(float64(maxNewMigrations), float64(len(data.migratableOutdatedVMIs))))
-	var migrationCandidates []*virtv1.VirtualMachineInstance
-	if migrateCount > 0 {
-		migrationCandidates = data.migratableOutdatedVMIs[0:migrateCount]
-	}
-
-	var evictionCandidates []*virtv1.VirtualMachineInstance
-	if batchDeletionCount > 0 {
-		evictionCandidates = data.evictOutdatedVMIs[0:batchDeletionCount]
+	maxPerNamespace := kv.Spec.WorkloadUpdateStrategy.MaxUpdatesPerNamespace
+	migrationCandidates := selectCandidates(data.migratableOutdatedVMIs, maxNewMigrations, data.updatesPerNamespace, maxPerNamespace)
+	evictionCandidates := selectCandidates(data.evictOutdatedVMIs, batchDeletionCount, data.updatesPerNamespace, maxPerNamespace)
+	if len(evictionCandidates) > 0 {
+		c.lastDeletionBatch = now
 	}
 
 	wgLen := len(migrationCandidates) + len(evictionCandidates) + len(data.abortChangeVMIs)
@@ -554,7 +639,7 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	wg.Add(wgLen)
 	errChan := make(chan error, wgLen)
 
-	c.migrationExpectations.ExpectCreations(key, migrateCount)
+	c.migrationExpectations.ExpectCreations(key, len(migrationCandidates))
 	for _, vmi := range migrationCandidates {
 		go func(vmi *virtv1.VirtualMachineInstance) {
 			var labels map[string]string
@@ -21,6 +21,7 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -69,7 +70,7 @@ var _ = Describe("Workload Updater", func() {
 
 	sanityExecute := func() {
 		controllertesting.SanityExecute(controller, []cache.Store{
-			controller.vmiStore, controller.podIndexer, controller.migrationIndexer, controller.kubeVirtStore,
+			controller.vmiStore, controller.podIndexer, controller.migrationIndexer, controller.kubeVirtStore, controller.namespaceStore,
 		}, Default)
 	}
 
@@ -98,8 +99,9 @@ var _ = Describe("Workload Updater", func() {
 		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
 		kubeVirtInformer, _ := testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 
-		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, recorder, virtClient, config)
+		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, namespaceInformer, recorder, virtClient, config)
 
 		// Set up mock client
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
@@ -734,6 +736,100 @@ var _ = Describe("Workload Updater", func() {
 		)
 	})
 
+	Context("workload update restrictions", func() {
+		const optOutLabel = "workload-update.kubevirt.io/opt-out"
+
+		addOutdatedVMIs := func(count int) {
+			for i := 0; i < count; i++ {
+				vmi := newVirtualMachineInstance(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup")
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, count)
+		}
+
+		expectMigrations := func(count int) {
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, migrations.Items).To(HaveLen(count))
+		}
+
+		DescribeTable("should only update the VMIs of the namespaces matching the selector", func(namespaceLabels map[string]string, expectedMigrations int) {
+			controller.namespaceStore.Add(&k8sv1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: k8sv1.NamespaceDefault, Labels: namespaceLabels},
+			})
+			addOutdatedVMIs(2)
+			kv := newKubeVirt(2)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			kv.Spec.WorkloadUpdateStrategy.NamespaceSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: optOutLabel, Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			reasons := []string{}
+			for i := 0; i < expectedMigrations; i++ {
+				reasons = append(reasons, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			}
+			testutils.ExpectEvents(recorder, reasons...)
+			expectMigrations(expectedMigrations)
+		},
+			Entry("migrate VMIs of a selected namespace", nil, 2),
+			Entry("skip VMIs of a namespace which opted out", map[string]string{optOutLabel: ""}, 0),
+		)
+
+		It("should limit the updates per namespace", func() {
+			addOutdatedVMIs(4)
+			kv := newKubeVirt(4)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			kv.Spec.WorkloadUpdateStrategy.MaxUpdatesPerNamespace = pointer.P(1)
+			addKubeVirt(kv)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrations(1)
+		})
+
+		It("should count in-flight workload update migrations against the namespace limit", func() {
+			migration := newMigration("vmim-in-flight", "testvm-other", v1.MigrationRunning)
+			migration.Annotations = map[string]string{v1.WorkloadUpdateMigrationAnnotation: ""}
+			controller.migrationIndexer.Add(migration)
+			addOutdatedVMIs(2)
+			kv := newKubeVirt(2)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.MaxUpdatesPerNamespace = pointer.P(1)
+			addKubeVirt(kv)
+
+			sanityExecute()
+			expectMigrations(0)
+		})
+
+		DescribeTable("should only start updates while a window is open", func(now time.Time, expectedMigrations int) {
+			controller.clock = clocktesting.NewFakeClock(now)
+			addOutdatedVMIs(1)
+			kv := newKubeVirt(1)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.Windows = []v1.WorkloadUpdateWindow{{
+				Start:    "0 2 * * *",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+				TimeZone: pointer.P("Europe/Berlin"),
+			}}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			if expectedMigrations > 0 {
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			}
+			expectMigrations(expectedMigrations)
+		},
+			Entry("migrate within the window", time.Date(2024, time.June, 3, 1, 0, 0, 0, time.UTC), 1),
+			Entry("wait before the window opens", time.Date(2024, time.June, 3, 23, 30, 0, 0, time.UTC), 0),
+			Entry("wait after the window closed", time.Date(2024, time.June, 3, 2, 0, 0, 0, time.UTC), 0),
+		)
+	})
+
 	AfterEach(func() {
 		Expect(recorder.Events).To(BeEmpty())
 	})
@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package balloon
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	// AdjustInterval is how often the balloon target of a VMI is re-evaluated
+	AdjustInterval = 30 * time.Second
+
+	// headroomPercent is the share of the used guest memory given on top of it
+	headroomPercent = 25
+	// pressureHeadroomPercent is the headroom given when the node is under memory pressure
+	pressureHeadroomPercent = 10
+
+	mebibyte = 1024 * 1024
+)
+
+// HasPolicy returns true if the VMI declares balloon bounds
+func HasPolicy(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Balloon != nil
+}
+
+// Target computes the balloon target of the VMI from the memory used by the guest,
+// keeping less headroom when the node is under memory pressure, and bounded by the
+// balloon bounds of the VMI.
+// It returns nil if the guest does not report its memory usage.
+func Target(vmi *v1.VirtualMachineInstance, memStats *stats.DomainStatsMemory, nodeUnderPressure bool) *resource.Quantity {
+	if !HasPolicy(vmi) || memStats == nil || !memStats.AvailableSet || !memStats.UsableSet ||
+		memStats.Usable > memStats.Available {
+		return nil
+	}
+
+	// The balloon stats are reported in KiB
+	used := int64(memStats.Available-memStats.Usable) * 1024
+
+	headroom := int64(headroomPercent)
+	if nodeUnderPressure {
+		headroom = pressureHeadroomPercent
+	}
+	target := used + used*headroom/100
+	target = (target + mebibyte - 1) / mebibyte * mebibyte
+
+	if upperBound := maxBound(vmi); upperBound > 0 && target > upperBound {
+		target = upperBound
+	}
+	if lowerBound := vmi.Spec.Domain.Memory.Balloon.Min.Value(); target < lowerBound {
+		target = lowerBound
+	}
+
+	return resource.NewQuantity(target, resource.BinarySI)
+}
+
+// maxBound returns the highest balloon target of the VMI, which defaults to the guest memory
+func maxBound(vmi *v1.VirtualMachineInstance) int64 {
+	if max := vmi.Spec.Domain.Memory.Balloon.Max; max != nil {
+		return max.Value()
+	}
+	if status := vmi.Status.Memory; status != nil {
+		if status.GuestRequested != nil {
+			return status.GuestRequested.Value()
+		}
+		if status.GuestAtBoot != nil {
+			return status.GuestAtBoot.Value()
+		}
+	}
+	if guest := vmi.Spec.Domain.Memory.Guest; guest != nil {
+		return guest.Value()
+	}
+	return 0
+}
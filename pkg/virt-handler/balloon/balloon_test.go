@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package balloon
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Balloon target", func() {
+	const kibPerGib = 1024 * 1024
+
+	newVMI := func(balloon *v1.MemoryBalloon) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Memory = &v1.Memory{
+			Guest:   pointer.P(resource.MustParse("8Gi")),
+			Balloon: balloon,
+		}
+		return vmi
+	}
+
+	memStats := func(availableKiB, usableKiB uint64) *stats.DomainStatsMemory {
+		return &stats.DomainStatsMemory{
+			AvailableSet: true,
+			Available:    availableKiB,
+			UsableSet:    true,
+			Usable:       usableKiB,
+		}
+	}
+
+	DescribeTable("should follow the guest memory usage", func(balloon *v1.MemoryBalloon, usedGiB uint64, nodeUnderPressure bool, expected string) {
+		vmi := newVMI(balloon)
+		target := Target(vmi, memStats(8*kibPerGib, (8-usedGiB)*kibPerGib), nodeUnderPressure)
+		Expect(target).ToNot(BeNil())
+		Expect(target.Cmp(resource.MustParse(expected))).To(BeZero(), "expected %s, got %s", expected, target.String())
+	},
+		Entry("with headroom on top of the used memory",
+			&v1.MemoryBalloon{Min: resource.MustParse("1Gi")}, uint64(4), false, "5Gi"),
+		Entry("with less headroom when the node is under pressure",
+			&v1.MemoryBalloon{Min: resource.MustParse("1Gi")}, uint64(4), true, "4506Mi"),
+		Entry("not below the lower bound",
+			&v1.MemoryBalloon{Min: resource.MustParse("3Gi")}, uint64(1), false, "3Gi"),
+		Entry("not above the upper bound",
+			&v1.MemoryBalloon{Min: resource.MustParse("1Gi"), Max: pointer.P(resource.MustParse("6Gi"))}, uint64(7), false, "6Gi"),
+		Entry("not above the guest memory without an upper bound",
+			&v1.MemoryBalloon{Min: resource.MustParse("1Gi")}, uint64(7), false, "8Gi"),
+	)
+
+	It("should use the requested guest memory as upper bound", func() {
+		vmi := newVMI(&v1.MemoryBalloon{Min: resource.MustParse("1Gi")})
+		vmi.Status.Memory = &v1.MemoryStatus{
+			GuestAtBoot:    pointer.P(resource.MustParse("8Gi")),
+			GuestRequested: pointer.P(resource.MustParse("6Gi")),
+		}
+
+		target := Target(vmi, memStats(8*kibPerGib, 1*kibPerGib), false)
+		Expect(target).ToNot(BeNil())
+		Expect(target.Cmp(resource.MustParse("6Gi"))).To(BeZero())
+	})
+
+	DescribeTable("should not compute a target", func(vmi *v1.VirtualMachineInstance, memStats *stats.DomainStatsMemory) {
+		Expect(Target(vmi, memStats, false)).To(BeNil())
+	},
+		Entry("without balloon bounds", newVMI(nil), memStats(8*kibPerGib, 4*kibPerGib)),
+		Entry("without memory stats", newVMI(&v1.MemoryBalloon{Min: resource.MustParse("1Gi")}), nil),
+		Entry("when the guest does not report its usable memory",
+			newVMI(&v1.MemoryBalloon{Min: resource.MustParse("1Gi")}),
+			&stats.DomainStatsMemory{AvailableSet: true, Available: 8 * kibPerGib}),
+	)
+})
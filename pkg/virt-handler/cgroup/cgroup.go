@@ -28,8 +28,6 @@ import (
 	"strconv"
 	"strings"
 
-	"kubevirt.io/client-go/log"
-
 	"kubevirt.io/kubevirt/pkg/util"
 
 	cgroups "github.com/opencontainers/cgroups"
@@ -95,15 +93,9 @@ func managerPath(taskPath string) string {
 
 // newManagerFromPid initializes a new cgroup manager from VMI's pid.
 // The pid is expected to VMI's pid from the host's viewpoint.
+// On cgroups v2 hosts only the unified hierarchy is looked at, the v1 controllers are not resolved.
 func newManagerFromPid(pid int, deviceRules []*devices.Rule) (manager Manager, err error) {
 	const isRootless = false
-	var version CgroupVersion
-
-	procCgroupBasePath := filepath.Join(cgroupconsts.ProcMountPoint, strconv.Itoa(pid), cgroupconsts.CgroupStr)
-	controllerPaths, err := cgroups.ParseCgroupFile(procCgroupBasePath)
-	if err != nil {
-		return nil, fmt.Errorf("cannot initialize new cgroup manager. err: %v", err)
-	}
 
 	config := &cgroups.Cgroup{
 		Path: cgroupconsts.HostCgroupBasePath,
@@ -114,30 +106,13 @@ func newManagerFromPid(pid int, deviceRules []*devices.Rule) (manager Manager, e
 	}
 
 	if cgroups.IsCgroup2UnifiedMode() {
-		version = V2
-		slicePath := filepath.Join(cgroupconsts.CgroupBasePath, controllerPaths[""])
-		slicePath = managerPath(slicePath)
-		manager, err = newV2Manager(config, slicePath)
-	} else {
-		version = V1
-		for subsystem, path := range controllerPaths {
-			if path == "" {
-				continue
-			}
-			path = managerPath(path)
-			controllerPaths[subsystem] = filepath.Join("/", subsystem, path)
-		}
-
-		manager, err = newV1Manager(config, controllerPaths)
-	}
-
-	if err != nil {
-		log.Log.Errorf("error occurred while initialized a new cgroup %s manager: %v", version, err)
-	} else {
-		log.Log.Infof("initialized a new cgroup %s manager successfully. controllerPaths: %v, procCgroupBasePath: %s", version, controllerPaths, procCgroupBasePath)
+		return newV2ManagerFromPid(pid, config)
 	}
+	return newV1ManagerFromPid(pid, config)
+}
 
-	return manager, err
+func procCgroupPath(pid int) string {
+	return filepath.Join(cgroupconsts.ProcMountPoint, strconv.Itoa(pid), cgroupconsts.CgroupStr)
 }
 
 func NewManagerFromVM(vmi *v1.VirtualMachineInstance, host string, hypervisorDevice string, allowEmulation bool) (Manager, error) {
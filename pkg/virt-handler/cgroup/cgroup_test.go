@@ -20,6 +20,7 @@
 package cgroup
 
 import (
+	"errors"
 	"io/fs"
 	"os"
 	"path"
@@ -77,7 +78,7 @@ var _ = Describe("cgroup manager", func() {
 		if version == V1 {
 			return newCustomizedV1Manager(mockCgroupsManager, false, execVirtChrootFunc, getCurrentlyDefinedRulesFunc)
 		} else {
-			return newCustomizedV2Manager(mockCgroupsManager, false, nil, execVirtChrootFunc)
+			return newCustomizedV2Manager(mockCgroupsManager, false, nil, execVirtChrootFunc, newDeviceRulesCache())
 		}
 	}
 
@@ -173,6 +174,91 @@ var _ = Describe("cgroup manager", func() {
 		Entry("for v2", V2),
 	)
 
+	Context("with cgroups v2", func() {
+		var (
+			appliedRules *deviceRulesCache
+			execCount    int
+			execErr      error
+		)
+
+		newV2Manager := func() Manager {
+			mockCgroupsManager := NewMockcgroupsManager(ctrl)
+			mockCgroupsManager.EXPECT().GetPaths().Return(map[string]string{"": v2DirPath}).AnyTimes()
+			manager, err := newCustomizedV2Manager(mockCgroupsManager, false, nil,
+				func(_ *cgroups.Resources, _ map[string]string, _ bool, _ CgroupVersion) error {
+					execCount++
+					return execErr
+				},
+				appliedRules,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			return manager
+		}
+
+		BeforeEach(func() {
+			appliedRules = newDeviceRulesCache()
+			execCount = 0
+			execErr = nil
+		})
+
+		It("should not apply the device rules again when they are unchanged", func() {
+			Expect(newV2Manager().Set(newResourcesWithRule(newDeviceRule(123)))).To(Succeed())
+			Expect(newV2Manager().Set(newResourcesWithRule(newDeviceRule(123)))).To(Succeed())
+			Expect(execCount).To(Equal(1))
+		})
+
+		It("should apply the device rules when they change", func() {
+			Expect(newV2Manager().Set(newResourcesWithRule(newDeviceRule(123)))).To(Succeed())
+			denyRule := newDeviceRule(123)
+			denyRule.Allow = false
+			Expect(newV2Manager().Set(newResourcesWithRule(denyRule))).To(Succeed())
+			Expect(execCount).To(Equal(2))
+		})
+
+		It("should apply the device rules again after a failure", func() {
+			Expect(newV2Manager().Set(newResourcesWithRule(newDeviceRule(123)))).To(Succeed())
+			execErr = errors.New("virt-chroot failed")
+			Expect(newV2Manager().Set(newResourcesWithRule(newDeviceRule(456)))).ToNot(Succeed())
+			execErr = nil
+			Expect(newV2Manager().Set(newResourcesWithRule(newDeviceRule(123)))).To(Succeed())
+			Expect(execCount).To(Equal(3))
+		})
+
+		It("should apply the resources other than device rules", func() {
+			resources := newResourcesWithRule(newDeviceRule(123))
+			Expect(newV2Manager().Set(resources)).To(Succeed())
+			resources.CpusetCpus = "1"
+			Expect(newV2Manager().Set(resources)).To(Succeed())
+			Expect(execCount).To(Equal(2))
+		})
+
+		Context("setting the cpu set", func() {
+			BeforeEach(func() {
+				cgroups.TestMode = true
+				DeferCleanup(func() { cgroups.TestMode = false })
+				v2DirPath = GinkgoT().TempDir()
+				Expect(os.Mkdir(path.Join(v2DirPath, "housekeeping"), 0o755)).To(Succeed())
+				Expect(os.WriteFile(path.Join(v2DirPath, "housekeeping", "cpuset.cpus"), []byte("1-3\n"), 0o644)).To(Succeed())
+			})
+
+			readCpuSet := func() string {
+				content, err := os.ReadFile(path.Join(v2DirPath, "housekeeping", "cpuset.cpus"))
+				Expect(err).ToNot(HaveOccurred())
+				return string(content)
+			}
+
+			It("should not write the cpu set when it is unchanged", func() {
+				Expect(newV2Manager().SetCpuSet("housekeeping", []int{3, 1, 2})).To(Succeed())
+				Expect(readCpuSet()).To(Equal("1-3\n"))
+			})
+
+			It("should write the cpu set when it changed", func() {
+				Expect(newV2Manager().SetCpuSet("housekeeping", []int{1, 2})).To(Succeed())
+				Expect(readCpuSet()).To(Equal("1,2"))
+			})
+		})
+	})
+
 	DescribeTable("ensure that correct set of cgroups is configured", func(dirPath string, expectedPaths []string) {
 		v2DirPath = dirPath
 		manager, err := newMockManager(V2)
@@ -47,6 +47,30 @@ type v1Manager struct {
 	getCurrentlyDefinedRules getCurrentlyDefinedRulesFunc
 }
 
+func newV1ManagerFromPid(pid int, config *cgroups.Cgroup) (Manager, error) {
+	procCgroupBasePath := procCgroupPath(pid)
+	controllerPaths, err := cgroups.ParseCgroupFile(procCgroupBasePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize new cgroup manager. err: %v", err)
+	}
+
+	for subsystem, path := range controllerPaths {
+		if path == "" {
+			continue
+		}
+		path = managerPath(path)
+		controllerPaths[subsystem] = filepath.Join("/", subsystem, path)
+	}
+
+	manager, err := newV1Manager(config, controllerPaths)
+	if err != nil {
+		log.Log.Errorf("error occurred while initialized a new cgroup %s manager: %v", V1, err)
+		return nil, err
+	}
+	log.Log.Infof("initialized a new cgroup %s manager successfully. controllerPaths: %v, procCgroupBasePath: %s", V1, controllerPaths, procCgroupBasePath)
+	return manager, nil
+}
+
 func newV1Manager(config *cgroups.Cgroup, controllerPaths map[string]string) (Manager, error) {
 	cgManager, err := cgroupfs.NewManager(config, controllerPaths)
 	if err != nil {
@@ -21,9 +21,11 @@ package cgroup
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -34,14 +36,52 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	cgroupconsts "kubevirt.io/kubevirt/pkg/virt-handler/cgroup/constants"
 )
 
+// appliedDeviceRules holds the device rules applied to the cgroups of the VMIs. With cgroups v2
+// the device rules are enforced by an eBPF program, which virt-chroot compiles and attaches again
+// on every Set. The managers are created on every VMI sync, so the rules are cached here in order
+// to only apply them again when they change.
+var appliedDeviceRules = newDeviceRulesCache()
+
 type v2Manager struct {
 	cgroups.Manager
 	dirPath        string
 	isRootless     bool
 	deviceRules    []*devices.Rule
 	execVirtChroot execVirtChrootFunc
+	appliedRules   *deviceRulesCache
+}
+
+func newV2ManagerFromPid(pid int, config *cgroups.Cgroup) (Manager, error) {
+	dirPath, err := unifiedCgroupPathFromPid(pid)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize new cgroup manager. err: %v", err)
+	}
+
+	manager, err := newV2Manager(config, dirPath)
+	if err != nil {
+		log.Log.Errorf("error occurred while initialized a new cgroup %s manager: %v", V2, err)
+		return nil, err
+	}
+	log.Log.Infof("initialized a new cgroup %s manager successfully. dirPath: %s", V2, dirPath)
+	return manager, nil
+}
+
+// unifiedCgroupPathFromPid returns the directory of the cgroup the pid belongs to in the unified hierarchy.
+// The pid is expected from the host's viewpoint.
+func unifiedCgroupPathFromPid(pid int) (string, error) {
+	controllerPaths, err := cgroups.ParseCgroupFile(procCgroupPath(pid))
+	if err != nil {
+		return "", err
+	}
+	unifiedPath, exists := controllerPaths[""]
+	if !exists {
+		return "", fmt.Errorf("pid %d is not in a cgroup of the unified hierarchy", pid)
+	}
+	return managerPath(filepath.Join(cgroupconsts.CgroupBasePath, unifiedPath)), nil
 }
 
 func newV2Manager(config *cgroups.Cgroup, dirPath string) (Manager, error) {
@@ -50,7 +90,7 @@ func newV2Manager(config *cgroups.Cgroup, dirPath string) (Manager, error) {
 		return nil, err
 	}
 
-	return newCustomizedV2Manager(cgManager, config.Rootless, config.Resources.Devices, execVirtChrootCgroups)
+	return newCustomizedV2Manager(cgManager, config.Rootless, config.Resources.Devices, execVirtChrootCgroups, appliedDeviceRules)
 }
 
 func newCustomizedV2Manager(
@@ -58,6 +98,7 @@ func newCustomizedV2Manager(
 	isRootless bool,
 	deviceRules []*devices.Rule,
 	execVirtChroot execVirtChrootFunc,
+	appliedRules *deviceRulesCache,
 ) (Manager, error) {
 	manager := v2Manager{
 		cgManager,
@@ -65,6 +106,7 @@ func newCustomizedV2Manager(
 		isRootless,
 		append(deviceRules, GenerateDefaultDeviceRules()...),
 		execVirtChroot,
+		appliedRules,
 	}
 
 	return &manager, nil
@@ -101,7 +143,17 @@ func (v *v2Manager) Set(r *cgroups.Resources) error {
 	}
 	log.Log.V(5).Infof("cgroupsv2 device allowlist: paths passed to virt-chroot: %s", subsystemPaths)
 
-	return v.execVirtChroot(&resourcesToSet, subsystemPaths, v.isRootless, v.GetCgroupVersion())
+	if isDevicesOnly(&resourcesToSet) && v.appliedRules.isApplied(v.dirPath, rulesToSet) {
+		log.Log.V(5).Infof("cgroupsv2 device allowlist: rules of %s are unchanged, skipping", v.dirPath)
+		return nil
+	}
+
+	if err := v.execVirtChroot(&resourcesToSet, subsystemPaths, v.isRootless, v.GetCgroupVersion()); err != nil {
+		v.appliedRules.forget(v.dirPath)
+		return err
+	}
+	v.appliedRules.store(v.dirPath, rulesToSet)
+	return nil
 }
 
 func (v *v2Manager) GetCgroupVersion() CgroupVersion {
@@ -177,6 +229,21 @@ func (v *v2Manager) GetCgroupThreads() ([]int, error) {
 	return getCgroupThreadsHelper(v, "cgroup.threads")
 }
 
+// SetCpuSet only writes the cpu set when it changed, as writing it makes the kernel
+// rebuild the scheduling domains of the cgroup on every sync of a VMI with dedicated CPUs.
 func (v *v2Manager) SetCpuSet(subcgroup string, cpulist []int) error {
+	current, err := cgroups.ReadFile(filepath.Join(v.dirPath, subcgroup), "cpuset.cpus")
+	if err == nil {
+		currentCpus, err := hardware.ParseCPUSetLine(strings.TrimSpace(current), hardware.MAX_CPU_LIMIT)
+		if err == nil && slices.Equal(currentCpus, sortedCpus(cpulist)) {
+			return nil
+		}
+	}
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func sortedCpus(cpulist []int) []int {
+	sorted := slices.Clone(cpulist)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cgroup
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	cgroups "github.com/opencontainers/cgroups"
+	devices "github.com/opencontainers/cgroups/devices/config"
+)
+
+// deviceRulesCache holds the device rules last applied to each cgroup directory
+type deviceRulesCache struct {
+	lock  sync.Mutex
+	rules map[string]map[string]struct{}
+}
+
+func newDeviceRulesCache() *deviceRulesCache {
+	return &deviceRulesCache{
+		rules: map[string]map[string]struct{}{},
+	}
+}
+
+func deviceRulesSet(rules []*devices.Rule) map[string]struct{} {
+	set := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		set[fmt.Sprintf("%s %t", rule.CgroupString(), rule.Allow)] = struct{}{}
+	}
+	return set
+}
+
+func (c *deviceRulesCache) isApplied(dirPath string, rules []*devices.Rule) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	applied, exists := c.rules[dirPath]
+	return exists && reflect.DeepEqual(applied, deviceRulesSet(rules))
+}
+
+// store records the rules applied to the cgroup and drops the cgroups which no longer exist
+func (c *deviceRulesCache) store(dirPath string, rules []*devices.Rule) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for path := range c.rules {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			delete(c.rules, path)
+		}
+	}
+	c.rules[dirPath] = deviceRulesSet(rules)
+}
+
+func (c *deviceRulesCache) forget(dirPath string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.rules, dirPath)
+}
+
+// isDevicesOnly tells whether the resources only hold device rules
+func isDevicesOnly(r *cgroups.Resources) bool {
+	withoutDevices := *r
+	withoutDevices.Devices = nil
+	return reflect.DeepEqual(withoutDevices, cgroups.Resources{})
+}
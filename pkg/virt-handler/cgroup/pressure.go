@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cgroup
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	cgroups "github.com/opencontainers/cgroups"
+	"golang.org/x/sys/unix"
+)
+
+// PressureStats holds the pressure stall information (PSI) of a cgroup.
+// A nil field means that the kernel does not report the pressure of that resource.
+type PressureStats struct {
+	CPU    *cgroups.PSIStats
+	Memory *cgroups.PSIStats
+	IO     *cgroups.PSIStats
+}
+
+// GetPressureStatsFromPid returns the pressure stall information of the cgroup the pid belongs to.
+// The pid is expected from the host's viewpoint. PSI is only available with cgroups v2, nil is
+// returned on cgroups v1 hosts.
+func GetPressureStatsFromPid(pid int) (*PressureStats, error) {
+	if !cgroups.IsCgroup2UnifiedMode() {
+		return nil, nil
+	}
+
+	dirPath, err := unifiedCgroupPathFromPid(pid)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find the cgroup of pid %d: %v", pid, err)
+	}
+
+	return readPressureStats(dirPath)
+}
+
+func readPressureStats(dirPath string) (*PressureStats, error) {
+	var (
+		stats PressureStats
+		err   error
+	)
+
+	if stats.CPU, err = readPSIFile(filepath.Join(dirPath, "cpu.pressure")); err != nil {
+		return nil, err
+	}
+	if stats.Memory, err = readPSIFile(filepath.Join(dirPath, "memory.pressure")); err != nil {
+		return nil, err
+	}
+	if stats.IO, err = readPSIFile(filepath.Join(dirPath, "io.pressure")); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// readPSIFile parses a PSI file, made of lines like:
+// some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readPSIFile(path string) (*cgroups.PSIStats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		// The files are missing or can't be read when PSI is disabled in the kernel
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, unix.ENOTSUP) || errors.Is(err, unix.EOPNOTSUPP) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	stats := &cgroups.PSIStats{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		var data *cgroups.PSIData
+		switch fields[0] {
+		case "some":
+			data = &stats.Some
+		case "full":
+			data = &stats.Full
+		default:
+			continue
+		}
+
+		for _, field := range fields[1:] {
+			key, value, found := strings.Cut(field, "=")
+			if !found {
+				return nil, fmt.Errorf("invalid PSI field %q in %s", field, path)
+			}
+			if err := setPSIValue(data, key, value); err != nil {
+				return nil, fmt.Errorf("invalid PSI field %q in %s: %v", field, path, err)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, unix.ENOTSUP) || errors.Is(err, unix.EOPNOTSUPP) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func setPSIValue(data *cgroups.PSIData, key, value string) (err error) {
+	switch key {
+	case "avg10":
+		data.Avg10, err = strconv.ParseFloat(value, 64)
+	case "avg60":
+		data.Avg60, err = strconv.ParseFloat(value, 64)
+	case "avg300":
+		data.Avg300, err = strconv.ParseFloat(value, 64)
+	case "total":
+		data.Total, err = strconv.ParseUint(value, 10, 64)
+	}
+	return err
+}
@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cgroups "github.com/opencontainers/cgroups"
+)
+
+var _ = Describe("pressure stall information", func() {
+	var dirPath string
+
+	BeforeEach(func() {
+		dirPath = GinkgoT().TempDir()
+	})
+
+	writePSIFile := func(name, content string) {
+		Expect(os.WriteFile(filepath.Join(dirPath, name), []byte(content), 0o644)).To(Succeed())
+	}
+
+	It("should parse the pressure of all resources", func() {
+		writePSIFile("cpu.pressure", "some avg10=1.50 avg60=0.75 avg300=0.25 total=123456\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=42\n")
+		writePSIFile("memory.pressure", "some avg10=0.00 avg60=0.00 avg300=0.00 total=10\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=5\n")
+		writePSIFile("io.pressure", "some avg10=0.00 avg60=0.00 avg300=0.00 total=20\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=15\n")
+
+		stats, err := readPressureStats(dirPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stats.CPU).To(Equal(&cgroups.PSIStats{
+			Some: cgroups.PSIData{Avg10: 1.5, Avg60: 0.75, Avg300: 0.25, Total: 123456},
+			Full: cgroups.PSIData{Total: 42},
+		}))
+		Expect(stats.Memory.Some.Total).To(BeEquivalentTo(10))
+		Expect(stats.Memory.Full.Total).To(BeEquivalentTo(5))
+		Expect(stats.IO.Some.Total).To(BeEquivalentTo(20))
+		Expect(stats.IO.Full.Total).To(BeEquivalentTo(15))
+	})
+
+	It("should leave the pressure of missing files empty", func() {
+		writePSIFile("cpu.pressure", "some avg10=0.00 avg60=0.00 avg300=0.00 total=1\n")
+
+		stats, err := readPressureStats(dirPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stats.CPU.Some.Total).To(BeEquivalentTo(1))
+		Expect(stats.Memory).To(BeNil())
+		Expect(stats.IO).To(BeNil())
+	})
+
+	It("should fail on malformed files", func() {
+		writePSIFile("cpu.pressure", "some avg10=abc total=1\n")
+
+		_, err := readPressureStats(dirPath)
+		Expect(err).To(HaveOccurred())
+	})
+})
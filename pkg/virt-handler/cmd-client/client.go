@@ -82,6 +82,9 @@ type LauncherClient interface {
 	SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	UnpauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	SuspendVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	RotateCloudInit(vmi *v1.VirtualMachineInstance) error
+	EjectCloudInit(vmi *v1.VirtualMachineInstance) error
 	FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error
 	UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	SyncMigrationTarget(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
@@ -98,11 +101,13 @@ type LauncherClient interface {
 	GetDomain() (*api.Domain, bool, error)
 	GetDomainStats() (*stats.DomainStats, bool, error)
 	GetGuestInfo() (*v1.VirtualMachineInstanceGuestAgentInfo, error)
+	RefreshGuestInfo(vmi *v1.VirtualMachineInstance) error
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
 	Exec(string, string, []string, int32) (int, string, error)
 	Ping() error
 	GuestPing(string, int32) error
+	GuestFileExists(string, string, int32) error
 	Close()
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
@@ -113,6 +118,11 @@ type LauncherClient interface {
 	SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetDomainDirtyRateStats() (dirtyRateMbps int64, err error)
 	GetScreenshot(*v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
+	GetDomainXML(vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstanceDomainXML, error)
+	ReadGuestFile(vmi *v1.VirtualMachineInstance, path string, maxBytes int) ([]byte, error)
+	WriteGuestFile(vmi *v1.VirtualMachineInstance, path string, content []byte) error
+	GuestExecStart(vmi *v1.VirtualMachineInstance, command string, args []string) (int64, error)
+	GuestExecStatus(vmi *v1.VirtualMachineInstance, pid int64) (*v1.VirtualMachineInstanceGuestExecOutput, error)
 	VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *backupv1.BackupOptions) error
 	RedefineCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *backupv1.BackupCheckpoint) (checkpointInvalid bool, err error)
 	GetVMStats(request *cmdv1.VMStatsRequest) (*stats.VMStats, error)
@@ -327,6 +337,18 @@ func (c *VirtLauncherClient) UnpauseVirtualMachine(vmi *v1.VirtualMachineInstanc
 	return c.genericSendVMICmd("Unpause", c.v1client.UnpauseVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
 
+func (c *VirtLauncherClient) SuspendVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("Suspend", c.v1client.SuspendVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
+func (c *VirtLauncherClient) RotateCloudInit(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("RotateCloudInit", c.v1client.RotateCloudInit, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
+func (c *VirtLauncherClient) EjectCloudInit(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("EjectCloudInit", c.v1client.EjectCloudInit, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
 func (c *VirtLauncherClient) FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error {
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
@@ -596,6 +618,11 @@ func (c *VirtLauncherClient) Ping() error {
 	return err
 }
 
+// RefreshGuestInfo is a counterpart for virt-launcher call to poll the guest agent right away
+func (c *VirtLauncherClient) RefreshGuestInfo(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("RefreshGuestInfo", c.v1client.RefreshGuestInfo, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
 // GetGuestInfo is a counterpart for virt-launcher call to gather guest agent data
 func (c *VirtLauncherClient) GetGuestInfo() (*v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	guestInfo := &v1.VirtualMachineInstanceGuestAgentInfo{}
@@ -734,6 +761,24 @@ func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32)
 	return err
 }
 
+func (c *VirtLauncherClient) GuestFileExists(domainName, path string, timeoutSeconds int32) error {
+	request := &cmdv1.GuestFileExistsRequest{
+		DomainName:     domainName,
+		Path:           path,
+		TimeoutSeconds: timeoutSeconds,
+	}
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		// we give the context a bit more time as the timeout should kick
+		// on the actual execution
+		time.Duration(timeoutSeconds)*time.Second+shortTimeout,
+	)
+	defer cancel()
+
+	_, err := c.v1client.GuestFileExists(ctx, request)
+	return err
+}
+
 func (c *VirtLauncherClient) GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error) {
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
@@ -752,6 +797,138 @@ func (c *VirtLauncherClient) GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmd
 	return c.v1client.GetScreenshot(ctx, request)
 }
 
+func (c *VirtLauncherClient) GetDomainXML(vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstanceDomainXML, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.VMIRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	domainXMLResponse, err := c.v1client.GetDomainXML(ctx, request)
+	if err = handleError(err, "GetDomainXML", domainXMLResponse.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	return &v1.VirtualMachineInstanceDomainXML{
+		DomainXML:             domainXMLResponse.GetDomainXML(),
+		DomainCapabilitiesXML: domainXMLResponse.GetDomainCapabilitiesXML(),
+	}, nil
+}
+
+func (c *VirtLauncherClient) ReadGuestFile(vmi *v1.VirtualMachineInstance, path string, maxBytes int) ([]byte, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.GuestFileRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Path:     path,
+		MaxBytes: int64(maxBytes),
+	}
+
+	// the file is read in several guest agent commands
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	guestFileResponse, err := c.v1client.ReadGuestFile(ctx, request)
+	if err = handleError(err, "ReadGuestFile", guestFileResponse.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	return guestFileResponse.GetContent(), nil
+}
+
+func (c *VirtLauncherClient) WriteGuestFile(vmi *v1.VirtualMachineInstance, path string, content []byte) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.GuestFileRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Path:    path,
+		Content: content,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	response, err := c.v1client.WriteGuestFile(ctx, request)
+	return handleError(err, "WriteGuestFile", response)
+}
+
+func (c *VirtLauncherClient) GuestExecStart(vmi *v1.VirtualMachineInstance, command string, args []string) (int64, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return 0, err
+	}
+
+	request := &cmdv1.GuestExecStartRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Command: command,
+		Args:    args,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	response, err := c.v1client.GuestExecStart(ctx, request)
+	if err = handleError(err, "GuestExecStart", response.GetResponse()); err != nil {
+		return 0, err
+	}
+
+	return response.GetPid(), nil
+}
+
+// GuestExecStatus returns the output of a command started with GuestExecStart, the exit code is only set
+// once the command exited.
+func (c *VirtLauncherClient) GuestExecStatus(vmi *v1.VirtualMachineInstance, pid int64) (*v1.VirtualMachineInstanceGuestExecOutput, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.GuestExecStatusRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Pid: pid,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	response, err := c.v1client.GuestExecStatus(ctx, request)
+	if err = handleError(err, "GuestExecStatus", response.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	output := &v1.VirtualMachineInstanceGuestExecOutput{
+		Stdout: response.GetStdout(),
+		Stderr: response.GetStderr(),
+	}
+	if response.GetExited() {
+		exitCode := response.GetExitCode()
+		output.ExitCode = &exitCode
+	}
+	return output, nil
+}
+
 func (c *VirtLauncherClient) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	request := &cmdv1.EmptyRequest{}
 	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
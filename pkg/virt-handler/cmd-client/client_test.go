@@ -169,6 +169,13 @@ var _ = Describe("Virt remote commands", func() {
 						TimeoutSeconds: testTimeoutSeconds,
 					})
 				}
+				expectGuestFileExists = func() *gomock.Call {
+					return mockCmdClient.EXPECT().GuestFileExists(gomock.Any(), &cmdv1.GuestFileExistsRequest{
+						DomainName:     testDomainName,
+						Path:           "/tmp/ready",
+						TimeoutSeconds: testTimeoutSeconds,
+					})
+				}
 				expectQemuVersion = func() *gomock.Call {
 					return mockCmdClient.EXPECT().GetQemuVersion(gomock.Any(), &cmdv1.EmptyRequest{})
 				}
@@ -220,6 +227,16 @@ var _ = Describe("Virt remote commands", func() {
 				err := client.GuestPing(testDomainName, testTimeoutSeconds)
 				Expect(err).ToNot(HaveOccurred())
 			})
+			It("calls cmdclient.GuestFileExists", func() {
+				expectGuestFileExists().Times(1).Return(&cmdv1.GuestFileExistsResponse{Response: &cmdv1.Response{Success: true}}, nil)
+				err := client.GuestFileExists(testDomainName, "/tmp/ready", testTimeoutSeconds)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			It("returns guest file exists client errors", func() {
+				expectGuestFileExists().Times(1).Return(&cmdv1.GuestFileExistsResponse{}, testClientErr)
+				err := client.GuestFileExists(testDomainName, "/tmp/ready", testTimeoutSeconds)
+				Expect(err).To(HaveOccurred())
+			})
 		})
 	})
 	Describe("Version mismatch", func() {
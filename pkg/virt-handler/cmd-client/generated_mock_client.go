@@ -85,6 +85,20 @@ func (mr *MockLauncherClientMockRecorder) DeleteDomain(vmi any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockLauncherClient)(nil).DeleteDomain), vmi)
 }
 
+// EjectCloudInit mocks base method.
+func (m *MockLauncherClient) EjectCloudInit(vmi *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EjectCloudInit", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EjectCloudInit indicates an expected call of EjectCloudInit.
+func (mr *MockLauncherClientMockRecorder) EjectCloudInit(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EjectCloudInit", reflect.TypeOf((*MockLauncherClient)(nil).EjectCloudInit), vmi)
+}
+
 // Exec mocks base method.
 func (m *MockLauncherClient) Exec(arg0, arg1 string, arg2 []string, arg3 int32) (int, string, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +190,21 @@ func (mr *MockLauncherClientMockRecorder) GetDomainStats() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainStats", reflect.TypeOf((*MockLauncherClient)(nil).GetDomainStats))
 }
 
+// GetDomainXML mocks base method.
+func (m *MockLauncherClient) GetDomainXML(vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstanceDomainXML, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainXML", vmi)
+	ret0, _ := ret[0].(*v1.VirtualMachineInstanceDomainXML)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainXML indicates an expected call of GetDomainXML.
+func (mr *MockLauncherClientMockRecorder) GetDomainXML(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainXML", reflect.TypeOf((*MockLauncherClient)(nil).GetDomainXML), vmi)
+}
+
 // GetFilesystems mocks base method.
 func (m *MockLauncherClient) GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +325,50 @@ func (mr *MockLauncherClientMockRecorder) GetVMStats(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMStats", reflect.TypeOf((*MockLauncherClient)(nil).GetVMStats), request)
 }
 
+// GuestExecStart mocks base method.
+func (m *MockLauncherClient) GuestExecStart(vmi *v1.VirtualMachineInstance, command string, args []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStart", vmi, command, args)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStart indicates an expected call of GuestExecStart.
+func (mr *MockLauncherClientMockRecorder) GuestExecStart(vmi, command, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStart", reflect.TypeOf((*MockLauncherClient)(nil).GuestExecStart), vmi, command, args)
+}
+
+// GuestExecStatus mocks base method.
+func (m *MockLauncherClient) GuestExecStatus(vmi *v1.VirtualMachineInstance, pid int64) (*v1.VirtualMachineInstanceGuestExecOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStatus", vmi, pid)
+	ret0, _ := ret[0].(*v1.VirtualMachineInstanceGuestExecOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStatus indicates an expected call of GuestExecStatus.
+func (mr *MockLauncherClientMockRecorder) GuestExecStatus(vmi, pid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStatus", reflect.TypeOf((*MockLauncherClient)(nil).GuestExecStatus), vmi, pid)
+}
+
+// GuestFileExists mocks base method.
+func (m *MockLauncherClient) GuestFileExists(arg0, arg1 string, arg2 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileExists indicates an expected call of GuestFileExists.
+func (mr *MockLauncherClientMockRecorder) GuestFileExists(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileExists", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileExists), arg0, arg1, arg2)
+}
+
 // GuestPing mocks base method.
 func (m *MockLauncherClient) GuestPing(arg0 string, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -394,6 +467,21 @@ func (mr *MockLauncherClientMockRecorder) Ping() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockLauncherClient)(nil).Ping))
 }
 
+// ReadGuestFile mocks base method.
+func (m *MockLauncherClient) ReadGuestFile(vmi *v1.VirtualMachineInstance, path string, maxBytes int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGuestFile", vmi, path, maxBytes)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGuestFile indicates an expected call of ReadGuestFile.
+func (mr *MockLauncherClientMockRecorder) ReadGuestFile(vmi, path, maxBytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGuestFile", reflect.TypeOf((*MockLauncherClient)(nil).ReadGuestFile), vmi, path, maxBytes)
+}
+
 // RedefineCheckpoint mocks base method.
 func (m *MockLauncherClient) RedefineCheckpoint(vmi *v1.VirtualMachineInstance, checkpoint *v1alpha1.BackupCheckpoint) (bool, error) {
 	m.ctrl.T.Helper()
@@ -409,6 +497,20 @@ func (mr *MockLauncherClientMockRecorder) RedefineCheckpoint(vmi, checkpoint any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedefineCheckpoint", reflect.TypeOf((*MockLauncherClient)(nil).RedefineCheckpoint), vmi, checkpoint)
 }
 
+// RefreshGuestInfo mocks base method.
+func (m *MockLauncherClient) RefreshGuestInfo(vmi *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshGuestInfo", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshGuestInfo indicates an expected call of RefreshGuestInfo.
+func (mr *MockLauncherClientMockRecorder) RefreshGuestInfo(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGuestInfo", reflect.TypeOf((*MockLauncherClient)(nil).RefreshGuestInfo), vmi)
+}
+
 // ResetVirtualMachine mocks base method.
 func (m *MockLauncherClient) ResetVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -423,6 +525,20 @@ func (mr *MockLauncherClientMockRecorder) ResetVirtualMachine(vmi any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).ResetVirtualMachine), vmi)
 }
 
+// RotateCloudInit mocks base method.
+func (m *MockLauncherClient) RotateCloudInit(vmi *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCloudInit", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateCloudInit indicates an expected call of RotateCloudInit.
+func (mr *MockLauncherClientMockRecorder) RotateCloudInit(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCloudInit", reflect.TypeOf((*MockLauncherClient)(nil).RotateCloudInit), vmi)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockLauncherClient) ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -465,6 +581,20 @@ func (mr *MockLauncherClientMockRecorder) SoftRebootVirtualMachine(vmi any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftRebootVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).SoftRebootVirtualMachine), vmi)
 }
 
+// SuspendVirtualMachine mocks base method.
+func (m *MockLauncherClient) SuspendVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendVirtualMachine", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendVirtualMachine indicates an expected call of SuspendVirtualMachine.
+func (mr *MockLauncherClientMockRecorder) SuspendVirtualMachine(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).SuspendVirtualMachine), vmi)
+}
+
 // SyncMigrationTarget mocks base method.
 func (m *MockLauncherClient) SyncMigrationTarget(vmi *v1.VirtualMachineInstance, options *v10.VirtualMachineOptions) error {
 	m.ctrl.T.Helper()
@@ -576,3 +706,17 @@ func (mr *MockLauncherClientMockRecorder) VirtualMachineMemoryDump(vmi, dumpPath
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineMemoryDump", reflect.TypeOf((*MockLauncherClient)(nil).VirtualMachineMemoryDump), vmi, dumpPath)
 }
+
+// WriteGuestFile mocks base method.
+func (m *MockLauncherClient) WriteGuestFile(vmi *v1.VirtualMachineInstance, path string, content []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteGuestFile", vmi, path, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteGuestFile indicates an expected call of WriteGuestFile.
+func (mr *MockLauncherClientMockRecorder) WriteGuestFile(vmi, path, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGuestFile", reflect.TypeOf((*MockLauncherClient)(nil).WriteGuestFile), vmi, path, content)
+}
@@ -92,6 +92,8 @@ const (
 
 	// MemoryHotplugFailedReason is the reason set when the VM cannot hotplug memory
 	memoryHotplugFailedReason = "Memory Hotplug Failed"
+	// vcpuHotplugFailedReason is the reason set when the VM cannot hotplug or unplug vCPUs
+	vcpuHotplugFailedReason = "vCPU Hotplug Failed"
 )
 
 type netconf interface {
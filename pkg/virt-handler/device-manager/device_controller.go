@@ -132,6 +132,7 @@ type DeviceController struct {
 	startedPlugins           map[string]controlledDevice
 	startedPluginsMutex      sync.Mutex
 	host                     string
+	defaultMaxDevices        int
 	maxDevices               int
 	permissions              string
 	backoff                  []time.Duration
@@ -161,16 +162,17 @@ func NewDeviceController(
 	}
 
 	controller := &DeviceController{
-		permanentPlugins: permanentPluginsMap,
-		startedPlugins:   map[string]controlledDevice{},
-		host:             host,
-		maxDevices:       maxDevices,
-		permissions:      permissions,
-		backoff:          defaultBackoffTime,
-		virtConfig:       clusterConfig,
-		mdevTypesManager: NewMDEVTypesManager(),
-		nodeStore:        nodeStore,
-		mdevRefreshWG:    &sync.WaitGroup{},
+		permanentPlugins:  permanentPluginsMap,
+		startedPlugins:    map[string]controlledDevice{},
+		host:              host,
+		defaultMaxDevices: maxDevices,
+		maxDevices:        maxDevices,
+		permissions:       permissions,
+		backoff:           defaultBackoffTime,
+		virtConfig:        clusterConfig,
+		mdevTypesManager:  NewMDEVTypesManager(),
+		nodeStore:         nodeStore,
+		mdevRefreshWG:     &sync.WaitGroup{},
 	}
 
 	return controller
@@ -249,8 +251,9 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 		return permittedDevices
 	}
 
-	if len(hostDevs.PciHostDevices) != 0 {
+	if len(hostDevs.PciHostDevices) != 0 || len(hostDevs.PciDiscoveryRules) != 0 {
 		supportedPCIDeviceMap := make(map[string]string)
+		externalPCIDevices := make(map[string]struct{})
 		for _, pciDev := range hostDevs.PciHostDevices {
 			log.Log.V(4).Infof("Permitted PCI device in the cluster, ID: %s, resourceName: %s, externalProvider: %t",
 				strings.ToLower(pciDev.PCIVendorSelector),
@@ -259,9 +262,17 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 			// do not add a device plugin for this resource if it's being provided via an external device plugin
 			if !pciDev.ExternalResourceProvider {
 				supportedPCIDeviceMap[strings.ToLower(pciDev.PCIVendorSelector)] = pciDev.ResourceName
+			} else {
+				externalPCIDevices[strings.ToLower(pciDev.PCIVendorSelector)] = struct{}{}
 			}
 		}
-		for pciResourceName, pciDevices := range discoverPermittedHostPCIDevices(supportedPCIDeviceMap) {
+		for _, rule := range hostDevs.PciDiscoveryRules {
+			log.Log.V(4).Infof("PCI discovery rule in the cluster, ID: %s, driver: %s, resourceName: %s",
+				strings.ToLower(rule.PCIVendorSelector),
+				rule.Driver,
+				rule.ResourceName)
+		}
+		for pciResourceName, pciDevices := range discoverPermittedHostPCIDevices(supportedPCIDeviceMap, externalPCIDevices, hostDevs.PciDiscoveryRules) {
 			log.Log.V(4).Infof("Discovered PCIs %d devices on the node for the resource: %s", len(pciDevices), pciResourceName)
 			// add a device plugin only for new devices
 			permittedDevices = append(permittedDevices, NewPCIDevicePlugin(pciDevices, pciResourceName))
@@ -421,6 +432,8 @@ func (c *DeviceController) refreshPermittedDevices() {
 	c.startedPluginsMutex.Lock()
 	defer c.startedPluginsMutex.Unlock()
 
+	c.refreshMaxDevices()
+
 	// Check if QGS config changed and restart the QGS device plugin if needed
 	if changed := c.refreshTDXConfig(); changed {
 		if _, exists := c.startedPlugins[services.TdxDevice]; exists {
@@ -456,6 +469,48 @@ func (c *DeviceController) refreshPermittedDevices() {
 	c.mdevRefreshWG.Done()
 }
 
+// refreshMaxDevices applies the number of VMIs per node of the node override to the device plugins
+func (c *DeviceController) refreshMaxDevices() {
+	maxDevices := c.defaultMaxDevices
+	if node, err := c.getNode(); err == nil {
+		if override := c.virtConfig.GetNodeConfigurationOverride(node); override != nil && override.VirtualMachineInstancesPerNode != nil {
+			maxDevices = *override.VirtualMachineInstancesPerNode
+		}
+	}
+	if maxDevices == c.maxDevices {
+		return
+	}
+
+	log.DefaultLogger().Infof("the maximum number of VMIs changed from %d to %d, restarting the device plugins", c.maxDevices, maxDevices)
+	c.maxDevices = maxDevices
+
+	for name, dev := range c.permanentPlugins {
+		genericDev, ok := dev.(*GenericDevicePlugin)
+		if !ok {
+			continue
+		}
+		c.permanentPlugins[name] = NewGenericDevicePlugin(genericDev.deviceName, genericDev.devicePath, maxDevices, genericDev.permissions, genericDev.preOpen)
+		if _, started := c.startedPlugins[name]; started {
+			c.startDevice(name, c.permanentPlugins[name])
+		}
+	}
+
+	// the permitted device plugins sized by the maximum number of VMIs are started again by the refresh
+	for name, dev := range c.startedPlugins {
+		if _, isPermanent := c.permanentPlugins[name]; isPermanent {
+			continue
+		}
+		switch plugin := dev.devicePlugin.(type) {
+		case *GenericDevicePlugin:
+			c.stopDevice(name)
+		case *SocketDevicePlugin:
+			if plugin.socketName == reservation.GetPrResourceName() {
+				c.stopDevice(name)
+			}
+		}
+	}
+}
+
 func (c *DeviceController) startDevice(resourceName string, dev Device) {
 	c.stopDevice(resourceName)
 	controlledDev := controlledDevice{
@@ -481,6 +536,7 @@ func (c *DeviceController) Run(stop chan struct{}) {
 	func() {
 		c.startedPluginsMutex.Lock()
 		defer c.startedPluginsMutex.Unlock()
+		c.refreshMaxDevices()
 		for name, dev := range c.permanentPlugins {
 			c.startDevice(name, dev)
 		}
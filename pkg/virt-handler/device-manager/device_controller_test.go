@@ -33,10 +33,12 @@ import (
 	"go.uber.org/mock/gomock"
 
 	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
@@ -277,4 +279,28 @@ var _ = Describe("Device Controller", func() {
 			}, 5*time.Second).Should(BeTrue())
 		})
 	})
+
+	Context("Node overrides", func() {
+		DescribeTable("should size the permanent device plugins by the number of VMIs of the node", func(nodeLabels map[string]string, expectedDevices int) {
+			Expect(fakeNodeStore.Add(&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: host, Labels: nodeLabels},
+			})).To(Succeed())
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				NodeOverrides: []v1.NodeConfigurationOverride{
+					{NodeSelector: map[string]string{"pool": "dense"}, VirtualMachineInstancesPerNode: pointer.P(250)},
+				},
+			})
+			permanentPlugins := []Device{NewGenericDevicePlugin("kvm", "/dev/kvm", maxDevices, permissions, false)}
+			deviceController := NewDeviceController(host, maxDevices, permissions, permanentPlugins, clusterConfig, fakeNodeStore)
+
+			deviceController.refreshMaxDevices()
+
+			Expect(deviceController.maxDevices).To(Equal(expectedDevices))
+			plugin := deviceController.permanentPlugins["kvm"].(*GenericDevicePlugin)
+			Expect(plugin.devs).To(HaveLen(expectedDevices))
+		},
+			Entry("with the override selecting the node", map[string]string{"pool": "dense"}, 250),
+			Entry("without override selecting the node", map[string]string{"pool": "sparse"}, 100),
+		)
+	})
 })
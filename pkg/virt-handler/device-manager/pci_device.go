@@ -253,7 +253,9 @@ func (dpi *PCIDevicePlugin) healthCheck() error {
 	}
 }
 
-func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string) map[string][]*PCIDevice {
+// discoverPermittedHostPCIDevices finds the host PCI devices either listed in supportedPCIDeviceMap or
+// matching the discovery rules, except for the ones provided by external device plugins.
+func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string, externalPCIDevices map[string]struct{}, discoveryRules []v1.PciDiscoveryRule) map[string][]*PCIDevice {
 	pciDevicesMap := make(map[string][]*PCIDevice)
 	err := filepath.Walk(pciBasePath, func(path string, info os.FileInfo, err error) error {
 		if info.IsDir() {
@@ -264,37 +266,50 @@ func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string) ma
 			log.DefaultLogger().Reason(err).Errorf("failed get vendor:device ID for device: %s", info.Name())
 			return nil
 		}
-		if resourceName, supported := supportedPCIDeviceMap[pciID]; supported {
-			// check device driver
-			driver, err := handler.GetDeviceDriver(pciBasePath, info.Name())
-			if err != nil {
-				log.DefaultLogger().Reason(err).Errorf("failed to get driver for device: %s", info.Name())
+		resourceName, supported := supportedPCIDeviceMap[pciID]
+		if !supported {
+			_, external := externalPCIDevices[pciID]
+			// the driver is only checked once the ID matches a rule
+			if _, matched := matchPCIDiscoveryRules(discoveryRules, pciID, ""); external || !matched {
+				log.DefaultLogger().V(9).Infof("Not supported %s", pciID)
 				return nil
 			}
+		}
 
-			// For devices without vfio-pci driver, check if they're NVIDIA vGPU VFs
-			if driver != "vfio-pci" {
-				if !shouldPermitNonVFIOPCI(driver, pciBasePath, info.Name(), pciID) {
-					log.DefaultLogger().V(9).Infof("Not supported driver %s, pci %s", driver, pciID)
-					return nil
-				}
-			}
+		// check device driver
+		driver, err := handler.GetDeviceDriver(pciBasePath, info.Name())
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("failed to get driver for device: %s", info.Name())
+			return nil
+		}
 
-			pcidev := &PCIDevice{
-				pciID:      pciID,
-				pciAddress: info.Name(),
+		if !supported {
+			if resourceName, supported = matchPCIDiscoveryRules(discoveryRules, pciID, driver); !supported {
+				log.DefaultLogger().V(9).Infof("Not supported driver %s, pci %s", driver, pciID)
+				return nil
 			}
-			iommuGroup, err := handler.GetDeviceIOMMUGroup(pciBasePath, info.Name())
-			if err != nil {
+		}
+
+		// For devices without vfio-pci driver, check if they're NVIDIA vGPU VFs
+		if driver != "vfio-pci" {
+			if !shouldPermitNonVFIOPCI(driver, pciBasePath, info.Name(), pciID) {
+				log.DefaultLogger().V(9).Infof("Not supported driver %s, pci %s", driver, pciID)
 				return nil
 			}
-			pcidev.iommuGroup = iommuGroup
-			pcidev.driver = driver
-			pcidev.numaNode = handler.GetDeviceNumaNode(pciBasePath, info.Name())
-			pciDevicesMap[resourceName] = append(pciDevicesMap[resourceName], pcidev)
-		} else {
-			log.DefaultLogger().V(9).Infof("Not supported %s", pciID)
 		}
+
+		pcidev := &PCIDevice{
+			pciID:      pciID,
+			pciAddress: info.Name(),
+		}
+		iommuGroup, err := handler.GetDeviceIOMMUGroup(pciBasePath, info.Name())
+		if err != nil {
+			return nil
+		}
+		pcidev.iommuGroup = iommuGroup
+		pcidev.driver = driver
+		pcidev.numaNode = handler.GetDeviceNumaNode(pciBasePath, info.Name())
+		pciDevicesMap[resourceName] = append(pciDevicesMap[resourceName], pcidev)
 		return nil
 	},
 	)
@@ -304,6 +319,20 @@ func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string) ma
 	return pciDevicesMap
 }
 
+// matchPCIDiscoveryRules returns the resource name of the first rule matching the PCI ID and the driver.
+// An empty driver matches the rules of any driver.
+func matchPCIDiscoveryRules(discoveryRules []v1.PciDiscoveryRule, pciID, driver string) (string, bool) {
+	for _, rule := range discoveryRules {
+		if matched, _ := filepath.Match(strings.ToLower(rule.PCIVendorSelector), pciID); !matched {
+			continue
+		}
+		if driver == "" || rule.Driver == "" || rule.Driver == driver {
+			return rule.ResourceName, true
+		}
+	}
+	return "", false
+}
+
 func shouldPermitNonVFIOPCI(driver, pciBasePath, pciAddress, pciID string) bool {
 	return shouldPermitNvidia(driver, pciBasePath, pciAddress, pciID)
 }
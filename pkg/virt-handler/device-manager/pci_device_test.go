@@ -104,7 +104,7 @@ pciHostDevices:
 		}
 		// discoverPermittedHostPCIDevices() will walk real PCI devices wherever the tests are running
 		// It's assumed here that it will find a PCI device at 0000:00:00.0
-		devices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap)
+		devices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap, nil, nil)
 		Expect(devices).To(HaveLen(1), "only one PCI device is expected to be found")
 		Expect(devices[fakeName]).To(HaveLen(1), "only one PCI device is expected to be found")
 		Expect(devices[fakeName][0].pciID).To(Equal(fakeID))
@@ -114,6 +114,14 @@ pciHostDevices:
 		Expect(devices[fakeName][0].numaNode).To(Equal(fakeNumaNode))
 	})
 
+	It("Should find the PCI device matching a discovery rule", func() {
+		rules := []v1.PciDiscoveryRule{{PCIVendorSelector: "DEAD:*", Driver: fakeDriver, ResourceName: fakeName}}
+		devices := discoverPermittedHostPCIDevices(map[string]string{}, nil, rules)
+		Expect(devices).To(HaveLen(1), "only one PCI device is expected to be found")
+		Expect(devices[fakeName]).To(HaveLen(1), "only one PCI device is expected to be found")
+		Expect(devices[fakeName][0].pciAddress).To(Equal(fakeAddress))
+	})
+
 	It("Should validate DPI devices", func() {
 		iommuToPCIMap := make(map[string]string)
 		supportedPCIDeviceMap := make(map[string]string)
@@ -125,7 +133,7 @@ pciHostDevices:
 		}
 		// discoverPermittedHostPCIDevices() will walk real PCI devices wherever the tests are running
 		// It's assumed here that it will find a PCI device at 0000:00:00.0
-		pciDevices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap)
+		pciDevices := discoverPermittedHostPCIDevices(supportedPCIDeviceMap, nil, nil)
 		devs := constructDPIdevices(pciDevices[fakeName], iommuToPCIMap)
 		Expect(devs[0].ID).To(Equal(fakeIommuGroup))
 		Expect(devs[0].Topology.Nodes[0].ID).To(Equal(int64(fakeNumaNode)))
@@ -195,6 +203,24 @@ pciHostDevices:
 	})
 })
 
+var _ = DescribeTable("PCI discovery rules", func(pciID, driver string, expectedResourceName string, expectedMatch bool) {
+	rules := []v1.PciDiscoveryRule{
+		{PCIVendorSelector: "10DE:1EB8", Driver: "nvidia", ResourceName: "nvidia.com/TU104GL_Tesla_T4_vGPU"},
+		{PCIVendorSelector: "10de:*", Driver: "vfio-pci", ResourceName: "nvidia.com/GPU"},
+		{PCIVendorSelector: "8086:15?b", ResourceName: "intel.com/NIC"},
+	}
+	resourceName, matched := matchPCIDiscoveryRules(rules, pciID, driver)
+	Expect(matched).To(Equal(expectedMatch))
+	Expect(resourceName).To(Equal(expectedResourceName))
+},
+	Entry("should match the first matching rule", "10de:1eb8", "vfio-pci", "nvidia.com/GPU", true),
+	Entry("should match the rule of the driver", "10de:1eb8", "nvidia", "nvidia.com/TU104GL_Tesla_T4_vGPU", true),
+	Entry("should match any driver with an empty driver", "10de:1eb8", "", "nvidia.com/TU104GL_Tesla_T4_vGPU", true),
+	Entry("should match a rule without driver", "8086:15fb", "vfio-pci", "intel.com/NIC", true),
+	Entry("should not match another driver", "10de:2236", "nouveau", "", false),
+	Entry("should not match another vendor", "1af4:1000", "vfio-pci", "", false),
+)
+
 var _ = Describe("Physical Function Detection", func() {
 	var tmpSysDir string
 
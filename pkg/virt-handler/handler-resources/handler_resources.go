@@ -0,0 +1,204 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package handler_resources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	handlerContainerName = "virt-handler"
+	resizeLoopInterval   = 5 * time.Minute
+)
+
+// Handler resizes the virt-handler pod in place to the resources of the node override selecting
+// the node, or back to the resources of the virt-handler DaemonSet when there is none.
+type Handler struct {
+	clusterConfig *virtconfig.ClusterConfig
+	nodeName      string
+	namespace     string
+	podName       string
+	client        kubernetes.Interface
+	lock          sync.Mutex
+	nodeStore     cache.Store
+	// chan for being notified by KV config changes
+	extChangesChan chan struct{}
+	loopChan       chan struct{}
+}
+
+func NewHandler(
+	nodeName string,
+	namespace string,
+	podName string,
+	client kubernetes.Interface,
+	nodeStore cache.Store,
+	clusterConfig *virtconfig.ClusterConfig,
+) *Handler {
+	return &Handler{
+		clusterConfig:  clusterConfig,
+		nodeName:       nodeName,
+		namespace:      namespace,
+		podName:        podName,
+		client:         client,
+		nodeStore:      nodeStore,
+		extChangesChan: make(chan struct{}, 1),
+		loopChan:       make(chan struct{}),
+	}
+}
+
+func (h *Handler) Run(stopCh chan struct{}) {
+	defer close(h.loopChan)
+	go h.Start()
+	<-stopCh
+}
+
+func (h *Handler) Start() {
+	h.clusterConfig.SetConfigModifiedCallback(func() {
+		select {
+		case h.extChangesChan <- struct{}{}:
+		default:
+		}
+	})
+	h.loop()
+}
+
+func (h *Handler) loop() {
+	h.spin()
+	ticker := time.NewTicker(resizeLoopInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.extChangesChan:
+			h.spin()
+			ticker.Reset(resizeLoopInterval)
+		case <-ticker.C:
+			h.spin()
+		case <-h.loopChan:
+			return
+		}
+	}
+}
+
+func (h *Handler) spin() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if err := h.resize(); err != nil {
+		log.DefaultLogger().Reason(err).Error("failed to resize virt-handler")
+	}
+}
+
+func (h *Handler) resize() error {
+	if h.podName == "" {
+		return nil
+	}
+
+	pod, err := h.client.CoreV1().Pods(h.namespace).Get(context.Background(), h.podName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	desired, err := h.desiredResources(pod)
+	if err != nil {
+		return err
+	}
+
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		if container.Name != handlerContainerName {
+			continue
+		}
+		if equality.Semantic.DeepEqual(container.Resources.Requests, desired.Requests) &&
+			equality.Semantic.DeepEqual(container.Resources.Limits, desired.Limits) {
+			return nil
+		}
+		log.DefaultLogger().Infof("resizing virt-handler to requests %v and limits %v", desired.Requests, desired.Limits)
+		container.Resources.Requests = desired.Requests
+		container.Resources.Limits = desired.Limits
+		_, err = h.client.CoreV1().Pods(h.namespace).UpdateResize(context.Background(), h.podName, pod, metav1.UpdateOptions{})
+		return err
+	}
+
+	return fmt.Errorf("container %s not found in pod %s", handlerContainerName, h.podName)
+}
+
+func (h *Handler) desiredResources(pod *k8sv1.Pod) (*k8sv1.ResourceRequirements, error) {
+	node, err := h.getNode()
+	if err != nil {
+		return nil, err
+	}
+	if override := h.clusterConfig.GetNodeConfigurationOverride(node); override != nil && override.HandlerResources != nil {
+		return &k8sv1.ResourceRequirements{
+			Requests: override.HandlerResources.Requests,
+			Limits:   override.HandlerResources.Limits,
+		}, nil
+	}
+
+	return h.daemonSetResources(pod)
+}
+
+func (h *Handler) daemonSetResources(pod *k8sv1.Pod) (*k8sv1.ResourceRequirements, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "DaemonSet" {
+		return nil, fmt.Errorf("pod %s is not owned by a DaemonSet", pod.Name)
+	}
+
+	daemonSet, err := h.client.AppsV1().DaemonSets(h.namespace).Get(context.Background(), owner.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, container := range daemonSet.Spec.Template.Spec.Containers {
+		if container.Name == handlerContainerName {
+			return &container.Resources, nil
+		}
+	}
+
+	return nil, fmt.Errorf("container %s not found in DaemonSet %s", handlerContainerName, daemonSet.Name)
+}
+
+func (h *Handler) getNode() (*k8sv1.Node, error) {
+	nodeObj, exists, err := h.nodeStore.GetByKey(h.nodeName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("node %s does not exist", h.nodeName)
+	}
+
+	node, ok := nodeObj.(*k8sv1.Node)
+	if !ok {
+		return nil, fmt.Errorf("unknown object type found in node informer")
+	}
+
+	return node, nil
+}
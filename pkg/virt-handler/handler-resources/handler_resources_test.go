@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package handler_resources
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testNamespace = "kubevirt"
+	testNodeName  = "test-node"
+	testPodName   = "virt-handler-abcde"
+)
+
+var _ = Describe("Handler resources", func() {
+	var fakeNodeStore cache.Store
+	var fakeClient *fake.Clientset
+
+	daemonSetResources := k8sv1.ResourceRequirements{
+		Requests: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("10m"),
+			k8sv1.ResourceMemory: resource.MustParse("325Mi"),
+		},
+	}
+	overrideResources := v1.ResourceRequirementsWithoutClaims{
+		Requests: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("500m"),
+			k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+	}
+
+	newHandler := func(podResources k8sv1.ResourceRequirements, nodeLabels map[string]string) *Handler {
+		daemonSet := &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "virt-handler", Namespace: testNamespace},
+			Spec: appsv1.DaemonSetSpec{
+				Template: k8sv1.PodTemplateSpec{
+					Spec: k8sv1.PodSpec{
+						Containers: []k8sv1.Container{{Name: handlerContainerName, Resources: daemonSetResources}},
+					},
+				},
+			},
+		}
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testPodName,
+				Namespace: testNamespace,
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "DaemonSet", Name: daemonSet.Name, Controller: pointer.P(true)},
+				},
+			},
+			Spec: k8sv1.PodSpec{
+				Containers: []k8sv1.Container{{Name: handlerContainerName, Resources: podResources}},
+			},
+		}
+		fakeClient = fake.NewSimpleClientset(daemonSet, pod)
+
+		fakeNodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		fakeNodeStore = fakeNodeInformer.GetStore()
+		Expect(fakeNodeStore.Add(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: testNodeName, Labels: nodeLabels},
+		})).To(Succeed())
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			NodeOverrides: []v1.NodeConfigurationOverride{
+				{NodeSelector: map[string]string{"pool": "dense"}, HandlerResources: &overrideResources},
+			},
+		})
+		return NewHandler(testNodeName, testNamespace, testPodName, fakeClient, fakeNodeStore, clusterConfig)
+	}
+
+	getPodResources := func() k8sv1.ResourceRequirements {
+		pod, err := fakeClient.CoreV1().Pods(testNamespace).Get(context.Background(), testPodName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pod.Spec.Containers[0].Resources
+	}
+
+	It("should resize virt-handler to the resources of the override selecting the node", func() {
+		handler := newHandler(daemonSetResources, map[string]string{"pool": "dense"})
+		Expect(handler.resize()).To(Succeed())
+		Expect(getPodResources().Requests).To(Equal(overrideResources.Requests))
+	})
+
+	It("should resize virt-handler back to the resources of the DaemonSet", func() {
+		handler := newHandler(k8sv1.ResourceRequirements{Requests: overrideResources.Requests}, map[string]string{"pool": "sparse"})
+		Expect(handler.resize()).To(Succeed())
+		Expect(getPodResources().Requests).To(Equal(daemonSetResources.Requests))
+	})
+
+	It("should not resize virt-handler when it has the desired resources", func() {
+		handler := newHandler(daemonSetResources, nil)
+		Expect(handler.resize()).To(Succeed())
+		for _, action := range fakeClient.Actions() {
+			Expect(action.GetSubresource()).ToNot(Equal("resize"))
+		}
+	})
+})
@@ -20,51 +20,82 @@
 package heartbeat
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	coordinationcli "k8s.io/client-go/kubernetes/typed/coordination/v1"
 	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
 )
 
-const failedSetCPUManagerLabelFmt = "failed to set a cpu manager label on host %s"
+const (
+	failedSetCPUManagerLabelFmt = "failed to set a cpu manager label on host %s"
+	memInfoPath                 = "/proc/meminfo"
+	// annotationRefreshInterval is the interval at which the heartbeat annotation of the node is refreshed even
+	// if its labels did not change. With the jitter of the heartbeat, the annotation is refreshed at the latest
+	// after about four and a half minutes, below the five minutes after which virt-controller marks a node
+	// without a lease unresponsive.
+	annotationRefreshInterval = 2 * time.Minute
+	leaseDurationSeconds      = 300
+)
 
 type HeartBeat struct {
 	clientset                 k8scli.CoreV1Interface
+	leaseClient               coordinationcli.LeaseInterface
+	nodeStore                 cache.Store
 	deviceManagerController   device_manager.DeviceControllerInterface
 	clusterConfig             *virtconfig.ClusterConfig
 	host                      string
 	cpuManagerPaths           []string
+	memInfoPath               string
 	devicePluginPollIntervall time.Duration
 	devicePluginWaitTimeout   time.Duration
+	annotationRefreshInterval time.Duration
+
+	lease         *coordinationv1.Lease
+	lastNodePatch time.Time
 }
 
-func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
+func NewHeartBeat(clientset k8scli.CoreV1Interface, leaseClient coordinationcli.LeaseInterface, nodeStore cache.Store, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
 	const cpuManagerOS3Path = virtutil.HostRootMount + "var/lib/origin/openshift.local.volumes/cpu_manager_state"
 	const cpuManagerPath = virtutil.KubeletRoot + "/cpu_manager_state"
 	return &HeartBeat{
 		clientset:               clientset,
+		leaseClient:             leaseClient,
+		nodeStore:               nodeStore,
 		deviceManagerController: deviceManager,
 		clusterConfig:           clusterConfig,
 		host:                    host,
 		// This is a temporary workaround until k8s bug #66525 is resolved
 		cpuManagerPaths:           []string{cpuManagerPath, cpuManagerOS3Path},
+		memInfoPath:               memInfoPath,
 		devicePluginPollIntervall: 1 * time.Second,
 		devicePluginWaitTimeout:   10 * time.Second,
+		annotationRefreshInterval: annotationRefreshInterval,
 	}
 }
 
@@ -138,18 +169,84 @@ func (h *HeartBeat) waitForDevicePlugins(stopCh chan struct{}) {
 }
 
 func (h *HeartBeat) do() {
-	now, err := json.Marshal(metav1.Now())
+	leaseRenewed := true
+	if err := h.renewLease(); err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't renew the heartbeat lease of node %s", h.host)
+		leaseRenewed = false
+	}
+
+	labels := h.nodeLabels()
+	staleLabels := h.staleNodeLabels()
+	// The node is only patched when its labels differ, or when the lease can't carry the heartbeat.
+	// The periodic patch keeps the annotation heartbeat for virt-controllers which don't read the lease.
+	if !leaseRenewed || !h.nodeHasLabels(labels) || len(staleLabels) > 0 || time.Since(h.lastNodePatch) >= h.annotationRefreshInterval {
+		if err := h.patchNode(labels, staleLabels); err != nil {
+			log.DefaultLogger().Reason(err).Errorf("Can't patch node %s", h.host)
+			return
+		}
+		h.lastNodePatch = time.Now()
+	}
+
+	// A configuration of mediated devices types on this node depends on the existing node labels
+	// and a MediatedDevicesConfiguration in KubeVirt CR.
+	// When labels change we should initialize a refresh to create/remove mdev types and start/stop
+	// relevant device plugins. This operation should be async.
+	if !h.clusterConfig.MediatedDevicesHandlingDisabled() {
+		h.deviceManagerController.RefreshMediatedDeviceTypes()
+	}
+
+	log.DefaultLogger().V(4).Infof("Heartbeat sent")
+}
+
+// renewLease renews the heartbeat lease of the node, creating it if needed. The lease last
+// written is reused so that a renewal costs a single update.
+func (h *HeartBeat) renewLease() error {
+	now := metav1.NowMicro()
+	if h.lease == nil {
+		lease, err := h.leaseClient.Get(context.Background(), virtutil.VirtHandlerLeaseName(h.host), metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			lease = &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: virtutil.VirtHandlerLeaseName(h.host),
+					Labels: map[string]string{
+						v1.AppLabel: "virt-handler",
+					},
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       pointer.P(h.host),
+					LeaseDurationSeconds: pointer.P(int32(leaseDurationSeconds)),
+					AcquireTime:          &now,
+					RenewTime:            &now,
+				},
+			}
+			h.lease, err = h.leaseClient.Create(context.Background(), lease, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+		h.lease = lease
+	}
+
+	lease := h.lease.DeepCopy()
+	lease.Spec.HolderIdentity = pointer.P(h.host)
+	lease.Spec.LeaseDurationSeconds = pointer.P(int32(leaseDurationSeconds))
+	lease.Spec.RenewTime = &now
+	updated, err := h.leaseClient.Update(context.Background(), lease, metav1.UpdateOptions{})
 	if err != nil {
-		log.DefaultLogger().Reason(err).Errorf("Can't determine date")
-		return
+		// Fetch the lease again on the next renewal, it may have been changed or removed
+		h.lease = nil
+		return err
 	}
+	h.lease = updated
+	return nil
+}
 
+func (h *HeartBeat) nodeLabels() map[string]string {
 	kubevirtSchedulable := "true"
 	if !h.deviceManagerController.Initialized() {
 		kubevirtSchedulable = "false"
 	}
 
-	var data []byte
 	// Label the node if cpu manager is running on it
 	// This is a temporary workaround until k8s bug #66525 is resolved
 	cpuManagerEnabled := false
@@ -157,27 +254,141 @@ func (h *HeartBeat) do() {
 		cpuManagerEnabled = h.isCPUManagerEnabled(h.cpuManagerPaths)
 	}
 
-	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": "%t"}, "annotations": {"%s": %s}}}`,
-		v1.NodeSchedulable, kubevirtSchedulable,
-		v1.DeprecatedCPUManager, cpuManagerEnabled,
-		v1.CPUManager, cpuManagerEnabled,
-		v1.VirtHandlerHeartbeat, string(now),
-	))
+	labels := map[string]string{
+		v1.NodeSchedulable:      kubevirtSchedulable,
+		v1.DeprecatedCPUManager: strconv.FormatBool(cpuManagerEnabled),
+		v1.CPUManager:           strconv.FormatBool(cpuManagerEnabled),
+	}
+	if h.clusterConfig.GetConfig().MemoryOvercommitConfiguration != nil {
+		maps.Copy(labels, h.memoryOvercommitLabels())
+	} else {
+		metrics.DeleteMemoryOvercommitPressure(h.host)
+	}
+	return labels
+}
+
+// staleNodeLabels returns the labels of the node which are not maintained anymore,
+// e.g. the memory overcommit labels once memory overcommit is not configured.
+func (h *HeartBeat) staleNodeLabels() []string {
+	if h.clusterConfig.GetConfig().MemoryOvercommitConfiguration != nil {
+		return nil
+	}
+	obj, exists, err := h.nodeStore.GetByKey(h.host)
+	if err != nil || !exists {
+		return nil
+	}
+	node := obj.(*k8sv1.Node)
+	var staleLabels []string
+	for _, label := range []string{v1.SwapEnabledLabel, v1.MemoryOvercommitPressureLabel} {
+		if _, exists := node.Labels[label]; exists {
+			staleLabels = append(staleLabels, label)
+		}
+	}
+	return staleLabels
+}
+
+// nodeHasLabels compares the labels with the ones of the node, rather than with the ones last patched,
+// so that labels changed by others, e.g. virt-controller marking an unresponsive node unschedulable,
+// are restored on the next heartbeat.
+func (h *HeartBeat) nodeHasLabels(labels map[string]string) bool {
+	obj, exists, err := h.nodeStore.GetByKey(h.host)
+	if err != nil || !exists {
+		return false
+	}
+	node := obj.(*k8sv1.Node)
+	for key, value := range labels {
+		if nodeValue, ok := node.Labels[key]; !ok || nodeValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *HeartBeat) patchNode(labels map[string]string, staleLabels []string) error {
+	now, err := json.Marshal(metav1.Now())
+	if err != nil {
+		return err
+	}
+
+	// a null value removes the label with the strategic merge patch
+	patchLabels := map[string]*string{}
+	for key, value := range labels {
+		patchLabels[key] = pointer.P(value)
+	}
+	for _, key := range staleLabels {
+		patchLabels[key] = nil
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels":      patchLabels,
+			"annotations": map[string]json.RawMessage{v1.VirtHandlerHeartbeat: now},
+		},
+	})
+	if err != nil {
+		return err
+	}
 	_, err = h.clientset.Nodes().Patch(context.Background(), h.host, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+	return err
+}
+
+// memoryOvercommitLabels reports whether swap is enabled on the node and whether the memory in use,
+// swap included, crossed the configured overcommit pressure threshold.
+func (h *HeartBeat) memoryOvercommitLabels() map[string]string {
+	memInfo, err := readMemInfo(h.memInfoPath)
 	if err != nil {
-		log.DefaultLogger().Reason(err).Errorf("Can't patch node %s", h.host)
-		return
+		log.DefaultLogger().Reason(err).Errorf("failed to read the memory usage of host %s", h.host)
+		return nil
 	}
 
-	// A configuration of mediated devices types on this node depends on the existing node labels
-	// and a MediatedDevicesConfiguration in KubeVirt CR.
-	// When labels change we should initialize a refresh to create/remove mdev types and start/stop
-	// relevant device plugins. This operation should be async.
-	if !h.clusterConfig.MediatedDevicesHandlingDisabled() {
-		h.deviceManagerController.RefreshMediatedDeviceTypes()
+	total := memInfo["MemTotal"] + memInfo["SwapTotal"]
+	available := memInfo["MemAvailable"] + memInfo["SwapFree"]
+	underPressure := false
+	if total > 0 && available <= total {
+		threshold := uint64(h.clusterConfig.GetMemoryOvercommitPressureThreshold())
+		underPressure = (total-available)*100 >= total*threshold
 	}
 
-	log.DefaultLogger().V(4).Infof("Heartbeat sent")
+	metrics.SetMemoryOvercommitPressure(h.host, underPressure)
+
+	return map[string]string{
+		v1.SwapEnabledLabel:              strconv.FormatBool(memInfo["SwapTotal"] > 0),
+		v1.MemoryOvercommitPressureLabel: strconv.FormatBool(underPressure),
+	}
+}
+
+// readMemInfo returns the fields of a meminfo file, in kB
+func readMemInfo(path string) (map[string]uint64, error) {
+	// #nosec No risk for path injection. path is composed of static values
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	memInfo := map[string]uint64{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		key, value, found := strings.Cut(s.Text(), ":")
+		if !found {
+			continue
+		}
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+		memInfo[key], err = strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", key, err)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if _, exists := memInfo["MemTotal"]; !exists {
+		return nil, fmt.Errorf("failed to find the total memory in %s", path)
+	}
+	return memInfo, nil
 }
 
 func (h *HeartBeat) isCPUManagerEnabled(cpuManagerPaths []string) bool {
@@ -26,13 +26,20 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	virtv1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtutil "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
@@ -41,12 +48,23 @@ import (
 const (
 	cpu_manager_static_path = "testdata/cpu_manager_state_static"
 	cpu_manager_none_path   = "testdata/cpu_manager_state_none"
+	meminfo_swap_path       = "testdata/meminfo_swap"
+	meminfo_noswap_path     = "testdata/meminfo_noswap"
+	namespace               = "kubevirt"
 )
 
 var _ = Describe("Heartbeat", func() {
 
 	var node *v1.Node
 	var fakeClient *fake.Clientset
+	var nodeStore cache.Store
+
+	// syncNodeStore stands for the node informer of virt-handler catching up with the node
+	syncNodeStore := func() {
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodeStore.Update(node)).To(Succeed())
+	}
 
 	BeforeEach(func() {
 		node = &v1.Node{
@@ -55,10 +73,12 @@ var _ = Describe("Heartbeat", func() {
 			},
 		}
 		fakeClient = fake.NewSimpleClientset(node)
+		nodeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		Expect(nodeStore.Add(node)).To(Succeed())
 	})
 	Context("upon finishing", func() {
 		It("should set the node to not schedulable", func() {
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(), "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), config(), "mynode")
 			stopChan := make(chan struct{})
 			done := heartbeat.Run(30*time.Second, stopChan)
 			Eventually(func() map[string]string {
@@ -77,7 +97,7 @@ var _ = Describe("Heartbeat", func() {
 	})
 
 	DescribeTable("with cpumanager featuregate should set the node to", func(deviceController device_manager.DeviceControllerInterface, cpuManagerPaths []string, schedulable string, cpumanager string) {
-		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, config(featuregate.CPUManager), "mynode")
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController, config(featuregate.CPUManager), "mynode")
 		heartbeat.cpuManagerPaths = cpuManagerPaths
 		heartbeat.do()
 		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
@@ -113,7 +133,7 @@ var _ = Describe("Heartbeat", func() {
 	)
 
 	DescribeTable("without cpumanager featuregate should set the node to", func(deviceController device_manager.DeviceControllerInterface, schedulable string) {
-		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, config(), "mynode")
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController, config(), "mynode")
 		heartbeat.do()
 		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
 		Expect(err).ToNot(HaveOccurred())
@@ -131,8 +151,144 @@ var _ = Describe("Heartbeat", func() {
 		),
 	)
 
+	DescribeTable("with memory overcommit configured should set the node to", func(memInfoPath string, threshold *int, swapEnabled, underPressure string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			MemoryOvercommitConfiguration: &virtv1.MemoryOvercommitConfiguration{PressureThreshold: threshold},
+		})
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), clusterConfig, "mynode")
+		heartbeat.memInfoPath = memInfoPath
+		heartbeat.do()
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, "true"))
+		Expect(node.Labels).To(HaveKeyWithValue(virtv1.SwapEnabledLabel, swapEnabled))
+		Expect(node.Labels).To(HaveKeyWithValue(virtv1.MemoryOvercommitPressureLabel, underPressure))
+	},
+		Entry("swap enabled and not under pressure below the default threshold", meminfo_swap_path, nil, "true", "false"),
+		Entry("swap enabled and under pressure above the configured threshold", meminfo_swap_path, pointer.P(80), "true", "true"),
+		Entry("swap disabled and not under pressure", meminfo_noswap_path, nil, "false", "false"),
+	)
+
+	It("without memory overcommit configured should not report swap or overcommit pressure", func() {
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), config(), "mynode")
+		heartbeat.memInfoPath = meminfo_swap_path
+		heartbeat.do()
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).ToNot(HaveKey(virtv1.SwapEnabledLabel))
+		Expect(node.Labels).ToNot(HaveKey(virtv1.MemoryOvercommitPressureLabel))
+	})
+
+	It("should remove the swap and overcommit pressure labels once memory overcommit is not configured anymore", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			MemoryOvercommitConfiguration: &virtv1.MemoryOvercommitConfiguration{},
+		})
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), clusterConfig, "mynode")
+		heartbeat.memInfoPath = meminfo_swap_path
+		heartbeat.do()
+		syncNodeStore()
+
+		heartbeat.clusterConfig = config()
+		heartbeat.do()
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, "true"))
+		Expect(node.Labels).ToNot(HaveKey(virtv1.SwapEnabledLabel))
+		Expect(node.Labels).ToNot(HaveKey(virtv1.MemoryOvercommitPressureLabel))
+	})
+
+	Context("with a lease", func() {
+		nodePatches := func() int {
+			patches := 0
+			for _, action := range fakeClient.Actions() {
+				if action.Matches("patch", "nodes") {
+					patches++
+				}
+			}
+			return patches
+		}
+
+		It("should create and renew the lease of the node", func() {
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), config(), "mynode")
+			heartbeat.do()
+			lease, err := fakeClient.CoordinationV1().Leases(namespace).Get(context.Background(), virtutil.VirtHandlerLeaseName("mynode"), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lease.Spec.HolderIdentity).To(HaveValue(Equal("mynode")))
+			Expect(lease.Spec.RenewTime).ToNot(BeNil())
+			firstRenewal := lease.Spec.RenewTime.DeepCopy()
+
+			time.Sleep(10 * time.Millisecond)
+			heartbeat.do()
+			lease, err = fakeClient.CoordinationV1().Leases(namespace).Get(context.Background(), virtutil.VirtHandlerLeaseName("mynode"), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lease.Spec.RenewTime.After(firstRenewal.Time)).To(BeTrue())
+		})
+
+		It("should only patch the node when its labels change", func() {
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), config(), "mynode")
+			heartbeat.do()
+			syncNodeStore()
+			heartbeat.do()
+			Expect(nodePatches()).To(Equal(1))
+
+			heartbeat.deviceManagerController = deviceController(false)
+			heartbeat.do()
+			Expect(nodePatches()).To(Equal(2))
+			node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, "false"))
+		})
+
+		It("should restore the labels changed by others", func() {
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), config(), "mynode")
+			heartbeat.do()
+
+			// virt-controller marks the node unschedulable when the heartbeat is late
+			node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			node.Labels[virtv1.NodeSchedulable] = "false"
+			_, err = fakeClient.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			syncNodeStore()
+
+			heartbeat.do()
+			Expect(nodePatches()).To(Equal(2))
+			node, err = fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, "true"))
+		})
+
+		It("should patch the node again once the annotation refresh interval passed", func() {
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), config(), "mynode")
+			heartbeat.annotationRefreshInterval = 0
+			heartbeat.do()
+			syncNodeStore()
+			heartbeat.do()
+			Expect(nodePatches()).To(Equal(2))
+		})
+
+		It("should refresh the annotation before virt-controller considers the node unresponsive", func() {
+			const heartBeatTimeout = 5 * time.Minute
+			const maxHeartBeatInterval = time.Minute * 22 / 10
+			Expect(annotationRefreshInterval + maxHeartBeatInterval).To(BeNumerically("<", heartBeatTimeout))
+		})
+
+		It("should patch the node on every heartbeat while the lease can't be renewed", func() {
+			fakeClient.PrependReactor("*", "leases", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, k8serrors.NewForbidden(coordinationv1.Resource("leases"), "", nil)
+			})
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController(true), config(), "mynode")
+			heartbeat.do()
+			heartbeat.do()
+			Expect(nodePatches()).To(Equal(2))
+			node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Annotations).To(HaveKey(virtv1.VirtHandlerHeartbeat))
+		})
+	})
+
 	DescribeTable("without deviceplugin and", func(deviceController device_manager.DeviceControllerInterface, initiallySchedulable string, finallySchedulable string) {
-		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, config(), "mynode")
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), fakeClient.CoordinationV1().Leases(namespace), nodeStore, deviceController, config(), "mynode")
 		heartbeat.devicePluginWaitTimeout = 2 * time.Second
 		heartbeat.devicePluginPollIntervall = 10 * time.Millisecond
 		stopChan := make(chan struct{})
@@ -0,0 +1,264 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launcher_watchdog
+
+import (
+	"context"
+	"sync"
+	"syscall"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+)
+
+const (
+	checkInterval  = 30 * time.Second
+	defaultTimeout = 5 * time.Minute
+
+	// LauncherUnresponsiveReason is the reason of the events emitted, and of the VMIs failed, for hung virt-launchers
+	LauncherUnresponsiveReason = "LauncherUnresponsive"
+)
+
+type launcherState struct {
+	lastResponsive time.Time
+	alerted        bool
+	recovered      bool
+}
+
+// Watchdog detects the virt-launchers of the running VMIs whose libvirt or QEMU process is hung, as
+// their domain stops reporting its statistics, and applies the configured recovery action to them.
+type Watchdog struct {
+	clusterConfig        *virtconfig.ClusterConfig
+	virtClient           kubecli.KubevirtClient
+	vmiStore             cache.Store
+	clientsManager       launcherclients.LauncherClientsManager
+	podIsolationDetector isolation.PodIsolationDetector
+	recorder             record.EventRecorder
+
+	lock      sync.Mutex
+	launchers map[types.UID]*launcherState
+	now       func() time.Time
+	kill      func(pid int) error
+
+	// chan for being notified by KV config changes
+	extChangesChan chan struct{}
+	loopChan       chan struct{}
+}
+
+func NewWatchdog(
+	clusterConfig *virtconfig.ClusterConfig,
+	virtClient kubecli.KubevirtClient,
+	vmiStore cache.Store,
+	clientsManager launcherclients.LauncherClientsManager,
+	podIsolationDetector isolation.PodIsolationDetector,
+	recorder record.EventRecorder,
+) *Watchdog {
+	return &Watchdog{
+		clusterConfig:        clusterConfig,
+		virtClient:           virtClient,
+		vmiStore:             vmiStore,
+		clientsManager:       clientsManager,
+		podIsolationDetector: podIsolationDetector,
+		recorder:             recorder,
+		launchers:            map[types.UID]*launcherState{},
+		now:                  time.Now,
+		kill: func(pid int) error {
+			return syscall.Kill(pid, syscall.SIGKILL)
+		},
+		extChangesChan: make(chan struct{}, 1),
+		loopChan:       make(chan struct{}),
+	}
+}
+
+func (w *Watchdog) Run(stopCh chan struct{}) {
+	defer close(w.loopChan)
+	go w.Start()
+	<-stopCh
+}
+
+func (w *Watchdog) Start() {
+	w.clusterConfig.SetConfigModifiedCallback(func() {
+		select {
+		case w.extChangesChan <- struct{}{}:
+		default:
+		}
+	})
+	w.loop()
+}
+
+func (w *Watchdog) loop() {
+	w.check()
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.extChangesChan:
+			w.check()
+			ticker.Reset(checkInterval)
+		case <-ticker.C:
+			w.check()
+		case <-w.loopChan:
+			return
+		}
+	}
+}
+
+func (w *Watchdog) check() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	config := w.clusterConfig.GetLauncherWatchdogConfiguration()
+	if config == nil {
+		w.launchers = map[types.UID]*launcherState{}
+		return
+	}
+
+	timeout := defaultTimeout
+	if config.Timeout != nil {
+		timeout = config.Timeout.Duration
+	}
+	action := config.Action
+	if action == "" {
+		action = v1.LauncherWatchdogActionAlert
+	}
+
+	now := w.now()
+	vmis := w.runningVMIs()
+	responsive := w.probe(vmis)
+
+	launchers := make(map[types.UID]*launcherState, len(vmis))
+	for i, vmi := range vmis {
+		state, exists := w.launchers[vmi.UID]
+		if !exists || responsive[i] {
+			// a VMI seen for the first time is given the whole timeout to respond
+			state = &launcherState{lastResponsive: now}
+		}
+		launchers[vmi.UID] = state
+
+		unresponsiveFor := now.Sub(state.lastResponsive)
+		if state.recovered || unresponsiveFor < timeout {
+			continue
+		}
+		if !state.alerted {
+			w.alert(vmi, action, unresponsiveFor)
+			state.alerted = true
+		}
+		if err := w.recover(vmi, action); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to recover the hung virt-launcher with action %s", action)
+			continue
+		}
+		state.recovered = true
+	}
+	w.launchers = launchers
+}
+
+func (w *Watchdog) runningVMIs() []*v1.VirtualMachineInstance {
+	var vmis []*v1.VirtualMachineInstance
+	for _, obj := range w.vmiStore.List() {
+		vmi, ok := obj.(*v1.VirtualMachineInstance)
+		if !ok || vmi.Status.Phase != v1.Running {
+			continue
+		}
+		// the domain of a migrating VMI may not report its statistics while it is paused for the switchover
+		if vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+			continue
+		}
+		vmis = append(vmis, vmi)
+	}
+	return vmis
+}
+
+// probe asks every virt-launcher for its domain statistics, which libvirt reads from the QEMU monitor.
+// The launchers are probed in parallel, so that hung launchers don't delay the others.
+func (w *Watchdog) probe(vmis []*v1.VirtualMachineInstance) []bool {
+	responsive := make([]bool, len(vmis))
+	var wg sync.WaitGroup
+	for i, vmi := range vmis {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responsive[i] = w.isResponsive(vmi)
+		}()
+	}
+	wg.Wait()
+	return responsive
+}
+
+func (w *Watchdog) isResponsive(vmi *v1.VirtualMachineInstance) bool {
+	client, err := w.clientsManager.GetLauncherClient(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).V(4).Info("failed to get the virt-launcher client")
+		return false
+	}
+	_, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).V(4).Info("failed to get the domain statistics")
+		return false
+	}
+	return exists
+}
+
+func (w *Watchdog) alert(vmi *v1.VirtualMachineInstance, action v1.LauncherWatchdogAction, unresponsiveFor time.Duration) {
+	log.Log.Object(vmi).Warningf("virt-launcher has not reported the domain statistics for %s, taking action %s", unresponsiveFor.Round(time.Second), action)
+	metrics.IncLauncherUnresponsive(vmi.Namespace, vmi.Name, string(action))
+	w.recorder.Eventf(vmi, k8sv1.EventTypeWarning, LauncherUnresponsiveReason,
+		"virt-launcher has not reported the domain statistics for %s, taking action %s", unresponsiveFor.Round(time.Second), action)
+}
+
+func (w *Watchdog) recover(vmi *v1.VirtualMachineInstance, action v1.LauncherWatchdogAction) error {
+	switch action {
+	case v1.LauncherWatchdogActionFail:
+		return w.failVMI(vmi)
+	case v1.LauncherWatchdogActionRestart:
+		return w.killLauncher(vmi)
+	}
+	return nil
+}
+
+// failVMI marks the VMI as failed. virt-controller then deletes its virt-launcher pod.
+func (w *Watchdog) failVMI(vmi *v1.VirtualMachineInstance) error {
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.Phase = v1.Failed
+	vmiCopy.Status.Reason = LauncherUnresponsiveReason
+	_, err := w.virtClient.VirtualMachineInstance(vmi.Namespace).Update(context.Background(), vmiCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// killLauncher kills the virt-launcher process, so that its pod terminates and the VMI fails.
+func (w *Watchdog) killLauncher(vmi *v1.VirtualMachineInstance) error {
+	res, err := w.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return err
+	}
+	return w.kill(res.Pid())
+}
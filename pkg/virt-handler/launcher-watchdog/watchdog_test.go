@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launcher_watchdog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Launcher watchdog", func() {
+	var (
+		ctrl           *gomock.Controller
+		virtFakeClient *kubevirtfake.Clientset
+		launcherClient *cmdclient.MockLauncherClient
+		isolationRes   *isolation.MockIsolationResult
+		recorder       *record.FakeRecorder
+		vmiStore       cache.Store
+		now            time.Time
+		killedPids     []int
+	)
+
+	newVMI := func() *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: metav1.NamespaceDefault, UID: "1234"},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: v1.Running},
+		}
+	}
+
+	newWatchdog := func(config *v1.LauncherWatchdogConfiguration) *Watchdog {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			LauncherWatchdog: config,
+		})
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		isolationDetector := isolation.NewMockPodIsolationDetector(ctrl)
+		isolationDetector.EXPECT().Detect(gomock.Any()).Return(isolationRes, nil).AnyTimes()
+
+		w := NewWatchdog(clusterConfig, virtClient, vmiStore, &launcherclients.MockLauncherClientManager{Client: launcherClient}, isolationDetector, recorder)
+		w.now = func() time.Time { return now }
+		w.kill = func(pid int) error {
+			killedPids = append(killedPids, pid)
+			return nil
+		}
+		return w
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtFakeClient = kubevirtfake.NewSimpleClientset()
+		launcherClient = cmdclient.NewMockLauncherClient(ctrl)
+		isolationRes = isolation.NewMockIsolationResult(ctrl)
+		isolationRes.EXPECT().Pid().Return(42).AnyTimes()
+		recorder = record.NewFakeRecorder(10)
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		now = time.Now()
+		killedPids = nil
+
+		vmi := newVMI()
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	expectHung := func() {
+		launcherClient.EXPECT().GetDomainStats().Return(nil, false, fmt.Errorf("timeout")).AnyTimes()
+	}
+
+	It("should not probe the virt-launchers when it is not configured", func() {
+		w := newWatchdog(nil)
+		w.check()
+		Expect(w.launchers).To(BeEmpty())
+	})
+
+	It("should not act on a virt-launcher that keeps reporting its domain statistics", func() {
+		launcherClient.EXPECT().GetDomainStats().Return(&stats.DomainStats{}, true, nil).AnyTimes()
+		w := newWatchdog(&v1.LauncherWatchdogConfiguration{Action: v1.LauncherWatchdogActionRestart})
+		w.check()
+		now = now.Add(10 * time.Minute)
+		w.check()
+		Expect(recorder.Events).To(BeEmpty())
+		Expect(killedPids).To(BeEmpty())
+	})
+
+	It("should wait for the timeout before alerting", func() {
+		expectHung()
+		w := newWatchdog(&v1.LauncherWatchdogConfiguration{Timeout: &metav1.Duration{Duration: time.Minute}})
+		w.check()
+		now = now.Add(30 * time.Second)
+		w.check()
+		Expect(recorder.Events).To(BeEmpty())
+
+		now = now.Add(30 * time.Second)
+		w.check()
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(ContainSubstring(LauncherUnresponsiveReason))
+
+		By("alerting only once")
+		now = now.Add(time.Minute)
+		w.check()
+		Expect(recorder.Events).To(BeEmpty())
+		Expect(killedPids).To(BeEmpty())
+	})
+
+	It("should mark the VMI as failed with the Fail action", func() {
+		expectHung()
+		w := newWatchdog(&v1.LauncherWatchdogConfiguration{Action: v1.LauncherWatchdogActionFail})
+		w.check()
+		now = now.Add(defaultTimeout)
+		w.check()
+
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), "testvmi", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmi.Status.Phase).To(Equal(v1.Failed))
+		Expect(vmi.Status.Reason).To(Equal(LauncherUnresponsiveReason))
+	})
+
+	It("should kill the virt-launcher once with the Restart action", func() {
+		expectHung()
+		w := newWatchdog(&v1.LauncherWatchdogConfiguration{Action: v1.LauncherWatchdogActionRestart})
+		w.check()
+		now = now.Add(defaultTimeout)
+		w.check()
+		now = now.Add(defaultTimeout)
+		w.check()
+		Expect(killedPids).To(Equal([]int{42}))
+	})
+
+	It("should skip migrating VMIs", func() {
+		vmi := newVMI()
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{}
+		Expect(vmiStore.Update(vmi)).To(Succeed())
+		w := newWatchdog(&v1.LauncherWatchdogConfiguration{})
+		w.check()
+		Expect(w.launchers).To(BeEmpty())
+	})
+})
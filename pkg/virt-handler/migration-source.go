@@ -480,6 +480,21 @@ func (c *MigrationSourceController) handleSourceMigrationProxy(vmi *v1.VirtualMa
 	return nil
 }
 
+// resyncSourceMigrationProxy starts the migration proxy of a migration in progress again when
+// virt-handler restarted during the migration, so that the connections virt-launcher opens to
+// the target reach it through the restarted virt-handler.
+func (c *MigrationSourceController) resyncSourceMigrationProxy(vmi *v1.VirtualMachineInstance) error {
+	if len(c.migrationProxy.GetSourceListenerFiles(string(vmi.UID))) > 0 ||
+		vmi.Status.MigrationState.TargetDirectMigrationNodePorts == nil {
+		return nil
+	}
+	c.logger.Object(vmi).Infof("restarting the migration proxy of migration %s", vmi.Status.MigrationState.MigrationUID)
+	if err := c.handleSourceMigrationProxy(vmi); err != nil {
+		return fmt.Errorf("failed to handle migration proxy: %v", err)
+	}
+	return nil
+}
+
 func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	shouldReturn, err := c.checkLauncherClient(vmi)
 	if shouldReturn {
@@ -499,7 +514,7 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 	if isMigrationInProgress(vmi, domain) {
 		// we already started this migration, no need to rerun this
 		c.logger.Object(vmi).V(4).Infof("migration %s has already been started", vmi.Status.MigrationState.MigrationUID)
-		return nil
+		return c.resyncSourceMigrationProxy(vmi)
 	}
 
 	err = c.handleSourceMigrationProxy(vmi)
@@ -600,6 +600,32 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(updatedVMI.Status.Interfaces[0].InterfaceName).To(Equal(testIfaceName))
 	})
+
+	It("should restart the migration proxy of a migration in progress after a restart", func() {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = host
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:                     "othernode",
+			TargetNodeAddress:              "127.0.0.1",
+			SourceNode:                     host,
+			MigrationUID:                   "123",
+			StartTimestamp:                 pointer.P(metav1.Now()),
+			TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+		}
+		vmi = addActivePods(vmi, podTestUUID, host)
+
+		domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+		domain.Status.Status = api.Running
+		addVMI(vmi, domain)
+		DeferCleanup(controller.migrationProxy.StopSourceListener, string(vmi.UID))
+
+		Expect(controller.migrationProxy.GetSourceListenerFiles(string(vmi.UID))).To(BeEmpty())
+		sanityExecute()
+		Expect(controller.migrationProxy.GetSourceListenerFiles(string(vmi.UID))).To(HaveLen(1))
+	})
 })
 
 type stubSourcePasstRepairHandler struct {
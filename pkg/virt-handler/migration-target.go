@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	goerror "errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -305,11 +306,15 @@ func (c *MigrationTargetController) updateStatus(vmi *v1.VirtualMachineInstance,
 	}
 
 	hostAddress := ""
+	var hostPorts map[string]int
 	// advertise target address
 	if vmi.Status.MigrationState != nil {
 		hostAddress = vmi.Status.MigrationState.TargetNodeAddress
+		hostPorts = vmi.Status.MigrationState.TargetDirectMigrationNodePorts
 	}
-	if hostAddress != c.migrationIpAddress {
+	// the listener gets new ports when virt-handler restarts, advertise them again
+	// so that a pending migration uses the listener of the restarted virt-handler
+	if hostAddress != c.migrationIpAddress || !maps.Equal(hostPorts, destSrcPortsMap) {
 		portsList := make([]string, 0, len(destSrcPortsMap))
 
 		for k := range destSrcPortsMap {
@@ -979,29 +984,31 @@ func (c *MigrationTargetController) reportTargetTopologyForMigratingVMI(vmi *v1.
 func (c *MigrationTargetController) hotplugCPU(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 
-	removeVMIVCPUChangeConditionAndLabel := func() {
+	removeVMIVCPUChangeLabel := func() {
 		delete(vmi.Labels, v1.VirtualMachinePodCPULimitsLabel)
-		vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
 	}
-	defer removeVMIVCPUChangeConditionAndLabel()
+	defer removeVMIVCPUChangeLabel()
 
-	if !vmiConditions.HasCondition(vmi, v1.VirtualMachineInstanceVCPUChange) {
+	if !vmiConditions.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue) {
 		return nil
 	}
 
 	if vmi.IsCPUDedicated() {
 		cpuLimitStr, ok := vmi.Labels[v1.VirtualMachinePodCPULimitsLabel]
 		if !ok || len(cpuLimitStr) == 0 {
+			vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
 			return fmt.Errorf("cannot read CPU limit from VMI annotation")
 		}
 
 		cpuLimit, err := strconv.Atoi(cpuLimitStr)
 		if err != nil {
+			vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
 			return fmt.Errorf("cannot parse CPU limit from VMI annotation: %v", err)
 		}
 
 		vcpus := hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
 		if vcpus > int64(cpuLimit) {
+			vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
 			return fmt.Errorf("number of requested VCPUS (%d) exceeds the limit (%d)", vcpus, cpuLimit)
 		}
 	}
@@ -1014,9 +1021,18 @@ func (c *MigrationTargetController) hotplugCPU(vmi *v1.VirtualMachineInstance, c
 		c.clusterConfig)
 
 	if err := client.SyncVirtualMachineCPUs(vmi, options); err != nil {
+		// mark hotplug as failed, e.g. the guest refused to offline the vCPUs to unplug
+		vmiConditions.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:    v1.VirtualMachineInstanceVCPUChange,
+			Status:  k8sv1.ConditionFalse,
+			Reason:  vcpuHotplugFailedReason,
+			Message: "vCPU hotplug failed, the guest did not accept the vCPU change",
+		})
 		return err
 	}
 
+	vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
+
 	if vmi.Status.CurrentCPUTopology == nil {
 		vmi.Status.CurrentCPUTopology = &v1.CPUTopology{}
 	}
@@ -287,6 +287,46 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(migrationTargetPasstRepairHandler.isHandleMigrationTargetCalled).Should(BeTrue())
 	})
 
+	It("should advertise the ports of the migration target again after a virt-handler restart", func() {
+		stalePorts := map[string]int{"1": migrationproxy.LibvirtDirectMigrationPort}
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Labels = make(map[string]string)
+		vmi.Status.NodeName = "othernode"
+		vmi.Labels[v1.MigrationTargetNodeNameLabel] = host
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:                     host,
+			SourceNode:                     "othernode",
+			MigrationUID:                   "123",
+			TargetNodeAddress:              controller.migrationIpAddress,
+			TargetDirectMigrationNodePorts: stalePorts,
+		}
+		vmi = addActivePods(vmi, podTestUUID, host)
+
+		// something has to be listening to the cmd socket
+		// for the proxy to work.
+		Expect(os.MkdirAll(cmdclient.SocketDirectoryOnHost(string(podTestUUID)), os.ModePerm)).To(Succeed())
+
+		socketFile := cmdclient.SocketFilePathOnHost(string(podTestUUID))
+		Expect(os.RemoveAll(socketFile)).To(Succeed())
+		socket, err := net.Listen("unix", socketFile)
+		Expect(err).NotTo(HaveOccurred())
+		defer socket.Close()
+
+		client.EXPECT().SyncMigrationTarget(vmi, gomock.Any())
+		createVMI(vmi)
+		sanityExecute()
+		testutils.ExpectEvent(recorder, VMIMigrationTargetPrepared)
+		testutils.ExpectEvent(recorder, "Migration Target is listening")
+
+		updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updatedVMI.Status.MigrationState.TargetDirectMigrationNodePorts).To(Equal(controller.migrationProxy.GetTargetListenerPorts(string(vmi.UID))))
+		Expect(updatedVMI.Status.MigrationState.TargetDirectMigrationNodePorts).ToNot(Equal(stalePorts))
+	})
+
 	It("should abort target prep if VMI is deleted", func() {
 		vmi := api2.NewMinimalVMI("testvmi")
 		vmi.UID = vmiTestUUID
@@ -490,7 +530,7 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(updatedVMI.Status.Interfaces).To(BeEmpty())
 	})
 
-	It("should always remove the VirtualMachineInstanceVCPUChange condition even if hotplug CPU has failed", func() {
+	It("should set the VirtualMachineInstanceVCPUChange condition to false if hotplug CPU has failed", func() {
 		vmi := api2.NewMinimalVMI("testvmi")
 		vmi.UID = vmiTestUUID
 		vmi.ObjectMeta.ResourceVersion = "1"
@@ -548,8 +588,10 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(updatedVMI.Status.CurrentCPUTopology).NotTo(BeNil())
-		Expect(updatedVMI.Status.Conditions).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{
-			"Type": Equal(v1.VirtualMachineInstanceVCPUChange),
+		Expect(updatedVMI.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(v1.VirtualMachineInstanceVCPUChange),
+			"Status": Equal(k8sv1.ConditionFalse),
+			"Reason": Equal(vcpuHotplugFailedReason),
 		})))
 	})
 
@@ -40,6 +40,10 @@ const (
 	NodeLabellerVolumePath        = "/var/lib/kubevirt-node-labeller/"
 
 	supportedFeaturesXml = "supported_features.xml"
+
+	hypervisorFeature = "hypervisor"
+	vmxFeature        = "vmx"
+	svmFeature        = "svm"
 )
 
 func (n *NodeLabeller) filterCpuModels(models []string, obsolete map[string]bool) []string {
@@ -58,13 +62,30 @@ func (n *NodeLabeller) filterCpuModels(models []string, obsolete map[string]bool
 }
 
 func (n *NodeLabeller) getSupportedCpuModels(obsolete map[string]bool) []string {
-	return n.filterCpuModels(n.hostCapabilities.usableModels, obsolete)
+	supported := n.filterCpuModels(n.hostCapabilities.usableModels, obsolete)
+
+	allowed := n.clusterConfig.GetAllowedCPUModels()
+	if len(allowed) == 0 {
+		return supported
+	}
+	return slices.DeleteFunc(supported, func(model string) bool {
+		return !slices.Contains(allowed, model)
+	})
 }
 
 func (n *NodeLabeller) getKnownCpuModels(obsolete map[string]bool) []string {
 	return n.filterCpuModels(n.hostCapabilities.knownModels, obsolete)
 }
 
+// hostModelBelowMinCPUModel returns the minimum CPU model of the host vendor if the host can't run it
+func (n *NodeLabeller) hostModelBelowMinCPUModel() (string, bool) {
+	minModel, ok := n.clusterConfig.GetMinCPUModels()[n.cpuModelVendor]
+	if !ok || slices.Contains(n.hostCapabilities.usableModels, minModel) {
+		return "", false
+	}
+	return minModel, true
+}
+
 func (n *NodeLabeller) getSupportedCpuFeatures() cpuFeatures {
 	supportedCpuFeatures := make(cpuFeatures)
 
@@ -135,6 +156,7 @@ func (n *NodeLabeller) loadDomCapabilities() error {
 	n.SEV = hostDomCapabilities.SEV
 	n.SecureExecution = hostDomCapabilities.SecureExecution
 	n.TDX = hostDomCapabilities.TDX
+	n.GIC = hostDomCapabilities.GIC
 
 	return nil
 }
@@ -266,6 +266,19 @@ var _ = Describe("Node-labeller config", func() {
 		)
 	})
 
+	DescribeTable("should return correct GIC capabilities",
+		func(domCapabilitiesFileName, supported string, versions []string) {
+			nlController.arch = newArchLabeller(arm64)
+			nlController.domCapabilitiesFileName = domCapabilitiesFileName
+			err := nlController.loadDomCapabilities()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nlController.GIC.Supported).To(Equal(supported))
+			Expect(nlController.GIC.Versions.Values).To(Equal(versions))
+		},
+		Entry("when GICv3 is supported", "arm64/virsh_domcapabilities.xml", "yes", []string{"3"}),
+		Entry("when GIC is not supported", "domcapabilities_nosev.xml", "no", nil),
+	)
+
 	It("Make sure proper labels are removed on removeLabellerLabels()", func() {
 		node := &k8sv1.Node{
 			ObjectMeta: metav1.ObjectMeta{
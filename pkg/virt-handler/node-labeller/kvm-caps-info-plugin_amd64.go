@@ -33,6 +33,7 @@ const int CapHypervTlbflush = KVM_CAP_HYPERV_TLBFLUSH;
 const int CapHypervSendIPI = KVM_CAP_HYPERV_SEND_IPI;
 const int CapHypervSynic = KVM_CAP_HYPERV_SYNIC;
 const int CapHypervSynic2 = KVM_CAP_HYPERV_SYNIC2;
+const int CapHypervCPUID = KVM_CAP_HYPERV_CPUID;
 __u32 msr_list_get(void* data, int index) {
 	struct kvm_msr_list *msrs = (struct kvm_msr_list*)data;
 	return msrs->indices[index];
@@ -128,6 +129,11 @@ var CapsDesc = []capability{
 		MSR:  HV_X64_MSR_REENLIGHTENMENT_CONTROL,
 		Name: "reenlightenment",
 	},
+	{
+		// Direct mode synthetic timers landed in the same kernel release as KVM_GET_SUPPORTED_HV_CPUID
+		Extension: uintptr(C.CapHypervCPUID),
+		Name:      "synictimer-direct",
+	},
 }
 
 func availableMsrs(fd uintptr) ([]uint32, error) {
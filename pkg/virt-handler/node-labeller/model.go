@@ -42,6 +42,7 @@ type HostDomCapabilities struct {
 	SecureExecution SecureExecutionConfiguration `xml:"features>s390-pv"`
 	TDX             TDXConfiguration             `xml:"features>tdx"`
 	LaunchSecurity  LaunchSecurityConfiguration  `xml:"features>launchSecurity"`
+	GIC             GICConfiguration             `xml:"features>gic"`
 }
 
 // CPU represents slice of cpu modes
@@ -118,3 +119,13 @@ type SecTypeEnum struct {
 	Name   string   `xml:"name,attr"`
 	Values []string `xml:"value"`
 }
+
+type GICConfiguration struct {
+	Supported string         `xml:"supported,attr"`
+	Versions  GICVersionEnum `xml:"enum"`
+}
+
+type GICVersionEnum struct {
+	Name   string   `xml:"name,attr"`
+	Values []string `xml:"value"`
+}
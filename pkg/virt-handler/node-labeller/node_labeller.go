@@ -21,9 +21,12 @@ package nodelabeller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
@@ -57,10 +60,15 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.SEVESLabel,
 	kubevirtv1.SEVSNPLabel,
 	kubevirtv1.TDXLabel,
+	kubevirtv1.CPUHotplugLabel,
+	kubevirtv1.NestedVirtualizationLabel,
+	kubevirtv1.VirtualizedNodeLabel,
 	kubevirtv1.HostModelCPULabel,
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
 	kubevirtv1.SupportedMachineTypeLabel,
+	kubevirtv1.NUMATopologyLabel,
+	kubevirtv1.CacheTopologyLabel,
 }
 
 // NodeLabeller struct holds information needed to run node-labeller
@@ -80,18 +88,20 @@ type NodeLabeller struct {
 	domCapabilitiesFileName string
 	cpuCounter              *libvirtxml.CapsHostCPUCounter
 	supportedMachines       []libvirtxml.CapsGuestMachine
+	hostTopology            *hostTopology
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
 	SecureExecution         SecureExecutionConfiguration
 	TDX                     TDXConfiguration
+	GIC                     GICConfiguration
 	arch                    archLabeller
 }
 
-func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, nodeStore cache.Store, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine) (*NodeLabeller, error) {
-	return newNodeLabeller(clusterConfig, nodeClient, nodeStore, host, NodeLabellerVolumePath, recorder, cpuCounter, supportedMachines)
+func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, nodeStore cache.Store, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine, hostCaps *libvirtxml.CapsHost) (*NodeLabeller, error) {
+	return newNodeLabeller(clusterConfig, nodeClient, nodeStore, host, NodeLabellerVolumePath, recorder, cpuCounter, supportedMachines, hostCaps)
 
 }
-func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, nodeStore cache.Store, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine) (*NodeLabeller, error) {
+func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, nodeStore cache.Store, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine, hostCaps *libvirtxml.CapsHost) (*NodeLabeller, error) {
 	n := &NodeLabeller{
 		recorder:      recorder,
 		nodeClient:    nodeClient,
@@ -111,6 +121,10 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.
 		arch:                    newArchLabeller(runtime.GOARCH),
 	}
 
+	if hostCaps != nil {
+		n.hostTopology = newHostTopology(hostCaps.NUMA, hostCaps.Cache)
+	}
+
 	err := n.loadAll()
 	if err != nil {
 		return n, err
@@ -201,6 +215,9 @@ func (n *NodeLabeller) run() error {
 	n.removeLabellerLabels(node)
 	//add new labels
 	n.addLabellerLabels(node, newLabels)
+	if err := n.updateHostTopologyAnnotation(node); err != nil {
+		return err
+	}
 	return n.patchNode(originalNode, node)
 }
 
@@ -210,15 +227,28 @@ func skipNodeLabelling(node *v1.Node) bool {
 }
 
 func (n *NodeLabeller) patchNode(originalNode, node *v1.Node) error {
-	if equality.Semantic.DeepEqual(originalNode.Labels, node.Labels) {
+	patchSet := patch.New()
+	if !equality.Semantic.DeepEqual(originalNode.Labels, node.Labels) {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/labels", originalNode.Labels),
+			patch.WithReplace("/metadata/labels", node.Labels),
+		)
+	}
+	if !equality.Semantic.DeepEqual(originalNode.Annotations, node.Annotations) {
+		if len(originalNode.Annotations) == 0 {
+			patchSet.AddOption(patch.WithAdd("/metadata/annotations", node.Annotations))
+		} else {
+			patchSet.AddOption(
+				patch.WithTest("/metadata/annotations", originalNode.Annotations),
+				patch.WithReplace("/metadata/annotations", node.Annotations),
+			)
+		}
+	}
+	if patchSet.IsEmpty() {
 		return nil
 	}
 
-	patchBytes, err := patch.New(
-		patch.WithTest("/metadata/labels", originalNode.Labels),
-		patch.WithReplace("/metadata/labels", node.Labels),
-	).GeneratePayload()
-
+	patchBytes, err := patchSet.GeneratePayload()
 	if err != nil {
 		return err
 	}
@@ -258,10 +288,18 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[labelKey] = "true"
 	}
 
+	for key, value := range n.hostTopology.labels() {
+		newLabels[key] = value
+	}
+
 	for _, key := range n.hypervFeatures.items {
 		newLabels[kubevirtv1.HypervLabel+key] = "true"
 	}
 
+	if n.arch.hasHostSupportedFeatures() && n.isNestedVirtualizationCapable() {
+		newLabels[kubevirtv1.NestedVirtualizationLabel] = "true"
+	}
+
 	if n.hasTSCCounter() {
 		newLabels[kubevirtv1.CPUTimerLabel+"tsc-frequency"] = fmt.Sprintf("%d", n.cpuCounter.Frequency)
 		newLabels[kubevirtv1.CPUTimerLabel+"tsc-scalable"] = fmt.Sprintf("%t", n.cpuCounter.Scaling == "yes")
@@ -271,6 +309,9 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		if _, hostModelObsolete := obsoleteCPUsx86[hostCpuModel.Name]; hostModelObsolete {
 			newLabels[kubevirtv1.NodeHostModelIsObsoleteLabel] = "true"
 			n.alertIfHostModelIsObsolete(node, hostCpuModel.Name, obsoleteCPUsx86)
+		} else if minModel, belowMinModel := n.hostModelBelowMinCPUModel(); belowMinModel {
+			newLabels[kubevirtv1.NodeHostModelIsObsoleteLabel] = "true"
+			n.alertIfHostModelIsBelowMinCPUModel(node, hostCpuModel.Name, minModel)
 		}
 
 		for feature := range hostCpuModel.requiredFeatures {
@@ -279,6 +320,11 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 
 		newLabels[kubevirtv1.CPUModelVendorLabel+n.cpuModelVendor] = "true"
 		newLabels[kubevirtv1.HostModelCPULabel+hostCpuModel.Name] = "true"
+
+		// the host-model CPU of a virtualized node carries the hypervisor feature of its own CPU
+		if hostCpuModel.requiredFeatures[hypervisorFeature] {
+			newLabels[kubevirtv1.VirtualizedNodeLabel] = "true"
+		}
 	}
 
 	capable, err := isNodeRealtimeCapable()
@@ -289,6 +335,14 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[kubevirtv1.RealtimeLabel] = "true"
 	}
 
+	realtimeKernel, err := isNodeRealtimeKernel()
+	if err != nil {
+		n.logger.Reason(err).Error("failed to identify if a node runs a realtime kernel")
+	}
+	if realtimeKernel {
+		newLabels[kubevirtv1.RealtimeKernelLabel] = "true"
+	}
+
 	if n.SEV.Supported == "yes" {
 		newLabels[kubevirtv1.SEVLabel] = "true"
 	}
@@ -309,6 +363,10 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[kubevirtv1.TDXLabel] = "true"
 	}
 
+	if n.arch.arch() == arm64 && n.GIC.Supported == "yes" && slices.Contains(n.GIC.Versions.Values, "3") {
+		newLabels[kubevirtv1.CPUHotplugLabel] = "true"
+	}
+
 	return newLabels
 }
 
@@ -336,6 +394,24 @@ func (n *NodeLabeller) addLabellerLabels(node *v1.Node, labels map[string]string
 	}
 }
 
+// updateHostTopologyAnnotation sets the host topology annotation on the node, or removes it
+// when the topology of the host is unknown
+func (n *NodeLabeller) updateHostTopologyAnnotation(node *v1.Node) error {
+	topology, err := n.hostTopology.annotation()
+	if err != nil {
+		return err
+	}
+	if topology == "" {
+		delete(node.Annotations, kubevirtv1.HostTopologyAnnotation)
+		return nil
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[kubevirtv1.HostTopologyAnnotation] = topology
+	return nil
+}
+
 // removeLabellerLabels removes labels from node
 func (n *NodeLabeller) removeLabellerLabels(node *v1.Node) {
 	for label := range node.Labels {
@@ -361,6 +437,20 @@ func isNodeRealtimeCapable() (bool, error) {
 	return fmt.Sprintf("%s = -1", kernelSchedRealtimeRuntimeInMicrosecods) == st, nil
 }
 
+// realtimeKernelPath only exists on kernels built with PREEMPT_RT, it then contains 1
+var realtimeKernelPath = "/sys/kernel/realtime"
+
+// isNodeRealtimeKernel checks if the node runs a fully preemptible kernel, as required by the lowLatency realtime profile
+func isNodeRealtimeKernel() (bool, error) {
+	content, err := os.ReadFile(realtimeKernelPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(content)) == "1", nil
+}
+
 func isNodeLabellerLabel(label string) bool {
 	for _, prefix := range nodeLabellerLabels {
 		if strings.HasPrefix(label, prefix) {
@@ -376,6 +466,19 @@ func (n *NodeLabeller) alertIfHostModelIsObsolete(originalNode *v1.Node, hostMod
 	n.recorder.Eventf(originalNode, v1.EventTypeWarning, "HostModelIsObsolete", warningMsg)
 }
 
+func (n *NodeLabeller) alertIfHostModelIsBelowMinCPUModel(originalNode *v1.Node, hostModel string, minModel string) {
+	warningMsg := fmt.Sprintf("This node has %v host-model cpu that can't run the %s minimum CPU model of vendor %s", hostModel, minModel, n.cpuModelVendor)
+	n.recorder.Eventf(originalNode, v1.EventTypeWarning, "HostModelIsBelowMinCPUModel", warningMsg)
+}
+
+// isNestedVirtualizationCapable tells whether the node can expose VMX or SVM to its guests.
+// Nodes running in a virtual machine without nested virtualization, e.g. most cloud instances,
+// do not support them.
+func (n *NodeLabeller) isNestedVirtualizationCapable() bool {
+	features := n.getSupportedCpuFeatures()
+	return features[vmxFeature] || features[svmFeature]
+}
+
 func (n *NodeLabeller) hasTSCCounter() bool {
 	return n.cpuCounter != nil && n.cpuCounter.Name == "tsc"
 }
@@ -24,6 +24,9 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -51,6 +54,7 @@ var _ = Describe("Node-labeller ", func() {
 	var fakeNodeStore cache.Store
 	var cpuCounter *libvirtxml.CapsHostCPUCounter
 	var supportedMachines []libvirtxml.CapsGuestMachine
+	var hostCaps *libvirtxml.CapsHost
 
 	initNodeLabeller := func(kubevirt *v1.KubeVirt) {
 		config, _, _ := testutils.NewFakeClusterConfigUsingKV(kubevirt)
@@ -58,7 +62,7 @@ var _ = Describe("Node-labeller ", func() {
 		recorder.IncludeObject = true
 
 		var err error
-		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), fakeNodeStore, nodeName, "testdata", recorder, cpuCounter, supportedMachines)
+		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), fakeNodeStore, nodeName, "testdata", recorder, cpuCounter, supportedMachines, hostCaps)
 		Expect(err).ToNot(HaveOccurred())
 	}
 
@@ -70,6 +74,7 @@ var _ = Describe("Node-labeller ", func() {
 		}
 
 		supportedMachines = []libvirtxml.CapsGuestMachine{{Name: "testmachine"}}
+		hostCaps = nil
 
 		node := newNode(nodeName)
 		kubeClient = fake.NewSimpleClientset(node)
@@ -218,6 +223,98 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(node.Labels).To(HaveKeyWithValue(v1.TDXLabel, "true"))
 	})
 
+	It("should not add CPU hotplug label on amd64", func() {
+		nlController.arch = newArchLabeller(arm64)
+		nlController.domCapabilitiesFileName = "arm64/virsh_domcapabilities.xml"
+		Expect(nlController.loadDomCapabilities()).To(Succeed())
+		nlController.arch = newArchLabeller(amd64)
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.CPUHotplugLabel)))
+	})
+
+	It("should add CPU hotplug label on arm64 with GICv3", func() {
+		nlController.arch = newArchLabeller(arm64)
+		nlController.domCapabilitiesFileName = "arm64/virsh_domcapabilities.xml"
+		Expect(nlController.loadDomCapabilities()).To(Succeed())
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.CPUHotplugLabel, "true"))
+	})
+
+	It("should add nested virtualization label when the host supports VMX", func() {
+		// supported_features.xml contains vmx
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.NestedVirtualizationLabel, "true"))
+	})
+
+	It("should not add nested virtualization label when the host supports neither VMX nor SVM", func() {
+		nlController.supportedFeatures = slices.DeleteFunc(nlController.supportedFeatures, func(feature string) bool {
+			return feature == vmxFeature || feature == svmFeature
+		})
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.NestedVirtualizationLabel)))
+	})
+
+	It("should not add virtualized node label on bare metal", func() {
+		// virsh_domcapabilities.xml has no hypervisor feature
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.VirtualizedNodeLabel)))
+	})
+
+	It("should add virtualized node label when the host-model CPU has the hypervisor feature", func() {
+		nlController.domCapabilitiesFileName = "domcapabilities_nosev.xml"
+		Expect(nlController.loadAll()).Should(Succeed())
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.VirtualizedNodeLabel, "true"))
+	})
+
+	It("should add realtime kernel label on a PREEMPT_RT kernel", func() {
+		realtimeFile := filepath.Join(GinkgoT().TempDir(), "realtime")
+		Expect(os.WriteFile(realtimeFile, []byte("1\n"), 0o644)).To(Succeed())
+		origRealtimeKernelPath := realtimeKernelPath
+		realtimeKernelPath = realtimeFile
+		DeferCleanup(func() { realtimeKernelPath = origRealtimeKernelPath })
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeKernelLabel, "true"))
+	})
+
+	It("should not add realtime kernel label on other kernels", func() {
+		origRealtimeKernelPath := realtimeKernelPath
+		realtimeKernelPath = filepath.Join(GinkgoT().TempDir(), "realtime")
+		DeferCleanup(func() { realtimeKernelPath = origRealtimeKernelPath })
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.RealtimeKernelLabel)))
+	})
+
 	It("should add usable cpu model labels for the host cpu model", func() {
 		res := nlController.execute()
 		Expect(res).To(BeTrue())
@@ -347,6 +444,145 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(recorder.Events).To(Receive(ContainSubstring("in ObsoleteCPUModels")))
 	})
 
+	It("should not add cpu model labels of the obsolete cpuModels", func() {
+		nlController.clusterConfig.GetConfig().CPUModels = &v1.CPUModelsConfiguration{
+			Obsolete: []string{"IvyBridge"},
+		}
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).ToNot(HaveKey(v1.CPUModelLabel + "IvyBridge"))
+		Expect(node.Labels).To(HaveKey(v1.CPUModelLabel + "Haswell"))
+	})
+
+	It("should only add cpu model labels of the allowed cpuModels", func() {
+		nlController.clusterConfig.GetConfig().CPUModels = &v1.CPUModelsConfiguration{
+			Allowed: []string{"Haswell", "Skylake-Client-IBRS"},
+		}
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(SatisfyAll(
+			HaveKey(v1.CPUModelLabel+"Haswell"),
+			HaveKey(v1.CPUModelLabel+"Skylake-Client-IBRS"),
+			HaveKey(v1.SupportedHostModelMigrationCPU+"IvyBridge"),
+		))
+		Expect(node.Labels).ToNot(SatisfyAny(
+			HaveKey(v1.CPUModelLabel+"Penryn"),
+			HaveKey(v1.CPUModelLabel+"IvyBridge"),
+		))
+	})
+
+	DescribeTable("should label the host model as obsolete", func(minModel string, expectObsolete bool) {
+		nlController.clusterConfig.GetConfig().CPUModels = &v1.CPUModelsConfiguration{
+			MinModels: []v1.VendorCPUModel{{Vendor: "Intel", Model: minModel}},
+		}
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		recorder := nlController.recorder.(*record.FakeRecorder)
+		if expectObsolete {
+			Expect(node.Labels).To(HaveKeyWithValue(v1.NodeHostModelIsObsoleteLabel, "true"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("can't run the %s minimum CPU model", minModel)))
+		} else {
+			Expect(node.Labels).ToNot(HaveKey(v1.NodeHostModelIsObsoleteLabel))
+			Expect(recorder.Events).ToNot(Receive())
+		}
+	},
+		Entry("when the host can't run the minimum CPU model of its vendor", "Cascadelake-Server", true),
+		Entry("not when the host can run the minimum CPU model of its vendor", "Haswell", false),
+	)
+
+	Context("host topology", func() {
+		BeforeEach(func() {
+			nlController.hostTopology = newHostTopology(
+				&libvirtxml.CapsHostNUMATopology{
+					Cells: &libvirtxml.CapsHostNUMACells{
+						Num: 2,
+						Cells: []libvirtxml.CapsHostNUMACell{
+							{
+								ID:     0,
+								Memory: &libvirtxml.CapsHostNUMAMemory{Size: 16777216, Unit: "KiB"},
+								CPUS:   &libvirtxml.CapsHostNUMACPUs{Num: 3, CPUs: []libvirtxml.CapsHostNUMACPU{{ID: 0}, {ID: 1}, {ID: 4}}},
+							},
+							{
+								ID:     1,
+								Memory: &libvirtxml.CapsHostNUMAMemory{Size: 8388608, Unit: "KiB"},
+								CPUS:   &libvirtxml.CapsHostNUMACPUs{Num: 2, CPUs: []libvirtxml.CapsHostNUMACPU{{ID: 2}, {ID: 3}}},
+							},
+						},
+					},
+				},
+				&libvirtxml.CapsHostCache{
+					Banks: []libvirtxml.CapsHostCacheBank{
+						{ID: 0, Level: 2, Type: "both", Size: 1, Unit: "MiB", CPUs: "0"},
+						{ID: 0, Level: 3, Type: "both", Size: 32, Unit: "MiB", CPUs: "0-1,4"},
+						{ID: 1, Level: 3, Type: "both", Size: 16, Unit: "MiB", CPUs: "2-3"},
+					},
+				},
+			)
+		})
+
+		It("should add NUMA and L3 cache labels", func() {
+			res := nlController.execute()
+			Expect(res).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).To(SatisfyAll(
+				HaveKeyWithValue(v1.NUMATopologyLabel+"cells", "2"),
+				HaveKeyWithValue(v1.NUMATopologyLabel+"cell-0-memory", "16Gi"),
+				HaveKeyWithValue(v1.NUMATopologyLabel+"cell-1-memory", "8Gi"),
+				HaveKeyWithValue(v1.CacheTopologyLabel+"l3-domains", "2"),
+				HaveKeyWithValue(v1.CacheTopologyLabel+"l3-domain-0-size", "32Mi"),
+				HaveKeyWithValue(v1.CacheTopologyLabel+"l3-domain-1-size", "16Mi"),
+			))
+		})
+
+		It("should add the host topology annotation", func() {
+			res := nlController.execute()
+			Expect(res).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Annotations).To(HaveKey(v1.HostTopologyAnnotation))
+			Expect(node.Annotations[v1.HostTopologyAnnotation]).To(MatchJSON(`{
+				"numaCells": [
+					{"id": 0, "memory": "16Gi", "cpus": "0-1,4"},
+					{"id": 1, "memory": "8Gi", "cpus": "2-3"}
+				],
+				"l3CacheDomains": [
+					{"id": 0, "size": "32Mi", "cpus": "0-1,4"},
+					{"id": 1, "size": "16Mi", "cpus": "2-3"}
+				]
+			}`))
+		})
+
+		It("should remove stale topology labels and annotation when the topology is unknown", func() {
+			Expect(nlController.execute()).To(BeTrue())
+			node := retrieveNode(kubeClient)
+			node.Labels[v1.NUMATopologyLabel+"cell-7-memory"] = "1Gi"
+			node, err := kubeClient.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeNodeStore.Update(node)).To(Succeed())
+
+			nlController.hostTopology = nil
+			nlController.queue.Add(nodeName)
+			Expect(nlController.execute()).To(BeTrue())
+
+			node = retrieveNode(kubeClient)
+			Expect(node.Labels).ToNot(SatisfyAny(
+				HaveKey(HavePrefix(v1.NUMATopologyLabel)),
+				HaveKey(HavePrefix(v1.CacheTopologyLabel)),
+			))
+			Expect(node.Annotations).ToNot(HaveKey(v1.HostTopologyAnnotation))
+		})
+	})
+
 	It("should keep existing label that is not owned by node labeller", func() {
 		res := nlController.execute()
 		Expect(res).To(BeTrue())
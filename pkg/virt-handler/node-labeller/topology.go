@@ -0,0 +1,188 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"libvirt.org/go/libvirtxml"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+)
+
+const l3CacheLevel = 3
+
+// hostTopology is the NUMA and L3 cache layout of the host, as published in the
+// HostTopologyAnnotation of the node
+type hostTopology struct {
+	NUMACells      []numaCell    `json:"numaCells,omitempty"`
+	L3CacheDomains []cacheDomain `json:"l3CacheDomains,omitempty"`
+}
+
+type numaCell struct {
+	ID     int    `json:"id"`
+	Memory string `json:"memory,omitempty"`
+	CPUs   string `json:"cpus,omitempty"`
+}
+
+type cacheDomain struct {
+	ID   uint   `json:"id"`
+	Size string `json:"size,omitempty"`
+	CPUs string `json:"cpus,omitempty"`
+}
+
+func newHostTopology(numa *libvirtxml.CapsHostNUMATopology, hostCache *libvirtxml.CapsHostCache) *hostTopology {
+	topology := &hostTopology{}
+
+	if numa != nil && numa.Cells != nil {
+		for _, cell := range numa.Cells.Cells {
+			c := numaCell{ID: cell.ID}
+			if cell.Memory != nil {
+				if memory, ok := toQuantity(cell.Memory.Size, cell.Memory.Unit); ok {
+					c.Memory = memory.String()
+				}
+			}
+			if cell.CPUS != nil {
+				var cpus []int
+				for _, cpu := range cell.CPUS.CPUs {
+					cpus = append(cpus, cpu.ID)
+				}
+				c.CPUs = formatCPUList(cpus)
+			}
+			topology.NUMACells = append(topology.NUMACells, c)
+		}
+	}
+
+	if hostCache != nil {
+		for _, bank := range hostCache.Banks {
+			if bank.Level != l3CacheLevel {
+				continue
+			}
+			d := cacheDomain{ID: bank.ID, CPUs: bank.CPUs}
+			if size, ok := toQuantity(uint64(bank.Size), bank.Unit); ok {
+				d.Size = size.String()
+			}
+			topology.L3CacheDomains = append(topology.L3CacheDomains, d)
+		}
+	}
+
+	return topology
+}
+
+func (t *hostTopology) isEmpty() bool {
+	return t == nil || (len(t.NUMACells) == 0 && len(t.L3CacheDomains) == 0)
+}
+
+// labels converts the topology to node labels
+// e.g. "numa.node.kubevirt.io/cells": "2", "numa.node.kubevirt.io/cell-0-memory": "64Gi"
+func (t *hostTopology) labels() map[string]string {
+	labels := make(map[string]string)
+	if t.isEmpty() {
+		return labels
+	}
+
+	if len(t.NUMACells) > 0 {
+		labels[kubevirtv1.NUMATopologyLabel+"cells"] = strconv.Itoa(len(t.NUMACells))
+	}
+	for _, cell := range t.NUMACells {
+		if cell.Memory != "" {
+			labels[fmt.Sprintf("%scell-%d-memory", kubevirtv1.NUMATopologyLabel, cell.ID)] = cell.Memory
+		}
+	}
+
+	if len(t.L3CacheDomains) > 0 {
+		labels[kubevirtv1.CacheTopologyLabel+"l3-domains"] = strconv.Itoa(len(t.L3CacheDomains))
+	}
+	for _, domain := range t.L3CacheDomains {
+		if domain.Size != "" {
+			labels[fmt.Sprintf("%sl3-domain-%d-size", kubevirtv1.CacheTopologyLabel, domain.ID)] = domain.Size
+		}
+	}
+
+	return labels
+}
+
+// annotation returns the JSON representation of the topology, the CPU lists
+// can't be expressed as label values
+func (t *hostTopology) annotation() (string, error) {
+	if t.isEmpty() {
+		return "", nil
+	}
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func toQuantity(size uint64, unit string) (*resource.Quantity, bool) {
+	var multiplier uint64
+	switch strings.ToLower(unit) {
+	case "b", "bytes":
+		multiplier = 1
+	case "", "k", "kib":
+		multiplier = 1 << 10
+	case "m", "mib":
+		multiplier = 1 << 20
+	case "g", "gib":
+		multiplier = 1 << 30
+	default:
+		return nil, false
+	}
+	return resource.NewQuantity(int64(size*multiplier), resource.BinarySI), true
+}
+
+// formatCPUList formats a list of CPU ids in the cpuset list format, e.g. "0-3,8"
+func formatCPUList(cpus []int) string {
+	if len(cpus) == 0 {
+		return ""
+	}
+	sorted := append([]int(nil), cpus...)
+	sort.Ints(sorted)
+
+	var ranges []string
+	start, end := sorted[0], sorted[0]
+	flush := func() {
+		if start == end {
+			ranges = append(ranges, strconv.Itoa(start))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", start, end))
+		}
+	}
+	for _, cpu := range sorted[1:] {
+		if cpu == end {
+			continue
+		}
+		if cpu == end+1 {
+			end = cpu
+			continue
+		}
+		flush()
+		start, end = cpu, cpu
+	}
+	flush()
+
+	return strings.Join(ranges, ",")
+}
@@ -52,6 +52,14 @@ func virtualMachineOptions(
 			Manufacturer: smbios.Manufacturer,
 			Sku:          smbios.Sku,
 			Version:      smbios.Version,
+			OemStrings:   smbios.OEMStrings,
+		}
+		if baseBoard := smbios.BaseBoard; baseBoard != nil {
+			options.VirtualMachineSMBios.BaseBoardManufacturer = baseBoard.Manufacturer
+			options.VirtualMachineSMBios.BaseBoardProduct = baseBoard.Product
+			options.VirtualMachineSMBios.BaseBoardVersion = baseBoard.Version
+			options.VirtualMachineSMBios.BaseBoardSerial = baseBoard.Serial
+			options.VirtualMachineSMBios.BaseBoardAsset = baseBoard.Asset
 		}
 	}
 
@@ -66,6 +74,11 @@ func virtualMachineOptions(
 			SerialConsoleLogDisabled:    clusterConfig.IsSerialConsoleLogDisabled(),
 			PCINUMAAwareTopologyEnabled: clusterConfig.PCINUMAAwareTopologyEnabled(),
 			VGPULiveMigrationEnabled:    clusterConfig.VGPULiveMigrationEnabled(),
+			LauncherLogVerbosity:        &cmdv1.LogVerbosity{Verbosity: uint32(clusterConfig.GetVirtLauncherVerbosity())},
+		}
+		if guestAgentCommands := clusterConfig.GetConfig().GuestAgentCommands; guestAgentCommands != nil {
+			options.ClusterConfig.GuestAgentAllowedCommands = guestAgentCommands.AllowedCommands
+			options.ClusterConfig.GuestAgentDeniedCommands = guestAgentCommands.DeniedCommands
 		}
 	}
 
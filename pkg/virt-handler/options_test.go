@@ -24,7 +24,10 @@ import (
 	. "github.com/onsi/gomega"
 	"libvirt.org/go/libvirtxml"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
 var _ = Describe("Parsing VMI Options", func() {
@@ -384,4 +387,47 @@ var _ = Describe("Parsing VMI Options", func() {
 
 		Expect(actualTopology).To(Equal(expectedTopology))
 	})
+
+	It("should pass the guest agent command configuration to virt-launcher", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			GuestAgentCommands: &v1.GuestAgentCommandsConfiguration{
+				AllowedCommands: []string{"guest-ping", "guest-info"},
+				DeniedCommands:  []string{"guest-exec"},
+			},
+		})
+
+		options := virtualMachineOptions(nil, 0, nil, nil, clusterConfig)
+		Expect(options.ClusterConfig.GuestAgentAllowedCommands).To(Equal([]string{"guest-ping", "guest-info"}))
+		Expect(options.ClusterConfig.GuestAgentDeniedCommands).To(Equal([]string{"guest-exec"}))
+	})
+
+	It("should pass the virt-launcher log verbosity to virt-launcher", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				LogVerbosity: &v1.LogVerbosity{VirtLauncher: 5},
+			},
+		})
+
+		options := virtualMachineOptions(nil, 0, nil, nil, clusterConfig)
+		Expect(options.ClusterConfig.LauncherLogVerbosity).To(Equal(&cmdv1.LogVerbosity{Verbosity: 5}))
+	})
+
+	It("should pass the cluster wide SMBIOS configuration to virt-launcher", func() {
+		options := virtualMachineOptions(&v1.SMBiosConfiguration{
+			Manufacturer: "manufacturer",
+			Product:      "product",
+			BaseBoard: &v1.SMBIOSBaseBoard{
+				Manufacturer: "baseboard-manufacturer",
+				Serial:       "baseboard-serial",
+			},
+			OEMStrings: []string{"license=1234"},
+		}, 0, nil, nil, nil)
+		Expect(options.VirtualMachineSMBios).To(Equal(&cmdv1.SMBios{
+			Manufacturer:          "manufacturer",
+			Product:               "product",
+			BaseBoardManufacturer: "baseboard-manufacturer",
+			BaseBoardSerial:       "baseboard-serial",
+			OemStrings:            []string{"license=1234"},
+		}))
+	})
 })
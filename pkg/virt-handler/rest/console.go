@@ -183,15 +183,27 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 		response.WriteError(code, err)
 		return
 	}
-	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-serial0")
+	socketName := "virt-serial0"
+	// every serial device has its own connection, identified by the VMI UID and the serial device name
+	connectionID := vmi.GetUID()
+	if serial := request.QueryParameter("serial"); serial != "" {
+		if !hasSerialDevice(vmi, serial) {
+			err := fmt.Errorf("no serial device named %s", serial)
+			log.Log.Object(vmi).Reason(err).Error("Failed finding the serial device")
+			response.WriteError(http.StatusBadRequest, err)
+			return
+		}
+		socketName = fmt.Sprintf("virt-serial-%s", serial)
+		connectionID = types.UID(fmt.Sprintf("%s/%s", connectionID, serial))
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, socketName)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for serial console")
 		response.WriteError(http.StatusBadRequest, err)
 		return
 	}
-	uid := vmi.GetUID()
-	stopCh := newStopChan(uid, t.serialLock, t.serialStopChans)
-	defer deleteStopChan(uid, stopCh, t.serialLock, t.serialStopChans)
+	stopCh := newStopChan(connectionID, t.serialLock, t.serialStopChans)
+	defer deleteStopChan(connectionID, stopCh, t.serialLock, t.serialStopChans)
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh)
 }
 
@@ -286,6 +298,15 @@ func deleteStopChan(uid types.UID, stopChn chan struct{}, lock *sync.Mutex, stop
 	}
 }
 
+func hasSerialDevice(vmi *v1.VirtualMachineInstance, name string) bool {
+	for _, serial := range vmi.Spec.Domain.Devices.Serials {
+		if serial.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *ConsoleHandler) getUnixSocketPath(vmi *v1.VirtualMachineInstance, socketName string) (*safepath.Path, error) {
 	result, err := t.podIsolationDetector.Detect(vmi)
 	if err != nil {
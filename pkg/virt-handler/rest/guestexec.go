@@ -0,0 +1,159 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/websocket"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+const (
+	// guestExecCommandParam is the repeated query parameter holding the command to run in the guest and its arguments
+	guestExecCommandParam = "command"
+	// guestExecTimeoutParam is the query parameter holding how long to wait for the command to exit
+	guestExecTimeoutParam = "timeoutSeconds"
+
+	guestExecDefaultTimeout = 60 * time.Second
+	guestExecPollInterval   = 500 * time.Millisecond
+)
+
+// GuestExecHandler runs a command in the guest on behalf of the user set by virt-api. The output of the command
+// is streamed over a websocket as VirtualMachineInstanceGuestExecOutput JSON objects, as virt-launcher reads it.
+// The last object holds the exit code, or the error which prevented getting it. The execution is recorded in events
+// of the VMI, without the arguments of the command, which may hold secrets.
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	command := request.Request.URL.Query()[guestExecCommandParam]
+	if len(command) == 0 || command[0] == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("no command in guest exec request"))
+		return
+	}
+	timeout := guestExecDefaultTimeout
+	if timeoutSeconds := request.QueryParameter(guestExecTimeoutParam); timeoutSeconds != "" {
+		seconds, err := strconv.Atoi(timeoutSeconds)
+		if err != nil || seconds <= 0 {
+			response.WriteError(http.StatusBadRequest, fmt.Errorf("invalid guest exec timeout %q", timeoutSeconds))
+			return
+		}
+		timeout = time.Duration(seconds) * time.Second
+	}
+
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	user := request.QueryParameter(guestUserParam)
+	pid, err := client.GuestExecStart(vmi, command[0], command[1:])
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to start guest command %s", command[0])
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "GuestExecFailed", "Failed to start guest command %s for %s: %v", command[0], user, err)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "GuestExecStarted", "Guest command %s was started by %s", command[0], user)
+
+	clientSocket, err := kvcorev1.NewUpgrader().Upgrade(response.ResponseWriter, request.Request, nil)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to upgrade client websocket connection")
+		return
+	}
+	defer clientSocket.Close()
+
+	// nothing is expected from the client, reading only detects when it goes away
+	clientGone := make(chan struct{})
+	go func() {
+		defer close(clientGone)
+		for {
+			if _, _, err := clientSocket.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	write := func(output *v1.VirtualMachineInstanceGuestExecOutput) error {
+		data, err := json.Marshal(output)
+		if err != nil {
+			return err
+		}
+		return clientSocket.WriteMessage(websocket.BinaryMessage, append(data, '\n'))
+	}
+	exitCode, err := streamGuestExec(vmi, client, pid, timeout, guestExecPollInterval, write, clientGone)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Guest command %s with pid %d failed", command[0], pid)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "GuestExecFailed", "Guest command %s started by %s failed: %v", command[0], user, err)
+		return
+	}
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "GuestExecExited", "Guest command %s started by %s exited with code %d", command[0], user, exitCode)
+	_ = clientSocket.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+}
+
+// streamGuestExec polls virt-launcher for the status of the command until it exits, and writes the output written
+// by the command since the previous poll. The last written object holds the exit code or the error which prevented
+// getting it.
+func streamGuestExec(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient, pid int64, timeout, pollInterval time.Duration,
+	write func(*v1.VirtualMachineInstanceGuestExecOutput) error, clientGone <-chan struct{}) (int32, error) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	fail := func(err error) (int32, error) {
+		_ = write(&v1.VirtualMachineInstanceGuestExecOutput{Error: err.Error()})
+		return 0, err
+	}
+
+	for {
+		output, err := client.GuestExecStatus(vmi, pid)
+		if err != nil {
+			return fail(err)
+		}
+		if len(output.Stdout) > 0 || len(output.Stderr) > 0 || output.ExitCode != nil {
+			if err := write(output); err != nil {
+				return 0, fmt.Errorf("failed to write the output: %v", err)
+			}
+		}
+		if output.ExitCode != nil {
+			return *output.ExitCode, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-deadline.C:
+			return fail(fmt.Errorf("the command did not exit within %s", timeout))
+		case <-clientGone:
+			return 0, fmt.Errorf("the client went away before the command exited")
+		}
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+var _ = Describe("Guest exec handler", func() {
+	const pid = int64(42)
+
+	var (
+		client  *cmdclient.MockLauncherClient
+		vmi     *v1.VirtualMachineInstance
+		written []*v1.VirtualMachineInstanceGuestExecOutput
+		write   func(*v1.VirtualMachineInstanceGuestExecOutput) error
+	)
+
+	BeforeEach(func() {
+		client = cmdclient.NewMockLauncherClient(gomock.NewController(GinkgoT()))
+		vmi = api.NewMinimalVMI("testvmi")
+		written = nil
+		write = func(output *v1.VirtualMachineInstanceGuestExecOutput) error {
+			written = append(written, output)
+			return nil
+		}
+	})
+
+	It("should write the output while the command runs and the exit code once it exited", func() {
+		gomock.InOrder(
+			client.EXPECT().GuestExecStatus(vmi, pid).Return(&v1.VirtualMachineInstanceGuestExecOutput{Stdout: []byte("out1")}, nil),
+			client.EXPECT().GuestExecStatus(vmi, pid).Return(&v1.VirtualMachineInstanceGuestExecOutput{}, nil),
+			client.EXPECT().GuestExecStatus(vmi, pid).Return(&v1.VirtualMachineInstanceGuestExecOutput{
+				Stdout:   []byte("out2"),
+				Stderr:   []byte("err"),
+				ExitCode: pointer.P(int32(3)),
+			}, nil),
+		)
+
+		exitCode, err := streamGuestExec(vmi, client, pid, time.Minute, time.Millisecond, write, nil)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exitCode).To(Equal(int32(3)))
+		Expect(written).To(HaveExactElements(
+			&v1.VirtualMachineInstanceGuestExecOutput{Stdout: []byte("out1")},
+			&v1.VirtualMachineInstanceGuestExecOutput{
+				Stdout:   []byte("out2"),
+				Stderr:   []byte("err"),
+				ExitCode: pointer.P(int32(3)),
+			},
+		))
+	})
+
+	It("should write the error when the status can't be retrieved", func() {
+		client.EXPECT().GuestExecStatus(vmi, pid).Return(nil, fmt.Errorf("agent unavailable"))
+
+		_, err := streamGuestExec(vmi, client, pid, time.Minute, time.Millisecond, write, nil)
+
+		Expect(err).To(MatchError("agent unavailable"))
+		Expect(written).To(ConsistOf(&v1.VirtualMachineInstanceGuestExecOutput{Error: "agent unavailable"}))
+	})
+
+	It("should write an error when the command does not exit before the timeout", func() {
+		client.EXPECT().GuestExecStatus(vmi, pid).Return(&v1.VirtualMachineInstanceGuestExecOutput{}, nil).AnyTimes()
+
+		_, err := streamGuestExec(vmi, client, pid, 10*time.Millisecond, time.Millisecond, write, nil)
+
+		Expect(err).To(HaveOccurred())
+		Expect(written).To(HaveLen(1))
+		Expect(written[0].Error).To(ContainSubstring("did not exit within 10ms"))
+	})
+
+	It("should stop polling when the client went away", func() {
+		clientGone := make(chan struct{})
+		close(clientGone)
+		client.EXPECT().GuestExecStatus(vmi, pid).Return(&v1.VirtualMachineInstanceGuestExecOutput{}, nil)
+
+		_, err := streamGuestExec(vmi, client, pid, time.Minute, time.Minute, write, clientGone)
+
+		Expect(err).To(HaveOccurred())
+		Expect(written).To(BeEmpty())
+	})
+})
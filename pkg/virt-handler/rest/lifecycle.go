@@ -45,6 +45,13 @@ const (
 	failedConnectCmdClient = "Failed to connect cmd client"
 )
 
+const (
+	// guestFilePathParam is the query parameter holding the path of the guest file to read
+	guestFilePathParam = "path"
+	// guestUserParam is the query parameter holding the user accessing the guest through the guest agent, for auditing
+	guestUserParam = "user"
+)
+
 type LifecycleHandler struct {
 	recorder     record.EventRecorder
 	vmiStore     cache.Store
@@ -95,6 +102,60 @@ func (lh *LifecycleHandler) UnpauseHandler(request *restful.Request, response *r
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) SuspendHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	err = client.SuspendVirtualMachine(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to suspend VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Suspending", "VirtualMachineInstance is being suspended to disk")
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) CloudInitRotateHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	err = client.RotateCloudInit(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to rotate the cloud-init data of VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "CloudInitRotating", "cloud-init data of VirtualMachineInstance is being rotated")
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) CloudInitEjectHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	err = client.EjectCloudInit(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to eject the cloud-init disk of VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "CloudInitEjected", "cloud-init disk of VirtualMachineInstance was ejected")
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (lh *LifecycleHandler) FreezeHandler(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
@@ -212,6 +273,24 @@ func (lh *LifecycleHandler) GetGuestInfo(request *restful.Request, response *res
 	response.WriteEntity(guestInfo)
 }
 
+func (lh *LifecycleHandler) RefreshGuestInfo(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	log.Log.Object(vmi).Infof("Refreshing guestinfo of %s", vmi.Name)
+
+	if err := client.RefreshGuestInfo(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to refresh guest info")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
 func (lh *LifecycleHandler) GetUsers(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
@@ -250,6 +329,91 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GetDomainXML(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	log.Log.Object(vmi).Infof("Retreiving domain XML of %s", vmi.Name)
+
+	domainXML, err := client.GetDomainXML(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain XML")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(domainXML)
+}
+
+// GuestFileReadHandler reads a file in the guest on behalf of the user set by virt-api, the access is
+// recorded in an event of the VMI.
+func (lh *LifecycleHandler) GuestFileReadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	path := request.QueryParameter(guestFilePathParam)
+	user := request.QueryParameter(guestUserParam)
+	content, err := client.ReadGuestFile(vmi, path, v1.GuestFileMaxSize)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to read guest file %s", path)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "GuestFileReadFailed", "Failed to read guest file %s for %s: %v", path, user, err)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "GuestFileRead", "Guest file %s was read by %s", path, user)
+	response.WriteEntity(&v1.VirtualMachineInstanceGuestFile{
+		Path:    path,
+		Content: content,
+	})
+}
+
+// GuestFileWriteHandler writes a file in the guest on behalf of the user set by virt-api, the access is
+// recorded in an event of the VMI.
+func (lh *LifecycleHandler) GuestFileWriteHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("no guest file in write request"))
+		return
+	}
+	defer request.Request.Body.Close()
+
+	// the content is base64 encoded in the body
+	file := &v1.VirtualMachineInstanceGuestFile{}
+	body := io.LimitReader(request.Request.Body, 2*v1.GuestFileMaxSize)
+	if err := yaml.NewYAMLOrJSONDecoder(body, 1024).Decode(file); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal guest file in write request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal guest file"))
+		return
+	}
+	if len(file.Content) > v1.GuestFileMaxSize {
+		response.WriteError(http.StatusRequestEntityTooLarge, fmt.Errorf("guest file content is larger than %d bytes", v1.GuestFileMaxSize))
+		return
+	}
+
+	user := request.QueryParameter(guestUserParam)
+	if err := client.WriteGuestFile(vmi, file.Path, file.Content); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to write guest file %s", file.Path)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "GuestFileWriteFailed", "Failed to write guest file %s for %s: %v", file.Path, user, err)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "GuestFileWritten", "Guest file %s (%d bytes) was written by %s", file.Path, len(file.Content), user)
+	response.WriteHeader(http.StatusOK)
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
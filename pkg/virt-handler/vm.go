@@ -30,6 +30,8 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/opencontainers/cgroups"
@@ -67,9 +69,11 @@ import (
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+	"kubevirt.io/kubevirt/pkg/virt-handler/balloon"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
@@ -114,6 +118,9 @@ type VirtualMachineController struct {
 	vmiGlobalStore           cache.Store
 	multipathSocketMonitor   *multipathmonitor.MultipathSocketMonitor
 	cbtHandler               *CBTHandler
+	nodeStore                cache.Store
+	gracefulShutdownHold     gracefulShutdownHold
+	launcherLogVerbosity     atomic.Uint32
 }
 
 var getCgroupManager = func(vmi *v1.VirtualMachineInstance, host string, hypervisorNodeInfo hypervisor.HypervisorNodeInformation, allowEmulation bool) (cgroup.Manager, error) {
@@ -125,6 +132,7 @@ func NewVirtualMachineController(
 	clientset kubecli.KubevirtClient,
 	nodeStore cache.Store,
 	host string,
+	namespace string,
 	virtPrivateDir string,
 	kubeletPodsDir string,
 	launcherClients launcherclients.LauncherClientsManager,
@@ -200,6 +208,8 @@ func NewVirtualMachineController(
 		vmiGlobalStore:           vmiGlobalStore,
 		multipathSocketMonitor:   multipathmonitor.NewMultipathSocketMonitor(),
 		cbtHandler:               cbtHandler,
+		nodeStore:                nodeStore,
+		gracefulShutdownHold:     gracefulShutdownHold{since: map[string]time.Time{}},
 	}
 
 	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -220,6 +230,9 @@ func NewVirtualMachineController(
 		return nil, err
 	}
 
+	c.launcherLogVerbosity.Store(uint32(clusterConfig.GetVirtLauncherVerbosity()))
+	clusterConfig.SetConfigModifiedCallback(c.launcherLogVerbosityChanged)
+
 	permissions := "rw"
 	if cgroups.IsCgroup2UnifiedMode() {
 		// Need 'rwm' permissions otherwise ebpf filtering program attached by runc
@@ -239,7 +252,7 @@ func NewVirtualMachineController(
 		deviceManager.PermanentHostDevicePlugins(c.hypervisorNodeInfo.GetHypervisorDevice(), maxDevices, permissions),
 		clusterConfig,
 		nodeStore)
-	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
+	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), clientset.CoordinationV1().Leases(namespace), nodeStore, c.deviceManagerController, clusterConfig, host)
 
 	return c, nil
 }
@@ -270,6 +283,7 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 			c.queue.Add(key)
 		}
 	}
+	c.adoptLaunchers()
 	c.multipathSocketMonitor.Run()
 
 	heartBeatDone := c.heartBeat.Run(c.heartBeatInterval, stopCh)
@@ -287,6 +301,42 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	c.logger.Info("Stopping virt-handler vms controller.")
 }
 
+const launcherAdoptionWorkers = 16
+
+// adoptLaunchers reconnects to the virt-launchers of the VMIs running on the node before the
+// first sync, so that after a restart of virt-handler the domain notifications of the
+// virt-launchers are received again and the VMIs are resynchronized from their domains.
+func (c *VirtualMachineController) adoptLaunchers() {
+	var vmis []*v1.VirtualMachineInstance
+	for _, domain := range c.domainStore.List() {
+		d := domain.(*api.Domain)
+		key := controller.VirtualMachineInstanceKey(v1.NewVMIReferenceWithUUID(
+			d.ObjectMeta.Namespace,
+			d.ObjectMeta.Name,
+			d.Spec.Metadata.KubeVirt.UID))
+		obj, exists, _ := c.vmiStore.GetByKey(key)
+		if !exists {
+			continue
+		}
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.UID != d.Spec.Metadata.KubeVirt.UID || vmi.IsFinal() || vmi.Status.NodeName != c.host {
+			continue
+		}
+		vmis = append(vmis, vmi)
+	}
+
+	var adopted atomic.Int32
+	workqueue.ParallelizeUntil(context.Background(), launcherAdoptionWorkers, len(vmis), func(i int) {
+		if _, err := c.launcherClients.GetVerifiedLauncherClient(vmis[i]); err != nil {
+			c.logger.Object(vmis[i]).Reason(err).Warning("Failed to reconnect to virt-launcher")
+			return
+		}
+		adopted.Add(1)
+		c.queue.Add(controller.VirtualMachineInstanceKey(vmis[i]))
+	})
+	c.logger.Infof("Reconnected to %d of %d virt-launchers", adopted.Load(), len(vmis))
+}
+
 func (c *VirtualMachineController) runWorker() {
 	for c.Execute() {
 	}
@@ -734,6 +784,66 @@ func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 	}
 }
 
+func (c *VirtualMachineController) updateCloudInitConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.CloudInit == nil {
+		return
+	}
+
+	cloudInit := domain.Spec.Metadata.KubeVirt.CloudInit
+	status := k8sv1.ConditionFalse
+	if cloudInit.Succeeded {
+		status = k8sv1.ConditionTrue
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceCloudInitSynchronized)
+	if condition != nil && condition.Status == status && condition.Reason == cloudInit.Reason && condition.Message == cloudInit.Message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceCloudInitSynchronized)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceCloudInitSynchronized,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Reason:             cloudInit.Reason,
+		Message:            cloudInit.Message,
+	})
+	if status == k8sv1.ConditionTrue {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, v1.CloudInitSyncSuccess.String(), "Cloud-init sync successful: %s", cloudInit.Message)
+	} else {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, v1.CloudInitSyncFailed.String(), "Cloud-init sync failed: %s", cloudInit.Message)
+	}
+}
+
+func (c *VirtualMachineController) updateSuspendConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Suspend == nil {
+		return
+	}
+
+	suspend := domain.Spec.Metadata.KubeVirt.Suspend
+	if !suspend.Failed {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceSuspendFailed)
+		return
+	}
+
+	transitionTime := metav1.Now()
+	if suspend.FailedTimestamp != nil {
+		transitionTime = *suspend.FailedTimestamp
+	}
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceSuspendFailed)
+	if condition != nil && condition.LastTransitionTime.Equal(&transitionTime) && condition.Message == suspend.FailureReason {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceSuspendFailed)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceSuspendFailed,
+		LastTransitionTime: transitionTime,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             v1.VirtualMachineInstanceReasonSaveFailed,
+		Message:            suspend.FailureReason,
+	})
+	c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonSaveFailed, "Suspending to disk failed: %s", suspend.FailureReason)
+}
+
 func (c *VirtualMachineController) updateLiveMigrationConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
 	// Calculate whether the VM is migratable
 	liveMigrationCondition, isBlockMigration := c.calculateLiveMigrationCondition(vmi)
@@ -785,6 +895,8 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 		vmi.Status.Conditions = append(vmi.Status.Conditions, agentCondition)
 	case !channelConnected:
 		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceAgentConnected)
+		markGuestAgentDataStale(vmi, v1.VirtualMachineInstanceReasonGuestAgentDisconnected,
+			"The guest agent is not connected, the guest agent data may be outdated", condManager)
 	}
 
 	if condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
@@ -797,6 +909,7 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 		if err != nil {
 			return err
 		}
+		updateGuestAgentData(vmi, guestInfo, condManager)
 
 		var supported = false
 		var reason = ""
@@ -835,6 +948,87 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 	return nil
 }
 
+// guestAgentDataRefreshInterval limits how often the timestamps of unchanged guest agent data are moved.
+const guestAgentDataRefreshInterval = time.Minute
+
+// updateGuestAgentData records the information reported by the guest agent. The timestamps move
+// when a value changes or the guest agent reports again after the data was stale. Otherwise they
+// follow the successful polls of the guest agent, at most once per guestAgentDataRefreshInterval,
+// to not update the status on every poll.
+func updateGuestAgentData(vmi *v1.VirtualMachineInstance, guestInfo *v1.VirtualMachineInstanceGuestAgentInfo, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if guestInfo.PollError != "" {
+		markGuestAgentDataStale(vmi, v1.VirtualMachineInstanceReasonGuestAgentNotResponding,
+			fmt.Sprintf("Polling the guest agent failed, the guest agent data may be outdated: %s", guestInfo.PollError), condManager)
+		return
+	}
+
+	stale := condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestAgentDataStale)
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestAgentDataStale)
+
+	data := vmi.Status.GuestAgentData
+	if data == nil {
+		data = &v1.GuestAgentData{}
+	}
+	// virt-launcher reports the time of the last successful poll, older versions do not
+	polled := metav1.Now()
+	if guestInfo.LastPolled != nil {
+		polled = *guestInfo.LastPolled
+	}
+
+	if guestInfo.OS.Name != "" && (data.OS == nil || stale || data.OS.Info != guestInfo.OS ||
+		guestAgentDataNeedsRefresh(data.OS.LastUpdated, polled)) {
+		data.OS = &v1.GuestAgentOSInfo{Info: guestInfo.OS, LastUpdated: polled}
+	}
+	data.Hostname = updateGuestAgentValue(data.Hostname, guestInfo.Hostname, stale, polled)
+	fqdn := ""
+	if strings.Contains(guestInfo.Hostname, ".") {
+		fqdn = guestInfo.Hostname
+	}
+	data.FQDN = updateGuestAgentValue(data.FQDN, fqdn, stale, polled)
+	timezone := guestInfo.Timezone
+	if strings.HasPrefix(timezone, ", ") {
+		// The guest agent did not report the name of the timezone
+		timezone = ""
+	}
+	data.Timezone = updateGuestAgentValue(data.Timezone, timezone, stale, polled)
+
+	if data.OS == nil && data.Hostname == nil && data.FQDN == nil && data.Timezone == nil {
+		return
+	}
+	vmi.Status.GuestAgentData = data
+}
+
+func updateGuestAgentValue(current *v1.GuestAgentValue, value string, stale bool, polled metav1.Time) *v1.GuestAgentValue {
+	if value == "" {
+		return current
+	}
+	if current == nil || stale || current.Value != value || guestAgentDataNeedsRefresh(current.LastUpdated, polled) {
+		return &v1.GuestAgentValue{Value: value, LastUpdated: polled}
+	}
+	return current
+}
+
+func guestAgentDataNeedsRefresh(lastUpdated, polled metav1.Time) bool {
+	return polled.Sub(lastUpdated.Time) >= guestAgentDataRefreshInterval
+}
+
+// markGuestAgentDataStale keeps the guest agent data when the guest agent disconnects or stops
+// responding, but flags it as stale so that consumers can decide whether to trust it
+func markGuestAgentDataStale(vmi *v1.VirtualMachineInstance, reason, message string, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if vmi.Status.GuestAgentData == nil ||
+		condManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceGuestAgentDataStale, k8sv1.ConditionTrue, reason) {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestAgentDataStale)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestAgentDataStale,
+		LastTransitionTime: metav1.Now(),
+		Status:             k8sv1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
 func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
 
 	// Update paused condition in case VMI was paused / unpaused
@@ -1025,6 +1219,143 @@ func (c *VirtualMachineController) updateMemoryInfo(vmi *v1.VirtualMachineInstan
 	return nil
 }
 
+// updateBalloonTarget re-evaluates the balloon target of VMIs declaring balloon bounds.
+// The target is applied by virt-launcher on the following sync.
+func (c *VirtualMachineController) updateBalloonTarget(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) {
+	if !c.clusterConfig.AutoMemoryBalloonEnabled() || !balloon.HasPolicy(vmi) {
+		return
+	}
+	// Keep following the guest memory usage
+	defer c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), balloon.AdjustInterval)
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil || !exists || domainStats == nil {
+		c.logger.Object(vmi).Reason(err).V(3).Info("Unable to get the domain stats to adjust the memory balloon")
+		return
+	}
+
+	target := balloon.Target(vmi, domainStats.Memory, c.isNodeUnderMemoryPressure())
+	if target == nil {
+		return
+	}
+	if vmi.Status.Memory == nil {
+		vmi.Status.Memory = &v1.MemoryStatus{}
+	}
+	vmi.Status.Memory.BalloonTarget = target
+}
+
+func (c *VirtualMachineController) isNodeUnderMemoryPressure() bool {
+	if c.nodeStore == nil {
+		return false
+	}
+	obj, exists, err := c.nodeStore.GetByKey(c.host)
+	if err != nil || !exists {
+		return false
+	}
+	node, ok := obj.(*k8sv1.Node)
+	return ok && node.Labels[v1.MemoryOvercommitPressureLabel] == "true"
+}
+
+// nodeShutdownDetectionTimeout bounds how long the graceful shutdown of a VMI is held back
+// until virt-controller reported whether the kubelet terminates its pod because of a node shutdown.
+const nodeShutdownDetectionTimeout = 5 * time.Second
+
+// gracefulShutdownHold records since when the graceful shutdown of the VMIs is held back
+type gracefulShutdownHold struct {
+	mu    sync.Mutex
+	since map[string]time.Time
+}
+
+// isNodeShuttingDown tells whether virt-controller reported that the kubelet terminates the
+// virt-launcher pod of the VMI during the graceful shutdown of its node
+func isNodeShuttingDown(vmi *v1.VirtualMachineInstance) bool {
+	cond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceNodeShutdown)
+	return cond != nil &&
+		cond.Reason != v1.VirtualMachineInstanceReasonNodeShutdownMigrated &&
+		cond.Reason != v1.VirtualMachineInstanceReasonNodeShutdownStopped
+}
+
+// holdGracefulShutdown tells whether the graceful shutdown of a VMI which could be evacuated is held back,
+// as the kubelet signals virt-launcher before virt-controller can report the shutdown of the node.
+func (c *VirtualMachineController) holdGracefulShutdown(key string, vmi *v1.VirtualMachineInstance) bool {
+	c.gracefulShutdownHold.mu.Lock()
+	defer c.gracefulShutdownHold.mu.Unlock()
+
+	if !c.clusterConfig.NodeGracefulShutdownEnabled() || vmi.DeletionTimestamp != nil || isNodeShuttingDown(vmi) ||
+		!vmi.IsMigratable() || !migrations.VMIMigratableOnEviction(c.clusterConfig, vmi) {
+		return false
+	}
+	since, exists := c.gracefulShutdownHold.since[key]
+	if !exists {
+		since = time.Now()
+		c.gracefulShutdownHold.since[key] = since
+	}
+	return time.Since(since) < nodeShutdownDetectionTimeout
+}
+
+func (c *VirtualMachineController) releaseGracefulShutdown(key string) {
+	c.gracefulShutdownHold.mu.Lock()
+	defer c.gracefulShutdownHold.mu.Unlock()
+	delete(c.gracefulShutdownHold.since, key)
+}
+
+// handleNodeShutdown decides how a running VMI is handled while its node is shutting down.
+// VMIs which can be live migrated are marked for evacuation, the others are stopped.
+// A VMI whose migration fails during the shutdown is stopped as well.
+func (c *VirtualMachineController) handleNodeShutdown(vmi *v1.VirtualMachineInstance) (migrating bool, stopping bool) {
+	if !c.clusterConfig.NodeGracefulShutdownEnabled() ||
+		!vmi.IsRunning() || vmi.DeletionTimestamp != nil || vmi.Status.NodeName != c.host ||
+		!isNodeShuttingDown(vmi) {
+		return false, false
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceNodeShutdown)
+	if cond.Reason == v1.VirtualMachineInstanceReasonNodeShutdownStarted {
+		// The VMI is not handled yet
+		cond = nil
+	}
+	if cond == nil || cond.Reason == v1.VirtualMachineInstanceReasonNodeShutdownMigrating {
+		if !hasMigrationFailedSince(vmi, cond) && vmi.IsMigratable() && migrations.VMIMigratableOnEviction(c.clusterConfig, vmi) {
+			if cond == nil {
+				c.logger.Object(vmi).Info("Node is shutting down, live migrating the VMI")
+				c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.VirtualMachineInstanceReasonNodeShutdownMigrating, "Node is shutting down, live migrating the VMI")
+				setNodeShutdownCondition(vmi, v1.VirtualMachineInstanceReasonNodeShutdownMigrating, "Live migrating the VMI away from its shutting down node")
+			}
+			vmi.Status.EvacuationNodeName = c.host
+			return true, false
+		}
+	}
+
+	if cond == nil || cond.Reason != v1.VirtualMachineInstanceReasonNodeShutdownStopping {
+		c.logger.Object(vmi).Info("Node is shutting down, stopping the VMI")
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.VirtualMachineInstanceReasonNodeShutdownStopping, "Node is shutting down, stopping the VMI")
+		setNodeShutdownCondition(vmi, v1.VirtualMachineInstanceReasonNodeShutdownStopping, "Stopping the VMI as its node is shutting down")
+	}
+	return false, true
+}
+
+// hasMigrationFailedSince tells whether a migration of the VMI failed after the node shutdown condition was set
+func hasMigrationFailedSince(vmi *v1.VirtualMachineInstance, cond *v1.VirtualMachineInstanceCondition) bool {
+	state := vmi.Status.MigrationState
+	if cond == nil || state == nil || !state.Failed || state.EndTimestamp == nil {
+		return false
+	}
+	return !state.EndTimestamp.Before(&cond.LastTransitionTime)
+}
+
+func setNodeShutdownCondition(vmi *v1.VirtualMachineInstance, reason, message string) {
+	now := metav1.Now()
+	controller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceNodeShutdown,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
 func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	c.updateIsoSizeStatus(vmi)
 	err := c.updateSELinuxContext(vmi)
@@ -1048,16 +1379,74 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 
 func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
 	c.updateAccessCredentialConditions(vmi, domain, condManager)
+	c.updateCloudInitConditions(vmi, domain, condManager)
+	c.updateSuspendConditions(vmi, domain, condManager)
 	c.updateLiveMigrationConditions(vmi, condManager)
 	err := c.updateGuestAgentConditions(vmi, guestAgentConnected(domain), condManager)
 	if err != nil {
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	c.updateGuestPanickedCondition(vmi, domain, condManager)
 
 	return nil
 }
 
+func (c *VirtualMachineController) updateGuestPanickedCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestPanicked) {
+		return
+	}
+	panicInfo := c.getGuestPanicInfo(domain, vmi)
+	if panicInfo == nil {
+		return
+	}
+
+	message := "The guest kernel panicked"
+	if panicInfo.Type != "" {
+		message = fmt.Sprintf("The guest kernel panicked, type: %s", panicInfo.Type)
+	}
+	if panicInfo.Arg1 != 0 {
+		message += fmt.Sprintf(", args: %#x, %#x, %#x, %#x, %#x", panicInfo.Arg1, panicInfo.Arg2, panicInfo.Arg3, panicInfo.Arg4, panicInfo.Arg5)
+	}
+	if panicInfo.ConsoleLog != "" {
+		message += "\nLast serial console output:\n" + panicInfo.ConsoleLog
+	}
+
+	c.logger.Object(vmi).V(3).Info("Adding guest panicked condition")
+	now := metav1.NewTime(time.Now())
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestPanicked,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             string(v1.VirtualMachineInstanceGuestPanicked),
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
+// isPreservedForMemoryDump returns true while the crashed domain of a panicked guest is kept,
+// so that virt-controller can request the memory dump to the PVC of the guest panic capture.
+func isPreservedForMemoryDump(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	capture := vmi.Spec.Domain.Devices.GuestPanicCapture
+	if domain == nil || capture == nil || capture.MemoryDumpClaimName == "" {
+		return false
+	}
+	if domain.Status.Status != api.Crashed || domain.Status.Reason != api.ReasonPanicked {
+		return false
+	}
+	// only the VirtualMachine controller requests memory dumps
+	if owner := metav1.GetControllerOf(vmi); owner == nil || owner.Kind != v1.VirtualMachineGroupVersionKind.Kind {
+		return false
+	}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Name == capture.MemoryDumpClaimName &&
+			(volumeStatus.Phase == v1.MemoryDumpVolumeCompleted || volumeStatus.Phase == v1.MemoryDumpVolumeFailed) {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineInstanceStatus, vmi *v1.VirtualMachineInstance, domain *api.Domain, syncError error) (err error) {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
 
@@ -1428,8 +1817,23 @@ func (c *VirtualMachineController) sync(key string,
 
 	forceShutdownIrrecoverable = domainExists && domainPausedFailedPostCopy(domain)
 
-	gracefulShutdown := c.hasGracefulShutdownTrigger(domain)
-	if gracefulShutdown && vmi.IsRunning() {
+	nodeShutdownMigrating, nodeShutdownStopping := false, false
+	if vmiExists {
+		nodeShutdownMigrating, nodeShutdownStopping = c.handleNodeShutdown(vmi)
+	}
+
+	// The graceful shutdown trigger is ignored while the VMI is migrated away from a shutting down node,
+	// the kubelet keeps virt-launcher running until the end of the grace period of the pod.
+	gracefulShutdown := c.hasGracefulShutdownTrigger(domain) && !nodeShutdownMigrating
+	if gracefulShutdown && vmiExists && c.holdGracefulShutdown(key, vmi) {
+		c.logger.Object(vmi).V(3).Info("Holding back the graceful shutdown until the node shutdown is reported.")
+		c.queue.AddAfter(key, time.Second)
+		gracefulShutdown = false
+	}
+	if !vmiExists {
+		c.releaseGracefulShutdown(key)
+	}
+	if (gracefulShutdown || nodeShutdownStopping) && vmi.IsRunning() {
 		if domainAlive {
 			c.logger.Object(vmi).V(3).Info("Shutting down due to graceful shutdown signal.")
 			shouldShutdown = true
@@ -1471,7 +1875,7 @@ func (c *VirtualMachineController) sync(key string,
 		shouldDelete = true
 	}
 
-	if !domainAlive && domainExists && !vmi.IsFinal() {
+	if !domainAlive && domainExists && !vmi.IsFinal() && !isPreservedForMemoryDump(vmi, domain) {
 		c.logger.Object(vmi).V(3).Info("Deleting inactive domain for vmi.")
 		shouldDelete = true
 	}
@@ -1503,25 +1907,34 @@ func (c *VirtualMachineController) sync(key string,
 	// * Shutdown and Deletion due to VirtualMachineInstance deletion, process stopping, graceful shutdown trigger, etc...
 	// * Cleanup of already shutdown and Deleted VMIs
 	// * Update due to spec change and initial start flow.
+	logger := tracecontext.WithTraceParent(c.logger.Object(vmi), tracecontext.FromObject(vmi))
 	switch {
 	case shouldShutdown:
-		c.logger.Object(vmi).V(3).Info("Processing shutdown.")
+		logger.V(3).Info("Processing shutdown.")
 		syncErr = c.processVmShutdown(vmi, domain)
 	case forceShutdownIrrecoverable:
 		msg := formatIrrecoverableErrorMessage(domain)
-		c.logger.Object(vmi).V(3).Infof("Processing a destruction of an irrecoverable domain - %s.", msg)
+		logger.V(3).Infof("Processing a destruction of an irrecoverable domain - %s.", msg)
 		syncErr = c.processVmDestroy(vmi, domain)
 		if syncErr == nil {
 			syncErr = &vmiIrrecoverableError{msg}
 		}
 	case shouldDelete:
-		c.logger.Object(vmi).V(3).Info("Processing deletion.")
+		logger.V(3).Info("Processing deletion.")
 		syncErr = c.deleteVM(vmi)
 	case shouldUpdate:
-		c.logger.Object(vmi).V(3).Info("Processing vmi update")
+		logger.V(3).Info("Processing vmi update")
+		var span *tracecontext.Span
+		if !domainExists {
+			span = tracecontext.StartSpan(tracecontext.FromObject(vmi), "StartVMI")
+			span.SetAttribute("namespace", vmi.Namespace)
+			span.SetAttribute("name", vmi.Name)
+			span.SetAttribute("node", c.host)
+		}
 		syncErr = c.processVmUpdate(vmi, domain)
+		span.End(syncErr)
 	default:
-		c.logger.Object(vmi).V(3).Info("No update processing required")
+		logger.V(3).Info("No update processing required")
 	}
 	if syncErr != nil && !vmi.IsFinal() {
 		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.SyncFailed.String(), syncErr.Error())
@@ -1891,6 +2304,10 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 		return nil
 	}
 
+	if vmi.IsRunning() {
+		c.updateBalloonTarget(vmi, client)
+	}
+
 	// Synchronize the VirtualMachineInstance state
 	err = c.syncVirtualMachine(client, vmi, preallocatedVolumes)
 	if err != nil {
@@ -2276,6 +2693,9 @@ func (c *VirtualMachineController) calculateVmPhaseForStatusReason(domain *api.D
 		case api.Shutoff, api.Crashed:
 			switch domain.Status.Reason {
 			case api.ReasonCrashed, api.ReasonPanicked:
+				if isPreservedForMemoryDump(vmi, domain) {
+					return v1.Running, nil
+				}
 				return v1.Failed, nil
 			case api.ReasonDestroyed:
 				if isACPIEnabled(vmi, domain) {
@@ -2325,6 +2745,18 @@ func (c *VirtualMachineController) updateFunc(_, new interface{}) {
 	}
 }
 
+// launcherLogVerbosityChanged syncs the VMIs of the node when the virt-launcher verbosity of the KubeVirt CR
+// changes, so that their virt-launcher pods apply it right away instead of on their next sync
+func (c *VirtualMachineController) launcherLogVerbosityChanged() {
+	verbosity := uint32(c.clusterConfig.GetVirtLauncherVerbosity())
+	if c.launcherLogVerbosity.Swap(verbosity) == verbosity {
+		return
+	}
+	for _, key := range c.vmiStore.ListKeys() {
+		c.queue.Add(key)
+	}
+}
+
 func (c *VirtualMachineController) addDomainFunc(obj interface{}) {
 	key, err := controller.KeyFunc(obj)
 	if err == nil {
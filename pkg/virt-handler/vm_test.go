@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 
 	k8sv1 "k8s.io/api/core/v1"
@@ -77,6 +79,7 @@ import (
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	notifyserver "kubevirt.io/kubevirt/pkg/virt-handler/notify-server"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var _ = Describe("VirtualMachineInstance", func() {
@@ -149,6 +152,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		ctrl := gomock.NewController(GinkgoT())
 		virtClient = kubecli.NewMockKubevirtClient(ctrl)
 		virtClient.EXPECT().CoreV1().Return(k8sfakeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().CoordinationV1().Return(k8sfakeClient.CoordinationV1()).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
 		kv := &v1.KubeVirtConfiguration{}
 		kv.NetworkConfiguration = &v1.NetworkConfiguration{Binding: map[string]v1.InterfaceBindingPlugin{
@@ -189,6 +193,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			virtClient,
 			fakeNodeStore,
 			host,
+			metav1.NamespaceDefault,
 			privateDir,
 			podsDir,
 			launcherClientManager,
@@ -593,6 +598,20 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(mockQueue.NumRequeues("a/b/c/d/e")).To(Equal(1))
 		})
 
+		It("should sync the VMIs of the node when the virt-launcher verbosity changes", func() {
+			Expect(controller.vmiStore.Add(api2.NewMinimalVMI("testvmi"))).To(Succeed())
+
+			controller.launcherLogVerbosityChanged()
+			Expect(mockQueue.Len()).To(BeZero())
+
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{LogVerbosity: &v1.LogVerbosity{VirtLauncher: 6}},
+			})
+			controller.clusterConfig = config
+			controller.launcherLogVerbosityChanged()
+			Expect(mockQueue.Len()).To(Equal(1))
+		})
+
 		It("should create the Domain if it sees the first time on a new VirtualMachineInstance", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -901,6 +920,100 @@ var _ = Describe("VirtualMachineInstance", func() {
 			))
 		})
 
+		It("should record the guest agent data with the time it was reported", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Channels = []api.Channel{
+				{
+					Type: "unix",
+					Target: &api.ChannelTarget{
+						Name:  "org.qemu.guest_agent.0",
+						State: "connected",
+					},
+				},
+			}
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			client.EXPECT().GetGuestInfo().Return(&v1.VirtualMachineInstanceGuestAgentInfo{
+				Hostname: "testvmi.example.com",
+				OS:       v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux", Version: "41"},
+				Timezone: "UTC, 0",
+			}, nil)
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			data := updatedVMI.Status.GuestAgentData
+			Expect(data).ToNot(BeNil())
+			Expect(data.OS).ToNot(BeNil())
+			Expect(data.OS.Info.Name).To(Equal("Fedora Linux"))
+			Expect(data.OS.LastUpdated.IsZero()).To(BeFalse())
+			Expect(data.Hostname).To(HaveField("Value", "testvmi.example.com"))
+			Expect(data.FQDN).To(HaveField("Value", "testvmi.example.com"))
+			Expect(data.Timezone).To(HaveField("Value", "UTC, 0"))
+			Expect(virtcontroller.NewVirtualMachineInstanceConditionManager().HasCondition(updatedVMI, v1.VirtualMachineInstanceGuestAgentDataStale)).To(BeFalse())
+		})
+
+		It("should keep the guest agent data and mark it stale when the channel disconnects", func() {
+			lastUpdated := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:          v1.VirtualMachineInstanceAgentConnected,
+					LastProbeTime: metav1.Now(),
+					Status:        k8sv1.ConditionTrue,
+				},
+			}
+			vmi.Status.GuestAgentData = &v1.GuestAgentData{
+				Hostname: &v1.GuestAgentValue{Value: "testvmi", LastUpdated: lastUpdated},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Channels = []api.Channel{
+				{
+					Type: "unix",
+					Target: &api.ChannelTarget{
+						Name:  "org.qemu.guest_agent.0",
+						State: "disconnected",
+					},
+				},
+			}
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.GuestAgentData.Hostname.Value).To(Equal("testvmi"))
+			Expect(updatedVMI.Status.GuestAgentData.Hostname.LastUpdated.Equal(&lastUpdated)).To(BeTrue())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(v1.VirtualMachineInstanceGuestAgentDataStale),
+				"Status": Equal(k8sv1.ConditionTrue),
+				"Reason": Equal(v1.VirtualMachineInstanceReasonGuestAgentDisconnected),
+			})))
+		})
+
 		It("should add access credential synced condition when credentials report success", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1031,6 +1144,105 @@ var _ = Describe("VirtualMachineInstance", func() {
 			))
 		})
 
+		DescribeTable("should report the outcome of the last cloud-init sync", func(cloudInit *api.CloudInitMetadata, expectedStatus k8sv1.ConditionStatus, expectedEvent v1.SyncEvent) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.CloudInit = cloudInit
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			expectEvent(string(expectedEvent), true)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(v1.VirtualMachineInstanceCloudInitSynchronized),
+				"Status":  Equal(expectedStatus),
+				"Reason":  Equal(cloudInit.Reason),
+				"Message": Equal(cloudInit.Message),
+			})))
+		},
+			Entry("when the rotation succeeded",
+				&api.CloudInitMetadata{Reason: v1.VirtualMachineInstanceReasonCloudInitRotated, Succeeded: true, Message: "cloud-init re-ran in the guest"},
+				k8sv1.ConditionTrue, v1.CloudInitSyncSuccess,
+			),
+			Entry("when the rotation failed",
+				&api.CloudInitMetadata{Reason: v1.VirtualMachineInstanceReasonCloudInitRotated, Message: "cloud-init not found in the guest"},
+				k8sv1.ConditionFalse, v1.CloudInitSyncFailed,
+			),
+			Entry("when the data got ejected",
+				&api.CloudInitMetadata{Reason: v1.VirtualMachineInstanceReasonCloudInitEjected, Succeeded: true, Message: "cloud-init data ejected"},
+				k8sv1.ConditionTrue, v1.CloudInitSyncSuccess,
+			),
+		)
+
+		DescribeTable("should report a failed save of the guest memory", func(existingConditions []v1.VirtualMachineInstanceCondition, suspend *api.SuspendMetadata, expectEvent bool, matchConditions gomegatypes.GomegaMatcher) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Status.Conditions = existingConditions
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Suspend = suspend
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			if expectEvent {
+				testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonSaveFailed)
+			}
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(matchConditions)
+		},
+			Entry("when the save failed",
+				nil,
+				&api.SuspendMetadata{Failed: true, FailureReason: "no space left on device", FailedTimestamp: pointer.P(metav1.Unix(1000, 0))},
+				true,
+				ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":               Equal(v1.VirtualMachineInstanceSuspendFailed),
+					"Status":             Equal(k8sv1.ConditionTrue),
+					"Reason":             Equal(v1.VirtualMachineInstanceReasonSaveFailed),
+					"Message":            Equal("no space left on device"),
+					"LastTransitionTime": Equal(metav1.Unix(1000, 0)),
+				})),
+			),
+			Entry("when the save failed again",
+				[]v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceSuspendFailed, Status: k8sv1.ConditionTrue, Message: "no space left on device", LastTransitionTime: metav1.Unix(1000, 0)}},
+				&api.SuspendMetadata{Failed: true, FailureReason: "no space left on device", FailedTimestamp: pointer.P(metav1.Unix(2000, 0))},
+				true,
+				ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":               Equal(v1.VirtualMachineInstanceSuspendFailed),
+					"LastTransitionTime": Equal(metav1.Unix(2000, 0)),
+				})),
+			),
+			Entry("when a new save started",
+				[]v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceSuspendFailed, Status: k8sv1.ConditionTrue, Message: "no space left on device", LastTransitionTime: metav1.Unix(1000, 0)}},
+				&api.SuspendMetadata{},
+				false,
+				Not(ContainElement(HaveField("Type", v1.VirtualMachineInstanceSuspendFailed))),
+			),
+		)
+
 		type domainIsPausedTest struct {
 			domainStateChangeReason api.StateChangeReason
 			vmiMigrationState       v1.VirtualMachineInstanceMigrationState
@@ -1251,6 +1463,60 @@ var _ = Describe("VirtualMachineInstance", func() {
 			})
 		})
 
+		Context("guest panic capture", func() {
+			newPanicVMI := func() *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{MemoryDumpClaimName: "dump-pvc"}
+				vmi.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(&v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "testvm"}}, v1.VirtualMachineGroupVersionKind),
+				}
+				return vmi
+			}
+
+			newPanicDomain := func() *api.Domain {
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Crashed
+				domain.Status.Reason = api.ReasonPanicked
+				domain.Status.GuestPanicInfo = &api.GuestPanicInfo{Type: "pvpanic", ConsoleLog: "Kernel panic - not syncing"}
+				return domain
+			}
+
+			It("should add the guest panicked condition with the console output", func() {
+				vmi := newPanicVMI()
+				controller.updateGuestPanickedCondition(vmi, newPanicDomain(), virtcontroller.NewVirtualMachineInstanceConditionManager())
+
+				Expect(vmi.Status.Conditions).To(HaveLen(1))
+				Expect(vmi.Status.Conditions[0].Type).To(Equal(v1.VirtualMachineInstanceGuestPanicked))
+				Expect(vmi.Status.Conditions[0].Status).To(Equal(k8sv1.ConditionTrue))
+				Expect(vmi.Status.Conditions[0].Message).To(Equal("The guest kernel panicked, type: pvpanic\nLast serial console output:\nKernel panic - not syncing"))
+			})
+
+			It("should keep the VMI running until the memory dump completes", func() {
+				vmi := newPanicVMI()
+				domain := newPanicDomain()
+
+				phase, err := controller.calculateVmPhaseForStatusReason(domain, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(phase).To(Equal(v1.Running))
+
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "dump-pvc", Phase: v1.MemoryDumpVolumeCompleted}}
+				phase, err = controller.calculateVmPhaseForStatusReason(domain, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(phase).To(Equal(v1.Failed))
+			})
+
+			It("should not keep a VMI which is not owned by a VirtualMachine", func() {
+				vmi := newPanicVMI()
+				vmi.OwnerReferences = nil
+
+				phase, err := controller.calculateVmPhaseForStatusReason(newPanicDomain(), vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(phase).To(Equal(v1.Failed))
+			})
+		})
+
 		It("should move VirtualMachineInstance to Failed if configuring the networks on the virt-launcher fails with critical error", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1952,6 +2218,29 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 	})
 
+	Context("after a restart", func() {
+		It("should reconnect to the virt-launchers of the running VMIs", func() {
+			launcherClients := &recordingLauncherClientManager{MockLauncherClientManager: launcherclients.MockLauncherClientManager{Client: client}}
+			controller.launcherClients = launcherClients
+
+			newVMI := func(name string, phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI(name)
+				vmi.UID = types.UID(name)
+				vmi.Status.Phase = phase
+				vmi.Status.NodeName = host
+				return vmi
+			}
+			running := newVMI("running", v1.Running)
+			addVMI(running, api.NewMinimalDomainWithUUID(running.Name, running.UID))
+			succeeded := newVMI("succeeded", v1.Succeeded)
+			addVMI(succeeded, api.NewMinimalDomainWithUUID(succeeded.Name, succeeded.UID))
+			createVMI(newVMI("scheduled", v1.Scheduled))
+
+			controller.adoptLaunchers()
+			Expect(launcherClients.adopted).To(ConsistOf(running.UID))
+		})
+	})
+
 	Context("check if migratable", func() {
 
 		var testBlockPvc *k8sv1.PersistentVolumeClaim
@@ -3330,6 +3619,230 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 	})
 
+	Context("memory balloon target", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.AutoMemoryBalloon},
+				},
+			})
+			controller.clusterConfig = config
+
+			vmi = api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest:   pointer.P(resource.MustParse("8Gi")),
+				Balloon: &v1.MemoryBalloon{Min: resource.MustParse("1Gi")},
+			}
+		})
+
+		domainStats := func(usedGiB uint64) *stats.DomainStats {
+			const kibPerGib = 1024 * 1024
+			return &stats.DomainStats{Memory: &stats.DomainStatsMemory{
+				AvailableSet: true,
+				Available:    8 * kibPerGib,
+				UsableSet:    true,
+				Usable:       (8 - usedGiB) * kibPerGib,
+			}}
+		}
+
+		DescribeTable("should set the balloon target from the guest memory usage", func(nodeUnderPressure bool, expected string) {
+			node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   host,
+				Labels: map[string]string{v1.MemoryOvercommitPressureLabel: strconv.FormatBool(nodeUnderPressure)},
+			}}
+			Expect(controller.nodeStore.Add(node)).To(Succeed())
+			client.EXPECT().GetDomainStats().Return(domainStats(4), true, nil)
+
+			controller.updateBalloonTarget(vmi, client)
+
+			Expect(vmi.Status.Memory).ToNot(BeNil())
+			Expect(vmi.Status.Memory.BalloonTarget).ToNot(BeNil())
+			Expect(vmi.Status.Memory.BalloonTarget.Cmp(resource.MustParse(expected))).To(BeZero())
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		},
+			Entry("when the node is not under memory pressure", false, "5Gi"),
+			Entry("when the node is under memory pressure", true, "4506Mi"),
+		)
+
+		It("should not set the balloon target when the feature gate is disabled", func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			controller.clusterConfig = config
+
+			controller.updateBalloonTarget(vmi, client)
+
+			Expect(vmi.Status.Memory).To(BeNil())
+		})
+
+		It("should not set the balloon target without balloon bounds", func() {
+			vmi.Spec.Domain.Memory.Balloon = nil
+
+			controller.updateBalloonTarget(vmi, client)
+
+			Expect(vmi.Status.Memory).To(BeNil())
+		})
+	})
+
+	Context("node graceful shutdown", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.NodeGracefulShutdownGate},
+				},
+			})
+			controller.clusterConfig = config
+
+			vmi = api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			vmi.Status.NodeName = host
+			vmi.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyLiveMigrateIfPossible)
+		})
+
+		startNodeShutdown := func() {
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:               v1.VirtualMachineInstanceNodeShutdown,
+				Status:             k8sv1.ConditionTrue,
+				Reason:             v1.VirtualMachineInstanceReasonNodeShutdownStarted,
+				LastTransitionTime: metav1.Now(),
+			})
+		}
+
+		setMigratable := func() {
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceIsMigratable,
+				Status: k8sv1.ConditionTrue,
+			})
+		}
+
+		nodeShutdownReason := func() string {
+			cond := virtcontroller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceNodeShutdown)
+			if cond == nil {
+				return ""
+			}
+			return cond.Reason
+		}
+
+		It("should mark a migratable VMI for evacuation", func() {
+			startNodeShutdown()
+			setMigratable()
+
+			migrating, stopping := controller.handleNodeShutdown(vmi)
+
+			Expect(migrating).To(BeTrue())
+			Expect(stopping).To(BeFalse())
+			Expect(vmi.Status.EvacuationNodeName).To(Equal(host))
+			Expect(nodeShutdownReason()).To(Equal(v1.VirtualMachineInstanceReasonNodeShutdownMigrating))
+			testutils.ExpectEvent(recorder, "live migrating the VMI")
+		})
+
+		It("should stop a VMI which is not migratable", func() {
+			startNodeShutdown()
+
+			migrating, stopping := controller.handleNodeShutdown(vmi)
+
+			Expect(migrating).To(BeFalse())
+			Expect(stopping).To(BeTrue())
+			Expect(vmi.Status.EvacuationNodeName).To(BeEmpty())
+			Expect(nodeShutdownReason()).To(Equal(v1.VirtualMachineInstanceReasonNodeShutdownStopping))
+			testutils.ExpectEvent(recorder, "stopping the VMI")
+		})
+
+		It("should stop a VMI whose migration failed", func() {
+			startNodeShutdown()
+			setMigratable()
+			migrating, _ := controller.handleNodeShutdown(vmi)
+			Expect(migrating).To(BeTrue())
+			testutils.ExpectEvent(recorder, "live migrating the VMI")
+
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				SourceNode:   host,
+				Completed:    true,
+				Failed:       true,
+				EndTimestamp: pointer.P(metav1.Now()),
+			}
+			migrating, stopping := controller.handleNodeShutdown(vmi)
+
+			Expect(migrating).To(BeFalse())
+			Expect(stopping).To(BeTrue())
+			Expect(nodeShutdownReason()).To(Equal(v1.VirtualMachineInstanceReasonNodeShutdownStopping))
+			testutils.ExpectEvent(recorder, "stopping the VMI")
+		})
+
+		DescribeTable("should not handle the VMI", func(shuttingDown bool, featureGates []string) {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			})
+			controller.clusterConfig = config
+			if shuttingDown {
+				startNodeShutdown()
+			}
+			setMigratable()
+
+			migrating, stopping := controller.handleNodeShutdown(vmi)
+
+			Expect(migrating).To(BeFalse())
+			Expect(stopping).To(BeFalse())
+			Expect(vmi.Status.EvacuationNodeName).To(BeEmpty())
+			Expect(nodeShutdownReason()).ToNot(BeElementOf(
+				v1.VirtualMachineInstanceReasonNodeShutdownMigrating,
+				v1.VirtualMachineInstanceReasonNodeShutdownStopping,
+			))
+		},
+			Entry("when the node is not shutting down", false, []string{featuregate.NodeGracefulShutdownGate}),
+			Entry("when the feature gate is disabled", true, nil),
+		)
+
+		It("should shut down the domain of a VMI which is not migratable", func() {
+			startNodeShutdown()
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			initGracePeriodHelper(600, vmi, domain)
+			addVMI(vmi, domain)
+
+			client.EXPECT().ShutdownVirtualMachine(gomock.Any())
+			sanityExecute()
+			testutils.ExpectEvent(recorder, "stopping the VMI")
+			testutils.ExpectEvent(recorder, VMIGracefulShutdown)
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			vmi = updatedVMI
+			Expect(nodeShutdownReason()).To(Equal(v1.VirtualMachineInstanceReasonNodeShutdownStopping))
+		})
+
+		It("should hold back the graceful shutdown of a VMI which could be evacuated until the node shutdown is reported", func() {
+			const key = "default/testvmi"
+			setMigratable()
+
+			Expect(controller.holdGracefulShutdown(key, vmi)).To(BeTrue())
+
+			startNodeShutdown()
+			Expect(controller.holdGracefulShutdown(key, vmi)).To(BeFalse())
+		})
+
+		It("should stop holding back the graceful shutdown after the detection timeout", func() {
+			const key = "default/testvmi"
+			setMigratable()
+
+			Expect(controller.holdGracefulShutdown(key, vmi)).To(BeTrue())
+			controller.gracefulShutdownHold.since[key] = time.Now().Add(-nodeShutdownDetectionTimeout)
+			Expect(controller.holdGracefulShutdown(key, vmi)).To(BeFalse())
+
+			controller.releaseGracefulShutdown(key)
+			Expect(controller.gracefulShutdownHold.since).ToNot(HaveKey(key))
+		})
+
+		It("should not hold back the graceful shutdown of a VMI which is not migratable", func() {
+			Expect(controller.holdGracefulShutdown("default/testvmi", vmi)).To(BeFalse())
+		})
+	})
+
 	Context("updateBackupStatus", func() {
 		startTime := metav1.Now()
 		endTime := metav1.NewTime(startTime.Add(5 * time.Minute))
@@ -3434,6 +3947,103 @@ var _ = Describe("VirtualMachineInstance", func() {
 	})
 })
 
+var _ = Describe("Guest agent data", func() {
+	var (
+		condManager *virtcontroller.VirtualMachineInstanceConditionManager
+		vmi         *v1.VirtualMachineInstance
+		lastUpdated metav1.Time
+	)
+
+	BeforeEach(func() {
+		condManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+		lastUpdated = metav1.NewTime(time.Now().Add(-time.Hour))
+		vmi = api2.NewMinimalVMI("testvmi")
+		vmi.Status.GuestAgentData = &v1.GuestAgentData{
+			OS:       &v1.GuestAgentOSInfo{Info: v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux"}, LastUpdated: lastUpdated},
+			Hostname: &v1.GuestAgentValue{Value: "testvmi", LastUpdated: lastUpdated},
+		}
+	})
+
+	It("should only move the timestamps of changed values", func() {
+		polled := metav1.NewTime(lastUpdated.Add(30 * time.Second))
+		updateGuestAgentData(vmi, &v1.VirtualMachineInstanceGuestAgentInfo{
+			Hostname:   "renamed",
+			OS:         v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux"},
+			LastPolled: &polled,
+		}, condManager)
+
+		Expect(vmi.Status.GuestAgentData.OS.LastUpdated).To(Equal(lastUpdated))
+		Expect(vmi.Status.GuestAgentData.Hostname.Value).To(Equal("renamed"))
+		Expect(vmi.Status.GuestAgentData.Hostname.LastUpdated).To(Equal(polled))
+	})
+
+	It("should move the timestamps of unchanged values to a later successful poll", func() {
+		polled := metav1.NewTime(lastUpdated.Add(guestAgentDataRefreshInterval))
+		updateGuestAgentData(vmi, &v1.VirtualMachineInstanceGuestAgentInfo{
+			Hostname:   "testvmi",
+			OS:         v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux"},
+			LastPolled: &polled,
+		}, condManager)
+
+		Expect(vmi.Status.GuestAgentData.OS.LastUpdated).To(Equal(polled))
+		Expect(vmi.Status.GuestAgentData.Hostname.LastUpdated).To(Equal(polled))
+	})
+
+	It("should refresh the timestamps and remove the stale condition when the guest agent reconnects", func() {
+		markGuestAgentDataStale(vmi, v1.VirtualMachineInstanceReasonGuestAgentDisconnected, "disconnected", condManager)
+		Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestAgentDataStale)).To(BeTrue())
+
+		polled := metav1.NewTime(lastUpdated.Add(time.Second))
+		updateGuestAgentData(vmi, &v1.VirtualMachineInstanceGuestAgentInfo{
+			Hostname:   "testvmi",
+			OS:         v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux"},
+			LastPolled: &polled,
+		}, condManager)
+
+		Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestAgentDataStale)).To(BeFalse())
+		Expect(vmi.Status.GuestAgentData.OS.LastUpdated).To(Equal(polled))
+		Expect(vmi.Status.GuestAgentData.Hostname.LastUpdated).To(Equal(polled))
+	})
+
+	It("should keep the guest agent data and mark it stale when polling the guest agent fails", func() {
+		updateGuestAgentData(vmi, &v1.VirtualMachineInstanceGuestAgentInfo{
+			Hostname:   "testvmi",
+			OS:         v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux"},
+			LastPolled: &lastUpdated,
+			PollError:  "guest agent timed out",
+		}, condManager)
+
+		Expect(vmi.Status.GuestAgentData.Hostname.LastUpdated).To(Equal(lastUpdated))
+		cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestAgentDataStale)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonGuestAgentNotResponding))
+		Expect(cond.Message).To(ContainSubstring("guest agent timed out"))
+	})
+
+	It("should update the reason of the stale condition", func() {
+		markGuestAgentDataStale(vmi, v1.VirtualMachineInstanceReasonGuestAgentNotResponding, "not responding", condManager)
+		markGuestAgentDataStale(vmi, v1.VirtualMachineInstanceReasonGuestAgentDisconnected, "disconnected", condManager)
+
+		Expect(vmi.Status.Conditions).To(ConsistOf(HaveField("Reason", v1.VirtualMachineInstanceReasonGuestAgentDisconnected)))
+	})
+
+	It("should not report a short hostname as FQDN nor a timezone without name", func() {
+		updateGuestAgentData(vmi, &v1.VirtualMachineInstanceGuestAgentInfo{
+			Hostname: "testvmi",
+			Timezone: ", 3600",
+		}, condManager)
+
+		Expect(vmi.Status.GuestAgentData.FQDN).To(BeNil())
+		Expect(vmi.Status.GuestAgentData.Timezone).To(BeNil())
+	})
+
+	It("should not mark the status stale without guest agent data", func() {
+		vmi.Status.GuestAgentData = nil
+		markGuestAgentDataStale(vmi, v1.VirtualMachineInstanceReasonGuestAgentDisconnected, "disconnected", condManager)
+		Expect(vmi.Status.Conditions).To(BeEmpty())
+	})
+})
+
 var _ = Describe("CurrentMemory in Libvirt Domain", func() {
 	DescribeTable("should be correctly parsed", func(inputMemory *api.Memory, outputQuantity resource.Quantity) {
 		result := parseLibvirtQuantity(int64(inputMemory.Value), inputMemory.Unit)
@@ -3561,3 +4171,16 @@ func withFilesystemDevice(deviceName string) libvmi.Option {
 		})
 	}
 }
+
+type recordingLauncherClientManager struct {
+	launcherclients.MockLauncherClientManager
+	lock    sync.Mutex
+	adopted []types.UID
+}
+
+func (m *recordingLauncherClientManager) GetVerifiedLauncherClient(vmi *v1.VirtualMachineInstance) (cmdclient.LauncherClient, error) {
+	m.lock.Lock()
+	m.adopted = append(m.adopted, vmi.UID)
+	m.lock.Unlock()
+	return m.MockLauncherClientManager.GetVerifiedLauncherClient(vmi)
+}
@@ -31,6 +31,8 @@ type Cache struct {
 	GracePeriod       SafeData[api.GracePeriodMetadata]
 	AccessCredential  SafeData[api.AccessCredentialMetadata]
 	MemoryDump        SafeData[api.MemoryDumpMetadata]
+	CloudInit         SafeData[api.CloudInitMetadata]
+	Suspend           SafeData[api.SuspendMetadata]
 	Backup            SafeData[api.BackupMetadata]
 	GuestPanicHandled SafeData[bool]
 
@@ -46,6 +48,8 @@ func NewCache() *Cache {
 	cache.GracePeriod.dirtyChanel = cache.notificationSignal
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
+	cache.CloudInit.dirtyChanel = cache.notificationSignal
+	cache.Suspend.dirtyChanel = cache.notificationSignal
 	cache.Backup.dirtyChanel = cache.notificationSignal
 	cache.GuestPanicHandled.dirtyChanel = cache.notificationSignal
 	return cache
@@ -47,5 +47,11 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.CloudInit.Load(); exists {
+		kubevirtMetadata.CloudInit = &value
+	}
+	if value, exists := metadataCache.Suspend.Load(); exists {
+		kubevirtMetadata.Suspend = &value
+	}
 	return kubevirtMetadata
 }
@@ -29,6 +29,7 @@ import (
 	com "kubevirt.io/kubevirt/pkg/handler-launcher-com"
 	"kubevirt.io/kubevirt/pkg/handler-launcher-com/notify/info"
 	notifyv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/notify/v1"
+	kvutil "kubevirt.io/kubevirt/pkg/util"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -43,10 +44,18 @@ const (
 	libvirtEventChannelFull        = "Libvirt event channel is full, dropping event."
 )
 
+const (
+	defaultGuestPanicConsoleLogLines = 20
+	// limits the serial console log read on guest panics, the lines end up in the VMI status
+	maxGuestPanicConsoleLogBytes = 16 * 1024
+)
+
 var (
 	// add older version when supported
 	// don't use the variable in pkg/handler-launcher-com/notify/v1/version.go in order to detect version mismatches early
 	supportedNotifyVersions = []uint32{1}
+
+	serialConsoleLogDir = kvutil.VirtPrivateDir
 )
 
 type Notifier struct {
@@ -307,6 +316,10 @@ func (e *eventCaller) handleGuestPanicEvent(client *Notifier, vmi *v1.VirtualMac
 		log.Log.Infof("Guest panic detected: %s", eventMessage)
 	}
 
+	if capture := vmi.Spec.Domain.Devices.GuestPanicCapture; capture != nil {
+		panicInfo.ConsoleLog = readGuestPanicConsoleLog(vmi, capture)
+	}
+
 	// Only mark as handled for PANICKED events. CRASHLOADED indicates kdump-based
 	// recovery where the guest reboots, so subsequent panic events should still fire.
 	if libvirt.DomainEventCrashedDetailType(eventDetail) == libvirt.DOMAIN_EVENT_CRASHED_PANICKED {
@@ -321,6 +334,19 @@ func (e *eventCaller) handleGuestPanicEvent(client *Notifier, vmi *v1.VirtualMac
 	return panicInfo
 }
 
+func readGuestPanicConsoleLog(vmi *v1.VirtualMachineInstance, capture *v1.GuestPanicCapture) string {
+	lines := defaultGuestPanicConsoleLogLines
+	if capture.ConsoleLogLines != nil {
+		lines = int(*capture.ConsoleLogLines)
+	}
+	logPath := filepath.Join(serialConsoleLogDir, string(vmi.UID), "virt-serial0-log")
+	consoleLog, err := util.ReadConsoleLogTail(logPath, lines, maxGuestPanicConsoleLogBytes)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("Failed to read the serial console log")
+	}
+	return consoleLog
+}
+
 func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEvent, client *Notifier, events chan watch.Event,
 	interfaceStatus []api.InterfaceStatus, osInfo *api.GuestOSInfo, vmi *v1.VirtualMachineInstance, fsFreezeStatus *api.FSFreeze,
 	metadataCache *metadata.Cache, nonRoot bool) {
@@ -41,6 +41,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/handler-launcher-com/notify/info"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	notifyserver "kubevirt.io/kubevirt/pkg/virt-handler/notify-server"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
@@ -481,6 +482,24 @@ var _ = Describe("Notify", func() {
 				Expect(exists).To(BeFalse())
 			})
 
+			It("should capture the serial console log when requested", func() {
+				vmi := api2.NewMinimalVMI("test-vmi")
+				vmi.Namespace = "test-ns"
+				vmi.UID = "1234"
+				vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{ConsoleLogLines: pointer.P(uint32(1))}
+				vmiStore.Add(vmi)
+
+				origDir := serialConsoleLogDir
+				serialConsoleLogDir = GinkgoT().TempDir()
+				DeferCleanup(func() { serialConsoleLogDir = origDir })
+				Expect(os.MkdirAll(filepath.Join(serialConsoleLogDir, "1234"), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(serialConsoleLogDir, "1234", "virt-serial0-log"), []byte("booting\nKernel panic - not syncing\n"), 0644)).To(Succeed())
+
+				panicInfo := e.handleGuestPanicEvent(client, vmi, metadata.NewCache(), int(libvirt.DOMAIN_EVENT_CRASHED_PANICKED), false)
+				Expect(panicInfo).ToNot(BeNil())
+				Expect(panicInfo.ConsoleLog).To(Equal("Kernel panic - not syncing"))
+			})
+
 			It("should return nil when VMI is nil", func() {
 				cache := metadata.NewCache()
 				panicInfo := e.handleGuestPanicEvent(client, nil, cache, int(libvirt.DOMAIN_EVENT_CRASHED_PANICKED), false)
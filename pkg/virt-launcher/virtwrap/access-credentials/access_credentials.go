@@ -276,6 +276,10 @@ func (l *AccessCredentialManager) agentSetFilePermissions(domName, filePath, per
 }
 
 func (l *AccessCredentialManager) agentSetUserPassword(domName, user, password string) (err error) {
+	if err := l.virConn.GuestAgentCommandPolicy().Check("guest-set-user-password"); err != nil {
+		return err
+	}
+
 	domain, err := l.virConn.LookupDomainByName(domName)
 	if err != nil {
 		return fmt.Errorf("domain lookup failed: %w", err)
@@ -294,6 +298,10 @@ func (l *AccessCredentialManager) pingAgent(domName string) error {
 
 func (l *AccessCredentialManager) agentSetAuthorizedKeys(domName, user string, authorizedKeys []string) error {
 	err := func() (err error) {
+		if err := l.virConn.GuestAgentCommandPolicy().Check("guest-ssh-add-authorized-keys"); err != nil {
+			return err
+		}
+
 		domain, err := l.virConn.LookupDomainByName(domName)
 		if err != nil {
 			return err
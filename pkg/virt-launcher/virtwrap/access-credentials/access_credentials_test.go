@@ -44,6 +44,7 @@ import (
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
@@ -115,6 +116,14 @@ var _ = Describe("AccessCredentials", func() {
 		Expect(manager.agentSetUserPassword(domName, user, password)).To(Succeed())
 	})
 
+	It("should not update the password when the guest agent command is denied", func() {
+		mockLibvirt.VirtConnection.SetGuestAgentCommandPolicy(agentpolicy.New(&v1.GuestAgentCommandsConfiguration{
+			DeniedCommands: []string{"guest-set-user-password"},
+		}))
+
+		Expect(manager.agentSetUserPassword("some-domain", "myuser", "1234")).To(MatchError(ContainSubstring("guest-set-user-password is not allowed")))
+	})
+
 	It("should handle dynamically updating ssh key with qemu agent", func() {
 		const domName = "some-domain"
 		const user = "someowner"
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agentpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// Policy decides which qemu-guest-agent commands may be invoked in the guest.
+// A nil Policy allows all commands.
+type Policy struct {
+	allowed []map[string]struct{}
+	denied  map[string]struct{}
+}
+
+// New returns the Policy allowing the commands allowed by all the configurations,
+// e.g. the cluster wide and the VMI configuration. Nil configurations are ignored.
+func New(configs ...*v1.GuestAgentCommandsConfiguration) *Policy {
+	policy := &Policy{denied: map[string]struct{}{}}
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+		if len(config.AllowedCommands) > 0 {
+			policy.allowed = append(policy.allowed, toSet(config.AllowedCommands))
+		}
+		for _, command := range config.DeniedCommands {
+			policy.denied[command] = struct{}{}
+		}
+	}
+
+	if len(policy.allowed) == 0 && len(policy.denied) == 0 {
+		return nil
+	}
+	return policy
+}
+
+// Allows returns true if the command may be invoked
+func (p *Policy) Allows(command string) bool {
+	if p == nil {
+		return true
+	}
+	if _, denied := p.denied[command]; denied {
+		return false
+	}
+	for _, allowed := range p.allowed {
+		if _, ok := allowed[command]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Check returns an error if the command may not be invoked
+func (p *Policy) Check(command string) error {
+	if !p.Allows(command) {
+		return fmt.Errorf("the guest agent command %s is not allowed", command)
+	}
+	return nil
+}
+
+// CommandName returns the name of the command executed by a guest agent request,
+// e.g. guest-ping for {"execute":"guest-ping"}
+func CommandName(request string) string {
+	var command struct {
+		Execute string `json:"execute"`
+	}
+	if err := json.Unmarshal([]byte(request), &command); err != nil {
+		return ""
+	}
+	return command.Execute
+}
+
+func toSet(commands []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(commands))
+	for _, command := range commands {
+		set[command] = struct{}{}
+	}
+	return set
+}
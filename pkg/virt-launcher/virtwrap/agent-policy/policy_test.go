@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agentpolicy_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
+)
+
+var _ = Describe("Guest agent command policy", func() {
+	It("should allow all commands without configuration", func() {
+		policy := agentpolicy.New(nil, &v1.GuestAgentCommandsConfiguration{})
+		Expect(policy).To(BeNil())
+		Expect(policy.Allows("guest-exec")).To(BeTrue())
+		Expect(policy.Check("guest-exec")).To(Succeed())
+	})
+
+	DescribeTable("should decide if a command is allowed", func(command string, expected bool) {
+		policy := agentpolicy.New(
+			&v1.GuestAgentCommandsConfiguration{
+				AllowedCommands: []string{"guest-ping", "guest-info", "guest-exec", "guest-get-users"},
+				DeniedCommands:  []string{"guest-file-write"},
+			},
+			&v1.GuestAgentCommandsConfiguration{
+				AllowedCommands: []string{"guest-ping", "guest-info", "guest-exec"},
+				DeniedCommands:  []string{"guest-exec"},
+			},
+		)
+		Expect(policy.Allows(command)).To(Equal(expected))
+	},
+		Entry("allowed by all configurations", "guest-ping", true),
+		Entry("denied by a configuration", "guest-exec", false),
+		Entry("denied without being allowed", "guest-file-write", false),
+		Entry("not allowed by all configurations", "guest-get-users", false),
+		Entry("not allowed by any configuration", "guest-shutdown", false),
+	)
+
+	It("should only deny the denied commands without allowlist", func() {
+		policy := agentpolicy.New(&v1.GuestAgentCommandsConfiguration{DeniedCommands: []string{"guest-exec"}})
+		Expect(policy.Check("guest-exec")).To(MatchError("the guest agent command guest-exec is not allowed"))
+		Expect(policy.Check("guest-ping")).To(Succeed())
+	})
+
+	DescribeTable("should extract the command name of a request", func(request, expected string) {
+		Expect(agentpolicy.CommandName(request)).To(Equal(expected))
+	},
+		Entry("without arguments", `{"execute":"guest-ping"}`, "guest-ping"),
+		Entry("with arguments", `{"execute": "guest-file-open", "arguments": { "path": "/tmp/a", "mode":"w" } }`, "guest-file-open"),
+		Entry("with an invalid request", `guest-ping`, ""),
+	)
+})
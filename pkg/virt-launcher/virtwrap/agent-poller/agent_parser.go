@@ -39,10 +39,21 @@ func stripAgentStringResponse(agentReply string) string {
 	return result[1]
 }
 
+// PCI address of a filesystem disk or of its controller
+type FSDiskPCIController struct {
+	Domain   int `json:"domain"`
+	Bus      int `json:"bus"`
+	Slot     int `json:"slot"`
+	Function int `json:"function"`
+}
+
 // Filesystem disk of the host
 type FSDisk struct {
-	Serial  string `json:"serial,omitempty"`
-	BusType string `json:"bus-type"`
+	Serial        string               `json:"serial,omitempty"`
+	BusType       string               `json:"bus-type"`
+	Target        int                  `json:"target"`
+	Unit          int                  `json:"unit"`
+	PCIController *FSDiskPCIController `json:"pci-controller,omitempty"`
 }
 
 // Filesystem of the host
@@ -103,10 +114,16 @@ func parseFilesystem(agentReply string) ([]api.Filesystem, error) {
 func parseFSDisks(fsDisks []FSDisk) []api.FSDisk {
 	disks := []api.FSDisk{}
 	for _, fsDisk := range fsDisks {
-		disks = append(disks, api.FSDisk{
+		disk := api.FSDisk{
 			Serial:  fsDisk.Serial,
 			BusType: fsDisk.BusType,
-		})
+			Target:  fsDisk.Target,
+			Unit:    fsDisk.Unit,
+		}
+		if pci := fsDisk.PCIController; pci != nil {
+			disk.PCIAddress = fmt.Sprintf("%04x:%02x:%02x.%x", pci.Domain, pci.Bus, pci.Slot, pci.Function)
+		}
+		disks = append(disks, disk)
 	}
 
 	return disks
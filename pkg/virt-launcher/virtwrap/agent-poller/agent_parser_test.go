@@ -102,5 +102,42 @@ var _ = Describe("Qemu agent poller", func() {
 			}
 			Expect(parseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
 		})
+
+		It("should parse the address of Filesystem disks", func() {
+			jsonInput := `{
+                "return":[
+                    {
+                        "name":"vda1",
+                        "mountpoint":"/",
+                        "type":"xfs",
+                        "total-bytes":99999,
+                        "used-bytes":33333,
+                        "disk":[
+                            {
+                                "bus-type":"virtio",
+                                "bus":0,
+                                "target":0,
+                                "unit":0,
+                                "pci-controller":{"domain":0,"bus":7,"slot":0,"function":0}
+                            },
+                            {
+                                "bus-type":"scsi",
+                                "bus":0,
+                                "target":0,
+                                "unit":3
+                            }
+                        ]
+                    }
+                ]
+            }`
+
+			filesystems, err := parseFilesystem(jsonInput)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filesystems).To(HaveLen(1))
+			Expect(filesystems[0].Disk).To(Equal([]api.FSDisk{
+				{BusType: "virtio", PCIAddress: "0000:07:00.0"},
+				{BusType: "scsi", Unit: 3},
+			}))
+		})
 	})
 })
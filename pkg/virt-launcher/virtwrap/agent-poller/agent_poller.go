@@ -19,6 +19,7 @@
 package agentpoller
 
 import (
+	"fmt"
 	"math"
 	"sync"
 	"time"
@@ -29,6 +30,7 @@ import (
 
 	"kubevirt.io/client-go/log"
 
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
@@ -50,6 +52,20 @@ const (
 	repeatingLogLevel = 3
 )
 
+// storeKey is a key to the store for data that is not the result of a single command
+type storeKey string
+
+// sysInfoPollStatus is the key to the outcome of the last poll of the guest OS information
+const sysInfoPollStatus storeKey = "sys-info-poll-status"
+
+// SysInfoPollStatus is the outcome of the last poll of the guest OS information
+type SysInfoPollStatus struct {
+	// LastSucceeded is the time the guest agent last reported the guest OS information
+	LastSucceeded time.Time
+	// Error is set when the last poll failed or timed out
+	Error string
+}
+
 // AgentUpdatedEvent fire up when data is changes in the store
 type AgentUpdatedEvent struct {
 	DomainInfo api.DomainGuestInfo
@@ -97,6 +113,40 @@ func (s *AsyncAgentStore) Store(key, value any) {
 	}
 }
 
+// storeSysInfoPoll records the outcome of a poll of the guest OS information, it fires up
+// an updated event when the guest agent stops or starts responding again
+func (s *AsyncAgentStore) storeSysInfoPoll(pollErr error) {
+	status := s.GetSysInfoPollStatus()
+	failedBefore := status.Error != ""
+	if pollErr != nil {
+		status.Error = pollErr.Error()
+	} else {
+		status.Error = ""
+		status.LastSucceeded = time.Now()
+	}
+	s.store.Store(sysInfoPollStatus, status)
+
+	if failedBefore == (pollErr != nil) {
+		return
+	}
+	s.AgentUpdated <- AgentUpdatedEvent{
+		DomainInfo: api.DomainGuestInfo{
+			OSInfo:         s.GetGuestOSInfo(),
+			Interfaces:     s.GetInterfaceStatus(),
+			FSFreezeStatus: s.GetFSFreezeStatus(),
+		},
+	}
+}
+
+// GetSysInfoPollStatus returns the outcome of the last poll of the guest OS information
+func (s *AsyncAgentStore) GetSysInfoPollStatus() SysInfoPollStatus {
+	data, ok := s.store.Load(sysInfoPollStatus)
+	if !ok {
+		return SysInfoPollStatus{}
+	}
+	return data.(SysInfoPollStatus)
+}
+
 // GetSysInfo returns the sysInfo information packed together.
 // Sysinfo comprises of:
 //   - Guest Hostname
@@ -350,8 +400,8 @@ func (p *AgentPoller) Start() {
 		go worker.Poll(func() {
 			if len(worker.AgentCommands) != 0 {
 				executeAgentCommands(worker.AgentCommands, p)
-			} else {
-				fetchAndStoreGuestInfo(worker.InfoTypes, p)
+			} else if err := fetchAndStoreGuestInfo(worker.InfoTypes, p); err != nil {
+				log.Log.Error(err.Error())
 			}
 		}, p.agentDone, pollInitialInterval)
 	}
@@ -445,13 +495,68 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 	}
 }
 
-func fetchAndStoreGuestInfo(infoTypes libvirt.DomainGuestInfoTypes, agentPoller *AgentPoller) {
+// RefreshGuestInfo polls the guest agent for the data reported as guest OS info right away,
+// instead of waiting for the next tick of the pollers.
+func RefreshGuestInfo(connection cli.Connection, domainName string, store *AsyncAgentStore) error {
+	agentPoller := &AgentPoller{
+		Connection: connection,
+		domainName: domainName,
+		agentStore: store,
+	}
+	executeAgentCommands([]AgentCommand{GetAgent, GetFilesystem, GetFSFreezeStatus}, agentPoller)
+	return fetchAndStoreGuestInfo(
+		libvirt.DOMAIN_GUEST_INFO_INTERFACES|
+			libvirt.DOMAIN_GUEST_INFO_OS|
+			libvirt.DOMAIN_GUEST_INFO_HOSTNAME|
+			libvirt.DOMAIN_GUEST_INFO_TIMEZONE|
+			libvirt.DOMAIN_GUEST_INFO_USERS,
+		agentPoller,
+	)
+}
+
+// guestInfoCommands are the guest agent commands libvirt executes to get each type of guest info
+var guestInfoCommands = map[libvirt.DomainGuestInfoTypes]string{
+	libvirt.DOMAIN_GUEST_INFO_INTERFACES: "guest-network-get-interfaces",
+	libvirt.DOMAIN_GUEST_INFO_OS:         "guest-get-osinfo",
+	libvirt.DOMAIN_GUEST_INFO_HOSTNAME:   "guest-get-host-name",
+	libvirt.DOMAIN_GUEST_INFO_TIMEZONE:   "guest-get-timezone",
+	libvirt.DOMAIN_GUEST_INFO_LOAD:       "guest-get-load",
+	libvirt.DOMAIN_GUEST_INFO_USERS:      "guest-get-users",
+}
+
+// allowedGuestInfoTypes drops the types of guest info whose guest agent command is not allowed
+func allowedGuestInfoTypes(infoTypes libvirt.DomainGuestInfoTypes, policy *agentpolicy.Policy) libvirt.DomainGuestInfoTypes {
+	for infoType, command := range guestInfoCommands {
+		if infoTypes&infoType != 0 && !policy.Allows(command) {
+			infoTypes &^= infoType
+		}
+	}
+	return infoTypes
+}
+
+// sysInfoTypes are the types of guest info reported as the guest OS information
+const sysInfoTypes = libvirt.DOMAIN_GUEST_INFO_OS | libvirt.DOMAIN_GUEST_INFO_HOSTNAME | libvirt.DOMAIN_GUEST_INFO_TIMEZONE
+
+func fetchAndStoreGuestInfo(infoTypes libvirt.DomainGuestInfoTypes, agentPoller *AgentPoller) error {
+	infoTypes = allowedGuestInfoTypes(infoTypes, agentPoller.Connection.GuestAgentCommandPolicy())
+	if infoTypes == 0 {
+		// libvirt returns all the types of guest info for 0
+		return nil
+	}
+
+	err := fetchGuestInfo(infoTypes, agentPoller)
+	if infoTypes&sysInfoTypes != 0 {
+		agentPoller.agentStore.storeSysInfoPoll(err)
+	}
+	return err
+}
+
+func fetchGuestInfo(infoTypes libvirt.DomainGuestInfoTypes, agentPoller *AgentPoller) error {
 	log.Log.Infof("Polling API operations: %v", infoTypes)
 
 	domain, err := agentPoller.Connection.LookupDomainByName(agentPoller.domainName)
 	if err != nil {
-		log.Log.Errorf("Domain lookup failed: %v", err)
-		return
+		return fmt.Errorf("domain lookup failed: %v", err)
 	}
 
 	// Ignoring errors from domain.Free() is safe because it
@@ -461,8 +566,7 @@ func fetchAndStoreGuestInfo(infoTypes libvirt.DomainGuestInfoTypes, agentPoller
 
 	guestInfo, err := domain.GetGuestInfo(infoTypes, 0)
 	if err != nil {
-		log.Log.Errorf("Fetching guest info failed: %v", err)
-		return
+		return fmt.Errorf("fetching guest info failed: %v", err)
 	}
 
 	if infoTypes&libvirt.DOMAIN_GUEST_INFO_INTERFACES != 0 {
@@ -488,6 +592,8 @@ func fetchAndStoreGuestInfo(infoTypes libvirt.DomainGuestInfoTypes, agentPoller
 	if infoTypes&libvirt.DOMAIN_GUEST_INFO_USERS != 0 {
 		agentPoller.agentStore.Store(libvirt.DOMAIN_GUEST_INFO_USERS, convertToUsers(guestInfo))
 	}
+
+	return nil
 }
 
 func convertToInterfaces(guestInfo *libvirt.DomainGuestInfo) []api.InterfaceStatus {
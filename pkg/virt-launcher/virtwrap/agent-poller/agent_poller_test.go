@@ -20,6 +20,7 @@
 package agentpoller
 
 import (
+	"errors"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -28,6 +29,9 @@ import (
 
 	"libvirt.org/go/libvirt"
 
+	v1 "kubevirt.io/api/core/v1"
+
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
 )
@@ -88,7 +92,7 @@ var _ = Describe("Qemu agent poller", func() {
 			mockLibvirt.DomainEXPECT().Free()
 			mockLibvirt.DomainEXPECT().GetGuestInfo(libvirtTypes, uint32(0)).Return(guestInfo, nil)
 
-			fetchAndStoreGuestInfo(libvirtTypes, agentPoller)
+			Expect(fetchAndStoreGuestInfo(libvirtTypes, agentPoller)).To(Succeed())
 
 			interfacesStatus := agentStore.GetInterfaceStatus()
 			Expect(interfacesStatus[0].InterfaceName).To(Equal("net0"))
@@ -103,6 +107,91 @@ var _ = Describe("Qemu agent poller", func() {
 			users := agentStore.GetUsers(1)
 			Expect(users[0].Name).To(Equal("admin"))
 		})
+
+		It("should not retrieve the guest info of denied guest agent commands", func() {
+			mockLibvirt.VirtConnection.SetGuestAgentCommandPolicy(agentpolicy.New(&v1.GuestAgentCommandsConfiguration{
+				DeniedCommands: []string{"guest-get-users", "guest-network-get-interfaces"},
+			}))
+			agentPoller := &AgentPoller{
+				Connection: mockLibvirt.VirtConnection,
+				domainName: "fake",
+				agentStore: &agentStore,
+			}
+
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().GetGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS, uint32(0)).Return(&libvirt.DomainGuestInfo{OS: &libvirt.DomainGuestInfoOS{Name: "fedora"}}, nil)
+
+			Expect(fetchAndStoreGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS|libvirt.DOMAIN_GUEST_INFO_USERS|libvirt.DOMAIN_GUEST_INFO_INTERFACES, agentPoller)).To(Succeed())
+			Expect(agentStore.GetGuestOSInfo().Name).To(Equal("fedora"))
+			Expect(agentStore.GetUsers(1)).To(BeEmpty())
+		})
+
+		It("should record when polling the guest OS information fails and recovers", func() {
+			agentPoller := &AgentPoller{
+				Connection: mockLibvirt.VirtConnection,
+				domainName: "fake",
+				agentStore: &agentStore,
+			}
+
+			mockLibvirt.DomainEXPECT().Free().Times(3)
+			mockLibvirt.DomainEXPECT().GetGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS, uint32(0)).Return(&libvirt.DomainGuestInfo{OS: &libvirt.DomainGuestInfoOS{Name: "fedora"}}, nil)
+			Expect(fetchAndStoreGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS, agentPoller)).To(Succeed())
+			Expect(agentStore.AgentUpdated).To(Receive())
+			lastSucceeded := agentStore.GetSysInfoPollStatus().LastSucceeded
+			Expect(lastSucceeded.IsZero()).To(BeFalse())
+			Expect(agentStore.GetSysInfoPollStatus().Error).To(BeEmpty())
+
+			mockLibvirt.DomainEXPECT().GetGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS, uint32(0)).Return(nil, errors.New("guest agent timed out"))
+			Expect(fetchAndStoreGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS, agentPoller)).ToNot(Succeed())
+			Expect(agentStore.AgentUpdated).To(Receive())
+			Expect(agentStore.GetSysInfoPollStatus().Error).To(ContainSubstring("guest agent timed out"))
+			Expect(agentStore.GetSysInfoPollStatus().LastSucceeded).To(Equal(lastSucceeded))
+			Expect(agentStore.GetGuestOSInfo().Name).To(Equal("fedora"))
+
+			mockLibvirt.DomainEXPECT().GetGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS, uint32(0)).Return(&libvirt.DomainGuestInfo{OS: &libvirt.DomainGuestInfoOS{Name: "fedora"}}, nil)
+			Expect(fetchAndStoreGuestInfo(libvirt.DOMAIN_GUEST_INFO_OS, agentPoller)).To(Succeed())
+			Expect(agentStore.AgentUpdated).To(Receive())
+			Expect(agentStore.GetSysInfoPollStatus().Error).To(BeEmpty())
+			Expect(agentStore.GetSysInfoPollStatus().LastSucceeded.After(lastSucceeded)).To(BeTrue())
+		})
+
+		It("should not call libvirt when all the guest info is denied", func() {
+			mockLibvirt.VirtConnection.SetGuestAgentCommandPolicy(agentpolicy.New(&v1.GuestAgentCommandsConfiguration{
+				AllowedCommands: []string{"guest-ping"},
+			}))
+			agentPoller := &AgentPoller{
+				Connection: mockLibvirt.VirtConnection,
+				domainName: "fake",
+				agentStore: &agentStore,
+			}
+
+			Expect(fetchAndStoreGuestInfo(libvirt.DOMAIN_GUEST_INFO_USERS, agentPoller)).To(Succeed())
+		})
+	})
+
+	Context("refreshing guest info", func() {
+		It("should poll the guest agent right away", func() {
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"guest-info"}`, "fake").Return(`{"return":{"version":"4.1"}}`, nil)
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"guest-get-fsinfo"}`, "fake").Return(`{"return":[]}`, nil)
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-status"}`, "fake").Return(`{"return":"thawed"}`, nil)
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().GetGuestInfo(gomock.Any(), uint32(0)).Return(&libvirt.DomainGuestInfo{
+				OS: &libvirt.DomainGuestInfoOS{Name: "fedora"},
+			}, nil)
+
+			Expect(RefreshGuestInfo(mockLibvirt.VirtConnection, "fake", &agentStore)).To(Succeed())
+
+			Expect(agentStore.GetGA().Version).To(Equal("4.1"))
+			Expect(agentStore.GetGuestOSInfo().Name).To(Equal("fedora"))
+		})
+
+		It("should fail if the guest info can't be fetched", func() {
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(gomock.Any(), "fake").Return("", errors.New("agent not connected")).Times(3)
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().GetGuestInfo(gomock.Any(), uint32(0)).Return(nil, errors.New("agent not connected"))
+
+			Expect(RefreshGuestInfo(mockLibvirt.VirtConnection, "fake", &agentStore)).To(MatchError(ContainSubstring("agent not connected")))
+		})
 	})
 
 	Context("with AsyncAgentStore", func() {
@@ -4,8 +4,11 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
+	"kubevirt.io/client-go/log"
+
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
 
@@ -23,6 +26,25 @@ type execStatusReturnData struct {
 	Exited   bool   `json:"exited"`
 	ExitCode int    `json:"exitcode"`
 	OutData  string `json:"out-data"`
+	ErrData  string `json:"err-data"`
+}
+
+type execArguments struct {
+	Path          string   `json:"path"`
+	Arg           []string `json:"arg"`
+	CaptureOutput bool     `json:"capture-output"`
+}
+
+type execStatusArguments struct {
+	Pid int64 `json:"pid"`
+}
+
+// GuestExecStatus is the state of a command started in the guest with GuestExecs.
+type GuestExecStatus struct {
+	Exited   bool
+	ExitCode int
+	Stdout   []byte
+	Stderr   []byte
 }
 
 // ExecExitCode returned at non-zero return codes
@@ -104,3 +126,193 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 
 	return stdOut, nil
 }
+
+const (
+	// guestExecRedirect replaces the shell with the command after redirecting the output of the command to files
+	// named after the pid of the shell, which is thus the pid returned by guest-exec
+	guestExecRedirect = `exec "$0" "$@" >/tmp/kubevirt-guest-exec-$$.stdout 2>/tmp/kubevirt-guest-exec-$$.stderr`
+	// guestExecOutputChunkSize is the number of bytes of each stream returned per status of a running command
+	guestExecOutputChunkSize = 1024 * 1024
+	// guestExecCleanupTimeoutSeconds is how long to wait for the removal of the output files of a command
+	guestExecCleanupTimeoutSeconds = 5
+)
+
+// guestExecFileCommands are the guest agent commands reading the output files of a command while it runs
+var guestExecFileCommands = []string{"guest-file-open", "guest-file-seek", "guest-file-read", "guest-file-close"}
+
+// GuestExecs starts commands in the guest and returns their output while they run. The output of a command is
+// redirected to files in the guest, which are read from where the previous status stopped. Commands fall back to
+// the output captured by the guest agent, only returned once the command exited, if the guest has no /bin/sh,
+// e.g. on Windows, or if the guest agent command policy does not allow reading files.
+// The zero value is ready to use.
+type GuestExecs struct {
+	lock    sync.Mutex
+	outputs map[int64]*guestExecOutput
+}
+
+type guestExecOutput struct {
+	stdoutOffset int64
+	stderrOffset int64
+	// exited is set once the guest agent reported the exit, which it reports only once
+	exited   bool
+	exitCode int
+}
+
+// Start starts the provided command in the guest through the guest agent without waiting for it, and returns the
+// pid to poll with Status.
+func (g *GuestExecs) Start(virConn cli.Connection, domName string, command string, args []string) (int64, error) {
+	policy := virConn.GuestAgentCommandPolicy()
+	for _, fileCommand := range guestExecFileCommands {
+		if !policy.Allows(fileCommand) {
+			return guestExecStart(virConn, domName, execArguments{Path: command, Arg: args, CaptureOutput: true})
+		}
+	}
+
+	pid, err := guestExecStart(virConn, domName, execArguments{Path: "/bin/sh", Arg: append([]string{"-c", guestExecRedirect, command}, args...)})
+	if err != nil {
+		return guestExecStart(virConn, domName, execArguments{Path: command, Arg: args, CaptureOutput: true})
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.outputs == nil {
+		g.outputs = map[int64]*guestExecOutput{}
+	}
+	g.outputs[pid] = &guestExecOutput{}
+	return pid, nil
+}
+
+// Status returns the output written by a command started with Start since the previous status. The exit is only
+// reported once all the output written before it was returned, the command is forgotten afterwards.
+func (g *GuestExecs) Status(virConn cli.Connection, domName string, pid int64) (*GuestExecStatus, error) {
+	g.lock.Lock()
+	output, redirected := g.outputs[pid]
+	g.lock.Unlock()
+	if !redirected {
+		return guestExecStatus(virConn, domName, pid)
+	}
+
+	// the exit is checked before reading, so that all the output is read once it is reported
+	if !output.exited {
+		status, err := guestExecStatus(virConn, domName, pid)
+		if err != nil {
+			g.forget(pid)
+			return nil, err
+		}
+		output.exited = status.Exited
+		output.exitCode = status.ExitCode
+	}
+
+	status := &GuestExecStatus{}
+	stdoutPath, stderrPath := guestExecOutputPath(pid, "stdout"), guestExecOutputPath(pid, "stderr")
+	var stdoutEOF, stderrEOF bool
+	var err error
+	if status.Stdout, stdoutEOF, err = readGuestExecOutput(virConn, domName, stdoutPath, &output.stdoutOffset); err != nil {
+		g.forget(pid)
+		return nil, err
+	}
+	if status.Stderr, stderrEOF, err = readGuestExecOutput(virConn, domName, stderrPath, &output.stderrOffset); err != nil {
+		g.forget(pid)
+		return nil, err
+	}
+
+	if output.exited && stdoutEOF && stderrEOF {
+		status.Exited = true
+		status.ExitCode = output.exitCode
+		g.forget(pid)
+		go func() {
+			if _, err := GuestExec(virConn, domName, "rm", []string{"-f", stdoutPath, stderrPath}, guestExecCleanupTimeoutSeconds); err != nil {
+				log.Log.Reason(err).Warningf("Failed to remove the output files of guest command with pid %d", pid)
+			}
+		}()
+	}
+	return status, nil
+}
+
+func (g *GuestExecs) forget(pid int64) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	delete(g.outputs, pid)
+}
+
+func guestExecOutputPath(pid int64, stream string) string {
+	return fmt.Sprintf("/tmp/kubevirt-guest-exec-%d.%s", pid, stream)
+}
+
+// readGuestExecOutput reads at most guestExecOutputChunkSize bytes of the output file from the offset, which is
+// moved past the returned bytes. A missing file is empty, the shell may not have created it yet, or failed to.
+func readGuestExecOutput(virConn cli.Connection, domName string, path string, offset *int64) (content []byte, eof bool, err error) {
+	handle, err := guestFileOpen(virConn, domName, path, "r")
+	if err != nil {
+		return nil, true, nil
+	}
+	defer func() {
+		if closeErr := guestFileClose(virConn, domName, handle); err == nil {
+			err = closeErr
+		}
+	}()
+
+	if err := guestFileSeek(virConn, domName, handle, *offset); err != nil {
+		return nil, false, err
+	}
+	for len(content) < guestExecOutputChunkSize {
+		chunk, chunkEOF, err := guestFileReadChunk(virConn, domName, handle, min(guestFileReadChunkSize, guestExecOutputChunkSize-len(content)))
+		if err != nil {
+			return nil, false, err
+		}
+		content = append(content, chunk...)
+		*offset += int64(len(chunk))
+		if chunkEOF {
+			return content, true, nil
+		}
+	}
+	return content, false, nil
+}
+
+func guestExecStart(virConn cli.Connection, domName string, arguments execArguments) (int64, error) {
+	cmdExec, err := json.Marshal(agentCommand{Execute: "guest-exec", Arguments: arguments})
+	if err != nil {
+		return 0, err
+	}
+	output, err := virConn.QemuAgentCommand(string(cmdExec), domName)
+	if err != nil {
+		return 0, err
+	}
+	execRes := &execReturn{}
+	if err := json.Unmarshal([]byte(output), execRes); err != nil {
+		return 0, err
+	}
+	if execRes.Return.Pid <= 0 {
+		return 0, fmt.Errorf("invalid pid [%d] returned from qemu agent: %s", execRes.Return.Pid, output)
+	}
+	return int64(execRes.Return.Pid), nil
+}
+
+// guestExecStatus returns the state of a command started with guest-exec. The guest agent only returns the
+// captured output once the command exited, and forgets the command after reporting it.
+func guestExecStatus(virConn cli.Connection, domName string, pid int64) (*GuestExecStatus, error) {
+	cmdStatus, err := json.Marshal(agentCommand{Execute: "guest-exec-status", Arguments: execStatusArguments{Pid: pid}})
+	if err != nil {
+		return nil, err
+	}
+	output, err := virConn.QemuAgentCommand(string(cmdStatus), domName)
+	if err != nil {
+		return nil, err
+	}
+	statusRes := &execStatusReturn{}
+	if err := json.Unmarshal([]byte(output), statusRes); err != nil {
+		return nil, err
+	}
+
+	status := &GuestExecStatus{
+		Exited:   statusRes.Return.Exited,
+		ExitCode: statusRes.Return.ExitCode,
+	}
+	if status.Stdout, err = base64.StdEncoding.DecodeString(statusRes.Return.OutData); err != nil {
+		return nil, err
+	}
+	if status.Stderr, err = base64.StdEncoding.DecodeString(statusRes.Return.ErrData); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
@@ -0,0 +1,196 @@
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// guestFileReadChunkSize is the number of bytes read per guest-file-read command, the agent
+// defaults to 4KiB.
+const guestFileReadChunkSize = 64 * 1024
+
+type fileOpenReturn struct {
+	Return int `json:"return"`
+}
+
+type fileReadReturn struct {
+	Return fileReadReturnData `json:"return"`
+}
+type fileReadReturnData struct {
+	Count  int    `json:"count"`
+	BufB64 string `json:"buf-b64"`
+	EOF    bool   `json:"eof"`
+}
+
+type fileWriteReturn struct {
+	Return fileWriteReturnData `json:"return"`
+}
+type fileWriteReturnData struct {
+	Count int `json:"count"`
+}
+
+type agentCommand struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments"`
+}
+
+type fileOpenArguments struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+type fileCloseArguments struct {
+	Handle int `json:"handle"`
+}
+
+type fileSeekArguments struct {
+	Handle int    `json:"handle"`
+	Offset int64  `json:"offset"`
+	Whence string `json:"whence"`
+}
+
+type fileReadArguments struct {
+	Handle int `json:"handle"`
+	Count  int `json:"count"`
+}
+
+type fileWriteArguments struct {
+	Handle int    `json:"handle"`
+	BufB64 string `json:"buf-b64"`
+}
+
+// GuestFileExists checks through the guest agent that the provided path can be opened for reading in the guest.
+// An error is returned if the file does not exist or the guest agent can't be reached.
+func GuestFileExists(virConn cli.Connection, domName string, path string) error {
+	handle, err := guestFileOpen(virConn, domName, path, "r")
+	if err != nil {
+		return err
+	}
+	return guestFileClose(virConn, domName, handle)
+}
+
+// GuestFileRead reads the content of the provided path in the guest through the guest agent.
+// An error is returned if the file is larger than maxBytes.
+func GuestFileRead(virConn cli.Connection, domName string, path string, maxBytes int) (content []byte, err error) {
+	handle, err := guestFileOpen(virConn, domName, path, "r")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := guestFileClose(virConn, domName, handle); err == nil {
+			err = closeErr
+		}
+	}()
+
+	for {
+		// read one byte past the limit to detect larger files
+		chunk, eof, err := guestFileReadChunk(virConn, domName, handle, min(guestFileReadChunkSize, maxBytes+1-len(content)))
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, chunk...)
+		if len(content) > maxBytes {
+			return nil, fmt.Errorf("file %s is larger than %d bytes", path, maxBytes)
+		}
+		if eof {
+			return content, nil
+		}
+	}
+}
+
+// GuestFileWrite replaces the content of the provided path in the guest through the guest agent.
+// The file is created if it does not exist.
+func GuestFileWrite(virConn cli.Connection, domName string, path string, content []byte) error {
+	handle, err := guestFileOpen(virConn, domName, path, "w")
+	if err != nil {
+		return err
+	}
+
+	writeErr := guestFileWriteContent(virConn, domName, handle, path, content)
+	closeErr := guestFileClose(virConn, domName, handle)
+	if writeErr != nil {
+		return writeErr
+	}
+	return closeErr
+}
+
+func guestFileWriteContent(virConn cli.Connection, domName string, handle int, path string, content []byte) error {
+	cmdWrite, err := json.Marshal(agentCommand{Execute: "guest-file-write", Arguments: fileWriteArguments{Handle: handle, BufB64: base64.StdEncoding.EncodeToString(content)}})
+	if err != nil {
+		return fmt.Errorf("failed to encode the guest-file-write command for %s: %v", path, err)
+	}
+	output, err := virConn.QemuAgentCommand(string(cmdWrite), domName)
+	if err != nil {
+		return err
+	}
+	writeRes := &fileWriteReturn{}
+	if err := json.Unmarshal([]byte(output), writeRes); err != nil {
+		return err
+	}
+	if writeRes.Return.Count != len(content) {
+		return fmt.Errorf("only %d of %d bytes were written to %s", writeRes.Return.Count, len(content), path)
+	}
+	return nil
+}
+
+func guestFileOpen(virConn cli.Connection, domName string, path string, mode string) (int, error) {
+	cmdOpen, err := json.Marshal(agentCommand{Execute: "guest-file-open", Arguments: fileOpenArguments{Path: path, Mode: mode}})
+	if err != nil {
+		return 0, err
+	}
+	output, err := virConn.QemuAgentCommand(string(cmdOpen), domName)
+	if err != nil {
+		return 0, err
+	}
+	openRes := &fileOpenReturn{}
+	if err := json.Unmarshal([]byte(output), openRes); err != nil {
+		return 0, err
+	}
+	if openRes.Return < 0 {
+		return 0, fmt.Errorf("Invalid file handle [%d] returned from qemu agent when opening %s: %s", openRes.Return, path, output)
+	}
+	return openRes.Return, nil
+}
+
+func guestFileClose(virConn cli.Connection, domName string, handle int) error {
+	cmdClose, err := json.Marshal(agentCommand{Execute: "guest-file-close", Arguments: fileCloseArguments{Handle: handle}})
+	if err != nil {
+		return err
+	}
+	_, err = virConn.QemuAgentCommand(string(cmdClose), domName)
+	return err
+}
+
+// guestFileReadChunk reads at most count bytes from the current position of the file, eof is set when the end of
+// the file was reached
+func guestFileReadChunk(virConn cli.Connection, domName string, handle int, count int) (chunk []byte, eof bool, err error) {
+	cmdRead, err := json.Marshal(agentCommand{Execute: "guest-file-read", Arguments: fileReadArguments{Handle: handle, Count: count}})
+	if err != nil {
+		return nil, false, err
+	}
+	output, err := virConn.QemuAgentCommand(string(cmdRead), domName)
+	if err != nil {
+		return nil, false, err
+	}
+	readRes := &fileReadReturn{}
+	if err := json.Unmarshal([]byte(output), readRes); err != nil {
+		return nil, false, err
+	}
+	if chunk, err = base64.StdEncoding.DecodeString(readRes.Return.BufB64); err != nil {
+		return nil, false, err
+	}
+	return chunk, readRes.Return.EOF || readRes.Return.Count == 0, nil
+}
+
+// guestFileSeek moves the position of the file to the offset from its start
+func guestFileSeek(virConn cli.Connection, domName string, handle int, offset int64) error {
+	cmdSeek, err := json.Marshal(agentCommand{Execute: "guest-file-seek", Arguments: fileSeekArguments{Handle: handle, Offset: offset, Whence: "set"}})
+	if err != nil {
+		return err
+	}
+	_, err = virConn.QemuAgentCommand(string(cmdSeek), domName)
+	return err
+}
@@ -667,6 +667,22 @@ func (in *Clock) DeepCopy() *Clock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInitMetadata) DeepCopyInto(out *CloudInitMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInitMetadata.
+func (in *CloudInitMetadata) DeepCopy() *CloudInitMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInitMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Commandline) DeepCopyInto(out *Commandline) {
 	*out = *in
@@ -2041,6 +2057,11 @@ func (in *FilesystemDevice) DeepCopyInto(out *FilesystemDevice) {
 		*out = new(FilesystemBinary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(Alias)
+		**out = **in
+	}
 	return
 }
 
@@ -2600,6 +2621,16 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CloudInit != nil {
+		in, out := &in.CloudInit, &out.CloudInit
+		*out = new(CloudInitMetadata)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(SuspendMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2853,6 +2884,11 @@ func (in *MemoryBacking) DeepCopyInto(out *MemoryBacking) {
 		*out = new(NoSharePages)
 		**out = **in
 	}
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(MemoryLocked)
+		**out = **in
+	}
 	return
 }
 
@@ -2954,6 +2990,22 @@ func (in *MemoryDumpMetadata) DeepCopy() *MemoryDumpMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryLocked) DeepCopyInto(out *MemoryLocked) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryLocked.
+func (in *MemoryLocked) DeepCopy() *MemoryLocked {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryLocked)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryTarget) DeepCopyInto(out *MemoryTarget) {
 	*out = *in
@@ -3143,6 +3195,27 @@ func (in *NumaTuneMemory) DeepCopy() *NumaTuneMemory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OEMStrings) DeepCopyInto(out *OEMStrings) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OEMStrings.
+func (in *OEMStrings) DeepCopy() *OEMStrings {
+	if in == nil {
+		return nil
+	}
+	out := new(OEMStrings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OS) DeepCopyInto(out *OS) {
 	*out = *in
@@ -3723,6 +3796,26 @@ func (in *Stats) DeepCopy() *Stats {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuspendMetadata) DeepCopyInto(out *SuspendMetadata) {
+	*out = *in
+	if in.FailedTimestamp != nil {
+		in, out := &in.FailedTimestamp, &out.FailedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuspendMetadata.
+func (in *SuspendMetadata) DeepCopy() *SuspendMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SuspendMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyNICTimer) DeepCopyInto(out *SyNICTimer) {
 	*out = *in
@@ -3767,6 +3860,11 @@ func (in *SysInfo) DeepCopyInto(out *SysInfo) {
 		*out = make([]Entry, len(*in))
 		copy(*out, *in)
 	}
+	if in.OEMStrings != nil {
+		in, out := &in.OEMStrings, &out.OEMStrings
+		*out = new(OEMStrings)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -135,6 +135,8 @@ type GuestPanicInfo struct {
 	Arg3 uint64
 	Arg4 uint64
 	Arg5 uint64
+	// ConsoleLog holds the last lines of the serial console log, if they are captured
+	ConsoleLog string
 }
 
 type DomainSysInfo struct {
@@ -178,6 +180,10 @@ type FSFreeze struct {
 type FSDisk struct {
 	Serial  string
 	BusType string
+	// PCIAddress of the disk, or of its controller for SCSI disks
+	PCIAddress string
+	Target     int
+	Unit       int
 }
 
 type Filesystem struct {
@@ -238,10 +244,12 @@ type DomainSpec struct {
 	IOThreads      *IOThreads      `xml:"iothreads,omitempty"`
 	LaunchSecurity *LaunchSecurity `xml:"launchSecurity,omitempty"`
 	OnReboot       string          `xml:"on_reboot,omitempty"`
+	OnCrash        string          `xml:"on_crash,omitempty"`
 }
 
 const DomainOnRebootDestroy = "destroy"
 const DomainOnRebootRestart = "restart"
+const DomainOnCrashPreserve = "preserve"
 
 type CPUTune struct {
 	VCPUPin     []CPUTuneVCPUPin     `xml:"vcpupin"`
@@ -419,6 +427,8 @@ type KubeVirtMetadata struct {
 	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	CloudInit        *CloudInitMetadata        `xml:"cloudInit,omitempty"`
+	Suspend          *SuspendMetadata          `xml:"suspend,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -426,6 +436,20 @@ type AccessCredentialMetadata struct {
 	Message   string `xml:"message,omitempty"`
 }
 
+// CloudInitMetadata reports the outcome of the last rotation or ejection of the cloud-init data
+type CloudInitMetadata struct {
+	Reason    string `xml:"reason,omitempty"`
+	Succeeded bool   `xml:"succeeded,omitempty"`
+	Message   string `xml:"message,omitempty"`
+}
+
+// SuspendMetadata reports the outcome of the last save of the guest memory to disk
+type SuspendMetadata struct {
+	Failed          bool         `xml:"failed,omitempty"`
+	FailureReason   string       `xml:"failureReason,omitempty"`
+	FailedTimestamp *metav1.Time `xml:"failedTimestamp,omitempty"`
+}
+
 type MemoryDumpMetadata struct {
 	FileName       string       `xml:"fileName,omitempty"`
 	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
@@ -564,6 +588,7 @@ type MemoryBacking struct {
 	Access       *MemoryBackingAccess `xml:"access,omitempty"`
 	Allocation   *MemoryAllocation    `xml:"allocation,omitempty"`
 	NoSharePages *NoSharePages        `xml:"nosharepages,omitempty"`
+	Locked       *MemoryLocked        `xml:"locked,omitempty"`
 }
 
 type MemoryAllocationMode string
@@ -599,6 +624,9 @@ type MemoryBackingAccess struct {
 type NoSharePages struct {
 }
 
+type MemoryLocked struct {
+}
+
 type MemoryAddress struct {
 	Base string `xml:"base,attr"`
 }
@@ -612,6 +640,8 @@ type MemoryTarget struct {
 	Address   *MemoryAddress `xml:"address,omitempty"`
 }
 
+const MemoryDeviceModelVirtioMem = "virtio-mem"
+
 type MemoryDevice struct {
 	XMLName xml.Name      `xml:"memory"`
 	Model   string        `xml:"model,attr"`
@@ -679,6 +709,7 @@ type FilesystemDevice struct {
 	Target     *FilesystemTarget `xml:"target,omitempty"`
 	Driver     *FilesystemDriver `xml:"driver,omitempty"`
 	Binary     *FilesystemBinary `xml:"binary,omitempty"`
+	Alias      *Alias            `xml:"alias,omitempty"`
 }
 
 type FilesystemTarget struct {
@@ -1171,11 +1202,17 @@ type BIOS struct {
 }
 
 type SysInfo struct {
-	Type      string  `xml:"type,attr"`
-	System    []Entry `xml:"system>entry"`
-	BIOS      []Entry `xml:"bios>entry"`
-	BaseBoard []Entry `xml:"baseBoard>entry"`
-	Chassis   []Entry `xml:"chassis>entry"`
+	Type       string      `xml:"type,attr"`
+	System     []Entry     `xml:"system>entry"`
+	BIOS       []Entry     `xml:"bios>entry"`
+	BaseBoard  []Entry     `xml:"baseBoard>entry"`
+	Chassis    []Entry     `xml:"chassis>entry"`
+	OEMStrings *OEMStrings `xml:"oemStrings,omitempty"`
+}
+
+// OEMStrings is a pointer in SysInfo, libvirt rejects an oemStrings element without entries
+type OEMStrings struct {
+	Entries []string `xml:"entry"`
 }
 
 type Entry struct {
@@ -1207,6 +1244,7 @@ type Clock struct {
 	Offset     string  `xml:"offset,attr,omitempty"`
 	Timezone   string  `xml:"timezone,attr,omitempty"`
 	Adjustment string  `xml:"adjustment,attr,omitempty"`
+	Basis      string  `xml:"basis,attr,omitempty"`
 	Timer      []Timer `xml:"timer,omitempty"`
 }
 
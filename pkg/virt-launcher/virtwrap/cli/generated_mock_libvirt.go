@@ -16,6 +16,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 	libvirt "libvirt.org/go/libvirt"
 
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
@@ -172,6 +173,20 @@ func (mr *MockConnectionMockRecorder) DomainEventMemoryDeviceSizeChangeRegister(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventMemoryDeviceSizeChangeRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventMemoryDeviceSizeChangeRegister), callback)
 }
 
+// DomainRestoreFlags mocks base method.
+func (m *MockConnection) DomainRestoreFlags(srcFile, xmlConf string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainRestoreFlags", srcFile, xmlConf, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainRestoreFlags indicates an expected call of DomainRestoreFlags.
+func (mr *MockConnectionMockRecorder) DomainRestoreFlags(srcFile, xmlConf, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainRestoreFlags", reflect.TypeOf((*MockConnection)(nil).DomainRestoreFlags), srcFile, xmlConf, flags)
+}
+
 // GetAllDomainStats mocks base method.
 func (m *MockConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +202,21 @@ func (mr *MockConnectionMockRecorder) GetAllDomainStats(statsTypes, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDomainStats", reflect.TypeOf((*MockConnection)(nil).GetAllDomainStats), statsTypes, flags)
 }
 
+// GetDomainCapabilities mocks base method.
+func (m *MockConnection) GetDomainCapabilities(emulatorbin, arch, machine, virttype string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainCapabilities", emulatorbin, arch, machine, virttype)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainCapabilities indicates an expected call of GetDomainCapabilities.
+func (mr *MockConnectionMockRecorder) GetDomainCapabilities(emulatorbin, arch, machine, virttype any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainCapabilities", reflect.TypeOf((*MockConnection)(nil).GetDomainCapabilities), emulatorbin, arch, machine, virttype)
+}
+
 // GetDomainDirtyRate mocks base method.
 func (m *MockConnection) GetDomainDirtyRate(calculationDuration time.Duration, flags libvirt.DomainDirtyRateCalcFlags) ([]*stats.DomainStatsDirtyRate, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +277,20 @@ func (mr *MockConnectionMockRecorder) GetSEVInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockConnection)(nil).GetSEVInfo))
 }
 
+// GuestAgentCommandPolicy mocks base method.
+func (m *MockConnection) GuestAgentCommandPolicy() *agentpolicy.Policy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestAgentCommandPolicy")
+	ret0, _ := ret[0].(*agentpolicy.Policy)
+	return ret0
+}
+
+// GuestAgentCommandPolicy indicates an expected call of GuestAgentCommandPolicy.
+func (mr *MockConnectionMockRecorder) GuestAgentCommandPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestAgentCommandPolicy", reflect.TypeOf((*MockConnection)(nil).GuestAgentCommandPolicy))
+}
+
 // ListAllDomains mocks base method.
 func (m *MockConnection) ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +351,18 @@ func (mr *MockConnectionMockRecorder) QemuAgentCommand(command, domainName any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuAgentCommand", reflect.TypeOf((*MockConnection)(nil).QemuAgentCommand), command, domainName)
 }
 
+// SetGuestAgentCommandPolicy mocks base method.
+func (m *MockConnection) SetGuestAgentCommandPolicy(policy *agentpolicy.Policy) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetGuestAgentCommandPolicy", policy)
+}
+
+// SetGuestAgentCommandPolicy indicates an expected call of SetGuestAgentCommandPolicy.
+func (mr *MockConnectionMockRecorder) SetGuestAgentCommandPolicy(policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuestAgentCommandPolicy", reflect.TypeOf((*MockConnection)(nil).SetGuestAgentCommandPolicy), policy)
+}
+
 // SetReconnectChan mocks base method.
 func (m *MockConnection) SetReconnectChan(reconnect chan bool) {
 	m.ctrl.T.Helper()
@@ -759,6 +815,21 @@ func (mr *MockVirDomainMockRecorder) GetUUIDString() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUUIDString", reflect.TypeOf((*MockVirDomain)(nil).GetUUIDString))
 }
 
+// GetVcpusFlags mocks base method.
+func (m *MockVirDomain) GetVcpusFlags(flags libvirt.DomainVcpuFlags) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVcpusFlags", flags)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVcpusFlags indicates an expected call of GetVcpusFlags.
+func (mr *MockVirDomainMockRecorder) GetVcpusFlags(flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVcpusFlags", reflect.TypeOf((*MockVirDomain)(nil).GetVcpusFlags), flags)
+}
+
 // GetXMLDesc mocks base method.
 func (m *MockVirDomain) GetXMLDesc(flags libvirt.DomainXMLFlags) (string, error) {
 	m.ctrl.T.Helper()
@@ -916,6 +987,20 @@ func (mr *MockVirDomainMockRecorder) Resume() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirDomain)(nil).Resume))
 }
 
+// SaveFlags mocks base method.
+func (m *MockVirDomain) SaveFlags(destFile, destXml string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFlags", destFile, destXml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFlags indicates an expected call of SaveFlags.
+func (mr *MockVirDomainMockRecorder) SaveFlags(destFile, destXml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFlags", reflect.TypeOf((*MockVirDomain)(nil).SaveFlags), destFile, destXml, flags)
+}
+
 // Screenshot mocks base method.
 func (m *MockVirDomain) Screenshot(stream *libvirt.Stream, screen, flags uint32) (string, error) {
 	m.ctrl.T.Helper()
@@ -945,6 +1030,20 @@ func (mr *MockVirDomainMockRecorder) SetLaunchSecurityState(params, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLaunchSecurityState", reflect.TypeOf((*MockVirDomain)(nil).SetLaunchSecurityState), params, flags)
 }
 
+// SetMemoryFlags mocks base method.
+func (m *MockVirDomain) SetMemoryFlags(memory uint64, flags libvirt.DomainMemoryModFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemoryFlags", memory, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemoryFlags indicates an expected call of SetMemoryFlags.
+func (mr *MockVirDomainMockRecorder) SetMemoryFlags(memory, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemoryFlags", reflect.TypeOf((*MockVirDomain)(nil).SetMemoryFlags), memory, flags)
+}
+
 // SetTime mocks base method.
 func (m *MockVirDomain) SetTime(secs int64, nsecs uint, flags libvirt.DomainSetTimeFlags) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const cloudInitCommandTimeoutSeconds = 300
+
+// cloudInitRerunCommands re-apply the credentials of the rotated cloud-init data in the guest.
+// The cached datasource is dropped, so that the first cloud-init single run reads the new disk.
+// The instance id does not change, cloud-init does not consider the guest as a new instance:
+// only the listed modules run again, the per-instance ones, e.g. the generation of the ssh host
+// keys, are not repeated.
+var cloudInitRerunCommands = [][]string{
+	{"rm", "-f", "/var/lib/cloud/instance/obj.pkl"},
+	{"cloud-init", "single", "--name", "users_groups", "--frequency", "always"},
+	{"cloud-init", "single", "--name", "set_passwords", "--frequency", "always"},
+	{"cloud-init", "single", "--name", "write_files", "--frequency", "always"},
+}
+
+// RotateCloudInit regenerates the cloud-init disk from the current content of the cloud-init volume,
+// including the referenced secrets, and swaps it in the guest. The cloud-init modules handling
+// credentials are then re-run in the guest through the guest agent, the outcome is reported in the
+// domain metadata.
+func (l *LibvirtDomainManager) RotateCloudInit(vmi *v1.VirtualMachineInstance) error {
+	select {
+	case l.cloudInitRotationInProgress <- struct{}{}:
+	default:
+		return fmt.Errorf("a cloud-init rotation is already in progress")
+	}
+
+	domName, err := l.rotateCloudInitDisk(vmi)
+	if err != nil {
+		<-l.cloudInitRotationInProgress
+		return err
+	}
+
+	go func() {
+		defer func() { <-l.cloudInitRotationInProgress }()
+
+		result := api.CloudInitMetadata{
+			Reason:    v1.VirtualMachineInstanceReasonCloudInitRotated,
+			Succeeded: true,
+		}
+		if err := l.rerunCloudInit(domName); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Re-running cloud-init in the guest failed.")
+			result.Succeeded = false
+			result.Message = err.Error()
+		} else {
+			log.Log.Object(vmi).Info("cloud-init re-ran in the guest")
+		}
+		l.metadataCache.CloudInit.Store(result)
+	}()
+	return nil
+}
+
+func (l *LibvirtDomainManager) rotateCloudInitDisk(vmi *v1.VirtualMachineInstance) (string, error) {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := util.VMINamespaceKeyFunc(vmi)
+	dom, err := l.lookupCloudInitDomain(vmi, domName)
+	if err != nil {
+		return "", err
+	}
+	defer dom.Free()
+
+	spec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return "", err
+	}
+
+	// secrets are resolved into the volumes of the VMI, do not alter the one of the caller
+	vmi = vmi.DeepCopy()
+	cloudInitData, err := cloudinit.ReadCloudInitVolumeDataSource(vmi, config.SecretSourceDir)
+	if err != nil {
+		return "", fmt.Errorf("ReadCloudInitVolumeDataSource failed: %v", err)
+	}
+	if cloudInitData == nil {
+		return "", fmt.Errorf("the VMI has no cloud-init volume")
+	}
+	disk := findCloudInitCDRom(spec, cloudInitData.VolumeName)
+	if disk == nil {
+		return "", fmt.Errorf("cloud-init volume %s is not attached as a cd-rom", cloudInitData.VolumeName)
+	}
+
+	l.cloudInitDataStore = cloudInitData
+	if err := l.generateCloudInitISO(vmi, &dom); err != nil {
+		return "", err
+	}
+
+	// libvirt ignores a media change to the same path, eject the old media before inserting the new one
+	source := disk.Source
+	if err := updateCDRomSource(dom, *disk, api.DiskSource{}); err != nil {
+		return "", err
+	}
+	if source.File == "" {
+		source.File = cloudinit.GetIsoFilePath(cloudInitData.DataSource, vmi.Name, vmi.Namespace)
+	}
+	if err := updateCDRomSource(dom, *disk, source); err != nil {
+		return "", err
+	}
+	log.Log.Object(vmi).Infof("Rotated the cloud-init disk %s", cloudInitData.VolumeName)
+	return domName, nil
+}
+
+func (l *LibvirtDomainManager) rerunCloudInit(domName string) error {
+	for _, command := range cloudInitRerunCommands {
+		if _, err := agent.GuestExec(l.virConn, domName, command[0], command[1:], cloudInitCommandTimeoutSeconds); err != nil {
+			return fmt.Errorf("%s: %v", strings.Join(command, " "), err)
+		}
+	}
+	return nil
+}
+
+// EjectCloudInit removes the media of the cloud-init disk, so that the cloud-init data, including
+// credentials, is not readable from the guest anymore. The media stays ejected until the VMI restarts
+// or the cloud-init data is rotated.
+func (l *LibvirtDomainManager) EjectCloudInit(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := util.VMINamespaceKeyFunc(vmi)
+	dom, err := l.lookupCloudInitDomain(vmi, domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	spec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return err
+	}
+
+	volumeName := cloudInitVolumeName(vmi)
+	if volumeName == "" {
+		return fmt.Errorf("the VMI has no cloud-init volume")
+	}
+	disk := findCloudInitCDRom(spec, volumeName)
+	if disk == nil {
+		return fmt.Errorf("cloud-init volume %s is not attached as a cd-rom", volumeName)
+	}
+
+	if err := updateCDRomSource(dom, *disk, api.DiskSource{}); err != nil {
+		return err
+	}
+	log.Log.Object(vmi).Infof("Ejected the cloud-init disk %s", volumeName)
+
+	l.metadataCache.CloudInit.Store(api.CloudInitMetadata{
+		Reason:    v1.VirtualMachineInstanceReasonCloudInitEjected,
+		Succeeded: true,
+	})
+	return nil
+}
+
+func (l *LibvirtDomainManager) lookupCloudInitDomain(vmi *v1.VirtualMachineInstance, domName string) (cli.VirDomain, error) {
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		if domainerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("Domain not found.")
+		}
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return nil, err
+	}
+	return dom, nil
+}
+
+func cloudInitVolumeName(vmi *v1.VirtualMachineInstance) string {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.CloudInitNoCloud != nil || volume.CloudInitConfigDrive != nil {
+			return volume.Name
+		}
+	}
+	return ""
+}
+
+func findCloudInitCDRom(spec *api.DomainSpec, volumeName string) *api.Disk {
+	for i, disk := range spec.Devices.Disks {
+		if disk.Alias.GetName() == volumeName && disk.Device == "cdrom" {
+			return &spec.Devices.Disks[i]
+		}
+	}
+	return nil
+}
+
+func updateCDRomSource(dom cli.VirDomain, disk api.Disk, source api.DiskSource) error {
+	disk.Source = source
+	disk.Type = "block"
+	if source.File != "" {
+		disk.Type = "file"
+	}
+	updateBytes, err := xml.Marshal(disk)
+	if err != nil {
+		return err
+	}
+	if err := dom.UpdateDeviceFlags(string(updateBytes), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+		return fmt.Errorf("updating the media of disk %s failed: %v", disk.Alias.GetName(), err)
+	}
+	return nil
+}
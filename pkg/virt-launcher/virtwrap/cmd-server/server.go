@@ -24,6 +24,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
@@ -39,6 +41,7 @@ import (
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	notifyclient "kubevirt.io/kubevirt/pkg/virt-launcher/notify-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap"
@@ -59,6 +62,7 @@ type ServerOptions struct {
 	vmiName                 string
 	vmiNamespace            string
 	vmiUID                  types.UID
+	logVerbosity            int
 }
 
 func NewServerOptions(allowEmulation bool) *ServerOptions {
@@ -75,6 +79,13 @@ func (o *ServerOptions) WithVMStatsCollector(enabled bool) *ServerOptions {
 	return o
 }
 
+// WithLogVerbosity sets the verbosity virt-launcher started with, which is restored
+// once the log verbosity label is removed from the VMI
+func (o *ServerOptions) WithLogVerbosity(verbosity int) *ServerOptions {
+	o.logVerbosity = verbosity
+	return o
+}
+
 func (o *ServerOptions) WithVMI(vmi *v1.VirtualMachineInstance) *ServerOptions {
 	if vmi != nil {
 		o.vmiName = vmi.Name
@@ -87,13 +98,44 @@ func (o *ServerOptions) WithVMI(vmi *v1.VirtualMachineInstance) *ServerOptions {
 type Launcher struct {
 	domainManager virtwrap.DomainManager
 	*ServerOptions
+
+	logVerbosityLock    sync.Mutex
+	appliedLogVerbosity int
 }
 
 func NewLauncher(domainManager virtwrap.DomainManager, options *ServerOptions) *Launcher {
 	return &Launcher{
-		domainManager: domainManager,
-		ServerOptions: options,
+		domainManager:       domainManager,
+		ServerOptions:       options,
+		appliedLogVerbosity: options.logVerbosity,
+	}
+}
+
+// syncLogVerbosity applies the log verbosity label of the VMI, or else the virt-launcher
+// verbosity of the KubeVirt CR sent by virt-handler, so that the verbosity can be
+// changed without restarting virt-launcher
+func (l *Launcher) syncLogVerbosity(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) {
+	verbosity := l.logVerbosity
+	if launcherLogVerbosity := options.GetClusterConfig().GetLauncherLogVerbosity(); launcherLogVerbosity != nil {
+		verbosity = int(launcherLogVerbosity.Verbosity)
+	}
+	if verbosityStr, isSet := vmi.Labels[v1.LogVerbosityLabel]; isSet {
+		labelVerbosity, err := strconv.Atoi(verbosityStr)
+		if err != nil || labelVerbosity < 0 {
+			log.Log.Object(vmi).Warningf("failed to set log verbosity. The value of logVerbosity label should be a non-negative integer, got %s instead.", verbosityStr)
+			return
+		}
+		verbosity = labelVerbosity
+	}
+
+	l.logVerbosityLock.Lock()
+	defer l.logVerbosityLock.Unlock()
+	if verbosity == l.appliedLogVerbosity {
+		return
 	}
+	log.Log.SetVerbosityLevel(verbosity)
+	l.appliedLogVerbosity = verbosity
+	log.Log.Object(vmi).Infof("set log verbosity to %d", verbosity)
 }
 
 func setPluginsFromOptions(options *cmdv1.VirtualMachineOptions) error {
@@ -160,13 +202,13 @@ func (l *Launcher) MigrateVirtualMachine(_ context.Context, request *cmdv1.Migra
 	}
 
 	if err := l.domainManager.MigrateVMI(vmi, options); err != nil {
-		log.Log.Object(vmi).Reason(err).Errorf("Failed to migrate vmi")
+		tracecontext.Log(vmi).Reason(err).Errorf("Failed to migrate vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
 		return response, nil
 	}
 
-	log.Log.Object(vmi).Info("Signaled vmi migration")
+	tracecontext.Log(vmi).Info("Signaled vmi migration")
 	return response, nil
 }
 
@@ -253,20 +295,29 @@ func (l *Launcher) SyncVirtualMachine(_ context.Context, request *cmdv1.VMIReque
 	if !response.Success {
 		return response, nil
 	}
+	l.syncLogVerbosity(vmi, request.Options)
 	if err := setPluginsFromOptions(request.Options); err != nil {
 		response.Success = false
 		response.Message = err.Error()
 		return response, nil
 	}
 
-	if _, err := l.domainManager.SyncVMI(vmi, l.allowEmulation, request.Options); err != nil {
-		log.Log.Object(vmi).Reason(err).Errorf("Failed to sync vmi")
+	var span *tracecontext.Span
+	if vmi.Status.Phase == v1.Scheduled {
+		span = tracecontext.StartSpan(tracecontext.FromObject(vmi), "StartDomain")
+		span.SetAttribute("namespace", vmi.Namespace)
+		span.SetAttribute("name", vmi.Name)
+	}
+	_, err := l.domainManager.SyncVMI(vmi, l.allowEmulation, request.Options)
+	span.End(err)
+	if err != nil {
+		tracecontext.Log(vmi).Reason(err).Errorf("Failed to sync vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
 		return response, nil
 	}
 
-	log.Log.Object(vmi).Info("Synced vmi")
+	tracecontext.Log(vmi).Info("Synced vmi")
 	return response, nil
 }
 
@@ -287,6 +338,57 @@ func (l *Launcher) PauseVirtualMachine(_ context.Context, request *cmdv1.VMIRequ
 	return response, nil
 }
 
+func (l *Launcher) SuspendVirtualMachine(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.SuspendVMI(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to suspend vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Signaled vmi suspend")
+	return response, nil
+}
+
+func (l *Launcher) RotateCloudInit(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.RotateCloudInit(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to rotate the cloud-init data of vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Signaled cloud-init rotation")
+	return response, nil
+}
+
+func (l *Launcher) EjectCloudInit(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.EjectCloudInit(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to eject the cloud-init disk of vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Signaled cloud-init ejection")
+	return response, nil
+}
+
 func (l *Launcher) UnpauseVirtualMachine(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
@@ -396,13 +498,13 @@ func (l *Launcher) KillVirtualMachine(_ context.Context, request *cmdv1.VMIReque
 	}
 
 	if err := l.domainManager.KillVMI(vmi); err != nil {
-		log.Log.Object(vmi).Reason(err).Errorf("Failed to kill vmi")
+		tracecontext.Log(vmi).Reason(err).Errorf("Failed to kill vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
 		return response, nil
 	}
 
-	log.Log.Object(vmi).Info("Signaled vmi kill")
+	tracecontext.Log(vmi).Info("Signaled vmi kill")
 	return response, nil
 }
 
@@ -414,13 +516,13 @@ func (l *Launcher) ShutdownVirtualMachine(_ context.Context, request *cmdv1.VMIR
 	}
 
 	if err := l.domainManager.SignalShutdownVMI(vmi); err != nil {
-		log.Log.Object(vmi).Reason(err).Errorf("Failed to signal shutdown for vmi")
+		tracecontext.Log(vmi).Reason(err).Errorf("Failed to signal shutdown for vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
 		return response, nil
 	}
 
-	log.Log.Object(vmi).Info("Signaled vmi shutdown")
+	tracecontext.Log(vmi).Info("Signaled vmi shutdown")
 	return response, nil
 }
 
@@ -598,6 +700,23 @@ func (l *Launcher) GetGuestInfo(_ context.Context, _ *cmdv1.EmptyRequest) (*cmdv
 	return response, nil
 }
 
+// RefreshGuestInfo polls the guest agent right away, so GetGuestInfo returns up to date data
+func (l *Launcher) RefreshGuestInfo(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.RefreshGuestInfo(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to refresh guest info")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
 // GetUsers returns the list of active users on the guest machine
 func (l *Launcher) GetUsers(_ context.Context, _ *cmdv1.EmptyRequest) (*cmdv1.GuestUserListResponse, error) {
 	response := &cmdv1.GuestUserListResponse{
@@ -687,6 +806,106 @@ func (l *Launcher) GuestPing(ctx context.Context, request *cmdv1.GuestPingReques
 	return resp, nil
 }
 
+func (l *Launcher) GuestFileExists(ctx context.Context, request *cmdv1.GuestFileExistsRequest) (*cmdv1.GuestFileExistsResponse, error) {
+	resp := &cmdv1.GuestFileExistsResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+	err := l.domainManager.GuestFileExists(request.DomainName, request.Path)
+	if err != nil {
+		resp.Response.Success = false
+		resp.Response.Message = err.Error()
+		log.Log.Reason(err).Warningf("GuestAgentFileExists probe for %s failed", request.Path)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (l *Launcher) ReadGuestFile(_ context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.GuestFileResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	resp := &cmdv1.GuestFileResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return resp, nil
+	}
+
+	content, err := l.domainManager.ReadGuestFile(vmi, request.Path, int(request.MaxBytes))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to read guest file %s", request.Path)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+
+	resp.Content = content
+	return resp, nil
+}
+
+func (l *Launcher) WriteGuestFile(_ context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.WriteGuestFile(vmi, request.Path, request.Content); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to write guest file %s", request.Path)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Wrote guest file %s", request.Path)
+	return response, nil
+}
+
+func (l *Launcher) GuestExecStart(_ context.Context, request *cmdv1.GuestExecStartRequest) (*cmdv1.GuestExecStartResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	resp := &cmdv1.GuestExecStartResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return resp, nil
+	}
+
+	pid, err := l.domainManager.GuestExecStart(vmi, request.Command, request.Args)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to start guest command %s", request.Command)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+
+	log.Log.Object(vmi).Infof("Started guest command %s with pid %d", request.Command, pid)
+	resp.Pid = pid
+	return resp, nil
+}
+
+func (l *Launcher) GuestExecStatus(_ context.Context, request *cmdv1.GuestExecStatusRequest) (*cmdv1.GuestExecStatusResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	resp := &cmdv1.GuestExecStatusResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return resp, nil
+	}
+
+	status, err := l.domainManager.GuestExecStatus(vmi, request.Pid)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get the status of guest command with pid %d", request.Pid)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+
+	resp.Exited = status.Exited
+	resp.ExitCode = int32(status.ExitCode)
+	resp.Stdout = status.Stdout
+	resp.Stderr = status.Stderr
+	return resp, nil
+}
+
 func RunServer(socketPath string,
 	domainManager virtwrap.DomainManager,
 	stopChan chan struct{},
@@ -868,6 +1087,28 @@ func (l *Launcher) GetScreenshot(_ context.Context, request *cmdv1.VMIRequest) (
 	return screenshotResponse, nil
 }
 
+func (l *Launcher) GetDomainXML(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.DomainXMLResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	domainXMLResponse := &cmdv1.DomainXMLResponse{
+		Response: response,
+	}
+
+	if !domainXMLResponse.Response.Success {
+		return domainXMLResponse, nil
+	}
+
+	domainXML, err := l.domainManager.GetDomainXML(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get the domain XML")
+		domainXMLResponse.Response.Success = false
+		domainXMLResponse.Response.Message = getErrorMessage(err)
+		return domainXMLResponse, nil
+	}
+	domainXMLResponse.DomainXML = domainXML.DomainXML
+	domainXMLResponse.DomainCapabilitiesXML = domainXML.DomainCapabilitiesXML
+	return domainXMLResponse, nil
+}
+
 func ReceivedEarlyExitSignal() bool {
 	_, earlyExit := os.LookupEnv(receivedEarlyExitSignalEnvVar)
 	return earlyExit
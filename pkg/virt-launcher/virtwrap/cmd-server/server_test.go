@@ -138,6 +138,18 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(client.SoftRebootVirtualMachine(vmi)).To(Succeed())
 		})
 
+		It("should refresh guest info", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().RefreshGuestInfo(vmi)
+			Expect(client.RefreshGuestInfo(vmi)).To(Succeed())
+		})
+
+		It("should return guest info refresh errors", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().RefreshGuestInfo(vmi).Return(errors.New("agent not connected"))
+			Expect(client.RefreshGuestInfo(vmi)).To(MatchError(ContainSubstring("agent not connected")))
+		})
+
 		It("should call memory dump", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			dumpPath := "path/to/dump/volMem"
@@ -158,6 +170,83 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(client.UnpauseVirtualMachine(vmi)).To(Succeed())
 		})
 
+		It("should suspend a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().SuspendVMI(vmi)
+			Expect(client.SuspendVirtualMachine(vmi)).To(Succeed())
+		})
+
+		It("should rotate the cloud-init data of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().RotateCloudInit(vmi)
+			Expect(client.RotateCloudInit(vmi)).To(Succeed())
+		})
+
+		It("should eject the cloud-init disk of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().EjectCloudInit(vmi)
+			Expect(client.EjectCloudInit(vmi)).To(Succeed())
+		})
+
+		It("should read a file in the guest of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().ReadGuestFile(vmi, "/etc/hostname", 10).Return([]byte("testvmi\n"), nil)
+			content, err := client.ReadGuestFile(vmi, "/etc/hostname", 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content).To(Equal([]byte("testvmi\n")))
+		})
+
+		It("should report a failure to read a file in the guest of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().ReadGuestFile(vmi, "/etc/hostname", 10).Return(nil, fmt.Errorf("file /etc/hostname is larger than 10 bytes"))
+			_, err := client.ReadGuestFile(vmi, "/etc/hostname", 10)
+			Expect(err).To(MatchError(ContainSubstring("file /etc/hostname is larger than 10 bytes")))
+		})
+
+		It("should write a file in the guest of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().WriteGuestFile(vmi, "/etc/motd", []byte("hello"))
+			Expect(client.WriteGuestFile(vmi, "/etc/motd", []byte("hello"))).To(Succeed())
+		})
+
+		It("should start a command in the guest of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestExecStart(vmi, "/usr/bin/ls", []string{"-l"}).Return(int64(42), nil)
+			pid, err := client.GuestExecStart(vmi, "/usr/bin/ls", []string{"-l"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pid).To(Equal(int64(42)))
+		})
+
+		It("should report the output and the exit code of a command in the guest of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestExecStatus(vmi, int64(42)).Return(&agent.GuestExecStatus{
+				Exited:   true,
+				ExitCode: 2,
+				Stdout:   []byte("out"),
+				Stderr:   []byte("err"),
+			}, nil)
+			output, err := client.GuestExecStatus(vmi, 42)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Stdout).To(Equal([]byte("out")))
+			Expect(output.Stderr).To(Equal([]byte("err")))
+			Expect(output.ExitCode).To(HaveValue(Equal(int32(2))))
+		})
+
+		It("should not report an exit code for a command still running in the guest of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestExecStatus(vmi, int64(42)).Return(&agent.GuestExecStatus{}, nil)
+			output, err := client.GuestExecStatus(vmi, 42)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.ExitCode).To(BeNil())
+		})
+
+		It("should report a failure to start a command in the guest of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestExecStart(vmi, "/usr/bin/ls", nil).Return(int64(0), fmt.Errorf("guest agent disconnected"))
+			_, err := client.GuestExecStart(vmi, "/usr/bin/ls", nil)
+			Expect(err).To(MatchError(ContainSubstring("guest agent disconnected")))
+		})
+
 		It("should list domains when no guest agent info exists", func() {
 			var list []*api.Domain
 			list = append(list, api.NewMinimalDomain("testvmi1"))
@@ -335,6 +424,27 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(fetchedSEVMeasurementInfo).To(Equal(sevMeasurementInfo))
 		})
 
+		It("should return the domain XML of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetDomainXML(vmi).Return(&cmdv1.DomainXMLResponse{
+				DomainXML:             "<domain/>",
+				DomainCapabilitiesXML: "<domainCapabilities/>",
+			}, nil)
+			domainXML, err := client.GetDomainXML(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainXML).To(Equal(&v1.VirtualMachineInstanceDomainXML{
+				DomainXML:             "<domain/>",
+				DomainCapabilitiesXML: "<domainCapabilities/>",
+			}))
+		})
+
+		It("should fail to return the domain XML of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetDomainXML(vmi).Return(nil, errors.New("error"))
+			_, err := client.GetDomainXML(vmi)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should inject a launch secret into a vmi", func() {
 			sevSecretOptions := &v1.SEVSecretOptions{}
 			vmi := v1.NewVMIReferenceFromName("testvmi")
@@ -414,7 +524,7 @@ var _ = Describe("Virt remote commands", func() {
 			})
 		})
 
-		Context("exec & guestPing", func() {
+		Context("exec, guestPing & guestFileExists", func() {
 			var (
 				testDomainName           = "test"
 				testCommand              = "testCmd"
@@ -449,6 +559,18 @@ var _ = Describe("Virt remote commands", func() {
 						TimeoutSeconds: testTimeoutSeconds,
 					}
 				}
+				testPath               = "/tmp/ready"
+				testGuestFileExistsErr = errors.New("no such file")
+				expectGuestFileExists  = func() *gomock.Call {
+					return domainManager.EXPECT().GuestFileExists(testDomainName, testPath)
+				}
+				guestFileExistsRequest = func() *cmdv1.GuestFileExistsRequest {
+					return &cmdv1.GuestFileExistsRequest{
+						DomainName:     testDomainName,
+						Path:           testPath,
+						TimeoutSeconds: testTimeoutSeconds,
+					}
+				}
 
 				server cmdv1.CmdServer
 			)
@@ -517,6 +639,19 @@ var _ = Describe("Virt remote commands", func() {
 				Expect(resp.Response.Success).To(BeFalse())
 				Expect(resp.Response.Message).To(Equal(testGuestPingErr.Error()))
 			})
+			It("returns success if the file exists in the guest", func() {
+				expectGuestFileExists().Times(1).Return(nil)
+				resp, err := server.GuestFileExists(context.TODO(), guestFileExistsRequest())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+			})
+			It("returns guest file exists errors in the response", func() {
+				expectGuestFileExists().Times(1).Return(testGuestFileExistsErr)
+				resp, err := server.GuestFileExists(context.TODO(), guestFileExistsRequest())
+				Expect(err).To(HaveOccurred())
+				Expect(resp.Response.Success).To(BeFalse())
+				Expect(resp.Response.Message).To(Equal(testGuestFileExistsErr.Error()))
+			})
 			Context("event handling", func() {
 				var (
 					notifyShareDir string
@@ -624,4 +759,46 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(options.vmiNamespace).To(BeEmpty())
 		})
 	})
+
+	Describe("log verbosity", func() {
+		It("should follow the log verbosity label of the VMI", func() {
+			launcher := NewLauncher(domainManager, NewServerOptions(false).WithLogVerbosity(2))
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+
+			vmi.Labels = map[string]string{v1.LogVerbosityLabel: "6"}
+			launcher.syncLogVerbosity(vmi, nil)
+			Expect(launcher.appliedLogVerbosity).To(Equal(6))
+
+			By("ignoring an invalid label")
+			vmi.Labels[v1.LogVerbosityLabel] = "verbose"
+			launcher.syncLogVerbosity(vmi, nil)
+			Expect(launcher.appliedLogVerbosity).To(Equal(6))
+
+			By("restoring the startup verbosity once the label is removed")
+			delete(vmi.Labels, v1.LogVerbosityLabel)
+			launcher.syncLogVerbosity(vmi, nil)
+			Expect(launcher.appliedLogVerbosity).To(Equal(2))
+		})
+
+		It("should follow the virt-launcher log verbosity of the KubeVirt CR", func() {
+			launcher := NewLauncher(domainManager, NewServerOptions(false).WithLogVerbosity(2))
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			options := &cmdv1.VirtualMachineOptions{
+				ClusterConfig: &cmdv1.ClusterConfig{LauncherLogVerbosity: &cmdv1.LogVerbosity{Verbosity: 4}},
+			}
+
+			launcher.syncLogVerbosity(vmi, options)
+			Expect(launcher.appliedLogVerbosity).To(Equal(4))
+
+			By("preferring the log verbosity label of the VMI")
+			vmi.Labels = map[string]string{v1.LogVerbosityLabel: "6"}
+			launcher.syncLogVerbosity(vmi, options)
+			Expect(launcher.appliedLogVerbosity).To(Equal(6))
+
+			By("keeping the startup verbosity when virt-handler does not send one")
+			delete(vmi.Labels, v1.LogVerbosityLabel)
+			launcher.syncLogVerbosity(vmi, &cmdv1.VirtualMachineOptions{})
+			Expect(launcher.appliedLogVerbosity).To(Equal(2))
+		})
+	})
 })
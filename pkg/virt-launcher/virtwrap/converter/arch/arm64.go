@@ -38,7 +38,7 @@ func (converterARM64) IsUSBNeeded(_ *v1.VirtualMachineInstance) bool {
 }
 
 func (converterARM64) SupportCPUHotplug() bool {
-	return false
+	return true
 }
 
 func (converterARM64) IsSMBiosNeeded() bool {
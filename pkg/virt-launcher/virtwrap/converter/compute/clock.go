@@ -66,6 +66,15 @@ func convert_v1_Clock_To_api_Clock(source *v1.Clock, clock *api.Clock) error {
 	} else if source.Timezone != nil {
 		clock.Offset = "timezone"
 		clock.Timezone = string(*source.Timezone)
+	} else if source.Variable != nil {
+		clock.Offset = "variable"
+		clock.Basis = string(v1.ClockOffsetBasisUTC)
+		if source.Variable.Basis != "" {
+			clock.Basis = string(source.Variable.Basis)
+		}
+		if source.Variable.OffsetSeconds != nil {
+			clock.Adjustment = strconv.Itoa(*source.Variable.OffsetSeconds)
+		}
 	}
 
 	if source.Timer != nil {
@@ -67,4 +67,27 @@ var _ = Describe("Clock Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	DescribeTable("Should set a variable offset when variable is specified on the VMI", func(variable v1.ClockOffsetVariable, expectedClock api.Clock) {
+		clock := v1.Clock{
+			ClockOffset: v1.ClockOffset{
+				Variable: &variable,
+			},
+		}
+		vmi := libvmi.New(libvmi.WithClock(clock))
+
+		var domain api.Domain
+
+		Expect(compute.ClockDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Clock).To(HaveValue(Equal(expectedClock)))
+	},
+		Entry("with the default basis",
+			v1.ClockOffsetVariable{},
+			api.Clock{Offset: "variable", Basis: "utc"},
+		),
+		Entry("with the localtime basis and an offset",
+			v1.ClockOffsetVariable{Basis: v1.ClockOffsetBasisLocaltime, OffsetSeconds: pointer.P(3600)},
+			api.Clock{Offset: "variable", Basis: "localtime", Adjustment: "3600"},
+		),
+	)
 })
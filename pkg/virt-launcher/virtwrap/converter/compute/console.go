@@ -39,19 +39,26 @@ func NewConsoleDomainConfigurator(useSerialConsoleLog bool) ConsoleDomainConfigu
 	}
 }
 
+const (
+	serialType     = "serial"
+	virtioType     = "virtio"
+	consoleType    = "pty"
+	serialTypeUnix = "unix"
+	bindMode       = "bind"
+	logAppend      = "on"
+)
+
 func (c ConsoleDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
-	if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && !*vmi.Spec.Domain.Devices.AutoattachSerialConsole {
-		return nil
+	if vmi.Spec.Domain.Devices.AutoattachSerialConsole == nil || *vmi.Spec.Domain.Devices.AutoattachSerialConsole {
+		c.configureDefaultConsole(vmi, domain)
 	}
+	configureSerialDevices(vmi, domain)
 
-	const (
-		serialPortIndex = uint(0)
-		serialType      = "serial"
-		consoleType     = "pty"
-		serialTypeUnix  = "unix"
-		bindMode        = "bind"
-		logAppend       = "on"
-	)
+	return nil
+}
+
+func (c ConsoleDomainConfigurator) configureDefaultConsole(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	const serialPortIndex = uint(0)
 
 	domain.Spec.Devices.Consoles = []api.Console{
 		{
@@ -83,6 +90,41 @@ func (c ConsoleDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, dom
 	}
 
 	domain.Spec.Devices.Serials = []api.Serial{serial}
+}
 
-	return nil
+// configureSerialDevices adds the additional serial devices, the serial ports and the virtio
+// consoles are numbered after the default console
+func configureSerialDevices(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	serialPort, virtioPort := uint(1), uint(1)
+	for _, device := range vmi.Spec.Domain.Devices.Serials {
+		socketPath := fmt.Sprintf("%s/%s/virt-serial-%s", util.VirtPrivateDir, vmi.ObjectMeta.UID, device.Name)
+
+		if device.Type == v1.SerialDeviceTypeVirtio {
+			domain.Spec.Devices.Consoles = append(domain.Spec.Devices.Consoles, api.Console{
+				Type: serialTypeUnix,
+				Target: &api.ConsoleTarget{
+					Type: pointer.P(virtioType),
+					Port: pointer.P(virtioPort),
+				},
+				Source: &api.ConsoleSource{
+					Mode: bindMode,
+					Path: socketPath,
+				},
+			})
+			virtioPort++
+			continue
+		}
+
+		domain.Spec.Devices.Serials = append(domain.Spec.Devices.Serials, api.Serial{
+			Type: serialTypeUnix,
+			Target: &api.SerialTarget{
+				Port: pointer.P(serialPort),
+			},
+			Source: &api.SerialSource{
+				Mode: bindMode,
+				Path: socketPath,
+			},
+		})
+		serialPort++
+	}
 }
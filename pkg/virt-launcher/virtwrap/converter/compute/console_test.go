@@ -25,6 +25,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -129,4 +131,57 @@ var _ = Describe("Console Domain Configurator", func() {
 
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("should configure the additional serial devices", func() {
+		vmi := libvmi.New(
+			libvmi.WithUID(uid),
+			libvmi.WithSerialDevice("mgmt", ""),
+			libvmi.WithSerialDevice("data", v1.SerialDeviceTypeSerial),
+			libvmi.WithSerialDevice("agent", v1.SerialDeviceTypeVirtio),
+		)
+
+		var domain api.Domain
+		Expect(compute.NewConsoleDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Serials).To(HaveLen(3))
+		Expect(domain.Spec.Devices.Serials[1]).To(Equal(api.Serial{
+			Type: "unix",
+			Source: &api.SerialSource{
+				Mode: "bind",
+				Path: fmt.Sprintf("%s/%s/virt-serial-mgmt", util.VirtPrivateDir, uid),
+			},
+			Target: &api.SerialTarget{
+				Port: pointer.P(uint(1)),
+			},
+		}))
+		Expect(domain.Spec.Devices.Serials[2].Target.Port).To(HaveValue(Equal(uint(2))))
+		Expect(domain.Spec.Devices.Serials[2].Source.Path).To(HaveSuffix("/virt-serial-data"))
+
+		Expect(domain.Spec.Devices.Consoles).To(HaveLen(2))
+		Expect(domain.Spec.Devices.Consoles[1]).To(Equal(api.Console{
+			Type: "unix",
+			Target: &api.ConsoleTarget{
+				Type: pointer.P("virtio"),
+				Port: pointer.P(uint(1)),
+			},
+			Source: &api.ConsoleSource{
+				Mode: "bind",
+				Path: fmt.Sprintf("%s/%s/virt-serial-agent", util.VirtPrivateDir, uid),
+			},
+		}))
+	})
+
+	It("should configure the additional serial devices when AutoattachSerialConsole is false", func() {
+		vmi := libvmi.New(
+			libvmi.WithAutoattachSerialConsole(false),
+			libvmi.WithSerialDevice("mgmt", ""),
+		)
+
+		var domain api.Domain
+		Expect(compute.NewConsoleDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Consoles).To(BeEmpty())
+		Expect(domain.Spec.Devices.Serials).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Serials[0].Target.Port).To(HaveValue(Equal(uint(1))))
+	})
 })
@@ -79,6 +79,20 @@ func (c CPUDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 			}
 		}
 
+		// Expose or hide the virtualization extensions of the host CPU
+		if policy := nestedVirtualizationFeaturePolicy(vmi.Spec.Domain.CPU.NestedVirtualization); policy != "" {
+			for _, name := range []string{"vmx", "svm"} {
+				if _, exists := existingFeatures[name]; exists {
+					continue
+				}
+				existingFeatures[name] = struct{}{}
+				domain.Spec.CPU.Features = append(domain.Spec.CPU.Features, api.CPUFeature{
+					Name:   name,
+					Policy: policy,
+				})
+			}
+		}
+
 		/*
 						Libvirt validation fails when a CPU model is usable
 						by QEMU but lacks features listed in
@@ -108,6 +122,18 @@ func (c CPUDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 	return nil
 }
 
+// nestedVirtualizationFeaturePolicy returns the libvirt policy of the vmx and svm
+// features. Require uses optional since a node only provides one of them.
+func nestedVirtualizationFeaturePolicy(nested v1.NestedVirtualizationPolicy) string {
+	switch nested {
+	case v1.NestedVirtualizationRequire:
+		return "optional"
+	case v1.NestedVirtualizationForbid:
+		return "disable"
+	}
+	return ""
+}
+
 func domainVCPUTopologyForHotplug(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 	cpuTopology := vcpu.GetCPUTopology(vmi)
 	cpuCount := vcpu.CalculateRequestedVCPUs(cpuTopology)
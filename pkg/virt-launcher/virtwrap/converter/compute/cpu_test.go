@@ -204,6 +204,34 @@ var _ = Describe("CPU Domain Configurator", func() {
 		)
 	})
 
+	Context("nested virtualization", func() {
+		DescribeTable("should set the vmx and svm features",
+			func(policy v1.NestedVirtualizationPolicy, userFeatures []v1.CPUFeature, expectedFeatures []api.CPUFeature) {
+				vmi := libvmi.New()
+				vmi.Spec.Domain.CPU = &v1.CPU{NestedVirtualization: policy, Features: userFeatures}
+				var domain api.Domain
+
+				configurator := compute.NewCPUDomainConfigurator(!hotplugSupported, !requiresMPXCPUValidation)
+				Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+				Expect(domain.Spec.CPU.Features).To(Equal(expectedFeatures))
+			},
+			Entry("not when no policy is set", v1.NestedVirtualizationPolicy(""), nil, nil),
+			Entry("as optional when nested virtualization is required",
+				v1.NestedVirtualizationRequire, nil,
+				[]api.CPUFeature{{Name: "vmx", Policy: "optional"}, {Name: "svm", Policy: "optional"}},
+			),
+			Entry("as disabled when nested virtualization is forbidden",
+				v1.NestedVirtualizationForbid, nil,
+				[]api.CPUFeature{{Name: "vmx", Policy: "disable"}, {Name: "svm", Policy: "disable"}},
+			),
+			Entry("without overriding features set by the user",
+				v1.NestedVirtualizationForbid, []v1.CPUFeature{{Name: "vmx", Policy: "require"}},
+				[]api.CPUFeature{{Name: "vmx", Policy: "require"}, {Name: "svm", Policy: "disable"}},
+			),
+		)
+	})
+
 	Context("CPU hotplug", func() {
 		It("should configure VCPUs for hotplug when MaxSockets is set and hotplug is supported", func() {
 			vmi := libvmi.New(
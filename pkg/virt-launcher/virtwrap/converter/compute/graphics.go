@@ -95,7 +95,7 @@ func (g GraphicsDomainConfigurator) configureAMD64VideoDevice(vmi *v1.VirtualMac
 		domain.Spec.Devices.Video = []api.Video{
 			{
 				Model: api.VideoModel{
-					Type:  "bochs",
+					Type:  v1.VideoTypeBochs,
 					Heads: pointer.P(graphicsDeviceDefaultHeads),
 				},
 			},
@@ -104,7 +104,7 @@ func (g GraphicsDomainConfigurator) configureAMD64VideoDevice(vmi *v1.VirtualMac
 		domain.Spec.Devices.Video = []api.Video{
 			{
 				Model: api.VideoModel{
-					Type:  "vga",
+					Type:  v1.VideoTypeVGA,
 					Heads: pointer.P(graphicsDeviceDefaultHeads),
 					VRam:  pointer.P(graphicsDeviceDefaultVRAM),
 				},
@@ -118,7 +118,7 @@ func (g GraphicsDomainConfigurator) configureARM64VideoDevice(domain *api.Domain
 	domain.Spec.Devices.Video = []api.Video{
 		{
 			Model: api.VideoModel{
-				Type:  v1.VirtIO,
+				Type:  v1.VideoTypeVirtio,
 				Heads: pointer.P(graphicsDeviceDefaultHeads),
 			},
 		},
@@ -128,7 +128,7 @@ func (g GraphicsDomainConfigurator) configureARM64VideoDevice(domain *api.Domain
 func (g GraphicsDomainConfigurator) configureS390XVideoDevice(domain *api.Domain) {
 	domain.Spec.Devices.Video = []api.Video{{
 		Model: api.VideoModel{
-			Type:  v1.VirtIO,
+			Type:  v1.VideoTypeVirtio,
 			Heads: pointer.P(graphicsDeviceDefaultHeads),
 		},
 	}}
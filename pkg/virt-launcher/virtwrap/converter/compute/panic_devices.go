@@ -22,6 +22,7 @@ package compute
 import (
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -34,5 +35,17 @@ func (p PanicDevicesDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance
 		})
 	}
 
+	capture := vmi.Spec.Domain.Devices.GuestPanicCapture
+	if capture == nil {
+		return nil
+	}
+	if len(domain.Spec.Devices.PanicDevices) == 0 {
+		domain.Spec.Devices.PanicDevices = []api.PanicDevice{{Model: pointer.P(v1.Pvpanic)}}
+	}
+	if capture.MemoryDumpClaimName != "" {
+		// Keep the crashed domain, so that its memory can be dumped
+		domain.Spec.OnCrash = api.DomainOnCrashPreserve
+	}
+
 	return nil
 }
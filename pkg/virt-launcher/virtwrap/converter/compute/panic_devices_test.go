@@ -65,4 +65,39 @@ var _ = Describe("Panic Device Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	Context("with guest panic capture", func() {
+		It("Should add a pvpanic device when no panic device is specified", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{}
+			var domain api.Domain
+
+			Expect(compute.PanicDevicesDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+			pvpanicModel := v1.Pvpanic
+			Expect(domain.Spec.Devices.PanicDevices).To(Equal([]api.PanicDevice{{Model: &pvpanicModel}}))
+			Expect(domain.Spec.OnCrash).To(BeEmpty())
+		})
+
+		It("Should keep the specified panic devices", func() {
+			isaModel := v1.Isa
+			vmi := libvmi.New(libvmi.WithPanicDevice(isaModel))
+			vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{}
+			var domain api.Domain
+
+			Expect(compute.PanicDevicesDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.PanicDevices).To(Equal([]api.PanicDevice{{Model: &isaModel}}))
+		})
+
+		It("Should preserve the crashed domain when a memory dump is requested", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.GuestPanicCapture = &v1.GuestPanicCapture{MemoryDumpClaimName: "dump-pvc"}
+			var domain api.Domain
+
+			Expect(compute.PanicDevicesDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.OnCrash).To(Equal(api.DomainOnCrashPreserve))
+		})
+	})
 })
@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"os"
 	"strconv"
-	"strings"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -44,8 +43,7 @@ func NewQemuCmdDomainConfigurator(verboseLogEnabled bool) QemuCmdDomainConfigura
 
 func (q QemuCmdDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	// Add Ignition Command Line if present
-	ignitiondata := vmi.Annotations[v1.IgnitionAnnotation]
-	if ignitiondata != "" && strings.Contains(ignitiondata, "ignition") {
+	if ignition.UsesFirmwareConfig(vmi) {
 		initializeQEMUCmdAndQEMUArg(domain)
 		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: "-fw_cfg"})
 		ignitionpath := fmt.Sprintf("%s/%s", ignition.GetDomainBasePath(vmi.Name, vmi.Namespace), ignition.IgnitionFile)
@@ -66,6 +64,17 @@ func (q QemuCmdDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, dom
 		}
 	}
 
+	// Append the passthrough arguments, they were validated against the allowlist by virt-api
+	if vmi.Spec.Domain.QEMUCommandLine != nil {
+		for _, arg := range vmi.Spec.Domain.QEMUCommandLine.Args {
+			initializeQEMUCmdAndQEMUArg(domain)
+			domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: arg.Name})
+			if arg.Value != "" {
+				domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: arg.Value})
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("QemuCmd Domain Configurator", func() {
+	It("Should not set the QEMU command line when no passthrough argument is specified", func() {
+		vmi := libvmi.New()
+		var domain api.Domain
+
+		Expect(compute.NewQemuCmdDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should append the passthrough arguments in order", func() {
+		vmi := libvmi.New(withQEMUArgs(
+			v1.QEMUArg{Name: "-device", Value: "pvpanic"},
+			v1.QEMUArg{Name: "-no-hpet"},
+		))
+		var domain api.Domain
+
+		Expect(compute.NewQemuCmdDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+		expectedDomain := api.Domain{
+			Spec: api.DomainSpec{
+				QEMUCmd: &api.Commandline{
+					QEMUArg: []api.Arg{
+						{Value: "-device"},
+						{Value: "pvpanic"},
+						{Value: "-no-hpet"},
+					},
+				},
+			},
+		}
+		Expect(domain).To(Equal(expectedDomain))
+	})
+
+	It("Should pass the Ignition config through the firmware configuration device", func() {
+		vmi := libvmi.New(
+			libvmi.WithNamespace("default"),
+			libvmi.WithName("coreos"),
+			libvmi.WithIgnition("", v1.IgnitionFragment{Data: `{"ignition":{"version":"3.4.0"}}`}),
+		)
+		var domain api.Domain
+
+		Expect(compute.NewQemuCmdDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.QEMUCmd).ToNot(BeNil())
+		Expect(domain.Spec.QEMUCmd.QEMUArg).To(HaveLen(2))
+		Expect(domain.Spec.QEMUCmd.QEMUArg[0].Value).To(Equal("-fw_cfg"))
+		Expect(domain.Spec.QEMUCmd.QEMUArg[1].Value).To(HavePrefix("name=opt/com.coreos/config,file="))
+		Expect(domain.Spec.QEMUCmd.QEMUArg[1].Value).To(HaveSuffix("/default/coreos/data.ign"))
+	})
+
+	It("Should not use the firmware configuration device when the Ignition config is on the config drive", func() {
+		vmi := libvmi.New(
+			libvmi.WithIgnition(v1.IgnitionPropagationConfigDrive, v1.IgnitionFragment{Data: `{"ignition":{"version":"3.4.0"}}`}),
+		)
+		var domain api.Domain
+
+		Expect(compute.NewQemuCmdDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+})
+
+func withQEMUArgs(args ...v1.QEMUArg) libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.QEMUCommandLine = &v1.QEMUCommandLine{Args: args}
+	}
+}
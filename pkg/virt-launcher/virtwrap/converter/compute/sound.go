@@ -38,8 +38,8 @@ func (s SoundDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domai
 	model := vmiSoundDevice.Model
 	switch model {
 	case "":
-		model = "ich9"
-	case "ich9", "ac97":
+		model = v1.SoundModelICH9
+	case v1.SoundModelICH9, v1.SoundModelAC97:
 	default:
 		return fmt.Errorf("invalid model: %s", model)
 	}
@@ -31,6 +31,8 @@ type SMBIOS struct {
 	Version      string
 	SKU          string
 	Family       string
+	BaseBoard    *v1.SMBIOSBaseBoard
+	OEMStrings   []string
 }
 
 type SysInfoDomainConfigurator struct {
@@ -49,7 +51,9 @@ func (s SysInfoDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, dom
 	}
 
 	domain.Spec.SysInfo.System = buildSystem(vmi.Spec.Domain.Firmware, s.smBIOS)
+	domain.Spec.SysInfo.BaseBoard = buildBaseBoard(vmi.Spec.Domain.Firmware, s.smBIOS)
 	domain.Spec.SysInfo.Chassis = buildChassis(vmi.Spec.Domain.Chassis)
+	domain.Spec.SysInfo.OEMStrings = buildOEMStrings(vmi.Spec.Domain.Firmware, s.smBIOS)
 
 	return nil
 }
@@ -65,19 +69,103 @@ func buildSystem(firmware *v1.Firmware, smBIOS *SMBIOS) []api.Entry {
 		}
 	}
 
-	if smBIOS != nil {
+	if system := systemInfo(firmware, smBIOS); system != nil {
 		systemEntries = append(systemEntries,
-			api.Entry{Name: "manufacturer", Value: smBIOS.Manufacturer},
-			api.Entry{Name: "family", Value: smBIOS.Family},
-			api.Entry{Name: "product", Value: smBIOS.Product},
-			api.Entry{Name: "sku", Value: smBIOS.SKU},
-			api.Entry{Name: "version", Value: smBIOS.Version},
+			api.Entry{Name: "manufacturer", Value: system.Manufacturer},
+			api.Entry{Name: "family", Value: system.Family},
+			api.Entry{Name: "product", Value: system.Product},
+			api.Entry{Name: "sku", Value: system.Sku},
+			api.Entry{Name: "version", Value: system.Version},
 		)
 	}
 
 	return systemEntries
 }
 
+// systemInfo returns the cluster wide system information overridden by the
+// values set on the VMI
+func systemInfo(firmware *v1.Firmware, smBIOS *SMBIOS) *v1.SMBIOSSystem {
+	var system *v1.SMBIOSSystem
+	if smBIOS != nil {
+		system = &v1.SMBIOSSystem{
+			Manufacturer: smBIOS.Manufacturer,
+			Product:      smBIOS.Product,
+			Version:      smBIOS.Version,
+			Sku:          smBIOS.SKU,
+			Family:       smBIOS.Family,
+		}
+	}
+
+	if firmware == nil || firmware.SMBIOS == nil || firmware.SMBIOS.System == nil {
+		return system
+	}
+
+	if system == nil {
+		system = &v1.SMBIOSSystem{}
+	}
+	override := firmware.SMBIOS.System
+	system.Manufacturer = overrideValue(system.Manufacturer, override.Manufacturer)
+	system.Product = overrideValue(system.Product, override.Product)
+	system.Version = overrideValue(system.Version, override.Version)
+	system.Sku = overrideValue(system.Sku, override.Sku)
+	system.Family = overrideValue(system.Family, override.Family)
+
+	return system
+}
+
+func buildBaseBoard(firmware *v1.Firmware, smBIOS *SMBIOS) []api.Entry {
+	var baseBoard *v1.SMBIOSBaseBoard
+	if smBIOS != nil && smBIOS.BaseBoard != nil {
+		baseBoard = smBIOS.BaseBoard.DeepCopy()
+	}
+
+	if firmware != nil && firmware.SMBIOS != nil && firmware.SMBIOS.BaseBoard != nil {
+		if baseBoard == nil {
+			baseBoard = &v1.SMBIOSBaseBoard{}
+		}
+		override := firmware.SMBIOS.BaseBoard
+		baseBoard.Manufacturer = overrideValue(baseBoard.Manufacturer, override.Manufacturer)
+		baseBoard.Product = overrideValue(baseBoard.Product, override.Product)
+		baseBoard.Version = overrideValue(baseBoard.Version, override.Version)
+		baseBoard.Serial = overrideValue(baseBoard.Serial, override.Serial)
+		baseBoard.Asset = overrideValue(baseBoard.Asset, override.Asset)
+	}
+
+	if baseBoard == nil {
+		return nil
+	}
+
+	return []api.Entry{
+		{Name: "manufacturer", Value: baseBoard.Manufacturer},
+		{Name: "product", Value: baseBoard.Product},
+		{Name: "version", Value: baseBoard.Version},
+		{Name: "serial", Value: baseBoard.Serial},
+		{Name: "asset", Value: baseBoard.Asset},
+	}
+}
+
+// buildOEMStrings returns the OEM strings of the VMI, which replace the
+// cluster wide ones
+func buildOEMStrings(firmware *v1.Firmware, smBIOS *SMBIOS) *api.OEMStrings {
+	var entries []string
+	if firmware != nil && firmware.SMBIOS != nil && firmware.SMBIOS.OEMStrings != nil {
+		entries = firmware.SMBIOS.OEMStrings
+	} else if smBIOS != nil {
+		entries = smBIOS.OEMStrings
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return &api.OEMStrings{Entries: entries}
+}
+
+func overrideValue(value, override string) string {
+	if override != "" {
+		return override
+	}
+	return value
+}
+
 func buildChassis(chassis *v1.Chassis) []api.Entry {
 	if chassis == nil {
 		return nil
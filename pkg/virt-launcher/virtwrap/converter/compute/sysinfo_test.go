@@ -193,6 +193,142 @@ var _ = Describe("SysInfo Domain Configurator", func() {
 				},
 			},
 		),
+		Entry(
+			"With system information overriding the cluster-wide SMBIOS",
+			libvmi.New(
+				libvmi.WithFirmwareUUID(expectedUUID),
+				withSMBIOS(&v1.SMBIOS{
+					System: &v1.SMBIOSSystem{
+						Manufacturer: "vmiManufacturer",
+						Product:      "vmiProduct",
+					},
+				}),
+			),
+			&clusterWideSMBIOS,
+			api.SysInfo{
+				Type: "smbios",
+				System: []api.Entry{
+					{Name: "uuid", Value: expectedUUID},
+					{Name: "manufacturer", Value: "vmiManufacturer"},
+					{Name: "family", Value: expectedFamily},
+					{Name: "product", Value: "vmiProduct"},
+					{Name: "sku", Value: expectedSKU},
+					{Name: "version", Value: expectedVersion},
+				},
+			},
+		),
+		Entry(
+			"With system information and without cluster-wide SMBIOS",
+			libvmi.New(
+				libvmi.WithFirmwareUUID(expectedUUID),
+				withSMBIOS(&v1.SMBIOS{
+					System: &v1.SMBIOSSystem{Manufacturer: "vmiManufacturer"},
+				}),
+			),
+			nil,
+			api.SysInfo{
+				Type: "smbios",
+				System: []api.Entry{
+					{Name: "uuid", Value: expectedUUID},
+					{Name: "manufacturer", Value: "vmiManufacturer"},
+					{Name: "family", Value: ""},
+					{Name: "product", Value: ""},
+					{Name: "sku", Value: ""},
+					{Name: "version", Value: ""},
+				},
+			},
+		),
+		Entry(
+			"With baseboard information overriding the cluster-wide baseboard",
+			libvmi.New(
+				libvmi.WithFirmwareUUID(expectedUUID),
+				withSMBIOS(&v1.SMBIOS{
+					BaseBoard: &v1.SMBIOSBaseBoard{
+						Serial: "vmiBaseBoardSerial",
+						Asset:  "vmiBaseBoardAsset",
+					},
+				}),
+			),
+			&compute.SMBIOS{
+				BaseBoard: &v1.SMBIOSBaseBoard{
+					Manufacturer: "baseBoardManufacturer",
+					Serial:       "baseBoardSerial",
+				},
+			},
+			api.SysInfo{
+				Type: "smbios",
+				System: []api.Entry{
+					{Name: "uuid", Value: expectedUUID},
+					{Name: "manufacturer", Value: ""},
+					{Name: "family", Value: ""},
+					{Name: "product", Value: ""},
+					{Name: "sku", Value: ""},
+					{Name: "version", Value: ""},
+				},
+				BaseBoard: []api.Entry{
+					{Name: "manufacturer", Value: "baseBoardManufacturer"},
+					{Name: "product", Value: ""},
+					{Name: "version", Value: ""},
+					{Name: "serial", Value: "vmiBaseBoardSerial"},
+					{Name: "asset", Value: "vmiBaseBoardAsset"},
+				},
+			},
+		),
+		Entry(
+			"With cluster-wide OEM strings",
+			libvmi.New(),
+			&compute.SMBIOS{OEMStrings: []string{"cluster=1"}},
+			api.SysInfo{
+				Type: "smbios",
+				System: []api.Entry{
+					{Name: "manufacturer", Value: ""},
+					{Name: "family", Value: ""},
+					{Name: "product", Value: ""},
+					{Name: "sku", Value: ""},
+					{Name: "version", Value: ""},
+				},
+				OEMStrings: &api.OEMStrings{Entries: []string{"cluster=1"}},
+			},
+		),
+		Entry(
+			"With OEM strings replacing the cluster-wide OEM strings",
+			libvmi.New(
+				libvmi.WithFirmwareUUID(expectedUUID),
+				withSMBIOS(&v1.SMBIOS{OEMStrings: []string{"vmi=1", "vmi=2"}}),
+			),
+			&compute.SMBIOS{OEMStrings: []string{"cluster=1"}},
+			api.SysInfo{
+				Type: "smbios",
+				System: []api.Entry{
+					{Name: "uuid", Value: expectedUUID},
+					{Name: "manufacturer", Value: ""},
+					{Name: "family", Value: ""},
+					{Name: "product", Value: ""},
+					{Name: "sku", Value: ""},
+					{Name: "version", Value: ""},
+				},
+				OEMStrings: &api.OEMStrings{Entries: []string{"vmi=1", "vmi=2"}},
+			},
+		),
+		Entry(
+			"With empty OEM strings replacing the cluster-wide OEM strings",
+			libvmi.New(
+				libvmi.WithFirmwareUUID(expectedUUID),
+				withSMBIOS(&v1.SMBIOS{OEMStrings: []string{}}),
+			),
+			&compute.SMBIOS{OEMStrings: []string{"cluster=1"}},
+			api.SysInfo{
+				Type: "smbios",
+				System: []api.Entry{
+					{Name: "uuid", Value: expectedUUID},
+					{Name: "manufacturer", Value: ""},
+					{Name: "family", Value: ""},
+					{Name: "product", Value: ""},
+					{Name: "sku", Value: ""},
+					{Name: "version", Value: ""},
+				},
+			},
+		),
 	)
 })
 
@@ -211,3 +347,13 @@ func withChassis(chassis *v1.Chassis) libvmi.Option {
 		vmi.Spec.Domain.Chassis = chassis
 	}
 }
+
+func withSMBIOS(smbios *v1.SMBIOS) libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.Firmware == nil {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{}
+		}
+
+		vmi.Spec.Domain.Firmware.SMBIOS = smbios
+	}
+}
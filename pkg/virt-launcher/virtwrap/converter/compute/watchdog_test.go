@@ -95,6 +95,38 @@ var _ = Describe("Watchdog Domain Configurator", func() {
 				Action: "reset",
 			},
 		),
+		Entry("amd64 with I6300ESB and inject-nmi action",
+			"amd64",
+			v1.Watchdog{
+				Name: "nmiwatchdog",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{
+						Action: v1.WatchdogActionInjectNMI,
+					},
+				},
+			},
+			api.Watchdog{
+				Alias:  api.NewUserDefinedAlias("nmiwatchdog"),
+				Model:  "i6300esb",
+				Action: "inject-nmi",
+			},
+		),
+		Entry("s390x with Diag288 and dump action",
+			"s390x",
+			v1.Watchdog{
+				Name: "dumpwatchdog",
+				WatchdogDevice: v1.WatchdogDevice{
+					Diag288: &v1.Diag288Watchdog{
+						Action: v1.WatchdogActionDump,
+					},
+				},
+			},
+			api.Watchdog{
+				Alias:  api.NewUserDefinedAlias("dumpwatchdog"),
+				Model:  "diag288",
+				Action: "dump",
+			},
+		),
 	)
 
 	DescribeTable("should fail to convert watchdog for unsupported or invalid architectures",
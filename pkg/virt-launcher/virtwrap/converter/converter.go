@@ -1050,7 +1050,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	case v1.HyperVDirectHypervisorName:
 		configurators = append(configurators, mshv.NewMshvDomainConfigurator(c.AllowEmulation, c.HypervisorDeviceAvailable))
 	default:
-		configurators = append(configurators, kvm.NewKvmDomainConfigurator(c.AllowEmulation, c.HypervisorDeviceAvailable))
+		configurators = append(configurators, kvm.NewKvmDomainConfigurator(c.AllowEmulation, c.HypervisorDeviceAvailable, c.VirtualizedHost))
 	}
 
 	builder := convertertypes.NewDomainBuilder(configurators...)
@@ -1058,6 +1058,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		return err
 	}
 
+	if err := vcpu.FormatGuestNUMATopology(vmi, &domain.Spec); err != nil {
+		return err
+	}
+
 	var isMemfdRequired = false
 	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil {
 		domain.Spec.MemoryBacking = &api.MemoryBacking{
@@ -1098,6 +1102,15 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	// opt out from KSM merging of the guest memory
+	if memory := vmi.Spec.Domain.Memory; memory != nil && memory.KSM != nil &&
+		memory.KSM.Enabled != nil && !*memory.KSM.Enabled {
+		if domain.Spec.MemoryBacking == nil {
+			domain.Spec.MemoryBacking = &api.MemoryBacking{}
+		}
+		domain.Spec.MemoryBacking.NoSharePages = &api.NoSharePages{}
+	}
+
 	volumeIndices := map[string]int{}
 	volumes := map[string]*v1.Volume{}
 	for i, volume := range vmi.Spec.Volumes {
@@ -1177,6 +1190,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	configureNetworkBoot(vmi, domain)
+
 	if vmi.Spec.Domain.CPU != nil {
 		// Adjust guest vcpu config. Currently will handle vCPUs to pCPUs pinning
 		if vmi.IsCPUDedicated() {
@@ -1286,13 +1301,27 @@ func convertCmdv1SMBIOSToComputeSMBIOS(input *cmdv1.SMBios) *compute.SMBIOS {
 		return nil
 	}
 
-	return &compute.SMBIOS{
+	smBIOS := &compute.SMBIOS{
 		Manufacturer: input.Manufacturer,
 		Product:      input.Product,
 		Version:      input.Version,
 		SKU:          input.Sku,
 		Family:       input.Family,
+		OEMStrings:   input.OemStrings,
+	}
+
+	baseBoard := v1.SMBIOSBaseBoard{
+		Manufacturer: input.BaseBoardManufacturer,
+		Product:      input.BaseBoardProduct,
+		Version:      input.BaseBoardVersion,
+		Serial:       input.BaseBoardSerial,
+		Asset:        input.BaseBoardAsset,
+	}
+	if baseBoard != (v1.SMBIOSBaseBoard{}) {
+		smBIOS.BaseBoard = &baseBoard
 	}
+
+	return smBIOS
 }
 
 func convertEFIConfiguration(input *convertertypes.EFIConfiguration) *compute.EFIConfiguration {
@@ -1306,3 +1335,33 @@ func convertEFIConfiguration(input *convertertypes.EFIConfiguration) *compute.EF
 		SecureLoader: input.SecureLoader,
 	}
 }
+
+// configureNetworkBoot makes the network boot interface the first boot device,
+// the devices with a boot order are tried after it, following their order.
+func configureNetworkBoot(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	netBootIface := netvmispec.LookupNetworkBootInterface(vmi.Spec.Domain.Devices.Interfaces)
+	if netBootIface == nil {
+		return
+	}
+
+	for i := range domain.Spec.Devices.Disks {
+		shiftBootOrder(domain.Spec.Devices.Disks[i].BootOrder)
+	}
+	for i := range domain.Spec.Devices.HostDevices {
+		shiftBootOrder(domain.Spec.Devices.HostDevices[i].BootOrder)
+	}
+	for i := range domain.Spec.Devices.Interfaces {
+		iface := &domain.Spec.Devices.Interfaces[i]
+		if iface.Alias != nil && iface.Alias.GetName() == netBootIface.Name {
+			iface.BootOrder = &api.BootOrder{Order: 1}
+			continue
+		}
+		shiftBootOrder(iface.BootOrder)
+	}
+}
+
+func shiftBootOrder(bootOrder *api.BootOrder) {
+	if bootOrder != nil {
+		bootOrder.Order++
+	}
+}
@@ -1000,7 +1000,7 @@ var _ = Describe("Converter", func() {
 				Entry("on s390x", s390x),
 			)
 
-			It("should not define hotplugable topology for ARM64", func() {
+			It("should define hotplugable topology for ARM64", func() {
 				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 				vmi.Spec.Architecture = arm64
 				vmi.Spec.Domain.Machine = &v1.Machine{Type: "virt"}
@@ -1012,10 +1012,18 @@ var _ = Describe("Converter", func() {
 				c.Architecture = archconverter.NewConverter(arm64)
 				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
 				Expect(domainSpec.CPU.Topology.Cores).To(Equal(uint32(2)), "Expect cores")
-				Expect(domainSpec.CPU.Topology.Sockets).To(Equal(uint32(2)), "Expect sockets")
+				Expect(domainSpec.CPU.Topology.Sockets).To(Equal(uint32(3)), "Expect sockets")
 				Expect(domainSpec.CPU.Topology.Threads).To(Equal(uint32(1)), "Expect threads")
-				Expect(domainSpec.VCPU.CPUs).To(Equal(uint32(4)), "Expect vcpus")
-				Expect(domainSpec.VCPUs).To(BeNil(), "Expecting topology for hotplug")
+				Expect(domainSpec.VCPU.CPUs).To(Equal(uint32(6)), "Expect vcpus")
+				Expect(domainSpec.VCPUs).ToNot(BeNil(), "Expecting topology for hotplug")
+				Expect(domainSpec.VCPUs.VCPU).To(HaveLen(6), "Expecting topology for hotplug")
+				for i, vcpu := range domainSpec.VCPUs.VCPU {
+					if i < 4 {
+						Expect(vcpu.Enabled).To(Equal("yes"), "Expecting vcpu %d to be enabled", i)
+					} else {
+						Expect(vcpu.Enabled).To(Equal("no"), "Expecting vcpu %d to be disabled", i)
+					}
+				}
 			})
 
 			DescribeTable("should convert CPU model", func(model string) {
@@ -1640,6 +1648,21 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Memory.Unit).To(Equal("b"))
 		})
 
+		DescribeTable("should configure KSM merging of the guest memory", func(ksm *v1.FeatureState, expectNoSharePages bool) {
+			vmi.Spec.Domain.Memory = &v1.Memory{KSM: ksm}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			if expectNoSharePages {
+				Expect(domainSpec.MemoryBacking).ToNot(BeNil())
+				Expect(domainSpec.MemoryBacking.NoSharePages).ToNot(BeNil())
+			} else if domainSpec.MemoryBacking != nil {
+				Expect(domainSpec.MemoryBacking.NoSharePages).To(BeNil())
+			}
+		},
+			Entry("allowed by default", nil, false),
+			Entry("allowed when enabled", &v1.FeatureState{Enabled: pointer.P(true)}, false),
+			Entry("prevented when disabled", &v1.FeatureState{Enabled: pointer.P(false)}, true),
+		)
+
 		It("should not add RNG when not present", func() {
 			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
 			Expect(domainSpec.Devices.Rng).To(BeNil())
@@ -2078,6 +2101,28 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].BootOrder.Order).To(Equal(bootOrder))
 			Expect(domain.Spec.Devices.Interfaces[1].BootOrder).To(BeNil())
 		})
+		It("should boot first from the network boot interface", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface1 := v1.DefaultBridgeNetworkInterface()
+			iface2 := v1.DefaultBridgeNetworkInterface()
+			net1 := v1.DefaultPodNetwork()
+			net2 := v1.DefaultPodNetwork()
+			iface1.Name = netName1
+			iface2.Name = netName2
+			bootOrder := uint(1)
+			iface1.BootOrder = &bootOrder
+			iface2.NetworkBoot = &v1.InterfaceNetworkBoot{}
+			net1.Name = netName1
+			net2.Name = netName2
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface1, *iface2}
+			vmi.Spec.Networks = []v1.Network{*net1, *net2}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Interfaces[0].BootOrder).To(Equal(&api.BootOrder{Order: 2}))
+			Expect(domain.Spec.Devices.Interfaces[1].BootOrder).To(Equal(&api.BootOrder{Order: 1}))
+			Expect(domain.Spec.Devices.Interfaces[1].Rom).To(BeNil())
+		})
 		It("Should create network configuration for masquerade interface", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 
@@ -29,15 +29,17 @@ import (
 )
 
 type KvmDomainConfigurator struct {
-	allowEmulation bool
-	kvmAvailable   bool
+	allowEmulation  bool
+	kvmAvailable    bool
+	virtualizedHost bool
 }
 
 // NewKvmDomainConfigurator creates a new hypervisor domain configurator
-func NewKvmDomainConfigurator(allowEmulation bool, kvmAvailable bool) KvmDomainConfigurator {
+func NewKvmDomainConfigurator(allowEmulation bool, kvmAvailable bool, virtualizedHost bool) KvmDomainConfigurator {
 	return KvmDomainConfigurator{
-		allowEmulation: allowEmulation,
-		kvmAvailable:   kvmAvailable,
+		allowEmulation:  allowEmulation,
+		kvmAvailable:    kvmAvailable,
+		virtualizedHost: virtualizedHost,
 	}
 }
 
@@ -53,5 +55,10 @@ func (k KvmDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 		}
 	}
 
+	// The PMU is either not emulated or costly to virtualize in a nested guest
+	if (!k.kvmAvailable || k.virtualizedHost) && domain.Spec.Features != nil && domain.Spec.Features.PMU == nil {
+		domain.Spec.Features.PMU = &api.FeatureState{State: "off"}
+	}
+
 	return nil
 }
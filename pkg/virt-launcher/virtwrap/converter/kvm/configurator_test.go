@@ -38,6 +38,7 @@ var _ = Describe("Hypervisor Domain Configurator", func() {
 	const (
 		kvmEnabled       = true
 		emulationAllowed = true
+		virtualizedHost  = true
 	)
 
 	BeforeEach(func() {
@@ -47,7 +48,7 @@ var _ = Describe("Hypervisor Domain Configurator", func() {
 
 	Context("When KVM is available", func() {
 		It("Should not modify domain type", func() {
-			configurator := kvm.NewKvmDomainConfigurator(!emulationAllowed, kvmEnabled)
+			configurator := kvm.NewKvmDomainConfigurator(!emulationAllowed, kvmEnabled, !virtualizedHost)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 			Expect(domain.Spec.Type).To(Equal(""))
 			Expect(domain).To(Equal(api.Domain{}))
@@ -55,7 +56,7 @@ var _ = Describe("Hypervisor Domain Configurator", func() {
 		})
 
 		It("Should not modify domain type even when emulation is allowed", func() {
-			configurator := kvm.NewKvmDomainConfigurator(emulationAllowed, kvmEnabled)
+			configurator := kvm.NewKvmDomainConfigurator(emulationAllowed, kvmEnabled, !virtualizedHost)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 			Expect(domain.Spec.Type).To(Equal(""))
 			Expect(domain).To(Equal(api.Domain{}))
@@ -64,16 +65,40 @@ var _ = Describe("Hypervisor Domain Configurator", func() {
 
 	Context("When KVM is not available", func() {
 		It("Should return error when emulation is not allowed", func() {
-			configurator := kvm.NewKvmDomainConfigurator(!emulationAllowed, !kvmEnabled)
+			configurator := kvm.NewKvmDomainConfigurator(!emulationAllowed, !kvmEnabled, !virtualizedHost)
 			err := configurator.Configure(vmi, &domain)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("kvm not present"))
 		})
 
 		It("Should set domain type to qemu when emulation is allowed", func() {
-			configurator := kvm.NewKvmDomainConfigurator(emulationAllowed, !kvmEnabled)
+			configurator := kvm.NewKvmDomainConfigurator(emulationAllowed, !kvmEnabled, !virtualizedHost)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 			Expect(domain.Spec.Type).To(Equal("qemu"))
 		})
+
+		It("Should disable the PMU when emulating", func() {
+			domain.Spec.Features = &api.Features{}
+			configurator := kvm.NewKvmDomainConfigurator(emulationAllowed, !kvmEnabled, !virtualizedHost)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+			Expect(domain.Spec.Features.PMU).To(Equal(&api.FeatureState{State: "off"}))
+		})
+	})
+
+	Context("When the host is a virtual machine", func() {
+		It("Should disable the PMU", func() {
+			domain.Spec.Features = &api.Features{}
+			configurator := kvm.NewKvmDomainConfigurator(!emulationAllowed, kvmEnabled, virtualizedHost)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+			Expect(domain.Spec.Type).To(Equal(""))
+			Expect(domain.Spec.Features.PMU).To(Equal(&api.FeatureState{State: "off"}))
+		})
+
+		It("Should not override the PMU state", func() {
+			domain.Spec.Features = &api.Features{PMU: &api.FeatureState{State: "on"}}
+			configurator := kvm.NewKvmDomainConfigurator(!emulationAllowed, kvmEnabled, virtualizedHost)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+			Expect(domain.Spec.Features.PMU).To(Equal(&api.FeatureState{State: "on"}))
+		})
 	})
 })
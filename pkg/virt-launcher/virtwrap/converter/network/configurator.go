@@ -140,7 +140,7 @@ func (d DomainConfigurator) tapBindingOptions(iface *v1.Interface, useLaunchSecu
 		opts = append(opts, withBootOrder(*iface.BootOrder))
 	}
 
-	if d.isROMTuningSupported && (iface.BootOrder == nil || useLaunchSecurity) {
+	if d.isROMTuningSupported && ((iface.BootOrder == nil && iface.NetworkBoot == nil) || useLaunchSecurity) {
 		opts = append(opts, withROMDisabled())
 	}
 
@@ -20,12 +20,16 @@
 package storage
 
 import (
+	"fmt"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
+const daxAliasPrefix = "fs-"
+
 type VirtiofsConfigurator struct{}
 
 func NewVirtiofsConfigurator() VirtiofsConfigurator {
@@ -38,22 +42,35 @@ func (f VirtiofsConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *
 			continue
 		}
 
-		domain.Spec.Devices.Filesystems = append(domain.Spec.Devices.Filesystems,
-			api.FilesystemDevice{
-				Type:       "mount",
-				AccessMode: "passthrough",
-				Driver: &api.FilesystemDriver{
-					Type:  "virtiofs",
-					Queue: "1024",
-				},
-				Source: &api.FilesystemSource{
-					Socket: virtiofs.VirtioFSSocketPath(fs.Name),
-				},
-				Target: &api.FilesystemTarget{
-					Dir: fs.Name,
-				},
+		filesystem := api.FilesystemDevice{
+			Type:       "mount",
+			AccessMode: "passthrough",
+			Driver: &api.FilesystemDriver{
+				Type:  "virtiofs",
+				Queue: "1024",
+			},
+			Source: &api.FilesystemSource{
+				Socket: virtiofs.VirtioFSSocketPath(fs.Name),
+			},
+			Target: &api.FilesystemTarget{
+				Dir: fs.Name,
 			},
-		)
+		}
+
+		// libvirt does not expose the DAX window, set it on the vhost-user-fs device through its alias
+		if fs.Virtiofs.DAXWindowSize != nil {
+			alias := daxAliasPrefix + fs.Name
+			filesystem.Alias = api.NewUserDefinedAlias(alias)
+			if domain.Spec.QEMUCmd == nil {
+				domain.Spec.QEMUCmd = &api.Commandline{}
+			}
+			domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg,
+				api.Arg{Value: "-set"},
+				api.Arg{Value: fmt.Sprintf("device.%s%s.cache-size=%d", api.UserAliasPrefix, alias, fs.Virtiofs.DAXWindowSize.Value())},
+			)
+		}
+
+		domain.Spec.Devices.Filesystems = append(domain.Spec.Devices.Filesystems, filesystem)
 	}
 
 	return nil
@@ -23,7 +23,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/storage"
 )
@@ -69,4 +72,24 @@ var _ = Describe("VirtioFS Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("Should set the DAX window of the filesystems requesting it", func() {
+		vmi := libvmi.New(
+			libvmi.WithFilesystemPVC("plainfs"),
+			libvmi.WithFilesystemPVC("daxfs"),
+		)
+		vmi.Spec.Domain.Devices.Filesystems[1].Virtiofs.DAXWindowSize = pointer.P(resource.MustParse("1Gi"))
+		var domain api.Domain
+
+		Expect(storage.VirtiofsConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Filesystems).To(HaveLen(2))
+		Expect(domain.Spec.Devices.Filesystems[0].Alias).To(BeNil())
+		Expect(domain.Spec.Devices.Filesystems[1].Alias).To(Equal(api.NewUserDefinedAlias("fs-daxfs")))
+		Expect(domain.Spec.QEMUCmd).ToNot(BeNil())
+		Expect(domain.Spec.QEMUCmd.QEMUArg).To(Equal([]api.Arg{
+			{Value: "-set"},
+			{Value: "device.ua-fs-daxfs.cache-size=1073741824"},
+		}))
+	})
 })
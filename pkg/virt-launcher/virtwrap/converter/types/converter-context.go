@@ -40,6 +40,7 @@ type ConverterContext struct {
 	Architecture                    arch.Converter
 	AllowEmulation                  bool
 	HypervisorDeviceAvailable       bool
+	VirtualizedHost                 bool
 	VirtualMachine                  *v1.VirtualMachineInstance
 	CPUSet                          []int
 	IsBlockPVC                      map[string]bool
@@ -185,6 +185,14 @@ var _ = Describe("NumaPlacement", func() {
 			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
 
 			Expect(givenSpec.MemoryBacking.NoSharePages).To(Equal(&api.NoSharePages{}))
+			Expect(givenSpec.MemoryBacking.Locked).To(BeNil())
+		})
+		It("should lock the memory when tuned for the lowLatency real time profile", func() {
+			givenVMI.Spec.Domain.CPU = &v1.CPU{Realtime: &v1.Realtime{Profile: v1.RealtimeProfileLowLatency}}
+			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+
+			Expect(givenSpec.MemoryBacking.NoSharePages).To(Equal(&api.NoSharePages{}))
+			Expect(givenSpec.MemoryBacking.Locked).To(Equal(&api.MemoryLocked{}))
 		})
 	})
 })
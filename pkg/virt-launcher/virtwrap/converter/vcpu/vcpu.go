@@ -617,6 +617,55 @@ func GetVirtualMemory(vmi *v12.VirtualMachineInstance) *resource.Quantity {
 	return &reqMemory
 }
 
+// FormatGuestNUMATopology creates the virtual numa nodes of the guest for the evenSplit
+// and the explicit cells NUMA strategies. The vCPUs are expected to be already set on the domain.
+func FormatGuestNUMATopology(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec) error {
+	if vmi.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU.NUMA == nil || domain.VCPU == nil {
+		return nil
+	}
+	numa := vmi.Spec.Domain.CPU.NUMA
+
+	switch {
+	case numa.EvenSplit != nil:
+		nodes := numa.EvenSplit.Nodes
+		if nodes == 0 || domain.VCPU.CPUs%nodes != 0 {
+			return fmt.Errorf("cannot split %d vCPUs evenly between %d numa nodes", domain.VCPU.CPUs, nodes)
+		}
+		memory, err := QuantityToByte(*GetVirtualMemory(vmi))
+		if err != nil {
+			return fmt.Errorf("could not convert VMI memory to quantity: %v", err)
+		}
+		cpusPerNode := domain.VCPU.CPUs / nodes
+		memoryPerNodeKiB := memory.Value / 1024 / uint64(nodes)
+
+		domain.CPU.NUMA = &api.NUMA{}
+		for i := uint32(0); i < nodes; i++ {
+			domain.CPU.NUMA.Cells = append(domain.CPU.NUMA.Cells, api.NUMACell{
+				ID:     strconv.Itoa(int(i)),
+				CPUs:   fmt.Sprintf("%d-%d", i*cpusPerNode, (i+1)*cpusPerNode-1),
+				Memory: memoryPerNodeKiB,
+				Unit:   "KiB",
+			})
+		}
+	case len(numa.Cells) > 0:
+		domain.CPU.NUMA = &api.NUMA{}
+		for i, cell := range numa.Cells {
+			memory, err := QuantityToByte(cell.Memory)
+			if err != nil {
+				return fmt.Errorf("could not convert memory of numa node %d to quantity: %v", i, err)
+			}
+			domain.CPU.NUMA.Cells = append(domain.CPU.NUMA.Cells, api.NUMACell{
+				ID:     strconv.Itoa(i),
+				CPUs:   cell.CPUs,
+				Memory: memory.Value / 1024,
+				Unit:   "KiB",
+			})
+		}
+	}
+
+	return nil
+}
+
 // numaMapping maps numa nodes based on already applied VCPU pinning. The sort result is stable compared to the order
 // of provided host numa nodes.
 func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topology *v1.Topology) error {
@@ -707,6 +756,9 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 	if vmi.IsRealtimeEnabled() {
 		// RT settings when hugepages are enabled
 		domain.MemoryBacking.NoSharePages = &api.NoSharePages{}
+		if vmi.Spec.Domain.CPU.Realtime.Profile == v12.RealtimeProfileLowLatency {
+			domain.MemoryBacking.Locked = &api.MemoryLocked{}
+		}
 	}
 	return nil
 }
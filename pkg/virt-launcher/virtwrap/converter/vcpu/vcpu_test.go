@@ -11,6 +11,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	corev1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -216,6 +217,55 @@ var _ = Describe("VCPU pinning", func() {
 	})
 })
 
+var _ = Describe("Guest NUMA topology", func() {
+	var domain *api.DomainSpec
+	var vmi *corev1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		domain = &api.DomainSpec{VCPU: &api.VCPU{Placement: "static", CPUs: 4}}
+		guestMemory := resource.MustParse("2Gi")
+		vmi = &corev1.VirtualMachineInstance{
+			Spec: corev1.VirtualMachineInstanceSpec{
+				Domain: corev1.DomainSpec{
+					CPU:    &corev1.CPU{Cores: 4, NUMA: &corev1.NUMA{}},
+					Memory: &corev1.Memory{Guest: &guestMemory},
+				},
+			},
+		}
+	})
+
+	It("should not create numa nodes without a guest NUMA strategy", func() {
+		Expect(FormatGuestNUMATopology(vmi, domain)).To(Succeed())
+		Expect(domain.CPU.NUMA).To(BeNil())
+	})
+
+	It("should split vCPUs and memory evenly between the numa nodes", func() {
+		vmi.Spec.Domain.CPU.NUMA.EvenSplit = &corev1.NUMAEvenSplit{Nodes: 2}
+		Expect(FormatGuestNUMATopology(vmi, domain)).To(Succeed())
+		Expect(domain.CPU.NUMA.Cells).To(Equal([]api.NUMACell{
+			{ID: "0", CPUs: "0-1", Memory: 1048576, Unit: "KiB"},
+			{ID: "1", CPUs: "2-3", Memory: 1048576, Unit: "KiB"},
+		}))
+	})
+
+	It("should fail when vCPUs cannot be split evenly", func() {
+		vmi.Spec.Domain.CPU.NUMA.EvenSplit = &corev1.NUMAEvenSplit{Nodes: 3}
+		Expect(FormatGuestNUMATopology(vmi, domain)).To(MatchError("cannot split 4 vCPUs evenly between 3 numa nodes"))
+	})
+
+	It("should create the explicitly declared numa nodes", func() {
+		vmi.Spec.Domain.CPU.NUMA.Cells = []corev1.NUMACell{
+			{CPUs: "0", Memory: resource.MustParse("512Mi")},
+			{CPUs: "1-3", Memory: resource.MustParse("1536Mi")},
+		}
+		Expect(FormatGuestNUMATopology(vmi, domain)).To(Succeed())
+		Expect(domain.CPU.NUMA.Cells).To(Equal([]api.NUMACell{
+			{ID: "0", CPUs: "0", Memory: 524288, Unit: "KiB"},
+			{ID: "1", CPUs: "1-3", Memory: 1572864, Unit: "KiB"},
+		}))
+	})
+})
+
 func shuffleCPUSet(cpuSet ...int) []int {
 	rand.Shuffle(len(cpuSet), func(i, j int) { cpuSet[i], cpuSet[j] = cpuSet[j], cpuSet[i] })
 	return cpuSet
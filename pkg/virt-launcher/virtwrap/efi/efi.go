@@ -38,6 +38,19 @@ const (
 	EFICodeTDXSecureBoot = "OVMF.inteltdx.secboot.fd"
 )
 
+// Keys of the firmware images which replace the images detected under their default names.
+const (
+	ImageCode              = "code"
+	ImageVars              = "vars"
+	ImageSecureBootCode    = "secureBootCode"
+	ImageSecureBootVars    = "secureBootVars"
+	ImageSEVCode           = "sevCode"
+	ImageSEVVars           = "sevVars"
+	ImageSNPCode           = "snpCode"
+	ImageTDXCode           = "tdxCode"
+	ImageTDXSecureBootCode = "tdxSecureBootCode"
+)
+
 type EFIEnvironment struct {
 	code              string
 	vars              string
@@ -142,39 +155,54 @@ func (e *EFIEnvironment) EFIVars(secureBoot bool, vmType SecureVMType) string {
 	}
 }
 
-func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
-	if arch == "arm64" {
-		codeArm64 := getEFIBinaryIfExists(ovmfPath, EFICodeAARCH64)
-		varsArm64 := getEFIBinaryIfExists(ovmfPath, EFIVarsAARCH64)
+// DetectEFIEnvironment looks the EFI firmware images up in ovmfPath. The file names in images, keyed by
+// ImageCode, ImageSecureBootCode, etc., replace the default names of the images.
+func DetectEFIEnvironment(arch, ovmfPath string, images map[string]string) *EFIEnvironment {
+	lookup := func(key, defaultBinary string) string {
+		if binary := images[key]; binary != "" {
+			return getEFIBinaryIfExists(ovmfPath, binary)
+		}
+		if defaultBinary == "" {
+			return ""
+		}
+		return getEFIBinaryIfExists(ovmfPath, defaultBinary)
+	}
 
+	if arch == "arm64" {
+		// There are no default SecureBoot images for arm64, they have to be configured
 		return &EFIEnvironment{
-			code: codeArm64,
-			vars: varsArm64,
+			code:           lookup(ImageCode, EFICodeAARCH64),
+			vars:           lookup(ImageVars, EFIVarsAARCH64),
+			codeSecureBoot: lookup(ImageSecureBootCode, ""),
+			varsSecureBoot: lookup(ImageSecureBootVars, ""),
 		}
 	}
 
 	// detect EFI with SecureBoot
-	codeWithSB := getEFIBinaryIfExists(ovmfPath, EFICodeSecureBoot)
-	varsWithSB := getEFIBinaryIfExists(ovmfPath, EFIVarsSecureBoot)
+	codeWithSB := lookup(ImageSecureBootCode, EFICodeSecureBoot)
+	varsWithSB := lookup(ImageSecureBootVars, EFIVarsSecureBoot)
 
 	// detect EFI without SecureBoot
 	// The combination (EFICodeSecureBoot + EFIVars) is valid for booting in EFI
 	// mode with SecureBoot disabled, and is preferred over plain EFICode which
 	// lacks SecureBoot infrastructure entirely.
-	code := codeWithSB
-	if code == "" {
-		code = getEFIBinaryIfExists(ovmfPath, EFICode)
+	code := lookup(ImageCode, "")
+	if images[ImageCode] == "" {
+		code = codeWithSB
+		if code == "" {
+			code = getEFIBinaryIfExists(ovmfPath, EFICode)
+		}
 	}
-	vars := getEFIBinaryIfExists(ovmfPath, EFIVars)
+	vars := lookup(ImageVars, EFIVars)
 
 	// detect EFI with SEV
-	codeWithSEV := getEFIBinaryIfExists(ovmfPath, EFICodeSEV)
-	varsWithSEV := getEFIBinaryIfExists(ovmfPath, EFIVarsSEV)
-	codeWithSNP := getEFIBinaryIfExists(ovmfPath, EFICodeSNP)
+	codeWithSEV := lookup(ImageSEVCode, EFICodeSEV)
+	varsWithSEV := lookup(ImageSEVVars, EFIVarsSEV)
+	codeWithSNP := lookup(ImageSNPCode, EFICodeSNP)
 
 	// detect EFI with TDX
-	codeWithTDX := getEFIBinaryIfExists(ovmfPath, EFICodeTDX)
-	codeWithTDXSB := getEFIBinaryIfExists(ovmfPath, EFICodeTDXSecureBoot)
+	codeWithTDX := lookup(ImageTDXCode, EFICodeTDX)
+	codeWithTDXSB := lookup(ImageTDXSecureBootCode, EFICodeTDXSecureBoot)
 
 	return &EFIEnvironment{
 		codeSecureBoot:    codeWithSB,
@@ -52,7 +52,7 @@ var _ = Describe("EFI environment detection", func() {
 			ovmfPath := createEFIRoms(codeSB, varsSB, code, vars)
 			defer os.RemoveAll(ovmfPath)
 
-			efiEnv := DetectEFIEnvironment(arch, ovmfPath)
+			efiEnv := DetectEFIEnvironment(arch, ovmfPath, nil)
 			Expect(efiEnv).ToNot(BeNil())
 
 			Expect(efiEnv.Bootable(secureBootEnabled, None)).To(Equal(SBBootable))
@@ -84,7 +84,7 @@ var _ = Describe("EFI environment detection", func() {
 		ovmfPath := createEFIRoms(EFICodeSecureBoot, EFIVarsSecureBoot, EFICode, EFIVars)
 		defer os.RemoveAll(ovmfPath)
 
-		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath)
+		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath, nil)
 		Expect(efiEnv).ToNot(BeNil())
 
 		Expect(efiEnv.Bootable(!secureBootEnabled, None)).To(BeTrue())
@@ -101,7 +101,7 @@ var _ = Describe("EFI environment detection", func() {
 		ovmfPath := createEFIRoms(EFICodeSEV, EFIVars, EFICodeSNP)
 		defer os.RemoveAll(ovmfPath)
 
-		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath)
+		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath, nil)
 		Expect(efiEnv).ToNot(BeNil())
 
 		Expect(efiEnv.Bootable(secureBootEnabled, None)).To(BeFalse())
@@ -133,7 +133,7 @@ var _ = Describe("EFI environment detection", func() {
 		ovmfPath := createEFIRoms(EFICodeTDX, EFICodeTDXSecureBoot)
 		defer os.RemoveAll(ovmfPath)
 
-		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath)
+		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath, nil)
 		Expect(efiEnv).ToNot(BeNil())
 
 		Expect(efiEnv.Bootable(secureBootEnabled, TDX)).To(BeTrue())
@@ -154,4 +154,51 @@ var _ = Describe("EFI environment detection", func() {
 		Expect(efiEnv.EFIVars(secureBootEnabled, TDX)).To(Equal(""))
 		Expect(efiEnv.EFIVars(!secureBootEnabled, TDX)).To(Equal(""))
 	})
+	Context("with configured images", func() {
+		It("should replace the default images", func() {
+			ovmfPath := createEFIRoms(EFICodeSecureBoot, EFIVarsSecureBoot, EFICode, EFIVars, "OVMF_CODE_4M.ms.fd", "OVMF_VARS_4M.ms.fd", "OVMF.custom-sev.fd")
+			defer os.RemoveAll(ovmfPath)
+
+			efiEnv := DetectEFIEnvironment("x86_64", ovmfPath, map[string]string{
+				ImageCode:           EFICode,
+				ImageSecureBootCode: "OVMF_CODE_4M.ms.fd",
+				ImageSecureBootVars: "OVMF_VARS_4M.ms.fd",
+				ImageSEVCode:        "OVMF.custom-sev.fd",
+			})
+
+			Expect(efiEnv.EFICode(!secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFICode)))
+			Expect(efiEnv.EFIVars(!secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFIVars)))
+			Expect(efiEnv.EFICode(secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, "OVMF_CODE_4M.ms.fd")))
+			Expect(efiEnv.EFIVars(secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, "OVMF_VARS_4M.ms.fd")))
+			Expect(efiEnv.EFICode(!secureBootEnabled, SEV)).To(Equal(filepath.Join(ovmfPath, "OVMF.custom-sev.fd")))
+			Expect(efiEnv.EFIVars(!secureBootEnabled, SEV)).To(Equal(filepath.Join(ovmfPath, EFIVars)))
+		})
+
+		It("should not fall back to the default images if a configured image is missing", func() {
+			ovmfPath := createEFIRoms(EFICodeSecureBoot, EFIVarsSecureBoot, EFICode, EFIVars)
+			defer os.RemoveAll(ovmfPath)
+
+			efiEnv := DetectEFIEnvironment("x86_64", ovmfPath, map[string]string{
+				ImageCode:           "OVMF_CODE_4M.fd",
+				ImageSecureBootCode: "OVMF_CODE_4M.ms.fd",
+			})
+
+			Expect(efiEnv.Bootable(secureBootEnabled, None)).To(BeFalse())
+			Expect(efiEnv.Bootable(!secureBootEnabled, None)).To(BeFalse())
+		})
+
+		It("should detect SecureBoot images for arm64", func() {
+			ovmfPath := createEFIRoms(EFICodeAARCH64, EFIVarsAARCH64, "AAVMF_CODE.ms.fd", "AAVMF_VARS.ms.fd")
+			defer os.RemoveAll(ovmfPath)
+
+			efiEnv := DetectEFIEnvironment("arm64", ovmfPath, map[string]string{
+				ImageSecureBootCode: "AAVMF_CODE.ms.fd",
+				ImageSecureBootVars: "AAVMF_VARS.ms.fd",
+			})
+
+			Expect(efiEnv.EFICode(!secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, EFICodeAARCH64)))
+			Expect(efiEnv.EFICode(secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, "AAVMF_CODE.ms.fd")))
+			Expect(efiEnv.EFIVars(secureBootEnabled, None)).To(Equal(filepath.Join(ovmfPath, "AAVMF_VARS.ms.fd")))
+		})
+	})
 })
@@ -19,6 +19,7 @@ import (
 
 	v10 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	agent "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
@@ -89,6 +90,20 @@ func (mr *MockDomainManagerMockRecorder) DeleteVMI(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMI", reflect.TypeOf((*MockDomainManager)(nil).DeleteVMI), arg0)
 }
 
+// EjectCloudInit mocks base method.
+func (m *MockDomainManager) EjectCloudInit(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EjectCloudInit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EjectCloudInit indicates an expected call of EjectCloudInit.
+func (mr *MockDomainManagerMockRecorder) EjectCloudInit(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EjectCloudInit", reflect.TypeOf((*MockDomainManager)(nil).EjectCloudInit), arg0)
+}
+
 // Exec mocks base method.
 func (m *MockDomainManager) Exec(arg0, arg1 string, arg2 []string, arg3 int32) (string, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +192,21 @@ func (mr *MockDomainManagerMockRecorder) GetDomainStats() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainStats", reflect.TypeOf((*MockDomainManager)(nil).GetDomainStats))
 }
 
+// GetDomainXML mocks base method.
+func (m *MockDomainManager) GetDomainXML(vmi *v1.VirtualMachineInstance) (*v10.DomainXMLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainXML", vmi)
+	ret0, _ := ret[0].(*v10.DomainXMLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainXML indicates an expected call of GetDomainXML.
+func (mr *MockDomainManagerMockRecorder) GetDomainXML(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainXML", reflect.TypeOf((*MockDomainManager)(nil).GetDomainXML), vmi)
+}
+
 // GetFilesystems mocks base method.
 func (m *MockDomainManager) GetFilesystems() []v1.VirtualMachineInstanceFileSystem {
 	m.ctrl.T.Helper()
@@ -307,6 +337,50 @@ func (mr *MockDomainManagerMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockDomainManager)(nil).GetUsers))
 }
 
+// GuestExecStart mocks base method.
+func (m *MockDomainManager) GuestExecStart(arg0 *v1.VirtualMachineInstance, arg1 string, arg2 []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStart indicates an expected call of GuestExecStart.
+func (mr *MockDomainManagerMockRecorder) GuestExecStart(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStart", reflect.TypeOf((*MockDomainManager)(nil).GuestExecStart), arg0, arg1, arg2)
+}
+
+// GuestExecStatus mocks base method.
+func (m *MockDomainManager) GuestExecStatus(arg0 *v1.VirtualMachineInstance, arg1 int64) (*agent.GuestExecStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStatus", arg0, arg1)
+	ret0, _ := ret[0].(*agent.GuestExecStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStatus indicates an expected call of GuestExecStatus.
+func (mr *MockDomainManagerMockRecorder) GuestExecStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStatus", reflect.TypeOf((*MockDomainManager)(nil).GuestExecStatus), arg0, arg1)
+}
+
+// GuestFileExists mocks base method.
+func (m *MockDomainManager) GuestFileExists(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileExists indicates an expected call of GuestFileExists.
+func (mr *MockDomainManagerMockRecorder) GuestFileExists(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileExists", reflect.TypeOf((*MockDomainManager)(nil).GuestFileExists), arg0, arg1)
+}
+
 // GuestPing mocks base method.
 func (m *MockDomainManager) GuestPing(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -460,6 +534,21 @@ func (mr *MockDomainManagerMockRecorder) PrepareMigrationTarget(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareMigrationTarget", reflect.TypeOf((*MockDomainManager)(nil).PrepareMigrationTarget), arg0, arg1, arg2)
 }
 
+// ReadGuestFile mocks base method.
+func (m *MockDomainManager) ReadGuestFile(arg0 *v1.VirtualMachineInstance, arg1 string, arg2 int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGuestFile", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGuestFile indicates an expected call of ReadGuestFile.
+func (mr *MockDomainManagerMockRecorder) ReadGuestFile(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGuestFile", reflect.TypeOf((*MockDomainManager)(nil).ReadGuestFile), arg0, arg1, arg2)
+}
+
 // RedefineCheckpoint mocks base method.
 func (m *MockDomainManager) RedefineCheckpoint(arg0 *v1.VirtualMachineInstance, arg1 *v1alpha1.BackupCheckpoint) (bool, error) {
 	m.ctrl.T.Helper()
@@ -475,6 +564,20 @@ func (mr *MockDomainManagerMockRecorder) RedefineCheckpoint(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedefineCheckpoint", reflect.TypeOf((*MockDomainManager)(nil).RedefineCheckpoint), arg0, arg1)
 }
 
+// RefreshGuestInfo mocks base method.
+func (m *MockDomainManager) RefreshGuestInfo(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshGuestInfo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshGuestInfo indicates an expected call of RefreshGuestInfo.
+func (mr *MockDomainManagerMockRecorder) RefreshGuestInfo(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGuestInfo", reflect.TypeOf((*MockDomainManager)(nil).RefreshGuestInfo), arg0)
+}
+
 // ResetVMI mocks base method.
 func (m *MockDomainManager) ResetVMI(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -489,6 +592,20 @@ func (mr *MockDomainManagerMockRecorder) ResetVMI(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVMI", reflect.TypeOf((*MockDomainManager)(nil).ResetVMI), arg0)
 }
 
+// RotateCloudInit mocks base method.
+func (m *MockDomainManager) RotateCloudInit(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCloudInit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateCloudInit indicates an expected call of RotateCloudInit.
+func (mr *MockDomainManagerMockRecorder) RotateCloudInit(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCloudInit", reflect.TypeOf((*MockDomainManager)(nil).RotateCloudInit), arg0)
+}
+
 // SignalShutdownVMI mocks base method.
 func (m *MockDomainManager) SignalShutdownVMI(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -517,6 +634,20 @@ func (mr *MockDomainManagerMockRecorder) SoftRebootVMI(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftRebootVMI", reflect.TypeOf((*MockDomainManager)(nil).SoftRebootVMI), arg0)
 }
 
+// SuspendVMI mocks base method.
+func (m *MockDomainManager) SuspendVMI(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendVMI", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendVMI indicates an expected call of SuspendVMI.
+func (mr *MockDomainManagerMockRecorder) SuspendVMI(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendVMI", reflect.TypeOf((*MockDomainManager)(nil).SuspendVMI), arg0)
+}
+
 // SyncVMI mocks base method.
 func (m *MockDomainManager) SyncVMI(arg0 *v1.VirtualMachineInstance, arg1 bool, arg2 *v10.VirtualMachineOptions) (*api.DomainSpec, error) {
 	m.ctrl.T.Helper()
@@ -587,3 +718,17 @@ func (mr *MockDomainManagerMockRecorder) UpdateVCPUs(vmi, options any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVCPUs", reflect.TypeOf((*MockDomainManager)(nil).UpdateVCPUs), vmi, options)
 }
+
+// WriteGuestFile mocks base method.
+func (m *MockDomainManager) WriteGuestFile(arg0 *v1.VirtualMachineInstance, arg1 string, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteGuestFile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteGuestFile indicates an expected call of WriteGuestFile.
+func (mr *MockDomainManagerMockRecorder) WriteGuestFile(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGuestFile", reflect.TypeOf((*MockDomainManager)(nil).WriteGuestFile), arg0, arg1, arg2)
+}
@@ -76,6 +76,7 @@ var _ = Describe("Live migration source", func() {
 				testEphemeralDiskDir,
 				nil, // agent store
 				virtconfig.DefaultARCHOVMFPath,
+				nil, // EFI firmware images
 				ephemeralDiskCreatorMock,
 				metadataCache,
 				nil, //stop chn
@@ -87,6 +88,7 @@ var _ = Describe("Live migration source", func() {
 				v1.KvmHypervisorName,
 				nil,
 				"", false,
+				DomainStatsOptions{},
 			)
 			libvirtDomainManager = manager.(*LibvirtDomainManager)
 			libvirtDomainManager.initializeMigrationMetadata(vmi, v1.MigrationPreCopy)
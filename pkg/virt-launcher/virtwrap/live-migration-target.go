@@ -66,7 +66,9 @@ func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineIns
 		}
 	}
 
-	l.setGuestTime(vmi)
+	if guestTimeSyncAfterMigration(vmi) {
+		l.setGuestTime(vmi)
+	}
 	return nil
 }
 
@@ -161,6 +163,7 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 	options *cmdv1.VirtualMachineOptions,
 ) error {
 	logger := log.Log.Object(vmi)
+	l.setGuestAgentCommandPolicy(vmi, options)
 	if l.imageVolumeFeatureGateEnabled {
 		err := l.linkImageVolumeFilePaths(vmi)
 		if err != nil {
@@ -80,6 +80,7 @@ import (
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/storage/volumepath"
@@ -92,6 +93,7 @@ import (
 	premigrationhookserver "kubevirt.io/kubevirt/pkg/virt-launcher/premigration-hook-server"
 	accesscredentials "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/access-credentials"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
 	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -134,6 +136,42 @@ const (
 
 const maxConcurrentHotplugHostDevices = 1
 
+const procCPUInfoPath = "/proc/cpuinfo"
+
+const (
+	defaultDomainStatsCollectionInterval = 3250 * time.Millisecond
+
+	allDomainStatsTypes = libvirt.DOMAIN_STATS_BALLOON | libvirt.DOMAIN_STATS_CPU_TOTAL | libvirt.DOMAIN_STATS_VCPU | libvirt.DOMAIN_STATS_INTERFACE | libvirt.DOMAIN_STATS_BLOCK | libvirt.DOMAIN_STATS_DIRTYRATE
+)
+
+// DomainStatsOptions tune the periodic collection of the domain statistics from libvirt.
+type DomainStatsOptions struct {
+	// CollectionInterval defaults to defaultDomainStatsCollectionInterval if zero.
+	CollectionInterval time.Duration
+	// DisabledStats are the statistics groups which are not requested from libvirt.
+	DisabledStats libvirt.DomainStatsTypes
+}
+
+// DomainStatsTypesForCollectors maps the domain stats collectors which can be
+// disabled through the KubeVirt CR to the libvirt statistics groups they need.
+// Unknown collectors, e.g. passed by a newer virt-controller, are ignored.
+func DomainStatsTypesForCollectors(collectors []v1.DomainStatsCollector) libvirt.DomainStatsTypes {
+	var statsTypes libvirt.DomainStatsTypes
+	for _, collector := range collectors {
+		switch collector {
+		case v1.DomainStatsCollectorBlock:
+			statsTypes |= libvirt.DOMAIN_STATS_BLOCK
+		case v1.DomainStatsCollectorVCPU:
+			statsTypes |= libvirt.DOMAIN_STATS_VCPU
+		case v1.DomainStatsCollectorNetwork:
+			statsTypes |= libvirt.DOMAIN_STATS_INTERFACE
+		default:
+			log.Log.Warningf("Ignoring the unknown domain stats collector %q", collector)
+		}
+	}
+	return statsTypes
+}
+
 var agentDataCommandTTLs = map[string]time.Duration{
 	// 20sec
 	"guest-get-load":      twentySeconds,
@@ -166,6 +204,9 @@ type contextStore struct {
 type DomainManager interface {
 	SyncVMI(*v1.VirtualMachineInstance, bool, *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error)
 	PauseVMI(*v1.VirtualMachineInstance) error
+	SuspendVMI(*v1.VirtualMachineInstance) error
+	RotateCloudInit(*v1.VirtualMachineInstance) error
+	EjectCloudInit(*v1.VirtualMachineInstance) error
 	UnpauseVMI(*v1.VirtualMachineInstance) error
 	FreezeVMI(*v1.VirtualMachineInstance, int32) error
 	UnfreezeVMI(*v1.VirtualMachineInstance) error
@@ -181,6 +222,7 @@ type DomainManager interface {
 	GetDomainStats() (*stats.DomainStats, error)
 	CancelVMIMigration(*v1.VirtualMachineInstance) error
 	GetGuestInfo() v1.VirtualMachineInstanceGuestAgentInfo
+	RefreshGuestInfo(*v1.VirtualMachineInstance) error
 	GetUsers() []v1.VirtualMachineInstanceGuestOSUser
 	GetFilesystems() []v1.VirtualMachineInstanceFileSystem
 	FinalizeVirtualMachineMigration(*v1.VirtualMachineInstance, *cmdv1.VirtualMachineOptions) error
@@ -189,6 +231,11 @@ type DomainManager interface {
 	GetGuestOSInfo() *api.GuestOSInfo
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
+	GuestFileExists(string, string) error
+	ReadGuestFile(*v1.VirtualMachineInstance, string, int) ([]byte, error)
+	WriteGuestFile(*v1.VirtualMachineInstance, string, []byte) error
+	GuestExecStart(*v1.VirtualMachineInstance, string, []string) (int64, error)
+	GuestExecStatus(*v1.VirtualMachineInstance, int64) (*agent.GuestExecStatus, error)
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	BackupVirtualMachine(*v1.VirtualMachineInstance, *backupv1.BackupOptions) error
 	RedefineCheckpoint(*v1.VirtualMachineInstance, *backupv1.BackupCheckpoint) (checkpointInvalid bool, err error)
@@ -200,6 +247,7 @@ type DomainManager interface {
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
 	GetDomainDirtyRateStats(calculationDuration time.Duration) (*stats.DomainStatsDirtyRate, error)
 	GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
+	GetDomainXML(vmi *v1.VirtualMachineInstance) (*cmdv1.DomainXMLResponse, error)
 	GetGuestAgentVersion() string
 	GetAgentData(dataKey string) (string, error)
 }
@@ -216,6 +264,10 @@ type LibvirtDomainManager struct {
 	storageManager *storage.StorageManager
 
 	hotplugHostDevicesInProgress chan struct{}
+	suspendInProgress            chan struct{}
+	cloudInitRotationInProgress  chan struct{}
+
+	guestExecs agent.GuestExecs
 
 	virtShareDir           string
 	ephemeralDiskDir       string
@@ -232,12 +284,14 @@ type LibvirtDomainManager struct {
 
 	metadataCache             *metadata.Cache
 	domainStatsCache          *virtcache.TimeDefinedCache[*stats.DomainStats]
+	domainStatsTypes          libvirt.DomainStatsTypes
 	domainDirtyRateStatsCache *virtcache.TimeDefinedCache[*stats.DomainStatsDirtyRate]
 	agentDataCaches           map[string]*virtcache.TimeDefinedCache[string]
 
 	// Device aliasas are updated only through hotplug events and SyncVMI
-	devAliasMap  map[string]string
-	devAliasLock sync.RWMutex
+	devAliasMap         map[string]string
+	diskAddressAliasMap map[string]string
+	devAliasLock        sync.RWMutex
 
 	cpuSetGetter                       func() ([]int, error)
 	imageVolumeFeatureGateEnabled      bool
@@ -249,6 +303,10 @@ type LibvirtDomainManager struct {
 
 	hypervisorDeviceAvailable bool
 	hypervisorName            string
+	virtualizedHost           bool
+
+	// preShutdownHook tracks the pre-shutdown hook executed in the guest, it is protected by the domainModifyLock
+	preShutdownHook *preShutdownHookExecution
 }
 
 type pausedVMIs struct {
@@ -275,15 +333,15 @@ func (s pausedVMIs) contains(uid types.UID) bool {
 }
 
 func NewLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralDiskDir string, agentStore *agentpoller.AsyncAgentStore,
-	ovmfPath string, ephemeralDiskCreator ephemeraldisk.EphemeralDiskCreatorInterface, metadataCache *metadata.Cache,
-	stopChan chan struct{}, diskMemoryLimitBytes int64, cpuSetGetter func() ([]int, error), imageVolumeEnabled bool, libvirtHooksServerAndClientEnabled bool, hookServer *premigrationhookserver.PreMigrationHookServer, hypervisorName string, registerNBD storage.RegisterNBDFunc, domainName string, vmStatsCollectorEnabled bool) (DomainManager, error) {
+	ovmfPath string, efiFirmwareImages map[string]string, ephemeralDiskCreator ephemeraldisk.EphemeralDiskCreatorInterface, metadataCache *metadata.Cache,
+	stopChan chan struct{}, diskMemoryLimitBytes int64, cpuSetGetter func() ([]int, error), imageVolumeEnabled bool, libvirtHooksServerAndClientEnabled bool, hookServer *premigrationhookserver.PreMigrationHookServer, hypervisorName string, registerNBD storage.RegisterNBDFunc, domainName string, vmStatsCollectorEnabled bool, domainStatsOptions DomainStatsOptions) (DomainManager, error) {
 	directIOChecker := converter.NewDirectIOChecker()
-	return newLibvirtDomainManager(connection, virtShareDir, ephemeralDiskDir, agentStore, ovmfPath, ephemeralDiskCreator, directIOChecker, metadataCache, stopChan, diskMemoryLimitBytes, cpuSetGetter, imageVolumeEnabled, libvirtHooksServerAndClientEnabled, hookServer, hypervisorName, registerNBD, domainName, vmStatsCollectorEnabled)
+	return newLibvirtDomainManager(connection, virtShareDir, ephemeralDiskDir, agentStore, ovmfPath, efiFirmwareImages, ephemeralDiskCreator, directIOChecker, metadataCache, stopChan, diskMemoryLimitBytes, cpuSetGetter, imageVolumeEnabled, libvirtHooksServerAndClientEnabled, hookServer, hypervisorName, registerNBD, domainName, vmStatsCollectorEnabled, domainStatsOptions)
 }
 
 func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralDiskDir string, agentStore *agentpoller.AsyncAgentStore, ovmfPath string,
-	ephemeralDiskCreator ephemeraldisk.EphemeralDiskCreatorInterface, directIOChecker converter.DirectIOChecker, metadataCache *metadata.Cache,
-	stopChan chan struct{}, diskMemoryLimitBytes int64, cpuSetGetter func() ([]int, error), imageVolumeEnabled bool, libvirtHooksServerAndClientEnabled bool, hookServer *premigrationhookserver.PreMigrationHookServer, hypervisorName string, registerNBD storage.RegisterNBDFunc, domainName string, vmStatsCollectorEnabled bool) (DomainManager, error) {
+	efiFirmwareImages map[string]string, ephemeralDiskCreator ephemeraldisk.EphemeralDiskCreatorInterface, directIOChecker converter.DirectIOChecker, metadataCache *metadata.Cache,
+	stopChan chan struct{}, diskMemoryLimitBytes int64, cpuSetGetter func() ([]int, error), imageVolumeEnabled bool, libvirtHooksServerAndClientEnabled bool, hookServer *premigrationhookserver.PreMigrationHookServer, hypervisorName string, registerNBD storage.RegisterNBDFunc, domainName string, vmStatsCollectorEnabled bool, domainStatsOptions DomainStatsOptions) (DomainManager, error) {
 
 	// Check hypervisor device availability
 	hypervisorDevicePath := "/dev/" + hypervisor.NewLauncherHypervisorResources(hypervisorName).GetHypervisorDevice()
@@ -306,11 +364,12 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 		},
 
 		agentData:            agentStore,
-		efiEnvironment:       efi.DetectEFIEnvironment(runtime.GOARCH, ovmfPath),
+		efiEnvironment:       efi.DetectEFIEnvironment(runtime.GOARCH, ovmfPath, efiFirmwareImages),
 		ephemeralDiskCreator: ephemeralDiskCreator,
 		directIOChecker:      directIOChecker,
 		disksInfo:            map[string]*osdisk.DiskInfo{},
 		domainInfoStats:      &stats.DomainJobInfo{},
+		domainStatsTypes:     allDomainStatsTypes &^ domainStatsOptions.DisabledStats,
 
 		metadataCache:                      metadataCache,
 		cpuSetGetter:                       cpuSetGetter,
@@ -320,9 +379,12 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 		hookServer:                         hookServer,
 		hypervisorName:                     hypervisorName,
 		hypervisorDeviceAvailable:          hypervisorDeviceAvailable,
+		virtualizedHost:                    isVirtualizedHost(procCPUInfoPath),
 	}
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
+	manager.suspendInProgress = make(chan struct{}, 1)
+	manager.cloudInitRotationInProgress = make(chan struct{}, 1)
 	manager.storageManager = storage.NewStorageManager(connection, metadataCache, registerNBD)
 	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache)
 
@@ -342,7 +404,11 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 	}
 
 	var err error
-	manager.domainStatsCache, err = virtcache.NewTimeDefinedCache(3250*time.Millisecond, true, reCalcDomainStats)
+	domainStatsCollectionInterval := defaultDomainStatsCollectionInterval
+	if domainStatsOptions.CollectionInterval > 0 {
+		domainStatsCollectionInterval = domainStatsOptions.CollectionInterval
+	}
+	manager.domainStatsCache, err = virtcache.NewTimeDefinedCache(domainStatsCollectionInterval, true, reCalcDomainStats)
 	if err != nil {
 		return nil, err
 	}
@@ -454,7 +520,7 @@ func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) {
 			}()
 
 			ctx := l.getGuestTimeContext()
-			timeout := time.After(60 * time.Second)
+			timeout := time.After(guestTimeSyncTimeout(vmi))
 			ticker := time.NewTicker(time.Second)
 			defer ticker.Stop()
 			for {
@@ -504,6 +570,28 @@ func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) {
 	})
 }
 
+func guestTimeSyncAfterMigration(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.Clock == nil || vmi.Spec.Domain.Clock.GuestTimeSync == nil {
+		return true
+	}
+	return vmi.Spec.Domain.Clock.GuestTimeSync.AfterMigration == nil || *vmi.Spec.Domain.Clock.GuestTimeSync.AfterMigration
+}
+
+func guestTimeSyncAfterUnpause(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.Clock == nil || vmi.Spec.Domain.Clock.GuestTimeSync == nil {
+		return true
+	}
+	return vmi.Spec.Domain.Clock.GuestTimeSync.AfterUnpause == nil || *vmi.Spec.Domain.Clock.GuestTimeSync.AfterUnpause
+}
+
+func guestTimeSyncTimeout(vmi *v1.VirtualMachineInstance) time.Duration {
+	if vmi.Spec.Domain.Clock == nil || vmi.Spec.Domain.Clock.GuestTimeSync == nil ||
+		vmi.Spec.Domain.Clock.GuestTimeSync.TimeoutSeconds == nil {
+		return oneMinute
+	}
+	return time.Duration(*vmi.Spec.Domain.Clock.GuestTimeSync.TimeoutSeconds) * time.Second
+}
+
 func (l *LibvirtDomainManager) getGuestTimeContext() context.Context {
 	l.setGuestTimeLock.Lock()
 	defer l.setGuestTimeLock.Unlock()
@@ -532,6 +620,22 @@ func (l *LibvirtDomainManager) FinalizeVirtualMachineMigration(vmi *v1.VirtualMa
 	return l.finalizeMigrationTarget(vmi, options)
 }
 
+// offlineGuestVCPUs asks the guest agent to offline the vCPUs about to be unplugged
+// and verifies that the guest only reports the remaining vCPUs as online.
+func offlineGuestVCPUs(dom cli.VirDomain, vcpuCount uint32) error {
+	if err := dom.SetVcpusFlags(uint(vcpuCount), libvirt.DOMAIN_VCPU_GUEST); err != nil {
+		return fmt.Errorf("failed to offline vCPUs in the guest: %v", err)
+	}
+	onlineVCPUCount, err := dom.GetVcpusFlags(libvirt.DOMAIN_VCPU_GUEST)
+	if err != nil {
+		return fmt.Errorf("failed to verify online vCPUs in the guest: %v", err)
+	}
+	if uint32(onlineVCPUCount) > vcpuCount {
+		return fmt.Errorf("guest still reports %d online vCPUs, expected %d", onlineVCPUCount, vcpuCount)
+	}
+	return nil
+}
+
 // UpdateVCPUs plugs or unplugs vCPUs on a running domain
 func (l *LibvirtDomainManager) UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error {
 	l.domainModifyLock.Lock()
@@ -551,6 +655,17 @@ func (l *LibvirtDomainManager) UpdateVCPUs(vmi *v1.VirtualMachineInstance, optio
 
 	vcpuTopology := vcpu.GetCPUTopology(vmi)
 	vcpuCount := vcpu.CalculateRequestedVCPUs(vcpuTopology)
+
+	currentVCPUCount, err := dom.GetVcpusFlags(libvirt.DOMAIN_VCPU_LIVE)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	if uint32(currentVCPUCount) > vcpuCount {
+		if err := offlineGuestVCPUs(dom, vcpuCount); err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+	}
+
 	// hot plug/unplug vCPUs
 	if err := dom.SetVcpusFlags(uint(vcpuCount),
 		affectDomainVCPULiveAndConfigLibvirtFlags); err != nil {
@@ -693,6 +808,31 @@ func (l *LibvirtDomainManager) GuestPing(domainName string) error {
 	return err
 }
 
+func (l *LibvirtDomainManager) GuestFileExists(domainName, path string) error {
+	return agent.GuestFileExists(l.virConn, domainName, path)
+}
+
+// ReadGuestFile reads a file of at most maxBytes bytes in the guest through the guest agent
+func (l *LibvirtDomainManager) ReadGuestFile(vmi *v1.VirtualMachineInstance, path string, maxBytes int) ([]byte, error) {
+	return agent.GuestFileRead(l.virConn, util.VMINamespaceKeyFunc(vmi), path, maxBytes)
+}
+
+// WriteGuestFile creates or truncates a file in the guest and writes the content to it through the guest agent
+func (l *LibvirtDomainManager) WriteGuestFile(vmi *v1.VirtualMachineInstance, path string, content []byte) error {
+	return agent.GuestFileWrite(l.virConn, util.VMINamespaceKeyFunc(vmi), path, content)
+}
+
+// GuestExecStart starts a command in the guest through the guest agent and returns its pid in the guest
+func (l *LibvirtDomainManager) GuestExecStart(vmi *v1.VirtualMachineInstance, command string, args []string) (int64, error) {
+	return l.guestExecs.Start(l.virConn, util.VMINamespaceKeyFunc(vmi), command, args)
+}
+
+// GuestExecStatus returns the state of a command started in the guest with GuestExecStart, and the output it wrote
+// since the previous status
+func (l *LibvirtDomainManager) GuestExecStatus(vmi *v1.VirtualMachineInstance, pid int64) (*agent.GuestExecStatus, error) {
+	return l.guestExecs.Status(l.virConn, util.VMINamespaceKeyFunc(vmi), pid)
+}
+
 // isGuestAgentUnavailableError returns true when the error from QemuAgentCommand
 // indicates that the guest agent is unreachable rather than a libvirt or
 // connection issue unrelated to the guest state.
@@ -804,6 +944,7 @@ func (l *LibvirtDomainManager) MigrateVMI(vmi *v1.VirtualMachineInstance, option
 
 func (l *LibvirtDomainManager) generateSomeCloudInitISO(vmi *v1.VirtualMachineInstance, domPtr *cli.VirDomain, size int64) error {
 	var devicesMetadata []cloudinit.DeviceData
+	var networkInterfacesData []cloudinit.NetworkInterfaceData
 	// this is the point where we need to build the devices metadata if it was requested.
 	// This metadata maps the user provided tag to the hypervisor assigned device address.
 	if domPtr != nil {
@@ -812,6 +953,13 @@ func (l *LibvirtDomainManager) generateSomeCloudInitISO(vmi *v1.VirtualMachineIn
 			return err
 		}
 		devicesMetadata = data
+
+		if l.cloudInitDataStore != nil && l.cloudInitDataStore.GenerateNetworkData {
+			networkInterfacesData, err = buildNetworkInterfacesData(*domPtr)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	// build condif drive iso file, that includes devices metadata if available
 	// get stored cloud init data
@@ -823,6 +971,9 @@ func (l *LibvirtDomainManager) generateSomeCloudInitISO(vmi *v1.VirtualMachineIn
 		if devicesMetadata != nil {
 			cloudInitDataStore.DevicesData = &devicesMetadata
 		}
+		if networkInterfacesData != nil {
+			cloudInitDataStore.NetworkInterfaces = &networkInterfacesData
+		}
 		var err error
 		if size != 0 {
 			err = cloudinit.GenerateEmptyIso(vmi.Name, vmi.Namespace, cloudInitDataStore, size)
@@ -899,10 +1050,9 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		l.cloudInitDataStore = cloudInitData
 	}
 
-	// generate ignition data
-	ignitionData := ignition.GetIgnitionSource(vmi)
-	if ignitionData != "" {
-
+	// generate ignition data passed through the firmware configuration device, the config drive
+	// propagation is part of the cloud-init data
+	if ignition.UsesFirmwareConfig(vmi) {
 		err := ignition.GenerateIgnitionLocalData(vmi, vmi.Namespace)
 		if err != nil {
 			return domain, err
@@ -1220,6 +1370,7 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		VirtualMachine:            vmi,
 		AllowEmulation:            allowEmulation,
 		HypervisorDeviceAvailable: l.hypervisorDeviceAvailable,
+		VirtualizedHost:           l.virtualizedHost,
 		CPUSet:                    podCPUSet,
 		IsBlockPVC:                isBlockPVCMap,
 		IsBlockDV:                 isBlockDVMap,
@@ -1318,18 +1469,59 @@ func isFreePageReportingEnabled(clusterFreePageReportingDisabled bool, vmi *v1.V
 		return false
 	}
 
+	if memory := vmi.Spec.Domain.Memory; memory != nil && memory.FreePageReporting != nil &&
+		memory.FreePageReporting.Enabled != nil && !*memory.FreePageReporting.Enabled {
+		return false
+	}
+
 	return true
 }
 
+// isVirtualizedHost reports whether the CPU flags announce a hypervisor, i.e. the
+// node is itself a virtual machine.
+func isVirtualizedHost(cpuInfoPath string) bool {
+	cpuInfo, err := os.ReadFile(cpuInfoPath)
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to read %s, assuming a bare metal host", cpuInfoPath)
+		return false
+	}
+	for _, line := range strings.Split(string(cpuInfo), "\n") {
+		key, flags, found := strings.Cut(line, ":")
+		if !found || strings.TrimSpace(key) != "flags" {
+			continue
+		}
+		for _, flag := range strings.Fields(flags) {
+			if flag == "hypervisor" {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
 func isSerialConsoleLogEnabled(clusterSerialConsoleLogDisabled bool, vmi *v1.VirtualMachineInstance) bool {
 	return (vmi.Spec.Domain.Devices.LogSerialConsole != nil && *vmi.Spec.Domain.Devices.LogSerialConsole) || (vmi.Spec.Domain.Devices.LogSerialConsole == nil && !clusterSerialConsoleLogDisabled)
 }
 
+// setGuestAgentCommandPolicy restricts the guest agent commands to the ones allowed by both the cluster and the VMI
+func (l *LibvirtDomainManager) setGuestAgentCommandPolicy(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) {
+	clusterConfig := options.GetClusterConfig()
+	l.virConn.SetGuestAgentCommandPolicy(agentpolicy.New(
+		&v1.GuestAgentCommandsConfiguration{
+			AllowedCommands: clusterConfig.GetGuestAgentAllowedCommands(),
+			DeniedCommands:  clusterConfig.GetGuestAgentDeniedCommands(),
+		},
+		vmi.Spec.GuestAgentCommands,
+	))
+}
+
 func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmulation bool, options *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error) {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
 
 	logger := log.Log.Object(vmi)
+	l.setGuestAgentCommandPolicy(vmi, options)
 
 	domain := &api.Domain{}
 
@@ -1408,6 +1600,10 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	if err := syncBalloonTarget(vmi, oldSpec, dom); err != nil {
+		return nil, err
+	}
+
 	l.refreshDeviceAliasMap(dom)
 	l.syncGracePeriod(vmi)
 
@@ -1415,6 +1611,25 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	return oldSpec, nil
 }
 
+// syncBalloonTarget resizes the memory balloon of a running domain to the target computed by virt-handler
+func syncBalloonTarget(vmi *v1.VirtualMachineInstance, spec *api.DomainSpec, dom cli.VirDomain) error {
+	if !vmi.IsRunning() || vmi.Status.Memory == nil || vmi.Status.Memory.BalloonTarget == nil {
+		return nil
+	}
+
+	targetKiB := uint64(vmi.Status.Memory.BalloonTarget.Value() / 1024)
+	if spec.CurrentMemory != nil && spec.CurrentMemory.Unit == "KiB" && spec.CurrentMemory.Value == targetKiB {
+		return nil
+	}
+
+	if err := dom.SetMemoryFlags(targetKiB, libvirt.DOMAIN_MEM_LIVE); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("setting the memory balloon target failed")
+		return err
+	}
+	log.Log.Object(vmi).V(2).Infof("memory balloon target set to %d KiB", targetKiB)
+	return nil
+}
+
 func (l *LibvirtDomainManager) syncDisks(
 	domain *api.Domain,
 	spec *api.DomainSpec,
@@ -1535,6 +1750,17 @@ func (l *LibvirtDomainManager) startDomain(
 		return err
 	}
 
+	if backendstorage.HasSuspendToDisk(&vmi.Spec) {
+		restored, err := l.restoreSavedState(vmi, dom)
+		if err != nil {
+			return err
+		}
+		if restored {
+			logger.Info("Domain restored from the saved state.")
+			return nil
+		}
+	}
+
 	createFlags := getDomainCreateFlags(vmi)
 	if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
@@ -1549,6 +1775,48 @@ func (l *LibvirtDomainManager) startDomain(
 	return nil
 }
 
+func savedStatePath(vmi *v1.VirtualMachineInstance) string {
+	return filepath.Join(kutil.PathForSavedState(vmi), "domain.save")
+}
+
+// restoreSavedState starts the domain from the guest memory saved when the VirtualMachine got suspended
+// to disk. A saved state which is not requested by the VMI is stale, and it is discarded.
+func (l *LibvirtDomainManager) restoreSavedState(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) (bool, error) {
+	logger := log.Log.Object(vmi)
+	savedState := savedStatePath(vmi)
+
+	if _, exists := vmi.Annotations[v1.RestoreSavedStateAnnotation]; !exists {
+		if err := os.Remove(savedState); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.Reason(err).Warning("failed to remove a stale saved state")
+		}
+		return false, nil
+	}
+
+	if _, err := os.Stat(savedState); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			logger.Warning("no saved state found, booting the domain")
+			return false, nil
+		}
+		return false, err
+	}
+
+	// The domain XML of the new launcher replaces the one stored in the saved state,
+	// since paths and devices are local to the pod.
+	domainXML, err := dom.GetXMLDesc(libvirt.DOMAIN_XML_SECURE)
+	if err != nil {
+		return false, err
+	}
+	if err := l.virConn.DomainRestoreFlags(savedState, domainXML, libvirt.DOMAIN_SAVE_RUNNING); err != nil {
+		logger.Reason(err).Error("Failed to restore the domain from the saved state.")
+		return false, err
+	}
+
+	if err := os.Remove(savedState); err != nil {
+		logger.Reason(err).Warning("failed to remove the restored saved state")
+	}
+	return true, nil
+}
+
 func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	domain *api.Domain,
 	vmi *v1.VirtualMachineInstance,
@@ -1959,6 +2227,85 @@ func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+// SuspendVMI saves the guest memory to the backend storage and stops the domain.
+// The save runs in the background, the domain shuts off with the Saved reason once it completes.
+func (l *LibvirtDomainManager) SuspendVMI(vmi *v1.VirtualMachineInstance) error {
+	if !backendstorage.HasSuspendToDisk(&vmi.Spec) {
+		return fmt.Errorf("suspend to disk is not enabled for the VMI")
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := util.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		if domainerrors.IsNotFound(err) {
+			return fmt.Errorf("Domain not found.")
+		}
+		log.Log.Object(vmi).Reason(err).Error("Getting the domain failed during suspend.")
+		return err
+	}
+	defer dom.Free()
+
+	domState, _, err := dom.GetState()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomainState)
+		return err
+	}
+	if domState != libvirt.DOMAIN_RUNNING && domState != libvirt.DOMAIN_PAUSED {
+		return fmt.Errorf("domain is not running")
+	}
+
+	select {
+	case l.suspendInProgress <- struct{}{}:
+	default:
+		log.Log.Object(vmi).Info("suspend is in progress")
+		return nil
+	}
+
+	// libvirt pauses the domain while saving it, SyncVMI must not resume it
+	wasPaused := l.paused.contains(vmi.UID)
+	l.paused.add(vmi.UID)
+	// forget the outcome of a previous save
+	l.metadataCache.Suspend.Store(api.SuspendMetadata{})
+
+	go func() {
+		defer func() { <-l.suspendInProgress }()
+
+		if err := l.suspendVMI(vmi); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Failed to save the domain.")
+			if !wasPaused {
+				l.domainModifyLock.Lock()
+				l.paused.remove(vmi.UID)
+				l.domainModifyLock.Unlock()
+			}
+			now := metav1.Now()
+			l.metadataCache.Suspend.Store(api.SuspendMetadata{
+				Failed:          true,
+				FailureReason:   err.Error(),
+				FailedTimestamp: &now,
+			})
+		}
+	}()
+	return nil
+}
+
+func (l *LibvirtDomainManager) suspendVMI(vmi *v1.VirtualMachineInstance) error {
+	dom, err := l.virConn.LookupDomainByName(util.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	log.Log.Object(vmi).Info("Saving the domain")
+	if err := dom.SaveFlags(savedStatePath(vmi), "", libvirt.DOMAIN_SAVE_RUNNING); err != nil {
+		return err
+	}
+	log.Log.Object(vmi).Info("Domain saved")
+	return nil
+}
+
 func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
@@ -1994,7 +2341,9 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 		l.paused.remove(vmi.UID)
 		// Try to set guest time after this commands execution.
 		// This operation is not disruptive.
-		l.setGuestTime(vmi)
+		if guestTimeSyncAfterUnpause(vmi) {
+			l.setGuestTime(vmi)
+		}
 	} else {
 		logger.Infof("Domain is not paused for %s", vmi.GetObjectMeta().GetName())
 	}
@@ -2088,6 +2437,11 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 		return err
 	}
 
+	if domState == libvirt.DOMAIN_RUNNING && l.preShutdownHookPending(vmi, domName) {
+		l.startGracePeriod()
+		return nil
+	}
+
 	if domState == libvirt.DOMAIN_RUNNING || domState == libvirt.DOMAIN_PAUSED {
 		err = dom.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT)
 		if err != nil {
@@ -2096,18 +2450,77 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 		}
 		log.Log.Object(vmi).Infof("Signaled graceful shutdown for %s", vmi.GetObjectMeta().GetName())
 
-		l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
-			if gracePeriodMetadata.DeletionTimestamp == nil {
-				now := metav1.Now()
-				gracePeriodMetadata.DeletionTimestamp = &now
-			}
-		})
-		log.Log.V(4).Infof("Graceful period set in metadata: %s", l.metadataCache.GracePeriod.String())
+		l.startGracePeriod()
 	}
 
 	return nil
 }
 
+// startGracePeriod records the start of the grace period in the metadata, unless it already started
+func (l *LibvirtDomainManager) startGracePeriod() {
+	l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+		if gracePeriodMetadata.DeletionTimestamp == nil {
+			now := metav1.Now()
+			gracePeriodMetadata.DeletionTimestamp = &now
+		}
+	})
+	log.Log.V(4).Infof("Graceful period set in metadata: %s", l.metadataCache.GracePeriod.String())
+}
+
+type preShutdownHookExecution struct {
+	done     chan struct{}
+	deadline time.Time
+}
+
+// preShutdownHookPending starts the pre-shutdown hook of the VMI in the guest on its first call.
+// It returns true as long as the hook neither exited nor timed out, the ACPI shutdown has to be
+// delayed until then.
+func (l *LibvirtDomainManager) preShutdownHookPending(vmi *v1.VirtualMachineInstance, domName string) bool {
+	hook := vmi.Spec.PreShutdownHook
+	if hook == nil {
+		return false
+	}
+
+	if l.preShutdownHook == nil {
+		timeout := preShutdownHookTimeout(vmi)
+		execution := &preShutdownHookExecution{
+			done:     make(chan struct{}),
+			deadline: time.Now().Add(time.Duration(timeout) * time.Second),
+		}
+		l.preShutdownHook = execution
+		log.Log.Object(vmi).Infof("Executing the pre-shutdown hook %s with a timeout of %d seconds", hook.Command, timeout)
+		go func() {
+			defer close(execution.done)
+			if _, err := agent.GuestExec(l.virConn, domName, hook.Command, hook.Args, int32(timeout)); err != nil {
+				log.Log.Object(vmi).Reason(err).Warning("The pre-shutdown hook failed")
+				return
+			}
+			log.Log.Object(vmi).Info("The pre-shutdown hook exited")
+		}()
+		return true
+	}
+
+	select {
+	case <-l.preShutdownHook.done:
+		return false
+	default:
+		return time.Now().Before(l.preShutdownHook.deadline)
+	}
+}
+
+// preShutdownHookTimeout returns the timeout of the pre-shutdown hook, it never exceeds the
+// termination grace period, which may have been decreased when the VMI was stopped
+func preShutdownHookTimeout(vmi *v1.VirtualMachineInstance) int64 {
+	timeout := v1.DefaultPreShutdownHookTimeoutSeconds
+	if vmi.Spec.PreShutdownHook.TimeoutSeconds != nil {
+		timeout = *vmi.Spec.PreShutdownHook.TimeoutSeconds
+	}
+	if vmi.Spec.TerminationGracePeriodSeconds != nil && *vmi.Spec.TerminationGracePeriodSeconds < timeout {
+		timeout = *vmi.Spec.TerminationGracePeriodSeconds
+	}
+	return timeout
+}
+
 func (l *LibvirtDomainManager) KillVMI(vmi *v1.VirtualMachineInstance) error {
 	domName := api.VMINamespaceKeyFunc(vmi)
 	dom, err := l.virConn.LookupDomainByName(domName)
@@ -2285,16 +2698,46 @@ func (l *LibvirtDomainManager) refreshDeviceAliasMap(dom cli.VirDomain) {
 			newMap[iface.Target.Device] = iface.Alias.GetName()
 		}
 	}
+	newDiskAddressMap := make(map[string]string)
 	for _, disk := range domSpec.Devices.Disks {
 		if disk.Target.Device != "" && disk.Alias != nil {
 			newMap[disk.Target.Device] = disk.Alias.GetName()
 		}
+		if key := diskAddressKey(disk.Target.Bus, disk.Address); key != "" && disk.Alias != nil {
+			newDiskAddressMap[key] = disk.Alias.GetName()
+		}
 	}
 	l.devAliasLock.Lock()
 	l.devAliasMap = newMap
+	l.diskAddressAliasMap = newDiskAddressMap
 	l.devAliasLock.Unlock()
 }
 
+// diskAddressKey identifies a disk by the address the guest agent reports for it:
+// the PCI address for virtio disks and the target and unit for SCSI disks.
+func diskAddressKey(bus v1.DiskBus, address *api.Address) string {
+	if address == nil {
+		return ""
+	}
+	switch {
+	case bus == v1.DiskBusVirtio && address.Type == api.AddressPCI:
+		return formatPCIAddressStr(address)
+	case bus == v1.DiskBusSCSI && address.Type == "drive":
+		return fmt.Sprintf("scsi:%s:%s", address.Target, address.Unit)
+	}
+	return ""
+}
+
+func fsDiskAddressKey(fsDisk api.FSDisk) string {
+	switch fsDisk.BusType {
+	case string(v1.DiskBusVirtio):
+		return fsDisk.PCIAddress
+	case string(v1.DiskBusSCSI):
+		return fmt.Sprintf("scsi:%d:%d", fsDisk.Target, fsDisk.Unit)
+	}
+	return ""
+}
+
 func (l *LibvirtDomainManager) getDeviceAliasMap() map[string]string {
 	l.devAliasLock.RLock()
 	defer l.devAliasLock.RUnlock()
@@ -2302,10 +2745,9 @@ func (l *LibvirtDomainManager) getDeviceAliasMap() map[string]string {
 }
 
 func (l *LibvirtDomainManager) getDomainStats() ([]*stats.DomainStats, error) {
-	statsTypes := libvirt.DOMAIN_STATS_BALLOON | libvirt.DOMAIN_STATS_CPU_TOTAL | libvirt.DOMAIN_STATS_VCPU | libvirt.DOMAIN_STATS_INTERFACE | libvirt.DOMAIN_STATS_BLOCK | libvirt.DOMAIN_STATS_DIRTYRATE
 	flags := libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING | libvirt.CONNECT_GET_ALL_DOMAINS_STATS_PAUSED
 
-	domstats, err := l.virConn.GetDomainStats(statsTypes, l.domainInfoStats, flags)
+	domstats, err := l.virConn.GetDomainStats(l.domainStatsTypes, l.domainInfoStats, flags)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get domain stats: %v", err)
 	}
@@ -2380,6 +2822,34 @@ func getDeviceNUMACPUAffinity(dev api.HostDevice, vmi *v1.VirtualMachineInstance
 	return
 }
 
+// buildNetworkInterfacesData collects the MAC address and MTU the hypervisor assigned to the
+// network interfaces of the domain, for the generation of the cloud-init network data.
+func buildNetworkInterfacesData(dom cli.VirDomain) ([]cloudinit.NetworkInterfaceData, error) {
+	domainSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var interfacesData []cloudinit.NetworkInterfaceData
+	for _, nic := range domainSpec.Devices.Interfaces {
+		if nic.Alias == nil {
+			continue
+		}
+		data := cloudinit.NetworkInterfaceData{Name: nic.Alias.GetName()}
+		if nic.MAC != nil {
+			data.MAC = nic.MAC.MAC
+		}
+		if nic.MTU != nil {
+			data.MTU, err = strconv.Atoi(nic.MTU.Size)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the MTU of interface %s: %v", data.Name, err)
+			}
+		}
+		interfacesData = append(interfacesData, data)
+	}
+	return interfacesData, nil
+}
+
 func (l *LibvirtDomainManager) buildDevicesMetadata(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) ([]cloudinit.DeviceData, error) {
 	taggedInterfaces := make(map[string]v1.Interface)
 	taggedHostDevices := make(map[string]v1.HostDevice)
@@ -2482,6 +2952,11 @@ func (l *LibvirtDomainManager) buildDevicesMetadata(vmi *v1.VirtualMachineInstan
 	return devicesMetadata, nil
 }
 
+// RefreshGuestInfo polls the Guest agent right away and updates the agent store with its data
+func (l *LibvirtDomainManager) RefreshGuestInfo(vmi *v1.VirtualMachineInstance) error {
+	return agentpoller.RefreshGuestInfo(l.virConn, api.VMINamespaceKeyFunc(vmi), l.agentData)
+}
+
 // GetGuestInfo queries the agent store and return the aggregated data from Guest agent
 func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgentInfo {
 	sysInfo := l.agentData.GetSysInfo()
@@ -2512,6 +2987,12 @@ func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgen
 		Timezone: fmt.Sprintf("%s, %d", sysInfo.Timezone.Zone, sysInfo.Timezone.Offset),
 	}
 
+	pollStatus := l.agentData.GetSysInfoPollStatus()
+	if !pollStatus.LastSucceeded.IsZero() {
+		guestInfo.LastPolled = pointer.P(metav1.NewTime(pollStatus.LastSucceeded))
+	}
+	guestInfo.PollError = pollStatus.Error
+
 	for _, user := range userInfo {
 		guestInfo.UserList = append(guestInfo.UserList, v1.VirtualMachineInstanceGuestOSUser{
 			UserName:  user.Name,
@@ -2637,6 +3118,42 @@ func (l *LibvirtDomainManager) GetScreenshot(vmi *v1.VirtualMachineInstance) (*c
 	}, nil
 }
 
+// GetDomainXML returns the live domain XML and the capabilities of the hypervisor
+// for the emulator, architecture and machine type the domain is running with
+func (l *LibvirtDomainManager) GetDomainXML(vmi *v1.VirtualMachineInstance) (*cmdv1.DomainXMLResponse, error) {
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return nil, err
+	}
+	defer dom.Free()
+
+	domainXML, err := dom.GetXMLDesc(0)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get the domain XML")
+		return nil, err
+	}
+
+	domainSpec := &api.DomainSpec{}
+	if err := xml.Unmarshal([]byte(domainXML), domainSpec); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal the domain XML")
+		return nil, err
+	}
+
+	domainCapabilitiesXML, err := l.virConn.GetDomainCapabilities(
+		domainSpec.Devices.Emulator, domainSpec.OS.Type.Arch, domainSpec.OS.Type.Machine, domainSpec.Type)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get the domain capabilities")
+		return nil, err
+	}
+
+	return &cmdv1.DomainXMLResponse{
+		DomainXML:             domainXML,
+		DomainCapabilitiesXML: domainCapabilitiesXML,
+	}, nil
+}
+
 func (l *LibvirtDomainManager) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	sevNodeParameters, err := l.virConn.GetSEVInfo()
 	if err != nil {
@@ -2734,12 +3251,19 @@ func (l *LibvirtDomainManager) InjectLaunchSecret(vmi *v1.VirtualMachineInstance
 }
 
 func (l *LibvirtDomainManager) parseFSDisks(fsDisks []api.FSDisk) []v1.VirtualMachineInstanceFileSystemDisk {
+	l.devAliasLock.RLock()
+	defer l.devAliasLock.RUnlock()
+
 	disks := []v1.VirtualMachineInstanceFileSystemDisk{}
 	for _, fsDisk := range fsDisks {
-		disks = append(disks, v1.VirtualMachineInstanceFileSystemDisk{
+		disk := v1.VirtualMachineInstanceFileSystemDisk{
 			Serial:  fsDisk.Serial,
 			BusType: fsDisk.BusType,
-		})
+		}
+		if key := fsDiskAddressKey(fsDisk); key != "" {
+			disk.VolumeName = l.diskAddressAliasMap[key]
+		}
+		disks = append(disks, disk)
 	}
 
 	return disks
@@ -59,6 +59,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
 	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -109,7 +110,7 @@ var _ = Describe("Manager", func() {
 	testDomainName := fmt.Sprintf("%s_%s", testNamespace, testVmName)
 	ephemeralDiskCreatorMock := &fake.MockEphemeralDiskImageCreator{}
 	newLibvirtDomainManagerDefault := func() (DomainManager, error) {
-		return NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+		return NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 	}
 
 	BeforeEach(func() {
@@ -659,6 +660,157 @@ var _ = Describe("Manager", func() {
 
 			Expect(manager.PauseVMI(vmi)).To(Succeed())
 		})
+		It("should save a VirtualMachineInstance suspended to disk", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Memory = &v1.Memory{SuspendToDisk: virtpointer.P(true)}
+
+			saved := make(chan string, 1)
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Times(2).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockLibvirt.DomainEXPECT().SaveFlags(gomock.Any(), "", libvirt.DOMAIN_SAVE_RUNNING).DoAndReturn(
+				func(destFile, _ string, _ libvirt.DomainSaveRestoreFlags) error {
+					saved <- destFile
+					return nil
+				})
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.SuspendVMI(vmi)).To(Succeed())
+			Eventually(saved).Should(Receive(Equal(savedStatePath(vmi))))
+		})
+		It("should report a failed save of a VirtualMachineInstance suspended to disk", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Memory = &v1.Memory{SuspendToDisk: virtpointer.P(true)}
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Times(2).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockLibvirt.DomainEXPECT().SaveFlags(gomock.Any(), "", libvirt.DOMAIN_SAVE_RUNNING).Return(fmt.Errorf("no space left on device"))
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.SuspendVMI(vmi)).To(Succeed())
+			Eventually(func() api.SuspendMetadata {
+				suspend, _ := metadataCache.Suspend.Load()
+				return suspend
+			}).Should(And(
+				HaveField("Failed", BeTrue()),
+				HaveField("FailureReason", "no space left on device"),
+				HaveField("FailedTimestamp", Not(BeNil())),
+			))
+		})
+		It("should not suspend a VirtualMachineInstance without suspend to disk", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.SuspendVMI(vmi)).To(MatchError("suspend to disk is not enabled for the VMI"))
+		})
+		Context("cloud-init", func() {
+			newCloudInitVMI := func() *v1.VirtualMachineInstance {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Spec.Volumes = []v1.Volume{{
+					Name: "cloudinitdisk",
+					VolumeSource: v1.VolumeSource{
+						CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+					},
+				}}
+				return vmi
+			}
+			newCloudInitDisk := func(device string) api.Disk {
+				return api.Disk{
+					Device: device,
+					Type:   "file",
+					Source: api.DiskSource{File: "/var/run/kubevirt-ephemeral-disks/cloud-init-data/default/testvmi/noCloud.iso"},
+					Target: api.DiskTarget{Bus: v1.DiskBusSATA, Device: "sda"},
+					Driver: &api.DiskDriver{Name: "qemu", Type: "raw"},
+					Alias:  api.NewUserDefinedAlias("cloudinitdisk"),
+				}
+			}
+			expectDomainWithDisk := func(vmi *v1.VirtualMachineInstance, disk api.Disk) {
+				domainSpec := expectedDomainFor(vmi)
+				domainSpec.Devices.Disks = []api.Disk{disk}
+				xmlDomain, err := xml.MarshalIndent(domainSpec, "", "\t")
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xmlDomain), nil)
+			}
+
+			It("should eject the cloud-init disk", func() {
+				vmi := newCloudInitVMI()
+				disk := newCloudInitDisk("cdrom")
+				expectDomainWithDisk(vmi, disk)
+
+				ejected := disk
+				ejected.Type = "block"
+				ejected.Source = api.DiskSource{}
+				updateBytes, err := xml.Marshal(ejected)
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.DomainEXPECT().UpdateDeviceFlags(string(updateBytes), affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.EjectCloudInit(vmi)).To(Succeed())
+				cloudInit, exists := metadataCache.CloudInit.Load()
+				Expect(exists).To(BeTrue())
+				Expect(cloudInit).To(Equal(api.CloudInitMetadata{
+					Reason:    v1.VirtualMachineInstanceReasonCloudInitEjected,
+					Succeeded: true,
+				}))
+			})
+
+			It("should rotate the cloud-init disk and keep the path of its media", func() {
+				vmi := newCloudInitVMI()
+				disk := newCloudInitDisk("cdrom")
+				expectDomainWithDisk(vmi, disk)
+				// the devices metadata of the regenerated disk is read from the domain
+				domainSpec := expectedDomainFor(vmi)
+				domainSpec.Devices.Disks = []api.Disk{disk}
+				xmlDomain, err := xml.MarshalIndent(domainSpec, "", "\t")
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xmlDomain), nil)
+
+				ejected := disk
+				ejected.Type = "block"
+				ejected.Source = api.DiskSource{}
+				ejectedBytes, err := xml.Marshal(ejected)
+				Expect(err).ToNot(HaveOccurred())
+				insertedBytes, err := xml.Marshal(disk)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(insertedBytes)).To(ContainSubstring(`<source file="/var/run/kubevirt-ephemeral-disks/cloud-init-data/default/testvmi/noCloud.iso"></source>`))
+				gomock.InOrder(
+					mockLibvirt.DomainEXPECT().UpdateDeviceFlags(string(ejectedBytes), affectDeviceLiveAndConfigLibvirtFlags).Return(nil),
+					mockLibvirt.DomainEXPECT().UpdateDeviceFlags(string(insertedBytes), affectDeviceLiveAndConfigLibvirtFlags).Return(nil),
+				)
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(gomock.Any(), testDomainName).Return("", fmt.Errorf("guest agent is not connected"))
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.RotateCloudInit(vmi)).To(Succeed())
+				Eventually(func() api.CloudInitMetadata {
+					cloudInit, _ := metadataCache.CloudInit.Load()
+					return cloudInit
+				}).Should(Equal(api.CloudInitMetadata{
+					Reason:  v1.VirtualMachineInstanceReasonCloudInitRotated,
+					Message: "cloud-init clean: guest agent is not connected",
+				}))
+			})
+
+			It("should not eject the cloud-init disk of a VMI without cloud-init", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				expectDomainWithDisk(vmi, newCloudInitDisk("cdrom"))
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.EjectCloudInit(vmi)).To(MatchError("the VMI has no cloud-init volume"))
+			})
+
+			DescribeTable("should not swap the media of a cloud-init disk which is not a cd-rom", func(action func(DomainManager, *v1.VirtualMachineInstance) error) {
+				vmi := newCloudInitVMI()
+				expectDomainWithDisk(vmi, newCloudInitDisk("disk"))
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(action(manager, vmi)).To(MatchError("cloud-init volume cloudinitdisk is not attached as a cd-rom"))
+				_, exists := metadataCache.CloudInit.Load()
+				Expect(exists).To(BeFalse())
+			},
+				Entry("when rotating", DomainManager.RotateCloudInit),
+				Entry("when ejecting", DomainManager.EjectCloudInit),
+			)
+		})
 		It("should unpause a VirtualMachineInstance", func() {
 			isSetTimeCalled := make(chan bool, 1)
 			defer close(isSetTimeCalled)
@@ -679,7 +831,7 @@ var _ = Describe("Manager", func() {
 				func() {
 					isFreeCalled <- true
 				})
-			manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, "fake", "fake", nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, "fake", "fake", nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			Expect(manager.UnpauseVMI(vmi)).To(Succeed())
 			Eventually(func() bool {
 				select {
@@ -698,6 +850,20 @@ var _ = Describe("Manager", func() {
 				return false
 			}, 20*time.Second, 1).Should(BeTrue(), "Free wasn't called")
 		})
+		It("should unpause a VirtualMachineInstance without setting the guest time if disabled", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Clock = &v1.Clock{
+				GuestTimeSync: &v1.GuestTimeSync{AfterUnpause: virtpointer.P(false)},
+			}
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, 1, nil)
+			mockLibvirt.DomainEXPECT().Resume().Return(nil)
+			mockLibvirt.DomainEXPECT().SetTime(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.UnpauseVMI(vmi)).To(Succeed())
+		})
 		It("should not try to unpause a running VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -773,7 +939,7 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
 			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(3).Return(string(xmlDomain), nil)
 			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(2)).MaxTimes(1).Return(string(domainXMLWithInterfaces), nil)
-			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, "fake", "fake", nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, "fake", "fake", nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{
 				VirtualMachineSMBios: &cmdv1.SMBios{},
 				PreallocatedVolumes:  []string{"permvolume1"},
@@ -903,7 +1069,7 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
 			mockLibvirt.DomainEXPECT().AttachDeviceFlags(strings.ToLower(string(attachBytes)), affectDeviceLiveAndConfigLibvirtFlags)
 			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(3).Return(string(xmlDomain2), nil)
-			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
@@ -1011,7 +1177,7 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
 			mockLibvirt.DomainEXPECT().DetachDeviceFlags(strings.ToLower(string(detachBytes)), affectDeviceLiveAndConfigLibvirtFlags)
 			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(3).Return(string(xmlDomain), nil)
-			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
@@ -1085,7 +1251,7 @@ var _ = Describe("Manager", func() {
 			}
 			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, 1, nil)
 			mockLibvirt.DomainEXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
-			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
@@ -1185,7 +1351,7 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, 1, nil)
 			mockLibvirt.DomainEXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
 			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(3).Return(string(xmlDomain2), nil)
-			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
@@ -1323,7 +1489,7 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
 			mockLibvirt.DomainEXPECT().UpdateDeviceFlags(strings.ToLower(string(updateBytes)), affectDeviceLiveAndConfigLibvirtFlags)
 			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(3).Return(string(xmlDomain2), nil)
-			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
@@ -1452,7 +1618,7 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
 			mockLibvirt.DomainEXPECT().UpdateDeviceFlags(strings.ToLower(string(updateBytes)), affectDeviceLiveAndConfigLibvirtFlags)
 			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(3).Return(string(xmlDomain2), nil)
-			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := newLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, mockDirectIOChecker, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
@@ -1491,6 +1657,8 @@ var _ = Describe("Manager", func() {
 			Entry("disabled if vmi is requesting DedicatedCPU", nil, false, &v1.CPU{
 				DedicatedCPUPlacement: true}, "false", "off"),
 			Entry("disabled if vmi has the disable free page reporting annotation", nil, false, nil, "true", "off"),
+			Entry("disabled if vmi opts out from free page reporting", &v1.Memory{FreePageReporting: &v1.FeatureState{Enabled: virtpointer.P(false)}}, false, nil, "false", "off"),
+			Entry("enabled if vmi opts in to free page reporting", &v1.Memory{FreePageReporting: &v1.FeatureState{Enabled: virtpointer.P(true)}}, false, nil, "false", "on"),
 		)
 
 		It("should return SEV platform info", func() {
@@ -1508,6 +1676,27 @@ var _ = Describe("Manager", func() {
 			Expect(sevPlatfomrInfo.CertChain).To(Equal(sevNodeParameters.CertChain))
 		})
 
+		It("should return the domain XML and the domain capabilities", func() {
+			const (
+				domainXML = `<domain type="kvm"><name>kubevirt</name><os><type arch="x86_64" machine="pc-q35-rhel9.6.0">hvm</type></os>` +
+					`<devices><emulator>/usr/libexec/qemu-kvm</emulator></devices></domain>`
+				domainCapabilitiesXML = "<domainCapabilities></domainCapabilities>"
+			)
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Return(mockLibvirt.VirtDomain, nil)
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(domainXML, nil)
+			mockLibvirt.ConnectionEXPECT().GetDomainCapabilities("/usr/libexec/qemu-kvm", "x86_64", "pc-q35-rhel9.6.0", "kvm").
+				Return(domainCapabilitiesXML, nil)
+
+			manager, _ := newLibvirtDomainManagerDefault()
+			response, err := manager.GetDomainXML(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.DomainXML).To(Equal(domainXML))
+			Expect(response.DomainCapabilitiesXML).To(Equal(domainCapabilitiesXML))
+		})
+
 		It("should return a VirtualMachineInstance launch measurement", func() {
 			if runtime.GOARCH == "s390x" {
 				Skip("Test is specific to amd64 architecture") //nolint:forbidigo
@@ -1530,7 +1719,7 @@ var _ = Describe("Manager", func() {
 			defer os.RemoveAll(ovmfDir)
 			err = os.WriteFile(filepath.Join(ovmfDir, efi.EFICodeSEV), loaderBytes, 0644)
 			Expect(err).ToNot(HaveOccurred())
-			manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, ovmfDir, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, ovmfDir, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			sevMeasurementInfo, err := manager.GetLaunchMeasurement(vmi)
 			if runtime.GOARCH == "amd64" {
 				Expect(err).ToNot(HaveOccurred())
@@ -1673,6 +1862,85 @@ var _ = Describe("Manager", func() {
 			})
 		})
 
+		Context("CPU hotplug", func() {
+			var vmi *v1.VirtualMachineInstance
+			var manager *LibvirtDomainManager
+
+			BeforeEach(func() {
+				vmi = newVMI(testNamespace, testVmName)
+				vmi.Spec.Domain.CPU = &v1.CPU{
+					Sockets:    2,
+					Cores:      1,
+					Threads:    1,
+					MaxSockets: 4,
+				}
+
+				manager = &LibvirtDomainManager{
+					virConn:       mockLibvirt.VirtConnection,
+					virtShareDir:  testVirtShareDir,
+					metadataCache: metadataCache,
+					cpuSetGetter:  fakeCpuSetGetter,
+				}
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(api.VMINamespaceKeyFunc(vmi)).Return(mockLibvirt.VirtDomain, nil)
+				mockLibvirt.DomainEXPECT().Free()
+			})
+
+			It("should hotplug vCPUs without offlining them in the guest", func() {
+				mockLibvirt.DomainEXPECT().GetVcpusFlags(libvirt.DOMAIN_VCPU_LIVE).Return(int32(1), nil)
+				mockLibvirt.DomainEXPECT().SetVcpusFlags(uint(2), affectDomainVCPULiveAndConfigLibvirtFlags).Return(nil)
+
+				Expect(manager.UpdateVCPUs(vmi, nil)).To(Succeed())
+			})
+
+			It("should offline vCPUs in the guest before unplugging them", func() {
+				mockLibvirt.DomainEXPECT().GetVcpusFlags(libvirt.DOMAIN_VCPU_LIVE).Return(int32(3), nil)
+				mockLibvirt.DomainEXPECT().SetVcpusFlags(uint(2), libvirt.DOMAIN_VCPU_GUEST).Return(nil)
+				mockLibvirt.DomainEXPECT().GetVcpusFlags(libvirt.DOMAIN_VCPU_GUEST).Return(int32(2), nil)
+				mockLibvirt.DomainEXPECT().SetVcpusFlags(uint(2), affectDomainVCPULiveAndConfigLibvirtFlags).Return(nil)
+
+				Expect(manager.UpdateVCPUs(vmi, nil)).To(Succeed())
+			})
+
+			It("should fail to unplug vCPUs when the guest does not offline them", func() {
+				mockLibvirt.DomainEXPECT().GetVcpusFlags(libvirt.DOMAIN_VCPU_LIVE).Return(int32(3), nil)
+				mockLibvirt.DomainEXPECT().SetVcpusFlags(uint(2), libvirt.DOMAIN_VCPU_GUEST).Return(nil)
+				mockLibvirt.DomainEXPECT().GetVcpusFlags(libvirt.DOMAIN_VCPU_GUEST).Return(int32(3), nil)
+
+				err := manager.UpdateVCPUs(vmi, nil)
+				Expect(err).To(MatchError(ContainSubstring("guest still reports 3 online vCPUs, expected 2")))
+			})
+		})
+
+		Context("memory balloon target", func() {
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vmi = newVMI(testNamespace, testVmName)
+				vmi.Status.Phase = v1.Running
+				vmi.Status.Memory = &v1.MemoryStatus{BalloonTarget: virtpointer.P(resource.MustParse("2Gi"))}
+			})
+
+			It("should resize the balloon to the target", func() {
+				spec := &api.DomainSpec{CurrentMemory: &api.Memory{Value: 4 * 1024 * 1024, Unit: "KiB"}}
+				mockLibvirt.DomainEXPECT().SetMemoryFlags(uint64(2*1024*1024), libvirt.DOMAIN_MEM_LIVE).Return(nil)
+
+				Expect(syncBalloonTarget(vmi, spec, mockLibvirt.VirtDomain)).To(Succeed())
+			})
+
+			It("should not resize the balloon when it already has the target size", func() {
+				spec := &api.DomainSpec{CurrentMemory: &api.Memory{Value: 2 * 1024 * 1024, Unit: "KiB"}}
+
+				Expect(syncBalloonTarget(vmi, spec, mockLibvirt.VirtDomain)).To(Succeed())
+			})
+
+			It("should not resize the balloon without a target", func() {
+				vmi.Status.Memory.BalloonTarget = nil
+				spec := &api.DomainSpec{CurrentMemory: &api.Memory{Value: 4 * 1024 * 1024, Unit: "KiB"}}
+
+				Expect(syncBalloonTarget(vmi, spec, mockLibvirt.VirtDomain)).To(Succeed())
+			})
+		})
+
 		It("should update grace period metadata if cached value differs", func() {
 			const initialGracePeriod int64 = 30
 			const updatedGracePeriod int64 = 0
@@ -1736,7 +2004,7 @@ var _ = Describe("Manager", func() {
 				Physical: 20 * 1024 * 1024 * 1024,
 			}, nil)
 
-			manager, err := NewLibvirtDomainManager(localMockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+			manager, err := NewLibvirtDomainManager(localMockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			Expect(err).ToNot(HaveOccurred())
 			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
 			Expect(err).ToNot(HaveOccurred())
@@ -1766,6 +2034,80 @@ var _ = Describe("Manager", func() {
 			gracePeriod, _ := metadataCache.GracePeriod.Load()
 			Expect(gracePeriod.DeletionTimestamp).NotTo(BeNil())
 		})
+
+		Context("with a pre-shutdown hook", func() {
+			const (
+				expectedExecCmd   = `{"execute": "guest-exec", "arguments": { "path": "/usr/bin/stop-db", "arg": [ "--flush" ], "capture-output":true } }`
+				expectedStatusCmd = `{"execute": "guest-exec-status", "arguments": { "pid": 789 } }`
+			)
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				mockLibvirt.DomainEXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).AnyTimes().DoAndReturn(mockDomainWithFreeExpectation)
+
+				vmi = newVMI(testNamespace, testVmName)
+				vmi.Spec.PreShutdownHook = &v1.PreShutdownHook{
+					Command:        "/usr/bin/stop-db",
+					Args:           []string{"--flush"},
+					TimeoutSeconds: virtpointer.P(int64(10)),
+				}
+			})
+
+			It("should signal graceful shutdown once the hook exited", func() {
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedExecCmd, testDomainName).Return(`{"return":{"pid":789}}`, nil)
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedStatusCmd, testDomainName).Return(`{"return":{"exitcode":0,"exited":true}}`, nil)
+
+				domainManager, _ := newLibvirtDomainManagerDefault()
+				manager := domainManager.(*LibvirtDomainManager)
+				Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+
+				gracePeriod, _ := metadataCache.GracePeriod.Load()
+				Expect(gracePeriod.DeletionTimestamp).NotTo(BeNil())
+				Eventually(manager.preShutdownHook.done).Should(BeClosed())
+
+				mockLibvirt.DomainEXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT).Return(nil)
+				Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+			})
+
+			It("should signal graceful shutdown if the hook can not be executed", func() {
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedExecCmd, testDomainName).Return("", fmt.Errorf("guest agent is not connected"))
+
+				domainManager, _ := newLibvirtDomainManagerDefault()
+				manager := domainManager.(*LibvirtDomainManager)
+				Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+				Eventually(manager.preShutdownHook.done).Should(BeClosed())
+
+				mockLibvirt.DomainEXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT).Return(nil)
+				Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+			})
+
+			It("should not signal graceful shutdown while the hook is running", func() {
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedExecCmd, testDomainName).Return(`{"return":{"pid":789}}`, nil)
+				exited := make(chan struct{})
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedStatusCmd, testDomainName).AnyTimes().DoAndReturn(func(_, _ string) (string, error) {
+					select {
+					case <-exited:
+						return `{"return":{"exitcode":0,"exited":true}}`, nil
+					default:
+						return `{"return":{"exited":false}}`, nil
+					}
+				})
+
+				domainManager, _ := newLibvirtDomainManagerDefault()
+				manager := domainManager.(*LibvirtDomainManager)
+				Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+				Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+
+				By("reaching the timeout of the hook")
+				manager.preShutdownHook.deadline = time.Now()
+				mockLibvirt.DomainEXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT).Return(nil)
+				Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+
+				close(exited)
+				Eventually(manager.preShutdownHook.done).WithTimeout(5 * time.Second).Should(BeClosed())
+			})
+		})
 	})
 	Context("test migration monitor", func() {
 		It("migration should be canceled if it's not progressing", func() {
@@ -2251,7 +2593,7 @@ var _ = Describe("Manager", func() {
 				options := &cmdv1.VirtualMachineOptions{
 					ClusterConfig: &cmdv1.ClusterConfig{VGPULiveMigrationEnabled: true},
 				}
-				manager, err := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, true, nil, v1.KvmHypervisorName, nil, "", false)
+				manager, err := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, true, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 				Expect(err).ToNot(HaveOccurred())
 				libvirtManager := manager.(*LibvirtDomainManager)
 
@@ -2297,7 +2639,7 @@ var _ = Describe("Manager", func() {
 			func(state libvirt.DomainState) {
 				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 				mockLibvirt.DomainEXPECT().UndefineFlags(libvirt.DOMAIN_UNDEFINE_KEEP_NVRAM | libvirt.DOMAIN_UNDEFINE_CHECKPOINTS_METADATA).Return(nil)
-				manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, "fake", "fake", nil, "/usr/share/", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+				manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, "fake", "fake", nil, "/usr/share/", nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 				Expect(manager.DeleteVMI(newVMI(testNamespace, testVmName))).To(Succeed())
 			},
 			Entry("crashed", libvirt.DOMAIN_CRASHED),
@@ -2374,6 +2716,28 @@ var _ = Describe("Manager", func() {
 		})
 	})
 
+	Context("with disabled domain stats", func() {
+		It("should not request the disabled stats from libvirt", func() {
+			const (
+				domainStats = libvirt.DOMAIN_STATS_BALLOON |
+					libvirt.DOMAIN_STATS_CPU_TOTAL |
+					libvirt.DOMAIN_STATS_INTERFACE |
+					libvirt.DOMAIN_STATS_DIRTYRATE
+				flags = libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING | libvirt.CONNECT_GET_ALL_DOMAINS_STATS_PAUSED
+			)
+			mockLibvirt.ConnectionEXPECT().GetDomainStats(domainStats, gomock.Any(), flags).Return([]*stats.DomainStats{{}}, nil)
+
+			disabledStats := DomainStatsTypesForCollectors([]v1.DomainStatsCollector{v1.DomainStatsCollectorBlock, v1.DomainStatsCollectorVCPU})
+			manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, nil, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{DisabledStats: disabledStats})
+			_, err := manager.GetDomainStats()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should ignore unknown domain stats collectors", func() {
+			Expect(DomainStatsTypesForCollectors([]v1.DomainStatsCollector{"memory", v1.DomainStatsCollectorBlock})).To(Equal(libvirt.DOMAIN_STATS_BLOCK))
+		})
+	})
+
 	Context("device alias injection in getDomainStats", func() {
 		const (
 			domainStats = libvirt.DOMAIN_STATS_BALLOON |
@@ -2479,7 +2843,7 @@ var _ = Describe("Manager", func() {
 
 			BeforeEach(func() {
 				agentStore = agentpoller.NewAsyncAgentStore()
-				libvirtmanager, _ = NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+				libvirtmanager, _ = NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			})
 
 			It("should report nil when no OS info exists in the cache", func() {
@@ -2503,7 +2867,7 @@ var _ = Describe("Manager", func() {
 
 			BeforeEach(func() {
 				agentStore = agentpoller.NewAsyncAgentStore()
-				libvirtmanager, _ = NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+				libvirtmanager, _ = NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 			})
 
 			It("should return nil when no interfaces exists in the cache", func() {
@@ -2547,7 +2911,7 @@ var _ = Describe("Manager", func() {
 				},
 			},
 		})
-		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 
 		// we need the non-typecast object to make the function we want to test available
 		libvirtmanager := manager.(*LibvirtDomainManager)
@@ -2583,7 +2947,7 @@ var _ = Describe("Manager", func() {
 			},
 		})
 
-		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 
 		// we need the non-typecast object to make the function we want to test available
 		libvirtmanager := manager.(*LibvirtDomainManager)
@@ -2610,7 +2974,7 @@ var _ = Describe("Manager", func() {
 			},
 		})
 
-		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 
 		// we need the non-typecast object to make the function we want to test available
 		libvirtmanager := manager.(*LibvirtDomainManager)
@@ -2619,6 +2983,37 @@ var _ = Describe("Manager", func() {
 		Expect(virtualMachineInstanceGuestAgentInfo).ToNot(BeEmpty())
 	})
 
+	It("resolves the volume names of filesystem disks on GetFilesystems", func() {
+		agentStore := agentpoller.NewAsyncAgentStore()
+		agentStore.Store(agentpoller.GetFilesystem, []api.Filesystem{
+			{
+				Name:       "vda1",
+				Mountpoint: "/",
+				Type:       "xfs",
+				Disk: []api.FSDisk{
+					{BusType: "virtio", PCIAddress: "0000:07:00.0"},
+					{BusType: "scsi", Target: 0, Unit: 1},
+					{BusType: "sata"},
+				},
+			},
+		})
+
+		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
+		libvirtmanager := manager.(*LibvirtDomainManager)
+		libvirtmanager.diskAddressAliasMap = map[string]string{
+			diskAddressKey(v1.DiskBusVirtio, &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x07", Slot: "0x00", Function: "0x0"}): "rootdisk",
+			diskAddressKey(v1.DiskBusSCSI, &api.Address{Type: "drive", Controller: "0", Bus: "0", Target: "0", Unit: "1"}):                     "datadisk",
+		}
+
+		filesystems := libvirtmanager.GetFilesystems()
+		Expect(filesystems).To(HaveLen(1))
+		Expect(filesystems[0].Disk).To(Equal([]v1.VirtualMachineInstanceFileSystemDisk{
+			{BusType: "virtio", VolumeName: "rootdisk"},
+			{BusType: "scsi", VolumeName: "datadisk"},
+			{BusType: "sata"},
+		}))
+	})
+
 	It("executes generateCloudInitEmptyISO and succeeds", func() {
 		agentStore := agentpoller.NewAsyncAgentStore()
 		agentStore.Store(agentpoller.GetFilesystem, []api.Filesystem{
@@ -2637,7 +3032,7 @@ var _ = Describe("Manager", func() {
 			},
 		})
 
-		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 
 		// we need the non-typecast object to make the function we want to test available
 		libvirtmanager := manager.(*LibvirtDomainManager)
@@ -2683,7 +3078,7 @@ var _ = Describe("Manager", func() {
 			},
 		})
 
-		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false)
+		manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, virtconfig.DefaultARCHOVMFPath, nil, ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false, false, nil, v1.KvmHypervisorName, nil, "", false, DomainStatsOptions{})
 
 		// we need the non-typecast object to make the function we want to test available
 		libvirtmanager := manager.(*LibvirtDomainManager)
@@ -2762,6 +3157,135 @@ var _ = Describe("Manager", func() {
 		})
 	})
 
+	Context("on guest files", func() {
+		const (
+			openReadCmd  = `{"execute":"guest-file-open","arguments":{"path":"/etc/motd","mode":"r"}}`
+			openWriteCmd = `{"execute":"guest-file-open","arguments":{"path":"/etc/motd","mode":"w"}}`
+			closeCmd     = `{"execute":"guest-file-close","arguments":{"handle":1000}}`
+		)
+		readCmd := func(count int) string {
+			return fmt.Sprintf(`{"execute":"guest-file-read","arguments":{"handle":1000,"count":%d}}`, count)
+		}
+
+		It("should read a guest file", func() {
+			manager, _ := newLibvirtDomainManagerDefault()
+			vmi := newVMI(testNamespace, testVmName)
+			gomock.InOrder(
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(openReadCmd, testDomainName).Return(`{"return":1000}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(readCmd(11), testDomainName).Return(`{"return":{"count":7,"buf-b64":"V2VsY29tZQ==","eof":true}}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(closeCmd, testDomainName).Return(`{"return":{}}`, nil),
+			)
+
+			Expect(manager.ReadGuestFile(vmi, "/etc/motd", 10)).To(Equal([]byte("Welcome")))
+		})
+
+		It("should refuse to read a guest file larger than the limit and close it", func() {
+			manager, _ := newLibvirtDomainManagerDefault()
+			vmi := newVMI(testNamespace, testVmName)
+			gomock.InOrder(
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(openReadCmd, testDomainName).Return(`{"return":1000}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(readCmd(5), testDomainName).Return(`{"return":{"count":5,"buf-b64":"V2VsY28=","eof":false}}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(closeCmd, testDomainName).Return(`{"return":{}}`, nil),
+			)
+
+			_, err := manager.ReadGuestFile(vmi, "/etc/motd", 4)
+			Expect(err).To(MatchError("file /etc/motd is larger than 4 bytes"))
+		})
+
+		It("should write a guest file", func() {
+			manager, _ := newLibvirtDomainManagerDefault()
+			vmi := newVMI(testNamespace, testVmName)
+			gomock.InOrder(
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(openWriteCmd, testDomainName).Return(`{"return":1000}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"guest-file-write","arguments":{"handle":1000,"buf-b64":"V2VsY29tZQ=="}}`, testDomainName).Return(`{"return":{"count":7,"eof":false}}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(closeCmd, testDomainName).Return(`{"return":{}}`, nil),
+			)
+
+			Expect(manager.WriteGuestFile(vmi, "/etc/motd", []byte("Welcome"))).To(Succeed())
+		})
+	})
+
+	Context("on guest exec", func() {
+		const (
+			redirectedExecCmd = `{"execute":"guest-exec","arguments":{"path":"/bin/sh","arg":["-c","exec \"$0\" \"$@\" \u003e/tmp/kubevirt-guest-exec-$$.stdout 2\u003e/tmp/kubevirt-guest-exec-$$.stderr","/usr/bin/ls","-l"],"capture-output":false}}`
+			capturedExecCmd   = `{"execute":"guest-exec","arguments":{"path":"/usr/bin/ls","arg":["-l"],"capture-output":true}}`
+			statusCmd         = `{"execute":"guest-exec-status","arguments":{"pid":42}}`
+			openStdoutCmd     = `{"execute":"guest-file-open","arguments":{"path":"/tmp/kubevirt-guest-exec-42.stdout","mode":"r"}}`
+			openStderrCmd     = `{"execute":"guest-file-open","arguments":{"path":"/tmp/kubevirt-guest-exec-42.stderr","mode":"r"}}`
+			readCmd           = `{"execute":"guest-file-read","arguments":{"handle":1000,"count":65536}}`
+			closeCmd          = `{"execute":"guest-file-close","arguments":{"handle":1000}}`
+		)
+		seekCmd := func(offset int) string {
+			return fmt.Sprintf(`{"execute":"guest-file-seek","arguments":{"handle":1000,"offset":%d,"whence":"set"}}`, offset)
+		}
+		expectStdout := func(offset int, result string) {
+			gomock.InOrder(
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(openStdoutCmd, testDomainName).Return(`{"return":1000}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(seekCmd(offset), testDomainName).Return(`{"return":{"position":0,"eof":false}}`, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(readCmd, testDomainName).Return(result, nil),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(closeCmd, testDomainName).Return(`{"return":{}}`, nil),
+			)
+		}
+
+		It("should return the output of a command while it runs", func() {
+			manager, _ := newLibvirtDomainManagerDefault()
+			vmi := newVMI(testNamespace, testVmName)
+			mockLibvirt.ConnectionEXPECT().GuestAgentCommandPolicy().Return(nil)
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(redirectedExecCmd, testDomainName).Return(`{"return":{"pid":42}}`, nil)
+			Expect(manager.GuestExecStart(vmi, "/usr/bin/ls", []string{"-l"})).To(Equal(int64(42)))
+
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(statusCmd, testDomainName).Return(`{"return":{"exited":false}}`, nil)
+			expectStdout(0, `{"return":{"count":7,"buf-b64":"V2VsY29tZQ==","eof":true}}`)
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(openStderrCmd, testDomainName).Return("", fmt.Errorf("No such file or directory"))
+			status, err := manager.GuestExecStatus(vmi, 42)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Exited).To(BeFalse())
+			Expect(status.Stdout).To(Equal([]byte("Welcome")))
+
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(statusCmd, testDomainName).Return(`{"return":{"exited":true,"exitcode":3}}`, nil)
+			expectStdout(7, `{"return":{"count":0,"buf-b64":"","eof":true}}`)
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(openStderrCmd, testDomainName).Return("", fmt.Errorf("No such file or directory"))
+			removed := make(chan struct{})
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(gomock.Any(), testDomainName).DoAndReturn(func(string, string) (string, error) {
+				close(removed)
+				return "", fmt.Errorf("guest agent disconnected")
+			})
+			status, err = manager.GuestExecStatus(vmi, 42)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Exited).To(BeTrue())
+			Expect(status.ExitCode).To(Equal(3))
+			Expect(status.Stdout).To(BeEmpty())
+			Eventually(removed).Should(BeClosed())
+		})
+
+		It("should fall back to the captured output if the guest files can not be read", func() {
+			manager, _ := newLibvirtDomainManagerDefault()
+			vmi := newVMI(testNamespace, testVmName)
+			mockLibvirt.ConnectionEXPECT().GuestAgentCommandPolicy().Return(agentpolicy.New(&v1.GuestAgentCommandsConfiguration{
+				DeniedCommands: []string{"guest-file-seek"},
+			}))
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(capturedExecCmd, testDomainName).Return(`{"return":{"pid":42}}`, nil)
+			Expect(manager.GuestExecStart(vmi, "/usr/bin/ls", []string{"-l"})).To(Equal(int64(42)))
+
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(statusCmd, testDomainName).Return(`{"return":{"exited":true,"exitcode":0,"out-data":"V2VsY29tZQ=="}}`, nil)
+			status, err := manager.GuestExecStatus(vmi, 42)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Exited).To(BeTrue())
+			Expect(status.Stdout).To(Equal([]byte("Welcome")))
+		})
+
+		It("should fall back to the captured output if the guest has no shell", func() {
+			manager, _ := newLibvirtDomainManagerDefault()
+			vmi := newVMI(testNamespace, testVmName)
+			mockLibvirt.ConnectionEXPECT().GuestAgentCommandPolicy().Return(nil)
+			gomock.InOrder(
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(redirectedExecCmd, testDomainName).Return("", fmt.Errorf("Failed to execute child process")),
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(capturedExecCmd, testDomainName).Return(`{"return":{"pid":42}}`, nil),
+			)
+			Expect(manager.GuestExecStart(vmi, "/usr/bin/ls", []string{"-l"})).To(Equal(int64(42)))
+		})
+	})
+
 	Context("on GuestPing", func() {
 		const pingCmd = `{"execute":"guest-ping"}`
 
@@ -28,6 +28,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"libvirt.org/go/libvirt"
 
+	agentpolicy "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-policy"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
 
@@ -117,16 +118,26 @@ func (d *virtDomain) Free() error {
 
 type virtConnection struct {
 	*cli.MockConnection
-	callStack *callStack
+	callStack          *callStack
+	agentCommandPolicy *agentpolicy.Policy
 }
 
 func newVirtConnection(ctrl *gomock.Controller, callStack *callStack) *virtConnection {
 	return &virtConnection{
-		cli.NewMockConnection(ctrl),
-		callStack,
+		MockConnection: cli.NewMockConnection(ctrl),
+		callStack:      callStack,
 	}
 }
 
+// SetGuestAgentCommandPolicy stores the policy instead of recording the call, most tests don't care about it
+func (c *virtConnection) SetGuestAgentCommandPolicy(policy *agentpolicy.Policy) {
+	c.agentCommandPolicy = policy
+}
+
+func (c *virtConnection) GuestAgentCommandPolicy() *agentpolicy.Policy {
+	return c.agentCommandPolicy
+}
+
 func (c *virtConnection) LookupDomainByName(name string) (cli.VirDomain, error) {
 	val, err := c.MockConnection.LookupDomainByName(name)
 	if err != nil {
@@ -36,9 +36,12 @@ const QEMUSeaBiosDebugPipe = compute.QEMUSeaBiosDebugPipe
 const (
 	qemuConfPath        = "/etc/libvirt/qemu.conf"
 	virtqemudConfPath   = "/etc/libvirt/virtqemud.conf"
+	virtlogdConfPath    = "/etc/libvirt/virtlogd.conf"
 	libvirtRuntimePath  = "/var/run/libvirt"
 	libvirtHomePath     = "/var/run/kubevirt-private/libvirt"
 	qemuNonRootConfPath = libvirtHomePath + "/qemu.conf"
+
+	runtimeVirtlogdConfPath = libvirtRuntimePath + "/virtlogd.conf"
 )
 
 var LifeCycleTranslationMap = map[libvirt.DomainState]api.LifeCycle{
@@ -297,7 +300,7 @@ func GetQemuLogPath(domainName string, nonRoot bool) string {
 
 func startVirtlogdLogging(stopChan chan struct{}, domainName string, nonRoot bool) {
 	for {
-		cmd := exec.Command("/usr/sbin/virtlogd", "-f", "/etc/libvirt/virtlogd.conf")
+		cmd := exec.Command("/usr/sbin/virtlogd", "-f", runtimeVirtlogdConfPath)
 
 		exitChan := make(chan struct{})
 
@@ -487,6 +490,38 @@ func configureQemuConf(qemuFilename string) (err error) {
 	return nil
 }
 
+// configureVirtlogdConf applies the serial console log rotation limits
+// passed by virt-controller. virtlogd rotates the log once it reaches max_size.
+func configureVirtlogdConf(virtlogdFilename string) (err error) {
+	virtlogdConf, err := os.OpenFile(virtlogdFilename, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer util.CloseIOAndCheckErr(virtlogdConf, &err)
+
+	if maxSizeStr, ok := os.LookupEnv(util.ENV_VAR_SERIAL_LOG_MAX_SIZE); ok {
+		maxSize, err := strconv.ParseUint(maxSizeStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %v", util.ENV_VAR_SERIAL_LOG_MAX_SIZE, maxSizeStr, err)
+		}
+		if _, err = virtlogdConf.WriteString(fmt.Sprintf("max_size = %d\n", maxSize)); err != nil {
+			return err
+		}
+	}
+
+	if maxBackupsStr, ok := os.LookupEnv(util.ENV_VAR_SERIAL_LOG_MAX_BACKUPS); ok {
+		maxBackups, err := strconv.ParseUint(maxBackupsStr, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %v", util.ENV_VAR_SERIAL_LOG_MAX_BACKUPS, maxBackupsStr, err)
+		}
+		if _, err = virtlogdConf.WriteString(fmt.Sprintf("max_backups = %d\n", maxBackups)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func copyFile(from, to string) error {
 	f, err := os.OpenFile(from, os.O_RDONLY, 0644)
 	if err != nil {
@@ -525,6 +560,14 @@ func (l LibvirtWrapper) SetupLibvirt(customLogFilters *string) (err error) {
 		return err
 	}
 
+	if err := copyFile(virtlogdConfPath, runtimeVirtlogdConfPath); err != nil {
+		return err
+	}
+
+	if err := configureVirtlogdConf(runtimeVirtlogdConfPath); err != nil {
+		return err
+	}
+
 	var libvirtLogVerbosityEnvVar *string
 	if envVarValue, envVarDefined := os.LookupEnv(util.ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY); envVarDefined {
 		libvirtLogVerbosityEnvVar = &envVarValue
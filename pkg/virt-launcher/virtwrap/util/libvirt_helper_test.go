@@ -356,4 +356,43 @@ var _ = Describe("LibvirtHelper", func() {
 		)
 
 	})
+
+	Context("configureVirtlogdConf()", func() {
+		var confPath string
+
+		BeforeEach(func() {
+			confPath = filepath.Join(GinkgoT().TempDir(), "virtlogd.conf")
+			Expect(os.WriteFile(confPath, []byte("dummy = 1\n"), 0644)).To(Succeed())
+		})
+
+		setEnv := func(key, value string) {
+			Expect(os.Setenv(key, value)).To(Succeed())
+			DeferCleanup(os.Unsetenv, key)
+		}
+
+		readLines := func() []string {
+			content, err := os.ReadFile(confPath)
+			Expect(err).ToNot(HaveOccurred())
+			return strings.Split(string(content), "\n")
+		}
+
+		It("should leave virtlogd.conf untouched when no rotation limits are set", func() {
+			Expect(configureVirtlogdConf(confPath)).To(Succeed())
+			Expect(readLines()).To(Equal([]string{"dummy = 1", ""}))
+		})
+
+		It("should append the rotation limits from env", func() {
+			setEnv(util.ENV_VAR_SERIAL_LOG_MAX_SIZE, "8388608")
+			setEnv(util.ENV_VAR_SERIAL_LOG_MAX_BACKUPS, "5")
+
+			Expect(configureVirtlogdConf(confPath)).To(Succeed())
+			Expect(readLines()).To(ContainElements("dummy = 1", "max_size = 8388608", "max_backups = 5"))
+		})
+
+		It("should fail on a malformed rotation limit", func() {
+			setEnv(util.ENV_VAR_SERIAL_LOG_MAX_SIZE, "8Mi")
+
+			Expect(configureVirtlogdConf(confPath)).To(MatchError(ContainSubstring(util.ENV_VAR_SERIAL_LOG_MAX_SIZE)))
+		})
+	})
 })
@@ -173,3 +173,34 @@ func ReadPanicInfoFromLog(logPath string) (*api.GuestPanicInfo, error) {
 
 	return &api.GuestPanicInfo{}, nil
 }
+
+// ReadConsoleLogTail returns the last lines of the serial console log.
+// At most maxBytes are read from the end of the file.
+func ReadConsoleLogTail(logPath string, lines int, maxBytes int64) (string, error) {
+	file, err := os.Open(logPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open console log file: %w", err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to stat console log file: %w", err)
+	}
+
+	offset := max(stat.Size()-maxBytes, 0)
+	buf := make([]byte, stat.Size()-offset)
+	if _, err := file.ReadAt(buf, offset); err != nil {
+		return "", fmt.Errorf("failed to read console log file: %w", err)
+	}
+
+	tail := strings.Split(strings.TrimRight(strings.ReplaceAll(string(buf), "\r", ""), "\n"), "\n")
+	if offset > 0 && len(tail) > 1 {
+		// the first line may have been cut
+		tail = tail[1:]
+	}
+	if len(tail) > lines {
+		tail = tail[len(tail)-lines:]
+	}
+	return strings.Join(tail, "\n"), nil
+}
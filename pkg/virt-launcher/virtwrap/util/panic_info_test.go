@@ -221,4 +221,45 @@ panic hyper-v: arg1='0x3'
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("ReadConsoleLogTail", func() {
+		var logFile string
+
+		BeforeEach(func() {
+			logFile = filepath.Join(GinkgoT().TempDir(), "virt-serial0-log")
+		})
+
+		It("should return the last lines of the console log", func() {
+			Expect(os.WriteFile(logFile, []byte("line 1\r\nline 2\r\nline 3\r\n"), 0644)).To(Succeed())
+
+			tail, err := ReadConsoleLogTail(logFile, 2, 1024)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tail).To(Equal("line 2\nline 3"))
+		})
+
+		It("should return the whole console log when it is shorter than the requested lines", func() {
+			Expect(os.WriteFile(logFile, []byte("line 1\nline 2\n"), 0644)).To(Succeed())
+
+			tail, err := ReadConsoleLogTail(logFile, 20, 1024)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tail).To(Equal("line 1\nline 2"))
+		})
+
+		It("should drop the line cut by the byte limit", func() {
+			Expect(os.WriteFile(logFile, []byte("first line\nsecond\nthird\n"), 0644)).To(Succeed())
+
+			tail, err := ReadConsoleLogTail(logFile, 20, 16)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tail).To(Equal("second\nthird"))
+		})
+
+		It("should return error for non-existent file", func() {
+			_, err := ReadConsoleLogTail("/non/existent/file.log", 20, 1024)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
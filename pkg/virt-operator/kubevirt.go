@@ -1038,6 +1038,9 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 	// Set the default architecture
 	operatorutil.SetDefaultArchitecture(kv)
 
+	// Report the lifecycle of the feature gates
+	operatorutil.SetFeatureGatesStatus(kv)
+
 	if kv.Status.Phase == "" {
 		kv.Status.Phase = v1.KubeVirtPhaseDeploying
 	}
@@ -91,8 +91,8 @@ const (
 	NAMESPACE = "kubevirt-test"
 
 	// +1 for ContainerPathVolumes webhook (always enabled in tests)
-	resourceCount = 99 + virtTemplateResourceCount
-	patchCount    = 67 + virtTemplatePatchCount
+	resourceCount = 106 + virtTemplateResourceCount
+	patchCount    = 74 + virtTemplatePatchCount
 	updateCount   = 33 + virtTemplateUpdateCount
 
 	// 1 because a temporary validation webhook is created to block new CRDs until api server is deployed
@@ -118,6 +118,8 @@ var (
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
 		components.NewVirtualMachineBackupTrackerCrd, components.NewPluginCrd,
+		components.NewVirtualMachineDefaultsCrd, components.NewVirtualMachineQuotaCrd,
+		components.NewNodeMaintenanceCrd,
 	}
 	numCRDs = len(crdFunctions) + numVirtTemplateCRDs
 )
@@ -1285,6 +1287,11 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 	pr, err := rules.BuildPrometheusRule(config.GetNamespace())
 	Expect(err).ToNot(HaveOccurred())
 	all = append(all, pr)
+	if config.WorkflowAlertsEnabled() {
+		workflowPr, err := rules.BuildWorkflowPrometheusRule(config.GetNamespace())
+		Expect(err).ToNot(HaveOccurred())
+		all = append(all, workflowPr)
+	}
 	// sccs
 	all = append(all, components.NewKubeVirtControllerSCC(NAMESPACE))
 	all = append(all, components.NewKubeVirtHandlerSCC(NAMESPACE))
@@ -1411,6 +1418,8 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 	all = append(all, vap.NewHandlerV1ValidatingAdmissionPolicy(userName), vap.NewHandlerV1ValidatingAdmissionPolicyBinding())
 	all = append(all, vap.NewPluginValidatingAdmissionPolicy(), vap.NewPluginValidatingAdmissionPolicyBinding())
 	all = append(all, vap.NewPluginWarningAdmissionPolicy(), vap.NewPluginWarningAdmissionPolicyBinding())
+	all = append(all, vap.NewVMSnapshotValidatingAdmissionPolicy(), vap.NewVMSnapshotValidatingAdmissionPolicyBinding())
+	all = append(all, vap.NewVMRestoreValidatingAdmissionPolicy(), vap.NewVMRestoreValidatingAdmissionPolicyBinding())
 
 	if config.VirtTemplateDeploymentEnabled() {
 		resources, err := components.NewVirtTemplateResources(config)
@@ -14,6 +14,8 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
 
 func (r *Reconciler) createOrUpdateAPIServices(caBundle []byte) error {
@@ -24,9 +26,44 @@ func (r *Reconciler) createOrUpdateAPIServices(caBundle []byte) error {
 		}
 	}
 
+	if !r.isPoolMetricsAdapterEnabled() {
+		util.RemoveConditionsPoolMetricsAdapter(r.kv)
+		return nil
+	}
+
+	return r.createOrUpdatePoolMetricsAPIService(caBundle)
+}
+
+// createOrUpdatePoolMetricsAPIService registers virt-api for the external metrics API. Only one adapter can serve
+// the API in a cluster, so an APIService of another adapter, e.g. prometheus-adapter, is never taken over.
+func (r *Reconciler) createOrUpdatePoolMetricsAPIService(caBundle []byte) error {
+	apiService := components.NewPoolMetricsAPIService(r.kv.Namespace)
+
+	if _, exists, _ := r.stores.APIServiceCache.Get(apiService); !exists {
+		existing, err := r.aggregatorclient.Get(context.Background(), apiService.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil && !util.IsManagedByOperator(existing.Labels) {
+			log.Log.Warningf("apiservice %s is not managed by KubeVirt, not registering the pool metrics adapter", apiService.Name)
+			util.UpdateConditionsPoolMetricsAdapterNotManaged(r.kv, apiService.Name)
+			return nil
+		}
+	}
+
+	if err := r.createOrUpdateAPIService(apiService, caBundle); err != nil {
+		return err
+	}
+	util.UpdateConditionsPoolMetricsAdapterRegistered(r.kv, apiService.Name)
+
 	return nil
 }
 
+// isPoolMetricsAdapterEnabled tells whether virt-api serves the external metrics API of the VirtualMachinePools
+func (r *Reconciler) isPoolMetricsAdapterEnabled() bool {
+	return r.kv.Spec.Configuration.PoolMetricsAdapter != nil
+}
+
 func (r *Reconciler) createOrUpdateAPIService(apiService *apiregv1.APIService, caBundle []byte) error {
 	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
 	injectOperatorMetadata(r.kv, &apiService.ObjectMeta, version, imageRegistry, id, true)
@@ -0,0 +1,108 @@
+package apply
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("Apply APIServices", func() {
+
+	var (
+		apiServiceClient *install.MockAPIServiceInterface
+		kv               *v1.KubeVirt
+		r                *Reconciler
+
+		existingAPIServices map[string]*apiregv1.APIService
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		apiServiceClient = install.NewMockAPIServiceInterface(ctrl)
+		existingAPIServices = map[string]*apiregv1.APIService{}
+		apiServiceClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, name string, _ metav1.GetOptions) (*apiregv1.APIService, error) {
+				if apiService, exists := existingAPIServices[name]; exists {
+					return apiService, nil
+				}
+				return nil, errors.NewNotFound(schema.GroupResource{Resource: "apiservices"}, name)
+			}).AnyTimes()
+
+		strategy := install.NewMockStrategyInterface(ctrl)
+		strategy.EXPECT().APIServices().Return(components.NewVirtAPIAPIServices(Namespace)).AnyTimes()
+
+		kv = &v1.KubeVirt{ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: Namespace}}
+		r = &Reconciler{
+			kv:               kv,
+			kvKey:            Namespace + "/kubevirt",
+			targetStrategy:   strategy,
+			stores:           util.Stores{APIServiceCache: cache.NewStore(cache.MetaNamespaceKeyFunc)},
+			aggregatorclient: apiServiceClient,
+			expectations: &util.Expectations{
+				APIService: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("APIService")),
+			},
+		}
+	})
+
+	createdAPIServices := func() []string {
+		var names []string
+		apiServiceClient.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, apiService *apiregv1.APIService, _ metav1.CreateOptions) (*apiregv1.APIService, error) {
+				names = append(names, apiService.Name)
+				return apiService, nil
+			}).AnyTimes()
+		Expect(r.createOrUpdateAPIServices([]byte("ca"))).To(Succeed())
+		return names
+	}
+
+	It("should not register the external metrics API by default", func() {
+		Expect(createdAPIServices()).ToNot(ContainElement(components.PoolMetricsAPIServiceName))
+	})
+
+	It("should register the external metrics API when the pool metrics adapter is configured", func() {
+		kv.Spec.Configuration.PoolMetricsAdapter = &v1.PoolMetricsAdapterConfiguration{PrometheusURL: "http://prometheus:9090"}
+		Expect(createdAPIServices()).To(ContainElement(components.PoolMetricsAPIServiceName))
+		Expect(kv.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", v1.KubeVirtConditionPoolMetricsAdapterRegistered),
+			HaveField("Status", k8sv1.ConditionTrue),
+		)))
+	})
+
+	It("should not take over the external metrics API of another adapter", func() {
+		kv.Spec.Configuration.PoolMetricsAdapter = &v1.PoolMetricsAdapterConfiguration{PrometheusURL: "http://prometheus:9090"}
+		existingAPIServices[components.PoolMetricsAPIServiceName] = &apiregv1.APIService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   components.PoolMetricsAPIServiceName,
+				Labels: map[string]string{v1.ManagedByLabel: "prometheus-adapter"},
+			},
+		}
+		apiServiceClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		Expect(createdAPIServices()).ToNot(ContainElement(components.PoolMetricsAPIServiceName))
+		Expect(kv.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", v1.KubeVirtConditionPoolMetricsAdapterRegistered),
+			HaveField("Status", k8sv1.ConditionFalse),
+			HaveField("Reason", util.ConditionReasonAPIServiceNotManaged),
+		)))
+	})
+
+	It("should drop the condition when the pool metrics adapter is not configured anymore", func() {
+		util.UpdateConditionsPoolMetricsAdapterNotManaged(kv, components.PoolMetricsAPIServiceName)
+
+		createdAPIServices()
+		Expect(kv.Status.Conditions).To(BeEmpty())
+	})
+})
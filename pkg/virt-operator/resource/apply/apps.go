@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/util/workqueue"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -219,13 +220,7 @@ func daemonHasDefaultRolloutStrategy(daemonSet *appsv1.DaemonSet) bool {
 func (r *Reconciler) processCanaryUpgrade(cachedDaemonSet, newDS *appsv1.DaemonSet, objectChanged bool) (bool, error, canaryUpgradeStatus) {
 	var updatedAndReadyPods int32
 
-	if hasTLS(cachedDaemonSet) && !hasTLS(newDS) {
-		insertTLS(newDS)
-	}
-	if !hasCertificateSecret(&cachedDaemonSet.Spec.Template.Spec, components.VirtHandlerCertSecretName) &&
-		hasCertificateSecret(&newDS.Spec.Template.Spec, components.VirtHandlerCertSecretName) {
-		unattachCertificateSecret(&newDS.Spec.Template.Spec, components.VirtHandlerCertSecretName)
-	}
+	keepTLSSettings(cachedDaemonSet, newDS)
 	log := log.Log.With("resource", fmt.Sprintf("ds/%s", cachedDaemonSet.Name))
 
 	desiredReadyPods := cachedDaemonSet.Status.DesiredNumberScheduled
@@ -309,6 +304,25 @@ func (r *Reconciler) processCanaryUpgrade(cachedDaemonSet, newDS *appsv1.DaemonS
 	}
 }
 
+// keepTLSSettings carries the migration TLS settings, which were already
+// rolled out, over to the new DaemonSet
+func keepTLSSettings(cachedDaemonSet, newDS *appsv1.DaemonSet) {
+	if hasTLS(cachedDaemonSet) && !hasTLS(newDS) {
+		insertTLS(newDS)
+	}
+	if !hasCertificateSecret(&cachedDaemonSet.Spec.Template.Spec, components.VirtHandlerCertSecretName) &&
+		hasCertificateSecret(&newDS.Spec.Template.Spec, components.VirtHandlerCertSecretName) {
+		unattachCertificateSecret(&newDS.Spec.Template.Spec, components.VirtHandlerCertSecretName)
+	}
+}
+
+// tlsRolloutPending returns true while the DaemonSet still has to be switched
+// to the migration TLS settings, which only the canary upgrade does
+func tlsRolloutPending(daemonSet *appsv1.DaemonSet) bool {
+	return supportsTLS(daemonSet) &&
+		(!hasTLS(daemonSet) || hasCertificateSecret(&daemonSet.Spec.Template.Spec, components.VirtHandlerCertSecretName))
+}
+
 func supportsTLS(daemonSet *appsv1.DaemonSet) bool {
 	if daemonSet.Labels == nil {
 		return false
@@ -369,7 +383,7 @@ func getMaxUnavailable(daemonSet *appsv1.DaemonSet) int {
 	return daemonSetDefaultMaxUnavailable.IntValue()
 }
 
-func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) (bool, error) {
+func (r *Reconciler) syncDaemonSet(queue workqueue.TypedRateLimitingInterface[string], daemonSet *appsv1.DaemonSet) (bool, error) {
 	kv := r.kv
 
 	daemonSet = daemonSet.DeepCopy()
@@ -415,6 +429,18 @@ func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) (bool, error) {
 
 	specChanged := !util.DaemonSetIsUpToDate(r.kv, cachedDaemonSet) || *objectMetaModified
 	generationUnknown := existingCopy.GetGeneration() != GetExpectedGeneration(daemonSet, kv.Status.Generations)
+
+	if strategy := stagedRolloutStrategy(kv, daemonSet); strategy != nil {
+		keepTLSSettings(cachedDaemonSet, daemonSet)
+		if !tlsRolloutPending(daemonSet) {
+			if !specChanged && !generationUnknown && hasOnDeleteUpdateStrategy(cachedDaemonSet) {
+				log.Log.V(4).Infof("daemonset %v is up-to-date", daemonSet.GetName())
+				return true, nil
+			}
+			return r.processStagedRollout(queue, cachedDaemonSet, daemonSet, strategy, specChanged)
+		}
+	}
+
 	ongoingRollout := !daemonHasDefaultRolloutStrategy(cachedDaemonSet)
 
 	if !specChanged && !ongoingRollout && !generationUnknown {
@@ -435,7 +461,10 @@ func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) (bool, error) {
 	// that an unknown generation alone does not restart the canary from
 	// scratch. The generation will be re-recorded when the canary
 	// completes.
-	done, err, _ := r.processCanaryUpgrade(cachedDaemonSet, daemonSet, specChanged)
+	//
+	// A DaemonSet left with the OnDelete update strategy by a staged rollout
+	// is treated as changed to restore the rolling update.
+	done, err, _ := r.processCanaryUpgrade(cachedDaemonSet, daemonSet, specChanged || hasOnDeleteUpdateStrategy(cachedDaemonSet))
 	return done, err
 }
 
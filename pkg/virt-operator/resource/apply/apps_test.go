@@ -41,6 +41,7 @@ import (
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 
 	secv1 "github.com/openshift/api/security/v1"
 	secv1fake "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1/fake"
@@ -221,6 +222,7 @@ var _ = Describe("Apply Apps", func() {
 		var mockDSCacheStore *MockStore
 		var mockPodCacheStore *cache.FakeCustomStore
 		var dsClient *fake.Clientset
+		var queue workqueue.TypedRateLimitingInterface[string]
 
 		var ctrl *gomock.Controller
 
@@ -294,6 +296,7 @@ var _ = Describe("Apply Apps", func() {
 			kvInterface := kubecli.NewMockKubeVirtInterface(ctrl)
 
 			dsClient = fake.NewSimpleClientset()
+			queue = workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
 
 			stores = util.Stores{}
 			mockDSCacheStore = &MockStore{}
@@ -351,7 +354,7 @@ var _ = Describe("Apply Apps", func() {
 					return true, update.GetObject(), nil
 				})
 
-				_, err = r.syncDaemonSet(daemonSet)
+				_, err = r.syncDaemonSet(queue, daemonSet)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeTrue())
@@ -406,7 +409,7 @@ var _ = Describe("Apply Apps", func() {
 					return true, &appsv1.DaemonSet{}, nil
 				})
 
-				_, err = r.syncDaemonSet(daemonSet)
+				_, err = r.syncDaemonSet(queue, daemonSet)
 
 				Expect(patched).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
@@ -417,7 +420,7 @@ var _ = Describe("Apply Apps", func() {
 				containMaxDeviceFlag = false
 				kv.SetGeneration(3)
 
-				_, err = r.syncDaemonSet(daemonSet)
+				_, err = r.syncDaemonSet(queue, daemonSet)
 
 				Expect(patched).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
@@ -512,7 +515,7 @@ var _ = Describe("Apply Apps", func() {
 				_, err := r.clientset.AppsV1().DaemonSets(cachedDs.Namespace).Create(context.TODO(), cachedDs, v12.CreateOptions{})
 				Expect(err).ToNot(HaveOccurred())
 
-				done, err := r.syncDaemonSet(daemonSet)
+				done, err := r.syncDaemonSet(queue, daemonSet)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(done).To(BeFalse())
@@ -567,7 +570,7 @@ var _ = Describe("Apply Apps", func() {
 
 				newDs := daemonSet.DeepCopy()
 				addCustomTargetDeployment(kv, newDs)
-				done, err := r.syncDaemonSet(newDs)
+				done, err := r.syncDaemonSet(queue, newDs)
 
 				Expect(patched).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
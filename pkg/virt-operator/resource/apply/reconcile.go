@@ -49,6 +49,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
@@ -501,12 +502,12 @@ func (r *Reconciler) Sync(queue workqueue.TypedRateLimitingInterface[string]) (b
 	}
 
 	if shouldTakeUpdatePath(targetVersion, observedVersion) {
-		finished, err := r.updateKubeVirtSystem(controllerDeploymentsRolledOver)
+		finished, err := r.updateKubeVirtSystem(queue, controllerDeploymentsRolledOver)
 		if !finished || err != nil {
 			return false, err
 		}
 	} else {
-		finished, err := r.createOrRollBackSystem(apiDeploymentsRolledOver)
+		finished, err := r.createOrRollBackSystem(queue, apiDeploymentsRolledOver)
 		if !finished || err != nil {
 			return false, err
 		}
@@ -558,7 +559,7 @@ func (r *Reconciler) Sync(queue workqueue.TypedRateLimitingInterface[string]) (b
 	return true, nil
 }
 
-func (r *Reconciler) createOrRollBackSystem(apiDeploymentsRolledOver bool) (bool, error) {
+func (r *Reconciler) createOrRollBackSystem(queue workqueue.TypedRateLimitingInterface[string], apiDeploymentsRolledOver bool) (bool, error) {
 	// CREATE/ROLLBACK PATH IS
 	// 1. apiserver - ensures validation of objects occur before allowing any control plane to act on them.
 	// 2. wait for apiservers to roll over
@@ -640,7 +641,7 @@ func (r *Reconciler) createOrRollBackSystem(apiDeploymentsRolledOver bool) (bool
 
 	// create/update Daemonsets
 	for _, daemonSet := range r.targetStrategy.DaemonSets() {
-		finished, err := r.syncDaemonSet(daemonSet)
+		finished, err := r.syncDaemonSet(queue, daemonSet)
 		if !finished || err != nil {
 			return false, err
 		}
@@ -740,8 +741,9 @@ func (r *Reconciler) deleteObjectsNotInInstallStrategy() error {
 	// remove unused APIServices
 	objects = r.stores.APIServiceCache.List()
 	for _, obj := range objects {
-		if apiService, ok := obj.(*apiregv1.APIService); ok && apiService.DeletionTimestamp == nil {
-			found := false
+		// never delete an APIService KubeVirt did not create, e.g. the one of another external metrics adapter
+		if apiService, ok := obj.(*apiregv1.APIService); ok && apiService.DeletionTimestamp == nil && util.IsManagedByOperator(apiService.Labels) {
+			found := apiService.Name == components.PoolMetricsAPIServiceName && r.isPoolMetricsAdapterEnabled()
 			for _, targetAPIService := range r.targetStrategy.APIServices() {
 				if targetAPIService.Name == apiService.Name {
 					found = true
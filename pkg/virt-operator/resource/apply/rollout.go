@@ -0,0 +1,279 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+const (
+	rolloutPausedReason = "RolloutPaused"
+
+	defaultPoolMaxUnavailable = 1
+	defaultPoolSoakSeconds    = 60
+	defaultRolloutErrorBudget = 0
+)
+
+// stagedRolloutStrategy returns the node pool rollout strategy configured
+// for the given DaemonSet, or nil if it is rolled out with the canary upgrade.
+func stagedRolloutStrategy(kv *v1.KubeVirt, daemonSet *appsv1.DaemonSet) *v1.NodePoolRolloutStrategy {
+	if daemonSet.GetName() != components.VirtHandlerName || kv.Spec.RolloutStrategy == nil {
+		return nil
+	}
+	return kv.Spec.RolloutStrategy.VirtHandler
+}
+
+func hasOnDeleteUpdateStrategy(daemonSet *appsv1.DaemonSet) bool {
+	return daemonSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType
+}
+
+func int32OrDefault(value *int32, defaultValue int32) int32 {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
+
+// nodePool holds the pods of a DaemonSet which run on the nodes of one pool
+type nodePool struct {
+	name     string
+	outdated []*corev1.Pod
+	updated  []*corev1.Pod
+}
+
+// groupPodsByNodePool sorts the pods into the node pools defined by the
+// pool label. The pools are ordered by the label value, nodes without the
+// label form the last pool.
+func groupPodsByNodePool(pods []*corev1.Pod, nodes []corev1.Node, kv *v1.KubeVirt, poolLabel string) []*nodePool {
+	nodePools := map[string]string{}
+	for _, node := range nodes {
+		nodePools[node.Name] = node.Labels[poolLabel]
+	}
+
+	pools := map[string]*nodePool{}
+	for _, pod := range pods {
+		name := nodePools[pod.Spec.NodeName]
+		pool, exists := pools[name]
+		if !exists {
+			pool = &nodePool{name: name}
+			pools[name] = pool
+		}
+		if util.PodIsUpToDate(pod, kv) {
+			pool.updated = append(pool.updated, pod)
+		} else {
+			pool.outdated = append(pool.outdated, pod)
+		}
+	}
+
+	sorted := make([]*nodePool, 0, len(pools))
+	for _, pool := range pools {
+		sorted = append(sorted, pool)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].name == "" || sorted[j].name == "" {
+			return sorted[j].name == ""
+		}
+		return sorted[i].name < sorted[j].name
+	})
+	return sorted
+}
+
+func podIsFailed(pod *corev1.Pod) bool {
+	return util.PodIsCrashLooping(pod) && !util.PodIsReady(pod)
+}
+
+func podIsUnavailable(pod *corev1.Pod) bool {
+	return pod.DeletionTimestamp != nil || !util.PodIsReady(pod)
+}
+
+// podReadyDuration returns how long the pod has been ready
+func podReadyDuration(pod *corev1.Pod) time.Duration {
+	if !util.PodIsReady(pod) {
+		return 0
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+			return time.Since(condition.LastTransitionTime.Time)
+		}
+	}
+	return 0
+}
+
+func (r *Reconciler) getDaemonSetPods(daemonSet *appsv1.DaemonSet) []*corev1.Pod {
+	pods := []*corev1.Pod{}
+	for _, obj := range r.stores.InfrastructurePodCache.List() {
+		pod := obj.(*corev1.Pod)
+		owner := metav1.GetControllerOf(pod)
+
+		if owner != nil && owner.Name == daemonSet.Name {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+// processStagedRollout rolls the DaemonSet out node pool by node pool.
+// The DaemonSet is switched to the OnDelete update strategy and the
+// outdated pods are deleted by the operator:
+// - the pools are updated one after another
+// - at most maxUnavailable pods of a pool are down at once
+// - a pool is only started once the updated pods of the previous pools were
+// ready for the soak time
+// - the rollout is paused while more updated pods fail than the error budget allows
+func (r *Reconciler) processStagedRollout(queue workqueue.TypedRateLimitingInterface[string], cachedDaemonSet, newDS *appsv1.DaemonSet, strategy *v1.NodePoolRolloutStrategy, objectChanged bool) (bool, error) {
+	log := log.Log.With("resource", fmt.Sprintf("ds/%s", cachedDaemonSet.Name))
+
+	newDS.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+		Type: appsv1.OnDeleteDaemonSetStrategyType,
+	}
+	if objectChanged || !hasOnDeleteUpdateStrategy(cachedDaemonSet) {
+		newDS, err := r.patchDaemonSet(cachedDaemonSet, newDS)
+		if err != nil {
+			return false, fmt.Errorf("unable to start staged rollout for daemonset %+v: %v", newDS, err)
+		}
+		log.V(2).Infof("daemonSet %v started staged rollout", newDS.GetName())
+		// Do not call SetGeneration here, the generation mismatch ensures
+		// that subsequent reconciles continue the rollout.
+		return false, nil
+	}
+
+	if strategy.Paused {
+		log.V(4).Infof("staged rollout of daemonSet %v is paused", cachedDaemonSet.GetName())
+		return false, nil
+	}
+
+	pods := r.getDaemonSetPods(cachedDaemonSet)
+
+	var outdated, failed int32
+	for _, pod := range pods {
+		if !util.PodIsUpToDate(pod, r.kv) {
+			outdated++
+		} else if podIsFailed(pod) {
+			failed++
+		}
+	}
+
+	if errorBudget := int32OrDefault(strategy.ErrorBudget, defaultRolloutErrorBudget); failed > errorBudget {
+		r.recorder.Eventf(cachedDaemonSet, corev1.EventTypeWarning, rolloutPausedReason,
+			"daemonSet %v rollout paused, %d updated pods failed which exceeds the error budget of %d", cachedDaemonSet.Name, failed, errorBudget)
+		return false, nil
+	}
+
+	if outdated == 0 {
+		if r.howManyUpdatedAndReadyPods(cachedDaemonSet) < cachedDaemonSet.Status.DesiredNumberScheduled {
+			log.V(4).Infof("waiting for all pods of daemonSet %v to be ready", cachedDaemonSet.GetName())
+			return false, nil
+		}
+		SetGeneration(&r.kv.Status.Generations, cachedDaemonSet)
+		log.V(2).Infof("daemonSet %v is ready", cachedDaemonSet.GetName())
+		return true, nil
+	}
+
+	if int32(len(pods)) < cachedDaemonSet.Status.DesiredNumberScheduled {
+		log.V(4).Infof("waiting for the pods of daemonSet %v to be recreated", cachedDaemonSet.GetName())
+		return false, nil
+	}
+
+	nodes, err := r.clientset.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("unable to list nodes: %v", err)
+	}
+
+	soakTime := time.Duration(int32OrDefault(strategy.PoolSoakSeconds, defaultPoolSoakSeconds)) * time.Second
+	for _, pool := range groupPodsByNodePool(pods, nodes.Items, r.kv, strategy.NodePoolLabel) {
+		if len(pool.outdated) == 0 {
+			// pools which are already updated have to be healthy for the soak
+			// time before the next pool is started
+			for _, pod := range pool.updated {
+				if !util.PodIsReady(pod) {
+					log.V(4).Infof("waiting for pod %s of daemonSet %v to be ready", pod.Name, cachedDaemonSet.GetName())
+					return false, nil
+				}
+				if remaining := soakTime - podReadyDuration(pod); remaining > 0 {
+					queue.AddAfter(r.kvKey, remaining)
+					return false, nil
+				}
+			}
+			continue
+		}
+
+		return false, r.updateNodePool(cachedDaemonSet, pool, int32OrDefault(strategy.MaxUnavailable, defaultPoolMaxUnavailable))
+	}
+
+	return false, nil
+}
+
+// updateNodePool deletes the outdated pods of the pool, while keeping at most
+// maxUnavailable pods of the pool unavailable
+func (r *Reconciler) updateNodePool(daemonSet *appsv1.DaemonSet, pool *nodePool, maxUnavailable int32) error {
+	var unavailable int32
+	candidates := []*corev1.Pod{}
+	for _, pod := range pool.updated {
+		if podIsUnavailable(pod) {
+			unavailable++
+		}
+	}
+	for _, pod := range pool.outdated {
+		if podIsUnavailable(pod) {
+			unavailable++
+		}
+		if pod.DeletionTimestamp == nil {
+			candidates = append(candidates, pod)
+		}
+	}
+	// pods which are down anyway are replaced first
+	sort.Slice(candidates, func(i, j int) bool {
+		if podIsUnavailable(candidates[i]) != podIsUnavailable(candidates[j]) {
+			return podIsUnavailable(candidates[i])
+		}
+		return candidates[i].Spec.NodeName < candidates[j].Spec.NodeName
+	})
+
+	for _, pod := range candidates {
+		available := !podIsUnavailable(pod)
+		if available && unavailable >= maxUnavailable {
+			return nil
+		}
+		err := r.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete pod %s of daemonset %s: %v", pod.Name, daemonSet.Name, err)
+		}
+		log.Log.V(2).Infof("deleted pod %s of daemonSet %v in node pool %q", pod.Name, daemonSet.GetName(), pool.name)
+		if available {
+			unavailable++
+		}
+	}
+	return nil
+}
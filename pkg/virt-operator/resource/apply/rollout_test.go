@@ -0,0 +1,367 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/placement"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("Staged rollout of virt-handler", func() {
+	const poolLabel = "node-pool"
+
+	var (
+		kv           *v1.KubeVirt
+		daemonSet    *appsv1.DaemonSet
+		cachedDs     *appsv1.DaemonSet
+		strategy     *v1.NodePoolRolloutStrategy
+		pods         []interface{}
+		k8sClient    *fake.Clientset
+		queue        *testutils.MockWorkQueue[string]
+		recorder     *record.FakeRecorder
+		mockDSCache  *MockStore
+		reconciler   *Reconciler
+		expectations *util.Expectations
+	)
+
+	newPod := func(node string, updated bool, readyFor time.Duration) *corev1.Pod {
+		version := kv.Status.TargetKubeVirtVersion
+		if !updated {
+			version = "old.version"
+		}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-handler-" + node,
+				Namespace: Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					{Name: daemonSet.Name, Controller: pointer.P(true), UID: daemonSet.UID},
+				},
+				Annotations: map[string]string{
+					v1.InstallStrategyVersionAnnotation:    version,
+					v1.InstallStrategyRegistryAnnotation:   kv.Status.TargetKubeVirtRegistry,
+					v1.InstallStrategyIdentifierAnnotation: kv.Status.TargetDeploymentID,
+				},
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Ready: true},
+				},
+				Conditions: []corev1.PodCondition{
+					{
+						Type:               corev1.PodReady,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-readyFor)),
+					},
+				},
+			},
+		}
+		return pod
+	}
+
+	addPods := func(newPods ...*corev1.Pod) {
+		for _, pod := range newPods {
+			pods = append(pods, pod)
+			_, err := k8sClient.CoreV1().Pods(Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		cachedDs.Status.DesiredNumberScheduled = int32(len(pods))
+	}
+
+	addNode := func(name, pool string) {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if pool != "" {
+			node.Labels = map[string]string{poolLabel: pool}
+		}
+		_, err := k8sClient.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	deletedPods := func() []string {
+		deleted := []string{}
+		for _, action := range k8sClient.Actions() {
+			if action.GetVerb() == "delete" && action.GetResource().Resource == "pods" {
+				deleted = append(deleted, action.(testing.DeleteAction).GetName())
+			}
+		}
+		return deleted
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		k8sClient = fake.NewSimpleClientset()
+		clientset := kubecli.NewMockKubevirtClient(ctrl)
+		clientset.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		clientset.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		strategy = &v1.NodePoolRolloutStrategy{NodePoolLabel: poolLabel}
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Namespace: Namespace},
+			Spec: v1.KubeVirtSpec{
+				RolloutStrategy: &v1.KubeVirtRolloutStrategy{VirtHandler: strategy},
+			},
+			Status: v1.KubeVirtStatus{
+				TargetKubeVirtVersion:  "custom.version",
+				TargetKubeVirtRegistry: "custom.registry",
+				TargetDeploymentID:     "custom.id",
+			},
+		}
+
+		daemonSet = components.NewHandlerDaemonSet(&util.KubeVirtDeploymentConfig{
+			Registry:        Registry,
+			KubeVirtVersion: Version,
+			Namespace:       Namespace,
+		}, "", "", "")
+		daemonSet.UID = "random-id"
+		daemonSet.Generation = 1
+		SetGeneration(&kv.Status.Generations, daemonSet)
+
+		imageTag, imageRegistry, id := getTargetVersionRegistryID(kv)
+		injectOperatorMetadata(kv, &daemonSet.ObjectMeta, imageTag, imageRegistry, id, true)
+		injectOperatorMetadata(kv, &daemonSet.Spec.Template.ObjectMeta, imageTag, imageRegistry, id, false)
+		placement.InjectPlacementMetadata(kv.Spec.Workloads, &daemonSet.Spec.Template.Spec, placement.AnyNode)
+		insertTLS(daemonSet)
+		unattachCertificateSecret(&daemonSet.Spec.Template.Spec, components.VirtHandlerCertSecretName)
+
+		// the cached DaemonSet was already patched to the target version
+		cachedDs = daemonSet.DeepCopy()
+		cachedDs.Generation = 2
+		cachedDs.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
+		_, err := k8sClient.AppsV1().DaemonSets(Namespace).Create(context.Background(), cachedDs, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		pods = nil
+		mockDSCache = &MockStore{get: cachedDs}
+		podCache := &cache.FakeCustomStore{
+			ListFunc: func() []interface{} {
+				return pods
+			},
+		}
+
+		expectations = &util.Expectations{}
+		expectations.DaemonSet = controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("DaemonSet"))
+		queue = testutils.NewMockWorkQueue[string](workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()))
+		recorder = record.NewFakeRecorder(100)
+		reconciler = &Reconciler{
+			kv:           kv,
+			kvKey:        "kubevirt",
+			clientset:    clientset,
+			expectations: expectations,
+			stores: util.Stores{
+				DaemonSetCache:         mockDSCache,
+				InfrastructurePodCache: podCache,
+			},
+			recorder: recorder,
+		}
+
+		addNode("node01", "a")
+		addNode("node02", "a")
+		addNode("node03", "b")
+		addNode("node04", "")
+	})
+
+	AfterEach(func() {
+		queue.ShutDown()
+	})
+
+	It("should switch the DaemonSet to the OnDelete update strategy when the update starts", func() {
+		cachedDs.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType}
+		addPods(newPod("node01", false, time.Hour))
+
+		done, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeFalse())
+
+		patchedDs, err := k8sClient.AppsV1().DaemonSets(Namespace).Get(context.Background(), cachedDs.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patchedDs.Spec.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType))
+		Expect(deletedPods()).To(BeEmpty())
+	})
+
+	It("should update the first node pool first", func() {
+		addPods(
+			newPod("node04", false, time.Hour),
+			newPod("node03", false, time.Hour),
+			newPod("node02", false, time.Hour),
+			newPod("node01", false, time.Hour),
+		)
+
+		done, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeFalse())
+		Expect(deletedPods()).To(ConsistOf("virt-handler-node01"))
+	})
+
+	It("should update up to maxUnavailable nodes of a pool at once", func() {
+		strategy.MaxUnavailable = pointer.P(int32(3))
+		addPods(
+			newPod("node01", false, time.Hour),
+			newPod("node02", false, time.Hour),
+			newPod("node03", false, time.Hour),
+		)
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deletedPods()).To(ConsistOf("virt-handler-node01", "virt-handler-node02"))
+	})
+
+	It("should not update more nodes while maxUnavailable pods are not ready", func() {
+		notReady := newPod("node01", true, 0)
+		notReady.Status.ContainerStatuses[0].Ready = false
+		addPods(notReady, newPod("node02", false, time.Hour))
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deletedPods()).To(BeEmpty())
+	})
+
+	It("should wait for the missing pods to be recreated", func() {
+		addPods(newPod("node02", false, time.Hour))
+		cachedDs.Status.DesiredNumberScheduled = 2
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deletedPods()).To(BeEmpty())
+	})
+
+	It("should wait for the updated pool to soak before the next pool is updated", func() {
+		strategy.PoolSoakSeconds = pointer.P(int32(60))
+		addPods(
+			newPod("node01", true, time.Hour),
+			newPod("node02", true, 10*time.Second),
+			newPod("node03", false, time.Hour),
+		)
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deletedPods()).To(BeEmpty())
+		Expect(queue.GetAddAfterEnqueueCount()).To(Equal(1))
+	})
+
+	It("should update the next pool once the updated pool soaked", func() {
+		addPods(
+			newPod("node01", true, time.Hour),
+			newPod("node02", true, time.Hour),
+			newPod("node03", false, time.Hour),
+			newPod("node04", false, time.Hour),
+		)
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deletedPods()).To(ConsistOf("virt-handler-node03"))
+	})
+
+	It("should update the nodes without the pool label last", func() {
+		addPods(
+			newPod("node01", true, time.Hour),
+			newPod("node02", true, time.Hour),
+			newPod("node03", true, time.Hour),
+			newPod("node04", false, time.Hour),
+		)
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deletedPods()).To(ConsistOf("virt-handler-node04"))
+	})
+
+	DescribeTable("should pause the rollout when the updated pods exceed the error budget", func(errorBudget *int32, expectPause bool) {
+		strategy.ErrorBudget = errorBudget
+		failed := newPod("node01", true, 0)
+		failed.Status.ContainerStatuses[0].Ready = false
+		failed.Status.ContainerStatuses[0].RestartCount = 3
+		addPods(failed, newPod("node02", false, time.Hour), newPod("node03", false, time.Hour))
+		strategy.MaxUnavailable = pointer.P(int32(2))
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		if expectPause {
+			Expect(deletedPods()).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring(rolloutPausedReason)))
+		} else {
+			Expect(deletedPods()).To(ConsistOf("virt-handler-node02"))
+		}
+	},
+		Entry("with the default error budget", nil, true),
+		Entry("with an error budget of one failed pod", pointer.P(int32(1)), false),
+	)
+
+	It("should not update any node when the rollout is paused", func() {
+		strategy.Paused = true
+		addPods(newPod("node01", false, time.Hour))
+
+		_, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deletedPods()).To(BeEmpty())
+	})
+
+	It("should complete the rollout once all pods are updated and ready", func() {
+		addPods(
+			newPod("node01", true, time.Hour),
+			newPod("node02", true, time.Hour),
+		)
+
+		done, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeTrue())
+		Expect(GetExpectedGeneration(cachedDs, kv.Status.Generations)).To(Equal(cachedDs.Generation))
+
+		done, err = reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeTrue())
+	})
+
+	It("should restore the rolling update when the staged rollout is removed", func() {
+		kv.Spec.RolloutStrategy = nil
+		addPods(newPod("node01", false, time.Hour))
+
+		done, err := reconciler.syncDaemonSet(queue, daemonSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeFalse())
+
+		patchedDs, err := k8sClient.AppsV1().DaemonSets(Namespace).Get(context.Background(), cachedDs.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patchedDs.Spec.UpdateStrategy.Type).To(Equal(appsv1.RollingUpdateDaemonSetStrategyType))
+		Expect(patchedDs.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable.IntValue()).To(Equal(1))
+		Expect(deletedPods()).To(BeEmpty())
+	})
+})
@@ -1,10 +1,12 @@
 package apply
 
 import (
+	"k8s.io/client-go/util/workqueue"
+
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
-func (r *Reconciler) updateKubeVirtSystem(controllerDeploymentsRolledOver bool) (bool, error) {
+func (r *Reconciler) updateKubeVirtSystem(queue workqueue.TypedRateLimitingInterface[string], controllerDeploymentsRolledOver bool) (bool, error) {
 	// UPDATE PATH IS
 	// 1. daemonsets - ensures all compute nodes are updated to handle new features
 	// 2. wait for daemonsets to roll over
@@ -14,7 +16,7 @@ func (r *Reconciler) updateKubeVirtSystem(controllerDeploymentsRolledOver bool)
 
 	// create/update Daemonsets
 	for _, daemonSet := range r.targetStrategy.DaemonSets() {
-		finished, err := r.syncDaemonSet(daemonSet)
+		finished, err := r.syncDaemonSet(queue, daemonSet)
 		if !finished || err != nil {
 			return false, err
 		}
@@ -7,6 +7,9 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 )
 
+// PoolMetricsAPIServiceName is the name of the APIService of the external metrics API
+const PoolMetricsAPIServiceName = "v1beta1.external.metrics.k8s.io"
+
 func NewVirtAPIAPIServices(installNamespace string) []*apiregv1.APIService {
 	apiservices := []*apiregv1.APIService{}
 
@@ -42,3 +45,34 @@ func NewVirtAPIAPIServices(installNamespace string) []*apiregv1.APIService {
 	}
 	return apiservices
 }
+
+// NewPoolMetricsAPIService registers virt-api as the external metrics adapter, which serves the guest metrics
+// of the VirtualMachinePools. Only one external metrics adapter can be registered in a cluster.
+func NewPoolMetricsAPIService(installNamespace string) *apiregv1.APIService {
+	return &apiregv1.APIService{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apiregistration.k8s.io/v1",
+			Kind:       "APIService",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: PoolMetricsAPIServiceName,
+			Labels: map[string]string{
+				v1.AppLabel:       "virt-api-aggregator",
+				v1.ManagedByLabel: v1.ManagedByLabelOperatorValue,
+			},
+			Annotations: map[string]string{
+				certificatesSecretAnnotationKey: VirtApiCertSecretName,
+			},
+		},
+		Spec: apiregv1.APIServiceSpec{
+			Service: &apiregv1.ServiceReference{
+				Namespace: installNamespace,
+				Name:      VirtApiServiceName,
+			},
+			Group:                "external.metrics.k8s.io",
+			Version:              "v1beta1",
+			GroupPriorityMinimum: 100,
+			VersionPriority:      100,
+		},
+	}
+}
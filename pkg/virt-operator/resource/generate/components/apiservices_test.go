@@ -16,4 +16,13 @@ var _ = Describe("APIServices", func() {
 		Expect(services).To(HaveLen(len(v1.SubresourceGroupVersions)))
 		Expect(services[0].Spec.Service.Namespace).To(Equal("mynamespace"))
 	})
+
+	It("should register virt-api as the external metrics adapter", func() {
+		service := NewPoolMetricsAPIService("mynamespace")
+		Expect(service.Name).To(Equal("v1beta1.external.metrics.k8s.io"))
+		Expect(service.Spec.Group).To(Equal("external.metrics.k8s.io"))
+		Expect(service.Spec.Version).To(Equal("v1beta1"))
+		Expect(service.Spec.Service.Namespace).To(Equal("mynamespace"))
+		Expect(service.Spec.Service.Name).To(Equal(VirtApiServiceName))
+	})
 })
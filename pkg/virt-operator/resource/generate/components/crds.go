@@ -23,7 +23,10 @@ import (
 	"strings"
 
 	"kubevirt.io/api/clone"
+	"kubevirt.io/api/defaults"
+	"kubevirt.io/api/maintenance"
 	"kubevirt.io/api/plugin"
+	"kubevirt.io/api/quota"
 
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
@@ -73,6 +76,9 @@ var (
 	VIRTUALMACHINEBACKUP             = "virtualmachinebackups." + backupv1alpha1.SchemeGroupVersion.Group
 	VIRTUALMACHINEBACKUPTRACKER      = "virtualmachinebackuptrackers." + backupv1alpha1.SchemeGroupVersion.Group
 	PLUGIN                           = "plugins." + plugin.GroupName
+	VIRTUALMACHINEDEFAULTS           = "virtualmachinedefaults." + defaults.GroupName
+	VIRTUALMACHINEQUOTA              = "virtualmachinequotas." + quota.GroupName
+	NODEMAINTENANCE                  = "nodemaintenances." + maintenance.GroupName
 )
 
 func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields ...interface{}) error {
@@ -1010,3 +1016,104 @@ func NewPluginCrd() (*extv1.CustomResourceDefinition, error) {
 	}
 	return crd, nil
 }
+
+func NewVirtualMachineDefaultsCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEDEFAULTS
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: defaults.GroupName,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    defaults.LatestVersion,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   defaults.ResourceVirtualMachineDefaultsPlural,
+			Singular: defaults.ResourceVirtualMachineDefaultsSingular,
+			Kind:     defaults.Kind,
+			ListKind: defaults.ListKind,
+		},
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineQuotaCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEQUOTA
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: quota.GroupName,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    quota.LatestVersion,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   quota.ResourceVirtualMachineQuotaPlural,
+			Singular: quota.ResourceVirtualMachineQuotaSingular,
+			Kind:     quota.Kind,
+			ListKind: quota.ListKind,
+		},
+	}
+	err := addFieldsToAllVersions(crd, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewNodeMaintenanceCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = NODEMAINTENANCE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: maintenance.GroupName,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    maintenance.LatestVersion,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   maintenance.ResourceNodeMaintenancePlural,
+			Singular: maintenance.ResourceNodeMaintenanceSingular,
+			Kind:     maintenance.Kind,
+			ListKind: maintenance.ListKind,
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		{Name: "Node", Type: "string", JSONPath: ".spec.nodeName"},
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "Progress", Description: "Percentage of the drained VirtualMachineInstances", Type: "integer", JSONPath: ".status.drainProgress"},
+	}, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
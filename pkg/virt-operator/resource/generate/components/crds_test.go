@@ -53,6 +53,9 @@ var _ = Describe("CRDs", func() {
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
 		Entry("for VirtualMachineBackup", NewVirtualMachineBackupCrd),
 		Entry("for VirtualMachineBackupTracker", NewVirtualMachineBackupTrackerCrd),
+		Entry("for VirtualMachineDefaults", NewVirtualMachineDefaultsCrd),
+		Entry("for VirtualMachineQuota", NewVirtualMachineQuotaCrd),
+		Entry("for NodeMaintenance", NewNodeMaintenanceCrd),
 	)
 
 	It("DataVolumeTemplates should have nullable a XPreserveUnknownFields on metadata", func() {
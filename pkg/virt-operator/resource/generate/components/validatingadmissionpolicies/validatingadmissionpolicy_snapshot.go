@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package validatingadmissionpolicies
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	vmSnapshotValidationPolicyName        = "kubevirt-vmsnapshot-validation-policy"
+	vmSnapshotValidationPolicyBindingName = "kubevirt-vmsnapshot-validation-binding"
+	vmSnapshotValidationAppLabelValue     = "kubevirt-vmsnapshot-validation"
+
+	vmRestoreValidationPolicyName        = "kubevirt-vmrestore-validation-policy"
+	vmRestoreValidationPolicyBindingName = "kubevirt-vmrestore-validation-binding"
+	vmRestoreValidationAppLabelValue     = "kubevirt-vmrestore-validation"
+
+	VMReferenceErrMissingAPIGroup = "missing apiGroup"
+	VMReferenceErrInvalidAPIGroup = "invalid apiGroup"
+	VMReferenceErrInvalidKind     = "invalid kind"
+	SnapshotErrImmutableSpec      = "spec is immutable after creation"
+
+	VMRestoreErrMissingSnapshotName = "missing virtualMachineSnapshotName"
+	VMRestoreErrMissingTargetName   = "missing target name"
+)
+
+// NewVMSnapshotValidatingAdmissionPolicy validates the structural rules of VirtualMachineSnapshots,
+// which don't need the state of the cluster, without a round trip to virt-api.
+func NewVMSnapshotValidatingAdmissionPolicy() *admissionregistrationv1.ValidatingAdmissionPolicy {
+	return &admissionregistrationv1.ValidatingAdmissionPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ValidatingAdmissionPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vmSnapshotValidationPolicyName,
+		},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+			FailurePolicy:    pointer.P(admissionregistrationv1.Fail),
+			MatchConstraints: snapshotMatchResources("virtualmachinesnapshots"),
+			Variables: []admissionregistrationv1.Variable{
+				{
+					Name:       "isCreate",
+					Expression: `request.operation == 'CREATE'`,
+				},
+			},
+			Validations: append(
+				vmReferenceValidations("object.spec.source"),
+				immutableSpecValidation(),
+			),
+		},
+	}
+}
+
+// NewVMRestoreValidatingAdmissionPolicy validates the structural rules of VirtualMachineRestores,
+// which don't need the state of the cluster, without a round trip to virt-api.
+func NewVMRestoreValidatingAdmissionPolicy() *admissionregistrationv1.ValidatingAdmissionPolicy {
+	return &admissionregistrationv1.ValidatingAdmissionPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ValidatingAdmissionPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vmRestoreValidationPolicyName,
+		},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+			FailurePolicy:    pointer.P(admissionregistrationv1.Fail),
+			MatchConstraints: snapshotMatchResources("virtualmachinerestores"),
+			Variables: []admissionregistrationv1.Variable{
+				{
+					Name:       "isCreate",
+					Expression: `request.operation == 'CREATE'`,
+				},
+			},
+			Validations: append(
+				vmReferenceValidations("object.spec.target"),
+				admissionregistrationv1.Validation{
+					Expression: `!variables.isCreate || size(object.spec.virtualMachineSnapshotName) > 0`,
+					Message:    VMRestoreErrMissingSnapshotName,
+				},
+				admissionregistrationv1.Validation{
+					Expression: `!variables.isCreate || size(object.spec.target.name) > 0`,
+					Message:    VMRestoreErrMissingTargetName,
+				},
+				immutableSpecValidation(),
+			),
+		},
+	}
+}
+
+func NewVMSnapshotValidatingAdmissionPolicyBinding() *admissionregistrationv1.ValidatingAdmissionPolicyBinding {
+	return newSnapshotValidatingAdmissionPolicyBinding(vmSnapshotValidationPolicyBindingName, vmSnapshotValidationPolicyName,
+		vmSnapshotValidationAppLabelValue, "virtualmachinesnapshots")
+}
+
+func NewVMRestoreValidatingAdmissionPolicyBinding() *admissionregistrationv1.ValidatingAdmissionPolicyBinding {
+	return newSnapshotValidatingAdmissionPolicyBinding(vmRestoreValidationPolicyBindingName, vmRestoreValidationPolicyName,
+		vmRestoreValidationAppLabelValue, "virtualmachinerestores")
+}
+
+func newSnapshotValidatingAdmissionPolicyBinding(name, policyName, appLabelValue, resource string) *admissionregistrationv1.ValidatingAdmissionPolicyBinding {
+	return &admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ValidatingAdmissionPolicyBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				v1.AppLabel:       appLabelValue,
+				v1.ManagedByLabel: v1.ManagedByLabelOperatorValue,
+			},
+		},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName: policyName,
+			ValidationActions: []admissionregistrationv1.ValidationAction{
+				admissionregistrationv1.Deny,
+			},
+			MatchResources: snapshotMatchResources(resource),
+		},
+	}
+}
+
+func snapshotMatchResources(resource string) *admissionregistrationv1.MatchResources {
+	return &admissionregistrationv1.MatchResources{
+		ResourceRules: []admissionregistrationv1.NamedRuleWithOperations{
+			{
+				RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{snapshotv1.SchemeGroupVersion.Group},
+						APIVersions: []string{"*"},
+						Resources:   []string{resource},
+					},
+				},
+			},
+		},
+	}
+}
+
+// vmReferenceValidations ensures on creation that the typed local object reference at path points to a VirtualMachine.
+func vmReferenceValidations(path string) []admissionregistrationv1.Validation {
+	return []admissionregistrationv1.Validation{
+		{
+			Expression: `!variables.isCreate || has(` + path + `.apiGroup)`,
+			Message:    VMReferenceErrMissingAPIGroup,
+		},
+		{
+			Expression: `!variables.isCreate || !has(` + path + `.apiGroup) || ` + path + `.apiGroup == 'kubevirt.io'`,
+			Message:    VMReferenceErrInvalidAPIGroup,
+		},
+		{
+			Expression: `!variables.isCreate || !has(` + path + `.apiGroup) || ` + path + `.apiGroup != 'kubevirt.io' || ` + path + `.kind == 'VirtualMachine'`,
+			Message:    VMReferenceErrInvalidKind,
+		},
+	}
+}
+
+func immutableSpecValidation() admissionregistrationv1.Validation {
+	return admissionregistrationv1.Validation{
+		Expression: `request.operation != 'UPDATE' || object.spec == oldObject.spec`,
+		Message:    SnapshotErrImmutableSpec,
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package validatingadmissionpolicies_test
+
+import (
+	"encoding/json"
+
+	"github.com/google/cel-go/cel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	vap "kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components/validatingadmissionpolicies"
+)
+
+// evaluateRequestPolicy evaluates a policy for an admission request and returns the messages of the failed validations.
+func evaluateRequestPolicy(policy *admissionregistrationv1.ValidatingAdmissionPolicy, operation admissionregistrationv1.OperationType, object, oldObject interface{}) []string {
+	env, err := cel.NewEnv(
+		cel.Variable("request", cel.DynType),
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("variables", cel.DynType),
+	)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	activation := map[string]interface{}{
+		"request":   map[string]interface{}{"operation": string(operation)},
+		"object":    toUnstructured(object),
+		"oldObject": toUnstructured(oldObject),
+	}
+	eval := func(expression string) interface{} {
+		ast, issues := env.Compile(expression)
+		ExpectWithOffset(2, issues.Err()).ToNot(HaveOccurred())
+		prg, err := env.Program(ast)
+		ExpectWithOffset(2, err).ToNot(HaveOccurred())
+		out, _, err := prg.Eval(activation)
+		ExpectWithOffset(2, err).ToNot(HaveOccurred())
+		return out.Value()
+	}
+
+	vars := map[string]interface{}{}
+	activation["variables"] = vars
+	for _, v := range policy.Spec.Variables {
+		vars[v.Name] = eval(v.Expression)
+	}
+
+	var msgs []string
+	for _, v := range policy.Spec.Validations {
+		if !eval(v.Expression).(bool) {
+			msgs = append(msgs, v.Message)
+		}
+	}
+	return msgs
+}
+
+func toUnstructured(obj interface{}) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+	data, err := json.Marshal(obj)
+	ExpectWithOffset(2, err).ToNot(HaveOccurred())
+	var result map[string]interface{}
+	ExpectWithOffset(2, json.Unmarshal(data, &result)).To(Succeed())
+	dropNulls(result)
+	return result
+}
+
+// dropNulls removes the null fields, like the apiserver does for the non-nullable fields of CRDs.
+func dropNulls(obj map[string]interface{}) {
+	for key, value := range obj {
+		switch v := value.(type) {
+		case nil:
+			delete(obj, key)
+		case map[string]interface{}:
+			dropNulls(v)
+		}
+	}
+}
+
+var _ = Describe("Snapshot ValidatingAdmissionPolicies", func() {
+	newVMReference := func(apiGroup *string, kind string) corev1.TypedLocalObjectReference {
+		return corev1.TypedLocalObjectReference{APIGroup: apiGroup, Kind: kind, Name: "vm"}
+	}
+
+	Context("VirtualMachineSnapshot", func() {
+		newSnapshot := func(source corev1.TypedLocalObjectReference) *snapshotv1.VirtualMachineSnapshot {
+			return &snapshotv1.VirtualMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default"},
+				Spec:       snapshotv1.VirtualMachineSnapshotSpec{Source: source},
+			}
+		}
+
+		DescribeTable("on creation", func(source corev1.TypedLocalObjectReference, expectedMsgs []string) {
+			msgs := evaluateRequestPolicy(vap.NewVMSnapshotValidatingAdmissionPolicy(), admissionregistrationv1.Create, newSnapshot(source), nil)
+			Expect(msgs).To(Equal(expectedMsgs))
+		},
+			Entry("should accept a VirtualMachine source", newVMReference(pointer.P("kubevirt.io"), "VirtualMachine"), nil),
+			Entry("should reject a missing apiGroup", newVMReference(nil, "VirtualMachine"), []string{vap.VMReferenceErrMissingAPIGroup}),
+			Entry("should reject an invalid apiGroup", newVMReference(pointer.P("foo.bar"), "VirtualMachine"), []string{vap.VMReferenceErrInvalidAPIGroup}),
+			Entry("should reject an invalid kind", newVMReference(pointer.P("kubevirt.io"), "VirtualMachineInstance"), []string{vap.VMReferenceErrInvalidKind}),
+		)
+
+		It("should reject spec updates", func() {
+			oldSnapshot := newSnapshot(newVMReference(pointer.P("kubevirt.io"), "VirtualMachine"))
+			snapshot := oldSnapshot.DeepCopy()
+			snapshot.Spec.Source.Name = "other-vm"
+
+			msgs := evaluateRequestPolicy(vap.NewVMSnapshotValidatingAdmissionPolicy(), admissionregistrationv1.Update, snapshot, oldSnapshot)
+			Expect(msgs).To(ConsistOf(vap.SnapshotErrImmutableSpec))
+		})
+
+		It("should allow metadata updates, even with an invalid source", func() {
+			oldSnapshot := newSnapshot(newVMReference(nil, "VirtualMachineInstance"))
+			snapshot := oldSnapshot.DeepCopy()
+			snapshot.Labels = map[string]string{"key": "value"}
+
+			msgs := evaluateRequestPolicy(vap.NewVMSnapshotValidatingAdmissionPolicy(), admissionregistrationv1.Update, snapshot, oldSnapshot)
+			Expect(msgs).To(BeEmpty())
+		})
+	})
+
+	Context("VirtualMachineRestore", func() {
+		newRestore := func(target corev1.TypedLocalObjectReference, snapshotName string) *snapshotv1.VirtualMachineRestore {
+			return &snapshotv1.VirtualMachineRestore{
+				ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "default"},
+				Spec: snapshotv1.VirtualMachineRestoreSpec{
+					Target:                     target,
+					VirtualMachineSnapshotName: snapshotName,
+				},
+			}
+		}
+
+		DescribeTable("on creation", func(restore *snapshotv1.VirtualMachineRestore, expectedMsgs []string) {
+			msgs := evaluateRequestPolicy(vap.NewVMRestoreValidatingAdmissionPolicy(), admissionregistrationv1.Create, restore, nil)
+			Expect(msgs).To(Equal(expectedMsgs))
+		},
+			Entry("should accept a VirtualMachine target", newRestore(newVMReference(pointer.P("kubevirt.io"), "VirtualMachine"), "snapshot"), nil),
+			Entry("should reject a missing apiGroup", newRestore(newVMReference(nil, "VirtualMachine"), "snapshot"), []string{vap.VMReferenceErrMissingAPIGroup}),
+			Entry("should reject an invalid apiGroup", newRestore(newVMReference(pointer.P("foo.bar"), "VirtualMachine"), "snapshot"), []string{vap.VMReferenceErrInvalidAPIGroup}),
+			Entry("should reject an invalid kind", newRestore(newVMReference(pointer.P("kubevirt.io"), "VirtualMachineInstance"), "snapshot"), []string{vap.VMReferenceErrInvalidKind}),
+			Entry("should reject a missing snapshot name", newRestore(newVMReference(pointer.P("kubevirt.io"), "VirtualMachine"), ""), []string{vap.VMRestoreErrMissingSnapshotName}),
+			Entry("should reject a missing target name", newRestore(corev1.TypedLocalObjectReference{APIGroup: pointer.P("kubevirt.io"), Kind: "VirtualMachine"}, "snapshot"), []string{vap.VMRestoreErrMissingTargetName}),
+		)
+
+		It("should reject spec updates", func() {
+			oldRestore := newRestore(newVMReference(pointer.P("kubevirt.io"), "VirtualMachine"), "snapshot")
+			restore := oldRestore.DeepCopy()
+			restore.Spec.VirtualMachineSnapshotName = "other-snapshot"
+
+			msgs := evaluateRequestPolicy(vap.NewVMRestoreValidatingAdmissionPolicy(), admissionregistrationv1.Update, restore, oldRestore)
+			Expect(msgs).To(ConsistOf(vap.SnapshotErrImmutableSpec))
+		})
+
+		It("should allow metadata updates", func() {
+			oldRestore := newRestore(newVMReference(pointer.P("kubevirt.io"), "VirtualMachine"), "snapshot")
+			restore := oldRestore.DeepCopy()
+			restore.Finalizers = []string{"finalizer"}
+
+			msgs := evaluateRequestPolicy(vap.NewVMRestoreValidatingAdmissionPolicy(), admissionregistrationv1.Update, restore, oldRestore)
+			Expect(msgs).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("should bind the policies to their resources", func(policy *admissionregistrationv1.ValidatingAdmissionPolicy, binding *admissionregistrationv1.ValidatingAdmissionPolicyBinding) {
+		Expect(binding.Spec.PolicyName).To(Equal(policy.Name))
+		Expect(binding.Spec.MatchResources).To(Equal(policy.Spec.MatchConstraints))
+		Expect(binding.Spec.ValidationActions).To(ConsistOf(admissionregistrationv1.Deny))
+	},
+		Entry("VirtualMachineSnapshot", vap.NewVMSnapshotValidatingAdmissionPolicy(), vap.NewVMSnapshotValidatingAdmissionPolicyBinding()),
+		Entry("VirtualMachineRestore", vap.NewVMRestoreValidatingAdmissionPolicy(), vap.NewVMRestoreValidatingAdmissionPolicyBinding()),
+	)
+})
@@ -758,8 +758,115 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       type: array
                       x-kubernetes-list-type: atomic
+                    firmware:
+                      description: Firmware selects the EFI firmware images booted
+                        by the VMIs of this architecture.
+                      properties:
+                        default:
+                          description: Default is booted by VMIs without SecureBoot
+                            and confidential computing.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        image:
+                          description: |-
+                            Image is a container image holding the firmware images. It is mounted into virt-launcher instead of
+                            looking the images up in ovmfPath. Requires the ImageVolume feature gate.
+                          type: string
+                        secureBoot:
+                          description: SecureBoot is booted by VMIs with SecureBoot
+                            enabled.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        sev:
+                          description: SEV is booted by SEV and SEV-ES VMIs.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        snp:
+                          description: SNP is booted by SEV-SNP VMIs. The firmware
+                            is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdx:
+                          description: TDX is booted by TDX VMIs. The firmware is
+                            stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdxSecureBoot:
+                          description: TDXSecureBoot is booted by TDX VMIs with SecureBoot
+                            enabled. The firmware is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                      type: object
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps logical machine type names, e.g. q35-stable, to the machine types they
+                        stand for on this architecture, e.g. pc-q35-rhel9.6.0. Aliases used as machine type of a VM or
+                        VMI, or as machineType, are replaced with the machine type they stand for on admission.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -770,8 +877,115 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       type: array
                       x-kubernetes-list-type: atomic
+                    firmware:
+                      description: Firmware selects the EFI firmware images booted
+                        by the VMIs of this architecture.
+                      properties:
+                        default:
+                          description: Default is booted by VMIs without SecureBoot
+                            and confidential computing.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        image:
+                          description: |-
+                            Image is a container image holding the firmware images. It is mounted into virt-launcher instead of
+                            looking the images up in ovmfPath. Requires the ImageVolume feature gate.
+                          type: string
+                        secureBoot:
+                          description: SecureBoot is booted by VMIs with SecureBoot
+                            enabled.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        sev:
+                          description: SEV is booted by SEV and SEV-ES VMIs.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        snp:
+                          description: SNP is booted by SEV-SNP VMIs. The firmware
+                            is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdx:
+                          description: TDX is booted by TDX VMIs. The firmware is
+                            stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdxSecureBoot:
+                          description: TDXSecureBoot is booted by TDX VMIs with SecureBoot
+                            enabled. The firmware is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                      type: object
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps logical machine type names, e.g. q35-stable, to the machine types they
+                        stand for on this architecture, e.g. pc-q35-rhel9.6.0. Aliases used as machine type of a VM or
+                        VMI, or as machineType, are replaced with the machine type they stand for on admission.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -785,8 +999,115 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       type: array
                       x-kubernetes-list-type: atomic
+                    firmware:
+                      description: Firmware selects the EFI firmware images booted
+                        by the VMIs of this architecture.
+                      properties:
+                        default:
+                          description: Default is booted by VMIs without SecureBoot
+                            and confidential computing.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        image:
+                          description: |-
+                            Image is a container image holding the firmware images. It is mounted into virt-launcher instead of
+                            looking the images up in ovmfPath. Requires the ImageVolume feature gate.
+                          type: string
+                        secureBoot:
+                          description: SecureBoot is booted by VMIs with SecureBoot
+                            enabled.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        sev:
+                          description: SEV is booted by SEV and SEV-ES VMIs.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        snp:
+                          description: SNP is booted by SEV-SNP VMIs. The firmware
+                            is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdx:
+                          description: TDX is booted by TDX VMIs. The firmware is
+                            stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdxSecureBoot:
+                          description: TDXSecureBoot is booted by TDX VMIs with SecureBoot
+                            enabled. The firmware is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                      type: object
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps logical machine type names, e.g. q35-stable, to the machine types they
+                        stand for on this architecture, e.g. pc-q35-rhel9.6.0. Aliases used as machine type of a VM or
+                        VMI, or as machineType, are replaced with the machine type they stand for on admission.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -797,8 +1118,115 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       type: array
                       x-kubernetes-list-type: atomic
+                    firmware:
+                      description: Firmware selects the EFI firmware images booted
+                        by the VMIs of this architecture.
+                      properties:
+                        default:
+                          description: Default is booted by VMIs without SecureBoot
+                            and confidential computing.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        image:
+                          description: |-
+                            Image is a container image holding the firmware images. It is mounted into virt-launcher instead of
+                            looking the images up in ovmfPath. Requires the ImageVolume feature gate.
+                          type: string
+                        secureBoot:
+                          description: SecureBoot is booted by VMIs with SecureBoot
+                            enabled.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        sev:
+                          description: SEV is booted by SEV and SEV-ES VMIs.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        snp:
+                          description: SNP is booted by SEV-SNP VMIs. The firmware
+                            is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdx:
+                          description: TDX is booted by TDX VMIs. The firmware is
+                            stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                        tdxSecureBoot:
+                          description: TDXSecureBoot is booted by TDX VMIs with SecureBoot
+                            enabled. The firmware is stateless, Vars is ignored.
+                          properties:
+                            code:
+                              description: Code is the file name of the firmware code,
+                                e.g. OVMF_CODE.secboot.fd.
+                              type: string
+                            vars:
+                              description: Vars is the file name of the template of
+                                the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+                              type: string
+                          required:
+                          - code
+                          type: object
+                      type: object
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps logical machine type names, e.g. q35-stable, to the machine types they
+                        stand for on this architecture, e.g. pc-q35-rhel9.6.0. Aliases used as machine type of a VM or
+                        VMI, or as machineType, are replaced with the machine type they stand for on admission.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -1024,6 +1452,49 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             cpuModel:
               type: string
+            cpuModels:
+              description: CPUModels configures the CPU models which the nodes offer
+                to the VirtualMachineInstances.
+              properties:
+                allowed:
+                  description: |-
+                    Allowed restricts the CPU models offered by the nodes to the listed ones.
+                    All the CPU models which are not obsolete are offered when empty.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                minModels:
+                  description: |-
+                    MinModels sets the minimum CPU model per CPU vendor. The host model of the nodes which
+                    can't run the minimum CPU model of their vendor is obsolete, so that these nodes don't
+                    run host-model VirtualMachineInstances.
+                  items:
+                    description: VendorCPUModel is a CPU model of a given CPU vendor.
+                    properties:
+                      model:
+                        description: Model is the name of the CPU model.
+                        type: string
+                      vendor:
+                        description: Vendor is the CPU vendor as reported by libvirt,
+                          e.g. Intel or AMD.
+                        type: string
+                    required:
+                    - model
+                    - vendor
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - vendor
+                  x-kubernetes-list-type: map
+                obsolete:
+                  description: Obsolete lists CPU models which are not offered by
+                    the nodes, in addition to obsoleteCPUModels.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             cpuRequest:
               anyOf:
               - type: integer
@@ -1140,17 +1611,155 @@ var CRDsValidation map[string]string = map[string]string{
                     in case hardware-assisted emulation is not available. Defaults to false
                   type: boolean
               type: object
+            domainStatsConfiguration:
+              description: DomainStatsConfiguration allows reducing the libvirt load
+                caused by the domain statistics collection on dense nodes.
+              nullable: true
+              properties:
+                collectionInterval:
+                  description: |-
+                    CollectionInterval is how often virt-launcher refreshes the domain statistics from libvirt.
+                    Defaults to 3.25s. Changes only apply to VMIs started afterwards.
+                  type: string
+                disabledCollectors:
+                  description: |-
+                    DisabledCollectors lists the domain statistics which are neither collected from libvirt nor exposed as metrics.
+                    Supported values are block (per-disk), vcpu (per-vCPU) and network (per-interface).
+                    Disabling vcpu also removes the vCPU statistics from the downward metrics.
+                    The metrics are removed immediately, the collection from libvirt only stops for VMIs started afterwards.
+                  items:
+                    enum:
+                    - block
+                    - vcpu
+                    - network
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             emulatedMachines:
               description: Deprecated. Use architectureConfiguration instead.
               items:
                 type: string
               type: array
+            eventConfiguration:
+              description: EventConfiguration allows reducing the number of events
+                emitted by virt-controller and virt-handler.
+              nullable: true
+              properties:
+                deduplicationWindow:
+                  description: |-
+                    DeduplicationWindow drops an event if an event with the same type, reason and message
+                    was already emitted for the same object within the window.
+                  type: string
+                rateLimiter:
+                  description: |-
+                    RateLimiter limits the rate at which each virt-controller and virt-handler instance emits events.
+                    Events beyond the limit are dropped.
+                  properties:
+                    burst:
+                      description: |-
+                        Maximum burst for throttle.
+                        If it's zero, the component default will be used
+                      type: integer
+                    qps:
+                      description: |-
+                        QPS indicates the maximum QPS to the apiserver from this client.
+                        If it's zero, the component default will be used
+                      type: number
+                  required:
+                  - burst
+                  - qps
+                  type: object
+                suppressedReasons:
+                  description: SuppressedReasons lists event reasons, e.g. FailedScheduling,
+                    which are never emitted.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             evictionStrategy:
               description: |-
                 EvictionStrategy defines at the cluster level if the VirtualMachineInstance should be
                 migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
                 field is set it overrides the cluster level one.
               type: string
+            guestAgentCommands:
+              description: |-
+                GuestAgentCommands restricts the qemu-guest-agent commands KubeVirt may invoke in the guests
+                of all VirtualMachineInstances. All commands are allowed if not set.
+              nullable: true
+              properties:
+                allowedCommands:
+                  description: AllowedCommands are the only commands which may be
+                    invoked. All commands are allowed if empty.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                deniedCommands:
+                  description: DeniedCommands may never be invoked, even if they are
+                    allowed.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
+            guestExec:
+              description: |-
+                GuestExec restricts the namespaces in which commands may be run in the guests with the guestexec
+                subresource. Requires the GuestExec feature gate.
+              nullable: true
+              properties:
+                namespaceSelector:
+                  description: |-
+                    NamespaceSelector allows running commands in the guests of the VirtualMachineInstances in the namespaces
+                    matching the selector. Commands can't be run in any namespace if not set.
+                  properties:
+                    matchExpressions:
+                      description: matchExpressions is a list of label selector requirements.
+                        The requirements are ANDed.
+                      items:
+                        description: |-
+                          A label selector requirement is a selector that contains values, a key, and an operator that
+                          relates the key and values.
+                        properties:
+                          key:
+                            description: key is the label key that the selector applies
+                              to.
+                            type: string
+                          operator:
+                            description: |-
+                              operator represents a key's relationship to a set of values.
+                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                            type: string
+                          values:
+                            description: |-
+                              values is an array of string values. If the operator is In or NotIn,
+                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                              the values array must be empty. This array is replaced during a strategic
+                              merge patch.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - key
+                        - operator
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    matchLabels:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                      type: object
+                  type: object
+                  x-kubernetes-map-type: atomic
+              type: object
             handlerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1222,6 +1831,22 @@ var CRDsValidation map[string]string = map[string]string{
                   - expandAll
                   nullable: true
                   type: string
+                virtioDrivers:
+                  description: |-
+                    VirtioDrivers defines the source of the virtio-win drivers disk attached to Windows guests
+                    requesting it through autoattachVirtioDriversDisk.
+                  nullable: true
+                  properties:
+                    image:
+                      description: Image is the containerDisk image providing the
+                        virtio-win drivers ISO.
+                      type: string
+                    persistentVolumeClaimName:
+                      description: |-
+                        PersistentVolumeClaimName is the name of a PersistentVolumeClaim providing the virtio-win drivers ISO,
+                        looked up in the namespace of the VirtualMachine. Takes precedence over Image.
+                      type: string
+                  type: object
               type: object
             ksmConfiguration:
               description: KSMConfiguration holds the information regarding the enabling
@@ -1276,6 +1901,84 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                   x-kubernetes-map-type: atomic
               type: object
+            launcherReservations:
+              description: |-
+                LauncherReservations keep placeholder pods on the nodes, which hold capacity and pull the
+                virt-launcher image. A starting VirtualMachineInstance claims one of them, the placeholder pod is
+                deleted and the virt-launcher pod prefers its node. The virt-launcher pod is still created and
+                started for the VirtualMachineInstance, the placeholder pods never run it.
+                No placeholder pods are kept if not set.
+              items:
+                description: LauncherReservation keeps placeholder pods on the nodes
+                  matching its node selector.
+                properties:
+                  instancetypes:
+                    description: |-
+                      Instancetypes restricts the reservation to the VirtualMachineInstances created from one of these
+                      VirtualMachineClusterInstancetypes. Any VirtualMachineInstance may claim a pod if empty.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: set
+                  name:
+                    description: Name identifies the reservation, its pods are labeled
+                      with it.
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: NodeSelector selects the nodes of the reservation.
+                      All schedulable nodes are selected if empty.
+                    type: object
+                  podsPerNode:
+                    description: PodsPerNode is the number of placeholder pods kept
+                      on each node of the reservation.
+                    format: int64
+                    maximum: 4294967295
+                    minimum: 0
+                    type: integer
+                  resources:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: |-
+                      Resources are requested by each placeholder pod, to hold the capacity of the VirtualMachineInstance
+                      which claims it. The claimed pod is deleted to release them.
+                    type: object
+                required:
+                - name
+                - podsPerNode
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            launcherWatchdog:
+              description: |-
+                LauncherWatchdog enables the detection of hung virt-launchers by virt-handler.
+                virt-launchers are not watched if not set.
+              nullable: true
+              properties:
+                action:
+                  description: |-
+                    Action is taken once a virt-launcher is considered hung. Alert only emits an event and
+                    increments the kubevirt_vmi_launcher_unresponsive_total metric, Fail additionally marks the
+                    VirtualMachineInstance as failed and Restart additionally kills the virt-launcher.
+                    Defaults to Alert.
+                  enum:
+                  - Alert
+                  - Fail
+                  - Restart
+                  type: string
+                timeout:
+                  description: |-
+                    Timeout is how long the domain of a running VirtualMachineInstance may fail to report its
+                    statistics before its virt-launcher is considered hung. Defaults to 5 minutes.
+                  type: string
+              type: object
             liveUpdateConfiguration:
               description: LiveUpdateConfiguration holds defaults for live update
                 features
@@ -1364,6 +2067,50 @@ var CRDsValidation map[string]string = map[string]string{
             memBalloonStatsPeriod:
               format: int32
               type: integer
+            memoryOvercommitConfiguration:
+              description: |-
+                MemoryOvercommitConfiguration holds the memory overcommit classes VMIs can opt into
+                and the settings for running VMIs on swap enabled nodes.
+              nullable: true
+              properties:
+                classes:
+                  description: |-
+                    Classes are named guest to requested memory ratios VMIs can select
+                    with spec.domain.memory.overcommitClass.
+                  items:
+                    description: MemoryOvercommitClass defines a named memory overcommit
+                      policy.
+                    properties:
+                      memoryOvercommit:
+                        description: |-
+                          MemoryOvercommit is the percentage of memory given to the guest compared to
+                          the amount requested by the virt-launcher pod.
+                        type: integer
+                      name:
+                        description: Name of the class, referenced by the VMIs.
+                        type: string
+                      swap:
+                        description: |-
+                          Swap allows the memory of VMIs of this class to be backed by swap.
+                          Such VMIs are only scheduled to nodes labeled with kubevirt.io/swap-enabled,
+                          and are live migrated instead of being killed when evicted, unless they
+                          define their own eviction strategy.
+                        type: boolean
+                    required:
+                    - memoryOvercommit
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+                pressureThreshold:
+                  description: |-
+                    PressureThreshold is the percentage of node memory, swap included, in use
+                    above which the node is labeled as being under memory overcommit pressure.
+                    Defaults to 90.
+                  type: integer
+              type: object
             migrations:
               description: |-
                 MigrationConfiguration holds migration options.
@@ -1410,6 +2157,21 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                evictionMigrationTimeout:
+                  description: |-
+                    EvictionMigrationTimeout is the maximum number of seconds VMIs with the LiveMigrateOrShutdown
+                    eviction strategy are tried to be migrated on eviction, before they are shutdown. Defaults to 600
+                  format: int64
+                  type: integer
+                evictionShutdownPriorityThreshold:
+                  description: |-
+                    EvictionShutdownPriorityThreshold makes VMIs with the LiveMigrateIfPossible eviction strategy
+                    and a priority lower than the threshold shut down on eviction instead of being migrated, so
+                    that the migration capacity of a drain goes to the more important VMIs first.
+                    The priority of a VMI is the one of the PriorityClass referenced by spec.priorityClassName.
+                    Unset by default, in which case all VMIs are migrated.
+                  format: int32
+                  type: integer
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -1547,6 +2309,60 @@ var CRDsValidation map[string]string = map[string]string{
                     Deprecated: Removed in v1.3.
                   type: boolean
               type: object
+            nodeOverrides:
+              description: |-
+                NodeOverrides override the settings of the nodes matching their node selector, for clusters
+                made of heterogeneous nodes. The first matching override applies to a node.
+              items:
+                description: NodeConfigurationOverride overrides the settings of the
+                  selected nodes.
+                properties:
+                  handlerResources:
+                    description: |-
+                      HandlerResources overrides the resources reserved for virt-handler on the selected nodes.
+                      virt-handler is resized in place, hence its QoS class can't change.
+                    properties:
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: NodeSelector selects the nodes the override applies
+                      to.
+                    type: object
+                  virtualMachineInstancesPerNode:
+                    description: |-
+                      VirtualMachineInstancesPerNode overrides virtualMachineInstancesPerNode, the maximum number
+                      of VirtualMachineInstances running on each of the selected nodes.
+                    type: integer
+                required:
+                - nodeSelector
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             obsoleteCPUModels:
               additionalProperties:
                 type: boolean
@@ -1575,6 +2391,34 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                pciDiscoveryRules:
+                  description: Rules exposing the host PCI devices they match, without
+                    listing each device
+                  items:
+                    description: PciDiscoveryRule exposes the host PCI devices matching
+                      its selectors for passthrough
+                    properties:
+                      driver:
+                        description: |-
+                          The name of the driver the PCI devices are bound to, e.g. vfio-pci.
+                          Matches any driver allowed for passthrough when empty.
+                        type: string
+                      pciVendorSelector:
+                        description: A glob matching the vendor_id:product_id tuple
+                          of the PCI devices, e.g. 10de:*
+                        type: string
+                      resourceName:
+                        description: |-
+                          The name of the resource that is representing the matching devices. Exposed by
+                          a device plugin and requested by VMs. Typically of the form
+                          vendor.com/product_name
+                        type: string
+                    required:
+                    - pciVendorSelector
+                    - resourceName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 pciHostDevices:
                   items:
                     description: PciHostDevice represents a host PCI device allowed
@@ -1645,6 +2489,54 @@ var CRDsValidation map[string]string = map[string]string{
                   nullable: true
                   type: boolean
               type: object
+            poolMetricsAdapter:
+              description: |-
+                PoolMetricsAdapter makes virt-api serve the guest metrics of the VirtualMachinePools, as aggregated by
+                Prometheus, through the external metrics API, so that the pools can be scaled on them by the
+                HorizontalPodAutoscaler. The metrics are not served if not set.
+              nullable: true
+              properties:
+                prometheusURL:
+                  description: |-
+                    PrometheusURL is the URL of the Prometheus server evaluating the vmpool recording rules of KubeVirt,
+                    e.g. http://prometheus-k8s.monitoring:9090.
+                  type: string
+              required:
+              - prometheusURL
+              type: object
+            qemuArgsPassthrough:
+              description: |-
+                QEMUArgsPassthrough lists the QEMU command line arguments VirtualMachineInstances may append.
+                No argument is allowed if not set. Requires the QEMUArgsPassthrough feature gate.
+              nullable: true
+              properties:
+                allowedArgs:
+                  description: AllowedArgs are the QEMU options VirtualMachineInstances
+                    may append to their QEMU command line.
+                  items:
+                    description: AllowedQEMUArg allows a QEMU option, optionally restricted
+                      to some values.
+                    properties:
+                      name:
+                        description: Name is the QEMU option, including its leading
+                          dash, e.g. "-device".
+                        type: string
+                      valuePrefixes:
+                        description: |-
+                          ValuePrefixes restricts the values of the option to the ones starting with one of the prefixes,
+                          e.g. "pvpanic," only allows pvpanic devices. Any value is allowed if empty.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+              type: object
             roleAggregationStrategy:
               description: |-
                 RoleAggregationStrategy controls whether RBAC cluster roles should be aggregated
@@ -1680,10 +2572,31 @@ var CRDsValidation map[string]string = map[string]string{
               type: string
             smbios:
               properties:
+                baseBoard:
+                  description: BaseBoard is the default baseboard information of the
+                    VMIs.
+                  properties:
+                    asset:
+                      type: string
+                    manufacturer:
+                      type: string
+                    product:
+                      type: string
+                    serial:
+                      type: string
+                    version:
+                      type: string
+                  type: object
                 family:
                   type: string
                 manufacturer:
                   type: string
+                oemStrings:
+                  description: OEMStrings are the default OEM strings of the VMIs.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 product:
                   type: string
                 sku:
@@ -1691,6 +2604,41 @@ var CRDsValidation map[string]string = map[string]string{
                 version:
                   type: string
               type: object
+            subresourceConnectionLimits:
+              description: |-
+                SubresourceConnectionLimits caps the console, VNC and port-forward connections virt-api proxies to virt-handler.
+                Connections are not limited if not set.
+              nullable: true
+              properties:
+                connectionRateLimiter:
+                  description: ConnectionRateLimiter limits the rate at which a single
+                    user can open new connections.
+                  properties:
+                    burst:
+                      description: |-
+                        Maximum burst for throttle.
+                        If it's zero, the component default will be used
+                      type: integer
+                    qps:
+                      description: |-
+                        QPS indicates the maximum QPS to the apiserver from this client.
+                        If it's zero, the component default will be used
+                      type: number
+                  required:
+                  - burst
+                  - qps
+                  type: object
+                maxConnectionsPerUser:
+                  description: MaxConnectionsPerUser is the maximum number of concurrent
+                    connections a single user can hold.
+                  format: int32
+                  type: integer
+                maxConnectionsPerVMI:
+                  description: MaxConnectionsPerVMI is the maximum number of concurrent
+                    connections to a single VirtualMachineInstance.
+                  format: int32
+                  type: integer
+              type: object
             supportContainerResources:
               description: SupportContainerResources specifies the resource requirements
                 for various types of supporting containers such as container disks/virtiofs/sidecars
@@ -1788,6 +2736,25 @@ var CRDsValidation map[string]string = map[string]string{
               description: VirtualMachineOptions holds the cluster level information
                 regarding the virtual machine.
               properties:
+                crashLoopBackoff:
+                  description: |-
+                    CrashLoopBackoff configures the delay before restarting VMs whose VMIs failed shortly after being started.
+                    The fields can be individually overridden for each VM.
+                  properties:
+                    baseDelay:
+                      description: |-
+                        BaseDelay is the delay after the first failure. The delay after n consecutive failures is the
+                        base delay multiplied by the square of n. Defaults to a thirtieth of MaxDelay, and at least 10 seconds.
+                      type: string
+                    maxDelay:
+                      description: MaxDelay caps the delay. Defaults to 5 minutes.
+                      type: string
+                    resetWindow:
+                      description: |-
+                        ResetWindow is how long a VirtualMachineInstance has to run for the consecutive failures to be
+                        forgotten. Defaults to 0, forgetting them as soon as the VirtualMachineInstance is running.
+                      type: string
+                  type: object
                 disableFreePageReporting:
                   description: |-
                     DisableFreePageReporting disable the free page reporting of
@@ -1801,6 +2768,49 @@ var CRDsValidation map[string]string = map[string]string{
                     If not set, serial console logs will be written to a file and then streamed from a container named 'guest-console-log'.
                     The value can be individually overridden for each VM, not relevant if AutoattachSerialConsole is disabled.
                   type: object
+                serialConsoleLogFormat:
+                  description: |-
+                    SerialConsoleLogFormat is the format of the serial console log streamed from the 'guest-console-log' container.
+                    With 'json', every line of the serial console is written as a JSON object carrying the VMI, like the logs of
+                    the other KubeVirt components, so that log pipelines can parse and attribute it.
+                    Defaults to 'text', which writes the lines unchanged.
+                  enum:
+                  - text
+                  - json
+                  type: string
+                serialConsoleLogRotation:
+                  description: |-
+                    SerialConsoleLogRotation limits the size of the serial console log streamed from the 'guest-console-log' container.
+                    If not set, the log is rotated at 2Mi and three rotated logs are kept.
+                  properties:
+                    maxBackups:
+                      description: MaxBackups is the number of rotated serial console
+                        logs kept in the pod.
+                      format: int32
+                      type: integer
+                    maxSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: MaxSize is the size at which the serial console
+                        log is rotated.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  type: object
+              type: object
+            vmInfoMetrics:
+              description: VMInfoMetrics controls the cardinality of the kubevirt_vm_metadata_info
+                metric.
+              nullable: true
+              properties:
+                disabledLabels:
+                  description: |-
+                    DisabledLabels lists the labels of the kubevirt_vm_metadata_info metric which are reported empty.
+                    Supported values are instance_type, preference, guest_os, run_strategy and owner.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
               type: object
             vmRolloutStrategy:
               description: |-
@@ -1847,6 +2857,18 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            workflowAlertsDeployment:
+              description: |-
+                WorkflowAlertsDeployment controls the deployment of the alerting rules covering
+                migration and storage workflow failures
+              nullable: true
+              properties:
+                enabled:
+                  description: Enabled controls the deployment of the workflow alerting
+                    rules, defaults to False.
+                  nullable: true
+                  type: boolean
+              type: object
           type: object
         customizeComponents:
           properties:
@@ -2942,6 +3964,49 @@ var CRDsValidation map[string]string = map[string]string{
             Useful if KubeVirt is included as part of a product.
             If ProductVersion is not specified, KubeVirt's version will be used.
           type: string
+        rolloutStrategy:
+          description: RolloutStrategy defines how updates of the KubeVirt components
+            are rolled out
+          properties:
+            virtHandler:
+              description: |-
+                VirtHandler rolls updates of virt-handler out node pool by node pool.
+                By default virt-handler is updated on a single canary node first and on 10% of the nodes at a time afterwards.
+              properties:
+                errorBudget:
+                  description: |-
+                    ErrorBudget is the number of updated pods which may fail before the rollout is paused.
+                    The rollout resumes once the failed pods recover.
+
+                    Defaults to 0
+                  format: int32
+                  type: integer
+                maxUnavailable:
+                  description: |-
+                    MaxUnavailable is the maximum number of nodes of a pool which are updated at once.
+
+                    Defaults to 1
+                  format: int32
+                  type: integer
+                nodePoolLabel:
+                  description: |-
+                    NodePoolLabel is the key of the node label which groups the nodes into pools.
+                    The pools are updated one after another, ordered by the label value. Nodes without the label are updated last.
+                  type: string
+                paused:
+                  description: Paused pauses the rollout
+                  type: boolean
+                poolSoakSeconds:
+                  description: |-
+                    PoolSoakSeconds is the time the updated pods have to be ready before the next pool is updated.
+
+                    Defaults to 60
+                  format: int32
+                  type: integer
+              required:
+              - nodePoolLabel
+              type: object
+          type: object
         serviceMonitorNamespace:
           description: |-
             The namespace the service monitor will be deployed
@@ -2976,6 +4041,64 @@ var CRDsValidation map[string]string = map[string]string{
 
                 Defaults to 10
               type: integer
+            maxUpdatesPerNamespace:
+              description: |-
+                MaxUpdatesPerNamespace is the maximum number of VMIs of a single namespace which are
+                migrated or evicted at the same time
+
+                Defaults to no limit
+              type: integer
+            namespaceSelector:
+              description: |-
+                NamespaceSelector limits automated workload updates to the VMIs in the namespaces
+                matching the selector. Namespaces can opt out with a label excluded by the selector.
+                The VMIs of other namespaces are only counted as outdated.
+
+                Defaults to all namespaces
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements.
+                    The requirements are ANDed.
+                  items:
+                    description: |-
+                      A label selector requirement is a selector that contains values, a key, and an operator that
+                      relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies
+                          to.
+                        type: string
+                      operator:
+                        description: |-
+                          operator represents a key's relationship to a set of values.
+                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                        type: string
+                      values:
+                        description: |-
+                          values is an array of string values. If the operator is In or NotIn,
+                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                          the values array must be empty. This array is replaced during a strategic
+                          merge patch.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                  type: object
+              type: object
+              x-kubernetes-map-type: atomic
             workloadUpdateMethods:
               description: |-
                 WorkloadUpdateMethods defines the methods that can be used to disrupt workloads
@@ -2989,6 +4112,33 @@ var CRDsValidation map[string]string = map[string]string{
                 type: string
               type: array
               x-kubernetes-list-type: atomic
+            windows:
+              description: |-
+                Windows restricts automated workload updates to recurring time windows. Migrations
+                and evictions are only started while a window is open.
+
+                An empty list allows workload updates at any time
+              items:
+                description: WorkloadUpdateWindow is a recurring time window in which
+                  automated workload updates are started
+                properties:
+                  duration:
+                    description: Duration is how long the window stays open.
+                    type: string
+                  start:
+                    description: Start is a cron expression, in the standard five
+                      fields format, at which the window opens.
+                    type: string
+                  timeZone:
+                    description: TimeZone is the IANA name of the time zone the cron
+                      expression is evaluated in. Defaults to UTC.
+                    type: string
+                required:
+                - start
+                - duration
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
           type: object
         workloads:
           description: selectors and tolerations that should apply to KubeVirt workloads
@@ -4012,6 +5162,39 @@ var CRDsValidation map[string]string = map[string]string{
           type: array
         defaultArchitecture:
           type: string
+        featureGates:
+          description: FeatureGates reports the lifecycle of the feature gates known
+            to the deployed KubeVirt version
+          items:
+            description: FeatureGateStatus reports the lifecycle of a feature gate
+            properties:
+              default:
+                description: Default tells whether the feature is enabled when the
+                  feature gate is neither enabled nor disabled explicitly
+                type: boolean
+              enabled:
+                description: Enabled tells whether the feature is enabled in the cluster
+                type: boolean
+              lockedToDefault:
+                description: LockedToDefault tells whether the feature gate can no
+                  longer be enabled or disabled, since the feature graduated or was
+                  discontinued
+                type: boolean
+              name:
+                description: Name of the feature gate
+                type: string
+              stage:
+                description: Stage of the feature gate
+                type: string
+            required:
+            - default
+            - enabled
+            - lockedToDefault
+            - name
+            - stage
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         generations:
           items:
             description: GenerationStatus keeps track of the generation for a given
@@ -4141,6 +5324,131 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"nodemaintenance": `openAPIV3Schema:
+  description: |-
+    NodeMaintenance drains the VirtualMachineInstances from a node. The node is cordoned while
+    the NodeMaintenance exists, and the VirtualMachineInstances are live migrated, shut down or
+    left running on the node according to their eviction strategy.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Spec defines the node and how its VirtualMachineInstances are drained.
+      properties:
+        nodeName:
+          description: NodeName is the name of the node to drain.
+          type: string
+        policies:
+          description: |-
+            Policies override the action taken on the VirtualMachineInstances with a given eviction strategy.
+            VirtualMachineInstances whose eviction strategy has no policy are migrated with LiveMigrate,
+            migrated if possible and shut down otherwise with LiveMigrateIfPossible, ignored with External
+            and shut down with None.
+          items:
+            properties:
+              action:
+                description: Action is taken on the selected VirtualMachineInstances.
+                type: string
+              evictionStrategy:
+                description: |-
+                  EvictionStrategy selects the VirtualMachineInstances the policy applies to, by their
+                  effective eviction strategy.
+                type: string
+            required:
+            - action
+            - evictionStrategy
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - evictionStrategy
+          x-kubernetes-list-type: map
+        reason:
+          description: Reason is a free form description of the maintenance.
+          type: string
+      required:
+      - nodeName
+      type: object
+    status:
+      description: Status reports the progress of the drain.
+      properties:
+        drainProgress:
+          description: DrainProgress is the percentage of the VirtualMachineInstances
+            drained from the node.
+          format: int32
+          type: integer
+        ignoredVMIs:
+          description: IgnoredVMIs is the number of VirtualMachineInstances left running
+            on the node.
+          format: int32
+          type: integer
+        lastError:
+          description: LastError is the last error which occurred during the maintenance.
+          type: string
+        nodeCordoned:
+          description: |-
+            NodeCordoned tells whether the node was cordoned by the maintenance,
+            in which case it is uncordoned when the maintenance is deleted.
+          type: boolean
+        pendingVMIs:
+          description: PendingVMIs lists the VirtualMachineInstances which are not
+            drained from the node yet.
+          items:
+            description: NodeMaintenancePendingVMI is a VirtualMachineInstance which
+              is not drained from the node yet.
+            properties:
+              action:
+                description: Action taken on the VirtualMachineInstance.
+                type: string
+              failedMigrations:
+                description: FailedMigrations is the number of migrations of the
+                  VirtualMachineInstance which failed during the maintenance.
+                format: int32
+                type: integer
+              message:
+                description: Message explains why the VirtualMachineInstance is not
+                  drained yet.
+                type: string
+              name:
+                description: Name of the VirtualMachineInstance.
+                type: string
+              namespace:
+                description: Namespace of the VirtualMachineInstance.
+                type: string
+            required:
+            - action
+            - name
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: Phase is the phase of the maintenance.
+          type: string
+        totalVMIs:
+          description: TotalVMIs is the number of VirtualMachineInstances to drain
+            from the node since the maintenance started.
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"plugin": `openAPIV3Schema:
   description: |-
@@ -4368,6 +5676,26 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: Spec contains the specification of VirtualMachineInstance created
       properties:
+        crashLoopBackoff:
+          description: |-
+            CrashLoopBackoff configures the delay before restarting the VirtualMachine after its
+            VirtualMachineInstance failed shortly after being started. Each field overrides the
+            cluster wide one.
+          properties:
+            baseDelay:
+              description: |-
+                BaseDelay is the delay after the first failure. The delay after n consecutive failures is the
+                base delay multiplied by the square of n. Defaults to a thirtieth of MaxDelay, and at least 10 seconds.
+              type: string
+            maxDelay:
+              description: MaxDelay caps the delay. Defaults to 5 minutes.
+              type: string
+            resetWindow:
+              description: |-
+                ResetWindow is how long a VirtualMachineInstance has to run for the consecutive failures to be
+                forgotten. Defaults to 0, forgetting them as soon as the VirtualMachineInstance is running.
+              type: string
+          type: object
         dataVolumeTemplates:
           description: |-
             dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -5017,6 +6345,23 @@ var CRDsValidation map[string]string = map[string]string{
             - spec
             type: object
           type: array
+        dependsOn:
+          description: |-
+            DependsOn lists VirtualMachines of the same namespace which must be ready before this
+            VirtualMachine is started. Requires the VirtualMachineDependencies feature gate.
+          items:
+            description: |-
+              VirtualMachineDependency references a VirtualMachine which must be ready before the
+              depending VirtualMachine is started
+            properties:
+              name:
+                description: Name is the name of the VirtualMachine
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         instancetype:
           description: InstancetypeMatcher references a instancetype that is used
             to fill fields in Template
@@ -5081,6 +6426,13 @@ var CRDsValidation map[string]string = map[string]string{
                 initially captured the first time the instancetype is applied to the VirtualMachineInstance.
               type: string
           type: object
+        revisionHistoryLimit:
+          description: |-
+            RevisionHistoryLimit is the number of old revisions of the spec to keep for rollbacks.
+            Revisions are only recorded with the VirtualMachineRevisionHistory feature gate.
+            Defaults to 10.
+          format: int32
+          type: integer
         runStrategy:
           description: |-
             Running state indicates the requested running state of the VirtualMachineInstance
@@ -5098,6 +6450,29 @@ var CRDsValidation map[string]string = map[string]string{
             Mutually exclusive with RunStrategy
             Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
           type: boolean
+        schedule:
+          description: |-
+            Schedule starts and stops the VirtualMachine at recurring times.
+            Requires the VirtualMachineSchedule feature gate.
+          properties:
+            skipStopIfInUse:
+              description: |-
+                SkipStopIfInUse skips scheduled stops while the VirtualMachine is in use, that is while users
+                are logged in the guest, or while it is migrating or being backed up. Defaults to false.
+              type: boolean
+            start:
+              description: Start is a cron expression, in the standard five fields
+                format, at which the VirtualMachine is started.
+              type: string
+            stop:
+              description: Stop is a cron expression, in the standard five fields
+                format, at which the VirtualMachine is stopped.
+              type: string
+            timeZone:
+              description: TimeZone is the IANA name of the time zone the cron expressions
+                are evaluated in. Defaults to UTC.
+              type: string
+          type: object
         template:
           description: Template is the direct specification of VirtualMachineInstance
           properties:
@@ -6215,6 +7590,28 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        guestTimeSync:
+                          description: |-
+                            GuestTimeSync controls when the guest clock is set to the host time through
+                            the guest agent, after the guest was not running for some time.
+                          properties:
+                            afterMigration:
+                              description: |-
+                                AfterMigration sets the guest clock once the vmi is migrated.
+                                Defaults to true.
+                              type: boolean
+                            afterUnpause:
+                              description: |-
+                                AfterUnpause sets the guest clock once the vmi is unpaused.
+                                Defaults to true.
+                              type: boolean
+                            timeoutSeconds:
+                              description: |-
+                                TimeoutSeconds is the time the guest agent is retried for if it is not responsive.
+                                Defaults to 60.
+                              format: int32
+                              type: integer
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -6306,6 +7703,21 @@ var CRDsValidation map[string]string = map[string]string{
                                 guest changes to the clock will be kept during reboots and not reset.
                               type: integer
                           type: object
+                        variable:
+                          description: |-
+                            Variable lets the guest change its clock freely relative to the basis.
+                            The guest changes are kept during reboots and migrations.
+                          properties:
+                            basis:
+                              description: |-
+                                Basis the offset is relative to.
+                                One of "utc", "localtime". Defaults to "utc".
+                              type: string
+                            offsetSeconds:
+                              description: OffsetSeconds specifies the initial offset
+                                in seconds, relative to the basis.
+                              type: integer
+                          type: object
                       type: object
                       x-kubernetes-preserve-unknown-fields: true
                     cpu:
@@ -6365,10 +7777,56 @@ var CRDsValidation map[string]string = map[string]string{
                             and "host-model" to get CPU closest to the node one.
                             Defaults to host-model.
                           type: string
+                        nestedVirtualization:
+                          description: |-
+                            NestedVirtualization controls whether the guest can run virtual machines itself.
+                            Require schedules the VMI onto nodes exposing VMX or SVM to their guests,
+                            Forbid hides VMX and SVM from the guest.
+                            By default the guest gets them when both the CPU model and the node provide them.
+                          type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
                           properties:
+                            cells:
+                              description: |-
+                                Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                                Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                              items:
+                                description: NUMACell declares a virtual numa node
+                                  of the guest.
+                                properties:
+                                  cpus:
+                                    description: CPUs is the list of vCPUs of the
+                                      numa node, e.g. "0-3,6".
+                                    type: string
+                                  memory:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Memory is the amount of guest memory
+                                      of the numa node.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - cpus
+                                - memory
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            evenSplit:
+                              description: |-
+                                EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                                split the vCPUs and the guest memory evenly between them.
+                              properties:
+                                nodes:
+                                  description: Nodes is the number of virtual numa
+                                    nodes of the guest.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - nodes
+                              type: object
                             guestMappingPassthrough:
                               description: |-
                                 GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -6384,6 +7842,14 @@ var CRDsValidation map[string]string = map[string]string{
                                 Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                                 Example: "0-3,^1","0,2,3","2-3"
                               type: string
+                            profile:
+                              description: |-
+                                Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                                The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                                Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                                the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                                Hugepages still need to be requested explicitly.
+                              type: string
                           type: object
                         sockets:
                           description: |-
@@ -6432,6 +7898,13 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether to attach the VSOCK CID to the VM or not.
                             VSOCK access will be available if set to true. Defaults to false.
                           type: boolean
+                        autoattachVirtioDriversDisk:
+                          description: |-
+                            Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as
+                            indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk
+                            is no longer attached on the next start once set to false.
+                            Defaults to false.
+                          type: boolean
                         blockMultiQueue:
                           description: |-
                             Whether or not to enable virtio multi-queue for block devices.
@@ -6604,6 +8077,28 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  cache:
+                                    description: |-
+                                      Cache sets the caching policy of the shared directory in the guest.
+                                      One of: auto, always, never. Defaults to auto.
+                                    type: string
+                                  daxWindowSize:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: |-
+                                      DAXWindowSize enables DAX for the filesystem and sets the size of the
+                                      shared memory window the guest maps the files into, bypassing its page cache.
+                                      It must be a multiple of 2Mi. DAX is disabled if not set.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  threadPoolSize:
+                                    description: |-
+                                      ThreadPoolSize sets the number of worker threads of the virtiofsd process
+                                      serving the filesystem. Defaults to the virtiofsd default.
+                                    format: int32
+                                    type: integer
                                 type: object
                             required:
                             - name
@@ -6668,6 +8163,25 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        guestPanicCapture:
+                          description: |-
+                            GuestPanicCapture configures what is captured when the guest kernel panics.
+                            A pvpanic device is added if no panic device is provided.
+                          properties:
+                            consoleLogLines:
+                              description: |-
+                                ConsoleLogLines is the number of trailing lines of the serial console log
+                                recorded in the GuestPanicked condition. Requires the serial console log.
+                                Defaults to 20, at most 100.
+                              format: int32
+                              type: integer
+                            memoryDumpClaimName:
+                              description: |-
+                                MemoryDumpClaimName is the name of the PVC the guest memory is dumped to
+                                when the guest kernel panics. The crashed guest is kept until the memory
+                                dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.
+                              type: string
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -6825,6 +8339,20 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              networkBoot:
+                                description: |-
+                                  NetworkBoot makes the interface the first boot device of the vmi.
+                                  The disks and interfaces with a boot order are tried after it, following their boot order.
+                                  Can not be combined with bootOrder.
+                                properties:
+                                  ipxeScriptRef:
+                                    description: |-
+                                      IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.
+                                      The firmware of the interface runs the script instead of fetching the boot file
+                                      offered by the DHCP server of the network.
+                                      Only supported on interfaces with the masquerade binding.
+                                    type: string
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -6929,6 +8457,33 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        serials:
+                          description: |-
+                            Serials defines additional named serial devices.
+                            Each of them is accessible through the console subresource by its name.
+                          items:
+                            description: SerialDevice is an additional serial device
+                              of the VMI.
+                            properties:
+                              name:
+                                description: Name of the serial device, used to select
+                                  it when connecting to the console subresource.
+                                type: string
+                              type:
+                                description: |-
+                                  Type of the serial device. One of: serial, virtio.
+                                  A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a
+                                  virtio console of the guest (e.g. hvc0).
+                                  Defaults to serial.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          maxItems: 8
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -6984,7 +8539,7 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, dump.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -6993,7 +8548,7 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -7366,6 +8921,52 @@ var CRDsValidation map[string]string = map[string]string{
                         serial:
                           description: The system-serial-number in SMBIOS
                           type: string
+                        smbios:
+                          description: |-
+                            SMBIOS defines the system and baseboard information and the OEM strings
+                            reported in the SMBIOS tables of the vmi.
+                            Unset values default to the cluster wide SMBIOS configuration.
+                          properties:
+                            baseBoard:
+                              description: BaseBoard overrides the cluster wide baseboard
+                                information (SMBIOS type 2).
+                              properties:
+                                asset:
+                                  type: string
+                                manufacturer:
+                                  type: string
+                                product:
+                                  type: string
+                                serial:
+                                  type: string
+                                version:
+                                  type: string
+                              type: object
+                            oemStrings:
+                              description: OEMStrings replace the cluster wide OEM
+                                strings (SMBIOS type 11).
+                              items:
+                                type: string
+                              maxItems: 32
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            system:
+                              description: |-
+                                System overrides the cluster wide system information (SMBIOS type 1).
+                                The uuid and the serial are set through the firmware.
+                              properties:
+                                family:
+                                  type: string
+                                manufacturer:
+                                  type: string
+                                product:
+                                  type: string
+                                sku:
+                                  type: string
+                                version:
+                                  type: string
+                              type: object
+                          type: object
                         uuid:
                           description: |-
                             UUID reported by the vmi bios.
@@ -7434,6 +9035,46 @@ var CRDsValidation map[string]string = map[string]string{
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        balloon:
+                          description: |-
+                            Balloon declares the bounds within which the memory balloon is
+                            automatically resized, based on the guest memory usage and the node
+                            memory pressure. Requires the AutoMemoryBalloon feature gate.
+                          properties:
+                            max:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Max is the highest amount of memory the guest can be given back.
+                                Defaults to the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            min:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Min is the lowest amount of memory the
+                                guest can be shrunk to.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - min
+                          type: object
+                        freePageReporting:
+                          description: |-
+                            FreePageReporting determines if the memory balloon reports free guest pages
+                            back to the host.
+                            Enabling it requires free page reporting to be allowed on the cluster and
+                            is not possible for high performance VirtualMachineInstances.
+                            Defaults to the cluster configuration.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                          type: object
                         guest:
                           anyOf:
                           - type: integer
@@ -7453,6 +9094,19 @@ var CRDsValidation map[string]string = map[string]string{
                                 x86_64 architecture valid values are 1Gi and 2Mi.
                               type: string
                           type: object
+                        ksm:
+                          description: |-
+                            KSM determines if the memory of the VirtualMachineInstance can be merged
+                            by Kernel Samepage Merging on nodes where KSM is enabled.
+                            Enabling it requires KSM to be configured on the cluster.
+                            Defaults to true.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                          type: object
                         maxGuest:
                           anyOf:
                           - type: integer
@@ -7462,6 +9116,12 @@ var CRDsValidation map[string]string = map[string]string{
                             The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                           pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                           x-kubernetes-int-or-string: true
+                        overcommitClass:
+                          description: |-
+                            OvercommitClass selects one of the memory overcommit classes defined
+                            in the KubeVirt configuration. The ratio of the class is used instead
+                            of the cluster wide memory overcommit.
+                          type: string
                         reservedOverhead:
                           description: |-
                             ReservedOverhead configures the memory overhead applied to a VM
@@ -7490,6 +9150,41 @@ var CRDsValidation map[string]string = map[string]string{
                               - Required
                               type: string
                           type: object
+                        suspendToDisk:
+                          description: |-
+                            SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage
+                            of the VirtualMachineInstance is sized to also hold the guest memory.
+                            Requires the SuspendToDisk feature gate.
+                          type: boolean
+                      type: object
+                    qemuCommandLine:
+                      description: |-
+                        QEMUCommandLine holds raw arguments appended to the QEMU command line.
+                        The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.
+                        Requires the QEMUArgsPassthrough feature gate.
+                      properties:
+                        args:
+                          description: Args are appended to the QEMU command line
+                            in the given order.
+                          items:
+                            description: QEMUArg is a QEMU command line option and
+                              its value, e.g. "-device" and "pvpanic".
+                            properties:
+                              name:
+                                description: Name is the QEMU option, including its
+                                  leading dash, e.g. "-device".
+                                type: string
+                              value:
+                                description: Value of the option, omitted for options
+                                  without a value.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - args
                       type: object
                     rebootPolicy:
                       description: |-
@@ -7538,6 +9233,13 @@ var CRDsValidation map[string]string = map[string]string{
                   required:
                   - devices
                   type: object
+                evictionMigrationTimeoutSeconds:
+                  description: |-
+                    EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be
+                    migrated on eviction with the "LiveMigrateOrShutdown" eviction strategy, before it is shutdown.
+                    Overrides the cluster wide evictionMigrationTimeout of the migration configuration.
+                  format: int64
+                  type: integer
                 evictionStrategy:
                   description: |-
                     EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -7545,13 +9247,72 @@ var CRDsValidation map[string]string = map[string]string{
                     - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
                     - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
+                    - "LiveMigrateOrShutdown": the same as "LiveMigrateIfPossible", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout.
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestAgentCommands:
+                  description: |-
+                    GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in
+                    the guest. A command must be allowed by both this and the cluster wide configuration.
+                  properties:
+                    allowedCommands:
+                      description: AllowedCommands are the only commands which may
+                        be invoked. All commands are allowed if empty.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                    deniedCommands:
+                      description: DeniedCommands may never be invoked, even if they
+                        are allowed.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                ignition:
+                  description: |-
+                    Ignition provisions CoreOS-family guests with an Ignition config. Unlike the
+                    kubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and
+                    be made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.
+                  properties:
+                    fragments:
+                      description: |-
+                        Fragments make up the Ignition config. A single fragment is passed to the guest as is, several
+                        fragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.
+                      items:
+                        description: IgnitionFragment is a part of the Ignition config.
+                          Exactly one of its sources must be set.
+                        properties:
+                          data:
+                            description: Data is an inline Ignition config.
+                            type: string
+                          volumeName:
+                            description: |-
+                              VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance,
+                              holding the Ignition config under the config.ign key.
+                            type: string
+                        type: object
+                      maxItems: 16
+                      minItems: 1
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    propagation:
+                      description: |-
+                        Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig.
+                        ConfigDrive requires a cloudInitConfigDrive volume without user data.
+                      enum:
+                      - FirmwareConfig
+                      - ConfigDrive
+                      type: string
+                  required:
+                  - fragments
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -7583,6 +9344,18 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentFileExists:
+                      description: |-
+                        GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        path:
+                          description: Path is the absolute path of the file in the
+                            guest.
+                          type: string
+                      required:
+                      - path
+                      type: object
                     guestAgentPing:
                       description: |-
                         GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -7772,6 +9545,29 @@ var CRDsValidation map[string]string = map[string]string{
                     Selector which must match a node's labels for the vmi to be scheduled on that node.
                     More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                   type: object
+                preShutdownHook:
+                  description: |-
+                    PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the
+                    applications of the guest, e.g. to let a database stop cleanly.
+                  properties:
+                    args:
+                      description: Args are passed to the command.
+                      items:
+                        type: string
+                      type: array
+                    command:
+                      description: Command is the path of the executable in the guest,
+                        e.g. a script stopping a database.
+                      type: string
+                    timeoutSeconds:
+                      description: |-
+                        TimeoutSeconds is the part of the termination grace period reserved for the hook.
+                        It must be shorter than terminationGracePeriodSeconds. Defaults to 10.
+                      format: int64
+                      type: integer
+                  required:
+                  - command
+                  type: object
                 priorityClassName:
                   description: |-
                     If specified, indicates the pod's priority.
@@ -7809,6 +9605,18 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentFileExists:
+                      description: |-
+                        GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        path:
+                          description: Path is the absolute path of the file in the
+                            guest.
+                          type: string
+                      required:
+                      - path
+                      type: object
                     guestAgentPing:
                       description: |-
                         GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -8308,6 +10116,12 @@ var CRDsValidation map[string]string = map[string]string{
                           The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                         properties:
+                          generateNetworkData:
+                            description: |-
+                              GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the
+                              VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.
+                              Can not be combined with another networkdata source.
+                            type: boolean
                           networkData:
                             description: NetworkData contains NoCloud inline cloud-init
                               networkdata.
@@ -8725,6 +10539,84 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          sources:
+                            description: |-
+                              Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file
+                              along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be
+                              selected by the items of one of them. Cannot be combined with Secret and ConfigMap.
+                            items:
+                              description: SysprepProjection adds the keys of a Secret
+                                or ConfigMap to a Sysprep disk. Exactly one of Secret
+                                and ConfigMap must be set.
+                              properties:
+                                configMap:
+                                  description: ConfigMap references a ConfigMap in
+                                    the namespace of the VirtualMachineInstance.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                items:
+                                  description: |-
+                                    Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.
+                                    All keys are added under their own name if empty.
+                                  items:
+                                    description: Maps a string key to a path within
+                                      a volume.
+                                    properties:
+                                      key:
+                                        description: key is the key to project.
+                                        type: string
+                                      mode:
+                                        description: |-
+                                          mode is Optional: mode bits used to set permissions on this file.
+                                          Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+                                          YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                          If not specified, the volume defaultMode will be used.
+                                          This might be in conflict with other options that affect the file
+                                          mode, like fsGroup, and the result can be other mode bits set.
+                                        format: int32
+                                        type: integer
+                                      path:
+                                        description: |-
+                                          path is the relative path of the file to map the key to.
+                                          May not be an absolute path.
+                                          May not contain the path element '..'.
+                                          May not start with the string '..'.
+                                        type: string
+                                    required:
+                                    - key
+                                    - path
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                secret:
+                                  description: Secret references a k8s Secret in the
+                                    namespace of the VirtualMachineInstance.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                              type: object
+                            maxItems: 16
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                     required:
                     - name
@@ -8814,6 +10706,24 @@ var CRDsValidation map[string]string = map[string]string{
           required:
           - state
           type: object
+        completion:
+          description: |-
+            Completion is set once the VirtualMachineInstance of a VirtualMachine with the Once
+            run strategy reached a final phase. The VirtualMachine is not started again until
+            the run strategy is changed.
+          nullable: true
+          properties:
+            completionTime:
+              description: CompletionTime is the time the completion was observed
+              format: date-time
+              type: string
+            phase:
+              description: Phase is the final phase the VirtualMachineInstance reached,
+                either Succeeded or Failed
+              type: string
+          required:
+          - phase
+          type: object
         conditions:
           description: Hold the state information of the VirtualMachine and its VirtualMachineInstance
           items:
@@ -8969,6 +10879,27 @@ var CRDsValidation map[string]string = map[string]string{
             RunStrategy tracks the last recorded RunStrategy used by the VM.
             This is needed to correctly process the next strategy (for now only the RerunOnFailure)
           type: string
+        schedule:
+          description: Schedule reports the progress of the schedule of the VirtualMachine
+          nullable: true
+          properties:
+            lastScheduleTime:
+              description: |-
+                LastScheduleTime is the last start or stop time which was handled, or the time the schedule
+                was first evaluated at.
+              format: date-time
+              type: string
+            nextStartTime:
+              description: NextStartTime is the next time the VirtualMachine is scheduled
+                to start.
+              format: date-time
+              type: string
+            nextStopTime:
+              description: NextStopTime is the next time the VirtualMachine is scheduled
+                to stop.
+              format: date-time
+              type: string
+          type: object
         snapshotInProgress:
           description: SnapshotInProgress is the name of the VirtualMachineSnapshot
             currently executing
@@ -8980,14 +10911,16 @@ var CRDsValidation map[string]string = map[string]string{
           nullable: true
           properties:
             consecutiveFailCount:
+              description: ConsecutiveFailCount is the number of VirtualMachineInstances
+                which failed in a row
               type: integer
             lastFailedVMIUID:
-              description: |-
-                UID is a type that holds unique ID values, including UUIDs.  Because we
-                don't ONLY use UUIDs, this is an alias to string.  Being a type captures
-                intent and helps make sure that UIDs and names do not get conflated.
+              description: LastFailedVMIUID is the UID of the last VirtualMachineInstance
+                which failed
               type: string
             retryAfterTimestamp:
+              description: RetryAfterTimestamp is the time after which the VirtualMachine
+                is started again
               format: date-time
               type: string
           type: object
@@ -9014,6 +10947,26 @@ var CRDsValidation map[string]string = map[string]string{
             - action
             type: object
           type: array
+        suspend:
+          description: |-
+            Suspend tracks the suspension of the VirtualMachine to disk. The VirtualMachine is not
+            started while it is suspended, until it is resumed.
+          nullable: true
+          properties:
+            phase:
+              description: Phase is the phase of the suspension
+              type: string
+            suspendTime:
+              description: SuspendTime is the time the guest memory was saved
+              format: date-time
+              type: string
+            vmiUID:
+              description: VMIUID is the UID of the VirtualMachineInstance which got
+                suspended
+              type: string
+          required:
+          - phase
+          type: object
         volumeRequests:
           description: |-
             VolumeRequests indicates a list of volumes add or remove from the VMI template and
@@ -9986,6 +11939,44 @@ var CRDsValidation map[string]string = map[string]string{
             numa:
               description: NUMA allows specifying settings for the guest NUMA topology
               properties:
+                cells:
+                  description: |-
+                    Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                    Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                  items:
+                    description: NUMACell declares a virtual numa node of the guest.
+                    properties:
+                      cpus:
+                        description: CPUs is the list of vCPUs of the numa node, e.g.
+                          "0-3,6".
+                        type: string
+                      memory:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Memory is the amount of guest memory of the numa
+                          node.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - cpus
+                    - memory
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                evenSplit:
+                  description: |-
+                    EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                    split the vCPUs and the guest memory evenly between them.
+                  properties:
+                    nodes:
+                      description: Nodes is the number of virtual numa nodes of the
+                        guest.
+                      format: int32
+                      type: integer
+                  required:
+                  - nodes
+                  type: object
                 guestMappingPassthrough:
                   description: |-
                     GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -10001,6 +11992,14 @@ var CRDsValidation map[string]string = map[string]string{
                     Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                     Example: "0-3,^1","0,2,3","2-3"
                   type: string
+                profile:
+                  description: |-
+                    Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                    The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                    Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                    the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                    Hugepages still need to be requested explicitly.
+                  type: string
               type: object
           required:
           - guest
@@ -10258,6 +12257,21 @@ var CRDsValidation map[string]string = map[string]string{
                         guest changes to the clock will be kept during reboots and not reset.
                       type: integer
                   type: object
+                variable:
+                  description: |-
+                    Variable lets the guest change its clock freely relative to the basis.
+                    The guest changes are kept during reboots and migrations.
+                  properties:
+                    basis:
+                      description: |-
+                        Basis the offset is relative to.
+                        One of "utc", "localtime". Defaults to "utc".
+                      type: string
+                    offsetSeconds:
+                      description: OffsetSeconds specifies the initial offset in seconds,
+                        relative to the basis.
+                      type: integer
+                  type: object
               type: object
             preferredTimer:
               description: Timer specifies whih timers are attached to the vmi.
@@ -10543,6 +12557,10 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            preferredWatchdogAction:
+              description: PreferredWatchdogAction optionally defines the preferred
+                action to take when a Watchdog device is triggered.
+              type: string
           type: object
         features:
           description: Features optionally defines preferences associated with the
@@ -10861,6 +12879,12 @@ var CRDsValidation map[string]string = map[string]string{
           description: PreferredArchitecture defines a prefeerred architecture for
             the VirtualMachine
           type: string
+        preferredGuestOS:
+          description: |-
+            PreferredGuestOS optionally hints at the operating system running in the guest.
+            When set to windows a complete set of Hyper-V enlightenments is applied to the
+            VirtualMachineInstance for any enlightenment not already configured.
+          type: string
         preferredSubdomain:
           description: Subdomain of the VirtualMachineInstance
           type: string
@@ -10913,6 +12937,86 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinedefaults": `openAPIV3Schema:
+  description: |-
+    VirtualMachineDefaults defines the defaults applied to the VirtualMachines created in its namespace.
+    The defaults only fill the fields a VirtualMachine leaves unset. When several VirtualMachineDefaults
+    exist in a namespace, they are applied in the alphabetical order of their names and the first one
+    setting a field wins.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Spec defines the defaults of the VirtualMachines.
+      properties:
+        diskBus:
+          description: DiskBus is set on the disks of the VirtualMachines which don't
+            set a bus.
+          enum:
+          - virtio
+          - sata
+          - scsi
+          - usb
+          type: string
+        evictionStrategy:
+          description: |-
+            EvictionStrategy is set on the VirtualMachines which don't set one.
+            It takes precedence over the cluster wide eviction strategy.
+          enum:
+          - None
+          - LiveMigrate
+          - LiveMigrateIfPossible
+          - External
+          type: string
+        networkBinding:
+          description: |-
+            NetworkBinding is set on the interfaces of the VirtualMachines which set neither
+            a binding method nor a binding plugin.
+          properties:
+            method:
+              description: Method is the core binding method of the interfaces.
+              enum:
+              - bridge
+              - masquerade
+              type: string
+            plugin:
+              description: Plugin is the name of a network binding plugin registered
+                in the KubeVirt configuration.
+              type: string
+          type: object
+          x-kubernetes-validations:
+          - message: exactly one of method and plugin must be set
+            rule: has(self.method) != has(self.plugin)
+        runStrategy:
+          description: RunStrategy is set on the VirtualMachines which set neither
+            runStrategy nor running.
+          enum:
+          - Always
+          - RerunOnFailure
+          - Manual
+          - Halted
+          - Once
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachineexport": `openAPIV3Schema:
   description: VirtualMachineExport defines the operation of exporting a VM source
@@ -12367,6 +14471,28 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: |-
+                    GuestTimeSync controls when the guest clock is set to the host time through
+                    the guest agent, after the guest was not running for some time.
+                  properties:
+                    afterMigration:
+                      description: |-
+                        AfterMigration sets the guest clock once the vmi is migrated.
+                        Defaults to true.
+                      type: boolean
+                    afterUnpause:
+                      description: |-
+                        AfterUnpause sets the guest clock once the vmi is unpaused.
+                        Defaults to true.
+                      type: boolean
+                    timeoutSeconds:
+                      description: |-
+                        TimeoutSeconds is the time the guest agent is retried for if it is not responsive.
+                        Defaults to 60.
+                      format: int32
+                      type: integer
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -12455,6 +14581,21 @@ var CRDsValidation map[string]string = map[string]string{
                         guest changes to the clock will be kept during reboots and not reset.
                       type: integer
                   type: object
+                variable:
+                  description: |-
+                    Variable lets the guest change its clock freely relative to the basis.
+                    The guest changes are kept during reboots and migrations.
+                  properties:
+                    basis:
+                      description: |-
+                        Basis the offset is relative to.
+                        One of "utc", "localtime". Defaults to "utc".
+                      type: string
+                    offsetSeconds:
+                      description: OffsetSeconds specifies the initial offset in seconds,
+                        relative to the basis.
+                      type: integer
+                  type: object
               type: object
               x-kubernetes-preserve-unknown-fields: true
             cpu:
@@ -12514,10 +14655,56 @@ var CRDsValidation map[string]string = map[string]string{
                     and "host-model" to get CPU closest to the node one.
                     Defaults to host-model.
                   type: string
+                nestedVirtualization:
+                  description: |-
+                    NestedVirtualization controls whether the guest can run virtual machines itself.
+                    Require schedules the VMI onto nodes exposing VMX or SVM to their guests,
+                    Forbid hides VMX and SVM from the guest.
+                    By default the guest gets them when both the CPU model and the node provide them.
+                  type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
                   properties:
+                    cells:
+                      description: |-
+                        Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                        Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                      items:
+                        description: NUMACell declares a virtual numa node of the
+                          guest.
+                        properties:
+                          cpus:
+                            description: CPUs is the list of vCPUs of the numa node,
+                              e.g. "0-3,6".
+                            type: string
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory is the amount of guest memory of the
+                              numa node.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - cpus
+                        - memory
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    evenSplit:
+                      description: |-
+                        EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                        split the vCPUs and the guest memory evenly between them.
+                      properties:
+                        nodes:
+                          description: Nodes is the number of virtual numa nodes of
+                            the guest.
+                          format: int32
+                          type: integer
+                      required:
+                      - nodes
+                      type: object
                     guestMappingPassthrough:
                       description: |-
                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -12533,6 +14720,14 @@ var CRDsValidation map[string]string = map[string]string{
                         Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                         Example: "0-3,^1","0,2,3","2-3"
                       type: string
+                    profile:
+                      description: |-
+                        Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                        The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                        Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                        the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                        Hugepages still need to be requested explicitly.
+                      type: string
                   type: object
                 sockets:
                   description: |-
@@ -12580,6 +14775,13 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether to attach the VSOCK CID to the VM or not.
                     VSOCK access will be available if set to true. Defaults to false.
                   type: boolean
+                autoattachVirtioDriversDisk:
+                  description: |-
+                    Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as
+                    indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk
+                    is no longer attached on the next start once set to false.
+                    Defaults to false.
+                  type: boolean
                 blockMultiQueue:
                   description: |-
                     Whether or not to enable virtio multi-queue for block devices.
@@ -12749,6 +14951,28 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache sets the caching policy of the shared directory in the guest.
+                              One of: auto, always, never. Defaults to auto.
+                            type: string
+                          daxWindowSize:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: |-
+                              DAXWindowSize enables DAX for the filesystem and sets the size of the
+                              shared memory window the guest maps the files into, bypassing its page cache.
+                              It must be a multiple of 2Mi. DAX is disabled if not set.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          threadPoolSize:
+                            description: |-
+                              ThreadPoolSize sets the number of worker threads of the virtiofsd process
+                              serving the filesystem. Defaults to the virtiofsd default.
+                            format: int32
+                            type: integer
                         type: object
                     required:
                     - name
@@ -12812,6 +15036,25 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                guestPanicCapture:
+                  description: |-
+                    GuestPanicCapture configures what is captured when the guest kernel panics.
+                    A pvpanic device is added if no panic device is provided.
+                  properties:
+                    consoleLogLines:
+                      description: |-
+                        ConsoleLogLines is the number of trailing lines of the serial console log
+                        recorded in the GuestPanicked condition. Requires the serial console log.
+                        Defaults to 20, at most 100.
+                      format: int32
+                      type: integer
+                    memoryDumpClaimName:
+                      description: |-
+                        MemoryDumpClaimName is the name of the PVC the guest memory is dumped to
+                        when the guest kernel panics. The crashed guest is kept until the memory
+                        dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.
+                      type: string
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -12968,6 +15211,20 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      networkBoot:
+                        description: |-
+                          NetworkBoot makes the interface the first boot device of the vmi.
+                          The disks and interfaces with a boot order are tried after it, following their boot order.
+                          Can not be combined with bootOrder.
+                        properties:
+                          ipxeScriptRef:
+                            description: |-
+                              IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.
+                              The firmware of the interface runs the script instead of fetching the boot file
+                              offered by the DHCP server of the network.
+                              Only supported on interfaces with the masquerade binding.
+                            type: string
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -13070,6 +15327,33 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                serials:
+                  description: |-
+                    Serials defines additional named serial devices.
+                    Each of them is accessible through the console subresource by its name.
+                  items:
+                    description: SerialDevice is an additional serial device of the
+                      VMI.
+                    properties:
+                      name:
+                        description: Name of the serial device, used to select it
+                          when connecting to the console subresource.
+                        type: string
+                      type:
+                        description: |-
+                          Type of the serial device. One of: serial, virtio.
+                          A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a
+                          virtio console of the guest (e.g. hvc0).
+                          Defaults to serial.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  maxItems: 8
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -13124,7 +15408,7 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, dump.
                             Defaults to reset.
                           type: string
                       type: object
@@ -13133,7 +15417,7 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.
                             Defaults to reset.
                           type: string
                       type: object
@@ -13500,6 +15784,52 @@ var CRDsValidation map[string]string = map[string]string{
                 serial:
                   description: The system-serial-number in SMBIOS
                   type: string
+                smbios:
+                  description: |-
+                    SMBIOS defines the system and baseboard information and the OEM strings
+                    reported in the SMBIOS tables of the vmi.
+                    Unset values default to the cluster wide SMBIOS configuration.
+                  properties:
+                    baseBoard:
+                      description: BaseBoard overrides the cluster wide baseboard
+                        information (SMBIOS type 2).
+                      properties:
+                        asset:
+                          type: string
+                        manufacturer:
+                          type: string
+                        product:
+                          type: string
+                        serial:
+                          type: string
+                        version:
+                          type: string
+                      type: object
+                    oemStrings:
+                      description: OEMStrings replace the cluster wide OEM strings
+                        (SMBIOS type 11).
+                      items:
+                        type: string
+                      maxItems: 32
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    system:
+                      description: |-
+                        System overrides the cluster wide system information (SMBIOS type 1).
+                        The uuid and the serial are set through the firmware.
+                      properties:
+                        family:
+                          type: string
+                        manufacturer:
+                          type: string
+                        product:
+                          type: string
+                        sku:
+                          type: string
+                        version:
+                          type: string
+                      type: object
+                  type: object
                 uuid:
                   description: |-
                     UUID reported by the vmi bios.
@@ -13566,6 +15896,46 @@ var CRDsValidation map[string]string = map[string]string{
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
+                balloon:
+                  description: |-
+                    Balloon declares the bounds within which the memory balloon is
+                    automatically resized, based on the guest memory usage and the node
+                    memory pressure. Requires the AutoMemoryBalloon feature gate.
+                  properties:
+                    max:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Max is the highest amount of memory the guest can be given back.
+                        Defaults to the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    min:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Min is the lowest amount of memory the guest can
+                        be shrunk to.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - min
+                  type: object
+                freePageReporting:
+                  description: |-
+                    FreePageReporting determines if the memory balloon reports free guest pages
+                    back to the host.
+                    Enabling it requires free page reporting to be allowed on the cluster and
+                    is not possible for high performance VirtualMachineInstances.
+                    Defaults to the cluster configuration.
+                  properties:
+                    enabled:
+                      description: |-
+                        Enabled determines if the feature should be enabled or disabled on the guest.
+                        Defaults to true.
+                      type: boolean
+                  type: object
                 guest:
                   anyOf:
                   - type: integer
@@ -13585,6 +15955,19 @@ var CRDsValidation map[string]string = map[string]string{
                         architecture valid values are 1Gi and 2Mi.
                       type: string
                   type: object
+                ksm:
+                  description: |-
+                    KSM determines if the memory of the VirtualMachineInstance can be merged
+                    by Kernel Samepage Merging on nodes where KSM is enabled.
+                    Enabling it requires KSM to be configured on the cluster.
+                    Defaults to true.
+                  properties:
+                    enabled:
+                      description: |-
+                        Enabled determines if the feature should be enabled or disabled on the guest.
+                        Defaults to true.
+                      type: boolean
+                  type: object
                 maxGuest:
                   anyOf:
                   - type: integer
@@ -13594,6 +15977,12 @@ var CRDsValidation map[string]string = map[string]string{
                     The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                   x-kubernetes-int-or-string: true
+                overcommitClass:
+                  description: |-
+                    OvercommitClass selects one of the memory overcommit classes defined
+                    in the KubeVirt configuration. The ratio of the class is used instead
+                    of the cluster wide memory overcommit.
+                  type: string
                 reservedOverhead:
                   description: |-
                     ReservedOverhead configures the memory overhead applied to a VM
@@ -13622,6 +16011,41 @@ var CRDsValidation map[string]string = map[string]string{
                       - Required
                       type: string
                   type: object
+                suspendToDisk:
+                  description: |-
+                    SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage
+                    of the VirtualMachineInstance is sized to also hold the guest memory.
+                    Requires the SuspendToDisk feature gate.
+                  type: boolean
+              type: object
+            qemuCommandLine:
+              description: |-
+                QEMUCommandLine holds raw arguments appended to the QEMU command line.
+                The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.
+                Requires the QEMUArgsPassthrough feature gate.
+              properties:
+                args:
+                  description: Args are appended to the QEMU command line in the given
+                    order.
+                  items:
+                    description: QEMUArg is a QEMU command line option and its value,
+                      e.g. "-device" and "pvpanic".
+                    properties:
+                      name:
+                        description: Name is the QEMU option, including its leading
+                          dash, e.g. "-device".
+                        type: string
+                      value:
+                        description: Value of the option, omitted for options without
+                          a value.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - args
               type: object
             rebootPolicy:
               description: |-
@@ -13670,6 +16094,13 @@ var CRDsValidation map[string]string = map[string]string{
           required:
           - devices
           type: object
+        evictionMigrationTimeoutSeconds:
+          description: |-
+            EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be
+            migrated on eviction with the "LiveMigrateOrShutdown" eviction strategy, before it is shutdown.
+            Overrides the cluster wide evictionMigrationTimeout of the migration configuration.
+          format: int64
+          type: integer
         evictionStrategy:
           description: |-
             EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -13677,13 +16108,71 @@ var CRDsValidation map[string]string = map[string]string{
             - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
             - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
+            - "LiveMigrateOrShutdown": the same as "LiveMigrateIfPossible", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout.
             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        guestAgentCommands:
+          description: |-
+            GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in
+            the guest. A command must be allowed by both this and the cluster wide configuration.
+          properties:
+            allowedCommands:
+              description: AllowedCommands are the only commands which may be invoked.
+                All commands are allowed if empty.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            deniedCommands:
+              description: DeniedCommands may never be invoked, even if they are allowed.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+          type: object
         hostname:
           description: |-
             Specifies the hostname of the vmi
             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
           type: string
+        ignition:
+          description: |-
+            Ignition provisions CoreOS-family guests with an Ignition config. Unlike the
+            kubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and
+            be made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.
+          properties:
+            fragments:
+              description: |-
+                Fragments make up the Ignition config. A single fragment is passed to the guest as is, several
+                fragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.
+              items:
+                description: IgnitionFragment is a part of the Ignition config. Exactly
+                  one of its sources must be set.
+                properties:
+                  data:
+                    description: Data is an inline Ignition config.
+                    type: string
+                  volumeName:
+                    description: |-
+                      VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance,
+                      holding the Ignition config under the config.ign key.
+                    type: string
+                type: object
+              maxItems: 16
+              minItems: 1
+              type: array
+              x-kubernetes-list-type: atomic
+            propagation:
+              description: |-
+                Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig.
+                ConfigDrive requires a cloudInitConfigDrive volume without user data.
+              enum:
+              - FirmwareConfig
+              - ConfigDrive
+              type: string
+          required:
+          - fragments
+          type: object
         livenessProbe:
           description: |-
             Periodic probe of VirtualMachineInstance liveness.
@@ -13715,6 +16204,17 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            guestAgentFileExists:
+              description: |-
+                GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                If the guest agent is not available, this probe will fail.
+              properties:
+                path:
+                  description: Path is the absolute path of the file in the guest.
+                  type: string
+              required:
+              - path
+              type: object
             guestAgentPing:
               description: |-
                 GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -13903,6 +16403,29 @@ var CRDsValidation map[string]string = map[string]string{
             Selector which must match a node's labels for the vmi to be scheduled on that node.
             More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
           type: object
+        preShutdownHook:
+          description: |-
+            PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the
+            applications of the guest, e.g. to let a database stop cleanly.
+          properties:
+            args:
+              description: Args are passed to the command.
+              items:
+                type: string
+              type: array
+            command:
+              description: Command is the path of the executable in the guest, e.g.
+                a script stopping a database.
+              type: string
+            timeoutSeconds:
+              description: |-
+                TimeoutSeconds is the part of the termination grace period reserved for the hook.
+                It must be shorter than terminationGracePeriodSeconds. Defaults to 10.
+              format: int64
+              type: integer
+          required:
+          - command
+          type: object
         priorityClassName:
           description: |-
             If specified, indicates the pod's priority.
@@ -13940,6 +16463,17 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            guestAgentFileExists:
+              description: |-
+                GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                If the guest agent is not available, this probe will fail.
+              properties:
+                path:
+                  description: Path is the absolute path of the file in the guest.
+                  type: string
+              required:
+              - path
+              type: object
             guestAgentPing:
               description: |-
                 GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -14438,6 +16972,12 @@ var CRDsValidation map[string]string = map[string]string{
                   The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                   More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                 properties:
+                  generateNetworkData:
+                    description: |-
+                      GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the
+                      VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.
+                      Can not be combined with another networkdata source.
+                    type: boolean
                   networkData:
                     description: NetworkData contains NoCloud inline cloud-init networkdata.
                     type: string
@@ -14844,6 +17384,83 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                     x-kubernetes-map-type: atomic
+                  sources:
+                    description: |-
+                      Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file
+                      along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be
+                      selected by the items of one of them. Cannot be combined with Secret and ConfigMap.
+                    items:
+                      description: SysprepProjection adds the keys of a Secret or
+                        ConfigMap to a Sysprep disk. Exactly one of Secret and ConfigMap
+                        must be set.
+                      properties:
+                        configMap:
+                          description: ConfigMap references a ConfigMap in the namespace
+                            of the VirtualMachineInstance.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        items:
+                          description: |-
+                            Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.
+                            All keys are added under their own name if empty.
+                          items:
+                            description: Maps a string key to a path within a volume.
+                            properties:
+                              key:
+                                description: key is the key to project.
+                                type: string
+                              mode:
+                                description: |-
+                                  mode is Optional: mode bits used to set permissions on this file.
+                                  Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+                                  YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                  If not specified, the volume defaultMode will be used.
+                                  This might be in conflict with other options that affect the file
+                                  mode, like fsGroup, and the result can be other mode bits set.
+                                format: int32
+                                type: integer
+                              path:
+                                description: |-
+                                  path is the relative path of the file to map the key to.
+                                  May not be an absolute path.
+                                  May not contain the path element '..'.
+                                  May not start with the string '..'.
+                                type: string
+                            required:
+                            - key
+                            - path
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        secret:
+                          description: Secret references a k8s Secret in the namespace
+                            of the VirtualMachineInstance.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                      type: object
+                    maxItems: 16
+                    type: array
+                    x-kubernetes-list-type: atomic
                 type: object
             required:
             - name
@@ -15000,6 +17617,99 @@ var CRDsValidation map[string]string = map[string]string{
             It will be set to "frozen" if the request was made, or unset otherwise.
             This does not reflect the actual state of the guest filesystem.
           type: string
+        guestAgentData:
+          description: |-
+            GuestAgentData contains the information reported by the guest agent along with the time it was reported.
+            It is kept when the guest agent stops responding, see the GuestAgentDataStale condition.
+          properties:
+            fqdn:
+              description: FQDN is the fully qualified domain name of the guest, reported
+                when the hostname of the guest is fully qualified
+              properties:
+                lastUpdated:
+                  description: LastUpdated is the time the guest agent reported the
+                    current value
+                  format: date-time
+                  type: string
+                value:
+                  description: Value is the value reported by the guest agent
+                  type: string
+              required:
+              - lastUpdated
+              - value
+              type: object
+            hostname:
+              description: Hostname is the hostname of the guest
+              properties:
+                lastUpdated:
+                  description: LastUpdated is the time the guest agent reported the
+                    current value
+                  format: date-time
+                  type: string
+                value:
+                  description: Value is the value reported by the guest agent
+                  type: string
+              required:
+              - lastUpdated
+              - value
+              type: object
+            os:
+              description: OS is the guest operating system information
+              properties:
+                info:
+                  description: Info is the guest operating system information
+                  properties:
+                    id:
+                      description: Guest OS Id
+                      type: string
+                    kernelRelease:
+                      description: Guest OS Kernel Release
+                      type: string
+                    kernelVersion:
+                      description: Kernel version of the Guest OS
+                      type: string
+                    machine:
+                      description: Machine type of the Guest OS
+                      type: string
+                    name:
+                      description: Name of the Guest OS
+                      type: string
+                    prettyName:
+                      description: Guest OS Pretty Name
+                      type: string
+                    version:
+                      description: Guest OS Version
+                      type: string
+                    versionId:
+                      description: Version ID of the Guest OS
+                      type: string
+                  type: object
+                lastUpdated:
+                  description: LastUpdated is the time the guest agent reported the
+                    current information
+                  format: date-time
+                  type: string
+              required:
+              - info
+              - lastUpdated
+              type: object
+            timezone:
+              description: Timezone is the timezone of the guest, as name and offset
+                to UTC in seconds
+              properties:
+                lastUpdated:
+                  description: LastUpdated is the time the guest agent reported the
+                    current value
+                  format: date-time
+                  type: string
+                value:
+                  description: Value is the value reported by the guest agent
+                  type: string
+              required:
+              - lastUpdated
+              - value
+              type: object
+          type: object
         guestOSInfo:
           description: Guest OS Information
           properties:
@@ -15111,6 +17821,15 @@ var CRDsValidation map[string]string = map[string]string{
           description: Memory shows various informations about the VirtualMachine
             memory.
           properties:
+            balloonTarget:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                BalloonTarget specifies the memory balloon size computed from the balloon
+                bounds of the VirtualMachine.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
             guestAtBoot:
               anyOf:
               - type: integer
@@ -15330,6 +18049,21 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                evictionMigrationTimeout:
+                  description: |-
+                    EvictionMigrationTimeout is the maximum number of seconds VMIs with the LiveMigrateOrShutdown
+                    eviction strategy are tried to be migrated on eviction, before they are shutdown. Defaults to 600
+                  format: int64
+                  type: integer
+                evictionShutdownPriorityThreshold:
+                  description: |-
+                    EvictionShutdownPriorityThreshold makes VMIs with the LiveMigrateIfPossible eviction strategy
+                    and a priority lower than the threshold shut down on eviction instead of being migrated, so
+                    that the migration capacity of a drain goes to the more important VMIs first.
+                    The priority of a VMI is the one of the PriorityClass referenced by spec.priorityClassName.
+                    Unset by default, in which case all VMIs are migrated.
+                  format: int32
+                  type: integer
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -15933,6 +18667,21 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                evictionMigrationTimeout:
+                  description: |-
+                    EvictionMigrationTimeout is the maximum number of seconds VMIs with the LiveMigrateOrShutdown
+                    eviction strategy are tried to be migrated on eviction, before they are shutdown. Defaults to 600
+                  format: int64
+                  type: integer
+                evictionShutdownPriorityThreshold:
+                  description: |-
+                    EvictionShutdownPriorityThreshold makes VMIs with the LiveMigrateIfPossible eviction strategy
+                    and a priority lower than the threshold shut down on eviction instead of being migrated, so
+                    that the migration capacity of a drain goes to the more important VMIs first.
+                    The priority of a VMI is the one of the PriorityClass referenced by spec.priorityClassName.
+                    Unset by default, in which case all VMIs are migrated.
+                  format: int32
+                  type: integer
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -16263,6 +19012,28 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: |-
+                    GuestTimeSync controls when the guest clock is set to the host time through
+                    the guest agent, after the guest was not running for some time.
+                  properties:
+                    afterMigration:
+                      description: |-
+                        AfterMigration sets the guest clock once the vmi is migrated.
+                        Defaults to true.
+                      type: boolean
+                    afterUnpause:
+                      description: |-
+                        AfterUnpause sets the guest clock once the vmi is unpaused.
+                        Defaults to true.
+                      type: boolean
+                    timeoutSeconds:
+                      description: |-
+                        TimeoutSeconds is the time the guest agent is retried for if it is not responsive.
+                        Defaults to 60.
+                      format: int32
+                      type: integer
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -16351,6 +19122,21 @@ var CRDsValidation map[string]string = map[string]string{
                         guest changes to the clock will be kept during reboots and not reset.
                       type: integer
                   type: object
+                variable:
+                  description: |-
+                    Variable lets the guest change its clock freely relative to the basis.
+                    The guest changes are kept during reboots and migrations.
+                  properties:
+                    basis:
+                      description: |-
+                        Basis the offset is relative to.
+                        One of "utc", "localtime". Defaults to "utc".
+                      type: string
+                    offsetSeconds:
+                      description: OffsetSeconds specifies the initial offset in seconds,
+                        relative to the basis.
+                      type: integer
+                  type: object
               type: object
               x-kubernetes-preserve-unknown-fields: true
             cpu:
@@ -16410,10 +19196,56 @@ var CRDsValidation map[string]string = map[string]string{
                     and "host-model" to get CPU closest to the node one.
                     Defaults to host-model.
                   type: string
+                nestedVirtualization:
+                  description: |-
+                    NestedVirtualization controls whether the guest can run virtual machines itself.
+                    Require schedules the VMI onto nodes exposing VMX or SVM to their guests,
+                    Forbid hides VMX and SVM from the guest.
+                    By default the guest gets them when both the CPU model and the node provide them.
+                  type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
                   properties:
+                    cells:
+                      description: |-
+                        Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                        Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                      items:
+                        description: NUMACell declares a virtual numa node of the
+                          guest.
+                        properties:
+                          cpus:
+                            description: CPUs is the list of vCPUs of the numa node,
+                              e.g. "0-3,6".
+                            type: string
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory is the amount of guest memory of the
+                              numa node.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - cpus
+                        - memory
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    evenSplit:
+                      description: |-
+                        EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                        split the vCPUs and the guest memory evenly between them.
+                      properties:
+                        nodes:
+                          description: Nodes is the number of virtual numa nodes of
+                            the guest.
+                          format: int32
+                          type: integer
+                      required:
+                      - nodes
+                      type: object
                     guestMappingPassthrough:
                       description: |-
                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -16429,6 +19261,14 @@ var CRDsValidation map[string]string = map[string]string{
                         Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                         Example: "0-3,^1","0,2,3","2-3"
                       type: string
+                    profile:
+                      description: |-
+                        Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                        The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                        Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                        the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                        Hugepages still need to be requested explicitly.
+                      type: string
                   type: object
                 sockets:
                   description: |-
@@ -16476,6 +19316,13 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether to attach the VSOCK CID to the VM or not.
                     VSOCK access will be available if set to true. Defaults to false.
                   type: boolean
+                autoattachVirtioDriversDisk:
+                  description: |-
+                    Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as
+                    indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk
+                    is no longer attached on the next start once set to false.
+                    Defaults to false.
+                  type: boolean
                 blockMultiQueue:
                   description: |-
                     Whether or not to enable virtio multi-queue for block devices.
@@ -16645,6 +19492,28 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache sets the caching policy of the shared directory in the guest.
+                              One of: auto, always, never. Defaults to auto.
+                            type: string
+                          daxWindowSize:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: |-
+                              DAXWindowSize enables DAX for the filesystem and sets the size of the
+                              shared memory window the guest maps the files into, bypassing its page cache.
+                              It must be a multiple of 2Mi. DAX is disabled if not set.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          threadPoolSize:
+                            description: |-
+                              ThreadPoolSize sets the number of worker threads of the virtiofsd process
+                              serving the filesystem. Defaults to the virtiofsd default.
+                            format: int32
+                            type: integer
                         type: object
                     required:
                     - name
@@ -16708,6 +19577,25 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                guestPanicCapture:
+                  description: |-
+                    GuestPanicCapture configures what is captured when the guest kernel panics.
+                    A pvpanic device is added if no panic device is provided.
+                  properties:
+                    consoleLogLines:
+                      description: |-
+                        ConsoleLogLines is the number of trailing lines of the serial console log
+                        recorded in the GuestPanicked condition. Requires the serial console log.
+                        Defaults to 20, at most 100.
+                      format: int32
+                      type: integer
+                    memoryDumpClaimName:
+                      description: |-
+                        MemoryDumpClaimName is the name of the PVC the guest memory is dumped to
+                        when the guest kernel panics. The crashed guest is kept until the memory
+                        dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.
+                      type: string
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -16864,6 +19752,20 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      networkBoot:
+                        description: |-
+                          NetworkBoot makes the interface the first boot device of the vmi.
+                          The disks and interfaces with a boot order are tried after it, following their boot order.
+                          Can not be combined with bootOrder.
+                        properties:
+                          ipxeScriptRef:
+                            description: |-
+                              IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.
+                              The firmware of the interface runs the script instead of fetching the boot file
+                              offered by the DHCP server of the network.
+                              Only supported on interfaces with the masquerade binding.
+                            type: string
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -16966,6 +19868,33 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                serials:
+                  description: |-
+                    Serials defines additional named serial devices.
+                    Each of them is accessible through the console subresource by its name.
+                  items:
+                    description: SerialDevice is an additional serial device of the
+                      VMI.
+                    properties:
+                      name:
+                        description: Name of the serial device, used to select it
+                          when connecting to the console subresource.
+                        type: string
+                      type:
+                        description: |-
+                          Type of the serial device. One of: serial, virtio.
+                          A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a
+                          virtio console of the guest (e.g. hvc0).
+                          Defaults to serial.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  maxItems: 8
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -17020,7 +19949,7 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, dump.
                             Defaults to reset.
                           type: string
                       type: object
@@ -17029,7 +19958,7 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.
                             Defaults to reset.
                           type: string
                       type: object
@@ -17396,6 +20325,52 @@ var CRDsValidation map[string]string = map[string]string{
                 serial:
                   description: The system-serial-number in SMBIOS
                   type: string
+                smbios:
+                  description: |-
+                    SMBIOS defines the system and baseboard information and the OEM strings
+                    reported in the SMBIOS tables of the vmi.
+                    Unset values default to the cluster wide SMBIOS configuration.
+                  properties:
+                    baseBoard:
+                      description: BaseBoard overrides the cluster wide baseboard
+                        information (SMBIOS type 2).
+                      properties:
+                        asset:
+                          type: string
+                        manufacturer:
+                          type: string
+                        product:
+                          type: string
+                        serial:
+                          type: string
+                        version:
+                          type: string
+                      type: object
+                    oemStrings:
+                      description: OEMStrings replace the cluster wide OEM strings
+                        (SMBIOS type 11).
+                      items:
+                        type: string
+                      maxItems: 32
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    system:
+                      description: |-
+                        System overrides the cluster wide system information (SMBIOS type 1).
+                        The uuid and the serial are set through the firmware.
+                      properties:
+                        family:
+                          type: string
+                        manufacturer:
+                          type: string
+                        product:
+                          type: string
+                        sku:
+                          type: string
+                        version:
+                          type: string
+                      type: object
+                  type: object
                 uuid:
                   description: |-
                     UUID reported by the vmi bios.
@@ -17462,6 +20437,46 @@ var CRDsValidation map[string]string = map[string]string{
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
+                balloon:
+                  description: |-
+                    Balloon declares the bounds within which the memory balloon is
+                    automatically resized, based on the guest memory usage and the node
+                    memory pressure. Requires the AutoMemoryBalloon feature gate.
+                  properties:
+                    max:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Max is the highest amount of memory the guest can be given back.
+                        Defaults to the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    min:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Min is the lowest amount of memory the guest can
+                        be shrunk to.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - min
+                  type: object
+                freePageReporting:
+                  description: |-
+                    FreePageReporting determines if the memory balloon reports free guest pages
+                    back to the host.
+                    Enabling it requires free page reporting to be allowed on the cluster and
+                    is not possible for high performance VirtualMachineInstances.
+                    Defaults to the cluster configuration.
+                  properties:
+                    enabled:
+                      description: |-
+                        Enabled determines if the feature should be enabled or disabled on the guest.
+                        Defaults to true.
+                      type: boolean
+                  type: object
                 guest:
                   anyOf:
                   - type: integer
@@ -17481,6 +20496,19 @@ var CRDsValidation map[string]string = map[string]string{
                         architecture valid values are 1Gi and 2Mi.
                       type: string
                   type: object
+                ksm:
+                  description: |-
+                    KSM determines if the memory of the VirtualMachineInstance can be merged
+                    by Kernel Samepage Merging on nodes where KSM is enabled.
+                    Enabling it requires KSM to be configured on the cluster.
+                    Defaults to true.
+                  properties:
+                    enabled:
+                      description: |-
+                        Enabled determines if the feature should be enabled or disabled on the guest.
+                        Defaults to true.
+                      type: boolean
+                  type: object
                 maxGuest:
                   anyOf:
                   - type: integer
@@ -17490,6 +20518,12 @@ var CRDsValidation map[string]string = map[string]string{
                     The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                   x-kubernetes-int-or-string: true
+                overcommitClass:
+                  description: |-
+                    OvercommitClass selects one of the memory overcommit classes defined
+                    in the KubeVirt configuration. The ratio of the class is used instead
+                    of the cluster wide memory overcommit.
+                  type: string
                 reservedOverhead:
                   description: |-
                     ReservedOverhead configures the memory overhead applied to a VM
@@ -17518,6 +20552,41 @@ var CRDsValidation map[string]string = map[string]string{
                       - Required
                       type: string
                   type: object
+                suspendToDisk:
+                  description: |-
+                    SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage
+                    of the VirtualMachineInstance is sized to also hold the guest memory.
+                    Requires the SuspendToDisk feature gate.
+                  type: boolean
+              type: object
+            qemuCommandLine:
+              description: |-
+                QEMUCommandLine holds raw arguments appended to the QEMU command line.
+                The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.
+                Requires the QEMUArgsPassthrough feature gate.
+              properties:
+                args:
+                  description: Args are appended to the QEMU command line in the given
+                    order.
+                  items:
+                    description: QEMUArg is a QEMU command line option and its value,
+                      e.g. "-device" and "pvpanic".
+                    properties:
+                      name:
+                        description: Name is the QEMU option, including its leading
+                          dash, e.g. "-device".
+                        type: string
+                      value:
+                        description: Value of the option, omitted for options without
+                          a value.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - args
               type: object
             rebootPolicy:
               description: |-
@@ -18816,6 +21885,28 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        guestTimeSync:
+                          description: |-
+                            GuestTimeSync controls when the guest clock is set to the host time through
+                            the guest agent, after the guest was not running for some time.
+                          properties:
+                            afterMigration:
+                              description: |-
+                                AfterMigration sets the guest clock once the vmi is migrated.
+                                Defaults to true.
+                              type: boolean
+                            afterUnpause:
+                              description: |-
+                                AfterUnpause sets the guest clock once the vmi is unpaused.
+                                Defaults to true.
+                              type: boolean
+                            timeoutSeconds:
+                              description: |-
+                                TimeoutSeconds is the time the guest agent is retried for if it is not responsive.
+                                Defaults to 60.
+                              format: int32
+                              type: integer
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -18907,6 +21998,21 @@ var CRDsValidation map[string]string = map[string]string{
                                 guest changes to the clock will be kept during reboots and not reset.
                               type: integer
                           type: object
+                        variable:
+                          description: |-
+                            Variable lets the guest change its clock freely relative to the basis.
+                            The guest changes are kept during reboots and migrations.
+                          properties:
+                            basis:
+                              description: |-
+                                Basis the offset is relative to.
+                                One of "utc", "localtime". Defaults to "utc".
+                              type: string
+                            offsetSeconds:
+                              description: OffsetSeconds specifies the initial offset
+                                in seconds, relative to the basis.
+                              type: integer
+                          type: object
                       type: object
                       x-kubernetes-preserve-unknown-fields: true
                     cpu:
@@ -18966,10 +22072,56 @@ var CRDsValidation map[string]string = map[string]string{
                             and "host-model" to get CPU closest to the node one.
                             Defaults to host-model.
                           type: string
+                        nestedVirtualization:
+                          description: |-
+                            NestedVirtualization controls whether the guest can run virtual machines itself.
+                            Require schedules the VMI onto nodes exposing VMX or SVM to their guests,
+                            Forbid hides VMX and SVM from the guest.
+                            By default the guest gets them when both the CPU model and the node provide them.
+                          type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
                           properties:
+                            cells:
+                              description: |-
+                                Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                                Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                              items:
+                                description: NUMACell declares a virtual numa node
+                                  of the guest.
+                                properties:
+                                  cpus:
+                                    description: CPUs is the list of vCPUs of the
+                                      numa node, e.g. "0-3,6".
+                                    type: string
+                                  memory:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Memory is the amount of guest memory
+                                      of the numa node.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - cpus
+                                - memory
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            evenSplit:
+                              description: |-
+                                EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                                split the vCPUs and the guest memory evenly between them.
+                              properties:
+                                nodes:
+                                  description: Nodes is the number of virtual numa
+                                    nodes of the guest.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - nodes
+                              type: object
                             guestMappingPassthrough:
                               description: |-
                                 GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -18985,6 +22137,14 @@ var CRDsValidation map[string]string = map[string]string{
                                 Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                                 Example: "0-3,^1","0,2,3","2-3"
                               type: string
+                            profile:
+                              description: |-
+                                Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                                The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                                Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                                the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                                Hugepages still need to be requested explicitly.
+                              type: string
                           type: object
                         sockets:
                           description: |-
@@ -19033,6 +22193,13 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether to attach the VSOCK CID to the VM or not.
                             VSOCK access will be available if set to true. Defaults to false.
                           type: boolean
+                        autoattachVirtioDriversDisk:
+                          description: |-
+                            Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as
+                            indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk
+                            is no longer attached on the next start once set to false.
+                            Defaults to false.
+                          type: boolean
                         blockMultiQueue:
                           description: |-
                             Whether or not to enable virtio multi-queue for block devices.
@@ -19205,6 +22372,28 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  cache:
+                                    description: |-
+                                      Cache sets the caching policy of the shared directory in the guest.
+                                      One of: auto, always, never. Defaults to auto.
+                                    type: string
+                                  daxWindowSize:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: |-
+                                      DAXWindowSize enables DAX for the filesystem and sets the size of the
+                                      shared memory window the guest maps the files into, bypassing its page cache.
+                                      It must be a multiple of 2Mi. DAX is disabled if not set.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  threadPoolSize:
+                                    description: |-
+                                      ThreadPoolSize sets the number of worker threads of the virtiofsd process
+                                      serving the filesystem. Defaults to the virtiofsd default.
+                                    format: int32
+                                    type: integer
                                 type: object
                             required:
                             - name
@@ -19269,6 +22458,25 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        guestPanicCapture:
+                          description: |-
+                            GuestPanicCapture configures what is captured when the guest kernel panics.
+                            A pvpanic device is added if no panic device is provided.
+                          properties:
+                            consoleLogLines:
+                              description: |-
+                                ConsoleLogLines is the number of trailing lines of the serial console log
+                                recorded in the GuestPanicked condition. Requires the serial console log.
+                                Defaults to 20, at most 100.
+                              format: int32
+                              type: integer
+                            memoryDumpClaimName:
+                              description: |-
+                                MemoryDumpClaimName is the name of the PVC the guest memory is dumped to
+                                when the guest kernel panics. The crashed guest is kept until the memory
+                                dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.
+                              type: string
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -19426,6 +22634,20 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              networkBoot:
+                                description: |-
+                                  NetworkBoot makes the interface the first boot device of the vmi.
+                                  The disks and interfaces with a boot order are tried after it, following their boot order.
+                                  Can not be combined with bootOrder.
+                                properties:
+                                  ipxeScriptRef:
+                                    description: |-
+                                      IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.
+                                      The firmware of the interface runs the script instead of fetching the boot file
+                                      offered by the DHCP server of the network.
+                                      Only supported on interfaces with the masquerade binding.
+                                    type: string
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -19530,6 +22752,33 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        serials:
+                          description: |-
+                            Serials defines additional named serial devices.
+                            Each of them is accessible through the console subresource by its name.
+                          items:
+                            description: SerialDevice is an additional serial device
+                              of the VMI.
+                            properties:
+                              name:
+                                description: Name of the serial device, used to select
+                                  it when connecting to the console subresource.
+                                type: string
+                              type:
+                                description: |-
+                                  Type of the serial device. One of: serial, virtio.
+                                  A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a
+                                  virtio console of the guest (e.g. hvc0).
+                                  Defaults to serial.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          maxItems: 8
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -19585,7 +22834,7 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, dump.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -19594,7 +22843,7 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -19967,6 +23216,52 @@ var CRDsValidation map[string]string = map[string]string{
                         serial:
                           description: The system-serial-number in SMBIOS
                           type: string
+                        smbios:
+                          description: |-
+                            SMBIOS defines the system and baseboard information and the OEM strings
+                            reported in the SMBIOS tables of the vmi.
+                            Unset values default to the cluster wide SMBIOS configuration.
+                          properties:
+                            baseBoard:
+                              description: BaseBoard overrides the cluster wide baseboard
+                                information (SMBIOS type 2).
+                              properties:
+                                asset:
+                                  type: string
+                                manufacturer:
+                                  type: string
+                                product:
+                                  type: string
+                                serial:
+                                  type: string
+                                version:
+                                  type: string
+                              type: object
+                            oemStrings:
+                              description: OEMStrings replace the cluster wide OEM
+                                strings (SMBIOS type 11).
+                              items:
+                                type: string
+                              maxItems: 32
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            system:
+                              description: |-
+                                System overrides the cluster wide system information (SMBIOS type 1).
+                                The uuid and the serial are set through the firmware.
+                              properties:
+                                family:
+                                  type: string
+                                manufacturer:
+                                  type: string
+                                product:
+                                  type: string
+                                sku:
+                                  type: string
+                                version:
+                                  type: string
+                              type: object
+                          type: object
                         uuid:
                           description: |-
                             UUID reported by the vmi bios.
@@ -20035,6 +23330,46 @@ var CRDsValidation map[string]string = map[string]string{
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        balloon:
+                          description: |-
+                            Balloon declares the bounds within which the memory balloon is
+                            automatically resized, based on the guest memory usage and the node
+                            memory pressure. Requires the AutoMemoryBalloon feature gate.
+                          properties:
+                            max:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Max is the highest amount of memory the guest can be given back.
+                                Defaults to the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            min:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Min is the lowest amount of memory the
+                                guest can be shrunk to.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - min
+                          type: object
+                        freePageReporting:
+                          description: |-
+                            FreePageReporting determines if the memory balloon reports free guest pages
+                            back to the host.
+                            Enabling it requires free page reporting to be allowed on the cluster and
+                            is not possible for high performance VirtualMachineInstances.
+                            Defaults to the cluster configuration.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                          type: object
                         guest:
                           anyOf:
                           - type: integer
@@ -20054,6 +23389,19 @@ var CRDsValidation map[string]string = map[string]string{
                                 x86_64 architecture valid values are 1Gi and 2Mi.
                               type: string
                           type: object
+                        ksm:
+                          description: |-
+                            KSM determines if the memory of the VirtualMachineInstance can be merged
+                            by Kernel Samepage Merging on nodes where KSM is enabled.
+                            Enabling it requires KSM to be configured on the cluster.
+                            Defaults to true.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                          type: object
                         maxGuest:
                           anyOf:
                           - type: integer
@@ -20063,6 +23411,12 @@ var CRDsValidation map[string]string = map[string]string{
                             The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                           pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                           x-kubernetes-int-or-string: true
+                        overcommitClass:
+                          description: |-
+                            OvercommitClass selects one of the memory overcommit classes defined
+                            in the KubeVirt configuration. The ratio of the class is used instead
+                            of the cluster wide memory overcommit.
+                          type: string
                         reservedOverhead:
                           description: |-
                             ReservedOverhead configures the memory overhead applied to a VM
@@ -20091,6 +23445,41 @@ var CRDsValidation map[string]string = map[string]string{
                               - Required
                               type: string
                           type: object
+                        suspendToDisk:
+                          description: |-
+                            SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage
+                            of the VirtualMachineInstance is sized to also hold the guest memory.
+                            Requires the SuspendToDisk feature gate.
+                          type: boolean
+                      type: object
+                    qemuCommandLine:
+                      description: |-
+                        QEMUCommandLine holds raw arguments appended to the QEMU command line.
+                        The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.
+                        Requires the QEMUArgsPassthrough feature gate.
+                      properties:
+                        args:
+                          description: Args are appended to the QEMU command line
+                            in the given order.
+                          items:
+                            description: QEMUArg is a QEMU command line option and
+                              its value, e.g. "-device" and "pvpanic".
+                            properties:
+                              name:
+                                description: Name is the QEMU option, including its
+                                  leading dash, e.g. "-device".
+                                type: string
+                              value:
+                                description: Value of the option, omitted for options
+                                  without a value.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - args
                       type: object
                     rebootPolicy:
                       description: |-
@@ -20139,6 +23528,13 @@ var CRDsValidation map[string]string = map[string]string{
                   required:
                   - devices
                   type: object
+                evictionMigrationTimeoutSeconds:
+                  description: |-
+                    EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be
+                    migrated on eviction with the "LiveMigrateOrShutdown" eviction strategy, before it is shutdown.
+                    Overrides the cluster wide evictionMigrationTimeout of the migration configuration.
+                  format: int64
+                  type: integer
                 evictionStrategy:
                   description: |-
                     EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -20146,13 +23542,72 @@ var CRDsValidation map[string]string = map[string]string{
                     - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
                     - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
+                    - "LiveMigrateOrShutdown": the same as "LiveMigrateIfPossible", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout.
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestAgentCommands:
+                  description: |-
+                    GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in
+                    the guest. A command must be allowed by both this and the cluster wide configuration.
+                  properties:
+                    allowedCommands:
+                      description: AllowedCommands are the only commands which may
+                        be invoked. All commands are allowed if empty.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                    deniedCommands:
+                      description: DeniedCommands may never be invoked, even if they
+                        are allowed.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                ignition:
+                  description: |-
+                    Ignition provisions CoreOS-family guests with an Ignition config. Unlike the
+                    kubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and
+                    be made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.
+                  properties:
+                    fragments:
+                      description: |-
+                        Fragments make up the Ignition config. A single fragment is passed to the guest as is, several
+                        fragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.
+                      items:
+                        description: IgnitionFragment is a part of the Ignition config.
+                          Exactly one of its sources must be set.
+                        properties:
+                          data:
+                            description: Data is an inline Ignition config.
+                            type: string
+                          volumeName:
+                            description: |-
+                              VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance,
+                              holding the Ignition config under the config.ign key.
+                            type: string
+                        type: object
+                      maxItems: 16
+                      minItems: 1
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    propagation:
+                      description: |-
+                        Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig.
+                        ConfigDrive requires a cloudInitConfigDrive volume without user data.
+                      enum:
+                      - FirmwareConfig
+                      - ConfigDrive
+                      type: string
+                  required:
+                  - fragments
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -20184,6 +23639,18 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentFileExists:
+                      description: |-
+                        GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        path:
+                          description: Path is the absolute path of the file in the
+                            guest.
+                          type: string
+                      required:
+                      - path
+                      type: object
                     guestAgentPing:
                       description: |-
                         GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -20373,6 +23840,29 @@ var CRDsValidation map[string]string = map[string]string{
                     Selector which must match a node's labels for the vmi to be scheduled on that node.
                     More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                   type: object
+                preShutdownHook:
+                  description: |-
+                    PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the
+                    applications of the guest, e.g. to let a database stop cleanly.
+                  properties:
+                    args:
+                      description: Args are passed to the command.
+                      items:
+                        type: string
+                      type: array
+                    command:
+                      description: Command is the path of the executable in the guest,
+                        e.g. a script stopping a database.
+                      type: string
+                    timeoutSeconds:
+                      description: |-
+                        TimeoutSeconds is the part of the termination grace period reserved for the hook.
+                        It must be shorter than terminationGracePeriodSeconds. Defaults to 10.
+                      format: int64
+                      type: integer
+                  required:
+                  - command
+                  type: object
                 priorityClassName:
                   description: |-
                     If specified, indicates the pod's priority.
@@ -20410,6 +23900,18 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentFileExists:
+                      description: |-
+                        GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        path:
+                          description: Path is the absolute path of the file in the
+                            guest.
+                          type: string
+                      required:
+                      - path
+                      type: object
                     guestAgentPing:
                       description: |-
                         GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -20909,6 +24411,12 @@ var CRDsValidation map[string]string = map[string]string{
                           The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                         properties:
+                          generateNetworkData:
+                            description: |-
+                              GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the
+                              VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.
+                              Can not be combined with another networkdata source.
+                            type: boolean
                           networkData:
                             description: NetworkData contains NoCloud inline cloud-init
                               networkdata.
@@ -21326,6 +24834,84 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          sources:
+                            description: |-
+                              Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file
+                              along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be
+                              selected by the items of one of them. Cannot be combined with Secret and ConfigMap.
+                            items:
+                              description: SysprepProjection adds the keys of a Secret
+                                or ConfigMap to a Sysprep disk. Exactly one of Secret
+                                and ConfigMap must be set.
+                              properties:
+                                configMap:
+                                  description: ConfigMap references a ConfigMap in
+                                    the namespace of the VirtualMachineInstance.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                items:
+                                  description: |-
+                                    Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.
+                                    All keys are added under their own name if empty.
+                                  items:
+                                    description: Maps a string key to a path within
+                                      a volume.
+                                    properties:
+                                      key:
+                                        description: key is the key to project.
+                                        type: string
+                                      mode:
+                                        description: |-
+                                          mode is Optional: mode bits used to set permissions on this file.
+                                          Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+                                          YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                          If not specified, the volume defaultMode will be used.
+                                          This might be in conflict with other options that affect the file
+                                          mode, like fsGroup, and the result can be other mode bits set.
+                                        format: int32
+                                        type: integer
+                                      path:
+                                        description: |-
+                                          path is the relative path of the file to map the key to.
+                                          May not be an absolute path.
+                                          May not contain the path element '..'.
+                                          May not start with the string '..'.
+                                        type: string
+                                    required:
+                                    - key
+                                    - path
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                secret:
+                                  description: Secret references a k8s Secret in the
+                                    namespace of the VirtualMachineInstance.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                              type: object
+                            maxItems: 16
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                     required:
                     - name
@@ -21454,6 +25040,44 @@ var CRDsValidation map[string]string = map[string]string{
             numa:
               description: NUMA allows specifying settings for the guest NUMA topology
               properties:
+                cells:
+                  description: |-
+                    Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                    Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                  items:
+                    description: NUMACell declares a virtual numa node of the guest.
+                    properties:
+                      cpus:
+                        description: CPUs is the list of vCPUs of the numa node, e.g.
+                          "0-3,6".
+                        type: string
+                      memory:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Memory is the amount of guest memory of the numa
+                          node.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - cpus
+                    - memory
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                evenSplit:
+                  description: |-
+                    EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                    split the vCPUs and the guest memory evenly between them.
+                  properties:
+                    nodes:
+                      description: Nodes is the number of virtual numa nodes of the
+                        guest.
+                      format: int32
+                      type: integer
+                  required:
+                  - nodes
+                  type: object
                 guestMappingPassthrough:
                   description: |-
                     GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -21469,6 +25093,14 @@ var CRDsValidation map[string]string = map[string]string{
                     Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                     Example: "0-3,^1","0,2,3","2-3"
                   type: string
+                profile:
+                  description: |-
+                    Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                    The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                    Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                    the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                    Hugepages still need to be requested explicitly.
+                  type: string
               type: object
           required:
           - guest
@@ -21696,6 +25328,1369 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        antiAffinity:
+          description: AntiAffinity keeps the VMs of the pool apart, placing at most
+            one of them in each topology domain, e.g. zone or host
+          items:
+            description: VirtualMachinePoolAntiAffinity keeps the VMs of a pool in
+              distinct domains of a topology
+            properties:
+              preferred:
+                description: |-
+                  Preferred turns the anti-affinity into a scheduling preference, placing several VMs of the pool in a domain
+                  when there are not enough domains. By default such VMs are not scheduled.
+                type: boolean
+              topologyKey:
+                description: TopologyKey is the key of the node labels defining the
+                  topology domains, e.g. kubernetes.io/hostname
+                type: string
+            required:
+            - topologyKey
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        architectureVariants:
+          description: |-
+            ArchitectureVariants adapt the VM template to several architectures, so that the VMs of the pool
+            run on nodes of each of them
+          items:
+            description: VirtualMachinePoolArchitectureVariant adapts the VM template
+              of a pool to the VMs running on an architecture
+            properties:
+              architecture:
+                description: Architecture of the VMs created from the variant, e.g.
+                  amd64 or arm64
+                type: string
+              dataVolumeTemplates:
+                description: DataVolumeTemplates replace the dataVolumeTemplates of
+                  the VM template with the same name
+                items:
+                  nullable: true
+                  properties:
+                    apiVersion:
+                      description: |-
+                        APIVersion defines the versioned schema of this representation of an object.
+                        Servers should convert recognized schemas to the latest internal value, and
+                        may reject unrecognized values.
+                        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+                      type: string
+                    kind:
+                      description: |-
+                        Kind is a string value representing the REST resource this object represents.
+                        Servers may infer this from the endpoint the client submits requests to.
+                        Cannot be updated.
+                        In CamelCase.
+                        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+                      type: string
+                    metadata:
+                      nullable: true
+                      type: object
+                      x-kubernetes-preserve-unknown-fields: true
+                    spec:
+                      description: DataVolumeSpec contains the DataVolume specification.
+                      properties:
+                        checkpoints:
+                          description: Checkpoints is a list of DataVolumeCheckpoints,
+                            representing stages in a multistage import.
+                          items:
+                            description: DataVolumeCheckpoint defines a stage in
+                              a warm migration.
+                            properties:
+                              current:
+                                description: Current is the identifier of the snapshot
+                                  created for this checkpoint.
+                                type: string
+                              previous:
+                                description: Previous is the identifier of the snapshot
+                                  from the previous checkpoint.
+                                type: string
+                            required:
+                            - current
+                            - previous
+                            type: object
+                          type: array
+                        contentType:
+                          description: 'DataVolumeContentType options: "kubevirt",
+                            "archive"'
+                          enum:
+                          - kubevirt
+                          - archive
+                          type: string
+                        finalCheckpoint:
+                          description: FinalCheckpoint indicates whether the current
+                            DataVolumeCheckpoint is the final checkpoint.
+                          type: boolean
+                        preallocation:
+                          description: Preallocation controls whether storage for
+                            DataVolumes should be allocated in advance.
+                          type: boolean
+                        priorityClassName:
+                          description: PriorityClassName for Importer, Cloner and
+                            Uploader pod
+                          type: string
+                        pvc:
+                          description: PVC is the PVC specification
+                          properties:
+                            accessModes:
+                              description: |-
+                                accessModes contains the desired access modes the volume should have.
+                                More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            dataSource:
+                              description: |-
+                                dataSource field can be used to specify either:
+                                * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+                                * An existing PVC (PersistentVolumeClaim)
+                                If the provisioner or an external controller can support the specified data source,
+                                it will create a new volume based on the contents of the specified data source.
+                                When the AnyVolumeDataSource feature gate is enabled, dataSource contents will be copied to dataSourceRef,
+                                and dataSourceRef contents will be copied to dataSource when dataSourceRef.namespace is not specified.
+                                If the namespace is specified, then dataSourceRef will not be copied to dataSource.
+                              properties:
+                                apiGroup:
+                                  description: |-
+                                    APIGroup is the group for the resource being referenced.
+                                    If APIGroup is not specified, the specified Kind must be in the core API group.
+                                    For any other third-party types, APIGroup is required.
+                                  type: string
+                                kind:
+                                  description: Kind is the type of resource being
+                                    referenced
+                                  type: string
+                                name:
+                                  description: Name is the name of resource being
+                                    referenced
+                                  type: string
+                              required:
+                              - kind
+                              - name
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            dataSourceRef:
+                              description: |-
+                                dataSourceRef specifies the object from which to populate the volume with data, if a non-empty
+                                volume is desired. This may be any object from a non-empty API group (non
+                                core object) or a PersistentVolumeClaim object.
+                                When this field is specified, volume binding will only succeed if the type of
+                                the specified object matches some installed volume populator or dynamic
+                                provisioner.
+                                This field will replace the functionality of the dataSource field and as such
+                                if both fields are non-empty, they must have the same value. For backwards
+                                compatibility, when namespace isn't specified in dataSourceRef,
+                                both fields (dataSource and dataSourceRef) will be set to the same
+                                value automatically if one of them is empty and the other is non-empty.
+                                When namespace is specified in dataSourceRef,
+                                dataSource isn't set to the same value and must be empty.
+                                There are three important differences between dataSource and dataSourceRef:
+                                * While dataSource only allows two specific types of objects, dataSourceRef
+                                  allows any non-core object, as well as PersistentVolumeClaim objects.
+                                * While dataSource ignores disallowed values (dropping them), dataSourceRef
+                                  preserves all values, and generates an error if a disallowed value is
+                                  specified.
+                                * While dataSource only allows local objects, dataSourceRef allows objects
+                                  in any namespaces.
+                                (Beta) Using this field requires the AnyVolumeDataSource feature gate to be enabled.
+                                (Alpha) Using the namespace field of dataSourceRef requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+                              properties:
+                                apiGroup:
+                                  description: |-
+                                    APIGroup is the group for the resource being referenced.
+                                    If APIGroup is not specified, the specified Kind must be in the core API group.
+                                    For any other third-party types, APIGroup is required.
+                                  type: string
+                                kind:
+                                  description: Kind is the type of resource being
+                                    referenced
+                                  type: string
+                                name:
+                                  description: Name is the name of resource being
+                                    referenced
+                                  type: string
+                                namespace:
+                                  description: |-
+                                    Namespace is the namespace of resource being referenced
+                                    Note that when a namespace is specified, a gateway.networking.k8s.io/ReferenceGrant object is required in the referent namespace to allow that namespace's owner to accept the reference. See the ReferenceGrant documentation for details.
+                                    (Alpha) This field requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+                                  type: string
+                              required:
+                              - kind
+                              - name
+                              type: object
+                            resources:
+                              description: |-
+                                resources represents the minimum resources the volume should have.
+                                If RecoverVolumeExpansionFailure feature is enabled users are allowed to specify resource requirements
+                                that are lower than previous value but must still be higher than capacity recorded in the
+                                status field of the claim.
+                                More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources
+                              properties:
+                                limits:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: |-
+                                    Limits describes the maximum amount of compute resources allowed.
+                                    More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                                  type: object
+                                requests:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: |-
+                                    Requests describes the minimum amount of compute resources required.
+                                    If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                                    otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                                    More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                                  type: object
+                              type: object
+                            selector:
+                              description: selector is a label query over volumes
+                                to consider for binding.
+                              properties:
+                                matchExpressions:
+                                  description: matchExpressions is a list of label
+                                    selector requirements. The requirements are
+                                    ANDed.
+                                  items:
+                                    description: |-
+                                      A label selector requirement is a selector that contains values, a key, and an operator that
+                                      relates the key and values.
+                                    properties:
+                                      key:
+                                        description: key is the label key that the
+                                          selector applies to.
+                                        type: string
+                                      operator:
+                                        description: |-
+                                          operator represents a key's relationship to a set of values.
+                                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                                        type: string
+                                      values:
+                                        description: |-
+                                          values is an array of string values. If the operator is In or NotIn,
+                                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                          the values array must be empty. This array is replaced during a strategic
+                                          merge patch.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    required:
+                                    - key
+                                    - operator
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                matchLabels:
+                                  additionalProperties:
+                                    type: string
+                                  description: |-
+                                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                  type: object
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            storageClassName:
+                              description: |-
+                                storageClassName is the name of the StorageClass required by the claim.
+                                More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1
+                              type: string
+                            volumeAttributesClassName:
+                              description: |-
+                                volumeAttributesClassName may be used to set the VolumeAttributesClass used by this claim.
+                                If specified, the CSI driver will create or update the volume with the attributes defined
+                                in the corresponding VolumeAttributesClass. This has a different purpose than storageClassName,
+                                it can be changed after the claim is created. An empty string or nil value indicates that no
+                                VolumeAttributesClass will be applied to the claim. If the claim enters an Infeasible error state,
+                                this field can be reset to its previous value (including nil) to cancel the modification.
+                                If the resource referred to by volumeAttributesClass does not exist, this PersistentVolumeClaim will be
+                                set to a Pending state, as reflected by the modifyVolumeStatus field, until such as a resource
+                                exists.
+                                More info: https://kubernetes.io/docs/concepts/storage/volume-attributes-classes/
+                              type: string
+                            volumeMode:
+                              description: |-
+                                volumeMode defines what type of volume is required by the claim.
+                                Value of Filesystem is implied when not included in claim spec.
+                              type: string
+                            volumeName:
+                              description: volumeName is the binding reference to
+                                the PersistentVolume backing this claim.
+                              type: string
+                          type: object
+                        source:
+                          description: Source is the src of the data for the requested
+                            DataVolume
+                          properties:
+                            blank:
+                              description: DataVolumeBlankImage provides the parameters
+                                to create a new raw blank image for the PVC
+                              type: object
+                            gcs:
+                              description: DataVolumeSourceGCS provides the parameters
+                                to create a Data Volume from an GCS source
+                              properties:
+                                secretRef:
+                                  description: SecretRef provides the secret reference
+                                    needed to access the GCS source
+                                  type: string
+                                url:
+                                  description: URL is the url of the GCS source
+                                  type: string
+                              required:
+                              - url
+                              type: object
+                            http:
+                              description: DataVolumeSourceHTTP can be either an
+                                http or https endpoint, with an optional basic auth
+                                user name and password, and an optional configmap
+                                containing additional CAs
+                              properties:
+                                certConfigMap:
+                                  description: CertConfigMap is a configmap reference,
+                                    containing a Certificate Authority(CA) public
+                                    key, and a base64 encoded pem certificate
+                                  type: string
+                                extraHeaders:
+                                  description: ExtraHeaders is a list of strings
+                                    containing extra headers to include with HTTP
+                                    transfer requests
+                                  items:
+                                    type: string
+                                  type: array
+                                secretExtraHeaders:
+                                  description: SecretExtraHeaders is a list of Secret
+                                    references, each containing an extra HTTP header
+                                    that may include sensitive information
+                                  items:
+                                    type: string
+                                  type: array
+                                secretRef:
+                                  description: SecretRef A Secret reference, the
+                                    secret should contain accessKeyId (user name)
+                                    base64 encoded, and secretKey (password) also
+                                    base64 encoded
+                                  type: string
+                                url:
+                                  description: URL is the URL of the http(s) endpoint
+                                  type: string
+                              required:
+                              - url
+                              type: object
+                            imageio:
+                              description: DataVolumeSourceImageIO provides the
+                                parameters to create a Data Volume from an imageio
+                                source
+                              properties:
+                                certConfigMap:
+                                  description: CertConfigMap provides a reference
+                                    to the CA cert
+                                  type: string
+                                diskId:
+                                  description: DiskID provides id of a disk to be
+                                    imported
+                                  type: string
+                                insecureSkipVerify:
+                                  description: InsecureSkipVerify is a flag to skip
+                                    certificate verification
+                                  type: boolean
+                                secretRef:
+                                  description: SecretRef provides the secret reference
+                                    needed to access the ovirt-engine
+                                  type: string
+                                url:
+                                  description: URL is the URL of the ovirt-engine
+                                  type: string
+                              required:
+                              - diskId
+                              - url
+                              type: object
+                            pvc:
+                              description: DataVolumeSourcePVC provides the parameters
+                                to create a Data Volume from an existing PVC
+                              properties:
+                                name:
+                                  description: The name of the source PVC
+                                  type: string
+                                namespace:
+                                  description: The namespace of the source PVC
+                                  type: string
+                              required:
+                              - name
+                              - namespace
+                              type: object
+                            registry:
+                              description: DataVolumeSourceRegistry provides the
+                                parameters to create a Data Volume from an registry
+                                source
+                              properties:
+                                certConfigMap:
+                                  description: CertConfigMap provides a reference
+                                    to the Registry certs
+                                  type: string
+                                imageStream:
+                                  description: ImageStream is the name of image
+                                    stream for import
+                                  type: string
+                                platform:
+                                  description: Platform describes the minimum runtime
+                                    requirements of the image
+                                  properties:
+                                    architecture:
+                                      description: Architecture specifies the image
+                                        target CPU architecture
+                                      type: string
+                                  type: object
+                                pullMethod:
+                                  description: PullMethod can be either "pod" (default
+                                    import), or "node" (node docker cache based
+                                    import)
+                                  type: string
+                                secretRef:
+                                  description: SecretRef provides the secret reference
+                                    needed to access the Registry source
+                                  type: string
+                                url:
+                                  description: 'URL is the url of the registry source
+                                    (starting with the scheme: docker, oci-archive)'
+                                  type: string
+                              type: object
+                            s3:
+                              description: DataVolumeSourceS3 provides the parameters
+                                to create a Data Volume from an S3 source
+                              properties:
+                                certConfigMap:
+                                  description: CertConfigMap is a configmap reference,
+                                    containing a Certificate Authority(CA) public
+                                    key, and a base64 encoded pem certificate
+                                  type: string
+                                secretRef:
+                                  description: SecretRef provides the secret reference
+                                    needed to access the S3 source
+                                  type: string
+                                url:
+                                  description: URL is the url of the S3 source
+                                  type: string
+                              required:
+                              - url
+                              type: object
+                            snapshot:
+                              description: DataVolumeSourceSnapshot provides the
+                                parameters to create a Data Volume from an existing
+                                VolumeSnapshot
+                              properties:
+                                name:
+                                  description: The name of the source VolumeSnapshot
+                                  type: string
+                                namespace:
+                                  description: The namespace of the source VolumeSnapshot
+                                  type: string
+                              required:
+                              - name
+                              - namespace
+                              type: object
+                            upload:
+                              description: DataVolumeSourceUpload provides the parameters
+                                to create a Data Volume by uploading the source
+                              type: object
+                            vddk:
+                              description: DataVolumeSourceVDDK provides the parameters
+                                to create a Data Volume from a Vmware source
+                              properties:
+                                backingFile:
+                                  description: BackingFile is the path to the virtual
+                                    hard disk to migrate from vCenter/ESXi
+                                  type: string
+                                extraArgs:
+                                  description: ExtraArgs is a reference to a ConfigMap
+                                    containing extra arguments to pass directly
+                                    to the VDDK library
+                                  type: string
+                                initImageURL:
+                                  description: InitImageURL is an optional URL to
+                                    an image containing an extracted VDDK library,
+                                    overrides v2v-vmware config map
+                                  type: string
+                                secretRef:
+                                  description: SecretRef provides a reference to
+                                    a secret containing the username and password
+                                    needed to access the vCenter or ESXi host
+                                  type: string
+                                thumbprint:
+                                  description: Thumbprint is the certificate thumbprint
+                                    of the vCenter or ESXi host
+                                  type: string
+                                url:
+                                  description: URL is the URL of the vCenter or
+                                    ESXi host with the VM to migrate
+                                  type: string
+                                uuid:
+                                  description: UUID is the UUID of the virtual machine
+                                    that the backing file is attached to in vCenter/ESXi
+                                  type: string
+                              type: object
+                          type: object
+                        sourceRef:
+                          description: SourceRef is an indirect reference to the
+                            source of data for the requested DataVolume
+                          properties:
+                            kind:
+                              description: The kind of the source reference, currently
+                                only "DataSource" is supported
+                              type: string
+                            name:
+                              description: The name of the source reference
+                              type: string
+                            namespace:
+                              description: The namespace of the source reference,
+                                defaults to the DataVolume namespace
+                              type: string
+                          required:
+                          - kind
+                          - name
+                          type: object
+                        storage:
+                          description: Storage is the requested storage specification
+                          properties:
+                            accessModes:
+                              description: |-
+                                AccessModes contains the desired access modes the volume should have.
+                                More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
+                              items:
+                                type: string
+                              type: array
+                            dataSource:
+                              description: |-
+                                This field can be used to specify either: * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot) * An existing PVC (PersistentVolumeClaim) * An existing custom resource that implements data population (Alpha) In order to use custom resource types that implement data population, the AnyVolumeDataSource feature gate must be enabled. If the provisioner or an external controller can support the specified data source, it will create a new volume based on the contents of the specified data source.
+                                If the AnyVolumeDataSource feature gate is enabled, this field will always have the same contents as the DataSourceRef field.
+                              properties:
+                                apiGroup:
+                                  description: |-
+                                    APIGroup is the group for the resource being referenced.
+                                    If APIGroup is not specified, the specified Kind must be in the core API group.
+                                    For any other third-party types, APIGroup is required.
+                                  type: string
+                                kind:
+                                  description: Kind is the type of resource being
+                                    referenced
+                                  type: string
+                                name:
+                                  description: Name is the name of resource being
+                                    referenced
+                                  type: string
+                              required:
+                              - kind
+                              - name
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            dataSourceRef:
+                              description: |-
+                                Specifies the object from which to populate the volume with data, if a non-empty volume is desired. This may be any local object from a non-empty API group (non core object) or a PersistentVolumeClaim object. When this field is specified, volume binding will only succeed if the type of the specified object matches some installed volume populator or dynamic provisioner.
+                                This field will replace the functionality of the DataSource field and as such if both fields are non-empty, they must have the same value. For backwards compatibility, both fields (DataSource and DataSourceRef) will be set to the same value automatically if one of them is empty and the other is non-empty.
+                                There are two important differences between DataSource and DataSourceRef:
+                                * While DataSource only allows two specific types of objects, DataSourceRef allows any non-core object, as well as PersistentVolumeClaim objects.
+                                * While DataSource ignores disallowed values (dropping them), DataSourceRef preserves all values, and generates an error if a disallowed value is specified.
+                                (Beta) Using this field requires the AnyVolumeDataSource feature gate to be enabled.
+                              properties:
+                                apiGroup:
+                                  description: |-
+                                    APIGroup is the group for the resource being referenced.
+                                    If APIGroup is not specified, the specified Kind must be in the core API group.
+                                    For any other third-party types, APIGroup is required.
+                                  type: string
+                                kind:
+                                  description: Kind is the type of resource being
+                                    referenced
+                                  type: string
+                                name:
+                                  description: Name is the name of resource being
+                                    referenced
+                                  type: string
+                                namespace:
+                                  description: |-
+                                    Namespace is the namespace of resource being referenced
+                                    Note that when a namespace is specified, a gateway.networking.k8s.io/ReferenceGrant object is required in the referent namespace to allow that namespace's owner to accept the reference. See the ReferenceGrant documentation for details.
+                                    (Alpha) This field requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+                                  type: string
+                              required:
+                              - kind
+                              - name
+                              type: object
+                            resources:
+                              description: |-
+                                Resources represents the minimum resources the volume should have.
+                                More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources
+                              properties:
+                                limits:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: |-
+                                    Limits describes the maximum amount of compute resources allowed.
+                                    More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                                  type: object
+                                requests:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: |-
+                                    Requests describes the minimum amount of compute resources required.
+                                    If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                                    otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                                    More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                                  type: object
+                              type: object
+                            selector:
+                              description: A label query over volumes to consider
+                                for binding.
+                              properties:
+                                matchExpressions:
+                                  description: matchExpressions is a list of label
+                                    selector requirements. The requirements are
+                                    ANDed.
+                                  items:
+                                    description: |-
+                                      A label selector requirement is a selector that contains values, a key, and an operator that
+                                      relates the key and values.
+                                    properties:
+                                      key:
+                                        description: key is the label key that the
+                                          selector applies to.
+                                        type: string
+                                      operator:
+                                        description: |-
+                                          operator represents a key's relationship to a set of values.
+                                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                                        type: string
+                                      values:
+                                        description: |-
+                                          values is an array of string values. If the operator is In or NotIn,
+                                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                          the values array must be empty. This array is replaced during a strategic
+                                          merge patch.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    required:
+                                    - key
+                                    - operator
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                matchLabels:
+                                  additionalProperties:
+                                    type: string
+                                  description: |-
+                                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                  type: object
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            storageClassName:
+                              description: |-
+                                Name of the StorageClass required by the claim.
+                                More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1
+                              type: string
+                            volumeMode:
+                              description: |-
+                                volumeMode defines what type of volume is required by the claim.
+                                Value of Filesystem is implied when not included in claim spec.
+                              type: string
+                            volumeName:
+                              description: VolumeName is the binding reference to
+                                the PersistentVolume backing this claim.
+                              type: string
+                          type: object
+                      type: object
+                    status:
+                      description: |-
+                        DataVolumeTemplateDummyStatus is here simply for backwards compatibility with
+                        a previous API.
+                      nullable: true
+                      type: object
+                  required:
+                  - spec
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              instancetype:
+                description: Instancetype replaces the instancetype matcher of the VM
+                  template
+                properties:
+                  inferFromVolume:
+                    description: |-
+                      InferFromVolume lists the name of a volume that should be used to infer or discover the instancetype
+                      to be used through known annotations on the underlying resource. Once applied to the InstancetypeMatcher
+                      this field is removed.
+                    type: string
+                  inferFromVolumeFailurePolicy:
+                    description: |-
+                      InferFromVolumeFailurePolicy controls what should happen on failure when inferring the instancetype.
+                      Allowed values are: "RejectInferFromVolumeFailure" and "IgnoreInferFromVolumeFailure".
+                      If not specified, "RejectInferFromVolumeFailure" is used by default.
+                    type: string
+                  kind:
+                    description: |-
+                      Kind specifies which instancetype resource is referenced.
+                      Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype".
+                      If not specified, "VirtualMachineClusterInstancetype" is used by default.
+                    type: string
+                  name:
+                    description: Name is the name of the VirtualMachineInstancetype
+                      or VirtualMachineClusterInstancetype
+                    type: string
+                  revisionName:
+                    description: |-
+                      RevisionName specifies a ControllerRevision containing a specific copy of the
+                      VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially
+                      captured the first time the instancetype is applied to the VirtualMachineInstance.
+                    type: string
+                type: object
+              preference:
+                description: Preference replaces the preference matcher of the VM template
+                properties:
+                  inferFromVolume:
+                    description: |-
+                      InferFromVolume lists the name of a volume that should be used to infer or discover the preference
+                      to be used through known annotations on the underlying resource. Once applied to the PreferenceMatcher
+                      this field is removed.
+                    type: string
+                  inferFromVolumeFailurePolicy:
+                    description: |-
+                      InferFromVolumeFailurePolicy controls what should happen on failure when preference the instancetype.
+                      Allowed values are: "RejectInferFromVolumeFailure" and "IgnoreInferFromVolumeFailure".
+                      If not specified, "RejectInferFromVolumeFailure" is used by default.
+                    type: string
+                  kind:
+                    description: |-
+                      Kind specifies which preference resource is referenced.
+                      Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference".
+                      If not specified, "VirtualMachineClusterPreference" is used by default.
+                    type: string
+                  name:
+                    description: Name is the name of the VirtualMachinePreference
+                      or VirtualMachineClusterPreference
+                    type: string
+                  revisionName:
+                    description: |-
+                      RevisionName specifies a ControllerRevision containing a specific copy of the
+                      VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is
+                      initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+                    type: string
+                type: object
+              volumes:
+                description: |-
+                  Volumes replace the volumes of the VM template with the same name, e.g. with the containerDisk
+                  image built for the architecture
+                items:
+                  description: Volume represents a named volume in a vmi.
+                  properties:
+                    cloudInitConfigDrive:
+                      description: |-
+                        CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
+                        The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
+                        More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
+                      properties:
+                        networkData:
+                          description: NetworkData contains config drive
+                            inline cloud-init networkdata.
+                          type: string
+                        networkDataBase64:
+                          description: NetworkDataBase64 contains config
+                            drive cloud-init networkdata as a base64 encoded
+                            string.
+                          type: string
+                        networkDataSecretRef:
+                          description: NetworkDataSecretRef references a
+                            k8s secret that contains config drive networkdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        secretRef:
+                          description: UserDataSecretRef references a k8s
+                            secret that contains config drive userdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        userData:
+                          description: UserData contains config drive inline
+                            cloud-init userdata.
+                          type: string
+                        userDataBase64:
+                          description: UserDataBase64 contains config drive
+                            cloud-init userdata as a base64 encoded string.
+                          type: string
+                      type: object
+                    cloudInitNoCloud:
+                      description: |-
+                        CloudInitNoCloud represents a cloud-init NoCloud user-data source.
+                        The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
+                        More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
+                      properties:
+                        generateNetworkData:
+                          description: |-
+                            GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the
+                            VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.
+                            Can not be combined with another networkdata source.
+                          type: boolean
+                        networkData:
+                          description: NetworkData contains NoCloud inline
+                            cloud-init networkdata.
+                          type: string
+                        networkDataBase64:
+                          description: NetworkDataBase64 contains NoCloud
+                            cloud-init networkdata as a base64 encoded string.
+                          type: string
+                        networkDataSecretRef:
+                          description: NetworkDataSecretRef references a
+                            k8s secret that contains NoCloud networkdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        secretRef:
+                          description: UserDataSecretRef references a k8s
+                            secret that contains NoCloud userdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        userData:
+                          description: UserData contains NoCloud inline
+                            cloud-init userdata.
+                          type: string
+                        userDataBase64:
+                          description: UserDataBase64 contains NoCloud cloud-init
+                            userdata as a base64 encoded string.
+                          type: string
+                      type: object
+                    configMap:
+                      description: |-
+                        ConfigMapSource represents a reference to a ConfigMap in the same namespace.
+                        More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                        optional:
+                          description: Specify whether the ConfigMap or
+                            it's keys must be defined
+                          type: boolean
+                        volumeLabel:
+                          description: |-
+                            The volume label of the resulting disk inside the VMI.
+                            Different bootstrapping mechanisms require different values.
+                            Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    containerDisk:
+                      description: |-
+                        ContainerDisk references a docker image, embedding a qcow or raw disk.
+                        More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html
+                      properties:
+                        image:
+                          description: Image is the name of the image with
+                            the embedded disk.
+                          type: string
+                        imagePullPolicy:
+                          description: |-
+                            Image pull policy.
+                            One of Always, Never, IfNotPresent.
+                            Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                            Cannot be updated.
+                            More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
+                          type: string
+                        imagePullSecret:
+                          description: ImagePullSecret is the name of the
+                            Docker registry secret required to pull the
+                            image. The secret must already exist.
+                          type: string
+                        path:
+                          description: Path defines the path to disk file
+                            in the container
+                          type: string
+                      required:
+                      - image
+                      type: object
+                    containerPath:
+                      description: |-
+                        ContainerPath exposes a path from the virt-launcher container to the VM via virtiofs.
+                        The path must correspond to an existing volumeMount in the compute container.
+                      properties:
+                        path:
+                          description: |-
+                            Path is the absolute path within the virt-launcher container to expose to the VM.
+                            The path must correspond to an existing volumeMount in the compute container.
+                          maxLength: 4096
+                          type: string
+                          x-kubernetes-validations:
+                          - message: path must be absolute (start with '/')
+                            rule: self.startsWith('/')
+                          - message: path must not contain '..'
+                            rule: '!self.contains(''..'')'
+                        readOnly:
+                          default: true
+                          description: |-
+                            ReadOnly controls whether the volume is exposed as read-only to the VM.
+                            Defaults to true. Write access is not currently supported.
+                          type: boolean
+                          x-kubernetes-validations:
+                          - message: readOnly must be true, write access
+                              is not supported
+                            rule: self == true
+                      required:
+                      - path
+                      type: object
+                    dataVolume:
+                      description: |-
+                        DataVolume represents the dynamic creation a PVC for this volume as well as
+                        the process of populating that PVC with a disk image.
+                      properties:
+                        hotpluggable:
+                          description: Hotpluggable indicates whether the
+                            volume can be hotplugged and hotunplugged.
+                          type: boolean
+                        name:
+                          description: Name of both the DataVolume and the
+                            PVC in the same namespace.
+                          type: string
+                      required:
+                      - name
+                      type: object
+                    downwardAPI:
+                      description: DownwardAPI represents downward API about
+                        the pod that should populate this volume
+                      properties:
+                        fields:
+                          description: Fields is a list of downward API
+                            volume file
+                          items:
+                            description: DownwardAPIVolumeFile represents
+                              information to create the file containing
+                              the pod field
+                            properties:
+                              fieldRef:
+                                description: 'Required: Selects a field
+                                  of the pod: only annotations, labels,
+                                  name, namespace and uid are supported.'
+                                properties:
+                                  apiVersion:
+                                    description: Version of the schema the
+                                      FieldPath is written in terms of,
+                                      defaults to "v1".
+                                    type: string
+                                  fieldPath:
+                                    description: Path of the field to select
+                                      in the specified API version.
+                                    type: string
+                                required:
+                                - fieldPath
+                                type: object
+                                x-kubernetes-map-type: atomic
+                              mode:
+                                description: |-
+                                  Optional: mode bits used to set permissions on this file, must be an octal value
+                                  between 0000 and 0777 or a decimal value between 0 and 511.
+                                  YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                  If not specified, the volume defaultMode will be used.
+                                  This might be in conflict with other options that affect the file
+                                  mode, like fsGroup, and the result can be other mode bits set.
+                                format: int32
+                                type: integer
+                              path:
+                                description: 'Required: Path is  the relative
+                                  path name of the file to be created. Must
+                                  not be absolute or contain the ''..''
+                                  path. Must be utf-8 encoded. The first
+                                  item of the relative path must not start
+                                  with ''..'''
+                                type: string
+                              resourceFieldRef:
+                                description: |-
+                                  Selects a resource of the container: only resources limits and requests
+                                  (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.
+                                properties:
+                                  containerName:
+                                    description: 'Container name: required
+                                      for volumes, optional for env vars'
+                                    type: string
+                                  divisor:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Specifies the output format
+                                      of the exposed resources, defaults
+                                      to "1"
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  resource:
+                                    description: 'Required: resource to
+                                      select'
+                                    type: string
+                                required:
+                                - resource
+                                type: object
+                                x-kubernetes-map-type: atomic
+                            required:
+                            - path
+                            type: object
+                          type: array
+                        volumeLabel:
+                          description: |-
+                            The volume label of the resulting disk inside the VMI.
+                            Different bootstrapping mechanisms require different values.
+                            Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                          type: string
+                      type: object
+                    downwardMetrics:
+                      description: |-
+                        DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
+                        metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                      type: object
+                    emptyDisk:
+                      description: |-
+                        EmptyDisk represents a temporary disk which shares the vmis lifecycle.
+                        More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html
+                      properties:
+                        capacity:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Capacity of the sparse disk.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - capacity
+                      type: object
+                    ephemeral:
+                      description: Ephemeral is a special volume source
+                        that "wraps" specified source and provides copy-on-write
+                        image on top of it.
+                      properties:
+                        persistentVolumeClaim:
+                          description: |-
+                            PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+                            Directly attached to the vmi via qemu.
+                            More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                          properties:
+                            claimName:
+                              description: |-
+                                claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                                More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                              type: string
+                            readOnly:
+                              description: |-
+                                readOnly Will force the ReadOnly setting in VolumeMounts.
+                                Default false.
+                              type: boolean
+                          required:
+                          - claimName
+                          type: object
+                      type: object
+                    hostDisk:
+                      description: HostDisk represents a disk created on
+                        the cluster level
+                      properties:
+                        capacity:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Capacity of the sparse disk
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        path:
+                          description: The path to HostDisk image located
+                            on the cluster
+                          type: string
+                        shared:
+                          description: Shared indicate whether the path
+                            is shared between nodes
+                          type: boolean
+                        type:
+                          description: |-
+                            Contains information if disk.img exists or should be created
+                            allowed options are 'Disk' and 'DiskOrCreate'
+                          type: string
+                      required:
+                      - path
+                      - type
+                      type: object
+                    memoryDump:
+                      description: MemoryDump is attached to the virt launcher
+                        and is populated with a memory dump of the vmi
+                      properties:
+                        claimName:
+                          description: |-
+                            claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                            More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                          type: string
+                        hotpluggable:
+                          description: Hotpluggable indicates whether the
+                            volume can be hotplugged and hotunplugged.
+                          type: boolean
+                        readOnly:
+                          description: |-
+                            readOnly Will force the ReadOnly setting in VolumeMounts.
+                            Default false.
+                          type: boolean
+                      required:
+                      - claimName
+                      type: object
+                    name:
+                      description: |-
+                        Volume's name.
+                        Must be a DNS_LABEL and unique within the vmi.
+                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                      type: string
+                    persistentVolumeClaim:
+                      description: |-
+                        PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+                        Directly attached to the vmi via qemu.
+                        More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                      properties:
+                        claimName:
+                          description: |-
+                            claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                            More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                          type: string
+                        hotpluggable:
+                          description: Hotpluggable indicates whether the
+                            volume can be hotplugged and hotunplugged.
+                          type: boolean
+                        readOnly:
+                          description: |-
+                            readOnly Will force the ReadOnly setting in VolumeMounts.
+                            Default false.
+                          type: boolean
+                      required:
+                      - claimName
+                      type: object
+                    secret:
+                      description: |-
+                        SecretVolumeSource represents a reference to a secret data in the same namespace.
+                        More info: https://kubernetes.io/docs/concepts/configuration/secret/
+                      properties:
+                        optional:
+                          description: Specify whether the Secret or it's
+                            keys must be defined
+                          type: boolean
+                        secretName:
+                          description: |-
+                            Name of the secret in the pod's namespace to use.
+                            More info: https://kubernetes.io/docs/concepts/storage/volumes#secret
+                          type: string
+                        volumeLabel:
+                          description: |-
+                            The volume label of the resulting disk inside the VMI.
+                            Different bootstrapping mechanisms require different values.
+                            Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                          type: string
+                      type: object
+                    serviceAccount:
+                      description: |-
+                        ServiceAccountVolumeSource represents a reference to a service account.
+                        There can only be one volume of this type!
+                        More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+                      properties:
+                        serviceAccountName:
+                          description: |-
+                            Name of the service account in the pod's namespace to use.
+                            More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+                          type: string
+                      type: object
+                    sysprep:
+                      description: Represents a Sysprep volume source.
+                      properties:
+                        configMap:
+                          description: ConfigMap references a ConfigMap
+                            that contains Sysprep answer file named autounattend.xml
+                            that should be attached as disk of CDROM type.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        secret:
+                          description: Secret references a k8s Secret that
+                            contains Sysprep answer file named autounattend.xml
+                            that should be attached as disk of CDROM type.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        sources:
+                          description: |-
+                            Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file
+                            along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be
+                            selected by the items of one of them. Cannot be combined with Secret and ConfigMap.
+                          items:
+                            description: SysprepProjection adds the keys
+                              of a Secret or ConfigMap to a Sysprep disk.
+                              Exactly one of Secret and ConfigMap must be
+                              set.
+                            properties:
+                              configMap:
+                                description: ConfigMap references a ConfigMap
+                                  in the namespace of the VirtualMachineInstance.
+                                properties:
+                                  name:
+                                    default: ""
+                                    description: |-
+                                      Name of the referent.
+                                      This field is effectively required, but due to backwards compatibility is
+                                      allowed to be empty. Instances of this type with an empty value here are
+                                      almost certainly wrong.
+                                      More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    type: string
+                                type: object
+                                x-kubernetes-map-type: atomic
+                              items:
+                                description: |-
+                                  Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.
+                                  All keys are added under their own name if empty.
+                                items:
+                                  description: Maps a string key to a path
+                                    within a volume.
+                                  properties:
+                                    key:
+                                      description: key is the key to project.
+                                      type: string
+                                    mode:
+                                      description: |-
+                                        mode is Optional: mode bits used to set permissions on this file.
+                                        Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+                                        YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                        If not specified, the volume defaultMode will be used.
+                                        This might be in conflict with other options that affect the file
+                                        mode, like fsGroup, and the result can be other mode bits set.
+                                      format: int32
+                                      type: integer
+                                    path:
+                                      description: |-
+                                        path is the relative path of the file to map the key to.
+                                        May not be an absolute path.
+                                        May not contain the path element '..'.
+                                        May not start with the string '..'.
+                                      type: string
+                                  required:
+                                  - key
+                                  - path
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              secret:
+                                description: Secret references a k8s Secret
+                                  in the namespace of the VirtualMachineInstance.
+                                properties:
+                                  name:
+                                    default: ""
+                                    description: |-
+                                      Name of the referent.
+                                      This field is effectively required, but due to backwards compatibility is
+                                      allowed to be empty. Instances of this type with an empty value here are
+                                      almost certainly wrong.
+                                      More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    type: string
+                                type: object
+                                x-kubernetes-map-type: atomic
+                            type: object
+                          maxItems: 16
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                  required:
+                  - name
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              weight:
+                description: |-
+                  Weight is the share of the VMs of the pool created from the variant, relative to the weights
+                  of the other variants. Defaults to 1
+                format: int32
+                minimum: 0
+                type: integer
+            required:
+            - architecture
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - architecture
+          x-kubernetes-list-type: map
         autohealing:
           description: Autohealing specifies when a VMpool should replace a failing
             VM with a reprovisioned instance
@@ -21714,6 +26709,14 @@ var CRDsValidation map[string]string = map[string]string{
               minimum: 1
               type: integer
           type: object
+        maxSurge:
+          anyOf:
+          - type: integer
+          - type: string
+          description: (Defaults to 0) Integer or string pointer, that when set represents
+            either a percentage or number of VMs that can be created above the desired
+            number of replicas during automated update with the Replace method.
+          x-kubernetes-int-or-string: true
         maxUnavailable:
           anyOf:
           - type: integer
@@ -21928,6 +26931,50 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
           type: object
           x-kubernetes-map-type: atomic
+        standby:
+          description: Standby keeps paused standby VMs on other nodes than the VMs
+            of the pool, which replace the failed VMs of the pool
+          properties:
+            replicas:
+              description: Replicas is the number of standby VMs
+              format: int32
+              minimum: 0
+              type: integer
+          required:
+          - replicas
+          type: object
+        topologySpread:
+          description: TopologySpread distributes the VMs of the pool evenly across
+            topology domains, e.g. zones or hosts
+          items:
+            description: VirtualMachinePoolTopologySpread spreads the VMs of a pool
+              evenly across the domains of a topology
+            properties:
+              maxSkew:
+                description: |-
+                  MaxSkew is the maximum difference between the numbers of VMs of the pool in any two domains.
+                  Defaults to 1
+                format: int32
+                minimum: 1
+                type: integer
+              topologyKey:
+                description: TopologyKey is the key of the node labels defining the
+                  topology domains, e.g. topology.kubernetes.io/zone
+                type: string
+              whenUnsatisfiable:
+                description: |-
+                  WhenUnsatisfiable defines how a VM exceeding the skew is scheduled [DoNotSchedule|ScheduleAnyway]
+                  DoNotSchedule - (Default) the VM is not scheduled until the skew allows it.
+                  ScheduleAnyway - the VM is scheduled, preferring the domains reducing the skew.
+                enum:
+                - DoNotSchedule
+                - ScheduleAnyway
+                type: string
+            required:
+            - topologyKey
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         updateStrategy:
           description: UpdateStrategy specifies how the VMPool controller manages
             updating VMs within a VMPool
@@ -21937,9 +26984,24 @@ var CRDsValidation map[string]string = map[string]string{
                 updated naturally upon the restart. Whereas proactive it applies both
                 the VM and VMI right away.
               type: object
+            paused:
+              description: Paused halts the rollout of template changes to the VMs
+                within the VMPool, scaling is not affected.
+              type: boolean
             proactive:
               description: Proactive update by forcing the VMs to restart during update
               properties:
+                method:
+                  description: |-
+                    Method defines how outdated VMs are updated [Restart|LiveUpdate|Replace]
+                    Restart - (Default) the VMI is restarted.
+                    LiveUpdate - the changes are live-updated to the VMI, which is only restarted when the VM requires it.
+                    Replace - the VM is deleted and a new one is created, see maxSurge.
+                  enum:
+                  - Restart
+                  - LiveUpdate
+                  - Replace
+                  type: string
                 selectionPolicy:
                   description: |-
                     SelectionPolicy defines the priority in which VM instances are selected for proactive update
@@ -22056,6 +27118,26 @@ var CRDsValidation map[string]string = map[string]string{
             spec:
               description: VirtualMachineSpec contains the VirtualMachine specification.
               properties:
+                crashLoopBackoff:
+                  description: |-
+                    CrashLoopBackoff configures the delay before restarting the VirtualMachine after its
+                    VirtualMachineInstance failed shortly after being started. Each field overrides the
+                    cluster wide one.
+                  properties:
+                    baseDelay:
+                      description: |-
+                        BaseDelay is the delay after the first failure. The delay after n consecutive failures is the
+                        base delay multiplied by the square of n. Defaults to a thirtieth of MaxDelay, and at least 10 seconds.
+                      type: string
+                    maxDelay:
+                      description: MaxDelay caps the delay. Defaults to 5 minutes.
+                      type: string
+                    resetWindow:
+                      description: |-
+                        ResetWindow is how long a VirtualMachineInstance has to run for the consecutive failures to be
+                        forgotten. Defaults to 0, forgetting them as soon as the VirtualMachineInstance is running.
+                      type: string
+                  type: object
                 dataVolumeTemplates:
                   description: |-
                     dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -22726,6 +27808,23 @@ var CRDsValidation map[string]string = map[string]string{
                     - spec
                     type: object
                   type: array
+                dependsOn:
+                  description: |-
+                    DependsOn lists VirtualMachines of the same namespace which must be ready before this
+                    VirtualMachine is started. Requires the VirtualMachineDependencies feature gate.
+                  items:
+                    description: |-
+                      VirtualMachineDependency references a VirtualMachine which must be ready before the
+                      depending VirtualMachine is started
+                    properties:
+                      name:
+                        description: Name is the name of the VirtualMachine
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 instancetype:
                   description: InstancetypeMatcher references a instancetype that
                     is used to fill fields in Template
@@ -22792,6 +27891,13 @@ var CRDsValidation map[string]string = map[string]string{
                         initially captured the first time the instancetype is applied to the VirtualMachineInstance.
                       type: string
                   type: object
+                revisionHistoryLimit:
+                  description: |-
+                    RevisionHistoryLimit is the number of old revisions of the spec to keep for rollbacks.
+                    Revisions are only recorded with the VirtualMachineRevisionHistory feature gate.
+                    Defaults to 10.
+                  format: int32
+                  type: integer
                 runStrategy:
                   description: |-
                     Running state indicates the requested running state of the VirtualMachineInstance
@@ -22809,6 +27915,29 @@ var CRDsValidation map[string]string = map[string]string{
                     Mutually exclusive with RunStrategy
                     Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                   type: boolean
+                schedule:
+                  description: |-
+                    Schedule starts and stops the VirtualMachine at recurring times.
+                    Requires the VirtualMachineSchedule feature gate.
+                  properties:
+                    skipStopIfInUse:
+                      description: |-
+                        SkipStopIfInUse skips scheduled stops while the VirtualMachine is in use, that is while users
+                        are logged in the guest, or while it is migrating or being backed up. Defaults to false.
+                      type: boolean
+                    start:
+                      description: Start is a cron expression, in the standard five
+                        fields format, at which the VirtualMachine is started.
+                      type: string
+                    stop:
+                      description: Stop is a cron expression, in the standard five
+                        fields format, at which the VirtualMachine is stopped.
+                      type: string
+                    timeZone:
+                      description: TimeZone is the IANA name of the time zone the
+                        cron expressions are evaluated in. Defaults to UTC.
+                      type: string
+                  type: object
                 template:
                   description: Template is the direct specification of VirtualMachineInstance
                   properties:
@@ -23936,6 +29065,28 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Clock sets the clock and timers of the
                                 vmi.
                               properties:
+                                guestTimeSync:
+                                  description: |-
+                                    GuestTimeSync controls when the guest clock is set to the host time through
+                                    the guest agent, after the guest was not running for some time.
+                                  properties:
+                                    afterMigration:
+                                      description: |-
+                                        AfterMigration sets the guest clock once the vmi is migrated.
+                                        Defaults to true.
+                                      type: boolean
+                                    afterUnpause:
+                                      description: |-
+                                        AfterUnpause sets the guest clock once the vmi is unpaused.
+                                        Defaults to true.
+                                      type: boolean
+                                    timeoutSeconds:
+                                      description: |-
+                                        TimeoutSeconds is the time the guest agent is retried for if it is not responsive.
+                                        Defaults to 60.
+                                      format: int32
+                                      type: integer
+                                  type: object
                                 timer:
                                   description: Timer specifies whih timers are attached
                                     to the vmi.
@@ -24028,6 +29179,21 @@ var CRDsValidation map[string]string = map[string]string{
                                         guest changes to the clock will be kept during reboots and not reset.
                                       type: integer
                                   type: object
+                                variable:
+                                  description: |-
+                                    Variable lets the guest change its clock freely relative to the basis.
+                                    The guest changes are kept during reboots and migrations.
+                                  properties:
+                                    basis:
+                                      description: |-
+                                        Basis the offset is relative to.
+                                        One of "utc", "localtime". Defaults to "utc".
+                                      type: string
+                                    offsetSeconds:
+                                      description: OffsetSeconds specifies the initial
+                                        offset in seconds, relative to the basis.
+                                      type: integer
+                                  type: object
                               type: object
                               x-kubernetes-preserve-unknown-fields: true
                             cpu:
@@ -24088,10 +29254,56 @@ var CRDsValidation map[string]string = map[string]string{
                                     and "host-model" to get CPU closest to the node one.
                                     Defaults to host-model.
                                   type: string
+                                nestedVirtualization:
+                                  description: |-
+                                    NestedVirtualization controls whether the guest can run virtual machines itself.
+                                    Require schedules the VMI onto nodes exposing VMX or SVM to their guests,
+                                    Forbid hides VMX and SVM from the guest.
+                                    By default the guest gets them when both the CPU model and the node provide them.
+                                  type: string
                                 numa:
                                   description: NUMA allows specifying settings for
                                     the guest NUMA topology
                                   properties:
+                                    cells:
+                                      description: |-
+                                        Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                                        Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                                      items:
+                                        description: NUMACell declares a virtual numa
+                                          node of the guest.
+                                        properties:
+                                          cpus:
+                                            description: CPUs is the list of vCPUs
+                                              of the numa node, e.g. "0-3,6".
+                                            type: string
+                                          memory:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: Memory is the amount of guest
+                                              memory of the numa node.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                        required:
+                                        - cpus
+                                        - memory
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    evenSplit:
+                                      description: |-
+                                        EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                                        split the vCPUs and the guest memory evenly between them.
+                                      properties:
+                                        nodes:
+                                          description: Nodes is the number of virtual
+                                            numa nodes of the guest.
+                                          format: int32
+                                          type: integer
+                                      required:
+                                      - nodes
+                                      type: object
                                     guestMappingPassthrough:
                                       description: |-
                                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -24108,6 +29320,14 @@ var CRDsValidation map[string]string = map[string]string{
                                         Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                                         Example: "0-3,^1","0,2,3","2-3"
                                       type: string
+                                    profile:
+                                      description: |-
+                                        Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                                        The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                                        Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                                        the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                                        Hugepages still need to be requested explicitly.
+                                      type: string
                                   type: object
                                 sockets:
                                   description: |-
@@ -24156,6 +29376,13 @@ var CRDsValidation map[string]string = map[string]string{
                                     Whether to attach the VSOCK CID to the VM or not.
                                     VSOCK access will be available if set to true. Defaults to false.
                                   type: boolean
+                                autoattachVirtioDriversDisk:
+                                  description: |-
+                                    Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as
+                                    indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk
+                                    is no longer attached on the next start once set to false.
+                                    Defaults to false.
+                                  type: boolean
                                 blockMultiQueue:
                                   description: |-
                                     Whether or not to enable virtio multi-queue for block devices.
@@ -24335,6 +29562,28 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                       virtiofs:
                                         description: Virtiofs is supported
+                                        properties:
+                                          cache:
+                                            description: |-
+                                              Cache sets the caching policy of the shared directory in the guest.
+                                              One of: auto, always, never. Defaults to auto.
+                                            type: string
+                                          daxWindowSize:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: |-
+                                              DAXWindowSize enables DAX for the filesystem and sets the size of the
+                                              shared memory window the guest maps the files into, bypassing its page cache.
+                                              It must be a multiple of 2Mi. DAX is disabled if not set.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          threadPoolSize:
+                                            description: |-
+                                              ThreadPoolSize sets the number of worker threads of the virtiofsd process
+                                              serving the filesystem. Defaults to the virtiofsd default.
+                                            format: int32
+                                            type: integer
                                         type: object
                                     required:
                                     - name
@@ -24400,6 +29649,25 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                   type: array
                                   x-kubernetes-list-type: atomic
+                                guestPanicCapture:
+                                  description: |-
+                                    GuestPanicCapture configures what is captured when the guest kernel panics.
+                                    A pvpanic device is added if no panic device is provided.
+                                  properties:
+                                    consoleLogLines:
+                                      description: |-
+                                        ConsoleLogLines is the number of trailing lines of the serial console log
+                                        recorded in the GuestPanicked condition. Requires the serial console log.
+                                        Defaults to 20, at most 100.
+                                      format: int32
+                                      type: integer
+                                    memoryDumpClaimName:
+                                      description: |-
+                                        MemoryDumpClaimName is the name of the PVC the guest memory is dumped to
+                                        when the guest kernel panics. The crashed guest is kept until the memory
+                                        dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.
+                                      type: string
+                                  type: object
                                 hostDevices:
                                   description: Whether to attach a host device to
                                     the vmi.
@@ -24562,6 +29830,20 @@ var CRDsValidation map[string]string = map[string]string{
                                           Logical name of the interface as well as a reference to the associated networks.
                                           Must match the Name of a Network.
                                         type: string
+                                      networkBoot:
+                                        description: |-
+                                          NetworkBoot makes the interface the first boot device of the vmi.
+                                          The disks and interfaces with a boot order are tried after it, following their boot order.
+                                          Can not be combined with bootOrder.
+                                        properties:
+                                          ipxeScriptRef:
+                                            description: |-
+                                              IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.
+                                              The firmware of the interface runs the script instead of fetching the boot file
+                                              offered by the DHCP server of the network.
+                                              Only supported on interfaces with the masquerade binding.
+                                            type: string
+                                        type: object
                                       passt:
                                         description: |-
                                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -24670,6 +29952,34 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Whether to have random number generator
                                     from host
                                   type: object
+                                serials:
+                                  description: |-
+                                    Serials defines additional named serial devices.
+                                    Each of them is accessible through the console subresource by its name.
+                                  items:
+                                    description: SerialDevice is an additional serial
+                                      device of the VMI.
+                                    properties:
+                                      name:
+                                        description: Name of the serial device, used
+                                          to select it when connecting to the console
+                                          subresource.
+                                        type: string
+                                      type:
+                                        description: |-
+                                          Type of the serial device. One of: serial, virtio.
+                                          A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a
+                                          virtio console of the guest (e.g. hvc0).
+                                          Defaults to serial.
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  maxItems: 8
+                                  type: array
+                                  x-kubernetes-list-map-keys:
+                                  - name
+                                  x-kubernetes-list-type: map
                                 sound:
                                   description: Whether to emulate a sound device.
                                   properties:
@@ -24726,7 +30036,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       properties:
                                         action:
                                           description: |-
-                                            The action to take. Valid values are poweroff, reset, shutdown.
+                                            The action to take. Valid values are poweroff, reset, shutdown, dump.
                                             Defaults to reset.
                                           type: string
                                       type: object
@@ -24735,7 +30045,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       properties:
                                         action:
                                           description: |-
-                                            The action to take. Valid values are poweroff, reset, shutdown.
+                                            The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.
                                             Defaults to reset.
                                           type: string
                                       type: object
@@ -25112,6 +30422,52 @@ var CRDsValidation map[string]string = map[string]string{
                                 serial:
                                   description: The system-serial-number in SMBIOS
                                   type: string
+                                smbios:
+                                  description: |-
+                                    SMBIOS defines the system and baseboard information and the OEM strings
+                                    reported in the SMBIOS tables of the vmi.
+                                    Unset values default to the cluster wide SMBIOS configuration.
+                                  properties:
+                                    baseBoard:
+                                      description: BaseBoard overrides the cluster
+                                        wide baseboard information (SMBIOS type 2).
+                                      properties:
+                                        asset:
+                                          type: string
+                                        manufacturer:
+                                          type: string
+                                        product:
+                                          type: string
+                                        serial:
+                                          type: string
+                                        version:
+                                          type: string
+                                      type: object
+                                    oemStrings:
+                                      description: OEMStrings replace the cluster
+                                        wide OEM strings (SMBIOS type 11).
+                                      items:
+                                        type: string
+                                      maxItems: 32
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    system:
+                                      description: |-
+                                        System overrides the cluster wide system information (SMBIOS type 1).
+                                        The uuid and the serial are set through the firmware.
+                                      properties:
+                                        family:
+                                          type: string
+                                        manufacturer:
+                                          type: string
+                                        product:
+                                          type: string
+                                        sku:
+                                          type: string
+                                        version:
+                                          type: string
+                                      type: object
+                                  type: object
                                 uuid:
                                   description: |-
                                     UUID reported by the vmi bios.
@@ -25184,6 +30540,46 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Memory allow specifying the VMI memory
                                 features.
                               properties:
+                                balloon:
+                                  description: |-
+                                    Balloon declares the bounds within which the memory balloon is
+                                    automatically resized, based on the guest memory usage and the node
+                                    memory pressure. Requires the AutoMemoryBalloon feature gate.
+                                  properties:
+                                    max:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        Max is the highest amount of memory the guest can be given back.
+                                        Defaults to the guest memory.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    min:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: Min is the lowest amount of memory
+                                        the guest can be shrunk to.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                  required:
+                                  - min
+                                  type: object
+                                freePageReporting:
+                                  description: |-
+                                    FreePageReporting determines if the memory balloon reports free guest pages
+                                    back to the host.
+                                    Enabling it requires free page reporting to be allowed on the cluster and
+                                    is not possible for high performance VirtualMachineInstances.
+                                    Defaults to the cluster configuration.
+                                  properties:
+                                    enabled:
+                                      description: |-
+                                        Enabled determines if the feature should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
                                 guest:
                                   anyOf:
                                   - type: integer
@@ -25205,6 +30601,19 @@ var CRDsValidation map[string]string = map[string]string{
                                         are 1Gi and 2Mi.
                                       type: string
                                   type: object
+                                ksm:
+                                  description: |-
+                                    KSM determines if the memory of the VirtualMachineInstance can be merged
+                                    by Kernel Samepage Merging on nodes where KSM is enabled.
+                                    Enabling it requires KSM to be configured on the cluster.
+                                    Defaults to true.
+                                  properties:
+                                    enabled:
+                                      description: |-
+                                        Enabled determines if the feature should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
                                 maxGuest:
                                   anyOf:
                                   - type: integer
@@ -25214,6 +30623,12 @@ var CRDsValidation map[string]string = map[string]string{
                                     The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                                   x-kubernetes-int-or-string: true
+                                overcommitClass:
+                                  description: |-
+                                    OvercommitClass selects one of the memory overcommit classes defined
+                                    in the KubeVirt configuration. The ratio of the class is used instead
+                                    of the cluster wide memory overcommit.
+                                  type: string
                                 reservedOverhead:
                                   description: |-
                                     ReservedOverhead configures the memory overhead applied to a VM
@@ -25242,6 +30657,41 @@ var CRDsValidation map[string]string = map[string]string{
                                       - Required
                                       type: string
                                   type: object
+                                suspendToDisk:
+                                  description: |-
+                                    SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage
+                                    of the VirtualMachineInstance is sized to also hold the guest memory.
+                                    Requires the SuspendToDisk feature gate.
+                                  type: boolean
+                              type: object
+                            qemuCommandLine:
+                              description: |-
+                                QEMUCommandLine holds raw arguments appended to the QEMU command line.
+                                The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.
+                                Requires the QEMUArgsPassthrough feature gate.
+                              properties:
+                                args:
+                                  description: Args are appended to the QEMU command
+                                    line in the given order.
+                                  items:
+                                    description: QEMUArg is a QEMU command line option
+                                      and its value, e.g. "-device" and "pvpanic".
+                                    properties:
+                                      name:
+                                        description: Name is the QEMU option, including
+                                          its leading dash, e.g. "-device".
+                                        type: string
+                                      value:
+                                        description: Value of the option, omitted
+                                          for options without a value.
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - args
                               type: object
                             rebootPolicy:
                               description: |-
@@ -25290,6 +30740,13 @@ var CRDsValidation map[string]string = map[string]string{
                           required:
                           - devices
                           type: object
+                        evictionMigrationTimeoutSeconds:
+                          description: |-
+                            EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be
+                            migrated on eviction with the "LiveMigrateOrShutdown" eviction strategy, before it is shutdown.
+                            Overrides the cluster wide evictionMigrationTimeout of the migration configuration.
+                          format: int64
+                          type: integer
                         evictionStrategy:
                           description: |-
                             EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -25297,13 +30754,72 @@ var CRDsValidation map[string]string = map[string]string{
                             - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
                             - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
+                            - "LiveMigrateOrShutdown": the same as "LiveMigrateIfPossible", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout.
                             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        guestAgentCommands:
+                          description: |-
+                            GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in
+                            the guest. A command must be allowed by both this and the cluster wide configuration.
+                          properties:
+                            allowedCommands:
+                              description: AllowedCommands are the only commands which
+                                may be invoked. All commands are allowed if empty.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: set
+                            deniedCommands:
+                              description: DeniedCommands may never be invoked, even
+                                if they are allowed.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: set
+                          type: object
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
                             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                           type: string
+                        ignition:
+                          description: |-
+                            Ignition provisions CoreOS-family guests with an Ignition config. Unlike the
+                            kubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and
+                            be made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.
+                          properties:
+                            fragments:
+                              description: |-
+                                Fragments make up the Ignition config. A single fragment is passed to the guest as is, several
+                                fragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.
+                              items:
+                                description: IgnitionFragment is a part of the Ignition
+                                  config. Exactly one of its sources must be set.
+                                properties:
+                                  data:
+                                    description: Data is an inline Ignition config.
+                                    type: string
+                                  volumeName:
+                                    description: |-
+                                      VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance,
+                                      holding the Ignition config under the config.ign key.
+                                    type: string
+                                type: object
+                              maxItems: 16
+                              minItems: 1
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            propagation:
+                              description: |-
+                                Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig.
+                                ConfigDrive requires a cloudInitConfigDrive volume without user data.
+                              enum:
+                              - FirmwareConfig
+                              - ConfigDrive
+                              type: string
+                          required:
+                          - fragments
+                          type: object
                         livenessProbe:
                           description: |-
                             Periodic probe of VirtualMachineInstance liveness.
@@ -25335,6 +30851,18 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            guestAgentFileExists:
+                              description: |-
+                                GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                                If the guest agent is not available, this probe will fail.
+                              properties:
+                                path:
+                                  description: Path is the absolute path of the file
+                                    in the guest.
+                                  type: string
+                              required:
+                              - path
+                              type: object
                             guestAgentPing:
                               description: |-
                                 GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -25524,6 +31052,29 @@ var CRDsValidation map[string]string = map[string]string{
                             Selector which must match a node's labels for the vmi to be scheduled on that node.
                             More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                           type: object
+                        preShutdownHook:
+                          description: |-
+                            PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the
+                            applications of the guest, e.g. to let a database stop cleanly.
+                          properties:
+                            args:
+                              description: Args are passed to the command.
+                              items:
+                                type: string
+                              type: array
+                            command:
+                              description: Command is the path of the executable in
+                                the guest, e.g. a script stopping a database.
+                              type: string
+                            timeoutSeconds:
+                              description: |-
+                                TimeoutSeconds is the part of the termination grace period reserved for the hook.
+                                It must be shorter than terminationGracePeriodSeconds. Defaults to 10.
+                              format: int64
+                              type: integer
+                          required:
+                          - command
+                          type: object
                         priorityClassName:
                           description: |-
                             If specified, indicates the pod's priority.
@@ -25561,6 +31112,18 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            guestAgentFileExists:
+                              description: |-
+                                GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                                If the guest agent is not available, this probe will fail.
+                              properties:
+                                path:
+                                  description: Path is the absolute path of the file
+                                    in the guest.
+                                  type: string
+                              required:
+                              - path
+                              type: object
                             guestAgentPing:
                               description: |-
                                 GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -26064,6 +31627,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                   More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                                 properties:
+                                  generateNetworkData:
+                                    description: |-
+                                      GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the
+                                      VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.
+                                      Can not be combined with another networkdata source.
+                                    type: boolean
                                   networkData:
                                     description: NetworkData contains NoCloud inline
                                       cloud-init networkdata.
@@ -26487,6 +32056,85 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                     type: object
                                     x-kubernetes-map-type: atomic
+                                  sources:
+                                    description: |-
+                                      Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file
+                                      along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be
+                                      selected by the items of one of them. Cannot be combined with Secret and ConfigMap.
+                                    items:
+                                      description: SysprepProjection adds the keys
+                                        of a Secret or ConfigMap to a Sysprep disk.
+                                        Exactly one of Secret and ConfigMap must be
+                                        set.
+                                      properties:
+                                        configMap:
+                                          description: ConfigMap references a ConfigMap
+                                            in the namespace of the VirtualMachineInstance.
+                                          properties:
+                                            name:
+                                              default: ""
+                                              description: |-
+                                                Name of the referent.
+                                                This field is effectively required, but due to backwards compatibility is
+                                                allowed to be empty. Instances of this type with an empty value here are
+                                                almost certainly wrong.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                              type: string
+                                          type: object
+                                          x-kubernetes-map-type: atomic
+                                        items:
+                                          description: |-
+                                            Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.
+                                            All keys are added under their own name if empty.
+                                          items:
+                                            description: Maps a string key to a path
+                                              within a volume.
+                                            properties:
+                                              key:
+                                                description: key is the key to project.
+                                                type: string
+                                              mode:
+                                                description: |-
+                                                  mode is Optional: mode bits used to set permissions on this file.
+                                                  Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+                                                  YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                                  If not specified, the volume defaultMode will be used.
+                                                  This might be in conflict with other options that affect the file
+                                                  mode, like fsGroup, and the result can be other mode bits set.
+                                                format: int32
+                                                type: integer
+                                              path:
+                                                description: |-
+                                                  path is the relative path of the file to map the key to.
+                                                  May not be an absolute path.
+                                                  May not contain the path element '..'.
+                                                  May not start with the string '..'.
+                                                type: string
+                                            required:
+                                            - key
+                                            - path
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        secret:
+                                          description: Secret references a k8s Secret
+                                            in the namespace of the VirtualMachineInstance.
+                                          properties:
+                                            name:
+                                              default: ""
+                                              description: |-
+                                                Name of the referent.
+                                                This field is effectively required, but due to backwards compatibility is
+                                                allowed to be empty. Instances of this type with an empty value here are
+                                                almost certainly wrong.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                              type: string
+                                          type: object
+                                          x-kubernetes-map-type: atomic
+                                      type: object
+                                    maxItems: 16
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                             required:
                             - name
@@ -26543,9 +32191,56 @@ var CRDsValidation map[string]string = map[string]string{
         readyReplicas:
           format: int32
           type: integer
+        readyStandbyReplicas:
+          description: ReadyStandbyReplicas is the number of standby VMs which are
+            running and ready to replace a failed VM
+          format: int32
+          type: integer
         replicas:
           format: int32
           type: integer
+        standbyReplicas:
+          description: StandbyReplicas is the number of standby VMs of the pool
+          format: int32
+          type: integer
+        topologySpread:
+          description: |-
+            TopologySpread reports the distribution of the running VMs of the pool for each topology key of the
+            spread and anti-affinity rules
+          items:
+            description: VirtualMachinePoolTopologySpreadStatus reports the distribution
+              of the running VMs of a pool across the domains of a topology
+            properties:
+              domains:
+                description: Domains lists the number of running VMs of the pool in
+                  each domain hosting at least one of them
+                items:
+                  properties:
+                    name:
+                      type: string
+                    replicas:
+                      format: int32
+                      type: integer
+                  required:
+                  - name
+                  - replicas
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              skew:
+                description: |-
+                  Skew is the difference between the numbers of running VMs in the most and the least populated domains,
+                  counting the domains of the nodes without VMs of the pool as well
+                format: int32
+                type: integer
+              topologyKey:
+                type: string
+            required:
+            - skew
+            - topologyKey
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
       type: object
   required:
   - spec
@@ -26605,6 +32300,21 @@ var CRDsValidation map[string]string = map[string]string{
                         guest changes to the clock will be kept during reboots and not reset.
                       type: integer
                   type: object
+                variable:
+                  description: |-
+                    Variable lets the guest change its clock freely relative to the basis.
+                    The guest changes are kept during reboots and migrations.
+                  properties:
+                    basis:
+                      description: |-
+                        Basis the offset is relative to.
+                        One of "utc", "localtime". Defaults to "utc".
+                      type: string
+                    offsetSeconds:
+                      description: OffsetSeconds specifies the initial offset in seconds,
+                        relative to the basis.
+                      type: integer
+                  type: object
               type: object
             preferredTimer:
               description: Timer specifies whih timers are attached to the vmi.
@@ -26890,6 +32600,10 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            preferredWatchdogAction:
+              description: PreferredWatchdogAction optionally defines the preferred
+                action to take when a Watchdog device is triggered.
+              type: string
           type: object
         features:
           description: Features optionally defines preferences associated with the
@@ -27208,6 +32922,12 @@ var CRDsValidation map[string]string = map[string]string{
           description: PreferredArchitecture defines a prefeerred architecture for
             the VirtualMachine
           type: string
+        preferredGuestOS:
+          description: |-
+            PreferredGuestOS optionally hints at the operating system running in the guest.
+            When set to windows a complete set of Hyper-V enlightenments is applied to the
+            VirtualMachineInstance for any enlightenment not already configured.
+          type: string
         preferredSubdomain:
           description: Subdomain of the VirtualMachineInstance
           type: string
@@ -27260,6 +32980,148 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinequota": `openAPIV3Schema:
+  description: |-
+    VirtualMachineQuota limits the resources the running VirtualMachines of its namespace may consume,
+    expressed in VirtualMachine terms rather than in the terms of the virt-launcher pods.
+    Creating a VirtualMachineInstance, directly or by starting a VirtualMachine, is rejected when it
+    would exceed any VirtualMachineQuota of its namespace.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Spec defines the limits of the quota.
+      properties:
+        hard:
+          description: Hard is the set of limits enforced on the running VirtualMachines
+            of the namespace.
+          properties:
+            guestMemory:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestMemory is the maximum sum of the guest memory of the
+                running VirtualMachines.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            runningVirtualMachines:
+              description: RunningVirtualMachines is the maximum number of VirtualMachines
+                running at once.
+              format: int64
+              minimum: 0
+              type: integer
+            sockets:
+              description: Sockets is the maximum sum of the vCPU sockets of the running
+                VirtualMachines.
+              format: int64
+              minimum: 0
+              type: integer
+          type: object
+      required:
+      - hard
+      type: object
+    status:
+      description: Status reports the current consumption of the quota.
+      properties:
+        reservations:
+          description: |-
+            Reservations are the charges of the VirtualMachineInstances admitted by virt-api which are not
+            accounted for in Used yet. virt-controller drops them once it observes the VirtualMachineInstances,
+            or after a minute for the ones which were not created.
+          items:
+            description: VirtualMachineQuotaReservation is the charge of a VirtualMachineInstance
+              admitted by virt-api.
+            properties:
+              name:
+                description: Name is the name of the VirtualMachineInstance.
+                type: string
+              time:
+                description: Time is when the VirtualMachineInstance was admitted.
+                format: date-time
+                type: string
+              uid:
+                description: UID is the UID of the VirtualMachineInstance.
+                type: string
+              used:
+                description: Used is the consumption of the VirtualMachineInstance,
+                  in the terms of the limits.
+                properties:
+                  guestMemory:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: GuestMemory is the maximum sum of the guest memory
+                      of the running VirtualMachines.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  runningVirtualMachines:
+                    description: RunningVirtualMachines is the maximum number of VirtualMachines
+                      running at once.
+                    format: int64
+                    minimum: 0
+                    type: integer
+                  sockets:
+                    description: Sockets is the maximum sum of the vCPU sockets of
+                      the running VirtualMachines.
+                    format: int64
+                    minimum: 0
+                    type: integer
+                type: object
+            required:
+            - name
+            - time
+            - used
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+        used:
+          description: |-
+            Used is the consumption of the running VirtualMachines of the namespace, in the terms of the limits.
+            It is computed by virt-controller from the VirtualMachineInstances it observes.
+          properties:
+            guestMemory:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestMemory is the maximum sum of the guest memory of the
+                running VirtualMachines.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            runningVirtualMachines:
+              description: RunningVirtualMachines is the maximum number of VirtualMachines
+                running at once.
+              format: int64
+              minimum: 0
+              type: integer
+            sockets:
+              description: Sockets is the maximum sum of the vCPU sockets of the running
+                VirtualMachines.
+              format: int64
+              minimum: 0
+              type: integer
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinerestore": `openAPIV3Schema:
   description: VirtualMachineRestore defines the operation of restoring a VM
@@ -27629,6 +33491,26 @@ var CRDsValidation map[string]string = map[string]string{
                 spec:
                   description: VirtualMachineSpec contains the VirtualMachine specification.
                   properties:
+                    crashLoopBackoff:
+                      description: |-
+                        CrashLoopBackoff configures the delay before restarting the VirtualMachine after its
+                        VirtualMachineInstance failed shortly after being started. Each field overrides the
+                        cluster wide one.
+                      properties:
+                        baseDelay:
+                          description: |-
+                            BaseDelay is the delay after the first failure. The delay after n consecutive failures is the
+                            base delay multiplied by the square of n. Defaults to a thirtieth of MaxDelay, and at least 10 seconds.
+                          type: string
+                        maxDelay:
+                          description: MaxDelay caps the delay. Defaults to 5 minutes.
+                          type: string
+                        resetWindow:
+                          description: |-
+                            ResetWindow is how long a VirtualMachineInstance has to run for the consecutive failures to be
+                            forgotten. Defaults to 0, forgetting them as soon as the VirtualMachineInstance is running.
+                          type: string
+                      type: object
                     dataVolumeTemplates:
                       description: |-
                         dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -28310,6 +34192,23 @@ var CRDsValidation map[string]string = map[string]string{
                         - spec
                         type: object
                       type: array
+                    dependsOn:
+                      description: |-
+                        DependsOn lists VirtualMachines of the same namespace which must be ready before this
+                        VirtualMachine is started. Requires the VirtualMachineDependencies feature gate.
+                      items:
+                        description: |-
+                          VirtualMachineDependency references a VirtualMachine which must be ready before the
+                          depending VirtualMachine is started
+                        properties:
+                          name:
+                            description: Name is the name of the VirtualMachine
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     instancetype:
                       description: InstancetypeMatcher references a instancetype that
                         is used to fill fields in Template
@@ -28376,6 +34275,13 @@ var CRDsValidation map[string]string = map[string]string{
                             initially captured the first time the instancetype is applied to the VirtualMachineInstance.
                           type: string
                       type: object
+                    revisionHistoryLimit:
+                      description: |-
+                        RevisionHistoryLimit is the number of old revisions of the spec to keep for rollbacks.
+                        Revisions are only recorded with the VirtualMachineRevisionHistory feature gate.
+                        Defaults to 10.
+                      format: int32
+                      type: integer
                     runStrategy:
                       description: |-
                         Running state indicates the requested running state of the VirtualMachineInstance
@@ -28393,6 +34299,29 @@ var CRDsValidation map[string]string = map[string]string{
                         Mutually exclusive with RunStrategy
                         Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                       type: boolean
+                    schedule:
+                      description: |-
+                        Schedule starts and stops the VirtualMachine at recurring times.
+                        Requires the VirtualMachineSchedule feature gate.
+                      properties:
+                        skipStopIfInUse:
+                          description: |-
+                            SkipStopIfInUse skips scheduled stops while the VirtualMachine is in use, that is while users
+                            are logged in the guest, or while it is migrating or being backed up. Defaults to false.
+                          type: boolean
+                        start:
+                          description: Start is a cron expression, in the standard
+                            five fields format, at which the VirtualMachine is started.
+                          type: string
+                        stop:
+                          description: Stop is a cron expression, in the standard
+                            five fields format, at which the VirtualMachine is stopped.
+                          type: string
+                        timeZone:
+                          description: TimeZone is the IANA name of the time zone
+                            the cron expressions are evaluated in. Defaults to UTC.
+                          type: string
+                      type: object
                     template:
                       description: Template is the direct specification of VirtualMachineInstance
                       properties:
@@ -29535,6 +35464,28 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Clock sets the clock and timers of
                                     the vmi.
                                   properties:
+                                    guestTimeSync:
+                                      description: |-
+                                        GuestTimeSync controls when the guest clock is set to the host time through
+                                        the guest agent, after the guest was not running for some time.
+                                      properties:
+                                        afterMigration:
+                                          description: |-
+                                            AfterMigration sets the guest clock once the vmi is migrated.
+                                            Defaults to true.
+                                          type: boolean
+                                        afterUnpause:
+                                          description: |-
+                                            AfterUnpause sets the guest clock once the vmi is unpaused.
+                                            Defaults to true.
+                                          type: boolean
+                                        timeoutSeconds:
+                                          description: |-
+                                            TimeoutSeconds is the time the guest agent is retried for if it is not responsive.
+                                            Defaults to 60.
+                                          format: int32
+                                          type: integer
+                                      type: object
                                     timer:
                                       description: Timer specifies whih timers are
                                         attached to the vmi.
@@ -29628,6 +35579,22 @@ var CRDsValidation map[string]string = map[string]string{
                                             guest changes to the clock will be kept during reboots and not reset.
                                           type: integer
                                       type: object
+                                    variable:
+                                      description: |-
+                                        Variable lets the guest change its clock freely relative to the basis.
+                                        The guest changes are kept during reboots and migrations.
+                                      properties:
+                                        basis:
+                                          description: |-
+                                            Basis the offset is relative to.
+                                            One of "utc", "localtime". Defaults to "utc".
+                                          type: string
+                                        offsetSeconds:
+                                          description: OffsetSeconds specifies the
+                                            initial offset in seconds, relative to
+                                            the basis.
+                                          type: integer
+                                      type: object
                                   type: object
                                   x-kubernetes-preserve-unknown-fields: true
                                 cpu:
@@ -29688,10 +35655,56 @@ var CRDsValidation map[string]string = map[string]string{
                                         and "host-model" to get CPU closest to the node one.
                                         Defaults to host-model.
                                       type: string
+                                    nestedVirtualization:
+                                      description: |-
+                                        NestedVirtualization controls whether the guest can run virtual machines itself.
+                                        Require schedules the VMI onto nodes exposing VMX or SVM to their guests,
+                                        Forbid hides VMX and SVM from the guest.
+                                        By default the guest gets them when both the CPU model and the node provide them.
+                                      type: string
                                     numa:
                                       description: NUMA allows specifying settings
                                         for the guest NUMA topology
                                       properties:
+                                        cells:
+                                          description: |-
+                                            Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+                                            Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+                                          items:
+                                            description: NUMACell declares a virtual
+                                              numa node of the guest.
+                                            properties:
+                                              cpus:
+                                                description: CPUs is the list of vCPUs
+                                                  of the numa node, e.g. "0-3,6".
+                                                type: string
+                                              memory:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Memory is the amount
+                                                  of guest memory of the numa node.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - cpus
+                                            - memory
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        evenSplit:
+                                          description: |-
+                                            EvenSplit will create a guest topology with the given number of virtual numa nodes and
+                                            split the vCPUs and the guest memory evenly between them.
+                                          properties:
+                                            nodes:
+                                              description: Nodes is the number of
+                                                virtual numa nodes of the guest.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - nodes
+                                          type: object
                                         guestMappingPassthrough:
                                           description: |-
                                             GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -29708,6 +35721,14 @@ var CRDsValidation map[string]string = map[string]string{
                                             Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
                                             Example: "0-3,^1","0,2,3","2-3"
                                           type: string
+                                        profile:
+                                          description: |-
+                                            Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+                                            The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+                                            Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+                                            the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+                                            Hugepages still need to be requested explicitly.
+                                          type: string
                                       type: object
                                     sockets:
                                       description: |-
@@ -29756,6 +35777,13 @@ var CRDsValidation map[string]string = map[string]string{
                                         Whether to attach the VSOCK CID to the VM or not.
                                         VSOCK access will be available if set to true. Defaults to false.
                                       type: boolean
+                                    autoattachVirtioDriversDisk:
+                                      description: |-
+                                        Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as
+                                        indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk
+                                        is no longer attached on the next start once set to false.
+                                        Defaults to false.
+                                      type: boolean
                                     blockMultiQueue:
                                       description: |-
                                         Whether or not to enable virtio multi-queue for block devices.
@@ -29940,6 +35968,28 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                           virtiofs:
                                             description: Virtiofs is supported
+                                            properties:
+                                              cache:
+                                                description: |-
+                                                  Cache sets the caching policy of the shared directory in the guest.
+                                                  One of: auto, always, never. Defaults to auto.
+                                                type: string
+                                              daxWindowSize:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: |-
+                                                  DAXWindowSize enables DAX for the filesystem and sets the size of the
+                                                  shared memory window the guest maps the files into, bypassing its page cache.
+                                                  It must be a multiple of 2Mi. DAX is disabled if not set.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              threadPoolSize:
+                                                description: |-
+                                                  ThreadPoolSize sets the number of worker threads of the virtiofsd process
+                                                  serving the filesystem. Defaults to the virtiofsd default.
+                                                format: int32
+                                                type: integer
                                             type: object
                                         required:
                                         - name
@@ -30006,6 +36056,25 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: object
                                       type: array
                                       x-kubernetes-list-type: atomic
+                                    guestPanicCapture:
+                                      description: |-
+                                        GuestPanicCapture configures what is captured when the guest kernel panics.
+                                        A pvpanic device is added if no panic device is provided.
+                                      properties:
+                                        consoleLogLines:
+                                          description: |-
+                                            ConsoleLogLines is the number of trailing lines of the serial console log
+                                            recorded in the GuestPanicked condition. Requires the serial console log.
+                                            Defaults to 20, at most 100.
+                                          format: int32
+                                          type: integer
+                                        memoryDumpClaimName:
+                                          description: |-
+                                            MemoryDumpClaimName is the name of the PVC the guest memory is dumped to
+                                            when the guest kernel panics. The crashed guest is kept until the memory
+                                            dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.
+                                          type: string
+                                      type: object
                                     hostDevices:
                                       description: Whether to attach a host device
                                         to the vmi.
@@ -30169,6 +36238,20 @@ var CRDsValidation map[string]string = map[string]string{
                                               Logical name of the interface as well as a reference to the associated networks.
                                               Must match the Name of a Network.
                                             type: string
+                                          networkBoot:
+                                            description: |-
+                                              NetworkBoot makes the interface the first boot device of the vmi.
+                                              The disks and interfaces with a boot order are tried after it, following their boot order.
+                                              Can not be combined with bootOrder.
+                                            properties:
+                                              ipxeScriptRef:
+                                                description: |-
+                                                  IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.
+                                                  The firmware of the interface runs the script instead of fetching the boot file
+                                                  offered by the DHCP server of the network.
+                                                  Only supported on interfaces with the masquerade binding.
+                                                type: string
+                                            type: object
                                           passt:
                                             description: |-
                                               DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -30278,6 +36361,34 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Whether to have random number generator
                                         from host
                                       type: object
+                                    serials:
+                                      description: |-
+                                        Serials defines additional named serial devices.
+                                        Each of them is accessible through the console subresource by its name.
+                                      items:
+                                        description: SerialDevice is an additional
+                                          serial device of the VMI.
+                                        properties:
+                                          name:
+                                            description: Name of the serial device,
+                                              used to select it when connecting to
+                                              the console subresource.
+                                            type: string
+                                          type:
+                                            description: |-
+                                              Type of the serial device. One of: serial, virtio.
+                                              A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a
+                                              virtio console of the guest (e.g. hvc0).
+                                              Defaults to serial.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      maxItems: 8
+                                      type: array
+                                      x-kubernetes-list-map-keys:
+                                      - name
+                                      x-kubernetes-list-type: map
                                     sound:
                                       description: Whether to emulate a sound device.
                                       properties:
@@ -30334,7 +36445,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           properties:
                                             action:
                                               description: |-
-                                                The action to take. Valid values are poweroff, reset, shutdown.
+                                                The action to take. Valid values are poweroff, reset, shutdown, dump.
                                                 Defaults to reset.
                                               type: string
                                           type: object
@@ -30343,7 +36454,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           properties:
                                             action:
                                               description: |-
-                                                The action to take. Valid values are poweroff, reset, shutdown.
+                                                The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.
                                                 Defaults to reset.
                                               type: string
                                           type: object
@@ -30722,6 +36833,53 @@ var CRDsValidation map[string]string = map[string]string{
                                     serial:
                                       description: The system-serial-number in SMBIOS
                                       type: string
+                                    smbios:
+                                      description: |-
+                                        SMBIOS defines the system and baseboard information and the OEM strings
+                                        reported in the SMBIOS tables of the vmi.
+                                        Unset values default to the cluster wide SMBIOS configuration.
+                                      properties:
+                                        baseBoard:
+                                          description: BaseBoard overrides the cluster
+                                            wide baseboard information (SMBIOS type
+                                            2).
+                                          properties:
+                                            asset:
+                                              type: string
+                                            manufacturer:
+                                              type: string
+                                            product:
+                                              type: string
+                                            serial:
+                                              type: string
+                                            version:
+                                              type: string
+                                          type: object
+                                        oemStrings:
+                                          description: OEMStrings replace the cluster
+                                            wide OEM strings (SMBIOS type 11).
+                                          items:
+                                            type: string
+                                          maxItems: 32
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        system:
+                                          description: |-
+                                            System overrides the cluster wide system information (SMBIOS type 1).
+                                            The uuid and the serial are set through the firmware.
+                                          properties:
+                                            family:
+                                              type: string
+                                            manufacturer:
+                                              type: string
+                                            product:
+                                              type: string
+                                            sku:
+                                              type: string
+                                            version:
+                                              type: string
+                                          type: object
+                                      type: object
                                     uuid:
                                       description: |-
                                         UUID reported by the vmi bios.
@@ -30794,6 +36952,46 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Memory allow specifying the VMI memory
                                     features.
                                   properties:
+                                    balloon:
+                                      description: |-
+                                        Balloon declares the bounds within which the memory balloon is
+                                        automatically resized, based on the guest memory usage and the node
+                                        memory pressure. Requires the AutoMemoryBalloon feature gate.
+                                      properties:
+                                        max:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            Max is the highest amount of memory the guest can be given back.
+                                            Defaults to the guest memory.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        min:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: Min is the lowest amount of
+                                            memory the guest can be shrunk to.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                      required:
+                                      - min
+                                      type: object
+                                    freePageReporting:
+                                      description: |-
+                                        FreePageReporting determines if the memory balloon reports free guest pages
+                                        back to the host.
+                                        Enabling it requires free page reporting to be allowed on the cluster and
+                                        is not possible for high performance VirtualMachineInstances.
+                                        Defaults to the cluster configuration.
+                                      properties:
+                                        enabled:
+                                          description: |-
+                                            Enabled determines if the feature should be enabled or disabled on the guest.
+                                            Defaults to true.
+                                          type: boolean
+                                      type: object
                                     guest:
                                       anyOf:
                                       - type: integer
@@ -30815,6 +37013,19 @@ var CRDsValidation map[string]string = map[string]string{
                                             are 1Gi and 2Mi.
                                           type: string
                                       type: object
+                                    ksm:
+                                      description: |-
+                                        KSM determines if the memory of the VirtualMachineInstance can be merged
+                                        by Kernel Samepage Merging on nodes where KSM is enabled.
+                                        Enabling it requires KSM to be configured on the cluster.
+                                        Defaults to true.
+                                      properties:
+                                        enabled:
+                                          description: |-
+                                            Enabled determines if the feature should be enabled or disabled on the guest.
+                                            Defaults to true.
+                                          type: boolean
+                                      type: object
                                     maxGuest:
                                       anyOf:
                                       - type: integer
@@ -30824,6 +37035,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                                       pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                                       x-kubernetes-int-or-string: true
+                                    overcommitClass:
+                                      description: |-
+                                        OvercommitClass selects one of the memory overcommit classes defined
+                                        in the KubeVirt configuration. The ratio of the class is used instead
+                                        of the cluster wide memory overcommit.
+                                      type: string
                                     reservedOverhead:
                                       description: |-
                                         ReservedOverhead configures the memory overhead applied to a VM
@@ -30852,6 +37069,42 @@ var CRDsValidation map[string]string = map[string]string{
                                           - Required
                                           type: string
                                       type: object
+                                    suspendToDisk:
+                                      description: |-
+                                        SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage
+                                        of the VirtualMachineInstance is sized to also hold the guest memory.
+                                        Requires the SuspendToDisk feature gate.
+                                      type: boolean
+                                  type: object
+                                qemuCommandLine:
+                                  description: |-
+                                    QEMUCommandLine holds raw arguments appended to the QEMU command line.
+                                    The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.
+                                    Requires the QEMUArgsPassthrough feature gate.
+                                  properties:
+                                    args:
+                                      description: Args are appended to the QEMU command
+                                        line in the given order.
+                                      items:
+                                        description: QEMUArg is a QEMU command line
+                                          option and its value, e.g. "-device" and
+                                          "pvpanic".
+                                        properties:
+                                          name:
+                                            description: Name is the QEMU option,
+                                              including its leading dash, e.g. "-device".
+                                            type: string
+                                          value:
+                                            description: Value of the option, omitted
+                                              for options without a value.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - args
                                   type: object
                                 rebootPolicy:
                                   description: |-
@@ -30900,6 +37153,13 @@ var CRDsValidation map[string]string = map[string]string{
                               required:
                               - devices
                               type: object
+                            evictionMigrationTimeoutSeconds:
+                              description: |-
+                                EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be
+                                migrated on eviction with the "LiveMigrateOrShutdown" eviction strategy, before it is shutdown.
+                                Overrides the cluster wide evictionMigrationTimeout of the migration configuration.
+                              format: int64
+                              type: integer
                             evictionStrategy:
                               description: |-
                                 EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -30907,13 +37167,74 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
                                 - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
+                                - "LiveMigrateOrShutdown": the same as "LiveMigrateIfPossible", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout.
                                 - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            guestAgentCommands:
+                              description: |-
+                                GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in
+                                the guest. A command must be allowed by both this and the cluster wide configuration.
+                              properties:
+                                allowedCommands:
+                                  description: AllowedCommands are the only commands
+                                    which may be invoked. All commands are allowed
+                                    if empty.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
+                                deniedCommands:
+                                  description: DeniedCommands may never be invoked,
+                                    even if they are allowed.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
+                              type: object
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
                                 If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                               type: string
+                            ignition:
+                              description: |-
+                                Ignition provisions CoreOS-family guests with an Ignition config. Unlike the
+                                kubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and
+                                be made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.
+                              properties:
+                                fragments:
+                                  description: |-
+                                    Fragments make up the Ignition config. A single fragment is passed to the guest as is, several
+                                    fragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.
+                                  items:
+                                    description: IgnitionFragment is a part of the
+                                      Ignition config. Exactly one of its sources
+                                      must be set.
+                                    properties:
+                                      data:
+                                        description: Data is an inline Ignition config.
+                                        type: string
+                                      volumeName:
+                                        description: |-
+                                          VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance,
+                                          holding the Ignition config under the config.ign key.
+                                        type: string
+                                    type: object
+                                  maxItems: 16
+                                  minItems: 1
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                propagation:
+                                  description: |-
+                                    Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig.
+                                    ConfigDrive requires a cloudInitConfigDrive volume without user data.
+                                  enum:
+                                  - FirmwareConfig
+                                  - ConfigDrive
+                                  type: string
+                              required:
+                              - fragments
+                              type: object
                             livenessProbe:
                               description: |-
                                 Periodic probe of VirtualMachineInstance liveness.
@@ -30945,6 +37266,18 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                guestAgentFileExists:
+                                  description: |-
+                                    GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                                    If the guest agent is not available, this probe will fail.
+                                  properties:
+                                    path:
+                                      description: Path is the absolute path of the
+                                        file in the guest.
+                                      type: string
+                                  required:
+                                  - path
+                                  type: object
                                 guestAgentPing:
                                   description: |-
                                     GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -31136,6 +37469,29 @@ var CRDsValidation map[string]string = map[string]string{
                                 Selector which must match a node's labels for the vmi to be scheduled on that node.
                                 More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                               type: object
+                            preShutdownHook:
+                              description: |-
+                                PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the
+                                applications of the guest, e.g. to let a database stop cleanly.
+                              properties:
+                                args:
+                                  description: Args are passed to the command.
+                                  items:
+                                    type: string
+                                  type: array
+                                command:
+                                  description: Command is the path of the executable
+                                    in the guest, e.g. a script stopping a database.
+                                  type: string
+                                timeoutSeconds:
+                                  description: |-
+                                    TimeoutSeconds is the part of the termination grace period reserved for the hook.
+                                    It must be shorter than terminationGracePeriodSeconds. Defaults to 10.
+                                  format: int64
+                                  type: integer
+                              required:
+                              - command
+                              type: object
                             priorityClassName:
                               description: |-
                                 If specified, indicates the pod's priority.
@@ -31173,6 +37529,18 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                guestAgentFileExists:
+                                  description: |-
+                                    GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+                                    If the guest agent is not available, this probe will fail.
+                                  properties:
+                                    path:
+                                      description: Path is the absolute path of the
+                                        file in the guest.
+                                      type: string
+                                  required:
+                                  - path
+                                  type: object
                                 guestAgentPing:
                                   description: |-
                                     GuestAgentPing contacts the qemu-guest-agent for availability checks.
@@ -31681,6 +38049,12 @@ var CRDsValidation map[string]string = map[string]string{
                                       The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                       More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                                     properties:
+                                      generateNetworkData:
+                                        description: |-
+                                          GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the
+                                          VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.
+                                          Can not be combined with another networkdata source.
+                                        type: boolean
                                       networkData:
                                         description: NetworkData contains NoCloud
                                           inline cloud-init networkdata.
@@ -32111,6 +38485,87 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                         type: object
                                         x-kubernetes-map-type: atomic
+                                      sources:
+                                        description: |-
+                                          Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file
+                                          along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be
+                                          selected by the items of one of them. Cannot be combined with Secret and ConfigMap.
+                                        items:
+                                          description: SysprepProjection adds the
+                                            keys of a Secret or ConfigMap to a Sysprep
+                                            disk. Exactly one of Secret and ConfigMap
+                                            must be set.
+                                          properties:
+                                            configMap:
+                                              description: ConfigMap references a
+                                                ConfigMap in the namespace of the
+                                                VirtualMachineInstance.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            items:
+                                              description: |-
+                                                Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.
+                                                All keys are added under their own name if empty.
+                                              items:
+                                                description: Maps a string key to
+                                                  a path within a volume.
+                                                properties:
+                                                  key:
+                                                    description: key is the key to
+                                                      project.
+                                                    type: string
+                                                  mode:
+                                                    description: |-
+                                                      mode is Optional: mode bits used to set permissions on this file.
+                                                      Must be an octal value between 0000 and 0777 or a decimal value between 0 and 511.
+                                                      YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                                      If not specified, the volume defaultMode will be used.
+                                                      This might be in conflict with other options that affect the file
+                                                      mode, like fsGroup, and the result can be other mode bits set.
+                                                    format: int32
+                                                    type: integer
+                                                  path:
+                                                    description: |-
+                                                      path is the relative path of the file to map the key to.
+                                                      May not be an absolute path.
+                                                      May not contain the path element '..'.
+                                                      May not start with the string '..'.
+                                                    type: string
+                                                required:
+                                                - key
+                                                - path
+                                                type: object
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            secret:
+                                              description: Secret references a k8s
+                                                Secret in the namespace of the VirtualMachineInstance.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                          type: object
+                                        maxItems: 16
+                                        type: array
+                                        x-kubernetes-list-type: atomic
                                     type: object
                                 required:
                                 - name
@@ -32207,6 +38662,25 @@ var CRDsValidation map[string]string = map[string]string{
                       required:
                       - state
                       type: object
+                    completion:
+                      description: |-
+                        Completion is set once the VirtualMachineInstance of a VirtualMachine with the Once
+                        run strategy reached a final phase. The VirtualMachine is not started again until
+                        the run strategy is changed.
+                      nullable: true
+                      properties:
+                        completionTime:
+                          description: CompletionTime is the time the completion was
+                            observed
+                          format: date-time
+                          type: string
+                        phase:
+                          description: Phase is the final phase the VirtualMachineInstance
+                            reached, either Succeeded or Failed
+                          type: string
+                      required:
+                      - phase
+                      type: object
                     conditions:
                       description: Hold the state information of the VirtualMachine
                         and its VirtualMachineInstance
@@ -32368,6 +38842,28 @@ var CRDsValidation map[string]string = map[string]string{
                         RunStrategy tracks the last recorded RunStrategy used by the VM.
                         This is needed to correctly process the next strategy (for now only the RerunOnFailure)
                       type: string
+                    schedule:
+                      description: Schedule reports the progress of the schedule of
+                        the VirtualMachine
+                      nullable: true
+                      properties:
+                        lastScheduleTime:
+                          description: |-
+                            LastScheduleTime is the last start or stop time which was handled, or the time the schedule
+                            was first evaluated at.
+                          format: date-time
+                          type: string
+                        nextStartTime:
+                          description: NextStartTime is the next time the VirtualMachine
+                            is scheduled to start.
+                          format: date-time
+                          type: string
+                        nextStopTime:
+                          description: NextStopTime is the next time the VirtualMachine
+                            is scheduled to stop.
+                          format: date-time
+                          type: string
+                      type: object
                     snapshotInProgress:
                       description: SnapshotInProgress is the name of the VirtualMachineSnapshot
                         currently executing
@@ -32379,14 +38875,16 @@ var CRDsValidation map[string]string = map[string]string{
                       nullable: true
                       properties:
                         consecutiveFailCount:
+                          description: ConsecutiveFailCount is the number of VirtualMachineInstances
+                            which failed in a row
                           type: integer
                         lastFailedVMIUID:
-                          description: |-
-                            UID is a type that holds unique ID values, including UUIDs.  Because we
-                            don't ONLY use UUIDs, this is an alias to string.  Being a type captures
-                            intent and helps make sure that UIDs and names do not get conflated.
+                          description: LastFailedVMIUID is the UID of the last VirtualMachineInstance
+                            which failed
                           type: string
                         retryAfterTimestamp:
+                          description: RetryAfterTimestamp is the time after which
+                            the VirtualMachine is started again
                           format: date-time
                           type: string
                       type: object
@@ -32415,6 +38913,26 @@ var CRDsValidation map[string]string = map[string]string{
                         - action
                         type: object
                       type: array
+                    suspend:
+                      description: |-
+                        Suspend tracks the suspension of the VirtualMachine to disk. The VirtualMachine is not
+                        started while it is suspended, until it is resumed.
+                      nullable: true
+                      properties:
+                        phase:
+                          description: Phase is the phase of the suspension
+                          type: string
+                        suspendTime:
+                          description: SuspendTime is the time the guest memory was saved
+                          format: date-time
+                          type: string
+                        vmiUID:
+                          description: VMIUID is the UID of the VirtualMachineInstance which got
+                            suspended
+                          type: string
+                      required:
+                      - phase
+                      type: object
                     volumeRequests:
                       description: |-
                         VolumeRequests indicates a list of volumes add or remove from the VMI template and
@@ -40,6 +40,29 @@ var _ = Describe("Webhooks", func() {
 		}
 	})
 
+	It("should declare all webhooks without side effects on dry-run requests", func() {
+		// The apiserver rejects dry-run requests matching webhooks which may have side effects
+		dryRunSafeSideEffects := []v1.SideEffectClass{v1.SideEffectClassNone, v1.SideEffectClassNoneOnDryRun}
+		for _, configuration := range []*v1.ValidatingWebhookConfiguration{
+			NewOpertorValidatingWebhookConfiguration("testnamespace"),
+			NewVirtAPIValidatingWebhookConfiguration("testnamespace"),
+		} {
+			for _, webhook := range configuration.Webhooks {
+				Expect(webhook.SideEffects).ToNot(BeNil(), webhook.Name)
+				Expect(dryRunSafeSideEffects).To(ContainElement(*webhook.SideEffects), webhook.Name)
+			}
+		}
+		for _, configuration := range []*v1.MutatingWebhookConfiguration{
+			NewVirtAPIMutatingWebhookConfiguration("testnamespace"),
+			NewVirtLauncherPodMutatingWebhookConfiguration("testnamespace"),
+		} {
+			for _, webhook := range configuration.Webhooks {
+				Expect(webhook.SideEffects).ToNot(BeNil(), webhook.Name)
+				Expect(dryRunSafeSideEffects).To(ContainElement(*webhook.SideEffects), webhook.Name)
+			}
+		}
+	})
+
 	It("should make all virt-api validating webhook required", func() {
 		configuration := NewVirtAPIValidatingWebhookConfiguration("testnamespace")
 		for _, webhook := range configuration.Webhooks {
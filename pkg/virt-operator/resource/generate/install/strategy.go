@@ -502,6 +502,9 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 		components.NewVirtualMachineCloneCrd, components.NewVirtualMachineBackupCrd,
 		components.NewVirtualMachineBackupTrackerCrd,
 		components.NewPluginCrd,
+		components.NewVirtualMachineDefaultsCrd,
+		components.NewVirtualMachineQuotaCrd,
+		components.NewNodeMaintenanceCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -541,6 +544,14 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 			return nil, err
 		}
 		strategy.prometheusRules = append(strategy.prometheusRules, prometheusRule)
+
+		if config.WorkflowAlertsEnabled() {
+			workflowPrometheusRule, err := rules.BuildWorkflowPrometheusRule(config.GetNamespace())
+			if err != nil {
+				return nil, err
+			}
+			strategy.prometheusRules = append(strategy.prometheusRules, workflowPrometheusRule)
+		}
 	} else {
 		log.Log.Warningf("failed to create ServiceMonitor resources because couldn't find ServiceAccount %v in any monitoring namespaces : %v", monitorServiceAccount, strings.Join(config.GetPotentialMonitorNamespaces(), ", "))
 	}
@@ -636,6 +647,11 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 	strategy.validatingAdmissionPolicyBindings = append(strategy.validatingAdmissionPolicyBindings, vap.NewPluginWarningAdmissionPolicyBinding())
 	strategy.validatingAdmissionPolicies = append(strategy.validatingAdmissionPolicies, vap.NewPluginWarningAdmissionPolicy())
 
+	strategy.validatingAdmissionPolicyBindings = append(strategy.validatingAdmissionPolicyBindings, vap.NewVMSnapshotValidatingAdmissionPolicyBinding())
+	strategy.validatingAdmissionPolicies = append(strategy.validatingAdmissionPolicies, vap.NewVMSnapshotValidatingAdmissionPolicy())
+	strategy.validatingAdmissionPolicyBindings = append(strategy.validatingAdmissionPolicyBindings, vap.NewVMRestoreValidatingAdmissionPolicyBinding())
+	strategy.validatingAdmissionPolicies = append(strategy.validatingAdmissionPolicies, vap.NewVMRestoreValidatingAdmissionPolicy())
+
 	instancetypes, err := components.NewClusterInstancetypes()
 	if err != nil {
 		return nil, fmt.Errorf("error generating instancetypes for environment %v", err)
@@ -29,7 +29,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/defaults"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/quota"
 )
 
 const (
@@ -279,6 +281,39 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					defaults.GroupName,
+				},
+				Resources: []string{
+					defaults.ResourceVirtualMachineDefaultsPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					quota.ResourceVirtualMachineQuotaPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					quota.ResourceVirtualMachineQuotaPlural + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
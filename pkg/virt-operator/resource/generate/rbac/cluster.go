@@ -25,9 +25,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"kubevirt.io/api/backup"
 	"kubevirt.io/api/clone"
+	"kubevirt.io/api/defaults"
 	"kubevirt.io/api/export"
 	"kubevirt.io/api/plugin"
 	"kubevirt.io/api/pool"
+	"kubevirt.io/api/quota"
 	"kubevirt.io/api/snapshot"
 
 	"kubevirt.io/api/instancetype"
@@ -78,6 +80,11 @@ const (
 	apiVMMemoryDump     = "virtualmachines/memorydump"
 	apiVMObjectGraph    = "virtualmachines/objectgraph"
 	apiVMEvacuateCancel = "virtualmachines/evacuate/cancel"
+	apiVMRollback       = "virtualmachines/rollback"
+	apiVMSuspend        = "virtualmachines/suspend"
+	apiVMResume         = "virtualmachines/resume"
+	apiVMInsertMedia    = "virtualmachines/insertmedia"
+	apiVMEjectMedia     = "virtualmachines/ejectmedia"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
@@ -91,9 +98,15 @@ const (
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
 	apiVMInstancesReset                     = "virtualmachineinstances/reset"
+	apiVMInstancesCloudInitRotate           = "virtualmachineinstances/cloudinit/rotate"
+	apiVMInstancesCloudInitEject            = "virtualmachineinstances/cloudinit/eject"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
+	apiVMInstancesGuestOSInfoRefresh        = "virtualmachineinstances/guestosinfo/refresh"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesDomainXML                 = "virtualmachineinstances/domainxml"
+	apiVMInstancesGuestFile                 = "virtualmachineinstances/guestfile"
+	apiVMInstancesGuestExec                 = "virtualmachineinstances/guestexec"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
@@ -216,6 +229,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesDomainXML,
+					apiVMInstancesGuestFile,
+					apiVMInstancesGuestExec,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -239,6 +255,10 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
+					apiVMInstancesCloudInitRotate,
+					apiVMInstancesCloudInitEject,
+					apiVMInstancesGuestFile,
+					apiVMInstancesGuestOSInfoRefresh,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
 					apiVMInstancesEvacuateCancel,
@@ -271,6 +291,11 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
+					apiVMRollback,
+					apiVMSuspend,
+					apiVMResume,
+					apiVMInsertMedia,
+					apiVMEjectMedia,
 				},
 				Verbs: []string{
 					"update",
@@ -370,6 +395,28 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
 				},
 			},
+			{
+				APIGroups: []string{
+					defaults.GroupName,
+				},
+				Resources: []string{
+					defaults.ResourceVirtualMachineDefaultsPlural,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					quota.ResourceVirtualMachineQuotaPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					instancetype.GroupName,
@@ -435,6 +482,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesGuestFile,
+					apiVMInstancesGuestExec,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -458,6 +507,10 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
+					apiVMInstancesCloudInitRotate,
+					apiVMInstancesCloudInitEject,
+					apiVMInstancesGuestFile,
+					apiVMInstancesGuestOSInfoRefresh,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
 					apiVMInstancesEvacuateCancel,
@@ -490,6 +543,11 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
+					apiVMRollback,
+					apiVMSuspend,
+					apiVMResume,
+					apiVMInsertMedia,
+					apiVMEjectMedia,
 				},
 				Verbs: []string{
 					"update",
@@ -578,6 +636,28 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "delete", "create", "update", "patch", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					defaults.GroupName,
+				},
+				Resources: []string{
+					defaults.ResourceVirtualMachineDefaultsPlural,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					quota.ResourceVirtualMachineQuotaPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					instancetype.GroupName,
@@ -784,6 +864,28 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					defaults.GroupName,
+				},
+				Resources: []string{
+					defaults.ResourceVirtualMachineDefaultsPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					quota.ResourceVirtualMachineQuotaPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					instancetype.GroupName,
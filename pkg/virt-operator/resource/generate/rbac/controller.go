@@ -32,7 +32,9 @@ import (
 	"kubevirt.io/api/instancetype"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/maintenance"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/quota"
 )
 
 func GetAllController(namespace string, includeNADRules bool) []runtime.Object {
@@ -416,6 +418,7 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"subresources.kubevirt.io",
 				},
 				Resources: []string{
+					"virtualmachines/start",
 					"virtualmachines/stop",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
@@ -432,6 +435,17 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					"subresources.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstances/userlist",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					"cdi.kubevirt.io",
@@ -547,6 +561,41 @@ func baseControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "update", "patch", "delete",
 				},
 			},
+			{
+				APIGroups: []string{
+					maintenance.GroupName,
+				},
+				Resources: []string{
+					maintenance.ResourceNodeMaintenancePlural,
+					maintenance.ResourceNodeMaintenancePlural + "/status",
+					maintenance.ResourceNodeMaintenancePlural + "/finalizers",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					quota.ResourceVirtualMachineQuotaPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					quota.ResourceVirtualMachineQuotaPlural + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -57,8 +57,20 @@ var _ = Describe("RBAC", func() {
 			Entry("for vmsnapshotcontents", "snapshot.kubevirt.io", "virtualmachinesnapshotcontents"),
 			Entry("for vms", "kubevirt.io", "virtualmachines"),
 			Entry("for vmis", "kubevirt.io", "virtualmachineinstances"),
+			Entry("for nodemaintenances", "maintenance.kubevirt.io", "nodemaintenances"),
 		)
 
+		It("should allow updating the status of VirtualMachineQuotas", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("quota.kubevirt.io"),
+					"Resources": ContainElement("virtualmachinequotas/status"),
+					"Verbs":     ContainElement("update"),
+				})),
+			)
+		})
+
 		It("should include NAD rules when includeNADRules is true", func() {
 			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
 			Expect(clusterRole.Rules).To(
@@ -49,6 +49,8 @@ const (
 	ConditionReasonDeploying                = "DeploymentInProgress"
 	ConditionReasonUpdating                 = "UpdateInProgress"
 	ConditionReasonDeleting                 = "DeletionInProgress"
+	ConditionReasonAPIServiceRegistered     = "APIServiceRegistered"
+	ConditionReasonAPIServiceNotManaged     = "APIServiceNotManaged"
 )
 
 func UpdateConditionsDeploying(kv *virtv1.KubeVirt) {
@@ -111,6 +113,20 @@ func UpdateConditionsDeletionFailed(kv *virtv1.KubeVirt, err error) {
 	updateCondition(kv, virtv1.KubeVirtConditionSynchronized, k8sv1.ConditionFalse, ConditionReasonDeletionFailedError, fmt.Sprintf("An error occurred during deletion: %v", err))
 }
 
+func UpdateConditionsPoolMetricsAdapterRegistered(kv *virtv1.KubeVirt, apiServiceName string) {
+	updateCondition(kv, virtv1.KubeVirtConditionPoolMetricsAdapterRegistered, k8sv1.ConditionTrue, ConditionReasonAPIServiceRegistered,
+		fmt.Sprintf("The APIService %s is served by virt-api.", apiServiceName))
+}
+
+func UpdateConditionsPoolMetricsAdapterNotManaged(kv *virtv1.KubeVirt, apiServiceName string) {
+	updateCondition(kv, virtv1.KubeVirtConditionPoolMetricsAdapterRegistered, k8sv1.ConditionFalse, ConditionReasonAPIServiceNotManaged,
+		fmt.Sprintf("The APIService %s already exists and is not managed by KubeVirt, remove it to use the pool metrics adapter.", apiServiceName))
+}
+
+func RemoveConditionsPoolMetricsAdapter(kv *virtv1.KubeVirt) {
+	removeCondition(kv, virtv1.KubeVirtConditionPoolMetricsAdapterRegistered)
+}
+
 func updateCondition(kv *virtv1.KubeVirt, conditionType virtv1.KubeVirtConditionType, status k8sv1.ConditionStatus, reason string, message string) {
 	condition, isNew := getCondition(kv, conditionType)
 	condition.Status = status
@@ -91,6 +91,9 @@ const (
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesVirtTemplateDeploymentEnabled = "VirtTemplateDeploymentEnabled"
 
+	// lookup key in AdditionalProperties
+	AdditionalPropertiesWorkflowAlertsEnabled = "WorkflowAlertsEnabled"
+
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesHypervisorName = "HypervisorName"
 
@@ -196,6 +199,11 @@ func GetTargetConfigFromKVWithEnvVarManager(kv *v1.KubeVirt, envVarManager EnvVa
 		}
 	}
 
+	if workflowAlerts := kv.Spec.Configuration.WorkflowAlertsDeployment; workflowAlerts != nil &&
+		workflowAlerts.Enabled != nil && *workflowAlerts.Enabled {
+		additionalProperties[AdditionalPropertiesWorkflowAlertsEnabled] = ""
+	}
+
 	if isFeatureGateEnabledInKvConfig(&kv.Spec.Configuration, featuregate.ExternalNetResourceInjection) {
 		additionalProperties[AdditionalPropertiesExternalNetResourceInjection] = ""
 	}
@@ -510,6 +518,11 @@ func SetDefaultArchitecture(kv *v1.KubeVirt) {
 	}
 }
 
+// SetFeatureGatesStatus reports the lifecycle of the feature gates known to this KubeVirt version
+func SetFeatureGatesStatus(kv *v1.KubeVirt) {
+	kv.Status.FeatureGates = featuregate.Status(kv.Spec.Configuration.DeveloperConfiguration)
+}
+
 func (c *KubeVirtDeploymentConfig) SetObservedDeploymentConfig(kv *v1.KubeVirt) error {
 	kv.Status.ObservedKubeVirtVersion = c.GetKubeVirtVersion()
 	kv.Status.ObservedKubeVirtRegistry = c.GetImageRegistry()
@@ -562,6 +575,11 @@ func (c *KubeVirtDeploymentConfig) VirtTemplateDeploymentEnabled() bool {
 	return enabled
 }
 
+func (c *KubeVirtDeploymentConfig) WorkflowAlertsEnabled() bool {
+	_, enabled := c.AdditionalProperties[AdditionalPropertiesWorkflowAlertsEnabled]
+	return enabled
+}
+
 func (c *KubeVirtDeploymentConfig) ExternalNetResourceInjectionEnabled() bool {
 	_, enabled := c.AdditionalProperties[AdditionalPropertiesExternalNetResourceInjection]
 	return enabled
@@ -29,6 +29,8 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Operator Config", func() {
@@ -141,6 +143,18 @@ var _ = Describe("Operator Config", func() {
 			Expect(cfgWith.ID).ToNot(Equal(cfgWithout.ID))
 		})
 
+		It("should result in different ID when the workflow alerts are enabled", func() {
+			kv := &v1.KubeVirt{}
+			cfgWithout := GetTargetConfigFromKV(kv)
+
+			kv.Spec.Configuration.WorkflowAlertsDeployment = &v1.WorkflowAlertsDeployment{Enabled: pointer.P(true)}
+			cfgWith := GetTargetConfigFromKV(kv)
+
+			Expect(cfgWithout.WorkflowAlertsEnabled()).To(BeFalse())
+			Expect(cfgWith.WorkflowAlertsEnabled()).To(BeTrue())
+			Expect(cfgWith.ID).ToNot(Equal(cfgWithout.ID))
+		})
+
 		DescribeTable("should result in different ID when component images change", func(setImage func(*KubeVirtDeploymentConfig, string)) {
 			cfgA := &KubeVirtDeploymentConfig{}
 			cfgA.AdditionalProperties = make(map[string]string)
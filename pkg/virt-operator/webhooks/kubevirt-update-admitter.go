@@ -23,9 +23,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"path/filepath"
 	"slices"
 	"strconv"
+	"strings"
+	"time"
 
+	"kubevirt.io/kubevirt/pkg/util/cron"
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 
@@ -39,6 +44,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -46,6 +52,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/util/webhooks/deprecation"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
 )
@@ -110,12 +117,66 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.EventConfiguration, newKV.Spec.Configuration.EventConfiguration) {
+		results = append(results,
+			validateEventConfiguration(field.NewPath("spec").Child("configuration", "eventConfiguration"), newKV.Spec.Configuration.EventConfiguration)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.DomainStatsConfiguration, newKV.Spec.Configuration.DomainStatsConfiguration) {
+		results = append(results,
+			validateDomainStatsConfiguration(field.NewPath("spec").Child("configuration", "domainStatsConfiguration"), newKV.Spec.Configuration.DomainStatsConfiguration)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.VirtualMachineOptions, newKV.Spec.Configuration.VirtualMachineOptions) &&
+		newKV.Spec.Configuration.VirtualMachineOptions != nil {
+		results = append(results,
+			validateCrashLoopBackoff(field.NewPath("spec").Child("configuration", "virtualMachineOptions", "crashLoopBackoff"), newKV.Spec.Configuration.VirtualMachineOptions.CrashLoopBackoff)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.VMInfoMetrics, newKV.Spec.Configuration.VMInfoMetrics) {
+		results = append(results,
+			validateVMInfoMetrics(field.NewPath("spec").Child("configuration", "vmInfoMetrics"), newKV.Spec.Configuration.VMInfoMetrics)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.GuestExec, newKV.Spec.Configuration.GuestExec) {
+		results = append(results,
+			validateGuestExec(field.NewPath("spec").Child("configuration", "guestExec"), newKV.Spec.Configuration.GuestExec)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.PermittedHostDevices, newKV.Spec.Configuration.PermittedHostDevices) {
+		results = append(results,
+			validatePermittedHostDevices(field.NewPath("spec").Child("configuration", "permittedHostDevices"), newKV.Spec.Configuration.PermittedHostDevices)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.ArchitectureConfiguration, newKV.Spec.Configuration.ArchitectureConfiguration) ||
+		featureGatesChanged(&currKV.Spec, &newKV.Spec) {
+		results = append(results,
+			validateArchitectureConfiguration(field.NewPath("spec").Child("configuration", "architectureConfiguration"), &newKV.Spec.Configuration)...)
+	}
+
 	if newKV.Spec.Infra != nil {
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.RolloutStrategy, newKV.Spec.RolloutStrategy) && newKV.Spec.RolloutStrategy != nil {
+		results = append(results,
+			validateNodePoolRolloutStrategy(field.NewPath("spec").Child("rolloutStrategy", "virtHandler"), newKV.Spec.RolloutStrategy.VirtHandler)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.WorkloadUpdateStrategy, newKV.Spec.WorkloadUpdateStrategy) {
+		results = append(results,
+			validateWorkloadUpdateStrategy(field.NewPath("spec").Child("workloadUpdateStrategy"), &newKV.Spec.WorkloadUpdateStrategy)...)
+	}
+
+	// only changes of the configuration are validated, so that an existing
+	// configuration does not block unrelated updates of the KubeVirt CR
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration, newKV.Spec.Configuration) {
+		results = append(results, validateClusterConfiguration(field.NewPath("spec", "configuration"), newKV)...)
+	}
+
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
 		results = append(results, validateFeatureGates(newKV.Spec.Configuration.DeveloperConfiguration)...)
+		results = append(results, validateLockedFeatureGates(currKV.Spec.Configuration.DeveloperConfiguration, newKV.Spec.Configuration.DeveloperConfiguration)...)
 	}
 
 	response := validating_webhooks.NewAdmissionResponse(results)
@@ -125,24 +186,7 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 		response.Warnings = append(response.Warnings, warnDeprecatedFeatureGates(featureGates)...)
 	}
 
-	const mdevWarningfmt = "%s is deprecated, use mediatedDeviceTypes"
-	if mdev := newKV.Spec.Configuration.MediatedDevicesConfiguration; mdev != nil {
-		f := field.NewPath("spec", "configuration", "mediatedDevicesConfiguration")
-		if mdev.MediatedDevicesTypes != nil {
-			f := f.Child("mediatedDevicesTypes")
-			response.Warnings = append(response.Warnings, fmt.Sprintf(mdevWarningfmt, f.String()))
-		}
-
-		f = f.Child("nodeMediatedDeviceTypes")
-		for i, mdevType := range mdev.NodeMediatedDeviceTypes {
-			f := f.Index(i).Child("mediatedDevicesTypes")
-			if mdevType.MediatedDevicesTypes != nil {
-				response.Warnings = append(response.Warnings, fmt.Sprintf(mdevWarningfmt, f.String()))
-			}
-		}
-	}
-
-	response.Warnings = append(response.Warnings, warnDeprecatedArchitectures(newKV.Spec.Configuration.ArchitectureConfiguration)...)
+	response.Warnings = append(response.Warnings, deprecation.Warnings(deprecation.KubeVirtSpecFields, field.NewPath("spec"), &newKV.Spec)...)
 
 	return response
 }
@@ -155,7 +199,7 @@ func getAdmissionReviewKubeVirt(ar *admissionv1.AdmissionReview) (new *v1.KubeVi
 	raw := ar.Request.Object.Raw
 	newKV := v1.KubeVirt{}
 
-	err = json.Unmarshal(raw, &newKV)
+	err = webhookutils.DecodeObject(raw, &newKV)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -163,7 +207,7 @@ func getAdmissionReviewKubeVirt(ar *admissionv1.AdmissionReview) (new *v1.KubeVi
 	if ar.Request.Operation == admissionv1.Update {
 		raw := ar.Request.OldObject.Raw
 		oldKV := v1.KubeVirt{}
-		err = json.Unmarshal(raw, &oldKV)
+		err = webhookutils.DecodeObject(raw, &oldKV)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -318,6 +362,267 @@ func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConf
 
 }
 
+func validateEventConfiguration(field *field.Path, eventConf *v1.EventConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if eventConf == nil {
+		return causes
+	}
+
+	if rateLimiter := eventConf.RateLimiter; rateLimiter != nil {
+		if rateLimiter.QPS < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("rateLimiter", "qps").String(),
+				Message: fmt.Sprintf("%s must not be negative", field.Child("rateLimiter", "qps").String()),
+			})
+		}
+		if rateLimiter.Burst < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("rateLimiter", "burst").String(),
+				Message: fmt.Sprintf("%s must not be negative", field.Child("rateLimiter", "burst").String()),
+			})
+		}
+	}
+
+	if eventConf.DeduplicationWindow != nil && eventConf.DeduplicationWindow.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("deduplicationWindow").String(),
+			Message: fmt.Sprintf("%s must not be negative", field.Child("deduplicationWindow").String()),
+		})
+	}
+
+	for i, reason := range eventConf.SuppressedReasons {
+		if reason == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("suppressedReasons").Index(i).String(),
+				Message: fmt.Sprintf("%s must not be empty", field.Child("suppressedReasons").Index(i).String()),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateDomainStatsConfiguration(field *field.Path, domainStatsConf *v1.DomainStatsConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if domainStatsConf == nil {
+		return causes
+	}
+
+	supportedCollectors := []v1.DomainStatsCollector{
+		v1.DomainStatsCollectorBlock,
+		v1.DomainStatsCollectorVCPU,
+		v1.DomainStatsCollectorNetwork,
+	}
+	for i, collector := range domainStatsConf.DisabledCollectors {
+		if !slices.Contains(supportedCollectors, collector) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   field.Child("disabledCollectors").Index(i).String(),
+				Message: fmt.Sprintf("%s is not a supported collector, supported collectors are %v", collector, supportedCollectors),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateCrashLoopBackoff(field *field.Path, backoff *v1.CrashLoopBackoff) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if backoff == nil {
+		return causes
+	}
+
+	for _, delay := range []struct {
+		name  string
+		value *metav1.Duration
+	}{{"baseDelay", backoff.BaseDelay}, {"maxDelay", backoff.MaxDelay}, {"resetWindow", backoff.ResetWindow}} {
+		if delay.value != nil && delay.value.Duration < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child(delay.name).String(),
+				Message: fmt.Sprintf("%s must not be negative", field.Child(delay.name).String()),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateVMInfoMetrics(field *field.Path, vmInfoMetrics *v1.VMInfoMetricsConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if vmInfoMetrics == nil {
+		return causes
+	}
+
+	supportedLabels := []v1.VMInfoMetricsLabel{
+		v1.VMInfoMetricsLabelInstancetype,
+		v1.VMInfoMetricsLabelPreference,
+		v1.VMInfoMetricsLabelGuestOS,
+		v1.VMInfoMetricsLabelRunStrategy,
+		v1.VMInfoMetricsLabelOwner,
+	}
+	for i, label := range vmInfoMetrics.DisabledLabels {
+		if !slices.Contains(supportedLabels, label) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   field.Child("disabledLabels").Index(i).String(),
+				Message: fmt.Sprintf("%s is not a supported label, supported labels are %v", label, supportedLabels),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateGuestExec(field *field.Path, guestExec *v1.GuestExecConfiguration) []metav1.StatusCause {
+	if guestExec == nil || guestExec.NamespaceSelector == nil {
+		return nil
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(guestExec.NamespaceSelector); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("namespaceSelector").String(),
+			Message: fmt.Sprintf("%s is invalid: %v", field.Child("namespaceSelector").String(), err),
+		}}
+	}
+	return nil
+}
+
+func validateArchitectureConfiguration(field *field.Path, config *v1.KubeVirtConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	archConfig := config.ArchitectureConfiguration
+	if archConfig == nil {
+		return causes
+	}
+
+	for _, arch := range []struct {
+		name   string
+		config *v1.ArchSpecificConfiguration
+	}{{"amd64", archConfig.Amd64}, {"arm64", archConfig.Arm64}, {"ppc64le", archConfig.Ppc64le}, {"s390x", archConfig.S390x}} {
+		if arch.config != nil && arch.config.Firmware != nil {
+			causes = append(causes, validateEFIFirmware(field.Child(arch.name, "firmware"), arch.config.Firmware, config)...)
+		}
+		if arch.config != nil {
+			causes = append(causes, validateMachineTypeAliases(field.Child(arch.name, "machineTypeAliases"), arch.config.MachineTypeAliases)...)
+		}
+	}
+
+	return causes
+}
+
+// validateMachineTypeAliases rejects empty aliases and machine types, and aliases standing for other aliases,
+// which are not resolved recursively
+func validateMachineTypeAliases(field *field.Path, aliases map[string]string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, alias := range slices.Sorted(maps.Keys(aliases)) {
+		machineType := aliases[alias]
+		switch {
+		case alias == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.String(),
+				Message: fmt.Sprintf("%s must not contain an empty alias", field.String()),
+			})
+		case machineType == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   field.Key(alias).String(),
+				Message: fmt.Sprintf("%s must be set", field.Key(alias).String()),
+			})
+		default:
+			if _, isAlias := aliases[machineType]; isAlias {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   field.Key(alias).String(),
+					Message: fmt.Sprintf("%s must be a machine type, not the alias %s", field.Key(alias).String(), machineType),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func validateEFIFirmware(field *field.Path, firmware *v1.EFIFirmwareConfiguration, config *v1.KubeVirtConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if firmware.Image != "" && !hasFeatureGateEnabled(config, featuregate.ImageVolume) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("image").String(),
+			Message: fmt.Sprintf("%s requires the %s feature gate", field.Child("image").String(), featuregate.ImageVolume),
+		})
+	}
+
+	for _, variant := range []struct {
+		name   string
+		images *v1.EFIFirmwareImages
+	}{
+		{"default", firmware.Default},
+		{"secureBoot", firmware.SecureBoot},
+		{"sev", firmware.SEV},
+		{"snp", firmware.SNP},
+		{"tdx", firmware.TDX},
+		{"tdxSecureBoot", firmware.TDXSecureBoot},
+	} {
+		if variant.images == nil {
+			continue
+		}
+		for _, image := range []struct {
+			name     string
+			fileName string
+		}{{"code", variant.images.Code}, {"vars", variant.images.Vars}} {
+			imageField := field.Child(variant.name, image.name)
+			if image.name == "code" && image.fileName == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Field:   imageField.String(),
+					Message: fmt.Sprintf("%s must be set", imageField.String()),
+				})
+			}
+			if filepath.IsAbs(image.fileName) || slices.Contains(strings.Split(image.fileName, "/"), "..") {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   imageField.String(),
+					Message: fmt.Sprintf("%s must be a file name relative to the firmware directory, without '..'", imageField.String()),
+				})
+			}
+		}
+	}
+
+	return causes
+}
+
+func validatePermittedHostDevices(field *field.Path, hostDevs *v1.PermittedHostDevices) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if hostDevs == nil {
+		return causes
+	}
+
+	for i, rule := range hostDevs.PciDiscoveryRules {
+		ruleField := field.Child("pciDiscoveryRules").Index(i)
+		if _, err := filepath.Match(rule.PCIVendorSelector, ""); err != nil || rule.PCIVendorSelector == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   ruleField.Child("pciVendorSelector").String(),
+				Message: fmt.Sprintf("%q is not a valid vendor_id:product_id glob", rule.PCIVendorSelector),
+			})
+		}
+		if rule.ResourceName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   ruleField.Child("resourceName").String(),
+				Message: "a resource name is required",
+			})
+		}
+	}
+
+	return causes
+}
+
 func validateWorkloadPlacement(ctx context.Context, namespace string, placementConfig *v1.NodePlacement, client kubecli.KubevirtClient) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 
@@ -441,6 +746,101 @@ func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	return statuses
 }
 
+func validateNodePoolRolloutStrategy(field *field.Path, strategy *v1.NodePoolRolloutStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if strategy == nil {
+		return causes
+	}
+
+	if strategy.NodePoolLabel == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   field.Child("nodePoolLabel").String(),
+			Message: fmt.Sprintf("%s must not be empty", field.Child("nodePoolLabel").String()),
+		})
+	} else if errs := k8svalidation.IsQualifiedName(strategy.NodePoolLabel); len(errs) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("nodePoolLabel").String(),
+			Message: fmt.Sprintf("%s is not a valid label key: %s", field.Child("nodePoolLabel").String(), strings.Join(errs, ", ")),
+		})
+	}
+
+	if strategy.MaxUnavailable != nil && *strategy.MaxUnavailable < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("maxUnavailable").String(),
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("maxUnavailable").String()),
+		})
+	}
+
+	for _, value := range []struct {
+		name  string
+		value *int32
+	}{{"poolSoakSeconds", strategy.PoolSoakSeconds}, {"errorBudget", strategy.ErrorBudget}} {
+		if value.value != nil && *value.value < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child(value.name).String(),
+				Message: fmt.Sprintf("%s must not be negative", field.Child(value.name).String()),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateWorkloadUpdateStrategy(field *field.Path, strategy *v1.KubeVirtWorkloadUpdateStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if strategy.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(strategy.NamespaceSelector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("namespaceSelector").String(),
+				Message: fmt.Sprintf("%s is invalid: %v", field.Child("namespaceSelector").String(), err),
+			})
+		}
+	}
+
+	if strategy.MaxUpdatesPerNamespace != nil && *strategy.MaxUpdatesPerNamespace < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("maxUpdatesPerNamespace").String(),
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("maxUpdatesPerNamespace").String()),
+		})
+	}
+
+	for i, window := range strategy.Windows {
+		windowField := field.Child("windows").Index(i)
+		if _, err := cron.Parse(window.Start); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   windowField.Child("start").String(),
+				Message: fmt.Sprintf("%s is not a valid cron expression: %v", windowField.Child("start").String(), err),
+			})
+		}
+		if window.Duration.Duration <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   windowField.Child("duration").String(),
+				Message: fmt.Sprintf("%s must be greater than zero", windowField.Child("duration").String()),
+			})
+		}
+		if window.TimeZone != nil {
+			if _, err := time.LoadLocation(*window.TimeZone); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   windowField.Child("timeZone").String(),
+					Message: fmt.Sprintf("%s is not a valid time zone: %v", windowField.Child("timeZone").String(), err),
+				})
+			}
+		}
+	}
+
+	return causes
+}
+
 func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 	currDevConfig := currKVSpec.Configuration.DeveloperConfiguration
 	newDevConfig := newKVSpec.Configuration.DeveloperConfiguration
@@ -458,7 +858,7 @@ func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 func warnDeprecatedFeatureGates(featureGates []string) (warnings []string) {
 	for _, featureGate := range featureGates {
 		deprectedFeature := featuregate.FeatureGateInfo(featureGate)
-		if deprectedFeature != nil {
+		if deprectedFeature != nil && deprectedFeature.Message != "" {
 			warning := deprectedFeature.Message
 			warnings = append(warnings, warning)
 			log.Log.Warning(warning)
@@ -468,13 +868,6 @@ func warnDeprecatedFeatureGates(featureGates []string) (warnings []string) {
 	return warnings
 }
 
-func warnDeprecatedArchitectures(archConfiguration *v1.ArchConfiguration) []string {
-	if archConfiguration != nil && archConfiguration.Ppc64le != nil {
-		return []string{"spec.configuration.architectureConfiguration.ppc64le is deprecated and no longer supported."}
-	}
-	return nil
-}
-
 func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.StatusCause) {
 	if ratioStrPtr == nil {
 		return
@@ -531,6 +924,47 @@ func hasFeatureGateEnabled(config *v1.KubeVirtConfiguration, gate string) bool {
 	return featuregate.IsEnabled(gate, config.DeveloperConfiguration)
 }
 
+// validateLockedFeatureGates rejects feature gates of graduated or discontinued features,
+// gates which were already set are only warned about to not block existing configurations
+func validateLockedFeatureGates(currDevConfig, newDevConfig *v1.DeveloperConfiguration) (causes []metav1.StatusCause) {
+	if newDevConfig == nil {
+		return
+	}
+	if currDevConfig == nil {
+		currDevConfig = &v1.DeveloperConfiguration{}
+	}
+
+	devConfigField := field.NewPath("spec", "configuration", "developerConfiguration")
+	for i, gate := range newDevConfig.FeatureGates {
+		fg := featuregate.FeatureGateInfo(gate)
+		if fg == nil || !fg.LockedToDefault() || slices.Contains(currDevConfig.FeatureGates, gate) {
+			continue
+		}
+		message := fmt.Sprintf(`feature gate "%s" graduated to GA and is always enabled, it has to be removed from "FeatureGates"`, gate)
+		if fg.State == featuregate.Discontinued {
+			message = fmt.Sprintf(`feature gate "%s" is discontinued and can no longer be enabled: %s`, gate, fg.Message)
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: message,
+			Field:   devConfigField.Child("featureGates").Index(i).String(),
+		})
+	}
+	for i, gate := range newDevConfig.DisabledFeatureGates {
+		fg := featuregate.FeatureGateInfo(gate)
+		if fg == nil || fg.State != featuregate.GA || slices.Contains(currDevConfig.DisabledFeatureGates, gate) {
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf(`feature gate "%s" graduated to GA and can no longer be disabled, it has to be removed from "DisabledFeatureGates"`, gate),
+			Field:   devConfigField.Child("disabledFeatureGates").Index(i).String(),
+		})
+	}
+
+	return causes
+}
+
 func validateVirtTemplateDeployment(config *v1.KubeVirtConfiguration) []metav1.StatusCause {
 	virtTemplateDeployment := config.VirtTemplateDeployment
 	if virtTemplateDeployment == nil || virtTemplateDeployment.Enabled == nil || !*virtTemplateDeployment.Enabled {
@@ -563,3 +997,197 @@ func validateRoleAggregationStrategy(config *v1.KubeVirtConfiguration) []metav1.
 		Message: fmt.Sprintf("RoleAggregationStrategy cannot be set to Manual without enabling the %s feature gate", featuregate.OptOutRoleAggregation),
 	}}
 }
+
+func validateClusterConfiguration(field *field.Path, kv *v1.KubeVirt) []metav1.StatusCause {
+	config := &kv.Spec.Configuration
+
+	var causes []metav1.StatusCause
+	causes = append(causes, validateMigrationConfiguration(field.Child("migrations"), config.MigrationConfiguration)...)
+	causes = append(causes, validateNetworkConfiguration(field.Child("network"), config.NetworkConfiguration)...)
+	causes = append(causes, validateHypervisors(field.Child("hypervisors"), config)...)
+	if len(causes) > 0 {
+		return causes
+	}
+
+	if err := virtconfig.ValidateKubeVirtConfiguration(kv); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s is invalid: %v", field.String(), err),
+		})
+	}
+	return causes
+}
+
+func validateMigrationConfiguration(field *field.Path, config *v1.MigrationConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if config == nil {
+		return causes
+	}
+
+	perCluster := virtconfig.ParallelMigrationsPerClusterDefault
+	if config.ParallelMigrationsPerCluster != nil {
+		perCluster = *config.ParallelMigrationsPerCluster
+	}
+	perNode := virtconfig.ParallelOutboundMigrationsPerNodeDefault
+	if config.ParallelOutboundMigrationsPerNode != nil {
+		perNode = *config.ParallelOutboundMigrationsPerNode
+	}
+	if perCluster == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("parallelMigrationsPerCluster").String(),
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("parallelMigrationsPerCluster").String()),
+		})
+	}
+	if perNode == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("parallelOutboundMigrationsPerNode").String(),
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("parallelOutboundMigrationsPerNode").String()),
+		})
+	} else if perCluster > 0 && perNode > perCluster {
+		causes = append(causes, metav1.StatusCause{
+			Type:  metav1.CauseTypeFieldValueInvalid,
+			Field: field.Child("parallelOutboundMigrationsPerNode").String(),
+			Message: fmt.Sprintf("%s (%d) must not exceed %s (%d)",
+				field.Child("parallelOutboundMigrationsPerNode").String(), perNode, field.Child("parallelMigrationsPerCluster").String(), perCluster),
+		})
+	}
+
+	if config.BandwidthPerMigration != nil && config.BandwidthPerMigration.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("bandwidthPerMigration").String(),
+			Message: fmt.Sprintf("%s must not be negative", field.Child("bandwidthPerMigration").String()),
+		})
+	}
+
+	for _, timeout := range []struct {
+		name  string
+		value *int64
+	}{
+		{"completionTimeoutPerGiB", config.CompletionTimeoutPerGiB},
+		{"progressTimeout", config.ProgressTimeout},
+		{"utilityVolumesTimeout", config.UtilityVolumesTimeout},
+		{"evictionMigrationTimeout", config.EvictionMigrationTimeout},
+	} {
+		if timeout.value != nil && *timeout.value <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child(timeout.name).String(),
+				Message: fmt.Sprintf("%s must be greater than zero", field.Child(timeout.name).String()),
+			})
+		}
+	}
+
+	// post-copy is only switched to when the completion timeout may disrupt the workload
+	if config.AllowPostCopy != nil && *config.AllowPostCopy &&
+		config.AllowWorkloadDisruption != nil && !*config.AllowWorkloadDisruption {
+		causes = append(causes, metav1.StatusCause{
+			Type:  metav1.CauseTypeFieldValueInvalid,
+			Field: field.Child("allowPostCopy").String(),
+			Message: fmt.Sprintf("%s requires %s to be enabled",
+				field.Child("allowPostCopy").String(), field.Child("allowWorkloadDisruption").String()),
+		})
+	}
+
+	if config.Network != nil {
+		if errs := k8svalidation.IsDNS1123Subdomain(*config.Network); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("network").String(),
+				Message: fmt.Sprintf("%s is not a valid network name: %s", field.Child("network").String(), strings.Join(errs, ", ")),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateNetworkConfiguration(field *field.Path, config *v1.NetworkConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if config == nil {
+		return causes
+	}
+
+	switch config.NetworkInterface {
+	case string(v1.BridgeInterface):
+		if config.PermitBridgeInterfaceOnPodNetwork != nil && !*config.PermitBridgeInterfaceOnPodNetwork {
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueInvalid,
+				Field: field.Child("defaultNetworkInterface").String(),
+				Message: fmt.Sprintf("%s can not be %s while %s is disabled",
+					field.Child("defaultNetworkInterface").String(), v1.BridgeInterface, field.Child("permitBridgeInterfaceOnPodNetwork").String()),
+			})
+		}
+	case string(v1.DeprecatedSlirpInterface):
+		if config.DeprecatedPermitSlirpInterface == nil || !*config.DeprecatedPermitSlirpInterface {
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueInvalid,
+				Field: field.Child("defaultNetworkInterface").String(),
+				Message: fmt.Sprintf("%s can not be %s while %s is disabled",
+					field.Child("defaultNetworkInterface").String(), v1.DeprecatedSlirpInterface, field.Child("permitSlirpInterface").String()),
+			})
+		}
+	}
+
+	for name, binding := range config.Binding {
+		bindingField := field.Child("binding").Key(name)
+		if binding.SidecarImage == "" && binding.DomainAttachmentType == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueRequired,
+				Field: bindingField.String(),
+				Message: fmt.Sprintf("%s must set %s or %s", bindingField.String(),
+					bindingField.Child("sidecarImage").String(), bindingField.Child("domainAttachmentType").String()),
+			})
+		}
+		switch binding.DomainAttachmentType {
+		case "", v1.Tap, v1.ManagedTap:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   bindingField.Child("domainAttachmentType").String(),
+				Message: fmt.Sprintf("%s %q is not supported", bindingField.Child("domainAttachmentType").String(), binding.DomainAttachmentType),
+			})
+		}
+		switch binding.DownwardAPI {
+		case "", v1.DeviceInfo:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   bindingField.Child("downwardAPI").String(),
+				Message: fmt.Sprintf("%s %q is not supported", bindingField.Child("downwardAPI").String(), binding.DownwardAPI),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateHypervisors rejects hypervisor configurations which the components
+// would ignore: only the first hypervisor is used, and only with the
+// ConfigurableHypervisor feature gate
+func validateHypervisors(field *field.Path, config *v1.KubeVirtConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(config.Hypervisors) == 0 {
+		return causes
+	}
+
+	if !hasFeatureGateEnabled(config, featuregate.ConfigurableHypervisor) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s requires the %s feature gate", field.String(), featuregate.ConfigurableHypervisor),
+		})
+	}
+	if len(config.Hypervisors) > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s supports a single hypervisor", field.String()),
+		})
+	}
+
+	return causes
+}
@@ -24,10 +24,12 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -172,6 +174,251 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	DescribeTable("validateEventConfiguration", func(eventConfiguration *v1.EventConfiguration, expectedFields []string) {
+		eventConfField := field.NewPath("spec", "configuration", "eventConfiguration")
+		causes := validateEventConfiguration(eventConfField, eventConfiguration)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no configuration", nil, nil),
+		Entry("accept a valid configuration", &v1.EventConfiguration{
+			RateLimiter:         &v1.TokenBucketRateLimiter{QPS: 5, Burst: 10},
+			DeduplicationWindow: &metav1.Duration{Duration: 5 * time.Minute},
+			SuppressedReasons:   []string{"FailedScheduling"},
+		}, nil),
+		Entry("reject a negative rate limit", &v1.EventConfiguration{
+			RateLimiter: &v1.TokenBucketRateLimiter{QPS: -1, Burst: -1},
+		}, []string{"spec.configuration.eventConfiguration.rateLimiter.qps", "spec.configuration.eventConfiguration.rateLimiter.burst"}),
+		Entry("reject a negative deduplication window", &v1.EventConfiguration{
+			DeduplicationWindow: &metav1.Duration{Duration: -time.Second},
+		}, []string{"spec.configuration.eventConfiguration.deduplicationWindow"}),
+		Entry("reject an empty suppressed reason", &v1.EventConfiguration{
+			SuppressedReasons: []string{"FailedScheduling", ""},
+		}, []string{"spec.configuration.eventConfiguration.suppressedReasons[1]"}),
+	)
+
+	DescribeTable("validateDomainStatsConfiguration", func(domainStatsConf *v1.DomainStatsConfiguration, expectedFields []string) {
+		causes := validateDomainStatsConfiguration(field.NewPath("spec", "configuration", "domainStatsConfiguration"), domainStatsConf)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no configuration", nil, nil),
+		Entry("accept supported collectors", &v1.DomainStatsConfiguration{
+			DisabledCollectors: []v1.DomainStatsCollector{v1.DomainStatsCollectorBlock, v1.DomainStatsCollectorNetwork},
+		}, nil),
+		Entry("reject unknown collectors", &v1.DomainStatsConfiguration{
+			DisabledCollectors: []v1.DomainStatsCollector{v1.DomainStatsCollectorVCPU, "memory"},
+		}, []string{"spec.configuration.domainStatsConfiguration.disabledCollectors[1]"}),
+	)
+
+	DescribeTable("validateVMInfoMetrics", func(vmInfoMetrics *v1.VMInfoMetricsConfiguration, expectedFields []string) {
+		causes := validateVMInfoMetrics(field.NewPath("spec", "configuration", "vmInfoMetrics"), vmInfoMetrics)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no configuration", nil, nil),
+		Entry("accept supported labels", &v1.VMInfoMetricsConfiguration{
+			DisabledLabels: []v1.VMInfoMetricsLabel{v1.VMInfoMetricsLabelGuestOS, v1.VMInfoMetricsLabelOwner},
+		}, nil),
+		Entry("reject unknown labels", &v1.VMInfoMetricsConfiguration{
+			DisabledLabels: []v1.VMInfoMetricsLabel{v1.VMInfoMetricsLabelOwner, "name"},
+		}, []string{"spec.configuration.vmInfoMetrics.disabledLabels[1]"}),
+	)
+
+	DescribeTable("validatePermittedHostDevices", func(hostDevs *v1.PermittedHostDevices, expectedFields []string) {
+		causes := validatePermittedHostDevices(field.NewPath("spec", "configuration", "permittedHostDevices"), hostDevs)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no configuration", nil, nil),
+		Entry("accept valid discovery rules", &v1.PermittedHostDevices{
+			PciDiscoveryRules: []v1.PciDiscoveryRule{
+				{PCIVendorSelector: "10de:*", Driver: "vfio-pci", ResourceName: "nvidia.com/gpu"},
+				{PCIVendorSelector: "8086:15[0-9]b", ResourceName: "intel.com/nic"},
+			},
+		}, nil),
+		Entry("reject malformed globs and missing resource names", &v1.PermittedHostDevices{
+			PciDiscoveryRules: []v1.PciDiscoveryRule{
+				{PCIVendorSelector: "10de:*", ResourceName: "nvidia.com/gpu"},
+				{PCIVendorSelector: "8086:[", ResourceName: "intel.com/nic"},
+				{PCIVendorSelector: "1af4:*"},
+			},
+		}, []string{
+			"spec.configuration.permittedHostDevices.pciDiscoveryRules[1].pciVendorSelector",
+			"spec.configuration.permittedHostDevices.pciDiscoveryRules[2].resourceName",
+		}),
+	)
+
+	DescribeTable("validateCrashLoopBackoff", func(backoff *v1.CrashLoopBackoff, expectedFields []string) {
+		causes := validateCrashLoopBackoff(field.NewPath("spec", "configuration", "virtualMachineOptions", "crashLoopBackoff"), backoff)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no configuration", nil, nil),
+		Entry("accept a valid configuration", &v1.CrashLoopBackoff{
+			BaseDelay:   &metav1.Duration{Duration: 5 * time.Second},
+			MaxDelay:    &metav1.Duration{Duration: 10 * time.Minute},
+			ResetWindow: &metav1.Duration{Duration: time.Minute},
+		}, nil),
+		Entry("reject negative durations", &v1.CrashLoopBackoff{
+			BaseDelay:   &metav1.Duration{Duration: -time.Second},
+			ResetWindow: &metav1.Duration{Duration: -time.Second},
+		}, []string{"spec.configuration.virtualMachineOptions.crashLoopBackoff.baseDelay", "spec.configuration.virtualMachineOptions.crashLoopBackoff.resetWindow"}),
+	)
+
+	DescribeTable("validateNodePoolRolloutStrategy", func(strategy *v1.NodePoolRolloutStrategy, expectedFields []string) {
+		causes := validateNodePoolRolloutStrategy(field.NewPath("spec", "rolloutStrategy", "virtHandler"), strategy)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no strategy", nil, nil),
+		Entry("accept a valid strategy", &v1.NodePoolRolloutStrategy{
+			NodePoolLabel:   "topology.kubernetes.io/zone",
+			MaxUnavailable:  pointer.P(int32(2)),
+			PoolSoakSeconds: pointer.P(int32(0)),
+			ErrorBudget:     pointer.P(int32(1)),
+		}, nil),
+		Entry("reject an empty node pool label", &v1.NodePoolRolloutStrategy{}, []string{"spec.rolloutStrategy.virtHandler.nodePoolLabel"}),
+		Entry("reject an invalid node pool label", &v1.NodePoolRolloutStrategy{NodePoolLabel: "node pool"}, []string{"spec.rolloutStrategy.virtHandler.nodePoolLabel"}),
+		Entry("reject invalid numbers", &v1.NodePoolRolloutStrategy{
+			NodePoolLabel:   "pool",
+			MaxUnavailable:  pointer.P(int32(0)),
+			PoolSoakSeconds: pointer.P(int32(-1)),
+			ErrorBudget:     pointer.P(int32(-1)),
+		}, []string{
+			"spec.rolloutStrategy.virtHandler.maxUnavailable",
+			"spec.rolloutStrategy.virtHandler.poolSoakSeconds",
+			"spec.rolloutStrategy.virtHandler.errorBudget",
+		}),
+	)
+
+	DescribeTable("validateWorkloadUpdateStrategy", func(strategy *v1.KubeVirtWorkloadUpdateStrategy, expectedFields []string) {
+		causes := validateWorkloadUpdateStrategy(field.NewPath("spec", "workloadUpdateStrategy"), strategy)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept an empty strategy", &v1.KubeVirtWorkloadUpdateStrategy{}, nil),
+		Entry("accept a valid strategy", &v1.KubeVirtWorkloadUpdateStrategy{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "workload-update.kubevirt.io/opt-out", Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			},
+			MaxUpdatesPerNamespace: pointer.P(2),
+			Windows: []v1.WorkloadUpdateWindow{{
+				Start:    "0 2 * * 1-5",
+				Duration: metav1.Duration{Duration: 3 * time.Hour},
+				TimeZone: pointer.P("Europe/Berlin"),
+			}},
+		}, nil),
+		Entry("reject an invalid namespace selector", &v1.KubeVirtWorkloadUpdateStrategy{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "opt-out", Operator: "Unknown"}},
+			},
+		}, []string{"spec.workloadUpdateStrategy.namespaceSelector"}),
+		Entry("reject a non positive namespace limit", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaxUpdatesPerNamespace: pointer.P(0),
+		}, []string{"spec.workloadUpdateStrategy.maxUpdatesPerNamespace"}),
+		Entry("reject invalid windows", &v1.KubeVirtWorkloadUpdateStrategy{
+			Windows: []v1.WorkloadUpdateWindow{
+				{Start: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				{Start: "0 25 * * *", TimeZone: pointer.P("Mars/Olympus")},
+			},
+		}, []string{
+			"spec.workloadUpdateStrategy.windows[1].start",
+			"spec.workloadUpdateStrategy.windows[1].duration",
+			"spec.workloadUpdateStrategy.windows[1].timeZone",
+		}),
+	)
+
+	DescribeTable("validateGuestExec", func(guestExec *v1.GuestExecConfiguration, expectedFields []string) {
+		causes := validateGuestExec(field.NewPath("spec", "configuration", "guestExec"), guestExec)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept an unset configuration", nil, nil),
+		Entry("accept a valid namespace selector", &v1.GuestExecConfiguration{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"guestexec.example.com/allowed": "true"}},
+		}, nil),
+		Entry("reject an invalid namespace selector", &v1.GuestExecConfiguration{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "allowed", Operator: "Unknown"}},
+			},
+		}, []string{"spec.configuration.guestExec.namespaceSelector"}),
+	)
+
+	DescribeTable("validateArchitectureConfiguration", func(firmware *v1.EFIFirmwareConfiguration, disabledFeatureGates []string, expectedFields []string) {
+		config := &v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{DisabledFeatureGates: disabledFeatureGates},
+			ArchitectureConfiguration: &v1.ArchConfiguration{
+				Amd64: &v1.ArchSpecificConfiguration{},
+				Arm64: &v1.ArchSpecificConfiguration{Firmware: firmware},
+			},
+		}
+		causes := validateArchitectureConfiguration(field.NewPath("spec", "configuration", "architectureConfiguration"), config)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no firmware", nil, nil, nil),
+		Entry("accept firmware images", &v1.EFIFirmwareConfiguration{
+			Image:      "registry.example.com/firmware/aavmf:latest",
+			Default:    &v1.EFIFirmwareImages{Code: "AAVMF_CODE.fd", Vars: "AAVMF_VARS.fd"},
+			SecureBoot: &v1.EFIFirmwareImages{Code: "secboot/AAVMF_CODE.ms.fd", Vars: "secboot/AAVMF_VARS.ms.fd"},
+		}, nil, nil),
+		Entry("reject an image without the ImageVolume feature gate", &v1.EFIFirmwareConfiguration{
+			Image: "registry.example.com/firmware/aavmf:latest",
+		}, []string{featuregate.ImageVolume}, []string{"spec.configuration.architectureConfiguration.arm64.firmware.image"}),
+		Entry("reject images without code", &v1.EFIFirmwareConfiguration{
+			SEV: &v1.EFIFirmwareImages{Vars: "OVMF_VARS.fd"},
+		}, nil, []string{"spec.configuration.architectureConfiguration.arm64.firmware.sev.code"}),
+		Entry("reject file names outside of the firmware directory", &v1.EFIFirmwareConfiguration{
+			Default: &v1.EFIFirmwareImages{Code: "/usr/share/AAVMF/AAVMF_CODE.fd", Vars: "../AAVMF_VARS.fd"},
+		}, nil, []string{
+			"spec.configuration.architectureConfiguration.arm64.firmware.default.code",
+			"spec.configuration.architectureConfiguration.arm64.firmware.default.vars",
+		}),
+	)
+
+	DescribeTable("validateArchitectureConfiguration with machine type aliases", func(aliases map[string]string, expectedFields []string) {
+		config := &v1.KubeVirtConfiguration{
+			ArchitectureConfiguration: &v1.ArchConfiguration{
+				Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: aliases},
+			},
+		}
+		causes := validateArchitectureConfiguration(field.NewPath("spec", "configuration", "architectureConfiguration"), config)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept no aliases", nil, nil),
+		Entry("accept aliases", map[string]string{"q35-stable": "pc-q35-rhel9.6.0", "q35-previous": "pc-q35-rhel9.4.0"}, nil),
+		Entry("reject an empty alias", map[string]string{"": "pc-q35-rhel9.6.0"},
+			[]string{"spec.configuration.architectureConfiguration.amd64.machineTypeAliases"}),
+		Entry("reject an alias without machine type", map[string]string{"q35-stable": ""},
+			[]string{"spec.configuration.architectureConfiguration.amd64.machineTypeAliases[q35-stable]"}),
+		Entry("reject an alias standing for another alias", map[string]string{"q35-stable": "pc-q35-rhel9.6.0", "q35-latest": "q35-stable"},
+			[]string{"spec.configuration.architectureConfiguration.amd64.machineTypeAliases[q35-latest]"}),
+	)
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -297,7 +544,7 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 			Expect(response).NotTo(BeNil())
 			if shouldWarn {
 				Expect(response.Warnings).NotTo(BeEmpty())
-				Expect(response.Warnings).To(ContainElement("spec.configuration.mediatedDevicesConfiguration.mediatedDevicesTypes is deprecated, use mediatedDeviceTypes"))
+				Expect(response.Warnings).To(ContainElement("spec.configuration.mediatedDevicesConfiguration.mediatedDevicesTypes is deprecated and will be removed in kubevirt.io/v2, please use spec.configuration.mediatedDevicesConfiguration.mediatedDeviceTypes instead."))
 			} else {
 				Expect(response.Warnings).To(BeEmpty())
 			}
@@ -331,7 +578,7 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 			Expect(response).NotTo(BeNil())
 			if shouldWarn {
 				Expect(response.Warnings).NotTo(BeEmpty())
-				Expect(response.Warnings).To(ContainElement("spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].mediatedDevicesTypes is deprecated, use mediatedDeviceTypes"))
+				Expect(response.Warnings).To(ContainElement("spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].mediatedDevicesTypes is deprecated and will be removed in kubevirt.io/v2, please use spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].mediatedDeviceTypes instead."))
 			} else {
 				Expect(response.Warnings).To(BeEmpty())
 			}
@@ -390,17 +637,27 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 					expectedWarning,
 				},
 			}))
+		},
+			Entry("with DisableMediatedDevicesHandling", featuregate.DisableMediatedDevicesHandling, "DisableMDEVConfiguration has been deprecated since v1.8.0"),
+			Entry("with MultiArchitecture", featuregate.MultiArchitecture, "MultiArchitecture has been deprecated since v1.8.0"),
+		)
+
+		DescribeTable("should only raise warning when a locked feature-gate was already enabled", func(featureGate, expectedWarning string) {
+			oldKV := &v1.KubeVirt{}
+			oldKV.Spec.Configuration.DeveloperConfiguration = &v1.DeveloperConfiguration{FeatureGates: []string{featureGate}}
+			newKV := oldKV.DeepCopy()
+			newKV.Spec.Configuration.DeveloperConfiguration.FeatureGates = append(newKV.Spec.Configuration.DeveloperConfiguration.FeatureGates, featuregate.CPUManager)
+
+			Expect(admitKVUpdate(admitter, oldKV, newKV)).To(Equal(&admissionv1.AdmissionResponse{
+				Allowed: true,
+				Warnings: []string{
+					expectedWarning,
+				},
+			}))
 		},
 			Entry("with LiveMigration", featuregate.LiveMigrationGate, fmt.Sprintf(featuregate.WarningPattern, featuregate.LiveMigrationGate, featuregate.GA)),
-			Entry("with SRIOVLiveMigration", featuregate.SRIOVLiveMigrationGate, fmt.Sprintf(featuregate.WarningPattern, featuregate.SRIOVLiveMigrationGate, featuregate.GA)),
-			Entry("with NonRoot", featuregate.NonRoot, fmt.Sprintf(featuregate.WarningPattern, featuregate.NonRoot, featuregate.GA)),
-			Entry("with PSA", featuregate.PSA, fmt.Sprintf(featuregate.WarningPattern, featuregate.PSA, featuregate.GA)),
-			Entry("with CPUNodeDiscoveryGate", featuregate.CPUNodeDiscoveryGate, fmt.Sprintf(featuregate.WarningPattern, featuregate.CPUNodeDiscoveryGate, featuregate.GA)),
 			Entry("with HotplugNICs", featuregate.HotplugNetworkIfacesGate, fmt.Sprintf(featuregate.WarningPattern, featuregate.HotplugNetworkIfacesGate, featuregate.GA)),
 			Entry("with Passt", featuregate.PasstGate, featuregate.PasstDiscontinueMessage),
-			Entry("with MacvtapGate", featuregate.MacvtapGate, featuregate.MacvtapDiscontinueMessage),
-			Entry("with ExperimentalVirtiofsSupport", featuregate.VirtIOFSGate, featuregate.VirtioFsFeatureGateDiscontinueMessage),
-			Entry("with DisableMediatedDevicesHandling", featuregate.DisableMediatedDevicesHandling, "DisableMDEVConfiguration has been deprecated since v1.8.0"),
 		)
 
 		DescribeTable("should raise warning when archConfig is set for ppc64le", func(shouldWarn bool, archConfig *v1.ArchConfiguration) {
@@ -417,7 +674,7 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 
 			if shouldWarn {
 				Expect(response.Warnings).NotTo(BeEmpty())
-				Expect(response.Warnings).To(ContainElement("spec.configuration.architectureConfiguration.ppc64le is deprecated and no longer supported."))
+				Expect(response.Warnings).To(ContainElement("spec.configuration.architectureConfiguration.ppc64le is deprecated and will be removed in kubevirt.io/v2."))
 			} else {
 				Expect(response.Warnings).To(BeEmpty())
 			}
@@ -442,6 +699,40 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 			return admitKVUpdate(admitter, oldKV, newKV)
 		}
 
+		DescribeTable("should reject a newly set feature gate of a graduated or discontinued feature", func(devConfig *v1.DeveloperConfiguration, expectedField, expectedMessage string) {
+			response := admitUpdate(devConfig)
+
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(ConsistOf(And(
+				HaveField("Type", metav1.CauseTypeFieldValueNotSupported),
+				HaveField("Field", expectedField),
+				HaveField("Message", expectedMessage),
+			)))
+		},
+			Entry("when enabling a GA feature gate",
+				&v1.DeveloperConfiguration{FeatureGates: []string{featuregate.CPUManager, featuregate.LiveMigrationGate}},
+				"spec.configuration.developerConfiguration.featureGates[1]",
+				`feature gate "LiveMigration" graduated to GA and is always enabled, it has to be removed from "FeatureGates"`,
+			),
+			Entry("when enabling a discontinued feature gate",
+				&v1.DeveloperConfiguration{FeatureGates: []string{featuregate.MacvtapGate}},
+				"spec.configuration.developerConfiguration.featureGates[0]",
+				`feature gate "Macvtap" is discontinued and can no longer be enabled: `+featuregate.MacvtapDiscontinueMessage,
+			),
+			Entry("when disabling a GA feature gate",
+				&v1.DeveloperConfiguration{DisabledFeatureGates: []string{featuregate.NonRoot}},
+				"spec.configuration.developerConfiguration.disabledFeatureGates[0]",
+				`feature gate "NonRoot" graduated to GA and can no longer be disabled, it has to be removed from "DisabledFeatureGates"`,
+			),
+		)
+
+		It("should allow disabling a discontinued or deprecated feature gate", func() {
+			response := admitUpdate(&v1.DeveloperConfiguration{
+				DisabledFeatureGates: []string{featuregate.PasstGate, featuregate.MultiArchitecture},
+			})
+			Expect(response.Allowed).To(BeTrue())
+		})
+
 		DescribeTable("should reject conflicting feature gates", func(enabledGates, disabledGates []string, expectedConflictingGates ...string) {
 			var devConfig *v1.DeveloperConfiguration
 			if enabledGates != nil || disabledGates != nil {
@@ -502,6 +793,138 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 				"Gate1", "Gate2", "Gate3"),
 		)
 	})
+
+	Context("with cluster configuration", func() {
+		var admitter *KubeVirtUpdateAdmitter
+
+		BeforeEach(func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter = NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+		})
+
+		admit := func(oldKV, newKV *v1.KubeVirt) *admissionv1.AdmissionResponse {
+			return admitKVUpdate(admitter, oldKV, newKV)
+		}
+
+		invalidKV := func() *v1.KubeVirt {
+			kv := &v1.KubeVirt{}
+			kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{
+				ParallelMigrationsPerCluster: pointer.P(uint32(0)),
+			}
+			return kv
+		}
+
+		It("should reject an invalid configuration change", func() {
+			response := admit(&v1.KubeVirt{}, invalidKV())
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(HaveLen(1))
+			Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.configuration.migrations.parallelMigrationsPerCluster"))
+		})
+
+		It("should not block updates which keep the configuration", func() {
+			oldKV := invalidKV()
+			newKV := oldKV.DeepCopy()
+			newKV.Labels = map[string]string{"updated": "true"}
+			Expect(admit(oldKV, newKV).Allowed).To(BeTrue())
+		})
+
+		It("should reject configurations the components would ignore", func() {
+			newKV := &v1.KubeVirt{}
+			newKV.Spec.Configuration.DeveloperConfiguration = &v1.DeveloperConfiguration{LessPVCSpaceToleration: 101}
+			response := admit(&v1.KubeVirt{}, newKV)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(HaveLen(1))
+			Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.configuration"))
+		})
+
+		DescribeTable("validateMigrationConfiguration", func(config *v1.MigrationConfiguration, expectedFields ...string) {
+			causes := validateMigrationConfiguration(field.NewPath("spec", "configuration", "migrations"), config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for _, cause := range causes {
+				Expect(cause.Field).To(BeElementOf(expectedFields))
+			}
+		},
+			Entry("accept no configuration", nil),
+			Entry("accept a consistent configuration", &v1.MigrationConfiguration{
+				ParallelMigrationsPerCluster:      pointer.P(uint32(10)),
+				ParallelOutboundMigrationsPerNode: pointer.P(uint32(4)),
+				BandwidthPerMigration:             pointer.P(resource.MustParse("64Mi")),
+				ProgressTimeout:                   pointer.P(int64(300)),
+				AllowPostCopy:                     pointer.P(true),
+				AllowWorkloadDisruption:           pointer.P(true),
+				Network:                           pointer.P("migration-network"),
+			}),
+			Entry("reject more outbound migrations per node than per cluster", &v1.MigrationConfiguration{
+				ParallelMigrationsPerCluster:      pointer.P(uint32(2)),
+				ParallelOutboundMigrationsPerNode: pointer.P(uint32(3)),
+			}, "spec.configuration.migrations.parallelOutboundMigrationsPerNode"),
+			Entry("reject more outbound migrations per node than the cluster default", &v1.MigrationConfiguration{
+				ParallelOutboundMigrationsPerNode: pointer.P(uint32(6)),
+			}, "spec.configuration.migrations.parallelOutboundMigrationsPerNode"),
+			Entry("reject no parallel migrations", &v1.MigrationConfiguration{
+				ParallelMigrationsPerCluster:      pointer.P(uint32(0)),
+				ParallelOutboundMigrationsPerNode: pointer.P(uint32(0)),
+			}, "spec.configuration.migrations.parallelMigrationsPerCluster", "spec.configuration.migrations.parallelOutboundMigrationsPerNode"),
+			Entry("reject a negative bandwidth", &v1.MigrationConfiguration{
+				BandwidthPerMigration: pointer.P(resource.MustParse("-1Mi")),
+			}, "spec.configuration.migrations.bandwidthPerMigration"),
+			Entry("reject non positive timeouts", &v1.MigrationConfiguration{
+				CompletionTimeoutPerGiB: pointer.P(int64(0)),
+				ProgressTimeout:         pointer.P(int64(-1)),
+			}, "spec.configuration.migrations.completionTimeoutPerGiB", "spec.configuration.migrations.progressTimeout"),
+			Entry("reject post-copy without workload disruption", &v1.MigrationConfiguration{
+				AllowPostCopy:           pointer.P(true),
+				AllowWorkloadDisruption: pointer.P(false),
+			}, "spec.configuration.migrations.allowPostCopy"),
+			Entry("reject an invalid network name", &v1.MigrationConfiguration{
+				Network: pointer.P("Migration Network"),
+			}, "spec.configuration.migrations.network"),
+		)
+
+		DescribeTable("validateNetworkConfiguration", func(config *v1.NetworkConfiguration, expectedFields ...string) {
+			causes := validateNetworkConfiguration(field.NewPath("spec", "configuration", "network"), config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for _, cause := range causes {
+				Expect(cause.Field).To(BeElementOf(expectedFields))
+			}
+		},
+			Entry("accept no configuration", nil),
+			Entry("accept a consistent configuration", &v1.NetworkConfiguration{
+				NetworkInterface:                  string(v1.MasqueradeInterface),
+				PermitBridgeInterfaceOnPodNetwork: pointer.P(false),
+				Binding: map[string]v1.InterfaceBindingPlugin{
+					"passt":   {SidecarImage: "registry.example.com/passt-binding:latest", DownwardAPI: v1.DeviceInfo},
+					"macvtap": {DomainAttachmentType: v1.Tap},
+				},
+			}),
+			Entry("reject a bridge default which is not permitted", &v1.NetworkConfiguration{
+				NetworkInterface:                  string(v1.BridgeInterface),
+				PermitBridgeInterfaceOnPodNetwork: pointer.P(false),
+			}, "spec.configuration.network.defaultNetworkInterface"),
+			Entry("reject a slirp default which is not permitted", &v1.NetworkConfiguration{
+				NetworkInterface: string(v1.DeprecatedSlirpInterface),
+			}, "spec.configuration.network.defaultNetworkInterface"),
+			Entry("reject an empty binding", &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{"empty": {}},
+			}, "spec.configuration.network.binding[empty]"),
+			Entry("reject unsupported binding values", &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{"unsupported": {DomainAttachmentType: "veth", DownwardAPI: "pod-info"}},
+			}, "spec.configuration.network.binding[unsupported].domainAttachmentType", "spec.configuration.network.binding[unsupported].downwardAPI"),
+		)
+
+		DescribeTable("validateHypervisors", func(featureGates []string, hypervisors []v1.HypervisorConfiguration, expectedCauses int) {
+			config := &v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+				Hypervisors:            hypervisors,
+			}
+			Expect(validateHypervisors(field.NewPath("spec", "configuration", "hypervisors"), config)).To(HaveLen(expectedCauses))
+		},
+			Entry("accept no hypervisors", nil, nil, 0),
+			Entry("accept a hypervisor with the feature gate", []string{featuregate.ConfigurableHypervisor}, []v1.HypervisorConfiguration{{Name: "kvm"}}, 0),
+			Entry("reject a hypervisor without the feature gate", nil, []v1.HypervisorConfiguration{{Name: "kvm"}}, 1),
+			Entry("reject multiple hypervisors", []string{featuregate.ConfigurableHypervisor}, []v1.HypervisorConfiguration{{Name: "kvm"}, {Name: "hyperv-direct"}}, 1),
+		)
+	})
 })
 
 func admitKVUpdate(admitter *KubeVirtUpdateAdmitter, oldKV, newKV *v1.KubeVirt) *admissionv1.AdmissionResponse {
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 )
 
 // key is an unexported type for keys defined in this package.
@@ -31,6 +35,9 @@ func ClientAndNamespaceFromContext(ctx context.Context) (virtClient kubecli.Kube
 	if !ok {
 		return nil, "", false, fmt.Errorf("unable to get client config from context")
 	}
+	if tracecontext.Enabled() {
+		clientConfig = &tracingClientConfig{clientConfig: clientConfig}
+	}
 	virtClient, err = kubecli.GetKubevirtClientFromClientConfig(clientConfig)
 	if err != nil {
 		return nil, "", false, err
@@ -41,3 +48,29 @@ func ClientAndNamespaceFromContext(ctx context.Context) (virtClient kubecli.Kube
 	}
 	return virtClient, namespace, overridden, nil
 }
+
+// tracingClientConfig passes the trace context of the virtctl command on to the requests of the client
+type tracingClientConfig struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+func (c *tracingClientConfig) RawConfig() (clientcmdapi.Config, error) {
+	return c.clientConfig.RawConfig()
+}
+
+func (c *tracingClientConfig) ClientConfig() (*rest.Config, error) {
+	config, err := c.clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	config.Wrap(tracecontext.WrapTransport)
+	return config, nil
+}
+
+func (c *tracingClientConfig) Namespace() (string, bool, error) {
+	return c.clientConfig.Namespace()
+}
+
+func (c *tracingClientConfig) ConfigAccess() clientcmd.ConfigAccess {
+	return c.clientConfig.ConfigAccess()
+}
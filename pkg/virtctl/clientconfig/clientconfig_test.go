@@ -10,6 +10,7 @@ import (
 
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 )
 
@@ -32,6 +33,19 @@ var _ = Describe("client", func() {
 		Expect(contextClient.Config()).To(Equal(client.Config()))
 	})
 
+	It("ClientAndNamespaceFromContext should pass the trace context on when spans are exported", func() {
+		GinkgoT().Setenv(tracecontext.EndpointEnv, "http://127.0.0.1:4318")
+		tracecontext.InitExporter("virtctl")
+		DeferCleanup(tracecontext.Shutdown)
+
+		clientConfig := kubecli.DefaultClientConfig(&pflag.FlagSet{})
+		contextClient, _, _, err := clientconfig.ClientAndNamespaceFromContext(
+			clientconfig.NewContext(context.Background(), clientConfig),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contextClient.Config().WrapTransport).ToNot(BeNil())
+	})
+
 	It("ClientAndNamespaceFromContext should fail when clientConfig is missing from context", func() {
 		_, _, _, err := clientconfig.ClientAndNamespaceFromContext(context.Background())
 		Expect(err).To(MatchError("unable to get client config from context"))
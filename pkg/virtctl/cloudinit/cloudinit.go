@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package cloudinit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_CLOUD_INIT = "cloud-init"
+	COMMAND_ROTATE     = "rotate"
+	COMMAND_EJECT      = "eject"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_CLOUD_INIT,
+		Short: "Manage the cloud-init data of a running virtual machine instance.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(
+		newRotateCommand(),
+		newEjectCommand(),
+	)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newRotateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate (VMI)",
+		Short: "Regenerate the cloud-init disk of a virtual machine instance and re-run cloud-init in the guest.",
+		Long: `Regenerate the cloud-init disk of a virtual machine instance from the current content of its cloud-init volume,
+including the referenced secrets, and re-run the cloud-init modules handling credentials (users_groups, set_passwords,
+write_files) in the guest through the guest agent.
+The outcome is reported by the CloudInitSynchronized condition of the virtual machine instance.`,
+		Args:    cobra.ExactArgs(1),
+		Example: usage(COMMAND_ROTATE),
+		RunE:    runRotate,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newEjectCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eject (VMI)",
+		Short: "Eject the cloud-init disk of a virtual machine instance.",
+		Long: `Eject the cloud-init disk of a virtual machine instance, so that the cloud-init data is not readable from the guest anymore.
+The disk stays ejected until the virtual machine instance restarts or its cloud-init data is rotated.`,
+		Args:    cobra.ExactArgs(1),
+		Example: usage(COMMAND_EJECT),
+		RunE:    runEject,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage(cmd string) string {
+	usage := fmt.Sprintf("  # %s the cloud-init data of a virtualmachineinstance called 'myvmi':\n", cmd)
+	usage += fmt.Sprintf("  {{ProgramName}} %s %s myvmi", COMMAND_CLOUD_INIT, cmd)
+	return usage
+}
+
+func runRotate(cmd *cobra.Command, args []string) error {
+	vmi := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	if err = virtClient.VirtualMachineInstance(namespace).RotateCloudInit(context.Background(), vmi); err != nil {
+		return fmt.Errorf("Error rotating the cloud-init data of VirtualMachineInstance %s: %v", vmi, err)
+	}
+
+	cmd.Printf("Cloud-init data of VMI %s is being rotated\n", vmi)
+	return nil
+}
+
+func runEject(cmd *cobra.Command, args []string) error {
+	vmi := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	if err = virtClient.VirtualMachineInstance(namespace).EjectCloudInit(context.Background(), vmi); err != nil {
+		return fmt.Errorf("Error ejecting the cloud-init disk of VirtualMachineInstance %s: %v", vmi, err)
+	}
+
+	cmd.Printf("Cloud-init disk of VMI %s was ejected\n", vmi)
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package cloudinit_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/cloudinit"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Cloud-init", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	DescribeTable("should fail with missing input parameters", func(subcommand string) {
+		cmd := testing.NewRepeatableVirtctlCommand(cloudinit.COMMAND_CLOUD_INIT, subcommand)
+		Expect(cmd()).ToNot(Succeed())
+	},
+		Entry("when rotating", cloudinit.COMMAND_ROTATE),
+		Entry("when ejecting", cloudinit.COMMAND_EJECT),
+	)
+
+	It("should rotate the cloud-init data of a VMI", func() {
+		vmiInterface.EXPECT().RotateCloudInit(context.Background(), vmiName).Return(nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(cloudinit.COMMAND_CLOUD_INIT, cloudinit.COMMAND_ROTATE, vmiName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should eject the cloud-init disk of a VMI", func() {
+		vmiInterface.EXPECT().EjectCloudInit(context.Background(), vmiName).Return(nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(cloudinit.COMMAND_CLOUD_INIT, cloudinit.COMMAND_EJECT, vmiName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should report a failed rotation", func() {
+		vmiInterface.EXPECT().RotateCloudInit(context.Background(), vmiName).Return(errors.New("guest agent not connected"))
+
+		cmd := testing.NewRepeatableVirtctlCommand(cloudinit.COMMAND_CLOUD_INIT, cloudinit.COMMAND_ROTATE, vmiName)
+		Expect(cmd()).To(MatchError(ContainSubstring("guest agent not connected")))
+	})
+})
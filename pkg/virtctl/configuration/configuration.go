@@ -56,6 +56,10 @@ func run(cmd *cobra.Command, _ []string) error {
 			hostDeviceList = append(hostDeviceList, hd.ResourceName)
 		}
 
+		for _, rule := range kv.Spec.Configuration.PermittedHostDevices.PciDiscoveryRules {
+			hostDeviceList = append(hostDeviceList, rule.ResourceName)
+		}
+
 		for _, hd := range kv.Spec.Configuration.PermittedHostDevices.MediatedDevices {
 			gpuDeviceList = append(gpuDeviceList, hd.ResourceName)
 		}
@@ -41,6 +41,7 @@ const defaultTimeoutMinutes = 5
 
 type consoleCommand struct {
 	timeout int
+	serial  string
 }
 
 func NewCommand() *cobra.Command {
@@ -54,6 +55,8 @@ func NewCommand() *cobra.Command {
 	}
 	cmd.Flags().IntVar(&c.timeout, "timeout", defaultTimeoutMinutes,
 		"The number of minutes to wait for the virtual machine instance to be ready.")
+	cmd.Flags().StringVar(&c.serial, "serial", "",
+		"The name of an additional serial device to connect to, instead of the default serial console.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -62,7 +65,9 @@ func usage() string {
 	usage := `  # Connect to the console on VirtualMachineInstance 'myvmi':
   {{ProgramName}} console myvmi
   # Configure one minute timeout (default 5 minutes)
-  {{ProgramName}} console --timeout=1 myvmi`
+  {{ProgramName}} console --timeout=1 myvmi
+  # Connect to the additional serial device 'mgmt' of VirtualMachineInstance 'myvmi':
+  {{ProgramName}} console --serial=mgmt myvmi`
 
 	return usage
 }
@@ -92,7 +97,7 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 
 	go func() {
 		con, err := client.VirtualMachineInstance(namespace).SerialConsole(vmi,
-			&kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute})
+			&kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute, Serial: c.serial})
 		runningChan <- err
 
 		if err != nil {
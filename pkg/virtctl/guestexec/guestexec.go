@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestexec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUEST_EXEC = "guest-exec"
+
+	timeoutFlag = "timeout"
+)
+
+// ExitCodeError is returned when the command run in the guest exited with a non-zero exit code,
+// virtctl exits with the same code.
+type ExitCodeError struct {
+	ExitCode int
+}
+
+func (e *ExitCodeError) Error() string {
+	return fmt.Sprintf("command exited with code %d", e.ExitCode)
+}
+
+type command struct {
+	timeoutSeconds int32
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "guest-exec (VMI) -- (COMMAND) [ARGS...]",
+		Short: "Run a command inside the guest of a running virtual machine instance through the guest agent.",
+		Long: `Run a command inside the guest of a running virtual machine instance through the guest agent.
+The standard output and standard error of the command are printed once it exited, and virtctl exits with its exit code.
+The GuestExec feature gate must be enabled, and the namespace of the virtual machine instance must be allowed
+by the guestExec configuration of KubeVirt.`,
+		Args: cobra.MinimumNArgs(2),
+		Example: `  # Print the uptime of the guest of a virtualmachineinstance called 'myvmi':
+  {{ProgramName}} guest-exec myvmi -- /usr/bin/uptime
+
+  # List the content of /var/log in the guest, waiting at most 10 seconds for the command to exit:
+  {{ProgramName}} guest-exec myvmi --timeout 10 -- /usr/bin/ls -l /var/log`,
+		RunE: c.run,
+	}
+	cmd.Flags().Int32Var(&c.timeoutSeconds, timeoutFlag, 0,
+		"Seconds to wait for the command to exit, the default of the server is used if not set.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	vmi := args[0]
+	if c.timeoutSeconds < 0 {
+		return fmt.Errorf("--%s must not be negative", timeoutFlag)
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	options := &v1.GuestExecOptions{Command: args[1:]}
+	if c.timeoutSeconds > 0 {
+		options.TimeoutSeconds = pointer.P(c.timeoutSeconds)
+	}
+	stream, err := virtClient.VirtualMachineInstance(namespace).GuestExec(vmi, options)
+	if err != nil {
+		return fmt.Errorf("Error running command in VirtualMachineInstance %s: %v", vmi, err)
+	}
+	conn := stream.AsConn()
+	defer conn.Close()
+
+	return printOutput(conn, cmd.OutOrStdout(), cmd.ErrOrStderr())
+}
+
+// printOutput decodes the output objects streamed by the server until the one holding the exit code or an error.
+func printOutput(reader io.Reader, stdout, stderr io.Writer) error {
+	decoder := json.NewDecoder(reader)
+	for {
+		output := &v1.VirtualMachineInstanceGuestExecOutput{}
+		if err := decoder.Decode(output); err != nil {
+			if errors.Is(err, io.EOF) {
+				return fmt.Errorf("the connection was closed before the command exited")
+			}
+			return fmt.Errorf("Error reading the output of the command: %v", err)
+		}
+		if _, err := stdout.Write(output.Stdout); err != nil {
+			return err
+		}
+		if _, err := stderr.Write(output.Stderr); err != nil {
+			return err
+		}
+		if output.Error != "" {
+			return errors.New(output.Error)
+		}
+		if output.ExitCode != nil {
+			if *output.ExitCode != 0 {
+				return &ExitCodeError{ExitCode: int(*output.ExitCode)}
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestexec_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest exec", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	DescribeTable("should fail with invalid input parameters", func(args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{guestexec.COMMAND_GUEST_EXEC}, args...)...)
+		Expect(cmd()).ToNot(Succeed())
+	},
+		Entry("without command", vmiName),
+		Entry("with a negative timeout", vmiName, "--timeout", "-1", "--", "/usr/bin/uptime"),
+	)
+
+	It("should print the output of the command", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, &v1.GuestExecOptions{
+			Command:        []string{"/usr/bin/ls", "-l"},
+			TimeoutSeconds: pointer.P(int32(10)),
+		}).Return(newFakeStream(&v1.VirtualMachineInstanceGuestExecOutput{
+			Stdout:   []byte("out"),
+			Stderr:   []byte("err"),
+			ExitCode: pointer.P(int32(0)),
+		}), nil)
+
+		out, errOut, err := testing.NewRepeatableVirtctlCommandWithOutAndErr(
+			guestexec.COMMAND_GUEST_EXEC, vmiName, "--timeout", "10", "--", "/usr/bin/ls", "-l")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("out"))
+		Expect(string(errOut)).To(Equal("err"))
+	})
+
+	It("should return the exit code of the command", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, &v1.GuestExecOptions{Command: []string{"/usr/bin/false"}}).
+			Return(newFakeStream(&v1.VirtualMachineInstanceGuestExecOutput{ExitCode: pointer.P(int32(1))}), nil)
+
+		err := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, vmiName, "--", "/usr/bin/false")()
+		var exitCodeErr *guestexec.ExitCodeError
+		Expect(errors.As(err, &exitCodeErr)).To(BeTrue())
+		Expect(exitCodeErr.ExitCode).To(Equal(1))
+	})
+
+	It("should return the error reported by the server", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, gomock.Any()).
+			Return(newFakeStream(&v1.VirtualMachineInstanceGuestExecOutput{Error: "the command did not exit within 1m0s"}), nil)
+
+		err := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, vmiName, "--", "/usr/bin/sleep", "120")()
+		Expect(err).To(MatchError("the command did not exit within 1m0s"))
+	})
+
+	It("should fail when the connection is closed before the command exited", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, gomock.Any()).Return(newFakeStream(), nil)
+
+		err := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, vmiName, "--", "/usr/bin/uptime")()
+		Expect(err).To(MatchError(ContainSubstring("closed before the command exited")))
+	})
+
+	It("should fail when the command can't be started", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, gomock.Any()).Return(nil, errors.New("forbidden"))
+
+		err := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, vmiName, "--", "/usr/bin/uptime")()
+		Expect(err).To(MatchError(ContainSubstring("forbidden")))
+	})
+})
+
+// fakeStream serves the given output objects like the guestexec subresource, and closes the connection afterwards.
+type fakeStream struct {
+	conn net.Conn
+}
+
+func newFakeStream(outputs ...*v1.VirtualMachineInstanceGuestExecOutput) *fakeStream {
+	client, server := net.Pipe()
+	go func() {
+		defer GinkgoRecover()
+		defer server.Close()
+		encoder := json.NewEncoder(server)
+		for _, output := range outputs {
+			if err := encoder.Encode(output); err != nil {
+				return
+			}
+		}
+	}()
+	return &fakeStream{conn: client}
+}
+
+func (s *fakeStream) Stream(_ kvcorev1.StreamOptions) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStream) AsConn() net.Conn {
+	return s.conn
+}
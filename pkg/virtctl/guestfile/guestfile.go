@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUEST_FILE = "guestfile"
+	COMMAND_READ       = "read"
+	COMMAND_WRITE      = "write"
+
+	outputFlag   = "output"
+	fromFileFlag = "from-file"
+)
+
+type command struct {
+	output   string
+	fromFile string
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_GUEST_FILE,
+		Short: "Read or write a small file inside the guest of a running virtual machine instance through the guest agent.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(
+		newReadCommand(),
+		newWriteCommand(),
+	)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newReadCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "read (VMI) (PATH)",
+		Short: "Read a file inside the guest of a virtual machine instance.",
+		Long: fmt.Sprintf(`Read a file of at most %d bytes inside the guest of a virtual machine instance through the guest agent.
+The content is printed on the standard output, unless an output file is provided.`, v1.GuestFileMaxSize),
+		Args: cobra.ExactArgs(2),
+		Example: `  # Print the content of /etc/hostname in the guest of a virtualmachineinstance called 'myvmi':
+  {{ProgramName}} guestfile read myvmi /etc/hostname
+
+  # Save the content of /etc/hostname in the guest of a virtualmachineinstance called 'myvmi' to a local file:
+  {{ProgramName}} guestfile read myvmi /etc/hostname --output hostname`,
+		RunE: c.runRead,
+	}
+	cmd.Flags().StringVarP(&c.output, outputFlag, "o", "", "Write the content to this local file instead of the standard output.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newWriteCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "write (VMI) (PATH)",
+		Short: "Write a file inside the guest of a virtual machine instance.",
+		Long: fmt.Sprintf(`Create or truncate a file inside the guest of a virtual machine instance and write at most %d bytes to it
+through the guest agent. The content is read from a local file, or from the standard input if the file is '-'.`, v1.GuestFileMaxSize),
+		Args: cobra.ExactArgs(2),
+		Example: `  # Write the local file motd to /etc/motd in the guest of a virtualmachineinstance called 'myvmi':
+  {{ProgramName}} guestfile write myvmi /etc/motd --from-file motd
+
+  # Write the standard input to /etc/motd in the guest of a virtualmachineinstance called 'myvmi':
+  echo "Welcome" | {{ProgramName}} guestfile write myvmi /etc/motd --from-file -`,
+		RunE: c.runWrite,
+	}
+	cmd.Flags().StringVar(&c.fromFile, fromFileFlag, "", "Local file to write in the guest, '-' for the standard input.")
+	if err := cmd.MarkFlagRequired(fromFileFlag); err != nil {
+		panic(err)
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (c *command) runRead(cmd *cobra.Command, args []string) error {
+	vmi, path := args[0], args[1]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	file, err := virtClient.VirtualMachineInstance(namespace).ReadGuestFile(context.Background(), vmi, path)
+	if err != nil {
+		return fmt.Errorf("Error reading file %s in VirtualMachineInstance %s: %v", path, vmi, err)
+	}
+
+	if c.output == "" {
+		_, err = cmd.OutOrStdout().Write(file.Content)
+		return err
+	}
+	if err := os.WriteFile(c.output, file.Content, 0o600); err != nil {
+		return fmt.Errorf("Error writing %s: %v", c.output, err)
+	}
+	return nil
+}
+
+func (c *command) runWrite(cmd *cobra.Command, args []string) error {
+	vmi, path := args[0], args[1]
+
+	content, err := c.readContent(cmd)
+	if err != nil {
+		return err
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	file := &v1.VirtualMachineInstanceGuestFile{
+		Path:    path,
+		Content: content,
+	}
+	if err := virtClient.VirtualMachineInstance(namespace).WriteGuestFile(context.Background(), vmi, file); err != nil {
+		return fmt.Errorf("Error writing file %s in VirtualMachineInstance %s: %v", path, vmi, err)
+	}
+
+	cmd.Printf("Wrote %d bytes to %s in VMI %s\n", len(content), path, vmi)
+	return nil
+}
+
+func (c *command) readContent(cmd *cobra.Command) ([]byte, error) {
+	reader := cmd.InOrStdin()
+	if c.fromFile != "-" {
+		f, err := os.Open(c.fromFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error opening %s: %v", c.fromFile, err)
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	content, err := io.ReadAll(io.LimitReader(reader, v1.GuestFileMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("Error reading %s: %v", c.fromFile, err)
+	}
+	if len(content) > v1.GuestFileMaxSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", c.fromFile, v1.GuestFileMaxSize)
+	}
+	return content, nil
+}
@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/guestfile"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest file", func() {
+	const (
+		vmiName   = "testvmi"
+		guestPath = "/etc/motd"
+	)
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	DescribeTable("should fail with missing input parameters", func(args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{guestfile.COMMAND_GUEST_FILE}, args...)...)
+		Expect(cmd()).ToNot(Succeed())
+	},
+		Entry("when reading without path", guestfile.COMMAND_READ, vmiName),
+		Entry("when writing without path", guestfile.COMMAND_WRITE, vmiName, "--from-file", "-"),
+		Entry("when writing without local file", guestfile.COMMAND_WRITE, vmiName, guestPath),
+	)
+
+	It("should print the content of a guest file", func() {
+		vmiInterface.EXPECT().ReadGuestFile(context.Background(), vmiName, guestPath).
+			Return(v1.VirtualMachineInstanceGuestFile{Path: guestPath, Content: []byte("Welcome")}, nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(guestfile.COMMAND_GUEST_FILE, guestfile.COMMAND_READ, vmiName, guestPath)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("Welcome"))
+	})
+
+	It("should save the content of a guest file", func() {
+		vmiInterface.EXPECT().ReadGuestFile(context.Background(), vmiName, guestPath).
+			Return(v1.VirtualMachineInstanceGuestFile{Path: guestPath, Content: []byte("Welcome")}, nil)
+		output := filepath.Join(GinkgoT().TempDir(), "motd")
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestfile.COMMAND_GUEST_FILE, guestfile.COMMAND_READ, vmiName, guestPath, "--output", output)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(output)).To(Equal([]byte("Welcome")))
+	})
+
+	It("should write a local file in the guest", func() {
+		input := filepath.Join(GinkgoT().TempDir(), "motd")
+		Expect(os.WriteFile(input, []byte("Welcome"), 0o600)).To(Succeed())
+		vmiInterface.EXPECT().WriteGuestFile(context.Background(), vmiName, &v1.VirtualMachineInstanceGuestFile{
+			Path:    guestPath,
+			Content: []byte("Welcome"),
+		}).Return(nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestfile.COMMAND_GUEST_FILE, guestfile.COMMAND_WRITE, vmiName, guestPath, "--from-file", input)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should refuse a local file larger than the limit", func() {
+		input := filepath.Join(GinkgoT().TempDir(), "large")
+		Expect(os.WriteFile(input, make([]byte, v1.GuestFileMaxSize+1), 0o600)).To(Succeed())
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestfile.COMMAND_GUEST_FILE, guestfile.COMMAND_WRITE, vmiName, guestPath, "--from-file", input)
+		Expect(cmd()).To(MatchError(ContainSubstring("is larger than")))
+	})
+
+	It("should report a failed read", func() {
+		vmiInterface.EXPECT().ReadGuestFile(context.Background(), vmiName, guestPath).
+			Return(v1.VirtualMachineInstanceGuestFile{}, errors.New("guest agent not connected"))
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestfile.COMMAND_GUEST_FILE, guestfile.COMMAND_READ, vmiName, guestPath)
+		Expect(cmd()).To(MatchError(ContainSubstring("guest agent not connected")))
+	})
+})
@@ -2,6 +2,7 @@ package virtctl
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -16,13 +17,17 @@ import (
 	"kubevirt.io/client-go/log"
 	client_version "kubevirt.io/client-go/version"
 
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	"kubevirt.io/kubevirt/pkg/virtctl/adm"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/cloudinit"
 	"kubevirt.io/kubevirt/pkg/virtctl/configuration"
 	"kubevirt.io/kubevirt/pkg/virtctl/console"
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestfile"
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
@@ -118,10 +123,16 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewGuestOsInfoCommand(),
 		vm.NewUserListCommand(),
 		vm.NewFSListCommand(),
+		vm.NewDomainXMLCommand(),
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
 		vm.NewExpandCommand(),
 		vm.NewEvacuateCancelCommand(),
+		vm.NewRollbackCommand(),
+		vm.NewInsertMediaCommand(),
+		vm.NewEjectMediaCommand(),
+		vm.NewSuspendCommand(),
+		vm.NewResumeCommand(),
 		memorydump.NewMemoryDumpCommand(),
 		pause.NewCommand(),
 		unpause.NewCommand(),
@@ -134,6 +145,9 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vmexport.NewVirtualMachineExportCommand(),
 		create.NewCommand(),
 		credentials.NewCommand(),
+		cloudinit.NewCommand(),
+		guestexec.NewCommand(),
+		guestfile.NewCommand(),
 		adm.NewCommand(),
 		objectgraph.NewCommand(),
 		template.NewCommand(),
@@ -156,8 +170,14 @@ func addVerbosityFlag(fs *pflag.FlagSet) {
 
 func Execute() int {
 	log.InitializeLogging(programName)
+	tracecontext.InitExporter(programName)
+	defer tracecontext.Shutdown()
 	cmd := NewVirtctlCommand()
 	if err := cmd.Execute(); err != nil {
+		var exitCodeErr *guestexec.ExitCodeError
+		if errors.As(err, &exitCodeErr) {
+			return exitCodeErr.ExitCode
+		}
 		if versionErr := checkClientServerVersion(cmd.Context()); versionErr != nil {
 			cmd.PrintErrln(versionErr)
 		}
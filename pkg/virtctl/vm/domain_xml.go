@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_DOMAINXML = "domainxml"
+
+type domainXMLCommand struct {
+	capabilities bool
+}
+
+func NewDomainXMLCommand() *cobra.Command {
+	c := domainXMLCommand{}
+	cmd := &cobra.Command{
+		Use:     "domainxml (VMI)",
+		Short:   "Return the live libvirt domain XML of a running virtual machine instance.",
+		Example: usageDomainXML(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().BoolVar(&c.capabilities, "capabilities", false, "Return the libvirt domain capabilities XML of the host instead.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageDomainXML() string {
+	return `  # Return the domain XML of a virtual machine instance called 'myvmi':
+  {{ProgramName}} domainxml myvmi
+
+  # Return the domain capabilities XML of the host running a virtual machine instance called 'myvmi':
+  {{ProgramName}} domainxml myvmi --capabilities`
+}
+
+func (c *domainXMLCommand) run(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	domainXML, err := virtClient.VirtualMachineInstance(namespace).DomainXML(context.Background(), vmiName)
+	if err != nil {
+		return fmt.Errorf("Error getting the domain XML of VirtualMachineInstance %s, %v", vmiName, err)
+	}
+
+	if c.capabilities {
+		fmt.Fprintln(cmd.OutOrStdout(), domainXML.DomainCapabilitiesXML)
+	} else {
+		fmt.Fprintln(cmd.OutOrStdout(), domainXML.DomainXML)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+	"kubevirt.io/kubevirt/pkg/virtctl/vm"
+)
+
+var _ = Describe("Domain XML command", func() {
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := testing.NewRepeatableVirtctlCommand(vm.COMMAND_DOMAINXML)
+		Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	It("should fail if the domain XML can not be fetched", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().DomainXML(context.Background(), vmiName).Return(v1.VirtualMachineInstanceDomainXML{}, fmt.Errorf("not running")).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand(vm.COMMAND_DOMAINXML, vmiName)
+		Expect(cmd()).To(MatchError("Error getting the domain XML of VirtualMachineInstance testvmi, not running"))
+	})
+
+	DescribeTable("should print", func(expected string, extraArgs ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().DomainXML(context.Background(), vmiName).Return(v1.VirtualMachineInstanceDomainXML{
+			DomainXML:             "<domain/>",
+			DomainCapabilitiesXML: "<domainCapabilities/>",
+		}, nil).Times(1)
+
+		args := append([]string{vm.COMMAND_DOMAINXML, vmiName}, extraArgs...)
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(args...)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(expected + "\n"))
+	},
+		Entry("the domain XML", "<domain/>"),
+		Entry("the domain capabilities XML", "<domainCapabilities/>", "--capabilities"),
+	)
+})
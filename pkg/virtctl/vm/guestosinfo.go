@@ -26,25 +26,33 @@ import (
 
 	"github.com/spf13/cobra"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
 const COMMAND_GUESTOSINFO = "guestosinfo"
 
+type guestOsInfoCommand struct {
+	refresh bool
+}
+
 func NewGuestOsInfoCommand() *cobra.Command {
+	c := guestOsInfoCommand{}
 	cmd := &cobra.Command{
 		Use:     "guestosinfo (VMI)",
 		Short:   "Return guest agent info about operating system.",
 		Example: usage(COMMAND_GUESTOSINFO),
 		Args:    cobra.ExactArgs(1),
-		RunE:    guestOsInfoRun,
+		RunE:    c.run,
 	}
+	cmd.Flags().BoolVar(&c.refresh, "refresh", false, "Poll the guest agent right away instead of returning the data of the last periodic sync.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
-func guestOsInfoRun(cmd *cobra.Command, args []string) error {
+func (c *guestOsInfoCommand) run(cmd *cobra.Command, args []string) error {
 	vmiName := args[0]
 
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
@@ -52,7 +60,12 @@ func guestOsInfoRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	guestosinfo, err := virtClient.VirtualMachineInstance(namespace).GuestOsInfo(context.Background(), vmiName)
+	var guestosinfo v1.VirtualMachineInstanceGuestAgentInfo
+	if c.refresh {
+		guestosinfo, err = virtClient.VirtualMachineInstance(namespace).RefreshGuestOsInfo(context.Background(), vmiName)
+	} else {
+		guestosinfo, err = virtClient.VirtualMachineInstance(namespace).GuestOsInfo(context.Background(), vmiName)
+	}
 	if err != nil {
 		return fmt.Errorf("Error getting guestosinfo of VirtualMachineInstance %s, %v", vmiName, err)
 	}
@@ -102,4 +102,22 @@ var _ = Describe("Guest os info command", func() {
 		cmd := testing.NewRepeatableVirtctlCommand("guestosinfo", vm.Name)
 		Expect(cmd()).To(Succeed())
 	})
+
+	It("should return refreshed guest agent data", func() {
+		vm := kubecli.NewMinimalVM(vmName)
+		guestOSInfo := v1.VirtualMachineInstanceGuestAgentInfo{
+			GAVersion: "3.1.0",
+		}
+
+		kubecli.MockKubevirtClientInstance.
+			EXPECT().
+			VirtualMachineInstance(k8smetav1.NamespaceDefault).
+			Return(vmiInterface).
+			Times(1)
+
+		vmiInterface.EXPECT().RefreshGuestOsInfo(context.Background(), vm.Name).Return(guestOSInfo, nil).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestosinfo", vm.Name, "--refresh")
+		Expect(cmd()).To(Succeed())
+	})
 })
@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_INSERTMEDIA = "insertmedia"
+	COMMAND_EJECTMEDIA  = "ejectmedia"
+
+	diskArg = "disk"
+)
+
+type mediaCommand struct {
+	disk       string
+	volumeName string
+	dryRun     bool
+}
+
+func NewInsertMediaCommand() *cobra.Command {
+	c := mediaCommand{}
+	cmd := &cobra.Command{
+		Use:     "insertmedia (VM)",
+		Short:   "Insert or change the media of a CD-ROM drive of a virtual machine.",
+		Example: usageInsertMedia(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.insertMedia,
+	}
+	cmd.Flags().StringVar(&c.disk, diskArg, "", "The name of the CD-ROM disk.")
+	cmd.MarkFlagRequired(diskArg)
+	cmd.Flags().StringVar(&c.volumeName, volumeNameArg, "", "The DataVolume or PersistentVolumeClaim holding the media.")
+	cmd.MarkFlagRequired(volumeNameArg)
+	cmd.Flags().BoolVar(&c.dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewEjectMediaCommand() *cobra.Command {
+	c := mediaCommand{}
+	cmd := &cobra.Command{
+		Use:     "ejectmedia (VM)",
+		Short:   "Eject the media of a CD-ROM drive of a virtual machine.",
+		Example: usageEjectMedia(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.ejectMedia,
+	}
+	cmd.Flags().StringVar(&c.disk, diskArg, "", "The name of the CD-ROM disk.")
+	cmd.MarkFlagRequired(diskArg)
+	cmd.Flags().BoolVar(&c.dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageInsertMedia() string {
+	return `  # Insert the ISO held by the PVC 'installer-iso' into the CD-ROM 'cdrom' of a virtual machine called 'myvm':
+  {{ProgramName}} insertmedia myvm --disk=cdrom --volume-name=installer-iso`
+}
+
+func usageEjectMedia() string {
+	return `  # Eject the media of the CD-ROM 'cdrom' of a virtual machine called 'myvm':
+  {{ProgramName}} ejectmedia myvm --disk=cdrom`
+}
+
+func (c *mediaCommand) insertMedia(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	volumeSource, err := getVolumeSourceFromVolume(c.volumeName, namespace, virtClient)
+	if err != nil {
+		return fmt.Errorf("error inserting media, %v", err)
+	}
+
+	opts := &v1.InsertMediaOptions{
+		Name:         c.disk,
+		VolumeSource: volumeSource,
+		DryRun:       setDryRunOption(c.dryRun),
+	}
+	if err := virtClient.VirtualMachine(namespace).InsertMedia(cmd.Context(), vmName, opts); err != nil {
+		return fmt.Errorf("error inserting media, %v", err)
+	}
+
+	cmd.Printf("Successfully submitted insert media request to VM %s for CD-ROM %s\n", vmName, c.disk)
+	return nil
+}
+
+func (c *mediaCommand) ejectMedia(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.EjectMediaOptions{
+		Name:   c.disk,
+		DryRun: setDryRunOption(c.dryRun),
+	}
+	if err := virtClient.VirtualMachine(namespace).EjectMedia(cmd.Context(), vmName, opts); err != nil {
+		return fmt.Errorf("error ejecting media, %v", err)
+	}
+
+	cmd.Printf("Successfully submitted eject media request to VM %s for CD-ROM %s\n", vmName, c.disk)
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Media commands", func() {
+	const (
+		vmName    = "testvm"
+		cdromName = "cdrom"
+		isoPVC    = "installer-iso"
+	)
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	DescribeTable("should fail with missing required parameters", func(expected string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("insertmedia without VM", "accepts 1 arg(s), received 0", "insertmedia"),
+		Entry("insertmedia without disk", "required flag(s) \"disk\" not set", "insertmedia", vmName, "--volume-name="+isoPVC),
+		Entry("insertmedia without volume", "required flag(s) \"volume-name\" not set", "insertmedia", vmName, "--disk="+cdromName),
+		Entry("ejectmedia without VM", "accepts 1 arg(s), received 0", "ejectmedia"),
+		Entry("ejectmedia without disk", "required flag(s) \"disk\" not set", "ejectmedia", vmName),
+	)
+
+	DescribeTable("should insert the media", func(dryRun []string, args ...string) {
+		coreClient := k8sfake.NewSimpleClientset(&k8sv1.PersistentVolumeClaim{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: isoPVC, Namespace: k8smetav1.NamespaceDefault},
+		})
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdifake.NewSimpleClientset())
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(coreClient.CoreV1())
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface)
+		vmInterface.EXPECT().InsertMedia(gomock.Any(), vmName, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, opts *v1.InsertMediaOptions) error {
+				Expect(opts.Name).To(Equal(cdromName))
+				Expect(opts.VolumeSource.PersistentVolumeClaim).ToNot(BeNil())
+				Expect(opts.VolumeSource.PersistentVolumeClaim.ClaimName).To(Equal(isoPVC))
+				Expect(opts.DryRun).To(Equal(dryRun))
+				return nil
+			})
+
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"insertmedia", vmName, "--disk=" + cdromName, "--volume-name=" + isoPVC}, args...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("with default", nil),
+		Entry("with dry-run", []string{k8smetav1.DryRunAll}, "--dry-run"),
+	)
+
+	It("should fail to insert a media which is not a DataVolume or PersistentVolumeClaim", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdifake.NewSimpleClientset())
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(k8sfake.NewSimpleClientset().CoreV1())
+
+		cmd := testing.NewRepeatableVirtctlCommand("insertmedia", vmName, "--disk="+cdromName, "--volume-name="+isoPVC)
+		Expect(cmd()).To(MatchError(ContainSubstring("is not a DataVolume or PersistentVolumeClaim")))
+	})
+
+	DescribeTable("should eject the media", func(ejectMediaOptions *v1.EjectMediaOptions, args ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface)
+		vmInterface.EXPECT().EjectMedia(gomock.Any(), vmName, ejectMediaOptions).Return(nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"ejectmedia", vmName, "--disk=" + cdromName}, args...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("with default", &v1.EjectMediaOptions{Name: cdromName}),
+		Entry("with dry-run", &v1.EjectMediaOptions{Name: cdromName, DryRun: []string{k8smetav1.DryRunAll}}, "--dry-run"),
+	)
+})
@@ -27,6 +27,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
@@ -69,7 +70,9 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 		AddedNodeSelector: c.addedNodeSelector,
 	}
 
-	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, options)
+	ctx, span := tracecontext.StartRootSpan(context.Background(), "virtctl migrate")
+	err = virtClient.VirtualMachine(namespace).Migrate(ctx, vmiName, options)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
 	}
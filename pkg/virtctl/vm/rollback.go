@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_ROLLBACK = "rollback"
+
+	toRevisionArg = "to-revision"
+)
+
+type rollbackCommand struct {
+	toRevision int64
+}
+
+func NewRollbackCommand() *cobra.Command {
+	c := rollbackCommand{}
+	cmd := &cobra.Command{
+		Use:     "rollback (VM)",
+		Short:   "Roll back a virtual machine to a previous revision of its spec.",
+		Example: usageRollback(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().Int64Var(&c.toRevision, toRevisionArg, 0, "The revision to roll back to. Defaults to the revision preceding the current one.")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageRollback() string {
+	return `  # Roll back a virtual machine called 'myvm' to its previous revision:
+  {{ProgramName}} rollback myvm
+
+  # Roll back a virtual machine called 'myvm' to its revision 3:
+  {{ProgramName}} rollback myvm --to-revision=3`
+}
+
+func (c *rollbackCommand) run(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.RollbackOptions{
+		DryRun:   setDryRunOption(dryRun),
+		Revision: c.toRevision,
+	}
+	if err := virtClient.VirtualMachine(namespace).Rollback(cmd.Context(), vmName, opts); err != nil {
+		return fmt.Errorf("error rolling back VirtualMachine: %v", err)
+	}
+
+	cmd.Printf("VM %s was rolled back\n", vmName)
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
@@ -65,7 +66,9 @@ func (o *Command) startRun(cmd *cobra.Command, args []string) error {
 
 	dryRunOption := setDryRunOption(dryRun)
 
-	err = virtClient.VirtualMachine(namespace).Start(context.Background(), vmiName, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
+	ctx, span := tracecontext.StartRootSpan(context.Background(), "virtctl start")
+	err = virtClient.VirtualMachine(namespace).Start(ctx, vmiName, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("Error starting VirtualMachine %v", err)
 	}
@@ -27,6 +27,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/util/tracecontext"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
@@ -72,7 +73,9 @@ func (o *Command) stopRun(cmd *cobra.Command, args []string) error {
 		errorFmt = "error force stopping VirtualMachine: %v"
 	}
 
-	err = virtClient.VirtualMachine(namespace).Stop(context.Background(), vmiName, stopOpts)
+	ctx, span := tracecontext.StartRootSpan(context.Background(), "virtctl stop")
+	err = virtClient.VirtualMachine(namespace).Stop(ctx, vmiName, stopOpts)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf(errorFmt, err)
 	}
@@ -0,0 +1,115 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SUSPEND = "suspend"
+	COMMAND_RESUME  = "resume"
+)
+
+type suspendCommand struct {
+	dryRun bool
+}
+
+func NewSuspendCommand() *cobra.Command {
+	c := suspendCommand{}
+	cmd := &cobra.Command{
+		Use:     "suspend (VM)",
+		Short:   "Suspend a virtual machine to disk, saving its memory and releasing its node resources.",
+		Example: usageSuspend(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.suspend,
+	}
+	cmd.Flags().BoolVar(&c.dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewResumeCommand() *cobra.Command {
+	c := suspendCommand{}
+	cmd := &cobra.Command{
+		Use:     "resume (VM)",
+		Short:   "Resume a virtual machine suspended to disk from its saved memory.",
+		Example: usageResume(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.resume,
+	}
+	cmd.Flags().BoolVar(&c.dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageSuspend() string {
+	return `  # Suspend a virtual machine called 'myvm' to disk:
+  {{ProgramName}} suspend myvm`
+}
+
+func usageResume() string {
+	return `  # Resume a virtual machine called 'myvm' which was suspended to disk:
+  {{ProgramName}} resume myvm`
+}
+
+func (c *suspendCommand) suspend(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.SuspendOptions{
+		DryRun: setDryRunOption(c.dryRun),
+	}
+	if err := virtClient.VirtualMachine(namespace).Suspend(cmd.Context(), vmName, opts); err != nil {
+		return fmt.Errorf("error suspending VirtualMachine: %v", err)
+	}
+
+	cmd.Printf("VM %s was scheduled to suspend\n", vmName)
+	return nil
+}
+
+func (c *suspendCommand) resume(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.ResumeOptions{
+		DryRun: setDryRunOption(c.dryRun),
+	}
+	if err := virtClient.VirtualMachine(namespace).Resume(cmd.Context(), vmName, opts); err != nil {
+		return fmt.Errorf("error resuming VirtualMachine: %v", err)
+	}
+
+	cmd.Printf("VM %s was scheduled to resume\n", vmName)
+	return nil
+}
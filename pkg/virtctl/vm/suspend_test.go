@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Suspend and resume commands", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	const vmName = "testvm"
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	DescribeTable("should fail with missing input parameters", func(command string) {
+		cmd := testing.NewRepeatableVirtctlCommand(command)
+		Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+	},
+		Entry("suspend", "suspend"),
+		Entry("resume", "resume"),
+	)
+
+	DescribeTable("should suspend the VM", func(suspendOptions *v1.SuspendOptions, args ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Suspend(gomock.Any(), vmName, suspendOptions).Return(nil).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"suspend", vmName}, args...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("without dry-run", &v1.SuspendOptions{}),
+		Entry("with dry-run", &v1.SuspendOptions{DryRun: []string{k8smetav1.DryRunAll}}, "--dry-run"),
+	)
+
+	DescribeTable("should resume the VM", func(resumeOptions *v1.ResumeOptions, args ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Resume(gomock.Any(), vmName, resumeOptions).Return(nil).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"resume", vmName}, args...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("without dry-run", &v1.ResumeOptions{}),
+		Entry("with dry-run", &v1.ResumeOptions{DryRun: []string{k8smetav1.DryRunAll}}, "--dry-run"),
+	)
+
+	It("should return an error if suspending the VM fails", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Suspend(gomock.Any(), vmName, gomock.Any()).Return(errors.New("test error")).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("suspend", vmName)
+		Expect(cmd()).To(MatchError("error suspending VirtualMachine: test error"))
+	})
+})
@@ -32,6 +32,9 @@ var (
 	DefaultCPULimit      = resource.MustParse("100m")
 	DefaultMemoryRequest = resource.MustParse("1M")
 	DefaultMemoryLimit   = resource.MustParse("80M")
+
+	// ThreadMemoryOverhead is the memory added to the virtiofs container for every worker thread
+	ThreadMemoryOverhead = resource.MustParse("2Mi")
 )
 
 // SupportContainerResourceConfig provides access to support container resource configuration
@@ -42,8 +45,9 @@ type SupportContainerResourceConfig interface {
 
 // ResourcesForVirtioFSContainer returns the resource requirements for a virtiofs container.
 // The dedicatedCPUs and guaranteedQOS parameters control whether CPU and memory requests
-// should be set equal to limits for QOS guarantees.
-func ResourcesForVirtioFSContainer(dedicatedCPUs, guaranteedQOS bool, config SupportContainerResourceConfig) k8sv1.ResourceRequirements {
+// should be set equal to limits for QOS guarantees. The memory is increased by the
+// ThreadMemoryOverhead for every worker thread of the thread pool of fs, if any.
+func ResourcesForVirtioFSContainer(dedicatedCPUs, guaranteedQOS bool, fs *v1.FilesystemVirtiofs, config SupportContainerResourceConfig) k8sv1.ResourceRequirements {
 	resources := k8sv1.ResourceRequirements{
 		Requests: k8sv1.ResourceList{},
 		Limits:   k8sv1.ResourceList{},
@@ -90,5 +94,14 @@ func ResourcesForVirtioFSContainer(dedicatedCPUs, guaranteedQOS bool, config Sup
 		}
 	}
 
+	if fs != nil && fs.ThreadPoolSize != nil && *fs.ThreadPoolSize > 0 {
+		threadsMemory := resource.NewQuantity(ThreadMemoryOverhead.Value()*int64(*fs.ThreadPoolSize), resource.BinarySI)
+		for _, list := range []k8sv1.ResourceList{resources.Requests, resources.Limits} {
+			memory := list[k8sv1.ResourceMemory]
+			memory.Add(*threadsMemory)
+			list[k8sv1.ResourceMemory] = memory
+		}
+	}
+
 	return resources
 }
@@ -23,6 +23,10 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
@@ -34,3 +38,42 @@ func VirtioFSSocketPath(volumeName string) string {
 	socketName := fmt.Sprintf("%s.sock", volumeName)
 	return filepath.Join(VirtioFSContainersMountBaseDir, socketName)
 }
+
+// DAXWindowAlignment is the alignment required for the size of a DAX window
+var DAXWindowAlignment = resource.MustParse("2Mi")
+
+// GetFilesystemVirtiofs returns the virtiofs options of the filesystem sharing the volume
+func GetFilesystemVirtiofs(vmi *v1.VirtualMachineInstance, volumeName string) *v1.FilesystemVirtiofs {
+	for _, fs := range vmi.Spec.Domain.Devices.Filesystems {
+		if fs.Name == volumeName {
+			return fs.Virtiofs
+		}
+	}
+	return nil
+}
+
+// TuningArgs returns the virtiofsd arguments for the cache mode and the thread pool size of the filesystem
+func TuningArgs(fs *v1.FilesystemVirtiofs) []string {
+	cache := v1.VirtiofsCacheAuto
+	if fs != nil && fs.Cache != "" {
+		cache = fs.Cache
+	}
+	args := []string{fmt.Sprintf("--cache=%s", cache)}
+
+	if fs != nil && fs.ThreadPoolSize != nil {
+		args = append(args, fmt.Sprintf("--thread-pool-size=%d", *fs.ThreadPoolSize))
+	}
+	return args
+}
+
+// DAXWindowsSize returns the total size of the DAX windows of the VMI.
+// The windows are mapped by QEMU, hence they are accounted in the virt-launcher overhead.
+func DAXWindowsSize(vmi *v1.VirtualMachineInstance) resource.Quantity {
+	size := resource.NewQuantity(0, resource.BinarySI)
+	for _, fs := range vmi.Spec.Domain.Devices.Filesystems {
+		if fs.Virtiofs != nil && fs.Virtiofs.DAXWindowSize != nil {
+			size.Add(*fs.Virtiofs.DAXWindowSize)
+		}
+	}
+	return *size
+}
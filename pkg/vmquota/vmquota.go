@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmquota
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
+)
+
+// Usage is the consumption of running VirtualMachines in the terms of a VirtualMachineQuota
+type Usage struct {
+	VMs         int64
+	GuestMemory resource.Quantity
+	Sockets     int64
+}
+
+// Add accounts for a VirtualMachineInstance with the given spec
+func (u *Usage) Add(spec *v1.VirtualMachineInstanceSpec) {
+	u.VMs++
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		u.GuestMemory.Add(*spec.Domain.Memory.Guest)
+	}
+	if spec.Domain.CPU != nil && spec.Domain.CPU.Sockets > 0 {
+		u.Sockets += int64(spec.Domain.CPU.Sockets)
+	} else {
+		u.Sockets++
+	}
+}
+
+func (u *Usage) AddUsage(other *Usage) {
+	u.VMs += other.VMs
+	u.GuestMemory.Add(other.GuestMemory)
+	u.Sockets += other.Sockets
+}
+
+// Limits returns the usage in the terms of the status of a VirtualMachineQuota
+func (u *Usage) Limits() *quotav1alpha1.VirtualMachineQuotaLimits {
+	guestMemory := u.GuestMemory.DeepCopy()
+	return &quotav1alpha1.VirtualMachineQuotaLimits{
+		RunningVirtualMachines: &u.VMs,
+		GuestMemory:            &guestMemory,
+		Sockets:                &u.Sockets,
+	}
+}
+
+// UsageFromLimits reads the usage reported in the status of a VirtualMachineQuota
+func UsageFromLimits(limits *quotav1alpha1.VirtualMachineQuotaLimits) Usage {
+	u := Usage{}
+	if limits.RunningVirtualMachines != nil {
+		u.VMs = *limits.RunningVirtualMachines
+	}
+	if limits.GuestMemory != nil {
+		u.GuestMemory = limits.GuestMemory.DeepCopy()
+	}
+	if limits.Sockets != nil {
+		u.Sockets = *limits.Sockets
+	}
+	return u
+}
+
+// Reserved sums the usage of the reservations of the quota
+func Reserved(quota *quotav1alpha1.VirtualMachineQuota) Usage {
+	u := Usage{}
+	for i := range quota.Status.Reservations {
+		reserved := UsageFromLimits(&quota.Status.Reservations[i].Used)
+		u.AddUsage(&reserved)
+	}
+	return u
+}
+
+// Consumes reports whether the VirtualMachineInstance consumes the quotas of its namespace
+func Consumes(vmi *v1.VirtualMachineInstance) bool {
+	return !vmi.IsFinal()
+}
+
+// NamespaceUsage sums the usage of the VirtualMachineInstances of the namespace consuming quota,
+// the one named exclude excluded.
+func NamespaceUsage(vmiIndexer cache.Indexer, namespace, exclude string) (Usage, error) {
+	used := Usage{}
+	err := cache.ListAllByNamespace(vmiIndexer, namespace, labels.Everything(), func(obj interface{}) {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.Name != exclude && Consumes(vmi) {
+			used.Add(&vmi.Spec)
+		}
+	})
+	return used, err
+}
+
+// List returns the VirtualMachineQuotas of the namespace, sorted by name
+func List(quotaIndexer cache.Indexer, namespace string) ([]*quotav1alpha1.VirtualMachineQuota, error) {
+	var quotas []*quotav1alpha1.VirtualMachineQuota
+	err := cache.ListAllByNamespace(quotaIndexer, namespace, labels.Everything(), func(obj interface{}) {
+		quotas = append(quotas, obj.(*quotav1alpha1.VirtualMachineQuota))
+	})
+	sort.Slice(quotas, func(i, j int) bool {
+		return quotas[i].Name < quotas[j].Name
+	})
+	return quotas, err
+}
+
+// Check returns a cause for each limit of the quota the requested usage exceeds on top of the used one
+func Check(quota *quotav1alpha1.VirtualMachineQuota, used, requested *Usage) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	exceeded := func(resourceName, requested, used, limit string) {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeForbidden,
+			Message: fmt.Sprintf("exceeded VirtualMachineQuota %s: requested %s %s, used %s, limited to %s",
+				quota.Name, resourceName, requested, used, limit),
+			Field: k8sfield.NewPath("spec").String(),
+		})
+	}
+
+	hard := quota.Spec.Hard
+	if hard.RunningVirtualMachines != nil && used.VMs+requested.VMs > *hard.RunningVirtualMachines {
+		exceeded("running VirtualMachines", fmt.Sprint(requested.VMs), fmt.Sprint(used.VMs), fmt.Sprint(*hard.RunningVirtualMachines))
+	}
+	if hard.GuestMemory != nil {
+		total := used.GuestMemory.DeepCopy()
+		total.Add(requested.GuestMemory)
+		if total.Cmp(*hard.GuestMemory) > 0 {
+			exceeded("guest memory", requested.GuestMemory.String(), used.GuestMemory.String(), hard.GuestMemory.String())
+		}
+	}
+	if hard.Sockets != nil && used.Sockets+requested.Sockets > *hard.Sockets {
+		exceeded("sockets", fmt.Sprint(requested.Sockets), fmt.Sprint(used.Sockets), fmt.Sprint(*hard.Sockets))
+	}
+	return causes
+}
@@ -120,6 +120,27 @@ func (in *AddVolumeOptions) DeepCopy() *AddVolumeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedQEMUArg) DeepCopyInto(out *AllowedQEMUArg) {
+	*out = *in
+	if in.ValuePrefixes != nil {
+		in, out := &in.ValuePrefixes, &out.ValuePrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedQEMUArg.
+func (in *AllowedQEMUArg) DeepCopy() *AllowedQEMUArg {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedQEMUArg)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchConfiguration) DeepCopyInto(out *ArchConfiguration) {
 	*out = *in
@@ -164,6 +185,18 @@ func (in *ArchSpecificConfiguration) DeepCopyInto(out *ArchSpecificConfiguration
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MachineTypeAliases != nil {
+		in, out := &in.MachineTypeAliases, &out.MachineTypeAliases
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(EFIFirmwareConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -334,6 +367,37 @@ func (in *CPUFeature) DeepCopy() *CPUFeature {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUModelsConfiguration) DeepCopyInto(out *CPUModelsConfiguration) {
+	*out = *in
+	if in.Obsolete != nil {
+		in, out := &in.Obsolete, &out.Obsolete
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinModels != nil {
+		in, out := &in.MinModels, &out.MinModels
+		*out = make([]VendorCPUModel, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUModelsConfiguration.
+func (in *CPUModelsConfiguration) DeepCopy() *CPUModelsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUModelsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUTopology) DeepCopyInto(out *CPUTopology) {
 	*out = *in
@@ -480,6 +544,11 @@ func (in *Clock) DeepCopyInto(out *Clock) {
 		*out = new(Timer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestTimeSync != nil {
+		in, out := &in.GuestTimeSync, &out.GuestTimeSync
+		*out = new(GuestTimeSync)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -506,6 +575,11 @@ func (in *ClockOffset) DeepCopyInto(out *ClockOffset) {
 		*out = new(ClockOffsetTimezone)
 		**out = **in
 	}
+	if in.Variable != nil {
+		in, out := &in.Variable, &out.Variable
+		*out = new(ClockOffsetVariable)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -540,6 +614,27 @@ func (in *ClockOffsetUTC) DeepCopy() *ClockOffsetUTC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClockOffsetVariable) DeepCopyInto(out *ClockOffsetVariable) {
+	*out = *in
+	if in.OffsetSeconds != nil {
+		in, out := &in.OffsetSeconds, &out.OffsetSeconds
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockOffsetVariable.
+func (in *ClockOffsetVariable) DeepCopy() *ClockOffsetVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(ClockOffsetVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudInitConfigDriveSource) DeepCopyInto(out *CloudInitConfigDriveSource) {
 	*out = *in
@@ -806,6 +901,37 @@ func (in *ControllerRevisionRef) DeepCopy() *ControllerRevisionRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrashLoopBackoff) DeepCopyInto(out *CrashLoopBackoff) {
+	*out = *in
+	if in.BaseDelay != nil {
+		in, out := &in.BaseDelay, &out.BaseDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ResetWindow != nil {
+		in, out := &in.ResetWindow, &out.ResetWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrashLoopBackoff.
+func (in *CrashLoopBackoff) DeepCopy() *CrashLoopBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(CrashLoopBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomBlockSize) DeepCopyInto(out *CustomBlockSize) {
 	*out = *in
@@ -1136,6 +1262,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Serials != nil {
+		in, out := &in.Serials, &out.Serials
+		*out = make([]SerialDevice, len(*in))
+		copy(*out, *in)
+	}
 	if in.AutoattachMemBalloon != nil {
 		in, out := &in.AutoattachMemBalloon, &out.AutoattachMemBalloon
 		*out = new(bool)
@@ -1151,6 +1282,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AutoattachVirtioDriversDisk != nil {
+		in, out := &in.AutoattachVirtioDriversDisk, &out.AutoattachVirtioDriversDisk
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Rng != nil {
 		in, out := &in.Rng, &out.Rng
 		*out = new(Rng)
@@ -1185,6 +1321,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GuestPanicCapture != nil {
+		in, out := &in.GuestPanicCapture, &out.GuestPanicCapture
+		*out = new(GuestPanicCapture)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Filesystems != nil {
 		in, out := &in.Filesystems, &out.Filesystems
 		*out = make([]Filesystem, len(*in))
@@ -1500,6 +1641,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 		*out = new(RebootPolicy)
 		**out = **in
 	}
+	if in.QEMUCommandLine != nil {
+		in, out := &in.QEMUCommandLine, &out.QEMUCommandLine
+		*out = new(QEMUCommandLine)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1513,6 +1659,32 @@ func (in *DomainSpec) DeepCopy() *DomainSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainStatsConfiguration) DeepCopyInto(out *DomainStatsConfiguration) {
+	*out = *in
+	if in.CollectionInterval != nil {
+		in, out := &in.CollectionInterval, &out.CollectionInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DisabledCollectors != nil {
+		in, out := &in.DisabledCollectors, &out.DisabledCollectors
+		*out = make([]DomainStatsCollector, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainStatsConfiguration.
+func (in *DomainStatsConfiguration) DeepCopy() *DomainStatsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainStatsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownwardAPIVolumeSource) DeepCopyInto(out *DownwardAPIVolumeSource) {
 	*out = *in
@@ -1594,6 +1766,89 @@ func (in *EFI) DeepCopy() *EFI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFIFirmwareConfiguration) DeepCopyInto(out *EFIFirmwareConfiguration) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(EFIFirmwareImages)
+		**out = **in
+	}
+	if in.SecureBoot != nil {
+		in, out := &in.SecureBoot, &out.SecureBoot
+		*out = new(EFIFirmwareImages)
+		**out = **in
+	}
+	if in.SEV != nil {
+		in, out := &in.SEV, &out.SEV
+		*out = new(EFIFirmwareImages)
+		**out = **in
+	}
+	if in.SNP != nil {
+		in, out := &in.SNP, &out.SNP
+		*out = new(EFIFirmwareImages)
+		**out = **in
+	}
+	if in.TDX != nil {
+		in, out := &in.TDX, &out.TDX
+		*out = new(EFIFirmwareImages)
+		**out = **in
+	}
+	if in.TDXSecureBoot != nil {
+		in, out := &in.TDXSecureBoot, &out.TDXSecureBoot
+		*out = new(EFIFirmwareImages)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFIFirmwareConfiguration.
+func (in *EFIFirmwareConfiguration) DeepCopy() *EFIFirmwareConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EFIFirmwareConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFIFirmwareImages) DeepCopyInto(out *EFIFirmwareImages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFIFirmwareImages.
+func (in *EFIFirmwareImages) DeepCopy() *EFIFirmwareImages {
+	if in == nil {
+		return nil
+	}
+	out := new(EFIFirmwareImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EjectMediaOptions) DeepCopyInto(out *EjectMediaOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EjectMediaOptions.
+func (in *EjectMediaOptions) DeepCopy() *EjectMediaOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EjectMediaOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmptyDiskSource) DeepCopyInto(out *EmptyDiskSource) {
 	*out = *in
@@ -1654,6 +1909,37 @@ func (in *EvacuateCancelOptions) DeepCopy() *EvacuateCancelOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventConfiguration) DeepCopyInto(out *EventConfiguration) {
+	*out = *in
+	if in.RateLimiter != nil {
+		in, out := &in.RateLimiter, &out.RateLimiter
+		*out = new(TokenBucketRateLimiter)
+		**out = **in
+	}
+	if in.DeduplicationWindow != nil {
+		in, out := &in.DeduplicationWindow, &out.DeduplicationWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SuppressedReasons != nil {
+		in, out := &in.SuppressedReasons, &out.SuppressedReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventConfiguration.
+func (in *EventConfiguration) DeepCopy() *EventConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EventConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureAPIC) DeepCopyInto(out *FeatureAPIC) {
 	*out = *in
@@ -1671,6 +1957,22 @@ func (in *FeatureAPIC) DeepCopy() *FeatureAPIC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureGateStatus) DeepCopyInto(out *FeatureGateStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGateStatus.
+func (in *FeatureGateStatus) DeepCopy() *FeatureGateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureGateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureHyperv) DeepCopyInto(out *FeatureHyperv) {
 	*out = *in
@@ -1886,7 +2188,7 @@ func (in *Filesystem) DeepCopyInto(out *Filesystem) {
 	if in.Virtiofs != nil {
 		in, out := &in.Virtiofs, &out.Virtiofs
 		*out = new(FilesystemVirtiofs)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1904,6 +2206,16 @@ func (in *Filesystem) DeepCopy() *Filesystem {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemVirtiofs) DeepCopyInto(out *FilesystemVirtiofs) {
 	*out = *in
+	if in.DAXWindowSize != nil {
+		in, out := &in.DAXWindowSize, &out.DAXWindowSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ThreadPoolSize != nil {
+		in, out := &in.ThreadPoolSize, &out.ThreadPoolSize
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -1935,6 +2247,11 @@ func (in *Firmware) DeepCopyInto(out *Firmware) {
 		*out = new(ACPI)
 		**out = **in
 	}
+	if in.SMBIOS != nil {
+		in, out := &in.SMBIOS, &out.SMBIOS
+		*out = new(SMBIOS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2065,55 +2382,272 @@ func (in *GuestAgentCommandInfo) DeepCopy() *GuestAgentCommandInfo {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GuestAgentPing) DeepCopyInto(out *GuestAgentPing) {
+func (in *GuestAgentCommandsConfiguration) DeepCopyInto(out *GuestAgentCommandsConfiguration) {
 	*out = *in
+	if in.AllowedCommands != nil {
+		in, out := &in.AllowedCommands, &out.AllowedCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedCommands != nil {
+		in, out := &in.DeniedCommands, &out.DeniedCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentPing.
-func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentCommandsConfiguration.
+func (in *GuestAgentCommandsConfiguration) DeepCopy() *GuestAgentCommandsConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(GuestAgentPing)
+	out := new(GuestAgentCommandsConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
+func (in *GuestAgentData) DeepCopyInto(out *GuestAgentData) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	if in.OS != nil {
+		in, out := &in.OS, &out.OS
+		*out = new(GuestAgentOSInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(GuestAgentValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FQDN != nil {
+		in, out := &in.FQDN, &out.FQDN
+		*out = new(GuestAgentValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(GuestAgentValue)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPETTimer.
-func (in *HPETTimer) DeepCopy() *HPETTimer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentData.
+func (in *GuestAgentData) DeepCopy() *GuestAgentData {
 	if in == nil {
 		return nil
 	}
-	out := new(HPETTimer)
+	out := new(GuestAgentData)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Handler) DeepCopyInto(out *Handler) {
+func (in *GuestAgentFileExists) DeepCopyInto(out *GuestAgentFileExists) {
 	*out = *in
-	if in.Exec != nil {
-		in, out := &in.Exec, &out.Exec
-		*out = new(corev1.ExecAction)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentFileExists.
+func (in *GuestAgentFileExists) DeepCopy() *GuestAgentFileExists {
+	if in == nil {
+		return nil
 	}
-	if in.GuestAgentPing != nil {
+	out := new(GuestAgentFileExists)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentOSInfo) DeepCopyInto(out *GuestAgentOSInfo) {
+	*out = *in
+	out.Info = in.Info
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentOSInfo.
+func (in *GuestAgentOSInfo) DeepCopy() *GuestAgentOSInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentOSInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentPing) DeepCopyInto(out *GuestAgentPing) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentPing.
+func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentPing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentValue) DeepCopyInto(out *GuestAgentValue) {
+	*out = *in
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentValue.
+func (in *GuestAgentValue) DeepCopy() *GuestAgentValue {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecConfiguration) DeepCopyInto(out *GuestExecConfiguration) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecConfiguration.
+func (in *GuestExecConfiguration) DeepCopy() *GuestExecConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecOptions) DeepCopyInto(out *GuestExecOptions) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecOptions.
+func (in *GuestExecOptions) DeepCopy() *GuestExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestPanicCapture) DeepCopyInto(out *GuestPanicCapture) {
+	*out = *in
+	if in.ConsoleLogLines != nil {
+		in, out := &in.ConsoleLogLines, &out.ConsoleLogLines
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestPanicCapture.
+func (in *GuestPanicCapture) DeepCopy() *GuestPanicCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestPanicCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestTimeSync) DeepCopyInto(out *GuestTimeSync) {
+	*out = *in
+	if in.AfterMigration != nil {
+		in, out := &in.AfterMigration, &out.AfterMigration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AfterUnpause != nil {
+		in, out := &in.AfterUnpause, &out.AfterUnpause
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestTimeSync.
+func (in *GuestTimeSync) DeepCopy() *GuestTimeSync {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestTimeSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPETTimer.
+func (in *HPETTimer) DeepCopy() *HPETTimer {
+	if in == nil {
+		return nil
+	}
+	out := new(HPETTimer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Handler) DeepCopyInto(out *Handler) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(corev1.ExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestAgentPing != nil {
 		in, out := &in.GuestAgentPing, &out.GuestAgentPing
 		*out = new(GuestAgentPing)
 		**out = **in
 	}
+	if in.GuestAgentFileExists != nil {
+		in, out := &in.GuestAgentFileExists, &out.GuestAgentFileExists
+		*out = new(GuestAgentFileExists)
+		**out = **in
+	}
 	if in.HTTPGet != nil {
 		in, out := &in.HTTPGet, &out.HTTPGet
 		*out = new(corev1.HTTPGetAction)
@@ -2312,6 +2846,43 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ignition) DeepCopyInto(out *Ignition) {
+	*out = *in
+	if in.Fragments != nil {
+		in, out := &in.Fragments, &out.Fragments
+		*out = make([]IgnitionFragment, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
+func (in *Ignition) DeepCopy() *Ignition {
+	if in == nil {
+		return nil
+	}
+	out := new(Ignition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionFragment) DeepCopyInto(out *IgnitionFragment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionFragment.
+func (in *IgnitionFragment) DeepCopy() *IgnitionFragment {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionFragment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2344,6 +2915,32 @@ func (in *Input) DeepCopy() *Input {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsertMediaOptions) DeepCopyInto(out *InsertMediaOptions) {
+	*out = *in
+	if in.VolumeSource != nil {
+		in, out := &in.VolumeSource, &out.VolumeSource
+		*out = new(HotplugVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsertMediaOptions.
+func (in *InsertMediaOptions) DeepCopy() *InsertMediaOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InsertMediaOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancetypeConfiguration) DeepCopyInto(out *InstancetypeConfiguration) {
 	*out = *in
@@ -2352,6 +2949,11 @@ func (in *InstancetypeConfiguration) DeepCopyInto(out *InstancetypeConfiguration
 		*out = new(InstancetypeReferencePolicy)
 		**out = **in
 	}
+	if in.VirtioDrivers != nil {
+		in, out := &in.VirtioDrivers, &out.VirtioDrivers
+		*out = new(VirtioDriversConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -2431,6 +3033,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.NetworkBoot != nil {
+		in, out := &in.NetworkBoot, &out.NetworkBoot
+		*out = new(InterfaceNetworkBoot)
+		**out = **in
+	}
 	if in.DHCPOptions != nil {
 		in, out := &in.DHCPOptions, &out.DHCPOptions
 		*out = new(DHCPOptions)
@@ -2574,6 +3181,22 @@ func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceNetworkBoot) DeepCopyInto(out *InterfaceNetworkBoot) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceNetworkBoot.
+func (in *InterfaceNetworkBoot) DeepCopy() *InterfaceNetworkBoot {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceNetworkBoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfacePasstBinding) DeepCopyInto(out *InterfacePasstBinding) {
 	*out = *in
@@ -2825,7 +3448,7 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 	if in.SMBIOSConfig != nil {
 		in, out := &in.SMBIOSConfig, &out.SMBIOSConfig
 		*out = new(SMBiosConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ArchitectureConfiguration != nil {
 		in, out := &in.ArchitectureConfiguration, &out.ArchitectureConfiguration
@@ -2911,6 +3534,18 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(SeccompConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CPUModels != nil {
+		in, out := &in.CPUModels, &out.CPUModels
+		*out = new(CPUModelsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeOverrides != nil {
+		in, out := &in.NodeOverrides, &out.NodeOverrides
+		*out = make([]NodeConfigurationOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.VirtualMachineOptions != nil {
 		in, out := &in.VirtualMachineOptions, &out.VirtualMachineOptions
 		*out = new(VirtualMachineOptions)
@@ -2921,6 +3556,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(KSMConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemoryOvercommitConfiguration != nil {
+		in, out := &in.MemoryOvercommitConfiguration, &out.MemoryOvercommitConfiguration
+		*out = new(MemoryOvercommitConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AutoCPULimitNamespaceLabelSelector != nil {
 		in, out := &in.AutoCPULimitNamespaceLabelSelector, &out.AutoCPULimitNamespaceLabelSelector
 		*out = new(metav1.LabelSelector)
@@ -2946,6 +3586,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(VirtTemplateDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkflowAlertsDeployment != nil {
+		in, out := &in.WorkflowAlertsDeployment, &out.WorkflowAlertsDeployment
+		*out = new(WorkflowAlertsDeployment)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Instancetype != nil {
 		in, out := &in.Instancetype, &out.Instancetype
 		*out = new(InstancetypeConfiguration)
@@ -2976,6 +3621,58 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(RoleAggregationStrategy)
 		**out = **in
 	}
+	if in.EventConfiguration != nil {
+		in, out := &in.EventConfiguration, &out.EventConfiguration
+		*out = new(EventConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DomainStatsConfiguration != nil {
+		in, out := &in.DomainStatsConfiguration, &out.DomainStatsConfiguration
+		*out = new(DomainStatsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VMInfoMetrics != nil {
+		in, out := &in.VMInfoMetrics, &out.VMInfoMetrics
+		*out = new(VMInfoMetricsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubresourceConnectionLimits != nil {
+		in, out := &in.SubresourceConnectionLimits, &out.SubresourceConnectionLimits
+		*out = new(SubresourceConnectionLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PoolMetricsAdapter != nil {
+		in, out := &in.PoolMetricsAdapter, &out.PoolMetricsAdapter
+		*out = new(PoolMetricsAdapterConfiguration)
+		**out = **in
+	}
+	if in.LauncherWatchdog != nil {
+		in, out := &in.LauncherWatchdog, &out.LauncherWatchdog
+		*out = new(LauncherWatchdogConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QEMUArgsPassthrough != nil {
+		in, out := &in.QEMUArgsPassthrough, &out.QEMUArgsPassthrough
+		*out = new(QEMUArgsPassthroughConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestAgentCommands != nil {
+		in, out := &in.GuestAgentCommands, &out.GuestAgentCommands
+		*out = new(GuestAgentCommandsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestExec != nil {
+		in, out := &in.GuestExec, &out.GuestExec
+		*out = new(GuestExecConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LauncherReservations != nil {
+		in, out := &in.LauncherReservations, &out.LauncherReservations
+		*out = make([]LauncherReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3022,6 +3719,27 @@ func (in *KubeVirtList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtRolloutStrategy) DeepCopyInto(out *KubeVirtRolloutStrategy) {
+	*out = *in
+	if in.VirtHandler != nil {
+		in, out := &in.VirtHandler, &out.VirtHandler
+		*out = new(NodePoolRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtRolloutStrategy.
+func (in *KubeVirtRolloutStrategy) DeepCopy() *KubeVirtRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtSelfSignConfiguration) DeepCopyInto(out *KubeVirtSelfSignConfiguration) {
 	*out = *in
@@ -3072,6 +3790,11 @@ func (in *KubeVirtSpec) DeepCopyInto(out *KubeVirtSpec) {
 		copy(*out, *in)
 	}
 	in.WorkloadUpdateStrategy.DeepCopyInto(&out.WorkloadUpdateStrategy)
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(KubeVirtRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.CertificateRotationStrategy.DeepCopyInto(&out.CertificateRotationStrategy)
 	in.Configuration.DeepCopyInto(&out.Configuration)
 	if in.Infra != nil {
@@ -3128,6 +3851,11 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]FeatureGateStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3159,6 +3887,23 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUpdatesPerNamespace != nil {
+		in, out := &in.MaxUpdatesPerNamespace, &out.MaxUpdatesPerNamespace
+		*out = new(int)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]WorkloadUpdateWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3203,6 +3948,62 @@ func (in *LaunchSecurity) DeepCopy() *LaunchSecurity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LauncherReservation) DeepCopyInto(out *LauncherReservation) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Instancetypes != nil {
+		in, out := &in.Instancetypes, &out.Instancetypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LauncherReservation.
+func (in *LauncherReservation) DeepCopy() *LauncherReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LauncherReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LauncherWatchdogConfiguration) DeepCopyInto(out *LauncherWatchdogConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LauncherWatchdogConfiguration.
+func (in *LauncherWatchdogConfiguration) DeepCopy() *LauncherWatchdogConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LauncherWatchdogConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LiveUpdateConfiguration) DeepCopyInto(out *LiveUpdateConfiguration) {
 	*out = *in
@@ -3361,6 +4162,26 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 		*out = new(ReservedOverhead)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KSM != nil {
+		in, out := &in.KSM, &out.KSM
+		*out = new(FeatureState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreePageReporting != nil {
+		in, out := &in.FreePageReporting, &out.FreePageReporting
+		*out = new(FeatureState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(MemoryBalloon)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuspendToDisk != nil {
+		in, out := &in.SuspendToDisk, &out.SuspendToDisk
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -3374,6 +4195,28 @@ func (in *Memory) DeepCopy() *Memory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryBalloon) DeepCopyInto(out *MemoryBalloon) {
+	*out = *in
+	out.Min = in.Min.DeepCopy()
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryBalloon.
+func (in *MemoryBalloon) DeepCopy() *MemoryBalloon {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryBalloon)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryDumpVolumeSource) DeepCopyInto(out *MemoryDumpVolumeSource) {
 	*out = *in
@@ -3391,6 +4234,48 @@ func (in *MemoryDumpVolumeSource) DeepCopy() *MemoryDumpVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryOvercommitClass) DeepCopyInto(out *MemoryOvercommitClass) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryOvercommitClass.
+func (in *MemoryOvercommitClass) DeepCopy() *MemoryOvercommitClass {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryOvercommitClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryOvercommitConfiguration) DeepCopyInto(out *MemoryOvercommitConfiguration) {
+	*out = *in
+	if in.Classes != nil {
+		in, out := &in.Classes, &out.Classes
+		*out = make([]MemoryOvercommitClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.PressureThreshold != nil {
+		in, out := &in.PressureThreshold, &out.PressureThreshold
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryOvercommitConfiguration.
+func (in *MemoryOvercommitConfiguration) DeepCopy() *MemoryOvercommitConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryOvercommitConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
 	*out = *in
@@ -3414,6 +4299,11 @@ func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.BalloonTarget != nil {
+		in, out := &in.BalloonTarget, &out.BalloonTarget
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -3529,6 +4419,16 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EvictionShutdownPriorityThreshold != nil {
+		in, out := &in.EvictionShutdownPriorityThreshold, &out.EvictionShutdownPriorityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EvictionMigrationTimeout != nil {
+		in, out := &in.EvictionMigrationTimeout, &out.EvictionMigrationTimeout
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -3566,6 +4466,18 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 		*out = new(NUMAGuestMappingPassthrough)
 		**out = **in
 	}
+	if in.EvenSplit != nil {
+		in, out := &in.EvenSplit, &out.EvenSplit
+		*out = new(NUMAEvenSplit)
+		**out = **in
+	}
+	if in.Cells != nil {
+		in, out := &in.Cells, &out.Cells
+		*out = make([]NUMACell, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3579,6 +4491,39 @@ func (in *NUMA) DeepCopy() *NUMA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMACell) DeepCopyInto(out *NUMACell) {
+	*out = *in
+	out.Memory = in.Memory.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMACell.
+func (in *NUMACell) DeepCopy() *NUMACell {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMACell)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAEvenSplit) DeepCopyInto(out *NUMAEvenSplit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAEvenSplit.
+func (in *NUMAEvenSplit) DeepCopy() *NUMAEvenSplit {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAEvenSplit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMAGuestMappingPassthrough) DeepCopyInto(out *NUMAGuestMappingPassthrough) {
 	*out = *in
@@ -3692,6 +4637,39 @@ func (in *NoCloudSSHPublicKeyAccessCredentialPropagation) DeepCopy() *NoCloudSSH
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConfigurationOverride) DeepCopyInto(out *NodeConfigurationOverride) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VirtualMachineInstancesPerNode != nil {
+		in, out := &in.VirtualMachineInstancesPerNode, &out.VirtualMachineInstancesPerNode
+		*out = new(int)
+		**out = **in
+	}
+	if in.HandlerResources != nil {
+		in, out := &in.HandlerResources, &out.HandlerResources
+		*out = new(ResourceRequirementsWithoutClaims)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfigurationOverride.
+func (in *NodeConfigurationOverride) DeepCopy() *NodeConfigurationOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConfigurationOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTypesConfig) {
 	*out = *in
@@ -3760,6 +4738,37 @@ func (in *NodePlacement) DeepCopy() *NodePlacement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolRolloutStrategy) DeepCopyInto(out *NodePoolRolloutStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PoolSoakSeconds != nil {
+		in, out := &in.PoolSoakSeconds, &out.PoolSoakSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ErrorBudget != nil {
+		in, out := &in.ErrorBudget, &out.ErrorBudget
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolRolloutStrategy.
+func (in *NodePoolRolloutStrategy) DeepCopy() *NodePoolRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectGraphNode) DeepCopyInto(out *ObjectGraphNode) {
 	*out = *in
@@ -3885,12 +4894,28 @@ func (in *PauseOptions) DeepCopyInto(out *PauseOptions) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseOptions.
-func (in *PauseOptions) DeepCopy() *PauseOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseOptions.
+func (in *PauseOptions) DeepCopy() *PauseOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PauseOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PciDiscoveryRule) DeepCopyInto(out *PciDiscoveryRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PciDiscoveryRule.
+func (in *PciDiscoveryRule) DeepCopy() *PciDiscoveryRule {
 	if in == nil {
 		return nil
 	}
-	out := new(PauseOptions)
+	out := new(PciDiscoveryRule)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3919,6 +4944,11 @@ func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
 		*out = make([]PciHostDevice, len(*in))
 		copy(*out, *in)
 	}
+	if in.PciDiscoveryRules != nil {
+		in, out := &in.PciDiscoveryRules, &out.PciDiscoveryRules
+		*out = make([]PciDiscoveryRule, len(*in))
+		copy(*out, *in)
+	}
 	if in.MediatedDevices != nil {
 		in, out := &in.MediatedDevices, &out.MediatedDevices
 		*out = make([]MediatedHostDevice, len(*in))
@@ -4059,6 +5089,22 @@ func (in *PodNetwork) DeepCopy() *PodNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolMetricsAdapterConfiguration) DeepCopyInto(out *PoolMetricsAdapterConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolMetricsAdapterConfiguration.
+func (in *PoolMetricsAdapterConfiguration) DeepCopy() *PoolMetricsAdapterConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolMetricsAdapterConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Port) DeepCopyInto(out *Port) {
 	*out = *in
@@ -4075,6 +5121,32 @@ func (in *Port) DeepCopy() *Port {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreShutdownHook) DeepCopyInto(out *PreShutdownHook) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreShutdownHook.
+func (in *PreShutdownHook) DeepCopy() *PreShutdownHook {
+	if in == nil {
+		return nil
+	}
+	out := new(PreShutdownHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreferenceMatcher) DeepCopyInto(out *PreferenceMatcher) {
 	*out = *in
@@ -4144,6 +5216,66 @@ func (in *ProfilerResult) DeepCopy() *ProfilerResult {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QEMUArg) DeepCopyInto(out *QEMUArg) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QEMUArg.
+func (in *QEMUArg) DeepCopy() *QEMUArg {
+	if in == nil {
+		return nil
+	}
+	out := new(QEMUArg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QEMUArgsPassthroughConfiguration) DeepCopyInto(out *QEMUArgsPassthroughConfiguration) {
+	*out = *in
+	if in.AllowedArgs != nil {
+		in, out := &in.AllowedArgs, &out.AllowedArgs
+		*out = make([]AllowedQEMUArg, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QEMUArgsPassthroughConfiguration.
+func (in *QEMUArgsPassthroughConfiguration) DeepCopy() *QEMUArgsPassthroughConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(QEMUArgsPassthroughConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QEMUCommandLine) DeepCopyInto(out *QEMUCommandLine) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]QEMUArg, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QEMUCommandLine.
+func (in *QEMUCommandLine) DeepCopy() *QEMUCommandLine {
+	if in == nil {
+		return nil
+	}
+	out := new(QEMUCommandLine)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QemuGuestAgentSSHPublicKeyAccessCredentialPropagation) DeepCopyInto(out *QemuGuestAgentSSHPublicKeyAccessCredentialPropagation) {
 	*out = *in
@@ -4415,6 +5547,28 @@ func (in *RestartOptions) DeepCopy() *RestartOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResumeOptions) DeepCopyInto(out *ResumeOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResumeOptions.
+func (in *ResumeOptions) DeepCopy() *ResumeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ResumeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rng) DeepCopyInto(out *Rng) {
 	*out = *in
@@ -4431,6 +5585,28 @@ func (in *Rng) DeepCopy() *Rng {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackOptions) DeepCopyInto(out *RollbackOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackOptions.
+func (in *RollbackOptions) DeepCopy() *RollbackOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEV) DeepCopyInto(out *SEV) {
 	*out = *in
@@ -4592,9 +5768,82 @@ func (in *SEVSessionOptions) DeepCopy() *SEVSessionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMBIOS) DeepCopyInto(out *SMBIOS) {
+	*out = *in
+	if in.System != nil {
+		in, out := &in.System, &out.System
+		*out = new(SMBIOSSystem)
+		**out = **in
+	}
+	if in.BaseBoard != nil {
+		in, out := &in.BaseBoard, &out.BaseBoard
+		*out = new(SMBIOSBaseBoard)
+		**out = **in
+	}
+	if in.OEMStrings != nil {
+		in, out := &in.OEMStrings, &out.OEMStrings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMBIOS.
+func (in *SMBIOS) DeepCopy() *SMBIOS {
+	if in == nil {
+		return nil
+	}
+	out := new(SMBIOS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMBIOSBaseBoard) DeepCopyInto(out *SMBIOSBaseBoard) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMBIOSBaseBoard.
+func (in *SMBIOSBaseBoard) DeepCopy() *SMBIOSBaseBoard {
+	if in == nil {
+		return nil
+	}
+	out := new(SMBIOSBaseBoard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMBIOSSystem) DeepCopyInto(out *SMBIOSSystem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMBIOSSystem.
+func (in *SMBIOSSystem) DeepCopy() *SMBIOSSystem {
+	if in == nil {
+		return nil
+	}
+	out := new(SMBIOSSystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMBiosConfiguration) DeepCopyInto(out *SMBiosConfiguration) {
 	*out = *in
+	if in.BaseBoard != nil {
+		in, out := &in.BaseBoard, &out.BaseBoard
+		*out = new(SMBIOSBaseBoard)
+		**out = **in
+	}
+	if in.OEMStrings != nil {
+		in, out := &in.OEMStrings, &out.OEMStrings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4736,6 +5985,48 @@ func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialConsoleLogRotation) DeepCopyInto(out *SerialConsoleLogRotation) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialConsoleLogRotation.
+func (in *SerialConsoleLogRotation) DeepCopy() *SerialConsoleLogRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialConsoleLogRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialDevice) DeepCopyInto(out *SerialDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialDevice.
+func (in *SerialDevice) DeepCopy() *SerialDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountVolumeSource) DeepCopyInto(out *ServiceAccountVolumeSource) {
 	*out = *in
@@ -4843,6 +6134,37 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceConnectionLimits) DeepCopyInto(out *SubresourceConnectionLimits) {
+	*out = *in
+	if in.MaxConnectionsPerUser != nil {
+		in, out := &in.MaxConnectionsPerUser, &out.MaxConnectionsPerUser
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxConnectionsPerVMI != nil {
+		in, out := &in.MaxConnectionsPerVMI, &out.MaxConnectionsPerVMI
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConnectionRateLimiter != nil {
+		in, out := &in.ConnectionRateLimiter, &out.ConnectionRateLimiter
+		*out = new(TokenBucketRateLimiter)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceConnectionLimits.
+func (in *SubresourceConnectionLimits) DeepCopy() *SubresourceConnectionLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceConnectionLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -4860,6 +6182,28 @@ func (in *SupportContainerResources) DeepCopy() *SupportContainerResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuspendOptions) DeepCopyInto(out *SuspendOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuspendOptions.
+func (in *SuspendOptions) DeepCopy() *SuspendOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SuspendOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyNICTimer) DeepCopyInto(out *SyNICTimer) {
 	*out = *in
@@ -4872,12 +6216,45 @@ func (in *SyNICTimer) DeepCopyInto(out *SyNICTimer) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyNICTimer.
-func (in *SyNICTimer) DeepCopy() *SyNICTimer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyNICTimer.
+func (in *SyNICTimer) DeepCopy() *SyNICTimer {
+	if in == nil {
+		return nil
+	}
+	out := new(SyNICTimer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepProjection) DeepCopyInto(out *SysprepProjection) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]corev1.KeyToPath, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepProjection.
+func (in *SysprepProjection) DeepCopy() *SysprepProjection {
 	if in == nil {
 		return nil
 	}
-	out := new(SyNICTimer)
+	out := new(SysprepProjection)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4895,6 +6272,13 @@ func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]SysprepProjection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5327,6 +6711,27 @@ func (in *VMISelector) DeepCopy() *VMISelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMInfoMetricsConfiguration) DeepCopyInto(out *VMInfoMetricsConfiguration) {
+	*out = *in
+	if in.DisabledLabels != nil {
+		in, out := &in.DisabledLabels, &out.DisabledLabels
+		*out = make([]VMInfoMetricsLabel, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMInfoMetricsConfiguration.
+func (in *VMInfoMetricsConfiguration) DeepCopy() *VMInfoMetricsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VMInfoMetricsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSOCKOptions) DeepCopyInto(out *VSOCKOptions) {
 	*out = *in
@@ -5348,6 +6753,22 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VendorCPUModel) DeepCopyInto(out *VendorCPUModel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VendorCPUModel.
+func (in *VendorCPUModel) DeepCopy() *VendorCPUModel {
+	if in == nil {
+		return nil
+	}
+	out := new(VendorCPUModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VideoDevice) DeepCopyInto(out *VideoDevice) {
 	*out = *in
@@ -5385,6 +6806,22 @@ func (in *VirtTemplateDeployment) DeepCopy() *VirtTemplateDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtioDriversConfiguration) DeepCopyInto(out *VirtioDriversConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtioDriversConfiguration.
+func (in *VirtioDriversConfiguration) DeepCopy() *VirtioDriversConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtioDriversConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -5413,6 +6850,26 @@ func (in *VirtualMachine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCompletion) DeepCopyInto(out *VirtualMachineCompletion) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCompletion.
+func (in *VirtualMachineCompletion) DeepCopy() *VirtualMachineCompletion {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCompletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCondition) DeepCopyInto(out *VirtualMachineCondition) {
 	*out = *in
@@ -5431,6 +6888,22 @@ func (in *VirtualMachineCondition) DeepCopy() *VirtualMachineCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDependency) DeepCopyInto(out *VirtualMachineDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDependency.
+func (in *VirtualMachineDependency) DeepCopy() *VirtualMachineDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstance) DeepCopyInto(out *VirtualMachineInstance) {
 	*out = *in
@@ -5557,6 +7030,31 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDomainXML) DeepCopyInto(out *VirtualMachineInstanceDomainXML) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDomainXML.
+func (in *VirtualMachineInstanceDomainXML) DeepCopy() *VirtualMachineInstanceDomainXML {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDomainXML)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceDomainXML) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -5666,6 +7164,10 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyInto(out *VirtualMachine
 		copy(*out, *in)
 	}
 	in.FSInfo.DeepCopyInto(&out.FSInfo)
+	if in.LastPolled != nil {
+		in, out := &in.LastPolled, &out.LastPolled
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -5687,6 +7189,67 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecOutput) DeepCopyInto(out *VirtualMachineInstanceGuestExecOutput) {
+	*out = *in
+	if in.Stdout != nil {
+		in, out := &in.Stdout, &out.Stdout
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stderr != nil {
+		in, out := &in.Stderr, &out.Stderr
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecOutput.
+func (in *VirtualMachineInstanceGuestExecOutput) DeepCopy() *VirtualMachineInstanceGuestExecOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestFile) DeepCopyInto(out *VirtualMachineInstanceGuestFile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestFile.
+func (in *VirtualMachineInstanceGuestFile) DeepCopy() *VirtualMachineInstanceGuestFile {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceGuestFile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -6461,6 +8024,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(EvictionStrategy)
 		**out = **in
 	}
+	if in.EvictionMigrationTimeoutSeconds != nil {
+		in, out := &in.EvictionMigrationTimeoutSeconds, &out.EvictionMigrationTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	if in.StartStrategy != nil {
 		in, out := &in.StartStrategy, &out.StartStrategy
 		*out = new(StartStrategy)
@@ -6471,6 +8039,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(int64)
 		**out = **in
 	}
+	if in.PreShutdownHook != nil {
+		in, out := &in.PreShutdownHook, &out.PreShutdownHook
+		*out = new(PreShutdownHook)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]Volume, len(*in))
@@ -6521,6 +8094,16 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GuestAgentCommands != nil {
+		in, out := &in.GuestAgentCommands, &out.GuestAgentCommands
+		*out = new(GuestAgentCommandsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(Ignition)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6559,6 +8142,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		}
 	}
 	out.GuestOSInfo = in.GuestOSInfo
+	if in.GuestAgentData != nil {
+		in, out := &in.GuestAgentData, &out.GuestAgentData
+		*out = new(GuestAgentData)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MigrationState != nil {
 		in, out := &in.MigrationState, &out.MigrationState
 		*out = new(VirtualMachineInstanceMigrationState)
@@ -6731,6 +8319,16 @@ func (in *VirtualMachineOptions) DeepCopyInto(out *VirtualMachineOptions) {
 		*out = new(DisableSerialConsoleLog)
 		**out = **in
 	}
+	if in.SerialConsoleLogRotation != nil {
+		in, out := &in.SerialConsoleLogRotation, &out.SerialConsoleLogRotation
+		*out = new(SerialConsoleLogRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CrashLoopBackoff != nil {
+		in, out := &in.CrashLoopBackoff, &out.CrashLoopBackoff
+		*out = new(CrashLoopBackoff)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6744,6 +8342,60 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSchedule) DeepCopyInto(out *VirtualMachineSchedule) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.SkipStopIfInUse != nil {
+		in, out := &in.SkipStopIfInUse, &out.SkipStopIfInUse
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSchedule.
+func (in *VirtualMachineSchedule) DeepCopy() *VirtualMachineSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineScheduleStatus) DeepCopyInto(out *VirtualMachineScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextStartTime != nil {
+		in, out := &in.NextStartTime, &out.NextStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextStopTime != nil {
+		in, out := &in.NextStopTime, &out.NextStopTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineScheduleStatus.
+func (in *VirtualMachineScheduleStatus) DeepCopy() *VirtualMachineScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -6784,6 +8436,26 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(VirtualMachineSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]VirtualMachineDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.CrashLoopBackoff != nil {
+		in, out := &in.CrashLoopBackoff, &out.CrashLoopBackoff
+		*out = new(CrashLoopBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -6914,6 +8586,21 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(InstancetypeStatusRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(VirtualMachineScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Completion != nil {
+		in, out := &in.Completion, &out.Completion
+		*out = new(VirtualMachineCompletion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(VirtualMachineSuspendStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6927,6 +8614,26 @@ func (in *VirtualMachineStatus) DeepCopy() *VirtualMachineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSuspendStatus) DeepCopyInto(out *VirtualMachineSuspendStatus) {
+	*out = *in
+	if in.SuspendTime != nil {
+		in, out := &in.SuspendTime, &out.SuspendTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSuspendStatus.
+func (in *VirtualMachineSuspendStatus) DeepCopy() *VirtualMachineSuspendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSuspendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineVolumeRequest) DeepCopyInto(out *VirtualMachineVolumeRequest) {
 	*out = *in
@@ -7204,3 +8911,46 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowAlertsDeployment) DeepCopyInto(out *WorkflowAlertsDeployment) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowAlertsDeployment.
+func (in *WorkflowAlertsDeployment) DeepCopy() *WorkflowAlertsDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowAlertsDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateWindow) DeepCopyInto(out *WorkloadUpdateWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateWindow.
+func (in *WorkloadUpdateWindow) DeepCopy() *WorkloadUpdateWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateWindow)
+	in.DeepCopyInto(out)
+	return out
+}
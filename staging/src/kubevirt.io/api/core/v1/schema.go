@@ -21,6 +21,7 @@ package v1
 
 import (
 	"encoding/json"
+	"slices"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -166,6 +167,28 @@ type SysprepSource struct {
 	// ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
 	// + optional
 	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
+	// Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file
+	// along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be
+	// selected by the items of one of them. Cannot be combined with Secret and ConfigMap.
+	// +kubebuilder:validation:MaxItems:=16
+	// +listType=atomic
+	// + optional
+	Sources []SysprepProjection `json:"sources,omitempty"`
+}
+
+// SysprepProjection adds the keys of a Secret or ConfigMap to a Sysprep disk. Exactly one of Secret and ConfigMap must be set.
+type SysprepProjection struct {
+	// Secret references a k8s Secret in the namespace of the VirtualMachineInstance.
+	// + optional
+	Secret *v1.LocalObjectReference `json:"secret,omitempty"`
+	// ConfigMap references a ConfigMap in the namespace of the VirtualMachineInstance.
+	// + optional
+	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
+	// Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.
+	// All keys are added under their own name if empty.
+	// +listType=atomic
+	// + optional
+	Items []v1.KeyToPath `json:"items,omitempty"`
 }
 
 // Represents a cloud-init nocloud user data source.
@@ -189,6 +212,11 @@ type CloudInitNoCloudSource struct {
 	// NetworkData contains NoCloud inline cloud-init networkdata.
 	// + optional
 	NetworkData string `json:"networkData,omitempty"`
+	// GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the
+	// VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.
+	// Can not be combined with another networkdata source.
+	// + optional
+	GenerateNetworkData bool `json:"generateNetworkData,omitempty"`
 }
 
 // Represents a cloud-init config drive user data source.
@@ -258,6 +286,27 @@ type DomainSpec struct {
 	// the VMI with any updated configuration such as boot order changes.
 	// +optional
 	RebootPolicy *RebootPolicy `json:"rebootPolicy,omitempty"`
+	// QEMUCommandLine holds raw arguments appended to the QEMU command line.
+	// The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.
+	// Requires the QEMUArgsPassthrough feature gate.
+	// +optional
+	QEMUCommandLine *QEMUCommandLine `json:"qemuCommandLine,omitempty"`
+}
+
+// QEMUCommandLine holds raw arguments appended to the QEMU command line.
+type QEMUCommandLine struct {
+	// Args are appended to the QEMU command line in the given order.
+	// +listType=atomic
+	Args []QEMUArg `json:"args"`
+}
+
+// QEMUArg is a QEMU command line option and its value, e.g. "-device" and "pvpanic".
+type QEMUArg struct {
+	// Name is the QEMU option, including its leading dash, e.g. "-device".
+	Name string `json:"name"`
+	// Value of the option, omitted for options without a value.
+	// +optional
+	Value string `json:"value,omitempty"`
 }
 
 // Chassis specifies the chassis info passed to the domain.
@@ -269,6 +318,40 @@ type Chassis struct {
 	Sku          string `json:"sku,omitempty"`
 }
 
+// SMBIOS specifies the SMBIOS information passed to the domain.
+type SMBIOS struct {
+	// System overrides the cluster wide system information (SMBIOS type 1).
+	// The uuid and the serial are set through the firmware.
+	// +optional
+	System *SMBIOSSystem `json:"system,omitempty"`
+	// BaseBoard overrides the cluster wide baseboard information (SMBIOS type 2).
+	// +optional
+	BaseBoard *SMBIOSBaseBoard `json:"baseBoard,omitempty"`
+	// OEMStrings replace the cluster wide OEM strings (SMBIOS type 11).
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=32
+	OEMStrings []string `json:"oemStrings,omitempty"`
+}
+
+// SMBIOSSystem specifies the system information passed to the domain.
+type SMBIOSSystem struct {
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Product      string `json:"product,omitempty"`
+	Version      string `json:"version,omitempty"`
+	Sku          string `json:"sku,omitempty"`
+	Family       string `json:"family,omitempty"`
+}
+
+// SMBIOSBaseBoard specifies the baseboard information passed to the domain.
+type SMBIOSBaseBoard struct {
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Product      string `json:"product,omitempty"`
+	Version      string `json:"version,omitempty"`
+	Serial       string `json:"serial,omitempty"`
+	Asset        string `json:"asset,omitempty"`
+}
+
 // Represents the firmware blob used to assist in the domain creation process.
 // Used for setting the QEMU BIOS file path for the libvirt domain.
 type Bootloader struct {
@@ -387,16 +470,47 @@ type CPU struct {
 	// Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads
 	// +optional
 	Realtime *Realtime `json:"realtime,omitempty"`
+	// NestedVirtualization controls whether the guest can run virtual machines itself.
+	// Require schedules the VMI onto nodes exposing VMX or SVM to their guests,
+	// Forbid hides VMX and SVM from the guest.
+	// By default the guest gets them when both the CPU model and the node provide them.
+	// +optional
+	NestedVirtualization NestedVirtualizationPolicy `json:"nestedVirtualization,omitempty"`
 }
 
+// NestedVirtualizationPolicy controls whether the guest of a VMI can run virtual machines itself
+type NestedVirtualizationPolicy string
+
+const (
+	// NestedVirtualizationRequire schedules the VMI onto nodes exposing VMX or SVM to their guests
+	NestedVirtualizationRequire NestedVirtualizationPolicy = "Require"
+	// NestedVirtualizationForbid hides VMX and SVM from the guest
+	NestedVirtualizationForbid NestedVirtualizationPolicy = "Forbid"
+)
+
 // Realtime holds the tuning knobs specific for realtime workloads.
 type Realtime struct {
 	// Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
 	// Example: "0-3,^1","0,2,3","2-3"
 	// +optional
 	Mask string `json:"mask,omitempty"`
+	// Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.
+	// The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.
+	// Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks
+	// the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.
+	// Hugepages still need to be requested explicitly.
+	// +optional
+	Profile RealtimeProfile `json:"profile,omitempty"`
 }
 
+// RealtimeProfile is a predefined set of realtime tunings.
+type RealtimeProfile string
+
+const (
+	// RealtimeProfileLowLatency tunes the VirtualMachineInstance for the lowest achievable latency.
+	RealtimeProfileLowLatency RealtimeProfile = "lowLatency"
+)
+
 // NUMAGuestMappingPassthrough instructs kubevirt to model numa topology which is compatible with the CPU pinning on the guest.
 // This will result in a subset of the node numa topology being passed through, ensuring that virtual numa nodes and their memory
 // never cross boundaries coming from the node numa mapping.
@@ -408,6 +522,29 @@ type NUMA struct {
 	// The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
 	// +optional
 	GuestMappingPassthrough *NUMAGuestMappingPassthrough `json:"guestMappingPassthrough,omitempty"`
+	// EvenSplit will create a guest topology with the given number of virtual numa nodes and
+	// split the vCPUs and the guest memory evenly between them.
+	// +optional
+	EvenSplit *NUMAEvenSplit `json:"evenSplit,omitempty"`
+	// Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.
+	// Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.
+	// +optional
+	// +listType=atomic
+	Cells []NUMACell `json:"cells,omitempty"`
+}
+
+// NUMAEvenSplit instructs kubevirt to split the vCPUs and the guest memory evenly between the virtual numa nodes.
+type NUMAEvenSplit struct {
+	// Nodes is the number of virtual numa nodes of the guest.
+	Nodes uint32 `json:"nodes"`
+}
+
+// NUMACell declares a virtual numa node of the guest.
+type NUMACell struct {
+	// CPUs is the list of vCPUs of the numa node, e.g. "0-3,6".
+	CPUs string `json:"cpus"`
+	// Memory is the amount of guest memory of the numa node.
+	Memory resource.Quantity `json:"memory"`
 }
 
 // CPUFeature allows specifying a CPU feature.
@@ -442,6 +579,44 @@ type Memory struct {
 	// and its characteristics.
 	// +optional
 	ReservedOverhead *ReservedOverhead `json:"reservedOverhead,omitempty"`
+	// KSM determines if the memory of the VirtualMachineInstance can be merged
+	// by Kernel Samepage Merging on nodes where KSM is enabled.
+	// Enabling it requires KSM to be configured on the cluster.
+	// Defaults to true.
+	// +optional
+	KSM *FeatureState `json:"ksm,omitempty"`
+	// FreePageReporting determines if the memory balloon reports free guest pages
+	// back to the host.
+	// Enabling it requires free page reporting to be allowed on the cluster and
+	// is not possible for high performance VirtualMachineInstances.
+	// Defaults to the cluster configuration.
+	// +optional
+	FreePageReporting *FeatureState `json:"freePageReporting,omitempty"`
+	// OvercommitClass selects one of the memory overcommit classes defined
+	// in the KubeVirt configuration. The ratio of the class is used instead
+	// of the cluster wide memory overcommit.
+	// +optional
+	OvercommitClass string `json:"overcommitClass,omitempty"`
+	// Balloon declares the bounds within which the memory balloon is
+	// automatically resized, based on the guest memory usage and the node
+	// memory pressure. Requires the AutoMemoryBalloon feature gate.
+	// +optional
+	Balloon *MemoryBalloon `json:"balloon,omitempty"`
+	// SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage
+	// of the VirtualMachineInstance is sized to also hold the guest memory.
+	// Requires the SuspendToDisk feature gate.
+	// +optional
+	SuspendToDisk *bool `json:"suspendToDisk,omitempty"`
+}
+
+// MemoryBalloon declares the bounds of the automatic balloon resizing.
+type MemoryBalloon struct {
+	// Min is the lowest amount of memory the guest can be shrunk to.
+	Min resource.Quantity `json:"min"`
+	// Max is the highest amount of memory the guest can be given back.
+	// Defaults to the guest memory.
+	// +optional
+	Max *resource.Quantity `json:"max,omitempty"`
 }
 
 type MemoryStatus struct {
@@ -458,6 +633,10 @@ type MemoryStatus struct {
 	// for the virt-launcher pod.
 	// +optional
 	MemoryOverhead *resource.Quantity `json:"memoryOverhead,omitempty"`
+	// BalloonTarget specifies the memory balloon size computed from the balloon
+	// bounds of the VirtualMachine.
+	// +optional
+	BalloonTarget *resource.Quantity `json:"balloonTarget,omitempty"`
 }
 
 // Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
@@ -516,6 +695,11 @@ type Firmware struct {
 	KernelBoot *KernelBoot `json:"kernelBoot,omitempty"`
 	// Information that can be set in the ACPI table
 	ACPI *ACPI `json:"acpi,omitempty"`
+	// SMBIOS defines the system and baseboard information and the OEM strings
+	// reported in the SMBIOS tables of the vmi.
+	// Unset values default to the cluster wide SMBIOS configuration.
+	// +optional
+	SMBIOS *SMBIOS `json:"smbios,omitempty"`
 }
 
 type ACPI struct {
@@ -558,6 +742,13 @@ type Devices struct {
 	// Not relevant if autoattachSerialConsole is disabled.
 	// Defaults to cluster wide setting on VirtualMachineOptions.
 	LogSerialConsole *bool `json:"logSerialConsole,omitempty"`
+	// Serials defines additional named serial devices.
+	// Each of them is accessible through the console subresource by its name.
+	// +optional
+	// +kubebuilder:validation:MaxItems:=8
+	// +listType=map
+	// +listMapKey=name
+	Serials []SerialDevice `json:"serials,omitempty"`
 	// Whether to attach the Memory balloon device with default period.
 	// Period can be adjusted in virt-config.
 	// Defaults to true.
@@ -570,6 +761,12 @@ type Devices struct {
 	// Whether to attach the VSOCK CID to the VM or not.
 	// VSOCK access will be available if set to true. Defaults to false.
 	AutoattachVSOCK *bool `json:"autoattachVSOCK,omitempty"`
+	// Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as
+	// indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk
+	// is no longer attached on the next start once set to false.
+	// Defaults to false.
+	// +optional
+	AutoattachVirtioDriversDisk *bool `json:"autoattachVirtioDriversDisk,omitempty"`
 	// Whether to have random number generator from host
 	// +optional
 	Rng *Rng `json:"rng,omitempty"`
@@ -591,6 +788,10 @@ type Devices struct {
 	// +optional
 	// +listtype=atomic
 	PanicDevices []PanicDevice `json:"panicDevices,omitempty"`
+	// GuestPanicCapture configures what is captured when the guest kernel panics.
+	// A pvpanic device is added if no panic device is provided.
+	// +optional
+	GuestPanicCapture *GuestPanicCapture `json:"guestPanicCapture,omitempty"`
 	// Filesystems describes filesystem which is connected to the vmi.
 	// +optional
 	// +listType=atomic
@@ -639,6 +840,30 @@ type SoundDevice struct {
 	Model string `json:"model,omitempty"`
 }
 
+const (
+	SoundModelICH9 = "ich9"
+	SoundModelAC97 = "ac97"
+)
+
+// SerialDevice is an additional serial device of the VMI.
+type SerialDevice struct {
+	// Name of the serial device, used to select it when connecting to the console subresource.
+	Name string `json:"name"`
+	// Type of the serial device. One of: serial, virtio.
+	// A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a
+	// virtio console of the guest (e.g. hvc0).
+	// Defaults to serial.
+	// +optional
+	Type SerialDeviceType `json:"type,omitempty"`
+}
+
+type SerialDeviceType string
+
+const (
+	SerialDeviceTypeSerial SerialDeviceType = "serial"
+	SerialDeviceTypeVirtio SerialDeviceType = "virtio"
+)
+
 type TPMDevice struct {
 	// Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
 	// Defaults to True
@@ -655,6 +880,14 @@ type VideoDevice struct {
 	Type string `json:"type,omitempty"`
 }
 
+const (
+	VideoTypeVGA    = "vga"
+	VideoTypeCirrus = "cirrus"
+	VideoTypeVirtio = "virtio"
+	VideoTypeRamfb  = "ramfb"
+	VideoTypeBochs  = "bochs"
+)
+
 type InputBus string
 
 const (
@@ -687,7 +920,34 @@ type Filesystem struct {
 	Virtiofs *FilesystemVirtiofs `json:"virtiofs"`
 }
 
-type FilesystemVirtiofs struct{}
+type FilesystemVirtiofs struct {
+	// Cache sets the caching policy of the shared directory in the guest.
+	// One of: auto, always, never. Defaults to auto.
+	// +optional
+	Cache VirtiofsCacheMode `json:"cache,omitempty"`
+	// DAXWindowSize enables DAX for the filesystem and sets the size of the
+	// shared memory window the guest maps the files into, bypassing its page cache.
+	// It must be a multiple of 2Mi. DAX is disabled if not set.
+	// +optional
+	DAXWindowSize *resource.Quantity `json:"daxWindowSize,omitempty"`
+	// ThreadPoolSize sets the number of worker threads of the virtiofsd process
+	// serving the filesystem. Defaults to the virtiofsd default.
+	// +optional
+	ThreadPoolSize *uint32 `json:"threadPoolSize,omitempty"`
+}
+
+// VirtiofsCacheMode is the caching policy of a virtiofs filesystem
+type VirtiofsCacheMode string
+
+const (
+	// VirtiofsCacheAuto lets the guest cache the metadata and the data for a short time
+	VirtiofsCacheAuto VirtiofsCacheMode = "auto"
+	// VirtiofsCacheAlways lets the guest cache the metadata and the data without expiration,
+	// it should only be used when the directory is not changed outside of the guest
+	VirtiofsCacheAlways VirtiofsCacheMode = "always"
+	// VirtiofsCacheNever disables the caching in the guest
+	VirtiofsCacheNever VirtiofsCacheMode = "never"
+)
 
 type DownwardMetrics struct{}
 
@@ -742,6 +1002,20 @@ type PanicDevice struct {
 	Model *PanicDeviceModel `json:"model,omitempty"`
 }
 
+// GuestPanicCapture configures the capture of the guest state when the guest kernel panics.
+type GuestPanicCapture struct {
+	// ConsoleLogLines is the number of trailing lines of the serial console log
+	// recorded in the GuestPanicked condition. Requires the serial console log.
+	// Defaults to 20, at most 100.
+	// +optional
+	ConsoleLogLines *uint32 `json:"consoleLogLines,omitempty"`
+	// MemoryDumpClaimName is the name of the PVC the guest memory is dumped to
+	// when the guest kernel panics. The crashed guest is kept until the memory
+	// dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.
+	// +optional
+	MemoryDumpClaimName string `json:"memoryDumpClaimName,omitempty"`
+}
+
 type HostDevice struct {
 	Name string `json:"name"`
 	// DeviceName is the name of the device provisioned by device-plugins
@@ -1105,6 +1379,9 @@ type ClockOffset struct {
 	// Timezone sets the guest clock to the specified timezone.
 	// Zone name follows the TZ environment variable format (e.g. 'America/New_York').
 	Timezone *ClockOffsetTimezone `json:"timezone,omitempty"`
+	// Variable lets the guest change its clock freely relative to the basis.
+	// The guest changes are kept during reboots and migrations.
+	Variable *ClockOffsetVariable `json:"variable,omitempty"`
 }
 
 // UTC sets the guest clock to UTC on each boot.
@@ -1118,6 +1395,28 @@ type ClockOffsetUTC struct {
 // Zone name follows the TZ environment variable format (e.g. 'America/New_York').
 type ClockOffsetTimezone string
 
+// ClockOffsetBasis specifies the clock the guest clock offset is relative to.
+type ClockOffsetBasis string
+
+const (
+	// ClockOffsetBasisUTC makes the offset relative to UTC.
+	ClockOffsetBasisUTC ClockOffsetBasis = "utc"
+	// ClockOffsetBasisLocaltime makes the offset relative to the local time of
+	// the virt-launcher pod.
+	ClockOffsetBasisLocaltime ClockOffsetBasis = "localtime"
+)
+
+// ClockOffsetVariable lets the guest change its clock freely.
+type ClockOffsetVariable struct {
+	// Basis the offset is relative to.
+	// One of "utc", "localtime". Defaults to "utc".
+	// +optional
+	Basis ClockOffsetBasis `json:"basis,omitempty"`
+	// OffsetSeconds specifies the initial offset in seconds, relative to the basis.
+	// +optional
+	OffsetSeconds *int `json:"offsetSeconds,omitempty"`
+}
+
 // Represents the clock and timers of a vmi.
 // +kubebuilder:pruning:PreserveUnknownFields
 type Clock struct {
@@ -1126,6 +1425,26 @@ type Clock struct {
 	// Timer specifies whih timers are attached to the vmi.
 	// +optional
 	Timer *Timer `json:"timer,omitempty"`
+	// GuestTimeSync controls when the guest clock is set to the host time through
+	// the guest agent, after the guest was not running for some time.
+	// +optional
+	GuestTimeSync *GuestTimeSync `json:"guestTimeSync,omitempty"`
+}
+
+// GuestTimeSync configures the guest clock synchronization through the guest agent.
+type GuestTimeSync struct {
+	// AfterMigration sets the guest clock once the vmi is migrated.
+	// Defaults to true.
+	// +optional
+	AfterMigration *bool `json:"afterMigration,omitempty"`
+	// AfterUnpause sets the guest clock once the vmi is unpaused.
+	// Defaults to true.
+	// +optional
+	AfterUnpause *bool `json:"afterUnpause,omitempty"`
+	// TimeoutSeconds is the time the guest agent is retried for if it is not responsive.
+	// Defaults to 60.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
 }
 
 // Represents all available timers in a vmi.
@@ -1402,8 +1721,25 @@ const (
 	WatchdogActionReset WatchdogAction = "reset"
 	// WatchdogActionShutdown will shutdown the vmi if the watchdog gets triggered.
 	WatchdogActionShutdown WatchdogAction = "shutdown"
+	// WatchdogActionInjectNMI will inject a non-maskable interrupt into the vmi if the watchdog gets triggered.
+	WatchdogActionInjectNMI WatchdogAction = "inject-nmi"
+	// WatchdogActionDump will dump the guest memory of the vmi if the watchdog gets triggered.
+	WatchdogActionDump WatchdogAction = "dump"
 )
 
+var watchdogActions = []WatchdogAction{
+	WatchdogActionPoweroff,
+	WatchdogActionReset,
+	WatchdogActionShutdown,
+	WatchdogActionInjectNMI,
+	WatchdogActionDump,
+}
+
+// SupportedWatchdogActions returns all the supported watchdog actions.
+func SupportedWatchdogActions() []WatchdogAction {
+	return slices.Clone(watchdogActions)
+}
+
 // Named watchdog device.
 type Watchdog struct {
 	// Name of the watchdog.
@@ -1427,14 +1763,14 @@ type WatchdogDevice struct {
 
 // i6300esb watchdog device.
 type I6300ESBWatchdog struct {
-	// The action to take. Valid values are poweroff, reset, shutdown.
+	// The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.
 	// Defaults to reset.
 	Action WatchdogAction `json:"action,omitempty"`
 }
 
 // diag288 watchdog device.
 type Diag288Watchdog struct {
-	// The action to take. Valid values are poweroff, reset, shutdown.
+	// The action to take. Valid values are poweroff, reset, shutdown, dump.
 	// Defaults to reset.
 	Action WatchdogAction `json:"action,omitempty"`
 }
@@ -1465,6 +1801,11 @@ type Interface struct {
 	// Interfaces without a boot order are not tried.
 	// +optional
 	BootOrder *uint `json:"bootOrder,omitempty"`
+	// NetworkBoot makes the interface the first boot device of the vmi.
+	// The disks and interfaces with a boot order are tried after it, following their boot order.
+	// Can not be combined with bootOrder.
+	// +optional
+	NetworkBoot *InterfaceNetworkBoot `json:"networkBoot,omitempty"`
 	// If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10
 	// +optional
 	PciAddress string `json:"pciAddress,omitempty"`
@@ -1489,6 +1830,19 @@ type Interface struct {
 	State InterfaceState `json:"state,omitempty"`
 }
 
+// InterfaceNetworkBoot configures booting the vmi from the network.
+type InterfaceNetworkBoot struct {
+	// IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.
+	// The firmware of the interface runs the script instead of fetching the boot file
+	// offered by the DHCP server of the network.
+	// Only supported on interfaces with the masquerade binding.
+	// +optional
+	IPXEScriptRef string `json:"ipxeScriptRef,omitempty"`
+}
+
+// IPXEScriptKey is the key of the iPXE script in the ConfigMap referenced by an interface.
+const IPXEScriptKey = "script.ipxe"
+
 type InterfaceState string
 
 const (
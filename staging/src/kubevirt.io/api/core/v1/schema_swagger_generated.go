@@ -63,6 +63,16 @@ func (SysprepSource) SwaggerDoc() map[string]string {
 		"":          "Represents a Sysprep volume source.",
 		"secret":    "Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.\n+ optional",
 		"configMap": "ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.\n+ optional",
+		"sources":   "Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file\nalong with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be\nselected by the items of one of them. Cannot be combined with Secret and ConfigMap.\n+kubebuilder:validation:MaxItems:=16\n+listType=atomic\n+ optional",
+	}
+}
+
+func (SysprepProjection) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "SysprepProjection adds the keys of a Secret or ConfigMap to a Sysprep disk. Exactly one of Secret and ConfigMap must be set.",
+		"secret":    "Secret references a k8s Secret in the namespace of the VirtualMachineInstance.\n+ optional",
+		"configMap": "ConfigMap references a ConfigMap in the namespace of the VirtualMachineInstance.\n+ optional",
+		"items":     "Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1.\nAll keys are added under their own name if empty.\n+listType=atomic\n+ optional",
 	}
 }
 
@@ -75,6 +85,7 @@ func (CloudInitNoCloudSource) SwaggerDoc() map[string]string {
 		"networkDataSecretRef": "NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.\n+ optional",
 		"networkDataBase64":    "NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.\n+ optional",
 		"networkData":          "NetworkData contains NoCloud inline cloud-init networkdata.\n+ optional",
+		"generateNetworkData":  "GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the\nVirtualMachineInstance, matching them by MAC address and setting their MTU and addressing.\nCan not be combined with another networkdata source.\n+ optional",
 	}
 }
 
@@ -105,6 +116,22 @@ func (DomainSpec) SwaggerDoc() map[string]string {
 		"chassis":         "Chassis specifies the chassis info passed to the domain.\n+optional",
 		"launchSecurity":  "Launch Security setting of the vmi.\n+optional",
 		"rebootPolicy":    "RebootPolicy specifies how the guest should behave on reboot.\nReboot (default): The guest is allowed to reboot silently.\nTerminate: The VMI will be terminated on guest reboot, allowing\nhigher level controllers (such as the VM controller) to recreate\nthe VMI with any updated configuration such as boot order changes.\n+optional",
+		"qemuCommandLine": "QEMUCommandLine holds raw arguments appended to the QEMU command line.\nThe arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR.\nRequires the QEMUArgsPassthrough feature gate.\n+optional",
+	}
+}
+
+func (QEMUCommandLine) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "QEMUCommandLine holds raw arguments appended to the QEMU command line.",
+		"args": "Args are appended to the QEMU command line in the given order.\n+listType=atomic",
+	}
+}
+
+func (QEMUArg) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "QEMUArg is a QEMU command line option and its value, e.g. \"-device\" and \"pvpanic\".",
+		"name":  "Name is the QEMU option, including its leading dash, e.g. \"-device\".",
+		"value": "Value of the option, omitted for options without a value.\n+optional",
 	}
 }
 
@@ -114,6 +141,27 @@ func (Chassis) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SMBIOS) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "SMBIOS specifies the SMBIOS information passed to the domain.",
+		"system":     "System overrides the cluster wide system information (SMBIOS type 1).\nThe uuid and the serial are set through the firmware.\n+optional",
+		"baseBoard":  "BaseBoard overrides the cluster wide baseboard information (SMBIOS type 2).\n+optional",
+		"oemStrings": "OEMStrings replace the cluster wide OEM strings (SMBIOS type 11).\n+optional\n+listType=atomic\n+kubebuilder:validation:MaxItems:=32",
+	}
+}
+
+func (SMBIOSSystem) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "SMBIOSSystem specifies the system information passed to the domain.",
+	}
+}
+
+func (SMBIOSBaseBoard) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "SMBIOSBaseBoard specifies the baseboard information passed to the domain.",
+	}
+}
+
 func (Bootloader) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":     "Represents the firmware blob used to assist in the domain creation process.\nUsed for setting the QEMU BIOS file path for the libvirt domain.",
@@ -177,13 +225,15 @@ func (CPU) SwaggerDoc() map[string]string {
 		"numa":                  "NUMA allows specifying settings for the guest NUMA topology\n+optional",
 		"isolateEmulatorThread": "IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place\nthe emulator thread on it.\n+optional",
 		"realtime":              "Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads\n+optional",
+		"nestedVirtualization":  "NestedVirtualization controls whether the guest can run virtual machines itself.\nRequire schedules the VMI onto nodes exposing VMX or SVM to their guests,\nForbid hides VMX and SVM from the guest.\nBy default the guest gets them when both the CPU model and the node provide them.\n+optional",
 	}
 }
 
 func (Realtime) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":     "Realtime holds the tuning knobs specific for realtime workloads.",
-		"mask": "Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.\nExample: \"0-3,^1\",\"0,2,3\",\"2-3\"\n+optional",
+		"":        "Realtime holds the tuning knobs specific for realtime workloads.",
+		"mask":    "Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.\nExample: \"0-3,^1\",\"0,2,3\",\"2-3\"\n+optional",
+		"profile": "Profile applies a predefined set of realtime tunings to the VirtualMachineInstance.\nThe lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel.\nUnless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks\nthe guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks.\nHugepages still need to be requested explicitly.\n+optional",
 	}
 }
 
@@ -196,6 +246,23 @@ func (NUMAGuestMappingPassthrough) SwaggerDoc() map[string]string {
 func (NUMA) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"guestMappingPassthrough": "GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.\nThe created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.\n+optional",
+		"evenSplit":               "EvenSplit will create a guest topology with the given number of virtual numa nodes and\nsplit the vCPUs and the guest memory evenly between them.\n+optional",
+		"cells":                   "Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory.\nEvery vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.\n+optional\n+listType=atomic",
+	}
+}
+
+func (NUMAEvenSplit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "NUMAEvenSplit instructs kubevirt to split the vCPUs and the guest memory evenly between the virtual numa nodes.",
+		"nodes": "Nodes is the number of virtual numa nodes of the guest.",
+	}
+}
+
+func (NUMACell) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "NUMACell declares a virtual numa node of the guest.",
+		"cpus":   "CPUs is the list of vCPUs of the numa node, e.g. \"0-3,6\".",
+		"memory": "Memory is the amount of guest memory of the numa node.",
 	}
 }
 
@@ -209,11 +276,24 @@ func (CPUFeature) SwaggerDoc() map[string]string {
 
 func (Memory) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                 "Memory allows specifying the VirtualMachineInstance memory features.",
-		"hugepages":        "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
-		"guest":            "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
-		"maxGuest":         "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.",
-		"reservedOverhead": "ReservedOverhead configures the memory overhead applied to a VM\nand its characteristics.\n+optional",
+		"":                  "Memory allows specifying the VirtualMachineInstance memory features.",
+		"hugepages":         "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
+		"guest":             "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
+		"maxGuest":          "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.",
+		"reservedOverhead":  "ReservedOverhead configures the memory overhead applied to a VM\nand its characteristics.\n+optional",
+		"ksm":               "KSM determines if the memory of the VirtualMachineInstance can be merged\nby Kernel Samepage Merging on nodes where KSM is enabled.\nEnabling it requires KSM to be configured on the cluster.\nDefaults to true.\n+optional",
+		"freePageReporting": "FreePageReporting determines if the memory balloon reports free guest pages\nback to the host.\nEnabling it requires free page reporting to be allowed on the cluster and\nis not possible for high performance VirtualMachineInstances.\nDefaults to the cluster configuration.\n+optional",
+		"overcommitClass":   "OvercommitClass selects one of the memory overcommit classes defined\nin the KubeVirt configuration. The ratio of the class is used instead\nof the cluster wide memory overcommit.\n+optional",
+		"balloon":           "Balloon declares the bounds within which the memory balloon is\nautomatically resized, based on the guest memory usage and the node\nmemory pressure. Requires the AutoMemoryBalloon feature gate.\n+optional",
+		"suspendToDisk":     "SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage\nof the VirtualMachineInstance is sized to also hold the guest memory.\nRequires the SuspendToDisk feature gate.\n+optional",
+	}
+}
+
+func (MemoryBalloon) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":    "MemoryBalloon declares the bounds of the automatic balloon resizing.",
+		"min": "Min is the lowest amount of memory the guest can be shrunk to.",
+		"max": "Max is the highest amount of memory the guest can be given back.\nDefaults to the guest memory.\n+optional",
 	}
 }
 
@@ -223,6 +303,7 @@ func (MemoryStatus) SwaggerDoc() map[string]string {
 		"guestCurrent":   "GuestCurrent specifies how much memory is currently available for the VirtualMachine.\n+optional",
 		"guestRequested": "GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.\n+optional",
 		"memoryOverhead": "MemoryOverhead specifies the memory overhead added by the virtualization infrastructure\nfor the virt-launcher pod.\n+optional",
+		"balloonTarget":  "BalloonTarget specifies the memory balloon size computed from the balloon\nbounds of the VirtualMachine.\n+optional",
 	}
 }
 
@@ -253,6 +334,7 @@ func (Firmware) SwaggerDoc() map[string]string {
 		"serial":     "The system-serial-number in SMBIOS",
 		"kernelBoot": "Settings to set the kernel for booting.\n+optional",
 		"acpi":       "Information that can be set in the ACPI table",
+		"smbios":     "SMBIOS defines the system and baseboard information and the OEM strings\nreported in the SMBIOS tables of the vmi.\nUnset values default to the cluster wide SMBIOS configuration.\n+optional",
 	}
 }
 
@@ -265,31 +347,34 @@ func (ACPI) SwaggerDoc() map[string]string {
 
 func (Devices) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"useVirtioTransitional":      "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
-		"disableHotplug":             "DisableHotplug disabled the ability to hotplug disks.",
-		"disks":                      "Disks describes disks, cdroms and luns which are connected to the vmi.\n+kubebuilder:validation:MaxItems:=256",
-		"watchdog":                   "Watchdog describes a watchdog device which can be added to the vmi.",
-		"interfaces":                 "Interfaces describe network interfaces which are added to the vmi.\n+kubebuilder:validation:MaxItems:=256",
-		"inputs":                     "Inputs describe input devices",
-		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
-		"autoattachGraphicsDevice":   "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
-		"autoattachSerialConsole":    "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
-		"logSerialConsole":           "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
-		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
-		"autoattachInputDevice":      "Whether to attach an Input Device.\nDefaults to false.\n+optional",
-		"autoattachVSOCK":            "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
-		"rng":                        "Whether to have random number generator from host\n+optional",
-		"blockMultiQueue":            "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
-		"networkInterfaceMultiqueue": "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
-		"gpus":                       "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
-		"downwardMetrics":            "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
-		"panicDevices":               "PanicDevices provides additional crash information when a guest crashes.\n+optional\n+listtype=atomic",
-		"filesystems":                "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
-		"hostDevices":                "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
-		"clientPassthrough":          "To configure and access client devices such as redirecting USB\n+optional",
-		"sound":                      "Whether to emulate a sound device.\n+optional",
-		"tpm":                        "Whether to emulate a TPM device.\n+optional",
-		"video":                      "Video describes the video device configuration for the vmi.\n+optional",
+		"useVirtioTransitional":       "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
+		"disableHotplug":              "DisableHotplug disabled the ability to hotplug disks.",
+		"disks":                       "Disks describes disks, cdroms and luns which are connected to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"watchdog":                    "Watchdog describes a watchdog device which can be added to the vmi.",
+		"interfaces":                  "Interfaces describe network interfaces which are added to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"inputs":                      "Inputs describe input devices",
+		"autoattachPodInterface":      "Whether to attach a pod network interface. Defaults to true.",
+		"autoattachGraphicsDevice":    "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
+		"autoattachSerialConsole":     "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
+		"logSerialConsole":            "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
+		"serials":                     "Serials defines additional named serial devices.\nEach of them is accessible through the console subresource by its name.\n+optional\n+kubebuilder:validation:MaxItems:=8\n+listType=map\n+listMapKey=name",
+		"autoattachMemBalloon":        "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
+		"autoattachInputDevice":       "Whether to attach an Input Device.\nDefaults to false.\n+optional",
+		"autoattachVSOCK":             "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
+		"autoattachVirtioDriversDisk": "Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as\nindicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk\nis no longer attached on the next start once set to false.\nDefaults to false.\n+optional",
+		"rng":                         "Whether to have random number generator from host\n+optional",
+		"blockMultiQueue":             "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
+		"networkInterfaceMultiqueue":  "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
+		"gpus":                        "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
+		"downwardMetrics":             "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
+		"panicDevices":                "PanicDevices provides additional crash information when a guest crashes.\n+optional\n+listtype=atomic",
+		"guestPanicCapture":           "GuestPanicCapture configures what is captured when the guest kernel panics.\nA pvpanic device is added if no panic device is provided.\n+optional",
+		"filesystems":                 "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
+		"hostDevices":                 "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
+		"clientPassthrough":           "To configure and access client devices such as redirecting USB\n+optional",
+		"sound":                       "Whether to emulate a sound device.\n+optional",
+		"tpm":                         "Whether to emulate a TPM device.\n+optional",
+		"video":                       "Video describes the video device configuration for the vmi.\n+optional",
 	}
 }
 
@@ -307,6 +392,14 @@ func (SoundDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SerialDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "SerialDevice is an additional serial device of the VMI.",
+		"name": "Name of the serial device, used to select it when connecting to the console subresource.",
+		"type": "Type of the serial device. One of: serial, virtio.\nA serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a\nvirtio console of the guest (e.g. hvc0).\nDefaults to serial.\n+optional",
+	}
+}
+
 func (TPMDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"enabled":    "Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine\nDefaults to True",
@@ -336,7 +429,11 @@ func (Filesystem) SwaggerDoc() map[string]string {
 }
 
 func (FilesystemVirtiofs) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"cache":          "Cache sets the caching policy of the shared directory in the guest.\nOne of: auto, always, never. Defaults to auto.\n+optional",
+		"daxWindowSize":  "DAXWindowSize enables DAX for the filesystem and sets the size of the\nshared memory window the guest maps the files into, bypassing its page cache.\nIt must be a multiple of 2Mi. DAX is disabled if not set.\n+optional",
+		"threadPoolSize": "ThreadPoolSize sets the number of worker threads of the virtiofsd process\nserving the filesystem. Defaults to the virtiofsd default.\n+optional",
+	}
 }
 
 func (DownwardMetrics) SwaggerDoc() map[string]string {
@@ -375,6 +472,14 @@ func (PanicDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestPanicCapture) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "GuestPanicCapture configures the capture of the guest state when the guest kernel panics.",
+		"consoleLogLines":     "ConsoleLogLines is the number of trailing lines of the serial console log\nrecorded in the GuestPanicked condition. Requires the serial console log.\nDefaults to 20, at most 100.\n+optional",
+		"memoryDumpClaimName": "MemoryDumpClaimName is the name of the PVC the guest memory is dumped to\nwhen the guest kernel panics. The crashed guest is kept until the memory\ndump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.\n+optional",
+	}
+}
+
 func (HostDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"deviceName": "DeviceName is the name of the device provisioned by device-plugins",
@@ -568,6 +673,7 @@ func (ClockOffset) SwaggerDoc() map[string]string {
 		"":         "Exactly one of its members must be set.",
 		"utc":      "UTC sets the guest clock to UTC on each boot. If an offset is specified,\nguest changes to the clock will be kept during reboots and are not reset.",
 		"timezone": "Timezone sets the guest clock to the specified timezone.\nZone name follows the TZ environment variable format (e.g. 'America/New_York').",
+		"variable": "Variable lets the guest change its clock freely relative to the basis.\nThe guest changes are kept during reboots and migrations.",
 	}
 }
 
@@ -578,10 +684,28 @@ func (ClockOffsetUTC) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ClockOffsetVariable) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "ClockOffsetVariable lets the guest change its clock freely.",
+		"basis":         "Basis the offset is relative to.\nOne of \"utc\", \"localtime\". Defaults to \"utc\".\n+optional",
+		"offsetSeconds": "OffsetSeconds specifies the initial offset in seconds, relative to the basis.\n+optional",
+	}
+}
+
 func (Clock) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":      "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
-		"timer": "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"":              "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
+		"timer":         "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"guestTimeSync": "GuestTimeSync controls when the guest clock is set to the host time through\nthe guest agent, after the guest was not running for some time.\n+optional",
+	}
+}
+
+func (GuestTimeSync) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestTimeSync configures the guest clock synchronization through the guest agent.",
+		"afterMigration": "AfterMigration sets the guest clock once the vmi is migrated.\nDefaults to true.\n+optional",
+		"afterUnpause":   "AfterUnpause sets the guest clock once the vmi is unpaused.\nDefaults to true.\n+optional",
+		"timeoutSeconds": "TimeoutSeconds is the time the guest agent is retried for if it is not responsive.\nDefaults to 60.\n+optional",
 	}
 }
 
@@ -730,14 +854,14 @@ func (WatchdogDevice) SwaggerDoc() map[string]string {
 func (I6300ESBWatchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "i6300esb watchdog device.",
-		"action": "The action to take. Valid values are poweroff, reset, shutdown.\nDefaults to reset.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump.\nDefaults to reset.",
 	}
 }
 
 func (Diag288Watchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "diag288 watchdog device.",
-		"action": "The action to take. Valid values are poweroff, reset, shutdown.\nDefaults to reset.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, dump.\nDefaults to reset.",
 	}
 }
 
@@ -749,6 +873,7 @@ func (Interface) SwaggerDoc() map[string]string {
 		"ports":       "List of ports to be forwarded to the virtual machine.",
 		"macAddress":  "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
 		"bootOrder":   "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
+		"networkBoot": "NetworkBoot makes the interface the first boot device of the vmi.\nThe disks and interfaces with a boot order are tried after it, following their boot order.\nCan not be combined with bootOrder.\n+optional",
 		"pciAddress":  "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
@@ -757,6 +882,13 @@ func (Interface) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InterfaceNetworkBoot) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "InterfaceNetworkBoot configures booting the vmi from the network.",
+		"ipxeScriptRef": "IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key.\nThe firmware of the interface runs the script instead of fetching the boot file\noffered by the DHCP server of the network.\nOnly supported on interfaces with the masquerade binding.\n+optional",
+	}
+}
+
 func (DHCPOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "Extra DHCP options to use in the interface.",
@@ -39,6 +39,8 @@ import (
 
 const DefaultGracePeriodSeconds int64 = 30
 
+const DefaultPreShutdownHookTimeoutSeconds int64 = 10
+
 // VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -116,15 +118,25 @@ type VirtualMachineInstanceSpec struct {
 	// - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
 	// - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
 	// - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
+	// - "LiveMigrateOrShutdown": the same as "LiveMigrateIfPossible", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout.
 	// - "External": the VirtualMachineInstance will be protected and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
 	// +optional
 	EvictionStrategy *EvictionStrategy `json:"evictionStrategy,omitempty"`
+	// EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be
+	// migrated on eviction with the "LiveMigrateOrShutdown" eviction strategy, before it is shutdown.
+	// Overrides the cluster wide evictionMigrationTimeout of the migration configuration.
+	// +optional
+	EvictionMigrationTimeoutSeconds *int64 `json:"evictionMigrationTimeoutSeconds,omitempty"`
 	// StartStrategy can be set to "Paused" if Virtual Machine should be started in paused state.
 	//
 	// +optional
 	StartStrategy *StartStrategy `json:"startStrategy,omitempty"`
 	// Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the
+	// applications of the guest, e.g. to let a database stop cleanly.
+	// +optional
+	PreShutdownHook *PreShutdownHook `json:"preShutdownHook,omitempty"`
 	// List of volumes that can be mounted by disks belonging to the vmi.
 	// +kubebuilder:validation:MaxItems:=256
 	Volumes []Volume `json:"volumes,omitempty"`
@@ -196,6 +208,73 @@ type VirtualMachineInstanceSpec struct {
 	// +listMapKey=name
 	// +optional
 	UtilityVolumes []UtilityVolume `json:"utilityVolumes,omitempty"`
+	// GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in
+	// the guest. A command must be allowed by both this and the cluster wide configuration.
+	// +optional
+	GuestAgentCommands *GuestAgentCommandsConfiguration `json:"guestAgentCommands,omitempty"`
+	// Ignition provisions CoreOS-family guests with an Ignition config. Unlike the
+	// kubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and
+	// be made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.
+	// +optional
+	Ignition *Ignition `json:"ignition,omitempty"`
+}
+
+// IgnitionConfigKey is the key of the Ignition config in the Secret or ConfigMap referenced by a fragment.
+const IgnitionConfigKey = "config.ign"
+
+// IgnitionPropagation defines how the Ignition config is passed to the guest.
+type IgnitionPropagation string
+
+const (
+	// IgnitionPropagationFirmwareConfig passes the config through the QEMU firmware configuration device,
+	// read by Fedora CoreOS, RHCOS and Flatcar on the qemu platform.
+	IgnitionPropagationFirmwareConfig IgnitionPropagation = "FirmwareConfig"
+	// IgnitionPropagationConfigDrive passes the config as the user data of the cloudInitConfigDrive
+	// volume, read by the guests booted on the openstack platform.
+	IgnitionPropagationConfigDrive IgnitionPropagation = "ConfigDrive"
+)
+
+// Ignition is the Ignition config of a CoreOS-family guest.
+type Ignition struct {
+	// Fragments make up the Ignition config. A single fragment is passed to the guest as is, several
+	// fragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=16
+	// +listType=atomic
+	Fragments []IgnitionFragment `json:"fragments"`
+	// Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig.
+	// ConfigDrive requires a cloudInitConfigDrive volume without user data.
+	// +kubebuilder:validation:Enum=FirmwareConfig;ConfigDrive
+	// +optional
+	Propagation IgnitionPropagation `json:"propagation,omitempty"`
+}
+
+// IgnitionFragment is a part of the Ignition config. Exactly one of its sources must be set.
+type IgnitionFragment struct {
+	// Data is an inline Ignition config.
+	// +optional
+	Data string `json:"data,omitempty"`
+	// VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance,
+	// holding the Ignition config under the config.ign key.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty"`
+}
+
+// PreShutdownHook is a command executed in the guest through the qemu-guest-agent when the
+// VirtualMachineInstance is stopped. The termination grace period is split between the hook and
+// the ACPI shutdown: the ACPI shutdown is signaled once the hook exited or timed out, and gets
+// the rest of the grace period. The ACPI shutdown is signaled right away if the hook can not be
+// executed, e.g. because the guest agent is not connected.
+type PreShutdownHook struct {
+	// Command is the path of the executable in the guest, e.g. a script stopping a database.
+	Command string `json:"command"`
+	// Args are passed to the command.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the part of the termination grace period reserved for the hook.
+	// It must be shorter than terminationGracePeriodSeconds. Defaults to 10.
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
 }
 
 type VirtualMachineInstanceResourceClaim struct {
@@ -275,6 +354,10 @@ type VirtualMachineInstanceStatus struct {
 	Interfaces []VirtualMachineInstanceNetworkInterface `json:"interfaces,omitempty"`
 	// Guest OS Information
 	GuestOSInfo VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
+	// GuestAgentData contains the information reported by the guest agent along with the time it was reported.
+	// It is kept when the guest agent stops responding, see the GuestAgentDataStale condition.
+	// +optional
+	GuestAgentData *GuestAgentData `json:"guestAgentData,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
 	// Represents the method using which the vmi can be migrated: live migration or block migration
@@ -697,9 +780,18 @@ const (
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceAgentConnected VirtualMachineInstanceConditionType = "AgentConnected"
 
+	// Reflects whether the guest agent data in the status is stale, since the QEMU guest agent stopped responding
+	VirtualMachineInstanceGuestAgentDataStale VirtualMachineInstanceConditionType = "GuestAgentDataStale"
+
 	// Reflects whether the QEMU guest agent updated access credentials successfully
 	VirtualMachineInstanceAccessCredentialsSynchronized VirtualMachineInstanceConditionType = "AccessCredentialsSynchronized"
 
+	// Reflects whether the cloud-init data of the VMI was rotated or ejected successfully
+	VirtualMachineInstanceCloudInitSynchronized VirtualMachineInstanceConditionType = "CloudInitSynchronized"
+
+	// Reflects that virt-launcher failed to save the guest memory while suspending the VMI to disk
+	VirtualMachineInstanceSuspendFailed VirtualMachineInstanceConditionType = "SuspendFailed"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceUnsupportedAgent VirtualMachineInstanceConditionType = "AgentVersionNotSupported"
 
@@ -729,6 +821,12 @@ const (
 
 	// VirtualMachineInstanceEvictionRequested indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceEvictionRequested VirtualMachineInstanceConditionType = "EvictionRequested"
+
+	// VirtualMachineInstanceNodeShutdown indicates that the node of the VMI is shutting down and reflects how the VMI is handled
+	VirtualMachineInstanceNodeShutdown VirtualMachineInstanceConditionType = "NodeShutdown"
+
+	// VirtualMachineInstanceGuestPanicked indicates that the guest kernel panicked, the message holds the panic details
+	VirtualMachineInstanceGuestPanicked VirtualMachineInstanceConditionType = "GuestPanicked"
 )
 
 // These are valid reasons for VMI conditions.
@@ -776,6 +874,30 @@ const (
 
 	// Indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceReasonEvictionRequested = "EvictionRequested"
+
+	// Indicates that the kubelet terminates the virt-launcher pod of the VMI because its node is shutting down
+	VirtualMachineInstanceReasonNodeShutdownStarted = "NodeShutdownStarted"
+	// Indicates that the VMI is live migrated away from its shutting down node
+	VirtualMachineInstanceReasonNodeShutdownMigrating = "NodeShutdownMigrating"
+	// Indicates that the VMI is stopped because its node is shutting down and it cannot be live migrated
+	VirtualMachineInstanceReasonNodeShutdownStopping = "NodeShutdownStopping"
+	// Indicates that the VMI was live migrated away from its shutting down node
+	VirtualMachineInstanceReasonNodeShutdownMigrated = "NodeShutdownMigrated"
+	// Indicates that the VMI was stopped because its node shut down
+	VirtualMachineInstanceReasonNodeShutdownStopped = "NodeShutdownStopped"
+
+	// Indicates that the guest agent data is stale since the guest agent disconnected
+	VirtualMachineInstanceReasonGuestAgentDisconnected = "GuestAgentDisconnected"
+	// Indicates that the guest agent data is stale since polling the guest agent failed or timed out
+	VirtualMachineInstanceReasonGuestAgentNotResponding = "GuestAgentNotResponding"
+
+	// Indicates that the cloud-init data was rotated and cloud-init re-ran in the guest
+	VirtualMachineInstanceReasonCloudInitRotated = "CloudInitRotated"
+	// Indicates that the cloud-init data was ejected from the guest
+	VirtualMachineInstanceReasonCloudInitEjected = "CloudInitEjected"
+
+	// Indicates that the guest memory could not be saved to the backend storage
+	VirtualMachineInstanceReasonSaveFailed = "SaveFailed"
 )
 
 const (
@@ -910,6 +1032,38 @@ type VirtualMachineInstanceGuestOSInfo struct {
 	ID string `json:"id,omitempty"`
 }
 
+// GuestAgentData is the information reported by the guest agent
+type GuestAgentData struct {
+	// OS is the guest operating system information
+	// +optional
+	OS *GuestAgentOSInfo `json:"os,omitempty"`
+	// Hostname is the hostname of the guest
+	// +optional
+	Hostname *GuestAgentValue `json:"hostname,omitempty"`
+	// FQDN is the fully qualified domain name of the guest, reported when the hostname of the guest is fully qualified
+	// +optional
+	FQDN *GuestAgentValue `json:"fqdn,omitempty"`
+	// Timezone is the timezone of the guest, as name and offset to UTC in seconds
+	// +optional
+	Timezone *GuestAgentValue `json:"timezone,omitempty"`
+}
+
+// GuestAgentOSInfo is the guest operating system information reported by the guest agent
+type GuestAgentOSInfo struct {
+	// Info is the guest operating system information
+	Info VirtualMachineInstanceGuestOSInfo `json:"info"`
+	// LastUpdated is the time the guest agent reported the current information
+	LastUpdated metav1.Time `json:"lastUpdated"`
+}
+
+// GuestAgentValue is a value reported by the guest agent
+type GuestAgentValue struct {
+	// Value is the value reported by the guest agent
+	Value string `json:"value"`
+	// LastUpdated is the time the guest agent reported the current value
+	LastUpdated metav1.Time `json:"lastUpdated"`
+}
+
 // +k8s:openapi-gen=true
 type MigrationNetworkType string
 
@@ -1270,6 +1424,10 @@ const (
 	CPUModelVendorLabel = "cpu-vendor.node.kubevirt.io/"
 	// This label represents supported machine type on the node
 	SupportedMachineTypeLabel = "machine-type.node.kubevirt.io/"
+	// This label represents the NUMA cells of the node and their memory
+	NUMATopologyLabel = "numa.node.kubevirt.io/"
+	// This label represents the L3 cache domains of the node and their size
+	CacheTopologyLabel = "cache.node.kubevirt.io/"
 
 	VirtIO = "virtio"
 
@@ -1279,19 +1437,28 @@ const (
 	HostModelRequiredFeaturesLabel = "host-model-required-features.node.kubevirt.io/"
 	NodeHostModelIsObsoleteLabel   = "node-labeller.kubevirt.io/obsolete-host-model"
 
-	LabellerSkipNodeAnnotation        = "node-labeller.kubevirt.io/skip-node"
-	VirtualMachineLabel               = AppLabel + "/vm"
-	MemfdMemoryBackend         string = "kubevirt.io/memfd"
+	LabellerSkipNodeAnnotation = "node-labeller.kubevirt.io/skip-node"
+	// HostTopologyAnnotation holds the NUMA cells and L3 cache domains of the node, including their CPUs, as JSON
+	HostTopologyAnnotation = "node-labeller.kubevirt.io/host-topology"
+	VirtualMachineLabel    = AppLabel + "/vm"
+	// LogVerbosityLabel sets the log verbosity of the virt-launcher of a VMI, it can be changed at runtime
+	LogVerbosityLabel  string = "logVerbosity"
+	MemfdMemoryBackend string = "kubevirt.io/memfd"
 
 	MigrationSelectorLabel = "kubevirt.io/vmi-name"
 	// RestoreRunStrategy is how to restore the run strategy of the VMI
 	RestoreRunStrategy = "kubevirt.io/restore-run-strategy"
+	// RestoreSavedStateAnnotation marks a VMI which is started from the guest memory
+	// saved in its backend storage when its VirtualMachine got suspended to disk
+	RestoreSavedStateAnnotation = "kubevirt.io/restore-saved-state"
 
 	// This annotation represents vmi running nonroot implementation
 	DeprecatedNonRootVMIAnnotation = "kubevirt.io/nonroot"
 
 	// This annotation is used to mark a VMI as a migration target, and to start a receiver pod.
 	CreateMigrationTarget = "kubevirt.io/create-migration-target"
+	// TraceParentAnnotation carries the W3C trace context of the request which started a VMI or a migration
+	TraceParentAnnotation string = "kubevirt.io/traceparent"
 	// This annotation is to keep virt launcher container alive when an VMI encounters a failure for debugging purpose
 	KeepLauncherAfterFailureAnnotation string = "kubevirt.io/keep-launcher-alive-after-failure"
 
@@ -1317,6 +1484,15 @@ const (
 
 	// RealtimeLabel marks the node as capable of running realtime workloads
 	RealtimeLabel string = "kubevirt.io/realtime"
+	// RealtimeKernelLabel marks the node as running a fully preemptible (PREEMPT_RT) kernel
+	RealtimeKernelLabel string = "kubevirt.io/realtime-kernel"
+
+	// SwapEnabledLabel marks the node as having swap enabled
+	SwapEnabledLabel string = "kubevirt.io/swap-enabled"
+
+	// MemoryOvercommitPressureLabel marks the node as running short of memory,
+	// swap included, to back its overcommitted VirtualMachineInstances
+	MemoryOvercommitPressureLabel string = "kubevirt.io/memory-overcommit-pressure"
 
 	// VirtualMachineUnpaused is a custom pod condition set for the virt-launcher pod.
 	// It's used as a readiness gate to prevent paused VMs from being marked as ready.
@@ -1337,6 +1513,24 @@ const (
 	// TDXLabel marks the node as capable of running workloads with Intel TDX
 	TDXLabel string = "kubevirt.io/tdx"
 
+	// CPUHotplugLabel marks an arm64 node as capable of hotplugging vCPUs into its guests,
+	// which requires a GICv3 interrupt controller
+	CPUHotplugLabel string = "kubevirt.io/cpu-hotplug"
+
+	// LauncherReservationLabel holds the name of the launcher reservation a placeholder pod belongs to
+	LauncherReservationLabel string = "kubevirt.io/launcher-reservation"
+
+	// ClaimedLauncherReservationAnnotation holds the namespace/name of the placeholder pod which was
+	// deleted to make room for a virt-launcher pod
+	ClaimedLauncherReservationAnnotation string = "kubevirt.io/claimed-launcher-reservation"
+
+	// NestedVirtualizationLabel marks the node as capable of exposing VMX or SVM to its guests,
+	// so that they can run virtual machines themselves
+	NestedVirtualizationLabel string = "kubevirt.io/nested-virtualization"
+
+	// VirtualizedNodeLabel marks the node as running in a virtual machine itself
+	VirtualizedNodeLabel string = "kubevirt.io/virtualized-node"
+
 	// KSMEnabledLabel marks the node as KSM-handling enabled
 	KSMEnabledLabel string = "kubevirt.io/ksm-enabled"
 
@@ -1368,6 +1562,18 @@ const (
 	// originated from.
 	VirtualMachinePoolRevisionName string = "kubevirt.io/vm-pool-revision-name"
 
+	// VirtualMachinePoolNameLabel is the name of the vmpool this object belongs to,
+	// it is propagated to the VMI and its pod to aggregate their metrics per pool.
+	VirtualMachinePoolNameLabel string = "kubevirt.io/vm-pool"
+
+	// VirtualMachinePoolStandbyLabel marks the standby VMs of a vmpool and their VMIs, which
+	// are kept paused to replace the failed VMs of the pool.
+	VirtualMachinePoolStandbyLabel string = "kubevirt.io/vm-pool-standby"
+
+	// VirtualMachineRevisionHistoryLabel is the name of the VirtualMachine whose spec is
+	// stored in a revision history ControllerRevision.
+	VirtualMachineRevisionHistoryLabel string = "kubevirt.io/vm-revision-history"
+
 	// DeprecatedVirtualMachineNameLabel is the name of the Virtual Machine
 	// Deprecated: Use VirtualMachineInstanceSelectorLabel instead. Kept for backwards compatibility.
 	DeprecatedVirtualMachineNameLabel string = "vm.kubevirt.io/name"
@@ -1530,6 +1736,8 @@ const (
 	Resumed                      SyncEvent = "Resumed"
 	AccessCredentialsSyncFailed  SyncEvent = "AccessCredentialsSyncFailed"
 	AccessCredentialsSyncSuccess SyncEvent = "AccessCredentialsSyncSuccess"
+	CloudInitSyncFailed          SyncEvent = "CloudInitSyncFailed"
+	CloudInitSyncSuccess         SyncEvent = "CloudInitSyncSuccess"
 )
 
 func (s SyncEvent) String() string {
@@ -2019,6 +2227,68 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// Schedule starts and stops the VirtualMachine at recurring times.
+	// Requires the VirtualMachineSchedule feature gate.
+	// +optional
+	Schedule *VirtualMachineSchedule `json:"schedule,omitempty"`
+
+	// DependsOn lists VirtualMachines of the same namespace which must be ready before this
+	// VirtualMachine is started. Requires the VirtualMachineDependencies feature gate.
+	// +optional
+	// +listType=atomic
+	DependsOn []VirtualMachineDependency `json:"dependsOn,omitempty"`
+
+	// CrashLoopBackoff configures the delay before restarting the VirtualMachine after its
+	// VirtualMachineInstance failed shortly after being started. Each field overrides the
+	// cluster wide one.
+	// +optional
+	CrashLoopBackoff *CrashLoopBackoff `json:"crashLoopBackoff,omitempty"`
+
+	// RevisionHistoryLimit is the number of old revisions of the spec to keep for rollbacks.
+	// Revisions are only recorded with the VirtualMachineRevisionHistory feature gate.
+	// Defaults to 10.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+}
+
+// VirtualMachineDependency references a VirtualMachine which must be ready before the
+// depending VirtualMachine is started
+type VirtualMachineDependency struct {
+	// Name is the name of the VirtualMachine
+	Name string `json:"name"`
+}
+
+// VirtualMachineSchedule starts and stops a VirtualMachine at recurring times, e.g. to stop
+// development VMs at night and start them again in the morning.
+type VirtualMachineSchedule struct {
+	// Start is a cron expression, in the standard five fields format, at which the VirtualMachine is started.
+	// +optional
+	Start string `json:"start,omitempty"`
+	// Stop is a cron expression, in the standard five fields format, at which the VirtualMachine is stopped.
+	// +optional
+	Stop string `json:"stop,omitempty"`
+	// TimeZone is the IANA name of the time zone the cron expressions are evaluated in. Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+	// SkipStopIfInUse skips scheduled stops while the VirtualMachine is in use, that is while users
+	// are logged in the guest, or while it is migrating or being backed up. Defaults to false.
+	// +optional
+	SkipStopIfInUse *bool `json:"skipStopIfInUse,omitempty"`
+}
+
+// VirtualMachineScheduleStatus reports the progress of the schedule of a VirtualMachine
+type VirtualMachineScheduleStatus struct {
+	// LastScheduleTime is the last start or stop time which was handled, or the time the schedule
+	// was first evaluated at.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// NextStartTime is the next time the VirtualMachine is scheduled to start.
+	// +optional
+	NextStartTime *metav1.Time `json:"nextStartTime,omitempty"`
+	// NextStopTime is the next time the VirtualMachine is scheduled to stop.
+	// +optional
+	NextStopTime *metav1.Time `json:"nextStopTime,omitempty"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -2037,6 +2307,18 @@ type VirtualMachinePrintableStatus string
 const (
 	// VirtualMachineStatusStopped indicates that the virtual machine is currently stopped and isn't expected to start.
 	VirtualMachineStatusStopped VirtualMachinePrintableStatus = "Stopped"
+	// VirtualMachineStatusSucceeded indicates that the virtual machine with the Once run strategy ran to
+	// completion and its guest shut down successfully.
+	VirtualMachineStatusSucceeded VirtualMachinePrintableStatus = "Succeeded"
+	// VirtualMachineStatusFailed indicates that the virtual machine with the Once run strategy ran to
+	// completion and its guest failed.
+	VirtualMachineStatusFailed VirtualMachinePrintableStatus = "Failed"
+	// VirtualMachineStatusSuspending indicates that the guest memory of the virtual machine is being
+	// saved to its backend storage.
+	VirtualMachineStatusSuspending VirtualMachinePrintableStatus = "Suspending"
+	// VirtualMachineStatusSuspended indicates that the virtual machine is suspended to disk. Its guest
+	// memory is kept in its backend storage and no node resources are allocated.
+	VirtualMachineStatusSuspended VirtualMachinePrintableStatus = "Suspended"
 	// VirtualMachineStatusProvisioning indicates that cluster resources associated with the virtual machine
 	// (e.g., DataVolumes) are being provisioned and prepared.
 	VirtualMachineStatusProvisioning VirtualMachinePrintableStatus = "Provisioning"
@@ -2079,14 +2361,70 @@ const (
 	// VirtualMachineStatusWaitingForReceiver indicates that this virtual machine is a receiver VM and
 	// migration should start next.
 	VirtualMachineStatusWaitingForReceiver VirtualMachinePrintableStatus = "WaitingForReceiver"
+	// VirtualMachineStatusWaitingForDependencies indicates that the virtual machine is waiting for the
+	// virtual machines it depends on to be ready before it is started.
+	VirtualMachineStatusWaitingForDependencies VirtualMachinePrintableStatus = "WaitingForDependencies"
 )
 
 // VirtualMachineStartFailure tracks VMIs which failed to transition successfully
 // to running using the VM status
 type VirtualMachineStartFailure struct {
-	ConsecutiveFailCount int          `json:"consecutiveFailCount,omitempty"`
-	LastFailedVMIUID     types.UID    `json:"lastFailedVMIUID,omitempty"`
-	RetryAfterTimestamp  *metav1.Time `json:"retryAfterTimestamp,omitempty"`
+	// ConsecutiveFailCount is the number of VirtualMachineInstances which failed in a row
+	ConsecutiveFailCount int `json:"consecutiveFailCount,omitempty"`
+	// LastFailedVMIUID is the UID of the last VirtualMachineInstance which failed
+	LastFailedVMIUID types.UID `json:"lastFailedVMIUID,omitempty"`
+	// RetryAfterTimestamp is the time after which the VirtualMachine is started again
+	RetryAfterTimestamp *metav1.Time `json:"retryAfterTimestamp,omitempty"`
+}
+
+// VirtualMachineCompletion records the final state of the VirtualMachineInstance of a
+// VirtualMachine with the Once run strategy
+type VirtualMachineCompletion struct {
+	// Phase is the final phase the VirtualMachineInstance reached, either Succeeded or Failed
+	Phase VirtualMachineInstancePhase `json:"phase"`
+	// CompletionTime is the time the completion was observed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// VirtualMachineSuspendPhase is the phase of the suspension of a VirtualMachine to disk
+type VirtualMachineSuspendPhase string
+
+const (
+	// VirtualMachineSuspending means the guest memory is being saved to the backend storage
+	VirtualMachineSuspending VirtualMachineSuspendPhase = "Suspending"
+	// VirtualMachineSuspended means the guest memory is saved and the VirtualMachineInstance is stopped
+	VirtualMachineSuspended VirtualMachineSuspendPhase = "Suspended"
+	// VirtualMachineResuming means a VirtualMachineInstance is started from the saved guest memory
+	VirtualMachineResuming VirtualMachineSuspendPhase = "Resuming"
+)
+
+// VirtualMachineSuspendStatus tracks the suspension of a VirtualMachine to disk
+type VirtualMachineSuspendStatus struct {
+	// Phase is the phase of the suspension
+	Phase VirtualMachineSuspendPhase `json:"phase"`
+	// VMIUID is the UID of the VirtualMachineInstance which got suspended
+	// +optional
+	VMIUID types.UID `json:"vmiUID,omitempty"`
+	// SuspendTime is the time the guest memory was saved
+	// +optional
+	SuspendTime *metav1.Time `json:"suspendTime,omitempty"`
+}
+
+// CrashLoopBackoff configures the delay before restarting a VirtualMachine whose VirtualMachineInstance
+// failed before reaching the Running phase, or before having run for the reset window
+type CrashLoopBackoff struct {
+	// BaseDelay is the delay after the first failure. The delay after n consecutive failures is the
+	// base delay multiplied by the square of n. Defaults to a thirtieth of MaxDelay, and at least 10 seconds.
+	// +optional
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+	// MaxDelay caps the delay. Defaults to 5 minutes.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+	// ResetWindow is how long a VirtualMachineInstance has to run for the consecutive failures to be
+	// forgotten. Defaults to 0, forgetting them as soon as the VirtualMachineInstance is running.
+	// +optional
+	ResetWindow *metav1.Duration `json:"resetWindow,omitempty"`
 }
 
 // VirtualMachineStatus represents the status returned by the
@@ -2163,6 +2501,24 @@ type VirtualMachineStatus struct {
 	//+nullable
 	//+optional
 	PreferenceRef *InstancetypeStatusRef `json:"preferenceRef,omitempty"`
+
+	// Schedule reports the progress of the schedule of the VirtualMachine
+	// +nullable
+	// +optional
+	Schedule *VirtualMachineScheduleStatus `json:"schedule,omitempty"`
+
+	// Completion is set once the VirtualMachineInstance of a VirtualMachine with the Once
+	// run strategy reached a final phase. The VirtualMachine is not started again until
+	// the run strategy is changed.
+	// +nullable
+	// +optional
+	Completion *VirtualMachineCompletion `json:"completion,omitempty"`
+
+	// Suspend tracks the suspension of the VirtualMachine to disk. The VirtualMachine is not
+	// started while it is suspended, until it is resumed.
+	// +nullable
+	// +optional
+	Suspend *VirtualMachineSuspendStatus `json:"suspend,omitempty"`
 }
 
 type ControllerRevisionRef struct {
@@ -2323,6 +2679,18 @@ const (
 
 	// VirtualMachineManualRecoveryRequired is added when the VM spec needs to be manually recovered by the user
 	VirtualMachineManualRecoveryRequired VirtualMachineConditionType = "ManualRecoveryRequired"
+
+	// VirtualMachineConfigurationDrifted is added when the configuration of the running VMI
+	// diverged from the VM spec it was created or live updated from
+	VirtualMachineConfigurationDrifted VirtualMachineConditionType = "ConfigurationDrifted"
+
+	// VirtualMachineMachineTypeUnsupported is added when no node supports the machine type of the VM,
+	// e.g. because it was removed by a newer QEMU version
+	VirtualMachineMachineTypeUnsupported VirtualMachineConditionType = "MachineTypeUnsupported"
+
+	// VirtualMachineSuspendFailed is added when the last suspension of the VM to disk failed,
+	// the VM keeps running
+	VirtualMachineSuspendFailed VirtualMachineConditionType = "SuspendFailed"
 )
 
 type HostDiskType string
@@ -2384,6 +2752,10 @@ type Handler struct {
 	// a fault (IO error, crash, or postcopy failure).
 	// +optional
 	GuestAgentPing *GuestAgentPing `json:"guestAgentPing,omitempty"`
+	// GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.
+	// If the guest agent is not available, this probe will fail.
+	// +optional
+	GuestAgentFileExists *GuestAgentFileExists `json:"guestAgentFileExists,omitempty"`
 	// HTTPGet specifies the http request to perform.
 	// +optional
 	HTTPGet *k8sv1.HTTPGetAction `json:"httpGet,omitempty"`
@@ -2521,6 +2893,84 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// NamespaceSelector limits automated workload updates to the VMIs in the namespaces
+	// matching the selector. Namespaces can opt out with a label excluded by the selector.
+	// The VMIs of other namespaces are only counted as outdated.
+	//
+	// Defaults to all namespaces
+	//
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// MaxUpdatesPerNamespace is the maximum number of VMIs of a single namespace which are
+	// migrated or evicted at the same time
+	//
+	// Defaults to no limit
+	//
+	// +optional
+	MaxUpdatesPerNamespace *int `json:"maxUpdatesPerNamespace,omitempty"`
+
+	// Windows restricts automated workload updates to recurring time windows. Migrations
+	// and evictions are only started while a window is open.
+	//
+	// An empty list allows workload updates at any time
+	//
+	// +listType=atomic
+	// +optional
+	Windows []WorkloadUpdateWindow `json:"windows,omitempty"`
+}
+
+// WorkloadUpdateWindow is a recurring time window in which automated workload updates are started
+type WorkloadUpdateWindow struct {
+	// Start is a cron expression, in the standard five fields format, at which the window opens.
+	Start string `json:"start"`
+	// Duration is how long the window stays open.
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the IANA name of the time zone the cron expression is evaluated in. Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// KubeVirtRolloutStrategy defines how updates of the KubeVirt components are rolled out
+type KubeVirtRolloutStrategy struct {
+	// VirtHandler rolls updates of virt-handler out node pool by node pool.
+	// By default virt-handler is updated on a single canary node first and on 10% of the nodes at a time afterwards.
+	// +optional
+	VirtHandler *NodePoolRolloutStrategy `json:"virtHandler,omitempty"`
+}
+
+// NodePoolRolloutStrategy rolls an update out node pool by node pool
+type NodePoolRolloutStrategy struct {
+	// NodePoolLabel is the key of the node label which groups the nodes into pools.
+	// The pools are updated one after another, ordered by the label value. Nodes without the label are updated last.
+	NodePoolLabel string `json:"nodePoolLabel"`
+
+	// MaxUnavailable is the maximum number of nodes of a pool which are updated at once.
+	//
+	// Defaults to 1
+	//
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+
+	// PoolSoakSeconds is the time the updated pods have to be ready before the next pool is updated.
+	//
+	// Defaults to 60
+	//
+	// +optional
+	PoolSoakSeconds *int32 `json:"poolSoakSeconds,omitempty"`
+
+	// ErrorBudget is the number of updated pods which may fail before the rollout is paused.
+	// The rollout resumes once the failed pods recover.
+	//
+	// Defaults to 0
+	//
+	// +optional
+	ErrorBudget *int32 `json:"errorBudget,omitempty"`
+
+	// Paused pauses the rollout
+	// +optional
+	Paused bool `json:"paused,omitempty"`
 }
 
 type KubeVirtSpec struct {
@@ -2560,6 +3010,10 @@ type KubeVirtSpec struct {
 	// This is mainly a precaution to avoid accidental data loss
 	UninstallStrategy KubeVirtUninstallStrategy `json:"uninstallStrategy,omitempty"`
 
+	// RolloutStrategy defines how updates of the KubeVirt components are rolled out
+	// +optional
+	RolloutStrategy *KubeVirtRolloutStrategy `json:"rolloutStrategy,omitempty"`
+
 	CertificateRotationStrategy KubeVirtCertificateRotateStrategy `json:"certificateRotateStrategy,omitempty"`
 
 	// Designate the apps.kubevirt.io/version label for KubeVirt components.
@@ -2676,8 +3130,42 @@ type KubeVirtStatus struct {
 	// +optional
 	// +listType=atomic
 	SynchronizationAddresses []string `json:"synchronizationAddresses,omitempty" optional:"true"`
+	// FeatureGates reports the lifecycle of the feature gates known to the deployed KubeVirt version
+	// +optional
+	// +listType=atomic
+	FeatureGates []FeatureGateStatus `json:"featureGates,omitempty" optional:"true"`
+}
+
+// FeatureGateStatus reports the lifecycle of a feature gate
+type FeatureGateStatus struct {
+	// Name of the feature gate
+	Name string `json:"name"`
+	// Stage of the feature gate
+	Stage FeatureGateStage `json:"stage"`
+	// Default tells whether the feature is enabled when the feature gate is neither enabled nor disabled explicitly
+	Default bool `json:"default"`
+	// LockedToDefault tells whether the feature gate can no longer be enabled or disabled, since the feature graduated or was discontinued
+	LockedToDefault bool `json:"lockedToDefault"`
+	// Enabled tells whether the feature is enabled in the cluster
+	Enabled bool `json:"enabled"`
 }
 
+// FeatureGateStage is the lifecycle stage of a feature gate
+type FeatureGateStage string
+
+const (
+	// The feature is under experimentation and disabled by default
+	FeatureGateStageAlpha FeatureGateStage = "Alpha"
+	// The feature is under evaluation and enabled by default
+	FeatureGateStageBeta FeatureGateStage = "Beta"
+	// The feature graduated and is always enabled
+	FeatureGateStageGA FeatureGateStage = "GA"
+	// The feature is going to be discontinued and disabled by default
+	FeatureGateStageDeprecated FeatureGateStage = "Deprecated"
+	// The feature was removed and can no longer be enabled
+	FeatureGateStageDiscontinued FeatureGateStage = "Discontinued"
+)
+
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
 type KubeVirtPhase string
 
@@ -2723,12 +3211,15 @@ const (
 	KubeVirtConditionProgressing KubeVirtConditionType = "Progressing"
 	// Whether KubeVirt is not functioning completely
 	KubeVirtConditionDegraded KubeVirtConditionType = "Degraded"
+	// Whether the external metrics API of the pool metrics adapter is registered (only set if the adapter is configured)
+	KubeVirtConditionPoolMetricsAdapterRegistered KubeVirtConditionType = "PoolMetricsAdapterRegistered"
 )
 
 const (
 	EvictionStrategyNone                  EvictionStrategy = "None"
 	EvictionStrategyLiveMigrate           EvictionStrategy = "LiveMigrate"
 	EvictionStrategyLiveMigrateIfPossible EvictionStrategy = "LiveMigrateIfPossible"
+	EvictionStrategyLiveMigrateOrShutdown EvictionStrategy = "LiveMigrateOrShutdown"
 	EvictionStrategyExternal              EvictionStrategy = "External"
 )
 
@@ -2802,6 +3293,8 @@ type UnpauseOptions struct {
 const (
 	StartRequestDataPausedKey  string = "paused"
 	StartRequestDataPausedTrue string = "true"
+	// StateChangeRequestDataTraceParentKey carries the W3C trace context of the start or stop request
+	StateChangeRequestDataTraceParentKey string = "traceparent"
 )
 
 // StopOptions may be provided when deleting an API object.
@@ -2858,6 +3351,49 @@ type EvacuateCancelOptions struct {
 	EvacuationNodeName string `json:"evacuationNodeName"`
 }
 
+// SuspendOptions may be provided on suspend request.
+type SuspendOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+}
+
+// ResumeOptions may be provided on resume request.
+type ResumeOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+}
+
+// RollbackOptions may be provided on rollback request.
+type RollbackOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+
+	// Revision is the revision to roll back to. Defaults to the revision preceding the current one.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+}
+
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2882,6 +3418,12 @@ type VirtualMachineInstanceGuestAgentInfo struct {
 	// It will be set to "frozen" if the request was made, or unset otherwise.
 	// This does not reflect the actual state of the guest filesystem.
 	FSFreezeStatus string `json:"fsFreezeStatus,omitempty"`
+	// LastPolled is the time the guest agent last reported the guest OS information
+	// +optional
+	LastPolled *metav1.Time `json:"lastPolled,omitempty"`
+	// PollError is set when the last poll of the guest OS information failed or timed out
+	// +optional
+	PollError string `json:"pollError,omitempty"`
 }
 
 // List of commands that QEMU guest agent supports
@@ -2926,6 +3468,9 @@ type VirtualMachineInstanceFileSystemList struct {
 type VirtualMachineInstanceFileSystemDisk struct {
 	Serial  string `json:"serial,omitempty"`
 	BusType string `json:"busType"`
+	// VolumeName is the name of the VMI volume backing the disk, when it can be resolved
+	// +optional
+	VolumeName string `json:"volumeName,omitempty"`
 }
 
 // VirtualMachineInstanceFileSystem represents guest os disk
@@ -2938,6 +3483,45 @@ type VirtualMachineInstanceFileSystem struct {
 	Disk           []VirtualMachineInstanceFileSystemDisk `json:"disk,omitempty"`
 }
 
+// VirtualMachineInstanceDomainXML represents the libvirt domain of a running VirtualMachineInstance
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceDomainXML struct {
+	metav1.TypeMeta `json:",inline"`
+	// DomainXML is the live libvirt domain XML of the VirtualMachineInstance
+	DomainXML string `json:"domainXML,omitempty"`
+	// DomainCapabilitiesXML is the libvirt domain capabilities XML of the node, for the emulator,
+	// architecture and machine type of the VirtualMachineInstance
+	DomainCapabilitiesXML string `json:"domainCapabilitiesXML,omitempty"`
+}
+
+// GuestFileMaxSize is the maximum size of a file read or written through the guestfile subresource
+const GuestFileMaxSize = 1024 * 1024
+
+// VirtualMachineInstanceGuestFile represents a file inside the guest of a running VirtualMachineInstance
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceGuestFile struct {
+	metav1.TypeMeta `json:",inline"`
+	// Path is the absolute path of the file inside the guest
+	Path string `json:"path"`
+	// Content is the content of the file, at most 1MiB
+	Content []byte `json:"content,omitempty"`
+}
+
+// VirtualMachineInstanceGuestExecOutput is streamed by the guestexec subresource as a sequence of JSON objects.
+// The last one holds the exit code of the command, or the error which prevented getting it.
+type VirtualMachineInstanceGuestExecOutput struct {
+	// Stdout is data written by the command to its standard output
+	Stdout []byte `json:"stdout,omitempty"`
+	// Stderr is data written by the command to its standard error
+	Stderr []byte `json:"stderr,omitempty"`
+	// ExitCode is the exit code of the command, set once it exited
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	// Error is set when the command could not be run or did not exit before the timeout
+	Error string `json:"error,omitempty"`
+}
+
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
@@ -3012,6 +3596,16 @@ type VSOCKOptions struct {
 	UseTLS     *bool  `json:"useTLS,omitempty"`
 }
 
+// GuestExecOptions are provided when running a command in the guest with the guestexec subresource
+type GuestExecOptions struct {
+	// Command is the path of the executable in the guest followed by its arguments
+	// +listType=atomic
+	Command []string `json:"command"`
+	// TimeoutSeconds is how long to wait for the command to exit
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
 // RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk
 type RemoveVolumeOptions struct {
 	// Name represents the name that maps to both the disk and volume that
@@ -3027,6 +3621,36 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// InsertMediaOptions is provided when inserting or changing the media of a CD-ROM drive
+type InsertMediaOptions struct {
+	// Name is the name of the CD-ROM disk the media is inserted into
+	Name string `json:"name"`
+	// VolumeSource represents the source of the media
+	VolumeSource *HotplugVolumeSource `json:"volumeSource"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// EjectMediaOptions is provided when ejecting the media of a CD-ROM drive
+type EjectMediaOptions struct {
+	// Name is the name of the CD-ROM disk the media is ejected from
+	Name string `json:"name"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -3106,6 +3730,16 @@ type KubeVirtConfiguration struct {
 	TLSConfiguration               *TLSConfiguration                 `json:"tlsConfiguration,omitempty"`
 	SeccompConfiguration           *SeccompConfiguration             `json:"seccompConfiguration,omitempty"`
 
+	// CPUModels configures the CPU models which the nodes offer to the VirtualMachineInstances.
+	// +optional
+	CPUModels *CPUModelsConfiguration `json:"cpuModels,omitempty"`
+
+	// NodeOverrides override the settings of the nodes matching their node selector, for clusters
+	// made of heterogeneous nodes. The first matching override applies to a node.
+	// +optional
+	// +listType=atomic
+	NodeOverrides []NodeConfigurationOverride `json:"nodeOverrides,omitempty"`
+
 	// VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.
 	VMStateStorageClass   string                 `json:"vmStateStorageClass,omitempty"`
 	VirtualMachineOptions *VirtualMachineOptions `json:"virtualMachineOptions,omitempty"`
@@ -3113,6 +3747,11 @@ type KubeVirtConfiguration struct {
 	// KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).
 	KSMConfiguration *KSMConfiguration `json:"ksmConfiguration,omitempty"`
 
+	// MemoryOvercommitConfiguration holds the memory overcommit classes VMIs can opt into
+	// and the settings for running VMIs on swap enabled nodes.
+	// +nullable
+	MemoryOvercommitConfiguration *MemoryOvercommitConfiguration `json:"memoryOvercommitConfiguration,omitempty"`
+
 	// When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside
 	// namespaces that match the label selector.
 	// The CPU limit will equal the number of requested vCPUs.
@@ -3135,6 +3774,11 @@ type KubeVirtConfiguration struct {
 	// +nullable
 	VirtTemplateDeployment *VirtTemplateDeployment `json:"virtTemplateDeployment,omitempty"`
 
+	// WorkflowAlertsDeployment controls the deployment of the alerting rules covering
+	// migration and storage workflow failures
+	// +nullable
+	WorkflowAlertsDeployment *WorkflowAlertsDeployment `json:"workflowAlertsDeployment,omitempty"`
+
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
@@ -3166,6 +3810,234 @@ type KubeVirtConfiguration struct {
 	// +optional
 	// +kubebuilder:validation:Enum=AggregateToDefault;Manual
 	RoleAggregationStrategy *RoleAggregationStrategy `json:"roleAggregationStrategy,omitempty"`
+
+	// EventConfiguration allows reducing the number of events emitted by virt-controller and virt-handler.
+	// +nullable
+	EventConfiguration *EventConfiguration `json:"eventConfiguration,omitempty"`
+
+	// DomainStatsConfiguration allows reducing the libvirt load caused by the domain statistics collection on dense nodes.
+	// +nullable
+	DomainStatsConfiguration *DomainStatsConfiguration `json:"domainStatsConfiguration,omitempty"`
+
+	// VMInfoMetrics controls the cardinality of the kubevirt_vm_metadata_info metric.
+	// +nullable
+	VMInfoMetrics *VMInfoMetricsConfiguration `json:"vmInfoMetrics,omitempty"`
+
+	// SubresourceConnectionLimits caps the console, VNC and port-forward connections virt-api proxies to virt-handler.
+	// Connections are not limited if not set.
+	// +nullable
+	SubresourceConnectionLimits *SubresourceConnectionLimits `json:"subresourceConnectionLimits,omitempty"`
+
+	// PoolMetricsAdapter makes virt-api serve the guest metrics of the VirtualMachinePools, as aggregated by
+	// Prometheus, through the external metrics API, so that the pools can be scaled on them by the
+	// HorizontalPodAutoscaler. The metrics are not served if not set.
+	// +nullable
+	PoolMetricsAdapter *PoolMetricsAdapterConfiguration `json:"poolMetricsAdapter,omitempty"`
+
+	// LauncherWatchdog enables the detection of hung virt-launchers by virt-handler.
+	// virt-launchers are not watched if not set.
+	// +nullable
+	LauncherWatchdog *LauncherWatchdogConfiguration `json:"launcherWatchdog,omitempty"`
+
+	// QEMUArgsPassthrough lists the QEMU command line arguments VirtualMachineInstances may append.
+	// No argument is allowed if not set. Requires the QEMUArgsPassthrough feature gate.
+	// +nullable
+	QEMUArgsPassthrough *QEMUArgsPassthroughConfiguration `json:"qemuArgsPassthrough,omitempty"`
+
+	// GuestAgentCommands restricts the qemu-guest-agent commands KubeVirt may invoke in the guests
+	// of all VirtualMachineInstances. All commands are allowed if not set.
+	// +nullable
+	GuestAgentCommands *GuestAgentCommandsConfiguration `json:"guestAgentCommands,omitempty"`
+
+	// GuestExec restricts the namespaces in which commands may be run in the guests with the guestexec
+	// subresource. Requires the GuestExec feature gate.
+	// +nullable
+	GuestExec *GuestExecConfiguration `json:"guestExec,omitempty"`
+
+	// LauncherReservations keep placeholder pods on the nodes, which hold capacity and pull the
+	// virt-launcher image. A starting VirtualMachineInstance claims one of them, the placeholder pod is
+	// deleted and the virt-launcher pod prefers its node. The virt-launcher pod is still created and
+	// started for the VirtualMachineInstance, the placeholder pods never run it.
+	// No placeholder pods are kept if not set.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	LauncherReservations []LauncherReservation `json:"launcherReservations,omitempty"`
+}
+
+// LauncherReservation keeps placeholder pods on the nodes matching its node selector.
+type LauncherReservation struct {
+	// Name identifies the reservation, its pods are labeled with it.
+	Name string `json:"name"`
+	// PodsPerNode is the number of placeholder pods kept on each node of the reservation.
+	PodsPerNode uint32 `json:"podsPerNode"`
+	// NodeSelector selects the nodes of the reservation. All schedulable nodes are selected if empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Instancetypes restricts the reservation to the VirtualMachineInstances created from one of these
+	// VirtualMachineClusterInstancetypes. Any VirtualMachineInstance may claim a pod if empty.
+	// +listType=set
+	// +optional
+	Instancetypes []string `json:"instancetypes,omitempty"`
+	// Resources are requested by each placeholder pod, to hold the capacity of the VirtualMachineInstance
+	// which claims it. The claimed pod is deleted to release them.
+	// +optional
+	Resources k8sv1.ResourceList `json:"resources,omitempty"`
+}
+
+// QEMUArgsPassthroughConfiguration holds the allowlist of the QEMU command line arguments.
+type QEMUArgsPassthroughConfiguration struct {
+	// AllowedArgs are the QEMU options VirtualMachineInstances may append to their QEMU command line.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AllowedArgs []AllowedQEMUArg `json:"allowedArgs,omitempty"`
+}
+
+// GuestAgentCommandsConfiguration restricts the qemu-guest-agent commands KubeVirt may invoke,
+// e.g. to disable guest-exec and guest-file-write. A denied command fails the feature relying on it,
+// and the guest information reported by a denied command is not used.
+type GuestAgentCommandsConfiguration struct {
+	// AllowedCommands are the only commands which may be invoked. All commands are allowed if empty.
+	// +listType=set
+	// +optional
+	AllowedCommands []string `json:"allowedCommands,omitempty"`
+	// DeniedCommands may never be invoked, even if they are allowed.
+	// +listType=set
+	// +optional
+	DeniedCommands []string `json:"deniedCommands,omitempty"`
+}
+
+// GuestExecConfiguration configures the guestexec subresource, which runs commands in the guests through the
+// guest agent.
+type GuestExecConfiguration struct {
+	// NamespaceSelector allows running commands in the guests of the VirtualMachineInstances in the namespaces
+	// matching the selector. Commands can't be run in any namespace if not set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// AllowedQEMUArg allows a QEMU option, optionally restricted to some values.
+type AllowedQEMUArg struct {
+	// Name is the QEMU option, including its leading dash, e.g. "-device".
+	Name string `json:"name"`
+	// ValuePrefixes restricts the values of the option to the ones starting with one of the prefixes,
+	// e.g. "pvpanic," only allows pvpanic devices. Any value is allowed if empty.
+	// +listType=atomic
+	// +optional
+	ValuePrefixes []string `json:"valuePrefixes,omitempty"`
+}
+
+// PoolMetricsAdapterConfiguration configures the source of the external metrics of the VirtualMachinePools.
+type PoolMetricsAdapterConfiguration struct {
+	// PrometheusURL is the URL of the Prometheus server evaluating the vmpool recording rules of KubeVirt,
+	// e.g. http://prometheus-k8s.monitoring:9090.
+	PrometheusURL string `json:"prometheusURL"`
+}
+
+// LauncherWatchdogConfiguration configures how virt-handler detects and recovers the virt-launchers
+// whose libvirt or QEMU process is hung.
+type LauncherWatchdogConfiguration struct {
+	// Timeout is how long the domain of a running VirtualMachineInstance may fail to report its
+	// statistics before its virt-launcher is considered hung. Defaults to 5 minutes.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Action is taken once a virt-launcher is considered hung. Alert only emits an event and
+	// increments the kubevirt_vmi_launcher_unresponsive_total metric, Fail additionally marks the
+	// VirtualMachineInstance as failed and Restart additionally kills the virt-launcher.
+	// Defaults to Alert.
+	// +optional
+	// +kubebuilder:validation:Enum=Alert;Fail;Restart
+	Action LauncherWatchdogAction `json:"action,omitempty"`
+}
+
+type LauncherWatchdogAction string
+
+const (
+	// LauncherWatchdogActionAlert emits an event and increments a metric
+	LauncherWatchdogActionAlert LauncherWatchdogAction = "Alert"
+	// LauncherWatchdogActionFail marks the VirtualMachineInstance as failed
+	LauncherWatchdogActionFail LauncherWatchdogAction = "Fail"
+	// LauncherWatchdogActionRestart kills the virt-launcher, so that its pod terminates
+	LauncherWatchdogActionRestart LauncherWatchdogAction = "Restart"
+)
+
+// SubresourceConnectionLimits holds the limits applied by virt-api to streaming subresource connections.
+// Rejected connections are answered with 429 Too Many Requests and a Retry-After header.
+type SubresourceConnectionLimits struct {
+	// MaxConnectionsPerUser is the maximum number of concurrent connections a single user can hold.
+	// +optional
+	MaxConnectionsPerUser *uint32 `json:"maxConnectionsPerUser,omitempty"`
+
+	// MaxConnectionsPerVMI is the maximum number of concurrent connections to a single VirtualMachineInstance.
+	// +optional
+	MaxConnectionsPerVMI *uint32 `json:"maxConnectionsPerVMI,omitempty"`
+
+	// ConnectionRateLimiter limits the rate at which a single user can open new connections.
+	// +optional
+	ConnectionRateLimiter *TokenBucketRateLimiter `json:"connectionRateLimiter,omitempty"`
+}
+
+// VMInfoMetricsConfiguration holds the settings of the kubevirt_vm_metadata_info metric.
+type VMInfoMetricsConfiguration struct {
+	// DisabledLabels lists the labels of the kubevirt_vm_metadata_info metric which are reported empty.
+	// Supported values are instance_type, preference, guest_os, run_strategy and owner.
+	// +optional
+	// +listType=set
+	DisabledLabels []VMInfoMetricsLabel `json:"disabledLabels,omitempty"`
+}
+
+type VMInfoMetricsLabel string
+
+const (
+	VMInfoMetricsLabelInstancetype VMInfoMetricsLabel = "instance_type"
+	VMInfoMetricsLabelPreference   VMInfoMetricsLabel = "preference"
+	VMInfoMetricsLabelGuestOS      VMInfoMetricsLabel = "guest_os"
+	VMInfoMetricsLabelRunStrategy  VMInfoMetricsLabel = "run_strategy"
+	VMInfoMetricsLabelOwner        VMInfoMetricsLabel = "owner"
+)
+
+// DomainStatsConfiguration holds the settings of the domain statistics collection.
+type DomainStatsConfiguration struct {
+	// CollectionInterval is how often virt-launcher refreshes the domain statistics from libvirt.
+	// Defaults to 3.25s. Changes only apply to VMIs started afterwards.
+	// +optional
+	CollectionInterval *metav1.Duration `json:"collectionInterval,omitempty"`
+
+	// DisabledCollectors lists the domain statistics which are neither collected from libvirt nor exposed as metrics.
+	// Supported values are block (per-disk), vcpu (per-vCPU) and network (per-interface).
+	// Disabling vcpu also removes the vCPU statistics from the downward metrics.
+	// The metrics are removed immediately, the collection from libvirt only stops for VMIs started afterwards.
+	// +optional
+	// +listType=set
+	DisabledCollectors []DomainStatsCollector `json:"disabledCollectors,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=block;vcpu;network
+type DomainStatsCollector string
+
+const (
+	DomainStatsCollectorBlock   DomainStatsCollector = "block"
+	DomainStatsCollectorVCPU    DomainStatsCollector = "vcpu"
+	DomainStatsCollectorNetwork DomainStatsCollector = "network"
+)
+
+// EventConfiguration holds the settings used to keep recurring events from flooding the cluster.
+type EventConfiguration struct {
+	// RateLimiter limits the rate at which each virt-controller and virt-handler instance emits events.
+	// Events beyond the limit are dropped.
+	// +optional
+	RateLimiter *TokenBucketRateLimiter `json:"rateLimiter,omitempty"`
+
+	// DeduplicationWindow drops an event if an event with the same type, reason and message
+	// was already emitted for the same object within the window.
+	// +optional
+	DeduplicationWindow *metav1.Duration `json:"deduplicationWindow,omitempty"`
+
+	// SuppressedReasons lists event reasons, e.g. FailedScheduling, which are never emitted.
+	// +optional
+	// +listType=set
+	SuppressedReasons []string `json:"suppressedReasons,omitempty"`
 }
 
 // QGSConfiguration holds QGS configuration
@@ -3221,6 +4093,21 @@ type InstancetypeConfiguration struct {
 	// +nullable
 	// +kubebuilder:validation:Enum=reference;expand;expandAll
 	ReferencePolicy *InstancetypeReferencePolicy `json:"referencePolicy,omitempty"`
+	// VirtioDrivers defines the source of the virtio-win drivers disk attached to Windows guests
+	// requesting it through autoattachVirtioDriversDisk.
+	// +nullable
+	VirtioDrivers *VirtioDriversConfiguration `json:"virtioDrivers,omitempty"`
+}
+
+// VirtioDriversConfiguration defines the source of the virtio-win drivers disk.
+type VirtioDriversConfiguration struct {
+	// Image is the containerDisk image providing the virtio-win drivers ISO.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// PersistentVolumeClaimName is the name of a PersistentVolumeClaim providing the virtio-win drivers ISO,
+	// looked up in the namespace of the VirtualMachine. Takes precedence over Image.
+	// +optional
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
 }
 
 type InstancetypeReferencePolicy string
@@ -3246,6 +4133,12 @@ type VirtTemplateDeployment struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type WorkflowAlertsDeployment struct {
+	// Enabled controls the deployment of the workflow alerting rules, defaults to False.
+	// +nullable
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
 // RoleAggregationStrategy represents the strategy for RBAC role aggregation
 type RoleAggregationStrategy string
 
@@ -3280,6 +4173,51 @@ type ArchSpecificConfiguration struct {
 	// +listType=atomic
 	EmulatedMachines []string `json:"emulatedMachines,omitempty,flow"`
 	MachineType      string   `json:"machineType,omitempty"`
+	// MachineTypeAliases maps logical machine type names, e.g. q35-stable, to the machine types they
+	// stand for on this architecture, e.g. pc-q35-rhel9.6.0. Aliases used as machine type of a VM or
+	// VMI, or as machineType, are replaced with the machine type they stand for on admission.
+	// +optional
+	MachineTypeAliases map[string]string `json:"machineTypeAliases,omitempty"`
+	// Firmware selects the EFI firmware images booted by the VMIs of this architecture.
+	// +optional
+	Firmware *EFIFirmwareConfiguration `json:"firmware,omitempty"`
+}
+
+// EFIFirmwareConfiguration selects the EFI firmware images per security feature. The file names are relative
+// to ovmfPath, or to the root of Image if set. The images which are not set are looked up under their default
+// names, e.g. OVMF_CODE.secboot.fd or AAVMF_CODE.fd.
+type EFIFirmwareConfiguration struct {
+	// Image is a container image holding the firmware images. It is mounted into virt-launcher instead of
+	// looking the images up in ovmfPath. Requires the ImageVolume feature gate.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Default is booted by VMIs without SecureBoot and confidential computing.
+	// +optional
+	Default *EFIFirmwareImages `json:"default,omitempty"`
+	// SecureBoot is booted by VMIs with SecureBoot enabled.
+	// +optional
+	SecureBoot *EFIFirmwareImages `json:"secureBoot,omitempty"`
+	// SEV is booted by SEV and SEV-ES VMIs.
+	// +optional
+	SEV *EFIFirmwareImages `json:"sev,omitempty"`
+	// SNP is booted by SEV-SNP VMIs. The firmware is stateless, Vars is ignored.
+	// +optional
+	SNP *EFIFirmwareImages `json:"snp,omitempty"`
+	// TDX is booted by TDX VMIs. The firmware is stateless, Vars is ignored.
+	// +optional
+	TDX *EFIFirmwareImages `json:"tdx,omitempty"`
+	// TDXSecureBoot is booted by TDX VMIs with SecureBoot enabled. The firmware is stateless, Vars is ignored.
+	// +optional
+	TDXSecureBoot *EFIFirmwareImages `json:"tdxSecureBoot,omitempty"`
+}
+
+// EFIFirmwareImages names the images of an EFI firmware.
+type EFIFirmwareImages struct {
+	// Code is the file name of the firmware code, e.g. OVMF_CODE.secboot.fd.
+	Code string `json:"code"`
+	// Vars is the file name of the template of the UEFI variable store, e.g. OVMF_VARS.secboot.fd.
+	// +optional
+	Vars string `json:"vars,omitempty"`
 }
 
 type SMBiosConfiguration struct {
@@ -3288,6 +4226,13 @@ type SMBiosConfiguration struct {
 	Version      string `json:"version,omitempty"`
 	Sku          string `json:"sku,omitempty"`
 	Family       string `json:"family,omitempty"`
+	// BaseBoard is the default baseboard information of the VMIs.
+	// +optional
+	BaseBoard *SMBIOSBaseBoard `json:"baseBoard,omitempty"`
+	// OEMStrings are the default OEM strings of the VMIs.
+	// +optional
+	// +listType=atomic
+	OEMStrings []string `json:"oemStrings,omitempty"`
 }
 
 type SupportContainerType string
@@ -3342,6 +4287,53 @@ type SeccompConfiguration struct {
 	VirtualMachineInstanceProfile *VirtualMachineInstanceProfile `json:"virtualMachineInstanceProfile,omitempty"`
 }
 
+// CPUModelsConfiguration holds the CPU models which the nodes offer to the VirtualMachineInstances.
+// Changes are reflected live on the node labels.
+type CPUModelsConfiguration struct {
+	// Obsolete lists CPU models which are not offered by the nodes, in addition to obsoleteCPUModels.
+	// +optional
+	// +listType=set
+	Obsolete []string `json:"obsolete,omitempty"`
+
+	// Allowed restricts the CPU models offered by the nodes to the listed ones.
+	// All the CPU models which are not obsolete are offered when empty.
+	// +optional
+	// +listType=set
+	Allowed []string `json:"allowed,omitempty"`
+
+	// MinModels sets the minimum CPU model per CPU vendor. The host model of the nodes which
+	// can't run the minimum CPU model of their vendor is obsolete, so that these nodes don't
+	// run host-model VirtualMachineInstances.
+	// +optional
+	// +listType=map
+	// +listMapKey=vendor
+	MinModels []VendorCPUModel `json:"minModels,omitempty"`
+}
+
+// NodeConfigurationOverride overrides the settings of the selected nodes.
+type NodeConfigurationOverride struct {
+	// NodeSelector selects the nodes the override applies to.
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// VirtualMachineInstancesPerNode overrides virtualMachineInstancesPerNode, the maximum number
+	// of VirtualMachineInstances running on each of the selected nodes.
+	// +optional
+	VirtualMachineInstancesPerNode *int `json:"virtualMachineInstancesPerNode,omitempty"`
+
+	// HandlerResources overrides the resources reserved for virt-handler on the selected nodes.
+	// virt-handler is resized in place, hence its QoS class can't change.
+	// +optional
+	HandlerResources *ResourceRequirementsWithoutClaims `json:"handlerResources,omitempty"`
+}
+
+// VendorCPUModel is a CPU model of a given CPU vendor.
+type VendorCPUModel struct {
+	// Vendor is the CPU vendor as reported by libvirt, e.g. Intel or AMD.
+	Vendor string `json:"vendor"`
+	// Model is the name of the CPU model.
+	Model string `json:"model"`
+}
+
 // VirtualMachineOptions holds the cluster level information regarding the virtual machine.
 type VirtualMachineOptions struct {
 	// DisableFreePageReporting disable the free page reporting of
@@ -3354,12 +4346,50 @@ type VirtualMachineOptions struct {
 	// If not set, serial console logs will be written to a file and then streamed from a container named `guest-console-log`.
 	// The value can be individually overridden for each VM, not relevant if AutoattachSerialConsole is disabled.
 	DisableSerialConsoleLog *DisableSerialConsoleLog `json:"disableSerialConsoleLog,omitempty"`
+
+	// SerialConsoleLogRotation limits the size of the serial console log streamed from the `guest-console-log` container.
+	// If not set, the log is rotated at 2Mi and three rotated logs are kept.
+	// +optional
+	SerialConsoleLogRotation *SerialConsoleLogRotation `json:"serialConsoleLogRotation,omitempty"`
+
+	// SerialConsoleLogFormat is the format of the serial console log streamed from the `guest-console-log` container.
+	// With `json`, every line of the serial console is written as a JSON object carrying the VMI, like the logs of
+	// the other KubeVirt components, so that log pipelines can parse and attribute it.
+	// Defaults to `text`, which writes the lines unchanged.
+	// +kubebuilder:validation:Enum=text;json
+	// +optional
+	SerialConsoleLogFormat SerialConsoleLogFormat `json:"serialConsoleLogFormat,omitempty"`
+
+	// CrashLoopBackoff configures the delay before restarting VMs whose VMIs failed shortly after being started.
+	// The fields can be individually overridden for each VM.
+	// +optional
+	CrashLoopBackoff *CrashLoopBackoff `json:"crashLoopBackoff,omitempty"`
 }
 
 type DisableFreePageReporting struct{}
 
 type DisableSerialConsoleLog struct{}
 
+// SerialConsoleLogRotation configures the rotation of the serial console log
+type SerialConsoleLogRotation struct {
+	// MaxSize is the size at which the serial console log is rotated.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// MaxBackups is the number of rotated serial console logs kept in the pod.
+	// +optional
+	MaxBackups *uint32 `json:"maxBackups,omitempty"`
+}
+
+// SerialConsoleLogFormat is the format of the serial console log
+type SerialConsoleLogFormat string
+
+const (
+	// SerialConsoleLogFormatText writes the lines of the serial console unchanged
+	SerialConsoleLogFormatText SerialConsoleLogFormat = "text"
+	// SerialConsoleLogFormatJSON writes every line of the serial console as a JSON object
+	SerialConsoleLogFormatJSON SerialConsoleLogFormat = "json"
+)
+
 // TLSConfiguration holds TLS options
 type TLSConfiguration struct {
 	// MinTLSVersion is a way to specify the minimum protocol version that is acceptable for TLS connections.
@@ -3430,6 +4460,17 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// EvictionShutdownPriorityThreshold makes VMIs with the LiveMigrateIfPossible eviction strategy
+	// and a priority lower than the threshold shut down on eviction instead of being migrated, so
+	// that the migration capacity of a drain goes to the more important VMIs first.
+	// The priority of a VMI is the one of the PriorityClass referenced by spec.priorityClassName.
+	// Unset by default, in which case all VMIs are migrated.
+	// +optional
+	EvictionShutdownPriorityThreshold *int32 `json:"evictionShutdownPriorityThreshold,omitempty"`
+	// EvictionMigrationTimeout is the maximum number of seconds VMIs with the LiveMigrateOrShutdown
+	// eviction strategy are tried to be migrated on eviction, before they are shutdown. Defaults to 600
+	// +optional
+	EvictionMigrationTimeout *int64 `json:"evictionMigrationTimeout,omitempty"`
 }
 
 // DiskVerification holds container disks verification limits
@@ -3509,6 +4550,10 @@ const (
 type PermittedHostDevices struct {
 	// +listType=atomic
 	PciHostDevices []PciHostDevice `json:"pciHostDevices,omitempty"`
+	// Rules exposing the host PCI devices they match, without listing each device
+	// +optional
+	// +listType=atomic
+	PciDiscoveryRules []PciDiscoveryRule `json:"pciDiscoveryRules,omitempty"`
 	// +listType=atomic
 	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
 	// +listType=atomic
@@ -3544,6 +4589,20 @@ type PciHostDevice struct {
 	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
 }
 
+// PciDiscoveryRule exposes the host PCI devices matching its selectors for passthrough
+type PciDiscoveryRule struct {
+	// A glob matching the vendor_id:product_id tuple of the PCI devices, e.g. 10de:*
+	PCIVendorSelector string `json:"pciVendorSelector"`
+	// The name of the driver the PCI devices are bound to, e.g. vfio-pci.
+	// Matches any driver allowed for passthrough when empty.
+	// +optional
+	Driver string `json:"driver,omitempty"`
+	// The name of the resource that is representing the matching devices. Exposed by
+	// a device plugin and requested by VMs. Typically of the form
+	// vendor.com/product_name
+	ResourceName string `json:"resourceName"`
+}
+
 // MediatedHostDevice represents a host mediated device allowed for passthrough
 type MediatedHostDevice struct {
 	MDEVNameSelector         string `json:"mdevNameSelector"`
@@ -3595,6 +4654,38 @@ type KSMConfiguration struct {
 	NodeLabelSelector *metav1.LabelSelector `json:"nodeLabelSelector,omitempty"`
 }
 
+// MemoryOvercommitConfiguration holds the cluster wide memory overcommit policies.
+// +k8s:openapi-gen=true
+type MemoryOvercommitConfiguration struct {
+	// Classes are named guest to requested memory ratios VMIs can select
+	// with spec.domain.memory.overcommitClass.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Classes []MemoryOvercommitClass `json:"classes,omitempty"`
+	// PressureThreshold is the percentage of node memory, swap included, in use
+	// above which the node is labeled as being under memory overcommit pressure.
+	// Defaults to 90.
+	// +optional
+	PressureThreshold *int `json:"pressureThreshold,omitempty"`
+}
+
+// MemoryOvercommitClass defines a named memory overcommit policy.
+// +k8s:openapi-gen=true
+type MemoryOvercommitClass struct {
+	// Name of the class, referenced by the VMIs.
+	Name string `json:"name"`
+	// MemoryOvercommit is the percentage of memory given to the guest compared to
+	// the amount requested by the virt-launcher pod.
+	MemoryOvercommit int `json:"memoryOvercommit"`
+	// Swap allows the memory of VMIs of this class to be backed by swap.
+	// Such VMIs are only scheduled to nodes labeled with kubevirt.io/swap-enabled,
+	// and are live migrated instead of being killed when evicted, unless they
+	// define their own eviction strategy.
+	// +optional
+	Swap bool `json:"swap,omitempty"`
+}
+
 // NetworkConfiguration holds network options
 type NetworkConfiguration struct {
 	NetworkInterface string `json:"defaultNetworkInterface,omitempty"`
@@ -3687,6 +4778,12 @@ const (
 type GuestAgentPing struct {
 }
 
+// GuestAgentFileExists configures the guest-agent based file existence probe
+type GuestAgentFileExists struct {
+	// Path is the absolute path of the file in the guest.
+	Path string `json:"path"`
+}
+
 type ProfilerResult struct {
 	PprofData map[string][]byte `json:"pprofData,omitempty"`
 }
@@ -18,29 +18,58 @@ func (VirtualMachineInstanceList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                              "VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.",
-		"priorityClassName":             "If specified, indicates the pod's priority.\nIf not specified, the pod priority will be default or zero if there is no\ndefault.\n+optional",
-		"domain":                        "Specification of the desired behavior of the VirtualMachineInstance on the host.",
-		"nodeSelector":                  "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/\n+optional",
-		"affinity":                      "If affinity is specifies, obey all the affinity rules",
-		"schedulerName":                 "If specified, the VMI will be dispatched by specified scheduler.\nIf not specified, the VMI will be dispatched by default scheduler.\n+optional",
-		"tolerations":                   "If toleration is specified, obey all the toleration rules.",
-		"topologySpreadConstraints":     "TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology\ndomains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.\n+optional\n+patchMergeKey=topologyKey\n+patchStrategy=merge\n+listType=map\n+listMapKey=topologyKey\n+listMapKey=whenUnsatisfiable",
-		"evictionStrategy":              "EvictionStrategy describes the strategy to follow when a node drain occurs.\nThe possible options are:\n- \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.\n- \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown.\n- \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\".\n- \"External\": the VirtualMachineInstance will be protected and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.\n+optional",
-		"startStrategy":                 "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.\n\n+optional",
-		"terminationGracePeriodSeconds": "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
-		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
-		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
-		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
-		"hostname":                      "Specifies the hostname of the vmi\nIf not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.\n+optional",
-		"subdomain":                     "If specified, the fully qualified vmi hostname will be \"<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>\".\nIf not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,\nno matter if the vmi itself can pick up a hostname.\n+optional",
-		"networks":                      "List of networks that can be attached to a vm's virtual interface.\n+kubebuilder:validation:MaxItems:=256",
-		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
-		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
-		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
-		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
-		"resourceClaims":                "ResourceClaims define which ResourceClaims must be allocated\nand reserved before the VMI, hence virt-launcher pod is allowed to start. The resources\nwill be made available to the domain which consumes them\nby name.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate in kubernetes\n https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/\nThis field should only be configured if one of the feature-gates GPUsWithDRA, HostDevicesWithDRA,\nor NetworkDevicesWithDRA is enabled.\nThis feature is in alpha.\n\n+listType=map\n+listMapKey=name\n+optional",
-		"utilityVolumes":                "List of utility volumes that can be mounted to the vmi virt-launcher pod\nwithout having a matching disk in the domain.\nUsed to collect data for various operational workflows.\n+kubebuilder:validation:MaxItems:=256\n+listType=map\n+listMapKey=name\n+optional",
+		"":                                "VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.",
+		"priorityClassName":               "If specified, indicates the pod's priority.\nIf not specified, the pod priority will be default or zero if there is no\ndefault.\n+optional",
+		"domain":                          "Specification of the desired behavior of the VirtualMachineInstance on the host.",
+		"nodeSelector":                    "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/\n+optional",
+		"affinity":                        "If affinity is specifies, obey all the affinity rules",
+		"schedulerName":                   "If specified, the VMI will be dispatched by specified scheduler.\nIf not specified, the VMI will be dispatched by default scheduler.\n+optional",
+		"tolerations":                     "If toleration is specified, obey all the toleration rules.",
+		"topologySpreadConstraints":       "TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology\ndomains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.\n+optional\n+patchMergeKey=topologyKey\n+patchStrategy=merge\n+listType=map\n+listMapKey=topologyKey\n+listMapKey=whenUnsatisfiable",
+		"evictionStrategy":                "EvictionStrategy describes the strategy to follow when a node drain occurs.\nThe possible options are:\n- \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.\n- \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown.\n- \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\".\n- \"LiveMigrateOrShutdown\": the same as \"LiveMigrateIfPossible\", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout.\n- \"External\": the VirtualMachineInstance will be protected and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.\n+optional",
+		"evictionMigrationTimeoutSeconds": "EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be\nmigrated on eviction with the \"LiveMigrateOrShutdown\" eviction strategy, before it is shutdown.\nOverrides the cluster wide evictionMigrationTimeout of the migration configuration.\n+optional",
+		"startStrategy":                   "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.\n\n+optional",
+		"terminationGracePeriodSeconds":   "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
+		"preShutdownHook":                 "PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the\napplications of the guest, e.g. to let a database stop cleanly.\n+optional",
+		"volumes":                         "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"livenessProbe":                   "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
+		"readinessProbe":                  "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
+		"hostname":                        "Specifies the hostname of the vmi\nIf not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.\n+optional",
+		"subdomain":                       "If specified, the fully qualified vmi hostname will be \"<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>\".\nIf not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,\nno matter if the vmi itself can pick up a hostname.\n+optional",
+		"networks":                        "List of networks that can be attached to a vm's virtual interface.\n+kubebuilder:validation:MaxItems:=256",
+		"dnsPolicy":                       "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
+		"dnsConfig":                       "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
+		"accessCredentials":               "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
+		"architecture":                    "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
+		"resourceClaims":                  "ResourceClaims define which ResourceClaims must be allocated\nand reserved before the VMI, hence virt-launcher pod is allowed to start. The resources\nwill be made available to the domain which consumes them\nby name.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate in kubernetes\n https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/\nThis field should only be configured if one of the feature-gates GPUsWithDRA, HostDevicesWithDRA,\nor NetworkDevicesWithDRA is enabled.\nThis feature is in alpha.\n\n+listType=map\n+listMapKey=name\n+optional",
+		"utilityVolumes":                  "List of utility volumes that can be mounted to the vmi virt-launcher pod\nwithout having a matching disk in the domain.\nUsed to collect data for various operational workflows.\n+kubebuilder:validation:MaxItems:=256\n+listType=map\n+listMapKey=name\n+optional",
+		"guestAgentCommands":              "GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in\nthe guest. A command must be allowed by both this and the cluster wide configuration.\n+optional",
+		"ignition":                        "Ignition provisions CoreOS-family guests with an Ignition config. Unlike the\nkubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and\nbe made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.\n+optional",
+	}
+}
+
+func (Ignition) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "Ignition is the Ignition config of a CoreOS-family guest.",
+		"fragments":   "Fragments make up the Ignition config. A single fragment is passed to the guest as is, several\nfragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.\n+kubebuilder:validation:MinItems:=1\n+kubebuilder:validation:MaxItems:=16\n+listType=atomic",
+		"propagation": "Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig.\nConfigDrive requires a cloudInitConfigDrive volume without user data.\n+kubebuilder:validation:Enum=FirmwareConfig;ConfigDrive\n+optional",
+	}
+}
+
+func (IgnitionFragment) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "IgnitionFragment is a part of the Ignition config. Exactly one of its sources must be set.",
+		"data":       "Data is an inline Ignition config.\n+optional",
+		"volumeName": "VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance,\nholding the Ignition config under the config.ign key.\n+optional",
+	}
+}
+
+func (PreShutdownHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "PreShutdownHook is a command executed in the guest through the qemu-guest-agent when the\nVirtualMachineInstance is stopped. The termination grace period is split between the hook and\nthe ACPI shutdown: the ACPI shutdown is signaled once the hook exited or timed out, and gets\nthe rest of the grace period. The ACPI shutdown is signaled right away if the hook can not be\nexecuted, e.g. because the guest agent is not connected.",
+		"command":        "Command is the path of the executable in the guest, e.g. a script stopping a database.",
+		"args":           "Args are passed to the command.\n+optional",
+		"timeoutSeconds": "TimeoutSeconds is the part of the termination grace period reserved for the hook.\nIt must be shorter than terminationGracePeriodSeconds. Defaults to 10.\n+optional",
 	}
 }
 
@@ -74,6 +103,7 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"phaseTransitionTimestamps":     "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"interfaces":                    "Interfaces represent the details of available network interfaces.",
 		"guestOSInfo":                   "Guest OS Information",
+		"guestAgentData":                "GuestAgentData contains the information reported by the guest agent along with the time it was reported.\nIt is kept when the guest agent stops responding, see the GuestAgentDataStale condition.\n+optional",
 		"migrationState":                "Represents the status of a live migration",
 		"migrationMethod":               "Represents the method using which the vmi can be migrated: live migration or block migration",
 		"migrationTransport":            "This represents the migration transport",
@@ -223,6 +253,32 @@ func (VirtualMachineInstanceGuestOSInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestAgentData) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "GuestAgentData is the information reported by the guest agent",
+		"os":       "OS is the guest operating system information\n+optional",
+		"hostname": "Hostname is the hostname of the guest\n+optional",
+		"fqdn":     "FQDN is the fully qualified domain name of the guest, reported when the hostname of the guest is fully qualified\n+optional",
+		"timezone": "Timezone is the timezone of the guest, as name and offset to UTC in seconds\n+optional",
+	}
+}
+
+func (GuestAgentOSInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "GuestAgentOSInfo is the guest operating system information reported by the guest agent",
+		"info":        "Info is the guest operating system information",
+		"lastUpdated": "LastUpdated is the time the guest agent reported the current information",
+	}
+}
+
+func (GuestAgentValue) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "GuestAgentValue is a value reported by the guest agent",
+		"value":       "Value is the value reported by the guest agent",
+		"lastUpdated": "LastUpdated is the time the guest agent reported the current value",
+	}
+}
+
 func (VirtualMachineInstanceCommonMigrationState) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"node":                      "The source node that the VMI originated on",
@@ -448,12 +504,71 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"schedule":              "Schedule starts and stops the VirtualMachine at recurring times.\nRequires the VirtualMachineSchedule feature gate.\n+optional",
+		"dependsOn":             "DependsOn lists VirtualMachines of the same namespace which must be ready before this\nVirtualMachine is started. Requires the VirtualMachineDependencies feature gate.\n+optional\n+listType=atomic",
+		"crashLoopBackoff":      "CrashLoopBackoff configures the delay before restarting the VirtualMachine after its\nVirtualMachineInstance failed shortly after being started. Each field overrides the\ncluster wide one.\n+optional",
+		"revisionHistoryLimit":  "RevisionHistoryLimit is the number of old revisions of the spec to keep for rollbacks.\nRevisions are only recorded with the VirtualMachineRevisionHistory feature gate.\nDefaults to 10.\n+optional",
+	}
+}
+
+func (VirtualMachineDependency) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineDependency references a VirtualMachine which must be ready before the\ndepending VirtualMachine is started",
+		"name": "Name is the name of the VirtualMachine",
+	}
+}
+
+func (VirtualMachineSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSchedule starts and stops a VirtualMachine at recurring times, e.g. to stop\ndevelopment VMs at night and start them again in the morning.",
+		"start":           "Start is a cron expression, in the standard five fields format, at which the VirtualMachine is started.\n+optional",
+		"stop":            "Stop is a cron expression, in the standard five fields format, at which the VirtualMachine is stopped.\n+optional",
+		"timeZone":        "TimeZone is the IANA name of the time zone the cron expressions are evaluated in. Defaults to UTC.\n+optional",
+		"skipStopIfInUse": "SkipStopIfInUse skips scheduled stops while the VirtualMachine is in use, that is while users\nare logged in the guest, or while it is migrating or being backed up. Defaults to false.\n+optional",
+	}
+}
+
+func (VirtualMachineScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineScheduleStatus reports the progress of the schedule of a VirtualMachine",
+		"lastScheduleTime": "LastScheduleTime is the last start or stop time which was handled, or the time the schedule\nwas first evaluated at.\n+optional",
+		"nextStartTime":    "NextStartTime is the next time the VirtualMachine is scheduled to start.\n+optional",
+		"nextStopTime":     "NextStopTime is the next time the VirtualMachine is scheduled to stop.\n+optional",
 	}
 }
 
 func (VirtualMachineStartFailure) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "VirtualMachineStartFailure tracks VMIs which failed to transition successfully\nto running using the VM status",
+		"":                     "VirtualMachineStartFailure tracks VMIs which failed to transition successfully\nto running using the VM status",
+		"consecutiveFailCount": "ConsecutiveFailCount is the number of VirtualMachineInstances which failed in a row",
+		"lastFailedVMIUID":     "LastFailedVMIUID is the UID of the last VirtualMachineInstance which failed",
+		"retryAfterTimestamp":  "RetryAfterTimestamp is the time after which the VirtualMachine is started again",
+	}
+}
+
+func (VirtualMachineCompletion) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineCompletion records the final state of the VirtualMachineInstance of a\nVirtualMachine with the Once run strategy",
+		"phase":          "Phase is the final phase the VirtualMachineInstance reached, either Succeeded or Failed",
+		"completionTime": "CompletionTime is the time the completion was observed\n+optional",
+	}
+}
+
+func (VirtualMachineSuspendStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineSuspendStatus tracks the suspension of a VirtualMachine to disk",
+		"phase":       "Phase is the phase of the suspension",
+		"vmiUID":      "VMIUID is the UID of the VirtualMachineInstance which got suspended\n+optional",
+		"suspendTime": "SuspendTime is the time the guest memory was saved\n+optional",
+	}
+}
+
+func (CrashLoopBackoff) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "CrashLoopBackoff configures the delay before restarting a VirtualMachine whose VirtualMachineInstance\nfailed before reaching the Running phase, or before having run for the reset window",
+		"baseDelay":   "BaseDelay is the delay after the first failure. The delay after n consecutive failures is the\nbase delay multiplied by the square of n. Defaults to a thirtieth of MaxDelay, and at least 10 seconds.\n+optional",
+		"maxDelay":    "MaxDelay caps the delay. Defaults to 5 minutes.\n+optional",
+		"resetWindow": "ResetWindow is how long a VirtualMachineInstance has to run for the consecutive failures to be\nforgotten. Defaults to 0, forgetting them as soon as the VirtualMachineInstance is running.\n+optional",
 	}
 }
 
@@ -478,6 +593,9 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"changedBlockTracking":   "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
+		"schedule":               "Schedule reports the progress of the schedule of the VirtualMachine\n+nullable\n+optional",
+		"completion":             "Completion is set once the VirtualMachineInstance of a VirtualMachine with the Once\nrun strategy reached a final phase. The VirtualMachine is not started again until\nthe run strategy is changed.\n+nullable\n+optional",
+		"suspend":                "Suspend tracks the suspension of the VirtualMachine to disk. The VirtualMachine is not\nstarted while it is suspended, until it is resumed.\n+nullable\n+optional",
 	}
 }
 
@@ -564,11 +682,12 @@ func (VirtualMachineCondition) SwaggerDoc() map[string]string {
 
 func (Handler) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "Handler defines a specific action that should be taken",
-		"exec":           "One and only one of the following should be specified.\nExec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.\nIf the guest agent is not available, this probe will fail.\n+optional",
-		"guestAgentPing": "GuestAgentPing contacts the qemu-guest-agent for availability checks.\nProbe failures are automatically suppressed when the guest agent is\nunreachable for a non-fault reason: during live migration (guest paused\non one pod while memory is transferred) and whenever the VM is paused\nfor an intentional or transient reason such as a user pause, snapshot,\nsave, or dump. Failures are not suppressed when the VM is paused due to\na fault (IO error, crash, or postcopy failure).\n+optional",
-		"httpGet":        "HTTPGet specifies the http request to perform.\n+optional",
-		"tcpSocket":      "TCPSocket specifies an action involving a TCP port.\nTCP hooks not yet supported\n+optional",
+		"":                     "Handler defines a specific action that should be taken",
+		"exec":                 "One and only one of the following should be specified.\nExec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.\nIf the guest agent is not available, this probe will fail.\n+optional",
+		"guestAgentPing":       "GuestAgentPing contacts the qemu-guest-agent for availability checks.\nProbe failures are automatically suppressed when the guest agent is\nunreachable for a non-fault reason: during live migration (guest paused\non one pod while memory is transferred) and whenever the VM is paused\nfor an intentional or transient reason such as a user pause, snapshot,\nsave, or dump. Failures are not suppressed when the VM is paused due to\na fault (IO error, crash, or postcopy failure).\n+optional",
+		"guestAgentFileExists": "GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest.\nIf the guest agent is not available, this probe will fail.\n+optional",
+		"httpGet":              "HTTPGet specifies the http request to perform.\n+optional",
+		"tcpSocket":            "TCPSocket specifies an action involving a TCP port.\nTCP hooks not yet supported\n+optional",
 	}
 }
 
@@ -619,10 +738,40 @@ func (KubeVirtCertificateRotateStrategy) SwaggerDoc() map[string]string {
 
 func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                      "KubeVirtWorkloadUpdateStrategy defines options related to updating a KubeVirt install",
-		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
-		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
-		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"":                       "KubeVirtWorkloadUpdateStrategy defines options related to updating a KubeVirt install",
+		"workloadUpdateMethods":  "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
+		"batchEvictionSize":      "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
+		"batchEvictionInterval":  "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"namespaceSelector":      "NamespaceSelector limits automated workload updates to the VMIs in the namespaces\nmatching the selector. Namespaces can opt out with a label excluded by the selector.\nThe VMIs of other namespaces are only counted as outdated.\n\nDefaults to all namespaces\n\n+optional",
+		"maxUpdatesPerNamespace": "MaxUpdatesPerNamespace is the maximum number of VMIs of a single namespace which are\nmigrated or evicted at the same time\n\nDefaults to no limit\n\n+optional",
+		"windows":                "Windows restricts automated workload updates to recurring time windows. Migrations\nand evictions are only started while a window is open.\n\nAn empty list allows workload updates at any time\n\n+listType=atomic\n+optional",
+	}
+}
+
+func (WorkloadUpdateWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "WorkloadUpdateWindow is a recurring time window in which automated workload updates are started",
+		"start":    "Start is a cron expression, in the standard five fields format, at which the window opens.",
+		"duration": "Duration is how long the window stays open.",
+		"timeZone": "TimeZone is the IANA name of the time zone the cron expression is evaluated in. Defaults to UTC.\n+optional",
+	}
+}
+
+func (KubeVirtRolloutStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "KubeVirtRolloutStrategy defines how updates of the KubeVirt components are rolled out",
+		"virtHandler": "VirtHandler rolls updates of virt-handler out node pool by node pool.\nBy default virt-handler is updated on a single canary node first and on 10% of the nodes at a time afterwards.\n+optional",
+	}
+}
+
+func (NodePoolRolloutStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "NodePoolRolloutStrategy rolls an update out node pool by node pool",
+		"nodePoolLabel":   "NodePoolLabel is the key of the node label which groups the nodes into pools.\nThe pools are updated one after another, ordered by the label value. Nodes without the label are updated last.",
+		"maxUnavailable":  "MaxUnavailable is the maximum number of nodes of a pool which are updated at once.\n\nDefaults to 1\n\n+optional",
+		"poolSoakSeconds": "PoolSoakSeconds is the time the updated pods have to be ready before the next pool is updated.\n\nDefaults to 60\n\n+optional",
+		"errorBudget":     "ErrorBudget is the number of updated pods which may fail before the rollout is paused.\nThe rollout resumes once the failed pods recover.\n\nDefaults to 0\n\n+optional",
+		"paused":          "Paused pauses the rollout\n+optional",
 	}
 }
 
@@ -637,6 +786,7 @@ func (KubeVirtSpec) SwaggerDoc() map[string]string {
 		"monitorAccount":          "The name of the Prometheus service account that needs read-access to KubeVirt endpoints\nDefaults to prometheus-k8s",
 		"workloadUpdateStrategy":  "WorkloadUpdateStrategy defines at the cluster level how to handle\nautomated workload updates",
 		"uninstallStrategy":       "Specifies if kubevirt can be deleted if workloads are still present.\nThis is mainly a precaution to avoid accidental data loss",
+		"rolloutStrategy":         "RolloutStrategy defines how updates of the KubeVirt components are rolled out\n+optional",
 		"productVersion":          "Designate the apps.kubevirt.io/version label for KubeVirt components.\nUseful if KubeVirt is included as part of a product.\nIf ProductVersion is not specified, KubeVirt's version will be used.",
 		"productName":             "Designate the apps.kubevirt.io/part-of label for KubeVirt components.\nUseful if KubeVirt is included as part of a product.\nIf ProductName is not specified, the part-of label will be omitted.",
 		"productComponent":        "Designate the apps.kubevirt.io/component label for KubeVirt components.\nUseful if KubeVirt is included as part of a product.\nIf ProductComponent is not specified, the component label default value is kubevirt.",
@@ -684,6 +834,18 @@ func (KubeVirtStatus) SwaggerDoc() map[string]string {
 		"":                         "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
 		"generations":              "+listType=atomic",
 		"synchronizationAddresses": "+optional\n+listType=atomic",
+		"featureGates":             "FeatureGates reports the lifecycle of the feature gates known to the deployed KubeVirt version\n+optional\n+listType=atomic",
+	}
+}
+
+func (FeatureGateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "FeatureGateStatus reports the lifecycle of a feature gate",
+		"name":            "Name of the feature gate",
+		"stage":           "Stage of the feature gate",
+		"default":         "Default tells whether the feature is enabled when the feature gate is neither enabled nor disabled explicitly",
+		"lockedToDefault": "LockedToDefault tells whether the feature gate can no longer be enabled or disabled, since the feature graduated or was discontinued",
+		"enabled":         "Enabled tells whether the feature is enabled in the cluster",
 	}
 }
 
@@ -748,6 +910,28 @@ func (EvacuateCancelOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SuspendOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "SuspendOptions may be provided on suspend request.",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (ResumeOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "ResumeOptions may be provided on resume request.",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (RollbackOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "RollbackOptions may be provided on rollback request.",
+		"dryRun":   "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"revision": "Revision is the revision to roll back to. Defaults to the revision preceding the current one.\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -759,6 +943,8 @@ func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 		"userList":          "UserList is a list of active guest OS users",
 		"fsInfo":            "FSInfo is a guest os filesystem information containing the disk mapping and disk mounts with usage",
 		"fsFreezeStatus":    "FSFreezeStatus indicates whether a freeze operation was requested for the guest filesystem.\nIt will be set to \"frozen\" if the request was made, or unset otherwise.\nThis does not reflect the actual state of the guest filesystem.",
+		"lastPolled":        "LastPolled is the time the guest agent last reported the guest OS information\n+optional",
+		"pollError":         "PollError is set when the last poll of the guest OS information failed or timed out\n+optional",
 	}
 }
 
@@ -795,7 +981,8 @@ func (VirtualMachineInstanceFileSystemList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceFileSystemDisk) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "VirtualMachineInstanceFileSystemDisk represents the guest os FS disks",
+		"":           "VirtualMachineInstanceFileSystemDisk represents the guest os FS disks",
+		"volumeName": "VolumeName is the name of the VMI volume backing the disk, when it can be resolved\n+optional",
 	}
 }
 
@@ -805,6 +992,32 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceDomainXML) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "VirtualMachineInstanceDomainXML represents the libvirt domain of a running VirtualMachineInstance\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"domainXML":             "DomainXML is the live libvirt domain XML of the VirtualMachineInstance",
+		"domainCapabilitiesXML": "DomainCapabilitiesXML is the libvirt domain capabilities XML of the node, for the emulator,\narchitecture and machine type of the VirtualMachineInstance",
+	}
+}
+
+func (VirtualMachineInstanceGuestFile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineInstanceGuestFile represents a file inside the guest of a running VirtualMachineInstance\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"path":    "Path is the absolute path of the file inside the guest",
+		"content": "Content is the content of the file, at most 1MiB",
+	}
+}
+
+func (VirtualMachineInstanceGuestExecOutput) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineInstanceGuestExecOutput is streamed by the guestexec subresource as a sequence of JSON objects.\nThe last one holds the exit code of the command, or the error which prevented getting it.",
+		"stdout":   "Stdout is data written by the command to its standard output",
+		"stderr":   "Stderr is data written by the command to its standard error",
+		"exitCode": "ExitCode is the exit code of the command, set once it exited",
+		"error":    "Error is set when the command could not be run or did not exit before the timeout",
+	}
+}
+
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
@@ -842,6 +1055,14 @@ func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (GuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestExecOptions are provided when running a command in the guest with the guestexec subresource",
+		"command":        "Command is the path of the executable in the guest followed by its arguments\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is how long to wait for the command to exit\n+optional",
+	}
+}
+
 func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
@@ -850,6 +1071,23 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InsertMediaOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InsertMediaOptions is provided when inserting or changing the media of a CD-ROM drive",
+		"name":         "Name is the name of the CD-ROM disk the media is inserted into",
+		"volumeSource": "VolumeSource represents the source of the media",
+		"dryRun":       "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (EjectMediaOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "EjectMediaOptions is provided when ejecting the media of a CD-ROM drive",
+		"name":   "Name is the name of the CD-ROM disk the media is ejected from",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -886,19 +1124,122 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"supportContainerResources":          "+listType=map\n+listMapKey=type\nSupportContainerResources specifies the resource requirements for various types of supporting containers such as container disks/virtiofs/sidecars and hotplug attachment pods. If omitted a sensible default will be supplied.",
 		"supportedGuestAgentVersions":        "deprecated",
 		"minCPUModel":                        "deprecated",
+		"cpuModels":                          "CPUModels configures the CPU models which the nodes offer to the VirtualMachineInstances.\n+optional",
+		"nodeOverrides":                      "NodeOverrides override the settings of the nodes matching their node selector, for clusters\nmade of heterogeneous nodes. The first matching override applies to a node.\n+optional\n+listType=atomic",
 		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.",
 		"ksmConfiguration":                   "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
+		"memoryOvercommitConfiguration":      "MemoryOvercommitConfiguration holds the memory overcommit classes VMIs can opt into\nand the settings for running VMIs on swap enabled nodes.\n+nullable",
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
 		"liveUpdateConfiguration":            "LiveUpdateConfiguration holds defaults for live update features",
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how live-updatable fields, like CPU sockets, memory,\ntolerations, and affinity, are propagated from a VM to its VMI.\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"virtTemplateDeployment":             "VirtTemplateDeployment controls the deployment of virt-template components\n+nullable",
+		"workflowAlertsDeployment":           "WorkflowAlertsDeployment controls the deployment of the alerting rules covering\nmigration and storage workflow failures\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
 		"hypervisors":                        "Hypervisors holds information regarding the hypervisor configurations supported on this cluster.\n+listType=atomic\n+kubebuilder:validation:MaxItems:=1",
 		"changedBlockTrackingLabelSelectors": "ChangedBlockTrackingLabelSelectors defines label selectors. VMs matching these selectors will have changed block tracking enabled.\nEnabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.\n+nullable",
 		"persistentReservationConfiguration": "PersistentReservationConfiguration controls the deployment of additional resources required for using SCSI persistent reservation in VMs\n+nullable",
 		"confidentialCompute":                "QGS configuration for attestation on the Intel TDX Platform\n+nullable",
 		"roleAggregationStrategy":            "RoleAggregationStrategy controls whether RBAC cluster roles should be aggregated\nto the default Kubernetes roles (admin, edit, view).\nWhen set to \"AggregateToDefault\" (default) or not specified, the aggregate-to-* labels are added to the cluster roles.\nWhen set to \"Manual\", the labels are not added, and roles will not be aggregated to the default roles.\nSetting this field to \"Manual\" requires the OptOutRoleAggregation feature gate to be enabled.\nThis is an Alpha feature and subject to change.\n+optional\n+kubebuilder:validation:Enum=AggregateToDefault;Manual",
+		"eventConfiguration":                 "EventConfiguration allows reducing the number of events emitted by virt-controller and virt-handler.\n+nullable",
+		"domainStatsConfiguration":           "DomainStatsConfiguration allows reducing the libvirt load caused by the domain statistics collection on dense nodes.\n+nullable",
+		"vmInfoMetrics":                      "VMInfoMetrics controls the cardinality of the kubevirt_vm_metadata_info metric.\n+nullable",
+		"subresourceConnectionLimits":        "SubresourceConnectionLimits caps the console, VNC and port-forward connections virt-api proxies to virt-handler.\nConnections are not limited if not set.\n+nullable",
+		"poolMetricsAdapter":                 "PoolMetricsAdapter makes virt-api serve the guest metrics of the VirtualMachinePools, as aggregated by\nPrometheus, through the external metrics API, so that the pools can be scaled on them by the\nHorizontalPodAutoscaler. The metrics are not served if not set.\n+nullable",
+		"launcherWatchdog":                   "LauncherWatchdog enables the detection of hung virt-launchers by virt-handler.\nvirt-launchers are not watched if not set.\n+nullable",
+		"qemuArgsPassthrough":                "QEMUArgsPassthrough lists the QEMU command line arguments VirtualMachineInstances may append.\nNo argument is allowed if not set. Requires the QEMUArgsPassthrough feature gate.\n+nullable",
+		"guestAgentCommands":                 "GuestAgentCommands restricts the qemu-guest-agent commands KubeVirt may invoke in the guests\nof all VirtualMachineInstances. All commands are allowed if not set.\n+nullable",
+		"guestExec":                          "GuestExec restricts the namespaces in which commands may be run in the guests with the guestexec\nsubresource. Requires the GuestExec feature gate.\n+nullable",
+		"launcherReservations":               "LauncherReservations keep placeholder pods on the nodes, which hold capacity and pull the\nvirt-launcher image. A starting VirtualMachineInstance claims one of them, the placeholder pod is\ndeleted and the virt-launcher pod prefers its node. The virt-launcher pod is still created and\nstarted for the VirtualMachineInstance, the placeholder pods never run it.\nNo placeholder pods are kept if not set.\n+listType=map\n+listMapKey=name\n+optional",
+	}
+}
+
+func (LauncherReservation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "LauncherReservation keeps placeholder pods on the nodes matching its node selector.",
+		"name":          "Name identifies the reservation, its pods are labeled with it.",
+		"podsPerNode":   "PodsPerNode is the number of placeholder pods kept on each node of the reservation.",
+		"nodeSelector":  "NodeSelector selects the nodes of the reservation. All schedulable nodes are selected if empty.\n+optional",
+		"instancetypes": "Instancetypes restricts the reservation to the VirtualMachineInstances created from one of these\nVirtualMachineClusterInstancetypes. Any VirtualMachineInstance may claim a pod if empty.\n+listType=set\n+optional",
+		"resources":     "Resources are requested by each placeholder pod, to hold the capacity of the VirtualMachineInstance\nwhich claims it. The claimed pod is deleted to release them.\n+optional",
+	}
+}
+
+func (QEMUArgsPassthroughConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "QEMUArgsPassthroughConfiguration holds the allowlist of the QEMU command line arguments.",
+		"allowedArgs": "AllowedArgs are the QEMU options VirtualMachineInstances may append to their QEMU command line.\n+listType=map\n+listMapKey=name\n+optional",
+	}
+}
+
+func (GuestAgentCommandsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "GuestAgentCommandsConfiguration restricts the qemu-guest-agent commands KubeVirt may invoke,\ne.g. to disable guest-exec and guest-file-write. A denied command fails the feature relying on it,\nand the guest information reported by a denied command is not used.",
+		"allowedCommands": "AllowedCommands are the only commands which may be invoked. All commands are allowed if empty.\n+listType=set\n+optional",
+		"deniedCommands":  "DeniedCommands may never be invoked, even if they are allowed.\n+listType=set\n+optional",
+	}
+}
+
+func (GuestExecConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "GuestExecConfiguration configures the guestexec subresource, which runs commands in the guests through the\nguest agent.",
+		"namespaceSelector": "NamespaceSelector allows running commands in the guests of the VirtualMachineInstances in the namespaces\nmatching the selector. Commands can't be run in any namespace if not set.\n+optional",
+	}
+}
+
+func (AllowedQEMUArg) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "AllowedQEMUArg allows a QEMU option, optionally restricted to some values.",
+		"name":          "Name is the QEMU option, including its leading dash, e.g. \"-device\".",
+		"valuePrefixes": "ValuePrefixes restricts the values of the option to the ones starting with one of the prefixes,\ne.g. \"pvpanic,\" only allows pvpanic devices. Any value is allowed if empty.\n+listType=atomic\n+optional",
+	}
+}
+
+func (PoolMetricsAdapterConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "PoolMetricsAdapterConfiguration configures the source of the external metrics of the VirtualMachinePools.",
+		"prometheusURL": "PrometheusURL is the URL of the Prometheus server evaluating the vmpool recording rules of KubeVirt,\ne.g. http://prometheus-k8s.monitoring:9090.",
+	}
+}
+
+func (LauncherWatchdogConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "LauncherWatchdogConfiguration configures how virt-handler detects and recovers the virt-launchers\nwhose libvirt or QEMU process is hung.",
+		"timeout": "Timeout is how long the domain of a running VirtualMachineInstance may fail to report its\nstatistics before its virt-launcher is considered hung. Defaults to 5 minutes.\n+optional",
+		"action":  "Action is taken once a virt-launcher is considered hung. Alert only emits an event and\nincrements the kubevirt_vmi_launcher_unresponsive_total metric, Fail additionally marks the\nVirtualMachineInstance as failed and Restart additionally kills the virt-launcher.\nDefaults to Alert.\n+optional\n+kubebuilder:validation:Enum=Alert;Fail;Restart",
+	}
+}
+
+func (SubresourceConnectionLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "SubresourceConnectionLimits holds the limits applied by virt-api to streaming subresource connections.\nRejected connections are answered with 429 Too Many Requests and a Retry-After header.",
+		"maxConnectionsPerUser": "MaxConnectionsPerUser is the maximum number of concurrent connections a single user can hold.\n+optional",
+		"maxConnectionsPerVMI":  "MaxConnectionsPerVMI is the maximum number of concurrent connections to a single VirtualMachineInstance.\n+optional",
+		"connectionRateLimiter": "ConnectionRateLimiter limits the rate at which a single user can open new connections.\n+optional",
+	}
+}
+
+func (VMInfoMetricsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VMInfoMetricsConfiguration holds the settings of the kubevirt_vm_metadata_info metric.",
+		"disabledLabels": "DisabledLabels lists the labels of the kubevirt_vm_metadata_info metric which are reported empty.\nSupported values are instance_type, preference, guest_os, run_strategy and owner.\n+optional\n+listType=set",
+	}
+}
+
+func (DomainStatsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "DomainStatsConfiguration holds the settings of the domain statistics collection.",
+		"collectionInterval": "CollectionInterval is how often virt-launcher refreshes the domain statistics from libvirt.\nDefaults to 3.25s. Changes only apply to VMIs started afterwards.\n+optional",
+		"disabledCollectors": "DisabledCollectors lists the domain statistics which are neither collected from libvirt nor exposed as metrics.\nSupported values are block (per-disk), vcpu (per-vCPU) and network (per-interface).\nDisabling vcpu also removes the vCPU statistics from the downward metrics.\nThe metrics are removed immediately, the collection from libvirt only stops for VMIs started afterwards.\n+optional\n+listType=set",
+	}
+}
+
+func (EventConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "EventConfiguration holds the settings used to keep recurring events from flooding the cluster.",
+		"rateLimiter":         "RateLimiter limits the rate at which each virt-controller and virt-handler instance emits events.\nEvents beyond the limit are dropped.\n+optional",
+		"deduplicationWindow": "DeduplicationWindow drops an event if an event with the same type, reason and message\nwas already emitted for the same object within the window.\n+optional",
+		"suppressedReasons":   "SuppressedReasons lists event reasons, e.g. FailedScheduling, which are never emitted.\n+optional\n+listType=set",
 	}
 }
 
@@ -937,6 +1278,15 @@ func (ChangedBlockTrackingSelectors) SwaggerDoc() map[string]string {
 func (InstancetypeConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"referencePolicy": "ReferencePolicy defines how an instance type or preference should be referenced by the VM after submission, supported values are:\nreference (default) - Where a copy of the original object is stashed in a ControllerRevision and referenced by the VM.\nexpand - Where the instance type or preference are expanded into the VM if no revisionNames have been populated.\nexpandAll - Where the instance type or preference are expanded into the VM regardless of revisionNames previously being populated.\n+nullable\n+kubebuilder:validation:Enum=reference;expand;expandAll",
+		"virtioDrivers":   "VirtioDrivers defines the source of the virtio-win drivers disk attached to Windows guests\nrequesting it through autoattachVirtioDriversDisk.\n+nullable",
+	}
+}
+
+func (VirtioDriversConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "VirtioDriversConfiguration defines the source of the virtio-win drivers disk.",
+		"image":                     "Image is the containerDisk image providing the virtio-win drivers ISO.\n+optional",
+		"persistentVolumeClaimName": "PersistentVolumeClaimName is the name of a PersistentVolumeClaim providing the virtio-win drivers ISO,\nlooked up in the namespace of the VirtualMachine. Takes precedence over Image.\n+optional",
 	}
 }
 
@@ -952,6 +1302,12 @@ func (VirtTemplateDeployment) SwaggerDoc() map[string]string {
 	}
 }
 
+func (WorkflowAlertsDeployment) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"enabled": "Enabled controls the deployment of the workflow alerting rules, defaults to False.\n+nullable",
+	}
+}
+
 func (ArchConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"ppc64le": "Deprecated: ppc64le architecture is no longer supported.",
@@ -960,12 +1316,38 @@ func (ArchConfiguration) SwaggerDoc() map[string]string {
 
 func (ArchSpecificConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"emulatedMachines": "+listType=atomic",
+		"emulatedMachines":   "+listType=atomic",
+		"machineTypeAliases": "MachineTypeAliases maps logical machine type names, e.g. q35-stable, to the machine types they\nstand for on this architecture, e.g. pc-q35-rhel9.6.0. Aliases used as machine type of a VM or\nVMI, or as machineType, are replaced with the machine type they stand for on admission.\n+optional",
+		"firmware":           "Firmware selects the EFI firmware images booted by the VMIs of this architecture.\n+optional",
+	}
+}
+
+func (EFIFirmwareConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "EFIFirmwareConfiguration selects the EFI firmware images per security feature. The file names are relative\nto ovmfPath, or to the root of Image if set. The images which are not set are looked up under their default\nnames, e.g. OVMF_CODE.secboot.fd or AAVMF_CODE.fd.",
+		"image":         "Image is a container image holding the firmware images. It is mounted into virt-launcher instead of\nlooking the images up in ovmfPath. Requires the ImageVolume feature gate.\n+optional",
+		"default":       "Default is booted by VMIs without SecureBoot and confidential computing.\n+optional",
+		"secureBoot":    "SecureBoot is booted by VMIs with SecureBoot enabled.\n+optional",
+		"sev":           "SEV is booted by SEV and SEV-ES VMIs.\n+optional",
+		"snp":           "SNP is booted by SEV-SNP VMIs. The firmware is stateless, Vars is ignored.\n+optional",
+		"tdx":           "TDX is booted by TDX VMIs. The firmware is stateless, Vars is ignored.\n+optional",
+		"tdxSecureBoot": "TDXSecureBoot is booted by TDX VMIs with SecureBoot enabled. The firmware is stateless, Vars is ignored.\n+optional",
+	}
+}
+
+func (EFIFirmwareImages) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "EFIFirmwareImages names the images of an EFI firmware.",
+		"code": "Code is the file name of the firmware code, e.g. OVMF_CODE.secboot.fd.",
+		"vars": "Vars is the file name of the template of the UEFI variable store, e.g. OVMF_VARS.secboot.fd.\n+optional",
 	}
 }
 
 func (SMBiosConfiguration) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"baseBoard":  "BaseBoard is the default baseboard information of the VMIs.\n+optional",
+		"oemStrings": "OEMStrings are the default OEM strings of the VMIs.\n+optional\n+listType=atomic",
+	}
 }
 
 func (SupportContainerResources) SwaggerDoc() map[string]string {
@@ -991,11 +1373,40 @@ func (SeccompConfiguration) SwaggerDoc() map[string]string {
 	}
 }
 
+func (CPUModelsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "CPUModelsConfiguration holds the CPU models which the nodes offer to the VirtualMachineInstances.\nChanges are reflected live on the node labels.",
+		"obsolete":  "Obsolete lists CPU models which are not offered by the nodes, in addition to obsoleteCPUModels.\n+optional\n+listType=set",
+		"allowed":   "Allowed restricts the CPU models offered by the nodes to the listed ones.\nAll the CPU models which are not obsolete are offered when empty.\n+optional\n+listType=set",
+		"minModels": "MinModels sets the minimum CPU model per CPU vendor. The host model of the nodes which\ncan't run the minimum CPU model of their vendor is obsolete, so that these nodes don't\nrun host-model VirtualMachineInstances.\n+optional\n+listType=map\n+listMapKey=vendor",
+	}
+}
+
+func (NodeConfigurationOverride) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                               "NodeConfigurationOverride overrides the settings of the selected nodes.",
+		"nodeSelector":                   "NodeSelector selects the nodes the override applies to.",
+		"virtualMachineInstancesPerNode": "VirtualMachineInstancesPerNode overrides virtualMachineInstancesPerNode, the maximum number\nof VirtualMachineInstances running on each of the selected nodes.\n+optional",
+		"handlerResources":               "HandlerResources overrides the resources reserved for virt-handler on the selected nodes.\nvirt-handler is resized in place, hence its QoS class can't change.\n+optional",
+	}
+}
+
+func (VendorCPUModel) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VendorCPUModel is a CPU model of a given CPU vendor.",
+		"vendor": "Vendor is the CPU vendor as reported by libvirt, e.g. Intel or AMD.",
+		"model":  "Model is the name of the CPU model.",
+	}
+}
+
 func (VirtualMachineOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                         "VirtualMachineOptions holds the cluster level information regarding the virtual machine.",
 		"disableFreePageReporting": "DisableFreePageReporting disable the free page reporting of\nmemory balloon device https://libvirt.org/formatdomain.html#memory-balloon-device.\nThis will have effect only if AutoattachMemBalloon is not false and the vmi is not\nrequesting any high performance feature (dedicatedCPU/realtime/hugePages), in which free page reporting is always disabled.",
 		"disableSerialConsoleLog":  "DisableSerialConsoleLog disables logging the auto-attached default serial console.\nIf not set, serial console logs will be written to a file and then streamed from a container named `guest-console-log`.\nThe value can be individually overridden for each VM, not relevant if AutoattachSerialConsole is disabled.",
+		"serialConsoleLogRotation": "SerialConsoleLogRotation limits the size of the serial console log streamed from the `guest-console-log` container.\nIf not set, the log is rotated at 2Mi and three rotated logs are kept.\n+optional",
+		"serialConsoleLogFormat":   "SerialConsoleLogFormat is the format of the serial console log streamed from the `guest-console-log` container.\nWith `json`, every line of the serial console is written as a JSON object carrying the VMI, like the logs of\nthe other KubeVirt components, so that log pipelines can parse and attribute it.\nDefaults to `text`, which writes the lines unchanged.\n+kubebuilder:validation:Enum=text;json\n+optional",
+		"crashLoopBackoff":         "CrashLoopBackoff configures the delay before restarting VMs whose VMIs failed shortly after being started.\nThe fields can be individually overridden for each VM.\n+optional",
 	}
 }
 
@@ -1007,6 +1418,14 @@ func (DisableSerialConsoleLog) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (SerialConsoleLogRotation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "SerialConsoleLogRotation configures the rotation of the serial console log",
+		"maxSize":    "MaxSize is the size at which the serial console log is rotated.\n+optional",
+		"maxBackups": "MaxBackups is the number of rotated serial console logs kept in the pod.\n+optional",
+	}
+}
+
 func (TLSConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":              "TLSConfiguration holds TLS options",
@@ -1032,6 +1451,8 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"evictionShutdownPriorityThreshold": "EvictionShutdownPriorityThreshold makes VMIs with the LiveMigrateIfPossible eviction strategy\nand a priority lower than the threshold shut down on eviction instead of being migrated, so\nthat the migration capacity of a drain goes to the more important VMIs first.\nThe priority of a VMI is the one of the PriorityClass referenced by spec.priorityClassName.\nUnset by default, in which case all VMIs are migrated.\n+optional",
+		"evictionMigrationTimeout":          "EvictionMigrationTimeout is the maximum number of seconds VMIs with the LiveMigrateOrShutdown\neviction strategy are tried to be migrated on eviction, before they are shutdown. Defaults to 600\n+optional",
 	}
 }
 
@@ -1066,10 +1487,11 @@ func (LogVerbosity) SwaggerDoc() map[string]string {
 
 func (PermittedHostDevices) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                "PermittedHostDevices holds information about devices allowed for passthrough",
-		"pciHostDevices":  "+listType=atomic",
-		"mediatedDevices": "+listType=atomic",
-		"usb":             "+listType=atomic",
+		"":                  "PermittedHostDevices holds information about devices allowed for passthrough",
+		"pciHostDevices":    "+listType=atomic",
+		"pciDiscoveryRules": "Rules exposing the host PCI devices they match, without listing each device\n+optional\n+listType=atomic",
+		"mediatedDevices":   "+listType=atomic",
+		"usb":               "+listType=atomic",
 	}
 }
 
@@ -1094,6 +1516,15 @@ func (PciHostDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (PciDiscoveryRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "PciDiscoveryRule exposes the host PCI devices matching its selectors for passthrough",
+		"pciVendorSelector": "A glob matching the vendor_id:product_id tuple of the PCI devices, e.g. 10de:*",
+		"driver":            "The name of the driver the PCI devices are bound to, e.g. vfio-pci.\nMatches any driver allowed for passthrough when empty.\n+optional",
+		"resourceName":      "The name of the resource that is representing the matching devices. Exposed by\na device plugin and requested by VMs. Typically of the form\nvendor.com/product_name",
+	}
+}
+
 func (MediatedHostDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "MediatedHostDevice represents a host mediated device allowed for passthrough",
@@ -1126,6 +1557,23 @@ func (KSMConfiguration) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MemoryOvercommitConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "MemoryOvercommitConfiguration holds the cluster wide memory overcommit policies.\n+k8s:openapi-gen=true",
+		"classes":           "Classes are named guest to requested memory ratios VMIs can select\nwith spec.domain.memory.overcommitClass.\n+optional\n+listType=map\n+listMapKey=name",
+		"pressureThreshold": "PressureThreshold is the percentage of node memory, swap included, in use\nabove which the node is labeled as being under memory overcommit pressure.\nDefaults to 90.\n+optional",
+	}
+}
+
+func (MemoryOvercommitClass) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "MemoryOvercommitClass defines a named memory overcommit policy.\n+k8s:openapi-gen=true",
+		"name":             "Name of the class, referenced by the VMIs.",
+		"memoryOvercommit": "MemoryOvercommit is the percentage of memory given to the guest compared to\nthe amount requested by the virt-launcher pod.",
+		"swap":             "Swap allows the memory of VMIs of this class to be backed by swap.\nSuch VMIs are only scheduled to nodes labeled with kubevirt.io/swap-enabled,\nand are live migrated instead of being killed when evicted, unless they\ndefine their own eviction strategy.\n+optional",
+	}
+}
+
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "NetworkConfiguration holds network options",
@@ -1164,6 +1612,13 @@ func (GuestAgentPing) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestAgentFileExists) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "GuestAgentFileExists configures the guest-agent based file existence probe",
+		"path": "Path is the absolute path of the file in the guest.",
+	}
+}
+
 func (ProfilerResult) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
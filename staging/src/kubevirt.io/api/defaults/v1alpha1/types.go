@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +resourceName=virtualmachinedefaults
+
+// VirtualMachineDefaults defines the defaults applied to the VirtualMachines created in its namespace.
+// The defaults only fill the fields a VirtualMachine leaves unset. When several VirtualMachineDefaults
+// exist in a namespace, they are applied in the alphabetical order of their names and the first one
+// setting a field wins.
+type VirtualMachineDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec defines the defaults of the VirtualMachines.
+	Spec VirtualMachineDefaultsSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineDefaults `json:"items"`
+}
+
+type VirtualMachineDefaultsSpec struct {
+	// RunStrategy is set on the VirtualMachines which set neither runStrategy nor running.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;RerunOnFailure;Manual;Halted;Once
+	RunStrategy *v1.VirtualMachineRunStrategy `json:"runStrategy,omitempty"`
+
+	// EvictionStrategy is set on the VirtualMachines which don't set one.
+	// It takes precedence over the cluster wide eviction strategy.
+	// +optional
+	// +kubebuilder:validation:Enum=None;LiveMigrate;LiveMigrateIfPossible;External
+	EvictionStrategy *v1.EvictionStrategy `json:"evictionStrategy,omitempty"`
+
+	// NetworkBinding is set on the interfaces of the VirtualMachines which set neither
+	// a binding method nor a binding plugin.
+	// +optional
+	NetworkBinding *NetworkBinding `json:"networkBinding,omitempty"`
+
+	// DiskBus is set on the disks of the VirtualMachines which don't set a bus.
+	// +optional
+	// +kubebuilder:validation:Enum=virtio;sata;scsi;usb
+	DiskBus *v1.DiskBus `json:"diskBus,omitempty"`
+}
+
+// NetworkBinding selects either a core binding method or a network binding plugin.
+// +kubebuilder:validation:XValidation:rule="has(self.method) != has(self.plugin)",message="exactly one of method and plugin must be set"
+type NetworkBinding struct {
+	// Method is the core binding method of the interfaces.
+	// +optional
+	// +kubebuilder:validation:Enum=bridge;masquerade
+	Method NetworkBindingMethod `json:"method,omitempty"`
+
+	// Plugin is the name of a network binding plugin registered in the KubeVirt configuration.
+	// +optional
+	Plugin string `json:"plugin,omitempty"`
+}
+
+type NetworkBindingMethod string
+
+const (
+	NetworkBindingMethodBridge     NetworkBindingMethod = "bridge"
+	NetworkBindingMethodMasquerade NetworkBindingMethod = "masquerade"
+)
@@ -235,6 +235,11 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PreferredWatchdogAction != nil {
+		in, out := &in.PreferredWatchdogAction, &out.PreferredWatchdogAction
+		*out = new(v1.WatchdogAction)
+		**out = **in
+	}
 	return
 }
 
@@ -818,6 +823,11 @@ func (in *VirtualMachinePreferenceSpec) DeepCopyInto(out *VirtualMachinePreferen
 		*out = new(string)
 		**out = **in
 	}
+	if in.PreferredGuestOS != nil {
+		in, out := &in.PreferredGuestOS, &out.PreferredGuestOS
+		*out = new(PreferredGuestOS)
+		**out = **in
+	}
 	return
 }
 
@@ -307,8 +307,26 @@ type VirtualMachinePreferenceSpec struct {
 	//
 	//+optional
 	PreferredArchitecture *string `json:"preferredArchitecture,omitempty"`
+
+	// PreferredGuestOS optionally hints at the operating system running in the guest.
+	// When set to windows a complete set of Hyper-V enlightenments is applied to the
+	// VirtualMachineInstance for any enlightenment not already configured.
+	//
+	//+optional
+	PreferredGuestOS *PreferredGuestOS `json:"preferredGuestOS,omitempty"`
 }
 
+// PreferredGuestOS defines the operating system hint of a preference
+type PreferredGuestOS string
+
+const (
+	// Windows guests get a complete set of Hyper-V enlightenments applied by default
+	GuestOSWindows PreferredGuestOS = "windows"
+
+	// Linux guests get no additional defaults applied
+	GuestOSLinux PreferredGuestOS = "linux"
+)
+
 type VolumePreferences struct {
 
 	// PreffereedStorageClassName optionally defines the preferred storageClass
@@ -538,6 +556,11 @@ type DevicePreferences struct {
 	//
 	// +optional
 	PreferredVideoType *string `json:"preferredVideoType,omitempty"`
+
+	// PreferredWatchdogAction optionally defines the preferred action to take when a Watchdog device is triggered.
+	//
+	// +optional
+	PreferredWatchdogAction *v1.WatchdogAction `json:"preferredWatchdogAction,omitempty"`
 }
 
 // FeaturePreferences contains various optional defaults for Features.
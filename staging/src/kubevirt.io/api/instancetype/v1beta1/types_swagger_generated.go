@@ -111,6 +111,7 @@ func (VirtualMachinePreferenceSpec) SwaggerDoc() map[string]string {
 		"annotations":                            "Optionally defines preferred Annotations to be applied to the VirtualMachineInstance\n\n+optional",
 		"preferSpreadSocketToCoreRatio":          "PreferSpreadSocketToCoreRatio defines the ratio to spread vCPUs between cores and sockets, it defaults to 2.\n\n+optional",
 		"preferredArchitecture":                  "PreferredArchitecture defines a prefeerred architecture for the VirtualMachine\n\n+optional",
+		"preferredGuestOS":                       "PreferredGuestOS optionally hints at the operating system running in the guest.\nWhen set to windows a complete set of Hyper-V enlightenments is applied to the\nVirtualMachineInstance for any enlightenment not already configured.\n\n+optional",
 	}
 }
 
@@ -165,6 +166,7 @@ func (DevicePreferences) SwaggerDoc() map[string]string {
 		"preferredInterfaceMasquerade":        "PreferredInterfaceMasquerade optionally defines the preferred masquerade configuration to use with each network interface.\n\n+optional",
 		"preferredPanicDeviceModel":           "PreferredPanicDeviceModel optionally defines the preferred panic device model to use with panic devices.\n\n+optional",
 		"preferredVideoType":                  "PreferredVideoType optionally defines the preferred type for Video devices.\n\n+optional",
+		"preferredWatchdogAction":             "PreferredWatchdogAction optionally defines the preferred action to take when a Watchdog device is triggered.\n\n+optional",
 	}
 }
 
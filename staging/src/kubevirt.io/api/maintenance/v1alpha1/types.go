@@ -0,0 +1,156 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// NodeMaintenanceFinalizer keeps a NodeMaintenance until its node is uncordoned.
+const NodeMaintenanceFinalizer = "maintenance.kubevirt.io/uncordon-protection"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+
+// NodeMaintenance drains the VirtualMachineInstances from a node. The node is cordoned while
+// the NodeMaintenance exists, and the VirtualMachineInstances are live migrated, shut down or
+// left running on the node according to their eviction strategy.
+type NodeMaintenance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec defines the node and how its VirtualMachineInstances are drained.
+	Spec NodeMaintenanceSpec `json:"spec"`
+	// Status reports the progress of the drain.
+	// +optional
+	Status NodeMaintenanceStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NodeMaintenanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []NodeMaintenance `json:"items"`
+}
+
+type NodeMaintenanceSpec struct {
+	// NodeName is the name of the node to drain.
+	NodeName string `json:"nodeName"`
+
+	// Reason is a free form description of the maintenance.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Policies override the action taken on the VirtualMachineInstances with a given eviction strategy.
+	// VirtualMachineInstances whose eviction strategy has no policy are migrated with LiveMigrate,
+	// migrated if possible and shut down otherwise with LiveMigrateIfPossible, ignored with External
+	// and shut down with None.
+	// +optional
+	// +listType=map
+	// +listMapKey=evictionStrategy
+	Policies []NodeMaintenancePolicy `json:"policies,omitempty"`
+}
+
+type NodeMaintenancePolicy struct {
+	// EvictionStrategy selects the VirtualMachineInstances the policy applies to, by their
+	// effective eviction strategy.
+	EvictionStrategy v1.EvictionStrategy `json:"evictionStrategy"`
+
+	// Action is taken on the selected VirtualMachineInstances.
+	Action NodeMaintenanceAction `json:"action"`
+}
+
+// NodeMaintenanceAction is the action taken on a VirtualMachineInstance of a node under maintenance.
+type NodeMaintenanceAction string
+
+const (
+	// NodeMaintenanceActionMigrate live migrates the VirtualMachineInstance to another node.
+	NodeMaintenanceActionMigrate NodeMaintenanceAction = "Migrate"
+	// NodeMaintenanceActionShutdown shuts the VirtualMachineInstance down. Depending on its run strategy,
+	// the VirtualMachine starts it again on another node.
+	NodeMaintenanceActionShutdown NodeMaintenanceAction = "Shutdown"
+	// NodeMaintenanceActionIgnore leaves the VirtualMachineInstance running on the node.
+	NodeMaintenanceActionIgnore NodeMaintenanceAction = "Ignore"
+)
+
+// NodeMaintenancePhase is the phase of a NodeMaintenance.
+type NodeMaintenancePhase string
+
+const (
+	// NodeMaintenanceRunning means the VirtualMachineInstances are being drained from the node.
+	NodeMaintenanceRunning NodeMaintenancePhase = "Running"
+	// NodeMaintenanceSucceeded means the node only runs ignored VirtualMachineInstances anymore.
+	NodeMaintenanceSucceeded NodeMaintenancePhase = "Succeeded"
+	// NodeMaintenanceFailed means the maintenance can't complete, e.g. because the node does not exist or
+	// because a VirtualMachineInstance failed to migrate too many times.
+	NodeMaintenanceFailed NodeMaintenancePhase = "Failed"
+)
+
+type NodeMaintenanceStatus struct {
+	// Phase is the phase of the maintenance.
+	// +optional
+	Phase NodeMaintenancePhase `json:"phase,omitempty"`
+
+	// NodeCordoned tells whether the node was cordoned by the maintenance,
+	// in which case it is uncordoned when the maintenance is deleted.
+	// +optional
+	NodeCordoned bool `json:"nodeCordoned,omitempty"`
+
+	// TotalVMIs is the number of VirtualMachineInstances to drain from the node since the maintenance started.
+	// +optional
+	TotalVMIs int32 `json:"totalVMIs,omitempty"`
+
+	// DrainProgress is the percentage of the VirtualMachineInstances drained from the node.
+	// +optional
+	DrainProgress int32 `json:"drainProgress,omitempty"`
+
+	// IgnoredVMIs is the number of VirtualMachineInstances left running on the node.
+	// +optional
+	IgnoredVMIs int32 `json:"ignoredVMIs,omitempty"`
+
+	// PendingVMIs lists the VirtualMachineInstances which are not drained from the node yet.
+	// +optional
+	// +listType=atomic
+	PendingVMIs []NodeMaintenancePendingVMI `json:"pendingVMIs,omitempty"`
+
+	// LastError is the last error which occurred during the maintenance.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+}
+
+// NodeMaintenancePendingVMI is a VirtualMachineInstance which is not drained from the node yet.
+type NodeMaintenancePendingVMI struct {
+	// Namespace of the VirtualMachineInstance.
+	Namespace string `json:"namespace"`
+	// Name of the VirtualMachineInstance.
+	Name string `json:"name"`
+	// Action taken on the VirtualMachineInstance.
+	Action NodeMaintenanceAction `json:"action"`
+	// Message explains why the VirtualMachineInstance is not drained yet.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// FailedMigrations is the number of migrations of the VirtualMachineInstance which failed during the maintenance.
+	// +optional
+	FailedMigrations int32 `json:"failedMigrations,omitempty"`
+}
@@ -28,6 +28,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
+	apicorev1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -74,6 +75,67 @@ func (in *VirtualMachinePool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolAntiAffinity) DeepCopyInto(out *VirtualMachinePoolAntiAffinity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolAntiAffinity.
+func (in *VirtualMachinePoolAntiAffinity) DeepCopy() *VirtualMachinePoolAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolArchitectureVariant) DeepCopyInto(out *VirtualMachinePoolArchitectureVariant) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]apicorev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataVolumeTemplates != nil {
+		in, out := &in.DataVolumeTemplates, &out.DataVolumeTemplates
+		*out = make([]apicorev1.DataVolumeTemplateSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Instancetype != nil {
+		in, out := &in.Instancetype, &out.Instancetype
+		*out = new(apicorev1.InstancetypeMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(apicorev1.PreferenceMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolArchitectureVariant.
+func (in *VirtualMachinePoolArchitectureVariant) DeepCopy() *VirtualMachinePoolArchitectureVariant {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolArchitectureVariant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolAutohealingStrategy) DeepCopyInto(out *VirtualMachinePoolAutohealingStrategy) {
 	*out = *in
@@ -232,6 +294,11 @@ func (in *VirtualMachinePoolProactiveUpdateStrategy) DeepCopyInto(out *VirtualMa
 		*out = new(VirtualMachinePoolSelectionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(VirtualMachinePoolProactiveUpdateMethod)
+		**out = **in
+	}
 	return
 }
 
@@ -362,6 +429,11 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	if in.ScaleInStrategy != nil {
 		in, out := &in.ScaleInStrategy, &out.ScaleInStrategy
 		*out = new(VirtualMachinePoolScaleInStrategy)
@@ -377,6 +449,30 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(VirtualMachinePoolAutohealingStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TopologySpread != nil {
+		in, out := &in.TopologySpread, &out.TopologySpread
+		*out = make([]VirtualMachinePoolTopologySpread, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AntiAffinity != nil {
+		in, out := &in.AntiAffinity, &out.AntiAffinity
+		*out = make([]VirtualMachinePoolAntiAffinity, len(*in))
+		copy(*out, *in)
+	}
+	if in.Standby != nil {
+		in, out := &in.Standby, &out.Standby
+		*out = new(VirtualMachinePoolStandby)
+		**out = **in
+	}
+	if in.ArchitectureVariants != nil {
+		in, out := &in.ArchitectureVariants, &out.ArchitectureVariants
+		*out = make([]VirtualMachinePoolArchitectureVariant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -390,6 +486,22 @@ func (in *VirtualMachinePoolSpec) DeepCopy() *VirtualMachinePoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolStandby) DeepCopyInto(out *VirtualMachinePoolStandby) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolStandby.
+func (in *VirtualMachinePoolStandby) DeepCopy() *VirtualMachinePoolStandby {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolStandby)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolStatus) DeepCopyInto(out *VirtualMachinePoolStatus) {
 	*out = *in
@@ -400,6 +512,13 @@ func (in *VirtualMachinePoolStatus) DeepCopyInto(out *VirtualMachinePoolStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologySpread != nil {
+		in, out := &in.TopologySpread, &out.TopologySpread
+		*out = make([]VirtualMachinePoolTopologySpreadStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -413,6 +532,64 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolTopologyDomain) DeepCopyInto(out *VirtualMachinePoolTopologyDomain) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolTopologyDomain.
+func (in *VirtualMachinePoolTopologyDomain) DeepCopy() *VirtualMachinePoolTopologyDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolTopologyDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolTopologySpread) DeepCopyInto(out *VirtualMachinePoolTopologySpread) {
+	*out = *in
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolTopologySpread.
+func (in *VirtualMachinePoolTopologySpread) DeepCopy() *VirtualMachinePoolTopologySpread {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolTopologySpread)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolTopologySpreadStatus) DeepCopyInto(out *VirtualMachinePoolTopologySpreadStatus) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]VirtualMachinePoolTopologyDomain, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolTopologySpreadStatus.
+func (in *VirtualMachinePoolTopologySpreadStatus) DeepCopy() *VirtualMachinePoolTopologySpreadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolTopologySpreadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolUnmanagedStrategy) DeepCopyInto(out *VirtualMachinePoolUnmanagedStrategy) {
 	*out = *in
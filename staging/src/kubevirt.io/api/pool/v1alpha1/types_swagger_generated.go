@@ -26,9 +26,26 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                     "+k8s:openapi-gen=true",
+		"standbyReplicas":      "StandbyReplicas is the number of standby VMs of the pool",
+		"readyStandbyReplicas": "ReadyStandbyReplicas is the number of standby VMs which are running and ready to replace a failed VM",
+		"conditions":           "+listType=atomic",
+		"labelSelector":        "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"topologySpread":       "TopologySpread reports the distribution of the running VMs of the pool for each topology key of the\nspread and anti-affinity rules\n+listType=atomic",
+	}
+}
+
+func (VirtualMachinePoolTopologySpreadStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachinePoolTopologySpreadStatus reports the distribution of the running VMs of a pool across the domains of a topology\n+k8s:openapi-gen=true",
+		"domains": "Domains lists the number of running VMs of the pool in each domain hosting at least one of them\n+listType=atomic",
+		"skew":    "Skew is the difference between the numbers of running VMs in the most and the least populated domains,\ncounting the domains of the nodes without VMs of the pool as well",
+	}
+}
+
+func (VirtualMachinePoolTopologyDomain) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "+k8s:openapi-gen=true",
 	}
 }
 
@@ -41,9 +58,14 @@ func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 		"paused":                 "Indicates that the pool is paused.\n+optional",
 		"nameGeneration":         "Options for the name generation in a pool.\n+optional",
 		"maxUnavailable":         "(Defaults to 100%) Integer or string pointer, that when set represents either a percentage or number of VMs in a pool that can be unavailable (ready condition false) at a time during automated update.\n+optional",
+		"maxSurge":               "(Defaults to 0) Integer or string pointer, that when set represents either a percentage or number of VMs that can be created above the desired number of replicas during automated update with the Replace method.\n+optional",
 		"scaleInStrategy":        "ScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool\n+optional",
 		"updateStrategy":         "UpdateStrategy specifies how the VMPool controller manages updating VMs within a VMPool\n+optional",
 		"autohealing":            "Autohealing specifies when a VMpool should replace a failing VM with a reprovisioned instance\n+optional",
+		"topologySpread":         "TopologySpread distributes the VMs of the pool evenly across topology domains, e.g. zones or hosts\n+optional\n+listType=atomic",
+		"antiAffinity":           "AntiAffinity keeps the VMs of the pool apart, placing at most one of them in each topology domain, e.g. zone or host\n+optional\n+listType=atomic",
+		"standby":                "Standby keeps paused standby VMs on other nodes than the VMs of the pool, which replace the failed VMs of the pool\n+optional",
+		"architectureVariants":   "ArchitectureVariants adapt the VM template to several architectures, so that the VMs of the pool\nrun on nodes of each of them\n+optional\n+listType=map\n+listMapKey=architecture",
 	}
 }
 
@@ -55,6 +77,42 @@ func (VirtualMachinePoolAutohealingStrategy) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachinePoolTopologySpread) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "VirtualMachinePoolTopologySpread spreads the VMs of a pool evenly across the domains of a topology\n+k8s:openapi-gen=true",
+		"topologyKey":       "TopologyKey is the key of the node labels defining the topology domains, e.g. topology.kubernetes.io/zone",
+		"maxSkew":           "MaxSkew is the maximum difference between the numbers of VMs of the pool in any two domains.\nDefaults to 1\n+optional\n+kubebuilder:validation:Minimum=1",
+		"whenUnsatisfiable": "WhenUnsatisfiable defines how a VM exceeding the skew is scheduled [DoNotSchedule|ScheduleAnyway]\nDoNotSchedule - (Default) the VM is not scheduled until the skew allows it.\nScheduleAnyway - the VM is scheduled, preferring the domains reducing the skew.\n+optional\n+kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway",
+	}
+}
+
+func (VirtualMachinePoolAntiAffinity) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachinePoolAntiAffinity keeps the VMs of a pool in distinct domains of a topology\n+k8s:openapi-gen=true",
+		"topologyKey": "TopologyKey is the key of the node labels defining the topology domains, e.g. kubernetes.io/hostname",
+		"preferred":   "Preferred turns the anti-affinity into a scheduling preference, placing several VMs of the pool in a domain\nwhen there are not enough domains. By default such VMs are not scheduled.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolStandby) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachinePoolStandby keeps pre-provisioned, paused VMs ready to replace the failed VMs of a pool\n+k8s:openapi-gen=true",
+		"replicas": "Replicas is the number of standby VMs\n+kubebuilder:validation:Minimum=0",
+	}
+}
+
+func (VirtualMachinePoolArchitectureVariant) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VirtualMachinePoolArchitectureVariant adapts the VM template of a pool to the VMs running on an architecture\n+k8s:openapi-gen=true",
+		"architecture":        "Architecture of the VMs created from the variant, e.g. amd64 or arm64",
+		"weight":              "Weight is the share of the VMs of the pool created from the variant, relative to the weights\nof the other variants. Defaults to 1\n+optional\n+kubebuilder:validation:Minimum=0",
+		"volumes":             "Volumes replace the volumes of the VM template with the same name, e.g. with the containerDisk\nimage built for the architecture\n+optional\n+listType=atomic",
+		"dataVolumeTemplates": "DataVolumeTemplates replace the dataVolumeTemplates of the VM template with the same name\n+optional\n+listType=atomic",
+		"instancetype":        "Instancetype replaces the instancetype matcher of the VM template\n+optional",
+		"preference":          "Preference replaces the preference matcher of the VM template\n+optional",
+	}
+}
+
 func (VirtualMachinePoolNameGeneration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "+k8s:openapi-gen=true",
@@ -113,6 +171,7 @@ func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
 		"unmanaged":     "Unmanaged indicates that no automatic update of VMs within a VMPool is performed. When this is set, the VMPool controller will not update the VMs within the pool.\n+optional",
 		"opportunistic": "Opportunistic update only gets applied to the VM, VMI is updated naturally upon the restart. Whereas proactive it applies both the VM and VMI right away.\n+optional",
 		"proactive":     "Proactive update by forcing the VMs to restart during update\n+optional",
+		"paused":        "Paused halts the rollout of template changes to the VMs within the VMPool, scaling is not affected.\n+optional",
 	}
 }
 
@@ -132,5 +191,6 @@ func (VirtualMachinePoolProactiveUpdateStrategy) SwaggerDoc() map[string]string
 	return map[string]string{
 		"":                "VirtualMachinePoolProactiveUpdateStrategy represents proactive update strategy\n+k8s:openapi-gen=true",
 		"selectionPolicy": "SelectionPolicy defines the priority in which VM instances are selected for proactive update\nDefaults to \"Random\" base policy when no SelectionPolicy is configured\n+optional",
+		"method":          "Method defines how outdated VMs are updated [Restart|LiveUpdate|Replace]\nRestart - (Default) the VMI is restarted.\nLiveUpdate - the changes are live-updated to the VMI, which is only restarted when the VM requires it.\nReplace - the VM is deleted and a new one is created, see maxSurge.\n+optional\n+kubebuilder:validation:Enum=Restart;LiveUpdate;Replace",
 	}
 }
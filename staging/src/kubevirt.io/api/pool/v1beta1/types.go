@@ -41,6 +41,13 @@ const (
 	VirtualMachinePoolSortPolicyRandom          VirtualMachinePoolSortPolicy = "Random"
 )
 
+const (
+	// Proactive update methods
+	VirtualMachinePoolProactiveUpdateMethodRestart    VirtualMachinePoolProactiveUpdateMethod = "Restart"
+	VirtualMachinePoolProactiveUpdateMethodLiveUpdate VirtualMachinePoolProactiveUpdateMethod = "LiveUpdate"
+	VirtualMachinePoolProactiveUpdateMethodReplace    VirtualMachinePoolProactiveUpdateMethod = "Replace"
+)
+
 // VirtualMachinePool resource contains a VirtualMachine configuration
 // that can be used to replicate multiple VirtualMachine resources.
 //
@@ -75,6 +82,9 @@ const (
 	// VirtualMachinePoolReplicaPaused is added in a pool when the pool got paused by the controller.
 	// After this condition was added, it is safe to remove or add vms by hand and adjust the replica count manually
 	VirtualMachinePoolReplicaPaused VirtualMachinePoolConditionType = "ReplicaPaused"
+
+	// VirtualMachinePoolUpdatePaused is added in a pool when the rollout of its template got paused.
+	VirtualMachinePoolUpdatePaused VirtualMachinePoolConditionType = "UpdatePaused"
 )
 
 // +k8s:openapi-gen=true
@@ -95,11 +105,42 @@ type VirtualMachinePoolStatus struct {
 
 	ReadyReplicas int32 `json:"readyReplicas,omitempty" optional:"true"`
 
+	// StandbyReplicas is the number of standby VMs of the pool
+	StandbyReplicas int32 `json:"standbyReplicas,omitempty" optional:"true"`
+
+	// ReadyStandbyReplicas is the number of standby VMs which are running and ready to replace a failed VM
+	ReadyStandbyReplicas int32 `json:"readyStandbyReplicas,omitempty" optional:"true"`
+
 	// +listType=atomic
 	Conditions []VirtualMachinePoolCondition `json:"conditions,omitempty" optional:"true"`
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// TopologySpread reports the distribution of the running VMs of the pool for each topology key of the
+	// spread and anti-affinity rules
+	// +listType=atomic
+	TopologySpread []VirtualMachinePoolTopologySpreadStatus `json:"topologySpread,omitempty" optional:"true"`
+}
+
+// VirtualMachinePoolTopologySpreadStatus reports the distribution of the running VMs of a pool across the domains of a topology
+// +k8s:openapi-gen=true
+type VirtualMachinePoolTopologySpreadStatus struct {
+	TopologyKey string `json:"topologyKey"`
+
+	// Domains lists the number of running VMs of the pool in each domain hosting at least one of them
+	// +listType=atomic
+	Domains []VirtualMachinePoolTopologyDomain `json:"domains,omitempty"`
+
+	// Skew is the difference between the numbers of running VMs in the most and the least populated domains,
+	// counting the domains of the nodes without VMs of the pool as well
+	Skew int32 `json:"skew"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolTopologyDomain struct {
+	Name     string `json:"name"`
+	Replicas int32  `json:"replicas"`
 }
 
 // +k8s:openapi-gen=true
@@ -129,6 +170,10 @@ type VirtualMachinePoolSpec struct {
 	// +optional
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" protobuf:"bytes,3,opt,name=maxUnavailable"`
 
+	// (Defaults to 0) Integer or string pointer, that when set represents either a percentage or number of VMs that can be created above the desired number of replicas during automated update with the Replace method.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
 	// ScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool
 	// +optional
 	ScaleInStrategy *VirtualMachinePoolScaleInStrategy `json:"scaleInStrategy,omitempty"`
@@ -140,6 +185,27 @@ type VirtualMachinePoolSpec struct {
 	// Autohealing specifies when a VMpool should replace a failing VM with a reprovisioned instance
 	// +optional
 	Autohealing *VirtualMachinePoolAutohealingStrategy `json:"autohealing,omitempty"`
+
+	// TopologySpread distributes the VMs of the pool evenly across topology domains, e.g. zones or hosts
+	// +optional
+	// +listType=atomic
+	TopologySpread []VirtualMachinePoolTopologySpread `json:"topologySpread,omitempty"`
+
+	// AntiAffinity keeps the VMs of the pool apart, placing at most one of them in each topology domain, e.g. zone or host
+	// +optional
+	// +listType=atomic
+	AntiAffinity []VirtualMachinePoolAntiAffinity `json:"antiAffinity,omitempty"`
+
+	// Standby keeps paused standby VMs on other nodes than the VMs of the pool, which replace the failed VMs of the pool
+	// +optional
+	Standby *VirtualMachinePoolStandby `json:"standby,omitempty"`
+
+	// ArchitectureVariants adapt the VM template to several architectures, so that the VMs of the pool
+	// run on nodes of each of them
+	// +optional
+	// +listType=map
+	// +listMapKey=architecture
+	ArchitectureVariants []VirtualMachinePoolArchitectureVariant `json:"architectureVariants,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -157,6 +223,78 @@ type VirtualMachinePoolAutohealingStrategy struct {
 	MinFailingToStartDuration *metav1.Duration `json:"minFailingToStartDuration,omitempty"`
 }
 
+// VirtualMachinePoolTopologySpread spreads the VMs of a pool evenly across the domains of a topology
+// +k8s:openapi-gen=true
+type VirtualMachinePoolTopologySpread struct {
+	// TopologyKey is the key of the node labels defining the topology domains, e.g. topology.kubernetes.io/zone
+	TopologyKey string `json:"topologyKey"`
+
+	// MaxSkew is the maximum difference between the numbers of VMs of the pool in any two domains.
+	// Defaults to 1
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+
+	// WhenUnsatisfiable defines how a VM exceeding the skew is scheduled [DoNotSchedule|ScheduleAnyway]
+	// DoNotSchedule - (Default) the VM is not scheduled until the skew allows it.
+	// ScheduleAnyway - the VM is scheduled, preferring the domains reducing the skew.
+	// +optional
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	WhenUnsatisfiable k8sv1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// VirtualMachinePoolAntiAffinity keeps the VMs of a pool in distinct domains of a topology
+// +k8s:openapi-gen=true
+type VirtualMachinePoolAntiAffinity struct {
+	// TopologyKey is the key of the node labels defining the topology domains, e.g. kubernetes.io/hostname
+	TopologyKey string `json:"topologyKey"`
+
+	// Preferred turns the anti-affinity into a scheduling preference, placing several VMs of the pool in a domain
+	// when there are not enough domains. By default such VMs are not scheduled.
+	// +optional
+	Preferred bool `json:"preferred,omitempty"`
+}
+
+// VirtualMachinePoolStandby keeps pre-provisioned, paused VMs ready to replace the failed VMs of a pool
+// +k8s:openapi-gen=true
+type VirtualMachinePoolStandby struct {
+	// Replicas is the number of standby VMs
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+}
+
+// VirtualMachinePoolArchitectureVariant adapts the VM template of a pool to the VMs running on an architecture
+// +k8s:openapi-gen=true
+type VirtualMachinePoolArchitectureVariant struct {
+	// Architecture of the VMs created from the variant, e.g. amd64 or arm64
+	Architecture string `json:"architecture"`
+
+	// Weight is the share of the VMs of the pool created from the variant, relative to the weights
+	// of the other variants. Defaults to 1
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Volumes replace the volumes of the VM template with the same name, e.g. with the containerDisk
+	// image built for the architecture
+	// +optional
+	// +listType=atomic
+	Volumes []virtv1.Volume `json:"volumes,omitempty"`
+
+	// DataVolumeTemplates replace the dataVolumeTemplates of the VM template with the same name
+	// +optional
+	// +listType=atomic
+	DataVolumeTemplates []virtv1.DataVolumeTemplateSpec `json:"dataVolumeTemplates,omitempty"`
+
+	// Instancetype replaces the instancetype matcher of the VM template
+	// +optional
+	Instancetype *virtv1.InstancetypeMatcher `json:"instancetype,omitempty"`
+
+	// Preference replaces the preference matcher of the VM template
+	// +optional
+	Preference *virtv1.PreferenceMatcher `json:"preference,omitempty"`
+}
+
 // +k8s:openapi-gen=true
 type VirtualMachinePoolNameGeneration struct {
 	AppendIndexToConfigMapRefs *bool `json:"appendIndexToConfigMapRefs,omitempty"`
@@ -262,6 +400,10 @@ type VirtualMachinePoolUpdateStrategy struct {
 	// Proactive update by forcing the VMs to restart during update
 	// +optional
 	Proactive *VirtualMachinePoolProactiveUpdateStrategy `json:"proactive,omitempty"`
+
+	// Paused halts the rollout of template changes to the VMs within the VMPool, scaling is not affected.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -279,8 +421,19 @@ type VirtualMachinePoolProactiveUpdateStrategy struct {
 	// Defaults to "Random" base policy when no SelectionPolicy is configured
 	// +optional
 	SelectionPolicy *VirtualMachinePoolSelectionPolicy `json:"selectionPolicy,omitempty"`
+
+	// Method defines how outdated VMs are updated [Restart|LiveUpdate|Replace]
+	// Restart - (Default) the VMI is restarted.
+	// LiveUpdate - the changes are live-updated to the VMI, which is only restarted when the VM requires it.
+	// Replace - the VM is deleted and a new one is created, see maxSurge.
+	// +optional
+	// +kubebuilder:validation:Enum=Restart;LiveUpdate;Replace
+	Method *VirtualMachinePoolProactiveUpdateMethod `json:"method,omitempty"`
 }
 
+// +k8s:openapi-gen=true
+type VirtualMachinePoolProactiveUpdateMethod string
+
 const (
 	StatePreservationDisabled StatePreservation = "Disabled"
 	StatePreservationOffline  StatePreservation = "Offline"
@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+
+// VirtualMachineQuota limits the resources the running VirtualMachines of its namespace may consume,
+// expressed in VirtualMachine terms rather than in the terms of the virt-launcher pods.
+// Creating a VirtualMachineInstance, directly or by starting a VirtualMachine, is rejected when it
+// would exceed any VirtualMachineQuota of its namespace.
+type VirtualMachineQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec defines the limits of the quota.
+	Spec VirtualMachineQuotaSpec `json:"spec"`
+	// Status reports the current consumption of the quota.
+	// +optional
+	Status VirtualMachineQuotaStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineQuota `json:"items"`
+}
+
+type VirtualMachineQuotaSpec struct {
+	// Hard is the set of limits enforced on the running VirtualMachines of the namespace.
+	Hard VirtualMachineQuotaLimits `json:"hard"`
+}
+
+type VirtualMachineQuotaStatus struct {
+	// Used is the consumption of the running VirtualMachines of the namespace, in the terms of the limits.
+	// It is computed by virt-controller from the VirtualMachineInstances it observes.
+	// +optional
+	Used *VirtualMachineQuotaLimits `json:"used,omitempty"`
+
+	// Reservations are the charges of the VirtualMachineInstances admitted by virt-api which are not
+	// accounted for in Used yet. virt-controller drops them once it observes the VirtualMachineInstances,
+	// or after a minute for the ones which were not created.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Reservations []VirtualMachineQuotaReservation `json:"reservations,omitempty"`
+}
+
+// VirtualMachineQuotaReservation is the charge of a VirtualMachineInstance admitted by virt-api.
+type VirtualMachineQuotaReservation struct {
+	// Name is the name of the VirtualMachineInstance.
+	Name string `json:"name"`
+
+	// UID is the UID of the VirtualMachineInstance.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+
+	// Used is the consumption of the VirtualMachineInstance, in the terms of the limits.
+	Used VirtualMachineQuotaLimits `json:"used"`
+
+	// Time is when the VirtualMachineInstance was admitted.
+	Time metav1.Time `json:"time"`
+}
+
+// VirtualMachineQuotaLimits lists the limits of a quota. Unset limits are not enforced.
+type VirtualMachineQuotaLimits struct {
+	// RunningVirtualMachines is the maximum number of VirtualMachines running at once.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RunningVirtualMachines *int64 `json:"runningVirtualMachines,omitempty"`
+
+	// GuestMemory is the maximum sum of the guest memory of the running VirtualMachines.
+	// +optional
+	GuestMemory *resource.Quantity `json:"guestMemory,omitempty"`
+
+	// Sockets is the maximum sum of the vCPU sockets of the running VirtualMachines.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Sockets *int64 `json:"sockets,omitempty"`
+}
@@ -352,6 +352,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.AccessCredential":                                                        schema_kubevirtio_api_core_v1_AccessCredential(ref),
 		"kubevirt.io/api/core/v1.AccessCredentialSecretSource":                                            schema_kubevirtio_api_core_v1_AccessCredentialSecretSource(ref),
 		"kubevirt.io/api/core/v1.AddVolumeOptions":                                                        schema_kubevirtio_api_core_v1_AddVolumeOptions(ref),
+		"kubevirt.io/api/core/v1.AllowedQEMUArg":                                                          schema_kubevirtio_api_core_v1_AllowedQEMUArg(ref),
 		"kubevirt.io/api/core/v1.ArchConfiguration":                                                       schema_kubevirtio_api_core_v1_ArchConfiguration(ref),
 		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                               schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
 		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                      schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
@@ -361,6 +362,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                             schema_kubevirtio_api_core_v1_CDRomTarget(ref),
 		"kubevirt.io/api/core/v1.CPU":                                                                     schema_kubevirtio_api_core_v1_CPU(ref),
 		"kubevirt.io/api/core/v1.CPUFeature":                                                              schema_kubevirtio_api_core_v1_CPUFeature(ref),
+		"kubevirt.io/api/core/v1.CPUModelsConfiguration":                                                  schema_kubevirtio_api_core_v1_CPUModelsConfiguration(ref),
 		"kubevirt.io/api/core/v1.CPUTopology":                                                             schema_kubevirtio_api_core_v1_CPUTopology(ref),
 		"kubevirt.io/api/core/v1.CertConfig":                                                              schema_kubevirtio_api_core_v1_CertConfig(ref),
 		"kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors":                                           schema_kubevirtio_api_core_v1_ChangedBlockTrackingSelectors(ref),
@@ -371,6 +373,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Clock":                                                                   schema_kubevirtio_api_core_v1_Clock(ref),
 		"kubevirt.io/api/core/v1.ClockOffset":                                                             schema_kubevirtio_api_core_v1_ClockOffset(ref),
 		"kubevirt.io/api/core/v1.ClockOffsetUTC":                                                          schema_kubevirtio_api_core_v1_ClockOffsetUTC(ref),
+		"kubevirt.io/api/core/v1.ClockOffsetVariable":                                                     schema_kubevirtio_api_core_v1_ClockOffsetVariable(ref),
 		"kubevirt.io/api/core/v1.CloudInitConfigDriveSource":                                              schema_kubevirtio_api_core_v1_CloudInitConfigDriveSource(ref),
 		"kubevirt.io/api/core/v1.CloudInitNoCloudSource":                                                  schema_kubevirtio_api_core_v1_CloudInitNoCloudSource(ref),
 		"kubevirt.io/api/core/v1.ClusterProfilerRequest":                                                  schema_kubevirtio_api_core_v1_ClusterProfilerRequest(ref),
@@ -384,6 +387,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ContainerDiskSource":                                                     schema_kubevirtio_api_core_v1_ContainerDiskSource(ref),
 		"kubevirt.io/api/core/v1.ContainerPathVolumeSource":                                               schema_kubevirtio_api_core_v1_ContainerPathVolumeSource(ref),
 		"kubevirt.io/api/core/v1.ControllerRevisionRef":                                                   schema_kubevirtio_api_core_v1_ControllerRevisionRef(ref),
+		"kubevirt.io/api/core/v1.CrashLoopBackoff":                                                        schema_kubevirtio_api_core_v1_CrashLoopBackoff(ref),
 		"kubevirt.io/api/core/v1.CustomBlockSize":                                                         schema_kubevirtio_api_core_v1_CustomBlockSize(ref),
 		"kubevirt.io/api/core/v1.CustomProfile":                                                           schema_kubevirtio_api_core_v1_CustomProfile(ref),
 		"kubevirt.io/api/core/v1.CustomizeComponents":                                                     schema_kubevirtio_api_core_v1_CustomizeComponents(ref),
@@ -408,14 +412,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.DiskVerification":                                                        schema_kubevirtio_api_core_v1_DiskVerification(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                                    schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
 		"kubevirt.io/api/core/v1.DomainSpec":                                                              schema_kubevirtio_api_core_v1_DomainSpec(ref),
+		"kubevirt.io/api/core/v1.DomainStatsConfiguration":                                                schema_kubevirtio_api_core_v1_DomainStatsConfiguration(ref),
 		"kubevirt.io/api/core/v1.DownwardAPIVolumeSource":                                                 schema_kubevirtio_api_core_v1_DownwardAPIVolumeSource(ref),
 		"kubevirt.io/api/core/v1.DownwardMetrics":                                                         schema_kubevirtio_api_core_v1_DownwardMetrics(ref),
 		"kubevirt.io/api/core/v1.DownwardMetricsVolumeSource":                                             schema_kubevirtio_api_core_v1_DownwardMetricsVolumeSource(ref),
 		"kubevirt.io/api/core/v1.EFI":                                                                     schema_kubevirtio_api_core_v1_EFI(ref),
+		"kubevirt.io/api/core/v1.EFIFirmwareConfiguration":                                                schema_kubevirtio_api_core_v1_EFIFirmwareConfiguration(ref),
+		"kubevirt.io/api/core/v1.EFIFirmwareImages":                                                       schema_kubevirtio_api_core_v1_EFIFirmwareImages(ref),
+		"kubevirt.io/api/core/v1.EjectMediaOptions":                                                       schema_kubevirtio_api_core_v1_EjectMediaOptions(ref),
 		"kubevirt.io/api/core/v1.EmptyDiskSource":                                                         schema_kubevirtio_api_core_v1_EmptyDiskSource(ref),
 		"kubevirt.io/api/core/v1.EphemeralVolumeSource":                                                   schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref),
 		"kubevirt.io/api/core/v1.EvacuateCancelOptions":                                                   schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref),
+		"kubevirt.io/api/core/v1.EventConfiguration":                                                      schema_kubevirtio_api_core_v1_EventConfiguration(ref),
 		"kubevirt.io/api/core/v1.FeatureAPIC":                                                             schema_kubevirtio_api_core_v1_FeatureAPIC(ref),
+		"kubevirt.io/api/core/v1.FeatureGateStatus":                                                       schema_kubevirtio_api_core_v1_FeatureGateStatus(ref),
 		"kubevirt.io/api/core/v1.FeatureHyperv":                                                           schema_kubevirtio_api_core_v1_FeatureHyperv(ref),
 		"kubevirt.io/api/core/v1.FeatureKVM":                                                              schema_kubevirtio_api_core_v1_FeatureKVM(ref),
 		"kubevirt.io/api/core/v1.FeatureSpinlocks":                                                        schema_kubevirtio_api_core_v1_FeatureSpinlocks(ref),
@@ -430,7 +440,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.GPU":                                                                     schema_kubevirtio_api_core_v1_GPU(ref),
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                        schema_kubevirtio_api_core_v1_GenerationStatus(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                                   schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
+		"kubevirt.io/api/core/v1.GuestAgentCommandsConfiguration":                                         schema_kubevirtio_api_core_v1_GuestAgentCommandsConfiguration(ref),
+		"kubevirt.io/api/core/v1.GuestAgentData":                                                          schema_kubevirtio_api_core_v1_GuestAgentData(ref),
+		"kubevirt.io/api/core/v1.GuestAgentFileExists":                                                    schema_kubevirtio_api_core_v1_GuestAgentFileExists(ref),
+		"kubevirt.io/api/core/v1.GuestAgentOSInfo":                                                        schema_kubevirtio_api_core_v1_GuestAgentOSInfo(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                          schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestAgentValue":                                                         schema_kubevirtio_api_core_v1_GuestAgentValue(ref),
+		"kubevirt.io/api/core/v1.GuestExecConfiguration":                                                  schema_kubevirtio_api_core_v1_GuestExecConfiguration(ref),
+		"kubevirt.io/api/core/v1.GuestExecOptions":                                                        schema_kubevirtio_api_core_v1_GuestExecOptions(ref),
+		"kubevirt.io/api/core/v1.GuestPanicCapture":                                                       schema_kubevirtio_api_core_v1_GuestPanicCapture(ref),
+		"kubevirt.io/api/core/v1.GuestTimeSync":                                                           schema_kubevirtio_api_core_v1_GuestTimeSync(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                               schema_kubevirtio_api_core_v1_HPETTimer(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                                 schema_kubevirtio_api_core_v1_Handler(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                              schema_kubevirtio_api_core_v1_HostDevice(ref),
@@ -442,8 +461,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.HypervTimer":                                                             schema_kubevirtio_api_core_v1_HypervTimer(ref),
 		"kubevirt.io/api/core/v1.HypervisorConfiguration":                                                 schema_kubevirtio_api_core_v1_HypervisorConfiguration(ref),
 		"kubevirt.io/api/core/v1.I6300ESBWatchdog":                                                        schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref),
+		"kubevirt.io/api/core/v1.Ignition":                                                                schema_kubevirtio_api_core_v1_Ignition(ref),
+		"kubevirt.io/api/core/v1.IgnitionFragment":                                                        schema_kubevirtio_api_core_v1_IgnitionFragment(ref),
 		"kubevirt.io/api/core/v1.InitrdInfo":                                                              schema_kubevirtio_api_core_v1_InitrdInfo(ref),
 		"kubevirt.io/api/core/v1.Input":                                                                   schema_kubevirtio_api_core_v1_Input(ref),
+		"kubevirt.io/api/core/v1.InsertMediaOptions":                                                      schema_kubevirtio_api_core_v1_InsertMediaOptions(ref),
 		"kubevirt.io/api/core/v1.InstancetypeConfiguration":                                               schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref),
 		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                     schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
 		"kubevirt.io/api/core/v1.InstancetypeStatusRef":                                                   schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref),
@@ -453,6 +475,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
 		"kubevirt.io/api/core/v1.InterfaceBridge":                                                         schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                     schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
+		"kubevirt.io/api/core/v1.InterfaceNetworkBoot":                                                    schema_kubevirtio_api_core_v1_InterfaceNetworkBoot(ref),
 		"kubevirt.io/api/core/v1.InterfacePasstBinding":                                                   schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                          schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
 		"kubevirt.io/api/core/v1.KSMConfiguration":                                                        schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
@@ -466,11 +489,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.KubeVirtCondition":                                                       schema_kubevirtio_api_core_v1_KubeVirtCondition(ref),
 		"kubevirt.io/api/core/v1.KubeVirtConfiguration":                                                   schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref),
 		"kubevirt.io/api/core/v1.KubeVirtList":                                                            schema_kubevirtio_api_core_v1_KubeVirtList(ref),
+		"kubevirt.io/api/core/v1.KubeVirtRolloutStrategy":                                                 schema_kubevirtio_api_core_v1_KubeVirtRolloutStrategy(ref),
 		"kubevirt.io/api/core/v1.KubeVirtSelfSignConfiguration":                                           schema_kubevirtio_api_core_v1_KubeVirtSelfSignConfiguration(ref),
 		"kubevirt.io/api/core/v1.KubeVirtSpec":                                                            schema_kubevirtio_api_core_v1_KubeVirtSpec(ref),
 		"kubevirt.io/api/core/v1.KubeVirtStatus":                                                          schema_kubevirtio_api_core_v1_KubeVirtStatus(ref),
 		"kubevirt.io/api/core/v1.KubeVirtWorkloadUpdateStrategy":                                          schema_kubevirtio_api_core_v1_KubeVirtWorkloadUpdateStrategy(ref),
 		"kubevirt.io/api/core/v1.LaunchSecurity":                                                          schema_kubevirtio_api_core_v1_LaunchSecurity(ref),
+		"kubevirt.io/api/core/v1.LauncherReservation":                                                     schema_kubevirtio_api_core_v1_LauncherReservation(ref),
+		"kubevirt.io/api/core/v1.LauncherWatchdogConfiguration":                                           schema_kubevirtio_api_core_v1_LauncherWatchdogConfiguration(ref),
 		"kubevirt.io/api/core/v1.LiveUpdateConfiguration":                                                 schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref),
 		"kubevirt.io/api/core/v1.LogVerbosity":                                                            schema_kubevirtio_api_core_v1_LogVerbosity(ref),
 		"kubevirt.io/api/core/v1.LunTarget":                                                               schema_kubevirtio_api_core_v1_LunTarget(ref),
@@ -478,24 +504,32 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                            schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
 		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                      schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
 		"kubevirt.io/api/core/v1.Memory":                                                                  schema_kubevirtio_api_core_v1_Memory(ref),
+		"kubevirt.io/api/core/v1.MemoryBalloon":                                                           schema_kubevirtio_api_core_v1_MemoryBalloon(ref),
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                                  schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
+		"kubevirt.io/api/core/v1.MemoryOvercommitClass":                                                   schema_kubevirtio_api_core_v1_MemoryOvercommitClass(ref),
+		"kubevirt.io/api/core/v1.MemoryOvercommitConfiguration":                                           schema_kubevirtio_api_core_v1_MemoryOvercommitConfiguration(ref),
 		"kubevirt.io/api/core/v1.MemoryStatus":                                                            schema_kubevirtio_api_core_v1_MemoryStatus(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                          schema_kubevirtio_api_core_v1_MigrateOptions(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                                  schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                           schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                                    schema_kubevirtio_api_core_v1_NUMA(ref),
+		"kubevirt.io/api/core/v1.NUMACell":                                                                schema_kubevirtio_api_core_v1_NUMACell(ref),
+		"kubevirt.io/api/core/v1.NUMAEvenSplit":                                                           schema_kubevirtio_api_core_v1_NUMAEvenSplit(ref),
 		"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough":                                             schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref),
 		"kubevirt.io/api/core/v1.Network":                                                                 schema_kubevirtio_api_core_v1_Network(ref),
 		"kubevirt.io/api/core/v1.NetworkConfiguration":                                                    schema_kubevirtio_api_core_v1_NetworkConfiguration(ref),
 		"kubevirt.io/api/core/v1.NetworkSource":                                                           schema_kubevirtio_api_core_v1_NetworkSource(ref),
 		"kubevirt.io/api/core/v1.NoCloudSSHPublicKeyAccessCredentialPropagation":                          schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagation(ref),
+		"kubevirt.io/api/core/v1.NodeConfigurationOverride":                                               schema_kubevirtio_api_core_v1_NodeConfigurationOverride(ref),
 		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                           schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
 		"kubevirt.io/api/core/v1.NodePlacement":                                                           schema_kubevirtio_api_core_v1_NodePlacement(ref),
+		"kubevirt.io/api/core/v1.NodePoolRolloutStrategy":                                                 schema_kubevirtio_api_core_v1_NodePoolRolloutStrategy(ref),
 		"kubevirt.io/api/core/v1.ObjectGraphNode":                                                         schema_kubevirtio_api_core_v1_ObjectGraphNode(ref),
 		"kubevirt.io/api/core/v1.ObjectGraphOptions":                                                      schema_kubevirtio_api_core_v1_ObjectGraphOptions(ref),
 		"kubevirt.io/api/core/v1.PITTimer":                                                                schema_kubevirtio_api_core_v1_PITTimer(ref),
 		"kubevirt.io/api/core/v1.PanicDevice":                                                             schema_kubevirtio_api_core_v1_PanicDevice(ref),
 		"kubevirt.io/api/core/v1.PauseOptions":                                                            schema_kubevirtio_api_core_v1_PauseOptions(ref),
+		"kubevirt.io/api/core/v1.PciDiscoveryRule":                                                        schema_kubevirtio_api_core_v1_PciDiscoveryRule(ref),
 		"kubevirt.io/api/core/v1.PciHostDevice":                                                           schema_kubevirtio_api_core_v1_PciHostDevice(ref),
 		"kubevirt.io/api/core/v1.PermittedHostDevices":                                                    schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
 		"kubevirt.io/api/core/v1.PersistentReservationConfiguration":                                      schema_kubevirtio_api_core_v1_PersistentReservationConfiguration(ref),
@@ -503,10 +537,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                       schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
 		"kubevirt.io/api/core/v1.PluginBinding":                                                           schema_kubevirtio_api_core_v1_PluginBinding(ref),
 		"kubevirt.io/api/core/v1.PodNetwork":                                                              schema_kubevirtio_api_core_v1_PodNetwork(ref),
+		"kubevirt.io/api/core/v1.PoolMetricsAdapterConfiguration":                                         schema_kubevirtio_api_core_v1_PoolMetricsAdapterConfiguration(ref),
 		"kubevirt.io/api/core/v1.Port":                                                                    schema_kubevirtio_api_core_v1_Port(ref),
+		"kubevirt.io/api/core/v1.PreShutdownHook":                                                         schema_kubevirtio_api_core_v1_PreShutdownHook(ref),
 		"kubevirt.io/api/core/v1.PreferenceMatcher":                                                       schema_kubevirtio_api_core_v1_PreferenceMatcher(ref),
 		"kubevirt.io/api/core/v1.Probe":                                                                   schema_kubevirtio_api_core_v1_Probe(ref),
 		"kubevirt.io/api/core/v1.ProfilerResult":                                                          schema_kubevirtio_api_core_v1_ProfilerResult(ref),
+		"kubevirt.io/api/core/v1.QEMUArg":                                                                 schema_kubevirtio_api_core_v1_QEMUArg(ref),
+		"kubevirt.io/api/core/v1.QEMUArgsPassthroughConfiguration":                                        schema_kubevirtio_api_core_v1_QEMUArgsPassthroughConfiguration(ref),
+		"kubevirt.io/api/core/v1.QEMUCommandLine":                                                         schema_kubevirtio_api_core_v1_QEMUCommandLine(ref),
 		"kubevirt.io/api/core/v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation":                   schema_kubevirtio_api_core_v1_QemuGuestAgentSSHPublicKeyAccessCredentialPropagation(ref),
 		"kubevirt.io/api/core/v1.QemuGuestAgentUserPasswordAccessCredentialPropagation":                   schema_kubevirtio_api_core_v1_QemuGuestAgentUserPasswordAccessCredentialPropagation(ref),
 		"kubevirt.io/api/core/v1.RESTClientConfiguration":                                                 schema_kubevirtio_api_core_v1_RESTClientConfiguration(ref),
@@ -519,7 +558,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ResourceRequirements":                                                    schema_kubevirtio_api_core_v1_ResourceRequirements(ref),
 		"kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims":                                       schema_kubevirtio_api_core_v1_ResourceRequirementsWithoutClaims(ref),
 		"kubevirt.io/api/core/v1.RestartOptions":                                                          schema_kubevirtio_api_core_v1_RestartOptions(ref),
+		"kubevirt.io/api/core/v1.ResumeOptions":                                                           schema_kubevirtio_api_core_v1_ResumeOptions(ref),
 		"kubevirt.io/api/core/v1.Rng":                                                                     schema_kubevirtio_api_core_v1_Rng(ref),
+		"kubevirt.io/api/core/v1.RollbackOptions":                                                         schema_kubevirtio_api_core_v1_RollbackOptions(ref),
 		"kubevirt.io/api/core/v1.SEV":                                                                     schema_kubevirtio_api_core_v1_SEV(ref),
 		"kubevirt.io/api/core/v1.SEVAttestation":                                                          schema_kubevirtio_api_core_v1_SEVAttestation(ref),
 		"kubevirt.io/api/core/v1.SEVMeasurementInfo":                                                      schema_kubevirtio_api_core_v1_SEVMeasurementInfo(ref),
@@ -528,6 +569,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SEVSNP":                                                                  schema_kubevirtio_api_core_v1_SEVSNP(ref),
 		"kubevirt.io/api/core/v1.SEVSecretOptions":                                                        schema_kubevirtio_api_core_v1_SEVSecretOptions(ref),
 		"kubevirt.io/api/core/v1.SEVSessionOptions":                                                       schema_kubevirtio_api_core_v1_SEVSessionOptions(ref),
+		"kubevirt.io/api/core/v1.SMBIOS":                                                                  schema_kubevirtio_api_core_v1_SMBIOS(ref),
+		"kubevirt.io/api/core/v1.SMBIOSBaseBoard":                                                         schema_kubevirtio_api_core_v1_SMBIOSBaseBoard(ref),
+		"kubevirt.io/api/core/v1.SMBIOSSystem":                                                            schema_kubevirtio_api_core_v1_SMBIOSSystem(ref),
 		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                     schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
 		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredential":                                            schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredential(ref),
 		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialPropagationMethod":                           schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialPropagationMethod(ref),
@@ -535,13 +579,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ScreenshotOptions":                                                       schema_kubevirtio_api_core_v1_ScreenshotOptions(ref),
 		"kubevirt.io/api/core/v1.SeccompConfiguration":                                                    schema_kubevirtio_api_core_v1_SeccompConfiguration(ref),
 		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                      schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
+		"kubevirt.io/api/core/v1.SerialConsoleLogRotation":                                                schema_kubevirtio_api_core_v1_SerialConsoleLogRotation(ref),
+		"kubevirt.io/api/core/v1.SerialDevice":                                                            schema_kubevirtio_api_core_v1_SerialDevice(ref),
 		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                              schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
 		"kubevirt.io/api/core/v1.SoundDevice":                                                             schema_kubevirtio_api_core_v1_SoundDevice(ref),
 		"kubevirt.io/api/core/v1.StartOptions":                                                            schema_kubevirtio_api_core_v1_StartOptions(ref),
 		"kubevirt.io/api/core/v1.StopOptions":                                                             schema_kubevirtio_api_core_v1_StopOptions(ref),
 		"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo":                                               schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref),
+		"kubevirt.io/api/core/v1.SubresourceConnectionLimits":                                             schema_kubevirtio_api_core_v1_SubresourceConnectionLimits(ref),
 		"kubevirt.io/api/core/v1.SupportContainerResources":                                               schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
+		"kubevirt.io/api/core/v1.SuspendOptions":                                                          schema_kubevirtio_api_core_v1_SuspendOptions(ref),
 		"kubevirt.io/api/core/v1.SyNICTimer":                                                              schema_kubevirtio_api_core_v1_SyNICTimer(ref),
+		"kubevirt.io/api/core/v1.SysprepProjection":                                                       schema_kubevirtio_api_core_v1_SysprepProjection(ref),
 		"kubevirt.io/api/core/v1.SysprepSource":                                                           schema_kubevirtio_api_core_v1_SysprepSource(ref),
 		"kubevirt.io/api/core/v1.TDX":                                                                     schema_kubevirtio_api_core_v1_TDX(ref),
 		"kubevirt.io/api/core/v1.TDXAttestationConfiguration":                                             schema_kubevirtio_api_core_v1_TDXAttestationConfiguration(ref),
@@ -562,20 +611,28 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                      schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
 		"kubevirt.io/api/core/v1.VGPUOptions":                                                             schema_kubevirtio_api_core_v1_VGPUOptions(ref),
 		"kubevirt.io/api/core/v1.VMISelector":                                                             schema_kubevirtio_api_core_v1_VMISelector(ref),
+		"kubevirt.io/api/core/v1.VMInfoMetricsConfiguration":                                              schema_kubevirtio_api_core_v1_VMInfoMetricsConfiguration(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                            schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
+		"kubevirt.io/api/core/v1.VendorCPUModel":                                                          schema_kubevirtio_api_core_v1_VendorCPUModel(ref),
 		"kubevirt.io/api/core/v1.VideoDevice":                                                             schema_kubevirtio_api_core_v1_VideoDevice(ref),
 		"kubevirt.io/api/core/v1.VirtTemplateDeployment":                                                  schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref),
+		"kubevirt.io/api/core/v1.VirtioDriversConfiguration":                                              schema_kubevirtio_api_core_v1_VirtioDriversConfiguration(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                          schema_kubevirtio_api_core_v1_VirtualMachine(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineCompletion":                                                schema_kubevirtio_api_core_v1_VirtualMachineCompletion(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                                 schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineDependency":                                                schema_kubevirtio_api_core_v1_VirtualMachineDependency(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                                  schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCommonMigrationState":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceDomainXML":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainXML(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemDisk":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemList":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestAgentInfo":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestExecOutput":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestExecOutput(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestFile":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestFile(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUser(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUserList":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref),
@@ -609,10 +666,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineList":                                                      schema_kubevirtio_api_core_v1_VirtualMachineList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest":                                         schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineOptions":                                                   schema_kubevirtio_api_core_v1_VirtualMachineOptions(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineSchedule":                                                  schema_kubevirtio_api_core_v1_VirtualMachineSchedule(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineScheduleStatus":                                            schema_kubevirtio_api_core_v1_VirtualMachineScheduleStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineSpec":                                                      schema_kubevirtio_api_core_v1_VirtualMachineSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineStartFailure":                                              schema_kubevirtio_api_core_v1_VirtualMachineStartFailure(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest":                                        schema_kubevirtio_api_core_v1_VirtualMachineStateChangeRequest(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineStatus":                                                    schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineSuspendStatus":                                             schema_kubevirtio_api_core_v1_VirtualMachineSuspendStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineVolumeRequest":                                             schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref),
 		"kubevirt.io/api/core/v1.Volume":                                                                  schema_kubevirtio_api_core_v1_Volume(ref),
 		"kubevirt.io/api/core/v1.VolumeMigrationState":                                                    schema_kubevirtio_api_core_v1_VolumeMigrationState(ref),
@@ -622,6 +682,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VolumeUpdateState":                                                       schema_kubevirtio_api_core_v1_VolumeUpdateState(ref),
 		"kubevirt.io/api/core/v1.Watchdog":                                                                schema_kubevirtio_api_core_v1_Watchdog(ref),
 		"kubevirt.io/api/core/v1.WatchdogDevice":                                                          schema_kubevirtio_api_core_v1_WatchdogDevice(ref),
+		"kubevirt.io/api/core/v1.WorkflowAlertsDeployment":                                                schema_kubevirtio_api_core_v1_WorkflowAlertsDeployment(ref),
+		"kubevirt.io/api/core/v1.WorkloadUpdateWindow":                                                    schema_kubevirtio_api_core_v1_WorkloadUpdateWindow(ref),
+		"kubevirt.io/api/defaults/v1alpha1.NetworkBinding":                                                schema_kubevirtio_api_defaults_v1alpha1_NetworkBinding(ref),
+		"kubevirt.io/api/defaults/v1alpha1.VirtualMachineDefaults":                                        schema_kubevirtio_api_defaults_v1alpha1_VirtualMachineDefaults(ref),
+		"kubevirt.io/api/defaults/v1alpha1.VirtualMachineDefaultsList":                                    schema_kubevirtio_api_defaults_v1alpha1_VirtualMachineDefaultsList(ref),
+		"kubevirt.io/api/defaults/v1alpha1.VirtualMachineDefaultsSpec":                                    schema_kubevirtio_api_defaults_v1alpha1_VirtualMachineDefaultsSpec(ref),
 		"kubevirt.io/api/export/v1.Condition":                                                             schema_kubevirtio_api_export_v1_Condition(ref),
 		"kubevirt.io/api/export/v1.VirtualMachineExport":                                                  schema_kubevirtio_api_export_v1_VirtualMachineExport(ref),
 		"kubevirt.io/api/export/v1.VirtualMachineExportBackup":                                            schema_kubevirtio_api_export_v1_VirtualMachineExportBackup(ref),
@@ -669,6 +735,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/instancetype/v1beta1.VirtualMachinePreferenceList":                               schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceList(ref),
 		"kubevirt.io/api/instancetype/v1beta1.VirtualMachinePreferenceSpec":                               schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceSpec(ref),
 		"kubevirt.io/api/instancetype/v1beta1.VolumePreferences":                                          schema_kubevirtio_api_instancetype_v1beta1_VolumePreferences(ref),
+		"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenance":                                            schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenance(ref),
+		"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenanceList":                                        schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenanceList(ref),
+		"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenancePendingVMI":                                  schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenancePendingVMI(ref),
+		"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenancePolicy":                                      schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenancePolicy(ref),
+		"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenanceSpec":                                        schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenanceSpec(ref),
+		"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenanceStatus":                                      schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenanceStatus(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicy":                                             schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicy(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicyList":                                         schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyList(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec":                                         schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref),
@@ -685,6 +757,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/plugin/v1alpha1.SidecarDomainHook":                                               schema_kubevirtio_api_plugin_v1alpha1_SidecarDomainHook(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachineOpportunisticUpdateStrategy":                         schema_kubevirtio_api_pool_v1alpha1_VirtualMachineOpportunisticUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePool":                                                schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAntiAffinity":                                    schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAntiAffinity(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolArchitectureVariant":                             schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolArchitectureVariant(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutohealingStrategy":                             schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAutohealingStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolCondition(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolList":                                            schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref),
@@ -696,12 +770,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolSelectionPolicy":                                 schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSelectionPolicy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolSelectors":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSelectors(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolSpec":                                            schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolStandby":                                         schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStandby(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolStatus":                                          schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologyDomain":                                  schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolTopologyDomain(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologySpread":                                  schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolTopologySpread(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologySpreadStatus":                            schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolTopologySpreadStatus(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUnmanagedStrategy":                               schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolUnmanagedStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy":                                  schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec":                                        schema_kubevirtio_api_pool_v1alpha1_VirtualMachineTemplateSpec(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachineOpportunisticUpdateStrategy":                          schema_kubevirtio_api_pool_v1beta1_VirtualMachineOpportunisticUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePool":                                                 schema_kubevirtio_api_pool_v1beta1_VirtualMachinePool(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAntiAffinity":                                     schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolAntiAffinity(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolArchitectureVariant":                              schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolArchitectureVariant(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAutohealingStrategy":                              schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolAutohealingStrategy(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolCondition":                                        schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolCondition(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolList":                                             schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolList(ref),
@@ -713,10 +793,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectionPolicy":                                  schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSelectionPolicy(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectors":                                        schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSelectors(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSpec":                                             schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSpec(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolStandby":                                          schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolStandby(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolStatus":                                           schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolStatus(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologyDomain":                                   schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolTopologyDomain(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologySpread":                                   schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolTopologySpread(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologySpreadStatus":                             schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolTopologySpreadStatus(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUnmanagedStrategy":                                schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolUnmanagedStrategy(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUpdateStrategy":                                   schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1beta1.VirtualMachineTemplateSpec":                                         schema_kubevirtio_api_pool_v1beta1_VirtualMachineTemplateSpec(ref),
+		"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuota":                                              schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuota(ref),
+		"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaLimits":                                        schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaLimits(ref),
+		"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaList":                                          schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaList(ref),
+		"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaReservation":                                   schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaReservation(ref),
+		"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaSpec":                                          schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaSpec(ref),
+		"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaStatus":                                        schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaStatus(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Condition":                                                     schema_kubevirtio_api_snapshot_v1alpha1_Condition(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Error":                                                         schema_kubevirtio_api_snapshot_v1alpha1_Error(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.PersistentVolumeClaim":                                         schema_kubevirtio_api_snapshot_v1alpha1_PersistentVolumeClaim(ref),
@@ -18709,6 +18799,48 @@ func schema_kubevirtio_api_core_v1_AddVolumeOptions(ref common.ReferenceCallback
 	}
 }
 
+func schema_kubevirtio_api_core_v1_AllowedQEMUArg(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AllowedQEMUArg allows a QEMU option, optionally restricted to some values.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the QEMU option, including its leading dash, e.g. \"-device\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"valuePrefixes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuePrefixes restricts the values of the option to the ones starting with one of the prefixes, e.g. \"pvpanic,\" only allows pvpanic devices. Any value is allowed if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ArchConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18787,9 +18919,33 @@ func schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref common.Referenc
 							Format: "",
 						},
 					},
+					"machineTypeAliases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypeAliases maps logical machine type names, e.g. q35-stable, to the machine types they stand for on this architecture, e.g. pc-q35-rhel9.6.0. Aliases used as machine type of a VM or VMI, or as machineType, are replaced with the machine type they stand for on admission.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"firmware": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Firmware selects the EFI firmware images booted by the VMIs of this architecture.",
+							Ref:         ref("kubevirt.io/api/core/v1.EFIFirmwareConfiguration"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.EFIFirmwareConfiguration"},
 	}
 }
 
@@ -19002,6 +19158,13 @@ func schema_kubevirtio_api_core_v1_CPU(ref common.ReferenceCallback) common.Open
 							Ref:         ref("kubevirt.io/api/core/v1.Realtime"),
 						},
 					},
+					"nestedVirtualization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NestedVirtualization controls whether the guest can run virtual machines itself. Require schedules the VMI onto nodes exposing VMX or SVM to their guests, Forbid hides VMX and SVM from the guest. By default the guest gets them when both the CPU model and the node provide them.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -19039,6 +19202,83 @@ func schema_kubevirtio_api_core_v1_CPUFeature(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CPUModelsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUModelsConfiguration holds the CPU models which the nodes offer to the VirtualMachineInstances. Changes are reflected live on the node labels.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"obsolete": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Obsolete lists CPU models which are not offered by the nodes, in addition to obsoleteCPUModels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowed": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Allowed restricts the CPU models offered by the nodes to the listed ones. All the CPU models which are not obsolete are offered when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"minModels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"vendor",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MinModels sets the minimum CPU model per CPU vendor. The host model of the nodes which can't run the minimum CPU model of their vendor is obsolete, so that these nodes don't run host-model VirtualMachineInstances.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VendorCPUModel"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VendorCPUModel"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CPUTopology(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19256,17 +19496,29 @@ func schema_kubevirtio_api_core_v1_Clock(ref common.ReferenceCallback) common.Op
 							Format:      "",
 						},
 					},
+					"variable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Variable lets the guest change its clock freely relative to the basis. The guest changes are kept during reboots and migrations.",
+							Ref:         ref("kubevirt.io/api/core/v1.ClockOffsetVariable"),
+						},
+					},
 					"timer": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Timer specifies whih timers are attached to the vmi.",
 							Ref:         ref("kubevirt.io/api/core/v1.Timer"),
 						},
 					},
+					"guestTimeSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestTimeSync controls when the guest clock is set to the host time through the guest agent, after the guest was not running for some time.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestTimeSync"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClockOffsetUTC", "kubevirt.io/api/core/v1.Timer"},
+			"kubevirt.io/api/core/v1.ClockOffsetUTC", "kubevirt.io/api/core/v1.ClockOffsetVariable", "kubevirt.io/api/core/v1.GuestTimeSync", "kubevirt.io/api/core/v1.Timer"},
 	}
 }
 
@@ -19290,11 +19542,17 @@ func schema_kubevirtio_api_core_v1_ClockOffset(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"variable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Variable lets the guest change its clock freely relative to the basis. The guest changes are kept during reboots and migrations.",
+							Ref:         ref("kubevirt.io/api/core/v1.ClockOffsetVariable"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClockOffsetUTC"},
+			"kubevirt.io/api/core/v1.ClockOffsetUTC", "kubevirt.io/api/core/v1.ClockOffsetVariable"},
 	}
 }
 
@@ -19318,6 +19576,33 @@ func schema_kubevirtio_api_core_v1_ClockOffsetUTC(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ClockOffsetVariable(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClockOffsetVariable lets the guest change its clock freely.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"basis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Basis the offset is relative to. One of \"utc\", \"localtime\". Defaults to \"utc\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"offsetSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OffsetSeconds specifies the initial offset in seconds, relative to the basis.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CloudInitConfigDriveSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19420,6 +19705,13 @@ func schema_kubevirtio_api_core_v1_CloudInitNoCloudSource(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"generateNetworkData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GenerateNetworkData generates a version 2 cloud-init networkdata from the interfaces of the VirtualMachineInstance, matching them by MAC address and setting their MTU and addressing. Can not be combined with another networkdata source.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -19718,6 +20010,39 @@ func schema_kubevirtio_api_core_v1_ControllerRevisionRef(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CrashLoopBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CrashLoopBackoff configures the delay before restarting a VirtualMachine whose VirtualMachineInstance failed before reaching the Running phase, or before having run for the reset window",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"baseDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaseDelay is the delay after the first failure. The delay after n consecutive failures is the base delay multiplied by the square of n. Defaults to a thirtieth of MaxDelay, and at least 10 seconds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDelay caps the delay. Defaults to 5 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"resetWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResetWindow is how long a VirtualMachineInstance has to run for the consecutive failures to be forgotten. Defaults to 0, forgetting them as soon as the VirtualMachineInstance is running.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CustomBlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20285,6 +20610,28 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"serials": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Serials defines additional named serial devices. Each of them is accessible through the console subresource by its name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.SerialDevice"),
+									},
+								},
+							},
+						},
+					},
 					"autoattachMemBalloon": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.",
@@ -20306,6 +20653,13 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"autoattachVirtioDriversDisk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to attach the virtio-win drivers disk configured on the cluster to Windows guests, as indicated by the preference of the VirtualMachine. Meant for the installation of the guest, the disk is no longer attached on the next start once set to false. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"rng": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Whether to have random number generator from host",
@@ -20365,6 +20719,12 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"guestPanicCapture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestPanicCapture configures what is captured when the guest kernel panics. A pvpanic device is added if no panic device is provided.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestPanicCapture"),
+						},
+					},
 					"filesystems": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -20431,7 +20791,7 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
+			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.GuestPanicCapture", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SerialDevice", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
 	}
 }
 
@@ -20444,7 +20804,7 @@ func schema_kubevirtio_api_core_v1_Diag288Watchdog(ref common.ReferenceCallback)
 				Properties: map[string]spec.Schema{
 					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.",
+							Description: "The action to take. Valid values are poweroff, reset, shutdown, dump. Defaults to reset.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -20820,12 +21180,59 @@ func schema_kubevirtio_api_core_v1_DomainSpec(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"qemuCommandLine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QEMUCommandLine holds raw arguments appended to the QEMU command line. The arguments must be allowed by the qemuArgsPassthrough configuration of the KubeVirt CR. Requires the QEMUArgsPassthrough feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.QEMUCommandLine"),
+						},
+					},
 				},
 				Required: []string{"devices"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPU", "kubevirt.io/api/core/v1.Chassis", "kubevirt.io/api/core/v1.Clock", "kubevirt.io/api/core/v1.Devices", "kubevirt.io/api/core/v1.DiskIOThreads", "kubevirt.io/api/core/v1.Features", "kubevirt.io/api/core/v1.Firmware", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.Memory", "kubevirt.io/api/core/v1.ResourceRequirements"},
+			"kubevirt.io/api/core/v1.CPU", "kubevirt.io/api/core/v1.Chassis", "kubevirt.io/api/core/v1.Clock", "kubevirt.io/api/core/v1.Devices", "kubevirt.io/api/core/v1.DiskIOThreads", "kubevirt.io/api/core/v1.Features", "kubevirt.io/api/core/v1.Firmware", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.Memory", "kubevirt.io/api/core/v1.QEMUCommandLine", "kubevirt.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DomainStatsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DomainStatsConfiguration holds the settings of the domain statistics collection.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"collectionInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CollectionInterval is how often virt-launcher refreshes the domain statistics from libvirt. Defaults to 3.25s. Changes only apply to VMIs started afterwards.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"disabledCollectors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DisabledCollectors lists the domain statistics which are neither collected from libvirt nor exposed as metrics. Supported values are block (per-disk), vcpu (per-vCPU) and network (per-interface). Disabling vcpu also removes the vCPU statistics from the downward metrics. The metrics are removed immediately, the collection from libvirt only stops for VMIs started afterwards.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -20913,6 +21320,135 @@ func schema_kubevirtio_api_core_v1_EFI(ref common.ReferenceCallback) common.Open
 	}
 }
 
+func schema_kubevirtio_api_core_v1_EFIFirmwareConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EFIFirmwareConfiguration selects the EFI firmware images per security feature. The file names are relative to ovmfPath, or to the root of Image if set. The images which are not set are looked up under their default names, e.g. OVMF_CODE.secboot.fd or AAVMF_CODE.fd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is a container image holding the firmware images. It is mounted into virt-launcher instead of looking the images up in ovmfPath. Requires the ImageVolume feature gate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default is booted by VMIs without SecureBoot and confidential computing.",
+							Ref:         ref("kubevirt.io/api/core/v1.EFIFirmwareImages"),
+						},
+					},
+					"secureBoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecureBoot is booted by VMIs with SecureBoot enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.EFIFirmwareImages"),
+						},
+					},
+					"sev": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SEV is booted by SEV and SEV-ES VMIs.",
+							Ref:         ref("kubevirt.io/api/core/v1.EFIFirmwareImages"),
+						},
+					},
+					"snp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SNP is booted by SEV-SNP VMIs. The firmware is stateless, Vars is ignored.",
+							Ref:         ref("kubevirt.io/api/core/v1.EFIFirmwareImages"),
+						},
+					},
+					"tdx": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TDX is booted by TDX VMIs. The firmware is stateless, Vars is ignored.",
+							Ref:         ref("kubevirt.io/api/core/v1.EFIFirmwareImages"),
+						},
+					},
+					"tdxSecureBoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TDXSecureBoot is booted by TDX VMIs with SecureBoot enabled. The firmware is stateless, Vars is ignored.",
+							Ref:         ref("kubevirt.io/api/core/v1.EFIFirmwareImages"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.EFIFirmwareImages"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_EFIFirmwareImages(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EFIFirmwareImages names the images of an EFI firmware.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"code": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Code is the file name of the firmware code, e.g. OVMF_CODE.secboot.fd.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vars": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vars is the file name of the template of the UEFI variable store, e.g. OVMF_VARS.secboot.fd.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"code"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_EjectMediaOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EjectMediaOptions is provided when ejecting the media of a CD-ROM drive",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the CD-ROM disk the media is ejected from",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_EmptyDiskSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21010,6 +21546,53 @@ func schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_core_v1_EventConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventConfiguration holds the settings used to keep recurring events from flooding the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rateLimiter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimiter limits the rate at which each virt-controller and virt-handler instance emits events. Events beyond the limit are dropped.",
+							Ref:         ref("kubevirt.io/api/core/v1.TokenBucketRateLimiter"),
+						},
+					},
+					"deduplicationWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeduplicationWindow drops an event if an event with the same type, reason and message was already emitted for the same object within the window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"suppressedReasons": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SuppressedReasons lists event reasons, e.g. FailedScheduling, which are never emitted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.TokenBucketRateLimiter"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_FeatureAPIC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21036,6 +21619,60 @@ func schema_kubevirtio_api_core_v1_FeatureAPIC(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_FeatureGateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FeatureGateStatus reports the lifecycle of a feature gate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the feature gate",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stage of the feature gate",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default tells whether the feature is enabled when the feature gate is neither enabled nor disabled explicitly",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lockedToDefault": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LockedToDefault tells whether the feature gate can no longer be enabled or disabled, since the feature graduated or was discontinued",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled tells whether the feature is enabled in the cluster",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "stage", "default", "lockedToDefault", "enabled"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_FeatureHyperv(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21317,8 +21954,32 @@ func schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref common.ReferenceCallba
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache sets the caching policy of the shared directory in the guest. One of: auto, always, never. Defaults to auto.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"daxWindowSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DAXWindowSize enables DAX for the filesystem and sets the size of the shared memory window the guest maps the files into, bypassing its page cache. It must be a multiple of 2Mi. DAX is disabled if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"threadPoolSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadPoolSize sets the number of worker threads of the virtiofsd process serving the filesystem. Defaults to the virtiofsd default.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -21360,11 +22021,17 @@ func schema_kubevirtio_api_core_v1_Firmware(ref common.ReferenceCallback) common
 							Ref:         ref("kubevirt.io/api/core/v1.ACPI"),
 						},
 					},
+					"smbios": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SMBIOS defines the system and baseboard information and the OEM strings reported in the SMBIOS tables of the vmi. Unset values default to the cluster wide SMBIOS configuration.",
+							Ref:         ref("kubevirt.io/api/core/v1.SMBIOS"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ACPI", "kubevirt.io/api/core/v1.Bootloader", "kubevirt.io/api/core/v1.KernelBoot"},
+			"kubevirt.io/api/core/v1.ACPI", "kubevirt.io/api/core/v1.Bootloader", "kubevirt.io/api/core/v1.KernelBoot", "kubevirt.io/api/core/v1.SMBIOS"},
 	}
 }
 
@@ -21590,6 +22257,149 @@ func schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestAgentCommandsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgentCommandsConfiguration restricts the qemu-guest-agent commands KubeVirt may invoke, e.g. to disable guest-exec and guest-file-write. A denied command fails the feature relying on it, and the guest information reported by a denied command is not used.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowedCommands": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCommands are the only commands which may be invoked. All commands are allowed if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deniedCommands": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DeniedCommands may never be invoked, even if they are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestAgentData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgentData is the information reported by the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"os": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OS is the guest operating system information",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentOSInfo"),
+						},
+					},
+					"hostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hostname is the hostname of the guest",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentValue"),
+						},
+					},
+					"fqdn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FQDN is the fully qualified domain name of the guest, reported when the hostname of the guest is fully qualified",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentValue"),
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the timezone of the guest, as name and offset to UTC in seconds",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentValue"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.GuestAgentOSInfo", "kubevirt.io/api/core/v1.GuestAgentValue"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestAgentFileExists(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgentFileExists configures the guest-agent based file existence probe",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the absolute path of the file in the guest.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestAgentOSInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgentOSInfo is the guest operating system information reported by the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"info": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Info is the guest operating system information",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo"),
+						},
+					},
+					"lastUpdated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdated is the time the guest agent reported the current information",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"info", "lastUpdated"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21601,6 +22411,159 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestAgentValue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgentValue is a value reported by the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value reported by the guest agent",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdated is the time the guest agent reported the current value",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"value", "lastUpdated"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestExecConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestExecConfiguration configures the guestexec subresource, which runs commands in the guests through the guest agent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector allows running commands in the guests of the VirtualMachineInstances in the namespaces matching the selector. Commands can't be run in any namespace if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestExecOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestExecOptions are provided when running a command in the guest with the guestexec subresource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the path of the executable in the guest followed by its arguments",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is how long to wait for the command to exit",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"command"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestPanicCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestPanicCapture configures the capture of the guest state when the guest kernel panics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"consoleLogLines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsoleLogLines is the number of trailing lines of the serial console log recorded in the GuestPanicked condition. Requires the serial console log. Defaults to 20, at most 100.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"memoryDumpClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryDumpClaimName is the name of the PVC the guest memory is dumped to when the guest kernel panics. The crashed guest is kept until the memory dump completes. Requires the VirtualMachineInstance to be owned by a VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestTimeSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestTimeSync configures the guest clock synchronization through the guest agent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"afterMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AfterMigration sets the guest clock once the vmi is migrated. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"afterUnpause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AfterUnpause sets the guest clock once the vmi is unpaused. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the time the guest agent is retried for if it is not responsive. Defaults to 60.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21646,6 +22609,12 @@ func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.
 							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentPing"),
 						},
 					},
+					"guestAgentFileExists": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest. If the guest agent is not available, this probe will fail.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentFileExists"),
+						},
+					},
 					"httpGet": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HTTPGet specifies the http request to perform.",
@@ -21662,7 +22631,7 @@ func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentPing"},
+			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentFileExists", "kubevirt.io/api/core/v1.GuestAgentPing"},
 	}
 }
 
@@ -21899,7 +22868,76 @@ func schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref common.ReferenceCallback
 				Properties: map[string]spec.Schema{
 					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.",
+							Description: "The action to take. Valid values are poweroff, reset, shutdown, inject-nmi, dump. Defaults to reset.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_Ignition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Ignition is the Ignition config of a CoreOS-family guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fragments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Fragments make up the Ignition config. A single fragment is passed to the guest as is, several fragments are merged in order into a spec 3 config, so each of them must be a spec 3 config.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.IgnitionFragment"),
+									},
+								},
+							},
+						},
+					},
+					"propagation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Propagation is one of FirmwareConfig and ConfigDrive. Defaults to FirmwareConfig. ConfigDrive requires a cloudInitConfigDrive volume without user data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"fragments"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.IgnitionFragment"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_IgnitionFragment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IgnitionFragment is a part of the Ignition config. Exactly one of its sources must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data is an inline Ignition config.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of a Secret or ConfigMap volume of the VirtualMachineInstance, holding the Ignition config under the config.ign key.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -21966,6 +23004,56 @@ func schema_kubevirtio_api_core_v1_Input(ref common.ReferenceCallback) common.Op
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InsertMediaOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InsertMediaOptions is provided when inserting or changing the media of a CD-ROM drive",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the CD-ROM disk the media is inserted into",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSource represents the source of the media",
+							Ref:         ref("kubevirt.io/api/core/v1.HotplugVolumeSource"),
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "volumeSource"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.HotplugVolumeSource"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21979,9 +23067,17 @@ func schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"virtioDrivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtioDrivers defines the source of the virtio-win drivers disk attached to Windows guests requesting it through autoattachVirtioDriversDisk.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtioDriversConfiguration"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtioDriversConfiguration"},
 	}
 }
 
@@ -22174,6 +23270,12 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "int32",
 						},
 					},
+					"networkBoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkBoot makes the interface the first boot device of the vmi. The disks and interfaces with a boot order are tried after it, following their boot order. Can not be combined with bootOrder.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceNetworkBoot"),
+						},
+					},
 					"pciAddress": {
 						SchemaProps: spec.SchemaProps{
 							Description: "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10",
@@ -22213,7 +23315,7 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasstBinding", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceNetworkBoot", "kubevirt.io/api/core/v1.InterfacePasstBinding", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
 	}
 }
 
@@ -22365,6 +23467,26 @@ func schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref common.ReferenceCallb
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InterfaceNetworkBoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceNetworkBoot configures booting the vmi from the network.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipxeScriptRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPXEScriptRef is the name of a ConfigMap volume holding an iPXE script under the script.ipxe key. The firmware of the interface runs the script instead of fetching the boot file offered by the DHCP server of the network. Only supported on interfaces with the masquerade binding.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22889,6 +24011,31 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref: ref("kubevirt.io/api/core/v1.SeccompConfiguration"),
 						},
 					},
+					"cpuModels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUModels configures the CPU models which the nodes offer to the VirtualMachineInstances.",
+							Ref:         ref("kubevirt.io/api/core/v1.CPUModelsConfiguration"),
+						},
+					},
+					"nodeOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeOverrides override the settings of the nodes matching their node selector, for clusters made of heterogeneous nodes. The first matching override applies to a node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.NodeConfigurationOverride"),
+									},
+								},
+							},
+						},
+					},
 					"vmStateStorageClass": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.",
@@ -22907,6 +24054,12 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref:         ref("kubevirt.io/api/core/v1.KSMConfiguration"),
 						},
 					},
+					"memoryOvercommitConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryOvercommitConfiguration holds the memory overcommit classes VMIs can opt into and the settings for running VMIs on swap enabled nodes.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryOvercommitConfiguration"),
+						},
+					},
 					"autoCPULimitNamespaceLabelSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside namespaces that match the label selector. The CPU limit will equal the number of requested vCPUs. This setting does not apply to VMIs with dedicated CPUs.",
@@ -22938,6 +24091,12 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref:         ref("kubevirt.io/api/core/v1.VirtTemplateDeployment"),
 						},
 					},
+					"workflowAlertsDeployment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkflowAlertsDeployment controls the deployment of the alerting rules covering migration and storage workflow failures",
+							Ref:         ref("kubevirt.io/api/core/v1.WorkflowAlertsDeployment"),
+						},
+					},
 					"instancetype": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Instancetype configuration",
@@ -22988,11 +24147,87 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"eventConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventConfiguration allows reducing the number of events emitted by virt-controller and virt-handler.",
+							Ref:         ref("kubevirt.io/api/core/v1.EventConfiguration"),
+						},
+					},
+					"domainStatsConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DomainStatsConfiguration allows reducing the libvirt load caused by the domain statistics collection on dense nodes.",
+							Ref:         ref("kubevirt.io/api/core/v1.DomainStatsConfiguration"),
+						},
+					},
+					"vmInfoMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMInfoMetrics controls the cardinality of the kubevirt_vm_metadata_info metric.",
+							Ref:         ref("kubevirt.io/api/core/v1.VMInfoMetricsConfiguration"),
+						},
+					},
+					"subresourceConnectionLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubresourceConnectionLimits caps the console, VNC and port-forward connections virt-api proxies to virt-handler. Connections are not limited if not set.",
+							Ref:         ref("kubevirt.io/api/core/v1.SubresourceConnectionLimits"),
+						},
+					},
+					"poolMetricsAdapter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PoolMetricsAdapter makes virt-api serve the guest metrics of the VirtualMachinePools, as aggregated by Prometheus, through the external metrics API, so that the pools can be scaled on them by the HorizontalPodAutoscaler. The metrics are not served if not set.",
+							Ref:         ref("kubevirt.io/api/core/v1.PoolMetricsAdapterConfiguration"),
+						},
+					},
+					"launcherWatchdog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LauncherWatchdog enables the detection of hung virt-launchers by virt-handler. virt-launchers are not watched if not set.",
+							Ref:         ref("kubevirt.io/api/core/v1.LauncherWatchdogConfiguration"),
+						},
+					},
+					"qemuArgsPassthrough": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QEMUArgsPassthrough lists the QEMU command line arguments VirtualMachineInstances may append. No argument is allowed if not set. Requires the QEMUArgsPassthrough feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.QEMUArgsPassthroughConfiguration"),
+						},
+					},
+					"guestAgentCommands": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentCommands restricts the qemu-guest-agent commands KubeVirt may invoke in the guests of all VirtualMachineInstances. All commands are allowed if not set.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentCommandsConfiguration"),
+						},
+					},
+					"guestExec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestExec restricts the namespaces in which commands may be run in the guests with the guestexec subresource. Requires the GuestExec feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestExecConfiguration"),
+						},
+					},
+					"launcherReservations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LauncherReservations keep placeholder pods on the nodes, which hold capacity and pull the virt-launcher image. A starting VirtualMachineInstance claims one of them, the placeholder pod is deleted and the virt-launcher pod prefers its node. The virt-launcher pod is still created and started for the VirtualMachineInstance, the placeholder pods never run it. No placeholder pods are kept if not set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.LauncherReservation"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.ConfidentialComputeConfiguration", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.HypervisorConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.PersistentReservationConfiguration", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtTemplateDeployment", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.CPUModelsConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.ConfidentialComputeConfiguration", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.DomainStatsConfiguration", "kubevirt.io/api/core/v1.EventConfiguration", "kubevirt.io/api/core/v1.GuestAgentCommandsConfiguration", "kubevirt.io/api/core/v1.GuestExecConfiguration", "kubevirt.io/api/core/v1.HypervisorConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LauncherReservation", "kubevirt.io/api/core/v1.LauncherWatchdogConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MemoryOvercommitConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.NodeConfigurationOverride", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.PersistentReservationConfiguration", "kubevirt.io/api/core/v1.PoolMetricsAdapterConfiguration", "kubevirt.io/api/core/v1.QEMUArgsPassthroughConfiguration", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SubresourceConnectionLimits", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VMInfoMetricsConfiguration", "kubevirt.io/api/core/v1.VirtTemplateDeployment", "kubevirt.io/api/core/v1.VirtualMachineOptions", "kubevirt.io/api/core/v1.WorkflowAlertsDeployment"},
 	}
 }
 
@@ -23045,6 +24280,27 @@ func schema_kubevirtio_api_core_v1_KubeVirtList(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_kubevirtio_api_core_v1_KubeVirtRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeVirtRolloutStrategy defines how updates of the KubeVirt components are rolled out",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtHandler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtHandler rolls updates of virt-handler out node pool by node pool. By default virt-handler is updated on a single canary node first and on 10% of the nodes at a time afterwards.",
+							Ref:         ref("kubevirt.io/api/core/v1.NodePoolRolloutStrategy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.NodePoolRolloutStrategy"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_KubeVirtSelfSignConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23171,6 +24427,12 @@ func schema_kubevirtio_api_core_v1_KubeVirtSpec(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"rolloutStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStrategy defines how updates of the KubeVirt components are rolled out",
+							Ref:         ref("kubevirt.io/api/core/v1.KubeVirtRolloutStrategy"),
+						},
+					},
 					"certificateRotateStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
@@ -23234,7 +24496,7 @@ func schema_kubevirtio_api_core_v1_KubeVirtSpec(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kubevirt.io/api/core/v1.ComponentConfig", "kubevirt.io/api/core/v1.CustomizeComponents", "kubevirt.io/api/core/v1.KubeVirtCertificateRotateStrategy", "kubevirt.io/api/core/v1.KubeVirtConfiguration", "kubevirt.io/api/core/v1.KubeVirtWorkloadUpdateStrategy"},
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevirt.io/api/core/v1.ComponentConfig", "kubevirt.io/api/core/v1.CustomizeComponents", "kubevirt.io/api/core/v1.KubeVirtCertificateRotateStrategy", "kubevirt.io/api/core/v1.KubeVirtConfiguration", "kubevirt.io/api/core/v1.KubeVirtRolloutStrategy", "kubevirt.io/api/core/v1.KubeVirtWorkloadUpdateStrategy"},
 	}
 }
 
@@ -23373,11 +24635,30 @@ func schema_kubevirtio_api_core_v1_KubeVirtStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"featureGates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates reports the lifecycle of the feature gates known to the deployed KubeVirt version",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FeatureGateStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition"},
+			"kubevirt.io/api/core/v1.FeatureGateStatus", "kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition"},
 	}
 }
 
@@ -23421,11 +24702,43 @@ func schema_kubevirtio_api_core_v1_KubeVirtWorkloadUpdateStrategy(ref common.Ref
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector limits automated workload updates to the VMIs in the namespaces matching the selector. Namespaces can opt out with a label excluded by the selector. The VMIs of other namespaces are only counted as outdated.\n\nDefaults to all namespaces",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"maxUpdatesPerNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUpdatesPerNamespace is the maximum number of VMIs of a single namespace which are migrated or evicted at the same time\n\nDefaults to no limit",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"windows": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows restricts automated workload updates to recurring time windows. Migrations and evictions are only started while a window is open.\n\nAn empty list allows workload updates at any time",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.WorkloadUpdateWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.WorkloadUpdateWindow"},
 	}
 }
 
@@ -23461,6 +24774,116 @@ func schema_kubevirtio_api_core_v1_LaunchSecurity(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_LauncherReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LauncherReservation keeps placeholder pods on the nodes matching its node selector.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the reservation, its pods are labeled with it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podsPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsPerNode is the number of placeholder pods kept on each node of the reservation.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes of the reservation. All schedulable nodes are selected if empty.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"instancetypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Instancetypes restricts the reservation to the VirtualMachineInstances created from one of these VirtualMachineClusterInstancetypes. Any VirtualMachineInstance may claim a pod if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources are requested by each placeholder pod, to hold the capacity of the VirtualMachineInstance which claims it. The claimed pod is deleted to release them.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "podsPerNode"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_LauncherWatchdogConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LauncherWatchdogConfiguration configures how virt-handler detects and recovers the virt-launchers whose libvirt or QEMU process is hung.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is how long the domain of a running VirtualMachineInstance may fail to report its statistics before its virt-launcher is considered hung. Defaults to 5 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is taken once a virt-launcher is considered hung. Alert only emits an event and increments the kubevirt_vmi_launcher_unresponsive_total metric, Fail additionally marks the VirtualMachineInstance as failed and Restart additionally kills the virt-launcher. Defaults to Alert.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23757,11 +25180,71 @@ func schema_kubevirtio_api_core_v1_Memory(ref common.ReferenceCallback) common.O
 							Ref:         ref("kubevirt.io/api/core/v1.ReservedOverhead"),
 						},
 					},
+					"ksm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KSM determines if the memory of the VirtualMachineInstance can be merged by Kernel Samepage Merging on nodes where KSM is enabled. Enabling it requires KSM to be configured on the cluster. Defaults to true.",
+							Ref:         ref("kubevirt.io/api/core/v1.FeatureState"),
+						},
+					},
+					"freePageReporting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreePageReporting determines if the memory balloon reports free guest pages back to the host. Enabling it requires free page reporting to be allowed on the cluster and is not possible for high performance VirtualMachineInstances. Defaults to the cluster configuration.",
+							Ref:         ref("kubevirt.io/api/core/v1.FeatureState"),
+						},
+					},
+					"overcommitClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OvercommitClass selects one of the memory overcommit classes defined in the KubeVirt configuration. The ratio of the class is used instead of the cluster wide memory overcommit.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"balloon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Balloon declares the bounds within which the memory balloon is automatically resized, based on the guest memory usage and the node memory pressure. Requires the AutoMemoryBalloon feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryBalloon"),
+						},
+					},
+					"suspendToDisk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuspendToDisk allows suspending the VirtualMachine to disk. The backend storage of the VirtualMachineInstance is sized to also hold the guest memory. Requires the SuspendToDisk feature gate.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages", "kubevirt.io/api/core/v1.ReservedOverhead"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.FeatureState", "kubevirt.io/api/core/v1.Hugepages", "kubevirt.io/api/core/v1.MemoryBalloon", "kubevirt.io/api/core/v1.ReservedOverhead"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MemoryBalloon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryBalloon declares the bounds of the automatic balloon resizing.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min is the lowest amount of memory the guest can be shrunk to.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max is the highest amount of memory the guest can be given back. Defaults to the guest memory.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"min"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -23800,6 +25283,87 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MemoryOvercommitClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryOvercommitClass defines a named memory overcommit policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the class, referenced by the VMIs.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memoryOvercommit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryOvercommit is the percentage of memory given to the guest compared to the amount requested by the virt-launcher pod.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"swap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Swap allows the memory of VMIs of this class to be backed by swap. Such VMIs are only scheduled to nodes labeled with kubevirt.io/swap-enabled, and are live migrated instead of being killed when evicted, unless they define their own eviction strategy.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "memoryOvercommit"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MemoryOvercommitConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryOvercommitConfiguration holds the cluster wide memory overcommit policies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"classes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Classes are named guest to requested memory ratios VMIs can select with spec.domain.memory.overcommitClass.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MemoryOvercommitClass"),
+									},
+								},
+							},
+						},
+					},
+					"pressureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PressureThreshold is the percentage of node memory, swap included, in use above which the node is labeled as being under memory overcommit pressure. Defaults to 90.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MemoryOvercommitClass"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23830,6 +25394,12 @@ func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"balloonTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BalloonTarget specifies the memory balloon size computed from the balloon bounds of the VirtualMachine.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 			},
 		},
@@ -24005,6 +25575,20 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"evictionShutdownPriorityThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionShutdownPriorityThreshold makes VMIs with the LiveMigrateIfPossible eviction strategy and a priority lower than the threshold shut down on eviction instead of being migrated, so that the migration capacity of a drain goes to the more important VMIs first. The priority of a VMI is the one of the PriorityClass referenced by spec.priorityClassName. Unset by default, in which case all VMIs are migrated.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"evictionMigrationTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionMigrationTimeout is the maximum number of seconds VMIs with the LiveMigrateOrShutdown eviction strategy are tried to be migrated on eviction, before they are shutdown. Defaults to 600",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -24054,11 +25638,88 @@ func schema_kubevirtio_api_core_v1_NUMA(ref common.ReferenceCallback) common.Ope
 							Ref:         ref("kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"),
 						},
 					},
+					"evenSplit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvenSplit will create a guest topology with the given number of virtual numa nodes and split the vCPUs and the guest memory evenly between them.",
+							Ref:         ref("kubevirt.io/api/core/v1.NUMAEvenSplit"),
+						},
+					},
+					"cells": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Cells explicitly declares the virtual numa nodes of the guest topology with their vCPUs and memory. Every vCPU must be assigned to exactly one cell and the memory of all cells must add up to the guest memory.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.NUMACell"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.NUMACell", "kubevirt.io/api/core/v1.NUMAEvenSplit", "kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NUMACell(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NUMACell declares a virtual numa node of the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUs is the list of vCPUs of the numa node, e.g. \"0-3,6\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the amount of guest memory of the numa node.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
+				Required: []string{"cpus", "memory"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NUMAEvenSplit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NUMAEvenSplit instructs kubevirt to split the vCPUs and the guest memory evenly between the virtual numa nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of virtual numa nodes of the guest.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"nodes"},
+			},
+		},
 	}
 }
 
@@ -24202,6 +25863,51 @@ func schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagatio
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NodeConfigurationOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeConfigurationOverride overrides the settings of the selected nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes the override applies to.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"virtualMachineInstancesPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstancesPerNode overrides virtualMachineInstancesPerNode, the maximum number of VirtualMachineInstances running on each of the selected nodes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"handlerResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HandlerResources overrides the resources reserved for virt-handler on the selected nodes. virt-handler is resized in place, hence its QoS class can't change.",
+							Ref:         ref("kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims"),
+						},
+					},
+				},
+				Required: []string{"nodeSelector"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24322,6 +26028,56 @@ func schema_kubevirtio_api_core_v1_NodePlacement(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NodePoolRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodePoolRolloutStrategy rolls an update out node pool by node pool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodePoolLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodePoolLabel is the key of the node label which groups the nodes into pools. The pools are updated one after another, ordered by the label value. Nodes without the label are updated last.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of nodes of a pool which are updated at once.\n\nDefaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"poolSoakSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PoolSoakSeconds is the time the updated pods have to be ready before the next pool is updated.\n\nDefaults to 60",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"errorBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorBudget is the number of updated pods which may fail before the rollout is paused. The rollout resumes once the failed pods recover.\n\nDefaults to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused pauses the rollout",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"nodePoolLabel"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ObjectGraphNode(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24517,6 +26273,43 @@ func schema_kubevirtio_api_core_v1_PauseOptions(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PciDiscoveryRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PciDiscoveryRule exposes the host PCI devices matching its selectors for passthrough",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pciVendorSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A glob matching the vendor_id:product_id tuple of the PCI devices, e.g. 10de:*",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"driver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the driver the PCI devices are bound to, e.g. vfio-pci. Matches any driver allowed for passthrough when empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the resource that is representing the matching devices. Exposed by a device plugin and requested by VMs. Typically of the form vendor.com/product_name",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"pciVendorSelector", "resourceName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PciHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24579,6 +26372,25 @@ func schema_kubevirtio_api_core_v1_PermittedHostDevices(ref common.ReferenceCall
 							},
 						},
 					},
+					"pciDiscoveryRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules exposing the host PCI devices they match, without listing each device",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.PciDiscoveryRule"),
+									},
+								},
+							},
+						},
+					},
 					"mediatedDevices": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -24619,7 +26431,7 @@ func schema_kubevirtio_api_core_v1_PermittedHostDevices(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciHostDevice", "kubevirt.io/api/core/v1.USBHostDevice"},
+			"kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciDiscoveryRule", "kubevirt.io/api/core/v1.PciHostDevice", "kubevirt.io/api/core/v1.USBHostDevice"},
 	}
 }
 
@@ -24820,6 +26632,28 @@ func schema_kubevirtio_api_core_v1_PodNetwork(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PoolMetricsAdapterConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PoolMetricsAdapterConfiguration configures the source of the external metrics of the VirtualMachinePools.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prometheusURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusURL is the URL of the Prometheus server evaluating the vmpool recording rules of KubeVirt, e.g. http://prometheus-k8s.monitoring:9090.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"prometheusURL"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Port(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24856,6 +26690,50 @@ func schema_kubevirtio_api_core_v1_Port(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PreShutdownHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PreShutdownHook is a command executed in the guest through the qemu-guest-agent when the VirtualMachineInstance is stopped. The termination grace period is split between the hook and the ACPI shutdown: the ACPI shutdown is signaled once the hook exited or timed out, and gets the rest of the grace period. The ACPI shutdown is signaled right away if the hook can not be executed, e.g. because the guest agent is not connected.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the path of the executable in the guest, e.g. a script stopping a database.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are passed to the command.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the part of the termination grace period reserved for the hook. It must be shorter than terminationGracePeriodSeconds. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"command"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PreferenceMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24923,6 +26801,12 @@ func schema_kubevirtio_api_core_v1_Probe(ref common.ReferenceCallback) common.Op
 							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentPing"),
 						},
 					},
+					"guestAgentFileExists": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentFileExists checks through the qemu-guest-agent that a file exists in the guest. If the guest agent is not available, this probe will fail.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentFileExists"),
+						},
+					},
 					"httpGet": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HTTPGet specifies the http request to perform.",
@@ -24974,7 +26858,7 @@ func schema_kubevirtio_api_core_v1_Probe(ref common.ReferenceCallback) common.Op
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentPing"},
+			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentFileExists", "kubevirt.io/api/core/v1.GuestAgentPing"},
 	}
 }
 
@@ -25004,6 +26888,107 @@ func schema_kubevirtio_api_core_v1_ProfilerResult(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_QEMUArg(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QEMUArg is a QEMU command line option and its value, e.g. \"-device\" and \"pvpanic\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the QEMU option, including its leading dash, e.g. \"-device\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value of the option, omitted for options without a value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_QEMUArgsPassthroughConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QEMUArgsPassthroughConfiguration holds the allowlist of the QEMU command line arguments.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowedArgs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedArgs are the QEMU options VirtualMachineInstances may append to their QEMU command line.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.AllowedQEMUArg"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.AllowedQEMUArg"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_QEMUCommandLine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QEMUCommandLine holds raw arguments appended to the QEMU command line.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are appended to the QEMU command line in the given order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.QEMUArg"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"args"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.QEMUArg"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_QemuGuestAgentSSHPublicKeyAccessCredentialPropagation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25134,6 +27119,13 @@ func schema_kubevirtio_api_core_v1_Realtime(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile applies a predefined set of realtime tunings to the VirtualMachineInstance. The lowLatency profile requires dedicated CPUs with an isolated emulator thread and a node with a realtime kernel. Unless configured otherwise, it requests guest mapping passthrough NUMA and the host-passthrough CPU model, locks the guest memory, disables the memory balloon and the HPET timer and delays missed PIT ticks. Hugepages still need to be requested explicitly.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -25376,6 +27368,53 @@ func schema_kubevirtio_api_core_v1_RestartOptions(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ResumeOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResumeOptions may be provided on resume request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Rng(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25387,6 +27426,60 @@ func schema_kubevirtio_api_core_v1_Rng(ref common.ReferenceCallback) common.Open
 	}
 }
 
+func schema_kubevirtio_api_core_v1_RollbackOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackOptions may be provided on rollback request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision to roll back to. Defaults to the revision preceding the current one.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SEV(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25630,6 +27723,139 @@ func schema_kubevirtio_api_core_v1_SEVSessionOptions(ref common.ReferenceCallbac
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SMBIOS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SMBIOS specifies the SMBIOS information passed to the domain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"system": {
+						SchemaProps: spec.SchemaProps{
+							Description: "System overrides the cluster wide system information (SMBIOS type 1). The uuid and the serial are set through the firmware.",
+							Ref:         ref("kubevirt.io/api/core/v1.SMBIOSSystem"),
+						},
+					},
+					"baseBoard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaseBoard overrides the cluster wide baseboard information (SMBIOS type 2).",
+							Ref:         ref("kubevirt.io/api/core/v1.SMBIOSBaseBoard"),
+						},
+					},
+					"oemStrings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OEMStrings replace the cluster wide OEM strings (SMBIOS type 11).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.SMBIOSBaseBoard", "kubevirt.io/api/core/v1.SMBIOSSystem"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SMBIOSBaseBoard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SMBIOSBaseBoard specifies the baseboard information passed to the domain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manufacturer": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"product": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"serial": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"asset": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SMBIOSSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SMBIOSSystem specifies the system information passed to the domain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manufacturer": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"product": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sku": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"family": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25666,9 +27892,37 @@ func schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref common.ReferenceCallb
 							Format: "",
 						},
 					},
+					"baseBoard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaseBoard is the default baseboard information of the VMIs.",
+							Ref:         ref("kubevirt.io/api/core/v1.SMBIOSBaseBoard"),
+						},
+					},
+					"oemStrings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OEMStrings are the default OEM strings of the VMIs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.SMBIOSBaseBoard"},
 	}
 }
 
@@ -25831,6 +28085,63 @@ func schema_kubevirtio_api_core_v1_SecretVolumeSource(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SerialConsoleLogRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SerialConsoleLogRotation configures the rotation of the serial console log",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the size at which the serial console log is rotated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the number of rotated serial console logs kept in the pod.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SerialDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SerialDevice is an additional serial device of the VMI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the serial device, used to select it when connecting to the console subresource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the serial device. One of: serial, virtio. A serial device is a serial port of the guest (e.g. ttyS1), a virtio device is a virtio console of the guest (e.g. hvc0). Defaults to serial.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26024,6 +28335,41 @@ func schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref common.Referenc
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SubresourceConnectionLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubresourceConnectionLimits holds the limits applied by virt-api to streaming subresource connections. Rejected connections are answered with 429 Too Many Requests and a Retry-After header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxConnectionsPerUser": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnectionsPerUser is the maximum number of concurrent connections a single user can hold.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxConnectionsPerVMI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnectionsPerVMI is the maximum number of concurrent connections to a single VirtualMachineInstance.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"connectionRateLimiter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionRateLimiter limits the rate at which a single user can open new connections.",
+							Ref:         ref("kubevirt.io/api/core/v1.TokenBucketRateLimiter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.TokenBucketRateLimiter"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SupportContainerResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26053,6 +28399,53 @@ func schema_kubevirtio_api_core_v1_SupportContainerResources(ref common.Referenc
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SuspendOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SuspendOptions may be provided on suspend request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SyNICTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26079,6 +28472,52 @@ func schema_kubevirtio_api_core_v1_SyNICTimer(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SysprepProjection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SysprepProjection adds the keys of a Secret or ConfigMap to a Sysprep disk. Exactly one of Secret and ConfigMap must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret references a k8s Secret in the namespace of the VirtualMachineInstance.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap references a ConfigMap in the namespace of the VirtualMachineInstance.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Items select the keys to add and their relative path on the disk, e.g. scripts/setup.ps1. All keys are added under their own name if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.KeyToPath"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.KeyToPath", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SysprepSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26098,11 +28537,30 @@ func schema_kubevirtio_api_core_v1_SysprepSource(ref common.ReferenceCallback) c
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
+					"sources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources assemble the disk from the keys of several Secrets and ConfigMaps, e.g. the answer file along with scripts and certificates. The answer file, autounattend.xml or unattend.xml, must be selected by the items of one of them. Cannot be combined with Secret and ConfigMap.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.SysprepProjection"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference"},
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevirt.io/api/core/v1.SysprepProjection"},
 	}
 }
 
@@ -26656,6 +29114,39 @@ func schema_kubevirtio_api_core_v1_VMISelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VMInfoMetricsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMInfoMetricsConfiguration holds the settings of the kubevirt_vm_metadata_info metric.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disabledLabels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DisabledLabels lists the labels of the kubevirt_vm_metadata_info metric which are reported empty. Supported values are instance_type, preference, guest_os, run_strategy and owner.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26682,35 +29173,46 @@ func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) co
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VideoDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VendorCPUModel(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VendorCPUModel is a CPU model of a given CPU vendor.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"vendor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies the video device type (e.g., virtio, vga, bochs, ramfb). If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).",
+							Description: "Vendor is the CPU vendor as reported by libvirt, e.g. Intel or AMD.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Model is the name of the CPU model.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"vendor", "model"},
 			},
 		},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VideoDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled controls the deployment of virt-template resources, defaults to True when feature gate is enabled.",
-							Type:        []string{"boolean"},
+							Description: "Type specifies the video device type (e.g., virtio, vga, bochs, ramfb). If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -26720,113 +29222,211 @@ func schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref common.ReferenceCa
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachine handles the VirtualMachines that are not running or are in a stopped state The VirtualMachine contains the template to create the VirtualMachineInstance. It also mirrors the running state of the created VirtualMachineInstance in its status.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "Enabled controls the deployment of virt-template resources, defaults to True when feature gate is enabled.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification of VirtualMachineInstance created",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status holds the current state of the controller and brief information about its associated VirtualMachineInstance",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStatus"),
-						},
-					},
 				},
-				Required: []string{"spec"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec", "kubevirt.io/api/core/v1.VirtualMachineStatus"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtioDriversConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineCondition represents the state of VirtualMachine",
+				Description: "VirtioDriversConfiguration defines the source of the virtio-win drivers disk.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"lastProbeTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Image is the containerDisk image providing the virtio-win drivers ISO.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"message": {
+					"persistentVolumeClaimName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "PersistentVolumeClaimName is the name of a PersistentVolumeClaim providing the virtio-win drivers ISO, looked up in the namespace of the VirtualMachine. Takes precedence over Image.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.",
+				Description: "VirtualMachine handles the VirtualMachines that are not running or are in a stopped state The VirtualMachine contains the template to create the VirtualMachineInstance. It also mirrors the running state of the created VirtualMachineInstance in its status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of VirtualMachineInstance created",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status holds the current state of the controller and brief information about its associated VirtualMachineInstance",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec", "kubevirt.io/api/core/v1.VirtualMachineStatus"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineCompletion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineCompletion records the final state of the VirtualMachineInstance of a VirtualMachine with the Once run strategy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the final phase the VirtualMachineInstance reached, either Succeeded or Failed",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time the completion was observed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineCondition represents the state of VirtualMachine",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDependency references a VirtualMachine which must be ready before the depending VirtualMachine is started",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VirtualMachine",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -27078,6 +29678,47 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.Re
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainXML(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceDomainXML represents the libvirt domain of a running VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domainXML": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DomainXML is the live libvirt domain XML of the VirtualMachineInstance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domainCapabilitiesXML": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DomainCapabilitiesXML is the libvirt domain capabilities XML of the node, for the emulator, architecture and machine type of the VirtualMachineInstance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -27162,6 +29803,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref comm
 							Format:  "",
 						},
 					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the VMI volume backing the disk, when it can be resolved",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"busType"},
 			},
@@ -27343,11 +29991,108 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref comm
 							Format:      "",
 						},
 					},
+					"lastPolled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastPolled is the time the guest agent last reported the guest OS information",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"pollError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PollError is set when the last poll of the guest OS information failed or timed out",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GuestAgentCommandInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.GuestAgentCommandInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestExecOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceGuestExecOutput is streamed by the guestexec subresource as a sequence of JSON objects. The last one holds the exit code of the command, or the error which prevented getting it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stdout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stdout is data written by the command to its standard output",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"stderr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stderr is data written by the command to its standard error",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"exitCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExitCode is the exit code of the command, set once it exited",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is set when the command could not be run or did not exit before the timeout",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceGuestFile represents a file inside the guest of a running VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the absolute path of the file inside the guest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the content of the file, at most 1MiB",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
 	}
 }
 
@@ -28989,11 +31734,18 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 					},
 					"evictionStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionStrategy describes the strategy to follow when a node drain occurs. The possible options are: - \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown. - \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown. - \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\". - \"External\": the VirtualMachineInstance will be protected and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.",
+							Description: "EvictionStrategy describes the strategy to follow when a node drain occurs. The possible options are: - \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown. - \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown. - \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\". - \"LiveMigrateOrShutdown\": the same as \"LiveMigrateIfPossible\", but the VirtualMachineInstance will be shutdown when it is not migrated within the eviction migration timeout. - \"External\": the VirtualMachineInstance will be protected and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"evictionMigrationTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionMigrationTimeoutSeconds is the maximum number of seconds the VirtualMachineInstance is tried to be migrated on eviction with the \"LiveMigrateOrShutdown\" eviction strategy, before it is shutdown. Overrides the cluster wide evictionMigrationTimeout of the migration configuration.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"startStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.",
@@ -29008,6 +31760,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							Format:      "int64",
 						},
 					},
+					"preShutdownHook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreShutdownHook is executed in the guest before the ACPI shutdown is signaled, to warn the applications of the guest, e.g. to let a database stop cleanly.",
+							Ref:         ref("kubevirt.io/api/core/v1.PreShutdownHook"),
+						},
+					},
 					"volumes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of volumes that can be mounted by disks belonging to the vmi.",
@@ -29146,12 +31904,24 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							},
 						},
 					},
+					"guestAgentCommands": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentCommands further restricts the qemu-guest-agent commands KubeVirt may invoke in the guest. A command must be allowed by both this and the cluster wide configuration.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentCommandsConfiguration"),
+						},
+					},
+					"ignition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ignition provisions CoreOS-family guests with an Ignition config. Unlike the kubevirt.io/ignitiondata annotation, the config can be read from Secrets and ConfigMaps and be made of several fragments. Requires the ExperimentalIgnitionSupport feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.Ignition"),
+						},
+					},
 				},
 				Required: []string{"domain"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.UtilityVolume", "kubevirt.io/api/core/v1.VirtualMachineInstanceResourceClaim", "kubevirt.io/api/core/v1.Volume"},
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.GuestAgentCommandsConfiguration", "kubevirt.io/api/core/v1.Ignition", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.PreShutdownHook", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.UtilityVolume", "kubevirt.io/api/core/v1.VirtualMachineInstanceResourceClaim", "kubevirt.io/api/core/v1.Volume"},
 	}
 }
 
@@ -29237,6 +32007,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo"),
 						},
 					},
+					"guestAgentData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentData contains the information reported by the guest agent along with the time it was reported. It is kept when the guest agent stops responding, see the GuestAgentDataStale condition.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentData"),
+						},
+					},
 					"migrationState": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Represents the status of a live migration",
@@ -29408,7 +32184,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
+			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.GuestAgentData", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
 	}
 }
 
@@ -29571,11 +32347,104 @@ func schema_kubevirtio_api_core_v1_VirtualMachineOptions(ref common.ReferenceCal
 							Ref:         ref("kubevirt.io/api/core/v1.DisableSerialConsoleLog"),
 						},
 					},
+					"serialConsoleLogRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SerialConsoleLogRotation limits the size of the serial console log streamed from the `guest-console-log` container. If not set, the log is rotated at 2Mi and three rotated logs are kept.",
+							Ref:         ref("kubevirt.io/api/core/v1.SerialConsoleLogRotation"),
+						},
+					},
+					"serialConsoleLogFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SerialConsoleLogFormat is the format of the serial console log streamed from the `guest-console-log` container. With `json`, every line of the serial console is written as a JSON object carrying the VMI, like the logs of the other KubeVirt components, so that log pipelines can parse and attribute it. Defaults to `text`, which writes the lines unchanged.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"crashLoopBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CrashLoopBackoff configures the delay before restarting VMs whose VMIs failed shortly after being started. The fields can be individually overridden for each VM.",
+							Ref:         ref("kubevirt.io/api/core/v1.CrashLoopBackoff"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DisableFreePageReporting", "kubevirt.io/api/core/v1.DisableSerialConsoleLog"},
+			"kubevirt.io/api/core/v1.CrashLoopBackoff", "kubevirt.io/api/core/v1.DisableFreePageReporting", "kubevirt.io/api/core/v1.DisableSerialConsoleLog", "kubevirt.io/api/core/v1.SerialConsoleLogRotation"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSchedule starts and stops a VirtualMachine at recurring times, e.g. to stop development VMs at night and start them again in the morning.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a cron expression, in the standard five fields format, at which the VirtualMachine is started.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stop is a cron expression, in the standard five fields format, at which the VirtualMachine is stopped.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone the cron expressions are evaluated in. Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"skipStopIfInUse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipStopIfInUse skips scheduled stops while the VirtualMachine is in use, that is while users are logged in the guest, or while it is migrating or being backed up. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineScheduleStatus reports the progress of the schedule of a VirtualMachine",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the last start or stop time which was handled, or the time the schedule was first evaluated at.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextStartTime is the next time the VirtualMachine is scheduled to start.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextStopTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextStopTime is the next time the VirtualMachine is scheduled to stop.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -29639,12 +32508,50 @@ func schema_kubevirtio_api_core_v1_VirtualMachineSpec(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule starts and stops the VirtualMachine at recurring times. Requires the VirtualMachineSchedule feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSchedule"),
+						},
+					},
+					"dependsOn": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists VirtualMachines of the same namespace which must be ready before this VirtualMachine is started. Requires the VirtualMachineDependencies feature gate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineDependency"),
+									},
+								},
+							},
+						},
+					},
+					"crashLoopBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CrashLoopBackoff configures the delay before restarting the VirtualMachine after its VirtualMachineInstance failed shortly after being started. Each field overrides the cluster wide one.",
+							Ref:         ref("kubevirt.io/api/core/v1.CrashLoopBackoff"),
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the number of old revisions of the spec to keep for rollbacks. Revisions are only recorded with the VirtualMachineRevisionHistory feature gate. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"template"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec"},
+			"kubevirt.io/api/core/v1.CrashLoopBackoff", "kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineDependency", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec", "kubevirt.io/api/core/v1.VirtualMachineSchedule"},
 	}
 }
 
@@ -29657,19 +32564,22 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStartFailure(ref common.Referen
 				Properties: map[string]spec.Schema{
 					"consecutiveFailCount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "ConsecutiveFailCount is the number of VirtualMachineInstances which failed in a row",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 					"lastFailedVMIUID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastFailedVMIUID is the UID of the last VirtualMachineInstance which failed",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"retryAfterTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "RetryAfterTimestamp is the time after which the VirtualMachine is started again",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
@@ -29884,236 +32794,291 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeStatusRef"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
-	}
-}
-
-func schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"addVolumeOptions": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddVolumeOptions when set indicates a volume should be added. The details within this field specify how to add the volume",
-							Ref:         ref("kubevirt.io/api/core/v1.AddVolumeOptions"),
+							Description: "Schedule reports the progress of the schedule of the VirtualMachine",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineScheduleStatus"),
 						},
 					},
-					"removeVolumeOptions": {
+					"completion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RemoveVolumeOptions when set indicates a volume should be removed. The details within this field specify how to add the volume",
-							Ref:         ref("kubevirt.io/api/core/v1.RemoveVolumeOptions"),
+							Description: "Completion is set once the VirtualMachineInstance of a VirtualMachine with the Once run strategy reached a final phase. The VirtualMachine is not started again until the run strategy is changed.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineCompletion"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend tracks the suspension of the VirtualMachine to disk. The VirtualMachine is not started while it is suspended, until it is resumed.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSuspendStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.AddVolumeOptions", "kubevirt.io/api/core/v1.RemoveVolumeOptions"},
+			"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus", "kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCompletion", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineScheduleStatus", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineSuspendStatus", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineSuspendStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Volume represents a named volume in a vmi.",
+				Description: "VirtualMachineSuspendStatus tracks the suspension of a VirtualMachine to disk",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volume's name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+							Description: "Phase is the phase of the suspension",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hostDisk": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HostDisk represents a disk created on the cluster level",
-							Ref:         ref("kubevirt.io/api/core/v1.HostDisk"),
-						},
-					},
-					"persistentVolumeClaim": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims",
-							Ref:         ref("kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource"),
-						},
-					},
-					"cloudInitNoCloud": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html",
-							Ref:         ref("kubevirt.io/api/core/v1.CloudInitNoCloudSource"),
-						},
-					},
-					"cloudInitConfigDrive": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html",
-							Ref:         ref("kubevirt.io/api/core/v1.CloudInitConfigDriveSource"),
-						},
-					},
-					"sysprep": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Represents a Sysprep volume source.",
-							Ref:         ref("kubevirt.io/api/core/v1.SysprepSource"),
-						},
-					},
-					"containerDisk": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html",
-							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskSource"),
-						},
-					},
-					"ephemeral": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ephemeral is a special volume source that \"wraps\" specified source and provides copy-on-write image on top of it.",
-							Ref:         ref("kubevirt.io/api/core/v1.EphemeralVolumeSource"),
-						},
-					},
-					"emptyDisk": {
-						SchemaProps: spec.SchemaProps{
-							Description: "EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html",
-							Ref:         ref("kubevirt.io/api/core/v1.EmptyDiskSource"),
-						},
-					},
-					"dataVolume": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.",
-							Ref:         ref("kubevirt.io/api/core/v1.DataVolumeSource"),
-						},
-					},
-					"configMap": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/",
-							Ref:         ref("kubevirt.io/api/core/v1.ConfigMapVolumeSource"),
-						},
-					},
-					"secret": {
+					"vmiUID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/",
-							Ref:         ref("kubevirt.io/api/core/v1.SecretVolumeSource"),
-						},
-					},
-					"downwardAPI": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DownwardAPI represents downward API about the pod that should populate this volume",
-							Ref:         ref("kubevirt.io/api/core/v1.DownwardAPIVolumeSource"),
-						},
-					},
-					"serviceAccount": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
-							Ref:         ref("kubevirt.io/api/core/v1.ServiceAccountVolumeSource"),
-						},
-					},
-					"downwardMetrics": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
-							Ref:         ref("kubevirt.io/api/core/v1.DownwardMetricsVolumeSource"),
-						},
-					},
-					"memoryDump": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
-							Ref:         ref("kubevirt.io/api/core/v1.MemoryDumpVolumeSource"),
+							Description: "VMIUID is the UID of the VirtualMachineInstance which got suspended",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"containerPath": {
+					"suspendTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContainerPath exposes a path from the virt-launcher container to the VM via virtiofs. The path must correspond to an existing volumeMount in the compute container.",
-							Ref:         ref("kubevirt.io/api/core/v1.ContainerPathVolumeSource"),
+							Description: "SuspendTime is the time the guest memory was saved",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"phase"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.ContainerPathVolumeSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VolumeMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"migratedVolumes": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"addVolumeOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddVolumeOptions when set indicates a volume should be added. The details within this field specify how to add the volume",
+							Ref:         ref("kubevirt.io/api/core/v1.AddVolumeOptions"),
 						},
+					},
+					"removeVolumeOptions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MigratedVolumes lists the source and destination volumes during the volume migration",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/core/v1.StorageMigratedVolumeInfo"),
-									},
-								},
-							},
+							Description: "RemoveVolumeOptions when set indicates a volume should be removed. The details within this field specify how to add the volume",
+							Ref:         ref("kubevirt.io/api/core/v1.RemoveVolumeOptions"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo"},
+			"kubevirt.io/api/core/v1.AddVolumeOptions", "kubevirt.io/api/core/v1.RemoveVolumeOptions"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Volume represents a named volume in a vmi.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volume name",
+							Description: "Volume's name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"enabled": {
-						SchemaProps: spec.SchemaProps{
-							Description: "True if the volume supports snapshotting",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Empty if snapshotting is enabled, contains reason otherwise",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"name", "enabled"},
-			},
-		},
-	}
-}
-
-func schema_kubevirtio_api_core_v1_VolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Represents the source of a volume to mount. Only one of its members may be specified.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
+					"hostDisk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostDisk represents a disk created on the cluster level",
+							Ref:         ref("kubevirt.io/api/core/v1.HostDisk"),
+						},
+					},
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims",
+							Ref:         ref("kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource"),
+						},
+					},
+					"cloudInitNoCloud": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html",
+							Ref:         ref("kubevirt.io/api/core/v1.CloudInitNoCloudSource"),
+						},
+					},
+					"cloudInitConfigDrive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html",
+							Ref:         ref("kubevirt.io/api/core/v1.CloudInitConfigDriveSource"),
+						},
+					},
+					"sysprep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents a Sysprep volume source.",
+							Ref:         ref("kubevirt.io/api/core/v1.SysprepSource"),
+						},
+					},
+					"containerDisk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html",
+							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskSource"),
+						},
+					},
+					"ephemeral": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ephemeral is a special volume source that \"wraps\" specified source and provides copy-on-write image on top of it.",
+							Ref:         ref("kubevirt.io/api/core/v1.EphemeralVolumeSource"),
+						},
+					},
+					"emptyDisk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html",
+							Ref:         ref("kubevirt.io/api/core/v1.EmptyDiskSource"),
+						},
+					},
+					"dataVolume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.",
+							Ref:         ref("kubevirt.io/api/core/v1.DataVolumeSource"),
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/",
+							Ref:         ref("kubevirt.io/api/core/v1.ConfigMapVolumeSource"),
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/",
+							Ref:         ref("kubevirt.io/api/core/v1.SecretVolumeSource"),
+						},
+					},
+					"downwardAPI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DownwardAPI represents downward API about the pod that should populate this volume",
+							Ref:         ref("kubevirt.io/api/core/v1.DownwardAPIVolumeSource"),
+						},
+					},
+					"serviceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
+							Ref:         ref("kubevirt.io/api/core/v1.ServiceAccountVolumeSource"),
+						},
+					},
+					"downwardMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
+							Ref:         ref("kubevirt.io/api/core/v1.DownwardMetricsVolumeSource"),
+						},
+					},
+					"memoryDump": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryDumpVolumeSource"),
+						},
+					},
+					"containerPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerPath exposes a path from the virt-launcher container to the VM via virtiofs. The path must correspond to an existing volumeMount in the compute container.",
+							Ref:         ref("kubevirt.io/api/core/v1.ContainerPathVolumeSource"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.ContainerPathVolumeSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VolumeMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migratedVolumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratedVolumes lists the source and destination volumes during the volume migration",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.StorageMigratedVolumeInfo"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume name",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "True if the volume supports snapshotting",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Empty if snapshotting is enabled, contains reason otherwise",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "enabled"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Represents the source of a volume to mount. Only one of its members may be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
 					"hostDisk": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HostDisk represents a disk created on the cluster level",
@@ -30385,6 +33350,226 @@ func schema_kubevirtio_api_core_v1_WatchdogDevice(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_WorkflowAlertsDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls the deployment of the workflow alerting rules, defaults to False.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_WorkloadUpdateWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadUpdateWindow is a recurring time window in which automated workload updates are started",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a cron expression, in the standard five fields format, at which the window opens.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone the cron expression is evaluated in. Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_defaults_v1alpha1_NetworkBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkBinding selects either a core binding method or a network binding plugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the core binding method of the interfaces.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin is the name of a network binding plugin registered in the KubeVirt configuration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_defaults_v1alpha1_VirtualMachineDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDefaults defines the defaults applied to the VirtualMachines created in its namespace. The defaults only fill the fields a VirtualMachine leaves unset. When several VirtualMachineDefaults exist in a namespace, they are applied in the alphabetical order of their names and the first one setting a field wins.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the defaults of the VirtualMachines.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/defaults/v1alpha1.VirtualMachineDefaultsSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/defaults/v1alpha1.VirtualMachineDefaultsSpec"},
+	}
+}
+
+func schema_kubevirtio_api_defaults_v1alpha1_VirtualMachineDefaultsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/defaults/v1alpha1.VirtualMachineDefaults"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/defaults/v1alpha1.VirtualMachineDefaults"},
+	}
+}
+
+func schema_kubevirtio_api_defaults_v1alpha1_VirtualMachineDefaultsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunStrategy is set on the VirtualMachines which set neither runStrategy nor running.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"evictionStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionStrategy is set on the VirtualMachines which don't set one. It takes precedence over the cluster wide eviction strategy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networkBinding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkBinding is set on the interfaces of the VirtualMachines which set neither a binding method nor a binding plugin.",
+							Ref:         ref("kubevirt.io/api/defaults/v1alpha1.NetworkBinding"),
+						},
+					},
+					"diskBus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskBus is set on the disks of the VirtualMachines which don't set a bus.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/defaults/v1alpha1.NetworkBinding"},
+	}
+}
+
 func schema_kubevirtio_api_export_v1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -31830,6 +35015,13 @@ func schema_kubevirtio_api_instancetype_v1beta1_DevicePreferences(ref common.Ref
 							Format:      "",
 						},
 					},
+					"preferredWatchdogAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredWatchdogAction optionally defines the preferred action to take when a Watchdog device is triggered.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -32682,6 +35874,13 @@ func schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceSpec(ref
 							Format:      "",
 						},
 					},
+					"preferredGuestOS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredGuestOS optionally hints at the operating system running in the guest. When set to windows a complete set of Hyper-V enlightenments is applied to the VirtualMachineInstance for any enlightenment not already configured.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -32709,6 +35908,317 @@ func schema_kubevirtio_api_instancetype_v1beta1_VolumePreferences(ref common.Ref
 	}
 }
 
+func schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeMaintenance drains the VirtualMachineInstances from a node. The node is cordoned while the NodeMaintenance exists, and the VirtualMachineInstances are live migrated, shut down or left running on the node according to their eviction strategy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the node and how its VirtualMachineInstances are drained.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/maintenance/v1alpha1.NodeMaintenanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status reports the progress of the drain.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/maintenance/v1alpha1.NodeMaintenanceStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/maintenance/v1alpha1.NodeMaintenanceSpec", "kubevirt.io/api/maintenance/v1alpha1.NodeMaintenanceStatus"},
+	}
+}
+
+func schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/maintenance/v1alpha1.NodeMaintenance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/maintenance/v1alpha1.NodeMaintenance"},
+	}
+}
+
+func schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenancePendingVMI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeMaintenancePendingVMI is a VirtualMachineInstance which is not drained from the node yet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the VirtualMachineInstance.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the VirtualMachineInstance.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action taken on the VirtualMachineInstance.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why the VirtualMachineInstance is not drained yet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failedMigrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedMigrations is the number of migrations of the VirtualMachineInstance which failed during the maintenance.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"namespace", "name", "action"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenancePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"evictionStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionStrategy selects the VirtualMachineInstances the policy applies to, by their effective eviction strategy.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is taken on the selected VirtualMachineInstances.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"evictionStrategy", "action"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName is the name of the node to drain.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a free form description of the maintenance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"evictionStrategy",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Policies override the action taken on the VirtualMachineInstances with a given eviction strategy. VirtualMachineInstances whose eviction strategy has no policy are migrated with LiveMigrate, migrated if possible and shut down otherwise with LiveMigrateIfPossible, ignored with External and shut down with None.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/maintenance/v1alpha1.NodeMaintenancePolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"nodeName"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenancePolicy"},
+	}
+}
+
+func schema_kubevirtio_api_maintenance_v1alpha1_NodeMaintenanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the maintenance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeCordoned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeCordoned tells whether the node was cordoned by the maintenance, in which case it is uncordoned when the maintenance is deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"totalVMIs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalVMIs is the number of VirtualMachineInstances to drain from the node since the maintenance started.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"drainProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrainProgress is the percentage of the VirtualMachineInstances drained from the node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ignoredVMIs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IgnoredVMIs is the number of VirtualMachineInstances left running on the node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pendingVMIs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingVMIs lists the VirtualMachineInstances which are not drained from the node yet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/maintenance/v1alpha1.NodeMaintenancePendingVMI"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError is the last error which occurred during the maintenance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/maintenance/v1alpha1.NodeMaintenancePendingVMI"},
+	}
+}
+
 func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -33404,6 +36914,116 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref common.Reference
 	}
 }
 
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAntiAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolAntiAffinity keeps the VMs of a pool in distinct domains of a topology",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey is the key of the node labels defining the topology domains, e.g. kubernetes.io/hostname",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"preferred": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preferred turns the anti-affinity into a scheduling preference, placing several VMs of the pool in a domain when there are not enough domains. By default such VMs are not scheduled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"topologyKey"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolArchitectureVariant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolArchitectureVariant adapts the VM template of a pool to the VMs running on an architecture",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture of the VMs created from the variant, e.g. amd64 or arm64",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the share of the VMs of the pool created from the variant, relative to the weights of the other variants. Defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes replace the volumes of the VM template with the same name, e.g. with the containerDisk image built for the architecture",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"dataVolumeTemplates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumeTemplates replace the dataVolumeTemplates of the VM template with the same name",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.DataVolumeTemplateSpec"),
+									},
+								},
+							},
+						},
+					},
+					"instancetype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instancetype replaces the instancetype matcher of the VM template",
+							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeMatcher"),
+						},
+					},
+					"preference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preference replaces the preference matcher of the VM template",
+							Ref:         ref("kubevirt.io/api/core/v1.PreferenceMatcher"),
+						},
+					},
+				},
+				Required: []string{"architecture"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.Volume"},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAutohealingStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -33616,6 +37236,13 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolProactiveUpdateStrate
 							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolSelectionPolicy"),
 						},
 					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method defines how outdated VMs are updated [Restart|LiveUpdate|Replace] Restart - (Default) the VMI is restarted. LiveUpdate - the changes are live-updated to the VMI, which is only restarted when the VM requires it. Replace - the VM is deleted and a new one is created, see maxSurge.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -33764,6 +37391,12 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.Refer
 							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "(Defaults to 0) Integer or string pointer, that when set represents either a percentage or number of VMs that can be created above the desired number of replicas during automated update with the Replace method.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
 					"scaleInStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool",
@@ -33782,12 +37415,100 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.Refer
 							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutohealingStrategy"),
 						},
 					},
+					"topologySpread": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpread distributes the VMs of the pool evenly across topology domains, e.g. zones or hosts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologySpread"),
+									},
+								},
+							},
+						},
+					},
+					"antiAffinity": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AntiAffinity keeps the VMs of the pool apart, placing at most one of them in each topology domain, e.g. zone or host",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAntiAffinity"),
+									},
+								},
+							},
+						},
+					},
+					"standby": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standby keeps paused standby VMs on other nodes than the VMs of the pool, which replace the failed VMs of the pool",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolStandby"),
+						},
+					},
+					"architectureVariants": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"architecture",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ArchitectureVariants adapt the VM template to several architectures, so that the VMs of the pool run on nodes of each of them",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolArchitectureVariant"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"selector", "virtualMachineTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutohealingStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolNameGeneration", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAntiAffinity", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolArchitectureVariant", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutohealingStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolNameGeneration", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolStandby", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologySpread", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStandby(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolStandby keeps pre-provisioned, paused VMs ready to replace the failed VMs of a pool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of standby VMs",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"replicas"},
+			},
+		},
 	}
 }
 
@@ -33809,6 +37530,20 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.Ref
 							Format: "int32",
 						},
 					},
+					"standbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StandbyReplicas is the number of standby VMs of the pool",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyStandbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyStandbyReplicas is the number of standby VMs which are running and ready to replace a failed VM",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -33834,11 +37569,144 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"topologySpread": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpread reports the distribution of the running VMs of the pool for each topology key of the spread and anti-affinity rules",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologySpreadStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologySpreadStatus"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolTopologyDomain(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"name", "replicas"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolTopologySpread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolTopologySpread spreads the VMs of a pool evenly across the domains of a topology",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey is the key of the node labels defining the topology domains, e.g. topology.kubernetes.io/zone",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSkew is the maximum difference between the numbers of VMs of the pool in any two domains. Defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"whenUnsatisfiable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WhenUnsatisfiable defines how a VM exceeding the skew is scheduled [DoNotSchedule|ScheduleAnyway] DoNotSchedule - (Default) the VM is not scheduled until the skew allows it. ScheduleAnyway - the VM is scheduled, preferring the domains reducing the skew.\n\nPossible enum values:\n - `\"DoNotSchedule\"` instructs the scheduler not to schedule the pod when constraints are not satisfied.\n - `\"ScheduleAnyway\"` instructs the scheduler to schedule the pod even if constraints are not satisfied.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"DoNotSchedule", "ScheduleAnyway"},
+						},
+					},
 				},
+				Required: []string{"topologyKey"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolTopologySpreadStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolTopologySpreadStatus reports the distribution of the running VMs of a pool across the domains of a topology",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"domains": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Domains lists the number of running VMs of the pool in each domain hosting at least one of them",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologyDomain"),
+									},
+								},
+							},
+						},
+					},
+					"skew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Skew is the difference between the numbers of running VMs in the most and the least populated domains, counting the domains of the nodes without VMs of the pool as well",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"topologyKey", "skew"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition"},
+			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolTopologyDomain"},
 	}
 }
 
@@ -33877,6 +37745,13 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolUpdateStrategy(ref co
 							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolProactiveUpdateStrategy"),
 						},
 					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused halts the rollout of template changes to the VMs within the VMPool, scaling is not affected.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -33970,6 +37845,116 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePool(ref common.ReferenceC
 	}
 }
 
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolAntiAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolAntiAffinity keeps the VMs of a pool in distinct domains of a topology",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey is the key of the node labels defining the topology domains, e.g. kubernetes.io/hostname",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"preferred": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preferred turns the anti-affinity into a scheduling preference, placing several VMs of the pool in a domain when there are not enough domains. By default such VMs are not scheduled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"topologyKey"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolArchitectureVariant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolArchitectureVariant adapts the VM template of a pool to the VMs running on an architecture",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture of the VMs created from the variant, e.g. amd64 or arm64",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the share of the VMs of the pool created from the variant, relative to the weights of the other variants. Defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes replace the volumes of the VM template with the same name, e.g. with the containerDisk image built for the architecture",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"dataVolumeTemplates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumeTemplates replace the dataVolumeTemplates of the VM template with the same name",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.DataVolumeTemplateSpec"),
+									},
+								},
+							},
+						},
+					},
+					"instancetype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instancetype replaces the instancetype matcher of the VM template",
+							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeMatcher"),
+						},
+					},
+					"preference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preference replaces the preference matcher of the VM template",
+							Ref:         ref("kubevirt.io/api/core/v1.PreferenceMatcher"),
+						},
+					},
+				},
+				Required: []string{"architecture"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.Volume"},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolAutohealingStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -34182,6 +38167,13 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolProactiveUpdateStrateg
 							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectionPolicy"),
 						},
 					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method defines how outdated VMs are updated [Restart|LiveUpdate|Replace] Restart - (Default) the VMI is restarted. LiveUpdate - the changes are live-updated to the VMI, which is only restarted when the VM requires it. Replace - the VM is deleted and a new one is created, see maxSurge.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -34330,6 +38322,12 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSpec(ref common.Refere
 							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "(Defaults to 0) Integer or string pointer, that when set represents either a percentage or number of VMs that can be created above the desired number of replicas during automated update with the Replace method.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
 					"scaleInStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool",
@@ -34348,12 +38346,100 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSpec(ref common.Refere
 							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAutohealingStrategy"),
 						},
 					},
+					"topologySpread": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpread distributes the VMs of the pool evenly across topology domains, e.g. zones or hosts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologySpread"),
+									},
+								},
+							},
+						},
+					},
+					"antiAffinity": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AntiAffinity keeps the VMs of the pool apart, placing at most one of them in each topology domain, e.g. zone or host",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAntiAffinity"),
+									},
+								},
+							},
+						},
+					},
+					"standby": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standby keeps paused standby VMs on other nodes than the VMs of the pool, which replace the failed VMs of the pool",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolStandby"),
+						},
+					},
+					"architectureVariants": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"architecture",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ArchitectureVariants adapt the VM template to several architectures, so that the VMs of the pool run on nodes of each of them",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolArchitectureVariant"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"selector", "virtualMachineTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAutohealingStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolNameGeneration", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolScaleInStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUpdateStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAntiAffinity", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolArchitectureVariant", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAutohealingStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolNameGeneration", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolScaleInStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolStandby", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologySpread", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUpdateStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachineTemplateSpec"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolStandby(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolStandby keeps pre-provisioned, paused VMs ready to replace the failed VMs of a pool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of standby VMs",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"replicas"},
+			},
+		},
 	}
 }
 
@@ -34375,6 +38461,20 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolStatus(ref common.Refe
 							Format: "int32",
 						},
 					},
+					"standbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StandbyReplicas is the number of standby VMs of the pool",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyStandbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyStandbyReplicas is the number of standby VMs which are running and ready to replace a failed VM",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -34400,11 +38500,144 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolStatus(ref common.Refe
 							Format:      "",
 						},
 					},
+					"topologySpread": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpread reports the distribution of the running VMs of the pool for each topology key of the spread and anti-affinity rules",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologySpreadStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolCondition"},
+			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolCondition", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologySpreadStatus"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolTopologyDomain(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"name", "replicas"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolTopologySpread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolTopologySpread spreads the VMs of a pool evenly across the domains of a topology",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey is the key of the node labels defining the topology domains, e.g. topology.kubernetes.io/zone",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSkew is the maximum difference between the numbers of VMs of the pool in any two domains. Defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"whenUnsatisfiable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WhenUnsatisfiable defines how a VM exceeding the skew is scheduled [DoNotSchedule|ScheduleAnyway] DoNotSchedule - (Default) the VM is not scheduled until the skew allows it. ScheduleAnyway - the VM is scheduled, preferring the domains reducing the skew.\n\nPossible enum values:\n - `\"DoNotSchedule\"` instructs the scheduler not to schedule the pod when constraints are not satisfied.\n - `\"ScheduleAnyway\"` instructs the scheduler to schedule the pod even if constraints are not satisfied.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"DoNotSchedule", "ScheduleAnyway"},
+						},
+					},
+				},
+				Required: []string{"topologyKey"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolTopologySpreadStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolTopologySpreadStatus reports the distribution of the running VMs of a pool across the domains of a topology",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"domains": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Domains lists the number of running VMs of the pool in each domain hosting at least one of them",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologyDomain"),
+									},
+								},
+							},
+						},
+					},
+					"skew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Skew is the difference between the numbers of running VMs in the most and the least populated domains, counting the domains of the nodes without VMs of the pool as well",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"topologyKey", "skew"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolTopologyDomain"},
 	}
 }
 
@@ -34443,6 +38676,13 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolUpdateStrategy(ref com
 							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolProactiveUpdateStrategy"),
 						},
 					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused halts the rollout of template changes to the VMs within the VMPool, scaling is not affected.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -34478,6 +38718,252 @@ func schema_kubevirtio_api_pool_v1beta1_VirtualMachineTemplateSpec(ref common.Re
 	}
 }
 
+func schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineQuota limits the resources the running VirtualMachines of its namespace may consume, expressed in VirtualMachine terms rather than in the terms of the virt-launcher pods. Creating a VirtualMachineInstance, directly or by starting a VirtualMachine, is rejected when it would exceed any VirtualMachineQuota of its namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the limits of the quota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status reports the current consumption of the quota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaSpec", "kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaStatus"},
+	}
+}
+
+func schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineQuotaLimits lists the limits of a quota. Unset limits are not enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runningVirtualMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunningVirtualMachines is the maximum number of VirtualMachines running at once.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"guestMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestMemory is the maximum sum of the guest memory of the running VirtualMachines.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"sockets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sockets is the maximum sum of the vCPU sockets of the running VirtualMachines.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/quota/v1alpha1.VirtualMachineQuota"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/quota/v1alpha1.VirtualMachineQuota"},
+	}
+}
+
+func schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineQuotaReservation is the charge of a VirtualMachineInstance admitted by virt-api.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VirtualMachineInstance.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID is the UID of the VirtualMachineInstance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the consumption of the VirtualMachineInstance, in the terms of the limits.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaLimits"),
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is when the VirtualMachineInstance was admitted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "used", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaLimits"},
+	}
+}
+
+func schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the set of limits enforced on the running VirtualMachines of the namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaLimits"),
+						},
+					},
+				},
+				Required: []string{"hard"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaLimits"},
+	}
+}
+
+func schema_kubevirtio_api_quota_v1alpha1_VirtualMachineQuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the consumption of the running VirtualMachines of the namespace, in the terms of the limits. It is computed by virt-controller from the VirtualMachineInstances it observes.",
+							Ref:         ref("kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaLimits"),
+						},
+					},
+					"reservations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Reservations are the charges of the VirtualMachineInstances admitted by virt-api which are not accounted for in Used yet. virt-controller drops them once it observes the VirtualMachineInstances, or after a minute for the ones which were not created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaReservation"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaLimits", "kubevirt.io/api/quota/v1alpha1.VirtualMachineQuotaReservation"},
+	}
+}
+
 func schema_kubevirtio_api_snapshot_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
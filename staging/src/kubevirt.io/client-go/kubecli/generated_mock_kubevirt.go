@@ -1520,6 +1520,35 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) DeleteCollection(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).DeleteCollection), ctx, opts, listOpts)
 }
 
+// DomainXML mocks base method.
+func (m *MockVirtualMachineInstanceInterface) DomainXML(ctx context.Context, name string) (v122.VirtualMachineInstanceDomainXML, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainXML", ctx, name)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceDomainXML)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainXML indicates an expected call of DomainXML.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) DomainXML(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainXML", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).DomainXML), ctx, name)
+}
+
+// EjectCloudInit mocks base method.
+func (m *MockVirtualMachineInstanceInterface) EjectCloudInit(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EjectCloudInit", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EjectCloudInit indicates an expected call of EjectCloudInit.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) EjectCloudInit(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EjectCloudInit", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).EjectCloudInit), ctx, name)
+}
+
 // EvacuateCancel mocks base method.
 func (m *MockVirtualMachineInstanceInterface) EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v122.EvacuateCancelOptions) error {
 	m.ctrl.T.Helper()
@@ -1578,6 +1607,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Get(ctx, name, opts a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Get), ctx, name, opts)
 }
 
+// GuestExec mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestExec(name string, options *v122.GuestExecOptions) (v123.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExec", name, options)
+	ret0, _ := ret[0].(v123.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExec indicates an expected call of GuestExec.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestExec(name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExec", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestExec), name, options)
+}
+
 // GuestOsInfo mocks base method.
 func (m *MockVirtualMachineInstanceInterface) GuestOsInfo(ctx context.Context, name string) (v122.VirtualMachineInstanceGuestAgentInfo, error) {
 	m.ctrl.T.Helper()
@@ -1672,6 +1716,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) PortForward(name, por
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).PortForward), name, port, protocol)
 }
 
+// ReadGuestFile mocks base method.
+func (m *MockVirtualMachineInstanceInterface) ReadGuestFile(ctx context.Context, name string, path string) (v122.VirtualMachineInstanceGuestFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGuestFile", ctx, name, path)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceGuestFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGuestFile indicates an expected call of ReadGuestFile.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) ReadGuestFile(ctx, name, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGuestFile", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).ReadGuestFile), ctx, name, path)
+}
+
 // RedefineCheckpoint mocks base method.
 func (m *MockVirtualMachineInstanceInterface) RedefineCheckpoint(ctx context.Context, name string, checkpoint *v1alpha18.BackupCheckpoint) error {
 	m.ctrl.T.Helper()
@@ -1686,6 +1745,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RedefineCheckpoint(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedefineCheckpoint", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RedefineCheckpoint), ctx, name, checkpoint)
 }
 
+// RefreshGuestOsInfo mocks base method.
+func (m *MockVirtualMachineInstanceInterface) RefreshGuestOsInfo(ctx context.Context, name string) (v122.VirtualMachineInstanceGuestAgentInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshGuestOsInfo", ctx, name)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceGuestAgentInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshGuestOsInfo indicates an expected call of RefreshGuestOsInfo.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RefreshGuestOsInfo(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGuestOsInfo", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RefreshGuestOsInfo), ctx, name)
+}
+
 // RemoveVolume mocks base method.
 func (m *MockVirtualMachineInstanceInterface) RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v122.RemoveVolumeOptions) error {
 	m.ctrl.T.Helper()
@@ -1714,6 +1788,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Reset(ctx, name any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Reset), ctx, name)
 }
 
+// RotateCloudInit mocks base method.
+func (m *MockVirtualMachineInstanceInterface) RotateCloudInit(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCloudInit", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateCloudInit indicates an expected call of RotateCloudInit.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RotateCloudInit(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCloudInit", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RotateCloudInit), ctx, name)
+}
+
 // SEVFetchCertChain mocks base method.
 func (m *MockVirtualMachineInstanceInterface) SEVFetchCertChain(ctx context.Context, name string) (v122.SEVPlatformInfo, error) {
 	m.ctrl.T.Helper()
@@ -1934,6 +2022,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Watch(ctx, opts any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Watch), ctx, opts)
 }
 
+// WriteGuestFile mocks base method.
+func (m *MockVirtualMachineInstanceInterface) WriteGuestFile(ctx context.Context, name string, file *v122.VirtualMachineInstanceGuestFile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteGuestFile", ctx, name, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteGuestFile indicates an expected call of WriteGuestFile.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) WriteGuestFile(ctx, name, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGuestFile", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).WriteGuestFile), ctx, name, file)
+}
+
 // MockReplicaSetInterface is a mock of ReplicaSetInterface interface.
 type MockReplicaSetInterface struct {
 	ctrl     *gomock.Controller
@@ -2369,6 +2471,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) DeleteCollection(ctx, opts, l
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockVirtualMachineInterface)(nil).DeleteCollection), ctx, opts, listOpts)
 }
 
+// EjectMedia mocks base method.
+func (m *MockVirtualMachineInterface) EjectMedia(ctx context.Context, name string, ejectMediaOptions *v122.EjectMediaOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EjectMedia", ctx, name, ejectMediaOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EjectMedia indicates an expected call of EjectMedia.
+func (mr *MockVirtualMachineInterfaceMockRecorder) EjectMedia(ctx, name, ejectMediaOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EjectMedia", reflect.TypeOf((*MockVirtualMachineInterface)(nil).EjectMedia), ctx, name, ejectMediaOptions)
+}
+
 // EvacuateCancel mocks base method.
 func (m *MockVirtualMachineInterface) EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v122.EvacuateCancelOptions) error {
 	m.ctrl.T.Helper()
@@ -2413,6 +2529,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) GetWithExpandedSpec(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithExpandedSpec", reflect.TypeOf((*MockVirtualMachineInterface)(nil).GetWithExpandedSpec), ctx, name)
 }
 
+// InsertMedia mocks base method.
+func (m *MockVirtualMachineInterface) InsertMedia(ctx context.Context, name string, insertMediaOptions *v122.InsertMediaOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMedia", ctx, name, insertMediaOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMedia indicates an expected call of InsertMedia.
+func (mr *MockVirtualMachineInterfaceMockRecorder) InsertMedia(ctx, name, insertMediaOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMedia", reflect.TypeOf((*MockVirtualMachineInterface)(nil).InsertMedia), ctx, name, insertMediaOptions)
+}
+
 // List mocks base method.
 func (m *MockVirtualMachineInterface) List(ctx context.Context, opts v12.ListOptions) (*v122.VirtualMachineList, error) {
 	m.ctrl.T.Helper()
@@ -2563,6 +2693,34 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) Restart(ctx, name, restartOpt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Restart), ctx, name, restartOptions)
 }
 
+// Resume mocks base method.
+func (m *MockVirtualMachineInterface) Resume(ctx context.Context, name string, resumeOptions *v122.ResumeOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, name, resumeOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Resume(ctx, name, resumeOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Resume), ctx, name, resumeOptions)
+}
+
+// Rollback mocks base method.
+func (m *MockVirtualMachineInterface) Rollback(ctx context.Context, name string, rollbackOptions *v122.RollbackOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", ctx, name, rollbackOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Rollback(ctx, name, rollbackOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Rollback), ctx, name, rollbackOptions)
+}
+
 // Start mocks base method.
 func (m *MockVirtualMachineInterface) Start(ctx context.Context, name string, startOptions *v122.StartOptions) error {
 	m.ctrl.T.Helper()
@@ -2591,6 +2749,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) Stop(ctx, name, stopOptions a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Stop), ctx, name, stopOptions)
 }
 
+// Suspend mocks base method.
+func (m *MockVirtualMachineInterface) Suspend(ctx context.Context, name string, suspendOptions *v122.SuspendOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, name, suspendOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Suspend(ctx, name, suspendOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Suspend), ctx, name, suspendOptions)
+}
+
 // Update mocks base method.
 func (m *MockVirtualMachineInterface) Update(ctx context.Context, virtualMachine *v122.VirtualMachine, opts v12.UpdateOptions) (*v122.VirtualMachine, error) {
 	m.ctrl.T.Helper()
@@ -24,8 +24,12 @@ const (
 	usbredirTemplateURI           = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
 	vncTemplateURI                = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
 	vsockTemplateURI              = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
+	guestExecTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestexec"
 	pauseTemplateURI              = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI            = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
+	suspendTemplateURI            = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/suspend"
+	cloudInitRotateTemplateURI    = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/cloudinit/rotate"
+	cloudInitEjectTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/cloudinit/eject"
 	backupTemplateURI             = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/backup"
 	redefineCheckpointTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/redefine-checkpoint"
 	freezeTemplateURI             = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
@@ -33,9 +37,12 @@ const (
 	resetTemplateURI              = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/reset"
 	softRebootTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
 	guestInfoTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
+	guestInfoRefreshTemplateURI   = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo/refresh"
 	userListTemplateURI           = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
 	screenshotTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc/screenshot"
+	domainXMLTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/domainxml"
+	guestFileTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfile"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
@@ -59,13 +66,17 @@ type VirtHandlerClient interface {
 
 type VirtHandlerConn interface {
 	ConnectionDetails() (ip string, port int, err error)
-	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	ConsoleURI(vmi *virtv1.VirtualMachineInstance, serial string) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance, preserveSession bool) (string, error)
 	ScreenshotURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
+	GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SuspendURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	CloudInitRotateURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	CloudInitEjectURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	ResetURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -77,8 +88,11 @@ type VirtHandlerConn interface {
 	Put(url string, body io.ReadCloser) error
 	Get(url, contentType string) (string, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestInfoRefreshURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	DomainXMLURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestFileURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	RedefineCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
@@ -183,8 +197,19 @@ func (v *virtHandlerConn) formatURI(template string, vmi *virtv1.VirtualMachineI
 }
 
 // TODO move the actual ws handling in here, and work with channels
-func (v *virtHandlerConn) ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
-	return v.formatURI(consoleTemplateURI, vmi)
+func (v *virtHandlerConn) ConsoleURI(vmi *virtv1.VirtualMachineInstance, serial string) (string, error) {
+	baseURI, err := v.formatURI(consoleTemplateURI, vmi)
+	if err != nil || serial == "" {
+		return baseURI, err
+	}
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return "", err
+	}
+	queryParams := url.Values{}
+	queryParams.Add("serial", serial)
+	u.RawQuery = queryParams.Encode()
+	return u.String(), nil
 }
 
 func (v *virtHandlerConn) USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
@@ -218,6 +243,10 @@ func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port stri
 	return fmt.Sprintf("%s?port=%s&tls=%s", baseURI, port, tls), nil
 }
 
+func (v *virtHandlerConn) GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestExecTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(backupTemplateURI, vmi)
 }
@@ -250,6 +279,18 @@ func (v *virtHandlerConn) UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string
 	return v.formatURI(unpauseTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) SuspendURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(suspendTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) CloudInitRotateURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(cloudInitRotateTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) CloudInitEjectURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(cloudInitEjectTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) Pod() (pod *v1.Pod, err error) {
 	if v.err != nil {
 		err = v.err
@@ -316,6 +357,10 @@ func (v *virtHandlerConn) GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (stri
 	return v.formatURI(guestInfoTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestInfoRefreshURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestInfoRefreshTemplateURI, vmi)
+}
+
 func formatIpForUri(ip string) string {
 	if netutils.IsIPv6String(ip) {
 		return "[" + ip + "]"
@@ -331,6 +376,14 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) DomainXMLURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(domainXMLTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) GuestFileURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestFileTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
@@ -92,6 +92,10 @@ type connectionStruct struct {
 }
 
 func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions) (kvcorev1.StreamInterface, error) {
+	queryParams := url.Values{}
+	if options != nil && options.Serial != "" {
+		queryParams.Add("serial", options.Serial)
+	}
 
 	if options != nil && options.ConnectionTimeout != 0 {
 		timeoutChan := time.Tick(options.ConnectionTimeout)
@@ -110,7 +114,7 @@ func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions
 				default:
 				}
 
-				con, err := kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+				con, err := kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 				if err != nil {
 					asyncSubresourceError, ok := err.(*kvcorev1.AsyncSubresourceError)
 					// return if response status code does not equal to 400
@@ -130,7 +134,7 @@ func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions
 		conStruct := <-connectionChan
 		return conStruct.con, conStruct.err
 	} else {
-		return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+		return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 	}
 }
 
@@ -181,3 +185,17 @@ func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInte
 	queryParams.Add("tls", strconv.FormatBool(useTLS))
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
 }
+
+func (v *vmis) GuestExec(name string, options *v1.GuestExecOptions) (kvcorev1.StreamInterface, error) {
+	if options == nil || len(options.Command) == 0 {
+		return nil, fmt.Errorf("a command is required but not provided")
+	}
+	queryParams := url.Values{}
+	for _, arg := range options.Command {
+		queryParams.Add("command", arg)
+	}
+	if options.TimeoutSeconds != nil {
+		queryParams.Add("timeoutSeconds", strconv.FormatInt(int64(*options.TimeoutSeconds), 10))
+	}
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "guestexec", queryParams)
+}
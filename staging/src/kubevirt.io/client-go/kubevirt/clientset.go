@@ -31,13 +31,16 @@ import (
 	clonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	defaultsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/defaults/v1alpha1"
 	exportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1"
 	exportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1"
 	migrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	pluginv1alpha1 "kubevirt.io/client-go/kubevirt/typed/plugin/v1alpha1"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	poolv1beta1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
+	quotav1alpha1 "kubevirt.io/client-go/kubevirt/typed/quota/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 )
@@ -48,13 +51,16 @@ type Interface interface {
 	CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface
 	CloneV1beta1() clonev1beta1.CloneV1beta1Interface
 	KubevirtV1() kubevirtv1.KubevirtV1Interface
+	DefaultsV1alpha1() defaultsv1alpha1.DefaultsV1alpha1Interface
 	ExportV1beta1() exportv1beta1.ExportV1beta1Interface
 	ExportV1() exportv1.ExportV1Interface
 	InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta1Interface
+	MaintenanceV1alpha1() maintenancev1alpha1.MaintenanceV1alpha1Interface
 	MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface
 	PluginV1alpha1() pluginv1alpha1.PluginV1alpha1Interface
 	PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface
 	PoolV1beta1() poolv1beta1.PoolV1beta1Interface
+	QuotaV1alpha1() quotav1alpha1.QuotaV1alpha1Interface
 	SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface
 	SnapshotV1beta1() snapshotv1beta1.SnapshotV1beta1Interface
 }
@@ -66,13 +72,16 @@ type Clientset struct {
 	cloneV1alpha1       *clonev1alpha1.CloneV1alpha1Client
 	cloneV1beta1        *clonev1beta1.CloneV1beta1Client
 	kubevirtV1          *kubevirtv1.KubevirtV1Client
+	defaultsV1alpha1    *defaultsv1alpha1.DefaultsV1alpha1Client
 	exportV1beta1       *exportv1beta1.ExportV1beta1Client
 	exportV1            *exportv1.ExportV1Client
 	instancetypeV1beta1 *instancetypev1beta1.InstancetypeV1beta1Client
+	maintenanceV1alpha1 *maintenancev1alpha1.MaintenanceV1alpha1Client
 	migrationsV1alpha1  *migrationsv1alpha1.MigrationsV1alpha1Client
 	pluginV1alpha1      *pluginv1alpha1.PluginV1alpha1Client
 	poolV1alpha1        *poolv1alpha1.PoolV1alpha1Client
 	poolV1beta1         *poolv1beta1.PoolV1beta1Client
+	quotaV1alpha1       *quotav1alpha1.QuotaV1alpha1Client
 	snapshotV1alpha1    *snapshotv1alpha1.SnapshotV1alpha1Client
 	snapshotV1beta1     *snapshotv1beta1.SnapshotV1beta1Client
 }
@@ -97,6 +106,11 @@ func (c *Clientset) KubevirtV1() kubevirtv1.KubevirtV1Interface {
 	return c.kubevirtV1
 }
 
+// DefaultsV1alpha1 retrieves the DefaultsV1alpha1Client
+func (c *Clientset) DefaultsV1alpha1() defaultsv1alpha1.DefaultsV1alpha1Interface {
+	return c.defaultsV1alpha1
+}
+
 // ExportV1beta1 retrieves the ExportV1beta1Client
 func (c *Clientset) ExportV1beta1() exportv1beta1.ExportV1beta1Interface {
 	return c.exportV1beta1
@@ -112,6 +126,11 @@ func (c *Clientset) InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta
 	return c.instancetypeV1beta1
 }
 
+// MaintenanceV1alpha1 retrieves the MaintenanceV1alpha1Client
+func (c *Clientset) MaintenanceV1alpha1() maintenancev1alpha1.MaintenanceV1alpha1Interface {
+	return c.maintenanceV1alpha1
+}
+
 // MigrationsV1alpha1 retrieves the MigrationsV1alpha1Client
 func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface {
 	return c.migrationsV1alpha1
@@ -132,6 +151,11 @@ func (c *Clientset) PoolV1beta1() poolv1beta1.PoolV1beta1Interface {
 	return c.poolV1beta1
 }
 
+// QuotaV1alpha1 retrieves the QuotaV1alpha1Client
+func (c *Clientset) QuotaV1alpha1() quotav1alpha1.QuotaV1alpha1Interface {
+	return c.quotaV1alpha1
+}
+
 // SnapshotV1alpha1 retrieves the SnapshotV1alpha1Client
 func (c *Clientset) SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface {
 	return c.snapshotV1alpha1
@@ -202,6 +226,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.defaultsV1alpha1, err = defaultsv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.exportV1beta1, err = exportv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -214,6 +242,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.maintenanceV1alpha1, err = maintenancev1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.migrationsV1alpha1, err = migrationsv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -230,6 +262,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.quotaV1alpha1, err = quotav1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.snapshotV1alpha1, err = snapshotv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -263,13 +299,16 @@ func New(c rest.Interface) *Clientset {
 	cs.cloneV1alpha1 = clonev1alpha1.New(c)
 	cs.cloneV1beta1 = clonev1beta1.New(c)
 	cs.kubevirtV1 = kubevirtv1.New(c)
+	cs.defaultsV1alpha1 = defaultsv1alpha1.New(c)
 	cs.exportV1beta1 = exportv1beta1.New(c)
 	cs.exportV1 = exportv1.New(c)
 	cs.instancetypeV1beta1 = instancetypev1beta1.New(c)
+	cs.maintenanceV1alpha1 = maintenancev1alpha1.New(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.New(c)
 	cs.pluginV1alpha1 = pluginv1alpha1.New(c)
 	cs.poolV1alpha1 = poolv1alpha1.New(c)
 	cs.poolV1beta1 = poolv1beta1.New(c)
+	cs.quotaV1alpha1 = quotav1alpha1.New(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.New(c)
 	cs.snapshotV1beta1 = snapshotv1beta1.New(c)
 
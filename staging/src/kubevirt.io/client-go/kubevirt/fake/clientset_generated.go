@@ -35,12 +35,16 @@ import (
 	fakeclonev1beta1 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1/fake"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	fakekubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1/fake"
+	defaultsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/defaults/v1alpha1"
+	fakedefaultsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/defaults/v1alpha1/fake"
 	exportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1"
 	fakeexportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1/fake"
 	exportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	fakeexportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1/fake"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	fakeinstancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1/fake"
+	maintenancev1alpha1 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1"
+	fakemaintenancev1alpha1 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1/fake"
 	migrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	fakemigrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1/fake"
 	pluginv1alpha1 "kubevirt.io/client-go/kubevirt/typed/plugin/v1alpha1"
@@ -49,6 +53,8 @@ import (
 	fakepoolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1/fake"
 	poolv1beta1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
 	fakepoolv1beta1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1/fake"
+	quotav1alpha1 "kubevirt.io/client-go/kubevirt/typed/quota/v1alpha1"
+	fakequotav1alpha1 "kubevirt.io/client-go/kubevirt/typed/quota/v1alpha1/fake"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
 	fakesnapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1/fake"
 	snapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
@@ -129,6 +135,11 @@ func (c *Clientset) KubevirtV1() kubevirtv1.KubevirtV1Interface {
 	return &fakekubevirtv1.FakeKubevirtV1{Fake: &c.Fake}
 }
 
+// DefaultsV1alpha1 retrieves the DefaultsV1alpha1Client
+func (c *Clientset) DefaultsV1alpha1() defaultsv1alpha1.DefaultsV1alpha1Interface {
+	return &fakedefaultsv1alpha1.FakeDefaultsV1alpha1{Fake: &c.Fake}
+}
+
 // ExportV1beta1 retrieves the ExportV1beta1Client
 func (c *Clientset) ExportV1beta1() exportv1beta1.ExportV1beta1Interface {
 	return &fakeexportv1beta1.FakeExportV1beta1{Fake: &c.Fake}
@@ -144,6 +155,11 @@ func (c *Clientset) InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta
 	return &fakeinstancetypev1beta1.FakeInstancetypeV1beta1{Fake: &c.Fake}
 }
 
+// MaintenanceV1alpha1 retrieves the MaintenanceV1alpha1Client
+func (c *Clientset) MaintenanceV1alpha1() maintenancev1alpha1.MaintenanceV1alpha1Interface {
+	return &fakemaintenancev1alpha1.FakeMaintenanceV1alpha1{Fake: &c.Fake}
+}
+
 // MigrationsV1alpha1 retrieves the MigrationsV1alpha1Client
 func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface {
 	return &fakemigrationsv1alpha1.FakeMigrationsV1alpha1{Fake: &c.Fake}
@@ -164,6 +180,11 @@ func (c *Clientset) PoolV1beta1() poolv1beta1.PoolV1beta1Interface {
 	return &fakepoolv1beta1.FakePoolV1beta1{Fake: &c.Fake}
 }
 
+// QuotaV1alpha1 retrieves the QuotaV1alpha1Client
+func (c *Clientset) QuotaV1alpha1() quotav1alpha1.QuotaV1alpha1Interface {
+	return &fakequotav1alpha1.FakeQuotaV1alpha1{Fake: &c.Fake}
+}
+
 // SnapshotV1alpha1 retrieves the SnapshotV1alpha1Client
 func (c *Clientset) SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface {
 	return &fakesnapshotv1alpha1.FakeSnapshotV1alpha1{Fake: &c.Fake}
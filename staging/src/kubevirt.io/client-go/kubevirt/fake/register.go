@@ -30,13 +30,16 @@ import (
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	defaultsv1alpha1 "kubevirt.io/api/defaults/v1alpha1"
 	exportv1 "kubevirt.io/api/export/v1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	pluginv1alpha1 "kubevirt.io/api/plugin/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 )
@@ -49,13 +52,16 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	clonev1alpha1.AddToScheme,
 	clonev1beta1.AddToScheme,
 	kubevirtv1.AddToScheme,
+	defaultsv1alpha1.AddToScheme,
 	exportv1beta1.AddToScheme,
 	exportv1.AddToScheme,
 	instancetypev1beta1.AddToScheme,
+	maintenancev1alpha1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
 	pluginv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
 	poolv1beta1.AddToScheme,
+	quotav1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
 	snapshotv1beta1.AddToScheme,
 }
@@ -118,3 +118,38 @@ func (c *fakeVirtualMachines) EvacuateCancel(ctx context.Context, name string, e
 
 	return err
 }
+
+func (c *fakeVirtualMachines) Rollback(ctx context.Context, name string, rollbackOptions *v1.RollbackOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "rollback", name, rollbackOptions), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachines) InsertMedia(ctx context.Context, name string, insertMediaOptions *v1.InsertMediaOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "insertmedia", name, insertMediaOptions), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachines) EjectMedia(ctx context.Context, name string, ejectMediaOptions *v1.EjectMediaOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "ejectmedia", name, ejectMediaOptions), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachines) Suspend(ctx context.Context, name string, suspendOptions *v1.SuspendOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "suspend", name, suspendOptions), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachines) Resume(ctx context.Context, name string, resumeOptions *v1.ResumeOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "resume", name, resumeOptions), nil)
+
+	return err
+}
@@ -93,6 +93,20 @@ func (c *fakeVirtualMachineInstances) SoftReboot(ctx context.Context, name strin
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) RotateCloudInit(ctx context.Context, name string) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "cloudinit/rotate", name, struct{}{}), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachineInstances) EjectCloudInit(ctx context.Context, name string) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "cloudinit/eject", name, struct{}{}), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "guestosinfo", name), &v1.VirtualMachineInstanceGuestAgentInfo{})
@@ -100,6 +114,13 @@ func (c *fakeVirtualMachineInstances) GuestOsInfo(ctx context.Context, name stri
 	return v1.VirtualMachineInstanceGuestAgentInfo{}, err
 }
 
+func (c *fakeVirtualMachineInstances) RefreshGuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "guestosinfo/refresh", name, struct{}{}), &v1.VirtualMachineInstanceGuestAgentInfo{})
+
+	return v1.VirtualMachineInstanceGuestAgentInfo{}, err
+}
+
 func (c *fakeVirtualMachineInstances) UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "userlist", name), &v1.VirtualMachineInstanceGuestOSUserList{})
@@ -115,6 +136,27 @@ func (c *fakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *fakeVirtualMachineInstances) DomainXML(ctx context.Context, name string) (v1.VirtualMachineInstanceDomainXML, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "domainxml", name), &v1.VirtualMachineInstanceDomainXML{})
+
+	return v1.VirtualMachineInstanceDomainXML{}, err
+}
+
+func (c *fakeVirtualMachineInstances) ReadGuestFile(ctx context.Context, name string, path string) (v1.VirtualMachineInstanceGuestFile, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "guestfile", name), &v1.VirtualMachineInstanceGuestFile{})
+
+	return v1.VirtualMachineInstanceGuestFile{}, err
+}
+
+func (c *fakeVirtualMachineInstances) WriteGuestFile(ctx context.Context, name string, file *v1.VirtualMachineInstanceGuestFile) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "guestfile", name, file), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "addvolume", name, addVolumeOptions), nil)
@@ -133,6 +175,10 @@ func (c *fakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOption
 	return nil, nil
 }
 
+func (c *fakeVirtualMachineInstances) GuestExec(name string, options *v1.GuestExecOptions) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *fakeVirtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "sev/fetchcertchain", name), &v1.SEVPlatformInfo{})
@@ -48,6 +48,11 @@ type VirtualMachineExpansion interface {
 	RemoveMemoryDump(ctx context.Context, name string) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error
+	Rollback(ctx context.Context, name string, rollbackOptions *v1.RollbackOptions) error
+	InsertMedia(ctx context.Context, name string, insertMediaOptions *v1.InsertMediaOptions) error
+	EjectMedia(ctx context.Context, name string, ejectMediaOptions *v1.EjectMediaOptions) error
+	Suspend(ctx context.Context, name string, suspendOptions *v1.SuspendOptions) error
+	Resume(ctx context.Context, name string, resumeOptions *v1.ResumeOptions) error
 }
 
 func (c *virtualMachines) GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error) {
@@ -237,3 +242,83 @@ func (c *virtualMachines) EvacuateCancel(ctx context.Context, name string, evacu
 		Do(ctx).
 		Error()
 }
+
+func (c *virtualMachines) Rollback(ctx context.Context, name string, rollbackOptions *v1.RollbackOptions) error {
+	body, err := json.Marshal(rollbackOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("rollback").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) InsertMedia(ctx context.Context, name string, insertMediaOptions *v1.InsertMediaOptions) error {
+	body, err := json.Marshal(insertMediaOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("insertmedia").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) EjectMedia(ctx context.Context, name string, ejectMediaOptions *v1.EjectMediaOptions) error {
+	body, err := json.Marshal(ejectMediaOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("ejectmedia").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) Suspend(ctx context.Context, name string, suspendOptions *v1.SuspendOptions) error {
+	body, err := json.Marshal(suspendOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("suspend").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) Resume(ctx context.Context, name string, resumeOptions *v1.ResumeOptions) error {
+	body, err := json.Marshal(resumeOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("resume").
+		Body(body).
+		Do(ctx).
+		Error()
+}
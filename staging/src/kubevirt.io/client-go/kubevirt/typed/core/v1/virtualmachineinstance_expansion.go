@@ -36,6 +36,8 @@ const vmiSubresourceURL = "/apis/subresources.kubevirt.io/%s"
 
 type SerialConsoleOptions struct {
 	ConnectionTimeout time.Duration
+	// Serial is the name of an additional serial device to connect to, the default serial console is used if empty
+	Serial string
 }
 
 type VirtualMachineInstanceExpansion interface {
@@ -52,13 +54,20 @@ type VirtualMachineInstanceExpansion interface {
 	Unfreeze(ctx context.Context, name string) error
 	Reset(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
+	RotateCloudInit(ctx context.Context, name string) error
+	EjectCloudInit(ctx context.Context, name string) error
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
+	RefreshGuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	DomainXML(ctx context.Context, name string) (v1.VirtualMachineInstanceDomainXML, error)
+	ReadGuestFile(ctx context.Context, name string, path string) (v1.VirtualMachineInstanceGuestFile, error)
+	WriteGuestFile(ctx context.Context, name string, file *v1.VirtualMachineInstanceGuestFile) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
+	GuestExec(name string, options *v1.GuestExecOptions) (StreamInterface, error)
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
@@ -242,6 +251,28 @@ func (c *virtualMachineInstances) SoftReboot(ctx context.Context, name string) e
 		Error()
 }
 
+func (c *virtualMachineInstances) RotateCloudInit(ctx context.Context, name string) error {
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("cloudinit", "rotate").
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) EjectCloudInit(ctx context.Context, name string) error {
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("cloudinit", "eject").
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	guestInfo := v1.VirtualMachineInstanceGuestAgentInfo{}
 	// WORKAROUND:
@@ -289,6 +320,31 @@ func (c *virtualMachineInstances) GuestOsInfo(ctx context.Context, name string)
 	return guestInfo, err
 }
 
+// RefreshGuestOsInfo polls the guest agent right away instead of waiting for the next periodic sync and returns its information.
+// See GuestOsInfo for why the response is unmarshalled by hand.
+func (c *virtualMachineInstances) RefreshGuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
+	guestInfo := v1.VirtualMachineInstanceGuestAgentInfo{}
+	rawInfo, err := c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestosinfo", "refresh").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		log.Log.Errorf("cannot refresh GuestOSInfo: %s", err.Error())
+		return guestInfo, err
+	}
+
+	err = json.Unmarshal(rawInfo, &guestInfo)
+	if err != nil {
+		log.Log.Errorf("cannot unmarshal GuestOSInfo response: %s", err.Error())
+	}
+
+	return guestInfo, err
+}
+
 func (c *virtualMachineInstances) UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error) {
 	userList := v1.VirtualMachineInstanceGuestOSUserList{}
 	err := c.GetClient().Get().
@@ -316,6 +372,52 @@ func (c *virtualMachineInstances) FilesystemList(ctx context.Context, name strin
 	return fsList, err
 }
 
+func (c *virtualMachineInstances) DomainXML(ctx context.Context, name string) (v1.VirtualMachineInstanceDomainXML, error) {
+	domainXML := v1.VirtualMachineInstanceDomainXML{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("domainxml").
+		Do(ctx).
+		Into(&domainXML)
+
+	return domainXML, err
+}
+
+func (c *virtualMachineInstances) ReadGuestFile(ctx context.Context, name string, path string) (v1.VirtualMachineInstanceGuestFile, error) {
+	file := v1.VirtualMachineInstanceGuestFile{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile").
+		Param("path", path).
+		Do(ctx).
+		Into(&file)
+
+	return file, err
+}
+
+func (c *virtualMachineInstances) WriteGuestFile(ctx context.Context, name string, file *v1.VirtualMachineInstanceGuestFile) error {
+	body, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	objectGraph := v1.ObjectGraphNode{}
 
@@ -377,6 +479,12 @@ func (c *virtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (
 	return nil, fmt.Errorf("VSOCK is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) GuestExec(name string, options *v1.GuestExecOptions) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("GuestExec is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	sevPlatformInfo := v1.SEVPlatformInfo{}
 	err := c.GetClient().Get().
@@ -31,13 +31,20 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8sres "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/retry"
 
 	v1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	poolv1 "kubevirt.io/api/pool/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
@@ -45,6 +52,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/tests/decorators"
 	"kubevirt.io/kubevirt/tests/framework/kubevirt"
+	"kubevirt.io/kubevirt/tests/libinstancetype/builder"
 	"kubevirt.io/kubevirt/tests/libkubevirt"
 	"kubevirt.io/kubevirt/tests/libkubevirt/config"
 	"kubevirt.io/kubevirt/tests/libmigration"
@@ -694,6 +702,83 @@ var _ = Describe("[sig-compute]Dry-Run requests", decorators.SigCompute, decorat
 			Expect(restore.Labels["key"]).ToNot(Equal("42"))
 		})
 	})
+
+	Context("Server-side apply", func() {
+		DescribeTable("should not persist a dry-run apply of", func(gvr schema.GroupVersionResource, kind string, newObj func(namespace string) runtime.Object) {
+			obj := newObj(testsuite.GetTestNamespace(nil))
+			obj.GetObjectKind().SetGroupVersionKind(gvr.GroupVersion().WithKind(kind))
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			Expect(err).ToNot(HaveOccurred())
+			applyObj := &unstructured.Unstructured{Object: content}
+
+			var resourceClient dynamic.ResourceInterface = virtClient.DynamicClient().Resource(gvr)
+			if applyObj.GetNamespace() != "" {
+				resourceClient = virtClient.DynamicClient().Resource(gvr).Namespace(applyObj.GetNamespace())
+			}
+
+			By(fmt.Sprintf("Make a Dry-Run server-side apply request for a %s", kind))
+			opts := metav1.ApplyOptions{DryRun: []string{metav1.DryRunAll}, FieldManager: "dry-run-test"}
+			_, err = resourceClient.Apply(context.Background(), applyObj.GetName(), applyObj, opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			By(fmt.Sprintf("Check that no %s was actually created", kind))
+			_, err = resourceClient.Get(context.Background(), applyObj.GetName(), metav1.GetOptions{})
+			Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+		},
+			Entry("a VirtualMachine", v1.SchemeGroupVersion.WithResource("virtualmachines"), "VirtualMachine",
+				func(namespace string) runtime.Object {
+					return libvmi.NewVirtualMachine(libvmifact.NewGuestless(libvmi.WithNamespace(namespace)))
+				},
+			),
+			Entry("a VirtualMachinePool", poolv1.SchemeGroupVersion.WithResource("virtualmachinepools"), "VirtualMachinePool",
+				func(namespace string) runtime.Object {
+					pool := newPoolFromVMI(libvmifact.NewGuestless())
+					pool.Namespace = namespace
+					return pool
+				},
+			),
+			Entry("a VirtualMachineInstancetype", instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachineinstancetypes"), "VirtualMachineInstancetype",
+				func(namespace string) runtime.Object {
+					instancetype := builder.NewInstancetype(builder.WithCPUs(1), builder.WithMemory("128Mi"))
+					instancetype.Name = "instancetype-" + rand.String(5)
+					instancetype.Namespace = namespace
+					return instancetype
+				},
+			),
+			Entry("a VirtualMachineClusterInstancetype", instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachineclusterinstancetypes"), "VirtualMachineClusterInstancetype",
+				func(_ string) runtime.Object {
+					instancetype := builder.NewClusterInstancetype(builder.WithCPUs(1), builder.WithMemory("128Mi"))
+					instancetype.Name = "clusterinstancetype-" + rand.String(5)
+					instancetype.Namespace = ""
+					return instancetype
+				},
+			),
+			Entry("a VirtualMachinePreference", instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachinepreferences"), "VirtualMachinePreference",
+				func(namespace string) runtime.Object {
+					preference := builder.NewPreference()
+					preference.Name = "preference-" + rand.String(5)
+					preference.Namespace = namespace
+					return preference
+				},
+			),
+			Entry("a VirtualMachineClusterPreference", instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachineclusterpreferences"), "VirtualMachineClusterPreference",
+				func(_ string) runtime.Object {
+					preference := builder.NewClusterPreference()
+					preference.Name = "clusterpreference-" + rand.String(5)
+					preference.Namespace = ""
+					return preference
+				},
+			),
+			Entry("a MigrationPolicy", migrationsv1.SchemeGroupVersion.WithResource("migrationpolicies"), "MigrationPolicy",
+				func(_ string) runtime.Object {
+					return &migrationsv1.MigrationPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "policy-" + rand.String(5)},
+						Spec:       migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{}},
+					}
+				},
+			),
+		)
+	})
 })
 
 func newVMIPreset(name, labelKey, labelValue string) *v1.VirtualMachineInstancePreset {
@@ -62,7 +62,7 @@ var _ = Describe("[sig-compute]CPU Hotplug", decorators.SigCompute, decorators.S
 
 	})
 
-	Context("with requests without topology", decorators.WgS390x, func() {
+	Context("with requests without topology", decorators.WgS390x, decorators.WgArm64, func() {
 
 		It("should be able to start", func() {
 			By("Kubevirt CR with default MaxHotplugRatio set to 4")
@@ -79,7 +79,7 @@ var _ = Describe("[sig-compute]CPU Hotplug", decorators.SigCompute, decorators.S
 		})
 	})
 
-	Context("with Kubevirt CR declaring MaxCpuSockets", decorators.WgS390x, func() {
+	Context("with Kubevirt CR declaring MaxCpuSockets", decorators.WgS390x, decorators.WgArm64, func() {
 
 		It("should be able to start", func() {
 			By("Kubevirt CR with MaxCpuSockets set to 2")
@@ -103,7 +103,7 @@ var _ = Describe("[sig-compute]CPU Hotplug", decorators.SigCompute, decorators.S
 	})
 
 	Context("A VM with cpu.maxSockets set higher than cpu.sockets", func() {
-		It("[test_id:10811]should successfully plug vCPUs", decorators.WgS390x, func() {
+		It("[test_id:10811]should successfully plug vCPUs", decorators.WgS390x, decorators.WgArm64, func() {
 			By("Creating a running VM with 1 socket and 2 max sockets")
 			const (
 				maxSockets uint32 = 2
@@ -290,7 +290,7 @@ var _ = Describe("[sig-compute]CPU Hotplug", decorators.SigCompute, decorators.S
 		})
 	})
 
-	Context("Abort CPU change", decorators.WgS390x, func() {
+	Context("Abort CPU change", decorators.WgS390x, decorators.WgArm64, func() {
 		It("[test_id:6547]should cancel the automated workload update", func() {
 			vmi := libvmifact.NewAlpineWithTestTooling(libnet.WithMasqueradeNetworking())
 			vmi.Namespace = testsuite.GetTestNamespace(vmi)
@@ -365,6 +365,35 @@ var _ = Describe("[sig-compute]CPU Hotplug", decorators.SigCompute, decorators.S
 
 		})
 	})
+
+	Context("on arm64", decorators.WgArm64, decorators.RequiresARM64, func() {
+		It("should schedule VMs with pluggable vCPUs on nodes capable of CPU hotplug", func() {
+			vmi := libvmifact.NewAlpine(libnet.WithMasqueradeNetworking())
+			vmi.Namespace = testsuite.GetTestNamespace(vmi)
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Sockets:    1,
+				Cores:      1,
+				Threads:    1,
+				MaxSockets: 2,
+			}
+			vm := libvmi.NewVirtualMachine(vmi, libvmi.WithRunStrategy(v1.RunStrategyAlways))
+
+			vm, err := virtClient.VirtualMachine(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(ThisVM(vm), 360*time.Second, 1*time.Second).Should(BeReady())
+			vmi = libwait.WaitForSuccessfulVMIStart(vmi)
+
+			By("Ensuring the virt-launcher pod requires a node capable of CPU hotplug")
+			pod, err := libpod.GetPodByVirtualMachineInstance(vmi, vmi.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUHotplugLabel, "true"))
+
+			By("Ensuring the node is labelled as capable of CPU hotplug")
+			node, err := virtClient.CoreV1().Nodes().Get(context.Background(), vmi.Status.NodeName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Labels).To(HaveKeyWithValue(v1.CPUHotplugLabel, "true"))
+		})
+	})
 })
 
 // The VMI is assumed to be already logged-in.
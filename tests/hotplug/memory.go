@@ -58,7 +58,7 @@ var _ = Describe("[sig-compute]Memory Hotplug", decorators.SigCompute, decorator
 
 	})
 
-	Context("A VM with memory liveUpdate enabled", func() {
+	Context("A VM with memory liveUpdate enabled", decorators.WgArm64, func() {
 
 		createHotplugVM := func(sockets *uint32, maxSockets uint32, opts ...libvmi.Option) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
 			vmiOpts := append([]libvmi.Option{},
@@ -0,0 +1,158 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package job
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+// DNSRecordType is the type of the DNS records a DNS resolution job looks up.
+type DNSRecordType string
+
+const (
+	DNSRecordA    DNSRecordType = "A"
+	DNSRecordAAAA DNSRecordType = "AAAA"
+	DNSRecordSRV  DNSRecordType = "SRV"
+)
+
+// WithExpectedRecords sets the records a DNS resolution job expects to resolve, in addition to resolving at least one.
+// A and AAAA records are IPs, SRV records are formatted as "target:port", where the target may omit the cluster domain,
+// e.g. "vmi-0.my-headless-service.my-namespace.svc:1500".
+func WithExpectedRecords(records ...string) Option {
+	return func(c *jobConfig) {
+		c.expectedRecords = append(c.expectedRecords, records...)
+	}
+}
+
+// NewDNSResolutionJob returns a job which resolves the records of the given type of the DNS name, e.g. the name of a
+// headless service or of one of its endpoints. The job succeeds if at least one record and all expected records resolve.
+func NewDNSResolutionJob(name string, recordType DNSRecordType, opts ...Option) (*batchv1.Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("DNS name is required")
+	}
+	switch recordType {
+	case DNSRecordA, DNSRecordAAAA, DNSRecordSRV:
+	default:
+		return nil, fmt.Errorf("unknown DNS record type %q", recordType)
+	}
+	c, err := newJobConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+	args := append([]string{dnsResolutionScript, string(recordType), name}, c.expectedRecords...)
+	return c.newJob("dns", []string{"python3", "-c"}, args), nil
+}
+
+// dnsResolutionScript resolves A and AAAA records through the resolver of the pod.
+// The test image does not ship DNS tools, SRV records are therefore queried directly from the first nameserver of
+// the pod, expanding the name with the search domains the same way the resolver does.
+const dnsResolutionScript = `
+import random
+import socket
+import struct
+import sys
+
+SRV = 33
+
+
+def read_name(message, offset):
+    labels = []
+    while True:
+        length = message[offset]
+        if length & 0xC0 == 0xC0:
+            pointer = struct.unpack("!H", message[offset:offset + 2])[0] & 0x3FFF
+            labels.append(read_name(message, pointer)[0])
+            return ".".join(labels), offset + 2
+        offset += 1
+        if length == 0:
+            return ".".join(labels), offset
+        labels.append(message[offset:offset + length].decode())
+        offset += length
+
+
+def query_srv(name):
+    nameservers, search = [], []
+    with open("/etc/resolv.conf") as resolv_conf:
+        for line in resolv_conf:
+            fields = line.split()
+            if len(fields) > 1 and fields[0] == "nameserver":
+                nameservers.append(fields[1])
+            elif fields and fields[0] == "search":
+                search = fields[1:]
+    if name.endswith("."):
+        candidates = [name.rstrip(".")]
+    else:
+        candidates = [name + "." + domain for domain in search] + [name]
+    for candidate in candidates:
+        question = b"".join(bytes([len(label)]) + label.encode() for label in candidate.split("."))
+        question += b"\0" + struct.pack("!HH", SRV, 1)
+        request = struct.pack("!HHHHHH", random.randint(0, 0xFFFF), 0x0100, 1, 0, 0, 0) + question
+        family = socket.AF_INET6 if ":" in nameservers[0] else socket.AF_INET
+        with socket.socket(family, socket.SOCK_DGRAM) as sock:
+            sock.settimeout(5)
+            sock.sendto(request, (nameservers[0], 53))
+            response = sock.recv(65535)
+        flags, answers = struct.unpack("!HH", response[2:4] + response[6:8])
+        if flags & 0xF != 0 or answers == 0:
+            continue
+        offset = 12 + len(question)
+        records = []
+        for _ in range(answers):
+            _, offset = read_name(response, offset)
+            record_type, _, _, length = struct.unpack("!HHIH", response[offset:offset + 10])
+            offset += 10
+            if record_type == SRV:
+                port = struct.unpack("!H", response[offset + 4:offset + 6])[0]
+                target, _ = read_name(response, offset + 6)
+                records.append("%s:%d" % (target, port))
+            offset += length
+        return sorted(records)
+    return []
+
+
+def resolve_addresses(name, family):
+    try:
+        return sorted({info[4][0] for info in socket.getaddrinfo(name, None, family, socket.SOCK_STREAM)})
+    except socket.gaierror:
+        return []
+
+
+def matches(record, expected):
+    if record == expected:
+        return True
+    target, _, port = record.rpartition(":")
+    expected_target, _, expected_port = expected.rpartition(":")
+    return port == expected_port and target.startswith(expected_target + ".")
+
+
+record_type, name, expected_records = sys.argv[1], sys.argv[2], sys.argv[3:]
+if record_type == "SRV":
+    records = query_srv(name)
+else:
+    records = resolve_addresses(name, socket.AF_INET if record_type == "A" else socket.AF_INET6)
+print("%s records of %s: %s" % (record_type, name, records))
+missing = [expected for expected in expected_records if not any(matches(record, expected) for record in records)]
+if not records or missing:
+    print("failed, missing records: %s" % missing)
+    sys.exit(1)
+print("succeeded")
+`
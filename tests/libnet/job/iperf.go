@@ -0,0 +1,179 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultIPerfDuration is the time in seconds an iperf3 client job transmits by default.
+const DefaultIPerfDuration = 10
+
+// WithIPerfDuration sets the time in seconds an iperf3 client job transmits, DefaultIPerfDuration by default.
+func WithIPerfDuration(seconds int) Option {
+	return func(c *jobConfig) {
+		c.iperfDuration = seconds
+	}
+}
+
+// NewIPerfServerJob returns a job which serves a single iperf3 test on the given port, e.g. for a VMI running the
+// iperf3 client. The job succeeds once the test finished, its result is returned by GetIPerfResult.
+func NewIPerfServerJob(port int, opts ...Option) (*batchv1.Job, error) {
+	if err := validatePort(port); err != nil {
+		return nil, err
+	}
+	c, err := newJobConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.newJob("iperf-server", []string{"iperf3"}, []string{"--server", "--one-off", "--json", "--port", strconv.Itoa(port)}), nil
+}
+
+// NewIPerfClientJob returns a job which measures the throughput to the iperf3 server listening on the host, a DNS
+// entry or an IP, and port, over TCP or over UDP with WithProtocol(ProtocolUDP).
+// The job succeeds once the test finished, its result is returned by GetIPerfResult.
+func NewIPerfClientJob(host string, port int, opts ...Option) (*batchv1.Job, error) {
+	if err := validateHostPort(host, port); err != nil {
+		return nil, err
+	}
+	c, err := newJobConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if c.protocol == ProtocolHTTP {
+		return nil, fmt.Errorf("iperf3 does not support protocol %q", c.protocol)
+	}
+	args := []string{"--client", host, "--port", strconv.Itoa(port), "--time", strconv.Itoa(c.iperfDuration), "--json"}
+	if flag := c.ipFamilyFlag(); flag != "" {
+		args = append(args, flag)
+	}
+	if c.protocol == ProtocolUDP {
+		// The UDP bitrate is limited to 1 Mbit/s by default.
+		args = append(args, "--udp", "--bitrate", "0")
+	}
+	return c.newJob("iperf-client", []string{"iperf3"}, args), nil
+}
+
+// MeasureThroughput runs an iperf3 client job in the namespace against the iperf3 server listening on the host and
+// port, e.g. in a VMI, and returns its result once it finished.
+func MeasureThroughput(ctx context.Context, client kubernetes.Interface, namespace, host string, port int, opts ...Option) (*IPerfResult, error) {
+	iperfJob, err := NewIPerfClientJob(host, port, opts...)
+	if err != nil {
+		return nil, err
+	}
+	iperfJob, err = client.BatchV1().Jobs(namespace).Create(ctx, iperfJob, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(*iperfJob.Spec.ActiveDeadlineSeconds) * time.Second
+	if err := WaitForJobToSucceed(ctx, client, iperfJob, timeout); err != nil {
+		return nil, err
+	}
+	return GetIPerfResult(ctx, client, iperfJob)
+}
+
+// IPerfResult is the summary of an iperf3 test.
+type IPerfResult struct {
+	// SentBitsPerSecond is the throughput the client sent at.
+	SentBitsPerSecond float64
+	// ReceivedBitsPerSecond is the throughput the server received at.
+	ReceivedBitsPerSecond float64
+	// Retransmits is the number of TCP segments the client retransmitted, zero over UDP.
+	Retransmits int64
+	// JitterMilliseconds is the jitter of the UDP datagrams, zero over TCP.
+	JitterMilliseconds float64
+	// LostPercent is the percentage of the UDP datagrams which were lost, zero over TCP.
+	LostPercent float64
+}
+
+// GetIPerfResult returns the result of the iperf3 test run by a succeeded iperf3 client or server job.
+func GetIPerfResult(ctx context.Context, client kubernetes.Interface, job *batchv1.Job) (*IPerfResult, error) {
+	pods, err := listJobPods(ctx, client, job)
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase != k8sv1.PodSucceeded {
+			continue
+		}
+		logs, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &k8sv1.PodLogOptions{}).DoRaw(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return ParseIPerfResult(logs)
+	}
+	return nil, fmt.Errorf("job %s has no succeeded pod", job.Name)
+}
+
+type iperfSum struct {
+	BitsPerSecond float64 `json:"bits_per_second"`
+	Retransmits   int64   `json:"retransmits"`
+	JitterMs      float64 `json:"jitter_ms"`
+	LostPercent   float64 `json:"lost_percent"`
+}
+
+type iperfOutput struct {
+	End struct {
+		SumSent     *iperfSum `json:"sum_sent"`
+		SumReceived *iperfSum `json:"sum_received"`
+		// Sum is the summary of a UDP test.
+		Sum *iperfSum `json:"sum"`
+	} `json:"end"`
+	Error string `json:"error"`
+}
+
+// ParseIPerfResult parses the JSON output of an iperf3 client or server, e.g. of one running in a VMI.
+func ParseIPerfResult(output []byte) (*IPerfResult, error) {
+	var parsed iperfOutput
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse the iperf3 output %q: %v", output, err)
+	}
+	if parsed.Error != "" {
+		return nil, fmt.Errorf("iperf3 failed: %s", parsed.Error)
+	}
+
+	end := parsed.End
+	if end.Sum != nil {
+		// The received throughput of a UDP test is derived from the datagrams which were not lost.
+		return &IPerfResult{
+			SentBitsPerSecond:     end.Sum.BitsPerSecond,
+			ReceivedBitsPerSecond: end.Sum.BitsPerSecond * (100 - end.Sum.LostPercent) / 100,
+			JitterMilliseconds:    end.Sum.JitterMs,
+			LostPercent:           end.Sum.LostPercent,
+		}, nil
+	}
+	if end.SumSent == nil || end.SumReceived == nil {
+		return nil, fmt.Errorf("iperf3 output has no summary: %q", output)
+	}
+	return &IPerfResult{
+		SentBitsPerSecond:     end.SumSent.BitsPerSecond,
+		ReceivedBitsPerSecond: end.SumReceived.BitsPerSecond,
+		Retransmits:           end.SumSent.Retransmits,
+	}, nil
+}
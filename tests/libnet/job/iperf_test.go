@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package job_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"kubevirt.io/kubevirt/tests/libnet/job"
+)
+
+var _ = Describe("IPerf", func() {
+	containerArgs := func(iperfJob *batchv1.Job, err error) []string {
+		Expect(err).ToNot(HaveOccurred())
+		containers := iperfJob.Spec.Template.Spec.Containers
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Command).To(Equal([]string{"iperf3"}))
+		return containers[0].Args
+	}
+
+	It("should serve a single test", func() {
+		Expect(containerArgs(job.NewIPerfServerJob(5201))).To(Equal([]string{"--server", "--one-off", "--json", "--port", "5201"}))
+	})
+
+	DescribeTable("should measure the throughput to the server", func(opts []job.Option, expectedArgs []string) {
+		Expect(containerArgs(job.NewIPerfClientJob("fd10::1", 5201, opts...))).To(Equal(expectedArgs))
+	},
+		Entry("over TCP", nil,
+			[]string{"--client", "fd10::1", "--port", "5201", "--time", "10", "--json"}),
+		Entry("over UDP", []job.Option{job.WithProtocol(job.ProtocolUDP), job.WithIPerfDuration(30)},
+			[]string{"--client", "fd10::1", "--port", "5201", "--time", "30", "--json", "--udp", "--bitrate", "0"}),
+		Entry("over IPv6", []job.Option{job.WithIPFamily(k8sv1.IPv6Protocol)},
+			[]string{"--client", "fd10::1", "--port", "5201", "--time", "10", "--json", "-6"}),
+	)
+
+	DescribeTable("should parse the result", func(output string, expectedResult *job.IPerfResult) {
+		Expect(job.ParseIPerfResult([]byte(output))).To(Equal(expectedResult))
+	},
+		Entry("of a TCP test",
+			`{"start": {}, "intervals": [], "end": {
+				"sum_sent": {"bytes": 1250000000, "bits_per_second": 1000000000.5, "retransmits": 12},
+				"sum_received": {"bytes": 1237500000, "bits_per_second": 990000000.25}
+			}}`,
+			&job.IPerfResult{SentBitsPerSecond: 1000000000.5, ReceivedBitsPerSecond: 990000000.25, Retransmits: 12},
+		),
+		Entry("of a UDP test",
+			`{"start": {}, "intervals": [], "end": {
+				"sum": {"bits_per_second": 800000000, "jitter_ms": 0.25, "lost_packets": 50, "lost_percent": 25}
+			}}`,
+			&job.IPerfResult{SentBitsPerSecond: 800000000, ReceivedBitsPerSecond: 600000000, JitterMilliseconds: 0.25, LostPercent: 25},
+		),
+	)
+
+	DescribeTable("should fail to parse", func(output, expectedError string) {
+		_, err := job.ParseIPerfResult([]byte(output))
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("an output which is not JSON", "iperf3: error - unable to connect to server", "failed to parse the iperf3 output"),
+		Entry("an iperf3 error", `{"start": {}, "end": {}, "error": "unable to connect to server: Connection refused"}`,
+			"iperf3 failed: unable to connect to server: Connection refused"),
+		Entry("an output without summary", `{"start": {}, "end": {}}`, "iperf3 output has no summary"),
+	)
+
+	Context("GetIPerfResult", func() {
+		iperfJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "iperf-client", Namespace: metav1.NamespaceDefault}}
+
+		newPod := func(phase k8sv1.PodPhase) *k8sv1.Pod {
+			return &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "iperf-client-pod",
+					Namespace: metav1.NamespaceDefault,
+					Labels:    map[string]string{batchv1.JobNameLabel: iperfJob.Name},
+				},
+				Status: k8sv1.PodStatus{Phase: phase},
+			}
+		}
+
+		It("should parse the logs of the succeeded pod", func() {
+			client := fake.NewSimpleClientset(newPod(k8sv1.PodSucceeded))
+
+			// The fake client returns "fake logs" as logs of any pod.
+			_, err := job.GetIPerfResult(context.Background(), client, iperfJob)
+			Expect(err).To(MatchError(ContainSubstring(`failed to parse the iperf3 output "fake logs"`)))
+		})
+
+		It("should fail when the job has no succeeded pod", func() {
+			client := fake.NewSimpleClientset(newPod(k8sv1.PodFailed))
+
+			_, err := job.GetIPerfResult(context.Background(), client, iperfJob)
+			Expect(err).To(MatchError("job iperf-client has no succeeded pod"))
+		})
+	})
+})
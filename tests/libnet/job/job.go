@@ -21,17 +21,23 @@ package job
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	. "github.com/onsi/ginkgo/v2"
+
 	batchv1 "k8s.io/api/batch/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 
-	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
-	"kubevirt.io/kubevirt/tests/framework/kubevirt"
-	"kubevirt.io/kubevirt/tests/libnet"
 	"kubevirt.io/kubevirt/tests/libpod"
+	"kubevirt.io/kubevirt/tests/libregistry"
 )
 
 const (
@@ -39,7 +45,7 @@ const (
 	toFail    = false
 )
 
-// Default Job arguments to be used with NewJob.
+// Default Job arguments used by NewConnectivityJob.
 const (
 	JobRetry   = 3
 	JobTTL     = 60
@@ -47,64 +53,270 @@ const (
 )
 
 // WaitForJobToFail blocks until the given job finishes.
-// On failure, it returns with a nil error, on success or timeout it returns with an error.
-func WaitForJobToFail(job *batchv1.Job, timeout time.Duration) error {
-	return WaitForJob(job, toFail, timeout)
+// On failure, it returns with a nil error, on success, timeout or cancellation of the context it returns with an error.
+func WaitForJobToFail(ctx context.Context, client kubernetes.Interface, job *batchv1.Job, timeout time.Duration) error {
+	return WaitForJob(ctx, client, job, toFail, timeout)
 }
 
 // WaitForJobToSucceed blocks until the given job finishes.
-// On success, it returns with a nil error, on failure or timeout it returns with an error.
-func WaitForJobToSucceed(job *batchv1.Job, timeout time.Duration) error {
-	return WaitForJob(job, toSucceed, timeout)
-}
-
-// NewHelloWorldJobUDP takes a DNS entry or an IP and a port which it will use create a pod
-// which tries to contact the host on the provided port. It expects to receive "Hello UDP World!" to succeed.
-// Note that in case of UDP, the server will not see the connection unless something is sent over it
-// However, netcat does not work well with UDP and closes before the answer arrives, we make netcat wait until
-// the defined timeout is expired to prevent this from happening.
-func NewHelloWorldJobUDP(host, port string) *batchv1.Job {
-	timeout := 5
-	check := fmt.Sprintf(`set -x
-x=$(cat <(echo) <(sleep %[1]d) | nc -u %s %s -i %[1]d -w %[1]d | head -n 1)
-echo "$x"
-if [ "$x" = "Hello UDP World!" ]; then
-  echo "succeeded"
-  exit 0
-else
-  echo "failed"
-  exit 1
-fi`,
-		timeout, host, port)
-
-	return NewHelloWorldJob(check)
-}
-
-// NewHelloWorldJob takes a DNS entry or an IP and a port which it will use to create a job
-// which tries to contact the host on the provided port.
-// It expects to receive "Hello World!" to succeed.
-func NewHelloWorldJobTCP(host, port string) *batchv1.Job {
-	check := fmt.Sprintf(`set -x; x="$(head -n 1 < <(nc %s %s -i 3 -w 3 --no-shutdown))"; echo "$x" ; \
-	  if [ "$x" = "Hello World!" ]; then echo "succeeded"; exit 0; else echo "failed"; exit 1; fi`, host, port)
-	return NewHelloWorldJob(check)
-}
-
-// NewHelloWorldJobHTTP gets an IP address and a port, which it uses to create a pod.
-// This pod tries to contact the host on the provided port, over HTTP.
-// On success - it expects to receive "Hello World!".
-func NewHelloWorldJobHTTP(host, port string) *batchv1.Job {
-	check := fmt.Sprintf(`set -x; x="$(head -n 1 < <(curl --silent %s:%s))"; echo "$x" ; \
-	  if [ "$x" = "Hello World!" ]; then echo "succeeded"; exit 0; else echo "failed"; exit 1; fi`, libnet.FormatIPForURL(host), port)
-	return NewHelloWorldJob(check)
-}
-
-func NewHelloWorldJob(checkConnectivityCmd string) *batchv1.Job {
-	return newJob("netcat", []string{"/bin/bash", "-c"}, []string{checkConnectivityCmd}, JobRetry, JobTTL, JobTimeout)
-}
-
-func WaitForJob(job *batchv1.Job, toSucceed bool, timeout time.Duration) error {
-	virtClient := kubevirt.Client()
+// On success, it returns with a nil error, on failure, timeout or cancellation of the context it returns with an error.
+func WaitForJobToSucceed(ctx context.Context, client kubernetes.Interface, job *batchv1.Job, timeout time.Duration) error {
+	return WaitForJob(ctx, client, job, toSucceed, timeout)
+}
+
+// Protocol is the protocol a connectivity job uses to reach the host.
+type Protocol string
+
+const (
+	ProtocolTCP  Protocol = "tcp"
+	ProtocolUDP  Protocol = "udp"
+	ProtocolHTTP Protocol = "http"
+)
+
+// DefaultExpectedPayload is the payload a connectivity job expects by default to receive from the host.
+const DefaultExpectedPayload = "Hello World!"
+
+// netAgentPath is the path of the test-net-agent binary in the test-helpers image.
+const netAgentPath = "/usr/bin/test-net-agent"
+
+// netAgentTimeout is the time the test-net-agent waits for the answer of the host in each attempt.
+const netAgentTimeout = 5 * time.Second
+
+type jobConfig struct {
+	protocol         Protocol
+	expectedPayload  string
+	expectedRecords  []string
+	iperfDuration    int
+	retries          int32
+	ttlAfterFinished int32
+	timeout          int64
+	ipFamily         k8sv1.IPFamily
+	podOptions       []libpod.Option
+}
+
+// Option customizes a job created with NewConnectivityJob, NewDNSResolutionJob or the iperf3 job constructors.
+type Option func(*jobConfig)
+
+// WithProtocol sets the protocol a connectivity job uses to reach the host, TCP by default.
+func WithProtocol(protocol Protocol) Option {
+	return func(c *jobConfig) {
+		c.protocol = protocol
+	}
+}
+
+// WithExpectedPayload sets the first line a connectivity job expects to receive from the host, DefaultExpectedPayload by default.
+func WithExpectedPayload(payload string) Option {
+	return func(c *jobConfig) {
+		c.expectedPayload = payload
+	}
+}
+
+// WithRetries sets the number of times the job retries, JobRetry by default.
+func WithRetries(retries int32) Option {
+	return func(c *jobConfig) {
+		c.retries = retries
+	}
+}
+
+// WithTimeout sets the overall time in seconds after which the job is terminated, JobTimeout by default.
+func WithTimeout(timeout int64) Option {
+	return func(c *jobConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithNodeAffinity restricts the nodes the job runs on, e.g. to run it on the node of a VMI
+// with the NodeSelectorOpIn operator, or from any other node with the NodeSelectorOpNotIn operator.
+func WithNodeAffinity(operator k8sv1.NodeSelectorOperator, nodeName string) Option {
+	return func(c *jobConfig) {
+		c.podOptions = append(c.podOptions, libpod.WithNodeAffinity(operator, nodeName))
+	}
+}
+
+// WithNodeName pins the job to the given node, e.g. to check the node-local traffic path to a VMI.
+func WithNodeName(nodeName string) Option {
+	return WithNodeAffinity(k8sv1.NodeSelectorOpIn, nodeName)
+}
+
+// WithIPFamily restricts the job to the given IP family when it reaches the host or the iperf3 server,
+// e.g. to check the IPv6 path to a dual-stack DNS name. Both families are used by default.
+func WithIPFamily(ipFamily k8sv1.IPFamily) Option {
+	return func(c *jobConfig) {
+		c.ipFamily = ipFamily
+	}
+}
+
+// WithSecondaryNetwork attaches the pod of the job to the given network attachment definition through Multus.
+// The traffic of the job leaves through the interface of this network if the destination is in its subnet.
+func WithSecondaryNetwork(networkName string) Option {
+	return func(c *jobConfig) {
+		c.podOptions = append(c.podOptions, libpod.WithNetworks(networkName))
+	}
+}
+
+// NewConnectivityJob returns a job which tries to reach the host, a DNS entry or an IP, on the given port.
+// The job succeeds if the first line it receives from the host is the expected payload, its verdict is returned
+// by GetConnectivityVerdicts.
+func NewConnectivityJob(host, port string, opts ...Option) (*batchv1.Job, error) {
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q: %v", port, err)
+	}
+	if err := validateHostPort(host, portNumber); err != nil {
+		return nil, err
+	}
+	c, err := newJobConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.podOptions = append(c.podOptions, libpod.WithImage(libregistry.GetTestHelpersImage()))
+	return c.newJob("connectivity", []string{netAgentPath, "client"}, c.netAgentClientArgs(host, port)), nil
+}
 
+// NewServiceConnectivityJob returns a job which tries to reach the service through its DNS name on the given port.
+func NewServiceConnectivityJob(serviceName, namespace string, port int, opts ...Option) (*batchv1.Job, error) {
+	if serviceName == "" || namespace == "" {
+		return nil, fmt.Errorf("service name and namespace are required, got %q and %q", serviceName, namespace)
+	}
+	return NewConnectivityJob(ServiceDNSName(serviceName, namespace), strconv.Itoa(port), opts...)
+}
+
+// ServiceDNSName returns the DNS name of the service, relative to the cluster domain.
+func ServiceDNSName(serviceName, namespace string) string {
+	return fmt.Sprintf("%s.%s.svc", serviceName, namespace)
+}
+
+func newJobConfig(opts ...Option) (*jobConfig, error) {
+	c := &jobConfig{
+		protocol:         ProtocolTCP,
+		expectedPayload:  DefaultExpectedPayload,
+		retries:          JobRetry,
+		ttlAfterFinished: JobTTL,
+		timeout:          JobTimeout,
+		iperfDuration:    DefaultIPerfDuration,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *jobConfig) validate() error {
+	switch c.protocol {
+	case ProtocolTCP, ProtocolUDP, ProtocolHTTP:
+	default:
+		return fmt.Errorf("unknown protocol %q", c.protocol)
+	}
+	switch c.ipFamily {
+	case "", k8sv1.IPv4Protocol, k8sv1.IPv6Protocol:
+	default:
+		return fmt.Errorf("unknown IP family %q", c.ipFamily)
+	}
+	if c.retries < 0 {
+		return fmt.Errorf("retries must not be negative, got %d", c.retries)
+	}
+	if c.timeout <= 0 {
+		return fmt.Errorf("timeout must be positive, got %d", c.timeout)
+	}
+	if c.iperfDuration <= 0 {
+		return fmt.Errorf("iperf3 duration must be positive, got %d", c.iperfDuration)
+	}
+	return nil
+}
+
+func validateHostPort(host string, port int) error {
+	if host == "" {
+		return fmt.Errorf("host is required")
+	}
+	return validatePort(port)
+}
+
+func validatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535, got %d", port)
+	}
+	return nil
+}
+
+func (c *jobConfig) newJob(name string, cmd, args []string) *batchv1.Job {
+	return newJob(name, cmd, args, c.retries, c.ttlAfterFinished, c.timeout, c.podOptions...)
+}
+
+// ipFamilyFlag returns the flag restricting iperf3 to the IP family of the job, if any.
+func (c *jobConfig) ipFamilyFlag() string {
+	switch c.ipFamily {
+	case k8sv1.IPv4Protocol:
+		return "-4"
+	case k8sv1.IPv6Protocol:
+		return "-6"
+	default:
+		return ""
+	}
+}
+
+// ConnectivityVerdict is the verdict a connectivity job prints as JSON.
+type ConnectivityVerdict struct {
+	Protocol  Protocol `json:"protocol"`
+	Address   string   `json:"address"`
+	Received  string   `json:"received,omitempty"`
+	Succeeded bool     `json:"succeeded"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// GetConnectivityVerdicts returns the verdicts of the pods of the connectivity job which finished, one per attempt.
+func GetConnectivityVerdicts(ctx context.Context, client kubernetes.Interface, job *batchv1.Job) ([]ConnectivityVerdict, error) {
+	pods, err := listJobPods(ctx, client, job)
+	if err != nil {
+		return nil, err
+	}
+	var verdicts []ConnectivityVerdict
+	for _, pod := range pods {
+		if pod.Status.Phase != k8sv1.PodSucceeded && pod.Status.Phase != k8sv1.PodFailed {
+			continue
+		}
+		logs, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &k8sv1.PodLogOptions{}).DoRaw(ctx)
+		if err != nil {
+			return nil, err
+		}
+		verdict, err := parseConnectivityVerdict(logs)
+		if err != nil {
+			return nil, fmt.Errorf("pod %s: %v", pod.Name, err)
+		}
+		verdicts = append(verdicts, *verdict)
+	}
+	return verdicts, nil
+}
+
+// parseConnectivityVerdict returns the verdict printed in the logs, the last JSON line following the errors of the
+// previous attempts.
+func parseConnectivityVerdict(logs []byte) (*ConnectivityVerdict, error) {
+	lines := strings.Split(strings.TrimSpace(string(logs)), "\n")
+	var verdict ConnectivityVerdict
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &verdict); err != nil {
+		return nil, fmt.Errorf("failed to parse the verdict from the logs %q: %v", logs, err)
+	}
+	return &verdict, nil
+}
+
+func (c *jobConfig) netAgentClientArgs(host, port string) []string {
+	args := []string{
+		"--protocol", string(c.protocol),
+		"--host", host,
+		"--port", port,
+		"--expected-payload", c.expectedPayload,
+		"--timeout", netAgentTimeout.String(),
+	}
+	if c.ipFamily != "" {
+		args = append(args, "--ip-family", string(c.ipFamily))
+	}
+	return args
+}
+
+// WaitForJob polls the given job with the client until it finishes, the timeout is reached or the context is cancelled.
+func WaitForJob(ctx context.Context, client kubernetes.Interface, job *batchv1.Job, toSucceed bool, timeout time.Duration) error {
 	jobFailedError := func(job *batchv1.Job) error {
 		if toSucceed {
 			return fmt.Errorf("job %s finished with failure, status: %+v", job.Name, job.Status)
@@ -120,10 +332,11 @@ func WaitForJob(job *batchv1.Job, toSucceed bool, timeout time.Duration) error {
 
 	const finish = true
 	poller := func(ctx context.Context) (done bool, err error) {
-		job, err = virtClient.BatchV1().Jobs(job.Namespace).Get(ctx, job.Name, metav1.GetOptions{})
+		current, err := client.BatchV1().Jobs(job.Namespace).Get(ctx, job.Name, metav1.GetOptions{})
 		if err != nil {
 			return finish, err
 		}
+		job = current
 		for _, c := range job.Status.Conditions {
 			switch c.Type {
 			case batchv1.JobComplete:
@@ -141,13 +354,70 @@ func WaitForJob(job *batchv1.Job, toSucceed bool, timeout time.Duration) error {
 		return !finish, nil
 	}
 
-	err := virtwait.PollImmediately(time.Second, timeout, poller)
+	err := wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, poller)
 	if err != nil {
-		return fmt.Errorf("job %s timeout reached, status: %+v, err: %v", job.Name, job.Status, err)
+		diagnostics := collectDiagnostics(client, job)
+		AddReportEntry(fmt.Sprintf("job %s diagnostics", job.Name), diagnostics, ReportEntryVisibilityFailureOrVerbose)
+		return fmt.Errorf("job %s timeout reached, status: %+v, err: %v\n%s", job.Name, job.Status, err, diagnostics)
 	}
 	return nil
 }
 
+// diagnosticsTimeout bounds the collection of the diagnostics, which does not use the context of
+// WaitForJob since it may already be cancelled.
+const diagnosticsTimeout = 30 * time.Second
+
+// collectDiagnostics returns the logs of the pods of the job, and the events of the job and its pods.
+// Errors are reported in place of the missing diagnostics.
+func collectDiagnostics(client kubernetes.Interface, job *batchv1.Job) string {
+	ctx, cancel := context.WithTimeout(context.Background(), diagnosticsTimeout)
+	defer cancel()
+
+	var diagnostics strings.Builder
+	involvedObjects := []string{job.Name}
+
+	pods, err := listJobPods(ctx, client, job)
+	if err != nil {
+		fmt.Fprintf(&diagnostics, "failed to list the pods of job %s: %v\n", job.Name, err)
+	} else {
+		for _, pod := range pods {
+			involvedObjects = append(involvedObjects, pod.Name)
+			logs, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &k8sv1.PodLogOptions{}).DoRaw(ctx)
+			if err != nil {
+				fmt.Fprintf(&diagnostics, "failed to get the logs of pod %s: %v\n", pod.Name, err)
+				continue
+			}
+			fmt.Fprintf(&diagnostics, "logs of pod %s (phase %s):\n%s\n", pod.Name, pod.Status.Phase, logs)
+		}
+	}
+
+	for _, name := range involvedObjects {
+		events, err := client.CoreV1().Events(job.Namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("involvedObject.name", name).String(),
+		})
+		if err != nil {
+			fmt.Fprintf(&diagnostics, "failed to list the events of %s: %v\n", name, err)
+			continue
+		}
+		fmt.Fprintf(&diagnostics, "events of %s:\n", name)
+		for _, event := range events.Items {
+			fmt.Fprintf(&diagnostics, "  %s %s %s: %s\n", event.LastTimestamp.UTC().Format(time.RFC3339), event.Type, event.Reason, event.Message)
+		}
+	}
+
+	return diagnostics.String()
+}
+
+func listJobPods(ctx context.Context, client kubernetes.Interface, job *batchv1.Job) ([]k8sv1.Pod, error) {
+	pods, err := client.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", batchv1.JobNameLabel, job.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
 // NewJob creates a job configuration that runs a single Pod.
 // A name is used for the job & pod while the command and its arguments are passed to the pod for execution.
 // In addition, the following arguments control the job behavior:
@@ -157,10 +427,14 @@ func WaitForJob(job *batchv1.Job, toSucceed bool, timeout time.Duration) error {
 //	Make sure to leave enough time for the reporter to collect the logs.
 //
 // timeout: The overall time at which the job is terminated, regardless of it finishing or not.
-func newJob(name string, cmd, args []string, retry, ttlAfterFinished int32, timeout int64) *batchv1.Job {
-	pod := libpod.RenderPod(name, cmd, args)
+// podOpts: The options applied to the pod, e.g. its placement.
+func newJob(name string, cmd, args []string, retry, ttlAfterFinished int32, timeout int64, podOpts ...libpod.Option) *batchv1.Job {
+	pod := libpod.RenderPod(name, cmd, args, podOpts...)
 	job := batchv1.Job{
-		ObjectMeta: pod.ObjectMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: pod.GenerateName,
+			Labels:       pod.Labels,
+		},
 		Spec: batchv1.JobSpec{
 			BackoffLimit:            &retry,
 			TTLSecondsAfterFinished: &ttlAfterFinished,
@@ -0,0 +1,304 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package job_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"kubevirt.io/kubevirt/tests/flags"
+	"kubevirt.io/kubevirt/tests/libnet/job"
+)
+
+var _ = Describe("Job", func() {
+	const timeout = 5 * time.Second
+
+	newJobWithCondition := func(conditionType batchv1.JobConditionType) *batchv1.Job {
+		testJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: metav1.NamespaceDefault}}
+		if conditionType != "" {
+			testJob.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: k8sv1.ConditionTrue}}
+		}
+		return testJob
+	}
+
+	DescribeTable("WaitForJobToSucceed", func(conditionType batchv1.JobConditionType, shouldSucceed bool) {
+		testJob := newJobWithCondition(conditionType)
+		client := fake.NewSimpleClientset(testJob)
+
+		err := job.WaitForJobToSucceed(context.Background(), client, testJob, timeout)
+		if shouldSucceed {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+		Entry("should succeed when the job completed", batchv1.JobComplete, true),
+		Entry("should fail when the job failed", batchv1.JobFailed, false),
+	)
+
+	DescribeTable("WaitForJobToFail", func(conditionType batchv1.JobConditionType, shouldSucceed bool) {
+		testJob := newJobWithCondition(conditionType)
+		client := fake.NewSimpleClientset(testJob)
+
+		err := job.WaitForJobToFail(context.Background(), client, testJob, timeout)
+		if shouldSucceed {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+		Entry("should succeed when the job failed", batchv1.JobFailed, true),
+		Entry("should fail when the job completed", batchv1.JobComplete, false),
+	)
+
+	It("should attach the logs and events of the job pods to the error", func() {
+		testJob := newJobWithCondition(batchv1.JobFailed)
+		pod := &k8sv1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-job-pod",
+			Namespace: metav1.NamespaceDefault,
+			Labels:    map[string]string{batchv1.JobNameLabel: testJob.Name},
+		}}
+		event := &k8sv1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "test-job-pod.event", Namespace: metav1.NamespaceDefault},
+			InvolvedObject: k8sv1.ObjectReference{Kind: "Pod", Name: pod.Name},
+			Type:           k8sv1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+		}
+		client := fake.NewSimpleClientset(testJob, pod, event)
+
+		err := job.WaitForJobToSucceed(context.Background(), client, testJob, timeout)
+		Expect(err).To(MatchError(SatisfyAll(
+			ContainSubstring("logs of pod test-job-pod"),
+			ContainSubstring("fake logs"),
+			ContainSubstring("BackOff: Back-off restarting failed container"),
+		)))
+	})
+
+	It("should fail when the job does not exist", func() {
+		client := fake.NewSimpleClientset()
+
+		Expect(job.WaitForJobToSucceed(context.Background(), client, newJobWithCondition(""), timeout)).ToNot(Succeed())
+	})
+
+	It("should stop waiting when the context is cancelled", func() {
+		testJob := newJobWithCondition("")
+		client := fake.NewSimpleClientset(testJob)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Expect(job.WaitForJobToSucceed(ctx, client, testJob, time.Minute)).To(MatchError(ContainSubstring("context canceled")))
+	})
+
+	Context("NewConnectivityJob", func() {
+		container := func(connectivityJob *batchv1.Job) k8sv1.Container {
+			containers := connectivityJob.Spec.Template.Spec.Containers
+			Expect(containers).To(HaveLen(1))
+			return containers[0]
+		}
+
+		It("should reach the host over TCP with the defaults", func() {
+			connectivityJob, err := job.NewConnectivityJob("10.0.0.1", "1500")
+			Expect(err).ToNot(HaveOccurred())
+
+			agent := container(connectivityJob)
+			Expect(agent.Image).To(HaveSuffix("/test-helpers:" + flags.KubeVirtVersionTag))
+			Expect(agent.Command).To(Equal([]string{"/usr/bin/test-net-agent", "client"}))
+			Expect(agent.Args).To(Equal([]string{
+				"--protocol", "tcp",
+				"--host", "10.0.0.1",
+				"--port", "1500",
+				"--expected-payload", "Hello World!",
+				"--timeout", "5s",
+			}))
+			Expect(*connectivityJob.Spec.BackoffLimit).To(Equal(int32(job.JobRetry)))
+			Expect(*connectivityJob.Spec.ActiveDeadlineSeconds).To(Equal(int64(job.JobTimeout)))
+			Expect(connectivityJob.Spec.Template.Spec.Affinity).To(BeNil())
+			Expect(connectivityJob.Spec.Template.Annotations).To(BeEmpty())
+		})
+
+		It("should reach the host with the protocol, IP family and expected payload", func() {
+			connectivityJob, err := job.NewConnectivityJob("fd10::1", "8080",
+				job.WithProtocol(job.ProtocolUDP),
+				job.WithIPFamily(k8sv1.IPv6Protocol),
+				job.WithExpectedPayload("Hello Client"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(container(connectivityJob).Args).To(Equal([]string{
+				"--protocol", "udp",
+				"--host", "fd10::1",
+				"--port", "8080",
+				"--expected-payload", "Hello Client",
+				"--timeout", "5s",
+				"--ip-family", "IPv6",
+			}))
+		})
+
+		It("should apply the options", func() {
+			connectivityJob, err := job.NewConnectivityJob("10.0.0.1", "1500",
+				job.WithRetries(1),
+				job.WithTimeout(90),
+				job.WithNodeAffinity(k8sv1.NodeSelectorOpNotIn, "node01"),
+				job.WithSecondaryNetwork("net-a"),
+				job.WithSecondaryNetwork("net-b"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*connectivityJob.Spec.BackoffLimit).To(Equal(int32(1)))
+			Expect(*connectivityJob.Spec.ActiveDeadlineSeconds).To(Equal(int64(90)))
+			nodeSelectorTerms := connectivityJob.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(nodeSelectorTerms).To(ConsistOf(k8sv1.NodeSelectorTerm{
+				MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+					Key:      k8sv1.LabelHostname,
+					Operator: k8sv1.NodeSelectorOpNotIn,
+					Values:   []string{"node01"},
+				}},
+			}))
+			Expect(connectivityJob.Spec.Template.Annotations).To(HaveKeyWithValue("k8s.v1.cni.cncf.io/networks", "net-a,net-b"))
+			Expect(connectivityJob.Annotations).To(BeEmpty())
+		})
+
+		It("should pin the job to the node", func() {
+			connectivityJob, err := job.NewConnectivityJob("10.0.0.1", "1500", job.WithNodeName("node01"))
+			Expect(err).ToNot(HaveOccurred())
+
+			nodeSelectorTerms := connectivityJob.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(nodeSelectorTerms).To(ConsistOf(k8sv1.NodeSelectorTerm{
+				MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+					Key:      k8sv1.LabelHostname,
+					Operator: k8sv1.NodeSelectorOpIn,
+					Values:   []string{"node01"},
+				}},
+			}))
+		})
+
+		It("should reach a service through its DNS name", func() {
+			connectivityJob, err := job.NewServiceConnectivityJob("my-service", "my-namespace", 1500)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(container(connectivityJob).Args).To(ContainElements("my-service.my-namespace.svc", "1500"))
+		})
+	})
+
+	DescribeTable("should reject invalid parameters", func(newJob func() (*batchv1.Job, error), expectedError string) {
+		invalidJob, err := newJob()
+		Expect(err).To(MatchError(expectedError))
+		Expect(invalidJob).To(BeNil())
+	},
+		Entry("connectivity job with a port which is not a number", func() (*batchv1.Job, error) {
+			return job.NewConnectivityJob("10.0.0.1", "http")
+		}, `invalid port "http": strconv.Atoi: parsing "http": invalid syntax`),
+		Entry("connectivity job with a port out of range", func() (*batchv1.Job, error) {
+			return job.NewConnectivityJob("10.0.0.1", "65536")
+		}, "port must be between 1 and 65535, got 65536"),
+		Entry("connectivity job without host", func() (*batchv1.Job, error) {
+			return job.NewConnectivityJob("", "1500")
+		}, "host is required"),
+		Entry("connectivity job with an unknown protocol", func() (*batchv1.Job, error) {
+			return job.NewConnectivityJob("10.0.0.1", "1500", job.WithProtocol("sctp"))
+		}, `unknown protocol "sctp"`),
+		Entry("connectivity job with an unknown IP family", func() (*batchv1.Job, error) {
+			return job.NewConnectivityJob("10.0.0.1", "1500", job.WithIPFamily("IPv5"))
+		}, `unknown IP family "IPv5"`),
+		Entry("connectivity job with negative retries", func() (*batchv1.Job, error) {
+			return job.NewConnectivityJob("10.0.0.1", "1500", job.WithRetries(-1))
+		}, "retries must not be negative, got -1"),
+		Entry("connectivity job without timeout", func() (*batchv1.Job, error) {
+			return job.NewConnectivityJob("10.0.0.1", "1500", job.WithTimeout(0))
+		}, "timeout must be positive, got 0"),
+		Entry("service connectivity job without namespace", func() (*batchv1.Job, error) {
+			return job.NewServiceConnectivityJob("my-service", "", 1500)
+		}, `service name and namespace are required, got "my-service" and ""`),
+		Entry("DNS resolution job without name", func() (*batchv1.Job, error) {
+			return job.NewDNSResolutionJob("", job.DNSRecordA)
+		}, "DNS name is required"),
+		Entry("DNS resolution job with an unknown record type", func() (*batchv1.Job, error) {
+			return job.NewDNSResolutionJob("my-service.my-namespace.svc", "MX")
+		}, `unknown DNS record type "MX"`),
+		Entry("iperf3 server job with a port out of range", func() (*batchv1.Job, error) {
+			return job.NewIPerfServerJob(0)
+		}, "port must be between 1 and 65535, got 0"),
+		Entry("iperf3 client job over HTTP", func() (*batchv1.Job, error) {
+			return job.NewIPerfClientJob("10.0.0.1", 5201, job.WithProtocol(job.ProtocolHTTP))
+		}, `iperf3 does not support protocol "http"`),
+		Entry("iperf3 client job without duration", func() (*batchv1.Job, error) {
+			return job.NewIPerfClientJob("10.0.0.1", 5201, job.WithIPerfDuration(0))
+		}, "iperf3 duration must be positive, got 0"),
+	)
+
+	Context("GetConnectivityVerdicts", func() {
+		It("should fail to parse logs without verdict", func() {
+			testJob := newJobWithCondition(batchv1.JobFailed)
+			pod := &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-job-pod",
+					Namespace: metav1.NamespaceDefault,
+					Labels:    map[string]string{batchv1.JobNameLabel: testJob.Name},
+				},
+				Status: k8sv1.PodStatus{Phase: k8sv1.PodFailed},
+			}
+			client := fake.NewSimpleClientset(testJob, pod)
+
+			// The fake client returns "fake logs" as logs of any pod.
+			_, err := job.GetConnectivityVerdicts(context.Background(), client, testJob)
+			Expect(err).To(MatchError(ContainSubstring(`pod test-job-pod: failed to parse the verdict from the logs "fake logs"`)))
+		})
+
+		It("should skip the running pods", func() {
+			testJob := newJobWithCondition("")
+			pod := &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-job-pod",
+					Namespace: metav1.NamespaceDefault,
+					Labels:    map[string]string{batchv1.JobNameLabel: testJob.Name},
+				},
+				Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+			}
+			client := fake.NewSimpleClientset(testJob, pod)
+
+			Expect(job.GetConnectivityVerdicts(context.Background(), client, testJob)).To(BeEmpty())
+		})
+	})
+
+	Context("NewDNSResolutionJob", func() {
+		It("should resolve the records of the name", func() {
+			dnsJob, err := job.NewDNSResolutionJob("my-service.my-namespace.svc", job.DNSRecordSRV,
+				job.WithExpectedRecords("vmi-0.my-service.my-namespace.svc:1500"),
+				job.WithRetries(1),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			containers := dnsJob.Spec.Template.Spec.Containers
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0].Command).To(Equal([]string{"python3", "-c"}))
+			Expect(containers[0].Args).To(HaveLen(4))
+			Expect(containers[0].Args[1:]).To(Equal([]string{"SRV", "my-service.my-namespace.svc", "vmi-0.my-service.my-namespace.svc:1500"}))
+			Expect(*dnsJob.Spec.BackoffLimit).To(Equal(int32(1)))
+		})
+	})
+})
@@ -97,6 +97,12 @@ EOL`, inetSuffix, port)
 	Expect(console.RunCommand(vmi, serverCommand, 60*time.Second)).To(Succeed())
 }
 
+// StartIPerfServer starts an iperf3 server in the background, the guest image must provide iperf3.
+func StartIPerfServer(vmi *v1.VirtualMachineInstance, port int) {
+	serverCommand := fmt.Sprintf("iperf3 --server --daemon --port %d\n", port)
+	Expect(console.RunCommand(vmi, serverCommand, 60*time.Second)).To(Succeed())
+}
+
 func (s server) Start(vmi *v1.VirtualMachineInstance, port int, extraArgs ...string) {
 	Expect(console.RunCommand(vmi, s.composeServerCommand(port, extraArgs...), 60*time.Second)).To(Succeed())
 }
@@ -20,6 +20,8 @@
 package libpod
 
 import (
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -59,7 +61,53 @@ func RenderPrivilegedPod(name string, cmd, args []string) *v1.Pod {
 	return &pod
 }
 
-func RenderPod(name string, cmd, args []string) *v1.Pod {
+// Option customizes a pod rendered by RenderPod.
+type Option func(*v1.Pod)
+
+// WithNodeAffinity restricts the nodes the pod runs on, e.g. to run it on a given node with the NodeSelectorOpIn
+// operator, or on any other node with the NodeSelectorOpNotIn operator.
+func WithNodeAffinity(operator v1.NodeSelectorOperator, nodeName string) Option {
+	return func(pod *v1.Pod) {
+		pod.Spec.Affinity = &v1.Affinity{
+			NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+					NodeSelectorTerms: []v1.NodeSelectorTerm{{
+						MatchExpressions: []v1.NodeSelectorRequirement{{
+							Key:      v1.LabelHostname,
+							Operator: operator,
+							Values:   []string{nodeName},
+						}},
+					}},
+				},
+			},
+		}
+	}
+}
+
+// WithImage runs the container of the pod from the given image instead of the vm-killer utility image.
+func WithImage(image string) Option {
+	return func(pod *v1.Pod) {
+		pod.Spec.Containers[0].Image = image
+	}
+}
+
+const networksAnnotation = "k8s.v1.cni.cncf.io/networks"
+
+// WithNetworks attaches the pod to the given network attachment definitions through Multus.
+func WithNetworks(networkNames ...string) Option {
+	return func(pod *v1.Pod) {
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		networks := networkNames
+		if current := pod.Annotations[networksAnnotation]; current != "" {
+			networks = append([]string{current}, networkNames...)
+		}
+		pod.Annotations[networksAnnotation] = strings.Join(networks, ",")
+	}
+}
+
+func RenderPod(name string, cmd, args []string, opts ...Option) *v1.Pod {
 	pod := v1.Pod{
 		ObjectMeta: v12.ObjectMeta{
 			GenerateName: name,
@@ -79,6 +127,9 @@ func RenderPod(name string, cmd, args []string) *v1.Pod {
 		},
 	}
 
+	for _, opt := range opts {
+		opt(&pod)
+	}
 	return &pod
 }
 
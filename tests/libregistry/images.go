@@ -28,3 +28,8 @@ import (
 func GetUtilityImageFromRegistry(imageName string) string {
 	return fmt.Sprintf("%s/%s:%s", flags.KubeVirtUtilityRepoPrefix, imageName, flags.KubeVirtUtilityVersionTag)
 }
+
+// GetTestHelpersImage returns the image of the test helper binaries, which is released along with the KubeVirt images.
+func GetTestHelpersImage() string {
+	return fmt.Sprintf("%s/test-helpers:%s", flags.KubeVirtRepoPrefix, flags.KubeVirtVersionTag)
+}
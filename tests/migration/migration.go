@@ -194,12 +194,12 @@ var _ = Describe(SIG("VM Live Migration", decorators.RequiresTwoSchedulableNodes
 
 			assertConnectivityToService := func(msg string) {
 				By(msg)
-				tcpJob := job.NewHelloWorldJobTCP(fmt.Sprintf("%s.%s", hostname, subdomain), strconv.FormatInt(int64(port), 10))
-				tcpJob.Spec.BackoffLimit = pointer.P(int32(3))
-				tcpJob, err := virtClient.BatchV1().Jobs(vmi.Namespace).Create(context.Background(), tcpJob, metav1.CreateOptions{})
+				tcpJob, err := job.NewConnectivityJob(fmt.Sprintf("%s.%s", hostname, subdomain), strconv.FormatInt(int64(port), 10), job.WithRetries(3))
+				Expect(err).ToNot(HaveOccurred())
+				tcpJob, err = virtClient.BatchV1().Jobs(vmi.Namespace).Create(context.Background(), tcpJob, metav1.CreateOptions{})
 				Expect(err).ToNot(HaveOccurred())
 
-				err = job.WaitForJobToSucceed(tcpJob, 90*time.Second)
+				err = job.WaitForJobToSucceed(context.Background(), virtClient, tcpJob, 90*time.Second)
 				Expect(err).ToNot(HaveOccurred(), msg)
 			}
 
@@ -244,7 +244,7 @@ func fakeMigrationSuccessInPVC(virtClient kubecli.KubevirtClient, pvcName, names
 	Expect(err).NotTo(HaveOccurred())
 
 	By("Waiting for the job to succeed")
-	err = job.WaitForJobToSucceed(fakeSuccessJob, time.Minute)
+	err = job.WaitForJobToSucceed(context.Background(), virtClient, fakeSuccessJob, time.Minute)
 	Expect(err).NotTo(HaveOccurred())
 
 	By("Removing the job")
@@ -22,7 +22,6 @@ package network
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -99,14 +98,14 @@ var _ = Describe(SIG("Services", func() {
 				tcpJob, err := createServiceConnectivityJob(serviceName, inboundVMI.Namespace, servicePort, jobSuccessRetry)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(job.WaitForJobToSucceed(tcpJob, 90*time.Second)).To(Succeed(), expectConnectivityToExposedService)
+				Expect(job.WaitForJobToSucceed(context.Background(), kubevirt.Client(), tcpJob, 90*time.Second)).To(Succeed(), expectConnectivityToExposedService)
 			})
 
 			It("[test_id:1548] should fail to reach the vmi if an invalid servicename is used", func() {
 				tcpJob, err := createServiceConnectivityJob("wrongservice", inboundVMI.Namespace, servicePort, jobFailureRetry)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = job.WaitForJobToFail(tcpJob, 90*time.Second)
+				err = job.WaitForJobToFail(context.Background(), kubevirt.Client(), tcpJob, 90*time.Second)
 				Expect(err).NotTo(HaveOccurred(), "connectivity is *not* expected, since there isn't an exposed service")
 			})
 		})
@@ -128,7 +127,7 @@ var _ = Describe(SIG("Services", func() {
 				tcpJob, err := createServiceConnectivityJob(serviceHostnameWithSubdomain, inboundVMI.Namespace, servicePort, jobSuccessRetry)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(job.WaitForJobToSucceed(tcpJob, 90*time.Second)).To(Succeed(), expectConnectivityToExposedService)
+				Expect(job.WaitForJobToSucceed(context.Background(), kubevirt.Client(), tcpJob, 90*time.Second)).To(Succeed(), expectConnectivityToExposedService)
 			})
 		})
 	})
@@ -181,7 +180,7 @@ var _ = Describe(SIG("Services", func() {
 				tcpJob, err := createServiceConnectivityJob(serviceName, inboundVMI.Namespace, servicePort, jobSuccessRetry)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(job.WaitForJobToSucceed(tcpJob, 90*time.Second)).To(Succeed(), expectConnectivityToExposedService)
+				Expect(job.WaitForJobToSucceed(context.Background(), kubevirt.Client(), tcpJob, 90*time.Second)).To(Succeed(), expectConnectivityToExposedService)
 			},
 				Entry("when the service is exposed by an IPv4 address.", k8sv1.IPv4Protocol),
 				Entry("when the service is exposed by an IPv6 address.", k8sv1.IPv6Protocol),
@@ -193,7 +192,7 @@ var _ = Describe(SIG("Services", func() {
 				tcpJob, err := createServiceConnectivityJob("missingservice", inboundVMI.Namespace, servicePort, jobFailureRetry)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = job.WaitForJobToFail(tcpJob, 90*time.Second)
+				err = job.WaitForJobToFail(context.Background(), kubevirt.Client(), tcpJob, 90*time.Second)
 				Expect(err).NotTo(HaveOccurred(), "connectivity is *not* expected, since there isn't an exposed service")
 			})
 		})
@@ -201,11 +200,12 @@ var _ = Describe(SIG("Services", func() {
 }))
 
 func createServiceConnectivityJob(serviceName, namespace string, servicePort int, retries int32) (*batchv1.Job, error) {
-	serviceFQDN := fmt.Sprintf("%s.%s", serviceName, namespace)
-
-	By(fmt.Sprintf("starting a job which tries to reach the vmi via service %s, on port %d", serviceFQDN, servicePort))
-	tcpJob := job.NewHelloWorldJobTCP(serviceFQDN, strconv.Itoa(servicePort))
-	tcpJob.Spec.BackoffLimit = &retries
+	By(fmt.Sprintf("starting a job which tries to reach the vmi via service %s, on port %d",
+		job.ServiceDNSName(serviceName, namespace), servicePort))
+	tcpJob, err := job.NewServiceConnectivityJob(serviceName, namespace, servicePort, job.WithRetries(retries))
+	if err != nil {
+		return nil, err
+	}
 	return kubevirt.Client().BatchV1().Jobs(namespace).Create(context.Background(), tcpJob, k8smetav1.CreateOptions{})
 }
 
@@ -160,6 +160,7 @@ var _ = Describe(SIG("SRIOV", Serial, decorators.SRIOV, func() {
 				Hostname:   dns.SanitizeHostname(vmi),
 				UUID:       string(vmi.Spec.Domain.Firmware.UUID),
 				Devices:    &deviceData,
+				KubeVirt:   &cloudinit.IdentityMetadata{Name: vmi.Name, Namespace: vmi.Namespace},
 			}
 
 			buf, err := json.Marshal(metadataStruct)
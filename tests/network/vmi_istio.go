@@ -117,11 +117,11 @@ var istioTests = func(vmType VmType) {
 			vmiIP := libnet.GetVmiPrimaryIPByFamily(vmi, k8sv1.IPv4Protocol)
 
 			By("Running job to send a request to the server")
-			return virtClient.BatchV1().Jobs(namespace).Create(
-				context.Background(),
-				job.NewHelloWorldJobHTTP(vmiIP, fmt.Sprintf("%d", targetPort)),
-				metav1.CreateOptions{},
-			)
+			httpJob, err := job.NewConnectivityJob(vmiIP, fmt.Sprintf("%d", targetPort), job.WithProtocol(job.ProtocolHTTP))
+			if err != nil {
+				return nil, err
+			}
+			return virtClient.BatchV1().Jobs(namespace).Create(context.Background(), httpJob, metav1.CreateOptions{})
 		}
 		BeforeEach(func() {
 			libnet.SkipWhenClusterNotSupportIpv4()
@@ -249,7 +249,7 @@ var istioTests = func(vmType VmType) {
 					return err
 				}
 				By("Waiting for the job to succeed")
-				return job.WaitForJobToSucceed(httpJob, 480*time.Second)
+				return job.WaitForJobToSucceed(context.Background(), virtClient, httpJob, 480*time.Second)
 			}
 
 			Context("With VMI having explicit ports specified", func() {
@@ -181,19 +181,19 @@ var _ = Describe(SIG("[rfe_id:694][crit:medium][vendor:cnv-qe@redhat.com][level:
 			ip := inboundVMI.Status.Interfaces[0].IP
 
 			By("start connectivity job on the same node as the VM")
-			localNodeTCPJob := job.NewHelloWorldJobTCP(ip, strconv.Itoa(testPort))
-			localNodeTCPJob.Spec.Template.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: newNodeAffinity(k8sv1.NodeSelectorOpIn, inboundVMI.Status.NodeName)}
+			localNodeTCPJob, err := job.NewConnectivityJob(ip, strconv.Itoa(testPort), job.WithNodeAffinity(k8sv1.NodeSelectorOpIn, inboundVMI.Status.NodeName))
+			Expect(err).ToNot(HaveOccurred())
 			localNodeTCPJob, err = virtClient.BatchV1().Jobs(inboundVMI.ObjectMeta.Namespace).Create(context.Background(), localNodeTCPJob, metav1.CreateOptions{})
 			Expect(err).ToNot(HaveOccurred())
 
 			By("start connectivity job on different node")
-			remoteNodeTCPJob := job.NewHelloWorldJobTCP(ip, strconv.Itoa(testPort))
-			remoteNodeTCPJob.Spec.Template.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: newNodeAffinity(k8sv1.NodeSelectorOpNotIn, inboundVMI.Status.NodeName)}
+			remoteNodeTCPJob, err := job.NewConnectivityJob(ip, strconv.Itoa(testPort), job.WithNodeAffinity(k8sv1.NodeSelectorOpNotIn, inboundVMI.Status.NodeName))
+			Expect(err).ToNot(HaveOccurred())
 			remoteNodeTCPJob, err = virtClient.BatchV1().Jobs(inboundVMI.ObjectMeta.Namespace).Create(context.Background(), remoteNodeTCPJob, metav1.CreateOptions{})
 			Expect(err).ToNot(HaveOccurred())
 
-			Expect(job.WaitForJobToSucceed(localNodeTCPJob, 90*time.Second)).To(Succeed(), "should be able to reach VM workload from a pod on the same node")
-			Expect(job.WaitForJobToSucceed(remoteNodeTCPJob, 90*time.Second)).To(Succeed(), "should be able to reach VM workload from a pod on different node")
+			Expect(job.WaitForJobToSucceed(context.Background(), virtClient, localNodeTCPJob, 90*time.Second)).To(Succeed(), "should be able to reach VM workload from a pod on the same node")
+			Expect(job.WaitForJobToSucceed(context.Background(), virtClient, remoteNodeTCPJob, 90*time.Second)).To(Succeed(), "should be able to reach VM workload from a pod on different node")
 		})
 	})
 
@@ -854,19 +854,3 @@ func vmiWithMultiQueue() *v1.VirtualMachineInstance {
 		libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
 		libvmi.WithNetwork(v1.DefaultPodNetwork()))
 }
-
-func newNodeAffinity(selector k8sv1.NodeSelectorOperator, nodeName string) *k8sv1.NodeAffinity {
-	req := k8sv1.NodeSelectorRequirement{
-		Key:      k8sv1.LabelHostname,
-		Operator: selector,
-		Values:   []string{nodeName},
-	}
-	term := []k8sv1.NodeSelectorTerm{
-		{
-			MatchExpressions: []k8sv1.NodeSelectorRequirement{req},
-		},
-	}
-	return &k8sv1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
-		NodeSelectorTerms: term,
-	}}
-}
@@ -41,12 +41,12 @@ import (
 	"kubevirt.io/kubevirt/tests/console"
 	"kubevirt.io/kubevirt/tests/decorators"
 	"kubevirt.io/kubevirt/tests/exec"
-	"kubevirt.io/kubevirt/tests/flags"
 	"kubevirt.io/kubevirt/tests/framework/kubevirt"
 	"kubevirt.io/kubevirt/tests/libinfra"
 	"kubevirt.io/kubevirt/tests/libmigration"
 	"kubevirt.io/kubevirt/tests/libnet"
 	"kubevirt.io/kubevirt/tests/libpod"
+	"kubevirt.io/kubevirt/tests/libregistry"
 	"kubevirt.io/kubevirt/tests/libsecret"
 	"kubevirt.io/kubevirt/tests/libvmifact"
 	"kubevirt.io/kubevirt/tests/libwait"
@@ -352,7 +352,7 @@ func setupWebhook(webhookName, webhookSecretName string, webhookPort int32, webh
 			},
 			Containers: []k8sv1.Container{{
 				Name:            webhookName,
-				Image:           fmt.Sprintf("%s/test-helpers:%s", flags.KubeVirtRepoPrefix, flags.KubeVirtVersionTag),
+				Image:           libregistry.GetTestHelpersImage(),
 				ImagePullPolicy: k8sv1.PullAlways,
 				Command:         []string{"/usr/bin/test-pod-mutator"},
 				Args:            webhookArgs,
@@ -358,6 +358,7 @@ var _ = Describe("[rfe_id:151][crit:high][vendor:cnv-qe@redhat.com][level:compon
 					InstanceType: testInstancetype,
 					Hostname:     dns.SanitizeHostname(vmi),
 					UUID:         string(vmi.Spec.Domain.Firmware.UUID),
+					KubeVirt:     &cloudinit.IdentityMetadata{Name: vmi.Name, Namespace: vmi.Namespace},
 					Devices: &[]cloudinit.DeviceData{
 						{
 							Type:    cloudinit.NICMetadataType,